@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/checkup"
+	"kubevirt.io/kubevirt/pkg/checkup/runner"
+)
+
+const (
+	defaultTerminationLog = "/dev/termination-log"
+
+	// minBenchmarkTime is left to the benchmark even if the timeout of the
+	// checkup barely covers the cleanup
+	minBenchmarkTime = 30 * time.Second
+)
+
+func main() {
+	var guestImage, terminationLog string
+	pflag.CommandLine.AddGoFlagSet(kubecli.FlagSet())
+	pflag.StringVar(&guestImage, "guest-image", runner.DefaultGuestImage, "container disk the benchmarks run in")
+	pflag.StringVar(&terminationLog, "termination-log", defaultTerminationLog, "file the result of the checkup is written to")
+	pflag.Parse()
+
+	log.InitializeLogging("virt-checkup")
+
+	res := run(guestImage)
+	if err := writeResult(terminationLog, res); err != nil {
+		log.Log.Reason(err).Error("Failed to report the checkup result")
+		os.Exit(1)
+	}
+	if !res.Succeeded {
+		log.Log.Errorf("The checkup failed: %s", res.FailureReason)
+		os.Exit(1)
+	}
+}
+
+func run(guestImage string) *checkup.Result {
+	spec := &checkupv1.VirtualMachineCheckupSpec{}
+	if err := json.Unmarshal([]byte(os.Getenv(checkup.EnvSpec)), spec); err != nil {
+		return &checkup.Result{FailureReason: fmt.Sprintf("invalid checkup spec: %v", err)}
+	}
+	name, namespace := os.Getenv(checkup.EnvName), os.Getenv(checkup.EnvNamespace)
+	if name == "" || namespace == "" {
+		return &checkup.Result{FailureReason: fmt.Sprintf("%s and %s are required", checkup.EnvName, checkup.EnvNamespace)}
+	}
+
+	client, err := kubecli.GetKubevirtClient()
+	if err != nil {
+		return &checkup.Result{FailureReason: fmt.Sprintf("failed to create the client: %v", err)}
+	}
+
+	// The pod is killed once the timeout of the checkup expired, stop the
+	// benchmark early enough to remove the guests it created
+	ctx := context.Background()
+	if spec.Timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, max(minBenchmarkTime, spec.Timeout.Duration-runner.CleanupTimeout))
+		defer cancel()
+	}
+
+	return runner.NewRunner(client, namespace, name, guestImage).Run(ctx, spec)
+}
+
+func writeResult(path string, res *checkup.Result) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
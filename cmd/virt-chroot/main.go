@@ -163,6 +163,13 @@ func main() {
 	removeMDEVCmd := NewRemoveMDEVCommand()
 	removeMDEVCmd.Flags().String("uuid", "", "uuid of the mediated device to remove")
 
+	setSRIOVNumVFsCmd := NewSetSRIOVNumVFsCommand()
+	setSRIOVNumVFsCmd.Flags().String("pf", "", "PCI address of the physical function")
+	setSRIOVNumVFsCmd.Flags().Uint("numvfs", 0, "the number of virtual functions to create")
+
+	bindVFIOCmd := NewBindVFIOCommand()
+	bindVFIOCmd.Flags().String("pci-address", "", "PCI address of the device to bind")
+
 	cgroupsCmd := &cobra.Command{
 		Use:   "set-cgroups-resources",
 		Short: "Set cgroups resources",
@@ -214,6 +221,8 @@ func main() {
 		createTapCmd,
 		createMDEVCmd,
 		removeMDEVCmd,
+		setSRIOVNumVFsCmd,
+		bindVFIOCmd,
 		cgroupsCmd,
 	)
 
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var pciDevicesPath string = "/sys/bus/pci/devices"
+var pciDriversProbePath string = "/sys/bus/pci/drivers_probe"
+
+const vfioPCIDriver = "vfio-pci"
+
+func writeSysfs(path, value string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0200)
+	if err != nil {
+		return fmt.Errorf("can't open path %s: %v", path, err)
+	}
+	defer f.Close()
+
+	if _, err = f.WriteString(value); err != nil {
+		return fmt.Errorf("can't write %q to %s: %v", value, path, err)
+	}
+	return nil
+}
+
+// setSRIOVNumVFs creates the given number of virtual functions on a physical function.
+// The virtual functions are not probed by the host drivers, so they can be bound to vfio-pci.
+func setSRIOVNumVFs(pfAddress string, numVFs int) error {
+	pfPath := filepath.Join(pciDevicesPath, pfAddress)
+	numVFsPath := filepath.Join(pfPath, "sriov_numvfs")
+
+	rawNumVFs, err := os.ReadFile(numVFsPath)
+	if err != nil {
+		fmt.Printf("failed to set the number of VFs of %s, can't read %s\n", pfAddress, numVFsPath)
+		return err
+	}
+	currentNumVFs, err := strconv.Atoi(strings.TrimSpace(string(rawNumVFs)))
+	if err != nil {
+		return fmt.Errorf("failed to parse the number of VFs of %s: %v", pfAddress, err)
+	}
+	if currentNumVFs == numVFs {
+		return nil
+	}
+
+	if err = writeSysfs(filepath.Join(pfPath, "sriov_drivers_autoprobe"), "0"); err != nil {
+		fmt.Printf("failed to disable VF driver autoprobe of %s\n", pfAddress)
+		return err
+	}
+
+	// The kernel only allows changing the number of VFs from or to zero
+	if currentNumVFs != 0 {
+		if err = writeSysfs(numVFsPath, "0"); err != nil {
+			fmt.Printf("failed to remove the VFs of %s\n", pfAddress)
+			return err
+		}
+	}
+	if numVFs != 0 {
+		if err = writeSysfs(numVFsPath, strconv.Itoa(numVFs)); err != nil {
+			fmt.Printf("failed to create %d VFs on %s\n", numVFs, pfAddress)
+			return err
+		}
+	}
+	fmt.Printf("Successfully set the number of VFs of %s to %d\n", pfAddress, numVFs)
+	return nil
+}
+
+// bindVFIO binds a PCI device to the vfio-pci driver, unbinding it from its current driver if needed.
+func bindVFIO(pciAddress string) error {
+	devicePath := filepath.Join(pciDevicesPath, pciAddress)
+
+	if err := writeSysfs(filepath.Join(devicePath, "driver_override"), vfioPCIDriver); err != nil {
+		fmt.Printf("failed to override the driver of %s\n", pciAddress)
+		return err
+	}
+
+	if driverPath, err := os.Readlink(filepath.Join(devicePath, "driver")); err == nil {
+		if filepath.Base(driverPath) == vfioPCIDriver {
+			return nil
+		}
+		if err = writeSysfs(filepath.Join(devicePath, "driver", "unbind"), pciAddress); err != nil {
+			fmt.Printf("failed to unbind %s from %s\n", pciAddress, filepath.Base(driverPath))
+			return err
+		}
+	}
+
+	if err := writeSysfs(pciDriversProbePath, pciAddress); err != nil {
+		fmt.Printf("failed to probe the driver of %s\n", pciAddress)
+		return err
+	}
+	fmt.Printf("Successfully bound %s to %s\n", pciAddress, vfioPCIDriver)
+	return nil
+}
+
+func NewSetSRIOVNumVFsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-sriov-numvfs",
+		Short: "set the number of virtual functions of an SR-IOV physical function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pfAddress := cmd.Flag("pf").Value.String()
+			numVFs, err := strconv.Atoi(cmd.Flag("numvfs").Value.String())
+			if err != nil {
+				return fmt.Errorf("failed to parse the number of VFs: %v", err)
+			}
+			return setSRIOVNumVFs(pfAddress, numVFs)
+		},
+	}
+}
+
+func NewBindVFIOCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bind-vfio",
+		Short: "bind a PCI device to the vfio-pci driver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pciAddress := cmd.Flag("pci-address").Value.String()
+			return bindVFIO(pciAddress)
+		},
+	}
+}
@@ -3,45 +3,22 @@ package main
 import (
 	"fmt"
 	"strconv"
-	"strings"
-	"time"
 
 	"github.com/spf13/cobra"
-	"github.com/vishvananda/netlink"
-	"golang.org/x/sys/unix"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/netlink"
 )
 
 func createTapDevice(name string, owner uint, group uint, queueNumber int, mtu int) error {
-	tapDevice := &netlink.Tuntap{
-		LinkAttrs:  netlink.LinkAttrs{Name: name},
-		Mode:       unix.IFF_TAP,
-		NonPersist: false,
-		Owner:      uint32(owner),
-		Group:      uint32(group),
-	}
-
-	// Configure tap devices with the same flags used by libvirt.
-	// This ensures consistent behavior and avoids potential issues.
-	// See libvirt's implementation for additional details:
-	// https://github.com/libvirt/libvirt/blob/1899d7df34576e414c49e4d91c68e6e2348f37f2/src/util/virnetdevtap.c#L217-L219
-	if queueNumber <= 1 {
-		tapDevice.Flags = netlink.TUNTAP_DEFAULTS
-	} else {
-		tapDevice.Flags = netlink.TUNTAP_MULTI_QUEUE_DEFAULTS
-	}
-
-	// Device creation is retried due to https://bugzilla.redhat.com/1933627
-	// which has been observed on multiple occasions on CI runs.
-	const retryAttempts = 5
-	attempt, err := retry(retryAttempts, func() error {
-		return netlink.LinkAdd(tapDevice)
+	attempt, err := netlink.NetLink{}.AddTapDevice(netlink.TapDevice{
+		Name:   name,
+		Owner:  uint32(owner),
+		Group:  uint32(group),
+		Queues: queueNumber,
+		MTU:    mtu,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create tap device named %s. Reason: %v", name, err)
-	}
-
-	if err := netlink.LinkSetMTU(tapDevice, mtu); err != nil {
-		return fmt.Errorf("failed to set MTU on tap device named %s. Reason: %v", name, err)
+		return err
 	}
 
 	fmt.Printf("Successfully created tap device %s, attempt %d\n", name, attempt)
@@ -79,20 +56,3 @@ func NewCreateTapCommand() *cobra.Command {
 		},
 	}
 }
-
-func retry(retryAttempts uint, f func() error) (uint, error) {
-	var errorsString []string
-	for attemptID := uint(0); attemptID < retryAttempts; attemptID++ {
-		if err := f(); err != nil {
-			errorsString = append(errorsString, fmt.Sprintf("[%d]: %v", attemptID, err))
-			time.Sleep(time.Second)
-		} else {
-			if len(errorsString) > 0 {
-				fmt.Printf("warning: Tap device creation has been retried: %v", strings.Join(errorsString, "\n"))
-			}
-			return attemptID, nil
-		}
-	}
-
-	return retryAttempts, fmt.Errorf("%s", strings.Join(errorsString, "\n"))
-}
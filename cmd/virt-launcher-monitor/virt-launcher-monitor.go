@@ -31,6 +31,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -46,6 +47,9 @@ const (
 	httpRequestTimeout                 = 2 * time.Second
 
 	passtLogFile = "/var/run/kubevirt/passt.log" // #nosec G101
+
+	// maxReattachAttempts bounds the virt-launcher restarts in case it keeps crashing
+	maxReattachAttempts = 3
 )
 
 func cleanupContainerDiskDirectory(ephemeralDiskDir string) {
@@ -61,6 +65,7 @@ func main() {
 	containerDiskDir := pflag.String("container-disk-dir", "/var/run/kubevirt/container-disks", "Base directory for container disk data")
 	keepAfterFailure := pflag.Bool("keep-after-failure", false, "virt-launcher will be kept alive after failure for debugging if set to true")
 	uid := pflag.String("uid", "", "UID of the VirtualMachineInstance")
+	reattachOnCrash := pflag.Bool("reattach-on-crash", false, "Restart a crashed virt-launcher and reattach it to the still running qemu process")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.CommandLine.ParseErrorsWhitelist = pflag.ParseErrorsWhitelist{UnknownFlags: true}
@@ -78,7 +83,7 @@ func main() {
 		}
 	}
 
-	exitCode, err := RunAndMonitor(*containerDiskDir, *uid)
+	exitCode, err := RunAndMonitor(*containerDiskDir, *uid, *reattachOnCrash)
 	if *keepAfterFailure && (exitCode != 0 || err != nil) {
 		log.Log.Infof("keeping virt-launcher container alive since --keep-after-failure is set to true")
 		<-make(chan struct{})
@@ -94,20 +99,15 @@ func main() {
 }
 
 // RunAndMonitor run virt-launcher process and monitor it to give qemu an extra grace period to properly terminate
-// in case of crashes
-func RunAndMonitor(containerDiskDir, uid string) (int, error) {
+// in case of crashes. With reattachOnCrash a crashed virt-launcher is restarted as long as qemu is still running.
+func RunAndMonitor(containerDiskDir, uid string, reattachOnCrash bool) (int, error) {
 	defer cleanupContainerDiskDirectory(containerDiskDir)
 	defer terminateIstioProxy()
 	args := removeArg(os.Args[1:], "--keep-after-failure")
+	args = removeArg(args, "--reattach-on-crash")
 
-	cmd := exec.Command("/usr/bin/virt-launcher", args...)
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
-	}
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Start(); err != nil {
+	launcher := &launcherProcess{}
+	if err := launcher.start(args); err != nil {
 		log.Log.Reason(err).Error("failed to run virt-launcher")
 		return 1, err
 	}
@@ -133,7 +133,7 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 						log.Log.V(4).Infof("No more processes to be reaped")
 						break
 					}
-					if wpid == cmd.Process.Pid {
+					if launcher.isPid(wpid) {
 						log.Log.Infof("Reaped Launcher main pid")
 						exitStatus <- wstatus.ExitStatus()
 					}
@@ -142,7 +142,7 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 
 			default:
 				log.Log.Infof("signalling virt-launcher to shut down")
-				err := cmd.Process.Signal(syscall.SIGTERM)
+				err := launcher.terminate()
 				sig.Signal()
 				if err != nil {
 					log.Log.Reason(err).Errorf("received signal %s but can't signal virt-launcher to shut down", sig.String())
@@ -152,6 +152,17 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 	}()
 
 	exitCode := <-exitStatus
+	for attempt := 1; reattachOnCrash && exitCode != 0 && attempt <= maxReattachAttempts; attempt++ {
+		if launcher.isTerminating() || !qemuRunning() {
+			break
+		}
+		log.Log.Warningf("virt-launcher crashed with exit-code %d while qemu is still running, restarting it to reattach (attempt %d/%d)", exitCode, attempt, maxReattachAttempts)
+		if err := launcher.start(append(args, "--reattach")); err != nil {
+			log.Log.Reason(err).Error("failed to restart virt-launcher")
+			break
+		}
+		exitCode = <-exitStatus
+	}
 	if exitCode != 0 {
 		log.Log.Errorf("dirty virt-launcher shutdown: exit-code %d", exitCode)
 	}
@@ -217,6 +228,59 @@ func RunAndMonitor(containerDiskDir, uid string) (int, error) {
 	return exitCode, nil
 }
 
+// launcherProcess tracks the current virt-launcher process, which changes when
+// a crashed launcher gets restarted.
+type launcherProcess struct {
+	lock        sync.Mutex
+	cmd         *exec.Cmd
+	terminating bool
+}
+
+func (l *launcherProcess) start(args []string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	cmd := exec.Command("/usr/bin/virt-launcher", args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	l.cmd = cmd
+	return nil
+}
+
+func (l *launcherProcess) isPid(pid int) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.cmd != nil && l.cmd.Process.Pid == pid
+}
+
+func (l *launcherProcess) terminate() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.terminating = true
+	return l.cmd.Process.Signal(syscall.SIGTERM)
+}
+
+func (l *launcherProcess) isTerminating() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.terminating
+}
+
+func qemuRunning() bool {
+	if pid, _ := findPid("qemu-system"); pid > 0 {
+		return true
+	}
+	pid, _ := findPid("qemu-kvm")
+	return pid > 0
+}
+
 func RemoveContents(dir string) error {
 	files, err := filepath.Glob(filepath.Join(dir, "*.sock"))
 	if err != nil {
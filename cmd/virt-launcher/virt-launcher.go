@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/hostsensors"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	putil "kubevirt.io/kubevirt/pkg/util"
@@ -61,6 +62,7 @@ import (
 	virtcli "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	cmdserver "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cmd-server"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/warmpool"
 )
 
 const defaultStartTimeout = 3 * time.Minute
@@ -75,6 +77,9 @@ func markReady() {
 	if err != nil {
 		panic(err)
 	}
+	if err := cmdclient.ClearReattachingOnGuest(); err != nil {
+		log.Log.Reason(err).Error("failed to clear the reattaching marker")
+	}
 	log.Log.Info("Marked as ready")
 }
 
@@ -226,6 +231,11 @@ func initializeDirs(ephemeralDiskDir string,
 	if err != nil {
 		panic(err)
 	}
+
+	err = virtlauncher.InitializeDisksDirectories(hostsensors.ChannelDir)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func detectDomainWithUUID(domainManager virtwrap.DomainManager) *api.Domain {
@@ -359,6 +369,8 @@ func main() {
 	qemuAgentFSFreezeStatusInterval := pflag.Duration("qemu-fsfreeze-status-interval", 5*time.Second, "Interval between consecutive qemu agent calls for fsfreeze status command")
 	simulateCrash := pflag.Bool("simulate-crash", false, "Causes virt-launcher to immediately crash. This is used by functional tests to simulate crash loop scenarios.")
 	libvirtLogFilters := pflag.String("libvirt-log-filters", "", "Set custom log filters for libvirt")
+	reattach := pflag.Bool("reattach", false, "Reattach to the qemu process left behind by a crashed virt-launcher")
+	warmPoolIdentityFile := pflag.String("warm-pool-identity-file", "", "Idle in a launcher warm pool until this file holds the namespace, name and UID of the VirtualMachineInstance")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -375,6 +387,11 @@ func main() {
 		}
 	}
 
+	if *warmPoolIdentityFile != "" {
+		identity := warmpool.WaitForIdentity(*warmPoolIdentityFile, time.Second)
+		*namespace, *name, *uid = identity.Namespace, identity.Name, identity.UID
+	}
+
 	// Initialize local and shared directories
 	initializeDirs(*ephemeralDiskDir, *containerDiskDir, *hotplugDiskDir, *uid)
 
@@ -412,6 +429,19 @@ func main() {
 		panic(err)
 	}
 
+	if *reattach {
+		// virtqemud and virtlogd of the crashed launcher may still be around,
+		// the fresh virtqemud reconnects to the running qemu process
+		log.Log.Object(vmi).Info("Reattaching to the domain of a crashed virt-launcher")
+		cmdclient.SetBaseDir(*virtShareDir)
+		if err := cmdclient.MarkReattachingOnGuest(); err != nil {
+			log.Log.Reason(err).Error("failed to mark virt-launcher as reattaching")
+		}
+		if err := util.StopStaleLibvirtDaemons(); err != nil {
+			panic(err)
+		}
+	}
+
 	l.StartVirtqemud(stopChan)
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
@@ -20,11 +20,16 @@
 package main
 
 import (
+	"context"
 	goflag "flag"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"runtime/pprof"
+	"time"
 
+	"github.com/mdlayher/vsock"
 	"github.com/spf13/pflag"
 
 	"kubevirt.io/client-go/log"
@@ -39,6 +44,8 @@ func main() {
 	memProfile := pflag.String("memProfile", "", "Path to store a memory profile. Profiling is skipped if empty")
 	timeoutSeconds := pflag.Int32("timeoutSeconds", 1, "Duration in seconds the probe will wait for the guest command to return.")
 	guestAgentPing := pflag.Bool("guestAgentPing", false, "Flag to specify readiness probe based of guest-agent ping")
+	vsockPort := pflag.Uint32("vsockPort", 0, "VSOCK port of a guest HTTP service to probe. The probe is sent over VSOCK if set")
+	vsockPath := pflag.String("vsockPath", "/", "Path to request from the guest HTTP service when probing over VSOCK")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -60,6 +67,15 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *vsockPort != 0 {
+		err := probeVSockHTTP(client, *vsockPort, *vsockPath, *timeoutSeconds)
+		if err != nil {
+			log.Log.Reason(err).Critical("Failed to probe the guest over VSOCK")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	exitCode, stdOut, err := client.Exec(*domainName, *command, pflag.Args(), *timeoutSeconds)
 	if len(stdOut) > 0 {
 		fmt.Println(stdOut)
@@ -72,6 +88,39 @@ func main() {
 	os.Exit(exitCode)
 }
 
+// probeVSockHTTP sends an http GET request to a guest service listening on the
+// given VSOCK port. Like the kubelet, any status code in [200, 400) is a success.
+func probeVSockHTTP(client cmdclient.LauncherClient, port uint32, path string, timeoutSeconds int32) error {
+	domain, exists, err := client.GetDomain()
+	if err != nil {
+		return err
+	}
+	if !exists || domain.Spec.Devices.VSOCK == nil || domain.Spec.Devices.VSOCK.CID.Address == 0 {
+		return fmt.Errorf("the domain has no VSOCK device")
+	}
+	cid := domain.Spec.Devices.VSOCK.CID.Address
+
+	httpClient := &http.Client{
+		Timeout: time.Duration(timeoutSeconds) * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
+				return vsock.Dial(cid, port, nil)
+			},
+			DisableKeepAlives: true,
+		},
+	}
+	resp, err := httpClient.Get(fmt.Sprintf("http://vsock-%d%s", cid, path))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("guest responded with status %s", resp.Status)
+	}
+	return nil
+}
+
 func saveMemoryProfile(path string) {
 	if len(path) > 0 {
 		log.Log.Info("creating memory profile")
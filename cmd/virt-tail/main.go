@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -35,6 +36,8 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/warmpool"
 )
 
 type VirtTail struct {
@@ -105,6 +108,7 @@ func main() {
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.CommandLine.ParseErrorsWhitelist = pflag.ParseErrorsWhitelist{UnknownFlags: true}
 	logFile := pflag.String("logfile", "", "path of the logfile to be streamed")
+	warmPoolIdentityFile := pflag.String("warm-pool-identity-file", "", "Idle in a launcher warm pool until this file holds the identity of the VirtualMachineInstance, whose UID is added to the directory of the logfile")
 	pflag.Parse()
 
 	log.InitializeLogging("virt-tail")
@@ -115,6 +119,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *warmPoolIdentityFile != "" {
+		identity := warmpool.WaitForIdentity(*warmPoolIdentityFile, time.Second)
+		*logFile = filepath.Join(filepath.Dir(*logFile), identity.UID, filepath.Base(*logFile))
+	}
+
 	// Create context that listens for the interrupt signal from the container runtime.
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
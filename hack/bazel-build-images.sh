@@ -78,7 +78,8 @@ bazel build \
     --define container_tag= \
     //cmd/virt-operator:virt-operator-image //cmd/virt-api:virt-api-image //cmd/virt-controller:virt-controller-image \
     //cmd/virt-handler:virt-handler-image //cmd/virt-launcher:virt-launcher-image //cmd/virt-exportproxy:virt-exportproxy-image \
-    //cmd/virt-exportserver:virt-exportserver-image //cmd/synchronization-controller:virt-synchronization-controller-image ${other_images[@]}
+    //cmd/virt-exportserver:virt-exportserver-image //cmd/synchronization-controller:virt-synchronization-controller-image \
+    //cmd/virt-checkup:virt-checkup-image ${other_images[@]}
 
 rm -rf ${DIGESTS_DIR}/${ARCHITECTURE}
 mkdir -p ${DIGESTS_DIR}/${ARCHITECTURE}
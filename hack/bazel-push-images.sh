@@ -33,6 +33,7 @@ default_targets="
     virt-exportserver
     virt-exportproxy
     virt-synchronization-controller
+    virt-checkup
     alpine-container-disk-demo
     fedora-with-test-tooling-container-disk
     vm-killer
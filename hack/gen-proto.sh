@@ -14,4 +14,5 @@ protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/notify/info/info.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/cmd/v1/cmd.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/cmd/info/info.proto
 protoc --go_out=plugins=grpc:. pkg/vsock/system/v1/system.proto
+protoc --go_out=plugins=grpc:. pkg/storage/scan/v1alpha1/scan.proto
 protoc --go_out=plugins=grpc:. pkg/synchronizer-com/synchronization/v1/synchronization.proto
@@ -22,6 +22,12 @@ swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/export/v1beta1/types
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1alpha1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/clone/v1beta1/types.go
 swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/backup/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/audit/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/policy/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/checkup/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/accounting/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/maintenance/v1alpha1/types.go
+swagger-doc -in ${KUBEVIRT_DIR}/staging/src/kubevirt.io/api/operations/v1alpha1/types.go
 
 deepcopy-gen \
     --bounding-dirs kubevirt.io/api \
@@ -38,6 +44,12 @@ deepcopy-gen \
     kubevirt.io/api/clone/v1alpha1 \
     kubevirt.io/api/clone/v1beta1 \
     kubevirt.io/api/backup/v1alpha1 \
+    kubevirt.io/api/audit/v1alpha1 \
+    kubevirt.io/api/policy/v1alpha1 \
+    kubevirt.io/api/checkup/v1alpha1 \
+    kubevirt.io/api/accounting/v1alpha1 \
+    kubevirt.io/api/maintenance/v1alpha1 \
+    kubevirt.io/api/operations/v1alpha1 \
     kubevirt.io/api/core/v1
 
 defaulter-gen \
@@ -68,6 +80,12 @@ openapi-gen \
     kubevirt.io/api/snapshot/v1alpha1 \
     kubevirt.io/api/snapshot/v1beta1 \
     kubevirt.io/api/backup/v1alpha1 \
+    kubevirt.io/api/audit/v1alpha1 \
+    kubevirt.io/api/policy/v1alpha1 \
+    kubevirt.io/api/checkup/v1alpha1 \
+    kubevirt.io/api/accounting/v1alpha1 \
+    kubevirt.io/api/maintenance/v1alpha1 \
+    kubevirt.io/api/operations/v1alpha1 \
     kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1
 
 conversion-gen \
@@ -86,7 +104,7 @@ fi
 
 client-gen --clientset-name kubevirt \
     --input-base kubevirt.io/api \
-    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1beta1,pool/v1alpha1,pool/v1beta1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,backup/v1alpha1 \
+    --input core/v1,export/v1alpha1,export/v1beta1,snapshot/v1alpha1,snapshot/v1beta1,instancetype/v1beta1,pool/v1alpha1,pool/v1beta1,migrations/v1alpha1,clone/v1alpha1,clone/v1beta1,backup/v1alpha1,audit/v1alpha1,policy/v1alpha1,checkup/v1alpha1,accounting/v1alpha1,maintenance/v1alpha1,operations/v1alpha1 \
     --output-dir ${KUBEVIRT_DIR}/staging/src/kubevirt.io/client-go \
     --output-pkg ${CLIENT_GEN_BASE} \
     --go-header-file ${KUBEVIRT_DIR}/hack/boilerplate/boilerplate.go.txt
@@ -159,6 +177,24 @@ deepcopy-gen \
     #include backup
     GOFLAGS= controller-gen crd paths=../api/backup/v1alpha1/
 
+    #include audit
+    GOFLAGS= controller-gen crd paths=../api/audit/v1alpha1/
+
+    #include policy
+    GOFLAGS= controller-gen crd paths=../api/policy/v1alpha1/
+
+    #include checkup
+    GOFLAGS= controller-gen crd paths=../api/checkup/v1alpha1/
+
+    #include accounting
+    GOFLAGS= controller-gen crd paths=../api/accounting/v1alpha1/
+
+    #include maintenance
+    GOFLAGS= controller-gen crd paths=../api/maintenance/v1alpha1/
+
+    #include operations
+    GOFLAGS= controller-gen crd paths=../api/operations/v1alpha1/
+
     #remove some weird stuff from controller-gen
     cd config/crd
     for file in *; do
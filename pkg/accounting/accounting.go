@@ -0,0 +1,419 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package accounting
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	accountingv1 "kubevirt.io/api/accounting/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	sampleInterval = time.Minute
+	reportInterval = 15 * time.Minute
+	// Time which passed without a sample, e.g. while another virt-controller
+	// was leading, is not accounted
+	maxSampleGap = 5 * sampleInterval
+
+	reportPeriod     = 24 * time.Hour
+	reportNamePrefix = "usage-"
+	reportDateLayout = "2006-01-02"
+
+	secondsPerHour = 3600
+	bytesPerGiB    = 1024 * 1024 * 1024
+
+	podMetricsPath = "/apis/metrics.k8s.io/v1beta1/pods"
+)
+
+// podMetricsList is the subset of the metrics.k8s.io PodMetricsList the
+// accountant reads, the usage is measured by the kubelet from the pod cgroups
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+type podMetrics struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Containers        []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string             `json:"name"`
+	Usage k8sv1.ResourceList `json:"usage"`
+}
+
+// usage holds vCPU and memory consumption in seconds
+type usage struct {
+	vcpuSeconds       float64
+	memoryByteSeconds float64
+}
+
+func (u *usage) add(vcpus, memoryBytes, seconds float64) {
+	u.vcpuSeconds += vcpus * seconds
+	u.memoryByteSeconds += memoryBytes * seconds
+}
+
+// vmiUsage holds the allocated and actual usage of a VMI or a namespace
+type vmiUsage struct {
+	allocated usage
+	actual    usage
+}
+
+// namespaceUsage holds the usage of a namespace which is not yet added to its
+// VirtualMachineUsageReport
+type namespaceUsage struct {
+	vmiUsage
+	vmis map[string]*vmiUsage
+}
+
+// Accountant samples the resources of running VMIs, accumulates them per
+// namespace into Prometheus counters and adds them to a daily
+// VirtualMachineUsageReport in each namespace.
+type Accountant struct {
+	client        kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
+	vmiStore      cache.Store
+	podIndexer    cache.Indexer
+	hasSynced     func() bool
+	now           func() time.Time
+	// podUsage returns the measured usage of the virt-launcher pods by namespace/name
+	podUsage func() (map[string]k8sv1.ResourceList, error)
+
+	periodStart time.Time
+	lastSample  time.Time
+	lastReport  time.Time
+	pending     map[string]*namespaceUsage
+}
+
+func NewAccountant(client kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+) *Accountant {
+	return &Accountant{
+		client:        client,
+		clusterConfig: clusterConfig,
+		vmiStore:      vmiInformer.GetStore(),
+		podIndexer:    podInformer.GetIndexer(),
+		hasSynced: func() bool {
+			return vmiInformer.HasSynced() && podInformer.HasSynced()
+		},
+		now:      time.Now,
+		podUsage: newPodUsageGetter(client),
+		pending:  map[string]*namespaceUsage{},
+	}
+}
+
+// newPodUsageGetter reads the usage of the virt-launcher pods from the metrics API
+func newPodUsageGetter(client kubecli.KubevirtClient) func() (map[string]k8sv1.ResourceList, error) {
+	selector := labels.SelectorFromSet(labels.Set{v1.AppLabel: "virt-launcher"}).String()
+	return func() (map[string]k8sv1.ResourceList, error) {
+		raw, err := client.CoreV1().RESTClient().Get().
+			AbsPath(podMetricsPath).
+			Param("labelSelector", selector).
+			Do(context.Background()).Raw()
+		if err != nil {
+			return nil, err
+		}
+		list := &podMetricsList{}
+		if err := json.Unmarshal(raw, list); err != nil {
+			return nil, err
+		}
+
+		usage := map[string]k8sv1.ResourceList{}
+		for _, pod := range list.Items {
+			total := k8sv1.ResourceList{}
+			for _, container := range pod.Containers {
+				for name, quantity := range container.Usage {
+					sum := total[name]
+					sum.Add(quantity)
+					total[name] = sum
+				}
+			}
+			usage[pod.Namespace+"/"+pod.Name] = total
+		}
+		return usage, nil
+	}
+}
+
+func (a *Accountant) Run(stopCh <-chan struct{}) {
+	log.Log.Info("Starting usage accountant.")
+	defer log.Log.Info("Shutting down usage accountant.")
+
+	if !cache.WaitForCacheSync(stopCh, a.hasSynced) {
+		return
+	}
+
+	wait.Until(a.sample, sampleInterval, stopCh)
+	a.flush()
+}
+
+func (a *Accountant) sample() {
+	now := a.now().UTC()
+	if !a.clusterConfig.UsageAccountingEnabled() {
+		a.flush()
+		return
+	}
+
+	if a.lastSample.IsZero() || now.Sub(a.lastSample) > maxSampleGap {
+		a.flush()
+		a.lastSample = now
+		a.lastReport = now
+		a.periodStart = startOfPeriod(now)
+		return
+	}
+
+	// Split the interval at the end of the period, so that each report only
+	// contains the usage of its own period
+	if periodEnd := a.periodStart.Add(reportPeriod); !now.Before(periodEnd) {
+		a.accumulate(periodEnd.Sub(a.lastSample).Seconds())
+		a.lastSample = periodEnd
+		a.report(periodEnd)
+		if len(a.pending) > 0 {
+			log.Log.Warningf("Dropping the usage of %d namespaces which could not be reported for the period %s", len(a.pending), reportName(a.periodStart))
+			a.pending = map[string]*namespaceUsage{}
+		}
+		a.periodStart = periodEnd
+	}
+
+	a.accumulate(now.Sub(a.lastSample).Seconds())
+	a.lastSample = now
+
+	if now.Sub(a.lastReport) >= reportInterval {
+		a.report(now)
+	}
+}
+
+// flush reports the pending usage and stops accounting until the next sample
+func (a *Accountant) flush() {
+	if a.lastSample.IsZero() {
+		return
+	}
+	a.report(a.lastSample)
+	a.pending = map[string]*namespaceUsage{}
+	a.lastSample = time.Time{}
+}
+
+func (a *Accountant) accumulate(seconds float64) {
+	if seconds <= 0 {
+		return
+	}
+
+	podUsage, err := a.podUsage()
+	if err != nil {
+		log.Log.Reason(err).Warning("Failed to read the measured usage of the virt-launcher pods, the actual usage is not accounted")
+	}
+
+	for _, obj := range a.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if !vmi.IsRunning() {
+			continue
+		}
+
+		allocatedVCPUs, allocatedMemory := allocatedResources(vmi)
+		actualVCPUs, actualMemory := a.actualResources(vmi, podUsage)
+
+		metrics.AddNamespaceUsage(vmi.Namespace, metrics.UsageAllocated, allocatedVCPUs*seconds, allocatedMemory*seconds)
+		metrics.AddNamespaceUsage(vmi.Namespace, metrics.UsageActual, actualVCPUs*seconds, actualMemory*seconds)
+
+		nsUsage, exists := a.pending[vmi.Namespace]
+		if !exists {
+			nsUsage = &namespaceUsage{vmis: map[string]*vmiUsage{}}
+			a.pending[vmi.Namespace] = nsUsage
+		}
+		vmiPending, exists := nsUsage.vmis[vmi.Name]
+		if !exists {
+			vmiPending = &vmiUsage{}
+			nsUsage.vmis[vmi.Name] = vmiPending
+		}
+
+		nsUsage.allocated.add(allocatedVCPUs, allocatedMemory, seconds)
+		nsUsage.actual.add(actualVCPUs, actualMemory, seconds)
+		vmiPending.allocated.add(allocatedVCPUs, allocatedMemory, seconds)
+		vmiPending.actual.add(actualVCPUs, actualMemory, seconds)
+	}
+}
+
+// allocatedResources returns the vCPUs and the memory in bytes of the guest
+func allocatedResources(vmi *v1.VirtualMachineInstance) (float64, float64) {
+	var vcpus int64
+	if topology := vmi.Status.CurrentCPUTopology; topology != nil {
+		vcpus = int64(topology.Sockets) * int64(topology.Cores) * int64(topology.Threads)
+	} else if vmi.Spec.Domain.CPU != nil {
+		vcpus = hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+	}
+	if vcpus == 0 {
+		vcpus = 1
+	}
+
+	var memory *resource.Quantity
+	if vmi.Status.Memory != nil && vmi.Status.Memory.GuestCurrent != nil {
+		memory = vmi.Status.Memory.GuestCurrent
+	} else if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		memory = vmi.Spec.Domain.Memory.Guest
+	} else if request, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		memory = &request
+	}
+
+	if memory == nil {
+		return float64(vcpus), 0
+	}
+	return float64(vcpus), memory.AsApproximateFloat64()
+}
+
+// actualResources returns the CPUs and the memory in bytes the launcher pod
+// of a VMI consumes on its node, as measured from the cgroups of the pod. It
+// includes the overhead of the virtualization stack.
+func (a *Accountant) actualResources(vmi *v1.VirtualMachineInstance, podUsage map[string]k8sv1.ResourceList) (float64, float64) {
+	pod := a.launcherPod(vmi)
+	if pod == nil {
+		return 0, 0
+	}
+
+	usage, exists := podUsage[pod.Namespace+"/"+pod.Name]
+	if !exists {
+		return 0, 0
+	}
+	return usage.Cpu().AsApproximateFloat64(), usage.Memory().AsApproximateFloat64()
+}
+
+func (a *Accountant) launcherPod(vmi *v1.VirtualMachineInstance) *k8sv1.Pod {
+	objs, err := a.podIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil
+	}
+
+	for _, obj := range objs {
+		pod, ok := obj.(*k8sv1.Pod)
+		if !ok {
+			continue
+		}
+		if pod.Labels[v1.CreatedByLabel] == string(vmi.UID) &&
+			pod.Status.Phase == k8sv1.PodRunning &&
+			pod.Spec.NodeName == vmi.Status.NodeName {
+			return pod
+		}
+	}
+	return nil
+}
+
+// report adds the pending usage of each namespace to its report of the
+// current period, the usage of namespaces which fail is retried on the
+// next report
+func (a *Accountant) report(now time.Time) {
+	for namespace, nsUsage := range a.pending {
+		if err := a.updateReport(namespace, nsUsage, now); err != nil {
+			log.Log.Reason(err).Errorf("Failed to update the usage report of namespace %s", namespace)
+			continue
+		}
+		delete(a.pending, namespace)
+	}
+	a.lastReport = now
+}
+
+func (a *Accountant) updateReport(namespace string, nsUsage *namespaceUsage, now time.Time) error {
+	reports := a.client.VirtualMachineUsageReport(namespace)
+	name := reportName(a.periodStart)
+
+	report, err := reports.Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		report, err = reports.Create(context.Background(), &accountingv1.VirtualMachineUsageReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: accountingv1.VirtualMachineUsageReportSpec{
+				PeriodStart: metav1.NewTime(a.periodStart),
+				PeriodEnd:   metav1.NewTime(a.periodStart.Add(reportPeriod)),
+			},
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	if report.Status == nil {
+		report.Status = &accountingv1.VirtualMachineUsageReportStatus{}
+	}
+	status := report.Status
+	addUsage(&status.Allocated, nsUsage.allocated)
+	addUsage(&status.Actual, nsUsage.actual)
+
+	for vmiName, usage := range nsUsage.vmis {
+		idx := -1
+		for i := range status.VirtualMachineInstances {
+			if status.VirtualMachineInstances[i].Name == vmiName {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			status.VirtualMachineInstances = append(status.VirtualMachineInstances, accountingv1.VirtualMachineInstanceUsage{Name: vmiName})
+			idx = len(status.VirtualMachineInstances) - 1
+		}
+		addUsage(&status.VirtualMachineInstances[idx].Allocated, usage.allocated)
+		addUsage(&status.VirtualMachineInstances[idx].Actual, usage.actual)
+	}
+	sort.Slice(status.VirtualMachineInstances, func(i, j int) bool {
+		return status.VirtualMachineInstances[i].Name < status.VirtualMachineInstances[j].Name
+	})
+	status.LastUpdateTime = &metav1.Time{Time: now}
+
+	_, err = reports.UpdateStatus(context.Background(), report, metav1.UpdateOptions{})
+	return err
+}
+
+func addUsage(total *accountingv1.ResourceUsage, u usage) {
+	total.VCPUHours = addHours(total.VCPUHours, u.vcpuSeconds/secondsPerHour)
+	total.MemoryGiBHours = addHours(total.MemoryGiBHours, u.memoryByteSeconds/bytesPerGiB/secondsPerHour)
+}
+
+// addHours adds hours to a quantity, rounded to milli-hours
+func addHours(total resource.Quantity, hours float64) resource.Quantity {
+	milliHours := int64(math.Round((total.AsApproximateFloat64() + hours) * 1000))
+	return *resource.NewMilliQuantity(milliHours, resource.DecimalSI)
+}
+
+func startOfPeriod(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func reportName(periodStart time.Time) string {
+	return reportNamePrefix + periodStart.Format(reportDateLayout)
+}
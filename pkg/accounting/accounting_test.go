@@ -0,0 +1,262 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package accounting
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	accountingv1 "kubevirt.io/api/accounting/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	testNamespace = "accounting"
+	vmiName       = "testvmi"
+	vmiUID        = "vmi-uid"
+	nodeName      = "node01"
+)
+
+var _ = Describe("Usage Accountant", func() {
+	var (
+		kubevirtClient *kubevirtfake.Clientset
+		vmiInformer    cache.SharedIndexInformer
+		podInformer    cache.SharedIndexInformer
+		accountant     *Accountant
+		now            time.Time
+		podUsage       map[string]k8sv1.ResourceList
+		podUsageErr    error
+	)
+
+	newAccountant := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		accountant = &Accountant{
+			client:        newVirtClient(kubevirtClient),
+			clusterConfig: clusterConfig,
+			vmiStore:      vmiInformer.GetStore(),
+			podIndexer:    podInformer.GetIndexer(),
+			now:           func() time.Time { return now },
+			podUsage: func() (map[string]k8sv1.ResourceList, error) {
+				return podUsage, podUsageErr
+			},
+			pending: map[string]*namespaceUsage{},
+		}
+	}
+
+	addRunningVMI := func() {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: vmiName, Namespace: testNamespace, UID: vmiUID},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					CPU:    &v1.CPU{Sockets: 4, Cores: 1, Threads: 1},
+					Memory: &v1.Memory{Guest: resource.NewQuantity(2*bytesPerGiB, resource.BinarySI)},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:              v1.Running,
+				NodeName:           nodeName,
+				CurrentCPUTopology: &v1.CPUTopology{Sockets: 2, Cores: 1, Threads: 1},
+			},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-testvmi",
+				Namespace: testNamespace,
+				Labels:    map[string]string{v1.CreatedByLabel: vmiUID},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+				Containers: []k8sv1.Container{{
+					Name: "compute",
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU:    resource.MustParse("200m"),
+							k8sv1.ResourceMemory: resource.MustParse("2560Mi"),
+						},
+					},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		}
+		Expect(podInformer.GetIndexer().Add(pod)).To(Succeed())
+	}
+
+	sampleFor := func(d time.Duration) {
+		for end := now.Add(d); now.Before(end); {
+			now = now.Add(sampleInterval)
+			accountant.sample()
+		}
+	}
+
+	getReport := func(name string) *accountingv1.VirtualMachineUsageReport {
+		report, err := kubevirtClient.AccountingV1alpha1().VirtualMachineUsageReports(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return report
+	}
+
+	expectUsage := func(usage accountingv1.ResourceUsage, vcpuHours, memoryGiBHours string) {
+		ExpectWithOffset(1, usage.VCPUHours.String()).To(Equal(vcpuHours))
+		ExpectWithOffset(1, usage.MemoryGiBHours.String()).To(Equal(memoryGiBHours))
+	}
+
+	BeforeEach(func() {
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		podInformer, _ = testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		now = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+		podUsage = map[string]k8sv1.ResourceList{
+			testNamespace + "/virt-launcher-testvmi": {
+				k8sv1.ResourceCPU:    resource.MustParse("100m"),
+				k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		}
+		podUsageErr = nil
+		addRunningVMI()
+	})
+
+	It("should not account usage without the feature gate", func() {
+		newAccountant()
+		accountant.sample()
+		sampleFor(time.Hour)
+
+		reports, err := kubevirtClient.AccountingV1alpha1().VirtualMachineUsageReports(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reports.Items).To(BeEmpty())
+	})
+
+	It("should report the allocated and actual usage of running VMIs", func() {
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		sampleFor(reportInterval)
+
+		report := getReport("usage-2024-05-01")
+		Expect(report.Spec.PeriodStart.Time).To(Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(report.Spec.PeriodEnd.Time).To(Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)))
+		Expect(report.Status).ToNot(BeNil())
+		Expect(report.Status.LastUpdateTime.Time).To(Equal(now))
+		expectUsage(report.Status.Allocated, "500m", "500m")
+		expectUsage(report.Status.Actual, "25m", "250m")
+		Expect(report.Status.VirtualMachineInstances).To(HaveLen(1))
+		Expect(report.Status.VirtualMachineInstances[0].Name).To(Equal(vmiName))
+		expectUsage(report.Status.VirtualMachineInstances[0].Allocated, "500m", "500m")
+		expectUsage(report.Status.VirtualMachineInstances[0].Actual, "25m", "250m")
+	})
+
+	It("should not account actual usage when the metrics API is unavailable", func() {
+		podUsage, podUsageErr = nil, errors.New("the server could not find the requested resource")
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		sampleFor(reportInterval)
+
+		report := getReport("usage-2024-05-01")
+		expectUsage(report.Status.Allocated, "500m", "500m")
+		expectUsage(report.Status.Actual, "0", "0")
+	})
+
+	It("should add to the usage of an existing report", func() {
+		_, err := kubevirtClient.AccountingV1alpha1().VirtualMachineUsageReports(testNamespace).Create(context.Background(), &accountingv1.VirtualMachineUsageReport{
+			ObjectMeta: metav1.ObjectMeta{Name: "usage-2024-05-01", Namespace: testNamespace},
+			Status: &accountingv1.VirtualMachineUsageReportStatus{
+				Allocated: accountingv1.ResourceUsage{
+					VCPUHours:      resource.MustParse("1"),
+					MemoryGiBHours: resource.MustParse("1500m"),
+				},
+				VirtualMachineInstances: []accountingv1.VirtualMachineInstanceUsage{{Name: "othervmi"}},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		sampleFor(reportInterval)
+
+		report := getReport("usage-2024-05-01")
+		expectUsage(report.Status.Allocated, "1500m", "2")
+		Expect(report.Status.VirtualMachineInstances).To(HaveLen(2))
+		Expect(report.Status.VirtualMachineInstances[0].Name).To(Equal("othervmi"))
+		Expect(report.Status.VirtualMachineInstances[1].Name).To(Equal(vmiName))
+	})
+
+	It("should split the usage at the end of the period", func() {
+		now = time.Date(2024, 5, 1, 23, 50, 0, 0, time.UTC)
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		sampleFor(15 * time.Minute)
+		accountant.flush()
+
+		expectUsage(getReport("usage-2024-05-01").Status.Allocated, "333m", "333m")
+		expectUsage(getReport("usage-2024-05-02").Status.Allocated, "167m", "167m")
+	})
+
+	It("should not account time without samples", func() {
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		now = now.Add(time.Hour)
+		accountant.sample()
+		sampleFor(reportInterval)
+
+		expectUsage(getReport("usage-2024-05-01").Status.Allocated, "500m", "500m")
+	})
+
+	It("should count the usage in Prometheus counters", func() {
+		before, err := metrics.GetNamespaceVCPUSeconds(testNamespace, metrics.UsageAllocated)
+		Expect(err).ToNot(HaveOccurred())
+
+		newAccountant(featuregate.UsageAccountingGate)
+		accountant.sample()
+		sampleFor(time.Minute)
+
+		after, err := metrics.GetNamespaceVCPUSeconds(testNamespace, metrics.UsageAllocated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after - before).To(BeNumerically("==", 120))
+	})
+})
+
+func newVirtClient(kubevirtClient *kubevirtfake.Clientset) kubecli.KubevirtClient {
+	virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+	virtClient.EXPECT().VirtualMachineUsageReport(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+		return kubevirtClient.AccountingV1alpha1().VirtualMachineUsageReports(namespace)
+	}).AnyTimes()
+	return virtClient
+}
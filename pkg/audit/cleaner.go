@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package audit deletes the VirtualMachineAuditEvents which are older than
+// the configured retention.
+package audit
+
+import (
+	"context"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const cleanupInterval = time.Hour
+
+// Cleaner periodically deletes the VirtualMachineAuditEvents of all namespaces
+// whose request time is older than the retention.
+type Cleaner struct {
+	client        kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
+	now           func() time.Time
+}
+
+func NewCleaner(client kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig) *Cleaner {
+	return &Cleaner{
+		client:        client,
+		clusterConfig: clusterConfig,
+		now:           time.Now,
+	}
+}
+
+func (c *Cleaner) Run(stopCh <-chan struct{}) {
+	log.Log.Info("Starting audit event cleaner.")
+	defer log.Log.Info("Shutting down audit event cleaner.")
+
+	wait.Until(c.cleanup, cleanupInterval, stopCh)
+}
+
+func (c *Cleaner) cleanup() {
+	if !c.clusterConfig.VirtualMachineAuditEventsEnabled() {
+		return
+	}
+
+	events, err := c.client.VirtualMachineAuditEvent(k8sv1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list the VirtualMachineAuditEvents")
+		return
+	}
+
+	deadline := c.now().Add(-c.clusterConfig.GetVirtualMachineAuditEventRetention())
+	for _, event := range events.Items {
+		if !event.Spec.RequestTime.Time.Before(deadline) {
+			continue
+		}
+		err := c.client.VirtualMachineAuditEvent(event.Namespace).Delete(context.Background(), event.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Log.Reason(err).Errorf("Failed to delete the VirtualMachineAuditEvent %s/%s", event.Namespace, event.Name)
+		}
+	}
+}
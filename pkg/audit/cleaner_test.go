@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package audit
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/testing"
+
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Audit event cleaner", func() {
+	var (
+		kubevirtClient *kubevirtfake.Clientset
+		now            time.Time
+	)
+
+	newEvent := func(namespace, name string, age time.Duration) *auditv1alpha1.VirtualMachineAuditEvent {
+		return &auditv1alpha1.VirtualMachineAuditEvent{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: auditv1alpha1.VirtualMachineAuditEventSpec{
+				Operation:   auditv1alpha1.ConsoleOperation,
+				RequestTime: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+
+	newCleaner := func(config *v1.KubeVirtConfiguration) *Cleaner {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(config)
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().VirtualMachineAuditEvent(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.AuditV1alpha1().VirtualMachineAuditEvents(namespace)
+		}).AnyTimes()
+		return &Cleaner{
+			client:        virtClient,
+			clusterConfig: clusterConfig,
+			now:           func() time.Time { return now },
+		}
+	}
+
+	deletedEvents := func() []string {
+		var deleted []string
+		for _, action := range kubevirtClient.Actions() {
+			if action.Matches("delete", "virtualmachineauditevents") {
+				deleteAction := action.(testing.DeleteAction)
+				deleted = append(deleted, deleteAction.GetNamespace()+"/"+deleteAction.GetName())
+			}
+		}
+		return deleted
+	}
+
+	enabled := &v1.DeveloperConfiguration{FeatureGates: []string{featuregate.VirtualMachineAuditEventsGate}}
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+		kubevirtClient = kubevirtfake.NewSimpleClientset(
+			newEvent("ns1", "old", 31*24*time.Hour),
+			newEvent("ns2", "older", 60*24*time.Hour),
+			newEvent("ns1", "recent", time.Hour),
+		)
+	})
+
+	It("should delete the events older than the default retention", func() {
+		newCleaner(&v1.KubeVirtConfiguration{DeveloperConfiguration: enabled}).cleanup()
+		Expect(deletedEvents()).To(ConsistOf("ns1/old", "ns2/older"))
+	})
+
+	It("should delete the events older than the configured retention", func() {
+		newCleaner(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: enabled,
+			VirtualMachineAuditEvents: &v1.VirtualMachineAuditEventsConfiguration{
+				Retention: &metav1.Duration{Duration: 45 * 24 * time.Hour},
+			},
+		}).cleanup()
+		Expect(deletedEvents()).To(ConsistOf("ns2/older"))
+	})
+
+	It("should not delete events if the feature gate is disabled", func() {
+		newCleaner(&v1.KubeVirtConfiguration{}).cleanup()
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+})
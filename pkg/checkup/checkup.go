@@ -0,0 +1,502 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checkup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	defaultTimeout        = 10 * time.Minute
+	defaultSampleDuration = 5 * time.Second
+	defaultBootSamples    = 3
+
+	// PodLabelValue is the value of the kubevirt.io label of checkup pods
+	PodLabelValue = "virt-checkup"
+	podNamePrefix = "virt-checkup-"
+	containerName = "checkup"
+
+	// The environment variables the checkup image reads its configuration from
+	EnvSpec      = "CHECKUP_SPEC"
+	EnvName      = "CHECKUP_NAME"
+	EnvNamespace = "CHECKUP_NAMESPACE"
+
+	checkupStartedEvent   = "VirtualMachineCheckupStarted"
+	checkupSucceededEvent = "VirtualMachineCheckupSucceeded"
+	checkupFailedEvent    = "VirtualMachineCheckupFailed"
+
+	timedOutMsg          = "checkup timed out after %s"
+	podFailedMsg         = "checkup pod failed: %s"
+	invalidResultMsg     = "invalid checkup result: %v"
+	missingResultMsg     = "checkup did not report %s results"
+	maxLatencyMsg        = "maximum latency %s is above the desired %s"
+	readIOPSMsg          = "read IOPS %d are below the desired %d"
+	writeIOPSMsg         = "write IOPS %d are below the desired %d"
+	maxBootTimeMsg       = "maximum boot time %s is above the desired %s"
+	checkupSucceededMsg  = "Checkup succeeded"
+	checkupStartedMsg    = "Started checkup pod %s"
+	podDeadlineExceeded  = "DeadlineExceeded"
+	defaultPodFailureMsg = "unknown error"
+)
+
+var defaultVolumeSize = resource.MustParse("10Gi")
+
+// Result is the JSON document a checkup writes to its termination message
+type Result struct {
+	Succeeded      bool                            `json:"succeeded"`
+	FailureReason  string                          `json:"failureReason,omitempty"`
+	NetworkLatency *checkupv1.NetworkLatencyResult `json:"networkLatency,omitempty"`
+	Storage        *checkupv1.StorageResult        `json:"storage,omitempty"`
+	BootTime       *checkupv1.BootTimeResult       `json:"bootTime,omitempty"`
+}
+
+// Controller runs VirtualMachineCheckups in pods of the checkup image and
+// publishes the results the pods report in the status of the checkups.
+type Controller struct {
+	client          kubecli.KubevirtClient
+	clusterConfig   *virtconfig.ClusterConfig
+	checkupInformer cache.SharedIndexInformer
+	podStore        cache.Store
+	recorder        record.EventRecorder
+	checkupQueue    workqueue.TypedRateLimitingInterface[string]
+	hasSynced       func() bool
+	image           string
+	now             func() time.Time
+}
+
+func NewController(client kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	checkupInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	image string,
+) (*Controller, error) {
+	c := &Controller{
+		checkupQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmcheckup"},
+		),
+		client:          client,
+		clusterConfig:   clusterConfig,
+		checkupInformer: checkupInformer,
+		podStore:        podInformer.GetStore(),
+		recorder:        recorder,
+		image:           image,
+		now:             time.Now,
+	}
+
+	c.hasSynced = func() bool {
+		return checkupInformer.HasSynced() && podInformer.HasSynced()
+	}
+
+	_, err := checkupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleCheckup,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleCheckup(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handlePod(newObj) },
+			DeleteFunc: c.handlePod,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleCheckup(obj interface{}) {
+	checkup, ok := obj.(*checkupv1.VirtualMachineCheckup)
+	if !ok {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(checkup)
+	if err != nil {
+		log.Log.Errorf("failed to get key from object: %v, %v", err, checkup)
+		return
+	}
+
+	log.Log.V(3).Infof("enqueued %q for sync", key)
+	c.checkupQueue.Add(key)
+}
+
+func (c *Controller) handlePod(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok || pod.Labels[v1.AppLabel] != PodLabelValue {
+		return
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != checkupv1.VirtualMachineCheckupGroupVersionKind.Kind {
+		return
+	}
+	c.checkupQueue.Add(fmt.Sprintf("%s/%s", pod.Namespace, owner.Name))
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.checkupQueue.ShutDown()
+
+	log.Log.Info("Starting checkup controller.")
+	defer log.Log.Info("Shutting down checkup controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.checkupQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.checkupQueue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineCheckup %v", key)
+		c.checkupQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineCheckup %v", key)
+		c.checkupQueue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.VirtualMachineCheckupsEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.checkupInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	checkup, ok := obj.(*checkupv1.VirtualMachineCheckup)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", obj)
+	}
+	if IsCheckupDone(checkup) {
+		return nil
+	}
+
+	checkupCopy := checkup.DeepCopy()
+	if checkupCopy.Status == nil {
+		checkupCopy.Status = &checkupv1.VirtualMachineCheckupStatus{}
+	}
+	if err := c.sync(checkupCopy); err != nil {
+		return err
+	}
+
+	if !equality.Semantic.DeepEqual(checkup.Status, checkupCopy.Status) {
+		_, err = c.client.VirtualMachineCheckup(checkupCopy.Namespace).UpdateStatus(context.Background(), checkupCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Enforce the timeout even if the pod never reports back
+	if !IsCheckupDone(checkupCopy) && checkupCopy.Status.StartTimestamp != nil {
+		remaining := checkupCopy.Status.StartTimestamp.Add(timeout(checkupCopy)).Sub(c.now())
+		c.checkupQueue.AddAfter(key, remaining)
+	}
+	return nil
+}
+
+// sync creates the checkup pod and, once the pod terminated, records the
+// results it reported. Checkups are never retried, a failed checkup has to be
+// created again.
+func (c *Controller) sync(checkup *checkupv1.VirtualMachineCheckup) error {
+	status := checkup.Status
+
+	if status.StartTimestamp != nil && c.now().Sub(status.StartTimestamp.Time) > timeout(checkup) {
+		c.fail(checkup, fmt.Sprintf(timedOutMsg, timeout(checkup)))
+		return nil
+	}
+
+	obj, exists, err := c.podStore.GetByKey(fmt.Sprintf("%s/%s", checkup.Namespace, podName(checkup)))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if status.Phase != "" {
+			// Wait for the created pod to show up in the store
+			return nil
+		}
+		return c.createPod(checkup)
+	}
+
+	pod := obj.(*k8sv1.Pod)
+	switch pod.Status.Phase {
+	case k8sv1.PodFailed:
+		c.fail(checkup, podFailureReason(pod))
+	case k8sv1.PodSucceeded:
+		c.complete(checkup, terminationMessage(pod))
+	}
+	return nil
+}
+
+func (c *Controller) createPod(checkup *checkupv1.VirtualMachineCheckup) error {
+	pod, err := c.renderPod(checkup)
+	if err != nil {
+		return err
+	}
+	_, err = c.client.CoreV1().Pods(checkup.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	checkup.Status.Phase = checkupv1.CheckupRunning
+	checkup.Status.StartTimestamp = pointer.P(metav1.NewTime(c.now()))
+	c.recorder.Eventf(checkup, k8sv1.EventTypeNormal, checkupStartedEvent, checkupStartedMsg, pod.Name)
+	return nil
+}
+
+func (c *Controller) renderPod(checkup *checkupv1.VirtualMachineCheckup) (*k8sv1.Pod, error) {
+	spec, err := json.Marshal(withDefaults(&checkup.Spec))
+	if err != nil {
+		return nil, err
+	}
+
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName(checkup),
+			Namespace: checkup.Namespace,
+			Labels: map[string]string{
+				v1.AppLabel: PodLabelValue,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(checkup, checkupv1.VirtualMachineCheckupGroupVersionKind),
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			ServiceAccountName:    checkup.Spec.ServiceAccountName,
+			RestartPolicy:         k8sv1.RestartPolicyNever,
+			ActiveDeadlineSeconds: pointer.P(int64(timeout(checkup).Seconds())),
+			SecurityContext: &k8sv1.PodSecurityContext{
+				RunAsNonRoot:   pointer.P(true),
+				SeccompProfile: &k8sv1.SeccompProfile{Type: k8sv1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []k8sv1.Container{{
+				Name:  containerName,
+				Image: c.image,
+				Env: []k8sv1.EnvVar{
+					{Name: EnvSpec, Value: string(spec)},
+					{Name: EnvName, Value: checkup.Name},
+					{Name: EnvNamespace, Value: checkup.Namespace},
+				},
+				TerminationMessagePolicy: k8sv1.TerminationMessageReadFile,
+				SecurityContext: &k8sv1.SecurityContext{
+					AllowPrivilegeEscalation: pointer.P(false),
+					Capabilities: &k8sv1.Capabilities{
+						Drop: []k8sv1.Capability{"ALL"},
+					},
+				},
+			}},
+		},
+	}, nil
+}
+
+// complete parses the result reported by the checkup pod and compares it
+// with the desired thresholds of the checkup
+func (c *Controller) complete(checkup *checkupv1.VirtualMachineCheckup, message string) {
+	res := &Result{}
+	if err := json.Unmarshal([]byte(message), res); err != nil {
+		c.fail(checkup, fmt.Sprintf(invalidResultMsg, err))
+		return
+	}
+
+	status := checkup.Status
+	status.NetworkLatency = res.NetworkLatency
+	status.Storage = res.Storage
+	status.BootTime = res.BootTime
+
+	if !res.Succeeded {
+		reason := res.FailureReason
+		if reason == "" {
+			reason = defaultPodFailureMsg
+		}
+		c.fail(checkup, reason)
+		return
+	}
+
+	if reason := checkThresholds(&checkup.Spec, status); reason != "" {
+		c.fail(checkup, reason)
+		return
+	}
+
+	status.Phase = checkupv1.CheckupSucceeded
+	status.CompletionTimestamp = pointer.P(metav1.NewTime(c.now()))
+	c.recorder.Event(checkup, k8sv1.EventTypeNormal, checkupSucceededEvent, checkupSucceededMsg)
+}
+
+func (c *Controller) fail(checkup *checkupv1.VirtualMachineCheckup, reason string) {
+	status := checkup.Status
+	status.Phase = checkupv1.CheckupFailed
+	status.FailureReason = reason
+	status.CompletionTimestamp = pointer.P(metav1.NewTime(c.now()))
+	c.recorder.Event(checkup, k8sv1.EventTypeWarning, checkupFailedEvent, reason)
+}
+
+// checkThresholds returns why the results miss the desired thresholds of the
+// spec, or an empty string if they meet them
+func checkThresholds(spec *checkupv1.VirtualMachineCheckupSpec, status *checkupv1.VirtualMachineCheckupStatus) string {
+	switch {
+	case spec.NetworkLatency != nil:
+		if status.NetworkLatency == nil {
+			return fmt.Sprintf(missingResultMsg, "networkLatency")
+		}
+		desired := spec.NetworkLatency.MaxDesiredLatency
+		if desired != nil && status.NetworkLatency.MaxLatency.Duration > desired.Duration {
+			return fmt.Sprintf(maxLatencyMsg, status.NetworkLatency.MaxLatency.Duration, desired.Duration)
+		}
+	case spec.Storage != nil:
+		if status.Storage == nil {
+			return fmt.Sprintf(missingResultMsg, "storage")
+		}
+		if desired := spec.Storage.MinDesiredReadIOPS; desired != nil && status.Storage.ReadIOPS < *desired {
+			return fmt.Sprintf(readIOPSMsg, status.Storage.ReadIOPS, *desired)
+		}
+		if desired := spec.Storage.MinDesiredWriteIOPS; desired != nil && status.Storage.WriteIOPS < *desired {
+			return fmt.Sprintf(writeIOPSMsg, status.Storage.WriteIOPS, *desired)
+		}
+	case spec.BootTime != nil:
+		if status.BootTime == nil {
+			return fmt.Sprintf(missingResultMsg, "bootTime")
+		}
+		desired := spec.BootTime.MaxDesiredBootTime
+		if desired != nil && status.BootTime.MaxBootTime.Duration > desired.Duration {
+			return fmt.Sprintf(maxBootTimeMsg, status.BootTime.MaxBootTime.Duration, desired.Duration)
+		}
+	}
+	return ""
+}
+
+// withDefaults returns a copy of the spec with the defaults applied, which is
+// handed to the checkup image
+func withDefaults(spec *checkupv1.VirtualMachineCheckupSpec) *checkupv1.VirtualMachineCheckupSpec {
+	spec = spec.DeepCopy()
+	if spec.Timeout == nil {
+		spec.Timeout = &metav1.Duration{Duration: defaultTimeout}
+	}
+	if spec.NetworkLatency != nil && spec.NetworkLatency.SampleDuration == nil {
+		spec.NetworkLatency.SampleDuration = &metav1.Duration{Duration: defaultSampleDuration}
+	}
+	if spec.Storage != nil && spec.Storage.VolumeSize == nil {
+		spec.Storage.VolumeSize = pointer.P(defaultVolumeSize.DeepCopy())
+	}
+	if spec.BootTime != nil && spec.BootTime.Samples == nil {
+		spec.BootTime.Samples = pointer.P(int32(defaultBootSamples))
+	}
+	return spec
+}
+
+func podFailureReason(pod *k8sv1.Pod) string {
+	if pod.Status.Reason == podDeadlineExceeded && pod.Spec.ActiveDeadlineSeconds != nil {
+		return fmt.Sprintf(timedOutMsg, time.Duration(*pod.Spec.ActiveDeadlineSeconds)*time.Second)
+	}
+	if message := terminationMessage(pod); message != "" {
+		// A checkup which fails on its own still reports why
+		res := &Result{}
+		if err := json.Unmarshal([]byte(message), res); err == nil && res.FailureReason != "" {
+			return res.FailureReason
+		}
+	}
+	message := pod.Status.Message
+	if message == "" {
+		message = defaultPodFailureMsg
+	}
+	return fmt.Sprintf(podFailedMsg, message)
+}
+
+func terminationMessage(pod *k8sv1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName && status.State.Terminated != nil {
+			return status.State.Terminated.Message
+		}
+	}
+	return ""
+}
+
+// IsCheckupDone returns true once the checkup succeeded or failed
+func IsCheckupDone(checkup *checkupv1.VirtualMachineCheckup) bool {
+	return checkup.Status != nil &&
+		(checkup.Status.Phase == checkupv1.CheckupSucceeded || checkup.Status.Phase == checkupv1.CheckupFailed)
+}
+
+func podName(checkup *checkupv1.VirtualMachineCheckup) string {
+	return podNamePrefix + string(checkup.UID)
+}
+
+func timeout(checkup *checkupv1.VirtualMachineCheckup) time.Duration {
+	if checkup.Spec.Timeout != nil {
+		return checkup.Spec.Timeout.Duration
+	}
+	return defaultTimeout
+}
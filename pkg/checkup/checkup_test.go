@@ -0,0 +1,325 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checkup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	testNamespace = "default"
+	checkupName   = "test-checkup"
+	checkupUID    = "checkup-uid"
+	testImage     = "quay.io/kubevirt/virt-checkup:latest"
+)
+
+var _ = Describe("Checkup Controller", func() {
+	var (
+		ctrl            *gomock.Controller
+		virtClient      *kubecli.MockKubevirtClient
+		k8sClient       *k8sfake.Clientset
+		kubevirtClient  *kubevirtfake.Clientset
+		checkupInformer cache.SharedIndexInformer
+		podInformer     cache.SharedIndexInformer
+		recorder        *record.FakeRecorder
+		controller      *Controller
+		now             time.Time
+	)
+
+	newController := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		controller = &Controller{
+			client:          virtClient,
+			clusterConfig:   clusterConfig,
+			checkupInformer: checkupInformer,
+			podStore:        podInformer.GetStore(),
+			recorder:        recorder,
+			checkupQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-checkup-queue"},
+			),
+			image: testImage,
+			now:   func() time.Time { return now },
+		}
+	}
+
+	newLatencyCheckup := func() *checkupv1.VirtualMachineCheckup {
+		return &checkupv1.VirtualMachineCheckup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      checkupName,
+				Namespace: testNamespace,
+				UID:       checkupUID,
+			},
+			Spec: checkupv1.VirtualMachineCheckupSpec{
+				ServiceAccountName: "checkup-sa",
+				NetworkLatency: &checkupv1.NetworkLatencyCheckup{
+					MaxDesiredLatency: &metav1.Duration{Duration: 10 * time.Millisecond},
+				},
+			},
+		}
+	}
+
+	runningCheckup := func(checkup *checkupv1.VirtualMachineCheckup) *checkupv1.VirtualMachineCheckup {
+		checkup.Status = &checkupv1.VirtualMachineCheckupStatus{
+			Phase:          checkupv1.CheckupRunning,
+			StartTimestamp: pointer.P(metav1.NewTime(now.Add(-time.Minute))),
+		}
+		return checkup
+	}
+
+	addCheckup := func(checkup *checkupv1.VirtualMachineCheckup) {
+		Expect(checkupInformer.GetStore().Add(checkup)).To(Succeed())
+		_, err := kubevirtClient.CheckupV1alpha1().VirtualMachineCheckups(checkup.Namespace).Create(context.Background(), checkup, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		controller.checkupQueue.Add(fmt.Sprintf("%s/%s", checkup.Namespace, checkup.Name))
+	}
+
+	getCheckupStatus := func() *checkupv1.VirtualMachineCheckupStatus {
+		checkup, err := kubevirtClient.CheckupV1alpha1().VirtualMachineCheckups(testNamespace).Get(context.Background(), checkupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return checkup.Status
+	}
+
+	addTerminatedPod := func(phase k8sv1.PodPhase, res *Result) {
+		message := ""
+		if res != nil {
+			data, err := json.Marshal(res)
+			Expect(err).ToNot(HaveOccurred())
+			message = string(data)
+		}
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podNamePrefix + checkupUID,
+				Namespace: testNamespace,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []k8sv1.ContainerStatus{{
+					Name: containerName,
+					State: k8sv1.ContainerState{
+						Terminated: &k8sv1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+		Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+	}
+
+	latencyResult := func(maxLatency time.Duration) *Result {
+		return &Result{
+			Succeeded: true,
+			NetworkLatency: &checkupv1.NetworkLatencyResult{
+				MinLatency:     metav1.Duration{Duration: time.Millisecond},
+				AverageLatency: metav1.Duration{Duration: 2 * time.Millisecond},
+				MaxLatency:     metav1.Duration{Duration: maxLatency},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		checkupInformer, _ = testutils.NewFakeInformerFor(&checkupv1.VirtualMachineCheckup{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		now = time.Now()
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineCheckup(testNamespace).
+			Return(kubevirtClient.CheckupV1alpha1().VirtualMachineCheckups(testNamespace)).AnyTimes()
+
+		newController(featuregate.VirtualMachineCheckupsGate)
+	})
+
+	It("should not run checkups without the feature gate", func() {
+		newController()
+		addCheckup(newLatencyCheckup())
+		controller.Execute()
+
+		Expect(getCheckupStatus()).To(BeNil())
+		pods, err := k8sClient.CoreV1().Pods(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pods.Items).To(BeEmpty())
+	})
+
+	It("should create the checkup pod", func() {
+		addCheckup(newLatencyCheckup())
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupRunning))
+		Expect(status.StartTimestamp).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, checkupStartedEvent)
+
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), podNamePrefix+checkupUID, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, PodLabelValue))
+		Expect(pod.OwnerReferences).To(HaveLen(1))
+		Expect(pod.OwnerReferences[0].Name).To(Equal(checkupName))
+		Expect(pod.Spec.ServiceAccountName).To(Equal("checkup-sa"))
+		Expect(pod.Spec.RestartPolicy).To(Equal(k8sv1.RestartPolicyNever))
+		Expect(pod.Spec.ActiveDeadlineSeconds).To(HaveValue(BeEquivalentTo(defaultTimeout.Seconds())))
+		Expect(pod.Spec.Containers).To(HaveLen(1))
+		Expect(pod.Spec.Containers[0].Image).To(Equal(testImage))
+
+		spec := &checkupv1.VirtualMachineCheckupSpec{}
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(HaveField("Name", EnvSpec)))
+		for _, env := range pod.Spec.Containers[0].Env {
+			if env.Name == EnvSpec {
+				Expect(json.Unmarshal([]byte(env.Value), spec)).To(Succeed())
+			}
+		}
+		Expect(spec.Timeout.Duration).To(Equal(defaultTimeout))
+		Expect(spec.NetworkLatency.SampleDuration.Duration).To(Equal(defaultSampleDuration))
+	})
+
+	It("should succeed when the results meet the thresholds", func() {
+		addCheckup(runningCheckup(newLatencyCheckup()))
+		addTerminatedPod(k8sv1.PodSucceeded, latencyResult(5*time.Millisecond))
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupSucceeded))
+		Expect(status.CompletionTimestamp).ToNot(BeNil())
+		Expect(status.NetworkLatency.MaxLatency.Duration).To(Equal(5 * time.Millisecond))
+		testutils.ExpectEvent(recorder, checkupSucceededEvent)
+	})
+
+	It("should fail when the results miss the thresholds", func() {
+		addCheckup(runningCheckup(newLatencyCheckup()))
+		addTerminatedPod(k8sv1.PodSucceeded, latencyResult(20*time.Millisecond))
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(Equal(fmt.Sprintf(maxLatencyMsg, 20*time.Millisecond, 10*time.Millisecond)))
+		Expect(status.NetworkLatency).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, checkupFailedEvent)
+	})
+
+	It("should fail when the storage results miss the thresholds", func() {
+		checkup := newLatencyCheckup()
+		checkup.Spec.NetworkLatency = nil
+		checkup.Spec.Storage = &checkupv1.StorageCheckup{
+			MinDesiredReadIOPS:  pointer.P(int64(1000)),
+			MinDesiredWriteIOPS: pointer.P(int64(1000)),
+		}
+		addCheckup(runningCheckup(checkup))
+		addTerminatedPod(k8sv1.PodSucceeded, &Result{
+			Succeeded: true,
+			Storage:   &checkupv1.StorageResult{ReadIOPS: 2000, WriteIOPS: 500},
+		})
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(Equal(fmt.Sprintf(writeIOPSMsg, 500, 1000)))
+	})
+
+	It("should fail when the checkup reports a failure", func() {
+		addCheckup(runningCheckup(newLatencyCheckup()))
+		addTerminatedPod(k8sv1.PodFailed, &Result{FailureReason: "target VMI did not start"})
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(Equal("target VMI did not start"))
+	})
+
+	It("should fail when the checkup does not report the expected results", func() {
+		addCheckup(runningCheckup(newLatencyCheckup()))
+		addTerminatedPod(k8sv1.PodSucceeded, &Result{Succeeded: true})
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(Equal(fmt.Sprintf(missingResultMsg, "networkLatency")))
+	})
+
+	It("should fail on an invalid result", func() {
+		addCheckup(runningCheckup(newLatencyCheckup()))
+		addTerminatedPod(k8sv1.PodSucceeded, nil)
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(HavePrefix("invalid checkup result"))
+	})
+
+	It("should fail a checkup which exceeded its timeout", func() {
+		checkup := runningCheckup(newLatencyCheckup())
+		checkup.Spec.Timeout = &metav1.Duration{Duration: 30 * time.Second}
+		addCheckup(checkup)
+		controller.Execute()
+
+		status := getCheckupStatus()
+		Expect(status.Phase).To(Equal(checkupv1.CheckupFailed))
+		Expect(status.FailureReason).To(Equal(fmt.Sprintf(timedOutMsg, 30*time.Second)))
+	})
+
+	It("should not touch a checkup which is done", func() {
+		checkup := newLatencyCheckup()
+		checkup.Status = &checkupv1.VirtualMachineCheckupStatus{Phase: checkupv1.CheckupSucceeded}
+		addCheckup(checkup)
+		controller.Execute()
+
+		Expect(getCheckupStatus().Phase).To(Equal(checkupv1.CheckupSucceeded))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should enqueue the checkup owning an updated pod", func() {
+		checkup := newLatencyCheckup()
+		pod, err := controller.renderPod(checkup)
+		Expect(err).ToNot(HaveOccurred())
+
+		controller.handlePod(pod)
+		Expect(controller.checkupQueue.Len()).To(Equal(1))
+	})
+})
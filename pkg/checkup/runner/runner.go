@@ -0,0 +1,455 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package runner
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/checkup"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
+)
+
+const (
+	// DefaultGuestImage is the container disk the benchmarks run in, it has to
+	// ship the guest agent, ping and dd.
+	// TODO: pin the image by its digest. No digest of a verified image has been
+	// recorded yet, so the tag is used and the guest may change between runs.
+	DefaultGuestImage = "quay.io/containerdisks/fedora:latest"
+
+	// CheckupLabel marks the objects created by a checkup with its name
+	CheckupLabel = "checkup.kubevirt.io/checkup"
+
+	// CleanupTimeout is the time the runner takes at most to remove what it
+	// created, once the benchmark is done
+	CleanupTimeout = 2 * time.Minute
+
+	// resultMarker prefixes the result the guests print on the serial console
+	resultMarker = "virt-checkup-result:"
+
+	networkName     = "checkup"
+	containerDisk   = "containerdisk"
+	storageDiskName = "checkup-disk"
+	storageSerial   = "checkup"
+	guestMemory     = "1Gi"
+
+	pingInterval    = 200 * time.Millisecond
+	storageBlock    = 4096
+	maxStorageIOs   = 10000
+	consoleTimeout  = 30 * time.Second
+	defaultInterval = 2 * time.Second
+
+	// The guests print their result every few seconds until they are removed,
+	// since the serial console does not replay what was printed before the
+	// runner connected
+	latencyScript = `#!/bin/sh
+out=$(ping -q -c %d -i %.1f %s 2>&1 | tail -n 1)
+while true; do echo "%s $out" > /dev/ttyS0; sleep 5; done
+`
+	storageScript = `#!/bin/sh
+dev=/dev/disk/by-id/virtio-%s
+n=%d
+iops() { s=$(date +%%s%%N); "$@" >/dev/null 2>&1 || { echo 0; return; }; e=$(date +%%s%%N); echo $((n * 1000000000 / (e - s))); }
+w=$(iops dd if=/dev/zero of=$dev bs=%d count=$n oflag=direct)
+r=$(iops dd if=$dev of=/dev/null bs=%d count=$n iflag=direct)
+while true; do echo "%s read=$r write=$w" > /dev/ttyS0; sleep 5; done
+`
+)
+
+var (
+	// ping of iputils reports "rtt min/avg/max/mdev", the one of busybox "round-trip min/avg/max"
+	pingSummary   = regexp.MustCompile(`min/avg/max(?:/mdev)? = ([0-9.]+)/([0-9.]+)/([0-9.]+)`)
+	storageResult = regexp.MustCompile(`read=([0-9]+) write=([0-9]+)`)
+)
+
+// Runner runs the benchmark of a VirtualMachineCheckup in guests it creates
+// in the namespace of the checkup, and removes them once done.
+type Runner struct {
+	client     kubecli.KubevirtClient
+	namespace  string
+	name       string
+	guestImage string
+	interval   time.Duration
+	now        func() time.Time
+}
+
+func NewRunner(client kubecli.KubevirtClient, namespace, name, guestImage string) *Runner {
+	return &Runner{
+		client:     client,
+		namespace:  namespace,
+		name:       name,
+		guestImage: guestImage,
+		interval:   defaultInterval,
+		now:        time.Now,
+	}
+}
+
+// Run runs the benchmark of the spec and returns the result to report. The
+// guests and volumes created for the benchmark are removed before it returns.
+func (r *Runner) Run(ctx context.Context, spec *checkupv1.VirtualMachineCheckupSpec) *checkup.Result {
+	defer r.cleanup()
+
+	res := &checkup.Result{}
+	var err error
+	switch {
+	case spec.NetworkLatency != nil:
+		res.NetworkLatency, err = r.networkLatency(ctx, spec.NetworkLatency)
+	case spec.Storage != nil:
+		res.Storage, err = r.storage(ctx, spec.Storage)
+	case spec.BootTime != nil:
+		res.BootTime, err = r.bootTime(ctx, spec.BootTime)
+	default:
+		err = fmt.Errorf("the checkup has no benchmark")
+	}
+	if err != nil {
+		res.FailureReason = err.Error()
+		return res
+	}
+	res.Succeeded = true
+	return res
+}
+
+// networkLatency pings the target guest from the source guest for the sample duration
+func (r *Runner) networkLatency(ctx context.Context, spec *checkupv1.NetworkLatencyCheckup) (*checkupv1.NetworkLatencyResult, error) {
+	target, err := r.createVMI(ctx, r.newVMI("target", spec.TargetNode, withCheckupNetwork(spec.NetworkAttachmentDefinition)))
+	if err != nil {
+		return nil, err
+	}
+	target, err = r.waitFor(ctx, target.Name, "have an IP address", func(vmi *v1.VirtualMachineInstance) bool {
+		return targetIP(vmi) != ""
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	count := 1
+	if spec.SampleDuration != nil {
+		count = max(1, int(spec.SampleDuration.Duration/pingInterval))
+	}
+	script := fmt.Sprintf(latencyScript, count, pingInterval.Seconds(), targetIP(target), resultMarker)
+	source, err := r.createVMI(ctx, r.newVMI("source", spec.SourceNode,
+		withCheckupNetwork(spec.NetworkAttachmentDefinition),
+		libvmi.WithCloudInitNoCloud(cloudinit.WithNoCloudUserData(script)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := r.readResult(ctx, source.Name)
+	if err != nil {
+		return nil, err
+	}
+	return parseLatency(output)
+}
+
+// storage measures the IOPS of direct 4KiB reads and writes on a new volume
+func (r *Runner) storage(ctx context.Context, spec *checkupv1.StorageCheckup) (*checkupv1.StorageResult, error) {
+	pvc, err := r.createPVC(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ios := int64(maxStorageIOs)
+	if size := pvc.Spec.Resources.Requests.Storage().Value(); size > 0 {
+		ios = min(ios, size/storageBlock)
+	}
+	script := fmt.Sprintf(storageScript, storageSerial, ios, storageBlock, storageBlock, resultMarker)
+	vmi, err := r.createVMI(ctx, r.newVMI("storage", "",
+		libvmi.WithPersistentVolumeClaim(storageDiskName, pvc.Name, func(disk *v1.Disk) {
+			disk.Serial = storageSerial
+		}),
+		libvmi.WithCloudInitNoCloud(cloudinit.WithNoCloudUserData(script)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := r.readResult(ctx, vmi.Name)
+	if err != nil {
+		return nil, err
+	}
+	return parseStorage(output)
+}
+
+// bootTime boots the guests one after the other, and measures the time from
+// their creation until their guest agent connected
+func (r *Runner) bootTime(ctx context.Context, spec *checkupv1.BootTimeCheckup) (*checkupv1.BootTimeResult, error) {
+	samples := 1
+	if spec.Samples != nil {
+		samples = max(1, int(*spec.Samples))
+	}
+
+	var bootTimes []time.Duration
+	for i := range samples {
+		start := r.now()
+		vmi, err := r.createVMI(ctx, r.newVMI(fmt.Sprintf("boot-%d", i), ""))
+		if err != nil {
+			return nil, err
+		}
+		_, err = r.waitFor(ctx, vmi.Name, "connect its guest agent", agentConnected)
+		if err != nil {
+			return nil, err
+		}
+		bootTimes = append(bootTimes, r.now().Sub(start))
+
+		if err := r.deleteVMI(ctx, vmi.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &checkupv1.BootTimeResult{
+		MinBootTime: metav1.Duration{Duration: bootTimes[0]},
+		MaxBootTime: metav1.Duration{Duration: bootTimes[0]},
+	}
+	var total time.Duration
+	for _, bootTime := range bootTimes {
+		result.MinBootTime.Duration = min(result.MinBootTime.Duration, bootTime)
+		result.MaxBootTime.Duration = max(result.MaxBootTime.Duration, bootTime)
+		total += bootTime
+	}
+	result.AverageBootTime = metav1.Duration{Duration: total / time.Duration(len(bootTimes))}
+	return result, nil
+}
+
+func (r *Runner) newVMI(purpose, node string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+	opts = append([]libvmi.Option{
+		libvmi.WithName(fmt.Sprintf("%s-%s", r.name, purpose)),
+		libvmi.WithNamespace(r.namespace),
+		libvmi.WithLabel(CheckupLabel, r.name),
+		libvmi.WithContainerDisk(containerDisk, r.guestImage),
+		libvmi.WithMemoryRequest(guestMemory),
+	}, opts...)
+	if node != "" {
+		opts = append(opts, libvmi.WithNodeAffinityFor(node))
+	}
+	return libvmi.New(opts...)
+}
+
+func withCheckupNetwork(networkAttachmentDefinition string) libvmi.Option {
+	if networkAttachmentDefinition == "" {
+		return func(vmi *v1.VirtualMachineInstance) {
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding())(vmi)
+			libvmi.WithNetwork(v1.DefaultPodNetwork())(vmi)
+		}
+	}
+	return func(vmi *v1.VirtualMachineInstance) {
+		libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(networkName))(vmi)
+		libvmi.WithNetwork(libvmi.MultusNetwork(networkName, networkAttachmentDefinition))(vmi)
+		libvmi.WithAutoAttachPodInterface(false)(vmi)
+	}
+}
+
+func (r *Runner) createVMI(ctx context.Context, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachineInstance, error) {
+	created, err := r.client.VirtualMachineInstance(r.namespace).Create(ctx, vmi, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create VMI %s: %v", vmi.Name, err)
+	}
+	log.Log.Object(created).Info("Created checkup VMI")
+	return created, nil
+}
+
+func (r *Runner) deleteVMI(ctx context.Context, name string) error {
+	err := r.client.VirtualMachineInstance(r.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete VMI %s: %v", name, err)
+	}
+	return nil
+}
+
+func (r *Runner) createPVC(ctx context.Context, spec *checkupv1.StorageCheckup) (*k8sv1.PersistentVolumeClaim, error) {
+	pvc := &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-storage", r.name),
+			Namespace: r.namespace,
+			Labels:    map[string]string{CheckupLabel: r.name},
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+			StorageClassName: spec.StorageClassName,
+		},
+	}
+	if spec.VolumeSize != nil {
+		pvc.Spec.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceStorage: *spec.VolumeSize}
+	}
+
+	created, err := r.client.CoreV1().PersistentVolumeClaims(r.namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PVC %s: %v", pvc.Name, err)
+	}
+	return created, nil
+}
+
+// waitFor waits until the VMI meets the condition, and fails early if the VMI failed
+func (r *Runner) waitFor(ctx context.Context, name, what string, condition func(*v1.VirtualMachineInstance) bool) (*v1.VirtualMachineInstance, error) {
+	var vmi *v1.VirtualMachineInstance
+	err := wait.PollUntilContextCancel(ctx, r.interval, true, func(ctx context.Context) (bool, error) {
+		var err error
+		vmi, err = r.client.VirtualMachineInstance(r.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if vmi.IsFinal() {
+			return false, fmt.Errorf("VMI %s is %s", name, vmi.Status.Phase)
+		}
+		return condition(vmi), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("VMI %s did not %s: %v", name, what, err)
+	}
+	return vmi, nil
+}
+
+// readResult waits for the guest to print its result on the serial console
+func (r *Runner) readResult(ctx context.Context, name string) (string, error) {
+	if _, err := r.waitFor(ctx, name, "start", (*v1.VirtualMachineInstance).IsRunning); err != nil {
+		return "", err
+	}
+
+	stream, err := r.client.VirtualMachineInstance(r.namespace).SerialConsole(name, &kvcorev1.SerialConsoleOptions{ConnectionTimeout: consoleTimeout})
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to the console of VMI %s: %v", name, err)
+	}
+
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	go func() {
+		outWriter.CloseWithError(stream.Stream(kvcorev1.StreamOptions{In: inReader, Out: outWriter}))
+	}()
+	go func() {
+		<-ctx.Done()
+		outReader.CloseWithError(ctx.Err())
+	}()
+	defer inWriter.Close()
+	defer outReader.Close()
+
+	return scanResult(outReader, name)
+}
+
+func scanResult(console io.Reader, name string) (string, error) {
+	scanner := bufio.NewScanner(console)
+	for scanner.Scan() {
+		if _, output, found := strings.Cut(scanner.Text(), resultMarker); found {
+			return strings.TrimSpace(output), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read the result from the console of VMI %s: %v", name, err)
+	}
+	return "", fmt.Errorf("the console of VMI %s closed before it reported a result", name)
+}
+
+// cleanup removes everything the checkup created, even if its context expired
+func (r *Runner) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), CleanupTimeout)
+	defer cancel()
+
+	selector := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", CheckupLabel, r.name)}
+	vmis, err := r.client.VirtualMachineInstance(r.namespace).List(ctx, selector)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list the checkup VMIs")
+	} else {
+		for _, vmi := range vmis.Items {
+			if err := r.deleteVMI(ctx, vmi.Name); err != nil {
+				log.Log.Reason(err).Error("Failed to clean up the checkup")
+			}
+		}
+	}
+
+	pvcs, err := r.client.CoreV1().PersistentVolumeClaims(r.namespace).List(ctx, selector)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list the checkup PVCs")
+		return
+	}
+	for _, pvc := range pvcs.Items {
+		err := r.client.CoreV1().PersistentVolumeClaims(r.namespace).Delete(ctx, pvc.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Log.Reason(err).Errorf("Failed to delete the checkup PVC %s", pvc.Name)
+		}
+	}
+}
+
+func agentConnected(vmi *v1.VirtualMachineInstance) bool {
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == v1.VirtualMachineInstanceAgentConnected {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func targetIP(vmi *v1.VirtualMachineInstance) string {
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.Name == networkName || iface.Name == v1.DefaultPodNetwork().Name {
+			return iface.IP
+		}
+	}
+	return ""
+}
+
+func parseLatency(output string) (*checkupv1.NetworkLatencyResult, error) {
+	match := pingSummary.FindStringSubmatch(output)
+	if match == nil {
+		return nil, fmt.Errorf("no latency measured: %s", output)
+	}
+	var latencies [3]time.Duration
+	for i := range latencies {
+		milliseconds, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latency %q: %v", match[i+1], err)
+		}
+		latencies[i] = time.Duration(milliseconds * float64(time.Millisecond))
+	}
+	return &checkupv1.NetworkLatencyResult{
+		MinLatency:     metav1.Duration{Duration: latencies[0]},
+		AverageLatency: metav1.Duration{Duration: latencies[1]},
+		MaxLatency:     metav1.Duration{Duration: latencies[2]},
+	}, nil
+}
+
+func parseStorage(output string) (*checkupv1.StorageResult, error) {
+	match := storageResult.FindStringSubmatch(output)
+	if match == nil {
+		return nil, fmt.Errorf("no IOPS measured: %s", output)
+	}
+	read, _ := strconv.ParseInt(match[1], 10, 64)
+	write, _ := strconv.ParseInt(match[2], 10, 64)
+	if read == 0 || write == 0 {
+		return nil, fmt.Errorf("the I/O on the volume failed: %s", output)
+	}
+	return &checkupv1.StorageResult{ReadIOPS: read, WriteIOPS: write}, nil
+}
@@ -0,0 +1,217 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package runner
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	testNamespace = "default"
+	checkupName   = "test-checkup"
+	testImage     = "quay.io/containerdisks/fedora:test"
+)
+
+var _ = Describe("Checkup Runner", func() {
+	var (
+		k8sClient      *k8sfake.Clientset
+		kubevirtClient *kubevirtfake.Clientset
+		runner         *Runner
+		clock          time.Time
+	)
+
+	// startVMIs makes the created VMIs reach the given phase, with their guest agent connected
+	startVMIs := func(phase v1.VirtualMachineInstancePhase) {
+		kubevirtClient.PrependReactor("create", "virtualmachineinstances", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			vmi := action.(k8stesting.CreateAction).GetObject().(*v1.VirtualMachineInstance)
+			vmi.Status.Phase = phase
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceAgentConnected,
+				Status: k8sv1.ConditionTrue,
+			}}
+			return false, nil, nil
+		})
+	}
+
+	listVMIs := func() []v1.VirtualMachineInstance {
+		vmis, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmis.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(testNamespace)).AnyTimes()
+
+		clock = time.Now()
+		runner = NewRunner(virtClient, testNamespace, checkupName, testImage)
+		runner.interval = time.Millisecond
+		runner.now = func() time.Time {
+			clock = clock.Add(5 * time.Second)
+			return clock
+		}
+	})
+
+	Context("boot time", func() {
+		It("should measure the boot time of every sample and remove the VMIs", func() {
+			var created []*v1.VirtualMachineInstance
+			kubevirtClient.PrependReactor("create", "virtualmachineinstances", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				created = append(created, action.(k8stesting.CreateAction).GetObject().(*v1.VirtualMachineInstance))
+				return false, nil, nil
+			})
+			startVMIs(v1.Running)
+
+			res := runner.Run(context.Background(), &checkupv1.VirtualMachineCheckupSpec{
+				BootTime: &checkupv1.BootTimeCheckup{Samples: pointer.P(int32(2))},
+			})
+
+			Expect(res.FailureReason).To(BeEmpty())
+			Expect(res.Succeeded).To(BeTrue())
+			Expect(res.BootTime).To(Equal(&checkupv1.BootTimeResult{
+				MinBootTime:     metav1.Duration{Duration: 5 * time.Second},
+				AverageBootTime: metav1.Duration{Duration: 5 * time.Second},
+				MaxBootTime:     metav1.Duration{Duration: 5 * time.Second},
+			}))
+
+			Expect(created).To(HaveLen(2))
+			for _, vmi := range created {
+				Expect(vmi.Name).To(HavePrefix(checkupName + "-boot-"))
+				Expect(vmi.Labels).To(HaveKeyWithValue(CheckupLabel, checkupName))
+				Expect(vmi.Spec.Volumes[0].ContainerDisk.Image).To(Equal(testImage))
+			}
+			Expect(listVMIs()).To(BeEmpty())
+		})
+
+		It("should fail if a VMI fails to boot and still remove it", func() {
+			startVMIs(v1.Failed)
+
+			res := runner.Run(context.Background(), &checkupv1.VirtualMachineCheckupSpec{
+				BootTime: &checkupv1.BootTimeCheckup{Samples: pointer.P(int32(1))},
+			})
+
+			Expect(res.Succeeded).To(BeFalse())
+			Expect(res.FailureReason).To(ContainSubstring("VMI test-checkup-boot-0 is Failed"))
+			Expect(listVMIs()).To(BeEmpty())
+		})
+
+		It("should fail once the context expires", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			res := runner.Run(ctx, &checkupv1.VirtualMachineCheckupSpec{
+				BootTime: &checkupv1.BootTimeCheckup{Samples: pointer.P(int32(1))},
+			})
+
+			Expect(res.Succeeded).To(BeFalse())
+			Expect(res.FailureReason).To(ContainSubstring("context canceled"))
+		})
+	})
+
+	Context("storage", func() {
+		It("should remove the PVC if the benchmark fails", func() {
+			kubevirtClient.PrependReactor("create", "virtualmachineinstances", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, context.DeadlineExceeded
+			})
+
+			res := runner.Run(context.Background(), &checkupv1.VirtualMachineCheckupSpec{
+				Storage: &checkupv1.StorageCheckup{StorageClassName: pointer.P("fast")},
+			})
+			Expect(res.Succeeded).To(BeFalse())
+			Expect(res.FailureReason).To(ContainSubstring("failed to create VMI test-checkup-storage"))
+
+			var createdPVC *k8sv1.PersistentVolumeClaim
+			for _, action := range k8sClient.Actions() {
+				if create, ok := action.(k8stesting.CreateAction); ok {
+					createdPVC = create.GetObject().(*k8sv1.PersistentVolumeClaim)
+				}
+			}
+			Expect(createdPVC).ToNot(BeNil())
+			Expect(createdPVC.Spec.StorageClassName).To(HaveValue(Equal("fast")))
+			Expect(createdPVC.Labels).To(HaveKeyWithValue(CheckupLabel, checkupName))
+
+			pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvcs.Items).To(BeEmpty())
+		})
+	})
+
+	Context("results", func() {
+		It("should read the result from the console", func() {
+			console := "booting\r\n" + resultMarker + " read=100 write=50\r\n"
+			output, err := scanResult(strings.NewReader(console), "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(output).To(Equal("read=100 write=50"))
+		})
+
+		It("should fail if the console closes without a result", func() {
+			_, err := scanResult(strings.NewReader("booting\n"), "test")
+			Expect(err).To(MatchError(ContainSubstring("closed before it reported a result")))
+		})
+
+		DescribeTable("should parse the latency of", func(output string) {
+			result, err := parseLatency(output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.MinLatency.Duration).To(Equal(210 * time.Microsecond))
+			Expect(result.AverageLatency.Duration).To(Equal(450 * time.Microsecond))
+			Expect(result.MaxLatency.Duration).To(Equal(1200 * time.Microsecond))
+		},
+			Entry("iputils", "rtt min/avg/max/mdev = 0.210/0.450/1.200/0.100 ms"),
+			Entry("busybox", "round-trip min/avg/max = 0.210/0.450/1.200 ms"),
+		)
+
+		It("should fail if no packet was answered", func() {
+			_, err := parseLatency("25 packets transmitted, 0 received, 100% packet loss, time 4900ms")
+			Expect(err).To(MatchError(ContainSubstring("no latency measured")))
+		})
+
+		It("should parse the IOPS", func() {
+			Expect(parseStorage("read=100 write=50")).To(Equal(&checkupv1.StorageResult{ReadIOPS: 100, WriteIOPS: 50}))
+		})
+
+		It("should fail if the I/O failed", func() {
+			_, err := parseStorage("read=0 write=50")
+			Expect(err).To(MatchError(ContainSubstring("the I/O on the volume failed")))
+		})
+	})
+})
@@ -195,7 +195,8 @@ type volumeInfo interface {
 func createIsoDisksForConfigVolumes(vmi *v1.VirtualMachineInstance, emptyIso bool, info volumeInfo) error {
 	volumes := make(map[string]v1.Volume)
 	for _, volume := range vmi.Spec.Volumes {
-		if info.isValidType(&volume) {
+		// hotpluggable volumes are turned into iso disks once they are plugged
+		if info.isValidType(&volume) && !isHotpluggable(&volume) {
 			volumes[volume.Name] = volume
 		}
 	}
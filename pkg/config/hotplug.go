@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+)
+
+const hotplugIsoSuffix = ".iso"
+
+// HotplugDisksDir represents the location where virt-handler mounts hotplugged
+// volumes, and where the iso images of hotplugged config volumes are created
+var HotplugDisksDir = v1.HotplugDiskDir
+
+// GetHotplugSourcePath returns a path to a hotplugged config volume mounted by virt-handler
+func GetHotplugSourcePath(volumeName string) string {
+	return filepath.Join(HotplugDisksDir, volumeName)
+}
+
+// GetHotplugDiskPath returns a path to the iso image of a hotplugged config volume
+func GetHotplugDiskPath(volumeName string) string {
+	return filepath.Join(HotplugDisksDir, volumeName+hotplugIsoSuffix)
+}
+
+func isHotpluggable(v *v1.Volume) bool {
+	return (v.ConfigMap != nil && v.ConfigMap.Hotpluggable) ||
+		(v.Secret != nil && v.Secret.Hotpluggable) ||
+		(v.ServiceAccount != nil && v.ServiceAccount.Hotpluggable)
+}
+
+func hotplugLabel(v *v1.Volume) string {
+	switch {
+	case v.ConfigMap != nil:
+		return v.ConfigMap.VolumeLabel
+	case v.Secret != nil:
+		return v.Secret.VolumeLabel
+	}
+	return ""
+}
+
+// CreateHotplugDisks creates the iso disks of hotplugged ConfigMap, Secret and
+// ServiceAccount volumes once virt-handler mounted them, and removes the iso
+// disks of unplugged volumes. The content of an iso disk is not updated while
+// the volume stays plugged.
+func CreateHotplugDisks(vmi *v1.VirtualMachineInstance) error {
+	mounted := make(map[string]bool)
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil &&
+			(volumeStatus.Phase == v1.HotplugVolumeMounted || volumeStatus.Phase == v1.VolumeReady) {
+			mounted[volumeStatus.Name] = true
+		}
+	}
+
+	volumeNames := make(map[string]bool)
+	hotplugIsos := make(map[string]bool)
+	for _, volume := range vmi.Spec.Volumes {
+		volumeNames[volume.Name] = true
+		if !isHotpluggable(&volume) {
+			continue
+		}
+		isoPath := GetHotplugDiskPath(volume.Name)
+		hotplugIsos[isoPath] = true
+		if !mounted[volume.Name] {
+			continue
+		}
+
+		if _, err := os.Stat(isoPath); err == nil {
+			continue
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		filesPath, err := getFilesLayout(GetHotplugSourcePath(volume.Name))
+		if err != nil {
+			return err
+		}
+		if err := createIsoConfigImage(isoPath, hotplugLabel(&volume), filesPath, 0); err != nil {
+			return err
+		}
+		if err := ephemeraldiskutils.DefaultOwnershipManager.UnsafeSetFileOwnership(isoPath); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(HotplugDisksDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		// hotplugged PVCs are mounted by their volume name, which could end in .iso
+		if entry.IsDir() || !strings.HasSuffix(name, hotplugIsoSuffix) || volumeNames[name] {
+			continue
+		}
+		isoPath := filepath.Join(HotplugDisksDir, name)
+		if hotplugIsos[isoPath] {
+			continue
+		}
+		if err := os.Remove(isoPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
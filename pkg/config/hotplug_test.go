@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Hotplug", func() {
+
+	newHotplugConfigMapVMI := func(phase v1.VolumePhase) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithConfigMapDisk("test-config", "configmap-volume"))
+		vmi.Spec.Volumes[0].ConfigMap.Hotpluggable = true
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+			Name:          "configmap-volume",
+			Phase:         phase,
+			HotplugVolume: &v1.HotplugVolumeStatus{},
+		}}
+		return vmi
+	}
+
+	BeforeEach(func() {
+		originalHotplugDisksDir := HotplugDisksDir
+		originalConfigMapDisksDir := ConfigMapDisksDir
+		HotplugDisksDir = GinkgoT().TempDir()
+		ConfigMapDisksDir = GinkgoT().TempDir()
+		DeferCleanup(func() {
+			HotplugDisksDir = originalHotplugDisksDir
+			ConfigMapDisksDir = originalConfigMapDisksDir
+		})
+
+		Expect(os.MkdirAll(filepath.Join(HotplugDisksDir, "configmap-volume"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(HotplugDisksDir, "configmap-volume", "test-file"), []byte("test"), 0644)).To(Succeed())
+	})
+
+	It("Should create the iso disk of a mounted hotplugged config map", func() {
+		vmi := newHotplugConfigMapVMI(v1.HotplugVolumeMounted)
+
+		Expect(CreateHotplugDisks(vmi)).To(Succeed())
+		_, err := os.Stat(GetHotplugDiskPath("configmap-volume"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Should not create the iso disk before the hotplugged config map is mounted", func() {
+		vmi := newHotplugConfigMapVMI(v1.HotplugVolumeAttachedToNode)
+
+		Expect(CreateHotplugDisks(vmi)).To(Succeed())
+		_, err := os.Stat(GetHotplugDiskPath("configmap-volume"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+
+	It("Should not create the iso disk of a hotpluggable config map at start", func() {
+		vmi := newHotplugConfigMapVMI(v1.HotplugVolumeMounted)
+
+		Expect(CreateConfigMapDisks(vmi, false)).To(Succeed())
+		_, err := os.Stat(GetConfigMapDiskPath("configmap-volume"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+
+	It("Should remove the iso disk of an unplugged config map", func() {
+		vmi := newHotplugConfigMapVMI(v1.HotplugVolumeMounted)
+		Expect(CreateHotplugDisks(vmi)).To(Succeed())
+
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "pvc.iso",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc"},
+					Hotpluggable:                      true,
+				},
+			},
+		}}
+		Expect(os.WriteFile(filepath.Join(HotplugDisksDir, "pvc.iso"), nil, 0644)).To(Succeed())
+
+		Expect(CreateHotplugDisks(vmi)).To(Succeed())
+		_, err := os.Stat(GetHotplugDiskPath("configmap-volume"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+		_, err = os.Stat(filepath.Join(HotplugDisksDir, "pvc.iso"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
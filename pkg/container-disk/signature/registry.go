@@ -0,0 +1,240 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package signature
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	dockerHubRegistry   = "docker.io"
+	dockerHubAPIHost    = "registry-1.docker.io"
+	dockerHubAuthHost   = "auth.docker.io"
+	dockerHubRepoPrefix = "library/"
+
+	manifestMediaTypes = "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json"
+
+	maxManifestSize = 4 << 20
+	maxPayloadSize  = 1 << 20
+)
+
+var authParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+type reference struct {
+	image      string
+	registry   string
+	repository string
+	digest     string
+}
+
+type manifest struct {
+	Layers []struct {
+		Digest      string            `json:"digest"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"layers"`
+}
+
+// parseReference splits an image pinned by its digest, like
+// quay.io/containerdisks/fedora@sha256:..., into its parts. Images without
+// a registry are pulled from Docker Hub.
+func parseReference(image string) (*reference, error) {
+	name, digest, found := strings.Cut(image, "@")
+	if !found || !strings.HasPrefix(digest, digestPrefix) {
+		return nil, fmt.Errorf("image %s is not pinned by its digest", image)
+	}
+
+	ref := &reference{image: image, registry: dockerHubRegistry, digest: digest}
+	if first, rest, found := strings.Cut(name, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.registry = first
+		name = rest
+	}
+	// Tags are ignored, the digest identifies the image
+	if colon := strings.LastIndex(name, ":"); colon != -1 {
+		name = name[:colon]
+	}
+	if ref.registry == dockerHubRegistry && !strings.Contains(name, "/") {
+		name = dockerHubRepoPrefix + name
+	}
+	ref.repository = name
+	return ref, nil
+}
+
+func (r *reference) host() string {
+	if r.registry == dockerHubRegistry {
+		return dockerHubAPIHost
+	}
+	return r.registry
+}
+
+// isTrustedTokenRealm returns true if anonymous tokens for the registry may be
+// requested from the realm. Only https realms on the host of the registry, on
+// the Docker Hub token service for Docker Hub images and on the hosts trusted
+// by the admin are accepted.
+func (r *reference) isTrustedTokenRealm(realm *url.URL, trustedHosts []string) bool {
+	if realm.Scheme != "https" || realm.User != nil {
+		return false
+	}
+	if realm.Host == r.host() || (r.registry == dockerHubRegistry && realm.Host == dockerHubAuthHost) {
+		return true
+	}
+	for _, host := range trustedHosts {
+		if realm.Host == host {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *Verifier) fetchSignatureManifest(ctx context.Context, ref *reference, trustedTokenHosts []string) (*manifest, error) {
+	body, err := v.get(ctx, ref, "manifests/"+signatureTag(ref.digest), manifestMediaTypes, maxManifestSize, trustedTokenHosts)
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{}
+	if err := json.Unmarshal(body, m); err != nil {
+		return nil, fmt.Errorf("failed to parse the signature manifest of %s: %v", ref.repository, err)
+	}
+	return m, nil
+}
+
+func (v *Verifier) fetchBlob(ctx context.Context, ref *reference, digest string, trustedTokenHosts []string) ([]byte, error) {
+	body, err := v.get(ctx, ref, "blobs/"+digest, "", maxPayloadSize, trustedTokenHosts)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(body)
+	if digestPrefix+hex.EncodeToString(hash[:]) != digest {
+		return nil, &VerificationError{Image: ref.image, Reason: fmt.Sprintf("the content of blob %s does not match its digest", digest)}
+	}
+	return body, nil
+}
+
+// get fetches a path below the repository from the registry API. Registries
+// requiring a bearer token, like Docker Hub, are accessed with an anonymous one.
+// The responses of the registry are only logged, they are not passed on in the
+// returned errors.
+func (v *Verifier) get(ctx context.Context, ref *reference, path, accept string, maxSize int64, trustedTokenHosts []string) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s://%s/v2/%s/%s", v.scheme, ref.host(), ref.repository, path)
+	resp, err := v.do(ctx, endpoint, accept, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		token, err := v.token(ctx, ref, challenge, trustedTokenHosts)
+		if err != nil {
+			return nil, err
+		}
+		if resp, err = v.do(ctx, endpoint, accept, token); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, &VerificationError{Image: ref.image, Reason: "no signature found"}
+	default:
+		log.Log.Warningf("Unexpected status %d from %s", resp.StatusCode, endpoint)
+		return nil, fmt.Errorf("the registry of image %s returned an unexpected response", ref.image)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", endpoint, err)
+	}
+	if int64(len(body)) > maxSize {
+		return nil, fmt.Errorf("%s exceeds %d bytes", endpoint, maxSize)
+	}
+	return body, nil
+}
+
+func (v *Verifier) do(ctx context.Context, endpoint, accept, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return v.client.Do(req)
+}
+
+// token requests an anonymous token for the bearer challenge of a registry.
+func (v *Verifier) token(ctx context.Context, ref *reference, challenge string, trustedHosts []string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported registry authentication %q", scheme)
+	}
+	values := url.Values{}
+	realm := ""
+	for _, match := range authParamRegex.FindAllStringSubmatch(params, -1) {
+		if match[1] == "realm" {
+			realm = match[2]
+		} else {
+			values.Set(match[1], match[2])
+		}
+	}
+	if realm == "" {
+		return "", fmt.Errorf("the registry did not announce a token realm")
+	}
+	realmURL, err := url.Parse(realm)
+	if err != nil || !ref.isTrustedTokenRealm(realmURL, trustedHosts) {
+		log.Log.Warningf("The registry of image %s announced the untrusted token realm %q", ref.image, realm)
+		return "", fmt.Errorf("the registry of image %s announced an untrusted token realm", ref.image)
+	}
+	realmURL.RawQuery = values.Encode()
+
+	resp, err := v.do(ctx, realmURL.String(), "", "")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Log.Warningf("Unexpected status %d from %s", resp.StatusCode, realmURL.Host)
+		return "", fmt.Errorf("the token service of image %s returned an unexpected response", ref.image)
+	}
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxPayloadSize)).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to parse the token from %s: %v", realmURL.Host, err)
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package signature verifies cosign signatures of container images.
+//
+// cosign stores the signatures of an image as the layers of an OCI image
+// tagged sha256-<digest>.sig in the repository of the signed image. Every
+// layer is a simple signing payload naming the digest of the signed image,
+// its signature is stored base64 encoded in a layer annotation.
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+	digestPrefix        = "sha256:"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// VerificationError is returned if an image does not carry a valid signature
+// of any of the keys. Failures to reach the registry are returned as plain
+// errors, since they are usually transient.
+type VerificationError struct {
+	Image  string
+	Reason string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("signature verification of image %s failed: %s", e.Image, e.Reason)
+}
+
+// Verifier verifies cosign signatures of images pinned by their digest.
+type Verifier struct {
+	client *http.Client
+	scheme string
+}
+
+func NewVerifier() *Verifier {
+	return &Verifier{
+		client: &http.Client{Timeout: defaultTimeout},
+		scheme: "https",
+	}
+}
+
+// Verify returns nil if the image carries a signature of any of the PEM
+// encoded public keys. Only images pinned by their digest can be verified.
+// Anonymous tokens are only requested from the registry itself and from the
+// trusted token hosts.
+func (v *Verifier) Verify(ctx context.Context, image string, publicKeys, trustedTokenHosts []string) error {
+	keys, err := parsePublicKeys(publicKeys)
+	if err != nil {
+		return &VerificationError{Image: image, Reason: err.Error()}
+	}
+	ref, err := parseReference(image)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := v.fetchSignatureManifest(ctx, ref, trustedTokenHosts)
+	if err != nil {
+		return err
+	}
+
+	reason := "no signature found"
+	for _, layer := range manifest.Layers {
+		encodedSignature := layer.Annotations[signatureAnnotation]
+		if encodedSignature == "" {
+			continue
+		}
+		payload, err := v.fetchBlob(ctx, ref, layer.Digest, trustedTokenHosts)
+		if err != nil {
+			return err
+		}
+		if err := verifyPayload(payload, encodedSignature, ref.digest, keys); err != nil {
+			reason = err.Error()
+			continue
+		}
+		return nil
+	}
+	return &VerificationError{Image: image, Reason: reason}
+}
+
+func parsePublicKeys(publicKeys []string) ([]crypto.PublicKey, error) {
+	if len(publicKeys) == 0 {
+		return nil, fmt.Errorf("no public keys are configured")
+	}
+	keys := make([]crypto.PublicKey, 0, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			return nil, fmt.Errorf("public key %d is not PEM encoded", i)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key %d: %v", i, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// simpleSigningPayload is the part of the payload signed by cosign which
+// identifies the signed image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func verifyPayload(payload []byte, encodedSignature, digest string, keys []crypto.PublicKey) error {
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("the signature is not base64 encoded: %v", err)
+	}
+	if !verifiedByAny(keys, payload, signature) {
+		return fmt.Errorf("the signature does not match any of the public keys")
+	}
+
+	signed := simpleSigningPayload{}
+	if err := json.Unmarshal(payload, &signed); err != nil {
+		return fmt.Errorf("failed to parse the signed payload: %v", err)
+	}
+	if signed.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("the signature is for digest %q", signed.Critical.Image.DockerManifestDigest)
+	}
+	return nil
+}
+
+func verifiedByAny(keys []crypto.PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, signature) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// signatureTag returns the tag cosign stores the signatures of the digest under.
+func signatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -58,6 +59,9 @@ const (
 	// SuccessfulCreatePodReason is added in an event when a pod for a vmi controller
 	// is successfully created.
 	SuccessfulCreatePodReason = "SuccessfulCreate"
+	// SuccessfulAdoptWarmPodReason is added in an event when an idle pod of a
+	// launcher warm pool is handed over to a vmi instead of creating a pod.
+	SuccessfulAdoptWarmPodReason = "SuccessfulAdoptWarmPod"
 	// FailedDeletePodReason is added in an event and in a vmi controller condition
 	// when a pod for a vmi controller failed to be deleted.
 	FailedDeletePodReason = "FailedDelete"
@@ -118,6 +122,14 @@ const (
 	// MigrationBackoffReason is set when an error has occured while migrating
 	// and virt-controller is backing off before retrying.
 	MigrationBackoffReason = "MigrationBackoff"
+	// DiskExpansionAcceptedReason is set when the PVC of a disk was expanded on request of the guest.
+	DiskExpansionAcceptedReason = "DiskExpansionAccepted"
+	// DiskExpansionRejectedReason is set when a disk expansion requested by the guest was refused.
+	DiskExpansionRejectedReason = "DiskExpansionRejected"
+	// FailedVolumeScanReason is set when the volumes of a VMI could not be passed to the volume scanner.
+	FailedVolumeScanReason = "FailedVolumeScan"
+	// VolumeScanRejectedReason is set when the volume scanner rejected a volume of a VMI.
+	VolumeScanRejectedReason = "VolumeScanRejected"
 )
 
 // NewListWatchFromClient creates a new ListWatch from the specified client, resource, kubevirtNamespace and field selector.
@@ -145,6 +157,50 @@ func NewListWatchFromClient(c cache.Getter, resource string, namespace string, f
 	return &cache.ListWatch{ListFunc: listFunc, WatchFunc: watchFunc}
 }
 
+// NewNamespaceFilteringListWatch drops the objects of the namespaces which are not accepted by keep
+// from the lists and the watch events of lw.
+func NewNamespaceFilteringListWatch(lw cache.ListerWatcher, keep func(namespace string) bool) *cache.ListWatch {
+	kept := func(obj runtime.Object) bool {
+		accessor, err := meta.Accessor(obj)
+		// objects without metadata are left to the informer to report
+		return err != nil || keep(accessor.GetNamespace())
+	}
+	listFunc := func(options metav1.ListOptions) (runtime.Object, error) {
+		list, err := lw.List(options)
+		if err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		var keptItems []runtime.Object
+		for _, item := range items {
+			if kept(item) {
+				keptItems = append(keptItems, item)
+			}
+		}
+		if err := meta.SetList(list, keptItems); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+	watchFunc := func(options metav1.ListOptions) (watch.Interface, error) {
+		w, err := lw.Watch(options)
+		if err != nil {
+			return nil, err
+		}
+		return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+			// bookmarks carry the resource version only
+			if event.Type == watch.Bookmark || event.Type == watch.Error {
+				return event, true
+			}
+			return event, kept(event.Object)
+		}), nil
+	}
+	return &cache.ListWatch{ListFunc: listFunc, WatchFunc: watchFunc}
+}
+
 func HandlePanic() {
 	if r := recover(); r != nil {
 		// Ignoring error - There is nothing to do, if logging fails
@@ -284,6 +340,18 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 				dvSource := request.AddVolumeOptions.VolumeSource.DataVolume.DeepCopy()
 				dvSource.Hotpluggable = true
 				newVolume.VolumeSource.DataVolume = dvSource
+			} else if request.AddVolumeOptions.VolumeSource.ConfigMap != nil {
+				configMapSource := request.AddVolumeOptions.VolumeSource.ConfigMap.DeepCopy()
+				configMapSource.Hotpluggable = true
+				newVolume.VolumeSource.ConfigMap = configMapSource
+			} else if request.AddVolumeOptions.VolumeSource.Secret != nil {
+				secretSource := request.AddVolumeOptions.VolumeSource.Secret.DeepCopy()
+				secretSource.Hotpluggable = true
+				newVolume.VolumeSource.Secret = secretSource
+			} else if request.AddVolumeOptions.VolumeSource.ServiceAccount != nil {
+				serviceAccountSource := request.AddVolumeOptions.VolumeSource.ServiceAccount.DeepCopy()
+				serviceAccountSource.Hotpluggable = true
+				newVolume.VolumeSource.ServiceAccount = serviceAccountSource
 			}
 
 			vmiSpec.Volumes = append(vmiSpec.Volumes, newVolume)
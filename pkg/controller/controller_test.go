@@ -26,6 +26,11 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -187,4 +192,44 @@ var _ = Describe("Controller", func() {
 			)
 		})
 	})
+
+	Context("NewNamespaceFilteringListWatch", func() {
+		newVMI := func(namespace string) *v1.VirtualMachineInstance {
+			return &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: namespace}}
+		}
+		keep := func(namespace string) bool { return namespace == "kept" }
+
+		It("should drop the listed objects of other namespaces", func() {
+			lw := controller.NewNamespaceFilteringListWatch(&cache.ListWatch{
+				ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+					return &v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{*newVMI("kept"), *newVMI("dropped")}}, nil
+				},
+			}, keep)
+
+			list, err := lw.List(metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.(*v1.VirtualMachineInstanceList).Items).To(ConsistOf(*newVMI("kept")))
+		})
+
+		It("should drop the watch events of other namespaces", func() {
+			fakeWatch := watch.NewFake()
+			lw := controller.NewNamespaceFilteringListWatch(&cache.ListWatch{
+				WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+					return fakeWatch, nil
+				},
+			}, keep)
+
+			w, err := lw.Watch(metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			defer w.Stop()
+
+			go func() {
+				fakeWatch.Add(newVMI("dropped"))
+				fakeWatch.Action(watch.Bookmark, &v1.VirtualMachineInstance{})
+				fakeWatch.Modify(newVMI("kept"))
+			}()
+			Expect((<-w.ResultChan()).Type).To(Equal(watch.Bookmark))
+			Expect(<-w.ResultChan()).To(Equal(watch.Event{Type: watch.Modified, Object: newVMI("kept")}))
+		})
+	})
 })
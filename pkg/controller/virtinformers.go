@@ -40,6 +40,7 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	resourcev1 "k8s.io/api/resource/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	extclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
@@ -53,6 +54,7 @@ import (
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
 	"kubevirt.io/api/core"
@@ -61,8 +63,11 @@ import (
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	operationsv1alpha1 "kubevirt.io/api/operations/v1alpha1"
+	vmpolicyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1beta1"
 	"kubevirt.io/api/snapshot"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -101,6 +106,10 @@ type KubeInformerFactory interface {
 	// Waits for all informers to sync
 	WaitForCacheSync(stopCh <-chan struct{})
 
+	// Restricts the vmi, vm, migration and kubevirt pod informers to the namespaces accepted by keep.
+	// It has to be called before these informers are created
+	SetNamespaceFilter(keep func(namespace string) bool)
+
 	// Watches for vmi objects
 	VMI() cache.SharedIndexInformer
 
@@ -138,6 +147,9 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineBackupTracker objects
 	VirtualMachineBackupTracker() cache.SharedIndexInformer
 
+	// Watches VirtualMachineBackupHook objects
+	VirtualMachineBackupHook() cache.SharedIndexInformer
+
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
@@ -153,6 +165,21 @@ type KubeInformerFactory interface {
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches VirtualMachinePolicy objects
+	VirtualMachinePolicy() cache.SharedIndexInformer
+
+	// Watches VirtualMachineDisruptionBudget objects
+	VirtualMachineDisruptionBudget() cache.SharedIndexInformer
+
+	// Watches VirtualMachineCheckup objects
+	VirtualMachineCheckup() cache.SharedIndexInformer
+
+	// Watches NodeMaintenance objects
+	NodeMaintenance() cache.SharedIndexInformer
+
+	// Watches VirtualMachineOperation objects
+	VirtualMachineOperation() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -330,6 +357,9 @@ type KubeInformerFactory interface {
 	// PVC StorageClasses
 	StorageClass() cache.SharedIndexInformer
 
+	// PriorityClasses the VMIs reference
+	PriorityClass() cache.SharedIndexInformer
+
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
@@ -357,6 +387,7 @@ type kubeInformerFactory struct {
 	startedInformers  map[string]bool
 	kubevirtNamespace string
 	k8sInformers      informers.SharedInformerFactory
+	namespaceFilter   func(namespace string) bool
 }
 
 func NewKubeInformerFactory(restClient *rest.RESTClient, clientSet kubecli.KubevirtClient, aggregatorClient aggregatorclient.Interface, kubevirtNamespace string) KubeInformerFactory {
@@ -373,6 +404,17 @@ func NewKubeInformerFactory(restClient *rest.RESTClient, clientSet kubecli.Kubev
 	}
 }
 
+func (f *kubeInformerFactory) SetNamespaceFilter(keep func(namespace string) bool) {
+	f.namespaceFilter = keep
+}
+
+func (f *kubeInformerFactory) filterNamespaces(lw cache.ListerWatcher) cache.ListerWatcher {
+	if f.namespaceFilter == nil {
+		return lw
+	}
+	return NewNamespaceFilteringListWatch(lw, f.namespaceFilter)
+}
+
 // Start can be called from multiple controllers in different go routines safely.
 // Only informers that have not started are triggered by this function.
 // Multiple calls to this function are idempotent.
@@ -477,7 +519,7 @@ func GetVMIInformerIndexers() cache.Indexers {
 func (f *kubeInformerFactory) VMI() cache.SharedIndexInformer {
 	return f.getInformer("vmiInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstances", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineInstance{}, f.defaultResync, GetVMIInformerIndexers())
+		return cache.NewSharedIndexInformer(f.filterNamespaces(lw), &kubev1.VirtualMachineInstance{}, f.defaultResync, GetVMIInformerIndexers())
 	})
 }
 
@@ -569,7 +611,7 @@ func GetVirtualMachineInstanceMigrationInformerIndexers() cache.Indexers {
 func (f *kubeInformerFactory) VirtualMachineInstanceMigration() cache.SharedIndexInformer {
 	return f.getInformer("vmimInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstancemigrations", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineInstanceMigration{}, f.defaultResync, GetVirtualMachineInstanceMigrationInformerIndexers())
+		return cache.NewSharedIndexInformer(f.filterNamespaces(lw), &kubev1.VirtualMachineInstanceMigration{}, f.defaultResync, GetVirtualMachineInstanceMigrationInformerIndexers())
 	})
 }
 
@@ -582,7 +624,7 @@ func (f *kubeInformerFactory) KubeVirtPod() cache.SharedIndexInformer {
 		}
 
 		lw := NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "pods", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
-		return cache.NewSharedIndexInformer(lw, &k8sv1.Pod{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		return cache.NewSharedIndexInformer(f.filterNamespaces(lw), &k8sv1.Pod{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 	})
 }
 
@@ -628,7 +670,7 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 func (f *kubeInformerFactory) VirtualMachine() cache.SharedIndexInformer {
 	return f.getInformer("vmInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachines", k8sv1.NamespaceAll, fields.Everything())
-		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachine{}, f.defaultResync, GetVirtualMachineInformerIndexers())
+		return cache.NewSharedIndexInformer(f.filterNamespaces(lw), &kubev1.VirtualMachine{}, f.defaultResync, GetVirtualMachineInformerIndexers())
 	})
 }
 
@@ -697,6 +739,27 @@ func (f *kubeInformerFactory) VirtualMachineBackupTracker() cache.SharedIndexInf
 	})
 }
 
+func GetVirtualMachineBackupHookInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		"vm": func(obj interface{}) ([]string, error) {
+			hook, ok := obj.(*backupv1.VirtualMachineBackupHook)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{fmt.Sprintf("%s/%s", hook.Namespace, hook.Spec.Source.Name)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackupHook() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupHookInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackuphooks", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupHook{}, f.defaultResync, GetVirtualMachineBackupHookInformerIndexers())
+	})
+}
+
 func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"pvc": func(obj interface{}) ([]string, error) {
@@ -837,6 +900,57 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachinePolicy() cache.SharedIndexInformer {
+	return f.getInformer("vmPolicyInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().PolicyV1alpha1().RESTClient(), "virtualmachinepolicies", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &vmpolicyv1alpha1.VirtualMachinePolicy{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineCheckup() cache.SharedIndexInformer {
+	return f.getInformer("vmCheckupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().CheckupV1alpha1().RESTClient(), "virtualmachinecheckups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &checkupv1.VirtualMachineCheckup{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineDisruptionBudget() cache.SharedIndexInformer {
+	return f.getInformer("vmDisruptionBudgetInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().PolicyV1alpha1().RESTClient(), "virtualmachinedisruptionbudgets", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &vmpolicyv1alpha1.VirtualMachineDisruptionBudget{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+}
+
+func (f *kubeInformerFactory) NodeMaintenance() cache.SharedIndexInformer {
+	return f.getInformer("nodeMaintenanceInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MaintenanceV1alpha1().RESTClient(), "nodemaintenances", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &maintenancev1alpha1.NodeMaintenance{}, f.defaultResync, GetNodeMaintenanceInformerIndexers())
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineOperation() cache.SharedIndexInformer {
+	return f.getInformer("vmOperationInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().OperationsV1alpha1().RESTClient(), "virtualmachineoperations", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &operationsv1alpha1.VirtualMachineOperation{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+}
+
+func GetNodeMaintenanceInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		"node": func(obj interface{}) ([]string, error) {
+			return []string{obj.(*maintenancev1alpha1.NodeMaintenance).Spec.NodeName}, nil
+		},
+	}
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clone.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -1544,6 +1658,14 @@ func (f *kubeInformerFactory) StorageClass() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) PriorityClass() cache.SharedIndexInformer {
+	return f.getInformer("priorityClassInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.SchedulingV1().RESTClient()
+		lw := cache.NewListWatchFromClient(restClient, "priorityclasses", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &schedulingv1.PriorityClass{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	return f.getInformer("podInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "pods", k8sv1.NamespaceAll, fields.Everything())
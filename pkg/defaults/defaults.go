@@ -33,6 +33,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -40,6 +41,10 @@ import (
 func SetVirtualMachineDefaults(vm *v1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig, virtClient kubecli.KubevirtClient) {
 	setDefaultArchitectureFromDataSource(clusterConfig, vm, virtClient)
 	setDefaultArchitecture(clusterConfig, &vm.Spec.Template.Spec)
+	if clusterConfig.SealedVMDefaultsEnabled() {
+		sealVMDefaultMachineType(vm, clusterConfig)
+		return
+	}
 	setVMDefaultMachineType(vm, clusterConfig)
 }
 
@@ -192,6 +197,7 @@ func setDefaultHypervFeatureDependencies(spec *v1.VirtualMachineInstanceSpec) {
 
 func SetDefaultVirtualMachineInstanceSpec(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) error {
 	setDefaultArchitecture(clusterConfig, spec)
+	setDefaultFirmwareProfile(clusterConfig, spec)
 	setDefaultMachineType(clusterConfig, spec)
 	setDefaultResourceRequests(clusterConfig, spec)
 	setGuestMemory(spec)
@@ -330,6 +336,33 @@ func setDefaultArchitecture(clusterConfig *virtconfig.ClusterConfig, spec *v1.Vi
 	}
 }
 
+// setDefaultFirmwareProfile sets the bootloader selected by the firmware profile of the VMI,
+// unless the VMI sets one. Conflicting bootloaders are rejected by the validating webhook.
+func setDefaultFirmwareProfile(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
+	firmware := spec.Domain.Firmware
+	if firmware == nil || firmware.Profile == "" || firmware.Bootloader != nil {
+		return
+	}
+	profile := clusterConfig.GetFirmwareProfile(firmware.Profile)
+	if profile == nil {
+		return
+	}
+	switch profile.Type {
+	case v1.FirmwareTypeBIOS:
+		firmware.Bootloader = &v1.Bootloader{BIOS: &v1.BIOS{}}
+	case v1.FirmwareTypeUEFI:
+		firmware.Bootloader = &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}
+	case v1.FirmwareTypeUEFISecureBoot:
+		firmware.Bootloader = &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(true)}}
+		if spec.Domain.Features == nil {
+			spec.Domain.Features = &v1.Features{}
+		}
+		if spec.Domain.Features.SMM == nil {
+			spec.Domain.Features.SMM = &v1.FeatureState{Enabled: pointer.P(true)}
+		}
+	}
+}
+
 func setDefaultArchitectureFromDataSource(clusterConfig *virtconfig.ClusterConfig, vm *v1.VirtualMachine, virtClient kubecli.KubevirtClient) {
 	const (
 		dataSourceKind        = "datasource"
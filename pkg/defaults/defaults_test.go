@@ -18,6 +18,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/libdv"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -192,4 +193,48 @@ var _ = Describe("Defaults", func() {
 			)
 		})
 	})
+
+	Context("Firmware profile", func() {
+		var clusterConfig *virtconfig.ClusterConfig
+
+		BeforeEach(func() {
+			clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				FirmwareProfiles: []v1.FirmwareProfile{
+					{Name: "bios", Type: v1.FirmwareTypeBIOS},
+					{Name: "uefi", Type: v1.FirmwareTypeUEFI},
+					{Name: "secure", Type: v1.FirmwareTypeUEFISecureBoot},
+				},
+			})
+		})
+
+		newVMI := func(profile string) *v1.VirtualMachineInstance {
+			vmi := libvmi.New()
+			vmi.Spec.Domain.Firmware = &v1.Firmware{Profile: profile}
+			return vmi
+		}
+
+		DescribeTable("should set the bootloader of the profile", func(profile string, expectedBootloader *v1.Bootloader) {
+			vmi := newVMI(profile)
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+			Expect(vmi.Spec.Domain.Firmware.Bootloader).To(Equal(expectedBootloader))
+		},
+			Entry("for BIOS", "bios", &v1.Bootloader{BIOS: &v1.BIOS{}}),
+			Entry("for UEFI", "uefi", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}),
+			Entry("for UEFI with SecureBoot", "secure", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(true)}}),
+			Entry("not for an unknown profile", "unknown", nil),
+		)
+
+		It("should enable SMM for UEFI with SecureBoot", func() {
+			vmi := newVMI("secure")
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+			Expect(vmi.Spec.Domain.Features.SMM).To(Equal(&v1.FeatureState{Enabled: pointer.P(true)}))
+		})
+
+		It("should keep the bootloader set by the VMI", func() {
+			vmi := newVMI("uefi")
+			vmi.Spec.Domain.Firmware.Bootloader = &v1.Bootloader{BIOS: &v1.BIOS{UseSerial: pointer.P(true)}}
+			Expect(defaults.SetDefaultVirtualMachineInstanceSpec(clusterConfig, &vmi.Spec)).To(Succeed())
+			Expect(vmi.Spec.Domain.Firmware.Bootloader).To(Equal(&v1.Bootloader{BIOS: &v1.BIOS{UseSerial: pointer.P(true)}}))
+		})
+	})
 })
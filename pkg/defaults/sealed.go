@@ -0,0 +1,249 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package defaults
+
+import (
+	"crypto/sha256"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// KeepSealedDefaults copies the sealed defaults of oldVM which are missing in vm,
+// e.g. because vm was replaced with a manifest that does not know about them.
+// Sealed interfaces are only kept while the interface is still part of the template.
+func KeepSealedDefaults(vm, oldVM *v1.VirtualMachine) {
+	if oldVM == nil || oldVM.Spec.Sealed == nil {
+		return
+	}
+	if vm.Spec.Sealed == nil {
+		vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{}
+	}
+	sealed, oldSealed := vm.Spec.Sealed, oldVM.Spec.Sealed
+
+	if sealed.FirmwareUUID == "" {
+		sealed.FirmwareUUID = oldSealed.FirmwareUUID
+	}
+	if sealed.FirmwareSerial == "" {
+		sealed.FirmwareSerial = oldSealed.FirmwareSerial
+	}
+	if sealed.MachineType == "" {
+		sealed.MachineType = oldSealed.MachineType
+	}
+
+	for _, oldIface := range oldSealed.Interfaces {
+		if vm.Spec.Template == nil ||
+			vmispec.LookupInterfaceByName(vm.Spec.Template.Spec.Domain.Devices.Interfaces, oldIface.Name) == nil {
+			continue
+		}
+		iface := LookupSealedInterface(sealed, oldIface.Name)
+		if iface == nil {
+			sealed.Interfaces = append(sealed.Interfaces, oldIface)
+			continue
+		}
+		if iface.MACAddress == "" {
+			iface.MACAddress = oldIface.MACAddress
+		}
+		if iface.PCIAddress == "" {
+			iface.PCIAddress = oldIface.PCIAddress
+		}
+	}
+
+	for _, oldDisk := range oldSealed.Disks {
+		if LookupSealedDisk(sealed, oldDisk.Name) != nil || vm.Spec.Template == nil ||
+			lookupDiskByName(vm.Spec.Template.Spec.Domain.Devices.Disks, oldDisk.Name) == nil {
+			continue
+		}
+		sealed.Disks = append(sealed.Disks, oldDisk)
+	}
+
+	if isSealedEmpty(sealed) {
+		vm.Spec.Sealed = nil
+	}
+}
+
+// ApplySealedDefaults sets the sealed defaults on the fields of spec which are not set.
+func ApplySealedDefaults(spec *v1.VirtualMachineInstanceSpec, sealed *v1.VirtualMachineSealedDefaults) {
+	if sealed == nil {
+		return
+	}
+
+	if sealed.FirmwareUUID != "" || sealed.FirmwareSerial != "" {
+		if spec.Domain.Firmware == nil {
+			spec.Domain.Firmware = &v1.Firmware{}
+		}
+		if spec.Domain.Firmware.UUID == "" {
+			spec.Domain.Firmware.UUID = sealed.FirmwareUUID
+		}
+		if spec.Domain.Firmware.Serial == "" {
+			spec.Domain.Firmware.Serial = sealed.FirmwareSerial
+		}
+	}
+
+	if sealed.MachineType != "" {
+		if spec.Domain.Machine == nil {
+			spec.Domain.Machine = &v1.Machine{}
+		}
+		if spec.Domain.Machine.Type == "" {
+			spec.Domain.Machine.Type = sealed.MachineType
+		}
+	}
+
+	for i, iface := range spec.Domain.Devices.Interfaces {
+		if iface.MacAddress != "" {
+			continue
+		}
+		if sealedIface := LookupSealedInterface(sealed, iface.Name); sealedIface != nil {
+			spec.Domain.Devices.Interfaces[i].MacAddress = sealedIface.MACAddress
+		}
+	}
+
+	applySealedPCIAddresses(spec, sealed)
+}
+
+// applySealedPCIAddresses places the interfaces and disks which do not set a PCI address on their sealed one.
+// A sealed PCI address is skipped if the template places another device on it.
+func applySealedPCIAddresses(spec *v1.VirtualMachineInstanceSpec, sealed *v1.VirtualMachineSealedDefaults) {
+	used := map[string]struct{}{}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.PciAddress != "" {
+			used[iface.PciAddress] = struct{}{}
+		}
+	}
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.PciAddress != "" {
+			used[disk.Disk.PciAddress] = struct{}{}
+		}
+	}
+	available := func(address string) bool {
+		if address == "" {
+			return false
+		}
+		if _, exists := used[address]; exists {
+			return false
+		}
+		used[address] = struct{}{}
+		return true
+	}
+
+	for i := range spec.Domain.Devices.Interfaces {
+		iface := &spec.Domain.Devices.Interfaces[i]
+		if iface.PciAddress != "" {
+			continue
+		}
+		if sealedIface := LookupSealedInterface(sealed, iface.Name); sealedIface != nil && available(sealedIface.PCIAddress) {
+			iface.PciAddress = sealedIface.PCIAddress
+		}
+	}
+	for i := range spec.Domain.Devices.Disks {
+		disk := &spec.Domain.Devices.Disks[i]
+		if !NeedsSealedPCIAddress(disk) {
+			continue
+		}
+		if sealedDisk := LookupSealedDisk(sealed, disk.Name); sealedDisk != nil && available(sealedDisk.PCIAddress) {
+			disk.Disk.PciAddress = sealedDisk.PCIAddress
+		}
+	}
+}
+
+// LookupSealedInterface returns the sealed interface with the given name, or nil.
+func LookupSealedInterface(sealed *v1.VirtualMachineSealedDefaults, name string) *v1.SealedInterface {
+	if sealed == nil {
+		return nil
+	}
+	for i := range sealed.Interfaces {
+		if sealed.Interfaces[i].Name == name {
+			return &sealed.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+// LookupSealedDisk returns the sealed disk with the given name, or nil.
+func LookupSealedDisk(sealed *v1.VirtualMachineSealedDefaults, name string) *v1.SealedDisk {
+	if sealed == nil {
+		return nil
+	}
+	for i := range sealed.Disks {
+		if sealed.Disks[i].Name == name {
+			return &sealed.Disks[i]
+		}
+	}
+	return nil
+}
+
+// NeedsSealedPCIAddress returns true for the disks which can be placed on a PCI address
+// but do not set one. Only virtio disks are attached to the PCI bus of the guest.
+func NeedsSealedPCIAddress(disk *v1.Disk) bool {
+	return disk.Disk != nil && disk.Disk.PciAddress == "" &&
+		(disk.Disk.Bus == "" || disk.Disk.Bus == v1.DiskBusVirtio)
+}
+
+// NeedsSealedMACAddress returns true for the interfaces which get a different MAC address
+// on every start of the VM unless one is set.
+func NeedsSealedMACAddress(iface *v1.Interface) bool {
+	return iface.MacAddress == "" &&
+		(iface.Bridge != nil || iface.Masquerade != nil || iface.SRIOV != nil)
+}
+
+// GenerateSealedMACAddress derives a locally administered unicast MAC address from the
+// UID of the VM and the name of the interface, so that it is the same whenever it is calculated.
+func GenerateSealedMACAddress(vm *v1.VirtualMachine, ifaceName string) string {
+	sum := sha256.Sum256([]byte(string(vm.UID) + "/" + ifaceName))
+	mac := net.HardwareAddr(sum[:6])
+	mac[0] = (mac[0] | 0x02) & 0xfe
+	return mac.String()
+}
+
+func sealVMDefaultMachineType(vm *v1.VirtualMachine, clusterConfig *virtconfig.ClusterConfig) {
+	// Nothing to do, let's the validating webhook fail later
+	if vm.Spec.Template == nil {
+		return
+	}
+
+	if machine := vm.Spec.Template.Spec.Domain.Machine; machine != nil && machine.Type != "" {
+		return
+	}
+	if vm.Spec.Sealed != nil && vm.Spec.Sealed.MachineType != "" {
+		return
+	}
+
+	if vm.Spec.Sealed == nil {
+		vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{}
+	}
+	vm.Spec.Sealed.MachineType = clusterConfig.GetMachineType(vm.Spec.Template.Spec.Architecture)
+}
+
+func lookupDiskByName(disks []v1.Disk, name string) *v1.Disk {
+	for i := range disks {
+		if disks[i].Name == name {
+			return &disks[i]
+		}
+	}
+	return nil
+}
+
+func isSealedEmpty(sealed *v1.VirtualMachineSealedDefaults) bool {
+	return sealed.FirmwareUUID == "" && sealed.FirmwareSerial == "" && sealed.MachineType == "" &&
+		len(sealed.Interfaces) == 0 && len(sealed.Disks) == 0
+}
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package defaults_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Sealed defaults", func() {
+	sealed := &v1.VirtualMachineSealedDefaults{
+		FirmwareUUID:   "sealed-uuid",
+		FirmwareSerial: "sealed-serial",
+		MachineType:    "sealed-machine",
+		Interfaces:     []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+	}
+
+	It("should apply the sealed defaults to a VMI spec which lacks them", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		defaults.ApplySealedDefaults(&vmi.Spec, sealed)
+		Expect(vmi.Spec.Domain.Firmware).To(Equal(&v1.Firmware{UUID: "sealed-uuid", Serial: "sealed-serial"}))
+		Expect(vmi.Spec.Domain.Machine).To(Equal(&v1.Machine{Type: "sealed-machine"}))
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should prefer the values of the VMI spec over the sealed defaults", func() {
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding()
+		iface.MacAddress = "02:00:00:00:00:02"
+		vmi := libvmi.New(
+			libvmi.WithFirmwareUUID("template-uuid"),
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vmi.Spec.Domain.Machine = &v1.Machine{Type: "template-machine"}
+
+		defaults.ApplySealedDefaults(&vmi.Spec, sealed)
+		Expect(vmi.Spec.Domain.Firmware.UUID).To(BeEquivalentTo("template-uuid"))
+		Expect(vmi.Spec.Domain.Machine.Type).To(Equal("template-machine"))
+		Expect(vmi.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:02"))
+	})
+
+	Context("PCI addresses", func() {
+		sealedPCI := &v1.VirtualMachineSealedDefaults{
+			Interfaces: []v1.SealedInterface{{Name: "default", PCIAddress: "0000:01:00.0"}},
+			Disks: []v1.SealedDisk{
+				{Name: "rootdisk", PCIAddress: "0000:07:00.0"},
+				{Name: "datadisk", PCIAddress: "0000:08:00.0"},
+				{Name: "satadisk", PCIAddress: "0000:09:00.0"},
+			},
+		}
+
+		newDisk := func(name string, bus v1.DiskBus) v1.Disk {
+			return v1.Disk{Name: name, DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: bus}}}
+		}
+
+		It("should place interfaces and virtio disks on their sealed PCI address", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				newDisk("rootdisk", ""), newDisk("datadisk", v1.DiskBusVirtio), newDisk("satadisk", v1.DiskBusSATA),
+			}
+
+			defaults.ApplySealedDefaults(&vmi.Spec, sealedPCI)
+			Expect(vmi.Spec.Domain.Devices.Interfaces[0].PciAddress).To(Equal("0000:01:00.0"))
+			Expect(vmi.Spec.Domain.Devices.Disks[0].Disk.PciAddress).To(Equal("0000:07:00.0"))
+			Expect(vmi.Spec.Domain.Devices.Disks[1].Disk.PciAddress).To(Equal("0000:08:00.0"))
+			Expect(vmi.Spec.Domain.Devices.Disks[2].Disk.PciAddress).To(BeEmpty())
+		})
+
+		It("should not place a device on a PCI address which is used by the template", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+			pinned := newDisk("datadisk", v1.DiskBusVirtio)
+			pinned.Disk.PciAddress = "0000:07:00.0"
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{newDisk("rootdisk", v1.DiskBusVirtio), pinned}
+
+			defaults.ApplySealedDefaults(&vmi.Spec, sealedPCI)
+			Expect(vmi.Spec.Domain.Devices.Disks[0].Disk.PciAddress).To(BeEmpty())
+			Expect(vmi.Spec.Domain.Devices.Disks[1].Disk.PciAddress).To(Equal("0000:07:00.0"))
+		})
+
+		It("should keep the sealed PCI addresses of devices which are still in the template", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			))
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{newDisk("rootdisk", v1.DiskBusVirtio)}
+			vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{
+				Interfaces: []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+			}
+			oldVM := vm.DeepCopy()
+			oldVM.Spec.Sealed = sealedPCI.DeepCopy()
+
+			defaults.KeepSealedDefaults(vm, oldVM)
+			Expect(vm.Spec.Sealed.Interfaces).To(Equal([]v1.SealedInterface{
+				{Name: "default", MACAddress: "02:00:00:00:00:01", PCIAddress: "0000:01:00.0"},
+			}))
+			Expect(vm.Spec.Sealed.Disks).To(Equal([]v1.SealedDisk{{Name: "rootdisk", PCIAddress: "0000:07:00.0"}}))
+		})
+	})
+
+	It("should generate the same locally administered MAC address for a VM and interface", func() {
+		vm := &v1.VirtualMachine{}
+		vm.UID = "vm-uid"
+
+		mac := defaults.GenerateSealedMACAddress(vm, "default")
+		Expect(defaults.GenerateSealedMACAddress(vm, "default")).To(Equal(mac))
+		Expect(defaults.GenerateSealedMACAddress(vm, "other")).ToNot(Equal(mac))
+
+		hwAddr, err := net.ParseMAC(mac)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hwAddr[0] & 0x02).To(BeEquivalentTo(0x02))
+		Expect(hwAddr[0] & 0x01).To(BeZero())
+	})
+})
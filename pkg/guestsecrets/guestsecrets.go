@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestsecrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+)
+
+const (
+	// FWCfgPrefix is prepended to the name of a guest secret to build the name
+	// of its fw_cfg blob. The guest finds the blob in
+	// /sys/firmware/qemu_fw_cfg/by_name/opt/io.kubevirt/<name>/raw.
+	FWCfgPrefix = "opt/io.kubevirt/"
+	// MaxNameLength keeps the fw_cfg blob names below the 56 bytes QEMU
+	// allows, including the prefix and the terminating NUL.
+	MaxNameLength = 39
+
+	// MaxFWCfgSize is the largest secret delivered through fw_cfg.
+	MaxFWCfgSize = 4096
+
+	volumeSuffix = "-guest-secret"
+)
+
+// VolumeName returns the name of the pod volume of the secret.
+func VolumeName(secretName string) string {
+	return secretName + volumeSuffix
+}
+
+// SourcePath returns the path of the secret key in the virt-launcher pod.
+func SourcePath(guestSecret *v1.GuestSecret) string {
+	return filepath.Join(config.SecretSourceDir, VolumeName(guestSecret.SecretName), guestSecret.Key)
+}
+
+// IsFWCfg returns true if the guest secret is delivered through fw_cfg.
+func IsFWCfg(guestSecret *v1.GuestSecret) bool {
+	return guestSecret.Target == "" || guestSecret.Target == v1.GuestSecretTargetFWCfg
+}
+
+// FWCfgName returns the name of the fw_cfg blob of the guest secret.
+func FWCfgName(guestSecret *v1.GuestSecret) string {
+	return FWCfgPrefix + guestSecret.Name
+}
+
+// Read reads the value of the guest secret from the pod and verifies that
+// it fits into the target in the guest.
+func Read(guestSecret *v1.GuestSecret) ([]byte, error) {
+	data, err := os.ReadFile(SourcePath(guestSecret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read guest secret %s: %v", guestSecret.Name, err)
+	}
+	if len(data) == 0 || len(data) > MaxFWCfgSize {
+		return nil, fmt.Errorf("guest secret %s has %d bytes, expected between 1 and %d", guestSecret.Name, len(data), MaxFWCfgSize)
+	}
+	return data, nil
+}
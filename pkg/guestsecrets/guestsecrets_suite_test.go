@@ -0,0 +1,11 @@
+package guestsecrets_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuestSecrets(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package guestsecrets_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/guestsecrets"
+)
+
+var _ = Describe("Guest secrets", func() {
+	var fwCfgSecret *v1.GuestSecret
+
+	BeforeEach(func() {
+		originalSecretSourceDir := config.SecretSourceDir
+		config.SecretSourceDir = GinkgoT().TempDir()
+		DeferCleanup(func() { config.SecretSourceDir = originalSecretSourceDir })
+
+		fwCfgSecret = &v1.GuestSecret{Name: "token", SecretName: "bootstrap", Key: "token", Target: v1.GuestSecretTargetFWCfg}
+	})
+
+	writeSecret := func(key, value string) {
+		dir := filepath.Join(config.SecretSourceDir, guestsecrets.VolumeName("bootstrap"))
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, key), []byte(value), 0600)).To(Succeed())
+	}
+
+	It("should locate the secret in the pod and in the guest", func() {
+		Expect(guestsecrets.SourcePath(fwCfgSecret)).To(Equal(filepath.Join(config.SecretSourceDir, "bootstrap-guest-secret", "token")))
+		Expect(guestsecrets.FWCfgName(fwCfgSecret)).To(Equal("opt/io.kubevirt/token"))
+	})
+
+	DescribeTable("should read secrets", func(value string, expectedErr string) {
+		writeSecret(fwCfgSecret.Key, value)
+		data, err := guestsecrets.Read(fwCfgSecret)
+		if expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(Equal([]byte(value)))
+	},
+		Entry("fitting into fw_cfg", strings.Repeat("a", 4096), ""),
+		Entry("but reject empty ones", "", "has 0 bytes"),
+		Entry("but reject too large ones", strings.Repeat("a", 4097), "expected between 1 and 4096"),
+	)
+
+	It("should fail to read a missing secret", func() {
+		_, err := guestsecrets.Read(fwCfgSecret)
+		Expect(err).To(MatchError(ContainSubstring("failed to read guest secret token")))
+	})
+})
@@ -44,6 +44,12 @@ It has these top-level messages:
 	DirtyRateStatsResponse
 	ScreenshotResponse
 	BackupRequest
+	RebootRequest
+	TPMAttestationRequest
+	TPMAttestationResponse
+	SEVSNPAttestationReportRequest
+	SEVSNPAttestationReportResponse
+	VNCResolutionRequest
 */
 package v1
 
@@ -433,6 +439,7 @@ type VirtualMachineOptions struct {
 	ClusterConfig             *ClusterConfig                        `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
 	InterfaceDomainAttachment map[string]string                     `protobuf:"bytes,8,rep,name=interfaceDomainAttachment" json:"interfaceDomainAttachment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	InterfaceMigration        map[string]*InterfaceBindingMigration `protobuf:"bytes,9,rep,name=interfaceMigration" json:"interfaceMigration,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AutoUnpauseTimeoutSeconds int32                                 `protobuf:"varint,10,opt,name=autoUnpauseTimeoutSeconds" json:"autoUnpauseTimeoutSeconds,omitempty"`
 }
 
 func (m *VirtualMachineOptions) Reset()                    { *m = VirtualMachineOptions{} }
@@ -503,6 +510,13 @@ func (m *VirtualMachineOptions) GetInterfaceMigration() map[string]*InterfaceBin
 	return nil
 }
 
+func (m *VirtualMachineOptions) GetAutoUnpauseTimeoutSeconds() int32 {
+	if m != nil {
+		return m.AutoUnpauseTimeoutSeconds
+	}
+	return 0
+}
+
 type VMIRequest struct {
 	Vmi     *VMI                   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	Options *VirtualMachineOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
@@ -1015,6 +1029,154 @@ func (m *BackupRequest) GetOptions() []byte {
 	return nil
 }
 
+type RebootRequest struct {
+	Vmi    *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method" json:"method,omitempty"`
+}
+
+func (m *RebootRequest) Reset()                    { *m = RebootRequest{} }
+func (m *RebootRequest) String() string            { return proto.CompactTextString(m) }
+func (*RebootRequest) ProtoMessage()               {}
+func (*RebootRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *RebootRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *RebootRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+type TPMAttestationRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *TPMAttestationRequest) Reset()                    { *m = TPMAttestationRequest{} }
+func (m *TPMAttestationRequest) String() string            { return proto.CompactTextString(m) }
+func (*TPMAttestationRequest) ProtoMessage()               {}
+func (*TPMAttestationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *TPMAttestationRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *TPMAttestationRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type TPMAttestationResponse struct {
+	Response    *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Attestation []byte    `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (m *TPMAttestationResponse) Reset()                    { *m = TPMAttestationResponse{} }
+func (m *TPMAttestationResponse) String() string            { return proto.CompactTextString(m) }
+func (*TPMAttestationResponse) ProtoMessage()               {}
+func (*TPMAttestationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *TPMAttestationResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TPMAttestationResponse) GetAttestation() []byte {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+type SEVSNPAttestationReportRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *SEVSNPAttestationReportRequest) Reset()         { *m = SEVSNPAttestationReportRequest{} }
+func (m *SEVSNPAttestationReportRequest) String() string { return proto.CompactTextString(m) }
+func (*SEVSNPAttestationReportRequest) ProtoMessage()    {}
+func (*SEVSNPAttestationReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{37}
+}
+
+func (m *SEVSNPAttestationReportRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *SEVSNPAttestationReportRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type SEVSNPAttestationReportResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Report   []byte    `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *SEVSNPAttestationReportResponse) Reset()         { *m = SEVSNPAttestationReportResponse{} }
+func (m *SEVSNPAttestationReportResponse) String() string { return proto.CompactTextString(m) }
+func (*SEVSNPAttestationReportResponse) ProtoMessage()    {}
+func (*SEVSNPAttestationReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{38}
+}
+
+func (m *SEVSNPAttestationReportResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *SEVSNPAttestationReportResponse) GetReport() []byte {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+type VNCResolutionRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *VNCResolutionRequest) Reset()                    { *m = VNCResolutionRequest{} }
+func (m *VNCResolutionRequest) String() string            { return proto.CompactTextString(m) }
+func (*VNCResolutionRequest) ProtoMessage()               {}
+func (*VNCResolutionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *VNCResolutionRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *VNCResolutionRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1050,6 +1212,12 @@ func init() {
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
 	proto.RegisterType((*ScreenshotResponse)(nil), "kubevirt.cmd.v1.ScreenshotResponse")
 	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
+	proto.RegisterType((*RebootRequest)(nil), "kubevirt.cmd.v1.RebootRequest")
+	proto.RegisterType((*TPMAttestationRequest)(nil), "kubevirt.cmd.v1.TPMAttestationRequest")
+	proto.RegisterType((*TPMAttestationResponse)(nil), "kubevirt.cmd.v1.TPMAttestationResponse")
+	proto.RegisterType((*SEVSNPAttestationReportRequest)(nil), "kubevirt.cmd.v1.SEVSNPAttestationReportRequest")
+	proto.RegisterType((*SEVSNPAttestationReportResponse)(nil), "kubevirt.cmd.v1.SEVSNPAttestationReportResponse")
+	proto.RegisterType((*VNCResolutionRequest)(nil), "kubevirt.cmd.v1.VNCResolutionRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1097,6 +1265,11 @@ type CmdClient interface {
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	RebootVirtualMachine(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*Response, error)
+	GetTPMAttestation(ctx context.Context, in *TPMAttestationRequest, opts ...grpc.CallOption) (*TPMAttestationResponse, error)
+	GetSEVSNPAttestationReport(ctx context.Context, in *SEVSNPAttestationReportRequest, opts ...grpc.CallOption) (*SEVSNPAttestationReportResponse, error)
+	SetVNCResolution(ctx context.Context, in *VNCResolutionRequest, opts ...grpc.CallOption) (*Response, error)
+	ResizeVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1413,6 +1586,51 @@ func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest,
 	return out, nil
 }
 
+func (c *cmdClient) RebootVirtualMachine(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/RebootVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetTPMAttestation(ctx context.Context, in *TPMAttestationRequest, opts ...grpc.CallOption) (*TPMAttestationResponse, error) {
+	out := new(TPMAttestationResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetTPMAttestation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetSEVSNPAttestationReport(ctx context.Context, in *SEVSNPAttestationReportRequest, opts ...grpc.CallOption) (*SEVSNPAttestationReportResponse, error) {
+	out := new(SEVSNPAttestationReportResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetSEVSNPAttestationReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SetVNCResolution(ctx context.Context, in *VNCResolutionRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SetVNCResolution", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) ResizeVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/ResizeVirtualMachineMemory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1450,6 +1668,11 @@ type CmdServer interface {
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	RebootVirtualMachine(context.Context, *RebootRequest) (*Response, error)
+	GetTPMAttestation(context.Context, *TPMAttestationRequest) (*TPMAttestationResponse, error)
+	GetSEVSNPAttestationReport(context.Context, *SEVSNPAttestationReportRequest) (*SEVSNPAttestationReportResponse, error)
+	SetVNCResolution(context.Context, *VNCResolutionRequest) (*Response, error)
+	ResizeVirtualMachineMemory(context.Context, *VMIRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2068,6 +2291,96 @@ func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_RebootVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).RebootVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/RebootVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).RebootVirtualMachine(ctx, req.(*RebootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetTPMAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TPMAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetTPMAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetTPMAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetTPMAttestation(ctx, req.(*TPMAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetSEVSNPAttestationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SEVSNPAttestationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetSEVSNPAttestationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetSEVSNPAttestationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetSEVSNPAttestationReport(ctx, req.(*SEVSNPAttestationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SetVNCResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VNCResolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SetVNCResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SetVNCResolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SetVNCResolution(ctx, req.(*VNCResolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_ResizeVirtualMachineMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).ResizeVirtualMachineMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/ResizeVirtualMachineMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).ResizeVirtualMachineMemory(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2208,6 +2521,26 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BackupVirtualMachine",
 			Handler:    _Cmd_BackupVirtualMachine_Handler,
 		},
+		{
+			MethodName: "RebootVirtualMachine",
+			Handler:    _Cmd_RebootVirtualMachine_Handler,
+		},
+		{
+			MethodName: "GetTPMAttestation",
+			Handler:    _Cmd_GetTPMAttestation_Handler,
+		},
+		{
+			MethodName: "GetSEVSNPAttestationReport",
+			Handler:    _Cmd_GetSEVSNPAttestationReport_Handler,
+		},
+		{
+			MethodName: "SetVNCResolution",
+			Handler:    _Cmd_SetVNCResolution_Handler,
+		},
+		{
+			MethodName: "ResizeVirtualMachineMemory",
+			Handler:    _Cmd_ResizeVirtualMachineMemory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2216,125 +2549,137 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x17, 0x45, 0x4a, 0x22, 0x57, 0x7f, 0x62, 0x9f, 0x25, 0x19, 0x52, 0x6b, 0x5b, 0xbd, 0x76,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x06, 0x25, 0x79, 0x9a, 0x36, 0x93, 0x39, 0x01, 0x27, 0xf2, 0x2a, 0xe0, 0x8e, 0xc1, 0x1d,
-	0x58, 0x33, 0x4f, 0x9d, 0x71, 0xa7, 0x0f, 0x9d, 0xe9, 0xe7, 0x6b, 0x9f, 0xfa, 0x2d, 0xfa, 0x9e,
-	0xb9, 0x03, 0x40, 0x81, 0x04, 0x20, 0x5a, 0x43, 0x3e, 0x11, 0x77, 0xb7, 0xfb, 0xdb, 0xbd, 0xbd,
-	0xdd, 0xbd, 0x1f, 0x40, 0xf8, 0xb8, 0x77, 0xd5, 0xd9, 0xef, 0x12, 0xee, 0x7a, 0x34, 0xf8, 0xd4,
-	0x23, 0x21, 0x77, 0xba, 0x34, 0xf8, 0xd4, 0x11, 0xfe, 0xbe, 0xe3, 0xbb, 0xfb, 0xfd, 0x27, 0xfa,
-	0x67, 0xaf, 0x17, 0x08, 0x25, 0xd0, 0x47, 0x57, 0xe1, 0x05, 0xed, 0xb3, 0x40, 0xed, 0xe9, 0xb9,
-	0xfe, 0x13, 0x7c, 0x09, 0xf7, 0xde, 0x50, 0x3f, 0x3c, 0xa7, 0x81, 0x64, 0x82, 0xdb, 0x54, 0xf6,
-	0x04, 0x97, 0x14, 0x7d, 0x0e, 0xd5, 0x20, 0x7e, 0xb6, 0x4a, 0x3b, 0xa5, 0xdd, 0xe5, 0xa7, 0x5b,
-	0x7b, 0x63, 0xaa, 0x7b, 0x89, 0xb0, 0x3d, 0x14, 0x45, 0x16, 0x2c, 0xf5, 0x23, 0x24, 0x6b, 0x7e,
-	0xa7, 0xb4, 0x5b, 0xb3, 0x93, 0x21, 0x7e, 0x04, 0xe5, 0xf3, 0xe6, 0x89, 0x11, 0xf0, 0xd9, 0x37,
-	0x52, 0x70, 0x03, 0xbb, 0x62, 0x27, 0x43, 0xfc, 0x04, 0xca, 0xf5, 0xd6, 0x19, 0x5a, 0x83, 0x79,
-	0xe6, 0x9a, 0xb5, 0x55, 0x7b, 0x9e, 0xb9, 0x68, 0x1b, 0xaa, 0x92, 0x5d, 0x78, 0x8c, 0x77, 0xa4,
-	0x35, 0xbf, 0x53, 0xde, 0x5d, 0xb5, 0x87, 0x63, 0xbc, 0x0f, 0x4b, 0xed, 0xe8, 0x39, 0xa3, 0xb6,
-	0x0e, 0x0b, 0x7d, 0xe2, 0x85, 0xd4, 0xb8, 0x51, 0xb1, 0xa3, 0x01, 0x6e, 0xc0, 0x42, 0x8b, 0x74,
-	0xa8, 0xd4, 0xcb, 0x8e, 0x08, 0xb9, 0x32, 0x1a, 0x15, 0x3b, 0x1a, 0x20, 0x04, 0x95, 0x90, 0x33,
-	0x15, 0xbb, 0x6e, 0x9e, 0xf5, 0x9c, 0x64, 0x3f, 0x51, 0xab, 0x6c, 0xa0, 0xcd, 0x33, 0x7e, 0x06,
-	0x8b, 0x4d, 0xea, 0x8b, 0x60, 0x80, 0x36, 0x61, 0x91, 0xf8, 0x29, 0xa0, 0x78, 0x94, 0x87, 0x84,
-	0xff, 0x53, 0x82, 0x4a, 0x9d, 0x7a, 0x5e, 0xc6, 0xd7, 0x7d, 0x58, 0xf4, 0x0d, 0x9c, 0x11, 0x5f,
-	0x7e, 0x7a, 0x3f, 0x13, 0xe9, 0xc8, 0x9a, 0x1d, 0x8b, 0xa1, 0x4f, 0x60, 0xa1, 0xa7, 0xb7, 0x61,
-	0x95, 0x77, 0xca, 0xbb, 0xcb, 0x4f, 0x37, 0x33, 0xf2, 0x66, 0x93, 0x76, 0x24, 0x84, 0xbe, 0x80,
-	0x9a, 0xcb, 0xa4, 0x22, 0xdc, 0xa1, 0xd2, 0xaa, 0x18, 0x0d, 0x2b, 0xa3, 0x11, 0xc7, 0xd1, 0xbe,
-	0x16, 0x45, 0xbb, 0x50, 0x71, 0x7a, 0xa1, 0xb4, 0x16, 0x8c, 0xca, 0x7a, 0x46, 0xa5, 0xde, 0x3a,
-	0xb3, 0x8d, 0x04, 0x7e, 0x01, 0xd5, 0x53, 0xd1, 0x13, 0x9e, 0xe8, 0x0c, 0xd0, 0x33, 0x00, 0x1e,
-	0xfa, 0xe4, 0x07, 0x87, 0x7a, 0x9e, 0xb4, 0x4a, 0x46, 0x77, 0x23, 0xab, 0x4b, 0x3d, 0xcf, 0xae,
-	0x69, 0x41, 0xfd, 0x24, 0xf1, 0xbf, 0x4a, 0xb0, 0xd8, 0x6e, 0x1e, 0x30, 0x21, 0x11, 0x86, 0x15,
-	0x9f, 0xf0, 0xf0, 0x92, 0x38, 0x2a, 0x0c, 0x68, 0x60, 0xe2, 0x54, 0xb3, 0x47, 0xe6, 0x74, 0x16,
-	0xf5, 0x02, 0xe1, 0x86, 0x4e, 0x12, 0xe1, 0x64, 0x98, 0x4e, 0xc0, 0xf2, 0x48, 0x02, 0xa2, 0x3b,
-	0x50, 0x96, 0x57, 0xa1, 0x55, 0x31, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x25, 0xf1, 0x99, 0x37, 0xb0,
-	0x16, 0xcc, 0x64, 0x3c, 0xc2, 0xff, 0x2c, 0x41, 0xf5, 0x90, 0xc9, 0xab, 0x13, 0x7e, 0x29, 0x8c,
-	0x90, 0x08, 0x7c, 0xa2, 0x62, 0x47, 0xe2, 0x11, 0xda, 0x81, 0xe5, 0x0b, 0xe2, 0x5c, 0x31, 0xde,
-	0x39, 0x62, 0x1e, 0x8d, 0xdd, 0x48, 0x4f, 0xa1, 0x87, 0x00, 0xda, 0x5f, 0xe2, 0xb5, 0x93, 0xfc,
-	0xa9, 0xd8, 0xa9, 0x19, 0x8d, 0xa0, 0x43, 0x92, 0x08, 0x54, 0x8c, 0x40, 0x7a, 0x0a, 0xff, 0xbf,
-	0x04, 0xab, 0x75, 0x2f, 0x94, 0x8a, 0x06, 0x75, 0xc1, 0x2f, 0x59, 0x07, 0xed, 0x01, 0x6a, 0xbc,
-	0xeb, 0x11, 0xee, 0x6a, 0xff, 0x64, 0x83, 0x93, 0x0b, 0x8f, 0x46, 0xa9, 0x54, 0xb5, 0x73, 0x56,
-	0xd0, 0x1f, 0x61, 0xeb, 0x28, 0xa0, 0x54, 0xe7, 0x83, 0x4d, 0x7b, 0x22, 0x50, 0x8c, 0x77, 0x0e,
-	0x99, 0x8c, 0xd4, 0xe6, 0x8d, 0x5a, 0xb1, 0x00, 0x7a, 0x0e, 0xd6, 0x81, 0x70, 0xba, 0xf2, 0x90,
-	0xc9, 0x9e, 0x47, 0x06, 0x47, 0x22, 0x68, 0x1c, 0x9d, 0x1c, 0x87, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0xb5, 0x0b, 0xd7, 0xb5, 0x6e, 0x9b, 0x06, 0x8c, 0x78, 0x75, 0xc1, 0xa5, 0xf0, 0xe8, 0x4b, 0x71,
-	0x6d, 0xb8, 0x12, 0xe9, 0x16, 0xad, 0xe3, 0xcf, 0x60, 0xeb, 0x84, 0x2b, 0x1a, 0x5c, 0x12, 0x87,
-	0x1e, 0x30, 0xee, 0x32, 0xde, 0x69, 0xb2, 0x4e, 0x40, 0x94, 0x3e, 0xc7, 0x4d, 0x5d, 0x7c, 0xaa,
-	0x2b, 0xdc, 0xe4, 0x40, 0xa2, 0x11, 0xfe, 0xdf, 0x12, 0x6c, 0x9c, 0x47, 0xc1, 0x6b, 0x12, 0xa7,
-	0xcb, 0x38, 0x7d, 0xdd, 0xd3, 0x0a, 0x12, 0x7d, 0x0b, 0xeb, 0xa3, 0x0b, 0x51, 0xa6, 0xc5, 0x7d,
-	0x2d, 0x5b, 0x6d, 0xd1, 0xb2, 0x9d, 0xab, 0x84, 0x9e, 0xc1, 0x46, 0x93, 0xfa, 0x07, 0xc4, 0xf3,
-	0x84, 0xe0, 0x6d, 0x45, 0x94, 0x6c, 0xd1, 0x80, 0x89, 0x28, 0x9a, 0xab, 0x76, 0xfe, 0x22, 0xfa,
-	0x3d, 0xdc, 0x6b, 0x05, 0x54, 0xcf, 0x3b, 0x44, 0x51, 0xf7, 0x5c, 0x78, 0xa1, 0x1f, 0xd7, 0x6f,
-	0xcd, 0xce, 0x5b, 0xd2, 0x0d, 0x58, 0xc5, 0x35, 0x65, 0xe2, 0x95, 0xd7, 0x80, 0x93, 0xa2, 0xb3,
-	0x87, 0xa2, 0xa8, 0x0d, 0x35, 0x93, 0x00, 0x3a, 0x77, 0xe3, 0xca, 0xfd, 0x3c, 0xa3, 0x97, 0x1b,
-	0xa6, 0xbd, 0xa1, 0x5e, 0x83, 0xab, 0x60, 0x60, 0x5f, 0xe3, 0x14, 0x64, 0xdd, 0x62, 0x61, 0xd6,
-	0x1d, 0xc2, 0xaa, 0x93, 0x4e, 0x5b, 0x6b, 0xc9, 0x6c, 0xe0, 0x61, 0xb6, 0x0d, 0xa4, 0xa5, 0xec,
-	0x51, 0x25, 0xf4, 0xbe, 0x04, 0x5b, 0x2c, 0x49, 0x83, 0x43, 0xe1, 0x13, 0xc6, 0xbf, 0x52, 0x8a,
-	0x38, 0x5d, 0x9f, 0x72, 0x65, 0x55, 0xcd, 0xde, 0x1a, 0x1f, 0xb8, 0xb7, 0x93, 0x22, 0x9c, 0x68,
-	0xaf, 0xc5, 0x76, 0x10, 0x07, 0x34, 0x5c, 0x1c, 0x26, 0xa1, 0x55, 0x33, 0xd6, 0xbf, 0xbc, 0xad,
-	0xf5, 0x21, 0x40, 0x64, 0x36, 0x07, 0x79, 0xfb, 0x2d, 0xac, 0x8d, 0x1e, 0x84, 0x6e, 0x5c, 0x57,
-	0x74, 0x10, 0x67, 0xbb, 0x7e, 0x44, 0xfb, 0xe9, 0xcb, 0x2d, 0x2f, 0x31, 0x92, 0xee, 0x15, 0xdf,
-	0x7b, 0xcf, 0xe7, 0xff, 0x50, 0xda, 0x7e, 0x09, 0x0f, 0x6f, 0x8e, 0x42, 0x8e, 0xa1, 0x91, 0x5b,
-	0xb4, 0x96, 0x46, 0xfb, 0x11, 0xee, 0x17, 0xec, 0x2a, 0x07, 0xe6, 0xc5, 0xa8, 0xbf, 0xbf, 0xcb,
-	0xf8, 0x5b, 0x58, 0xed, 0x29, 0x93, 0xb8, 0x0f, 0x70, 0xde, 0x3c, 0xb1, 0xe9, 0x8f, 0xba, 0xc1,
-	0xa0, 0xc7, 0x50, 0xee, 0xfb, 0x2c, 0xae, 0xe1, 0xec, 0xe5, 0xa4, 0x25, 0xb5, 0x00, 0x7a, 0x01,
-	0x4b, 0x22, 0x3a, 0x86, 0xd8, 0xfa, 0xe3, 0x0f, 0x3b, 0x34, 0x3b, 0x51, 0xc3, 0xa7, 0x70, 0xe7,
-	0xda, 0x9f, 0x5b, 0x5a, 0xb7, 0x46, 0xad, 0xaf, 0x5c, 0xa3, 0xbe, 0x2f, 0xc1, 0x72, 0xe3, 0x1d,
-	0x75, 0x12, 0xc4, 0x87, 0x00, 0xae, 0x39, 0x95, 0x57, 0xc4, 0xa7, 0x71, 0xf0, 0x52, 0x33, 0x1a,
-	0xa9, 0x2e, 0x7c, 0x9f, 0x70, 0x37, 0xb9, 0xf2, 0xe2, 0xa1, 0xe6, 0x1a, 0x5f, 0x05, 0x9d, 0xa4,
-	0x99, 0x98, 0x67, 0xf4, 0x18, 0xd6, 0x14, 0xf3, 0xa9, 0x08, 0x55, 0x9b, 0x3a, 0x82, 0xbb, 0xd2,
-	0xf4, 0x90, 0x05, 0x7b, 0x6c, 0x16, 0xaf, 0xc1, 0x4a, 0xc3, 0xef, 0xa9, 0x41, 0xec, 0x05, 0xfe,
-	0x12, 0xaa, 0x76, 0x8a, 0xcb, 0xc9, 0xd0, 0x71, 0xa8, 0x94, 0xf1, 0x05, 0x93, 0x0c, 0xf5, 0x8a,
-	0x4f, 0xa5, 0x24, 0x9d, 0x24, 0x31, 0x92, 0x21, 0xfe, 0x01, 0xd6, 0xa2, 0xdc, 0x9a, 0x96, 0x48,
-	0x6e, 0xc2, 0x62, 0xb4, 0xf9, 0xd8, 0x42, 0x3c, 0xc2, 0x1c, 0xee, 0x45, 0x06, 0x4c, 0x77, 0x9d,
-	0xd6, 0xca, 0x0e, 0x2c, 0xbb, 0xd7, 0x68, 0xc9, 0x25, 0x9e, 0x9a, 0xc2, 0xef, 0xe0, 0xae, 0xb9,
-	0xd0, 0x4c, 0x35, 0x4d, 0x69, 0xed, 0x13, 0xb8, 0xdb, 0x19, 0xc7, 0x8a, 0x6d, 0x66, 0x17, 0xf0,
-	0x3f, 0x4a, 0xb0, 0x61, 0x4c, 0x9f, 0x49, 0x1a, 0xbc, 0x64, 0x52, 0x4d, 0x6b, 0xfe, 0x19, 0x6c,
-	0x74, 0xf2, 0xf0, 0x62, 0x17, 0xf2, 0x17, 0xf1, 0xbf, 0x4b, 0x60, 0x19, 0x37, 0x34, 0xa7, 0x91,
-	0x03, 0xa9, 0xa8, 0x3f, 0x75, 0xd8, 0x9f, 0x83, 0xd5, 0x29, 0x80, 0x8c, 0x9d, 0x29, 0x5c, 0xc7,
-	0x03, 0x58, 0x89, 0xca, 0x66, 0x3a, 0x17, 0xb6, 0xa1, 0x4a, 0xdf, 0x31, 0x55, 0x17, 0x6e, 0x64,
-	0x72, 0xc1, 0x1e, 0x8e, 0x75, 0xee, 0x49, 0xe5, 0xbe, 0x0e, 0x55, 0x4c, 0x21, 0xe3, 0x11, 0xfe,
-	0x0e, 0xee, 0x98, 0x48, 0xb4, 0x34, 0x51, 0xfe, 0xc0, 0xb2, 0xcd, 0x16, 0xe2, 0x7c, 0x6e, 0x21,
-	0x7e, 0x13, 0xe7, 0x59, 0x84, 0x3d, 0xd5, 0xde, 0xb0, 0x80, 0x55, 0xcd, 0xe9, 0x7e, 0xa2, 0xb7,
-	0xed, 0x56, 0x5f, 0xc0, 0x66, 0xc8, 0x2f, 0x8d, 0xea, 0x69, 0x9e, 0xd3, 0x05, 0xab, 0xf8, 0x2d,
-	0xdc, 0x8d, 0xde, 0x50, 0x0e, 0x43, 0xbf, 0x77, 0x5b, 0xa3, 0xdb, 0x50, 0x75, 0x43, 0xbf, 0xd7,
-	0x22, 0xaa, 0x1b, 0x1f, 0xfe, 0x70, 0x8c, 0x2f, 0xe0, 0xa3, 0x76, 0xe3, 0x7c, 0x16, 0xb5, 0xa7,
-	0x9b, 0x19, 0xed, 0x1b, 0x56, 0x14, 0x37, 0xe2, 0x78, 0x88, 0xff, 0x5e, 0x82, 0xad, 0x97, 0xe6,
-	0x9d, 0xb9, 0x49, 0x89, 0x0c, 0x03, 0xaa, 0x2f, 0xc4, 0x19, 0x94, 0xba, 0x37, 0x8e, 0x19, 0x1b,
-	0xce, 0x2e, 0xe0, 0xef, 0x35, 0xdf, 0xfd, 0x2b, 0x75, 0x54, 0xe4, 0x47, 0x9b, 0x3a, 0x01, 0x55,
-	0xb3, 0xbb, 0x6a, 0x24, 0x6c, 0x1e, 0xb2, 0x40, 0x0d, 0x6c, 0xa2, 0xe8, 0x4c, 0xda, 0x26, 0x86,
-	0x15, 0x37, 0x01, 0x6c, 0x5e, 0x44, 0xf6, 0xca, 0xf6, 0xc8, 0x1c, 0x96, 0x80, 0xda, 0x4e, 0x40,
-	0x29, 0x97, 0x5d, 0x31, 0x75, 0x38, 0x11, 0x54, 0x7c, 0xe6, 0x27, 0xcd, 0xc1, 0x3c, 0xeb, 0x39,
-	0x97, 0x28, 0x62, 0x6a, 0x74, 0xc5, 0x36, 0xcf, 0xf8, 0x0d, 0xac, 0x1e, 0x10, 0xe7, 0x2a, 0xec,
-	0xcd, 0x2c, 0x78, 0x4f, 0xff, 0xbb, 0x09, 0xe5, 0xba, 0xef, 0xa2, 0x57, 0x80, 0xda, 0x03, 0xee,
-	0x8c, 0x72, 0x05, 0xf4, 0x8b, 0x5c, 0xc8, 0xc8, 0xf8, 0x76, 0xf1, 0xd6, 0xf0, 0x1c, 0x7a, 0x0d,
-	0xf7, 0x5a, 0x24, 0x94, 0x74, 0x66, 0x80, 0x6f, 0x60, 0xe3, 0x8c, 0xf7, 0x66, 0x0a, 0xd9, 0x86,
-	0xf5, 0xa8, 0x91, 0x8c, 0x21, 0x66, 0x89, 0xfc, 0x48, 0xbf, 0xb9, 0x19, 0xd4, 0x86, 0xcd, 0xb3,
-	0xb8, 0x8d, 0xcc, 0x32, 0x98, 0x36, 0x95, 0x54, 0xcd, 0x0c, 0xf0, 0x14, 0xac, 0xb6, 0xb8, 0x54,
-	0x36, 0xbd, 0x10, 0x62, 0x76, 0xa8, 0x36, 0x6c, 0xb6, 0xbb, 0xa1, 0x72, 0xc5, 0xdf, 0xf8, 0xcc,
-	0x30, 0x5f, 0x01, 0xfa, 0x96, 0x79, 0xde, 0xcc, 0xf0, 0x5a, 0xb0, 0x7e, 0x48, 0x3d, 0xaa, 0x66,
-	0x77, 0x38, 0x6f, 0x61, 0x23, 0xe2, 0xcf, 0xe3, 0x90, 0xbf, 0xca, 0x7e, 0xe7, 0x1a, 0xe3, 0xd9,
-	0x13, 0x4f, 0x5d, 0x97, 0xe4, 0x50, 0xe9, 0x94, 0x04, 0x1d, 0xaa, 0xa6, 0xf0, 0xf4, 0x4f, 0xf0,
-	0xa0, 0x4e, 0xb8, 0x43, 0xc7, 0xa2, 0x79, 0xfd, 0xed, 0x61, 0xba, 0xa3, 0x67, 0x1d, 0x4e, 0xbc,
-	0xc8, 0xc9, 0x96, 0x70, 0xeb, 0x1e, 0x25, 0x3c, 0xec, 0x4d, 0x81, 0xf9, 0x67, 0x78, 0x74, 0xc4,
-	0x38, 0xf1, 0xd8, 0x78, 0x25, 0xcd, 0xc2, 0xe1, 0x57, 0x80, 0xbe, 0x16, 0xaa, 0xe7, 0x85, 0x9d,
-	0xaf, 0x85, 0x54, 0x87, 0xb4, 0xcf, 0x1c, 0x2a, 0xa7, 0xc0, 0x6b, 0x42, 0xed, 0x98, 0xaa, 0x88,
-	0xbb, 0xa3, 0x07, 0x19, 0xc9, 0xf4, 0x5b, 0xc8, 0xf6, 0xa3, 0xec, 0x0b, 0xed, 0xc8, 0x4b, 0x85,
-	0x49, 0xaa, 0xb5, 0x21, 0x9c, 0xb9, 0xd3, 0x26, 0x61, 0xfe, 0xa6, 0x00, 0x73, 0xe4, 0x42, 0x34,
-	0x3d, 0x6f, 0xe5, 0x98, 0xaa, 0x21, 0xe7, 0x9f, 0x04, 0x8b, 0x33, 0xcb, 0x99, 0xd7, 0x05, 0x03,
-	0x5a, 0x3d, 0xa6, 0x86, 0x5b, 0x4f, 0xf4, 0xf3, 0x71, 0x3e, 0x60, 0x86, 0x97, 0xcf, 0xa1, 0xbf,
-	0x98, 0x10, 0xa4, 0x38, 0xf2, 0x24, 0xe8, 0x8f, 0xf3, 0xa1, 0xf3, 0x58, 0xf6, 0x1c, 0x3a, 0x80,
-	0x8a, 0xe6, 0xa2, 0x93, 0x30, 0x6f, 0x3c, 0xf3, 0x06, 0x54, 0x34, 0x57, 0x47, 0xbf, 0xcc, 0x62,
-	0x5c, 0xbf, 0xf9, 0x6e, 0x3f, 0x28, 0x58, 0x4d, 0x35, 0xe3, 0xda, 0x90, 0x1b, 0xe7, 0x34, 0x8d,
-	0x71, 0x4e, 0x5e, 0x74, 0x26, 0x69, 0x6a, 0x6d, 0xaa, 0xc7, 0x1a, 0xab, 0x9a, 0x21, 0x85, 0x45,
-	0xb8, 0xe0, 0x0b, 0x7c, 0x8a, 0xdf, 0x4e, 0xea, 0x79, 0xfa, 0x6c, 0x52, 0x7f, 0xac, 0xdc, 0x3e,
-	0x3d, 0x73, 0xfe, 0x95, 0x89, 0xfb, 0x48, 0x86, 0x86, 0xd4, 0x5b, 0x67, 0x72, 0xca, 0xcb, 0x2e,
-	0x83, 0x19, 0xff, 0xc1, 0x31, 0xcd, 0x9d, 0x0c, 0xc7, 0x54, 0xc5, 0xf4, 0x7d, 0xd2, 0xf6, 0x77,
-	0xb2, 0x1f, 0x61, 0x47, 0x79, 0x3f, 0x9e, 0x43, 0x04, 0xd6, 0x8f, 0xa9, 0xca, 0x50, 0xf5, 0x9b,
-	0x5d, 0xcc, 0x7e, 0x6b, 0x2a, 0xe4, 0xfa, 0x78, 0x0e, 0x7d, 0x0f, 0x28, 0x4b, 0xc4, 0x51, 0xde,
-	0xf7, 0xaa, 0x02, 0xb6, 0x7e, 0x73, 0x48, 0x1c, 0xb8, 0x3f, 0x6c, 0x5a, 0xa3, 0x8c, 0x7c, 0x52,
-	0x7c, 0x7e, 0x9b, 0xf3, 0x89, 0x2f, 0x8f, 0xd1, 0x9b, 0x5e, 0xb3, 0xaa, 0xe3, 0x3e, 0xe4, 0xde,
-	0x37, 0xc7, 0xe7, 0xd7, 0xd9, 0xc0, 0x67, 0x58, 0x7b, 0xc4, 0x04, 0x23, 0x62, 0x3d, 0x91, 0x09,
-	0x8e, 0xf0, 0xef, 0x1b, 0xc3, 0x71, 0x50, 0xf9, 0x6e, 0xbe, 0xff, 0xe4, 0x62, 0xd1, 0xfc, 0x31,
-	0xf9, 0xd9, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa6, 0x93, 0x1a, 0xc5, 0x1c, 0x00, 0x00,
+	// 2098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x5a, 0x6d, 0x73, 0xdb, 0xc6,
+	0x11, 0x36, 0x45, 0x4a, 0x26, 0xd7, 0x92, 0x62, 0x9f, 0x25, 0x99, 0x66, 0x6b, 0xd9, 0x41, 0x13,
+	0x37, 0xe9, 0xa4, 0x52, 0xec, 0xbc, 0x4c, 0x26, 0xd3, 0xc9, 0x24, 0xa2, 0xa8, 0x44, 0x89, 0x65,
+	0xd3, 0xa0, 0x24, 0x37, 0x6f, 0x93, 0x81, 0x80, 0x13, 0x85, 0x08, 0xc0, 0xd1, 0xb8, 0x83, 0x6a,
+	0xe6, 0x53, 0x3b, 0xed, 0xf4, 0x43, 0x67, 0xfa, 0x0f, 0xfa, 0x87, 0xfa, 0x0b, 0xfa, 0x4b, 0xfa,
+	0xbd, 0x7b, 0x87, 0x03, 0x09, 0x10, 0x80, 0x68, 0x95, 0xfc, 0xa4, 0x7b, 0xdb, 0x67, 0xf7, 0xf6,
+	0xf6, 0xf6, 0xf6, 0x01, 0x05, 0xef, 0x0e, 0xce, 0xfb, 0xdb, 0x67, 0x56, 0xe0, 0x78, 0x34, 0xfc,
+	0xbd, 0x67, 0x45, 0x81, 0x7d, 0x86, 0x0d, 0x9b, 0xf9, 0xdb, 0xb6, 0xef, 0x6c, 0x5f, 0x3c, 0x92,
+	0x7f, 0xb6, 0x06, 0x21, 0x13, 0x8c, 0xbc, 0x71, 0x1e, 0x9d, 0xd0, 0x0b, 0x37, 0x14, 0x5b, 0x72,
+	0xec, 0xe2, 0x91, 0x71, 0x0a, 0xb7, 0x9f, 0x53, 0x3f, 0x3a, 0xa6, 0x21, 0x77, 0x59, 0x60, 0x52,
+	0x3e, 0x60, 0x01, 0xa7, 0xe4, 0x23, 0xa8, 0x87, 0xba, 0xdd, 0xac, 0x3c, 0xa8, 0xbc, 0x73, 0xe3,
+	0xf1, 0xdd, 0xad, 0x09, 0xd1, 0xad, 0x64, 0xb1, 0x39, 0x5a, 0x4a, 0x9a, 0x70, 0xfd, 0x22, 0x46,
+	0x6a, 0x2e, 0xa0, 0x54, 0xc3, 0x4c, 0xba, 0xc6, 0x7d, 0xa8, 0x1e, 0x1f, 0xec, 0xab, 0x05, 0xbe,
+	0xfb, 0x35, 0xc7, 0x05, 0x12, 0x76, 0xd9, 0x4c, 0xba, 0xc6, 0x23, 0xa8, 0xb6, 0xbb, 0x47, 0x64,
+	0x15, 0x16, 0x5c, 0x47, 0xcd, 0xad, 0x98, 0xd8, 0x22, 0x2d, 0xa8, 0x73, 0xf7, 0xc4, 0x73, 0x83,
+	0x3e, 0x47, 0xc8, 0x2a, 0x8e, 0x8e, 0xfa, 0xc6, 0x36, 0x5c, 0xef, 0xc5, 0xed, 0x9c, 0xd8, 0x1a,
+	0x2c, 0x5e, 0x58, 0x5e, 0x44, 0x95, 0x19, 0x35, 0x33, 0xee, 0x18, 0x1d, 0x58, 0xec, 0x5a, 0x7d,
+	0xca, 0xe5, 0xb4, 0xcd, 0xa2, 0x40, 0x28, 0x09, 0x9c, 0x56, 0x1d, 0x42, 0xa0, 0x16, 0x05, 0xae,
+	0xd0, 0xa6, 0xab, 0xb6, 0x1c, 0xe3, 0xee, 0x2f, 0xb4, 0x59, 0x55, 0xd0, 0xaa, 0x6d, 0x7c, 0x08,
+	0x4b, 0x07, 0xd4, 0x67, 0xe1, 0x90, 0x6c, 0xc0, 0x92, 0xe5, 0xa7, 0x80, 0x74, 0xaf, 0x08, 0xc9,
+	0xf8, 0x4f, 0x05, 0x6a, 0x6d, 0xea, 0x79, 0x39, 0x5b, 0xb7, 0x61, 0xc9, 0x57, 0x70, 0x6a, 0xf9,
+	0x8d, 0xc7, 0x77, 0x72, 0x9e, 0x8e, 0xb5, 0x99, 0x7a, 0x19, 0x79, 0x0f, 0x16, 0x07, 0x72, 0x1b,
+	0x68, 0x54, 0x15, 0xd7, 0x6f, 0xe4, 0xd6, 0xab, 0x4d, 0x9a, 0xf1, 0x22, 0xf2, 0x31, 0x34, 0x1c,
+	0x97, 0x0b, 0x2b, 0xb0, 0x51, 0xa2, 0xa6, 0x24, 0x9a, 0x39, 0x09, 0xed, 0x47, 0x73, 0xbc, 0x94,
+	0xbc, 0x03, 0x35, 0x7b, 0x10, 0xf1, 0xe6, 0xa2, 0x12, 0x59, 0xcb, 0x89, 0xe0, 0x69, 0x99, 0x6a,
+	0x85, 0xf1, 0x39, 0xd4, 0x0f, 0xd9, 0x80, 0x79, 0xac, 0x3f, 0x24, 0x1f, 0x02, 0x04, 0x91, 0x6f,
+	0xfd, 0x64, 0xe3, 0x4e, 0x39, 0x6e, 0x52, 0xca, 0xae, 0xe7, 0x65, 0x71, 0xd6, 0x6c, 0xc8, 0x85,
+	0xb2, 0xc5, 0x8d, 0x7f, 0x54, 0x60, 0xa9, 0x77, 0xb0, 0xe3, 0x32, 0x4e, 0x0c, 0x58, 0xf6, 0xad,
+	0x20, 0x3a, 0xb5, 0x6c, 0x11, 0x85, 0x34, 0x54, 0x7e, 0x6a, 0x98, 0x99, 0x31, 0x19, 0x45, 0x18,
+	0xce, 0x4e, 0x64, 0x27, 0x1e, 0x4e, 0xba, 0xe9, 0x00, 0xac, 0x66, 0x02, 0x90, 0xdc, 0x84, 0x2a,
+	0x3f, 0x8f, 0xd0, 0x01, 0x72, 0x54, 0x36, 0xe5, 0xe1, 0x9d, 0x5a, 0xbe, 0xeb, 0x0d, 0x71, 0x8b,
+	0x72, 0x50, 0xf7, 0x8c, 0xbf, 0x57, 0xa0, 0xbe, 0xeb, 0xf2, 0xf3, 0xfd, 0xe0, 0x94, 0xa9, 0x45,
+	0x2c, 0xf4, 0x2d, 0xa1, 0x0d, 0xd1, 0x3d, 0xf2, 0x00, 0x6e, 0x9c, 0x58, 0xf6, 0x39, 0xfa, 0x6c,
+	0xcf, 0xf5, 0xa8, 0x36, 0x23, 0x3d, 0x44, 0x36, 0x01, 0xa4, 0xbd, 0x96, 0xd7, 0x4b, 0xe2, 0xa7,
+	0x66, 0xa6, 0x46, 0x24, 0x82, 0x74, 0x49, 0xb2, 0xa0, 0xa6, 0x16, 0xa4, 0x87, 0x8c, 0xff, 0x56,
+	0x60, 0xa5, 0xed, 0x45, 0x5c, 0xd0, 0xb0, 0xcd, 0x82, 0x53, 0xb7, 0x4f, 0xb6, 0x80, 0x74, 0x5e,
+	0x0d, 0xf0, 0xa6, 0x4b, 0xfb, 0x78, 0x27, 0xb0, 0x4e, 0x3c, 0x1a, 0x87, 0x52, 0xdd, 0x2c, 0x98,
+	0x21, 0x7f, 0x80, 0xbb, 0x7b, 0x21, 0xa5, 0x32, 0x1e, 0x4c, 0x3a, 0x60, 0xa1, 0x40, 0xe3, 0x70,
+	0x41, 0x2c, 0xb6, 0xa0, 0xc4, 0xca, 0x17, 0x90, 0x4f, 0xa1, 0xb9, 0xc3, 0xec, 0x33, 0x8e, 0x03,
+	0x03, 0xcf, 0x1a, 0xee, 0xb1, 0xb0, 0xb3, 0xb7, 0xff, 0x65, 0x44, 0xb9, 0xe0, 0x6a, 0x3f, 0x75,
+	0xb3, 0x74, 0x5e, 0xca, 0xf6, 0x68, 0xe8, 0x5a, 0x1e, 0x5a, 0xce, 0x99, 0x47, 0x9f, 0xb0, 0xb1,
+	0xe2, 0x5a, 0x2c, 0x5b, 0x36, 0x6f, 0x7c, 0x00, 0x77, 0xf7, 0x03, 0xdc, 0x34, 0x9e, 0x37, 0xdd,
+	0x71, 0x03, 0x07, 0x6d, 0x3a, 0x70, 0xfb, 0xa1, 0x25, 0xe4, 0x39, 0x6e, 0xc8, 0xcb, 0x27, 0xce,
+	0x98, 0x93, 0x1c, 0x48, 0xdc, 0x33, 0xfe, 0x55, 0x87, 0xf5, 0xe3, 0xd8, 0x79, 0x07, 0x96, 0x7d,
+	0xe6, 0x06, 0xf4, 0xd9, 0x40, 0x0a, 0x70, 0xf2, 0x0d, 0xac, 0x65, 0x27, 0xe2, 0x48, 0xd3, 0x79,
+	0x2d, 0x7f, 0xdb, 0xe2, 0x69, 0xb3, 0x50, 0x08, 0xe3, 0x7b, 0x1d, 0x6f, 0xe3, 0x8e, 0xe5, 0x79,
+	0x8c, 0x05, 0x3d, 0x61, 0x09, 0xde, 0xc5, 0x6d, 0xb0, 0xd8, 0x9b, 0x2b, 0x66, 0xf1, 0x24, 0x79,
+	0x1f, 0x6e, 0x77, 0x43, 0x2a, 0xc7, 0x6d, 0x4b, 0x50, 0xe7, 0x98, 0x79, 0x91, 0xaf, 0xef, 0x6f,
+	0xc3, 0x2c, 0x9a, 0x92, 0x09, 0x58, 0xe8, 0x3b, 0xa5, 0xfc, 0x55, 0x94, 0x80, 0x93, 0x4b, 0x67,
+	0x8e, 0x96, 0x92, 0x1e, 0x34, 0x54, 0x00, 0xc8, 0xd8, 0xd5, 0x37, 0xf7, 0xa3, 0x9c, 0x5c, 0xa1,
+	0x9b, 0xb6, 0x46, 0x72, 0x9d, 0x40, 0x60, 0xb2, 0x19, 0xe3, 0x94, 0x44, 0xdd, 0x52, 0x69, 0xd4,
+	0xed, 0xc2, 0x8a, 0x9d, 0x0e, 0xdb, 0xe6, 0x75, 0xb5, 0x81, 0xcd, 0x7c, 0x1a, 0x48, 0xaf, 0x32,
+	0xb3, 0x42, 0xe4, 0xaf, 0x15, 0xb8, 0xeb, 0x26, 0x61, 0xb0, 0xcb, 0x7c, 0xcb, 0x0d, 0xbe, 0x10,
+	0x02, 0x6d, 0xf6, 0x29, 0xe6, 0xdb, 0xba, 0xda, 0x5b, 0xe7, 0x35, 0xf7, 0xb6, 0x5f, 0x86, 0x13,
+	0xef, 0xb5, 0x5c, 0x0f, 0x09, 0x80, 0x8c, 0x26, 0x47, 0x41, 0xd8, 0x6c, 0x28, 0xed, 0x9f, 0x5d,
+	0x55, 0xfb, 0x08, 0x20, 0x56, 0x5b, 0x80, 0x2c, 0x6f, 0xac, 0x15, 0x09, 0x76, 0x14, 0x0c, 0xac,
+	0x88, 0xd3, 0x43, 0xd7, 0xa7, 0x2c, 0x12, 0x3d, 0x6a, 0xb3, 0xc0, 0xe1, 0x4d, 0x40, 0x3f, 0x2e,
+	0x9a, 0xe5, 0x0b, 0x5a, 0x2f, 0x60, 0x35, 0x7b, 0x8c, 0x32, 0xed, 0x9d, 0xd3, 0xa1, 0xbe, 0x2b,
+	0xb2, 0x89, 0xcf, 0x4d, 0xea, 0x69, 0x2c, 0x0a, 0xab, 0x24, 0xf7, 0xe9, 0x57, 0xf3, 0xd3, 0x85,
+	0x4f, 0x2a, 0xad, 0x27, 0xb0, 0x79, 0xb9, 0x0f, 0x0b, 0x14, 0x65, 0xde, 0xe0, 0x46, 0x1a, 0xed,
+	0x25, 0xdc, 0x29, 0xf1, 0x49, 0x01, 0xcc, 0xe7, 0x59, 0x7b, 0x7f, 0x97, 0xb3, 0xb7, 0x34, 0x57,
+	0xa4, 0x54, 0x1a, 0x17, 0x00, 0x58, 0x7f, 0x98, 0xf4, 0xa5, 0x4c, 0x4f, 0xe4, 0x21, 0x54, 0xb1,
+	0xee, 0xd0, 0x19, 0x20, 0xff, 0xb4, 0xc9, 0x95, 0x72, 0x01, 0xea, 0xbe, 0xce, 0xe2, 0x43, 0xd4,
+	0xda, 0x1f, 0xbe, 0xde, 0x91, 0x9b, 0x89, 0x98, 0x71, 0x08, 0x37, 0xc7, 0xf6, 0x5c, 0x51, 0x7b,
+	0x33, 0xab, 0x7d, 0x79, 0x8c, 0x8a, 0x77, 0xe3, 0x46, 0xe7, 0x15, 0xb5, 0x13, 0x44, 0x7c, 0x6b,
+	0x1c, 0x75, 0x2a, 0x4f, 0x2d, 0x9f, 0x6a, 0xe7, 0xa5, 0x46, 0x24, 0x52, 0x9b, 0xf9, 0xf8, 0x86,
+	0x3a, 0xc9, 0x83, 0xa9, 0xbb, 0xb2, 0x52, 0xf9, 0x22, 0xec, 0x27, 0xa9, 0x48, 0xb5, 0xd1, 0xbe,
+	0x55, 0x91, 0x0d, 0xbc, 0x9a, 0x0a, 0xbc, 0x89, 0x51, 0x63, 0x15, 0x96, 0x3b, 0xfe, 0x40, 0x0c,
+	0xb5, 0x15, 0xc6, 0x67, 0x50, 0x37, 0x53, 0x95, 0x20, 0x8f, 0x6c, 0xac, 0x23, 0xb8, 0x7e, 0x9e,
+	0x92, 0xae, 0x9c, 0xc1, 0x04, 0xc7, 0xf1, 0xc5, 0x49, 0x6c, 0xd1, 0x5d, 0xe3, 0x27, 0x8c, 0x5e,
+	0x65, 0xf3, 0xac, 0x65, 0x28, 0xbe, 0x11, 0xf1, 0xe6, 0xb5, 0x06, 0xdd, 0x33, 0x02, 0xb8, 0x1d,
+	0x2b, 0x50, 0xb9, 0x79, 0x56, 0x2d, 0xf8, 0x80, 0x3b, 0x63, 0xb4, 0xa4, 0x04, 0x48, 0x0d, 0x19,
+	0xaf, 0xe0, 0x96, 0x7a, 0x0e, 0xd5, 0x6d, 0x9a, 0x51, 0xdb, 0x7b, 0x70, 0xab, 0x3f, 0x89, 0xa5,
+	0x75, 0xe6, 0x27, 0x8c, 0xbf, 0x55, 0x60, 0x5d, 0xa9, 0x3e, 0xe2, 0x34, 0x7c, 0x82, 0x35, 0xdd,
+	0xac, 0xea, 0xf1, 0xdd, 0xeb, 0x17, 0xe1, 0x69, 0x13, 0x8a, 0x27, 0x8d, 0x7f, 0x56, 0xa0, 0xa9,
+	0xcc, 0x90, 0x15, 0x11, 0x1f, 0x62, 0x76, 0xf7, 0x67, 0x76, 0x3b, 0x56, 0x16, 0xfd, 0x12, 0x48,
+	0x6d, 0x4c, 0xe9, 0xbc, 0x31, 0xc4, 0x88, 0x55, 0xd7, 0x66, 0x36, 0x13, 0x90, 0x94, 0xd0, 0x57,
+	0xae, 0x68, 0x33, 0x27, 0x56, 0xb9, 0x68, 0x8e, 0xfa, 0x32, 0xf6, 0xb8, 0x70, 0x9e, 0x45, 0x42,
+	0x17, 0xa0, 0xba, 0x67, 0x7c, 0x07, 0x37, 0x95, 0x27, 0xba, 0xb2, 0xcc, 0x7e, 0xcd, 0x6b, 0x9b,
+	0xbf, 0x88, 0x0b, 0x85, 0x17, 0xf1, 0x6b, 0x1d, 0x67, 0x31, 0xf6, 0x4c, 0x7b, 0x33, 0x18, 0xac,
+	0xc8, 0x8a, 0xf0, 0x17, 0x7a, 0xd5, 0x6c, 0xf5, 0x31, 0x6c, 0x44, 0xc1, 0xa9, 0x12, 0x3d, 0x2c,
+	0x32, 0xba, 0x64, 0xd6, 0x78, 0x01, 0xb7, 0x62, 0x7e, 0xb3, 0x1b, 0xf9, 0x83, 0xab, 0x2a, 0xc5,
+	0x93, 0x70, 0x50, 0xac, 0x6b, 0x89, 0x33, 0x7d, 0xf8, 0xa3, 0xbe, 0x71, 0x02, 0x6f, 0xf4, 0x3a,
+	0xc7, 0xf3, 0xb8, 0x7b, 0x32, 0x99, 0xd1, 0x0b, 0x55, 0x53, 0xe9, 0x44, 0xac, 0xbb, 0xc6, 0x9f,
+	0xb1, 0x48, 0x79, 0xa2, 0x18, 0xf7, 0x01, 0xb5, 0x38, 0x92, 0x13, 0xf9, 0x20, 0xce, 0xe1, 0xaa,
+	0x7b, 0x93, 0x98, 0x5a, 0x71, 0x7e, 0xc2, 0xf8, 0x51, 0x56, 0xcb, 0x3f, 0x53, 0x5b, 0xc4, 0x76,
+	0xa0, 0x5b, 0x43, 0x2a, 0xe6, 0xf7, 0xd4, 0x70, 0xd8, 0xd8, 0x45, 0x89, 0xa1, 0x89, 0xd5, 0xe9,
+	0x5c, 0xd2, 0x26, 0x12, 0x3c, 0x27, 0x01, 0x3c, 0x38, 0x89, 0xf5, 0x55, 0xcd, 0xcc, 0x18, 0x2a,
+	0x25, 0x3d, 0xdc, 0x06, 0x0d, 0xf8, 0x19, 0x9b, 0xd9, 0x9d, 0xf8, 0xc4, 0xf9, 0x18, 0x72, 0x09,
+	0x19, 0x97, 0x6d, 0x39, 0xe6, 0x58, 0xc2, 0x52, 0x77, 0x74, 0xd9, 0x54, 0x6d, 0xe3, 0x39, 0xac,
+	0xec, 0x20, 0x7f, 0x8b, 0x06, 0xf3, 0x73, 0xde, 0x33, 0x58, 0x31, 0xe9, 0x09, 0x63, 0x57, 0x3e,
+	0x8f, 0x0d, 0xf9, 0x4d, 0x40, 0xb1, 0x1c, 0xfd, 0x82, 0xc5, 0x3d, 0xe3, 0x5b, 0x58, 0x3f, 0xec,
+	0x1e, 0x60, 0xed, 0x85, 0x60, 0x73, 0xae, 0x29, 0x5e, 0xc2, 0xc6, 0x24, 0xf4, 0xcc, 0xef, 0xa3,
+	0x35, 0x46, 0xd3, 0xea, 0xd2, 0x43, 0x78, 0x43, 0x37, 0xf1, 0x86, 0xf6, 0x9e, 0x76, 0x33, 0x5a,
+	0x25, 0x0d, 0x9d, 0xdf, 0xb6, 0x06, 0x70, 0xbf, 0x54, 0xc7, 0xcc, 0x55, 0x46, 0xa8, 0x80, 0xb4,
+	0x4a, 0xdd, 0x33, 0xfe, 0x88, 0x7c, 0xf3, 0x69, 0x1b, 0x05, 0x90, 0xca, 0xcd, 0xf5, 0x88, 0x1e,
+	0xff, 0xbb, 0x05, 0xd5, 0xb6, 0xef, 0x90, 0xa7, 0x78, 0x3d, 0x86, 0x81, 0x9d, 0x2d, 0x3d, 0xc9,
+	0xaf, 0x0a, 0x21, 0x63, 0xe5, 0xad, 0xf2, 0x1d, 0x19, 0xd7, 0xc8, 0x33, 0xa4, 0xa7, 0x92, 0x4d,
+	0xcc, 0x0d, 0xf0, 0x39, 0xac, 0x6b, 0x82, 0x32, 0x37, 0xc8, 0x1e, 0xac, 0xc5, 0xef, 0xd2, 0x04,
+	0x62, 0x9e, 0x55, 0x66, 0x9e, 0xaf, 0xcb, 0x41, 0x4d, 0xd8, 0x38, 0xd2, 0xaf, 0xd2, 0x3c, 0x9d,
+	0x89, 0x3d, 0x2a, 0xe6, 0x06, 0x78, 0x08, 0xcd, 0x1e, 0x3b, 0x15, 0x71, 0x22, 0x99, 0x1b, 0x2a,
+	0x6e, 0xbd, 0x77, 0x16, 0x09, 0x87, 0xfd, 0x29, 0x98, 0x1b, 0x26, 0xc6, 0xe5, 0x37, 0xae, 0xe7,
+	0xcd, 0x0d, 0xaf, 0x0b, 0x6b, 0xbb, 0xd4, 0xa3, 0x62, 0x7e, 0x87, 0xf3, 0x02, 0xd6, 0x63, 0x3a,
+	0x36, 0x09, 0xf9, 0x66, 0xfe, 0xa3, 0xeb, 0x04, 0x6d, 0x9b, 0x7a, 0xea, 0xf2, 0x4a, 0x8e, 0x84,
+	0x0e, 0xad, 0xb0, 0x4f, 0xc5, 0x0c, 0x96, 0x7e, 0x0b, 0xf7, 0xda, 0xf2, 0x43, 0xec, 0x84, 0x37,
+	0xc7, 0x5f, 0x0a, 0x66, 0x3b, 0x7a, 0xb7, 0x1f, 0x58, 0x5e, 0x6c, 0x64, 0x97, 0x39, 0x6d, 0x8f,
+	0x5a, 0x41, 0x34, 0x98, 0x01, 0xf3, 0x7b, 0xb8, 0xbf, 0xe7, 0x22, 0xa4, 0x3b, 0x79, 0x93, 0xe6,
+	0x61, 0x30, 0xc6, 0xd5, 0x57, 0x4c, 0x0c, 0xbc, 0xa8, 0xff, 0x15, 0xe3, 0x62, 0x17, 0x97, 0xc9,
+	0x0f, 0xd4, 0xff, 0x3f, 0xde, 0x01, 0x34, 0xbe, 0xa4, 0x22, 0xa6, 0x82, 0xe4, 0x5e, 0x6e, 0x65,
+	0x9a, 0xd4, 0xb6, 0xee, 0xe7, 0xbf, 0x8f, 0x64, 0x38, 0xaa, 0x0a, 0xaa, 0xd5, 0x11, 0x9c, 0x2a,
+	0x91, 0xa6, 0x61, 0xbe, 0x55, 0x82, 0x99, 0xa9, 0xaf, 0x54, 0xce, 0x5b, 0x46, 0xe0, 0x11, 0x85,
+	0x9c, 0x06, 0x6b, 0xe4, 0xa6, 0x73, 0xec, 0x53, 0x81, 0xd6, 0x11, 0x54, 0x52, 0xb5, 0xa9, 0x76,
+	0x3e, 0x2c, 0x06, 0xcc, 0xd1, 0xbc, 0x6b, 0xe4, 0x07, 0xe5, 0x82, 0x14, 0xe5, 0x9a, 0x06, 0xfd,
+	0x6e, 0x31, 0x74, 0x11, 0x69, 0xbb, 0x46, 0x76, 0xa0, 0x26, 0xa9, 0xcd, 0x34, 0xcc, 0x4b, 0xcf,
+	0xbc, 0x03, 0x35, 0x49, 0xfd, 0xc8, 0xaf, 0xf3, 0x18, 0xe3, 0x0f, 0x29, 0xad, 0x7b, 0x25, 0xb3,
+	0xa9, 0x64, 0xdc, 0x18, 0x51, 0xad, 0x82, 0xa4, 0x31, 0x49, 0xf1, 0xca, 0xce, 0x24, 0xcd, 0xd4,
+	0xd4, 0xed, 0x69, 0x4e, 0xdc, 0x9a, 0x11, 0x23, 0x22, 0x46, 0xc9, 0xcf, 0x41, 0x29, 0xba, 0x34,
+	0x2d, 0xe7, 0xc9, 0xb3, 0x49, 0xfd, 0xca, 0x77, 0xf5, 0xf0, 0x2c, 0xf8, 0x89, 0x50, 0xe7, 0x91,
+	0x5c, 0x19, 0xd2, 0xee, 0x1e, 0xf1, 0x19, 0x1f, 0xbb, 0x1c, 0xa6, 0xfe, 0xb5, 0x6d, 0x96, 0x37,
+	0x19, 0xd0, 0x05, 0x9a, 0x0d, 0x4e, 0xdb, 0xfe, 0x83, 0xfc, 0x2f, 0x02, 0x59, 0x1a, 0x89, 0x80,
+	0x16, 0xac, 0x21, 0x60, 0x8e, 0xf9, 0x5d, 0x6e, 0x62, 0xfe, 0xd3, 0x65, 0x29, 0x75, 0x44, 0x15,
+	0x3f, 0x02, 0xc9, 0xf3, 0x3a, 0x52, 0xf4, 0xf9, 0xb3, 0x84, 0xfc, 0x5d, 0xee, 0x12, 0x1b, 0xee,
+	0x8c, 0x92, 0x56, 0x96, 0xe0, 0x4d, 0xf3, 0xcf, 0x6f, 0x0b, 0xbe, 0x18, 0x17, 0x11, 0x44, 0x95,
+	0x6b, 0x56, 0xa4, 0xdf, 0x47, 0x54, 0xee, 0x72, 0xff, 0xfc, 0x26, 0xef, 0xf8, 0x1c, 0x09, 0x8c,
+	0x2b, 0xc1, 0x98, 0xa7, 0x4d, 0xad, 0x04, 0x33, 0x74, 0x6e, 0x6a, 0x79, 0x59, 0x58, 0x60, 0x6d,
+	0x16, 0x08, 0xa5, 0x08, 0xdd, 0xe5, 0xa0, 0xa7, 0x70, 0x0b, 0xb7, 0x9f, 0x65, 0x55, 0x24, 0x9f,
+	0x54, 0x0b, 0x19, 0x5d, 0x81, 0x9b, 0x8b, 0xe9, 0x19, 0xea, 0xf9, 0x4b, 0x05, 0x5a, 0x71, 0x7c,
+	0x17, 0xf1, 0x1c, 0xb2, 0x5d, 0x14, 0xd0, 0x97, 0xb0, 0xae, 0xd6, 0xfb, 0xaf, 0x2f, 0x30, 0xb2,
+	0xe1, 0x18, 0x6e, 0xf6, 0xb0, 0xe8, 0x4d, 0x13, 0x1f, 0xf2, 0x76, 0xfe, 0xb4, 0x0b, 0x88, 0xd1,
+	0xe5, 0x3e, 0x3c, 0x86, 0x16, 0xf6, 0xf2, 0x65, 0xc5, 0x8c, 0x29, 0x61, 0xa7, 0xf6, 0xdd, 0xc2,
+	0xc5, 0xa3, 0x93, 0x25, 0xf5, 0x6f, 0x11, 0x1f, 0xfc, 0x0f, 0x83, 0x75, 0x6f, 0x10, 0x43, 0x21,
+	0x00, 0x00,
 }
@@ -321,6 +321,26 @@ func (mr *MockCmdClientMockRecorder) GetSEVInfo(ctx, in any, opts ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockCmdClient)(nil).GetSEVInfo), varargs...)
 }
 
+// GetSEVSNPAttestationReport mocks base method.
+func (m *MockCmdClient) GetSEVSNPAttestationReport(ctx context.Context, in *SEVSNPAttestationReportRequest, opts ...grpc.CallOption) (*SEVSNPAttestationReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationReport", varargs...)
+	ret0, _ := ret[0].(*SEVSNPAttestationReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationReport indicates an expected call of GetSEVSNPAttestationReport.
+func (mr *MockCmdClientMockRecorder) GetSEVSNPAttestationReport(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationReport", reflect.TypeOf((*MockCmdClient)(nil).GetSEVSNPAttestationReport), varargs...)
+}
+
 // GetScreenshot mocks base method.
 func (m *MockCmdClient) GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +361,26 @@ func (mr *MockCmdClientMockRecorder) GetScreenshot(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreenshot", reflect.TypeOf((*MockCmdClient)(nil).GetScreenshot), varargs...)
 }
 
+// GetTPMAttestation mocks base method.
+func (m *MockCmdClient) GetTPMAttestation(ctx context.Context, in *TPMAttestationRequest, opts ...grpc.CallOption) (*TPMAttestationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTPMAttestation", varargs...)
+	ret0, _ := ret[0].(*TPMAttestationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTPMAttestation indicates an expected call of GetTPMAttestation.
+func (mr *MockCmdClientMockRecorder) GetTPMAttestation(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTPMAttestation", reflect.TypeOf((*MockCmdClient)(nil).GetTPMAttestation), varargs...)
+}
+
 // GetUsers mocks base method.
 func (m *MockCmdClient) GetUsers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestUserListResponse, error) {
 	m.ctrl.T.Helper()
@@ -501,6 +541,26 @@ func (mr *MockCmdClientMockRecorder) Ping(ctx, in any, opts ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCmdClient)(nil).Ping), varargs...)
 }
 
+// RebootVirtualMachine mocks base method.
+func (m *MockCmdClient) RebootVirtualMachine(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RebootVirtualMachine", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVirtualMachine indicates an expected call of RebootVirtualMachine.
+func (mr *MockCmdClientMockRecorder) RebootVirtualMachine(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).RebootVirtualMachine), varargs...)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockCmdClient) ResetVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -521,6 +581,46 @@ func (mr *MockCmdClientMockRecorder) ResetVirtualMachine(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).ResetVirtualMachine), varargs...)
 }
 
+// ResizeVirtualMachineMemory mocks base method.
+func (m *MockCmdClient) ResizeVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResizeVirtualMachineMemory", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeVirtualMachineMemory indicates an expected call of ResizeVirtualMachineMemory.
+func (mr *MockCmdClientMockRecorder) ResizeVirtualMachineMemory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVirtualMachineMemory", reflect.TypeOf((*MockCmdClient)(nil).ResizeVirtualMachineMemory), varargs...)
+}
+
+// SetVNCResolution mocks base method.
+func (m *MockCmdClient) SetVNCResolution(ctx context.Context, in *VNCResolutionRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetVNCResolution", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVNCResolution indicates an expected call of SetVNCResolution.
+func (mr *MockCmdClientMockRecorder) SetVNCResolution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVNCResolution", reflect.TypeOf((*MockCmdClient)(nil).SetVNCResolution), varargs...)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdClient) ShutdownVirtualMachine(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -955,6 +1055,21 @@ func (mr *MockCmdServerMockRecorder) GetSEVInfo(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockCmdServer)(nil).GetSEVInfo), arg0, arg1)
 }
 
+// GetSEVSNPAttestationReport mocks base method.
+func (m *MockCmdServer) GetSEVSNPAttestationReport(arg0 context.Context, arg1 *SEVSNPAttestationReportRequest) (*SEVSNPAttestationReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationReport", arg0, arg1)
+	ret0, _ := ret[0].(*SEVSNPAttestationReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationReport indicates an expected call of GetSEVSNPAttestationReport.
+func (mr *MockCmdServerMockRecorder) GetSEVSNPAttestationReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationReport", reflect.TypeOf((*MockCmdServer)(nil).GetSEVSNPAttestationReport), arg0, arg1)
+}
+
 // GetScreenshot mocks base method.
 func (m *MockCmdServer) GetScreenshot(arg0 context.Context, arg1 *VMIRequest) (*ScreenshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -970,6 +1085,21 @@ func (mr *MockCmdServerMockRecorder) GetScreenshot(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreenshot", reflect.TypeOf((*MockCmdServer)(nil).GetScreenshot), arg0, arg1)
 }
 
+// GetTPMAttestation mocks base method.
+func (m *MockCmdServer) GetTPMAttestation(arg0 context.Context, arg1 *TPMAttestationRequest) (*TPMAttestationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTPMAttestation", arg0, arg1)
+	ret0, _ := ret[0].(*TPMAttestationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTPMAttestation indicates an expected call of GetTPMAttestation.
+func (mr *MockCmdServerMockRecorder) GetTPMAttestation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTPMAttestation", reflect.TypeOf((*MockCmdServer)(nil).GetTPMAttestation), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockCmdServer) GetUsers(arg0 context.Context, arg1 *EmptyRequest) (*GuestUserListResponse, error) {
 	m.ctrl.T.Helper()
@@ -1090,6 +1220,21 @@ func (mr *MockCmdServerMockRecorder) Ping(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockCmdServer)(nil).Ping), arg0, arg1)
 }
 
+// RebootVirtualMachine mocks base method.
+func (m *MockCmdServer) RebootVirtualMachine(arg0 context.Context, arg1 *RebootRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVirtualMachine", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVirtualMachine indicates an expected call of RebootVirtualMachine.
+func (mr *MockCmdServerMockRecorder) RebootVirtualMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).RebootVirtualMachine), arg0, arg1)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockCmdServer) ResetVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -1105,6 +1250,36 @@ func (mr *MockCmdServerMockRecorder) ResetVirtualMachine(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).ResetVirtualMachine), arg0, arg1)
 }
 
+// ResizeVirtualMachineMemory mocks base method.
+func (m *MockCmdServer) ResizeVirtualMachineMemory(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeVirtualMachineMemory", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeVirtualMachineMemory indicates an expected call of ResizeVirtualMachineMemory.
+func (mr *MockCmdServerMockRecorder) ResizeVirtualMachineMemory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVirtualMachineMemory", reflect.TypeOf((*MockCmdServer)(nil).ResizeVirtualMachineMemory), arg0, arg1)
+}
+
+// SetVNCResolution mocks base method.
+func (m *MockCmdServer) SetVNCResolution(arg0 context.Context, arg1 *VNCResolutionRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVNCResolution", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVNCResolution indicates an expected call of SetVNCResolution.
+func (mr *MockCmdServerMockRecorder) SetVNCResolution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVNCResolution", reflect.TypeOf((*MockCmdServer)(nil).SetVNCResolution), arg0, arg1)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockCmdServer) ShutdownVirtualMachine(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
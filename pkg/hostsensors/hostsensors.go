@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	SerialDeviceName = "org.kubevirt.hostsensors.0"
+	ChannelDir       = util.VirtPrivateDir + "/hostsensors-channel"
+	ChannelSocket    = ChannelDir + "/hostsensors.sock"
+)
+
+var allTypes = []v1.HostSensorType{v1.HostSensorTypeTemperature, v1.HostSensorTypePower}
+
+func HasDevice(spec *v1.VirtualMachineInstanceSpec) bool {
+	return spec.Domain.Devices.HostSensors != nil
+}
+
+func ChannelSocketPathOnHost(pid int) string {
+	return filepath.Join("/proc", strconv.Itoa(pid), "root", ChannelSocket)
+}
+
+// IsKnownType returns whether the given sensor type can be exposed to a vmi
+func IsKnownType(sensorType v1.HostSensorType) bool {
+	return slices.Contains(allTypes, sensorType)
+}
+
+// Types returns the sensor types a vmi requested, all of them if none is listed
+func Types(sensors *v1.HostSensors) []v1.HostSensorType {
+	if len(sensors.Types) == 0 {
+		return allTypes
+	}
+	return sensors.Types
+}
+
+// AllowedInNamespace returns whether the admin allowed the vmis of a namespace with the given labels to read
+// the sensors of their node. Nil labels stand for an unknown namespace, which is never allowed.
+func AllowedInNamespace(config *v1.HostSensorsConfiguration, namespaceLabels map[string]string) (bool, error) {
+	if config == nil || namespaceLabels == nil {
+		return false, nil
+	}
+	if len(config.NamespaceSelector.MatchLabels) == 0 && len(config.NamespaceSelector.MatchExpressions) == 0 {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&config.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(namespaceLabels)), nil
+}
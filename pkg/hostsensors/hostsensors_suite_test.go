@@ -0,0 +1,11 @@
+package hostsensors_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestHostSensors(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
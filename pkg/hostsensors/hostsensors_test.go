@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("namespace allowlist", func() {
+	appliances := &v1.HostSensorsConfiguration{
+		NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"appliances": "true"}},
+	}
+
+	DescribeTable("should allow only the namespaces selected by the admin", func(config *v1.HostSensorsConfiguration, namespaceLabels map[string]string, expected bool) {
+		allowed, err := AllowedInNamespace(config, namespaceLabels)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(Equal(expected))
+	},
+		Entry("without a configuration", nil, map[string]string{"appliances": "true"}, false),
+		Entry("with an empty selector", &v1.HostSensorsConfiguration{}, map[string]string{"appliances": "true"}, false),
+		Entry("in an unknown namespace", appliances, nil, false),
+		Entry("in a namespace not matching the selector", appliances, map[string]string{"appliances": "false"}, false),
+		Entry("in a namespace matching the selector", appliances, map[string]string{"appliances": "true"}, true),
+	)
+
+	It("should fail on an invalid selector", func() {
+		_, err := AllowedInNamespace(&v1.HostSensorsConfiguration{
+			NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "appliances", Operator: "Unknown",
+			}}},
+		}, map[string]string{"appliances": "true"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// HwmonDir is where the kernel exposes the hardware monitoring chips of the node
+const HwmonDir = "/sys/class/hwmon"
+
+// Reading is the current value of a hardware monitoring sensor.
+// Temperatures are in millidegrees Celsius and power consumptions in microwatts,
+// as reported by the kernel.
+type Reading struct {
+	Type  v1.HostSensorType `json:"type"`
+	Chip  string            `json:"chip"`
+	Label string            `json:"label"`
+	Value int64             `json:"value"`
+}
+
+// Some chips only report an average power consumption, the instantaneous
+// one is preferred when both are available
+var sensorFiles = map[v1.HostSensorType]*regexp.Regexp{
+	v1.HostSensorTypeTemperature: regexp.MustCompile(`^(temp\d+)_input$`),
+	v1.HostSensorTypePower:       regexp.MustCompile(`^(power\d+)_(input|average)$`),
+}
+
+// Reader reads the sensors of the hwmon chips below a sysfs directory
+type Reader struct {
+	hwmonDir string
+}
+
+func NewReader(hwmonDir string) *Reader {
+	return &Reader{hwmonDir: hwmonDir}
+}
+
+// Read returns the readings of all sensors of the given types, ordered by chip and label
+func (r *Reader) Read(types []v1.HostSensorType) ([]Reading, error) {
+	chips, err := os.ReadDir(r.hwmonDir)
+	if err != nil {
+		return nil, err
+	}
+
+	readings := []Reading{}
+	for _, chip := range chips {
+		chipReadings, err := r.readChip(filepath.Join(r.hwmonDir, chip.Name()), types)
+		if err != nil {
+			return nil, err
+		}
+		readings = append(readings, chipReadings...)
+	}
+	sort.SliceStable(readings, func(i, j int) bool {
+		if readings[i].Chip != readings[j].Chip {
+			return readings[i].Chip < readings[j].Chip
+		}
+		return readings[i].Label < readings[j].Label
+	})
+	return readings, nil
+}
+
+func (r *Reader) readChip(chipDir string, types []v1.HostSensorType) ([]Reading, error) {
+	entries, err := os.ReadDir(chipDir)
+	if err != nil {
+		return nil, err
+	}
+	chipName := readAttribute(chipDir, "name")
+	if chipName == "" {
+		chipName = filepath.Base(chipDir)
+	}
+
+	names := map[string]struct{}{}
+	for _, entry := range entries {
+		names[entry.Name()] = struct{}{}
+	}
+
+	var readings []Reading
+	for _, entry := range entries {
+		for sensorType, pattern := range sensorFiles {
+			if !slices.Contains(types, sensorType) {
+				continue
+			}
+			match := pattern.FindStringSubmatch(entry.Name())
+			if match == nil {
+				continue
+			}
+			sensor := match[1]
+			if _, hasInput := names[sensor+"_input"]; len(match) > 2 && match[2] == "average" && hasInput {
+				continue
+			}
+
+			value, err := strconv.ParseInt(readAttribute(chipDir, entry.Name()), 10, 64)
+			if err != nil {
+				// Sensors which are not connected fail to read, skip them
+				continue
+			}
+
+			label := readAttribute(chipDir, sensor+"_label")
+			if label == "" {
+				label = sensor
+			}
+			readings = append(readings, Reading{
+				Type:  sensorType,
+				Chip:  chipName,
+				Label: label,
+				Value: value,
+			})
+		}
+	}
+	return readings, nil
+}
+
+func readAttribute(dir, name string) string {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("hwmon reader", func() {
+	var hwmonDir string
+
+	writeAttributes := func(chip string, attributes map[string]string) {
+		chipDir := filepath.Join(hwmonDir, chip)
+		Expect(os.MkdirAll(chipDir, 0755)).To(Succeed())
+		for name, value := range attributes {
+			Expect(os.WriteFile(filepath.Join(chipDir, name), []byte(value+"\n"), 0644)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		hwmonDir = GinkgoT().TempDir()
+		writeAttributes("hwmon0", map[string]string{
+			"name":        "coretemp",
+			"temp1_input": "45000",
+			"temp1_label": "Package id 0",
+			"temp2_input": "43000",
+		})
+		writeAttributes("hwmon1", map[string]string{
+			"name":           "power_meter",
+			"power1_average": "120000000",
+			"power2_average": "80000000",
+			"power2_input":   "85000000",
+		})
+	})
+
+	It("should read all requested sensors", func() {
+		readings, err := NewReader(hwmonDir).Read([]v1.HostSensorType{v1.HostSensorTypeTemperature, v1.HostSensorTypePower})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readings).To(Equal([]Reading{
+			{Type: v1.HostSensorTypeTemperature, Chip: "coretemp", Label: "Package id 0", Value: 45000},
+			{Type: v1.HostSensorTypeTemperature, Chip: "coretemp", Label: "temp2", Value: 43000},
+			{Type: v1.HostSensorTypePower, Chip: "power_meter", Label: "power1", Value: 120000000},
+			{Type: v1.HostSensorTypePower, Chip: "power_meter", Label: "power2", Value: 85000000},
+		}))
+	})
+
+	It("should only read sensors of the requested types", func() {
+		readings, err := NewReader(hwmonDir).Read([]v1.HostSensorType{v1.HostSensorTypePower})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readings).To(HaveLen(2))
+		for _, reading := range readings {
+			Expect(reading.Type).To(Equal(v1.HostSensorTypePower))
+		}
+	})
+
+	It("should skip sensors which can not be read", func() {
+		writeAttributes("hwmon2", map[string]string{
+			"name":        "acpitz",
+			"temp1_input": "",
+		})
+		readings, err := NewReader(hwmonDir).Read([]v1.HostSensorType{v1.HostSensorTypeTemperature})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readings).To(HaveLen(2))
+	})
+
+	It("should fail when hwmon is not available", func() {
+		_, err := NewReader(filepath.Join(hwmonDir, "missing")).Read([]v1.HostSensorType{v1.HostSensorTypeTemperature})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	maxConnectAttempts   = 6
+	maxRequestsPerSecond = 1
+	maxRequestsBurst     = 1
+
+	// readRequest is the only request the guest can send, followed by a newline
+	readRequest = "READ"
+)
+
+type sensorsReporter func() ([]Reading, error)
+
+type readingsResponse struct {
+	Readings []Reading `json:"readings"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// RunHostSensorsVirtioServer serves the readings of the given sensor types on the
+// virtio-serial channel of a vmi. Like for the DownwardMetrics, QEMU listens on the
+// channel socket and the server connects to it. The guest writes a READ line to the
+// port and receives a single line of JSON with the current readings.
+func RunHostSensorsVirtioServer(ctx context.Context, channelSocketPath string, reader *Reader, types []v1.HostSensorType) {
+	server := hostSensorsServer{
+		rateLimiter:        rate.NewLimiter(maxRequestsPerSecond, maxRequestsBurst),
+		maxConnectAttempts: maxConnectAttempts,
+		virtioSerialSocket: channelSocketPath,
+		reportFn: func() ([]Reading, error) {
+			return reader.Read(types)
+		},
+	}
+	go server.start(ctx)
+}
+
+type hostSensorsServer struct {
+	rateLimiter        *rate.Limiter
+	maxConnectAttempts uint
+	virtioSerialSocket string
+	reportFn           sensorsReporter
+}
+
+func (s *hostSensorsServer) start(ctx context.Context) {
+	conn, err := connect(ctx, s.virtioSerialSocket, s.maxConnectAttempts)
+	if err != nil {
+		log.Log.Reason(err).Error("failed to connect to the host sensors virtio-serial socket")
+		return
+	}
+
+	s.serve(ctx, conn)
+}
+
+func (s *hostSensorsServer) serve(ctx context.Context, conn net.Conn) {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// Unblock the pending read when the server is stopped
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			log.Log.Reason(err).Warning("host sensors virtio-serial failed to close the connection")
+		}
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		request, err := reader.ReadString('\n')
+		if err != nil {
+			// `io.EOF`: QEMU closed the connection, `net.ErrClosed`: the server was stopped
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				log.Log.Reason(err).Warning("host sensors virtio-serial socket read failed")
+			}
+			return
+		}
+
+		// The guest can not make virt-handler read sysfs in a tight loop
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return
+		}
+
+		if err := reply(conn, s.handleRequest(strings.TrimSpace(request))); err != nil {
+			log.Log.Reason(err).Error("failed to send the host sensor readings")
+			return
+		}
+	}
+}
+
+func (s *hostSensorsServer) handleRequest(request string) any {
+	if request != readRequest {
+		return errorResponse{Error: fmt.Sprintf("invalid request: %q", request)}
+	}
+
+	readings, err := s.reportFn()
+	if err != nil {
+		log.Log.Reason(err).Error("failed to read the host sensors")
+		return errorResponse{Error: "host sensors not available"}
+	}
+	return readingsResponse{Readings: readings}
+}
+
+func reply(conn net.Conn, response any) error {
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Write(append(encoded, '\n'))
+	return err
+}
+
+func connect(ctx context.Context, socketPath string, attempts uint) (net.Conn, error) {
+	backoff := time.Second
+	for i := uint(1); ; i++ {
+		conn, err := net.Dial("unix", socketPath)
+		if err == nil {
+			return conn, nil
+		}
+
+		// It is only tried again in case the socket doesn't exist or no one is
+		// listening on the other end yet
+		if (!errors.Is(err, syscall.ECONNREFUSED) && !errors.Is(err, syscall.ENOENT)) || i == attempts {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Host sensors virtio-serial server", func() {
+	var (
+		qemu      net.Conn
+		reader    *bufio.Reader
+		done      chan struct{}
+		cancelCtx context.CancelFunc
+	)
+
+	startServer := func(reportFn sensorsReporter) {
+		var server net.Conn
+		qemu, server = net.Pipe()
+		reader = bufio.NewReader(qemu)
+
+		var ctx context.Context
+		ctx, cancelCtx = context.WithCancel(context.Background())
+		s := hostSensorsServer{
+			rateLimiter:        rate.NewLimiter(rate.Inf, 1),
+			maxConnectAttempts: 1,
+			reportFn:           reportFn,
+		}
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			s.serve(ctx, server)
+		}()
+	}
+
+	request := func(line string) string {
+		_, err := qemu.Write([]byte(line + "\n"))
+		Expect(err).ToNot(HaveOccurred())
+		response, err := reader.ReadString('\n')
+		Expect(err).ToNot(HaveOccurred())
+		return response
+	}
+
+	AfterEach(func() {
+		cancelCtx()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should reply with the readings", func() {
+		startServer(func() ([]Reading, error) {
+			return []Reading{{Type: v1.HostSensorTypeTemperature, Chip: "coretemp", Label: "temp1", Value: 45000}}, nil
+		})
+		Expect(request("READ")).To(Equal(`{"readings":[{"type":"temperature","chip":"coretemp","label":"temp1","value":45000}]}` + "\n"))
+		Expect(request("READ")).To(HavePrefix(`{"readings":`))
+	})
+
+	It("should reply with an error to invalid requests", func() {
+		startServer(func() ([]Reading, error) {
+			return nil, nil
+		})
+		Expect(request("GET /metrics/XML")).To(Equal(`{"error":"invalid request: \"GET /metrics/XML\""}` + "\n"))
+	})
+
+	It("should reply with an error when the sensors can not be read", func() {
+		startServer(func() ([]Reading, error) {
+			return nil, errors.New("no hwmon")
+		})
+		Expect(request("READ")).To(Equal(`{"error":"host sensors not available"}` + "\n"))
+	})
+
+	It("should stop when QEMU closes the connection", func() {
+		startServer(func() ([]Reading, error) {
+			return nil, nil
+		})
+		Expect(qemu.Close()).To(Succeed())
+		Eventually(done).Should(BeClosed())
+	})
+})
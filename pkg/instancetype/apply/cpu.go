@@ -70,9 +70,20 @@ func applyCPU(
 
 	applyGuestCPUTopology(instancetypeSpec.CPU.Guest, preferenceSpec, vmiSpec)
 
+	if instancetypeSpec.CPU.MaxCPUs != nil {
+		applyMaxCPUs(*instancetypeSpec.CPU.MaxCPUs, vmiSpec)
+	}
+
 	return nil
 }
 
+// applyMaxCPUs derives the hotplug ceiling in sockets from the maximum amount of vCPUs,
+// as only whole sockets can be hotplugged into the guest.
+func applyMaxCPUs(maxCPUs uint32, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	vCPUsPerSocket := vmiSpec.Domain.CPU.Cores * vmiSpec.Domain.CPU.Threads
+	vmiSpec.Domain.CPU.MaxSockets = max(maxCPUs/vCPUsPerSocket, vmiSpec.Domain.CPU.Sockets)
+}
+
 func applyGuestCPUTopology(vCPUs uint32, preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
 	// Apply the default topology here to avoid duplication below
 	vmiSpec.Domain.CPU.Cores = 1
@@ -153,5 +164,9 @@ func validateCPU(
 		conflicts = append(conflicts, baseConflict.NewChild("domain", "cpu", "realtime"))
 	}
 
+	if vmiSpec.Domain.CPU.MaxSockets != 0 && instancetypeSpec.CPU.MaxCPUs != nil {
+		conflicts = append(conflicts, baseConflict.NewChild("domain", "cpu", "maxSockets"))
+	}
+
 	return conflicts
 }
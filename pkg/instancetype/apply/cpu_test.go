@@ -473,6 +473,42 @@ var _ = Describe("instancetype.spec.CPU and preference.spec.CPU", func() {
 		}))
 	})
 
+	DescribeTable("should derive MaxSockets from MaxCPUs", func(maxCPUs uint32, preferredTopology v1beta1.PreferredCPUTopology, expectedMaxSockets uint32) {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			CPU: v1beta1.CPUInstancetype{
+				Guest:   uint32(4),
+				MaxCPUs: pointer.P(maxCPUs),
+			},
+		}
+		preferenceSpec.CPU.PreferredCPUTopology = pointer.P(preferredTopology)
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+		Expect(vmi.Spec.Domain.CPU.MaxSockets).To(Equal(expectedMaxSockets))
+	},
+		Entry("with one vCPU per socket", uint32(16), v1beta1.Sockets, uint32(16)),
+		Entry("with multiple vCPUs per socket", uint32(16), v1beta1.Spread, uint32(8)),
+		Entry("rounded down to whole sockets", uint32(15), v1beta1.Spread, uint32(7)),
+		Entry("at least the current sockets", uint32(2), v1beta1.Sockets, uint32(4)),
+	)
+
+	It("should return a conflict if vmi.Spec.Domain.CPU.MaxSockets is defined with MaxCPUs", func() {
+		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
+			CPU: v1beta1.CPUInstancetype{
+				Guest:   uint32(2),
+				MaxCPUs: pointer.P(uint32(8)),
+			},
+		}
+
+		vmi.Spec.Domain.CPU = &virtv1.CPU{
+			MaxSockets: 4,
+		}
+
+		conflicts := vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)
+		Expect(conflicts).To(Equal(conflict.Conflicts{
+			conflict.New("spec", "template", "spec", "domain", "cpu", "maxSockets"),
+		}))
+	})
+
 	It("should return a conflict if vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU] already defined", func() {
 		instancetypeSpec = &v1beta1.VirtualMachineInstancetypeSpec{
 			CPU: v1beta1.CPUInstancetype{
@@ -21,6 +21,8 @@ package apply
 import (
 	virtv1 "kubevirt.io/api/core/v1"
 	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 func applyClockPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
@@ -42,4 +44,10 @@ func applyClockPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec,
 	if preferenceSpec.Clock.PreferredTimer != nil && vmiSpec.Domain.Clock.Timer == nil {
 		vmiSpec.Domain.Clock.Timer = preferenceSpec.Clock.PreferredTimer.DeepCopy()
 	}
+
+	if preferenceSpec.Clock.PreferredPTP != nil && vmiSpec.Domain.Clock.PTP == nil {
+		vmiSpec.Domain.Clock.PTP = &virtv1.PTPClock{
+			Enabled: pointer.P(*preferenceSpec.Clock.PreferredPTP),
+		}
+	}
 }
@@ -65,4 +65,31 @@ var _ = Describe("Preference.Clock", func() {
 		Expect(vmi.Spec.Domain.Clock.ClockOffset).To(Equal(*preferenceSpec.Clock.PreferredClockOffset))
 		Expect(vmi.Spec.Domain.Clock.Timer).To(HaveValue(Equal(*preferenceSpec.Clock.PreferredTimer)))
 	})
+
+	It("should apply the PTP clock to VMI", func() {
+		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
+			Clock: &v1beta1.ClockPreferences{
+				PreferredPTP: pointer.P(true),
+			},
+		}
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Clock.PTP).To(Equal(&virtv1.PTPClock{Enabled: pointer.P(true)}))
+	})
+
+	It("should not overwrite the PTP clock of the VMI", func() {
+		vmi.Spec.Domain.Clock = &virtv1.Clock{
+			PTP: &virtv1.PTPClock{Enabled: pointer.P(false)},
+		}
+		preferenceSpec = &v1beta1.VirtualMachinePreferenceSpec{
+			Clock: &v1beta1.ClockPreferences{
+				PreferredPTP: pointer.P(true),
+			},
+		}
+
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Clock.PTP).To(Equal(&virtv1.PTPClock{Enabled: pointer.P(false)}))
+	})
 })
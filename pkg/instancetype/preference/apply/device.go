@@ -32,6 +32,7 @@ type field struct {
 }
 
 func ApplyAutoAttachPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	preferenceSpec = ExpandPerformanceProfile(preferenceSpec, vmiSpec)
 	if preferenceSpec.Devices == nil {
 		return
 	}
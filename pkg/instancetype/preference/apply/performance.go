@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const defaultPerformanceProfileArchitecture = "amd64"
+
+// performanceProfiles lists the preferences each PerformanceProfile expands into, per architecture.
+// KVM paravirtual features like halt polling control and paravirtual spinlocks are only available on amd64.
+var performanceProfiles = map[string]map[v1beta1.PerformanceProfile]v1beta1.VirtualMachinePreferenceSpec{
+	"amd64": {
+		v1beta1.PerformanceProfileThroughput: {
+			Devices: throughputDevicePreferences(),
+		},
+		v1beta1.PerformanceProfileLatency: {
+			Devices: latencyDevicePreferences(),
+			Features: &v1beta1.FeaturePreferences{
+				PreferredKvm: &virtv1.FeatureKVM{
+					PollControl: pointer.P(true),
+				},
+			},
+		},
+		v1beta1.PerformanceProfileDensity: {
+			Devices: densityDevicePreferences(),
+			Features: &v1beta1.FeaturePreferences{
+				PreferredPvspinlock: &virtv1.FeatureState{
+					Enabled: pointer.P(true),
+				},
+			},
+		},
+	},
+	"arm64": {
+		v1beta1.PerformanceProfileThroughput: {Devices: throughputDevicePreferences()},
+		v1beta1.PerformanceProfileLatency:    {Devices: latencyDevicePreferences()},
+		v1beta1.PerformanceProfileDensity:    {Devices: densityDevicePreferences()},
+	},
+	"s390x": {
+		v1beta1.PerformanceProfileThroughput: {Devices: throughputDevicePreferences()},
+		v1beta1.PerformanceProfileLatency:    {Devices: latencyDevicePreferences()},
+		v1beta1.PerformanceProfileDensity:    {Devices: densityDevicePreferences()},
+	},
+}
+
+func throughputDevicePreferences() *v1beta1.DevicePreferences {
+	return &v1beta1.DevicePreferences{
+		PreferredDiskIO:                     virtv1.IONative,
+		PreferredDiskCache:                  virtv1.CacheNone,
+		PreferredDiskDedicatedIoThread:      pointer.P(true),
+		PreferredBlockMultiQueue:            pointer.P(true),
+		PreferredNetworkInterfaceMultiQueue: pointer.P(true),
+	}
+}
+
+func latencyDevicePreferences() *v1beta1.DevicePreferences {
+	return &v1beta1.DevicePreferences{
+		PreferredDiskIO:                     virtv1.IONative,
+		PreferredDiskCache:                  virtv1.CacheNone,
+		PreferredDiskDedicatedIoThread:      pointer.P(true),
+		PreferredNetworkInterfaceMultiQueue: pointer.P(true),
+		// Avoid the periodic balloon statistics polling waking up the guest
+		PreferredAutoattachMemBalloon: pointer.P(false),
+	}
+}
+
+func densityDevicePreferences() *v1beta1.DevicePreferences {
+	return &v1beta1.DevicePreferences{
+		PreferredDiskIO:                virtv1.IOThreads,
+		PreferredDiskCache:             virtv1.CacheWriteBack,
+		PreferredDiskDedicatedIoThread: pointer.P(false),
+		PreferredAutoattachMemBalloon:  pointer.P(true),
+	}
+}
+
+// IsSupportedPerformanceProfile returns true when the PerformanceProfile is known on at least one architecture
+func IsSupportedPerformanceProfile(profile v1beta1.PerformanceProfile) bool {
+	for _, profiles := range performanceProfiles {
+		if _, ok := profiles[profile]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandPerformanceProfile returns a copy of the preferenceSpec with the preferences of its PerformanceProfile filled
+// in wherever the preferenceSpec doesn't already define them. The preferenceSpec is returned as is without a profile.
+func ExpandPerformanceProfile(
+	preferenceSpec *v1beta1.VirtualMachinePreferenceSpec,
+	vmiSpec *virtv1.VirtualMachineInstanceSpec,
+) *v1beta1.VirtualMachinePreferenceSpec {
+	if preferenceSpec == nil || preferenceSpec.PerformanceProfile == nil {
+		return preferenceSpec
+	}
+
+	profile, ok := performanceProfiles[performanceProfileArchitecture(preferenceSpec, vmiSpec)][*preferenceSpec.PerformanceProfile]
+	if !ok {
+		return preferenceSpec
+	}
+
+	expandedSpec := preferenceSpec.DeepCopy()
+	if profile.Devices != nil {
+		if expandedSpec.Devices == nil {
+			expandedSpec.Devices = &v1beta1.DevicePreferences{}
+		}
+		expandDevicePreferences(expandedSpec.Devices, profile.Devices)
+	}
+	if profile.Features != nil {
+		if expandedSpec.Features == nil {
+			expandedSpec.Features = &v1beta1.FeaturePreferences{}
+		}
+		expandFeaturePreferences(expandedSpec.Features, profile.Features)
+	}
+	return expandedSpec
+}
+
+func performanceProfileArchitecture(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) string {
+	if vmiSpec.Architecture != "" {
+		return vmiSpec.Architecture
+	}
+	if preferenceSpec.PreferredArchitecture != nil && *preferenceSpec.PreferredArchitecture != "" {
+		return *preferenceSpec.PreferredArchitecture
+	}
+	return defaultPerformanceProfileArchitecture
+}
+
+func expandDevicePreferences(devices, profileDevices *v1beta1.DevicePreferences) {
+	if profileDevices.PreferredDiskIO != "" && devices.PreferredDiskIO == "" {
+		devices.PreferredDiskIO = profileDevices.PreferredDiskIO
+	}
+
+	if profileDevices.PreferredDiskCache != "" && devices.PreferredDiskCache == "" {
+		devices.PreferredDiskCache = profileDevices.PreferredDiskCache
+	}
+
+	if profileDevices.PreferredDiskDedicatedIoThread != nil && devices.PreferredDiskDedicatedIoThread == nil {
+		devices.PreferredDiskDedicatedIoThread = pointer.P(*profileDevices.PreferredDiskDedicatedIoThread)
+	}
+
+	if profileDevices.PreferredBlockMultiQueue != nil && devices.PreferredBlockMultiQueue == nil {
+		devices.PreferredBlockMultiQueue = pointer.P(*profileDevices.PreferredBlockMultiQueue)
+	}
+
+	if profileDevices.PreferredNetworkInterfaceMultiQueue != nil && devices.PreferredNetworkInterfaceMultiQueue == nil {
+		devices.PreferredNetworkInterfaceMultiQueue = pointer.P(*profileDevices.PreferredNetworkInterfaceMultiQueue)
+	}
+
+	if profileDevices.PreferredAutoattachMemBalloon != nil && devices.PreferredAutoattachMemBalloon == nil {
+		devices.PreferredAutoattachMemBalloon = pointer.P(*profileDevices.PreferredAutoattachMemBalloon)
+	}
+}
+
+func expandFeaturePreferences(features, profileFeatures *v1beta1.FeaturePreferences) {
+	if profileFeatures.PreferredKvm != nil && features.PreferredKvm == nil {
+		features.PreferredKvm = profileFeatures.PreferredKvm.DeepCopy()
+	}
+
+	if profileFeatures.PreferredPvspinlock != nil && features.PreferredPvspinlock == nil {
+		features.PreferredPvspinlock = profileFeatures.PreferredPvspinlock.DeepCopy()
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package apply_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	preferenceApply "kubevirt.io/kubevirt/pkg/instancetype/preference/apply"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Preference.PerformanceProfile", func() {
+	var (
+		vmi              *virtv1.VirtualMachineInstance
+		instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec
+		preferenceSpec   *v1beta1.VirtualMachinePreferenceSpec
+
+		field      = k8sfield.NewPath("spec", "template", "spec")
+		vmiApplier = apply.NewVMIApplier()
+	)
+
+	withProfile := func(profile v1beta1.PerformanceProfile) *v1beta1.VirtualMachinePreferenceSpec {
+		return &v1beta1.VirtualMachinePreferenceSpec{
+			PerformanceProfile: pointer.P(profile),
+		}
+	}
+
+	BeforeEach(func() {
+		vmi = libvmi.New()
+		vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{{
+			Name: "disk",
+			DiskDevice: virtv1.DiskDevice{
+				Disk: &virtv1.DiskTarget{
+					Bus: virtv1.DiskBusVirtio,
+				},
+			},
+		}}
+	})
+
+	It("should expand the throughput profile", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileThroughput)
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks[0].IO).To(Equal(virtv1.IONative))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].Cache).To(Equal(virtv1.CacheNone))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].DedicatedIOThread).To(HaveValue(BeTrue()))
+		Expect(vmi.Spec.Domain.Devices.BlockMultiQueue).To(HaveValue(BeTrue()))
+		Expect(vmi.Spec.Domain.Devices.NetworkInterfaceMultiQueue).To(HaveValue(BeTrue()))
+	})
+
+	It("should expand the latency profile", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileLatency)
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeFalse()))
+		Expect(vmi.Spec.Domain.Features.KVM).ToNot(BeNil())
+		Expect(vmi.Spec.Domain.Features.KVM.PollControl).To(HaveValue(BeTrue()))
+	})
+
+	It("should expand the density profile", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileDensity)
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks[0].IO).To(Equal(virtv1.IOThreads))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].Cache).To(Equal(virtv1.CacheWriteBack))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].DedicatedIOThread).To(HaveValue(BeFalse()))
+		Expect(vmi.Spec.Domain.Devices.AutoattachMemBalloon).To(HaveValue(BeTrue()))
+		Expect(vmi.Spec.Domain.Features.Pvspinlock).ToNot(BeNil())
+		Expect(vmi.Spec.Domain.Features.Pvspinlock.Enabled).To(HaveValue(BeTrue()))
+	})
+
+	It("should only expand the features available on the architecture", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileLatency)
+		vmi.Spec.Architecture = "arm64"
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks[0].IO).To(Equal(virtv1.IONative))
+		Expect(vmi.Spec.Domain.Features).To(BeNil())
+	})
+
+	It("should use the preferred architecture when the VMI does not define one", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileLatency)
+		preferenceSpec.PreferredArchitecture = pointer.P("s390x")
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Features).To(BeNil())
+	})
+
+	It("should not override preferences defined explicitly", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileThroughput)
+		preferenceSpec.Devices = &v1beta1.DevicePreferences{
+			PreferredDiskIO:          virtv1.IOThreads,
+			PreferredBlockMultiQueue: pointer.P(false),
+		}
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks[0].IO).To(Equal(virtv1.IOThreads))
+		Expect(vmi.Spec.Domain.Devices.Disks[0].Cache).To(Equal(virtv1.CacheNone))
+		Expect(vmi.Spec.Domain.Devices.BlockMultiQueue).To(HaveValue(BeFalse()))
+	})
+
+	It("should not override values defined in the VMI", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileThroughput)
+		vmi.Spec.Domain.Devices.Disks[0].Cache = virtv1.CacheWriteThrough
+		Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+
+		Expect(vmi.Spec.Domain.Devices.Disks[0].Cache).To(Equal(virtv1.CacheWriteThrough))
+	})
+
+	It("should not modify the preference", func() {
+		preferenceSpec = withProfile(v1beta1.PerformanceProfileLatency)
+		expandedSpec := preferenceApply.ExpandPerformanceProfile(preferenceSpec, &vmi.Spec)
+
+		Expect(expandedSpec.Devices).ToNot(BeNil())
+		Expect(preferenceSpec.Devices).To(BeNil())
+		Expect(preferenceSpec.Features).To(BeNil())
+	})
+})
@@ -40,6 +40,7 @@ func (a *vmiApplier) Apply(
 		return
 	}
 
+	preferenceSpec = ExpandPerformanceProfile(preferenceSpec, vmiSpec)
 	applyCPUPreferences(preferenceSpec, vmiSpec)
 	ApplyDevicePreferences(preferenceSpec, vmiSpec)
 	applyFeaturePreferences(preferenceSpec, vmiSpec)
@@ -53,6 +53,7 @@ func ValidatePreferenceSpec(field *k8sfield.Path, spec *instancetypeapiv1beta1.V
 
 	causes = append(causes, validatePreferredCPUTopology(field, spec)...)
 	causes = append(causes, validateSpreadOptions(field, spec)...)
+	causes = append(causes, validatePerformanceProfile(field, spec)...)
 	return causes
 }
 
@@ -73,6 +74,23 @@ func validatePreferredCPUTopology(field *k8sfield.Path, spec *instancetypeapiv1b
 	return nil
 }
 
+const performanceProfileUnknownErrFmt = "unknown performanceProfile %s"
+
+func validatePerformanceProfile(field *k8sfield.Path, spec *instancetypeapiv1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	if spec.PerformanceProfile == nil {
+		return nil
+	}
+	profile := *spec.PerformanceProfile
+	if !apply.IsSupportedPerformanceProfile(profile) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(performanceProfileUnknownErrFmt, profile),
+			Field:   field.Child("performanceProfile").String(),
+		}}
+	}
+	return nil
+}
+
 const (
 	spreadAcrossCoresThreadsRatioErr = "only a ratio of 2 (1 core 2 threads) is allowed when spreading vCPUs over cores and threads"
 	spreadAcrossUnsupportedErrFmt    = "across %s is not supported"
@@ -75,6 +75,35 @@ var _ = Describe("Validating Preference Admitter", func() {
 		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "cpu", "preferredCPUTopology").String()))
 	})
 
+	It("should reject unsupported PerformanceProfile value", func() {
+		unsupportedProfile := instancetypev1beta1.PerformanceProfile("foo")
+		preferenceObj = &instancetypev1beta1.VirtualMachinePreference{
+			Spec: instancetypev1beta1.VirtualMachinePreferenceSpec{
+				PerformanceProfile: pointer.P(unsupportedProfile),
+			},
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+		Expect(response.Result.Details.Causes[0].Message).To(Equal(fmt.Sprintf("unknown performanceProfile %s", unsupportedProfile)))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(k8sfield.NewPath("spec", "performanceProfile").String()))
+	})
+
+	DescribeTable("should accept PerformanceProfile value", func(profile instancetypev1beta1.PerformanceProfile) {
+		preferenceObj.Spec.PerformanceProfile = pointer.P(profile)
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeTrue())
+	},
+		Entry("throughput", instancetypev1beta1.PerformanceProfileThroughput),
+		Entry("latency", instancetypev1beta1.PerformanceProfileLatency),
+		Entry("density", instancetypev1beta1.PerformanceProfileDensity),
+	)
+
 	DescribeTable("should reject unsupported SpreadOptions Across value", func(preferredCPUTopology instancetypev1beta1.PreferredCPUTopology) {
 		var unsupportedAcrossValue instancetypev1beta1.SpreadAcross = "foobar"
 		preferenceObj = &instancetypev1beta1.VirtualMachinePreference{
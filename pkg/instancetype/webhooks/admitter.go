@@ -46,6 +46,35 @@ func ValidateInstanceTypeSpec(field *k8sfield.Path, spec *instancetypev1beta1.Vi
 
 	causes = append(causes, validateMemoryOvercommitPercentSetting(field, spec)...)
 	causes = append(causes, validateMemoryOvercommitPercentNoHugepages(field, spec)...)
+	causes = append(causes, validateCPUMaxCPUs(field, spec)...)
+	return causes
+}
+
+func validateCPUMaxCPUs(
+	field *k8sfield.Path,
+	spec *instancetypev1beta1.VirtualMachineInstancetypeSpec,
+) (causes []metav1.StatusCause) {
+	if spec.CPU.MaxCPUs == nil {
+		return nil
+	}
+	if spec.CPU.MaxSockets != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s should not be requested together.",
+				field.Child("cpu", "maxCPUs").String(),
+				field.Child("cpu", "maxSockets").String()),
+			Field: field.Child("cpu", "maxCPUs").String(),
+		})
+	}
+	if *spec.CPU.MaxCPUs < spec.CPU.Guest {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s '%d': must be greater than or equal to %s '%d'.",
+				field.Child("cpu", "maxCPUs").String(), *spec.CPU.MaxCPUs,
+				field.Child("cpu", "guest").String(), spec.CPU.Guest),
+			Field: field.Child("cpu", "maxCPUs").String(),
+		})
+	}
 	return causes
 }
 
@@ -36,6 +36,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/instancetype/webhooks"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating Instancetype Admitter", func() {
@@ -105,6 +106,36 @@ var _ = Describe("Validating Instancetype Admitter", func() {
 		Expect(response.Result.Code).To(
 			Equal(int32(http.StatusUnprocessableEntity)), "overCommitPercent and hugepages should not be requested together.")
 	})
+
+	It("should accept specs with maxCPUs", func() {
+		instancetypeObj.Spec.CPU = instancetypev1beta1.CPUInstancetype{
+			Guest:   uint32(2),
+			MaxCPUs: pointer.P(uint32(8)),
+		}
+		ar := createInstancetypeAdmissionReview(instancetypeObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeTrue(), "Expected instancetype to be allowed.")
+	})
+
+	DescribeTable("should reject invalid maxCPUs", func(cpu instancetypev1beta1.CPUInstancetype, expectedMessage string) {
+		instancetypeObj.Spec.CPU = cpu
+		ar := createInstancetypeAdmissionReview(instancetypeObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(context.Background(), ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected instancetype to not be allowed")
+		Expect(response.Result.Details.Causes).To(ConsistOf(HaveField("Message", expectedMessage)))
+	},
+		Entry("together with maxSockets", instancetypev1beta1.CPUInstancetype{
+			Guest:      uint32(2),
+			MaxCPUs:    pointer.P(uint32(8)),
+			MaxSockets: pointer.P(uint32(8)),
+		}, "spec.cpu.maxCPUs and spec.cpu.maxSockets should not be requested together."),
+		Entry("lower than guest", instancetypev1beta1.CPUInstancetype{
+			Guest:   uint32(4),
+			MaxCPUs: pointer.P(uint32(2)),
+		}, "spec.cpu.maxCPUs '2': must be greater than or equal to spec.cpu.guest '4'."),
+	)
 })
 
 var _ = Describe("Validating ClusterInstancetype Admitter", func() {
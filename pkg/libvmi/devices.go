@@ -20,6 +20,8 @@
 package libvmi
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -93,6 +95,20 @@ func WithDownwardMetricsChannel() Option {
 	}
 }
 
+// WithHostSensors exposes the sensors of the given types of the node, all of them if none is given
+func WithHostSensors(types ...v1.HostSensorType) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.HostSensors = &v1.HostSensors{Types: types}
+	}
+}
+
+// WithSGX exposes an SGX EPC section of the given size to the vmi
+func WithSGX(epcSize string) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse(epcSize)}
+	}
+}
+
 func WithoutSerialConsole() Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		enabled := false
@@ -116,9 +132,31 @@ func WithVideo(videoType string) Option {
 	}
 }
 
+// WithVideoResolution sets the preferred resolution of the video device
+func WithVideoResolution(width, height uint32) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		if vmi.Spec.Domain.Devices.Video == nil {
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{}
+		}
+		vmi.Spec.Domain.Devices.Video.Resolution = &v1.VideoResolution{Width: width, Height: height}
+	}
+}
+
 // WithPanicDevice adds a panic device with the given model
 func WithPanicDevice(model v1.PanicDeviceModel) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
 		vmi.Spec.Domain.Devices.PanicDevices = append(vmi.Spec.Domain.Devices.PanicDevices, v1.PanicDevice{Model: &model})
 	}
 }
+
+func WithChannel(channel v1.Channel) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.Channels = append(vmi.Spec.Domain.Devices.Channels, channel)
+	}
+}
+
+func WithSharedMemoryDevice(shmem v1.SharedMemoryDevice) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.SharedMemoryDevices = append(vmi.Spec.Domain.Devices.SharedMemoryDevices, shmem)
+	}
+}
@@ -193,6 +193,16 @@ func WithIOThreads(iothreads v1.DiskIOThreads) Option {
 	}
 }
 
+// WithSCSIController adds a virtio-scsi controller
+func WithSCSIController(name string, dedicatedIOThread bool) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.SCSIControllers = append(vmi.Spec.Domain.Devices.SCSIControllers, v1.SCSIController{
+			Name:              name,
+			DedicatedIOThread: pointer.P(dedicatedIOThread),
+		})
+	}
+}
+
 func addDisk(vmi *v1.VirtualMachineInstance, disk v1.Disk) {
 	if !diskExists(vmi, disk) {
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, disk)
@@ -273,6 +283,14 @@ func WithDedicatedIOThreads(enabled bool) DiskOption {
 	}
 }
 
+// WithDiskOnSCSIController puts the disk on the scsi bus, attached to the named controller
+func WithDiskOnSCSIController(controller string) DiskOption {
+	return func(d *v1.Disk) {
+		d.Disk.Bus = v1.DiskBusSCSI
+		d.SCSIController = controller
+	}
+}
+
 func newCDRom(name string, bus v1.DiskBus) v1.Disk {
 	return v1.Disk{
 		Name: name,
@@ -20,7 +20,9 @@
 package memory
 
 import (
+	"encoding/xml"
 	"fmt"
+	"strconv"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -135,8 +137,129 @@ func BuildMemoryDevice(vmi *v1.VirtualMachineInstance) (*api.MemoryDevice, error
 		Target: &api.MemoryTarget{
 			Size:      pluggableMemorySize,
 			Node:      "0",
-			Block:     api.Memory{Unit: "b", Value: uint64(blockAlignment)},
-			Requested: pluggableMemoryRequested,
+			Block:     &api.Memory{Unit: "b", Value: uint64(blockAlignment)},
+			Requested: &pluggableMemoryRequested,
 		},
 	}, nil
 }
+
+// ResizeMemoryDevice sets the requested size of the virtio-mem device of the domain to the memory
+// missing between the boot memory and the guest memory of the vmi. Unlike DIMMs, the requested size
+// can be lowered, in which case the guest unplugs memory blocks until it is reached.
+func ResizeMemoryDevice(vmi *v1.VirtualMachineInstance, device *api.MemoryDevice) error {
+	if device.Target == nil || device.Target.Block == nil {
+		return fmt.Errorf("the memory device is not a virtio-mem device")
+	}
+
+	requestedHotPlugMemory := vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	requestedHotPlugMemory.Sub(*vmi.Status.Memory.GuestAtBoot)
+	if requestedHotPlugMemory.Sign() < 0 {
+		return fmt.Errorf("the guest memory can not be resized below the boot memory %s", vmi.Status.Memory.GuestAtBoot.String())
+	}
+
+	requested, err := vcpu.QuantityToByte(requestedHotPlugMemory)
+	if err != nil {
+		return err
+	}
+	size, err := domainMemoryToQuantity(device.Target.Size)
+	if err != nil {
+		return err
+	}
+	if requestedHotPlugMemory.Cmp(*size) > 0 {
+		return fmt.Errorf("the requested memory %s exceeds the size %s of the virtio-mem device", requestedHotPlugMemory.String(), size.String())
+	}
+	block, err := domainMemoryToQuantity(*device.Target.Block)
+	if err != nil {
+		return err
+	}
+	if block.Value() != 0 && requestedHotPlugMemory.Value()%block.Value() != 0 {
+		return fmt.Errorf("the requested memory %s is not aligned to the block size %s of the virtio-mem device", requestedHotPlugMemory.String(), block.String())
+	}
+
+	device.Target.Requested = &requested
+	return nil
+}
+
+// BuildDIMMDevice builds the DIMM which plugs the memory missing between the memory of the
+// domain and the requested guest memory. It returns nil when there is no memory to plug.
+func BuildDIMMDevice(vmi *v1.VirtualMachineInstance, domainMemory api.Memory) (*api.MemoryDIMM, error) {
+	currentMemory, err := domainMemoryToQuantity(domainMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	pluggableMemory := vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	pluggableMemory.Sub(*currentMemory)
+	if pluggableMemory.Sign() < 0 {
+		return nil, fmt.Errorf("DIMMs can not be hot unplugged")
+	}
+	if pluggableMemory.IsZero() {
+		return nil, nil
+	}
+
+	size, err := vcpu.QuantityToByte(pluggableMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.MemoryDIMM{
+		Model: "dimm",
+		Target: &api.MemoryDIMMTarget{
+			Size: size,
+			Node: "0",
+		},
+	}, nil
+}
+
+// domainDIMMs holds the DIMM slots of a domain XML and the DIMMs plugged in them. The DomainSpec
+// can not be used, since it can not tell DIMMs apart from the virtio-mem device.
+type domainDIMMs struct {
+	MaxMemory *api.MaxMemory   `xml:"maxMemory"`
+	Memories  []api.MemoryDIMM `xml:"devices>memory"`
+}
+
+// AssignDIMMSlot addresses the DIMM to the first slot of the domain which no DIMM is plugged in
+func AssignDIMMSlot(dimm *api.MemoryDIMM, domainXML string) error {
+	domain := domainDIMMs{}
+	if err := xml.Unmarshal([]byte(domainXML), &domain); err != nil {
+		return err
+	}
+	if domain.MaxMemory == nil || domain.MaxMemory.Slots == 0 {
+		return fmt.Errorf("the domain has no DIMM slots")
+	}
+
+	usedSlots := map[string]struct{}{}
+	for _, memory := range domain.Memories {
+		if memory.Model == "dimm" && memory.Address != nil {
+			usedSlots[memory.Address.Slot] = struct{}{}
+		}
+	}
+	for slot := uint64(0); slot < domain.MaxMemory.Slots; slot++ {
+		if _, used := usedSlots[strconv.FormatUint(slot, 10)]; !used {
+			dimm.Address = &api.Address{
+				Type: "dimm",
+				Slot: strconv.FormatUint(slot, 10),
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("all %d DIMM slots are in use", domain.MaxMemory.Slots)
+}
+
+// domainMemoryToQuantity converts the memory of a domain, reported by libvirt in KiB by default
+func domainMemoryToQuantity(memory api.Memory) (*resource.Quantity, error) {
+	var multiplier int64
+	switch memory.Unit {
+	case "b", "bytes":
+		multiplier = 1
+	case "", "k", "KiB":
+		multiplier = 1024
+	case "M", "MiB":
+		multiplier = 1024 * 1024
+	case "G", "GiB":
+		multiplier = 1024 * 1024 * 1024
+	default:
+		return nil, fmt.Errorf("unsupported memory unit %s", memory.Unit)
+	}
+	return resource.NewQuantity(int64(memory.Value)*multiplier, resource.BinarySI), nil
+}
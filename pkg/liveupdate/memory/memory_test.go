@@ -28,6 +28,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 )
@@ -131,8 +132,8 @@ var _ = Describe("LiveUpdate Memory", func() {
 					Target: &api.MemoryTarget{
 						Size:      size,
 						Node:      "0",
-						Block:     block,
-						Requested: requested,
+						Block:     &block,
+						Requested: &requested,
 					},
 				}))
 			},
@@ -142,5 +143,134 @@ var _ = Describe("LiveUpdate Memory", func() {
 			)
 		})
 
+		Context("virtio-mem resize", func() {
+			var vmi *v1.VirtualMachineInstance
+			var device *api.MemoryDevice
+
+			BeforeEach(func() {
+				vmi = libvmi.New(
+					libvmi.WithArchitecture("amd64"),
+					libvmi.WithGuestMemory("1Gi"),
+					libvmi.WithMaxGuest("4Gi"),
+				)
+				guestAtBoot := resource.MustParse("1Gi")
+				vmi.Status.Memory = &v1.MemoryStatus{
+					GuestAtBoot:    &guestAtBoot,
+					GuestCurrent:   pointer.P(resource.MustParse("3Gi")),
+					GuestRequested: pointer.P(resource.MustParse("3Gi")),
+				}
+
+				size, err := vcpu.QuantityToByte(resource.MustParse("3Gi"))
+				Expect(err).ToNot(HaveOccurred())
+				requested, err := vcpu.QuantityToByte(resource.MustParse("2Gi"))
+				Expect(err).ToNot(HaveOccurred())
+				device = &api.MemoryDevice{
+					Model: "virtio-mem",
+					Target: &api.MemoryTarget{
+						Size:      size,
+						Node:      "0",
+						Block:     &api.Memory{Unit: "b", Value: uint64(memory.HotplugBlockAlignmentBytes)},
+						Requested: &requested,
+					},
+				}
+			})
+
+			DescribeTable("should set the requested size", func(guest, expectedRequested string) {
+				vmi.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse(guest))
+
+				Expect(memory.ResizeMemoryDevice(vmi, device)).To(Succeed())
+
+				requested, err := vcpu.QuantityToByte(resource.MustParse(expectedRequested))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(device.Target.Requested).To(Equal(&requested))
+			},
+				Entry("when the guest memory is lowered", "2Gi", "1Gi"),
+				Entry("when the guest memory is lowered to the boot memory", "1Gi", "0"),
+				Entry("when the guest memory is raised", "4Gi", "3Gi"),
+			)
+
+			DescribeTable("should fail", func(guest, expectedError string) {
+				vmi.Spec.Domain.Memory.Guest = pointer.P(resource.MustParse(guest))
+
+				Expect(memory.ResizeMemoryDevice(vmi, device)).To(MatchError(ContainSubstring(expectedError)))
+			},
+				Entry("when the guest memory is below the boot memory", "512Mi", "can not be resized below the boot memory"),
+				Entry("when the requested memory exceeds the device", "5Gi", "exceeds the size"),
+				Entry("when the requested memory is not aligned", "1025Mi", "is not aligned to the block size"),
+			)
+
+			It("should fail when the device is not a virtio-mem device", func() {
+				Expect(memory.ResizeMemoryDevice(vmi, &api.MemoryDevice{Model: "sgx-epc", Target: &api.MemoryTarget{}})).
+					To(MatchError("the memory device is not a virtio-mem device"))
+			})
+		})
+
+		Context("DIMM", func() {
+			newDIMMVMI := func(guest string) *v1.VirtualMachineInstance {
+				vmi := libvmi.New(
+					libvmi.WithArchitecture("amd64"),
+					libvmi.WithGuestMemory(guest),
+					libvmi.WithMaxGuest("4Gi"),
+				)
+				vmi.Spec.Domain.Memory.DIMMSlots = pointer.P(uint32(8))
+				return vmi
+			}
+
+			It("should plug the memory missing in the domain", func() {
+				vmi := newDIMMVMI("3Gi")
+
+				dimm, err := memory.BuildDIMMDevice(vmi, api.Memory{Unit: "KiB", Value: 1024 * 1024})
+				Expect(err).ToNot(HaveOccurred())
+
+				size, err := vcpu.QuantityToByte(resource.MustParse("2Gi"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dimm).To(Equal(&api.MemoryDIMM{
+					Model: "dimm",
+					Target: &api.MemoryDIMMTarget{
+						Size: size,
+						Node: "0",
+					},
+				}))
+			})
+
+			It("should not plug anything when the domain has the guest memory", func() {
+				vmi := newDIMMVMI("2Gi")
+
+				dimm, err := memory.BuildDIMMDevice(vmi, api.Memory{Unit: "b", Value: 2 * 1024 * 1024 * 1024})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dimm).To(BeNil())
+			})
+
+			It("should fail when the guest memory is lower than the domain memory", func() {
+				vmi := newDIMMVMI("1Gi")
+
+				_, err := memory.BuildDIMMDevice(vmi, api.Memory{Unit: "KiB", Value: 2 * 1024 * 1024})
+				Expect(err).To(MatchError("DIMMs can not be hot unplugged"))
+			})
+
+			DescribeTable("should assign the first free slot", func(domainXML, expectedSlot string) {
+				dimm := &api.MemoryDIMM{Model: "dimm"}
+				Expect(memory.AssignDIMMSlot(dimm, domainXML)).To(Succeed())
+				Expect(dimm.Address).To(Equal(&api.Address{Type: "dimm", Slot: expectedSlot}))
+			},
+				Entry("when no DIMM is plugged", `<domain><maxMemory slots="4" unit="KiB">4194304</maxMemory><devices></devices></domain>`, "0"),
+				Entry("when DIMMs are plugged", `<domain><maxMemory slots="4" unit="KiB">4194304</maxMemory><devices>
+					<memory model="dimm"><target><size unit="KiB">1048576</size><node>0</node></target><address type="dimm" slot="0" base="0x100000000"/></memory>
+					<memory model="dimm"><target><size unit="KiB">1048576</size><node>0</node></target><address type="dimm" slot="2" base="0x140000000"/></memory>
+				</devices></domain>`, "1"),
+			)
+
+			It("should fail when all slots are in use", func() {
+				domainXML := `<domain><maxMemory slots="1" unit="KiB">4194304</maxMemory><devices>
+					<memory model="dimm"><target><size unit="KiB">1048576</size><node>0</node></target><address type="dimm" slot="0"/></memory>
+				</devices></domain>`
+				Expect(memory.AssignDIMMSlot(&api.MemoryDIMM{Model: "dimm"}, domainXML)).To(MatchError("all 1 DIMM slots are in use"))
+			})
+
+			It("should fail when the domain has no slots", func() {
+				Expect(memory.AssignDIMMSlot(&api.MemoryDIMM{Model: "dimm"}, `<domain><devices></devices></domain>`)).To(MatchError("the domain has no DIMM slots"))
+			})
+		})
+
 	})
 })
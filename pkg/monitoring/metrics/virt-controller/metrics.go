@@ -56,6 +56,9 @@ type Stores struct {
 	Preference            cache.Store
 	ClusterPreference     cache.Store
 	ControllerRevision    cache.Store
+	VMSnapshot            cache.Store
+	VMExport              cache.Store
+	VMPool                cache.Store
 }
 
 var (
@@ -64,6 +67,8 @@ var (
 		migrationMetrics,
 		perfscaleMetrics,
 		vmSnapshotMetrics,
+		orphanedDiskMetrics,
+		usageMetrics,
 	}
 
 	indexers       *Indexers
@@ -122,6 +127,7 @@ func SetupMetrics(
 		migrationStatsCollector,
 		vmiStatsCollector,
 		vmStatsCollector,
+		objectStateCollector,
 	)
 }
 
@@ -0,0 +1,299 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"k8s.io/client-go/tools/cache"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	poolv1 "kubevirt.io/api/pool/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// The object state collector reports the state of KubeVirt objects in the
+// style of kube-state-metrics: one series per object, phase or condition with
+// the value 1, so that fleet dashboards can aggregate them with PromQL.
+
+var (
+	objectStateCollector = operatormetrics.Collector{
+		Metrics: []operatormetrics.Metric{
+			vmSpecInfo,
+			vmStatusCondition,
+			vmiStatusCondition,
+			vmimStatusPhase,
+			vmSnapshotStatusPhase,
+			vmSnapshotStatusCondition,
+			vmExportStatusPhase,
+			vmExportStatusCondition,
+			vmPoolDesiredReplicas,
+			vmPoolReplicas,
+			vmPoolReadyReplicas,
+			vmPoolStatusCondition,
+		},
+		CollectCallback: objectStateCollectorCallback,
+	}
+
+	conditionLabels = []string{"name", "namespace", "condition", "status", "reason"}
+	sourceLabels    = []string{"name", "namespace", "source_kind", "source_name", "phase"}
+
+	vmSpecInfo = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_spec_info",
+			Help: "Highlights of the spec of VirtualMachines, like the run strategy and the referenced instancetype and preference.",
+		},
+		[]string{"name", "namespace", "run_strategy", "instancetype_kind", "instancetype", "preference_kind", "preference"},
+	)
+
+	vmStatusCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vm_status_condition",
+			Help: "The conditions of VirtualMachines, with their status and reason.",
+		},
+		conditionLabels,
+	)
+
+	vmiStatusCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_status_condition",
+			Help: "The conditions of VirtualMachineInstances, with their status and reason.",
+		},
+		conditionLabels,
+	)
+
+	vmimStatusPhase = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmim_status_phase",
+			Help: "The current phase of VirtualMachineInstanceMigrations.",
+		},
+		[]string{"name", "namespace", "vmi", "phase"},
+	)
+
+	vmSnapshotStatusPhase = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_status_phase",
+			Help: "The current phase of VirtualMachineSnapshots.",
+		},
+		sourceLabels,
+	)
+
+	vmSnapshotStatusCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_status_condition",
+			Help: "The conditions of VirtualMachineSnapshots, with their status and reason.",
+		},
+		conditionLabels,
+	)
+
+	vmExportStatusPhase = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmexport_status_phase",
+			Help: "The current phase of VirtualMachineExports.",
+		},
+		sourceLabels,
+	)
+
+	vmExportStatusCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmexport_status_condition",
+			Help: "The conditions of VirtualMachineExports, with their status and reason.",
+		},
+		conditionLabels,
+	)
+
+	vmPoolDesiredReplicas = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmpool_desired_replicas",
+			Help: "The number of VirtualMachines requested by VirtualMachinePools.",
+		},
+		labels,
+	)
+
+	vmPoolReplicas = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmpool_replicas",
+			Help: "The number of VirtualMachines created by VirtualMachinePools.",
+		},
+		labels,
+	)
+
+	vmPoolReadyReplicas = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmpool_ready_replicas",
+			Help: "The number of ready VirtualMachines of VirtualMachinePools.",
+		},
+		labels,
+	)
+
+	vmPoolStatusCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmpool_status_condition",
+			Help: "The conditions of VirtualMachinePools, with their status and reason.",
+		},
+		conditionLabels,
+	)
+)
+
+func objectStateCollectorCallback() []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+
+	for _, obj := range listStore(stores.VM) {
+		cr = append(cr, reportVMState(obj.(*k6tv1.VirtualMachine))...)
+	}
+	for _, obj := range listStore(stores.VMI) {
+		cr = append(cr, reportVMIState(obj.(*k6tv1.VirtualMachineInstance))...)
+	}
+	if indexers.VMIMigration != nil {
+		for _, obj := range indexers.VMIMigration.List() {
+			cr = append(cr, reportVMIMState(obj.(*k6tv1.VirtualMachineInstanceMigration))...)
+		}
+	}
+	for _, obj := range listStore(stores.VMSnapshot) {
+		cr = append(cr, reportVMSnapshotState(obj.(*snapshotv1.VirtualMachineSnapshot))...)
+	}
+	for _, obj := range listStore(stores.VMExport) {
+		cr = append(cr, reportVMExportState(obj.(*exportv1.VirtualMachineExport))...)
+	}
+	for _, obj := range listStore(stores.VMPool) {
+		cr = append(cr, reportVMPoolState(obj.(*poolv1.VirtualMachinePool))...)
+	}
+
+	return cr
+}
+
+func listStore(store cache.Store) []interface{} {
+	if store == nil {
+		return nil
+	}
+	return store.List()
+}
+
+func reportVMState(vm *k6tv1.VirtualMachine) []operatormetrics.CollectorResult {
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		runStrategy = k6tv1.RunStrategyUnknown
+	}
+
+	instancetypeKind, instancetypeName := "<none>", "<none>"
+	if vm.Spec.Instancetype != nil {
+		instancetypeKind, instancetypeName = vm.Spec.Instancetype.Kind, vm.Spec.Instancetype.Name
+	}
+	preferenceKind, preferenceName := "<none>", "<none>"
+	if vm.Spec.Preference != nil {
+		preferenceKind, preferenceName = vm.Spec.Preference.Kind, vm.Spec.Preference.Name
+	}
+
+	cr := []operatormetrics.CollectorResult{{
+		Metric: vmSpecInfo,
+		Labels: []string{vm.Name, vm.Namespace, string(runStrategy), instancetypeKind, instancetypeName, preferenceKind, preferenceName},
+		Value:  1,
+	}}
+	for _, c := range vm.Status.Conditions {
+		cr = append(cr, conditionResult(vmStatusCondition, vm.Name, vm.Namespace, string(c.Type), string(c.Status), c.Reason))
+	}
+	return cr
+}
+
+func reportVMIState(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var cr []operatormetrics.CollectorResult
+	for _, c := range vmi.Status.Conditions {
+		cr = append(cr, conditionResult(vmiStatusCondition, vmi.Name, vmi.Namespace, string(c.Type), string(c.Status), c.Reason))
+	}
+	return cr
+}
+
+func reportVMIMState(vmim *k6tv1.VirtualMachineInstanceMigration) []operatormetrics.CollectorResult {
+	return []operatormetrics.CollectorResult{{
+		Metric: vmimStatusPhase,
+		Labels: []string{vmim.Name, vmim.Namespace, vmim.Spec.VMIName, phaseOrUnset(string(vmim.Status.Phase))},
+		Value:  1,
+	}}
+}
+
+func reportVMSnapshotState(snapshot *snapshotv1.VirtualMachineSnapshot) []operatormetrics.CollectorResult {
+	phase := ""
+	var conditions []snapshotv1.Condition
+	if snapshot.Status != nil {
+		phase = string(snapshot.Status.Phase)
+		conditions = snapshot.Status.Conditions
+	}
+
+	cr := []operatormetrics.CollectorResult{{
+		Metric: vmSnapshotStatusPhase,
+		Labels: []string{snapshot.Name, snapshot.Namespace, snapshot.Spec.Source.Kind, snapshot.Spec.Source.Name, phaseOrUnset(phase)},
+		Value:  1,
+	}}
+	for _, c := range conditions {
+		cr = append(cr, conditionResult(vmSnapshotStatusCondition, snapshot.Name, snapshot.Namespace, string(c.Type), string(c.Status), c.Reason))
+	}
+	return cr
+}
+
+func reportVMExportState(export *exportv1.VirtualMachineExport) []operatormetrics.CollectorResult {
+	phase := ""
+	var conditions []exportv1.Condition
+	if export.Status != nil {
+		phase = string(export.Status.Phase)
+		conditions = export.Status.Conditions
+	}
+
+	cr := []operatormetrics.CollectorResult{{
+		Metric: vmExportStatusPhase,
+		Labels: []string{export.Name, export.Namespace, export.Spec.Source.Kind, export.Spec.Source.Name, phaseOrUnset(phase)},
+		Value:  1,
+	}}
+	for _, c := range conditions {
+		cr = append(cr, conditionResult(vmExportStatusCondition, export.Name, export.Namespace, string(c.Type), string(c.Status), c.Reason))
+	}
+	return cr
+}
+
+func reportVMPoolState(pool *poolv1.VirtualMachinePool) []operatormetrics.CollectorResult {
+	desired := int32(1)
+	if pool.Spec.Replicas != nil {
+		desired = *pool.Spec.Replicas
+	}
+
+	cr := []operatormetrics.CollectorResult{
+		{Metric: vmPoolDesiredReplicas, Labels: []string{pool.Name, pool.Namespace}, Value: float64(desired)},
+		{Metric: vmPoolReplicas, Labels: []string{pool.Name, pool.Namespace}, Value: float64(pool.Status.Replicas)},
+		{Metric: vmPoolReadyReplicas, Labels: []string{pool.Name, pool.Namespace}, Value: float64(pool.Status.ReadyReplicas)},
+	}
+	for _, c := range pool.Status.Conditions {
+		cr = append(cr, conditionResult(vmPoolStatusCondition, pool.Name, pool.Namespace, string(c.Type), string(c.Status), c.Reason))
+	}
+	return cr
+}
+
+func conditionResult(metric operatormetrics.Metric, name, namespace, conditionType, status, reason string) operatormetrics.CollectorResult {
+	return operatormetrics.CollectorResult{
+		Metric: metric,
+		Labels: []string{name, namespace, conditionType, status, reason},
+		Value:  1,
+	}
+}
+
+func phaseOrUnset(phase string) string {
+	if phase == "" {
+		return "Unset"
+	}
+	return phase
+}
@@ -0,0 +1,188 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	poolv1 "kubevirt.io/api/pool/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Object State Collector", func() {
+	objectMeta := metav1.ObjectMeta{Name: "test", Namespace: "test-ns"}
+
+	It("should report the run strategy, instancetype and preference of VMs", func() {
+		vm := &k6tv1.VirtualMachine{
+			ObjectMeta: objectMeta,
+			Spec: k6tv1.VirtualMachineSpec{
+				RunStrategy:  pointer.P(k6tv1.RunStrategyAlways),
+				Instancetype: &k6tv1.InstancetypeMatcher{Kind: "VirtualMachineClusterInstancetype", Name: "u1.medium"},
+			},
+			Status: k6tv1.VirtualMachineStatus{
+				Conditions: []k6tv1.VirtualMachineCondition{
+					{Type: k6tv1.VirtualMachineReady, Status: k8sv1.ConditionFalse, Reason: "PodNotReady"},
+				},
+			},
+		}
+
+		cr := reportVMState(vm)
+		Expect(cr).To(HaveLen(2))
+		Expect(cr[0].Metric).To(Equal(vmSpecInfo))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "Always", "VirtualMachineClusterInstancetype", "u1.medium", "<none>", "<none>"}))
+		Expect(cr[1].Metric).To(Equal(vmStatusCondition))
+		Expect(cr[1].Labels).To(Equal([]string{"test", "test-ns", "Ready", "False", "PodNotReady"}))
+	})
+
+	It("should report the conditions of VMIs", func() {
+		vmi := &k6tv1.VirtualMachineInstance{
+			ObjectMeta: objectMeta,
+			Status: k6tv1.VirtualMachineInstanceStatus{
+				Conditions: []k6tv1.VirtualMachineInstanceCondition{
+					{Type: k6tv1.VirtualMachineInstanceReady, Status: k8sv1.ConditionTrue},
+					{Type: k6tv1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionFalse, Reason: k6tv1.VirtualMachineInstanceReasonDisksNotMigratable},
+				},
+			},
+		}
+
+		cr := reportVMIState(vmi)
+		Expect(cr).To(HaveLen(2))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "Ready", "True", ""}))
+		Expect(cr[1].Labels).To(Equal([]string{"test", "test-ns", "LiveMigratable", "False", k6tv1.VirtualMachineInstanceReasonDisksNotMigratable}))
+	})
+
+	DescribeTable("should report the phase of migrations", func(phase k6tv1.VirtualMachineInstanceMigrationPhase, expectedPhase string) {
+		vmim := &k6tv1.VirtualMachineInstanceMigration{
+			ObjectMeta: objectMeta,
+			Spec:       k6tv1.VirtualMachineInstanceMigrationSpec{VMIName: "test-vmi"},
+			Status:     k6tv1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+		}
+
+		cr := reportVMIMState(vmim)
+		Expect(cr).To(HaveLen(1))
+		Expect(cr[0].Metric).To(Equal(vmimStatusPhase))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "test-vmi", expectedPhase}))
+	},
+		Entry("with the phase unset", k6tv1.MigrationPhaseUnset, "Unset"),
+		Entry("with the phase running", k6tv1.MigrationRunning, "Running"),
+	)
+
+	It("should report the phase and conditions of snapshots", func() {
+		snapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: objectMeta,
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: k8sv1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: "test-vm"},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: snapshotv1.InProgress,
+				Conditions: []snapshotv1.Condition{
+					{Type: snapshotv1.ConditionProgressing, Status: k8sv1.ConditionTrue, Reason: "Source locked"},
+				},
+			},
+		}
+
+		cr := reportVMSnapshotState(snapshot)
+		Expect(cr).To(HaveLen(2))
+		Expect(cr[0].Metric).To(Equal(vmSnapshotStatusPhase))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "VirtualMachine", "test-vm", "InProgress"}))
+		Expect(cr[1].Metric).To(Equal(vmSnapshotStatusCondition))
+		Expect(cr[1].Labels).To(Equal([]string{"test", "test-ns", "Progressing", "True", "Source locked"}))
+	})
+
+	It("should report snapshots without status as unset", func() {
+		snapshot := &snapshotv1.VirtualMachineSnapshot{ObjectMeta: objectMeta}
+
+		cr := reportVMSnapshotState(snapshot)
+		Expect(cr).To(HaveLen(1))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "", "", "Unset"}))
+	})
+
+	It("should report the phase and conditions of exports", func() {
+		export := &exportv1.VirtualMachineExport{
+			ObjectMeta: objectMeta,
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: k8sv1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "test-pvc"},
+			},
+			Status: &exportv1.VirtualMachineExportStatus{
+				Phase: exportv1.Ready,
+				Conditions: []exportv1.Condition{
+					{Type: exportv1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "PodReady"},
+				},
+			},
+		}
+
+		cr := reportVMExportState(export)
+		Expect(cr).To(HaveLen(2))
+		Expect(cr[0].Metric).To(Equal(vmExportStatusPhase))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "PersistentVolumeClaim", "test-pvc", "Ready"}))
+		Expect(cr[1].Metric).To(Equal(vmExportStatusCondition))
+		Expect(cr[1].Labels).To(Equal([]string{"test", "test-ns", "Ready", "True", "PodReady"}))
+	})
+
+	DescribeTable("should report the replicas of pools", func(replicas *int32, expectedDesired float64) {
+		pool := &poolv1.VirtualMachinePool{
+			ObjectMeta: objectMeta,
+			Spec:       poolv1.VirtualMachinePoolSpec{Replicas: replicas},
+			Status: poolv1.VirtualMachinePoolStatus{
+				Replicas:      2,
+				ReadyReplicas: 1,
+			},
+		}
+
+		cr := reportVMPoolState(pool)
+		Expect(cr).To(HaveLen(3))
+		Expect(cr[0].Metric).To(Equal(vmPoolDesiredReplicas))
+		Expect(cr[0].Value).To(Equal(expectedDesired))
+		Expect(cr[1].Metric).To(Equal(vmPoolReplicas))
+		Expect(cr[1].Value).To(BeEquivalentTo(2))
+		Expect(cr[2].Metric).To(Equal(vmPoolReadyReplicas))
+		Expect(cr[2].Value).To(BeEquivalentTo(1))
+	},
+		Entry("with replicas set", pointer.P(int32(3)), 3.0),
+		Entry("with replicas defaulted", nil, 1.0),
+	)
+
+	It("should collect all objects in the stores", func() {
+		vmInformer, _ := testutils.NewFakeInformerFor(&k6tv1.VirtualMachine{})
+		poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+		originalStores, originalIndexers := stores, indexers
+		DeferCleanup(func() {
+			stores, indexers = originalStores, originalIndexers
+		})
+		stores = &Stores{VM: vmInformer.GetStore(), VMPool: poolInformer.GetStore()}
+		indexers = &Indexers{}
+
+		Expect(vmInformer.GetStore().Add(&k6tv1.VirtualMachine{ObjectMeta: objectMeta})).To(Succeed())
+		Expect(poolInformer.GetStore().Add(&poolv1.VirtualMachinePool{ObjectMeta: objectMeta})).To(Succeed())
+
+		cr := objectStateCollectorCallback()
+		Expect(cr).To(HaveLen(4))
+		Expect(cr[0].Labels).To(Equal([]string{"test", "test-ns", "Halted", "<none>", "<none>", "<none>", "<none>"}))
+	})
+})
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+var (
+	orphanedDiskMetrics = []operatormetrics.Metric{
+		orphanedDisks,
+		orphanedDisksReclaimableBytes,
+	}
+
+	orphanedDisks = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_orphaned_disks",
+			Help: "The number of DataVolumes and PersistentVolumeClaims which are not referenced by any VM or snapshot for longer than the grace period.",
+		},
+		[]string{"namespace"},
+	)
+
+	orphanedDisksReclaimableBytes = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_orphaned_disks_reclaimable_bytes",
+			Help: "The storage capacity in bytes which deleting the orphaned disks would reclaim.",
+		},
+		[]string{"namespace"},
+	)
+)
+
+// SetOrphanedDisks reports the orphaned disks of a namespace, namespaces without orphans are not reported
+func SetOrphanedDisks(namespace string, count int, reclaimableBytes int64) {
+	if count == 0 {
+		orphanedDisks.DeleteLabelValues(namespace)
+		orphanedDisksReclaimableBytes.DeleteLabelValues(namespace)
+		return
+	}
+	orphanedDisks.WithLabelValues(namespace).Set(float64(count))
+	orphanedDisksReclaimableBytes.WithLabelValues(namespace).Set(float64(reclaimableBytes))
+}
+
+func GetOrphanedDisks(namespace string) (count float64, reclaimableBytes float64, err error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := orphanedDisks.WithLabelValues(namespace).Write(dto); err != nil {
+		return 0, 0, err
+	}
+	count = *dto.Gauge.Value
+	if err := orphanedDisksReclaimableBytes.WithLabelValues(namespace).Write(dto); err != nil {
+		return 0, 0, err
+	}
+	return count, *dto.Gauge.Value, nil
+}
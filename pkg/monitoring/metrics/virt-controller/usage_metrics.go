@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+const (
+	// UsageAllocated labels the guest resources of running VMIs
+	UsageAllocated = "allocated"
+	// UsageActual labels the resources the launcher pods of VMIs reserve on their nodes
+	UsageActual = "actual"
+)
+
+var (
+	usageMetrics = []operatormetrics.Metric{
+		namespaceVCPUSeconds,
+		namespaceMemoryByteSeconds,
+	}
+
+	namespaceVCPUSeconds = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_vcpu_seconds_total",
+			Help: "Total vCPU seconds consumed by the running VMIs of a namespace, either allocated to the guests or actually reserved on the nodes.",
+		},
+		[]string{"namespace", "type"},
+	)
+
+	namespaceMemoryByteSeconds = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_namespace_memory_byte_seconds_total",
+			Help: "Total memory byte seconds consumed by the running VMIs of a namespace, either allocated to the guests or actually reserved on the nodes.",
+		},
+		[]string{"namespace", "type"},
+	)
+)
+
+// AddNamespaceUsage accounts vCPU and memory byte seconds of the given type to a namespace
+func AddNamespaceUsage(namespace, usageType string, vcpuSeconds, memoryByteSeconds float64) {
+	namespaceVCPUSeconds.WithLabelValues(namespace, usageType).Add(vcpuSeconds)
+	namespaceMemoryByteSeconds.WithLabelValues(namespace, usageType).Add(memoryByteSeconds)
+}
+
+func GetNamespaceVCPUSeconds(namespace, usageType string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := namespaceVCPUSeconds.WithLabelValues(namespace, usageType).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Counter.Value, nil
+}
@@ -63,6 +63,7 @@ var (
 			vmiVnicInfo,
 			vmiLauncherMemoryOverhead,
 			vmiEphemeralHotplugVolume,
+			vmiDegradedCondition,
 		},
 		CollectCallback: vmiStatsCollectorCallback,
 	}
@@ -146,6 +147,22 @@ var (
 		},
 		[]string{"namespace", "name", "volume_name"},
 	)
+
+	vmiDegradedCondition = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_degraded_condition",
+			Help: "Reported for each degraded condition of a VirtualMachineInstance which is true, i.e. StorageDegraded, " +
+				"NetworkDegraded, GuestAgentOutdated and MigrationBlocked, with the reason code of the condition.",
+		},
+		[]string{"node", "namespace", "name", "condition", "reason"},
+	)
+
+	degradedConditions = []k6tv1.VirtualMachineInstanceConditionType{
+		k6tv1.VirtualMachineInstanceStorageDegraded,
+		k6tv1.VirtualMachineInstanceNetworkDegraded,
+		k6tv1.VirtualMachineInstanceGuestAgentOutdated,
+		k6tv1.VirtualMachineInstanceMigrationBlocked,
+	}
 )
 
 func vmiStatsCollectorCallback() []operatormetrics.CollectorResult {
@@ -175,6 +192,7 @@ func reportVmisStats(vmis []*k6tv1.VirtualMachineInstance) []operatormetrics.Col
 		crs = append(crs, CollectVmisVnicInfo(vmi)...)
 		crs = append(crs, collectVMILauncherMemoryOverhead(vmi))
 		crs = append(crs, collectVMIEphemeralHotplug(vmi)...)
+		crs = append(crs, collectVMIDegradedConditions(vmi)...)
 	}
 
 	return crs
@@ -342,6 +360,23 @@ func fetchResourceName(key string, store cache.Store) string {
 	return other
 }
 
+func collectVMIDegradedConditions(vmi *k6tv1.VirtualMachineInstance) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	for _, condType := range degradedConditions {
+		cond := condManager.GetCondition(vmi, condType)
+		if cond == nil || cond.Status != k8sv1.ConditionTrue {
+			continue
+		}
+		crs = append(crs, operatormetrics.CollectorResult{
+			Metric: vmiDegradedCondition,
+			Labels: []string{vmi.Status.NodeName, vmi.Namespace, vmi.Name, string(condType), cond.Reason},
+			Value:  1,
+		})
+	}
+	return crs
+}
+
 func getEvictionBlocker(vmi *k6tv1.VirtualMachineInstance) operatormetrics.CollectorResult {
 	nonEvictable := 1.0
 	if isVMEvictable(vmi) {
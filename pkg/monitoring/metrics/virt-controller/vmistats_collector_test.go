@@ -693,6 +693,41 @@ var _ = Describe("VMI Stats Collector", func() {
 			Expect(metric1.Value).To(BeNumerically("<", metric2.Value))
 		})
 	})
+
+	Context("VMI degraded conditions", func() {
+		It("should report the true degraded conditions with their reason", func() {
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-ns",
+					Name:      "test-vmi",
+				},
+				Status: k6tv1.VirtualMachineInstanceStatus{
+					NodeName: "test-node",
+					Conditions: []k6tv1.VirtualMachineInstanceCondition{
+						{
+							Type:   k6tv1.VirtualMachineInstanceStorageDegraded,
+							Status: k8sv1.ConditionTrue,
+							Reason: k6tv1.VirtualMachineInstanceReasonIOError,
+						},
+						{
+							Type:   k6tv1.VirtualMachineInstanceMigrationBlocked,
+							Status: k8sv1.ConditionFalse,
+						},
+						{
+							Type:   k6tv1.VirtualMachineInstanceReady,
+							Status: k8sv1.ConditionTrue,
+						},
+					},
+				},
+			}
+
+			crs := collectVMIDegradedConditions(vmi)
+			Expect(crs).To(HaveLen(1))
+			Expect(crs[0].Metric.GetOpts().Name).To(Equal("kubevirt_vmi_degraded_condition"))
+			Expect(crs[0].Labels).To(Equal([]string{"test-node", "test-ns", "test-vmi", "StorageDegraded", "IOError"}))
+			Expect(crs[0].Value).To(BeEquivalentTo(1))
+		})
+	})
 })
 
 func interfacesFor(values [][]string) []k6tv1.VirtualMachineInstanceNetworkInterface {
@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	diskSpaceMetrics = []operatormetrics.Metric{
+		diskSpaceLow,
+	}
+
+	diskSpaceLow = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_guest_filesystem_space_low",
+			Help: "Indicates that a guest filesystem of a VMI exceeds the utilization set in spec.diskSpaceLow, broken down by namespace, vmi name and mount point.",
+		},
+		[]string{"namespace", "name", "mount_point"},
+	)
+)
+
+// SetDiskSpaceLow reports the mount points of the guest filesystems of a VMI which are low on space
+func SetDiskSpaceLow(namespace, name string, mountPoints []string) {
+	DeleteDiskSpaceLow(namespace, name)
+	for _, mountPoint := range mountPoints {
+		diskSpaceLow.WithLabelValues(namespace, name, mountPoint).Set(1)
+	}
+}
+
+// DeleteDiskSpaceLow removes the series of a VMI, once it is gone from the node
+func DeleteDiskSpaceLow(namespace, name string) {
+	diskSpaceLow.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "name": name})
+}
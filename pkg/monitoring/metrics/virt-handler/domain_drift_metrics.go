@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	domainDriftMetrics = []operatormetrics.Metric{
+		domainDrift,
+	}
+
+	domainDrift = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_domain_drift",
+			Help: "Indicates that the live domain of a VMI deviates from the domain generated by KubeVirt, broken down by namespace and vmi name.",
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+func SetDomainDrift(namespace, name string, drifted bool) {
+	if !drifted {
+		DeleteDomainDrift(namespace, name)
+		return
+	}
+	domainDrift.WithLabelValues(namespace, name).Set(1)
+}
+
+// DeleteDomainDrift removes the series of a VMI, once it is gone from the node
+func DeleteDomainDrift(namespace, name string) {
+	domainDrift.DeleteLabelValues(namespace, name)
+}
@@ -40,6 +40,7 @@ var (
 		networkMetrics{},
 		cpuAffinityMetrics{},
 		filesystemMetrics{},
+		gpuMetrics{},
 	}
 
 	Collector = operatormetrics.Collector{
@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package domainstats
+
+import (
+	"strings"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/dra"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
+)
+
+var (
+	gpuUtilization = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_gpu_utilization_ratio",
+			Help: "Utilization of the GPU assigned to the VMI, as reported by the host driver, in the range [0, 1]. For mediated devices (vGPUs) the value reflects the whole parent GPU.",
+		},
+	)
+
+	gpuMemoryUsedBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_gpu_memory_used_bytes",
+			Help: "Amount of GPU memory in use, as reported by the host driver. For mediated devices (vGPUs) the value reflects the whole parent GPU.",
+		},
+	)
+
+	gpuMemoryTotalBytes = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_gpu_memory_total_bytes",
+			Help: "Total amount of GPU memory, as reported by the host driver. For mediated devices (vGPUs) the value reflects the whole parent GPU.",
+		},
+	)
+)
+
+type gpuMetrics struct{}
+
+func (gpuMetrics) Describe() []operatormetrics.Metric {
+	return []operatormetrics.Metric{
+		gpuUtilization,
+		gpuMemoryUsedBytes,
+		gpuMemoryTotalBytes,
+	}
+}
+
+func (gpuMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatormetrics.CollectorResult {
+	var crs []operatormetrics.CollectorResult
+
+	if vmiReport.vmiStats.DomainStats == nil {
+		return crs
+	}
+
+	for _, hostDev := range vmiReport.vmiStats.DomainStats.HostDevices {
+		gpuName, isGPU := gpuNameFromAlias(hostDev.Alias)
+		if !isGPU {
+			continue
+		}
+
+		pciAddress, gpuStats, err := gpuReader.Read(hostDev)
+		if err != nil {
+			log.Log.Object(vmiReport.vmi).Reason(err).V(4).Infof("failed to read stats of GPU %s", gpuName)
+			continue
+		}
+
+		additionalLabels := map[string]string{
+			"gpu":         gpuName,
+			"pci_address": pciAddress,
+		}
+
+		if gpuStats.UtilizationSet {
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(gpuUtilization, gpuStats.Utilization, additionalLabels))
+		}
+
+		if gpuStats.MemoryUsedSet {
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(gpuMemoryUsedBytes, float64(gpuStats.MemoryUsed), additionalLabels))
+		}
+
+		if gpuStats.MemoryTotalSet {
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(gpuMemoryTotalBytes, float64(gpuStats.MemoryTotal), additionalLabels))
+		}
+	}
+
+	return crs
+}
+
+// gpuNameFromAlias returns the name of the VMI GPU the host device was created for,
+// covering both device plugin and DRA provided GPUs.
+func gpuNameFromAlias(alias string) (string, bool) {
+	if name, found := strings.CutPrefix(alias, dra.AliasPrefix); found {
+		return name, true
+	}
+	return strings.CutPrefix(alias, gpu.AliasPrefix)
+}
@@ -0,0 +1,197 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package domainstats
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/monitoring/metrics/testing"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+type fakeGPUStatsReader struct {
+	stats map[string]*gpuStats
+}
+
+func (f *fakeGPUStatsReader) Read(hostDev stats.DomainStatsHostDevice) (string, *gpuStats, error) {
+	return hostDev.PCIAddress, f.stats[hostDev.PCIAddress], nil
+}
+
+var _ = Describe("gpu metrics", func() {
+	Context("on Collect", func() {
+		var (
+			origReader gpuStatsReader
+			vmiReport  *VirtualMachineInstanceReport
+		)
+
+		BeforeEach(func() {
+			origReader = gpuReader
+			gpuReader = &fakeGPUStatsReader{
+				stats: map[string]*gpuStats{
+					"0000:81:00.0": {
+						UtilizationSet: true,
+						Utilization:    0.42,
+						MemoryUsedSet:  true,
+						MemoryUsed:     1024,
+						MemoryTotalSet: true,
+						MemoryTotal:    4096,
+					},
+				},
+			}
+
+			vmi := &k6tv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vmi-1",
+					Namespace: "test-ns-1",
+				},
+			}
+			vmiStats := &VirtualMachineInstanceStats{
+				DomainStats: &stats.DomainStats{
+					HostDevices: []stats.DomainStatsHostDevice{
+						{Alias: "gpu-gpu1", PCIAddress: "0000:81:00.0"},
+						{Alias: "hostdevice-nic1", PCIAddress: "0000:82:00.0"},
+					},
+				},
+			}
+			vmiReport = newVirtualMachineInstanceReport(vmi, vmiStats)
+		})
+
+		AfterEach(func() {
+			gpuReader = origReader
+		})
+
+		DescribeTable("should collect metrics values", func(metric operatormetrics.Metric, expectedValue float64) {
+			crs := gpuMetrics{}.Collect(vmiReport)
+			Expect(crs).To(ContainElement(testing.GomegaContainsCollectorResultMatcher(metric, expectedValue)))
+		},
+			Entry("kubevirt_vmi_gpu_utilization_ratio", gpuUtilization, 0.42),
+			Entry("kubevirt_vmi_gpu_memory_used_bytes", gpuMemoryUsedBytes, 1024.0),
+			Entry("kubevirt_vmi_gpu_memory_total_bytes", gpuMemoryTotalBytes, 4096.0),
+		)
+
+		It("should only report GPU host devices", func() {
+			crs := gpuMetrics{}.Collect(vmiReport)
+			Expect(crs).To(HaveLen(3))
+			for _, cr := range crs {
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("gpu", "gpu1"))
+				Expect(cr.ConstLabels).To(HaveKeyWithValue("pci_address", "0000:81:00.0"))
+			}
+		})
+
+		It("result should be empty if stat not populated", func() {
+			vmiReport.vmiStats.DomainStats.HostDevices = nil
+			Expect(gpuMetrics{}.Collect(vmiReport)).To(BeEmpty())
+		})
+	})
+
+	Context("gpuNameFromAlias", func() {
+		DescribeTable("should resolve the GPU name", func(alias, expectedName string, expectedGPU bool) {
+			name, isGPU := gpuNameFromAlias(alias)
+			Expect(isGPU).To(Equal(expectedGPU))
+			Expect(name).To(Equal(expectedName))
+		},
+			Entry("device plugin GPU", "gpu-gpu1", "gpu1", true),
+			Entry("DRA GPU", "dra-gpu-gpu1", "gpu1", true),
+			Entry("generic host device", "hostdevice-dev1", "hostdevice-dev1", false),
+		)
+	})
+
+	Context("hostGPUStatsReader", func() {
+		var origPCIBasePath, origMdevBasePath string
+
+		BeforeEach(func() {
+			origPCIBasePath, origMdevBasePath = pciBasePath, mdevBasePath
+			root := GinkgoT().TempDir()
+			pciBasePath = filepath.Join(root, "pci")
+			mdevBasePath = filepath.Join(root, "mdev")
+
+			devicePath := filepath.Join(pciBasePath, "0000:81:00.0")
+			Expect(os.MkdirAll(devicePath, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(devicePath, "gpu_busy_percent"), []byte("25\n"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(devicePath, "mem_info_vram_used"), []byte("2048\n"), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(devicePath, "mem_info_vram_total"), []byte("8192\n"), 0644)).To(Succeed())
+
+			Expect(os.MkdirAll(mdevBasePath, 0755)).To(Succeed())
+			Expect(os.Symlink(filepath.Join(devicePath, "5a2d1bd4-0fbd-4a88-b2c8-2aa8e6e0b6a1"),
+				filepath.Join(mdevBasePath, "5a2d1bd4-0fbd-4a88-b2c8-2aa8e6e0b6a1"))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			pciBasePath, mdevBasePath = origPCIBasePath, origMdevBasePath
+		})
+
+		expectedStats := &gpuStats{
+			UtilizationSet: true,
+			Utilization:    0.25,
+			MemoryUsedSet:  true,
+			MemoryUsed:     2048,
+			MemoryTotalSet: true,
+			MemoryTotal:    8192,
+		}
+
+		It("should read stats of a PCI GPU from sysfs", func() {
+			pciAddress, result, err := (&hostGPUStatsReader{}).Read(stats.DomainStatsHostDevice{PCIAddress: "0000:81:00.0"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pciAddress).To(Equal("0000:81:00.0"))
+			Expect(result).To(Equal(expectedStats))
+		})
+
+		It("should read stats of the parent GPU of a mediated device", func() {
+			pciAddress, result, err := (&hostGPUStatsReader{}).Read(stats.DomainStatsHostDevice{MDevUUID: "5a2d1bd4-0fbd-4a88-b2c8-2aa8e6e0b6a1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pciAddress).To(Equal("0000:81:00.0"))
+			Expect(result).To(Equal(expectedStats))
+		})
+	})
+
+	Context("parseNvidiaSMIOutput", func() {
+		It("should parse utilization and memory", func() {
+			result, err := parseNvidiaSMIOutput([]byte("37, 512, 16384\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(&gpuStats{
+				UtilizationSet: true,
+				Utilization:    0.37,
+				MemoryUsedSet:  true,
+				MemoryUsed:     512 * mebibyte,
+				MemoryTotalSet: true,
+				MemoryTotal:    16384 * mebibyte,
+			}))
+		})
+
+		It("should skip fields which are not supported", func() {
+			result, err := parseNvidiaSMIOutput([]byte("[N/A], 512, 16384"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.UtilizationSet).To(BeFalse())
+			Expect(result.MemoryUsedSet).To(BeTrue())
+		})
+
+		It("should fail on unexpected output", func() {
+			_, err := parseNvidiaSMIOutput([]byte("No devices were found"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
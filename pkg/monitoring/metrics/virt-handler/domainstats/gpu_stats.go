@@ -0,0 +1,178 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package domainstats
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	nvidiaSMIBinary  = "nvidia-smi"
+	nvidiaSMITimeout = 2 * time.Second
+	mebibyte         = 1024 * 1024
+)
+
+var (
+	pciBasePath  = "/sys/bus/pci/devices"
+	mdevBasePath = "/sys/bus/mdev/devices"
+
+	gpuReader gpuStatsReader = &hostGPUStatsReader{}
+)
+
+type gpuStats struct {
+	UtilizationSet bool
+	Utilization    float64
+	MemoryUsedSet  bool
+	MemoryUsed     uint64
+	MemoryTotalSet bool
+	MemoryTotal    uint64
+}
+
+type gpuStatsReader interface {
+	// Read returns the PCI address of the physical GPU backing the host
+	// device together with the statistics reported for it.
+	Read(hostDev stats.DomainStatsHostDevice) (string, *gpuStats, error)
+}
+
+// hostGPUStatsReader reads GPU statistics from the host driver. The vendor
+// sysfs attributes (as exposed by amdgpu) are preferred; nvidia-smi is used as
+// a fallback when it is available in the PATH.
+// GPUs bound to vfio-pci for full passthrough are owned by the guest and the
+// host driver has no visibility into them, so no stats are reported.
+type hostGPUStatsReader struct{}
+
+func (r *hostGPUStatsReader) Read(hostDev stats.DomainStatsHostDevice) (string, *gpuStats, error) {
+	pciAddress := hostDev.PCIAddress
+	if hostDev.MDevUUID != "" {
+		parent, err := mdevParentPCIAddress(hostDev.MDevUUID)
+		if err != nil {
+			return "", nil, err
+		}
+		pciAddress = parent
+	}
+	if pciAddress == "" {
+		return "", nil, fmt.Errorf("no PCI address for host device %s", hostDev.Alias)
+	}
+
+	if gpuStats := readSysfsGPUStats(pciAddress); gpuStats != nil {
+		return pciAddress, gpuStats, nil
+	}
+
+	gpuStats, err := readNvidiaSMIGPUStats(pciAddress)
+	if err != nil {
+		return "", nil, err
+	}
+	return pciAddress, gpuStats, nil
+}
+
+// /sys/bus/mdev/devices/<uuid> -> ../../../devices/pci0000:80/0000:80:03.0/0000:81:00.0/<uuid>
+func mdevParentPCIAddress(mdevUUID string) (string, error) {
+	mdevLink, err := os.Readlink(filepath.Join(mdevBasePath, mdevUUID))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(filepath.Dir(mdevLink)), nil
+}
+
+func readSysfsGPUStats(pciAddress string) *gpuStats {
+	devicePath := filepath.Join(pciBasePath, pciAddress)
+	gpuStats := &gpuStats{}
+
+	if busy, err := readUintFile(filepath.Join(devicePath, "gpu_busy_percent")); err == nil {
+		gpuStats.UtilizationSet = true
+		gpuStats.Utilization = float64(busy) / 100
+	}
+	if used, err := readUintFile(filepath.Join(devicePath, "mem_info_vram_used")); err == nil {
+		gpuStats.MemoryUsedSet = true
+		gpuStats.MemoryUsed = used
+	}
+	if total, err := readUintFile(filepath.Join(devicePath, "mem_info_vram_total")); err == nil {
+		gpuStats.MemoryTotalSet = true
+		gpuStats.MemoryTotal = total
+	}
+
+	if !gpuStats.UtilizationSet && !gpuStats.MemoryUsedSet && !gpuStats.MemoryTotalSet {
+		return nil
+	}
+	return gpuStats
+}
+
+func readUintFile(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func readNvidiaSMIGPUStats(pciAddress string) (*gpuStats, error) {
+	binary, err := exec.LookPath(nvidiaSMIBinary)
+	if err != nil {
+		return nil, fmt.Errorf("no GPU stats available for %s: %v", pciAddress, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), nvidiaSMITimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, binary,
+		"--id="+pciAddress,
+		"--query-gpu=utilization.gpu,memory.used,memory.total",
+		"--format=csv,noheader,nounits",
+	).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s for %s: %v", nvidiaSMIBinary, pciAddress, err)
+	}
+
+	return parseNvidiaSMIOutput(out)
+}
+
+// parseNvidiaSMIOutput parses a single "<utilization %>, <used MiB>, <total MiB>" line.
+// Fields the driver does not support are reported as "[N/A]" and are skipped.
+func parseNvidiaSMIOutput(out []byte) (*gpuStats, error) {
+	line, _, _ := bytes.Cut(bytes.TrimSpace(out), []byte("\n"))
+	fields := strings.Split(string(line), ",")
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected %s output: %q", nvidiaSMIBinary, string(line))
+	}
+
+	gpuStats := &gpuStats{}
+	if utilization, err := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 64); err == nil {
+		gpuStats.UtilizationSet = true
+		gpuStats.Utilization = float64(utilization) / 100
+	}
+	if used, err := strconv.ParseUint(strings.TrimSpace(fields[1]), 10, 64); err == nil {
+		gpuStats.MemoryUsedSet = true
+		gpuStats.MemoryUsed = used * mebibyte
+	}
+	if total, err := strconv.ParseUint(strings.TrimSpace(fields[2]), 10, 64); err == nil {
+		gpuStats.MemoryTotalSet = true
+		gpuStats.MemoryTotal = total * mebibyte
+	}
+	return gpuStats, nil
+}
@@ -45,7 +45,14 @@ var (
 	vcpuDelaySeconds = operatormetrics.NewCounter(
 		operatormetrics.MetricOpts{
 			Name: "kubevirt_vmi_vcpu_delay_seconds_total",
-			Help: "Amount of time spent by each vcpu waiting in the queue instead of running.",
+			Help: "Amount of time spent by each vcpu waiting in the queue instead of running. The guest sees this time as steal time.",
+		},
+	)
+
+	vcpuTimeslices = operatormetrics.NewCounter(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_vcpu_timeslices_total",
+			Help: "Number of timeslices each vcpu ran on the host CPU. Divide kubevirt_vmi_vcpu_delay_seconds_total by it to get the average scheduling latency.",
 		},
 	)
 )
@@ -57,6 +64,7 @@ func (vcpuMetrics) Describe() []operatormetrics.Metric {
 		vcpuSeconds,
 		vcpuWaitSeconds,
 		vcpuDelaySeconds,
+		vcpuTimeslices,
 	}
 }
 
@@ -91,6 +99,13 @@ func (vcpuMetrics) Collect(vmiReport *VirtualMachineInstanceReport) []operatorme
 			}
 			crs = append(crs, vmiReport.newCollectorResultWithLabels(vcpuDelaySeconds, nanosecondsToSeconds(vcpu.Delay), additionalLabels))
 		}
+
+		if vcpu.TimeslicesSet {
+			additionalLabels := map[string]string{
+				"id": stringVcpuIdx,
+			}
+			crs = append(crs, vmiReport.newCollectorResultWithLabels(vcpuTimeslices, float64(vcpu.Timeslices), additionalLabels))
+		}
 	}
 
 	return crs
@@ -44,12 +44,14 @@ var _ = Describe("vcpu metrics", func() {
 			DomainStats: &stats.DomainStats{
 				Vcpu: []stats.DomainStatsVcpu{
 					{
-						TimeSet:  true,
-						Time:     1,
-						WaitSet:  true,
-						Wait:     2,
-						DelaySet: true,
-						Delay:    3,
+						TimeSet:       true,
+						Time:          1,
+						WaitSet:       true,
+						Wait:          2,
+						DelaySet:      true,
+						Delay:         3,
+						TimeslicesSet: true,
+						Timeslices:    4,
 					},
 				},
 			},
@@ -64,6 +66,7 @@ var _ = Describe("vcpu metrics", func() {
 			Entry("kubevirt_vmi_vcpu_seconds_total", vcpuSeconds, nanosecondsToSeconds(1)),
 			Entry("kubevirt_vmi_vcpu_wait_seconds_total", vcpuWaitSeconds, nanosecondsToSeconds(2)),
 			Entry("kubevirt_vmi_vcpu_delay_seconds_total", vcpuDelaySeconds, nanosecondsToSeconds(3)),
+			Entry("kubevirt_vmi_vcpu_timeslices_total", vcpuTimeslices, 4.0),
 		)
 
 		It("result should be empty if stat not populated or set is false", func() {
@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
+)
+
+var (
+	ioErrorMetrics = []operatormetrics.Metric{
+		ioErrorResumeAttempts,
+	}
+
+	ioErrorResumeAttempts = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_io_error_resume_attempts_total",
+			Help: "The total number of attempts to resume a VMI paused on an IO error, broken down by namespace and vmi name.",
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+func NewIOErrorResumeAttempt(namespace, name string) {
+	ioErrorResumeAttempts.WithLabelValues(namespace, name).Inc()
+}
+
+// DeleteIOErrorResumeAttempts removes the series of a VMI, once it is gone from the node
+func DeleteIOErrorResumeAttempts(namespace, name string) {
+	ioErrorResumeAttempts.DeleteLabelValues(namespace, name)
+}
@@ -32,6 +32,7 @@ func TestAdmitter(t *testing.T) {
 type stubClusterConfigChecker struct {
 	bridgeBindingOnPodNetEnabled bool
 	macvtapFeatureGateEnabled    bool
+	sriovFailoverEnabled         bool
 }
 
 func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
@@ -41,3 +42,7 @@ func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 func (s stubClusterConfigChecker) MacvtapEnabled() bool {
 	return s.macvtapFeatureGateEnabled
 }
+
+func (s stubClusterConfigChecker) SRIOVFailoverEnabled() bool {
+	return s.sriovFailoverEnabled
+}
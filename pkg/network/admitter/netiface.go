@@ -109,6 +109,10 @@ func validateInterfacesFields(field *k8sfield.Path, spec *v1.VirtualMachineInsta
 	for idx, iface := range spec.Domain.Devices.Interfaces {
 		causes = append(causes, validateInterfaceNameFormat(field, idx, iface)...)
 		causes = append(causes, validateInterfaceModel(field, idx, iface)...)
+		causes = append(causes, validateVirtioTransitional(field, idx, iface)...)
+		causes = append(causes, validateInterfaceBackend(field, idx, iface)...)
+		causes = append(causes, validateInterfaceQueues(field, idx, iface)...)
+		causes = append(causes, validateInterfaceOffloads(field, idx, iface)...)
 		causes = append(causes, validateMacAddress(field, idx, iface)...)
 		causes = append(causes, validatePciAddress(field, idx, iface)...)
 		causes = append(causes, validatePortConfiguration(field, idx, iface, networksByName[iface.Name])...)
@@ -156,6 +160,117 @@ func validateInterfaceModel(field *k8sfield.Path, idx int, iface v1.Interface) [
 	return nil
 }
 
+func validateVirtioTransitional(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.VirtioTransitional == nil || iface.Model == "" || iface.Model == v1.VirtIO {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf(
+			"interface %s - setting virtioTransitional is only possible with model virtio.",
+			field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+		),
+		Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("virtioTransitional").String(),
+	}}
+}
+
+func validateInterfaceBackend(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	switch iface.Backend {
+	case "":
+		return nil
+	case v1.InterfaceBackendVhost, v1.InterfaceBackendUserspace:
+	default:
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf(
+				"interface %s uses backend %s that is not supported.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				iface.Backend,
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("backend").String(),
+		}}
+	}
+	if !isVirtioTapInterface(iface) {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s - setting backend is only possible with model virtio and a binding that is not SR-IOV.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("backend").String(),
+		}}
+	}
+	return nil
+}
+
+// maxInterfaceQueues is the maximum number of queues of a tap device
+const maxInterfaceQueues = 256
+
+func validateInterfaceQueues(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Queues == nil {
+		return nil
+	}
+	if !isVirtioTapInterface(iface) {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s - setting queues is only possible with model virtio and a binding that is not SR-IOV.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("queues").String(),
+		}}
+	}
+	if *iface.Queues < 1 || *iface.Queues > maxInterfaceQueues {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s has %d queues, it must be between 1 and %d.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+				*iface.Queues,
+				maxInterfaceQueues,
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("queues").String(),
+		}}
+	}
+	return nil
+}
+
+func validateInterfaceOffloads(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
+	if iface.Offloads == nil {
+		return nil
+	}
+	if !isVirtioTapInterface(iface) {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s - setting offloads is only possible with model virtio and a binding that is not SR-IOV.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("offloads").String(),
+		}}
+	}
+
+	// Segmentation offloads rely on the checksum offload
+	offloads := iface.Offloads
+	checksumDisabled := offloads.Checksum != nil && !*offloads.Checksum
+	segmentationEnabled := (offloads.TSO != nil && *offloads.TSO) || (offloads.GSO != nil && *offloads.GSO)
+	if checksumDisabled && segmentationEnabled {
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(
+				"interface %s - TSO and GSO can not be enabled when the checksum offload is disabled.",
+				field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+			),
+			Field: field.Child("domain", "devices", "interfaces").Index(idx).Child("offloads").String(),
+		}}
+	}
+	return nil
+}
+
+func isVirtioTapInterface(iface v1.Interface) bool {
+	return (iface.Model == "" || iface.Model == v1.VirtIO) && iface.SRIOV == nil
+}
+
 func validateMacAddress(field *k8sfield.Path, idx int, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if err := link.ValidateMacAddress(iface.MacAddress); err != nil {
@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -232,6 +233,130 @@ var _ = Describe("Validating VMI network spec", func() {
 		Entry("valid address B", "0001:02:00.0"),
 	)
 
+	DescribeTable("should reject virtioTransitional with a non-virtio model", func(model string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Domain.Devices.Interfaces[0].VirtioTransitional = pointer.P(true)
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name - setting virtioTransitional is only possible with model virtio.",
+			Field:   "fake.domain.devices.interfaces[0].virtioTransitional",
+		}))
+	},
+		Entry("e1000", "e1000"),
+		Entry("rtl8139", "rtl8139"),
+	)
+
+	DescribeTable("should accept virtioTransitional with the virtio model", func(model string) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Model = model
+		spec.Domain.Devices.Interfaces[0].VirtioTransitional = pointer.P(true)
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("explicit virtio", v1.VirtIO),
+		Entry("default model", ""),
+	)
+
+	DescribeTable("should accept the interface backend and queues", func(backend v1.InterfaceBackend, queues uint32) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Backend = backend
+		spec.Domain.Devices.Interfaces[0].Queues = pointer.P(queues)
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("vhost", v1.InterfaceBackendVhost, uint32(4)),
+		Entry("userspace", v1.InterfaceBackendUserspace, uint32(1)),
+		Entry("the default backend", v1.InterfaceBackend(""), uint32(256)),
+	)
+
+	It("should accept disabled offloads", func() {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		spec.Domain.Devices.Interfaces[0].Offloads = &v1.InterfaceOffloads{
+			Checksum: pointer.P(false),
+			TSO:      pointer.P(false),
+			GSO:      pointer.P(false),
+		}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	DescribeTable("should reject", func(modify func(iface *v1.Interface), expectedCause metav1.StatusCause) {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+		modify(&spec.Domain.Devices.Interfaces[0])
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("an unknown backend", func(iface *v1.Interface) {
+			iface.Backend = "dpdk"
+		}, metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: "interface fake.domain.devices.interfaces[0].name uses backend dpdk that is not supported.",
+			Field:   "fake.domain.devices.interfaces[0].backend",
+		}),
+		Entry("a backend with a non-virtio model", func(iface *v1.Interface) {
+			iface.Model = "e1000"
+			iface.Backend = v1.InterfaceBackendUserspace
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name - setting backend is only possible with model virtio and a binding that is not SR-IOV.",
+			Field:   "fake.domain.devices.interfaces[0].backend",
+		}),
+		Entry("queues with a non-virtio model", func(iface *v1.Interface) {
+			iface.Model = "e1000"
+			iface.Queues = pointer.P(uint32(2))
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name - setting queues is only possible with model virtio and a binding that is not SR-IOV.",
+			Field:   "fake.domain.devices.interfaces[0].queues",
+		}),
+		Entry("zero queues", func(iface *v1.Interface) {
+			iface.Queues = pointer.P(uint32(0))
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name has 0 queues, it must be between 1 and 256.",
+			Field:   "fake.domain.devices.interfaces[0].queues",
+		}),
+		Entry("more queues than a tap device supports", func(iface *v1.Interface) {
+			iface.Queues = pointer.P(uint32(257))
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name has 257 queues, it must be between 1 and 256.",
+			Field:   "fake.domain.devices.interfaces[0].queues",
+		}),
+		Entry("offloads with a non-virtio model", func(iface *v1.Interface) {
+			iface.Model = "e1000"
+			iface.Offloads = &v1.InterfaceOffloads{Checksum: pointer.P(false)}
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name - setting offloads is only possible with model virtio and a binding that is not SR-IOV.",
+			Field:   "fake.domain.devices.interfaces[0].offloads",
+		}),
+		Entry("TSO without the checksum offload", func(iface *v1.Interface) {
+			iface.Offloads = &v1.InterfaceOffloads{Checksum: pointer.P(false), TSO: pointer.P(true)}
+		}, metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: "interface fake.domain.devices.interfaces[0].name - TSO and GSO can not be enabled when the checksum offload is disabled.",
+			Field:   "fake.domain.devices.interfaces[0].offloads",
+		}),
+	)
+
 	When("the interface port is specified", func() {
 		DescribeTable("should reject interface port with", func(ports []v1.Port, expectedCauses []metav1.StatusCause) {
 			spec := &v1.VirtualMachineInstanceSpec{}
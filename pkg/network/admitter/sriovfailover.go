@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func validateSRIOVFailover(
+	fieldPath *field.Path, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ifacesByName := vmispec.IndexInterfaceSpecByName(spec.Domain.Devices.Interfaces)
+	networksByName := vmispec.IndexNetworkSpecByName(spec.Networks)
+	standbyOwners := map[string]string{}
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.SRIOV == nil || iface.SRIOV.Failover == nil {
+			continue
+		}
+		failoverField := fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("sriov", "failover")
+		if !config.SRIOVFailoverEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "SRIOVFailover feature gate is not enabled",
+				Field:   failoverField.String(),
+			})
+			continue
+		}
+
+		standbyName := iface.SRIOV.Failover.StandbyInterface
+		standbyField := failoverField.Child("standbyInterface").String()
+		if standbyName == "" {
+			// The standby interface is generated when the VMI is created
+			if iface.SRIOV.Failover.StandbyNetworkName == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("failover of interface %s must set standbyNetworkName or standbyInterface", iface.Name),
+					Field:   failoverField.String(),
+				})
+			}
+			continue
+		}
+		if owner, exists := standbyOwners[standbyName]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("interface %s is already the failover standby of interface %s", standbyName, owner),
+				Field:   standbyField,
+			})
+			continue
+		}
+		standbyOwners[standbyName] = iface.Name
+
+		standby, exists := ifacesByName[standbyName]
+		if !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("failover standby interface %s of interface %s not found", standbyName, iface.Name),
+				Field:   standbyField,
+			})
+			continue
+		}
+		if standby.Bridge == nil || networksByName[standbyName].Multus == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("failover standby interface %s must use bridge binding on a Multus network", standbyName),
+				Field:   standbyField,
+			})
+		}
+		if standby.Model != "" && standby.Model != v1.VirtIO {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("failover standby interface %s must use the %s model", standbyName, v1.VirtIO),
+				Field:   standbyField,
+			})
+		}
+		if !isSameMacAddress(iface.MacAddress, standby.MacAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("interface %s and its failover standby interface %s must set the same MAC address", iface.Name, standbyName),
+				Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func isSameMacAddress(mac1, mac2 string) bool {
+	if mac1 == "" || mac2 == "" {
+		return false
+	}
+	hwAddr1, err1 := net.ParseMAC(mac1)
+	hwAddr2, err2 := net.ParseMAC(mac2)
+	return err1 == nil && err2 == nil && hwAddr1.String() == hwAddr2.String()
+}
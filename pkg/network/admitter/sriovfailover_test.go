@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating SR-IOV failover", func() {
+	const (
+		sriovName   = "sriov"
+		standbyName = "standby"
+		macAddress  = "02:00:00:00:00:01"
+	)
+
+	newSpec := func(sriovMac string, standby v1.Interface) *v1.VirtualMachineInstanceSpec {
+		sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(sriovName)
+		sriovIface.MacAddress = sriovMac
+		sriovIface.SRIOV.Failover = &v1.InterfaceSRIOVFailover{StandbyInterface: standbyName}
+		vmi := libvmi.New(
+			libvmi.WithInterface(sriovIface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovName, "sriov-nad")),
+			libvmi.WithInterface(standby),
+			libvmi.WithNetwork(libvmi.MultusNetwork(standbyName, "bridge-nad")),
+		)
+		return &vmi.Spec
+	}
+
+	newStandby := func(mac string) v1.Interface {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(standbyName)
+		iface.MacAddress = mac
+		return iface
+	}
+
+	It("should accept an SR-IOV interface paired with a bridge standby with the same MAC", func() {
+		spec := newSpec(macAddress, newStandby("02:00:00:00:00:01"))
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject failover when the feature gate is disabled", func() {
+		spec := newSpec(macAddress, newStandby(macAddress))
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SRIOVFailover feature gate is not enabled",
+			Field:   "fake.domain.devices.interfaces[0].sriov.failover",
+		}))
+	})
+
+	It("should accept a standby network, the standby interface is generated when the VMI is created", func() {
+		sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(sriovName)
+		sriovIface.SRIOV.Failover = &v1.InterfaceSRIOVFailover{StandbyNetworkName: "bridge-nad"}
+		vmi := libvmi.New(
+			libvmi.WithInterface(sriovIface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovName, "sriov-nad")),
+		)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject failover without a standby network or interface", func() {
+		spec := newSpec(macAddress, newStandby(macAddress))
+		spec.Domain.Devices.Interfaces[0].SRIOV.Failover.StandbyInterface = ""
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "failover of interface sriov must set standbyNetworkName or standbyInterface",
+			Field:   "fake.domain.devices.interfaces[0].sriov.failover",
+		}))
+	})
+
+	It("should reject a missing standby interface", func() {
+		spec := newSpec(macAddress, newStandby(macAddress))
+		spec.Domain.Devices.Interfaces[0].SRIOV.Failover.StandbyInterface = "missing"
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failover standby interface missing of interface sriov not found",
+			Field:   "fake.domain.devices.interfaces[0].sriov.failover.standbyInterface",
+		}))
+	})
+
+	It("should reject a standby interface which does not use bridge binding", func() {
+		standby := libvmi.InterfaceDeviceWithSRIOVBinding(standbyName)
+		standby.MacAddress = macAddress
+		spec := newSpec(macAddress, standby)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failover standby interface standby must use bridge binding on a Multus network",
+			Field:   "fake.domain.devices.interfaces[0].sriov.failover.standbyInterface",
+		}))
+	})
+
+	It("should reject a standby interface with a non-virtio model", func() {
+		standby := newStandby(macAddress)
+		standby.Model = "e1000"
+		spec := newSpec(macAddress, standby)
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failover standby interface standby must use the virtio model",
+			Field:   "fake.domain.devices.interfaces[0].sriov.failover.standbyInterface",
+		}))
+	})
+
+	DescribeTable("should reject interfaces without the same MAC address", func(sriovMac, standbyMac string) {
+		spec := newSpec(sriovMac, newStandby(standbyMac))
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{sriovFailoverEnabled: true})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "interface sriov and its failover standby interface standby must set the same MAC address",
+			Field:   "fake.domain.devices.interfaces[0].macAddress",
+		}))
+	},
+		Entry("when neither sets a MAC address", "", ""),
+		Entry("when the standby does not set a MAC address", macAddress, ""),
+		Entry("when the MAC addresses differ", macAddress, "02:00:00:00:00:02"),
+	)
+})
@@ -28,6 +28,7 @@ import (
 type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	MacvtapEnabled() bool
+	SRIOVFailoverEnabled() bool
 }
 
 type Validator struct {
@@ -57,6 +58,7 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateInterfaceNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesAssignedToNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfacesFields(v.field, v.vmiSpec)...)
+	causes = append(causes, validateSRIOVFailover(v.field, v.vmiSpec, v.configChecker)...)
 
 	return causes
 }
@@ -0,0 +1,426 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package dnsregistration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// ServiceLabel marks the headless Services which are managed by the controller
+	ServiceLabel = "kubevirt.io/dns-registration"
+	managedBy    = "virt-controller.kubevirt.io"
+
+	resyncPeriod = 5 * time.Minute
+	// The maximum number of endpoints the API server accepts in an EndpointSlice
+	maxEndpointsPerSlice = 1000
+)
+
+var addressTypes = []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6}
+
+// record is a name under which the guest IP of a VMI is published
+type record struct {
+	hostname  string
+	ip        net.IP
+	targetRef k8sv1.ObjectReference
+	nodeName  string
+}
+
+// Controller publishes the hostnames and guest IPs of the running VMIs of a
+// namespace as the EndpointSlices of a selector-less headless Service, so that
+// the cluster DNS resolves <hostname>.<service>.<namespace>.svc to the IPs of
+// all the interfaces of a VMI.
+type Controller struct {
+	client        kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
+	vmiIndexer    cache.Indexer
+	queue         workqueue.TypedRateLimitingInterface[string]
+	hasSynced     func() bool
+}
+
+func NewController(client kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	vmiInformer cache.SharedIndexInformer,
+) (*Controller, error) {
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-dns-registration"},
+		),
+		client:        client,
+		clusterConfig: clusterConfig,
+		vmiIndexer:    vmiInformer.GetIndexer(),
+		hasSynced:     vmiInformer.HasSynced,
+	}
+
+	_, err := vmiInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleVMI,
+			UpdateFunc: c.updateVMI,
+			DeleteFunc: c.handleVMI,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleVMI(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*v1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	c.queue.Add(vmi.Namespace)
+}
+
+func (c *Controller) updateVMI(oldObj, newObj interface{}) {
+	oldVMI, ok := oldObj.(*v1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	newVMI, ok := newObj.(*v1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	if reflect.DeepEqual(vmiRecords(oldVMI), vmiRecords(newVMI)) {
+		return
+	}
+	c.queue.Add(newVMI.Namespace)
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting DNS registration controller.")
+	defer log.Log.Info("Shutting down DNS registration controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	// The resync removes the records of namespaces which lost all their
+	// VMIs or which are no longer published since the feature was disabled
+	go wait.Until(c.resync, resyncPeriod, stopCh)
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) resync() {
+	for _, namespace := range c.vmiIndexer.ListIndexFuncValues(cache.NamespaceIndex) {
+		c.queue.Add(namespace)
+	}
+
+	services, err := c.client.CoreV1().Services(k8sv1.NamespaceAll).List(context.Background(), metav1.ListOptions{
+		LabelSelector: ServiceLabel,
+	})
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to list the DNS registration services")
+		return
+	}
+	for _, service := range services.Items {
+		c.queue.Add(service.Namespace)
+	}
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing DNS registration of namespace %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed DNS registration of namespace %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(namespace string) error {
+	serviceName := c.clusterConfig.GetDNSRegistrationServiceName()
+
+	var records []record
+	if c.clusterConfig.VMDNSRegistrationEnabled() {
+		objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			records = append(records, vmiRecords(obj.(*v1.VirtualMachineInstance))...)
+		}
+	}
+
+	if err := c.deleteStaleServices(namespace, serviceName, len(records) == 0); err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	service, err := c.ensureService(namespace, serviceName)
+	if err != nil || service == nil {
+		return err
+	}
+
+	for _, addressType := range addressTypes {
+		if err := c.syncEndpointSlice(service, addressType, endpointsOf(records, addressType)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteStaleServices deletes the managed services of the namespace which are not named serviceName,
+// or all of them when all is set. Their EndpointSlices are garbage collected with them.
+func (c *Controller) deleteStaleServices(namespace, serviceName string, all bool) error {
+	services, err := c.client.CoreV1().Services(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: ServiceLabel,
+	})
+	if err != nil {
+		return err
+	}
+	for _, service := range services.Items {
+		if !all && service.Name == serviceName {
+			continue
+		}
+		err := c.client.CoreV1().Services(namespace).Delete(context.Background(), service.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		log.Log.V(2).Infof("deleted DNS registration service %s/%s", namespace, service.Name)
+	}
+	return nil
+}
+
+// ensureService returns the headless service of the namespace, creating it when missing.
+// It returns nil when a service with the same name which is not managed by the controller exists.
+func (c *Controller) ensureService(namespace, serviceName string) (*k8sv1.Service, error) {
+	service, err := c.client.CoreV1().Services(namespace).Get(context.Background(), serviceName, metav1.GetOptions{})
+	if err == nil {
+		if _, isManaged := service.Labels[ServiceLabel]; !isManaged {
+			log.Log.Warningf("not publishing VMI DNS records in namespace %s: service %s is not managed by KubeVirt", namespace, serviceName)
+			return nil, nil
+		}
+		return service, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	service = &k8sv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+			Labels:    map[string]string{ServiceLabel: ""},
+		},
+		Spec: k8sv1.ServiceSpec{
+			ClusterIP:                k8sv1.ClusterIPNone,
+			PublishNotReadyAddresses: true,
+		},
+	}
+	return c.client.CoreV1().Services(namespace).Create(context.Background(), service, metav1.CreateOptions{})
+}
+
+func (c *Controller) syncEndpointSlice(service *k8sv1.Service, addressType discoveryv1.AddressType, endpoints []discoveryv1.Endpoint) error {
+	slices := c.client.DiscoveryV1().EndpointSlices(service.Namespace)
+	name := endpointSliceName(service.Name, addressType)
+
+	slice, err := slices.Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if len(endpoints) == 0 {
+			return nil
+		}
+		_, err = slices.Create(context.Background(), newEndpointSlice(service, name, addressType, endpoints), metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if slice.Labels[discoveryv1.LabelManagedBy] != managedBy {
+		return fmt.Errorf("endpoint slice %s/%s is not managed by KubeVirt", slice.Namespace, slice.Name)
+	}
+	if len(endpoints) == 0 {
+		err = slices.Delete(context.Background(), name, metav1.DeleteOptions{})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if equality.Semantic.DeepEqual(slice.Endpoints, endpoints) {
+		return nil
+	}
+
+	slice = slice.DeepCopy()
+	slice.Endpoints = endpoints
+	_, err = slices.Update(context.Background(), slice, metav1.UpdateOptions{})
+	return err
+}
+
+func newEndpointSlice(service *k8sv1.Service, name string, addressType discoveryv1.AddressType, endpoints []discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: service.Namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: service.Name,
+				discoveryv1.LabelManagedBy:   managedBy,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Service",
+				Name:       service.Name,
+				UID:        service.UID,
+			}},
+		},
+		AddressType: addressType,
+		Endpoints:   endpoints,
+	}
+}
+
+func endpointSliceName(serviceName string, addressType discoveryv1.AddressType) string {
+	return fmt.Sprintf("%s-%s", serviceName, strings.ToLower(string(addressType)))
+}
+
+// vmiRecords returns the records of the guest IPs of all the interfaces of a running VMI,
+// including those of secondary networks. Link-local addresses are not published.
+func vmiRecords(vmi *v1.VirtualMachineInstance) []record {
+	if !vmi.IsRunning() || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	hostname := vmi.Spec.Hostname
+	if hostname == "" {
+		hostname = vmi.Name
+	}
+	if len(validation.IsDNS1123Label(hostname)) > 0 {
+		return nil
+	}
+
+	targetRef := k8sv1.ObjectReference{
+		APIVersion: v1.GroupVersion.String(),
+		Kind:       v1.VirtualMachineInstanceGroupVersionKind.Kind,
+		Namespace:  vmi.Namespace,
+		Name:       vmi.Name,
+		UID:        vmi.UID,
+	}
+	networksByName := vmispec.IndexNetworkSpecByName(vmi.Spec.Networks)
+	seen := map[string]struct{}{}
+	var records []record
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		if _, exists := networksByName[ifaceStatus.Name]; !exists {
+			continue
+		}
+		ips := ifaceStatus.IPs
+		if len(ips) == 0 && ifaceStatus.IP != "" {
+			ips = []string{ifaceStatus.IP}
+		}
+		for _, ipStr := range ips {
+			ip := net.ParseIP(ipStr)
+			if ip == nil || ip.IsLinkLocalUnicast() || ip.IsLoopback() {
+				continue
+			}
+			if _, exists := seen[ip.String()]; exists {
+				continue
+			}
+			seen[ip.String()] = struct{}{}
+			records = append(records, record{hostname: hostname, ip: ip, targetRef: targetRef, nodeName: vmi.Status.NodeName})
+		}
+	}
+	return records
+}
+
+func endpointsOf(records []record, addressType discoveryv1.AddressType) []discoveryv1.Endpoint {
+	var endpoints []discoveryv1.Endpoint
+	for _, r := range records {
+		isIPv4 := r.ip.To4() != nil
+		if isIPv4 != (addressType == discoveryv1.AddressTypeIPv4) {
+			continue
+		}
+		endpoint := discoveryv1.Endpoint{
+			Addresses:  []string{r.ip.String()},
+			Conditions: discoveryv1.EndpointConditions{Ready: pointer.P(true)},
+			Hostname:   pointer.P(r.hostname),
+			TargetRef:  pointer.P(r.targetRef),
+		}
+		if r.nodeName != "" {
+			endpoint.NodeName = pointer.P(r.nodeName)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		if *endpoints[i].Hostname != *endpoints[j].Hostname {
+			return *endpoints[i].Hostname < *endpoints[j].Hostname
+		}
+		return endpoints[i].Addresses[0] < endpoints[j].Addresses[0]
+	})
+
+	if len(endpoints) > maxEndpointsPerSlice {
+		log.Log.Warningf("publishing only %d of %d %s VMI DNS records", maxEndpointsPerSlice, len(endpoints), addressType)
+		endpoints = endpoints[:maxEndpointsPerSlice]
+	}
+	return endpoints
+}
@@ -0,0 +1,242 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package dnsregistration
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const testNamespace = "default"
+
+var _ = Describe("DNS registration controller", func() {
+	var (
+		k8sClient   *k8sfake.Clientset
+		vmiInformer cache.SharedIndexInformer
+		controller  *Controller
+	)
+
+	newController := func(networkConfig *v1.NetworkConfiguration, featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			NetworkConfiguration:   networkConfig,
+		})
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().DiscoveryV1().Return(k8sClient.DiscoveryV1()).AnyTimes()
+		controller = &Controller{
+			client:        virtClient,
+			clusterConfig: clusterConfig,
+			vmiIndexer:    vmiInformer.GetIndexer(),
+			queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-dns-registration-queue"},
+			),
+		}
+	}
+
+	runningVMI := func(name string, ifaceStatuses ...v1.VirtualMachineInstanceNetworkInterface) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(testNamespace),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("secondary")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("secondary", "nad")),
+		)
+		vmi.UID = types.UID("uid-" + vmi.Name)
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = "node01"
+		vmi.Status.Interfaces = ifaceStatuses
+		return vmi
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	getEndpointSlice := func(name string) *discoveryv1.EndpointSlice {
+		slice, err := k8sClient.DiscoveryV1().EndpointSlices(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return slice
+	}
+
+	expectNoService := func(name string) {
+		_, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	}
+
+	newEndpoint := func(hostname, ip, vmiName string) discoveryv1.Endpoint {
+		return discoveryv1.Endpoint{
+			Addresses:  []string{ip},
+			Conditions: discoveryv1.EndpointConditions{Ready: pointer.P(true)},
+			Hostname:   pointer.P(hostname),
+			NodeName:   pointer.P("node01"),
+			TargetRef: &k8sv1.ObjectReference{
+				APIVersion: "kubevirt.io/v1",
+				Kind:       "VirtualMachineInstance",
+				Namespace:  testNamespace,
+				Name:       vmiName,
+				UID:        types.UID("uid-" + vmiName),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		k8sClient = k8sfake.NewSimpleClientset()
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+	})
+
+	It("should publish the IPs of all the interfaces of running VMIs", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		addVMI(runningVMI("vm1",
+			v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10", "fd10:244::a", "fe80::1"}},
+			v1.VirtualMachineInstanceNetworkInterface{Name: "secondary", IPs: []string{"192.168.1.10"}},
+			v1.VirtualMachineInstanceNetworkInterface{InterfaceName: "eth2", IPs: []string{"172.16.0.1"}},
+		))
+		pending := runningVMI("vm2", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.11"}})
+		pending.Status.Phase = v1.Scheduled
+		addVMI(pending)
+
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		service, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), "vms", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.Spec.ClusterIP).To(Equal(k8sv1.ClusterIPNone))
+		Expect(service.Labels).To(HaveKey(ServiceLabel))
+
+		ipv4Slice := getEndpointSlice("vms-ipv4")
+		Expect(ipv4Slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, "vms"))
+		Expect(ipv4Slice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+		Expect(ipv4Slice.Endpoints).To(Equal([]discoveryv1.Endpoint{
+			newEndpoint("vm1", "10.244.0.10", "vm1"),
+			newEndpoint("vm1", "192.168.1.10", "vm1"),
+		}))
+		Expect(getEndpointSlice("vms-ipv6").Endpoints).To(Equal([]discoveryv1.Endpoint{
+			newEndpoint("vm1", "fd10:244::a", "vm1"),
+		}))
+	})
+
+	It("should publish a VMI under its hostname and a configured service name", func() {
+		newController(&v1.NetworkConfiguration{
+			DNSRegistration: &v1.DNSRegistrationConfiguration{ServiceName: "records"},
+		}, featuregate.VMDNSRegistrationGate)
+		vmi := runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}})
+		vmi.Spec.Hostname = "db"
+		addVMI(vmi)
+
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		Expect(getEndpointSlice("records-ipv4").Endpoints).To(Equal([]discoveryv1.Endpoint{
+			newEndpoint("db", "10.244.0.10", "vm1"),
+		}))
+		_, err := k8sClient.DiscoveryV1().EndpointSlices(testNamespace).Get(context.Background(), "records-ipv6", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should update the records when the IPs of a VMI change", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		vmi := runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}})
+		addVMI(vmi)
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		vmi = vmi.DeepCopy()
+		vmi.Status.Interfaces[0].IPs = []string{"10.244.0.20"}
+		Expect(vmiInformer.GetStore().Update(vmi)).To(Succeed())
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		Expect(getEndpointSlice("vms-ipv4").Endpoints).To(Equal([]discoveryv1.Endpoint{
+			newEndpoint("vm1", "10.244.0.20", "vm1"),
+		}))
+	})
+
+	It("should delete the service when no VMI is left", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		vmi := runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}})
+		addVMI(vmi)
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		Expect(vmiInformer.GetStore().Delete(vmi)).To(Succeed())
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		expectNoService("vms")
+	})
+
+	It("should delete the service when the feature gate is disabled", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		addVMI(runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}}))
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		newController(nil)
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		expectNoService("vms")
+	})
+
+	It("should not take over a service which is not managed by KubeVirt", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		_, err := k8sClient.CoreV1().Services(testNamespace).Create(context.Background(), &k8sv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "vms", Namespace: testNamespace},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		addVMI(runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}}))
+
+		Expect(controller.execute(testNamespace)).To(Succeed())
+
+		slices, err := k8sClient.DiscoveryV1().EndpointSlices(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(slices.Items).To(BeEmpty())
+	})
+
+	It("should not enqueue VMI updates which do not change the records", func() {
+		newController(nil, featuregate.VMDNSRegistrationGate)
+		oldVMI := runningVMI("vm1", v1.VirtualMachineInstanceNetworkInterface{Name: "default", IPs: []string{"10.244.0.10"}})
+		newVMI := oldVMI.DeepCopy()
+		newVMI.Labels = map[string]string{"updated": "true"}
+
+		controller.updateVMI(oldVMI, newVMI)
+		Expect(controller.queue.Len()).To(BeZero())
+
+		newVMI.Status.Interfaces[0].IPs = []string{"10.244.0.20"}
+		controller.updateVMI(oldVMI, newVMI)
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
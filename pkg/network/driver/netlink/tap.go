@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package netlink
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// TapDevice describes a persistent tap device.
+// The offloads of the device are set by QEMU, according to the features
+// negotiated with the guest and the offload options of the domain interface.
+type TapDevice struct {
+	Name   string
+	Owner  uint32
+	Group  uint32
+	Queues int
+	MTU    int
+}
+
+const (
+	tapCreationAttempts      = 5
+	tapCreationRetryInterval = time.Second
+)
+
+// AddTapDevice creates a persistent tap device and sets its MTU.
+// It returns the number of failed attempts before the device was created.
+func (n NetLink) AddTapDevice(device TapDevice) (uint, error) {
+	tapDevice := &netlink.Tuntap{
+		LinkAttrs:  netlink.LinkAttrs{Name: device.Name},
+		Mode:       unix.IFF_TAP,
+		NonPersist: false,
+		Owner:      device.Owner,
+		Group:      device.Group,
+	}
+
+	// Configure tap devices with the same flags used by libvirt.
+	// This ensures consistent behavior and avoids potential issues.
+	// See libvirt's implementation for additional details:
+	// https://github.com/libvirt/libvirt/blob/1899d7df34576e414c49e4d91c68e6e2348f37f2/src/util/virnetdevtap.c#L217-L219
+	if device.Queues <= 1 {
+		tapDevice.Flags = netlink.TUNTAP_DEFAULTS
+	} else {
+		tapDevice.Flags = netlink.TUNTAP_MULTI_QUEUE_DEFAULTS
+	}
+
+	// Device creation is retried due to https://bugzilla.redhat.com/1933627
+	// which has been observed on multiple occasions on CI runs.
+	attempt, err := retry(tapCreationAttempts, func() error {
+		return netlink.LinkAdd(tapDevice)
+	})
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create tap device named %s. Reason: %v", device.Name, err)
+	}
+
+	if err := netlink.LinkSetMTU(tapDevice, device.MTU); err != nil {
+		return attempt, fmt.Errorf("failed to set MTU on tap device named %s. Reason: %v", device.Name, err)
+	}
+
+	return attempt, nil
+}
+
+func retry(retryAttempts uint, f func() error) (uint, error) {
+	var errorsString []string
+	for attemptID := uint(0); attemptID < retryAttempts; attemptID++ {
+		if err := f(); err != nil {
+			errorsString = append(errorsString, fmt.Sprintf("[%d]: %v", attemptID, err))
+			time.Sleep(tapCreationRetryInterval)
+		} else {
+			return attemptID, nil
+		}
+	}
+
+	return retryAttempts, fmt.Errorf("%s", strings.Join(errorsString, "\n"))
+}
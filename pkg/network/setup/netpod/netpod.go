@@ -577,6 +577,8 @@ func calcQueuesCapByIface(desiredQueueCount int,
 		ifaceStatus, existsInDomain := ifaceStatusesInDomainByName[iface.Name]
 		if existsInDomain {
 			queuesCapByIface[iface.Name] = int(ifaceStatus.QueueCount)
+		} else if iface.Queues != nil {
+			queuesCapByIface[iface.Name] = int(*iface.Queues)
 		} else {
 			queuesCapByIface[iface.Name] = desiredQueueCount
 		}
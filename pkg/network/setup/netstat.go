@@ -282,6 +282,7 @@ func ifacesStatusFromDomainInterfaces(domainSpecIfaces []api.Interface) []v1.Vir
 			InfoSource: netvmispec.InfoSourceDomain,
 			QueueCount: domainInterfaceQueues(domainSpecIface.Driver),
 			LinkState:  linkStateFromDomain(domainSpecIface.LinkState),
+			Backend:    domainInterfaceBackend(domainSpecIface),
 		})
 	}
 	return vmiStatusIfaces
@@ -295,6 +296,20 @@ func domainInterfaceQueues(driver *api.InterfaceDriver) int32 {
 	return DefaultInterfaceQueueCount
 }
 
+// domainInterfaceBackend reports the backend of virtio interfaces backed by a tap device.
+// The converter sets the userspace driver explicitly when /dev/vhost-net is unavailable.
+func domainInterfaceBackend(domainSpecIface api.Interface) v1.InterfaceBackend {
+	if domainSpecIface.Type != "ethernet" || domainSpecIface.Model == nil ||
+		!strings.HasPrefix(domainSpecIface.Model.Type, v1.VirtIO) {
+		return ""
+	}
+
+	if domainSpecIface.Driver != nil && domainSpecIface.Driver.Name == "qemu" {
+		return v1.InterfaceBackendUserspace
+	}
+	return v1.InterfaceBackendVhost
+}
+
 func linkStateFromDomain(linkState *api.LinkState) string {
 	const linkStateUp = "up"
 
@@ -166,6 +166,31 @@ var _ = Describe("netstat", func() {
 			Expect(setup.NetStat.PodInterfaceVolatileDataIsCached(setup.Vmi, primaryNetworkName)).To(BeTrue())
 		})
 
+		DescribeTable("run status and expect the interface backend to be reported", func(driver *api.InterfaceDriver, expectedBackend v1.InterfaceBackend) {
+			domainSpecInterface := newDomainSpecIface(primaryNetworkName, "")
+			domainSpecInterface.Type = "ethernet"
+			domainSpecInterface.Model = &api.Model{Type: "virtio-non-transitional"}
+			domainSpecInterface.Driver = driver
+
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithBridgeBinding(primaryNetworkName),
+					newVMISpecPodNetwork(primaryNetworkName),
+					domainSpecInterface,
+					primaryPodIPv4, primaryPodIPv6,
+				),
+			).To(Succeed())
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			Expect(setup.Vmi.Status.Interfaces).To(HaveLen(1))
+			Expect(setup.Vmi.Status.Interfaces[0].Backend).To(Equal(expectedBackend))
+		},
+			Entry("vhost without a driver", nil, v1.InterfaceBackendVhost),
+			Entry("vhost with the vhost driver", &api.InterfaceDriver{Name: "vhost"}, v1.InterfaceBackendVhost),
+			Entry("userspace with the qemu driver", &api.InterfaceDriver{Name: "qemu"}, v1.InterfaceBackendUserspace),
+		)
+
 		It("run status and expect 2 interfaces to be reported based on pod and guest-agent data", func() {
 			Expect(
 				setup.addNetworkInterface(
@@ -22,6 +22,9 @@ package vmispec
 import (
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
 	v1 "kubevirt.io/api/core/v1"
 )
 
@@ -30,7 +33,51 @@ type netClusterConfigurer interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 }
 
+type netBindingPolicyConfigurer interface {
+	netClusterConfigurer
+	GetDefaultBindingPolicies() []v1.DefaultBindingPolicy
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
+}
+
 func SetDefaultNetworkInterface(config netClusterConfigurer, spec *v1.VirtualMachineInstanceSpec) error {
+	return setDefaultNetworkInterface(config, spec, config.GetDefaultNetworkInterface(), false)
+}
+
+// SetDefaultNetworkInterfaceForNamespace is like SetDefaultNetworkInterface, except that the binding
+// of the default interface is taken from the first default binding policy matching the namespace labels.
+func SetDefaultNetworkInterfaceForNamespace(
+	config netBindingPolicyConfigurer,
+	spec *v1.VirtualMachineInstanceSpec,
+	namespaceLabels map[string]string,
+) error {
+	binding, err := matchDefaultBindingPolicy(config.GetDefaultBindingPolicies(), namespaceLabels)
+	if err != nil {
+		return err
+	}
+	if binding == "" {
+		return SetDefaultNetworkInterface(config, spec)
+	}
+
+	if _, exists := config.GetNetworkBindings()[binding]; exists {
+		return setDefaultNetworkInterface(config, spec, binding, true)
+	}
+	return setDefaultNetworkInterface(config, spec, binding, false)
+}
+
+func matchDefaultBindingPolicy(policies []v1.DefaultBindingPolicy, namespaceLabels map[string]string) (string, error) {
+	for i := range policies {
+		selector, err := metav1.LabelSelectorAsSelector(&policies[i].NamespaceSelector)
+		if err != nil {
+			return "", fmt.Errorf("invalid namespace selector in default binding policy %d: %v", i, err)
+		}
+		if selector.Matches(labels.Set(namespaceLabels)) {
+			return policies[i].Binding, nil
+		}
+	}
+	return "", nil
+}
+
+func setDefaultNetworkInterface(config netClusterConfigurer, spec *v1.VirtualMachineInstanceSpec, binding string, isPlugin bool) error {
 	if autoAttach := spec.Domain.Devices.AutoattachPodInterface; autoAttach != nil && !*autoAttach {
 		return nil
 	}
@@ -40,7 +87,16 @@ func SetDefaultNetworkInterface(config netClusterConfigurer, spec *v1.VirtualMac
 		return nil
 	}
 
-	switch v1.NetworkInterfaceType(config.GetDefaultNetworkInterface()) {
+	if isPlugin {
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:    v1.DefaultPodNetwork().Name,
+			Binding: &v1.PluginBinding{Name: binding},
+		}}
+		spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+		return nil
+	}
+
+	switch v1.NetworkInterfaceType(binding) {
 	case v1.BridgeInterface:
 		if !config.IsBridgeInterfaceOnPodNetworkEnabled() {
 			return fmt.Errorf("bridge interface is not enabled in kubevirt-config")
@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -90,9 +92,83 @@ var _ = Describe("Default pod network", func() {
 	)
 })
 
+var _ = Describe("Default pod network by namespace", func() {
+	const passtBinding = "passt"
+
+	config := stubClusterConfig{
+		defaultNetworkInterface:              string(v1.MasqueradeInterface),
+		isBridgeInterfaceEnabledOnPodNetwork: true,
+		networkBindings:                      map[string]v1.InterfaceBindingPlugin{passtBinding: {}},
+		defaultBindingPolicies: []v1.DefaultBindingPolicy{
+			{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"net": "passt"}},
+				Binding:           passtBinding,
+			},
+			{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"net": "bridge"}},
+				Binding:           string(v1.BridgeInterface),
+			},
+		},
+	}
+
+	DescribeTable("It should add the pod network using the binding of the matching policy",
+		func(namespaceLabels map[string]string, expectedInterface v1.Interface) {
+			vmi := libvmi.New()
+
+			Expect(vmispec.SetDefaultNetworkInterfaceForNamespace(config, &vmi.Spec, namespaceLabels)).To(Succeed())
+
+			Expect(vmi.Spec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{expectedInterface}))
+			Expect(vmi.Spec.Networks).To(Equal([]v1.Network{*v1.DefaultPodNetwork()}))
+		},
+		Entry("when a binding plugin policy matches",
+			map[string]string{"net": "passt"},
+			v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: passtBinding}},
+		),
+		Entry("when a core binding policy matches",
+			map[string]string{"net": "bridge"},
+			*v1.DefaultBridgeNetworkInterface(),
+		),
+		Entry("when no policy matches, using the cluster-wide default",
+			map[string]string{"net": "other"},
+			*v1.DefaultMasqueradeNetworkInterface(),
+		),
+		Entry("when the namespace has no labels, using the cluster-wide default",
+			nil,
+			*v1.DefaultMasqueradeNetworkInterface(),
+		),
+	)
+
+	It("should not override interfaces specified by the VMI", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		origSpec := vmi.Spec.DeepCopy()
+
+		Expect(vmispec.SetDefaultNetworkInterfaceForNamespace(config, &vmi.Spec, map[string]string{"net": "passt"})).To(Succeed())
+
+		Expect(vmi.Spec).To(Equal(*origSpec))
+	})
+
+	It("should return an error when the namespace selector is invalid", func() {
+		config := stubClusterConfig{
+			defaultBindingPolicies: []v1.DefaultBindingPolicy{{
+				NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "net", Operator: "Between"},
+				}},
+				Binding: string(v1.MasqueradeInterface),
+			}},
+		}
+
+		Expect(vmispec.SetDefaultNetworkInterfaceForNamespace(config, &v1.VirtualMachineInstanceSpec{}, nil)).NotTo(Succeed())
+	})
+})
+
 type stubClusterConfig struct {
 	defaultNetworkInterface              string
 	isBridgeInterfaceEnabledOnPodNetwork bool
+	defaultBindingPolicies               []v1.DefaultBindingPolicy
+	networkBindings                      map[string]v1.InterfaceBindingPlugin
 }
 
 func (scc stubClusterConfig) GetDefaultNetworkInterface() string {
@@ -102,3 +178,11 @@ func (scc stubClusterConfig) GetDefaultNetworkInterface() string {
 func (scc stubClusterConfig) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return scc.isBridgeInterfaceEnabledOnPodNetwork
 }
+
+func (scc stubClusterConfig) GetDefaultBindingPolicies() []v1.DefaultBindingPolicy {
+	return scc.defaultBindingPolicies
+}
+
+func (scc stubClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return scc.networkBindings
+}
@@ -22,9 +22,10 @@ package vmispec
 import v1 "kubevirt.io/api/core/v1"
 
 // RequiresVirtioNetDevice checks whether a VMI requires the presence of the "virtio" net device.
-// This happens when the VMI wants to use a "virtio" network interface, and software emulation is disallowed.
+// This happens when the VMI wants to use a "virtio" network interface, and software emulation is disallowed,
+// or when an interface explicitly requires the vhost backend.
 func RequiresVirtioNetDevice(vmi *v1.VirtualMachineInstance, allowEmulation bool) bool {
-	return hasVirtioIface(vmi) && !allowEmulation
+	return hasVhostIface(vmi) || (hasVirtioIface(vmi) && !allowEmulation)
 }
 
 func RequiresTunDevice(vmi *v1.VirtualMachineInstance) bool {
@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmispec
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const sriovFailoverStandbySuffix = "-standby"
+
+// SRIOVFailoverStandbyName returns the name of the standby interface generated for an SR-IOV interface.
+func SRIOVFailoverStandbyName(ifaceName string) string {
+	return ifaceName + sriovFailoverStandbySuffix
+}
+
+// AddSRIOVFailoverStandbyInterfaces generates the standby interface, and its network, of every SR-IOV
+// interface which requests a failover standby on a network. Both interfaces share the MAC address of
+// the SR-IOV interface, which is generated if it is not set.
+func AddSRIOVFailoverStandbyInterfaces(spec *v1.VirtualMachineInstanceSpec) error {
+	for i := range spec.Domain.Devices.Interfaces {
+		iface := &spec.Domain.Devices.Interfaces[i]
+		if iface.SRIOV == nil || iface.SRIOV.Failover == nil ||
+			iface.SRIOV.Failover.StandbyInterface != "" || iface.SRIOV.Failover.StandbyNetworkName == "" {
+			continue
+		}
+
+		if iface.MacAddress == "" {
+			macAddress, err := generateMACAddress()
+			if err != nil {
+				return fmt.Errorf("failed to generate the MAC address of interface %s: %v", iface.Name, err)
+			}
+			iface.MacAddress = macAddress
+		}
+		standbyName := SRIOVFailoverStandbyName(iface.Name)
+		iface.SRIOV.Failover.StandbyInterface = standbyName
+
+		standby := v1.Interface{
+			Name:       standbyName,
+			Model:      v1.VirtIO,
+			MacAddress: iface.MacAddress,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				Bridge: &v1.InterfaceBridge{},
+			},
+		}
+		network := v1.Network{
+			Name: standbyName,
+			NetworkSource: v1.NetworkSource{
+				Multus: &v1.MultusNetwork{NetworkName: iface.SRIOV.Failover.StandbyNetworkName},
+			},
+		}
+		spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, standby)
+		spec.Networks = append(spec.Networks, network)
+	}
+	return nil
+}
+
+// generateMACAddress returns a random locally administered unicast MAC address
+func generateMACAddress() (string, error) {
+	macAddress := make(net.HardwareAddr, 6)
+	if _, err := rand.Read(macAddress); err != nil {
+		return "", err
+	}
+	macAddress[0] = (macAddress[0] | 0x02) &^ 0x01
+	return macAddress.String(), nil
+}
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmispec_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+var _ = Describe("SR-IOV failover standby", func() {
+	const (
+		sriovName      = "sriov-net"
+		standbyNetwork = "bridge-network"
+	)
+
+	sriovSpec := func(macAddress string, failover *v1.InterfaceSRIOVFailover) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   sriovName,
+			MacAddress:             macAddress,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{Failover: failover}},
+		}}
+		spec.Networks = []v1.Network{{
+			Name:          sriovName,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "sriov-network"}},
+		}}
+		return spec
+	}
+
+	It("should generate the standby interface with the MAC address of the SR-IOV interface", func() {
+		spec := sriovSpec("02:00:00:00:00:01", &v1.InterfaceSRIOVFailover{StandbyNetworkName: standbyNetwork})
+
+		Expect(netvmispec.AddSRIOVFailoverStandbyInterfaces(spec)).To(Succeed())
+
+		standbyName := netvmispec.SRIOVFailoverStandbyName(sriovName)
+		Expect(spec.Domain.Devices.Interfaces[0].SRIOV.Failover.StandbyInterface).To(Equal(standbyName))
+		Expect(spec.Domain.Devices.Interfaces).To(HaveLen(2))
+		Expect(spec.Domain.Devices.Interfaces[1]).To(Equal(v1.Interface{
+			Name:                   standbyName,
+			Model:                  v1.VirtIO,
+			MacAddress:             "02:00:00:00:00:01",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+		}))
+		Expect(spec.Networks).To(ContainElement(v1.Network{
+			Name:          standbyName,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: standbyNetwork}},
+		}))
+	})
+
+	It("should generate a locally administered unicast MAC address if the SR-IOV interface has none", func() {
+		spec := sriovSpec("", &v1.InterfaceSRIOVFailover{StandbyNetworkName: standbyNetwork})
+
+		Expect(netvmispec.AddSRIOVFailoverStandbyInterfaces(spec)).To(Succeed())
+
+		macAddress := spec.Domain.Devices.Interfaces[0].MacAddress
+		hwAddr, err := net.ParseMAC(macAddress)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hwAddr[0] & 0x02).To(Equal(byte(0x02)))
+		Expect(hwAddr[0] & 0x01).To(BeZero())
+		Expect(spec.Domain.Devices.Interfaces[1].MacAddress).To(Equal(macAddress))
+	})
+
+	DescribeTable("should not generate a standby interface", func(failover *v1.InterfaceSRIOVFailover) {
+		spec := sriovSpec("02:00:00:00:00:01", failover)
+		expectedSpec := spec.DeepCopy()
+
+		Expect(netvmispec.AddSRIOVFailoverStandbyInterfaces(spec)).To(Succeed())
+		Expect(spec).To(Equal(expectedSpec))
+	},
+		Entry("without failover", nil),
+		Entry("with a declared standby interface", &v1.InterfaceSRIOVFailover{StandbyInterface: "standby-net"}),
+		Entry("with a declared standby interface and a network",
+			&v1.InterfaceSRIOVFailover{StandbyInterface: "standby-net", StandbyNetworkName: standbyNetwork}),
+	)
+})
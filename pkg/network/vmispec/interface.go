@@ -191,11 +191,22 @@ func HasBindingPluginDeviceInfo(iface v1.Interface, bindingPlugins map[string]v1
 	return false
 }
 
-// hasVirtioIface checks whether a VMI references at least one "virtio" network interface.
+// hasVirtioIface checks whether a VMI references at least one "virtio" network interface
+// which may use the vhost backend.
 // Note that the reference can be explicit or implicit (unspecified nic models defaults to "virtio").
 func hasVirtioIface(vmi *v1.VirtualMachineInstance) bool {
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
-		if iface.Model == "" || iface.Model == v1.VirtIO {
+		if (iface.Model == "" || iface.Model == v1.VirtIO) && iface.Backend != v1.InterfaceBackendUserspace {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVhostIface checks whether a VMI references at least one network interface which requires the vhost backend.
+func hasVhostIface(vmi *v1.VirtualMachineInstance) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Backend == v1.InterfaceBackendVhost {
 			return true
 		}
 	}
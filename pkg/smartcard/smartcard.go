@@ -0,0 +1,47 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package smartcard
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+)
+
+const (
+	// DatabaseVolumeName is the pod volume of the Secret holding the NSS
+	// database of an emulated smartcard.
+	DatabaseVolumeName = "smartcard-database"
+	// SocketName is the unix socket QEMU listens on for a passthrough smartcard.
+	SocketName = "virt-smartcard"
+)
+
+func HasPassthrough(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
+	return vmiSpec.Domain.Devices.Smartcard != nil && vmiSpec.Domain.Devices.Smartcard.Passthrough != nil
+}
+
+func HasHostCertificates(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
+	return vmiSpec.Domain.Devices.Smartcard != nil && vmiSpec.Domain.Devices.Smartcard.HostCertificates != nil
+}
+
+// DatabasePath is the path of the NSS database in the virt-launcher pod
+func DatabasePath() string {
+	return config.GetSecretSourcePath(DatabaseVolumeName)
+}
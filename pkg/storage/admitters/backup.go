@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -143,3 +144,89 @@ func (admitter *VMBackupTrackerAdmitter) Admit(ctx context.Context, ar *admissio
 
 	return &admissionv1.AdmissionResponse{Allowed: true}
 }
+
+// VMBackupHookAdmitter validates VirtualMachineBackupHooks
+type VMBackupHookAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupHookAdmitter creates a VMBackupHookAdmitter
+func NewVMBackupHookAdmitter(config *virtconfig.ClusterConfig) *VMBackupHookAdmitter {
+	return &VMBackupHookAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineBackupHook
+func (admitter *VMBackupHookAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackuphooks" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.BackupHooksEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("BackupHooks feature gate not enabled"))
+	}
+
+	// Only need to validate on Create - spec immutability is enforced by CEL
+	if ar.Request.Operation != admissionv1.Create {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	hook := &backupv1.VirtualMachineBackupHook{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, hook); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	if causes := validateBackupHookSpec(&hook.Spec); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+var (
+	backupHookStages = []backupv1.BackupHookStage{
+		backupv1.PreBackup,
+		backupv1.PostBackup,
+		backupv1.PostRestore,
+	}
+	backupHookActions = []backupv1.BackupHookAction{
+		backupv1.FreezeAction,
+		backupv1.UnfreezeAction,
+		backupv1.ReattachHotplugVolumesAction,
+		backupv1.RegenerateMACAddressesAction,
+	}
+)
+
+func validateBackupHookSpec(spec *backupv1.VirtualMachineBackupHookSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+
+	if !slices.Contains(backupHookStages, spec.Stage) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("stage %q is not supported, supported stages are %v", spec.Stage, backupHookStages),
+			Field:   specField.Child("stage").String(),
+		})
+	}
+
+	if len(spec.Actions) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "at least one action is required",
+			Field:   specField.Child("actions").String(),
+		})
+	}
+	for i, action := range spec.Actions {
+		if !slices.Contains(backupHookActions, action) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("action %q is not supported, supported actions are %v", action, backupHookActions),
+				Field:   specField.Child("actions").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -497,3 +497,90 @@ func createBackupTrackerUpdateAdmissionReview(old, current *backupv1.VirtualMach
 
 	return ar
 }
+
+var _ = Describe("Validating VirtualMachineBackupHook Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMBackupHookAdmitter
+	)
+
+	newHookAdmissionReview := func(hook *backupv1.VirtualMachineBackupHook) *admissionv1.AdmissionReview {
+		bytes, _ := json.Marshal(hook)
+		return &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Namespace: "default",
+				Resource: metav1.GroupVersionResource{
+					Group:    backupv1.SchemeGroupVersion.Group,
+					Resource: "virtualmachinebackuphooks",
+				},
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		}
+	}
+
+	newHook := func() *backupv1.VirtualMachineBackupHook {
+		return &backupv1.VirtualMachineBackupHook{
+			Spec: backupv1.VirtualMachineBackupHookSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     "test-vm",
+				},
+				Stage:   backupv1.PreBackup,
+				Actions: []backupv1.BackupHookAction{backupv1.FreezeAction},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "BackupHooks")
+		admitter = NewVMBackupHookAdmitter(config)
+	})
+
+	It("should reject invalid resource name", func() {
+		ar := newHookAdmissionReview(newHook())
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should allow Create operation when BackupHooks feature gate is enabled", func() {
+		resp := admitter.Admit(context.Background(), newHookAdmissionReview(newHook()))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject Create operation when BackupHooks feature gate is not enabled", func() {
+		disableFeatureGate(kvStore, "BackupHooks")
+
+		resp := admitter.Admit(context.Background(), newHookAdmissionReview(newHook()))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("BackupHooks feature gate not enabled"))
+	})
+	DescribeTable("should reject an invalid spec", func(mutate func(hook *backupv1.VirtualMachineBackupHook), field, message string) {
+		hook := newHook()
+		mutate(hook)
+
+		resp := admitter.Admit(context.Background(), newHookAdmissionReview(hook))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(message))
+	},
+		Entry("with an unknown stage", func(hook *backupv1.VirtualMachineBackupHook) {
+			hook.Spec.Stage = "PreRestore"
+		}, "spec.stage", `stage "PreRestore" is not supported`),
+		Entry("without actions", func(hook *backupv1.VirtualMachineBackupHook) {
+			hook.Spec.Actions = nil
+		}, "spec.actions", "at least one action is required"),
+		Entry("with an unknown action", func(hook *backupv1.VirtualMachineBackupHook) {
+			hook.Spec.Actions = append(hook.Spec.Actions, "Snapshot")
+		}, "spec.actions[1]", `action "Snapshot" is not supported`),
+	)
+})
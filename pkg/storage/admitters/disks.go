@@ -40,20 +40,35 @@ const (
 	// Should be a power of 2
 	minCustomBlockSize = 512
 	maxCustomBlockSize = 2097152 // 2 MB
+
+	// VIRTIO_QUEUE_MAX of QEMU
+	maxDiskQueues = 1024
+	// virtio-scsi reserves a control and an event queue
+	maxSCSIControllerQueues = maxDiskQueues - 2
+
+	// The serial number field of the nvme identify controller data structure
+	maxNVMeSerialLen = 20
 )
 
 var isValidExpression = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`).MatchString
 
+var isValidWWN = regexp.MustCompile(`^[0-9A-Fa-f]{16}$`).MatchString
+
 func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for idx, disk := range disks {
 		causes = append(causes, validateDiskName(field, idx, disks)...)
 		causes = append(causes, validateDeviceTarget(field, idx, disk)...)
 		causes = append(causes, validatePciAddress(field, idx, disk)...)
+		causes = append(causes, validateVirtioTransitional(field, idx, disk)...)
 		causes = append(causes, validateBootOrderValue(field, idx, disk)...)
 		causes = append(causes, validateBusSupport(field, idx, disk)...)
 		causes = append(causes, validateSerialNumValue(field, idx, disk)...)
 		causes = append(causes, validateSerialNumLength(field, idx, disk)...)
+		causes = append(causes, validateSerialPolicy(field, idx, disk)...)
+		causes = append(causes, validateWWN(field, idx, disk)...)
+		causes = append(causes, validateQueues(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field, idx, disk)...)
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
@@ -65,6 +80,67 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 	return causes
 }
 
+// ValidateSCSIControllers validates the scsi controllers of the vmi and the
+// references of disks to them
+func ValidateSCSIControllers(field *k8sfield.Path, devices *v1.Devices) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	controllerField := field.Child("scsiControllers")
+	names := map[string]struct{}{}
+	for idx, controller := range devices.SCSIControllers {
+		for _, err := range validation.IsDNS1123Label(controller.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is invalid: %s", controllerField.Index(idx).Child("name").String(), err),
+				Field:   controllerField.Index(idx).Child("name").String(),
+			})
+		}
+		if _, exists := names[controller.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s must be unique", controllerField.Index(idx).Child("name").String()),
+				Field:   controllerField.Index(idx).Child("name").String(),
+			})
+		}
+		names[controller.Name] = struct{}{}
+		if controller.Queues != nil && (*controller.Queues < 1 || *controller.Queues > maxSCSIControllerQueues) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s has %d queues, it must be between 1 and %d",
+					controllerField.Index(idx).Child("queues").String(), *controller.Queues, maxSCSIControllerQueues),
+				Field: controllerField.Index(idx).Child("queues").String(),
+			})
+		}
+	}
+
+	for idx, disk := range devices.Disks {
+		if disk.SCSIController == "" {
+			continue
+		}
+		diskField := field.Child("disks").Index(idx).Child("scsiController")
+		if disk.Disk == nil && disk.LUN == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is only supported for disks and luns", diskField.String()),
+				Field:   diskField.String(),
+			})
+		} else if bus := getDiskBus(disk); bus != v1.DiskBusSCSI {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is only supported on the %s bus, got %s", diskField.String(), v1.DiskBusSCSI, bus),
+				Field:   diskField.String(),
+			})
+		}
+		if _, exists := names[disk.SCSIController]; !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("%s references the unknown scsi controller %s", diskField.String(), disk.SCSIController),
+				Field:   diskField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func ValidateContainerDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for idx, volume := range spec.Volumes {
@@ -138,6 +214,17 @@ func validatePciAddress(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 	return causes
 }
 
+func validateVirtioTransitional(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	if disk.Disk == nil || disk.Disk.VirtioTransitional == nil || disk.Disk.Bus == v1.DiskBusVirtio {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("disk %s - setting virtioTransitional is only possible with bus type virtio.", field.Child("domain", "devices", "disks", "disk").Index(idx).Child("name").String()),
+		Field:   field.Child("domain", "devices", "disks", "disk").Index(idx).Child("virtioTransitional").String(),
+	}}
+}
+
 func validateBootOrderValue(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if disk.BootOrder != nil && *disk.BootOrder < 1 {
@@ -210,8 +297,24 @@ func validateBusSupport(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.St
 		}
 	case v1.DiskBusSCSI, v1.DiskBusUSB:
 		break
+	case v1.DiskBusNVMe:
+		// disks are attached as namespaces of emulated nvme controllers
+		if diskType != "disk" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Bus type %s is only supported for disks", bus),
+				Field:   field.Index(idx).Child(diskType, "bus").String(),
+			})
+		}
+		if len(disk.Serial) > maxNVMeSerialLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be longer than %d characters on the %s bus", field.Index(idx).Child("serial").String(), maxNVMeSerialLen, bus),
+				Field:   field.Index(idx).Child("serial").String(),
+			})
+		}
 	default:
-		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB}
+		supportedBuses := []v1.DiskBus{v1.DiskBusVirtio, v1.DiskBusSCSI, v1.DiskBusSATA, v1.DiskBusUSB, v1.DiskBusNVMe}
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s is set with an unrecognized bus %s, must be one of: %v", field.Index(idx).String(), bus, supportedBuses),
@@ -254,6 +357,154 @@ func validateSerialNumLength(field *k8sfield.Path, idx int, disk v1.Disk) []meta
 	return causes
 }
 
+func validateSerialPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	switch disk.SerialPolicy {
+	case "", v1.DiskSerialPolicyNone, v1.DiskSerialPolicyVolumeNameHash:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s has invalid value %s, must be one of: %v", field.Index(idx).Child("serialPolicy").String(), disk.SerialPolicy,
+			[]v1.DiskSerialPolicy{v1.DiskSerialPolicyNone, v1.DiskSerialPolicyVolumeNameHash}),
+		Field: field.Index(idx).Child("serialPolicy").String(),
+	}}
+}
+
+func validateWWN(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.WWN == "" {
+		return causes
+	}
+	if !isValidWWN(disk.WWN) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be made up of 16 hexadecimal digits", field.Index(idx).Child("wwn").String()),
+			Field:   field.Index(idx).Child("wwn").String(),
+		})
+	}
+	if bus := getDiskBus(disk); bus != v1.DiskBusSCSI {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is only supported on the %s bus, got %s", field.Index(idx).Child("wwn").String(), v1.DiskBusSCSI, bus),
+			Field:   field.Index(idx).Child("wwn").String(),
+		})
+	}
+	return causes
+}
+
+func validateQueues(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.Queues == nil {
+		return causes
+	}
+	if bus := getDiskBus(disk); bus != v1.DiskBusVirtio {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is only supported on the %s bus, got %s", field.Index(idx).Child("queues").String(), v1.DiskBusVirtio, bus),
+			Field:   field.Index(idx).Child("queues").String(),
+		})
+	}
+	if *disk.Queues < 1 || *disk.Queues > maxDiskQueues {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s has %d queues, it must be between 1 and %d", field.Index(idx).Child("queues").String(), *disk.Queues, maxDiskQueues),
+			Field:   field.Index(idx).Child("queues").String(),
+		})
+	}
+	return causes
+}
+
+func validateIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ioTune := disk.IOTune
+	if ioTune == nil {
+		return causes
+	}
+	ioTuneField := field.Index(idx).Child("ioTune")
+	burst := v1.DiskIOTuneBurst{}
+	if ioTune.Burst != nil {
+		burst = *ioTune.Burst
+	}
+
+	limits := []struct {
+		name         string
+		limit, burst *uint64
+	}{
+		{"totalBytesSec", ioTune.TotalBytesSec, burst.TotalBytesSec},
+		{"readBytesSec", ioTune.ReadBytesSec, burst.ReadBytesSec},
+		{"writeBytesSec", ioTune.WriteBytesSec, burst.WriteBytesSec},
+		{"totalIOPSSec", ioTune.TotalIOPSSec, burst.TotalIOPSSec},
+		{"readIOPSSec", ioTune.ReadIOPSSec, burst.ReadIOPSSec},
+		{"writeIOPSSec", ioTune.WriteIOPSSec, burst.WriteIOPSSec},
+	}
+	for _, l := range limits {
+		limitField := ioTuneField.Child(l.name)
+		burstField := ioTuneField.Child("burst", l.name)
+		if l.limit != nil && *l.limit == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", limitField.String()),
+				Field:   limitField.String(),
+			})
+		}
+		if l.burst == nil {
+			continue
+		}
+		if l.limit == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s requires %s to be set", burstField.String(), limitField.String()),
+				Field:   burstField.String(),
+			})
+		} else if *l.burst < *l.limit {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be lower than %s", burstField.String(), limitField.String()),
+				Field:   burstField.String(),
+			})
+		}
+	}
+
+	// QEMU does not allow to combine a total limit with a read or write limit of the same kind
+	for _, kind := range []struct {
+		total, read, write *uint64
+		name               string
+	}{
+		{ioTune.TotalBytesSec, ioTune.ReadBytesSec, ioTune.WriteBytesSec, "BytesSec"},
+		{ioTune.TotalIOPSSec, ioTune.ReadIOPSSec, ioTune.WriteIOPSSec, "IOPSSec"},
+	} {
+		if kind.total != nil && (kind.read != nil || kind.write != nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can not be combined with %s or %s", ioTuneField.Child("total"+kind.name).String(),
+					ioTuneField.Child("read"+kind.name).String(), ioTuneField.Child("write"+kind.name).String()),
+				Field: ioTuneField.Child("total" + kind.name).String(),
+			})
+		}
+	}
+
+	if burst.LengthSeconds != nil {
+		lengthField := ioTuneField.Child("burst", "lengthSeconds")
+		hasBurst := burst.TotalBytesSec != nil || burst.ReadBytesSec != nil || burst.WriteBytesSec != nil ||
+			burst.TotalIOPSSec != nil || burst.ReadIOPSSec != nil || burst.WriteIOPSSec != nil
+		if !hasBurst {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires a burst limit", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		}
+		if *burst.LengthSeconds < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", lengthField.String()),
+				Field:   lengthField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateCacheMode(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if disk.Cache != "" && disk.Cache != v1.CacheNone && disk.Cache != v1.CacheWriteThrough && disk.Cache != v1.CacheWriteBack {
@@ -287,6 +538,14 @@ func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.S
 			Field:   field.Index(idx).Child("errorPolicy").String(),
 		})
 	}
+	// enospace only applies to write errors
+	if disk.RerrorPolicy != nil && *disk.RerrorPolicy != v1.DiskErrorPolicyStop && *disk.RerrorPolicy != v1.DiskErrorPolicyIgnore && *disk.RerrorPolicy != v1.DiskErrorPolicyReport {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s has invalid value \"%s\"", field.Index(idx).Child("rerrorPolicy").String(), *disk.RerrorPolicy),
+			Field:   field.Index(idx).Child("rerrorPolicy").String(),
+		})
+	}
 	return causes
 }
 
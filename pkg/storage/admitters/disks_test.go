@@ -112,6 +112,43 @@ var _ = Describe("Disk Validation", func() {
 			Expect(causes[0].Field).To(Equal("disks[0].disk.bus"))
 		})
 
+		It("should accept disks on the nvme bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				Serial: "nvme-serial-0123456",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("disks"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject cdroms on the nvme bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testcdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{Bus: v1.DiskBusNVMe},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("disks"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("disks[0].cdrom.bus"))
+		})
+
+		It("should reject disks on the nvme bus with a serial longer than 20 characters", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				Serial: "nvme-serial-0123456789",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("disks"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("disks[0].serial"))
+		})
+
 		It("should reject disks with PCI address on a non-virtio bus ", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -126,6 +163,33 @@ var _ = Describe("Disk Validation", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].pciAddress"))
 		})
 
+		It("should reject disks with virtioTransitional on a non-virtio bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						VirtioTransitional: pointer.P(true),
+						Bus:                v1.DiskBusSATA},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].virtioTransitional"))
+		})
+
+		It("should accept disks with virtioTransitional on the virtio bus", func() {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						VirtioTransitional: pointer.P(true),
+						Bus:                v1.DiskBusVirtio},
+				},
+			})
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
 		It("should reject disks malformed PCI addresses ", func() {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 				Name: "testdisk",
@@ -329,6 +393,34 @@ var _ = Describe("Disk Validation", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid rerrorPolicy", func(policy string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", RerrorPolicy: pointer.P(v1.DiskErrorPolicy(policy)), DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal("fake[0].rerrorPolicy"))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("fake[0].rerrorPolicy has invalid value \"%s\"", policy)))
+		},
+			Entry("with arbitrary string", "unsupported"),
+			Entry("with enospace", string(v1.DiskErrorPolicyEnospace)),
+		)
+
+		DescribeTable("It should accept a disk with a valid rerrorPolicy", func(mode v1.DiskErrorPolicy) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", RerrorPolicy: pointer.P(mode), DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("stop", v1.DiskErrorPolicyStop),
+			Entry("report", v1.DiskErrorPolicyReport),
+			Entry("ignore", v1.DiskErrorPolicyIgnore),
+		)
+
 		It("should reject invalid SN characters", func() {
 			order := uint(1)
 			sn := "$$$$"
@@ -381,6 +473,113 @@ var _ = Describe("Disk Validation", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		DescribeTable("should validate the serial policy", func(policy v1.DiskSerialPolicy, expectedCauses int) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:         "testdisk",
+				SerialPolicy: policy,
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{},
+				},
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake[0].serialPolicy"))
+			}
+		},
+			Entry("and accept None", v1.DiskSerialPolicyNone, 0),
+			Entry("and accept VolumeNameHash", v1.DiskSerialPolicyVolumeNameHash, 0),
+			Entry("and reject an unknown policy", v1.DiskSerialPolicy("Random"), 1),
+		)
+
+		DescribeTable("should validate the WWN", func(wwn string, diskDevice v1.DiskDevice, expectedCauses int) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				WWN:        wwn,
+				DiskDevice: diskDevice,
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake[0].wwn"))
+			}
+		},
+			Entry("and accept a scsi disk", "5000c50015ea71ac", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, 0),
+			Entry("and accept a scsi lun", "5000C50015EA71AC", v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI}}, 0),
+			Entry("and reject too few digits", "5000c50015ea71", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, 1),
+			Entry("and reject non hexadecimal digits", "5000c50015ea71zz", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, 1),
+			Entry("and reject a virtio disk", "5000c50015ea71ac", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, 1),
+		)
+
+		DescribeTable("should validate the queues", func(queues uint32, diskDevice v1.DiskDevice, expectedCauses int) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				Queues:     pointer.P(queues),
+				DiskDevice: diskDevice,
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake[0].queues"))
+			}
+		},
+			Entry("and accept a virtio disk", uint32(8), v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, 0),
+			Entry("and accept the maximum on a virtio lun", uint32(1024), v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusVirtio}}, 0),
+			Entry("and reject zero queues", uint32(0), v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, 1),
+			Entry("and reject too many queues", uint32(1025), v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, 1),
+			Entry("and reject a scsi disk", uint32(4), v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}}, 1),
+			Entry("and reject a sata cdrom", uint32(4), v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}}, 1),
+		)
+
+		DescribeTable("should validate the IO limits", func(ioTune *v1.DiskIOTune, expectedFields ...string) {
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:       "testdisk",
+				IOTune:     ioTune,
+				DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+			})
+
+			causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("and accept limits with bursts", &v1.DiskIOTune{
+				ReadBytesSec:  pointer.P(uint64(1048576)),
+				WriteBytesSec: pointer.P(uint64(1048576)),
+				TotalIOPSSec:  pointer.P(uint64(500)),
+				Burst: &v1.DiskIOTuneBurst{
+					ReadBytesSec:  pointer.P(uint64(4194304)),
+					TotalIOPSSec:  pointer.P(uint64(500)),
+					LengthSeconds: pointer.P(uint64(60)),
+				},
+			}),
+			Entry("and reject a zero limit", &v1.DiskIOTune{ReadIOPSSec: pointer.P(uint64(0))},
+				"fake[0].ioTune.readIOPSSec"),
+			Entry("and reject a total limit combined with a read limit", &v1.DiskIOTune{
+				TotalBytesSec: pointer.P(uint64(1048576)),
+				ReadBytesSec:  pointer.P(uint64(1048576)),
+			}, "fake[0].ioTune.totalBytesSec"),
+			Entry("and reject a burst without a limit", &v1.DiskIOTune{
+				Burst: &v1.DiskIOTuneBurst{WriteIOPSSec: pointer.P(uint64(100))},
+			}, "fake[0].ioTune.burst.writeIOPSSec"),
+			Entry("and reject a burst lower than the limit", &v1.DiskIOTune{
+				TotalIOPSSec: pointer.P(uint64(100)),
+				Burst:        &v1.DiskIOTuneBurst{TotalIOPSSec: pointer.P(uint64(50))},
+			}, "fake[0].ioTune.burst.totalIOPSSec"),
+			Entry("and reject a burst length without a burst", &v1.DiskIOTune{
+				TotalIOPSSec: pointer.P(uint64(100)),
+				Burst:        &v1.DiskIOTuneBurst{LengthSeconds: pointer.P(uint64(10))},
+			}, "fake[0].ioTune.burst.lengthSeconds"),
+			Entry("and reject a zero burst length", &v1.DiskIOTune{
+				TotalIOPSSec: pointer.P(uint64(100)),
+				Burst:        &v1.DiskIOTuneBurst{TotalIOPSSec: pointer.P(uint64(200)), LengthSeconds: pointer.P(uint64(0))},
+			}, "fake[0].ioTune.burst.lengthSeconds"),
+		)
+
 		DescribeTable("Should reject disk with DedicatedIOThread and non-virtio bus", func(bus v1.DiskBus) {
 			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks,
 				v1.Disk{
@@ -416,6 +615,7 @@ var _ = Describe("Disk Validation", func() {
 			Entry("SATA bus", v1.DiskBusSATA),
 			Entry("SCSI bus", v1.DiskBusSCSI),
 			Entry("USB bus", v1.DiskBusUSB),
+			Entry("NVMe bus", v1.DiskBusNVMe),
 		)
 
 		Context("With block size", func() {
@@ -565,4 +765,60 @@ var _ = Describe("Disk Validation", func() {
 			})
 		})
 	})
+
+	Context("with ValidateSCSIControllers", func() {
+		scsiDisk := func(controller string) v1.Disk {
+			return v1.Disk{
+				Name:           "testdisk",
+				SCSIController: controller,
+				DiskDevice:     v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI}},
+			}
+		}
+
+		DescribeTable("should validate the controllers", func(controllers []v1.SCSIController, expectedFields ...string) {
+			devices := &v1.Devices{SCSIControllers: controllers}
+			causes := ValidateSCSIControllers(k8sfield.NewPath("fake"), devices)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("and accept valid controllers", []v1.SCSIController{
+				{Name: "fast", Queues: pointer.P(uint32(4)), DedicatedIOThread: pointer.P(true)},
+				{Name: "slow", Queues: pointer.P(uint32(1022))},
+			}),
+			Entry("and reject an invalid name", []v1.SCSIController{{Name: "Fast_1"}}, "fake.scsiControllers[0].name"),
+			Entry("and reject an empty name", []v1.SCSIController{{Name: ""}}, "fake.scsiControllers[0].name"),
+			Entry("and reject a duplicate name", []v1.SCSIController{{Name: "fast"}, {Name: "fast"}}, "fake.scsiControllers[1].name"),
+			Entry("and reject zero queues", []v1.SCSIController{{Name: "fast", Queues: pointer.P(uint32(0))}}, "fake.scsiControllers[0].queues"),
+			Entry("and reject too many queues", []v1.SCSIController{{Name: "fast", Queues: pointer.P(uint32(1023))}}, "fake.scsiControllers[0].queues"),
+		)
+
+		DescribeTable("should validate the controller of disks", func(disk v1.Disk, expectedCauses int) {
+			devices := &v1.Devices{
+				SCSIControllers: []v1.SCSIController{{Name: "fast"}},
+				Disks:           []v1.Disk{disk},
+			}
+			causes := ValidateSCSIControllers(k8sfield.NewPath("fake"), devices)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake.disks[0].scsiController"))
+			}
+		},
+			Entry("and accept a scsi disk", scsiDisk("fast"), 0),
+			Entry("and accept a scsi lun", v1.Disk{
+				Name: "testdisk", SCSIController: "fast", DiskDevice: v1.DiskDevice{LUN: &v1.LunTarget{Bus: v1.DiskBusSCSI}},
+			}, 0),
+			Entry("and accept a disk without a controller", v1.Disk{
+				Name: "testdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+			}, 0),
+			Entry("and reject an unknown controller", scsiDisk("slow"), 1),
+			Entry("and reject a virtio disk", v1.Disk{
+				Name: "testdisk", SCSIController: "fast", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}},
+			}, 1),
+			Entry("and reject a cdrom", v1.Disk{
+				Name: "testdisk", SCSIController: "fast", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSCSI}},
+			}, 1),
+		)
+	})
 })
@@ -217,6 +217,22 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		return res
 	}
 
+	makeConfigMapVolumes := func(indexes ...int) []v1.Volume {
+		res := make([]v1.Volume, 0)
+		for _, index := range indexes {
+			res = append(res, v1.Volume{
+				Name: fmt.Sprintf("volume-name-%d", index),
+				VolumeSource: v1.VolumeSource{
+					ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: k8sv1.LocalObjectReference{Name: fmt.Sprintf("configmap-name-%d", index)},
+						Hotpluggable:         true,
+					},
+				},
+			})
+		}
+		return res
+	}
+
 	makeInvalidVolumes := func(total int, indexes ...int) []v1.Volume {
 		res := make([]v1.Volume, 0)
 		for i := 0; i < total; i++ {
@@ -397,7 +413,7 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeDisks(0),
 			makeFilesystems(),
 			makeStatus(1, 0),
-			makeExpected("volume volume-name-1 is not a PVC or DataVolume", "")),
+			makeExpected("volume volume-name-1 is not a PVC, DataVolume or hotpluggable ConfigMap, Secret or ServiceAccount", "")),
 		Entry("Should accept if we add volumes and disk properly",
 			makeVolumes(0, 1),
 			makeVolumes(0, 1),
@@ -406,6 +422,14 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeFilesystems(),
 			makeStatus(2, 1),
 			nil),
+		Entry("Should accept if we add a hotpluggable ConfigMap and disk properly",
+			append(makeVolumes(0), makeConfigMapVolumes(1)...),
+			makeVolumes(0),
+			makeDisks(0, 1),
+			makeDisks(0),
+			makeFilesystems(),
+			makeStatus(1, 0),
+			nil),
 		Entry("Should accept if we add volumes and disk properly (virtio bus)",
 			makeVolumes(0, 1),
 			makeVolumes(0),
@@ -114,6 +114,16 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 					if newCauses != nil {
 						causes = append(causes, newCauses...)
 					}
+
+					newCauses = admitter.validateFirmwareIdentityPolicy(ctx, vmRestore)
+					if newCauses != nil {
+						causes = append(causes, newCauses...)
+					}
+
+					newCauses = admitter.validateMappings(ctx, vmRestore)
+					if newCauses != nil {
+						causes = append(causes, newCauses...)
+					}
 				default:
 					causes = []metav1.StatusCause{
 						{
@@ -349,3 +359,72 @@ func (admitter *VMRestoreAdmitter) validateVolumeOwnershipPolicy(ctx context.Con
 
 	return causes
 }
+
+func (admitter *VMRestoreAdmitter) validateFirmwareIdentityPolicy(ctx context.Context, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause) {
+	// Cancel if there's no firmware identity policy
+	if vmRestore.Spec.FirmwareIdentityPolicy == nil {
+		return nil
+	}
+
+	policy := *vmRestore.Spec.FirmwareIdentityPolicy
+
+	// Verify the policy provided is among the ones that are allowed
+	switch policy {
+	case snapshotv1.FirmwareIdentityPolicyPreserve, snapshotv1.FirmwareIdentityPolicyRegenerate:
+		return nil
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("firmware identity policy \"%s\" doesn't exist", policy),
+			Field: k8sfield.NewPath("spec").
+				Child("firmwareIdentityPolicy").
+				String(),
+		})
+	}
+
+	return causes
+}
+
+func (admitter *VMRestoreAdmitter) validateMappings(ctx context.Context, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause) {
+	storageClasses := map[string]bool{}
+	for i, mapping := range vmRestore.Spec.StorageClassMappings {
+		field := k8sfield.NewPath("spec").Child("storageClassMappings").Index(i)
+		causes = append(causes, validateMapping(field, mapping.Source, mapping.Target, storageClasses)...)
+	}
+
+	networks := map[string]bool{}
+	for i, mapping := range vmRestore.Spec.NetworkMappings {
+		field := k8sfield.NewPath("spec").Child("networkMappings").Index(i)
+		causes = append(causes, validateMapping(field, mapping.Source, mapping.Target, networks)...)
+	}
+
+	return causes
+}
+
+// validateMapping requires the source and target of a mapping, and rejects sources which are already mapped
+func validateMapping(field *k8sfield.Path, source, target string, mapped map[string]bool) (causes []metav1.StatusCause) {
+	if source == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "must provide a source",
+			Field:   field.Child("source").String(),
+		})
+	} else if mapped[source] {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueDuplicate,
+			Message: fmt.Sprintf("source \"%s\" is mapped more than once", source),
+			Field:   field.Child("source").String(),
+		})
+	}
+	mapped[source] = true
+
+	if target == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "must provide a target",
+			Field:   field.Child("target").String(),
+		})
+	}
+
+	return causes
+}
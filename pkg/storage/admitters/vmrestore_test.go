@@ -551,6 +551,75 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeOwnershipPolicy"))
 			})
 
+			DescribeTable("should validate the firmware identity policy", func(policy snapshotv1.FirmwareIdentityPolicy, allowed bool) {
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						VirtualMachineSnapshotName: vmSnapshotName,
+						FirmwareIdentityPolicy:     pointer.P(policy),
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(allowed))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.firmwareIdentityPolicy"))
+				}
+			},
+				Entry("accept Preserve", snapshotv1.FirmwareIdentityPolicyPreserve, true),
+				Entry("accept Regenerate", snapshotv1.FirmwareIdentityPolicyRegenerate, true),
+				Entry("reject an unknown policy", snapshotv1.FirmwareIdentityPolicy("invalid"), false),
+			)
+
+			DescribeTable("should validate the mappings", func(spec snapshotv1.VirtualMachineRestoreSpec, expectedFields ...string) {
+				spec.Target = corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				}
+				spec.VirtualMachineSnapshotName = vmSnapshotName
+				restore := &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: spec,
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(context.Background(), ar)
+				Expect(resp.Allowed).To(Equal(len(expectedFields) == 0))
+				if len(expectedFields) > 0 {
+					var fields []string
+					for _, cause := range resp.Result.Details.Causes {
+						fields = append(fields, cause.Field)
+					}
+					Expect(fields).To(ConsistOf(expectedFields))
+				}
+			},
+				Entry("accept storage class and network mappings", snapshotv1.VirtualMachineRestoreSpec{
+					StorageClassMappings: []snapshotv1.StorageClassMapping{{Source: "old-sc", Target: "new-sc"}},
+					NetworkMappings:      []snapshotv1.NetworkMapping{{Source: "old-net", Target: "other-ns/new-net"}},
+				}),
+				Entry("reject mappings without source or target", snapshotv1.VirtualMachineRestoreSpec{
+					StorageClassMappings: []snapshotv1.StorageClassMapping{{Target: "new-sc"}},
+					NetworkMappings:      []snapshotv1.NetworkMapping{{Source: "old-net"}},
+				}, "spec.storageClassMappings[0].source", "spec.networkMappings[0].target"),
+				Entry("reject sources mapped more than once", snapshotv1.VirtualMachineRestoreSpec{
+					StorageClassMappings: []snapshotv1.StorageClassMapping{{Source: "old-sc", Target: "new-sc"}, {Source: "old-sc", Target: "other-sc"}},
+				}, "spec.storageClassMappings[1].source"),
+			)
+
 			DescribeTable("Should reject restore when using backend storage and restoring to different VM", func(doesTargetExist bool) {
 				const targetVMName = "new-test-vm"
 				targetVM := &v1.VirtualMachine{}
@@ -36,6 +36,7 @@ import (
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 // VMSnapshotAdmitter validates VirtualMachineSnapshots
@@ -108,6 +109,8 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 			}
 		}
 
+		causes = append(causes, admitter.validateIncludeMemory(vmSnapshot)...)
+
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -137,3 +140,27 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 	}
 	return &reviewResponse
 }
+
+func (admitter *VMSnapshotAdmitter) validateIncludeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) []metav1.StatusCause {
+	if vmSnapshot.Spec.IncludeMemory == nil || !*vmSnapshot.Spec.IncludeMemory {
+		return nil
+	}
+
+	field := k8sfield.NewPath("spec", "includeMemory").String()
+	if !admitter.Config.SnapshotMemoryEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s feature gate not enabled", featuregate.SnapshotMemoryGate),
+			Field:   field,
+		}}
+	}
+	// The memory state is written by a memory dump, which hotplugs its claim
+	if !admitter.Config.HotplugVolumesEnabled() && !admitter.Config.DeclarativeHotplugVolumesEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "capturing the memory requires volume hotplug to be enabled",
+			Field:   field,
+		}}
+	}
+	return nil
+}
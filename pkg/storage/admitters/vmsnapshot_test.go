@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
@@ -64,12 +65,12 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 	})
 
 	Context("With feature gate enabled", func() {
-		enableFeatureGate := func(featureGate string) {
+		enableFeatureGate := func(featureGates ...string) {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 				Spec: v1.KubeVirtSpec{
 					Configuration: v1.KubeVirtConfiguration{
 						DeveloperConfiguration: &v1.DeveloperConfiguration{
-							FeatureGates: []string{featureGate},
+							FeatureGates: featureGates,
 						},
 					},
 				},
@@ -192,6 +193,38 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("with includeMemory", func(expectedMessage string, featureGates ...string) {
+			enableFeatureGate(append(featureGates, featuregate.SnapshotGate)...)
+			snapshot := &snapshotv1.VirtualMachineSnapshot{
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     vmName,
+					},
+					IncludeMemory: pointer.P(true),
+				},
+			}
+
+			ar := createSnapshotAdmissionReview(snapshot)
+			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
+			if expectedMessage == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.includeMemory"))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("should reject without the SnapshotMemory feature gate",
+				"SnapshotMemory feature gate not enabled", featuregate.HotplugVolumesGate),
+			Entry("should reject without volume hotplug",
+				"capturing the memory requires volume hotplug to be enabled", featuregate.SnapshotMemoryGate),
+			Entry("should allow with the SnapshotMemory feature gate and volume hotplug",
+				"", featuregate.SnapshotMemoryGate, featuregate.HotplugVolumesGate),
+		)
+
 		Context("when VirtualMachine exists", func() {
 			var vm *v1.VirtualMachine
 
@@ -0,0 +1,388 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backuphook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	defaultFreezeTimeout = 5 * time.Minute
+
+	backupHookSucceededEvent = "VirtualMachineBackupHookSucceeded"
+	backupHookFailedEvent    = "VirtualMachineBackupHookFailed"
+
+	vmNotFoundMsg     = "Waiting for VM %s to exist"
+	actionFailedMsg   = "%s action failed: %v"
+	hookSucceededMsg  = "Executed the %s hook for VM %s"
+	unknownActionMsg  = "unknown action %s"
+	interfacesPath    = "/spec/template/spec/domain/devices/interfaces"
+	hookIndexVMSource = "vm"
+)
+
+// Controller executes the actions of VirtualMachineBackupHooks, which external
+// backup tools create around the backup and the restore of a VM.
+type Controller struct {
+	client       kubecli.KubevirtClient
+	hookInformer cache.SharedIndexInformer
+	vmStore      cache.Store
+	vmiStore     cache.Store
+	recorder     record.EventRecorder
+	hookQueue    workqueue.TypedRateLimitingInterface[string]
+	hasSynced    func() bool
+}
+
+func NewController(client kubecli.KubevirtClient,
+	hookInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*Controller, error) {
+	c := &Controller{
+		hookQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackuphook"},
+		),
+		client:       client,
+		hookInformer: hookInformer,
+		vmStore:      vmInformer.GetStore(),
+		vmiStore:     vmiInformer.GetStore(),
+		recorder:     recorder,
+	}
+
+	c.hasSynced = func() bool {
+		return hookInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := hookInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleHook,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleHook(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hooks of restored VMs may be created before the VM itself
+	_, err = vmInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.handleVM,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleHook(obj interface{}) {
+	hook, ok := obj.(*backupv1.VirtualMachineBackupHook)
+	if !ok {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(hook)
+	if err != nil {
+		log.Log.Errorf("failed to get key from object: %v, %v", err, hook)
+		return
+	}
+
+	log.Log.V(3).Infof("enqueued %q for sync", key)
+	c.hookQueue.Add(key)
+}
+
+func (c *Controller) handleVM(obj interface{}) {
+	vm, ok := obj.(*v1.VirtualMachine)
+	if !ok {
+		return
+	}
+
+	keys, err := c.hookInformer.GetIndexer().IndexKeys(hookIndexVMSource, fmt.Sprintf("%s/%s", vm.Namespace, vm.Name))
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		c.hookQueue.Add(key)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.hookQueue.ShutDown()
+
+	log.Log.Info("Starting backup hook controller.")
+	defer log.Log.Info("Shutting down backup hook controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.hookQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.hookQueue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineBackupHook %v", key)
+		c.hookQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineBackupHook %v", key)
+		c.hookQueue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.hookInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	hook, ok := obj.(*backupv1.VirtualMachineBackupHook)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", obj)
+	}
+	if IsHookDone(hook) {
+		return nil
+	}
+
+	hookCopy := hook.DeepCopy()
+	if hookCopy.Status == nil {
+		hookCopy.Status = &backupv1.VirtualMachineBackupHookStatus{}
+	}
+	c.sync(hookCopy)
+
+	if equality.Semantic.DeepEqual(hook.Status, hookCopy.Status) {
+		return nil
+	}
+	_, err = c.client.VirtualMachineBackupHook(hookCopy.Namespace).UpdateStatus(context.Background(), hookCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// sync executes the pending actions of the hook in order and records the
+// outcome in its status. Any failing action fails the whole hook, the backup
+// tool is expected to create a new hook to retry.
+func (c *Controller) sync(hook *backupv1.VirtualMachineBackupHook) {
+	status := hook.Status
+	status.Phase = backupv1.BackupHookRunning
+
+	vmKey := fmt.Sprintf("%s/%s", hook.Namespace, hook.Spec.Source.Name)
+	obj, exists, err := c.vmStore.GetByKey(vmKey)
+	if err != nil || !exists {
+		status.Message = fmt.Sprintf(vmNotFoundMsg, hook.Spec.Source.Name)
+		return
+	}
+	vm := obj.(*v1.VirtualMachine)
+
+	var vmi *v1.VirtualMachineInstance
+	if obj, exists, _ := c.vmiStore.GetByKey(vmKey); exists {
+		vmi = obj.(*v1.VirtualMachineInstance)
+	}
+
+	for _, action := range hook.Spec.Actions {
+		if isActionCompleted(status, action) {
+			continue
+		}
+		if err := c.executeAction(hook, action, vm, vmi); err != nil {
+			status.Phase = backupv1.BackupHookFailed
+			status.Message = fmt.Sprintf(actionFailedMsg, action, err)
+			status.CompletionTime = now()
+			c.recorder.Event(hook, corev1.EventTypeWarning, backupHookFailedEvent, status.Message)
+			return
+		}
+		status.CompletedActions = append(status.CompletedActions, action)
+	}
+
+	status.Phase = backupv1.BackupHookSucceeded
+	status.Message = fmt.Sprintf(hookSucceededMsg, hook.Spec.Stage, vm.Name)
+	status.CompletionTime = now()
+	c.recorder.Event(hook, corev1.EventTypeNormal, backupHookSucceededEvent, status.Message)
+}
+
+func (c *Controller) executeAction(hook *backupv1.VirtualMachineBackupHook, action backupv1.BackupHookAction, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) error {
+	switch action {
+	case backupv1.FreezeAction:
+		// The disks of a VM which is not running are consistent already
+		if !isRunning(vmi) {
+			return nil
+		}
+		return c.client.VirtualMachineInstance(vmi.Namespace).Freeze(context.Background(), vmi.Name, freezeTimeout(hook))
+	case backupv1.UnfreezeAction:
+		if !isRunning(vmi) {
+			return nil
+		}
+		return c.client.VirtualMachineInstance(vmi.Namespace).Unfreeze(context.Background(), vmi.Name)
+	case backupv1.ReattachHotplugVolumesAction:
+		// A VMI which is started later attaches all the volumes of the VM
+		if !isRunning(vmi) {
+			return nil
+		}
+		return c.reattachHotplugVolumes(vm, vmi)
+	case backupv1.RegenerateMACAddressesAction:
+		return c.regenerateMACAddresses(vm)
+	default:
+		return fmt.Errorf(unknownActionMsg, action)
+	}
+}
+
+// reattachHotplugVolumes hotplugs the hotpluggable volumes of the VM which are
+// missing on its running VMI, e.g. because the VMI was restored without them.
+func (c *Controller) reattachHotplugVolumes(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) error {
+	attached := map[string]struct{}{}
+	for _, volume := range vmi.Spec.Volumes {
+		attached[volume.Name] = struct{}{}
+	}
+
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if _, exists := attached[volume.Name]; exists || !storagetypes.IsHotplugVolume(&volume) {
+			continue
+		}
+		options := &v1.AddVolumeOptions{
+			Name: volume.Name,
+			Disk: lookupDisk(vm.Spec.Template.Spec.Domain.Devices.Disks, volume.Name),
+			VolumeSource: &v1.HotplugVolumeSource{
+				PersistentVolumeClaim: volume.PersistentVolumeClaim,
+				DataVolume:            volume.DataVolume,
+				ConfigMap:             volume.ConfigMap,
+				Secret:                volume.Secret,
+				ServiceAccount:        volume.ServiceAccount,
+			},
+		}
+		if err := c.client.VirtualMachineInstance(vmi.Namespace).AddVolume(context.Background(), vmi.Name, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// regenerateMACAddresses clears the MAC addresses of the VM interfaces. The
+// MAC pool of the cluster, if any, assigns new addresses, otherwise the
+// network bindings pick their defaults on the next start of the VM.
+func (c *Controller) regenerateMACAddresses(vm *v1.VirtualMachine) error {
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	newInterfaces := make([]v1.Interface, len(interfaces))
+	changed := false
+	for i, iface := range interfaces {
+		newInterfaces[i] = iface
+		if iface.MacAddress != "" {
+			newInterfaces[i].MacAddress = ""
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	payload, err := patch.New(
+		patch.WithTest(interfacesPath, interfaces),
+		patch.WithReplace(interfacesPath, newInterfaces),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, k8stypes.JSONPatchType, payload, metav1.PatchOptions{})
+	return err
+}
+
+// IsHookDone returns true once all the actions of the hook were executed or one of them failed
+func IsHookDone(hook *backupv1.VirtualMachineBackupHook) bool {
+	return hook.Status != nil &&
+		(hook.Status.Phase == backupv1.BackupHookSucceeded || hook.Status.Phase == backupv1.BackupHookFailed)
+}
+
+func isActionCompleted(status *backupv1.VirtualMachineBackupHookStatus, action backupv1.BackupHookAction) bool {
+	for _, completed := range status.CompletedActions {
+		if completed == action {
+			return true
+		}
+	}
+	return false
+}
+
+func freezeTimeout(hook *backupv1.VirtualMachineBackupHook) time.Duration {
+	if hook.Spec.FreezeTimeout != nil {
+		return hook.Spec.FreezeTimeout.Duration
+	}
+	return defaultFreezeTimeout
+}
+
+func isRunning(vmi *v1.VirtualMachineInstance) bool {
+	return vmi != nil && vmi.IsRunning()
+}
+
+func lookupDisk(disks []v1.Disk, name string) *v1.Disk {
+	for i := range disks {
+		if disks[i].Name == name {
+			return disks[i].DeepCopy()
+		}
+	}
+	return &v1.Disk{
+		Name: name,
+		DiskDevice: v1.DiskDevice{
+			Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI},
+		},
+	}
+}
+
+func now() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
@@ -0,0 +1,281 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backuphook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testNamespace = "default"
+	vmName        = "test-vm"
+	hookName      = "test-hook"
+)
+
+var _ = Describe("Backup Hook Controller", func() {
+	var (
+		ctrl           *gomock.Controller
+		virtClient     *kubecli.MockKubevirtClient
+		vmInterface    *kubecli.MockVirtualMachineInterface
+		vmiInterface   *kubecli.MockVirtualMachineInstanceInterface
+		hookInformer   cache.SharedIndexInformer
+		vmInformer     cache.SharedIndexInformer
+		vmiInformer    cache.SharedIndexInformer
+		controller     *Controller
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	createHook := func(stage backupv1.BackupHookStage, actions ...backupv1.BackupHookAction) *backupv1.VirtualMachineBackupHook {
+		return &backupv1.VirtualMachineBackupHook{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      hookName,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupHookSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				Stage:   stage,
+				Actions: actions,
+			},
+		}
+	}
+
+	createVM := func(opts ...libvmi.Option) *v1.VirtualMachine {
+		opts = append([]libvmi.Option{libvmi.WithName(vmName), libvmi.WithNamespace(testNamespace)}, opts...)
+		return libvmi.NewVirtualMachine(libvmi.New(opts...))
+	}
+
+	createRunningVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{libvmi.WithName(vmName), libvmi.WithNamespace(testNamespace)}, opts...)
+		vmi := libvmi.New(opts...)
+		vmi.Status.Phase = v1.Running
+		return vmi
+	}
+
+	addHook := func(hook *backupv1.VirtualMachineBackupHook) {
+		Expect(hookInformer.GetStore().Add(hook)).To(Succeed())
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupHooks(hook.Namespace).Create(context.Background(), hook, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		controller.hookQueue.Add(fmt.Sprintf("%s/%s", hook.Namespace, hook.Name))
+	}
+
+	getHookStatus := func() *backupv1.VirtualMachineBackupHookStatus {
+		hook, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupHooks(testNamespace).Get(context.Background(), hookName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return hook.Status
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		hookInformer, _ = testutils.NewFakeInformerWithIndexersFor(
+			&backupv1.VirtualMachineBackupHook{},
+			cache.Indexers{
+				hookIndexVMSource: func(obj interface{}) ([]string, error) {
+					hook := obj.(*backupv1.VirtualMachineBackupHook)
+					return []string{fmt.Sprintf("%s/%s", hook.Namespace, hook.Spec.Source.Name)}, nil
+				},
+			},
+		)
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &Controller{
+			client:       virtClient,
+			hookInformer: hookInformer,
+			vmStore:      vmInformer.GetStore(),
+			vmiStore:     vmiInformer.GetStore(),
+			recorder:     recorder,
+			hookQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-backup-hook-queue"},
+			),
+		}
+
+		virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackupHook(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupHooks(testNamespace)).AnyTimes()
+	})
+
+	It("should wait for the VM to exist", func() {
+		addHook(createHook(backupv1.PostRestore, backupv1.ReattachHotplugVolumesAction))
+		controller.Execute()
+
+		status := getHookStatus()
+		Expect(status.Phase).To(Equal(backupv1.BackupHookRunning))
+		Expect(status.Message).To(Equal(fmt.Sprintf(vmNotFoundMsg, vmName)))
+	})
+
+	It("should enqueue the hooks of a VM once it is created", func() {
+		Expect(hookInformer.GetStore().Add(createHook(backupv1.PostRestore, backupv1.ReattachHotplugVolumesAction))).To(Succeed())
+		controller.handleVM(createVM())
+		Expect(controller.hookQueue.Len()).To(Equal(1))
+	})
+
+	It("should freeze a running VMI with the default timeout", func() {
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+		vmiInterface.EXPECT().Freeze(gomock.Any(), vmName, defaultFreezeTimeout).Return(nil)
+
+		addHook(createHook(backupv1.PreBackup, backupv1.FreezeAction))
+		controller.Execute()
+
+		status := getHookStatus()
+		Expect(status.Phase).To(Equal(backupv1.BackupHookSucceeded))
+		Expect(status.CompletedActions).To(ConsistOf(backupv1.FreezeAction))
+		Expect(status.CompletionTime).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, backupHookSucceededEvent)
+	})
+
+	It("should freeze with the timeout of the hook", func() {
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+		vmiInterface.EXPECT().Freeze(gomock.Any(), vmName, time.Minute).Return(nil)
+
+		hook := createHook(backupv1.PreBackup, backupv1.FreezeAction)
+		hook.Spec.FreezeTimeout = &metav1.Duration{Duration: time.Minute}
+		addHook(hook)
+		controller.Execute()
+
+		Expect(getHookStatus().Phase).To(Equal(backupv1.BackupHookSucceeded))
+	})
+
+	It("should succeed without unfreezing a VM which is not running", func() {
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+
+		addHook(createHook(backupv1.PostBackup, backupv1.UnfreezeAction))
+		controller.Execute()
+
+		status := getHookStatus()
+		Expect(status.Phase).To(Equal(backupv1.BackupHookSucceeded))
+		Expect(status.CompletedActions).To(ConsistOf(backupv1.UnfreezeAction))
+	})
+
+	It("should fail the hook when an action fails", func() {
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+		vmiInterface.EXPECT().Unfreeze(gomock.Any(), vmName).Return(fmt.Errorf("guest agent not connected"))
+
+		addHook(createHook(backupv1.PostBackup, backupv1.UnfreezeAction))
+		controller.Execute()
+
+		status := getHookStatus()
+		Expect(status.Phase).To(Equal(backupv1.BackupHookFailed))
+		Expect(status.Message).To(ContainSubstring("guest agent not connected"))
+		Expect(status.CompletedActions).To(BeEmpty())
+		testutils.ExpectEvent(recorder, backupHookFailedEvent)
+	})
+
+	It("should not execute a hook which is done", func() {
+		hook := createHook(backupv1.PreBackup, backupv1.FreezeAction)
+		hook.Status = &backupv1.VirtualMachineBackupHookStatus{Phase: backupv1.BackupHookSucceeded}
+		Expect(vmInformer.GetStore().Add(createVM())).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+
+		addHook(hook)
+		controller.Execute()
+
+		Expect(getHookStatus().CompletedActions).To(BeEmpty())
+	})
+
+	It("should reattach the hotplugged volumes missing on the VMI", func() {
+		hotplugged := v1.Volume{
+			Name: "hotplugged",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "hotplugged-pvc"},
+					Hotpluggable:                      true,
+				},
+			},
+		}
+		vm := createVM(libvmi.WithPersistentVolumeClaim("disk0", "disk0-pvc"))
+		vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, hotplugged)
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(libvmi.WithPersistentVolumeClaim("disk0", "disk0-pvc")))).To(Succeed())
+
+		vmiInterface.EXPECT().AddVolume(gomock.Any(), vmName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, options *v1.AddVolumeOptions) error {
+				Expect(options.Name).To(Equal(hotplugged.Name))
+				Expect(options.VolumeSource.PersistentVolumeClaim).To(Equal(hotplugged.PersistentVolumeClaim))
+				Expect(options.Disk.Name).To(Equal(hotplugged.Name))
+				return nil
+			})
+
+		addHook(createHook(backupv1.PostRestore, backupv1.ReattachHotplugVolumesAction))
+		controller.Execute()
+
+		Expect(getHookStatus().Phase).To(Equal(backupv1.BackupHookSucceeded))
+	})
+
+	It("should clear the MAC addresses of the VM interfaces", func() {
+		iface := libvmi.InterfaceDeviceWithMasqueradeBinding()
+		iface.MacAddress = "de:ad:00:00:be:af"
+		vm := createVM(libvmi.WithInterface(iface), libvmi.WithNetwork(v1.DefaultPodNetwork()))
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+
+		vmInterface.EXPECT().Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ k8stypes.PatchType, payload []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(string(payload)).To(ContainSubstring(`"op":"test"`))
+				Expect(string(payload)).To(ContainSubstring(`"op":"replace"`))
+				Expect(string(payload)).To(ContainSubstring(`"macAddress":"de:ad:00:00:be:af"`))
+				return vm, nil
+			})
+
+		addHook(createHook(backupv1.PostRestore, backupv1.RegenerateMACAddressesAction))
+		controller.Execute()
+
+		Expect(getHookStatus().CompletedActions).To(ConsistOf(backupv1.RegenerateMACAddressesAction))
+	})
+})
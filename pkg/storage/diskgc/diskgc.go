@@ -0,0 +1,578 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package diskgc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// annContentType is set by CDI on the PVCs it populates, it tells the disks apart from other PVCs
+	annContentType = "cdi.kubevirt.io/storage.contentType"
+
+	// resyncPeriod bounds how late a namespace is scanned after a change that emits no event,
+	// like enabling the feature gate
+	resyncPeriod = time.Hour
+
+	OrphanedDiskDeletedReason = "OrphanedDiskDeleted"
+	OrphanedDiskDeleteFailed  = "OrphanedDiskDeleteFailed"
+
+	dataVolumeKind            = "DataVolume"
+	persistentVolumeClaimKind = "PersistentVolumeClaim"
+)
+
+type diskObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// disk is a DataVolume or a PersistentVolumeClaim which is subject to garbage collection
+type disk struct {
+	kind string
+	obj  diskObject
+	size int64
+}
+
+// Controller detects the DataVolumes and PersistentVolumeClaims which are no
+// longer referenced by any VM, VMI, pod, snapshot, export, backup or DataSource.
+// Unreferenced disks are annotated with the time they were first found
+// unreferenced, once the grace period elapsed they are reported as orphaned
+// and deleted if the cleanup is enabled and they opted in.
+type Controller struct {
+	client               kubecli.KubevirtClient
+	clusterConfig        *virtconfig.ClusterConfig
+	vmStore              cache.Store
+	vmiStore             cache.Store
+	podStore             cache.Store
+	dataVolumeStore      cache.Store
+	pvcStore             cache.Store
+	snapshotContentStore cache.Store
+	dataSourceStore      cache.Store
+	exportStore          cache.Store
+	backupStore          cache.Store
+	recorder             record.EventRecorder
+	namespaceQueue       workqueue.TypedRateLimitingInterface[string]
+	hasSynced            func() bool
+	now                  func() time.Time
+}
+
+func NewController(client kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	dataVolumeInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
+	snapshotContentInformer cache.SharedIndexInformer,
+	dataSourceInformer cache.SharedIndexInformer,
+	exportInformer cache.SharedIndexInformer,
+	backupInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*Controller, error) {
+	c := &Controller{
+		namespaceQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-diskgc"},
+		),
+		client:               client,
+		clusterConfig:        clusterConfig,
+		vmStore:              vmInformer.GetStore(),
+		vmiStore:             vmiInformer.GetStore(),
+		podStore:             podInformer.GetStore(),
+		dataVolumeStore:      dataVolumeInformer.GetStore(),
+		pvcStore:             pvcInformer.GetStore(),
+		snapshotContentStore: snapshotContentInformer.GetStore(),
+		dataSourceStore:      dataSourceInformer.GetStore(),
+		exportStore:          exportInformer.GetStore(),
+		backupStore:          backupInformer.GetStore(),
+		recorder:             recorder,
+		now:                  time.Now,
+	}
+
+	c.hasSynced = func() bool {
+		return vmInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() &&
+			dataVolumeInformer.HasSynced() && pvcInformer.HasSynced() && snapshotContentInformer.HasSynced() &&
+			dataSourceInformer.HasSynced() && exportInformer.HasSynced() && backupInformer.HasSynced()
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNamespace,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueNamespace(newObj) },
+		DeleteFunc: c.enqueueNamespace,
+	}
+	// Pods are not watched, there are too many of them. A disk is always
+	// checked against the pods right before it gets deleted.
+	for _, informer := range []cache.SharedIndexInformer{vmInformer, vmiInformer, pvcInformer, snapshotContentInformer, exportInformer, backupInformer} {
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return nil, err
+		}
+	}
+
+	// DataVolumes may clone PVCs of other namespaces
+	_, err := dataVolumeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueDataVolume,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueDataVolume(newObj) },
+		DeleteFunc: c.enqueueDataVolume,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// DataSources may reference PVCs of other namespaces, like golden images
+	_, err = dataSourceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueDataSourceSource,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueueDataSourceSource(newObj) },
+		DeleteFunc: c.enqueueDataSourceSource,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueNamespace(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		log.Log.Errorf("failed to get object meta: %v, %v", err, obj)
+		return
+	}
+	c.namespaceQueue.Add(o.GetNamespace())
+}
+
+func (c *Controller) enqueueDataVolume(obj interface{}) {
+	c.enqueueNamespace(obj)
+
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dv, ok := obj.(*cdiv1.DataVolume)
+	if !ok || dv.Spec.Source == nil || dv.Spec.Source.PVC == nil {
+		return
+	}
+	c.namespaceQueue.Add(dataVolumeSourcePVCNamespace(dv))
+}
+
+func (c *Controller) enqueueDataSourceSource(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dataSource, ok := obj.(*cdiv1.DataSource)
+	if !ok || dataSource.Spec.Source.PVC == nil {
+		return
+	}
+	c.namespaceQueue.Add(dataSourcePVCNamespace(dataSource))
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.namespaceQueue.ShutDown()
+
+	log.Log.Info("Starting disk garbage collection controller.")
+	defer log.Log.Info("Shutting down disk garbage collection controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+	go wait.Until(c.resync, resyncPeriod, stopCh)
+
+	<-stopCh
+
+	return nil
+}
+
+// resync enqueues all the namespaces which contain disks
+func (c *Controller) resync() {
+	for _, store := range []cache.Store{c.dataVolumeStore, c.pvcStore} {
+		for _, obj := range store.List() {
+			c.enqueueNamespace(obj)
+		}
+	}
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	namespace, quit := c.namespaceQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.namespaceQueue.Done(namespace)
+
+	requeueAfter, err := c.sync(namespace)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing namespace %s for disk garbage collection", namespace)
+		c.namespaceQueue.AddRateLimited(namespace)
+		return true
+	}
+
+	log.Log.V(4).Infof("processed namespace %s for disk garbage collection", namespace)
+	c.namespaceQueue.Forget(namespace)
+	if requeueAfter > 0 {
+		c.namespaceQueue.AddAfter(namespace, requeueAfter)
+	}
+	return true
+}
+
+// sync marks the unreferenced disks of the namespace, reports the ones whose
+// grace period elapsed and deletes the ones which opted in if the cleanup is enabled. It returns
+// when the namespace has to be synced again for the next grace period to elapse.
+func (c *Controller) sync(namespace string) (time.Duration, error) {
+	if !c.clusterConfig.DiskGarbageCollectionEnabled() {
+		metrics.SetOrphanedDisks(namespace, 0, 0)
+		return 0, nil
+	}
+	gracePeriod, cleanup := c.clusterConfig.GetDiskGarbageCollection()
+
+	references := c.references(namespace)
+	now := c.now()
+
+	var (
+		requeueAfter     time.Duration
+		orphans          int
+		reclaimableBytes int64
+		errs             []error
+	)
+	for _, d := range c.candidates(namespace) {
+		orphanedSince, marked := orphanedSince(d.obj)
+		if references.Has(d.obj.GetName()) || isRetained(d.obj) {
+			if marked {
+				errs = append(errs, c.unmark(d))
+			}
+			continue
+		}
+		if !marked {
+			errs = append(errs, c.mark(d, now))
+			requeueAfter = minRequeue(requeueAfter, gracePeriod)
+			continue
+		}
+		if remaining := orphanedSince.Add(gracePeriod).Sub(now); remaining > 0 {
+			requeueAfter = minRequeue(requeueAfter, remaining)
+			continue
+		}
+
+		if cleanup && isCollectable(d.obj) {
+			errs = append(errs, c.delete(d))
+			continue
+		}
+		orphans++
+		reclaimableBytes += d.size
+	}
+	metrics.SetOrphanedDisks(namespace, orphans, reclaimableBytes)
+
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return 0, err
+	}
+	return requeueAfter, nil
+}
+
+// references returns the names of the DataVolumes and the PVCs of the namespace
+// which are in use. The PVC of a DataVolume has the name of the DataVolume.
+func (c *Controller) references(namespace string) sets.Set[string] {
+	references := sets.New[string]()
+
+	for _, obj := range c.vmStore.List() {
+		vm := obj.(*v1.VirtualMachine)
+		if vm.Namespace != namespace {
+			continue
+		}
+		for _, dvTemplate := range vm.Spec.DataVolumeTemplates {
+			references.Insert(dvTemplate.Name)
+		}
+		if vm.Spec.Template != nil {
+			references.Insert(volumeClaimNames(vm.Spec.Template.Spec.Volumes)...)
+			insertMemoryStateClaim(references, vm.Spec.Template.ObjectMeta.Annotations)
+		}
+		if vm.Status.MemoryDumpRequest != nil {
+			references.Insert(vm.Status.MemoryDumpRequest.ClaimName)
+		}
+		if vm.Status.VolumeRequests != nil {
+			for _, request := range vm.Status.VolumeRequests {
+				if request.AddVolumeOptions != nil && request.AddVolumeOptions.VolumeSource != nil {
+					source := request.AddVolumeOptions.VolumeSource
+					if source.PersistentVolumeClaim != nil {
+						references.Insert(source.PersistentVolumeClaim.ClaimName)
+					}
+					if source.DataVolume != nil {
+						references.Insert(source.DataVolume.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for _, obj := range c.vmiStore.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.Namespace == namespace {
+			references.Insert(volumeClaimNames(vmi.Spec.Volumes)...)
+			insertMemoryStateClaim(references, vmi.Annotations)
+		}
+	}
+
+	for _, obj := range c.podStore.List() {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Namespace != namespace {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				references.Insert(volume.PersistentVolumeClaim.ClaimName)
+			}
+		}
+	}
+
+	// DataVolumes may clone PVCs of other namespaces
+	for _, obj := range c.dataVolumeStore.List() {
+		dv := obj.(*cdiv1.DataVolume)
+		if dv.Spec.Source != nil && dv.Spec.Source.PVC != nil && dataVolumeSourcePVCNamespace(dv) == namespace {
+			references.Insert(dv.Spec.Source.PVC.Name)
+		}
+	}
+
+	for _, obj := range c.snapshotContentStore.List() {
+		content := obj.(*snapshotv1.VirtualMachineSnapshotContent)
+		if content.Namespace != namespace {
+			continue
+		}
+		for _, volumeBackup := range content.Spec.VolumeBackups {
+			references.Insert(volumeBackup.PersistentVolumeClaim.Name)
+		}
+	}
+
+	for _, obj := range c.dataSourceStore.List() {
+		dataSource := obj.(*cdiv1.DataSource)
+		if dataSource.Spec.Source.PVC != nil && dataSourcePVCNamespace(dataSource) == namespace {
+			references.Insert(dataSource.Spec.Source.PVC.Name)
+		}
+	}
+
+	for _, obj := range c.exportStore.List() {
+		export := obj.(*exportv1.VirtualMachineExport)
+		if export.Namespace == namespace && export.Spec.Source.Kind == persistentVolumeClaimKind {
+			references.Insert(export.Spec.Source.Name)
+		}
+	}
+
+	for _, obj := range c.backupStore.List() {
+		backup := obj.(*backupv1.VirtualMachineBackup)
+		if backup.Namespace == namespace && backup.Spec.PvcName != nil {
+			references.Insert(*backup.Spec.PvcName)
+		}
+	}
+
+	return references
+}
+
+// candidates returns the disks of the namespace which no other object manages.
+// DataVolumes have to be populated, PVCs have to be bound and populated by CDI.
+// Only disks holding kubevirt content are candidates, archives are data of other workloads.
+// The PVCs owned by DataVolumes are collected together with their DataVolume.
+func (c *Controller) candidates(namespace string) []disk {
+	var disks []disk
+
+	for _, obj := range c.dataVolumeStore.List() {
+		dv := obj.(*cdiv1.DataVolume)
+		if dv.Namespace != namespace || dv.Status.Phase != cdiv1.Succeeded || metav1.GetControllerOf(dv) != nil {
+			continue
+		}
+		if dv.Spec.ContentType != "" && dv.Spec.ContentType != cdiv1.DataVolumeKubeVirt {
+			continue
+		}
+		var size int64
+		if obj, exists, _ := c.pvcStore.GetByKey(fmt.Sprintf("%s/%s", dv.Namespace, dv.Name)); exists {
+			size = capacityOf(obj.(*k8sv1.PersistentVolumeClaim))
+		}
+		disks = append(disks, disk{kind: dataVolumeKind, obj: dv, size: size})
+	}
+
+	for _, obj := range c.pvcStore.List() {
+		pvc := obj.(*k8sv1.PersistentVolumeClaim)
+		if pvc.Namespace != namespace || pvc.Status.Phase != k8sv1.ClaimBound || len(pvc.OwnerReferences) > 0 {
+			continue
+		}
+		if pvc.Annotations[annContentType] != string(cdiv1.DataVolumeKubeVirt) {
+			continue
+		}
+		disks = append(disks, disk{kind: persistentVolumeClaimKind, obj: pvc, size: capacityOf(pvc)})
+	}
+
+	return disks
+}
+
+func (c *Controller) mark(d disk, now time.Time) error {
+	since := now.UTC().Format(time.RFC3339)
+	var patchSet *patch.PatchSet
+	if d.obj.GetAnnotations() == nil {
+		patchSet = patch.New(patch.WithAdd("/metadata/annotations", map[string]string{v1.OrphanedDiskSinceAnnotation: since}))
+	} else {
+		patchSet = patch.New(patch.WithAdd(annotationPath(), since))
+	}
+	log.Log.V(3).Infof("%s %s/%s is no longer referenced", d.kind, d.obj.GetNamespace(), d.obj.GetName())
+	return c.patch(d, patchSet)
+}
+
+func (c *Controller) unmark(d disk) error {
+	return c.patch(d, patch.New(patch.WithRemove(annotationPath())))
+}
+
+func (c *Controller) patch(d disk, patchSet *patch.PatchSet) error {
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	namespace, name := d.obj.GetNamespace(), d.obj.GetName()
+	switch d.kind {
+	case dataVolumeKind:
+		_, err = c.client.CdiClient().CdiV1beta1().DataVolumes(namespace).Patch(context.Background(), name, k8stypes.JSONPatchType, payload, metav1.PatchOptions{})
+	default:
+		_, err = c.client.CoreV1().PersistentVolumeClaims(namespace).Patch(context.Background(), name, k8stypes.JSONPatchType, payload, metav1.PatchOptions{})
+	}
+	return err
+}
+
+func (c *Controller) delete(d disk) error {
+	namespace, name := d.obj.GetNamespace(), d.obj.GetName()
+	// The UID precondition protects a disk which was recreated with the same name
+	options := metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: pointer.P(d.obj.GetUID())}}
+
+	var err error
+	switch d.kind {
+	case dataVolumeKind:
+		err = c.client.CdiClient().CdiV1beta1().DataVolumes(namespace).Delete(context.Background(), name, options)
+	default:
+		err = c.client.CoreV1().PersistentVolumeClaims(namespace).Delete(context.Background(), name, options)
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		c.recorder.Eventf(d.obj, k8sv1.EventTypeWarning, OrphanedDiskDeleteFailed, "Failed to delete orphaned %s %s: %v", d.kind, name, err)
+		return err
+	}
+	c.recorder.Eventf(d.obj, k8sv1.EventTypeNormal, OrphanedDiskDeletedReason, "Deleted orphaned %s %s", d.kind, name)
+	return nil
+}
+
+func orphanedSince(obj metav1.Object) (time.Time, bool) {
+	value, exists := obj.GetAnnotations()[v1.OrphanedDiskSinceAnnotation]
+	if !exists {
+		return time.Time{}, false
+	}
+	since, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// An invalid timestamp is overwritten as if the disk was not marked
+		return time.Time{}, false
+	}
+	return since, true
+}
+
+func isRetained(obj metav1.Object) bool {
+	return obj.GetLabels()[v1.RetainDiskLabel] == "true"
+}
+
+func isCollectable(obj metav1.Object) bool {
+	return obj.GetLabels()[v1.CollectDiskLabel] == "true"
+}
+
+func insertMemoryStateClaim(references sets.Set[string], annotations map[string]string) {
+	if claimName := annotations[v1.MemoryStateClaimAnnotation]; claimName != "" {
+		references.Insert(claimName)
+	}
+}
+
+func volumeClaimNames(volumes []v1.Volume) []string {
+	var names []string
+	for _, claimName := range storagetypes.GetPVCsFromVolumes(volumes) {
+		names = append(names, claimName)
+	}
+	return names
+}
+
+func dataSourcePVCNamespace(dataSource *cdiv1.DataSource) string {
+	if dataSource.Spec.Source.PVC.Namespace != "" {
+		return dataSource.Spec.Source.PVC.Namespace
+	}
+	return dataSource.Namespace
+}
+
+func dataVolumeSourcePVCNamespace(dv *cdiv1.DataVolume) string {
+	if dv.Spec.Source.PVC.Namespace != "" {
+		return dv.Spec.Source.PVC.Namespace
+	}
+	return dv.Namespace
+}
+
+func capacityOf(pvc *k8sv1.PersistentVolumeClaim) int64 {
+	if capacity, exists := pvc.Status.Capacity[k8sv1.ResourceStorage]; exists {
+		return capacity.Value()
+	}
+	if request, exists := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]; exists {
+		return request.Value()
+	}
+	return 0
+}
+
+func annotationPath() string {
+	return "/metadata/annotations/" + patch.EscapeJSONPointer(v1.OrphanedDiskSinceAnnotation)
+}
+
+func minRequeue(current, next time.Duration) time.Duration {
+	if current == 0 || next < current {
+		return next
+	}
+	return current
+}
@@ -0,0 +1,413 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package diskgc
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	testNamespace = "default"
+	diskName      = "disk"
+)
+
+var _ = Describe("Disk garbage collection controller", func() {
+	var (
+		ctrl                    *gomock.Controller
+		virtClient              *kubecli.MockKubevirtClient
+		k8sClient               *fake.Clientset
+		cdiClient               *cdifake.Clientset
+		vmInformer              cache.SharedIndexInformer
+		vmiInformer             cache.SharedIndexInformer
+		podInformer             cache.SharedIndexInformer
+		dataVolumeInformer      cache.SharedIndexInformer
+		pvcInformer             cache.SharedIndexInformer
+		snapshotContentInformer cache.SharedIndexInformer
+		dataSourceInformer      cache.SharedIndexInformer
+		exportInformer          cache.SharedIndexInformer
+		backupInformer          cache.SharedIndexInformer
+		recorder                *record.FakeRecorder
+		controller              *Controller
+		now                     time.Time
+	)
+
+	newController := func(config *v1.KubeVirtConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(config)
+		controller = &Controller{
+			client:               virtClient,
+			clusterConfig:        clusterConfig,
+			vmStore:              vmInformer.GetStore(),
+			vmiStore:             vmiInformer.GetStore(),
+			podStore:             podInformer.GetStore(),
+			dataVolumeStore:      dataVolumeInformer.GetStore(),
+			pvcStore:             pvcInformer.GetStore(),
+			snapshotContentStore: snapshotContentInformer.GetStore(),
+			dataSourceStore:      dataSourceInformer.GetStore(),
+			exportStore:          exportInformer.GetStore(),
+			backupStore:          backupInformer.GetStore(),
+			recorder:             recorder,
+			namespaceQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-diskgc-queue"},
+			),
+			now: func() time.Time { return now },
+		}
+	}
+
+	enabledConfig := func(cleanup bool) *v1.KubeVirtConfiguration {
+		return &v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: []string{featuregate.DiskGarbageCollectionGate},
+			},
+			DiskGarbageCollection: &v1.DiskGarbageCollectionConfiguration{
+				GracePeriod: &metav1.Duration{Duration: time.Hour},
+				Cleanup:     pointer.P(cleanup),
+			},
+		}
+	}
+
+	newDataVolume := func(annotations map[string]string) *cdiv1.DataVolume {
+		return &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        diskName,
+				Namespace:   testNamespace,
+				UID:         "dv-uid",
+				Annotations: annotations,
+			},
+			Status: cdiv1.DataVolumeStatus{Phase: cdiv1.Succeeded},
+		}
+	}
+
+	newPVC := func(annotations map[string]string) *k8sv1.PersistentVolumeClaim {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[annContentType] = string(cdiv1.DataVolumeKubeVirt)
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        diskName,
+				Namespace:   testNamespace,
+				UID:         "pvc-uid",
+				Annotations: annotations,
+			},
+			Status: k8sv1.PersistentVolumeClaimStatus{
+				Phase:    k8sv1.ClaimBound,
+				Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		}
+	}
+
+	orphanedSinceAnnotation := func(since time.Time) map[string]string {
+		return map[string]string{v1.OrphanedDiskSinceAnnotation: since.UTC().Format(time.RFC3339)}
+	}
+
+	addDataVolume := func(dv *cdiv1.DataVolume) {
+		Expect(dataVolumeInformer.GetStore().Add(dv)).To(Succeed())
+		_, err := cdiClient.CdiV1beta1().DataVolumes(dv.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addPVC := func(pvc *k8sv1.PersistentVolumeClaim) {
+		Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getDataVolume := func() *cdiv1.DataVolume {
+		dv, err := cdiClient.CdiV1beta1().DataVolumes(testNamespace).Get(context.Background(), diskName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return dv
+	}
+
+	getPVC := func() *k8sv1.PersistentVolumeClaim {
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), diskName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pvc
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = fake.NewSimpleClientset()
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		dataVolumeInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		snapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		dataSourceInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataSource{})
+		exportInformer, _ = testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		backupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		now = time.Now().Truncate(time.Second)
+	})
+
+	It("should not mark disks when the feature gate is disabled", func() {
+		newController(&v1.KubeVirtConfiguration{})
+		addDataVolume(newDataVolume(nil))
+
+		requeueAfter, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(BeZero())
+		Expect(getDataVolume().Annotations).ToNot(HaveKey(v1.OrphanedDiskSinceAnnotation))
+	})
+
+	It("should mark an unreferenced DataVolume and requeue after the grace period", func() {
+		newController(enabledConfig(false))
+		addDataVolume(newDataVolume(nil))
+
+		requeueAfter, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(Equal(time.Hour))
+		Expect(getDataVolume().Annotations).To(HaveKeyWithValue(v1.OrphanedDiskSinceAnnotation, now.UTC().Format(time.RFC3339)))
+	})
+
+	It("should requeue a marked disk for the remaining grace period", func() {
+		newController(enabledConfig(false))
+		addPVC(newPVC(orphanedSinceAnnotation(now.Add(-20 * time.Minute))))
+
+		requeueAfter, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(Equal(40 * time.Minute))
+	})
+
+	DescribeTable("should unmark a disk which is referenced again", func(addReference func()) {
+		newController(enabledConfig(true))
+		addDataVolume(newDataVolume(orphanedSinceAnnotation(now.Add(-2 * time.Hour))))
+		addReference()
+
+		_, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getDataVolume().Annotations).ToNot(HaveKey(v1.OrphanedDiskSinceAnnotation))
+	},
+		Entry("by a VM", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(testNamespace), libvmi.WithDataVolume("disk0", diskName)))
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}),
+		Entry("by a VMI", func() {
+			vmi := libvmi.New(libvmi.WithNamespace(testNamespace), libvmi.WithPersistentVolumeClaim("disk0", diskName))
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		}),
+		Entry("by a snapshot", func() {
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{Name: "content", Namespace: testNamespace},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					VolumeBackups: []snapshotv1.VolumeBackup{{
+						VolumeName:            "disk0",
+						PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: diskName}},
+					}},
+				},
+			}
+			Expect(snapshotContentInformer.GetStore().Add(content)).To(Succeed())
+		}),
+		Entry("by a DataSource of another namespace", func() {
+			dataSource := &cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{Name: "golden-image", Namespace: "os-images"},
+				Spec: cdiv1.DataSourceSpec{
+					Source: cdiv1.DataSourceSource{
+						PVC: &cdiv1.DataVolumeSourcePVC{Namespace: testNamespace, Name: diskName},
+					},
+				},
+			}
+			Expect(dataSourceInformer.GetStore().Add(dataSource)).To(Succeed())
+		}),
+		Entry("by a pod", func() {
+			pod := &k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: testNamespace},
+				Spec: k8sv1.PodSpec{
+					Volumes: []k8sv1.Volume{{
+						Name: "disk0",
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: diskName},
+						},
+					}},
+				},
+			}
+			Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+		}),
+		Entry("by a DataVolume of another namespace cloning it", func() {
+			clone := &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: "other"},
+				Spec: cdiv1.DataVolumeSpec{
+					Source: &cdiv1.DataVolumeSource{
+						PVC: &cdiv1.DataVolumeSourcePVC{Namespace: testNamespace, Name: diskName},
+					},
+				},
+			}
+			Expect(dataVolumeInformer.GetStore().Add(clone)).To(Succeed())
+		}),
+		Entry("by an export", func() {
+			export := &exportv1.VirtualMachineExport{
+				ObjectMeta: metav1.ObjectMeta{Name: "export", Namespace: testNamespace},
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: k8sv1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: diskName},
+				},
+			}
+			Expect(exportInformer.GetStore().Add(export)).To(Succeed())
+		}),
+		Entry("by a backup", func() {
+			backup := &backupv1.VirtualMachineBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: testNamespace},
+				Spec:       backupv1.VirtualMachineBackupSpec{PvcName: pointer.P(diskName)},
+			}
+			Expect(backupInformer.GetStore().Add(backup)).To(Succeed())
+		}),
+		Entry("by the memory dump of a VM", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(testNamespace)))
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{ClaimName: diskName}
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}),
+		Entry("by the memory state of a VM", func() {
+			vm := libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithNamespace(testNamespace),
+				libvmi.WithAnnotation(v1.MemoryStateClaimAnnotation, diskName),
+			))
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}),
+	)
+
+	It("should not mark a disk with the retain label", func() {
+		newController(enabledConfig(true))
+		pvc := newPVC(nil)
+		pvc.Labels = map[string]string{v1.RetainDiskLabel: "true"}
+		addPVC(pvc)
+
+		_, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getPVC().Annotations).ToNot(HaveKey(v1.OrphanedDiskSinceAnnotation))
+	})
+
+	DescribeTable("should ignore", func(addDisk func()) {
+		newController(enabledConfig(true))
+		addDisk()
+
+		requeueAfter, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeueAfter).To(BeZero())
+		for _, action := range append(k8sClient.Actions(), cdiClient.Actions()...) {
+			Expect(action.GetVerb()).To(Equal("create"))
+		}
+	},
+		Entry("a PVC which was not populated by CDI", func() {
+			pvc := newPVC(nil)
+			delete(pvc.Annotations, annContentType)
+			addPVC(pvc)
+		}),
+		Entry("a PVC holding an archive", func() {
+			pvc := newPVC(nil)
+			pvc.Annotations[annContentType] = string(cdiv1.DataVolumeArchive)
+			addPVC(pvc)
+		}),
+		Entry("a DataVolume holding an archive", func() {
+			dv := newDataVolume(nil)
+			dv.Spec.ContentType = cdiv1.DataVolumeArchive
+			addDataVolume(dv)
+		}),
+		Entry("a PVC owned by a DataVolume", func() {
+			pvc := newPVC(nil)
+			pvc.OwnerReferences = []metav1.OwnerReference{{Kind: "DataVolume", Name: diskName}}
+			addPVC(pvc)
+		}),
+		Entry("a DataVolume owned by a VM", func() {
+			dv := newDataVolume(nil)
+			dv.OwnerReferences = []metav1.OwnerReference{{Kind: "VirtualMachine", Name: "vm", Controller: pointer.P(true)}}
+			addDataVolume(dv)
+		}),
+		Entry("a DataVolume which is not populated", func() {
+			dv := newDataVolume(nil)
+			dv.Status.Phase = cdiv1.ImportInProgress
+			addDataVolume(dv)
+		}),
+	)
+
+	It("should report the orphaned disks once the grace period elapsed", func() {
+		newController(enabledConfig(false))
+		addPVC(newPVC(orphanedSinceAnnotation(now.Add(-2 * time.Hour))))
+
+		_, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getPVC().Annotations).To(HaveKey(v1.OrphanedDiskSinceAnnotation))
+
+		count, reclaimableBytes, err := metrics.GetOrphanedDisks(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(1.0))
+		Expect(reclaimableBytes).To(Equal(float64(1024 * 1024 * 1024)))
+	})
+
+	It("should only report the orphaned disks which did not opt in to the cleanup", func() {
+		newController(enabledConfig(true))
+		addDataVolume(newDataVolume(orphanedSinceAnnotation(now.Add(-2 * time.Hour))))
+
+		_, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getDataVolume().Annotations).To(HaveKey(v1.OrphanedDiskSinceAnnotation))
+
+		count, _, err := metrics.GetOrphanedDisks(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(Equal(1.0))
+	})
+
+	It("should delete the orphaned disks which opted in once the grace period elapsed when cleanup is enabled", func() {
+		newController(enabledConfig(true))
+		dv := newDataVolume(orphanedSinceAnnotation(now.Add(-2 * time.Hour)))
+		dv.Labels = map[string]string{v1.CollectDiskLabel: "true"}
+		addDataVolume(dv)
+
+		_, err := controller.sync(testNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = cdiClient.CdiV1beta1().DataVolumes(testNamespace).Get(context.Background(), diskName, metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		testutils.ExpectEvent(recorder, OrphanedDiskDeletedReason)
+	})
+})
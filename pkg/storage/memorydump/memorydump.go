@@ -21,9 +21,12 @@ package memorydump
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	k8score "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
@@ -31,14 +34,20 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	kutil "kubevirt.io/kubevirt/pkg/util"
 )
 
 const (
 	ErrorReason = "MemoryDumpError"
 	failed      = "Memory dump failed"
+
+	pvcVolumeModeErr = "pvc should be filesystem pvc"
+	pvcAccessModeErr = "pvc access mode can't be read only"
+	pvcSizeErrFmt    = "pvc size [%s] should be bigger then [%s]"
 )
 
 func HasCompleted(vm *v1.VirtualMachine) bool {
@@ -75,7 +84,7 @@ func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1
 		// When in state associating we want to add the memory dump pvc
 		// as a volume in the vm and in the vmi to trigger the mount
 		// to virt launcher and the memory dump
-		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest.ClaimName)
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, vm.Status.MemoryDumpRequest)
 		if _, exists := vmiVolumeMap[vm.Status.MemoryDumpRequest.ClaimName]; exists {
 			return nil
 		}
@@ -196,6 +205,125 @@ func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
 	vm.Status.MemoryDumpRequest = updatedMemoryDumpReq
 }
 
+// RequestOnWatchdogExpiry issues a memory dump request to the claim configured
+// by the MemoryDump watchdog remediation once virt-handler reported a guest
+// watchdog expiry which is newer than the last finished memory dump. The claim
+// is validated like the claims of the memory dump subresource.
+func RequestOnWatchdogExpiry(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pvcStore cache.Store) error {
+	if vmi == nil || !vmi.IsRunning() {
+		return nil
+	}
+	watchdog := vmi.Spec.Domain.Devices.Watchdog
+	if watchdog == nil || watchdog.Remediation == nil ||
+		watchdog.Remediation.Type != v1.WatchdogRemediationMemoryDump ||
+		watchdog.Remediation.MemoryDumpClaimName == "" {
+		return nil
+	}
+	// the guest memory is only worth dumping if the watchdog left the guest as it expired
+	if !PreservesGuestMemory(watchdog) {
+		return nil
+	}
+
+	var expired *v1.VirtualMachineInstanceCondition
+	for i := range vmi.Status.Conditions {
+		if vmi.Status.Conditions[i].Type == v1.VirtualMachineInstanceGuestWatchdogExpired {
+			expired = &vmi.Status.Conditions[i]
+			break
+		}
+	}
+	if expired == nil || expired.Status != k8score.ConditionTrue {
+		return nil
+	}
+
+	if request := vm.Status.MemoryDumpRequest; request != nil {
+		if request.Phase != v1.MemoryDumpCompleted && request.Phase != v1.MemoryDumpFailed {
+			return nil
+		}
+		if request.EndTimestamp == nil || !request.EndTimestamp.Before(&expired.LastProbeTime) {
+			return nil
+		}
+	}
+
+	claimName := watchdog.Remediation.MemoryDumpClaimName
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, claimName, pvcStore)
+	if err != nil {
+		return err
+	}
+	if pvc == nil {
+		return fmt.Errorf("pvc %s not found", claimName)
+	}
+	if err := ValidateClaimMode(pvc); err != nil {
+		return fmt.Errorf("pvc %s can not hold the memory dump: %v", claimName, err)
+	}
+	cdiConfig, err := client.CdiClient().CdiV1beta1().CDIConfigs().Get(context.Background(), storagetypes.ConfigName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		cdiConfig = nil
+	} else if err != nil {
+		return fmt.Errorf("unable to retrieve cdi config: %v", err)
+	}
+	if err := ValidateClaimSize(vmi, pvc, cdiConfig); err != nil {
+		return fmt.Errorf("pvc %s can not hold the memory dump: %v", claimName, err)
+	}
+
+	log.Log.Object(vmi).Infof("Requesting a memory dump to %s after the guest watchdog expired", claimName)
+	vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Phase:     v1.MemoryDumpAssociating,
+	}
+	return nil
+}
+
+// PreservesGuestMemory returns whether the action of the watchdog leaves the
+// guest memory as it was when the watchdog expired.
+func PreservesGuestMemory(watchdog *v1.Watchdog) bool {
+	var action v1.WatchdogAction
+	switch {
+	case watchdog.I6300ESB != nil:
+		action = watchdog.I6300ESB.Action
+	case watchdog.Diag288 != nil:
+		action = watchdog.Diag288.Action
+	}
+	return action == v1.WatchdogActionPause || action == v1.WatchdogActionNone
+}
+
+// ValidateClaimMode checks that the memory dump can be written to the claim.
+func ValidateClaimMode(pvc *k8score.PersistentVolumeClaim) error {
+	if storagetypes.IsPVCBlock(pvc.Spec.VolumeMode) {
+		return errors.New(pvcVolumeModeErr)
+	}
+
+	if storagetypes.IsReadOnlyAccessMode(pvc.Spec.AccessModes) {
+		return errors.New(pvcAccessModeErr)
+	}
+
+	return nil
+}
+
+// ValidateClaimSize checks that the claim can hold the memory dump of the VMI.
+// The CDI config is optional and defines the filesystem overhead of the claim.
+func ValidateClaimSize(vmi *v1.VirtualMachineInstance, pvc *k8score.PersistentVolumeClaim, cdiConfig *cdiv1.CDIConfig) error {
+	pvcSize := pvc.Spec.Resources.Requests.Storage()
+	scaledPvcSize := resource.NewScaledQuantity(pvcSize.ScaledValue(resource.Kilo), resource.Kilo)
+
+	expectedMemoryDumpSize := kutil.CalcExpectedMemoryDumpSize(vmi)
+	var expectedPvcSize *resource.Quantity
+	var err error
+	if cdiConfig == nil {
+		log.Log.Object(vmi).V(3).Infof(storagetypes.FSOverheadMsg)
+		expectedPvcSize, err = storagetypes.GetSizeIncludingDefaultFSOverhead(expectedMemoryDumpSize)
+	} else {
+		expectedPvcSize, err = storagetypes.GetSizeIncludingFSOverhead(expectedMemoryDumpSize, pvc.Spec.StorageClassName, pvc.Spec.VolumeMode, cdiConfig)
+	}
+	if err != nil {
+		return err
+	}
+	if scaledPvcSize.Cmp(*expectedPvcSize) < 0 {
+		return fmt.Errorf(pvcSizeErrFmt, scaledPvcSize.String(), expectedPvcSize.String())
+	}
+
+	return nil
+}
+
 func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineMemoryDumpRequest, addVolume bool) error {
 	foundRemoveVol := false
 	for _, volume := range vmi.Spec.Volumes {
@@ -214,7 +342,7 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 
 	vmiCopy := vmi.DeepCopy()
 	if addVolume {
-		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request.ClaimName)
+		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request)
 	} else {
 		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
 	}
@@ -235,7 +363,8 @@ func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.Vir
 	return err
 }
 
-func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
+	claimName := request.ClaimName
 	for _, volume := range vmiSpec.Volumes {
 		if volume.Name == claimName {
 			return vmiSpec
@@ -249,6 +378,7 @@ func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpe
 			},
 			Hotpluggable: true,
 		},
+		Format: request.Format,
 	}
 
 	newVolume := v1.Volume{
@@ -20,18 +20,21 @@ package memorydump
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
 	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/kubevirt/fake"
 
@@ -165,6 +168,24 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	It("should add the memory dump volume in the requested format", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Spec.Template.Spec.Volumes = nil
+		vm.Status.MemoryDumpRequest.Format = v1.MemoryDumpFormatState
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatState))
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(HaveLen(1))
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatState))
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
@@ -203,6 +224,119 @@ var _ = Describe("MemoryDump", func() {
 		Entry("when phase is Unmounting", v1.MemoryDumpUnmounting, targetFileName),
 		Entry("when phase is Failed", v1.MemoryDumpFailed, "Memory dump failed"),
 	)
+
+	Context("RequestOnWatchdogExpiry", func() {
+		newWatchdogVM := func(expiry metav1.Time) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+			vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpCompleted)
+			vm.Status.MemoryDumpRequest = nil
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+				Name: "watchdog",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionPause},
+				},
+				Remediation: &v1.WatchdogRemediation{
+					Type:                v1.WatchdogRemediationMemoryDump,
+					MemoryDumpClaimName: testPVCName,
+				},
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:          v1.VirtualMachineInstanceGuestWatchdogExpired,
+				Status:        k8score.ConditionTrue,
+				LastProbeTime: expiry,
+			}}
+			return vm, vmi
+		}
+
+		addClaim := func(size string) {
+			Expect(pvcStore.Add(&k8score.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: testPVCName, Namespace: metav1.NamespaceDefault},
+				Spec: k8score.PersistentVolumeClaimSpec{
+					Resources: k8score.VolumeResourceRequirements{
+						Requests: k8score.ResourceList{k8score.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+			})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			virtClient.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset()).AnyTimes()
+			addClaim("2Gi")
+		})
+
+		It("should request a memory dump when the guest watchdog expired", func() {
+			vm, vmi := newWatchdogVM(now)
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(Succeed())
+			Expect(vm.Status.MemoryDumpRequest).To(Equal(&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Phase:     v1.MemoryDumpAssociating,
+			}))
+		})
+
+		It("should not request a memory dump when the watchdog action does not keep the guest memory", func() {
+			vm, vmi := newWatchdogVM(now)
+			vmi.Spec.Domain.Devices.Watchdog.I6300ESB.Action = v1.WatchdogActionReset
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(Succeed())
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not request a memory dump to a claim which can not hold it", func() {
+			vm, vmi := newWatchdogVM(now)
+			addClaim("1Mi")
+
+			err := RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)
+			Expect(err).To(MatchError(ContainSubstring("pvc testPVC can not hold the memory dump")))
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not request a memory dump to a missing claim", func() {
+			vm, vmi := newWatchdogVM(now)
+			vmi.Spec.Domain.Devices.Watchdog.Remediation.MemoryDumpClaimName = "missing"
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(MatchError("pvc missing not found"))
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not request a memory dump without the MemoryDump remediation", func() {
+			vm, vmi := newWatchdogVM(now)
+			vmi.Spec.Domain.Devices.Watchdog.Remediation.Type = v1.WatchdogRemediationReset
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(Succeed())
+			Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+		})
+
+		It("should not interrupt a memory dump in progress", func() {
+			vm, vmi := newWatchdogVM(now)
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: "other",
+				Phase:     v1.MemoryDumpInProgress,
+			}
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(Succeed())
+			Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpInProgress))
+			Expect(vm.Status.MemoryDumpRequest.ClaimName).To(Equal("other"))
+		})
+
+		DescribeTable("with a finished memory dump", func(endTimestamp metav1.Time, expectRequest bool) {
+			vm, vmi := newWatchdogVM(now)
+			vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+				ClaimName:    testPVCName,
+				Phase:        v1.MemoryDumpCompleted,
+				EndTimestamp: pointer.P(endTimestamp),
+			}
+
+			Expect(RequestOnWatchdogExpiry(virtClient, vm, vmi, pvcStore)).To(Succeed())
+			if expectRequest {
+				Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpAssociating))
+			} else {
+				Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpCompleted))
+			}
+		},
+			Entry("should request a new dump for a newer expiry", metav1.NewTime(now.Add(-time.Minute)), true),
+			Entry("should not request a new dump for an already dumped expiry", metav1.NewTime(now.Add(time.Minute)), false),
+		)
+	})
 })
 
 func ApplyVMIMemoryDumpVol(spec *v1.VirtualMachineInstanceSpec) {
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package scan passes the volumes of a VMI to the scanner configured in the
+// KubeVirt CR before the VMI boots for the first time. The scanner implements
+// the VolumeScanner service of the v1alpha1 package.
+package scan
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/utils/lru"
+
+	"kubevirt.io/kubevirt/pkg/storage/scan/v1alpha1"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// verdictCacheSize bounds the number of remembered verdicts, the least
+// recently used ones are scanned again.
+const verdictCacheSize = 4096
+
+// Verdict is the decision of the scanner about a volume.
+type Verdict struct {
+	Allowed bool
+	Message string
+}
+
+// Scanner runs the scans in the background, so that slow scanners don't block
+// the caller, and caches their verdicts by a key identifying the content of
+// the volume, like the digest of a containerdisk image.
+type Scanner struct {
+	clusterConfig *virtconfig.ClusterConfig
+	newClient     func(endpoint, caBundle string) (v1alpha1.VolumeScannerClient, func() error, error)
+
+	lock        sync.Mutex
+	endpoint    string
+	caBundle    string
+	client      v1alpha1.VolumeScannerClient
+	closeClient func() error
+	verdicts    *lru.Cache
+	// pending holds the callbacks of the running scans
+	pending  map[string][]func()
+	failures map[string]error
+}
+
+func NewScanner(clusterConfig *virtconfig.ClusterConfig) *Scanner {
+	return &Scanner{
+		clusterConfig: clusterConfig,
+		newClient:     dialScanner,
+		verdicts:      lru.New(verdictCacheSize),
+		pending:       map[string][]func(){},
+		failures:      map[string]error{},
+	}
+}
+
+// dialScanner connects to the scanner with TLS. The certificate of the scanner
+// is verified with the CA bundle, or with the CAs of the system without one.
+func dialScanner(endpoint, caBundle string) (v1alpha1.VolumeScannerClient, func() error, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caBundle != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, nil, fmt.Errorf("the CA bundle holds no valid PEM encoded certificate")
+		}
+	}
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, nil, err
+	}
+	return v1alpha1.NewVolumeScannerClient(conn), conn.Close, nil
+}
+
+// Verdict returns the cached verdict for the key. Without one, a scan of the
+// volume is started in the background and nil is returned, onDone is called
+// once the scan finished. The error of a failed scan is returned once, the
+// next call starts a new scan.
+func (s *Scanner) Verdict(key string, request *v1alpha1.ScanRequest, onDone func()) (*Verdict, error) {
+	endpoint, caBundle, timeout := s.clusterConfig.GetVolumeScan()
+	if endpoint == "" {
+		return nil, fmt.Errorf("no volume scanner is configured")
+	}
+	// Verdicts of another scanner don't count
+	cacheKey := endpoint + "/" + key
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if verdict, exists := s.verdicts.Get(cacheKey); exists {
+		return verdict.(*Verdict), nil
+	}
+	if err, failed := s.failures[cacheKey]; failed {
+		delete(s.failures, cacheKey)
+		return nil, err
+	}
+	if callbacks, running := s.pending[cacheKey]; running {
+		s.pending[cacheKey] = append(callbacks, onDone)
+		return nil, nil
+	}
+
+	client, err := s.clientFor(endpoint, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the volume scanner %s: %v", endpoint, err)
+	}
+	s.pending[cacheKey] = []func(){onDone}
+	go s.scan(client, cacheKey, timeout, request)
+	return nil, nil
+}
+
+func (s *Scanner) scan(client v1alpha1.VolumeScannerClient, cacheKey string, timeout time.Duration, request *v1alpha1.ScanRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result, err := client.Scan(ctx, request)
+
+	s.lock.Lock()
+	if err != nil {
+		s.failures[cacheKey] = fmt.Errorf("failed to scan volume %s: %v", request.Volume, err)
+	} else {
+		s.verdicts.Add(cacheKey, &Verdict{Allowed: result.Allowed, Message: result.Message})
+	}
+	callbacks := s.pending[cacheKey]
+	delete(s.pending, cacheKey)
+	s.lock.Unlock()
+
+	for _, onDone := range callbacks {
+		onDone()
+	}
+}
+
+// clientFor returns the client of the endpoint, the connection to a previous
+// endpoint or with a previous CA bundle is closed. Must be called with the lock held.
+func (s *Scanner) clientFor(endpoint, caBundle string) (v1alpha1.VolumeScannerClient, error) {
+	if s.client != nil && s.endpoint == endpoint && s.caBundle == caBundle {
+		return s.client, nil
+	}
+	client, closeClient, err := s.newClient(endpoint, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	if s.closeClient != nil {
+		// Running scans fail and are retried
+		_ = s.closeClient()
+	}
+	s.endpoint, s.caBundle, s.client, s.closeClient = endpoint, caBundle, client, closeClient
+	return client, nil
+}
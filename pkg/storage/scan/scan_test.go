@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package scan_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/storage/scan"
+	"kubevirt.io/kubevirt/pkg/storage/scan/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const testDigest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+type fakeVolumeScanner struct {
+	lock     sync.Mutex
+	requests []*v1alpha1.ScanRequest
+	result   *v1alpha1.ScanResult
+	err      error
+	delay    time.Duration
+}
+
+func (f *fakeVolumeScanner) Scan(ctx context.Context, request *v1alpha1.ScanRequest) (*v1alpha1.ScanResult, error) {
+	f.lock.Lock()
+	f.requests = append(f.requests, request)
+	result, err, delay := f.result, f.err, f.delay
+	f.lock.Unlock()
+
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return result, err
+}
+
+func (f *fakeVolumeScanner) scans() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return len(f.requests)
+}
+
+var _ = Describe("Volume scanner", func() {
+	var (
+		server   *grpc.Server
+		fake     *fakeVolumeScanner
+		endpoint string
+		caBundle string
+		scanner  *scan.Scanner
+		done     chan struct{}
+		request  *v1alpha1.ScanRequest
+	)
+
+	newScannerWithCABundle := func(timeout time.Duration, caBundle string) *scan.Scanner {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			VolumeScan: &v1.VolumeScanConfiguration{
+				Endpoint: endpoint,
+				CABundle: caBundle,
+				Timeout:  &metav1.Duration{Duration: timeout},
+			},
+		})
+		return scan.NewScanner(config)
+	}
+
+	newScanner := func(timeout time.Duration) *scan.Scanner {
+		return newScannerWithCABundle(timeout, caBundle)
+	}
+
+	newCA := func() *triple.KeyPair {
+		ca, err := triple.NewCA("volume-scanner", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		return ca
+	}
+
+	onDone := func() {
+		done <- struct{}{}
+	}
+
+	BeforeEach(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		endpoint = listener.Addr().String()
+
+		ca := newCA()
+		caBundle = string(cert.EncodeCertPEM(ca.Cert))
+		keyPair, err := triple.NewServerKeyPair(ca, "volume-scanner", "volume-scanner", "default", "cluster.local", []string{"127.0.0.1"}, nil, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		serverCert := tls.Certificate{Certificate: [][]byte{keyPair.Cert.Raw}, PrivateKey: keyPair.Key}
+
+		fake = &fakeVolumeScanner{result: &v1alpha1.ScanResult{Allowed: true}}
+		server = grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{serverCert}})))
+		v1alpha1.RegisterVolumeScannerServer(server, fake)
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Serve(listener)).To(Succeed())
+		}()
+
+		scanner = newScanner(time.Minute)
+		done = make(chan struct{}, 10)
+		request = &v1alpha1.ScanRequest{Namespace: "default", VMI: "testvmi", Volume: "disk0", Image: "quay.io/containerdisks/fedora@" + testDigest}
+	})
+
+	AfterEach(func() {
+		server.Stop()
+	})
+
+	It("should scan the volume in the background and cache the verdict", func() {
+		verdict, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verdict).To(BeNil())
+		Eventually(done).Should(Receive())
+
+		verdict, err = scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verdict).To(Equal(&scan.Verdict{Allowed: true}))
+		Expect(fake.requests).To(HaveLen(1))
+		Expect(fake.requests[0].Image).To(Equal(request.Image))
+	})
+
+	It("should pass on the rejection of the scanner", func() {
+		fake.result = &v1alpha1.ScanResult{Allowed: false, Message: "malware found"}
+		_, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(done).Should(Receive())
+
+		verdict, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verdict).To(Equal(&scan.Verdict{Allowed: false, Message: "malware found"}))
+	})
+
+	It("should scan a volume only once while the scan is running", func() {
+		fake.delay = 200 * time.Millisecond
+		for i := 0; i < 2; i++ {
+			verdict, err := scanner.Verdict(testDigest, request, onDone)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verdict).To(BeNil())
+		}
+		Eventually(done).Should(Receive())
+		Eventually(done).Should(Receive())
+		Expect(fake.scans()).To(Equal(1))
+	})
+
+	It("should return a failed scan once and scan again", func() {
+		fake.err = fmt.Errorf("scanner unavailable")
+		_, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(done).Should(Receive())
+
+		_, err = scanner.Verdict(testDigest, request, onDone)
+		Expect(err).To(MatchError(ContainSubstring("scanner unavailable")))
+
+		fake.lock.Lock()
+		fake.err = nil
+		fake.lock.Unlock()
+		verdict, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verdict).To(BeNil())
+		Eventually(done).Should(Receive())
+		Expect(fake.scans()).To(Equal(2))
+	})
+
+	It("should fail a scan which exceeds the timeout", func() {
+		fake.delay = time.Minute
+		scanner = newScanner(100 * time.Millisecond)
+		_, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(done).Should(Receive())
+
+		_, err = scanner.Verdict(testDigest, request, onDone)
+		Expect(err).To(MatchError(ContainSubstring("DeadlineExceeded")))
+	})
+
+	It("should fail a scan if the certificate of the scanner is not signed by the CA bundle", func() {
+		scanner = newScannerWithCABundle(time.Minute, string(cert.EncodeCertPEM(newCA().Cert)))
+		_, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(done).Should(Receive())
+
+		_, err = scanner.Verdict(testDigest, request, onDone)
+		Expect(err).To(MatchError(ContainSubstring("certificate signed by unknown authority")))
+		Expect(fake.scans()).To(BeZero())
+	})
+
+	It("should fail to connect with an invalid CA bundle", func() {
+		scanner = newScannerWithCABundle(time.Minute, "invalid")
+		_, err := scanner.Verdict(testDigest, request, onDone)
+		Expect(err).To(MatchError(ContainSubstring("the CA bundle holds no valid PEM encoded certificate")))
+	})
+
+	It("should fail without a configured scanner", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		_, err := scan.NewScanner(config).Verdict(testDigest, request, onDone)
+		Expect(err).To(MatchError(ContainSubstring("no volume scanner is configured")))
+	})
+})
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/uuid"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/openshift/library-go/pkg/build/naming"
 	appsv1 "k8s.io/api/apps/v1"
@@ -885,6 +886,7 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	log.Log.Object(t.vmRestore).V(3).Info("generating restored VM spec")
 	var newTemplates = make([]kubevirtv1.DataVolumeTemplateSpec, len(snapshotVM.Spec.DataVolumeTemplates))
 	var newVolumes []kubevirtv1.Volume
+	var memoryStateClaimName string
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
 		t.DeepCopyInto(&newTemplates[i])
@@ -936,6 +938,13 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 				}
 			}
 		} else if nv.MemoryDump != nil {
+			if nv.MemoryDump.Format == kubevirtv1.MemoryDumpFormatState {
+				for _, vr := range t.vmRestore.Status.Restores {
+					if vr.VolumeName == nv.Name {
+						memoryStateClaimName = vr.PersistentVolumeClaimName
+					}
+				}
+			}
 			// don't restore memory dump volume in the new spec
 			continue
 		}
@@ -979,13 +988,33 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	newVM.Spec.DataVolumeTemplates = newTemplates
 	newVM.Spec.Template.Spec.Volumes = newVolumes
 	setLastRestoreAnnotation(t.vmRestore, newVM)
-	if snapshotVM.Name == newVM.Name {
+	setMemoryStateClaimAnnotation(newVM, memoryStateClaimName)
+	applyStorageClassMappings(t.vmRestore, newVM)
+	applyNetworkMappings(t.vmRestore, newVM)
+	if isFirmwareIdentityPolicyRegenerate(t.vmRestore) {
+		regenerateFirmwareIdentity(newVM)
+	} else if snapshotVM.Name == newVM.Name {
 		setLegacyFirmwareUUID(newVM)
 	}
 
 	return newVM, nil
 }
 
+// setMemoryStateClaimAnnotation makes the restored VM resume from the memory state restored to claimName
+func setMemoryStateClaimAnnotation(vm *kubevirtv1.VirtualMachine, claimName string) {
+	if vm.Spec.Template == nil {
+		return
+	}
+	if claimName == "" {
+		delete(vm.Spec.Template.ObjectMeta.Annotations, kubevirtv1.MemoryStateClaimAnnotation)
+		return
+	}
+	if vm.Spec.Template.ObjectMeta.Annotations == nil {
+		vm.Spec.Template.ObjectMeta.Annotations = make(map[string]string)
+	}
+	vm.Spec.Template.ObjectMeta.Annotations[kubevirtv1.MemoryStateClaimAnnotation] = claimName
+}
+
 func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconcile new VM spec")
 
@@ -1669,6 +1698,8 @@ func CreateRestorePVCDefFromVMRestore(vmRestore *snapshotv1.VirtualMachineRestor
 		return nil, err
 	}
 
+	pvc.Spec.StorageClassName = mapStorageClass(vmRestore, pvc.Spec.StorageClassName)
+
 	return pvc, nil
 }
 
@@ -1677,7 +1708,8 @@ func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Co
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
+// Currently only memory dump volumes should not be restored, unless they hold
+// the memory state the restored VM resumes from
 func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) (sets.String, error) {
 	noRestore := sets.NewString()
 
@@ -1687,7 +1719,7 @@ func (ctrl *VMRestoreController) volumesNotForRestore(content *snapshotv1.Virtua
 	}
 
 	for _, volume := range volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil && volume.MemoryDump.Format != kubevirtv1.MemoryDumpFormatState {
 			noRestore.Insert(volume.Name)
 		}
 	}
@@ -1738,6 +1770,55 @@ func applyVolumeRestoreOverride(restorePVC *corev1.PersistentVolumeClaim, volume
 	return nil
 }
 
+// mapStorageClass returns the storage class the StorageClassMappings replace storageClassName with,
+// or storageClassName if it is not mapped
+func mapStorageClass(vmRestore *snapshotv1.VirtualMachineRestore, storageClassName *string) *string {
+	if storageClassName == nil {
+		return nil
+	}
+
+	for _, mapping := range vmRestore.Spec.StorageClassMappings {
+		if mapping.Source == *storageClassName {
+			return pointer.P(mapping.Target)
+		}
+	}
+
+	return storageClassName
+}
+
+// applyStorageClassMappings replaces the storage classes of the DataVolumeTemplates of the restored VM
+func applyStorageClassMappings(vmRestore *snapshotv1.VirtualMachineRestore, vm *kubevirtv1.VirtualMachine) {
+	for i := range vm.Spec.DataVolumeTemplates {
+		dvSpec := &vm.Spec.DataVolumeTemplates[i].Spec
+		if dvSpec.PVC != nil {
+			dvSpec.PVC.StorageClassName = mapStorageClass(vmRestore, dvSpec.PVC.StorageClassName)
+		}
+		if dvSpec.Storage != nil {
+			dvSpec.Storage.StorageClassName = mapStorageClass(vmRestore, dvSpec.Storage.StorageClassName)
+		}
+	}
+}
+
+// applyNetworkMappings replaces the Multus networks of the restored VM
+func applyNetworkMappings(vmRestore *snapshotv1.VirtualMachineRestore, vm *kubevirtv1.VirtualMachine) {
+	if vm.Spec.Template == nil {
+		return
+	}
+
+	for i := range vm.Spec.Template.Spec.Networks {
+		multus := vm.Spec.Template.Spec.Networks[i].Multus
+		if multus == nil {
+			continue
+		}
+		for _, mapping := range vmRestore.Spec.NetworkMappings {
+			if mapping.Source == multus.NetworkName {
+				multus.NetworkName = mapping.Target
+				break
+			}
+		}
+	}
+}
+
 // isVolumeRestorePolicyInPlace determines if the VolumeRestorePolicy is set to "InPlace"
 // If this is the case, we'll have to try to restore the volumes over the original ones, which means
 // deleting the original volumes first, if they already exist.
@@ -1785,6 +1866,24 @@ func isVolumeOwnershipPolicyNone(vmRestore *snapshotv1.VirtualMachineRestore) bo
 	return *vmRestore.Spec.VolumeOwnershipPolicy == snapshotv1.VolumeOwnershipPolicyNone
 }
 
+func isFirmwareIdentityPolicyRegenerate(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	if vmRestore.Spec.FirmwareIdentityPolicy == nil {
+		return false
+	}
+
+	return *vmRestore.Spec.FirmwareIdentityPolicy == snapshotv1.FirmwareIdentityPolicyRegenerate
+}
+
+// regenerateFirmwareIdentity gives the restored VM a new firmware UUID and SMBIOS serial.
+// The restore is only applied once, so the new values do not change on later reconciles.
+func regenerateFirmwareIdentity(vm *kubevirtv1.VirtualMachine) {
+	if vm.Spec.Template.Spec.Domain.Firmware == nil {
+		vm.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{}
+	}
+	vm.Spec.Template.Spec.Domain.Firmware.UUID = types.UID(uuid.New().String())
+	vm.Spec.Template.Spec.Domain.Firmware.Serial = uuid.New().String()
+}
+
 func setLegacyFirmwareUUID(vm *kubevirtv1.VirtualMachine) {
 	if vm.Spec.Template.Spec.Domain.Firmware == nil {
 		vm.Spec.Template.Spec.Domain.Firmware = &kubevirtv1.Firmware{}
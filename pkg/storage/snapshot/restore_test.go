@@ -591,6 +591,37 @@ var _ = Describe("Restore controller", func() {
 				Expect(*calls).To(Equal(1))
 			})
 
+			It("should create restore PVCs with the mapped storage class", func() {
+				r := createRestoreWithOwner()
+				backupStorageClass := sc.Spec.VolumeBackups[0].PersistentVolumeClaim.Spec.StorageClassName
+				r.Spec.StorageClassMappings = []snapshotv1.StorageClassMapping{{Source: *backupStorageClass, Target: "new-sc"}}
+				vm := createRestoreInProgressVM()
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					},
+				}
+				Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+				addVolumeRestores(r)
+				pvcSize := resource.MustParse("2Gi")
+				vs := createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize)
+				fakeVolumeSnapshotProvider.Add(vs)
+				var storageClasses []string
+				k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					create, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+					pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+					Expect(pvc.Spec.StorageClassName).ToNot(BeNil())
+					storageClasses = append(storageClasses, *pvc.Spec.StorageClassName)
+					return true, pvc, nil
+				})
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				Expect(storageClasses).To(Equal([]string{"new-sc"}))
+			})
+
 			It("should create pvcs for both datavolume and pvc restore volumes", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -1599,6 +1630,95 @@ var _ = Describe("Restore controller", func() {
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(res).To(BeTrue())
 				})
+
+				It("should regenerate firmware UUID and serial with the Regenerate firmware identity policy", func() {
+					addRestoreVolumes(true, cdiv1.Succeeded)
+					r.Spec.FirmwareIdentityPolicy = pointer.P(snapshotv1.FirmwareIdentityPolicyRegenerate)
+					addVirtualMachineRestore(r)
+
+					existingFirmware := &kubevirtv1.Firmware{UUID: types.UID("existing-uid"), Serial: "existing-serial"}
+					vm.Spec.Template.Spec.Domain.Firmware = existingFirmware
+					sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Firmware = existingFirmware
+
+					Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+					var restoredFirmware *kubevirtv1.Firmware
+					kubevirtClient.Fake.PrependReactor("update", "virtualmachines", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						update, ok := action.(testing.UpdateAction)
+						Expect(ok).To(BeTrue())
+						restoredFirmware = update.GetObject().(*kubevirtv1.VirtualMachine).Spec.Template.Spec.Domain.Firmware
+						return true, update.GetObject(), nil
+					})
+					res, err := targetVM.Reconcile()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).To(BeTrue())
+
+					Expect(restoredFirmware).ToNot(BeNil())
+					Expect(restoredFirmware.UUID).ToNot(BeEmpty())
+					Expect(restoredFirmware.UUID).ToNot(Equal(existingFirmware.UUID))
+					Expect(restoredFirmware.Serial).ToNot(BeEmpty())
+					Expect(restoredFirmware.Serial).ToNot(Equal(existingFirmware.Serial))
+				})
+
+				It("should map the storage classes and networks of the restored VM", func() {
+					addRestoreVolumes(true, cdiv1.Succeeded)
+					templateStorageClass := sc.Spec.Source.VirtualMachine.Spec.DataVolumeTemplates[0].Spec.PVC.StorageClassName
+					r.Spec.StorageClassMappings = []snapshotv1.StorageClassMapping{{Source: *templateStorageClass, Target: "new-sc"}}
+					r.Spec.NetworkMappings = []snapshotv1.NetworkMapping{{Source: "old-net", Target: "other-ns/new-net"}}
+					addVirtualMachineRestore(r)
+
+					sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Networks = []kubevirtv1.Network{
+						*kubevirtv1.DefaultPodNetwork(),
+						{
+							Name: "secondary",
+							NetworkSource: kubevirtv1.NetworkSource{
+								Multus: &kubevirtv1.MultusNetwork{NetworkName: "old-net"},
+							},
+						},
+					}
+
+					Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+					var restoredVM *kubevirtv1.VirtualMachine
+					kubevirtClient.Fake.PrependReactor("update", "virtualmachines", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						update, ok := action.(testing.UpdateAction)
+						Expect(ok).To(BeTrue())
+						restoredVM = update.GetObject().(*kubevirtv1.VirtualMachine)
+						return true, update.GetObject(), nil
+					})
+					res, err := targetVM.Reconcile()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).To(BeTrue())
+
+					Expect(restoredVM).ToNot(BeNil())
+					Expect(restoredVM.Spec.DataVolumeTemplates[0].Spec.PVC.StorageClassName).To(HaveValue(Equal("new-sc")))
+					Expect(restoredVM.Spec.Template.Spec.Networks[0].Pod).ToNot(BeNil())
+					Expect(restoredVM.Spec.Template.Spec.Networks[1].Multus.NetworkName).To(Equal("other-ns/new-net"))
+				})
+
+				It("should resume the restored VM from the restored memory state", func() {
+					snapshotVM := sc.Spec.Source.VirtualMachine.DeepCopy()
+					snapshotVM.Spec.Template.Spec.Volumes = append(snapshotVM.Spec.Template.Spec.Volumes, kubevirtv1.Volume{
+						Name: "memory",
+						VolumeSource: kubevirtv1.VolumeSource{
+							MemoryDump: &kubevirtv1.MemoryDumpVolumeSource{
+								PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+									PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "vmsnapshot-memory"},
+								},
+								Format: kubevirtv1.MemoryDumpFormatState,
+							},
+						},
+					})
+					r.Status.Restores = append(r.Status.Restores, snapshotv1.VolumeRestore{
+						VolumeName:                "memory",
+						PersistentVolumeClaimName: "restore-uid-memory",
+					})
+
+					restoredVM, err := targetVM.(*vmRestoreTarget).generateRestoredVMSpec(snapshotVM)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(restoredVM.Spec.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(kubevirtv1.MemoryStateClaimAnnotation, "restore-uid-memory"))
+					for _, volume := range restoredVM.Spec.Template.Spec.Volumes {
+						Expect(volume.MemoryDump).To(BeNil())
+					}
+				})
 			})
 
 			Context("target VM is different than source VM", func() {
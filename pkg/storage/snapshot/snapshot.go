@@ -77,6 +77,7 @@ var snapshotIndicationMessages = map[snapshotv1.Indication]string{
 	snapshotv1.VMSnapshotNoGuestAgentIndication:   "Guest agent was not available. Snapshot is crash-consistent and may not be application-consistent.",
 	snapshotv1.VMSnapshotQuiesceFailedIndication:  "Guest agent failed to quiesce the filesystem. Snapshot is crash-consistent and may not be application-consistent.",
 	snapshotv1.VMSnapshotPausedIndication:         "Snapshot taken while the VM was paused. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotMemoryIndication:         "Snapshot includes the memory state of the VM. The VM was paused until its volumes were snapshotted.",
 }
 
 func VmSnapshotReady(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
@@ -200,12 +201,21 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 				// attempt to lock source
 				// if fails will attempt again when source is updated
 				if !source.Locked() {
-					locked, err := source.Lock()
+					// the memory is captured before locking the source,
+					// as the memory dump hotplugs a volume to the VM
+					captured, err := source.CaptureMemory()
 					if err != nil {
 						return 0, err
 					}
 
-					log.Log.V(3).Infof("Attempt to lock source returned: %t", locked)
+					if captured {
+						locked, err := source.Lock()
+						if err != nil {
+							return 0, err
+						}
+
+						log.Log.V(3).Infof("Attempt to lock source returned: %t", locked)
+					}
 
 					retry = snapshotRetryInterval
 				} else {
@@ -222,6 +232,9 @@ func (ctrl *VMSnapshotController) updateVMSnapshot(vmSnapshot *snapshotv1.Virtua
 					if _, err := source.Unlock(); err != nil {
 						return 0, err
 					}
+					if err := source.ReleaseMemory(); err != nil {
+						return 0, err
+					}
 				}
 				canRemoveFinalizer = !source.Locked()
 			}
@@ -859,7 +872,9 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 		indications := sets.New(snapshot.Status.Indications...)
 		indications = sets.Insert(indications, snapshotv1.VMSnapshotOnlineSnapshotIndication)
 
-		if source.Paused() {
+		// a source paused after the memory indication was recorded got paused to capture its memory
+		paused := source.Paused() && !indications.Has(snapshotv1.VMSnapshotMemoryIndication)
+		if paused {
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotPausedIndication)
 		} else if source.GuestAgent() {
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotGuestAgentIndication)
@@ -872,6 +887,10 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
 		}
 
+		if snapshot.Spec.IncludeMemory != nil && *snapshot.Spec.IncludeMemory {
+			indications = sets.Insert(indications, snapshotv1.VMSnapshotMemoryIndication)
+		}
+
 		indicationsList := sets.List(indications)
 
 		// Update the old field for backward compatibility
@@ -811,6 +811,112 @@ var _ = Describe("Snapshot controlleer", func() {
 				Entry("when vm not running", false),
 			)
 
+			Context("with memory", func() {
+				memoryIndications := []snapshotv1.Indication{
+					snapshotv1.VMSnapshotMemoryIndication,
+					snapshotv1.VMSnapshotNoGuestAgentIndication,
+					snapshotv1.VMSnapshotOnlineSnapshotIndication,
+				}
+
+				createMemoryVMSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) *snapshotv1.VirtualMachineSnapshot {
+					vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+					vmSnapshot.Status.Indications = memoryIndications
+					for _, indication := range memoryIndications {
+						vmSnapshot.Status.SourceIndications = append(vmSnapshot.Status.SourceIndications, snapshotv1.SourceIndication{
+							Indication: indication,
+							Message:    IndicationMessage(indication),
+						})
+					}
+					return vmSnapshot
+				}
+
+				pausedCondition := v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstancePaused,
+					Status: corev1.ConditionTrue,
+				}
+
+				It("should pause the vm and capture its memory before locking it", func() {
+					vmSnapshot := createMemoryVMSnapshot(createVMSnapshotInProgress())
+					vm := createVM()
+					vmSource.Add(vm)
+					vmiSource.Add(createVMI(vm))
+
+					claimName := memoryStateClaimName(vmSnapshot)
+					virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+					pvcCreates := 0
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						pvc := action.(testing.CreateAction).GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(claimName))
+						Expect(pvc.OwnerReferences).To(HaveLen(1))
+						Expect(pvc.OwnerReferences[0].Name).To(Equal(vmSnapshot.Name))
+						pvcCreates++
+						return true, pvc, nil
+					})
+					vmiInterface.EXPECT().Pause(context.Background(), vm.Name, &v1.PauseOptions{}).Return(nil).Times(1)
+					vmInterface.EXPECT().MemoryDump(context.Background(), vm.Name, &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: claimName,
+						Format:    v1.MemoryDumpFormatState,
+					}).Return(nil).Times(1)
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Source not locked"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(pvcCreates).To(Equal(1))
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should not lock the vm until its memory is captured", func() {
+					vmSnapshot := createMemoryVMSnapshot(createVMSnapshotInProgress())
+					vm := createVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryStateClaimName(vmSnapshot),
+						Phase:     v1.MemoryDumpInProgress,
+					}
+					vmSource.Add(vm)
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, pausedCondition)
+					vmiSource.Add(vmi)
+
+					updatedSnapshot := vmSnapshot.DeepCopy()
+					updatedSnapshot.ResourceVersion = "1"
+					updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Source not locked"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					}
+					updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should unpause the vm and release the memory claim once unlocked", func() {
+					vmSnapshot := createMemoryVMSnapshot(createVMSnapshotSuccess())
+					vm := createVM()
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: memoryStateClaimName(vmSnapshot),
+						Phase:     v1.MemoryDumpCompleted,
+					}
+					vmSource.Add(vm)
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, pausedCondition)
+					vmiSource.Add(vmi)
+
+					vmiInterface.EXPECT().Unpause(context.Background(), vm.Name, &v1.UnpauseOptions{}).Return(nil).Times(1)
+					vmInterface.EXPECT().RemoveMemoryDump(context.Background(), vm.Name).Return(nil).Times(1)
+
+					addVirtualMachineSnapshot(vmSnapshot)
+					controller.processVMSnapshotWorkItem()
+				})
+			})
+
 			DescribeTable("should not lock source if volume PVCs", func(createPVCs, boundPVCs bool, expectedReason string) {
 				vmSnapshot := createVMSnapshotInProgress()
 				vm := createVM()
@@ -74,6 +74,8 @@ type snapshotSource interface {
 	Frozen() bool
 	Freeze() error
 	Unfreeze() error
+	CaptureMemory() (bool, error)
+	ReleaseMemory() error
 	Spec() (snapshotv1.SourceSpec, error)
 	PersistentVolumeClaims() (map[string]string, error)
 }
@@ -436,6 +438,11 @@ func (s *vmSnapshotSource) Freeze() error {
 		return nil
 	}
 
+	if s.pausedForMemory() {
+		// the guest can't write to its disks while paused, they are consistent with the captured memory
+		return nil
+	}
+
 	if s.Paused() {
 		log.Log.Warningf("VM %s is paused - taking snapshot without filesystem freeze. Paused VMs cannot flush memory buffers to disk, which may result in inconsistent snapshots.", s.vm.Name)
 		return nil
@@ -464,6 +471,9 @@ func (s *vmSnapshotSource) Freeze() error {
 }
 
 func (s *vmSnapshotSource) Unfreeze() error {
+	if s.Locked() && s.pausedForMemory() {
+		return s.unpause()
+	}
 	if !s.Locked() || !s.GuestAgent() || s.Paused() {
 		return nil
 	}
@@ -480,6 +490,141 @@ func (s *vmSnapshotSource) Unfreeze() error {
 	return nil
 }
 
+// CaptureMemory dumps the memory state of the running source to a new claim, which is then
+// snapshotted along with the other volumes. It returns true once the memory was captured,
+// or right away when the snapshot doesn't include the memory.
+func (s *vmSnapshotSource) CaptureMemory() (bool, error) {
+	if !s.Online() || s.snapshot.Spec.IncludeMemory == nil || !*s.snapshot.Spec.IncludeMemory {
+		return true, nil
+	}
+	if !s.hasIndication(snapshotv1.VMSnapshotMemoryIndication) {
+		// the state of the source is recorded before it gets paused
+		return false, nil
+	}
+
+	claimName := memoryStateClaimName(s.snapshot)
+	if request := s.vm.Status.MemoryDumpRequest; request != nil && request.ClaimName == claimName {
+		switch request.Phase {
+		case kubevirtv1.MemoryDumpCompleted:
+			return true, nil
+		case kubevirtv1.MemoryDumpFailed:
+			return false, fmt.Errorf("failed capturing the memory of vm %s: %s", s.vm.Name, request.Message)
+		}
+		return false, nil
+	}
+
+	if err := s.createMemoryStateClaim(claimName); err != nil {
+		return false, err
+	}
+
+	if !s.Paused() {
+		log.Log.V(3).Infof("Pausing vm %s to capture its memory", s.vm.Name)
+		err := s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Pause(context.Background(), s.vm.Name, &kubevirtv1.PauseOptions{})
+		if err != nil {
+			return false, err
+		}
+		s.state.paused = true
+	}
+
+	log.Log.V(3).Infof("Capturing the memory of vm %s to %s", s.vm.Name, claimName)
+	err := s.controller.Client.VirtualMachine(s.vm.Namespace).MemoryDump(context.Background(), s.vm.Name, &kubevirtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: claimName,
+		Format:    kubevirtv1.MemoryDumpFormatState,
+	})
+	return false, err
+}
+
+// ReleaseMemory resumes the source if it is still paused for capturing its memory, and
+// dissociates the memory claim from the source once it is unlocked.
+func (s *vmSnapshotSource) ReleaseMemory() error {
+	if s.vm.Status.SnapshotInProgress != nil {
+		return nil
+	}
+	if s.pausedForMemory() {
+		if err := s.unpause(); err != nil {
+			return err
+		}
+	}
+
+	request := s.vm.Status.MemoryDumpRequest
+	if request == nil || request.ClaimName != memoryStateClaimName(s.snapshot) || request.Remove {
+		return nil
+	}
+	return s.controller.Client.VirtualMachine(s.vm.Namespace).RemoveMemoryDump(context.Background(), s.vm.Name)
+}
+
+func (s *vmSnapshotSource) createMemoryStateClaim(claimName string) error {
+	_, exists, err := s.controller.PVCInformer.GetStore().GetByKey(cacheKeyFunc(s.vm.Namespace, claimName))
+	if err != nil || exists {
+		return err
+	}
+
+	vmi, exists, err := s.controller.getVMI(s.vm)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("can't capture the memory of vm %s, vmi doesn't exist", s.vm.Name)
+	}
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(utils.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return err
+	}
+
+	// The claim is owned by the snapshot, the snapshot content keeps a volume snapshot of it
+	obj, err := utils.GenerateKubeVirtGroupVersionKind(s.snapshot)
+	if err != nil {
+		return err
+	}
+	snapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if !ok {
+		return fmt.Errorf("Unexpected object format returned from GenerateKubeVirtGroupVersionKind")
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            claimName,
+			Namespace:       s.vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(snapshot, snapshot.GroupVersionKind())},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: *size},
+			},
+		},
+	}
+	_, err = s.controller.Client.CoreV1().PersistentVolumeClaims(s.vm.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *vmSnapshotSource) unpause() error {
+	log.Log.V(3).Infof("Unpausing vm %s after capturing its memory", s.vm.Name)
+	err := s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Unpause(context.Background(), s.vm.Name, &kubevirtv1.UnpauseOptions{})
+	if err != nil {
+		return err
+	}
+	s.state.paused = false
+	return nil
+}
+
+// pausedForMemory returns true when the source is paused, and wasn't before the snapshot paused it to capture its memory
+func (s *vmSnapshotSource) pausedForMemory() bool {
+	return s.Paused() &&
+		s.hasIndication(snapshotv1.VMSnapshotMemoryIndication) &&
+		!s.hasIndication(snapshotv1.VMSnapshotPausedIndication)
+}
+
+func (s *vmSnapshotSource) hasIndication(indication snapshotv1.Indication) bool {
+	return s.snapshot.Status != nil && slices.Contains(s.snapshot.Status.Indications, indication)
+}
+
+func memoryStateClaimName(snapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", snapshot.UID)
+}
+
 func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
 	volumes, err := storageutils.GetVolumes(s.vm, s.controller.Client, storageutils.WithAllVolumes)
 	if err != nil {
@@ -41,13 +41,26 @@ func IsDeclarativeHotplugVolume(vol *v1.Volume) bool {
 	if volSrc.DataVolume != nil && volSrc.DataVolume.Hotpluggable {
 		return true
 	}
+	if IsHotplugConfigVolume(vol) {
+		return true
+	}
 
 	return false
 }
 
+// IsHotplugConfigVolume returns whether the volume is a ConfigMap, Secret or
+// ServiceAccount volume which is hotplugged as an iso image.
+func IsHotplugConfigVolume(vol *v1.Volume) bool {
+	volSrc := vol.VolumeSource
+	return (volSrc.ConfigMap != nil && volSrc.ConfigMap.Hotpluggable) ||
+		(volSrc.Secret != nil && volSrc.Secret.Hotpluggable) ||
+		(volSrc.ServiceAccount != nil && volSrc.ServiceAccount.Hotpluggable)
+}
+
 func IsHotpluggableVolumeSource(vol *v1.Volume) bool {
 	return IsStorageVolume(vol) ||
-		vol.MemoryDump != nil
+		vol.MemoryDump != nil ||
+		IsHotplugConfigVolume(vol)
 }
 
 func IsHotplugVolume(vol *v1.Volume) bool {
@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tpm
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// AttestationKeyHandle is the persistent handle of the attestation key in
+	// the vTPM. The guest has to create the key, the quote is signed with it.
+	AttestationKeyHandle = "0x81010002"
+	// EndorsementKeyCertificateIndex is the NV index swtpm_setup stores the
+	// certificate of the RSA endorsement key at.
+	EndorsementKeyCertificateIndex = "0x01c00002"
+
+	maxNonceBytes      = 32
+	maxCredentialBytes = 1024
+	maxPCRIndex        = 23
+	sha256PCRLength    = 32
+
+	NonceParam      = "nonce"
+	PCRsParam       = "pcrs"
+	CredentialParam = "credential"
+
+	// The local CA of swtpm issuing the endorsement key certificates
+	localCAIssuerCertificate = "issuercert.pem"
+	localCARootCertificate   = "swtpm-localca-rootca-cert.pem"
+
+	// attestationScript quotes the PCRs and prints the event log, the quote,
+	// its signature, the quoted PCR values, the public attestation key, the
+	// endorsement key certificate and the secret of the activated credential
+	// base64 encoded, one per line. It is passed to the guest agent as is,
+	// so it must neither contain double quotes nor backslashes nor newlines.
+	attestationScript = "set -e; dir=$(mktemp -d); trap 'rm -rf $dir' EXIT; " +
+		"tpm2_quote --key-context $1 --pcr-list sha256:$2 ${3:+--qualification $3} " +
+		"--message $dir/quote --signature $dir/signature --pcr $dir/pcrs --pcrs_format values >/dev/null; " +
+		"tpm2_readpublic --object-context $1 --output $dir/ak >/dev/null; " +
+		"tpm2_nvread --output $dir/ekcert $4 >/dev/null 2>&1 || : >$dir/ekcert; " +
+		": >$dir/secret; " +
+		"if [ ${#5} -gt 0 ]; then printf %s $5 | base64 -d >$dir/credential; " +
+		"tpm2_createek --ek-context $dir/ek --key-algorithm rsa >/dev/null; " +
+		"tpm2_startauthsession --policy-session --session $dir/session; " +
+		"tpm2_policysecret --session $dir/session --object-context endorsement >/dev/null; " +
+		"tpm2_activatecredential --credentialedkey-context $1 --credentialkey-context $dir/ek " +
+		"--credentialkey-auth session:$dir/session --credential-blob $dir/credential --certinfo-data $dir/secret >/dev/null; " +
+		"tpm2_flushcontext $dir/session; fi; " +
+		"for f in /sys/kernel/security/tpm0/binary_bios_measurements $dir/quote $dir/signature $dir/pcrs $dir/ak $dir/ekcert $dir/secret; " +
+		"do base64 -w 0 $f; echo; done"
+)
+
+// DefaultAttestationPCRs are the PCRs holding the measurements of the
+// firmware and the boot loader.
+var DefaultAttestationPCRs = []uint32{0, 1, 2, 3, 4, 5, 6, 7}
+
+// ParseAttestationOptions parses the nonce, the comma separated PCRs and the
+// credential of a tpm/attestation request.
+func ParseAttestationOptions(nonce, pcrs, credential string) (*v1.TPMAttestationOptions, error) {
+	options := &v1.TPMAttestationOptions{
+		Nonce:      nonce,
+		Credential: credential,
+	}
+	if pcrs != "" {
+		for _, pcr := range strings.Split(pcrs, ",") {
+			index, err := strconv.ParseUint(strings.TrimSpace(pcr), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid PCR index %q", pcr)
+			}
+			options.PCRs = append(options.PCRs, uint32(index))
+		}
+	}
+	if err := ValidateAttestationOptions(options); err != nil {
+		return nil, err
+	}
+	return options, nil
+}
+
+// EncodeAttestationOptions encodes the options as the query of a
+// tpm/attestation request.
+func EncodeAttestationOptions(options *v1.TPMAttestationOptions) string {
+	query := url.Values{}
+	if options.Nonce != "" {
+		query.Set(NonceParam, options.Nonce)
+	}
+	if len(options.PCRs) > 0 {
+		query.Set(PCRsParam, joinPCRs(options.PCRs))
+	}
+	if options.Credential != "" {
+		query.Set(CredentialParam, options.Credential)
+	}
+	return query.Encode()
+}
+
+// ValidateAttestationOptions checks that the nonce is hex encoded and fits into
+// the quote, that the PCRs exist and that the credential is base64 encoded.
+func ValidateAttestationOptions(options *v1.TPMAttestationOptions) error {
+	if options.Nonce != "" {
+		nonce, err := hex.DecodeString(options.Nonce)
+		if err != nil {
+			return fmt.Errorf("the nonce must be hex encoded: %v", err)
+		}
+		if len(nonce) > maxNonceBytes {
+			return fmt.Errorf("the nonce must not be longer than %d bytes", maxNonceBytes)
+		}
+	}
+	for _, pcr := range options.PCRs {
+		if pcr > maxPCRIndex {
+			return fmt.Errorf("invalid PCR index %d, the vTPM has the PCRs 0 to %d", pcr, maxPCRIndex)
+		}
+	}
+	if options.Credential != "" {
+		credential, err := base64.StdEncoding.DecodeString(options.Credential)
+		if err != nil {
+			return fmt.Errorf("the credential must be base64 encoded: %v", err)
+		}
+		if len(credential) > maxCredentialBytes {
+			return fmt.Errorf("the credential must not be longer than %d bytes", maxCredentialBytes)
+		}
+	}
+	return nil
+}
+
+// AttestationCommand returns the command which collects the attestation in
+// the guest, with the tpm2-tools installed.
+func AttestationCommand(options *v1.TPMAttestationOptions) (string, []string) {
+	return "/bin/sh", []string{"-c", attestationScript, "sh",
+		AttestationKeyHandle, joinPCRs(quotedPCRs(options)), options.Nonce, EndorsementKeyCertificateIndex, options.Credential}
+}
+
+// ParseAttestationOutput parses the output of the AttestationCommand.
+func ParseAttestationOutput(output string, options *v1.TPMAttestationOptions) (*v1.TPMAttestation, error) {
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != 7 {
+		return nil, fmt.Errorf("unexpected attestation output of %d lines", len(lines))
+	}
+	for _, line := range lines {
+		if _, err := base64.StdEncoding.DecodeString(line); err != nil {
+			return nil, fmt.Errorf("unexpected attestation output: %v", err)
+		}
+	}
+
+	pcrs := quotedPCRs(options)
+	values, _ := base64.StdEncoding.DecodeString(lines[3])
+	if len(values) != len(pcrs)*sha256PCRLength {
+		return nil, fmt.Errorf("expected %d PCR values but got %d bytes", len(pcrs), len(values))
+	}
+	attestation := &v1.TPMAttestation{
+		EventLog:                  lines[0],
+		Quote:                     lines[1],
+		Signature:                 lines[2],
+		AttestationKey:            lines[4],
+		PCRs:                      map[string]string{},
+		Nonce:                     options.Nonce,
+		EndorsementKeyCertificate: lines[5],
+		ActivatedCredential:       lines[6],
+	}
+	// The values are ordered by the PCR index
+	for i, pcr := range pcrs {
+		attestation.PCRs[strconv.Itoa(int(pcr))] = hex.EncodeToString(values[i*sha256PCRLength : (i+1)*sha256PCRLength])
+	}
+	return attestation, nil
+}
+
+// LocalCACertificates reads the certificates of the local CA of swtpm, which
+// issued the endorsement key certificate, from the virt-launcher pod. The
+// guest has no access to them, a verifier can trust them to validate the
+// endorsement key certificate the guest returns.
+func LocalCACertificates(vmi *v1.VirtualMachineInstance) (string, error) {
+	var certificates []byte
+	for _, name := range []string{localCAIssuerCertificate, localCARootCertificate} {
+		certificate, err := os.ReadFile(filepath.Join(LocalCAPath(vmi), name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		certificates = append(certificates, certificate...)
+	}
+	return string(certificates), nil
+}
+
+func quotedPCRs(options *v1.TPMAttestationOptions) []uint32 {
+	if len(options.PCRs) == 0 {
+		return DefaultAttestationPCRs
+	}
+	pcrs := slices.Clone(options.PCRs)
+	slices.Sort(pcrs)
+	return slices.Compact(pcrs)
+}
+
+func joinPCRs(pcrs []uint32) string {
+	indexes := make([]string, 0, len(pcrs))
+	for _, pcr := range pcrs {
+		indexes = append(indexes, strconv.FormatUint(uint64(pcr), 10))
+	}
+	return strings.Join(indexes, ",")
+}
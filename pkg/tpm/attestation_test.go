@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package tpm_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/tpm"
+)
+
+var _ = Describe("TPM attestation", func() {
+	Context("options", func() {
+		DescribeTable("should parse", func(query string, expected *v1.TPMAttestationOptions) {
+			values, err := url.ParseQuery(query)
+			Expect(err).ToNot(HaveOccurred())
+			options, err := tpm.ParseAttestationOptions(values.Get(tpm.NonceParam), values.Get(tpm.PCRsParam), values.Get(tpm.CredentialParam))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options).To(Equal(expected))
+			Expect(tpm.EncodeAttestationOptions(options)).To(Equal(query))
+		},
+			Entry("no options", "", &v1.TPMAttestationOptions{}),
+			Entry("a nonce", "nonce=c0ffee", &v1.TPMAttestationOptions{Nonce: "c0ffee"}),
+			Entry("PCRs", "pcrs=0%2C7%2C23", &v1.TPMAttestationOptions{PCRs: []uint32{0, 7, 23}}),
+			Entry("a credential", "credential=Y3JlZA%3D%3D", &v1.TPMAttestationOptions{Credential: "Y3JlZA=="}),
+		)
+
+		DescribeTable("should reject", func(query string) {
+			values, err := url.ParseQuery(query)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tpm.ParseAttestationOptions(values.Get(tpm.NonceParam), values.Get(tpm.PCRsParam), values.Get(tpm.CredentialParam))
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("a nonce which is not hex encoded", "nonce=coffee"),
+			Entry("a nonce of odd length", "nonce=c0ffe"),
+			Entry("a nonce longer than 32 bytes", "nonce="+strings.Repeat("ab", 33)),
+			Entry("a PCR which is not a number", "pcrs=0,boot"),
+			Entry("a PCR which does not exist", "pcrs=24"),
+			Entry("a credential which is not base64 encoded", "credential=cred!"),
+			Entry("a credential longer than 1024 bytes", "credential="+base64.StdEncoding.EncodeToString(make([]byte, 1025))),
+		)
+	})
+
+	Context("guest command", func() {
+		It("should quote the default PCRs without a nonce", func() {
+			command, args := tpm.AttestationCommand(&v1.TPMAttestationOptions{})
+			Expect(command).To(Equal("/bin/sh"))
+			Expect(args).To(HaveLen(8))
+			Expect(args[3:]).To(Equal([]string{tpm.AttestationKeyHandle, "0,1,2,3,4,5,6,7", "", tpm.EndorsementKeyCertificateIndex, ""}))
+		})
+
+		It("should quote the requested PCRs sorted and deduplicated", func() {
+			_, args := tpm.AttestationCommand(&v1.TPMAttestationOptions{Nonce: "c0ffee", PCRs: []uint32{7, 0, 7}})
+			Expect(args[3:]).To(Equal([]string{tpm.AttestationKeyHandle, "0,7", "c0ffee", tpm.EndorsementKeyCertificateIndex, ""}))
+		})
+
+		It("should pass the credential to activate", func() {
+			_, args := tpm.AttestationCommand(&v1.TPMAttestationOptions{Credential: "Y3JlZA=="})
+			Expect(args[7]).To(Equal("Y3JlZA=="))
+		})
+
+		It("should not contain characters the guest agent can't pass", func() {
+			_, args := tpm.AttestationCommand(&v1.TPMAttestationOptions{})
+			Expect(args[1]).ToNot(ContainSubstring(`"`))
+			Expect(args[1]).ToNot(ContainSubstring(`\`))
+			Expect(args[1]).ToNot(ContainSubstring("\n"))
+		})
+	})
+
+	Context("guest output", func() {
+		encode := func(data []byte) string {
+			return base64.StdEncoding.EncodeToString(data)
+		}
+		output := func(pcrValues []byte) string {
+			return strings.Join([]string{
+				encode([]byte("eventlog")),
+				encode([]byte("quote")),
+				encode([]byte("signature")),
+				encode(pcrValues),
+				encode([]byte("ak")),
+				encode([]byte("ekcert")),
+				encode([]byte("secret")),
+			}, "\n") + "\n"
+		}
+
+		It("should return the attestation with the PCR values by index", func() {
+			values := append(bytes.Repeat([]byte{0x00}, 32), bytes.Repeat([]byte{0xff}, 32)...)
+			options := &v1.TPMAttestationOptions{Nonce: "c0ffee", PCRs: []uint32{7, 0}}
+
+			attestation, err := tpm.ParseAttestationOutput(output(values), options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attestation.EventLog).To(Equal(encode([]byte("eventlog"))))
+			Expect(attestation.Quote).To(Equal(encode([]byte("quote"))))
+			Expect(attestation.Signature).To(Equal(encode([]byte("signature"))))
+			Expect(attestation.AttestationKey).To(Equal(encode([]byte("ak"))))
+			Expect(attestation.EndorsementKeyCertificate).To(Equal(encode([]byte("ekcert"))))
+			Expect(attestation.ActivatedCredential).To(Equal(encode([]byte("secret"))))
+			Expect(attestation.Nonce).To(Equal("c0ffee"))
+			Expect(attestation.PCRs).To(Equal(map[string]string{
+				"0": strings.Repeat("00", 32),
+				"7": strings.Repeat("ff", 32),
+			}))
+		})
+
+		It("should fail if PCR values are missing", func() {
+			_, err := tpm.ParseAttestationOutput(output(bytes.Repeat([]byte{0x00}, 32)), &v1.TPMAttestationOptions{})
+			Expect(err).To(MatchError(ContainSubstring("expected 8 PCR values")))
+		})
+
+		It("should omit a missing endorsement key certificate and credential", func() {
+			lines := strings.Split(output(make([]byte, 8*32)), "\n")
+			lines[5], lines[6] = "", ""
+			attestation, err := tpm.ParseAttestationOutput(strings.Join(lines, "\n"), &v1.TPMAttestationOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(attestation.EndorsementKeyCertificate).To(BeEmpty())
+			Expect(attestation.ActivatedCredential).To(BeEmpty())
+		})
+
+		It("should fail on truncated output", func() {
+			_, err := tpm.ParseAttestationOutput(encode([]byte("eventlog"))+"\n", &v1.TPMAttestationOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
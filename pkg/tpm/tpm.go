@@ -1,6 +1,21 @@
 package tpm
 
-import v1 "kubevirt.io/api/core/v1"
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// EncryptionSecretVolumeName is the pod volume of the Secret holding the
+	// passphrase of the TPM state.
+	EncryptionSecretVolumeName = "tpm-encryption-secret"
+	// EncryptionSecretKey is the entry of the Secret holding the passphrase.
+	EncryptionSecretKey = "key"
+)
 
 func HasDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
 	return vmiSpec.Domain.Devices.TPM != nil &&
@@ -12,3 +27,30 @@ func HasPersistentDevice(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
 		vmiSpec.Domain.Devices.TPM.Persistent != nil &&
 		*vmiSpec.Domain.Devices.TPM.Persistent
 }
+
+func HasEncryptedState(vmiSpec *v1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentDevice(vmiSpec) &&
+		vmiSpec.Domain.Devices.TPM.EncryptionSecretRef != nil
+}
+
+// Version returns the version of the emulated TPM, 2.0 unless 1.2 is requested
+func Version(vmiSpec *v1.VirtualMachineInstanceSpec) v1.TPMVersion {
+	if HasDevice(vmiSpec) && vmiSpec.Domain.Devices.TPM.Version == v1.TPMVersion12 {
+		return v1.TPMVersion12
+	}
+	return v1.TPMVersion20
+}
+
+// EncryptionSecretPath is the path of the passphrase in the virt-launcher pod
+func EncryptionSecretPath() string {
+	return filepath.Join(config.GetSecretSourcePath(EncryptionSecretVolumeName), EncryptionSecretKey)
+}
+
+// LocalCAPath is the state directory of the local CA of swtpm in the
+// virt-launcher pod
+func LocalCAPath(vmi *v1.VirtualMachineInstance) string {
+	if util.IsNonRootVMI(vmi) {
+		return filepath.Join(util.VirtPrivateDir, "var", "lib", "swtpm-localca")
+	}
+	return "/var/lib/swtpm-localca"
+}
@@ -62,3 +62,13 @@ func IsSEVAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
 func IsTDXVMI(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.TDX != nil
 }
+
+// Check if a VMI spec requests Intel TDX with attestation
+func IsTDXAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
+	return IsTDXVMI(vmi) && vmi.Spec.Domain.LaunchSecurity.TDX.Attestation != nil
+}
+
+// Check if a VMI spec requests an Intel SGX EPC section
+func IsSGXVMI(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Devices.SGX != nil
+}
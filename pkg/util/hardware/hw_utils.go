@@ -21,6 +21,7 @@ package hardware
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -37,6 +38,9 @@ const (
 	PCI_ADDRESS_PATTERN = `^([\da-fA-F]{4}):([\da-fA-F]{2}):([\da-fA-F]{2})\.([0-7]{1})$`
 )
 
+// CPUDevicesPath holds the PMU devices of the host CPUs, which tell the core types of hybrid CPUs
+const CPUDevicesPath = "/sys/devices"
+
 // Parse linux cpuset into an array of ints
 // See: http://man7.org/linux/man-pages/man7/cpuset.7.html#FORMATS
 func ParseCPUSetLine(cpusetLine string, limit int) (cpusList []int, err error) {
@@ -157,6 +161,33 @@ func GetNumaNodeCPUList(numaNode int) ([]int, error) {
 	return cpusList, nil
 }
 
+// GetCPUCoreTypes returns the core type of each host CPU on hosts with hybrid CPUs, where the kernel
+// lists the performance cores in cpu_core/cpus and the efficiency cores in cpu_atom/cpus of the
+// devices path. It returns nothing on other hosts.
+func GetCPUCoreTypes(devicesPath string) (map[int]v1.CPUCoreType, error) {
+	coreTypes := map[int]v1.CPUCoreType{}
+	for device, coreType := range map[string]v1.CPUCoreType{
+		"cpu_core": v1.CPUCoreTypePerformance,
+		"cpu_atom": v1.CPUCoreTypeEfficiency,
+	} {
+		cpusFile := filepath.Join(devicesPath, device, "cpus")
+		content, err := os.ReadFile(cpusFile)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		cpus, err := ParseCPUSetLine(string(bytes.TrimSpace(content)), 50000)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", cpusFile, err)
+		}
+		for _, cpu := range cpus {
+			coreTypes[cpu] = coreType
+		}
+	}
+	return coreTypes, nil
+}
+
 func LookupDeviceVCPUAffinity(pciAddress string, domainSpec *api.DomainSpec) ([]uint32, error) {
 	alignedVCPUList := []uint32{}
 	p2vCPUMap := make(map[string]uint32)
@@ -20,6 +20,9 @@
 package hardware
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -119,4 +122,47 @@ var _ = Describe("Hardware utils test", func() {
 			}
 		})
 	})
+
+	Context("CPU core types", func() {
+		var devicesPath string
+
+		BeforeEach(func() {
+			devicesPath = GinkgoT().TempDir()
+		})
+
+		writeCPUs := func(device, cpus string) {
+			Expect(os.MkdirAll(filepath.Join(devicesPath, device), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(devicesPath, device, "cpus"), []byte(cpus+"\n"), 0644)).To(Succeed())
+		}
+
+		It("should return the core type of every CPU of hybrid hosts", func() {
+			writeCPUs("cpu_core", "0-1,4")
+			writeCPUs("cpu_atom", "2-3")
+
+			coreTypes, err := GetCPUCoreTypes(devicesPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreTypes).To(Equal(map[int]v1.CPUCoreType{
+				0: v1.CPUCoreTypePerformance,
+				1: v1.CPUCoreTypePerformance,
+				2: v1.CPUCoreTypeEfficiency,
+				3: v1.CPUCoreTypeEfficiency,
+				4: v1.CPUCoreTypePerformance,
+			}))
+		})
+
+		It("should return nothing on hosts without hybrid CPUs", func() {
+			Expect(os.MkdirAll(filepath.Join(devicesPath, "cpu"), 0755)).To(Succeed())
+
+			coreTypes, err := GetCPUCoreTypes(devicesPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreTypes).To(BeEmpty())
+		})
+
+		It("should fail if the CPUs of a core type can not be parsed", func() {
+			writeCPUs("cpu_core", "0-a")
+
+			_, err := GetCPUCoreTypes(devicesPath)
+			Expect(err).To(MatchError(ContainSubstring("failed to parse")))
+		})
+	})
 })
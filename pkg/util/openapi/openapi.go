@@ -225,8 +225,22 @@ func LoadOpenAPISpec(webServices []*restful.WebService) *spec.Swagger {
 	return openapispec
 }
 
-func CreateOpenAPIValidator(webServices []*restful.WebService) *Validator {
+// CreateOpenAPIValidator creates a validator for the definitions of webServices.
+// bodyModels are request bodies of APIs which are not part of webServices, like
+// the subresource API, whose definitions are added for ValidateBody.
+func CreateOpenAPIValidator(webServices []*restful.WebService, bodyModels ...interface{}) *Validator {
 	openapispec := LoadOpenAPISpec(webServices)
+	for _, model := range bodyModels {
+		definitions, err := builder.BuildOpenAPIDefinitionsForResource(model, CreateConfig())
+		if err != nil {
+			panic(fmt.Errorf("Failed to build definitions for %T: %s", model, err))
+		}
+		for name, definition := range *definitions {
+			if _, exists := openapispec.Definitions[name]; !exists {
+				openapispec.Definitions[name] = definition
+			}
+		}
+	}
 	data, err := json.Marshal(openapispec)
 	if err != nil {
 		log.Print(err)
@@ -303,6 +317,18 @@ func (v *Validator) ValidateSpec(gvk schema.GroupVersionKind, obj map[string]int
 	return result.Errors
 }
 
+// ValidateBody validates the body of a subresource request against the
+// definition of its type, e.g. "v1.AddVolumeOptions". Unknown fields are
+// rejected like in specs.
+func (v *Validator) ValidateBody(definition string, body interface{}) []error {
+	schema, exists := v.specSchemes.Definitions[definition]
+	if !exists {
+		return []error{fmt.Errorf("no OpenAPI definition for %s", definition)}
+	}
+	result := openapi_validate.NewSchemaValidator(&schema, nil, "body", strfmt.Default).Validate(body)
+	return result.Errors
+}
+
 func (v *Validator) ValidateStatus(gvk schema.GroupVersionKind, obj map[string]interface{}) []error {
 	schema := v.statusSchemes.Definitions["v1."+gvk.Kind+"Status"]
 	result := openapi_validate.NewSchemaValidator(&schema, nil, "status", strfmt.Default).Validate(obj["status"])
@@ -15,7 +15,7 @@ import (
 var validator *openapi.Validator
 
 var _ = BeforeSuite(func() {
-	validator = openapi.CreateOpenAPIValidator(definitions.ComposeAPIDefinitions())
+	validator = openapi.CreateOpenAPIValidator(definitions.ComposeAPIDefinitions(), v1.AddVolumeOptions{})
 })
 
 var _ = Describe("Openapi", func() {
@@ -64,4 +64,20 @@ var _ = Describe("Openapi", func() {
 		expectValidationsToSucceed(obj)
 	})
 
+	It("should validate request bodies against their definition", func() {
+		data, err := json.Marshal(&v1.AddVolumeOptions{Name: "vol1", Disk: &v1.Disk{}, VolumeSource: &v1.HotplugVolumeSource{}})
+		Expect(err).ToNot(HaveOccurred())
+
+		body := map[string]interface{}{}
+		Expect(json.Unmarshal(data, &body)).To(Succeed())
+		Expect(validator.ValidateBody("v1.AddVolumeOptions", body)).To(BeEmpty())
+
+		delete(body, "disk")
+		body["unknown"] = "something"
+		Expect(validator.ValidateBody("v1.AddVolumeOptions", body)).To(HaveLen(2))
+	})
+
+	It("should reject request bodies without definition", func() {
+		Expect(validator.ValidateBody("v1.Unknown", map[string]interface{}{})).ToNot(BeEmpty())
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -21,6 +22,14 @@ const (
 	VirtImageVolumeDir                        = "/var/run/kubevirt-image-volume"
 	VirtKernelBootVolumeDir                   = "/var/run/kubevirt-kernel-boot"
 	VirtPrivateDir                            = "/var/run/kubevirt-private"
+	VirtMemoryStateDir                        = VirtPrivateDir + "/memory-state"
+	VirtSharedMemoryDir                       = "/var/run/kubevirt-shmem"
+	SharedMemoryDir                           = "/dev/shm"
+	VirtChannelsDir                           = "/var/run/kubevirt-channels"
+	VirtEmulatorBundleDir                     = "/var/run/kubevirt-emulator-bundle"
+	VirtFirmwareImageDir                      = "/var/run/kubevirt-firmware"
+	TDXQuoteGenerationServiceDir              = "/var/run/tdx-qgs"
+	TDXQuoteGenerationServiceSocket           = TDXQuoteGenerationServiceDir + "/qgs.socket"
 	KubeletRoot                               = "/var/lib/kubelet"
 	KubeletPodsDir                            = KubeletRoot + "/pods"
 	HostRootMount                             = "/proc/1/root/"
@@ -31,6 +40,15 @@ const (
 	RootUser          = 0
 )
 
+// EnvVarEmulatorPath points virt-launcher to the emulator of the bundle the VMI uses
+const EnvVarEmulatorPath = "EMULATOR_PATH"
+
+// Largest guest display the VNC server of QEMU can show
+const (
+	MaxDisplayWidth  = 2560
+	MaxDisplayHeight = 2048
+)
+
 func IsNonRootVMI(vmi *v1.VirtualMachineInstance) bool {
 	_, ok := vmi.Annotations[v1.DeprecatedNonRootVMIAnnotation]
 
@@ -75,6 +93,51 @@ func IsHostDevVMI(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if a VMI spec requests a shared memory device
+func IsSharedMemoryVMI(vmi *v1.VirtualMachineInstance) bool {
+	return len(vmi.Spec.Domain.Devices.SharedMemoryDevices) != 0
+}
+
+// SharedMemoryPath returns the path libvirt backs an ivshmem-plain region with
+func SharedMemoryPath(name string) string {
+	return filepath.Join(SharedMemoryDir, name)
+}
+
+// SharedMemoryServerSocketPath returns the path the ivshmem-server socket of an
+// ivshmem-doorbell device is mounted at in the virt-launcher pod
+func SharedMemoryServerSocketPath(name string) string {
+	return filepath.Join(VirtSharedMemoryDir, name+".sock")
+}
+
+// ChannelSocketPath returns the path of the unix socket backing a virtio-serial
+// channel in the virt-launcher pod. Channels on a host path get their own mount
+func ChannelSocketPath(channel v1.Channel) string {
+	if channel.HostPath != "" {
+		return filepath.Join(VirtChannelsDir, channel.Name, channel.Name+".sock")
+	}
+	return filepath.Join(VirtChannelsDir, channel.Name+".sock")
+}
+
+// VNCSocketPath returns the path of the unix socket QEMU serves VNC on in the
+// virt-launcher pod
+func VNCSocketPath(vmi *v1.VirtualMachineInstance) string {
+	return fmt.Sprintf("%s/%s/virt-vnc", VirtPrivateDir, vmi.UID)
+}
+
+// HasResizableDisplay returns true if the guest display of the VMI can follow
+// resolution changes requested by a client, which requires a virtio-gpu
+func HasResizableDisplay(vmi *v1.VirtualMachineInstance) bool {
+	devices := vmi.Spec.Domain.Devices
+	if devices.AutoattachGraphicsDevice != nil && !*devices.AutoattachGraphicsDevice {
+		return false
+	}
+	if devices.Video != nil {
+		return devices.Video.Type == v1.VirtIO
+	}
+	// Arm and s390x default to a virtio-gpu
+	return vmi.Spec.Architecture == "arm64" || vmi.Spec.Architecture == "s390x"
+}
+
 // Check if a VMI spec requests a VFIO device
 func IsVFIOVMI(vmi *v1.VirtualMachineInstance) bool {
 
@@ -84,8 +147,49 @@ func IsVFIOVMI(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if a VMI spec requests to run its virt-launcher pod in a micro VM
+func IsIsolatedLauncherVMI(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.LauncherIsolation == v1.LauncherIsolationMicroVM
+}
+
+// LaunchSecurityType is the confidential computing technology a VMI requests
+type LaunchSecurityType string
+
+const (
+	LaunchSecurityNone            LaunchSecurityType = ""
+	LaunchSecuritySEV             LaunchSecurityType = "SEV"
+	LaunchSecuritySEVES           LaunchSecurityType = "SEV-ES"
+	LaunchSecuritySEVSNP          LaunchSecurityType = "SEV-SNP"
+	LaunchSecurityTDX             LaunchSecurityType = "TDX"
+	LaunchSecuritySecureExecution LaunchSecurityType = "SecureExecution"
+)
+
+// GetLaunchSecurityType returns the launch security technology a VMI spec
+// requests. On s390x any launchSecurity requests Secure Execution, on other
+// architectures the configured vendor technology decides.
+func GetLaunchSecurityType(vmi *v1.VirtualMachineInstance) LaunchSecurityType {
+	switch {
+	case IsSecureExecutionVMI(vmi):
+		return LaunchSecuritySecureExecution
+	case IsTDXVMI(vmi):
+		return LaunchSecurityTDX
+	case IsSEVSNPVMI(vmi):
+		return LaunchSecuritySEVSNP
+	case IsSEVESVMI(vmi):
+		return LaunchSecuritySEVES
+	case IsSEVVMI(vmi):
+		return LaunchSecuritySEV
+	}
+	return LaunchSecurityNone
+}
+
+// Check if a VMI spec requests any confidential computing technology
+func IsConfidentialComputeVMI(vmi *v1.VirtualMachineInstance) bool {
+	return GetLaunchSecurityType(vmi) != LaunchSecurityNone
+}
+
 func UseLaunchSecurity(vmi *v1.VirtualMachineInstance) bool {
-	return IsSEVVMI(vmi) || IsSecureExecutionVMI(vmi) || IsTDXVMI(vmi)
+	return IsConfidentialComputeVMI(vmi)
 }
 
 func IsAutoAttachVSOCK(vmi *v1.VirtualMachineInstance) bool {
@@ -99,3 +99,62 @@ var _ = Describe("Host Device VMI Predicates", func() {
 		Expect(IsHostDevVMI(vmi)).To(BeTrue())
 	})
 })
+
+var _ = Describe("Launch Security VMI Predicates", func() {
+	newVMI := func(arch string, launchSecurity *v1.LaunchSecurity) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Architecture: arch,
+				Domain: v1.DomainSpec{
+					LaunchSecurity: launchSecurity,
+				},
+			},
+		}
+	}
+
+	DescribeTable("should detect the requested launch security", func(vmi *v1.VirtualMachineInstance, expected LaunchSecurityType) {
+		Expect(GetLaunchSecurityType(vmi)).To(Equal(expected))
+		Expect(IsConfidentialComputeVMI(vmi)).To(Equal(expected != LaunchSecurityNone))
+		Expect(UseLaunchSecurity(vmi)).To(Equal(expected != LaunchSecurityNone))
+	},
+		Entry("without launch security", newVMI("amd64", nil), LaunchSecurityNone),
+		Entry("with empty launch security on amd64", newVMI("amd64", &v1.LaunchSecurity{}), LaunchSecurityNone),
+		Entry("with SEV", newVMI("amd64", &v1.LaunchSecurity{SEV: &v1.SEV{}}), LaunchSecuritySEV),
+		Entry("with SEV-ES", newVMI("amd64", &v1.LaunchSecurity{
+			SEV: &v1.SEV{Policy: &v1.SEVPolicy{EncryptedState: pointer.P(true)}},
+		}), LaunchSecuritySEVES),
+		Entry("with SEV-SNP", newVMI("amd64", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}), LaunchSecuritySEVSNP),
+		Entry("with TDX", newVMI("amd64", &v1.LaunchSecurity{TDX: &v1.TDX{}}), LaunchSecurityTDX),
+		Entry("with TDX and no architecture set", newVMI("", &v1.LaunchSecurity{TDX: &v1.TDX{}}), LaunchSecurityTDX),
+		Entry("with Secure Execution on s390x", newVMI("s390x", &v1.LaunchSecurity{}), LaunchSecuritySecureExecution),
+		Entry("without launch security on s390x", newVMI("s390x", nil), LaunchSecurityNone),
+	)
+
+	It("should detect TDX", func() {
+		Expect(IsTDXVMI(newVMI("amd64", &v1.LaunchSecurity{TDX: &v1.TDX{}}))).To(BeTrue())
+		Expect(IsTDXVMI(newVMI("amd64", &v1.LaunchSecurity{SEV: &v1.SEV{}}))).To(BeFalse())
+		Expect(IsTDXVMI(newVMI("amd64", nil))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Display VMI Predicates", func() {
+	newVMI := func(arch string, devices v1.Devices) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Architecture: arch,
+				Domain:       v1.DomainSpec{Devices: devices},
+			},
+		}
+	}
+
+	DescribeTable("should detect a resizable display", func(vmi *v1.VirtualMachineInstance, expected bool) {
+		Expect(HasResizableDisplay(vmi)).To(Equal(expected))
+	},
+		Entry("with a virtio video device", newVMI("amd64", v1.Devices{Video: &v1.VideoDevice{Type: v1.VirtIO}}), true),
+		Entry("with a vga video device", newVMI("amd64", v1.Devices{Video: &v1.VideoDevice{Type: "vga"}}), false),
+		Entry("with the default video device on amd64", newVMI("amd64", v1.Devices{}), false),
+		Entry("with the default video device on arm64", newVMI("arm64", v1.Devices{}), true),
+		Entry("with the default video device on s390x", newVMI("s390x", v1.Devices{}), true),
+		Entry("without a graphics device", newVMI("arm64", v1.Devices{AutoattachGraphicsDevice: pointer.P(false)}), false),
+	)
+})
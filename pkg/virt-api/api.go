@@ -50,6 +50,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
 
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -246,11 +247,12 @@ func (app *virtAPIApp) composeSubresources() {
 			Consumes(mime.MIME_ANY).
 			Reads(v1.RestartOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Restart").
 			Doc("Restart a VirtualMachine object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{})
 		restartRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(restartRouteBuilder)
 
@@ -259,33 +261,36 @@ func (app *virtAPIApp) composeSubresources() {
 			Consumes(mime.MIME_ANY).
 			Reads(v1.MigrateOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Migrate").
 			Doc("Migrate a running VirtualMachine to another node.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("start")).
 			To(subresourceApp.StartVMRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.StartOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Start").
 			Doc("Start a VirtualMachine object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		stopRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("stop")).
 			To(subresourceApp.StopVMRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.StopOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Stop").
 			Doc("Stop a VirtualMachine object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "")
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{})
 		stopRouteBuilder.ParameterNamed("body").Required(false)
 		subws.Route(stopRouteBuilder)
 
@@ -296,26 +301,28 @@ func (app *virtAPIApp) composeSubresources() {
 			Produces(restful.MIME_JSON).
 			Doc("Get VirtualMachine object with expanded instancetype and preference.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("freeze")).
 			To(subresourceApp.FreezeVMIRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.FreezeOperation, "VirtualMachineInstance")).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.FreezeUnfreezeTimeout{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version+"Freeze").
 			Doc("Freeze a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("unfreeze")).
 			To(subresourceApp.UnfreezeVMIRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.UnfreezeOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version+"Unfreeze").
 			Doc("Unfreeze a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("reset")).
 			To(subresourceApp.ResetVMIRequestHandler).
@@ -323,7 +330,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"Reset").
 			Doc("Reset a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("softreboot")).
 			To(subresourceApp.SoftRebootVMIRequestHandler).
@@ -331,38 +338,55 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"SoftReboot").
 			Doc("Soft reboot a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("reboot")).
+			To(subresourceApp.RebootVMIRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.RebootOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
+			Operation(version.Version+"Reboot").
+			Doc("Reboot a VirtualMachineInstance object without recreating its virt-launcher pod.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("pause")).
 			To(subresourceApp.PauseVMIRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.PauseOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Pause").
 			Doc("Pause a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("unpause")).
 			To(subresourceApp.UnpauseVMIRequestHandler). // handles VMIs as well
 			Consumes(mime.MIME_ANY).
 			Reads(v1.UnpauseOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"Unpause").
 			Doc("Unpause a VirtualMachineInstance object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.ConsoleOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.SerialParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
 			To(subresourceApp.VNCRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.VNCOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
 			Param(definitions.PreserveSessionParam(subws)).
@@ -375,29 +399,49 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.MoveCursorParam(subws)).
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("vnc/resolution")).
+			To(subresourceApp.VNCResolutionRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.VNCResolutionOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
+			Operation(version.Version+"VNCResolution").
+			Doc("Request a new resolution of the guest display of a Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.USBRedirOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
 			Operation(version.Version + "usbredir").
 			Doc("Open a websocket connection to connect to USB device on the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("smartcard")).
+			To(subresourceApp.SmartcardRequestHandler).
+			Param(definitions.NamespaceParam(subws)).
+			Param(definitions.NameParam(subws)).
+			Operation(version.Version + "smartcard").
+			Doc("Open a websocket connection to pass a smartcard through to the specified VirtualMachineInstance."))
 
 		// VMI endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.PortForwardOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vmi-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.PortForwardOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
 			Operation(version.Version + "vmi-PortForwardWithProtocol").
 			Doc("Open a websocket connection forwarding traffic of the specified protocol (either tcp or udp) to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vsock")).
-			To(subresourceApp.VSOCKRequestHandler).
+			To(subresourceApp.VSOCKRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.VSOCKOperation, "VirtualMachineInstance")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
@@ -405,17 +449,25 @@ func (app *virtAPIApp) composeSubresources() {
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.PortForwardOperation, "VirtualMachine")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vm-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the running VMI for the specified VirtualMachine and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.PortForwardOperation, "VirtualMachine")).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
 			Operation(version.Version + "vm-PortForwardWithProtocol").
 			Doc("Open a websocket connection forwarding traffic of the specified protocol (either tcp or udp) to the specified VirtualMachine and port."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("vsock")).
+			To(subresourceApp.VSOCKRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.VSOCKOperation, "VirtualMachine")).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
+			Operation(version.Version + "vm-VSOCK").
+			Doc("Open a websocket connection forwarding traffic to the running VMI for the specified VirtualMachine and port via VSOCK."))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourceBasePath(expandvmspecGVR)).
 			To(subresourceApp.ExpandSpecRequestHandler).
@@ -425,8 +477,8 @@ func (app *virtAPIApp) composeSubresources() {
 			Produces(restful.MIME_JSON).
 			Doc("Expands instancetype and preference into the passed VirtualMachine object.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.GET(definitions.SubResourcePath("version")).Produces(restful.MIME_JSON).
 			To(func(request *restful.Request, response *restful.Response) {
@@ -449,7 +501,7 @@ func (app *virtAPIApp) composeSubresources() {
 			To(app.GetGsInfo()).
 			Operation(version.Version+"Guestfs").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 		subws.Route(subws.GET(definitions.SubResourcePath("healthz")).
 			To(healthz.KubeConnectionHealthzFuncFactory(app.clusterConfig, apiHealthVersion)).
 			Consumes(restful.MIME_JSON).
@@ -494,6 +546,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Reads(v1.ObjectGraphOptions{}).
 			Produces(restful.MIME_JSON).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vmi-objectgraph").
 			Doc("Get graph of objects related to a Virtual Machine Instance").
 			Writes(v1.ObjectGraphNode{}).
@@ -505,6 +558,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Reads(v1.ObjectGraphOptions{}).
 			Produces(restful.MIME_JSON).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vm-objectgraph").
 			Doc("Get graph of objects related to a Virtual Machine").
 			Writes(v1.ObjectGraphNode{}).
@@ -515,50 +569,56 @@ func (app *virtAPIApp) composeSubresources() {
 			Consumes(mime.MIME_ANY).
 			Reads(v1.AddVolumeOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vmi-addvolume").
 			Doc("Add a volume and disk to a running Virtual Machine Instance").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("removevolume")).
 			To(subresourceApp.VMIRemoveVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.RemoveVolumeOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vmi-removevolume").
 			Doc("Removes a volume and disk from a running Virtual Machine Instance").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.AddVolumeOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vm-addvolume").
 			Doc("Add a volume and disk to a running Virtual Machine.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("removevolume")).
 			To(subresourceApp.VMRemoveVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.RemoveVolumeOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vm-removevolume").
 			Doc("Removes a volume and disk from a running Virtual Machine.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("memorydump")).
 			To(subresourceApp.MemoryDumpVMRequestHandler).
+			Filter(subresourceApp.AuditFilter(auditv1alpha1.MemoryDumpOperation, "VirtualMachine")).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.VirtualMachineMemoryDumpRequest{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"MemoryDump").
 			Doc("Dumps a VirtualMachineInstance memory.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("removememorydump")).
 			To(subresourceApp.RemoveMemoryDumpVMRequestHandler).
@@ -566,7 +626,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"RemoveMemoryDump").
 			Doc("Remove memory dump association.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
@@ -594,10 +654,11 @@ func (app *virtAPIApp) composeSubresources() {
 			Consumes(mime.MIME_ANY).
 			Reads(v1.SEVSessionOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"SEVSetupSession").
 			Doc("Setup SEV session parameters for a Virtual Machine").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/injectlaunchsecret")).
 			To(subresourceApp.SEVInjectLaunchSecretHandler).
@@ -607,31 +668,55 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"SEVInjectLaunchSecret").
 			Doc("Inject SEV launch secret into a Virtual Machine").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/attestationreport")).
+			To(subresourceApp.SEVSNPAttestationReportHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.SEVSNPNonceParameter(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"SEVSNPAttestationReport").
+			Doc("Fetch the SEV-SNP attestation report of a Virtual Machine").
+			Writes(v1.SEVSNPAttestationReport{}).
+			Returns(http.StatusOK, "OK", v1.SEVSNPAttestationReport{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("tpm/attestation")).
+			To(subresourceApp.TPMAttestationRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.TPMNonceParameter(subws)).Param(definitions.TPMPCRsParameter(subws)).Param(definitions.TPMCredentialParameter(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"TPMAttestation").
+			Doc("Fetch the TPM event log and a PCR quote from a Virtual Machine").
+			Writes(v1.TPMAttestation{}).
+			Returns(http.StatusOK, "OK", v1.TPMAttestation{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("evacuate/cancel")).
 			To(subresourceApp.EvacuateCancelHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.EvacuateCancelOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vm-evacuatecancel").
 			Doc("Cancel evacuation Virtual Machine").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("evacuate/cancel")).
 			To(subresourceApp.EvacuateCancelHandler(subresourceApp.FetchVirtualMachineInstance)).
 			Consumes(mime.MIME_ANY).
 			Reads(v1.EvacuateCancelOptions{}).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.FieldValidationParam(subws)).
 			Operation(version.Version+"vmi-evacuatecancel").
 			Doc("Cancel evacuation Virtual Machine Instance").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
-			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, metav1.Status{}))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("backup")).
 			To(subresourceApp.BackupVMIRequestHandler).
@@ -641,8 +726,8 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"Backup").
 			Doc("Initiate a VirtualMachineInstance backup.").
 			Returns(http.StatusOK, "OK", "").
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
-			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, metav1.Status{}))
 
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
@@ -670,6 +755,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/vnc/screenshot",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/vnc/resolution",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
@@ -706,6 +795,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/softreboot",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/reboot",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/start",
 						Namespaced: true,
@@ -774,6 +867,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/sev/attestationreport",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/tpm/attestation",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/evacuate/cancel",
 						Namespaced: true,
@@ -785,7 +886,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"getAPISubResources").
 			Doc("Get a KubeVirt API resources").
 			Returns(http.StatusOK, "OK", metav1.APIResourceList{}).
-			Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}))
 
 		restful.Add(subws)
 
@@ -812,7 +913,7 @@ func (app *virtAPIApp) composeSubresources() {
 		Operation("getRootPaths").
 		Doc("Get KubeVirt API root paths").
 		Returns(http.StatusOK, "OK", metav1.RootPaths{}).
-		Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+		Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}))
 	ws.Route(ws.GET("/healthz").To(healthz.KubeConnectionHealthzFuncFactory(app.clusterConfig, apiHealthVersion)).Doc("Health endpoint"))
 
 	componentProfiler := profiler.NewProfileManager(app.clusterConfig)
@@ -830,7 +931,7 @@ func (app *virtAPIApp) composeSubresources() {
 		Operation(v1.SubresourceGroupVersions[0].Version+"GetSubAPIGroup").
 		Doc("Get a KubeVirt API Group").
 		Returns(http.StatusOK, "OK", metav1.APIGroup{}).
-		Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+		Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}))
 
 	// K8s needs the ability to query the list of API groups this endpoint supports
 	ws.Route(ws.GET("apis").
@@ -844,7 +945,7 @@ func (app *virtAPIApp) composeSubresources() {
 		Operation("getAPIGroupList").
 		Doc("Get a KubeVirt API GroupList").
 		Returns(http.StatusOK, "OK", metav1.APIGroupList{}).
-		Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+		Returns(http.StatusNotFound, httpStatusNotFoundMessage, metav1.Status{}))
 
 	once := sync.Once{}
 	var openapispec *spec.Swagger
@@ -967,14 +1068,14 @@ func (app *virtAPIApp) prepareCertManager() {
 
 func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers) {
 	http.HandleFunc(components.VMICreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts,
+		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts,
 			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
 				return netadmitter.Validate(field, vmiSpec, clusterCfg)
 			},
 		)
 	})
 	http.HandleFunc(components.VMIUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMIUpdate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts)
+		validating_webhook.ServeVMIUpdate(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
 	})
 	http.HandleFunc(components.VMValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, informers, app.kubeVirtServiceAccounts)
@@ -1006,6 +1107,12 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMBackupTrackerValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMBackupTrackers(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMBackupHookValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackupHooks(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMOperationValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMOperations(w, r, app.clusterConfig, app.virtCli)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -1038,7 +1145,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 func (app *virtAPIApp) registerMutatingWebhook(informers *webhooks.Informers) {
 
 	http.HandleFunc(components.VMMutatePath, func(w http.ResponseWriter, r *http.Request) {
-		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli)
+		mutating_webhook.ServeVMs(w, r, app.clusterConfig, app.virtCli, informers)
 	})
 	http.HandleFunc(components.VMIMutatePath, func(w http.ResponseWriter, r *http.Request) {
 		mutating_webhook.ServeVMIs(w, r, app.clusterConfig, informers, app.kubeVirtServiceAccounts)
@@ -1182,6 +1289,8 @@ func (app *virtAPIApp) Run() {
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	vmBackupInformer := kubeInformerFactory.VirtualMachineBackup()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	vmPolicyInformer := kubeInformerFactory.VirtualMachinePolicy()
+	nodeInformer := kubeInformerFactory.KubeVirtNode()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1221,6 +1330,8 @@ func (app *virtAPIApp) Run() {
 		VMBackupInformer:   vmBackupInformer,
 		DataSourceInformer: dataSourceInformer,
 		NamespaceInformer:  namespaceInformer,
+		VMPolicyInformer:   vmPolicyInformer,
+		NodeInformer:       nodeInformer,
 	}
 
 	// Build webhook subresources
@@ -591,6 +591,11 @@ const (
 	NameParamName            = "name"
 	MoveCursorParamName      = "moveCursor"
 	PreserveSessionParamName = "preserveSession"
+	SerialParamName          = "serial"
+	FieldValidationParamName = "fieldValidation"
+
+	// FieldValidationStrict rejects request bodies which do not match their API
+	FieldValidationStrict = "Strict"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -612,6 +617,14 @@ func PreserveSessionParam(ws *restful.WebService) *restful.Parameter {
 		DefaultValue("false")
 }
 
+func SerialParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(SerialParamName, "Name of the serial port to connect to. Defaults to the serial console.")
+}
+
+func FieldValidationParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(FieldValidationParamName, "Strict rejects a request body with unknown fields, missing required fields or values of the wrong type. By default the body is not validated.")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
@@ -717,3 +730,25 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+const (
+	NonceParamName      = "nonce"
+	PCRsParamName       = "pcrs"
+	CredentialParamName = "credential"
+)
+
+func TPMNonceParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(NonceParamName, "Hex encoded nonce to qualify the TPM quote with.").DataType("string").Required(false)
+}
+
+func TPMPCRsParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(PCRsParamName, "Comma separated indexes of the SHA-256 PCRs to quote.").DataType("string").Required(false)
+}
+
+func TPMCredentialParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(CredentialParamName, "Base64 encoded credential for the guest to activate with the endorsement key.").DataType("string").Required(false)
+}
+
+func SEVSNPNonceParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(NonceParamName, "Hex encoded nonce to place into the report data of the SEV-SNP attestation report.").DataType("string").Required(false)
+}
@@ -19,6 +19,29 @@
 
 package definitions
 
-import "kubevirt.io/kubevirt/pkg/util/openapi"
+import (
+	v1 "kubevirt.io/api/core/v1"
 
-var Validator = openapi.CreateOpenAPIValidator(ComposeAPIDefinitions())
+	"kubevirt.io/kubevirt/pkg/util/openapi"
+)
+
+// subresourceBodies are the request bodies of the subresource API, which are
+// validated against their definitions on strict field validation
+var subresourceBodies = []interface{}{
+	v1.AddVolumeOptions{},
+	v1.EvacuateCancelOptions{},
+	v1.MigrateOptions{},
+	v1.ObjectGraphOptions{},
+	v1.PauseOptions{},
+	v1.RebootOptions{},
+	v1.RemoveVolumeOptions{},
+	v1.RestartOptions{},
+	v1.SEVSessionOptions{},
+	v1.StartOptions{},
+	v1.StopOptions{},
+	v1.UnpauseOptions{},
+	v1.VNCResolutionOptions{},
+	v1.VirtualMachineMemoryDumpRequest{},
+}
+
+var Validator = openapi.CreateOpenAPIValidator(ComposeAPIDefinitions(), subresourceBodies...)
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	authv1 "k8s.io/api/authorization/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const forwardedForHeader = "X-Forwarded-For"
+
+// AuditFilter records a VirtualMachineAuditEvent for every request which
+// reaches the route, once the handler finished with the result it answered
+// with. For streams the event is recorded when the connection is closed.
+// Failures to record the event are logged, the request is never blocked
+// because of them.
+func (app *SubresourceAPIApp) AuditFilter(operation auditv1alpha1.AuditOperation, targetKind string) restful.FilterFunction {
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		if !app.clusterConfig.VirtualMachineAuditEventsEnabled() {
+			chain.ProcessFilter(request, response)
+			return
+		}
+
+		requestTime := metav1.Now()
+		chain.ProcessFilter(request, response)
+
+		namespace := request.PathParameter("namespace")
+		name := request.PathParameter("name")
+		event := newAuditEvent(request, operation, targetKind, name, requestTime, response.StatusCode())
+		if _, err := app.virtCli.VirtualMachineAuditEvent(namespace).Create(context.Background(), event, metav1.CreateOptions{}); err != nil {
+			log.Log.Reason(err).Errorf("Failed to record %s audit event for %s %s/%s", operation, targetKind, namespace, name)
+		}
+	}
+}
+
+func newAuditEvent(request *restful.Request, operation auditv1alpha1.AuditOperation, targetKind, name string, requestTime metav1.Time, statusCode int) *auditv1alpha1.VirtualMachineAuditEvent {
+	apiGroup := v1.SchemeGroupVersion.Group
+	result := auditv1alpha1.SucceededResult
+	if statusCode >= http.StatusBadRequest {
+		result = auditv1alpha1.FailedResult
+	}
+	return &auditv1alpha1.VirtualMachineAuditEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", name, strings.ToLower(string(operation))),
+		},
+		Spec: auditv1alpha1.VirtualMachineAuditEventSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     targetKind,
+				Name:     name,
+			},
+			Operation:      operation,
+			User:           auditUser(request),
+			SourceIPs:      sourceIPs(request),
+			RequestTime:    requestTime,
+			CompletionTime: metav1.Now(),
+			Result:         result,
+			ResponseCode:   int32(statusCode),
+		},
+	}
+}
+
+func auditUser(request *restful.Request) auditv1alpha1.AuditUser {
+	spec, ok := request.Attribute(userInfoAttribute).(authv1.SubjectAccessReviewSpec)
+	if !ok {
+		return auditv1alpha1.AuditUser{}
+	}
+	user := auditv1alpha1.AuditUser{
+		Username: spec.User,
+		Groups:   spec.Groups,
+	}
+	if len(spec.Extra) > 0 {
+		user.Extra = make(map[string][]string, len(spec.Extra))
+		for key, value := range spec.Extra {
+			user.Extra[key] = value
+		}
+	}
+	return user
+}
+
+// sourceIPs returns the client address the apiserver appended to the
+// X-Forwarded-For header. The hops before it are sent by the client and
+// can not be trusted.
+func sourceIPs(request *restful.Request) []string {
+	headers := request.Request.Header.Values(forwardedForHeader)
+	if len(headers) == 0 {
+		return nil
+	}
+	hops := strings.Split(headers[len(headers)-1], ",")
+	if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+		return []string{ip}
+	}
+	return nil
+}
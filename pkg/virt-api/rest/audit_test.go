@@ -0,0 +1,172 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testing "k8s.io/client-go/testing"
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Audit filter", func() {
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		chain      *restful.FilterChain
+		targetHit  bool
+		eventsSeen int
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		kv := &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+		}
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockVirtClient := kubecli.NewMockKubevirtClient(ctrl)
+		mockVirtClient.EXPECT().VirtualMachineAuditEvent(metav1.NamespaceDefault).
+			Return(virtClient.AuditV1alpha1().VirtualMachineAuditEvents(metav1.NamespaceDefault)).AnyTimes()
+		return NewSubresourceAPIApp(mockVirtClient, 0, nil, config)
+	}
+
+	createdEvents := func() []*auditv1alpha1.VirtualMachineAuditEvent {
+		var events []*auditv1alpha1.VirtualMachineAuditEvent
+		for _, action := range virtClient.Actions() {
+			if action.Matches("create", "virtualmachineauditevents") {
+				events = append(events, action.(testing.CreateAction).GetObject().(*auditv1alpha1.VirtualMachineAuditEvent))
+			}
+		}
+		return events
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{Header: http.Header{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		response = restful.NewResponse(httptest.NewRecorder())
+		virtClient = kubevirtfake.NewSimpleClientset()
+		targetHit = false
+		chain = &restful.FilterChain{Target: func(*restful.Request, *restful.Response) {
+			targetHit = true
+			eventsSeen = len(createdEvents())
+		}}
+	})
+
+	It("should not record an event if the feature gate is disabled", func() {
+		app := newApp()
+		app.AuditFilter(auditv1alpha1.ConsoleOperation, "VirtualMachineInstance")(request, response, chain)
+
+		Expect(targetHit).To(BeTrue())
+		Expect(createdEvents()).To(BeEmpty())
+	})
+
+	It("should record the operation with the identity of the user", func() {
+		request.SetAttribute(userInfoAttribute, authv1.SubjectAccessReviewSpec{
+			User:   "alice",
+			Groups: []string{"system:authenticated", "admins"},
+			Extra:  map[string]authv1.ExtraValue{"scopes": {"console"}},
+		})
+		request.Request.Header.Add(forwardedForHeader, "10.0.0.1, 10.0.0.2")
+		request.Request.Header.Add(forwardedForHeader, "10.0.0.3")
+
+		app := newApp(featuregate.VirtualMachineAuditEventsGate)
+		app.AuditFilter(auditv1alpha1.VNCOperation, "VirtualMachineInstance")(request, response, chain)
+
+		Expect(targetHit).To(BeTrue())
+		events := createdEvents()
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].GenerateName).To(Equal(testVMIName + "-vnc-"))
+
+		spec := events[0].Spec
+		Expect(spec.Operation).To(Equal(auditv1alpha1.VNCOperation))
+		Expect(*spec.Target.APIGroup).To(Equal(v1.SchemeGroupVersion.Group))
+		Expect(spec.Target.Kind).To(Equal("VirtualMachineInstance"))
+		Expect(spec.Target.Name).To(Equal(testVMIName))
+		Expect(spec.User.Username).To(Equal("alice"))
+		Expect(spec.User.Groups).To(ConsistOf("system:authenticated", "admins"))
+		Expect(spec.User.Extra).To(HaveKeyWithValue("scopes", []string{"console"}))
+		Expect(spec.SourceIPs).To(Equal([]string{"10.0.0.3"}))
+		Expect(spec.RequestTime.IsZero()).To(BeFalse())
+		Expect(spec.CompletionTime.Before(&spec.RequestTime)).To(BeFalse())
+		Expect(spec.Result).To(Equal(auditv1alpha1.SucceededResult))
+		Expect(spec.ResponseCode).To(Equal(int32(http.StatusOK)))
+	})
+
+	It("should record the event once the request is handled", func() {
+		app := newApp(featuregate.VirtualMachineAuditEventsGate)
+		app.AuditFilter(auditv1alpha1.PortForwardOperation, "VirtualMachine")(request, response, chain)
+
+		Expect(eventsSeen).To(BeZero())
+		Expect(createdEvents()).To(HaveLen(1))
+	})
+
+	It("should record a failed request with its response code", func() {
+		chain.Target = func(_ *restful.Request, response *restful.Response) {
+			response.WriteHeader(http.StatusNotFound)
+		}
+
+		app := newApp(featuregate.VirtualMachineAuditEventsGate)
+		app.AuditFilter(auditv1alpha1.USBRedirOperation, "VirtualMachineInstance")(request, response, chain)
+
+		events := createdEvents()
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Spec.Result).To(Equal(auditv1alpha1.FailedResult))
+		Expect(events[0].Spec.ResponseCode).To(Equal(int32(http.StatusNotFound)))
+	})
+
+	It("should not block the request if the event can't be recorded", func() {
+		virtClient.PrependReactor("create", "virtualmachineauditevents", func(testing.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("conflict")
+		})
+
+		app := newApp(featuregate.VirtualMachineAuditEventsGate)
+		app.AuditFilter(auditv1alpha1.FreezeOperation, "VirtualMachineInstance")(request, response, chain)
+
+		Expect(targetHit).To(BeTrue())
+	})
+})
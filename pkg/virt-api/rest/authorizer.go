@@ -42,6 +42,10 @@ const (
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
 
+	// userInfoAttribute holds the SubjectAccessReviewSpec of an authorized
+	// request, which identifies the user for later filters
+	userInfoAttribute = "kubevirt.io/user-info"
+
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
 )
@@ -345,6 +349,7 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(userInfoAttribute, r.Spec)
 		return true, "", nil
 	}
 
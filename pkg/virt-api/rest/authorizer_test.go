@@ -44,8 +44,7 @@ var _ = Describe("Authorizer", func() {
 		)
 
 		BeforeEach(func() {
-			req = &restful.Request{}
-			req.Request = &http.Request{}
+			req = restful.NewRequest(&http.Request{})
 			req.Request.URL = &url.URL{}
 			req.Request.Header = make(map[string][]string)
 			req.Request.Header[userHeader] = []string{"user"}
@@ -132,6 +131,18 @@ var _ = Describe("Authorizer", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
 				})
+
+				It("should remember the user of an authorized request", func() {
+					allowedFn = allowed(true)
+					result, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
+
+					spec, ok := req.Attribute(userInfoAttribute).(authv1.SubjectAccessReviewSpec)
+					Expect(ok).To(BeTrue())
+					Expect(spec.User).To(Equal("user"))
+					Expect(spec.Groups).To(ConsistOf("userGroup"))
+				})
 			})
 
 			Context("with namespaced base resource", func() {
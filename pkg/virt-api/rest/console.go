@@ -21,6 +21,7 @@ package rest
 
 import (
 	"fmt"
+	"net/url"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
 func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, response *restful.Response) {
@@ -38,19 +40,32 @@ func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, re
 
 	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
 
+	serial := request.QueryParameter(definitions.SerialParamName)
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
-		validateVMIForConsole,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			return validateVMIForConsole(vmi, serial)
+		},
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
-			return conn.ConsoleURI(vmi)
+			uri, err := conn.ConsoleURI(vmi)
+			if err != nil || serial == "" {
+				return uri, err
+			}
+			return fmt.Sprintf("%s?serial=%s", uri, url.QueryEscape(serial)), nil
 		}),
 	)
 
 	streamer.Handle(request, response)
 }
 
-func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
-	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
+func validateVMIForConsole(vmi *v1.VirtualMachineInstance, serial string) *errors.StatusError {
+	if serial != "" {
+		if !hasSerialPort(vmi, serial) {
+			err := fmt.Errorf("Serial port %s is not present.", serial)
+			log.Log.Object(vmi).Reason(err).Error("Can't establish a serial console connection.")
+			return errors.NewBadRequest(err.Error())
+		}
+	} else if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
 		err := fmt.Errorf("No serial consoles are present.")
 		log.Log.Object(vmi).Reason(err).Error("Can't establish a serial console connection.")
 		return errors.NewBadRequest(err.Error())
@@ -63,3 +78,12 @@ func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	}
 	return nil
 }
+
+func hasSerialPort(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, serialPort := range vmi.Spec.Domain.Devices.Serials {
+		if serialPort.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -71,7 +72,7 @@ var _ = Describe("Console Subresource api", func() {
 
 	BeforeEach(func() {
 		recorder = httptest.NewRecorder()
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		response = restful.NewResponse(recorder)
 
 		backend := ghttp.NewTLSServer()
@@ -109,6 +110,25 @@ var _ = Describe("Console Subresource api", func() {
 		Entry("should fail if vmi is not running", true, v1.Scheduling),
 	)
 
+	It("should fail if the serial port does not exist", func() {
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		request.Request.URL.RawQuery = "serial=kernel"
+
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+		)
+		vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{{Name: "scada", Port: 1}}
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		app.ConsoleRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(recorder.Body.String()).To(ContainSubstring("Serial port kernel is not present"))
+	})
+
 	It("should fail to connect to the serial console if the VMI is Failed", func() {
 		request.PathParameters()["name"] = testVMIName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
@@ -26,6 +26,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -85,7 +86,7 @@ var _ = Describe("EvacuateCancel Subresource API", func() {
 	config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
 
 	BeforeEach(func() {
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		request.PathParameters()["name"] = testVMName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
 		recorder := httptest.NewRecorder()
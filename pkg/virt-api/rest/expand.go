@@ -55,7 +55,7 @@ func (app *SubresourceAPIApp) ExpandSpecRequestHandler(request *restful.Request,
 
 	validationErrors := definitions.Validator.Validate(v1.VirtualMachineGroupVersionKind, rawObj)
 	if len(validationErrors) > 0 {
-		writeValidationErrors(validationErrors, response)
+		writeError(newValidationError("VirtualMachine", validationErrors), response)
 		return
 	}
 
@@ -110,7 +110,7 @@ func (app *SubresourceAPIApp) expandSpecResponse(vm *v1.VirtualMachine, errorFun
 	}
 }
 
-func writeValidationErrors(validationErrors []error, response *restful.Response) {
+func newValidationError(kind string, validationErrors []error) *errors.StatusError {
 	causes := make([]metav1.StatusCause, 0, len(validationErrors))
 	for _, err := range validationErrors {
 		causes = append(causes, metav1.StatusCause{
@@ -118,8 +118,7 @@ func writeValidationErrors(validationErrors []error, response *restful.Response)
 		})
 	}
 
-	statusError := errors.NewBadRequest("Object is not a valid VirtualMachine")
+	statusError := errors.NewBadRequest(fmt.Sprintf("Object is not a valid %s", kind))
 	statusError.ErrStatus.Details = &metav1.StatusDetails{Causes: causes}
-
-	writeError(statusError, response)
+	return statusError
 }
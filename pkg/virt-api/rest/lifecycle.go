@@ -20,10 +20,15 @@
 package rest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 
@@ -41,8 +46,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
 )
 
+const maxAutoUnpauseTimeout = math.MaxInt32 * time.Second
+
 func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
@@ -303,6 +312,26 @@ func (app *SubresourceAPIApp) PauseVMIRequestHandler(request *restful.Request, r
 			return
 		}
 	}
+	if bodyStruct.AutoUnpauseTimeout != nil {
+		if bodyStruct.AutoUnpauseTimeout.Duration < time.Second {
+			writeError(errors.NewBadRequest("autoUnpauseTimeout must be at least one second"), response)
+			return
+		}
+		// virt-handler passes the timeout to virt-launcher as int32 seconds
+		if bodyStruct.AutoUnpauseTimeout.Duration > maxAutoUnpauseTimeout {
+			writeError(errors.NewBadRequest(fmt.Sprintf("autoUnpauseTimeout must not exceed %v", maxAutoUnpauseTimeout)), response)
+			return
+		}
+	}
+
+	// The original body has been consumed, forward the decoded options to virt-handler
+	body, err := json.Marshal(bodyStruct)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
 	var dryRun bool
 	if len(bodyStruct.DryRun) > 0 && bodyStruct.DryRun[0] == metav1.DryRunAll {
 		dryRun = true
@@ -411,6 +440,54 @@ func (app *SubresourceAPIApp) ResetVMIRequestHandler(request *restful.Request, r
 	app.putRequestHandlerWithErrorPostProcessing(request, response, nil, errorPostProcessing, getURL, false)
 }
 
+// checkDisruptionBudgets rejects disrupting the VMI if this would exceed a
+// VirtualMachineDisruptionBudget of its namespace. VMIs which are shutting
+// down or migrating count as disrupted.
+func (app *SubresourceAPIApp) checkDisruptionBudgets(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	budgetList, err := app.virtCli.VirtualMachineDisruptionBudget(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if len(budgetList.Items) == 0 {
+		return nil
+	}
+
+	vmiList, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	migrationList, err := app.virtCli.VirtualMachineInstanceMigration(vmi.Namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	migrating := map[string]bool{}
+	for _, migration := range migrationList.Items {
+		if !migration.IsFinal() {
+			migrating[migration.Spec.VMIName] = true
+		}
+	}
+	isDisrupted := func(vmi *v1.VirtualMachineInstance) bool {
+		return (vmi.DeletionTimestamp != nil && !vmi.IsFinal()) || migrationutils.IsMigrating(vmi) || migrating[vmi.Name]
+	}
+	// the VMI counts towards its budgets already
+	if isDisrupted(vmi) {
+		return nil
+	}
+
+	budgets, err := vmpolicy.NewDisruptionBudgetsFromLists(budgetList.Items, vmiList.Items, isDisrupted)
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	allowed, err := budgets.Filter([]*v1.VirtualMachineInstance{vmi})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if len(allowed) == 0 {
+		return errors.NewTooManyRequests(fmt.Sprintf("VMI %s can not be disrupted, a VirtualMachineDisruptionBudget of namespace %s is exhausted", vmi.Name, vmi.Namespace), 10)
+	}
+	return nil
+}
+
 func (app *SubresourceAPIApp) RestartVMRequestHandler(request *restful.Request, response *restful.Response) {
 	// RunStrategyHalted         -> doesn't make sense
 	// RunStrategyManual         -> send restart request
@@ -469,6 +546,11 @@ func (app *SubresourceAPIApp) RestartVMRequestHandler(request *restful.Request,
 		return
 	}
 
+	if statusErr := app.checkDisruptionBudgets(vmi); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
 	patchBytes, err := getChangeRequestJson(vm,
 		v1.VirtualMachineStateChangeRequest{Action: v1.StopRequest, UID: &vmi.UID},
 		v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest})
@@ -525,10 +607,8 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 		if condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstancePaused, k8sv1.ConditionTrue) {
 			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
 		}
-		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
-			if features := vmi.Spec.Domain.Features; features != nil && features.ACPI.Enabled != nil && !(*features.ACPI.Enabled) {
-				return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI neither have the agent connected nor the ACPI feature enabled"))
-			}
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) && isACPIDisabled(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI neither have the agent connected nor the ACPI feature enabled"))
 		}
 		return nil
 	}
@@ -540,6 +620,72 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 	app.putRequestHandler(request, response, validate, getURL, false)
 }
 
+func (app *SubresourceAPIApp) RebootVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	bodyStruct := &v1.RebootOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	switch bodyStruct.Method {
+	case "", v1.RebootMethodACPI, v1.RebootMethodGuestAgent:
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("unsupported reboot method %q, must be one of %s, %s", bodyStruct.Method, v1.RebootMethodACPI, v1.RebootMethodGuestAgent)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstancePaused, k8sv1.ConditionTrue) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+		}
+		agentConnected := condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue)
+		switch bodyStruct.Method {
+		case v1.RebootMethodGuestAgent:
+			if !agentConnected {
+				return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have the guest agent connected"))
+			}
+		case v1.RebootMethodACPI:
+			if isACPIDisabled(vmi) {
+				return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have the ACPI feature enabled"))
+			}
+		default:
+			if !agentConnected && isACPIDisabled(vmi) {
+				return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI neither have the agent connected nor the ACPI feature enabled"))
+			}
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.RebootURI(vmi)
+	}
+
+	// The original body has been consumed, forward the decoded options to virt-handler
+	body, err := json.Marshal(bodyStruct)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var dryRun bool
+	if len(bodyStruct.DryRun) > 0 && bodyStruct.DryRun[0] == metav1.DryRunAll {
+		dryRun = true
+	}
+	app.putRequestHandler(request, response, validate, getURL, dryRun)
+}
+
+func isACPIDisabled(vmi *v1.VirtualMachineInstance) bool {
+	features := vmi.Spec.Domain.Features
+	return features != nil && features.ACPI.Enabled != nil && !(*features.ACPI.Enabled)
+}
+
 func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request, response *restful.Response) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
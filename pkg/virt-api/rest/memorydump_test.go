@@ -27,6 +27,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -122,7 +123,7 @@ var _ = Describe("Memory dump Subresource api", func() {
 	}
 
 	BeforeEach(func() {
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		request.PathParameters()["name"] = testVMName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
 		recorder := httptest.NewRecorder()
@@ -306,6 +307,16 @@ var _ = Describe("Memory dump Subresource api", func() {
 				ClaimName: testPVCName,
 				Phase:     v1.MemoryDumpCompleted,
 			}, http.StatusConflict),
+		Entry("VM with a memory dump request in State format without the SnapshotMemory feature gate should fail",
+			&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Format:    v1.MemoryDumpFormatState,
+			}, nil, http.StatusBadRequest),
+		Entry("VM with a memory dump request in an unknown format should fail",
+			&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: testPVCName,
+				Format:    "Unknown",
+			}, nil, http.StatusBadRequest),
 	)
 
 	DescribeTable("Should generate expected vm patch", func(memDumpReq *v1.VirtualMachineMemoryDumpRequest, existingMemDumpReq *v1.VirtualMachineMemoryDumpRequest, expectedPatchSet *patch.PatchSet, expectError bool, removeReq bool) {
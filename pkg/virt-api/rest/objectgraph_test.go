@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -90,7 +91,7 @@ var _ = Describe("Object Graph", func() {
 		)
 
 		BeforeEach(func() {
-			request = restful.NewRequest(&http.Request{})
+			request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 			request.PathParameters()["name"] = testVMName
 			request.PathParameters()["namespace"] = metav1.NamespaceDefault
 			recorder = httptest.NewRecorder()
@@ -36,11 +36,14 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 )
 
 const (
 	vmiNoAttestationErr = "Attestation not requested for VMI"
+	vmiNoSEVSNPErr      = "VMI does not use SEV-SNP"
 )
 
 func (app *SubresourceAPIApp) ensureSEVEnabled(response *restful.Response) bool {
@@ -85,6 +88,41 @@ func (app *SubresourceAPIApp) SEVQueryLaunchMeasurementHandler(request *restful.
 	app.httpGetRequestHandler(request, response, validateVMIForSEVAttestation, getURL, v1.SEVMeasurementInfo{})
 }
 
+func (app *SubresourceAPIApp) SEVSNPAttestationReportHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureSEVEnabled(response) {
+		return
+	}
+
+	nonce := request.QueryParameter(definitions.NonceParamName)
+	if err := launchsecurity.ValidateSEVSNPAttestationReportOptions(&v1.SEVSNPAttestationReportOptions{Nonce: nonce}); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !kutil.IsSEVSNPVMI(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoSEVSNPErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		url, err := conn.SEVSNPAttestationReportURI(vmi)
+		if err != nil {
+			return "", err
+		}
+		if nonce != "" {
+			url += "?" + definitions.NonceParamName + "=" + nonce
+		}
+		return url, nil
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.SEVSNPAttestationReport{})
+}
+
 func (app *SubresourceAPIApp) SEVSetupSessionHandler(request *restful.Request, response *restful.Response) {
 	if !app.ensureSEVEnabled(response) {
 		return
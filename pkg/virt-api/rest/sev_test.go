@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -80,7 +81,7 @@ var _ = Describe("SEV Subresources", func() {
 	config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
 
 	BeforeEach(func() {
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		request.PathParameters()["name"] = testVMIName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
 		recorder := httptest.NewRecorder()
@@ -212,6 +213,43 @@ var _ = Describe("SEV Subresources", func() {
 		Entry("when attestation is not requested ", Running, Paused),
 	)
 
+	It("Should allow to fetch the SEV-SNP attestation report when VMI is running", func() {
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/sev/attestationreport", "nonce=c0ffee"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.SEVSNPAttestationReport{Report: "AAAA", Nonce: "c0ffee"}),
+			),
+		)
+		request.Request.URL = &url.URL{RawQuery: "nonce=c0ffee"}
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		createVMI(Running, UnPaused, []libvmi.Option{libvmi.WithSEV(false, true)}, nil)
+		app.SEVSNPAttestationReportHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	DescribeTable("Should fail to fetch the SEV-SNP attestation report",
+		func(running bool, option libvmi.Option) {
+			request.Request.URL = &url.URL{}
+			createVMI(running, UnPaused, []libvmi.Option{option}, nil)
+			app.SEVSNPAttestationReportHandler(request, response)
+			Expect(response.Error()).To(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+		},
+		Entry("when VMI is not running", NotRunning, libvmi.WithSEV(false, true)),
+		Entry("when VMI does not use SEV-SNP", Running, libvmi.WithSEV(true, false)),
+	)
+
+	DescribeTable("Should reject an invalid nonce for the SEV-SNP attestation report", func(nonce string) {
+		request.Request.URL = &url.URL{RawQuery: "nonce=" + nonce}
+		app.SEVSNPAttestationReportHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	},
+		Entry("which is not hex encoded", "coffee"),
+		Entry("which is too long", strings.Repeat("ab", 65)),
+	)
+
 	It("Should allow to setup SEV session parameters for a paused VMI", func() {
 		sevSessionOptions := &v1.SEVSessionOptions{
 			Session: "AAABBB",
@@ -20,11 +20,13 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"time"
 
 	"github.com/emicklei/go-restful/v3"
@@ -41,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype/expand"
 	"kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferenceFind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -352,12 +355,38 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// decodeBody decodes the JSON or YAML body of a request into bodyStruct. With
+// strict field validation, the body is validated against the OpenAPI definition
+// of its type first. An empty or null body leaves bodyStruct untouched.
 func decodeBody(request *restful.Request, bodyStruct interface{}) *errors.StatusError {
-	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(&bodyStruct)
-	switch err {
-	case io.EOF, nil:
+	data, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
 		return nil
-	default:
+	}
+	data, err = yaml.ToJSON(data)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+
+	if request.QueryParameter(definitions.FieldValidationParamName) == definitions.FieldValidationStrict {
+		var rawObj interface{}
+		if err := json.Unmarshal(data, &rawObj); err != nil {
+			return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+		}
+		if rawObj == nil {
+			return nil
+		}
+		kind := reflect.TypeOf(bodyStruct).Elem().Name()
+		if validationErrors := definitions.Validator.ValidateBody("v1."+kind, rawObj); len(validationErrors) > 0 {
+			return newValidationError(kind, validationErrors)
+		}
+	}
+
+	if err := json.Unmarshal(data, bodyStruct); err != nil {
 		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
 	}
+	return nil
 }
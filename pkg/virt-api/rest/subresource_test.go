@@ -28,6 +28,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -50,8 +51,10 @@ import (
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
@@ -92,6 +95,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 	var vmClient *kubecli.MockVirtualMachineInterface
 	var vmiClient *kubecli.MockVirtualMachineInstanceInterface
 	var migrateClient *kubecli.MockVirtualMachineInstanceMigrationInterface
+	var kubevirtClient *kubevirtfake.Clientset
 
 	gracePeriodZero := pointer.P(int64(0))
 
@@ -120,6 +124,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
 		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 		migrateClient = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
 
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmClient).AnyTimes()
@@ -127,6 +132,9 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance("").Return(vmiClient).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrateClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineDisruptionBudget(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.PolicyV1alpha1().VirtualMachineDisruptionBudgets(namespace)
+		}).AnyTimes()
 
 		backend = ghttp.NewTLSServer()
 		backendAddr := strings.Split(backend.Addr(), ":")
@@ -144,7 +152,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Timeout: 10 * time.Second,
 		}
 
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		recorder = httptest.NewRecorder()
 		response = restful.NewResponse(recorder)
 		// Make sure that any unexpected call to the client will fail
@@ -420,6 +428,57 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 				Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
 			})
 
+			Context("with a VirtualMachineDisruptionBudget", func() {
+				BeforeEach(func() {
+					_, err := kubevirtClient.PolicyV1alpha1().VirtualMachineDisruptionBudgets(k8smetav1.NamespaceDefault).Create(context.Background(),
+						&policyv1alpha1.VirtualMachineDisruptionBudget{
+							ObjectMeta: k8smetav1.ObjectMeta{Name: "budget", Namespace: k8smetav1.NamespaceDefault},
+							Spec: policyv1alpha1.VirtualMachineDisruptionBudgetSpec{
+								Selector:       &k8smetav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+								MaxDisruptions: 1,
+							},
+						}, k8smetav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				newSelectedVMI := func(name string) *v1.VirtualMachineInstance {
+					vmi := libvmi.New(libvmi.WithName(name), libvmi.WithNamespace(k8smetav1.NamespaceDefault), libvmi.WithLabel("app", "db"))
+					vmi.UID = uuid.NewUUID()
+					return vmi
+				}
+
+				restart := func(migrations ...v1.VirtualMachineInstanceMigration) {
+					request.PathParameters()["name"] = testVMName
+					request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+
+					vm := newVirtualMachineWithRunning(pointer.P(Running))
+					vmi := newSelectedVMI(testVMName)
+					vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+					vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vmi, nil)
+					vmiClient.EXPECT().List(context.Background(), k8smetav1.ListOptions{}).Return(&v1.VirtualMachineInstanceList{
+						Items: []v1.VirtualMachineInstance{*vmi, *newSelectedVMI("other")},
+					}, nil)
+					migrateClient.EXPECT().List(context.Background(), k8smetav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{Items: migrations}, nil)
+					vmClient.EXPECT().PatchStatus(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), k8smetav1.PatchOptions{}).Return(vm, nil).MaxTimes(1)
+
+					app.RestartVMRequestHandler(request, response)
+				}
+
+				It("should restart the VirtualMachine if the budget allows it", func() {
+					restart()
+					Expect(response.Error()).ToNot(HaveOccurred())
+					Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+				})
+
+				It("should not restart the VirtualMachine if the budget is exhausted", func() {
+					restart(v1.VirtualMachineInstanceMigration{
+						ObjectMeta: k8smetav1.ObjectMeta{Name: "migration", Namespace: k8smetav1.NamespaceDefault},
+						Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: "other"},
+					})
+					ExpectStatusErrorWithCode(recorder, http.StatusTooManyRequests)
+				})
+			})
+
 			It("should start VirtualMachine if VMI doesn't exist", func() {
 				request.PathParameters()["name"] = testVMName
 				request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
@@ -1254,18 +1313,82 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Reboot", func() {
+		DescribeTable("Should reboot a running VMI", func(rebootOptions *v1.RebootOptions, wrapFunctions ...func(vmi *v1.VirtualMachineInstance)) {
+			bytesRepresentation, _ := json.Marshal(rebootOptions)
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/reboot"),
+					ghttp.VerifyBody(bytesRepresentation),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+
+			expectVMI(Running, UnPaused, wrapFunctions...)
+
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.RebootVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(backend.ReceivedRequests()).To(HaveLen(1))
+		},
+			Entry("with default method", &v1.RebootOptions{}, guestAgentConnected),
+			Entry("with ACPI method", &v1.RebootOptions{Method: v1.RebootMethodACPI}),
+			Entry("with guest agent method", &v1.RebootOptions{Method: v1.RebootMethodGuestAgent}, guestAgentConnected),
+		)
+
+		It("Should not propagate a dry-run reboot", func() {
+			expectVMI(Running, UnPaused, guestAgentConnected)
+
+			bytesRepresentation, _ := json.Marshal(&v1.RebootOptions{DryRun: withDryRun()})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.RebootVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(backend.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Should reject an unknown reboot method", func() {
+			bytesRepresentation, _ := json.Marshal(&v1.RebootOptions{Method: "PowerCycle"})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.RebootVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		DescribeTable("Should fail to reboot", func(running, paused bool, rebootOptions *v1.RebootOptions, wrapFunctions ...func(vmi *v1.VirtualMachineInstance)) {
+			expectVMI(running, paused, wrapFunctions...)
+
+			bytesRepresentation, _ := json.Marshal(rebootOptions)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.RebootVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			Entry("a not running VMI", NotRunning, UnPaused, &v1.RebootOptions{}, guestAgentConnected),
+			Entry("a paused VMI", Running, Paused, &v1.RebootOptions{}, guestAgentConnected),
+			Entry("a VMI without agent and ACPI", Running, UnPaused, &v1.RebootOptions{}, ACPIDisabled),
+			Entry("a VMI without agent using the guest agent method", Running, UnPaused, &v1.RebootOptions{Method: v1.RebootMethodGuestAgent}),
+			Entry("a VMI without ACPI using the ACPI method", Running, UnPaused, &v1.RebootOptions{Method: v1.RebootMethodACPI}, guestAgentConnected, ACPIDisabled),
+		)
+	})
+
 	Context("Pausing", func() {
 		DescribeTable("Should pause a running, not paused VMI according to options", func(pauseOptions *v1.PauseOptions, matchExpectation gomegatypes.GomegaMatcher) {
-
+			bytesRepresentation, _ := json.Marshal(pauseOptions)
 			backend.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/pause"),
+					ghttp.VerifyBody(bytesRepresentation),
 					ghttp.RespondWith(http.StatusOK, ""),
 				),
 			)
 			expectVMI(Running, UnPaused)
 
-			bytesRepresentation, _ := json.Marshal(pauseOptions)
 			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
 
 			app.PauseVMIRequestHandler(request, response)
@@ -1276,6 +1399,19 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		},
 			Entry("with default", &v1.PauseOptions{}, HaveLen(1)),
 			Entry("with dry-run option", &v1.PauseOptions{DryRun: withDryRun()}, BeNil()),
+			Entry("with auto-unpause timeout", &v1.PauseOptions{AutoUnpauseTimeout: &k8smetav1.Duration{Duration: time.Hour}}, HaveLen(1)),
+		)
+
+		DescribeTable("Should reject an auto-unpause timeout out of bounds", func(timeout time.Duration) {
+			bytesRepresentation, _ := json.Marshal(&v1.PauseOptions{AutoUnpauseTimeout: &k8smetav1.Duration{Duration: timeout}})
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+
+			app.PauseVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("below one second", time.Millisecond),
+			Entry("above the int32 seconds virt-launcher accepts", maxAutoUnpauseTimeout+time.Second),
 		)
 
 		withLivenessProbe := func(vmi *v1.VirtualMachineInstance) {
@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/tpm"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	vmiNoTPMErr  = "VMI does not have a TPM device"
+	vmiTPM12Err  = "VMI has a TPM 1.2 device, attestation requires TPM 2.0"
+	vmiNoUEFIErr = "VMI does not boot with UEFI"
+)
+
+func (app *SubresourceAPIApp) TPMAttestationRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.TPMAttestationEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.TPMAttestationGate)), response)
+		return
+	}
+
+	opts, err := tpm.ParseAttestationOptions(request.QueryParameter(definitions.NonceParamName), request.QueryParameter(definitions.PCRsParamName), request.QueryParameter(definitions.CredentialParamName))
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !tpm.HasDevice(&vmi.Spec) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoTPMErr))
+		}
+		if tpm.Version(&vmi.Spec) != v1.TPMVersion20 {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiTPM12Err))
+		}
+		if firmware := vmi.Spec.Domain.Firmware; firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoUEFIErr))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		url, err := conn.TPMAttestationURI(vmi)
+		if err != nil {
+			return "", err
+		}
+		if query := tpm.EncodeAttestationOptions(opts); query != "" {
+			url += "?" + query
+		}
+		return url, nil
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.TPMAttestation{})
+}
@@ -0,0 +1,179 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("TPM Subresources", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend     *ghttp.Server
+		backendPort int
+		request     *restful.Request
+		response    *restful.Response
+		kubeClient  *fake.Clientset
+		virtClient  *kubevirtfake.Clientset
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		return NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder := httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		var err error
+		backendPort, err = strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		}
+		kubeClient = fake.NewSimpleClientset(pod)
+		virtClient = kubevirtfake.NewSimpleClientset()
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	createVMI := func(running, agentConnected bool, opts ...libvmi.Option) {
+		phase := v1.Running
+		if !running {
+			phase = v1.Failed
+		}
+		status := []libvmistatus.Option{
+			libvmistatus.WithPhase(phase),
+			libvmistatus.WithNodeName(nodeName),
+		}
+		if agentConnected {
+			status = append(status, libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAgentConnected,
+				Status: k8sv1.ConditionTrue,
+			}))
+		}
+		vmi := libvmi.New(append([]libvmi.Option{
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(status...)),
+		}, opts...)...)
+
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should pass the attestation options to virt-handler", func() {
+		request.Request.URL.RawQuery = "nonce=c0ffee&pcrs=7,0"
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/tpm/attestation", "nonce=c0ffee&pcrs=7%2C0"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.TPMAttestation{Nonce: "c0ffee"}),
+			),
+		)
+		createVMI(Running, true, libvmi.WithTPM(false), libvmi.WithUefi(false))
+
+		newApp(featuregate.TPMAttestationGate).TPMAttestationRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+	})
+
+	It("should fail when the feature gate is disabled", func() {
+		createVMI(Running, true, libvmi.WithTPM(false), libvmi.WithUefi(false))
+
+		newApp().TPMAttestationRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject invalid attestation options", func() {
+		request.Request.URL.RawQuery = "nonce=coffee"
+		createVMI(Running, true, libvmi.WithTPM(false), libvmi.WithUefi(false))
+
+		newApp(featuregate.TPMAttestationGate).TPMAttestationRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	DescribeTable("should fail to fetch the attestation", func(running, agentConnected bool, expectedErr string, opts ...libvmi.Option) {
+		createVMI(running, agentConnected, opts...)
+
+		newApp(featuregate.TPMAttestationGate).TPMAttestationRequestHandler(request, response)
+		Expect(response.Error()).To(MatchError(ContainSubstring(expectedErr)))
+		Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+	},
+		Entry("when the VMI is not running", NotRunning, true, vmiNotRunning, libvmi.WithTPM(false), libvmi.WithUefi(false)),
+		Entry("when the VMI has no TPM", Running, true, vmiNoTPMErr, libvmi.WithUefi(false)),
+		Entry("when the VMI has a TPM 1.2", Running, true, vmiTPM12Err, libvmi.WithTPM(false), libvmi.WithUefi(false),
+			func(vmi *v1.VirtualMachineInstance) { vmi.Spec.Domain.Devices.TPM.Version = v1.TPMVersion12 }),
+		Entry("when the VMI does not boot with UEFI", Running, true, vmiNoUEFIErr, libvmi.WithTPM(false)),
+		Entry("when the guest agent is not connected", Running, false, vmiGuestAgentErr, libvmi.WithTPM(false), libvmi.WithUefi(false)),
+	)
+})
@@ -20,7 +20,10 @@
 package rest
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 
 	restful "github.com/emicklei/go-restful/v3"
@@ -31,9 +34,12 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
+const vmiNoResizableDisplayErr = "VMI display can not be resized, a virtio video device is required"
+
 func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, response *restful.Response) {
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
@@ -73,6 +79,48 @@ func (app *SubresourceAPIApp) ScreenshotRequestHandler(request *restful.Request,
 	app.httpGetRequestBinaryHandler(request, response, vmiHasDisplay, getURL)
 }
 
+func (app *SubresourceAPIApp) VNCResolutionRequestHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: resolution is required"), response)
+		return
+	}
+
+	opts := &v1.VNCResolutionOptions{}
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.Width == 0 || opts.Width > kutil.MaxDisplayWidth || opts.Height == 0 || opts.Height > kutil.MaxDisplayHeight {
+		writeError(errors.NewBadRequest(fmt.Sprintf("resolution must be between 1x1 and %dx%d", kutil.MaxDisplayWidth, kutil.MaxDisplayHeight)), response)
+		return
+	}
+
+	// The original body has been consumed, forward the decoded options to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if statusErr := vmiHasDisplay(vmi); statusErr != nil {
+			return statusErr
+		}
+		if !kutil.HasResizableDisplay(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNoResizableDisplayErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.VNCResolutionURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
 func vmiHasDisplay(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	// If there are no graphics devices present, we can't proceed
 	if vmi.Spec.Domain.Devices.AutoattachGraphicsDevice != nil && !*vmi.Spec.Domain.Devices.AutoattachGraphicsDevice {
@@ -20,11 +20,14 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -33,8 +36,10 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -75,7 +80,7 @@ var _ = Describe("VNC Subresource api", func() {
 
 	BeforeEach(func() {
 		recorder = httptest.NewRecorder()
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		response = restful.NewResponse(recorder)
 
 		backend := ghttp.NewTLSServer()
@@ -155,3 +160,137 @@ var _ = Describe("VNC Subresource api", func() {
 		Entry("should fail if vmi is not running", true, v1.Scheduling),
 	)
 })
+
+var _ = Describe("VNC resolution Subresource api", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend    *ghttp.Server
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+	)
+
+	config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubevirt",
+			Namespace: "kubevirt",
+		},
+		Status: v1.KubeVirtStatus{
+			Phase: v1.KubeVirtPhaseDeploying,
+		},
+	})
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		backend = ghttp.NewTLSServer()
+		DeferCleanup(backend.Close)
+		backendAddr := strings.Split(backend.Addr(), ":")
+		backendPort, err := strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app = NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	createVMI := func(opts ...libvmi.Option) {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithNodeName(nodeName),
+			)),
+		}, opts...)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), libvmi.New(opts...), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	setBody := func(options *v1.VNCResolutionOptions) {
+		body, err := json.Marshal(options)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	It("should forward the resolution to virt-handler", func() {
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/vnc/resolution"),
+				ghttp.VerifyBody([]byte(`{"width":1920,"height":1080}`)),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			),
+		)
+		setBody(&v1.VNCResolutionOptions{Width: 1920, Height: 1080})
+		createVMI(libvmi.WithVideo(v1.VirtIO))
+
+		app.VNCResolutionRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(backend.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("should fail without a request body", func() {
+		app.VNCResolutionRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	DescribeTable("should reject an invalid resolution", func(width, height uint32) {
+		setBody(&v1.VNCResolutionOptions{Width: width, Height: height})
+		createVMI(libvmi.WithVideo(v1.VirtIO))
+
+		app.VNCResolutionRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal("resolution must be between 1x1 and 2560x2048"))
+		Expect(backend.ReceivedRequests()).To(BeEmpty())
+	},
+		Entry("with a zero width", uint32(0), uint32(768)),
+		Entry("with a zero height", uint32(1024), uint32(0)),
+		Entry("wider than the largest display", uint32(4096), uint32(768)),
+		Entry("higher than the largest display", uint32(1024), uint32(4096)),
+	)
+
+	It("should fail when the video device can not be resized", func() {
+		setBody(&v1.VNCResolutionOptions{Width: 1920, Height: 1080})
+		createVMI(libvmi.WithVideo("vga"))
+
+		app.VNCResolutionRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		Expect(backend.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("should fail when there is no graphics device", func() {
+		setBody(&v1.VNCResolutionOptions{Width: 1920, Height: 1080})
+		createVMI(libvmi.WithAutoattachGraphicsDevice(false))
+
+		app.VNCResolutionRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal("No graphics devices are present."))
+	})
+})
@@ -34,6 +34,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 )
 
 const (
@@ -108,6 +109,12 @@ func (app *SubresourceAPIApp) addVolumeRequestHandler(request *restful.Request,
 		opts.VolumeSource.DataVolume.Hotpluggable = true
 	} else if opts.VolumeSource.PersistentVolumeClaim != nil {
 		opts.VolumeSource.PersistentVolumeClaim.Hotpluggable = true
+	} else if opts.VolumeSource.ConfigMap != nil {
+		opts.VolumeSource.ConfigMap.Hotpluggable = true
+	} else if opts.VolumeSource.Secret != nil {
+		opts.VolumeSource.Secret.Hotpluggable = true
+	} else if opts.VolumeSource.ServiceAccount != nil {
+		opts.VolumeSource.ServiceAccount.Hotpluggable = true
 	}
 
 	// inject into VMI if ephemeral, else set as a request on the VM to both make permanent and hotplug.
@@ -373,7 +380,8 @@ func generateVolumeRequestPatch(prefix string, vmiSpec *v1.VirtualMachineInstanc
 }
 
 func volumeHotpluggable(volume v1.Volume) bool {
-	return (volume.DataVolume != nil && volume.DataVolume.Hotpluggable) || (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable)
+	return (volume.DataVolume != nil && volume.DataVolume.Hotpluggable) || (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable) ||
+		storagetypes.IsHotplugConfigVolume(&volume)
 }
 
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) ([]byte, error) {
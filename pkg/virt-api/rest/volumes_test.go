@@ -27,6 +27,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"slices"
 	"strconv"
 	"strings"
@@ -90,7 +91,7 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 	}
 
 	BeforeEach(func() {
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		request.PathParameters()["name"] = testVMName
 		request.PathParameters()["namespace"] = metav1.NamespaceDefault
 		recorder := httptest.NewRecorder()
@@ -379,6 +380,36 @@ var _ = Describe("Add/Remove Volume Subresource api", func() {
 		}, http.StatusAccepted, featuregate.HotplugVolumesGate, featuregate.DeclarativeHotplugVolumesGate),
 	)
 
+	It("Should reject add volume requests which do not match the API on strict field validation and report the invalid fields", func() {
+		enableFeatureGates(featuregate.HotplugVolumesGate)
+		recorder := httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		request.Request.URL.RawQuery = "fieldValidation=Strict"
+		request.Request.Body = &readCloserWrapper{bytes.NewReader([]byte(`{"name":"vol1","disk":{"name":""},"volumeSource":{},"bootOrder":1}`))}
+
+		app.VMIAddVolumeRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		status := &metav1.Status{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), status)).To(Succeed())
+		Expect(status.Message).To(Equal("Object is not a valid AddVolumeOptions"))
+		Expect(status.Details.Causes).To(ConsistOf(metav1.StatusCause{Message: "body.bootOrder in body is a forbidden property"}))
+	})
+
+	It("Should ignore unknown fields of add volume requests without strict field validation", func() {
+		enableFeatureGates(featuregate.HotplugVolumesGate)
+		recorder := httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		request.Request.Body = &readCloserWrapper{bytes.NewReader([]byte(`{"disk":{},"volumeSource":{},"bootOrder":1}`))}
+
+		app.VMIAddVolumeRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		status := &metav1.Status{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), status)).To(Succeed())
+		Expect(status.Message).To(Equal("AddVolumeOptions requires name to be set"))
+	})
+
 	DescribeTable("Should generate expected vmi patch", func(volumeRequest *v1.VirtualMachineVolumeRequest, expectedPatchSet *patch.PatchSet) {
 
 		vmi := api.NewMinimalVMI(request.PathParameter("name"))
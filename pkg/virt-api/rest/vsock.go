@@ -32,20 +32,22 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
-func (app *SubresourceAPIApp) VSOCKRequestHandler(request *restful.Request, response *restful.Response) {
-	streamer := NewRawStreamer(
-		app.FetchVirtualMachineInstance,
-		validateVMIForVSOCK,
-		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
-			tls := "true"
-			if request.QueryParameter("tls") != "" {
-				tls = request.QueryParameter("tls")
-			}
-			return conn.VSOCKURI(vmi, request.QueryParameter("port"), tls)
-		}),
-	)
-
-	streamer.Handle(request, response)
+func (app *SubresourceAPIApp) VSOCKRequestHandler(fetcher vmiFetcher) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		streamer := NewRawStreamer(
+			fetcher,
+			validateVMIForVSOCK,
+			app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+				tls := "true"
+				if request.QueryParameter("tls") != "" {
+					tls = request.QueryParameter("tls")
+				}
+				return conn.VSOCKURI(vmi, request.QueryParameter("port"), tls)
+			}),
+		)
+
+		streamer.Handle(request, response)
+	}
 }
 
 func validateVMIForVSOCK(vmi *v1.VirtualMachineInstance) *errors.StatusError {
@@ -20,8 +20,12 @@
 package webhooks
 
 import (
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
+	"net/url"
 	"slices"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,9 +41,24 @@ func ValidateVirtualMachineInstanceAmd64Setting(field *k8sfield.Path, spec *v1.V
 	var statusCauses []metav1.StatusCause
 	validateWatchdogAmd64(field, spec, &statusCauses)
 	validateVideoTypeAmd64(field, spec, &statusCauses)
+	validateIOMMUAmd64(field, spec, &statusCauses)
 	return statusCauses
 }
 
+func validateIOMMUAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.IOMMU == nil {
+		return
+	}
+
+	if machine := spec.Domain.Machine; machine != nil && machine.Type != "" && !strings.Contains(machine.Type, "q35") {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("IOMMU is not supported by machine type '%s' on amd64 architecture, a q35 machine is required", machine.Type),
+			Field:   field.Child("domain", "devices", "iommu").String(),
+		})
+	}
+}
+
 func validateVideoTypeAmd64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
 	if spec.Domain.Devices.Video == nil {
 		return
@@ -159,6 +178,13 @@ func ValidateLaunchSecurityAmd64(field *k8sfield.Path, spec *v1.VirtualMachineIn
 				})
 			}
 		}
+		if launchSecurity.SEV != nil && launchSecurity.SEV.Attestation != nil && launchSecurity.SEV.Attestation.KeyBroker != nil {
+			causes = append(causes, validateKeyBroker(field.Child("launchSecurity", "sev", "attestation", "keyBroker"), launchSecurity.SEV.Attestation.KeyBroker)...)
+		}
+
+		if launchSecurity.TDX != nil {
+			causes = append(causes, validateTDXMeasurements(field.Child("launchSecurity", "tdx"), launchSecurity.TDX)...)
+		}
 
 		for _, iface := range spec.Domain.Devices.Interfaces {
 			if iface.BootOrder != nil {
@@ -173,3 +199,55 @@ func ValidateLaunchSecurityAmd64(field *k8sfield.Path, spec *v1.VirtualMachineIn
 
 	return causes
 }
+
+func validateKeyBroker(field *k8sfield.Path, keyBroker *v1.KeyBroker) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if keyBroker.Protocol != "" && keyBroker.Protocol != v1.KeyBrokerProtocolKBS {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("key broker protocol %s is not supported, supported protocols: %s", keyBroker.Protocol, v1.KeyBrokerProtocolKBS),
+			Field:   field.Child("protocol").String(),
+		})
+	}
+	if u, err := url.Parse(keyBroker.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "url must be an absolute http or https URL",
+			Field:   field.Child("url").String(),
+		})
+	}
+	if strings.Trim(keyBroker.ResourcePath, "/") == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "resourcePath is required",
+			Field:   field.Child("resourcePath").String(),
+		})
+	}
+	return causes
+}
+
+func validateTDXMeasurements(field *k8sfield.Path, tdx *v1.TDX) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	measurements := []struct {
+		name  string
+		value string
+	}{
+		{"mrConfigId", tdx.MrConfigID},
+		{"mrOwner", tdx.MrOwner},
+		{"mrOwnerConfig", tdx.MrOwnerConfig},
+	}
+	for _, measurement := range measurements {
+		if measurement.value == "" {
+			continue
+		}
+		// The measurement registers hold a SHA384 digest
+		if digest, err := base64.StdEncoding.DecodeString(measurement.value); err != nil || len(digest) != sha512.Size384 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a base64 encoded SHA384 digest", measurement.name),
+				Field:   field.Child(measurement.name).String(),
+			})
+		}
+	}
+	return causes
+}
@@ -42,6 +42,12 @@ func ValidateVirtualMachineInstanceArm64Setting(field *k8sfield.Path, spec *v1.V
 	validateWatchdog(field, spec, &statusCauses)
 	validateSoundDevice(field, spec, &statusCauses)
 	validateVideoTypeArm64(field, spec, &statusCauses)
+	validateMachineOptions(field, spec, &statusCauses)
+	validateIOMMUModelArm64(field, spec, &statusCauses)
+	validateNestedVirtualization(field, spec, &statusCauses)
+	validateKVMHints(field, spec, &statusCauses)
+	validateSerialPortsArm64(field, spec, &statusCauses)
+	validateSGXArm64(field, spec, &statusCauses)
 	return statusCauses
 }
 
@@ -151,3 +157,76 @@ func validateSoundDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 		})
 	}
 }
+
+func validateMachineOptions(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.MachineOptions != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 not support machine options",
+			Field:   field.Child("domain", "machineOptions").String(),
+		})
+	}
+}
+
+func validateIOMMUModelArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.IOMMU != nil && spec.Domain.Devices.IOMMU.Model == v1.IOMMUModelIntel {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 only support virtio IOMMU model",
+			Field:   field.Child("domain", "devices", "iommu", "model").String(),
+		})
+	}
+}
+
+func validateNestedVirtualization(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NestedVirtualization != nil && *spec.Domain.CPU.NestedVirtualization {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 not support nested virtualization",
+			Field:   field.Child("domain", "cpu", "nestedVirtualization").String(),
+		})
+	}
+}
+
+func validateKVMHints(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Features == nil || spec.Domain.Features.KVM == nil {
+		return
+	}
+	kvmField := field.Child("domain", "features", "kvm")
+	if spec.Domain.Features.KVM.HintDedicated != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 not support KVM hint-dedicated",
+			Field:   kvmField.Child("hintDedicated").String(),
+		})
+	}
+	if spec.Domain.Features.KVM.PollControl != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 not support KVM poll-control",
+			Field:   kvmField.Child("pollControl").String(),
+		})
+	}
+}
+
+func validateSerialPortsArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	for idx, serialPort := range spec.Domain.Devices.Serials {
+		if serialPort.Port != 0 {
+			*statusCauses = append(*statusCauses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "only serial port 0 is supported on arm64 architecture",
+				Field:   field.Child("domain", "devices", "serials").Index(idx).Child("port").String(),
+			})
+		}
+	}
+}
+
+func validateSGXArm64(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.SGX != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "Arm64 does not support SGX",
+			Field:   field.Child("domain", "devices", "sgx").String(),
+		})
+	}
+}
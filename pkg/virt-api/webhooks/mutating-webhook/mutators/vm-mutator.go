@@ -26,6 +26,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -34,9 +35,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
 )
 
 type instancetypeVMsMutator interface {
@@ -47,13 +50,15 @@ type VMsMutator struct {
 	ClusterConfig       *virtconfig.ClusterConfig
 	instancetypeMutator instancetypeVMsMutator
 	virtClient          kubecli.KubevirtClient
+	VMPolicyInformer    cache.SharedIndexInformer
 }
 
-func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) *VMsMutator {
+func NewVMsMutator(clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient, informers *webhooks.Informers) *VMsMutator {
 	return &VMsMutator{
 		ClusterConfig:       clusterConfig,
 		instancetypeMutator: instancetypeVMWebhooks.NewMutator(virtCli),
 		virtClient:          virtCli,
+		VMPolicyInformer:    informers.VMPolicyInformer,
 	}
 }
 
@@ -87,9 +92,17 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	// On update, the mutator does not modify the UUID field to avoid
 	// race conditions with the VM controller.
 	if ar.Request.Operation == admissionv1.Create {
-		setFirmwareDefaultsIfEmpty(vm)
+		if mutator.ClusterConfig.SealedVMDefaultsEnabled() {
+			sealFirmwareDefaultsIfEmpty(vm)
+		} else {
+			setFirmwareDefaultsIfEmpty(vm)
+		}
+		mutator.setPolicyRunStrategyIfEmpty(vm)
 	}
 
+	// Sealed defaults are kept for the lifetime of the VM, even if an update does not include them
+	defaults.KeepSealedDefaults(vm, oldVM)
+
 	// Set VM defaults
 	log.Log.Object(vm).V(4).Info("Apply defaults")
 
@@ -118,6 +131,26 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	}
 }
 
+// setPolicyRunStrategyIfEmpty sets the default run strategy of the
+// VirtualMachinePolicies of the namespace on VMs created without one
+func (mutator *VMsMutator) setPolicyRunStrategyIfEmpty(vm *v1.VirtualMachine) {
+	if mutator.VMPolicyInformer == nil || !mutator.ClusterConfig.VirtualMachinePoliciesEnabled() {
+		return
+	}
+	if vm.Spec.Running != nil || vm.Spec.RunStrategy != nil {
+		return
+	}
+
+	policies, err := vmpolicy.List(mutator.VMPolicyInformer.GetIndexer(), vm.Namespace)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Errorf("Failed to list the VirtualMachinePolicies of namespace %s", vm.Namespace)
+		return
+	}
+	if runStrategy := vmpolicy.DefaultRunStrategy(policies); runStrategy != nil {
+		vm.Spec.RunStrategy = pointer.P(*runStrategy)
+	}
+}
+
 func setFirmwareDefaultsIfEmpty(vm *v1.VirtualMachine) {
 	if vm.Spec.Template.Spec.Domain.Firmware == nil {
 		vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{}
@@ -132,3 +165,26 @@ func setFirmwareDefaultsIfEmpty(vm *v1.VirtualMachine) {
 		fw.Serial = uuid.New().String()
 	}
 }
+
+func sealFirmwareDefaultsIfEmpty(vm *v1.VirtualMachine) {
+	var fw v1.Firmware
+	if vm.Spec.Template.Spec.Domain.Firmware != nil {
+		fw = *vm.Spec.Template.Spec.Domain.Firmware
+	}
+	if fw.UUID != "" && fw.Serial != "" {
+		return
+	}
+
+	if vm.Spec.Sealed == nil {
+		vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{}
+	}
+	sealed := vm.Spec.Sealed
+
+	if fw.UUID == "" && sealed.FirmwareUUID == "" {
+		sealed.FirmwareUUID = types.UID(uuid.New().String())
+	}
+
+	if fw.Serial == "" && sealed.FirmwareSerial == "" {
+		sealed.FirmwareSerial = uuid.New().String()
+	}
+}
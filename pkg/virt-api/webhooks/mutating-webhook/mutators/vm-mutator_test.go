@@ -39,6 +39,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	apiinstancetype "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -48,7 +49,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	instancetypeVMWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("VirtualMachine Mutator", func() {
@@ -751,6 +754,64 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		})
 	})
 
+	Context("with the SealedVMDefaults feature gate", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.SealedVMDefaultsGate},
+						},
+					},
+				},
+			})
+		})
+
+		It("should seal the firmware and machine type defaults instead of setting them in the template on VM create", func() {
+			vmSpec, _ := getVMSpecMetaFromResponseCreateWithArch("amd64")
+			Expect(vmSpec.Template.Spec.Domain.Firmware).To(BeNil())
+			Expect(vmSpec.Template.Spec.Domain.Machine).To(BeNil())
+			Expect(vmSpec.Sealed).ToNot(BeNil())
+			Expect(vmSpec.Sealed.FirmwareUUID).ToNot(BeEmpty())
+			Expect(vmSpec.Sealed.FirmwareSerial).ToNot(BeEmpty())
+			Expect(vmSpec.Sealed.MachineType).To(Equal("q35"))
+		})
+
+		It("should not seal defaults which are set in the template on VM create", func() {
+			vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{UUID: "test-uuid", Serial: "test-serial"}
+			vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: "pc-q35-3.0"}
+
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.Sealed).To(BeNil())
+		})
+
+		It("should keep the sealed defaults when an update drops them", func() {
+			sealed := &v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: "sealed-uuid",
+				MachineType:  "pc-q35-3.0",
+				Interfaces: []v1.SealedInterface{
+					{Name: "default", MACAddress: "02:00:00:00:00:01"},
+					{Name: "removed", MACAddress: "02:00:00:00:00:02"},
+				},
+			}
+			oldVM := vm.DeepCopy()
+			oldVM.Spec.Sealed = sealed
+			newVM := vm.DeepCopy()
+			newVM.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+
+			resp := getResponseFromVMUpdate(oldVM, newVM)
+			Expect(resp.Allowed).To(BeTrue())
+
+			vmSpec, _ := getVMSpecMetaFromResponse(resp)
+			Expect(vmSpec.Template.Spec.Domain.Machine).To(BeNil())
+			Expect(vmSpec.Sealed).To(Equal(&v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: "sealed-uuid",
+				MachineType:  "pc-q35-3.0",
+				Interfaces:   []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+			}))
+		})
+	})
+
 	It("should default architecture to compiled architecture when not provided", func() {
 		// provide empty string for architecture so that default will apply
 		vmSpec, _ := getVMSpecMetaFromResponseCreate()
@@ -822,6 +883,47 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with the VirtualMachinePolicies feature gate", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{featuregate.VirtualMachinePoliciesGate},
+						},
+					},
+				},
+			})
+
+			mutator.VMPolicyInformer, _ = testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachinePolicy{})
+			Expect(mutator.VMPolicyInformer.GetStore().Add(&policyv1alpha1.VirtualMachinePolicy{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "defaults", Namespace: vm.Namespace},
+				Spec: policyv1alpha1.VirtualMachinePolicySpec{
+					DefaultRunStrategy: pointer.P(v1.RunStrategyRerunOnFailure),
+				},
+			})).To(Succeed())
+		})
+
+		It("should set the default run strategy of the namespace on VM create", func() {
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyRerunOnFailure)))
+		})
+
+		It("should not override the run strategy of the VM on VM create", func() {
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+
+			vmSpec, _ := getVMSpecMetaFromResponseCreate()
+			Expect(vmSpec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+		})
+
+		It("should not set the default run strategy of the namespace on VM update", func() {
+			resp := getResponseFromVMUpdate(vm.DeepCopy(), vm.DeepCopy())
+			Expect(resp.Allowed).To(BeTrue())
+			vmSpec, _ := getVMSpecMetaFromResponse(resp)
+			Expect(vmSpec.RunStrategy).To(BeNil())
+		})
+	})
+
 	Context("failure tests", func() {
 		invalidInferFromVolumeFailurePolicy := v1.InferFromVolumeFailurePolicy("not-valid")
 
@@ -35,6 +35,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/defaults"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -44,6 +45,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	VMIPresetInformer       cache.SharedIndexInformer
+	NamespaceInformer       cache.SharedIndexInformer
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
@@ -105,6 +107,16 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		// Apply the default binding policy matching the namespace before the rest of the defaults
+		namespaceLabels := webhooks.NamespaceLabels(mutator.NamespaceInformer, ar.Request.Namespace)
+		if err := netvmispec.SetDefaultNetworkInterfaceForNamespace(mutator.ClusterConfig, &newVMI.Spec, namespaceLabels); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if err := netvmispec.AddSRIOVFailoverStandbyInterfaces(&newVMI.Spec); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
 		if err := ApplyNewVMIMutations(newVMI, mutator.ClusterConfig); err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
 		}
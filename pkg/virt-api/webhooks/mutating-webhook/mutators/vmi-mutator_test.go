@@ -476,6 +476,61 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Entry("as masquerade", "masquerade", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}),
 	)
 
+	It("should add the default network interface using the binding of the namespace policy", func() {
+		const passtBinding = "passt"
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					NetworkConfiguration: &v1.NetworkConfiguration{
+						NetworkInterface: string(v1.MasqueradeInterface),
+						Binding:          map[string]v1.InterfaceBindingPlugin{passtBinding: {}},
+						DefaultBindingPolicies: []v1.DefaultBindingPolicy{{
+							NamespaceSelector: k8smetav1.LabelSelector{MatchLabels: map[string]string{"net": passtBinding}},
+							Binding:           passtBinding,
+						}},
+					},
+				},
+			},
+		})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		Expect(namespaceInformer.GetIndexer().Add(&k8sv1.Namespace{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "passt-ns", Labels: map[string]string{"net": passtBinding}},
+		})).To(Succeed())
+		mutator.NamespaceInformer = namespaceInformer
+		vmi.Namespace = "passt-ns"
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.Interfaces).To(Equal([]v1.Interface{{
+			Name:    "default",
+			Binding: &v1.PluginBinding{Name: passtBinding},
+		}}))
+		Expect(vmiSpec.Networks).To(Equal([]v1.Network{*v1.DefaultPodNetwork()}))
+	})
+
+	It("should add the failover standby interface of SR-IOV interfaces", func() {
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:       "sriov-net",
+			MacAddress: "02:00:00:00:00:01",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{
+				SRIOV: &v1.InterfaceSRIOV{Failover: &v1.InterfaceSRIOVFailover{StandbyNetworkName: "bridge-network"}},
+			},
+		}}
+		vmi.Spec.Networks = []v1.Network{{
+			Name:          "sriov-net",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "sriov-network"}},
+		}}
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit()
+		Expect(vmiSpec.Domain.Devices.Interfaces).To(HaveLen(2))
+		Expect(vmiSpec.Domain.Devices.Interfaces[0].SRIOV.Failover.StandbyInterface).To(Equal("sriov-net-standby"))
+		Expect(vmiSpec.Domain.Devices.Interfaces[1].Name).To(Equal("sriov-net-standby"))
+		Expect(vmiSpec.Domain.Devices.Interfaces[1].MacAddress).To(Equal("02:00:00:00:00:01"))
+		Expect(vmiSpec.Networks).To(ContainElement(v1.Network{
+			Name:          "sriov-net-standby",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "bridge-network"}},
+		}))
+	})
+
 	It("should reject adding a default deprecated slirp interface", func() {
 		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
 			Spec: v1.KubeVirtSpec{
@@ -37,6 +37,15 @@ func ValidateVirtualMachineInstanceS390XSetting(field *k8sfield.Path, spec *v1.V
 	var statusCauses []metav1.StatusCause
 	validateWatchdogS390x(field, spec, &statusCauses)
 	validateVideoTypeS390x(field, spec, &statusCauses)
+	validateMachineOptionsS390x(field, spec, &statusCauses)
+	validateIOMMUS390x(field, spec, &statusCauses)
+	validateNestedVirtualizationS390x(field, spec, &statusCauses)
+	validateKVMHintsS390x(field, spec, &statusCauses)
+	validateSerialPortsS390x(field, spec, &statusCauses)
+	validateInputDevicesS390x(field, spec, &statusCauses)
+	validateSmartcardS390x(field, spec, &statusCauses)
+	validatePTPClockS390x(field, spec, &statusCauses)
+	validateSGXS390x(field, spec, &statusCauses)
 	return statusCauses
 }
 
@@ -72,6 +81,57 @@ func validateWatchdogS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 	}
 }
 
+func validateMachineOptionsS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.MachineOptions != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support machine options",
+			Field:   field.Child("domain", "machineOptions").String(),
+		})
+	}
+}
+
+func validateIOMMUS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.IOMMU != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support IOMMU device",
+			Field:   field.Child("domain", "devices", "iommu").String(),
+		})
+	}
+}
+
+func validateNestedVirtualizationS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NestedVirtualization != nil && *spec.Domain.CPU.NestedVirtualization {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support nested virtualization",
+			Field:   field.Child("domain", "cpu", "nestedVirtualization").String(),
+		})
+	}
+}
+
+func validateKVMHintsS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Features == nil || spec.Domain.Features.KVM == nil {
+		return
+	}
+	kvmField := field.Child("domain", "features", "kvm")
+	if spec.Domain.Features.KVM.HintDedicated != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support KVM hint-dedicated",
+			Field:   kvmField.Child("hintDedicated").String(),
+		})
+	}
+	if spec.Domain.Features.KVM.PollControl != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support KVM poll-control",
+			Field:   kvmField.Child("pollControl").String(),
+		})
+	}
+}
+
 func isOnlyDiag288Watchdog(watchdog *v1.Watchdog) bool {
 	return watchdog.WatchdogDevice.Diag288 != nil && watchdog.WatchdogDevice.I6300ESB == nil
 }
@@ -93,3 +153,58 @@ func ValidateLaunchSecurityS390x(field *k8sfield.Path, spec *v1.VirtualMachineIn
 
 	return causes
 }
+
+func validateSerialPortsS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	for idx, serialPort := range spec.Domain.Devices.Serials {
+		if serialPort.Port != 0 {
+			*statusCauses = append(*statusCauses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "only serial port 0 is supported on s390x architecture",
+				Field:   field.Child("domain", "devices", "serials").Index(idx).Child("port").String(),
+			})
+		}
+	}
+}
+
+func validateInputDevicesS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	for idx, input := range spec.Domain.Devices.Inputs {
+		if input.Type == v1.InputTypeMultitouch {
+			*statusCauses = append(*statusCauses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "s390x does not support multitouch input devices",
+				Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("type").String(),
+			})
+		}
+	}
+}
+
+func validateSmartcardS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.Smartcard != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support smartcard devices",
+			Field:   field.Child("domain", "devices", "smartcard").String(),
+		})
+	}
+}
+
+func validatePTPClockS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	clock := spec.Domain.Clock
+	if clock != nil && clock.PTP != nil && (clock.PTP.Enabled == nil || *clock.PTP.Enabled) {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support the PTP clock",
+			Field:   field.Child("domain", "clock", "ptp").String(),
+		})
+	}
+}
+
+func validateSGXS390x(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, statusCauses *[]metav1.StatusCause) {
+	if spec.Domain.Devices.SGX != nil {
+		*statusCauses = append(*statusCauses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "s390x does not support SGX",
+			Field:   field.Child("domain", "devices", "sgx").String(),
+		})
+	}
+}
@@ -20,6 +20,7 @@
 package webhooks
 
 import (
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -69,4 +70,21 @@ type Informers struct {
 	VMBackupInformer   cache.SharedIndexInformer
 	DataSourceInformer cache.SharedIndexInformer
 	NamespaceInformer  cache.SharedIndexInformer
+	VMPolicyInformer   cache.SharedIndexInformer
+	NodeInformer       cache.SharedIndexInformer
+}
+
+// NamespaceLabels returns the labels of the namespace, or none when it is not in the informer cache.
+func NamespaceLabels(namespaceInformer cache.SharedIndexInformer, namespace string) map[string]string {
+	if namespaceInformer == nil {
+		return nil
+	}
+	obj, exists, err := namespaceInformer.GetStore().GetByKey(namespace)
+	if err != nil || !exists {
+		return nil
+	}
+	if ns, ok := obj.(*k8sv1.Namespace); ok {
+		return ns.Labels
+	}
+	return nil
 }
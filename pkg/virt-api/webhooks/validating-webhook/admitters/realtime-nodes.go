@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// maxReportedRealtimeNodes limits how many nodes are listed when a realtime
+// VMI is rejected, to keep the message readable on large clusters
+const maxReportedRealtimeNodes = 3
+
+// ValidateRealtimeNodes rejects realtime VMIs if no realtime capable node
+// matching their node selector is labelled as ready by virt-handler, and
+// reports the unmet requirements recorded on these nodes. Nothing is rejected unless the
+// RealtimeReadinessValidation feature gate is enabled, or if no node matches,
+// in which case the VMI stays pending as before.
+func ValidateRealtimeNodes(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, nodeInformer cache.SharedIndexInformer, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if nodeInformer == nil || !config.RealtimeReadinessValidationEnabled() {
+		return nil
+	}
+	if spec.Domain.CPU == nil || spec.Domain.CPU.Realtime == nil {
+		return nil
+	}
+
+	selector := labels.SelectorFromSet(spec.NodeSelector)
+	var unready []string
+	for _, obj := range nodeInformer.GetStore().List() {
+		node := obj.(*k8sv1.Node)
+		if node.Labels[v1.RealtimeLabel] != "true" || !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		if node.Labels[v1.RealtimeReadyLabel] == "true" {
+			return nil
+		}
+		unmet, exists := node.Annotations[v1.RealtimeUnmetRequirementsAnnotation]
+		if !exists {
+			unmet = "readiness not checked yet"
+		}
+		unready = append(unready, fmt.Sprintf("node %s: %s", node.Name, unmet))
+	}
+	if len(unready) == 0 {
+		return nil
+	}
+
+	sort.Strings(unready)
+	if len(unready) > maxReportedRealtimeNodes {
+		unready = append(unready[:maxReportedRealtimeNodes], fmt.Sprintf("and %d more nodes", len(unready)-maxReportedRealtimeNodes))
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("no node the realtime VMI can be scheduled to is ready for realtime workloads, %s", strings.Join(unready, ", ")),
+		Field:   field.Child("domain", "cpu", "realtime").String(),
+	}}
+}
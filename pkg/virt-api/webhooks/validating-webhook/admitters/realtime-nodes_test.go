@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Realtime node readiness", func() {
+	var nodeInformer cache.SharedIndexInformer
+
+	enabledConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+		DeveloperConfiguration: &v1.DeveloperConfiguration{
+			FeatureGates: []string{featuregate.RealtimeReadinessValidationGate},
+		},
+	})
+
+	addNode := func(name string, nodeLabels map[string]string, unmet string) {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      map[string]string{v1.RealtimeLabel: "true"},
+				Annotations: map[string]string{},
+			},
+		}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		if unmet != "" {
+			node.Annotations[v1.RealtimeUnmetRequirementsAnnotation] = unmet
+		} else {
+			node.Labels[v1.RealtimeReadyLabel] = "true"
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+	}
+
+	realtimeVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		return libvmi.New(append(opts, libvmi.WithDedicatedCPUPlacement(), libvmi.WithRealtimeMask(""))...)
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) []metav1.StatusCause {
+		return ValidateRealtimeNodes(k8sfield.NewPath("spec"), &vmi.Spec, nodeInformer, enabledConfig)
+	}
+
+	BeforeEach(func() {
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+	})
+
+	It("should not validate nodes if the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		addNode("node01", nil, "isolcpus must be set")
+
+		vmi := realtimeVMI()
+		Expect(ValidateRealtimeNodes(k8sfield.NewPath("spec"), &vmi.Spec, nodeInformer, config)).To(BeEmpty())
+	})
+
+	It("should accept VMIs which are not realtime", func() {
+		addNode("node01", nil, "isolcpus must be set")
+
+		Expect(validate(libvmi.New())).To(BeEmpty())
+	})
+
+	It("should accept realtime VMIs if no realtime node exists", func() {
+		Expect(validate(realtimeVMI())).To(BeEmpty())
+	})
+
+	It("should accept realtime VMIs if a ready node exists", func() {
+		addNode("node01", nil, "isolcpus must be set")
+		addNode("node02", nil, "")
+
+		Expect(validate(realtimeVMI())).To(BeEmpty())
+	})
+
+	It("should reject realtime VMIs if every realtime node misses requirements", func() {
+		addNode("node01", nil, "isolcpus must be set")
+		addNode("node02", nil, "hugepages must be reserved")
+
+		causes := validate(realtimeVMI())
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal("spec.domain.cpu.realtime"))
+		Expect(causes[0].Message).To(ContainSubstring("node node01: isolcpus must be set"))
+		Expect(causes[0].Message).To(ContainSubstring("node node02: hugepages must be reserved"))
+	})
+
+	It("should reject realtime VMIs if the readiness of the realtime nodes is not checked yet", func() {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node01",
+				Labels: map[string]string{v1.RealtimeLabel: "true"},
+			},
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+
+		causes := validate(realtimeVMI())
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Message).To(ContainSubstring("node node01: readiness not checked yet"))
+	})
+
+	It("should only consider the nodes matching the node selector", func() {
+		addNode("node01", map[string]string{"rt": "true"}, "isolcpus must be set")
+		addNode("node02", nil, "")
+
+		causes := validate(realtimeVMI(libvmi.WithNodeSelector("rt", "true")))
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Message).To(ContainSubstring("node node01"))
+		Expect(causes[0].Message).ToNot(ContainSubstring("node node02"))
+	})
+
+	It("should limit the number of reported nodes", func() {
+		for _, name := range []string{"node01", "node02", "node03", "node04", "node05"} {
+			addNode(name, nil, "isolcpus must be set")
+		}
+
+		causes := validate(realtimeVMI())
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Message).To(ContainSubstring("node node03"))
+		Expect(causes[0].Message).ToNot(ContainSubstring("node node04"))
+		Expect(causes[0].Message).To(HaveSuffix("and 2 more nodes"))
+	})
+})
@@ -0,0 +1,241 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+	"slices"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// ValidateVirtualMachinePolicies checks the spec against every
+// VirtualMachinePolicy of the namespace. Nothing is enforced unless the
+// VirtualMachinePolicies feature gate is enabled.
+func ValidateVirtualMachinePolicies(field *k8sfield.Path, namespace string, spec *v1.VirtualMachineInstanceSpec, policyInformer cache.SharedIndexInformer, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	return validateVirtualMachinePolicies(field, namespace, spec, policyInformer, config, false)
+}
+
+// ValidateVirtualMachineTemplatePolicies checks the template of a VM like
+// ValidateVirtualMachinePolicies, and additionally the maximum CPU and memory
+// the VM can be hot plugged to. The ceilings of a VMI are not checked, as they
+// are defaulted by the cluster when the VM didn't set them, the hotplug itself
+// is checked when the VM template changes.
+func ValidateVirtualMachineTemplatePolicies(field *k8sfield.Path, namespace string, spec *v1.VirtualMachineInstanceSpec, policyInformer cache.SharedIndexInformer, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	return validateVirtualMachinePolicies(field, namespace, spec, policyInformer, config, true)
+}
+
+func validateVirtualMachinePolicies(field *k8sfield.Path, namespace string, spec *v1.VirtualMachineInstanceSpec, policyInformer cache.SharedIndexInformer, config *virtconfig.ClusterConfig, hotplugCeilings bool) []metav1.StatusCause {
+	if policyInformer == nil || !config.VirtualMachinePoliciesEnabled() {
+		return nil
+	}
+
+	objs, err := policyInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the VirtualMachinePolicies of namespace %s", namespace)
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("failed to list the VirtualMachinePolicies of namespace %s", namespace),
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for _, obj := range objs {
+		policy := obj.(*policyv1alpha1.VirtualMachinePolicy)
+		causes = append(causes, validateVirtualMachinePolicy(field, policy, spec, hotplugCeilings)...)
+	}
+	return causes
+}
+
+func validateVirtualMachinePolicy(field *k8sfield.Path, policy *policyv1alpha1.VirtualMachinePolicy, spec *v1.VirtualMachineInstanceSpec, hotplugCeilings bool) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	policySpec := &policy.Spec
+	domainField := field.Child("domain")
+
+	if policySpec.MaxCPUs != nil {
+		if vCPUs := policyVCPUs(spec); vCPUs > int64(*policySpec.MaxCPUs) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%d vCPUs exceed the maximum of %d allowed by VirtualMachinePolicy %s", vCPUs, *policySpec.MaxCPUs, policy.Name),
+				Field:   domainField.Child("cpu").String(),
+			})
+		} else if maxVCPUs := policyMaxVCPUs(spec); hotplugCeilings && maxVCPUs > int64(*policySpec.MaxCPUs) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU hotplug up to %d vCPUs exceeds the maximum of %d allowed by VirtualMachinePolicy %s", maxVCPUs, *policySpec.MaxCPUs, policy.Name),
+				Field:   domainField.Child("cpu", "maxSockets").String(),
+			})
+		}
+	}
+
+	if policySpec.MaxMemory != nil {
+		if memory := policyGuestMemory(spec); memory != nil && memory.Cmp(*policySpec.MaxMemory) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("memory %s exceeds the maximum of %s allowed by VirtualMachinePolicy %s", memory.String(), policySpec.MaxMemory.String(), policy.Name),
+				Field:   domainField.Child("memory").String(),
+			})
+		} else if maxGuest := policyMaxGuestMemory(spec); hotplugCeilings && maxGuest != nil && maxGuest.Cmp(*policySpec.MaxMemory) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("memory hotplug up to %s exceeds the maximum of %s allowed by VirtualMachinePolicy %s", maxGuest.String(), policySpec.MaxMemory.String(), policy.Name),
+				Field:   domainField.Child("memory", "maxGuest").String(),
+			})
+		}
+	}
+
+	if policySpec.AllowedDiskBuses != nil {
+		for i, disk := range spec.Domain.Devices.Disks {
+			bus := diskBus(&disk)
+			if bus != "" && !slices.Contains(policySpec.AllowedDiskBuses, bus) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("disk bus %s is not allowed by VirtualMachinePolicy %s", bus, policy.Name),
+					Field:   domainField.Child("devices", "disks").Index(i).String(),
+				})
+			}
+		}
+	}
+
+	if policySpec.AllowedNetworkBindings != nil {
+		for i, iface := range spec.Domain.Devices.Interfaces {
+			binding := interfaceBinding(&iface)
+			if binding != "" && !slices.Contains(policySpec.AllowedNetworkBindings, binding) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("network binding %s is not allowed by VirtualMachinePolicy %s", binding, policy.Name),
+					Field:   domainField.Child("devices", "interfaces").Index(i).String(),
+				})
+			}
+		}
+	}
+
+	if policySpec.ForbidHostDevices {
+		if len(spec.Domain.Devices.HostDevices) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeForbidden,
+				Message: fmt.Sprintf("host devices are forbidden by VirtualMachinePolicy %s", policy.Name),
+				Field:   domainField.Child("devices", "hostDevices").String(),
+			})
+		}
+		if len(spec.Domain.Devices.GPUs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeForbidden,
+				Message: fmt.Sprintf("GPUs are forbidden by VirtualMachinePolicy %s", policy.Name),
+				Field:   domainField.Child("devices", "gpus").String(),
+			})
+		}
+	}
+
+	if policySpec.RequireLaunchSecurity && spec.Domain.LaunchSecurity == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("launch security is required by VirtualMachinePolicy %s", policy.Name),
+			Field:   domainField.Child("launchSecurity").String(),
+		})
+	}
+
+	return causes
+}
+
+// policyVCPUs returns the number of vCPUs the guest will see, the CPU
+// topology takes precedence over the resources like in virt-launcher.
+func policyVCPUs(spec *v1.VirtualMachineInstanceSpec) int64 {
+	if spec.Domain.CPU != nil {
+		if vCPUs := hwutil.GetNumberOfVCPUs(spec.Domain.CPU); vCPUs > 0 {
+			return vCPUs
+		}
+	}
+	if cpu, ok := spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; ok {
+		return cpu.Value()
+	}
+	if cpu, ok := spec.Domain.Resources.Requests[k8sv1.ResourceCPU]; ok {
+		return cpu.Value()
+	}
+	return 1
+}
+
+// policyMaxVCPUs returns the number of vCPUs CPU hotplug can raise the guest to,
+// or 0 when the spec doesn't set a maximum number of sockets
+func policyMaxVCPUs(spec *v1.VirtualMachineInstanceSpec) int64 {
+	cpu := spec.Domain.CPU
+	if cpu == nil || cpu.MaxSockets == 0 {
+		return 0
+	}
+	return int64(cpu.MaxSockets) * int64(max(cpu.Cores, 1)) * int64(max(cpu.Threads, 1))
+}
+
+func policyMaxGuestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil {
+		return spec.Domain.Memory.MaxGuest
+	}
+	return nil
+}
+
+func policyGuestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	if memory, ok := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	return nil
+}
+
+func diskBus(disk *v1.Disk) v1.DiskBus {
+	switch {
+	case disk.Disk != nil:
+		return disk.Disk.Bus
+	case disk.CDRom != nil:
+		return disk.CDRom.Bus
+	case disk.LUN != nil:
+		return disk.LUN.Bus
+	}
+	return ""
+}
+
+func interfaceBinding(iface *v1.Interface) string {
+	switch {
+	case iface.Bridge != nil:
+		return "bridge"
+	case iface.Masquerade != nil:
+		return "masquerade"
+	case iface.SRIOV != nil:
+		return "sriov"
+	case iface.Binding != nil:
+		return iface.Binding.Name
+	}
+	return ""
+}
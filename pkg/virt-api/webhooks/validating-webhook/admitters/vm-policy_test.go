@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("VirtualMachinePolicy enforcement", func() {
+	var policyInformer cache.SharedIndexInformer
+
+	enabledConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+		DeveloperConfiguration: &v1.DeveloperConfiguration{
+			FeatureGates: []string{featuregate.VirtualMachinePoliciesGate},
+		},
+	})
+
+	addPolicy := func(namespace string, spec policyv1alpha1.VirtualMachinePolicySpec) {
+		policy := &policyv1alpha1.VirtualMachinePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restricted",
+				Namespace: namespace,
+			},
+			Spec: spec,
+		}
+		Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) []metav1.StatusCause {
+		return ValidateVirtualMachinePolicies(k8sfield.NewPath("spec"), vmi.Namespace, &vmi.Spec, policyInformer, enabledConfig)
+	}
+
+	BeforeEach(func() {
+		policyInformer, _ = testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachinePolicy{})
+	})
+
+	It("should not enforce policies if the feature gate is disabled", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		addPolicy(metav1.NamespaceDefault, policyv1alpha1.VirtualMachinePolicySpec{RequireLaunchSecurity: true})
+
+		vmi := libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault))
+		Expect(ValidateVirtualMachinePolicies(k8sfield.NewPath("spec"), vmi.Namespace, &vmi.Spec, policyInformer, config)).To(BeEmpty())
+	})
+
+	It("should only enforce the policies of the namespace of the VMI", func() {
+		addPolicy("other", policyv1alpha1.VirtualMachinePolicySpec{RequireLaunchSecurity: true})
+
+		Expect(validate(libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)))).To(BeEmpty())
+	})
+
+	DescribeTable("should reject a VMI violating the policy", func(spec policyv1alpha1.VirtualMachinePolicySpec, vmi *v1.VirtualMachineInstance, field string) {
+		addPolicy(metav1.NamespaceDefault, spec)
+
+		causes := validate(vmi)
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(field))
+		Expect(causes[0].Message).To(ContainSubstring("VirtualMachinePolicy restricted"))
+	},
+		Entry("with too many vCPUs in the topology",
+			policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(4))},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithCPUCount(2, 2, 2)),
+			"spec.domain.cpu",
+		),
+		Entry("with too many vCPUs in the resources",
+			policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(4))},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithResourceCPU("6")),
+			"spec.domain.cpu",
+		),
+		Entry("with too much memory",
+			policyv1alpha1.VirtualMachinePolicySpec{MaxMemory: pointer.P(resource.MustParse("1Gi"))},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithGuestMemory("2Gi")),
+			"spec.domain.memory",
+		),
+		Entry("with a disk bus which is not allowed",
+			policyv1alpha1.VirtualMachinePolicySpec{AllowedDiskBuses: []v1.DiskBus{v1.DiskBusVirtio}},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithEmptyDisk("disk0", v1.DiskBusSATA, resource.MustParse("1Gi"))),
+			"spec.domain.devices.disks[0]",
+		),
+		Entry("with a network binding which is not allowed",
+			policyv1alpha1.VirtualMachinePolicySpec{AllowedNetworkBindings: []string{"masquerade"}},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(v1.DefaultPodNetwork().Name))),
+			"spec.domain.devices.interfaces[0]",
+		),
+		Entry("with a host device",
+			policyv1alpha1.VirtualMachinePolicySpec{ForbidHostDevices: true},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), withHostDevice("dev0", "example.com/device")),
+			"spec.domain.devices.hostDevices",
+		),
+		Entry("without launch security",
+			policyv1alpha1.VirtualMachinePolicySpec{RequireLaunchSecurity: true},
+			libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault)),
+			"spec.domain.launchSecurity",
+		),
+	)
+
+	It("should allow a VMI complying with the policy", func() {
+		addPolicy(metav1.NamespaceDefault, policyv1alpha1.VirtualMachinePolicySpec{
+			MaxCPUs:                pointer.P(uint32(4)),
+			MaxMemory:              pointer.P(resource.MustParse("2Gi")),
+			AllowedDiskBuses:       []v1.DiskBus{v1.DiskBusVirtio},
+			AllowedNetworkBindings: []string{"masquerade"},
+			ForbidHostDevices:      true,
+			RequireLaunchSecurity:  true,
+		})
+
+		vmi := libvmi.New(
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithCPUCount(2, 1, 2),
+			libvmi.WithGuestMemory("2Gi"),
+			libvmi.WithEmptyDisk("disk0", v1.DiskBusVirtio, resource.MustParse("1Gi")),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithSEV(false, false),
+		)
+		Expect(validate(vmi)).To(BeEmpty())
+	})
+
+	Context("with hotplug ceilings", func() {
+		withMaxSockets := func(maxSockets uint32) libvmi.Option {
+			return func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.MaxSockets = maxSockets
+			}
+		}
+
+		DescribeTable("should reject a VM template which can be hot plugged beyond the policy", func(spec policyv1alpha1.VirtualMachinePolicySpec, vmi *v1.VirtualMachineInstance, field string) {
+			addPolicy(metav1.NamespaceDefault, spec)
+
+			causes := ValidateVirtualMachineTemplatePolicies(k8sfield.NewPath("spec"), vmi.Namespace, &vmi.Spec, policyInformer, enabledConfig)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+			Expect(causes[0].Message).To(ContainSubstring("hotplug up to"))
+
+			Expect(validate(vmi)).To(BeEmpty(), "the ceilings of a VMI are defaulted by the cluster and not checked")
+		},
+			Entry("with too many sockets",
+				policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(4))},
+				libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithCPUCount(2, 1, 1), withMaxSockets(4)),
+				"spec.domain.cpu.maxSockets",
+			),
+			Entry("with too much memory",
+				policyv1alpha1.VirtualMachinePolicySpec{MaxMemory: pointer.P(resource.MustParse("4Gi"))},
+				libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithGuestMemory("2Gi"), libvmi.WithMaxGuest("8Gi")),
+				"spec.domain.memory.maxGuest",
+			),
+		)
+
+		It("should allow a VM template whose ceilings comply with the policy", func() {
+			addPolicy(metav1.NamespaceDefault, policyv1alpha1.VirtualMachinePolicySpec{
+				MaxCPUs:   pointer.P(uint32(8)),
+				MaxMemory: pointer.P(resource.MustParse("8Gi")),
+			})
+
+			vmi := libvmi.New(
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmi.WithCPUCount(2, 1, 1),
+				withMaxSockets(4),
+				libvmi.WithGuestMemory("2Gi"),
+				libvmi.WithMaxGuest("8Gi"),
+			)
+			Expect(ValidateVirtualMachineTemplatePolicies(k8sfield.NewPath("spec"), vmi.Namespace, &vmi.Spec, policyInformer, enabledConfig)).To(BeEmpty())
+		})
+	})
+
+	It("should report every policy which is violated", func() {
+		addPolicy(metav1.NamespaceDefault, policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(1))})
+		Expect(policyInformer.GetStore().Add(&policyv1alpha1.VirtualMachinePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "confidential", Namespace: metav1.NamespaceDefault},
+			Spec:       policyv1alpha1.VirtualMachinePolicySpec{RequireLaunchSecurity: true},
+		})).To(Succeed())
+
+		vmi := libvmi.New(libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithResourceCPU("2"))
+		Expect(validate(vmi)).To(HaveLen(2))
+	})
+})
+
+func withHostDevice(name, deviceName string) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.HostDevices = append(vmi.Spec.Domain.Devices.HostDevices, v1.HostDevice{
+			Name:       name,
+			DeviceName: deviceName,
+		})
+	}
+}
@@ -112,6 +112,10 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateFirmwareIdentityPolicy(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -280,6 +284,24 @@ func validatePatches(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+func validateFirmwareIdentityPolicy(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	policy := vmClone.Spec.FirmwareIdentityPolicy
+	if policy == nil {
+		return nil
+	}
+
+	switch *policy {
+	case clone.FirmwareIdentityPolicyPreserve, clone.FirmwareIdentityPolicyRegenerate:
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("firmware identity policy \"%s\" doesn't exist", *policy),
+			Field:   k8sfield.NewPath("spec").Child("firmwareIdentityPolicy").String(),
+		}}
+	}
+}
+
 func doesSliceContainStr(slice []string, str string) (isFound bool) {
 	for _, curSliceStr := range slice {
 		if curSliceStr == str {
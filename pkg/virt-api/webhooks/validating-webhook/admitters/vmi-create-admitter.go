@@ -23,8 +23,11 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"maps"
+	"math"
 	"net"
 	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -34,23 +37,29 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	draadmitter "kubevirt.io/kubevirt/pkg/dra/admitter"
+	"kubevirt.io/kubevirt/pkg/guestsecrets"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/hostsensors"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
 
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-handler/usbredir"
 )
 
 const requiredFieldFmt = "%s is a required field"
@@ -73,6 +82,23 @@ var validIOThreadsPolicies = []v1.IOThreadsPolicy{v1.IOThreadsPolicyShared, v1.I
 var validCPUFeaturePolicies = map[string]*struct{}{"": nil, "force": nil, "require": nil, "optional": nil, "disable": nil, "forbid": nil}
 var validPanicDeviceModels = []v1.PanicDeviceModel{v1.Hyperv, v1.Isa, v1.Pvpanic}
 
+// maxSharedMemoryNameLen leaves room for the prefix of the virt-launcher pod volumes
+const maxSharedMemoryNameLen = 57
+
+// maxChannelNameLen leaves room for the prefix of the virt-launcher pod volumes
+const maxChannelNameLen = 55
+
+// maxSerialPorts is the number of ISA serial ports QEMU supports on x86_64
+const maxSerialPorts = 4
+
+// maxDIMMSlots is the number of memory slots QEMU supports on x86_64
+const maxDIMMSlots = 256
+
+var channelTargetRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// reservedChannelTargets are the virtio-serial ports KubeVirt adds on its own
+var reservedChannelTargets = []string{"org.qemu.guest_agent.0", downwardmetrics.DownwardMetricsSerialDeviceName}
+
 var restrictedVmiLabels = map[string]bool{
 	v1.CreatedByLabel:               true,
 	v1.MigrationJobLabel:            true,
@@ -96,6 +122,9 @@ type VMICreateAdmitter struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	SpecValidators          []SpecValidator
 	KubeVirtServiceAccounts map[string]struct{}
+	VMPolicyInformer        cache.SharedIndexInformer
+	NodeInformer            cache.SharedIndexInformer
+	NamespaceInformer       cache.SharedIndexInformer
 }
 
 func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -127,6 +156,10 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, isKubeVirtServiceAccount)...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstancePerArch(k8sfield.NewPath("spec"), &vmi.Spec)...)
+	causes = append(causes, ValidateVirtualMachinePolicies(k8sfield.NewPath("spec"), vmi.Namespace, &vmi.Spec, admitter.VMPolicyInformer, admitter.ClusterConfig)...)
+	causes = append(causes, ValidateRealtimeNodes(k8sfield.NewPath("spec"), &vmi.Spec, admitter.NodeInformer, admitter.ClusterConfig)...)
+	causes = append(causes, ValidateHostSensorsNamespace(k8sfield.NewPath("spec"), &vmi.Spec,
+		webhooks.NamespaceLabels(admitter.NamespaceInformer, ar.Request.Namespace), admitter.ClusterConfig)...)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -181,6 +214,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMemoryLimitsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateHugepagesMemoryRequests(field, spec)...)
 	causes = append(causes, validateGuestMemoryLimit(field, spec, config)...)
+	causes = append(causes, validateDIMMSlots(field, spec, config)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareACPI(field.Child("acpi"), spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
@@ -189,7 +223,9 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCpuPinning(field, spec, config)...)
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
+	causes = append(causes, validateCPUCoreType(field, spec, config)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
+	causes = append(causes, validateNestedVirtualization(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec)...)
@@ -204,10 +240,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateBootOrder(field, spec, config)...)
 
 	causes = append(causes, validateInputDevices(field, spec)...)
+	causes = append(causes, validateGraphicsDevice(field, spec)...)
+	causes = append(causes, validateTPMDevice(field, spec)...)
 
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
-	causes = append(causes, validateProbe(field.Child("readinessProbe"), spec.ReadinessProbe)...)
-	causes = append(causes, validateProbe(field.Child("livenessProbe"), spec.LivenessProbe)...)
+	causes = append(causes, validateProbe(field.Child("readinessProbe"), spec.ReadinessProbe, spec)...)
+	causes = append(causes, validateProbe(field.Child("livenessProbe"), spec.LivenessProbe, spec)...)
 
 	if podNetwork := vmispec.LookupPodNetwork(spec.Networks); podNetwork == nil {
 		causes = appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field.Child("readinessProbe"), spec.ReadinessProbe, causes)
@@ -229,13 +267,32 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateIOMMU(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
+	causes = append(causes, validateNVMe(field, spec, config)...)
+	causes = append(causes, validatePTPClock(field, spec)...)
+	causes = append(causes, validateSGX(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
+	causes = append(causes, validateHostSensors(field, spec, config)...)
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
+	causes = append(causes, validateVirtiofsOptions(field, spec)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateWatchdogRemediation(field, spec, config)...)
+	causes = append(causes, validateDiskSpaceLow(field, spec)...)
+	causes = append(causes, validateSharedMemoryDevices(field, spec, config)...)
+	causes = append(causes, validateChannels(field, spec, config)...)
+	causes = append(causes, validateSerialPorts(field, spec)...)
+	causes = append(causes, validateClientPassthrough(field, spec)...)
+	causes = append(causes, validateSmartcard(field, spec)...)
+	causes = append(causes, validateGuestSecrets(field, spec, config)...)
+	causes = append(causes, validateLauncherPodSettings(field, spec, config)...)
+	causes = append(causes, validateLauncherIsolation(field, spec, config)...)
+	causes = append(causes, validateEmulation(field, spec, config)...)
+	causes = append(causes, validateEmulatorBundle(field, spec, config)...)
+	causes = append(causes, validateFirmwareProfile(field, spec, config)...)
 
 	return causes
 }
@@ -265,6 +322,67 @@ func validateFilesystemsWithVirtIOFSEnabled(field *k8sfield.Path, spec *v1.Virtu
 	return causes
 }
 
+func validateVirtiofsOptions(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	for idx, fs := range spec.Domain.Devices.Filesystems {
+		if fs.Virtiofs == nil {
+			continue
+		}
+		fsField := field.Child("domain", "devices", "filesystems").Index(idx).Child("virtiofs")
+
+		if queueSize := fs.Virtiofs.QueueSize; queueSize != nil && (*queueSize == 0 || *queueSize > 1024 || *queueSize&(*queueSize-1) != 0) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a power of two not larger than 1024", fsField.Child("queueSize").String()),
+				Field:   fsField.Child("queueSize").String(),
+			})
+		}
+
+		if fs.Virtiofs.IDMap != nil {
+			causes = append(causes, validateFilesystemIDMapRanges(fsField.Child("idMap", "uid"), fs.Virtiofs.IDMap.UID)...)
+			causes = append(causes, validateFilesystemIDMapRanges(fsField.Child("idMap", "gid"), fs.Virtiofs.IDMap.GID)...)
+		}
+	}
+
+	return causes
+}
+
+func validateFilesystemIDMapRanges(field *k8sfield.Path, ranges []v1.FilesystemIDMapRange) (causes []metav1.StatusCause) {
+	end := func(start, count uint32) uint64 {
+		return uint64(start) + uint64(count)
+	}
+
+	for idx, r := range ranges {
+		if r.Count == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", field.Index(idx).Child("count").String()),
+				Field:   field.Index(idx).Child("count").String(),
+			})
+			continue
+		}
+		if end(r.Start, r.Count) > math.MaxUint32+1 || end(r.Target, r.Count) > math.MaxUint32+1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s exceeds the range of IDs", field.Index(idx).String()),
+				Field:   field.Index(idx).Child("count").String(),
+			})
+			continue
+		}
+		for _, other := range ranges[:idx] {
+			if uint64(r.Start) < end(other.Start, other.Count) && uint64(other.Start) < end(r.Start, r.Count) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s overlaps with another range", field.Index(idx).String()),
+					Field:   field.Index(idx).Child("start").String(),
+				})
+				break
+			}
+		}
+	}
+
+	return causes
+}
+
 func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -280,6 +398,59 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateHostSensors(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if !hostsensors.HasDevice(spec) {
+		return causes
+	}
+	hostSensorsField := field.Child("domain", "devices", "hostSensors")
+
+	if !config.HostSensorsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.HostSensorsGate),
+			Field:   hostSensorsField.String(),
+		})
+	}
+
+	for idx, sensorType := range spec.Domain.Devices.HostSensors.Types {
+		if !hostsensors.IsKnownType(sensorType) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("host sensor type %q is not supported", sensorType),
+				Field:   hostSensorsField.Child("types").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// ValidateHostSensorsNamespace rejects the hostSensors device outside of the namespaces selected by the admin,
+// the VM owner must not be able to read the sensors of the node on their own.
+func ValidateHostSensorsNamespace(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, namespaceLabels map[string]string, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if !hostsensors.HasDevice(spec) || !config.HostSensorsEnabled() {
+		return nil
+	}
+	hostSensorsField := field.Child("domain", "devices", "hostSensors")
+
+	allowed, err := hostsensors.AllowedInNamespace(config.GetConfig().HostSensors, namespaceLabels)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("failed to match the namespace against the hostSensors configuration: %v", err),
+			Field:   hostSensorsField.String(),
+		}}
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "host sensors are not allowed in this namespace, see spec.configuration.hostSensors of the KubeVirt CR",
+			Field:   hostSensorsField.String(),
+		}}
+	}
+	return nil
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -347,10 +518,20 @@ func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 			})
 		}
 
-		if input.Type != v1.InputTypeTablet {
+		switch input.Type {
+		case v1.InputTypeTablet, v1.InputTypeKeyboard, v1.InputTypeMouse:
+		case v1.InputTypeMultitouch:
+			if input.Bus != v1.InputBusVirtio {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "Multitouch input device can have only virtio bus.",
+					Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("bus").String(),
+				})
+			}
+		default:
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Input device can have only tablet type.",
+				Message: "Input device can have only tablet, keyboard, mouse or multitouch type.",
 				Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("type").String(),
 			})
 		}
@@ -358,6 +539,65 @@ func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+// vncKeymaps are the keymaps QEMU ships for VNC clients sending keysyms
+var vncKeymaps = []string{
+	"ar", "bepo", "cz", "da", "de", "de-ch", "en-gb", "en-us", "es", "et", "fi", "fo",
+	"fr", "fr-be", "fr-ca", "fr-ch", "hr", "hu", "is", "it", "ja", "lt", "lv", "mk",
+	"nl", "no", "pl", "pt", "pt-br", "ru", "sl", "sv", "th", "tr",
+}
+
+func validateGraphicsDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	graphics := spec.Domain.Devices.Graphics
+	if graphics == nil || graphics.Keymap == "" {
+		return causes
+	}
+	if !slices.Contains(vncKeymaps, graphics.Keymap) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("keymap %s is not supported, supported keymaps: %s", graphics.Keymap, strings.Join(vncKeymaps, ", ")),
+			Field:   field.Child("domain", "devices", "graphics", "keymap").String(),
+		})
+	}
+	return causes
+}
+
+func validateTPMDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	tpmDevice := spec.Domain.Devices.TPM
+	if tpmDevice == nil {
+		return causes
+	}
+	tpmField := field.Child("domain", "devices", "tpm")
+
+	switch tpmDevice.Version {
+	case "", v1.TPMVersion12, v1.TPMVersion20:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("TPM version %s is not supported, supported versions: %s, %s", tpmDevice.Version, v1.TPMVersion20, v1.TPMVersion12),
+			Field:   tpmField.Child("version").String(),
+		})
+	}
+
+	if tpmDevice.EncryptionSecretRef == nil {
+		return causes
+	}
+	if tpmDevice.Persistent == nil || !*tpmDevice.Persistent {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the TPM state can only be encrypted if the TPM is persistent",
+			Field:   tpmField.Child("encryptionSecretRef").String(),
+		})
+	}
+	if tpmDevice.EncryptionSecretRef.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "the name of the secret encrypting the TPM state is required",
+			Field:   tpmField.Child("encryptionSecretRef", "name").String(),
+		})
+	}
+	return causes
+}
+
 func validateIOThreadsPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.IOThreadsPolicy == nil {
@@ -392,7 +632,7 @@ func validateIOThreadsPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
-func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
+func validateProbe(field *k8sfield.Path, probe *v1.Probe, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if probe == nil {
 		return causes
@@ -408,144 +648,911 @@ func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	if probe.Exec != nil {
 		numHandlers++
 	}
-	if probe.GuestAgentPing != nil {
-		numHandlers++
+	if probe.GuestAgentPing != nil {
+		numHandlers++
+	}
+	if probe.GuestAgentExec != nil {
+		numHandlers++
+		if len(probe.GuestAgentExec.Command) == 0 || probe.GuestAgentExec.Command[0] == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", field.Child("guestAgentExec", "command")),
+				Field:   field.Child("guestAgentExec", "command").String(),
+			})
+		}
+	}
+	if probe.VSockHTTP != nil {
+		numHandlers++
+		causes = append(causes, validateVSockHTTPProbe(field.Child("vsockHTTP"), probe.VSockHTTP, spec)...)
+	}
+
+	if numHandlers > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one probe type set", field),
+			Field:   field.String(),
+		})
+	}
+
+	if numHandlers < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("either %s, %s or %s must be set if a %s is specified",
+				field.Child("tcpSocket").String(),
+				field.Child("exec").String(),
+				field.Child("httpGet").String(),
+				field,
+			),
+			Field: field.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVSockHTTPProbe(field *k8sfield.Path, action *v1.VSockHTTPAction, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if action.Port == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be set", field.Child("port")),
+			Field:   field.Child("port").String(),
+		})
+	}
+	if action.Path != "" && !strings.HasPrefix(action.Path, "/") {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be an absolute path", field.Child("path")),
+			Field:   field.Child("path").String(),
+		})
+	}
+	if spec.Domain.Devices.AutoattachVSOCK == nil || !*spec.Domain.Devices.AutoattachVSOCK {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is only allowed if autoattachVSOCK is enabled", field),
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field *k8sfield.Path, probe *v1.Probe, causes []metav1.StatusCause) []metav1.StatusCause {
+	if probe == nil {
+		return causes
+	}
+
+	if probe.HTTPGet != nil {
+		causes = append(causes, podNetworkRequiredStatusCause(field.Child("httpGet")))
+	}
+
+	if probe.TCPSocket != nil {
+		causes = append(causes, podNetworkRequiredStatusCause(field.Child("tcpSocket")))
+	}
+	return causes
+}
+
+func podNetworkRequiredStatusCause(field *k8sfield.Path) metav1.StatusCause {
+	return metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s is only allowed if the Pod Network is attached", field.String()),
+		Field:   field.String(),
+	}
+}
+
+func isValidEvictionStrategy(evictionStrategy *v1.EvictionStrategy) bool {
+	return evictionStrategy == nil ||
+		*evictionStrategy == v1.EvictionStrategyLiveMigrate ||
+		*evictionStrategy == v1.EvictionStrategyLiveMigrateIfPossible ||
+		*evictionStrategy == v1.EvictionStrategyNone ||
+		*evictionStrategy == v1.EvictionStrategyExternal
+}
+
+func validateLiveMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	evictionStrategy := config.GetConfig().EvictionStrategy
+
+	if spec.EvictionStrategy != nil {
+		evictionStrategy = spec.EvictionStrategy
+	}
+	if !isValidEvictionStrategy(evictionStrategy) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is set with an unrecognized option: %s", field.Child("evictionStrategy").String(), *spec.EvictionStrategy),
+			Field:   field.Child("evictionStrategy").String(),
+		})
+	}
+	return causes
+}
+
+func countConfiguredMDEVRamFBs(spec *v1.VirtualMachineInstanceSpec) int {
+	count := 0
+	for _, device := range spec.Domain.Devices.GPUs {
+		if device.VirtualGPUOptions != nil &&
+			device.VirtualGPUOptions.Display != nil &&
+			(device.VirtualGPUOptions.Display.Enabled == nil || *device.VirtualGPUOptions.Display.Enabled) &&
+			(device.VirtualGPUOptions.Display.RamFB == nil || (device.VirtualGPUOptions.Display.RamFB.Enabled != nil && *device.VirtualGPUOptions.Display.RamFB.Enabled)) {
+			count++
+		}
+	}
+	return count
+}
+
+func validateMDEVRamFB(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if countConfiguredMDEVRamFBs(spec) > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "configuring multiple displays with ramfb is not valid ",
+			Field:   field.Child("GPUs").String(),
+		})
+
+	}
+	return causes
+}
+
+func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Devices.HostDevices != nil && !config.HostDevicesPassthroughEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Host Devices feature gate is not enabled in kubevirt-config",
+			Field:   field.Child("HostDevices").String(),
+		})
+	}
+	return causes
+}
+
+func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Devices.Sound == nil {
+		return causes
+	}
+	model := spec.Domain.Devices.Sound.Model
+	if model != "" && model != "ich9" && model != "ac97" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Sound device type is not supported. Options: 'ich9' or 'ac97'",
+			Field:   field.Child("Sound").String(),
+		})
+	}
+	if spec.Domain.Devices.Sound.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Sound device requires a name field.",
+			Field:   field.Child("Sound").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateWatchdogRemediation(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	watchdog := spec.Domain.Devices.Watchdog
+	if watchdog == nil || watchdog.Remediation == nil {
+		return causes
+	}
+	remediationField := field.Child("domain", "devices", "watchdog", "remediation")
+
+	switch watchdog.Remediation.Type {
+	case v1.WatchdogRemediationReset, v1.WatchdogRemediationRestartVM:
+	case v1.WatchdogRemediationMemoryDump:
+		if watchdog.Remediation.MemoryDumpClaimName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s is required for the %s remediation", remediationField.Child("memoryDumpClaimName").String(), v1.WatchdogRemediationMemoryDump),
+				Field:   remediationField.Child("memoryDumpClaimName").String(),
+			})
+		}
+		if !config.HotplugVolumesEnabled() && !config.DeclarativeHotplugVolumesEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the %s remediation requires volume hotplug to be enabled", v1.WatchdogRemediationMemoryDump),
+				Field:   remediationField.Child("type").String(),
+			})
+		}
+		if !memorydump.PreservesGuestMemory(watchdog) {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("the %s remediation requires the %s or %s watchdog action",
+					v1.WatchdogRemediationMemoryDump, v1.WatchdogActionPause, v1.WatchdogActionNone),
+				Field: remediationField.Child("type").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not a supported watchdog remediation", watchdog.Remediation.Type),
+			Field:   remediationField.Child("type").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateDiskSpaceLow(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	threshold := spec.DiskSpaceLow
+	if threshold == nil {
+		return causes
+	}
+	thresholdField := field.Child("diskSpaceLow")
+
+	usedPercentage := int32(90)
+	if threshold.UsedPercentage != nil {
+		usedPercentage = *threshold.UsedPercentage
+		if usedPercentage < 1 || usedPercentage > 100 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be between 1 and 100", thresholdField.Child("usedPercentage").String()),
+				Field:   thresholdField.Child("usedPercentage").String(),
+			})
+		}
+	}
+	if hysteresis := threshold.HysteresisPercentage; hysteresis != nil && (*hysteresis < 0 || *hysteresis >= usedPercentage) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least 0 and lower than the used percentage", thresholdField.Child("hysteresisPercentage").String()),
+			Field:   thresholdField.Child("hysteresisPercentage").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateIOMMU(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	iommu := spec.Domain.Devices.IOMMU
+	if iommu == nil {
+		return causes
+	}
+	iommuField := field.Child("domain", "devices", "iommu")
+
+	switch iommu.Model {
+	case "", v1.IOMMUModelIntel:
+	case v1.IOMMUModelVirtio:
+		intelOptions := []struct {
+			name  string
+			value *bool
+		}{
+			{"cachingMode", iommu.CachingMode},
+			{"interruptRemapping", iommu.InterruptRemapping},
+			{"eim", iommu.EIM},
+		}
+		for _, option := range intelOptions {
+			if option.value != nil && *option.value {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s is only supported by the %s IOMMU model", option.name, v1.IOMMUModelIntel),
+					Field:   iommuField.Child(option.name).String(),
+				})
+			}
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("IOMMU model %s is not supported. Options: '%s' or '%s'", iommu.Model, v1.IOMMUModelIntel, v1.IOMMUModelVirtio),
+			Field:   iommuField.Child("model").String(),
+		})
+	}
+
+	if iommu.EIM != nil && *iommu.EIM && (iommu.InterruptRemapping == nil || !*iommu.InterruptRemapping) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "eim requires interruptRemapping to be enabled",
+			Field:   iommuField.Child("eim").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateSharedMemoryDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.Domain.Devices.SharedMemoryDevices) == 0 {
+		return causes
+	}
+	shmemField := field.Child("domain", "devices", "sharedMemoryDevices")
+	if !config.SharedMemoryDevicesEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.SharedMemoryDevicesGate),
+			Field:   shmemField.String(),
+		})
+	}
+
+	names := map[string]struct{}{}
+	for idx, shmem := range spec.Domain.Devices.SharedMemoryDevices {
+		idxField := shmemField.Index(idx)
+		if errs := validation.IsDNS1123Label(shmem.Name); len(errs) != 0 || len(shmem.Name) > maxSharedMemoryNameLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("shared memory device name %q must be a DNS_LABEL of at most %d characters", shmem.Name, maxSharedMemoryNameLen),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		if _, exists := names[shmem.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("shared memory device name %q is used more than once", shmem.Name),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		names[shmem.Name] = struct{}{}
+
+		switch shmem.Model {
+		case "", v1.SharedMemoryModelPlain:
+			if shmem.Size == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf(requiredFieldFmt, idxField.Child("size").String()),
+					Field:   idxField.Child("size").String(),
+				})
+			} else if size := shmem.Size.Value(); size <= 0 || size&(size-1) != 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("shared memory size %s must be a power of two", shmem.Size.String()),
+					Field:   idxField.Child("size").String(),
+				})
+			}
+			if shmem.HostPath != "" {
+				causes = append(causes, validateAllowedHostPath(idxField, "hostPath", shmem.HostPath, config)...)
+			}
+			if shmem.ServerSocketPath != "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("serverSocketPath is only supported by the %s model", v1.SharedMemoryModelDoorbell),
+					Field:   idxField.Child("serverSocketPath").String(),
+				})
+			}
+		case v1.SharedMemoryModelDoorbell:
+			if shmem.ServerSocketPath == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf(requiredFieldFmt, idxField.Child("serverSocketPath").String()),
+					Field:   idxField.Child("serverSocketPath").String(),
+				})
+			} else {
+				causes = append(causes, validateAllowedHostPath(idxField, "serverSocketPath", shmem.ServerSocketPath, config)...)
+			}
+			for _, option := range []struct {
+				name string
+				set  bool
+			}{
+				{"size", shmem.Size != nil},
+				{"hostPath", shmem.HostPath != ""},
+			} {
+				if option.set {
+					causes = append(causes, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("%s is only supported by the %s model", option.name, v1.SharedMemoryModelPlain),
+						Field:   idxField.Child(option.name).String(),
+					})
+				}
+			}
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("shared memory model %s is not supported. Options: '%s' or '%s'", shmem.Model, v1.SharedMemoryModelPlain, v1.SharedMemoryModelDoorbell),
+				Field:   idxField.Child("model").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateAllowedHostPath checks that a path of the node, which is mounted into
+// the virt-launcher pod, lies in one of the directories allowed by the admin
+func validateAllowedHostPath(field *k8sfield.Path, name, hostPath string, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	pathField := field.Child(name)
+	switch {
+	case !filepath.IsAbs(hostPath):
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %s must be an absolute path", name, hostPath),
+			Field:   pathField.String(),
+		}}
+	case filepath.Clean(hostPath) != hostPath:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %s must be a clean path", name, hostPath),
+			Field:   pathField.String(),
+		}}
+	}
+
+	for _, allowed := range config.GetAllowedHostPaths() {
+		allowed = filepath.Clean(allowed)
+		if hostPath == allowed || strings.HasPrefix(hostPath, strings.TrimSuffix(allowed, "/")+"/") {
+			return nil
+		}
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("%s %s is not in any of the host paths allowed by the cluster admin", name, hostPath),
+		Field:   pathField.String(),
+	}}
+}
+
+func validateSerialPorts(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	serialsField := field.Child("domain", "devices", "serials")
+
+	names := map[string]struct{}{}
+	ports := map[uint32]struct{}{}
+	if spec.Domain.Devices.AutoattachSerialConsole == nil || *spec.Domain.Devices.AutoattachSerialConsole {
+		ports[0] = struct{}{}
+	}
+	for idx, serialPort := range spec.Domain.Devices.Serials {
+		idxField := serialsField.Index(idx)
+		if errs := validation.IsDNS1123Label(serialPort.Name); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("serial port name %q must be a DNS_LABEL", serialPort.Name),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		if _, exists := names[serialPort.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("serial port name %q is used more than once", serialPort.Name),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		names[serialPort.Name] = struct{}{}
+
+		switch _, exists := ports[serialPort.Port]; {
+		case serialPort.Port >= maxSerialPorts:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("serial port %d exceeds the maximum of %d serial ports", serialPort.Port, maxSerialPorts),
+				Field:   idxField.Child("port").String(),
+			})
+		case exists:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("serial port %d is already in use", serialPort.Port),
+				Field:   idxField.Child("port").String(),
+			})
+		}
+		ports[serialPort.Port] = struct{}{}
+	}
+
+	return causes
+}
+
+func validateClientPassthrough(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	clientPassthrough := spec.Domain.Devices.ClientPassthrough
+	if clientPassthrough == nil {
+		return causes
+	}
+	clientPassthroughField := field.Child("domain", "devices", "clientPassthrough")
+
+	if count := clientPassthrough.Count; count != nil && (*count < 1 || *count > v1.UsbClientPassthroughMaxNumberOf) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("USB redirection count %d must be between 1 and %d", *count, v1.UsbClientPassthroughMaxNumberOf),
+			Field:   clientPassthroughField.Child("count").String(),
+		})
+	}
+
+	if clientPassthrough.Filter == nil {
+		return causes
+	}
+	filterField := clientPassthroughField.Child("filter")
+	causes = append(causes, validateUSBDeviceClasses(filterField.Child("allow"), clientPassthrough.Filter.Allow)...)
+	causes = append(causes, validateUSBDeviceClasses(filterField.Child("deny"), clientPassthrough.Filter.Deny)...)
+	return causes
+}
+
+func validateUSBDeviceClasses(field *k8sfield.Path, classes []v1.USBDeviceClass) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, class := range classes {
+		if !usbredir.IsKnownClass(class) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("USB device class %q is not supported", class),
+				Field:   field.Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateSmartcard(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	card := spec.Domain.Devices.Smartcard
+	if card == nil {
+		return causes
+	}
+	smartcardField := field.Child("domain", "devices", "smartcard")
+
+	if (card.Passthrough == nil) == (card.HostCertificates == nil) {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "exactly one of passthrough or hostCertificates must be set on a smartcard",
+			Field:   smartcardField.String(),
+		})
+	}
+
+	if card.HostCertificates == nil {
+		return causes
+	}
+	hostCertificatesField := smartcardField.Child("hostCertificates")
+	if card.HostCertificates.SecretName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "the Secret holding the NSS database of the smartcard must be set",
+			Field:   hostCertificatesField.Child("secretName").String(),
+		})
+	}
+	if len(card.HostCertificates.Certificates) != 3 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("an emulated smartcard requires exactly 3 certificates, got %d", len(card.HostCertificates.Certificates)),
+			Field:   hostCertificatesField.Child("certificates").String(),
+		})
+		return causes
+	}
+	for idx, certificate := range card.HostCertificates.Certificates {
+		if certificate == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "the nickname of a smartcard certificate must not be empty",
+				Field:   hostCertificatesField.Child("certificates").Index(idx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateChannels(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.Domain.Devices.Channels) == 0 {
+		return causes
+	}
+	channelsField := field.Child("domain", "devices", "channels")
+	if !config.VirtioSerialChannelsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.VirtioSerialChannelsGate),
+			Field:   channelsField.String(),
+		})
+	}
+
+	names := map[string]struct{}{}
+	targets := map[string]struct{}{}
+	for idx, channel := range spec.Domain.Devices.Channels {
+		idxField := channelsField.Index(idx)
+		if errs := validation.IsDNS1123Label(channel.Name); len(errs) != 0 || len(channel.Name) > maxChannelNameLen {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("channel name %q must be a DNS_LABEL of at most %d characters", channel.Name, maxChannelNameLen),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		if _, exists := names[channel.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("channel name %q is used more than once", channel.Name),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		names[channel.Name] = struct{}{}
+
+		switch _, exists := targets[channel.Target]; {
+		case !channelTargetRegex.MatchString(channel.Target):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("channel target %q may only contain alphanumeric characters, '.', '_' and '-'", channel.Target),
+				Field:   idxField.Child("target").String(),
+			})
+		case slices.Contains(reservedChannelTargets, channel.Target):
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("channel target %q is reserved", channel.Target),
+				Field:   idxField.Child("target").String(),
+			})
+		case exists:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("channel target %q is used more than once", channel.Target),
+				Field:   idxField.Child("target").String(),
+			})
+		}
+		targets[channel.Target] = struct{}{}
+
+		if channel.HostPath != "" {
+			causes = append(causes, validateAllowedHostPath(idxField, "hostPath", channel.HostPath, config)...)
+		}
+	}
+
+	return causes
+}
+
+func validateGuestSecrets(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if len(spec.GuestSecrets) == 0 {
+		return causes
+	}
+	guestSecretsField := field.Child("guestSecrets")
+	if !config.GuestSecretsEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.GuestSecretsGate),
+			Field:   guestSecretsField.String(),
+		})
+	}
+
+	names := map[string]struct{}{}
+	for idx, guestSecret := range spec.GuestSecrets {
+		idxField := guestSecretsField.Index(idx)
+		if errs := validation.IsDNS1123Label(guestSecret.Name); len(errs) != 0 || len(guestSecret.Name) > guestsecrets.MaxNameLength {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("guest secret name %q must be a DNS_LABEL of at most %d characters", guestSecret.Name, guestsecrets.MaxNameLength),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		if _, exists := names[guestSecret.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("guest secret name %q is used more than once", guestSecret.Name),
+				Field:   idxField.Child("name").String(),
+			})
+		}
+		names[guestSecret.Name] = struct{}{}
+
+		if guestSecret.SecretName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, idxField.Child("secretName").String()),
+				Field:   idxField.Child("secretName").String(),
+			})
+		}
+		if guestSecret.Key == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf(requiredFieldFmt, idxField.Child("key").String()),
+				Field:   idxField.Child("key").String(),
+			})
+		}
+
+		if !guestsecrets.IsFWCfg(&guestSecret) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("guest secret target %q is not supported", guestSecret.Target),
+				Field:   idxField.Child("target").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateEmulation(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.Domain.UseEmulation == nil || !*spec.Domain.UseEmulation {
+		return nil
+	}
+	emulationField := field.Child("domain", "useEmulation")
+	if !config.VMEmulationEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.VMEmulationGate),
+			Field:   emulationField.String(),
+		}}
+	}
+	if spec.Domain.LaunchSecurity != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "software emulation cannot be combined with launch security",
+			Field:   emulationField.String(),
+		}}
+	}
+	return nil
+}
+
+func validateFirmwareProfile(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	firmware := spec.Domain.Firmware
+	if firmware == nil || firmware.Profile == "" {
+		return nil
+	}
+	profileField := field.Child("domain", "firmware", "profile")
+	profile := config.GetFirmwareProfile(firmware.Profile)
+	if profile == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("firmware profile %s is not configured in kubevirt-config", firmware.Profile),
+			Field:   profileField.String(),
+		}}
 	}
 
-	if numHandlers > 1 {
+	var causes []metav1.StatusCause
+	if firmware.Bootloader != nil && !bootloaderMatchesFirmwareType(firmware, profile.Type) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s must have exactly one probe type set", field),
-			Field:   field.String(),
+			Message: fmt.Sprintf("bootloader does not match the %s firmware of profile %s", profile.Type, profile.Name),
+			Field:   field.Child("domain", "firmware", "bootloader").String(),
 		})
 	}
-
-	if numHandlers < 1 {
+	if config.GetFirmwareImage(profile.Name, spec.Architecture) != nil && !config.ImageVolumeEnabled() {
 		causes = append(causes, metav1.StatusCause{
-			Type: metav1.CauseTypeFieldValueRequired,
-			Message: fmt.Sprintf("either %s, %s or %s must be set if a %s is specified",
-				field.Child("tcpSocket").String(),
-				field.Child("exec").String(),
-				field.Child("httpGet").String(),
-				field,
-			),
-			Field: field.String(),
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.ImageVolume),
+			Field:   profileField.String(),
 		})
 	}
-
 	return causes
 }
 
-func appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field *k8sfield.Path, probe *v1.Probe, causes []metav1.StatusCause) []metav1.StatusCause {
-	if probe == nil {
-		return causes
+func bootloaderMatchesFirmwareType(firmware *v1.Firmware, firmwareType v1.FirmwareType) bool {
+	switch firmwareType {
+	case v1.FirmwareTypeBIOS:
+		return !efiBootEnabled(firmware)
+	case v1.FirmwareTypeUEFI:
+		return efiBootEnabled(firmware) && !secureBootEnabled(firmware)
+	case v1.FirmwareTypeUEFISecureBoot:
+		return secureBootEnabled(firmware)
 	}
+	return false
+}
 
-	if probe.HTTPGet != nil {
-		causes = append(causes, podNetworkRequiredStatusCause(field.Child("httpGet")))
+func validateEmulatorBundle(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.Domain.EmulatorBundle == "" {
+		return nil
 	}
-
-	if probe.TCPSocket != nil {
-		causes = append(causes, podNetworkRequiredStatusCause(field.Child("tcpSocket")))
+	bundleField := field.Child("domain", "emulatorBundle")
+	if !config.ImageVolumeEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.ImageVolume),
+			Field:   bundleField.String(),
+		}}
 	}
-	return causes
-}
-
-func podNetworkRequiredStatusCause(field *k8sfield.Path) metav1.StatusCause {
-	return metav1.StatusCause{
-		Type:    metav1.CauseTypeFieldValueInvalid,
-		Message: fmt.Sprintf("%s is only allowed if the Pod Network is attached", field.String()),
-		Field:   field.String(),
+	for _, bundle := range config.GetConfig().EmulatorBundles {
+		if bundle.Name == spec.Domain.EmulatorBundle {
+			return nil
+		}
 	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotFound,
+		Message: fmt.Sprintf("emulator bundle %s is not configured in kubevirt-config", spec.Domain.EmulatorBundle),
+		Field:   bundleField.String(),
+	}}
 }
 
-func isValidEvictionStrategy(evictionStrategy *v1.EvictionStrategy) bool {
-	return evictionStrategy == nil ||
-		*evictionStrategy == v1.EvictionStrategyLiveMigrate ||
-		*evictionStrategy == v1.EvictionStrategyLiveMigrateIfPossible ||
-		*evictionStrategy == v1.EvictionStrategyNone ||
-		*evictionStrategy == v1.EvictionStrategyExternal
-}
-
-func validateLiveMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+func validateLauncherPodSettings(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
-	evictionStrategy := config.GetConfig().EvictionStrategy
+	if spec.RuntimeClassName == nil && spec.LauncherMetadata == nil {
+		return causes
+	}
+	allowed := config.GetConfig().LauncherPodConfiguration
+	if allowed == nil {
+		allowed = &v1.LauncherPodConfiguration{}
+	}
 
-	if spec.EvictionStrategy != nil {
-		evictionStrategy = spec.EvictionStrategy
+	if spec.RuntimeClassName != nil {
+		runtimeClassField := field.Child("runtimeClassName")
+		if errs := validation.IsDNS1123Subdomain(*spec.RuntimeClassName); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("runtimeClassName %q is invalid: %s", *spec.RuntimeClassName, strings.Join(errs, ", ")),
+				Field:   runtimeClassField.String(),
+			})
+		} else if !slices.Contains(allowed.AllowedRuntimeClasses, *spec.RuntimeClassName) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("runtimeClassName %q is not allowed by launcherPodConfiguration in kubevirt-config", *spec.RuntimeClassName),
+				Field:   runtimeClassField.String(),
+			})
+		}
 	}
-	if !isValidEvictionStrategy(evictionStrategy) {
-		causes = append(causes, metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s is set with an unrecognized option: %s", field.Child("evictionStrategy").String(), *spec.EvictionStrategy),
-			Field:   field.Child("evictionStrategy").String(),
-		})
+
+	if spec.LauncherMetadata == nil {
+		return causes
+	}
+	labelsField := field.Child("launcherMetadata", "labels")
+	for _, key := range slices.Sorted(maps.Keys(spec.LauncherMetadata.Labels)) {
+		errs := append(validation.IsQualifiedName(key), validation.IsValidLabelValue(spec.LauncherMetadata.Labels[key])...)
+		causes = append(causes, validateLauncherMetadataKey(labelsField.Key(key), "label", key, errs, allowed.AllowedLabelPrefixes)...)
 	}
+	annotationsField := field.Child("launcherMetadata", "annotations")
+	for _, key := range slices.Sorted(maps.Keys(spec.LauncherMetadata.Annotations)) {
+		errs := validation.IsQualifiedName(strings.ToLower(key))
+		causes = append(causes, validateLauncherMetadataKey(annotationsField.Key(key), "annotation", key, errs, allowed.AllowedAnnotationPrefixes)...)
+	}
+
 	return causes
 }
 
-func countConfiguredMDEVRamFBs(spec *v1.VirtualMachineInstanceSpec) int {
-	count := 0
-	for _, device := range spec.Domain.Devices.GPUs {
-		if device.VirtualGPUOptions != nil &&
-			device.VirtualGPUOptions.Display != nil &&
-			(device.VirtualGPUOptions.Display.Enabled == nil || *device.VirtualGPUOptions.Display.Enabled) &&
-			(device.VirtualGPUOptions.Display.RamFB == nil || (device.VirtualGPUOptions.Display.RamFB.Enabled != nil && *device.VirtualGPUOptions.Display.RamFB.Enabled)) {
-			count++
-		}
+func validateLauncherIsolation(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if spec.LauncherIsolation != v1.LauncherIsolationMicroVM {
+		return nil
+	}
+	isolationField := field.Child("launcherIsolation")
+	if !config.IsolatedLauncherEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.IsolatedLauncherGate),
+			Field:   isolationField.String(),
+		}}
 	}
-	return count
-}
 
-func validateMDEVRamFB(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	if countConfiguredMDEVRamFBs(spec) > 1 {
+	// virt-handler can not detect the isolation of a launcher inside a micro VM yet, nor reach its
+	// sockets or set up its network, so isolated launchers would never start.
+	causes := []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("the %s launcher isolation is not functional yet", v1.LauncherIsolationMicroVM),
+		Field:   isolationField.String(),
+	}}
+	if podConfig := config.GetConfig().LauncherPodConfiguration; podConfig == nil || podConfig.IsolatedRuntimeClass == "" {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "configuring multiple displays with ramfb is not valid ",
-			Field:   field.Child("GPUs").String(),
+			Message: "launcherPodConfiguration in kubevirt-config has no isolatedRuntimeClass",
+			Field:   isolationField.String(),
 		})
-
 	}
-	return causes
-}
-
-func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	if spec.Domain.Devices.HostDevices != nil && !config.HostDevicesPassthroughEnabled() {
+	if spec.RuntimeClassName != nil {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Host Devices feature gate is not enabled in kubevirt-config",
-			Field:   field.Child("HostDevices").String(),
+			Message: fmt.Sprintf("runtimeClassName cannot be combined with the %s launcher isolation", v1.LauncherIsolationMicroVM),
+			Field:   field.Child("runtimeClassName").String(),
 		})
 	}
-	return causes
-}
-
-func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
-	var causes []metav1.StatusCause
-	if spec.Domain.Devices.Sound == nil {
-		return causes
+	if len(spec.Domain.Devices.HostDevices) != 0 || len(spec.Domain.Devices.GPUs) != 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("host devices and GPUs cannot be passed through with the %s launcher isolation", v1.LauncherIsolationMicroVM),
+			Field:   field.Child("domain", "devices").String(),
+		})
 	}
-	model := spec.Domain.Devices.Sound.Model
-	if model != "" && model != "ich9" && model != "ac97" {
+	if spec.Domain.UseEmulation != nil && *spec.Domain.UseEmulation {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Sound device type is not supported. Options: 'ich9' or 'ac97'",
-			Field:   field.Child("Sound").String(),
+			Message: fmt.Sprintf("software emulation cannot be combined with the %s launcher isolation", v1.LauncherIsolationMicroVM),
+			Field:   field.Child("domain", "useEmulation").String(),
 		})
 	}
-	if spec.Domain.Devices.Sound.Name == "" {
+	if cpu := spec.Domain.CPU; cpu != nil && (cpu.DedicatedCPUPlacement || cpu.Realtime != nil) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: "Sound device requires a name field.",
-			Field:   field.Child("Sound").String(),
+			Message: fmt.Sprintf("dedicated CPUs and realtime cannot be combined with the %s launcher isolation", v1.LauncherIsolationMicroVM),
+			Field:   field.Child("domain", "cpu").String(),
 		})
 	}
-
 	return causes
 }
 
+func validateLauncherMetadataKey(field *k8sfield.Path, kind, key string, errs []string, allowedPrefixes []string) []metav1.StatusCause {
+	switch {
+	case len(errs) != 0:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %q is invalid: %s", kind, key, strings.Join(errs, ", ")),
+			Field:   field.String(),
+		}}
+	case isKubeVirtReservedKey(key):
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s %q uses a prefix reserved for KubeVirt", kind, key),
+			Field:   field.String(),
+		}}
+	case !slices.ContainsFunc(allowedPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) }):
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s %q is not allowed by launcherPodConfiguration in kubevirt-config", kind, key),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
+func isKubeVirtReservedKey(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	return found && (prefix == "kubevirt.io" || strings.HasSuffix(prefix, ".kubevirt.io"))
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -663,6 +1670,47 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+func validateNestedVirtualization(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	cpu := spec.Domain.CPU
+	if cpu == nil || cpu.NestedVirtualization == nil || !*cpu.NestedVirtualization {
+		return causes
+	}
+	for idx, feature := range cpu.Features {
+		if (feature.Name == "vmx" || feature.Name == "svm") && (feature.Policy == "disable" || feature.Policy == "forbid") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("CPU feature %s can't use policy %s when nested virtualization is enabled", feature.Name, feature.Policy),
+				Field:   field.Child("domain", "cpu", "features").Index(idx).Child("policy").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDIMMSlots(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Memory == nil || spec.Domain.Memory.DIMMSlots == nil {
+		return causes
+	}
+	dimmSlotsField := field.Child("domain", "memory", "dimmSlots")
+	if !config.DIMMMemoryHotplugEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.DIMMMemoryHotplugGate),
+			Field:   dimmSlotsField.String(),
+		})
+	}
+	if slots := *spec.Domain.Memory.DIMMSlots; slots < 1 || slots > maxDIMMSlots {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be between 1 and %d", dimmSlotsField.String(), maxDIMMSlots),
+			Field:   dimmSlotsField.String(),
+		})
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
@@ -675,6 +1723,39 @@ func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInst
 	return causes
 }
 
+func validateCPUCoreType(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.CPU == nil || spec.Domain.CPU.CoreType == "" {
+		return causes
+	}
+	coreTypeField := field.Child("domain", "cpu", "coreType")
+	if !config.HybridCPUPinningEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.HybridCPUPinningGate),
+			Field:   coreTypeField.String(),
+		})
+	}
+	switch spec.Domain.CPU.CoreType {
+	case v1.CPUCoreTypePerformance, v1.CPUCoreTypeEfficiency:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("CPU core type %s is not supported. Options: '%s' or '%s'",
+				spec.Domain.CPU.CoreType, v1.CPUCoreTypePerformance, v1.CPUCoreTypeEfficiency),
+			Field: coreTypeField.String(),
+		})
+	}
+	if !spec.Domain.CPU.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "CoreType should be only set in combination with DedicatedCPUPlacement",
+			Field:   coreTypeField.String(),
+		})
+	}
+	return causes
+}
+
 func validateCpuPinning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.DedicatedCPUPlacement {
@@ -877,8 +1958,9 @@ func validateCPURequestIsInteger(field *k8sfield.Path, spec *v1.VirtualMachineIn
 
 func validateMemoryLimitAndRequestProvided(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
+	memory := spec.Domain.Memory
 	if spec.Domain.Resources.Limits.Memory().Value() == 0 && spec.Domain.Resources.Requests.Memory().Value() == 0 &&
-		spec.Domain.Memory.Hugepages == nil && spec.Domain.Memory.Guest.Value() == 0 {
+		(memory == nil || memory.Hugepages == nil && (memory.Guest == nil || memory.Guest.Value() == 0)) {
 		causes = append(causes, metav1.StatusCause{
 			Type: metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s, %s, %s or %s should be provided",
@@ -1413,6 +2495,7 @@ func validateDomainSpec(field *k8sfield.Path, spec *v1.DomainSpec) []metav1.Stat
 	var causes []metav1.StatusCause
 
 	causes = append(causes, storageadmitters.ValidateDisks(field.Child("devices").Child("disks"), spec.Devices.Disks)...)
+	causes = append(causes, storageadmitters.ValidateSCSIControllers(field.Child("devices"), &spec.Devices)...)
 	causes = append(causes, validateFirmware(field.Child("firmware"), spec.Firmware)...)
 
 	if secureBootEnabled(spec.Firmware) && !smmFeatureEnabled(spec.Features) {
@@ -1973,6 +3056,80 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+func validateNVMe(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if config.NVMeEnabled() {
+		return causes
+	}
+
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil && disk.Disk.Bus == v1.DiskBusNVMe {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.NVMeGate),
+				Field:   field.Child("domain", "devices", "disks").Index(idx).Child("disk", "bus").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validatePTPClock rejects disabling the KVM clock, which the ptp_kvm driver of x86 guests reads
+// the host clock through
+func validatePTPClock(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	clock := spec.Domain.Clock
+	if clock == nil || clock.PTP == nil || (clock.PTP.Enabled != nil && !*clock.PTP.Enabled) {
+		return causes
+	}
+
+	if clock.Timer != nil && clock.Timer.KVM != nil && clock.Timer.KVM.Enabled != nil && !*clock.Timer.KVM.Enabled {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the KVM clock", field.Child("domain", "clock", "ptp").String()),
+			Field:   field.Child("domain", "clock", "timer", "kvm", "present").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateSGX(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	sgx := spec.Domain.Devices.SGX
+	if sgx == nil {
+		return causes
+	}
+	sgxField := field.Child("domain", "devices", "sgx")
+
+	if !config.SGXEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.SGXGate),
+			Field:   sgxField.String(),
+		})
+	}
+
+	if sgx.EPCSize.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", sgxField.Child("epcSize").String()),
+			Field:   sgxField.Child("epcSize").String(),
+		})
+	}
+
+	if spec.Domain.LaunchSecurity != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with %s", sgxField.String(), field.Child("domain", "launchSecurity").String()),
+			Field:   sgxField.String(),
+		})
+	}
+
+	return causes
+}
+
 func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.CPU != nil && spec.Domain.CPU.MaxSockets != 0 {
@@ -2011,6 +3168,40 @@ func validateVideoConfig(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSp
 		})
 	}
 
+	causes = append(causes, validateVideoResolution(field.Child("domain", "devices", "video"), spec.Domain.Devices.Video)...)
+
+	return causes
+}
+
+// videoTypesWithResolution are the video types QEMU can advertise a preferred resolution for through EDID
+var videoTypesWithResolution = []string{"vga", v1.VirtIO, "bochs"}
+
+func validateVideoResolution(field *k8sfield.Path, video *v1.VideoDevice) (causes []metav1.StatusCause) {
+	resolution := video.Resolution
+	if resolution == nil {
+		return causes
+	}
+	if !slices.Contains(videoTypesWithResolution, video.Type) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("video model '%s' does not support a resolution, supported models: %s", video.Type, strings.Join(videoTypesWithResolution, ", ")),
+			Field:   field.Child("resolution").String(),
+		})
+	}
+	if resolution.Width == 0 || resolution.Width > util.MaxDisplayWidth {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("width must be between 1 and %d", util.MaxDisplayWidth),
+			Field:   field.Child("resolution", "width").String(),
+		})
+	}
+	if resolution.Height == 0 || resolution.Height > util.MaxDisplayHeight {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("height must be between 1 and %d", util.MaxDisplayHeight),
+			Field:   field.Child("resolution", "height").String(),
+		})
+	}
 	return causes
 }
 
@@ -21,8 +21,10 @@ package admitters
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
 	"runtime"
 	"strings"
 
@@ -335,6 +337,55 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Allowed).To(BeFalse())
 			Expect(resp.Result.Message).To(Equal(`spec.readinessProbe.tcpSocket is only allowed if the Pod Network is attached, spec.livenessProbe.httpGet is only allowed if the Pod Network is attached`))
 		})
+		It("should accept guest agent exec and vsock http probes if no Pod Network is present", func() {
+			enableFeatureGates(featuregate.VSOCKGate)
+			vmi := newBaseVmi(
+				libvmi.WithAutoAttachPodInterface(false),
+				withReadinessProbe(&v1.Probe{
+					Handler: v1.Handler{
+						GuestAgentExec: &v1.GuestAgentExecAction{Command: []string{"systemctl", "is-active", "nginx"}},
+					},
+				}),
+				withLivenessProbe(&v1.Probe{
+					Handler: v1.Handler{
+						VSockHTTP: &v1.VSockHTTPAction{Port: 8080, Path: "/healthz"},
+					},
+				}),
+			)
+			vmi.Spec.Domain.Devices.AutoattachVSOCK = pointer.P(true)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		DescribeTable("should reject invalid guest based probes", func(handler v1.Handler, expectedMessage string) {
+			vmi := newBaseVmi(
+				libvmi.WithAutoAttachPodInterface(false),
+				withReadinessProbe(&v1.Probe{Handler: handler}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(Equal(expectedMessage))
+		},
+			Entry("guest agent exec with an empty command",
+				v1.Handler{GuestAgentExec: &v1.GuestAgentExecAction{Command: []string{""}}},
+				"spec.readinessProbe.guestAgentExec.command must not be empty",
+			),
+			Entry("vsock http without autoattachVSOCK",
+				v1.Handler{VSockHTTP: &v1.VSockHTTPAction{Port: 8080}},
+				"spec.readinessProbe.vsockHTTP is only allowed if autoattachVSOCK is enabled",
+			),
+			Entry("vsock http without port and with a relative path",
+				v1.Handler{VSockHTTP: &v1.VSockHTTPAction{Path: "healthz"}},
+				"spec.readinessProbe.vsockHTTP.port must be set, spec.readinessProbe.vsockHTTP.path must be an absolute path, spec.readinessProbe.vsockHTTP is only allowed if autoattachVSOCK is enabled",
+			),
+		)
 	})
 
 	It("should accept valid vmi spec on create", func() {
@@ -625,6 +676,38 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
 		})
 
+		DescribeTable("should accept valid IOMMU devices", func(iommu v1.IOMMUDevice) {
+			vmi.Spec.Domain.Devices.IOMMU = &iommu
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with the default model", v1.IOMMUDevice{}),
+			Entry("with the virtio model", v1.IOMMUDevice{Model: v1.IOMMUModelVirtio}),
+			Entry("with the intel model and all its options", v1.IOMMUDevice{
+				Model:              v1.IOMMUModelIntel,
+				CachingMode:        pointer.P(true),
+				InterruptRemapping: pointer.P(true),
+				EIM:                pointer.P(true),
+			}),
+		)
+
+		DescribeTable("should reject invalid IOMMU devices", func(iommu v1.IOMMUDevice, expectedField, expectedMessage string) {
+			vmi.Spec.Domain.Devices.IOMMU = &iommu
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("with an unknown model", v1.IOMMUDevice{Model: "amd"},
+				"fake.domain.devices.iommu.model", "IOMMU model amd is not supported. Options: 'intel' or 'virtio'"),
+			Entry("with caching mode on the virtio model", v1.IOMMUDevice{Model: v1.IOMMUModelVirtio, CachingMode: pointer.P(true)},
+				"fake.domain.devices.iommu.cachingMode", "cachingMode is only supported by the intel IOMMU model"),
+			Entry("with interrupt remapping on the virtio model", v1.IOMMUDevice{Model: v1.IOMMUModelVirtio, InterruptRemapping: pointer.P(true)},
+				"fake.domain.devices.iommu.interruptRemapping", "interruptRemapping is only supported by the intel IOMMU model"),
+			Entry("with eim but without interrupt remapping", v1.IOMMUDevice{EIM: pointer.P(true)},
+				"fake.domain.devices.iommu.eim", "eim requires interruptRemapping to be enabled"),
+		)
+
 		It("should reject volume with missing disk / file system", func() {
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
 				Name: "testvolume",
@@ -708,26 +791,85 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					Name: "tablet0",
 					Bus:  v1.InputBus("ps2"),
 				}, 1, []string{"fake.domain.devices.inputs[0].bus"}, "Expect bus error"),
-			Entry("and reject input with keyboard type and virtio bus",
+			Entry("and accept input with keyboard type",
 				v1.Input{
 					Type: v1.InputTypeKeyboard,
-					Name: "tablet0",
+					Name: "keyboard0",
 					Bus:  v1.InputBusVirtio,
-				}, 1, []string{"fake.domain.devices.inputs[0].type"}, "Expect type error"),
-			Entry("and reject input with keyboard type and usb bus",
+				}, 0, []string{}, "Expect no errors"),
+			Entry("and accept input with mouse type",
 				v1.Input{
-					Type: v1.InputTypeKeyboard,
-					Name: "tablet0",
+					Type: v1.InputTypeMouse,
+					Name: "mouse0",
+					Bus:  v1.InputBusUSB,
+				}, 0, []string{}, "Expect no errors"),
+			Entry("and accept input with multitouch type and virtio bus",
+				v1.Input{
+					Type: v1.InputTypeMultitouch,
+					Name: "touch0",
+					Bus:  v1.InputBusVirtio,
+				}, 0, []string{}, "Expect no errors"),
+			Entry("and reject input with multitouch type and usb bus",
+				v1.Input{
+					Type: v1.InputTypeMultitouch,
+					Name: "touch0",
+					Bus:  v1.InputBusUSB,
+				}, 1, []string{"fake.domain.devices.inputs[0].bus"}, "Expect bus error"),
+			Entry("and reject input with multitouch type without bus",
+				v1.Input{
+					Type: v1.InputTypeMultitouch,
+					Name: "touch0",
+				}, 1, []string{"fake.domain.devices.inputs[0].bus"}, "Expect bus error"),
+			Entry("and reject input with unknown type",
+				v1.Input{
+					Type: v1.InputType("joystick"),
+					Name: "joystick0",
 					Bus:  v1.InputBusUSB,
 				}, 1, []string{"fake.domain.devices.inputs[0].type"}, "Expect type error"),
 			Entry("and reject input with wrong type and wrong bus",
 				v1.Input{
-					Type: v1.InputTypeKeyboard,
-					Name: "tablet0",
+					Type: v1.InputType("joystick"),
+					Name: "joystick0",
 					Bus:  v1.InputBus("ps2"),
 				}, 2, []string{"fake.domain.devices.inputs[0].bus", "fake.domain.devices.inputs[0].type"}, "Expect type error"),
 		)
 
+		DescribeTable("should verify the TPM device", func(tpmDevice *v1.TPMDevice, expectedFields ...string) {
+			vmi.Spec.Domain.Devices.TPM = tpmDevice
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("and accept TPM 1.2", &v1.TPMDevice{Version: v1.TPMVersion12}),
+			Entry("and accept an encrypted persistent TPM", &v1.TPMDevice{
+				Persistent:          pointer.P(true),
+				EncryptionSecretRef: &k8sv1.LocalObjectReference{Name: "tpm-passphrase"},
+			}),
+			Entry("and reject an unknown version", &v1.TPMDevice{Version: "3.0"}, "fake.domain.devices.tpm.version"),
+			Entry("and reject an encrypted non-persistent TPM", &v1.TPMDevice{
+				EncryptionSecretRef: &k8sv1.LocalObjectReference{Name: "tpm-passphrase"},
+			}, "fake.domain.devices.tpm.encryptionSecretRef"),
+			Entry("and reject an encryption secret without name", &v1.TPMDevice{
+				Persistent:          pointer.P(true),
+				EncryptionSecretRef: &k8sv1.LocalObjectReference{},
+			}, "fake.domain.devices.tpm.encryptionSecretRef.name"),
+		)
+
+		DescribeTable("should verify the VNC keymap", func(keymap string, expectedCauses int) {
+			vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{Keymap: keymap}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.graphics.keymap"))
+			}
+		},
+			Entry("and accept the default keymap", "", 0),
+			Entry("and accept a known keymap", "fr-ch", 0),
+			Entry("and reject an unknown keymap", "klingon", 1),
+		)
+
 		It("should reject negative requests.cpu value", func() {
 			vm := api.NewMinimalVMI("testvm")
 
@@ -1259,6 +1401,49 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("DV should be rejected when the deprecated feature gate is enabled", featuregate.VirtIOFSGate, false, libvmi.WithFilesystemDV("sharedtestdisk")),
 		)
 
+		DescribeTable("should validate the virtiofs options", func(virtiofs *v1.FilesystemVirtiofs, expectedField string) {
+			vmi := libvmi.New(libvmi.WithConfigMapFs("sharedconfigmap", "sharedconfigmap"))
+			vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs = virtiofs
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept all options", &v1.FilesystemVirtiofs{
+				QueueSize:      pointer.P(uint32(256)),
+				ThreadPoolSize: pointer.P(uint32(0)),
+				IDMap: &v1.FilesystemIDMap{
+					UID: []v1.FilesystemIDMapRange{{Start: 0, Target: 107, Count: 1}, {Start: 1000, Target: 1000, Count: 1000}},
+					GID: []v1.FilesystemIDMapRange{{Start: 0, Target: 107, Count: 1}},
+				},
+			}, ""),
+			Entry("reject a queue size which is not a power of two", &v1.FilesystemVirtiofs{
+				QueueSize: pointer.P(uint32(1000)),
+			}, "fake.domain.devices.filesystems[0].virtiofs.queueSize"),
+			Entry("reject a queue size larger than 1024", &v1.FilesystemVirtiofs{
+				QueueSize: pointer.P(uint32(2048)),
+			}, "fake.domain.devices.filesystems[0].virtiofs.queueSize"),
+			Entry("reject an empty ID range", &v1.FilesystemVirtiofs{
+				IDMap: &v1.FilesystemIDMap{
+					UID: []v1.FilesystemIDMapRange{{Start: 0, Target: 107}},
+				},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMap.uid[0].count"),
+			Entry("reject an ID range exceeding the range of IDs", &v1.FilesystemVirtiofs{
+				IDMap: &v1.FilesystemIDMap{
+					GID: []v1.FilesystemIDMapRange{{Start: 0, Target: math.MaxUint32, Count: 2}},
+				},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMap.gid[0].count"),
+			Entry("reject overlapping ID ranges", &v1.FilesystemVirtiofs{
+				IDMap: &v1.FilesystemIDMap{
+					UID: []v1.FilesystemIDMapRange{{Start: 0, Target: 1000, Count: 100}, {Start: 50, Target: 2000, Count: 100}},
+				},
+			}, "fake.domain.devices.filesystems[0].virtiofs.idMap.uid[1].start"),
+		)
+
 		It("should reject host devices when feature gate is disabled", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
@@ -1399,74 +1584,848 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
-		It("should reject invalid start strategy", func() {
-			strategy := v1.StartStrategy("invalid")
-			vmi.Spec.StartStrategy = &strategy
+		It("should reject invalid start strategy", func() {
+			strategy := v1.StartStrategy("invalid")
+			vmi.Spec.StartStrategy = &strategy
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
+			Expect(causes[0].Message).To(Equal("fake.startStrategy is set with an unrecognized option: invalid"))
+		})
+
+		It("should reject spec with paused start strategy and LivenessProbe", func() {
+			strategy := v1.StartStrategyPaused
+			vmi.Spec.StartStrategy = &strategy
+			vmi.Spec.LivenessProbe = &v1.Probe{
+				InitialDelaySeconds: 2,
+				Handler: v1.Handler{
+					HTTPGet: &k8sv1.HTTPGetAction{Host: "test", Port: intstr.Parse("80")},
+				},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
+			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
+		})
+
+		Context("with panic devices defined", func() {
+			It("should fail when PanicDevices featuregate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Hyperv)}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices"))
+				Expect(causes[0].Message).To(Equal("Panic Devices feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should allow valid panic device model", func() {
+				enableFeatureGates(featuregate.PanicDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Hyperv)}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject invalid panic device model", func() {
+				enableFeatureGates(featuregate.PanicDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				panicDeviceModel := v1.PanicDeviceModel("bad")
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: &panicDeviceModel}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices[0].model"))
+				Expect(causes[0].Message).To(Equal(fmt.Sprintf(invalidPanicDeviceModelErrFmt, panicDeviceModel)))
+			})
+
+			It("should reject panic devices on s390x architecture", func() {
+				enableFeatureGates(featuregate.PanicDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Architecture = "s390x"
+				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Isa)}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices"))
+				Expect(causes[0].Message).To(Equal("custom panic devices are not supported on s390x architecture"))
+			})
+		})
+
+		Context("with a watchdog remediation", func() {
+			newWatchdogVMI := func(remediation *v1.WatchdogRemediation) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: v1.WatchdogDevice{
+						I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionReset},
+					},
+					Remediation: remediation,
+				}
+				return vmi
+			}
+
+			It("should allow the RestartVM remediation", func() {
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationRestartVM})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject an unknown remediation", func() {
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: "Explode"})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.watchdog.remediation.type"))
+				Expect(causes[0].Message).To(Equal("Explode is not a supported watchdog remediation"))
+			})
+
+			It("should allow the MemoryDump remediation with a claim when volume hotplug is enabled", func() {
+				enableFeatureGates(featuregate.HotplugVolumesGate)
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationMemoryDump, MemoryDumpClaimName: "dump"})
+				vmi.Spec.Domain.Devices.Watchdog.I6300ESB.Action = v1.WatchdogActionPause
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject the MemoryDump remediation with a watchdog action which does not keep the guest memory", func() {
+				enableFeatureGates(featuregate.HotplugVolumesGate)
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationMemoryDump, MemoryDumpClaimName: "dump"})
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.watchdog.remediation.type"))
+				Expect(causes[0].Message).To(Equal("the MemoryDump remediation requires the pause or none watchdog action"))
+			})
+
+			It("should reject the MemoryDump remediation without a claim", func() {
+				enableFeatureGates(featuregate.HotplugVolumesGate)
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationMemoryDump})
+				vmi.Spec.Domain.Devices.Watchdog.I6300ESB.Action = v1.WatchdogActionNone
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.watchdog.remediation.memoryDumpClaimName"))
+			})
+
+			It("should reject the MemoryDump remediation when volume hotplug is disabled", func() {
+				vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationMemoryDump, MemoryDumpClaimName: "dump"})
+				vmi.Spec.Domain.Devices.Watchdog.I6300ESB.Action = v1.WatchdogActionPause
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.watchdog.remediation.type"))
+				Expect(causes[0].Message).To(Equal("the MemoryDump remediation requires volume hotplug to be enabled"))
+			})
+		})
+
+		Context("with a disk space low threshold", func() {
+			It("should allow the default threshold", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.DiskSpaceLow = &v1.DiskSpaceLowThreshold{}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject an invalid threshold", func(used, hysteresis *int32, expectedField string) {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.DiskSpaceLow = &v1.DiskSpaceLowThreshold{
+					UsedPercentage:       used,
+					HysteresisPercentage: hysteresis,
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("with a used percentage of 0", pointer.P(int32(0)), nil, "fake.diskSpaceLow.usedPercentage"),
+				Entry("with a used percentage above 100", pointer.P(int32(101)), nil, "fake.diskSpaceLow.usedPercentage"),
+				Entry("with a negative hysteresis", nil, pointer.P(int32(-1)), "fake.diskSpaceLow.hysteresisPercentage"),
+				Entry("with a hysteresis not lower than the used percentage", pointer.P(int32(50)), pointer.P(int32(50)), "fake.diskSpaceLow.hysteresisPercentage"),
+			)
+		})
+
+		Context("with shared memory devices defined", func() {
+			size := resource.MustParse("4Mi")
+
+			enableWithAllowedHostPaths := func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.SharedMemoryDevicesGate}
+				kvConfig.Spec.Configuration.AllowedHostPaths = []string{"/dev/shm", "/run/ivshmem"}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			}
+
+			It("should fail when SharedMemoryDevices featuregate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.SharedMemoryDevices = []v1.SharedMemoryDevice{{Name: "ring", Size: &size}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.sharedMemoryDevices"))
+				Expect(causes[0].Message).To(Equal("SharedMemoryDevices feature gate is not enabled in kubevirt-config"))
+			})
+
+			DescribeTable("should accept", func(shmem v1.SharedMemoryDevice) {
+				enableWithAllowedHostPaths()
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.SharedMemoryDevices = []v1.SharedMemoryDevice{shmem}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("a plain device", v1.SharedMemoryDevice{Name: "ring", Size: &size}),
+				Entry("a plain device backed by a host file", v1.SharedMemoryDevice{Name: "ring", Size: &size, HostPath: "/dev/shm/ring"}),
+				Entry("a doorbell device", v1.SharedMemoryDevice{Name: "bell", Model: v1.SharedMemoryModelDoorbell, ServerSocketPath: "/run/ivshmem/ivshmem.sock"}),
+			)
+
+			DescribeTable("should reject", func(shmem v1.SharedMemoryDevice, field, message string) {
+				enableWithAllowedHostPaths()
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.SharedMemoryDevices = []v1.SharedMemoryDevice{shmem}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("an invalid name", v1.SharedMemoryDevice{Name: "Ring", Size: &size},
+					"fake.domain.devices.sharedMemoryDevices[0].name", `shared memory device name "Ring" must be a DNS_LABEL of at most 57 characters`),
+				Entry("an unknown model", v1.SharedMemoryDevice{Name: "ring", Model: "ivshmem", Size: &size},
+					"fake.domain.devices.sharedMemoryDevices[0].model", "shared memory model ivshmem is not supported. Options: 'ivshmem-plain' or 'ivshmem-doorbell'"),
+				Entry("a plain device without size", v1.SharedMemoryDevice{Name: "ring"},
+					"fake.domain.devices.sharedMemoryDevices[0].size", "fake.domain.devices.sharedMemoryDevices[0].size is a required field"),
+				Entry("a size which is not a power of two", v1.SharedMemoryDevice{Name: "ring", Size: pointer.P(resource.MustParse("3Mi"))},
+					"fake.domain.devices.sharedMemoryDevices[0].size", "shared memory size 3Mi must be a power of two"),
+				Entry("a relative host path", v1.SharedMemoryDevice{Name: "ring", Size: &size, HostPath: "shm/ring"},
+					"fake.domain.devices.sharedMemoryDevices[0].hostPath", "hostPath shm/ring must be an absolute path"),
+				Entry("a host path escaping the allowed paths", v1.SharedMemoryDevice{Name: "ring", Size: &size, HostPath: "/dev/shm/../../etc/shadow"},
+					"fake.domain.devices.sharedMemoryDevices[0].hostPath", "hostPath /dev/shm/../../etc/shadow must be a clean path"),
+				Entry("a host path outside the allowed paths", v1.SharedMemoryDevice{Name: "ring", Size: &size, HostPath: "/etc/shadow"},
+					"fake.domain.devices.sharedMemoryDevices[0].hostPath", "hostPath /etc/shadow is not in any of the host paths allowed by the cluster admin"),
+				Entry("a host path sharing a prefix with an allowed path", v1.SharedMemoryDevice{Name: "ring", Size: &size, HostPath: "/dev/shmem"},
+					"fake.domain.devices.sharedMemoryDevices[0].hostPath", "hostPath /dev/shmem is not in any of the host paths allowed by the cluster admin"),
+				Entry("a server socket outside the allowed paths", v1.SharedMemoryDevice{Name: "bell", Model: v1.SharedMemoryModelDoorbell, ServerSocketPath: "/var/run/docker.sock"},
+					"fake.domain.devices.sharedMemoryDevices[0].serverSocketPath", "serverSocketPath /var/run/docker.sock is not in any of the host paths allowed by the cluster admin"),
+				Entry("a server socket on a plain device", v1.SharedMemoryDevice{Name: "ring", Size: &size, ServerSocketPath: "/run/ivshmem/ivshmem.sock"},
+					"fake.domain.devices.sharedMemoryDevices[0].serverSocketPath", "serverSocketPath is only supported by the ivshmem-doorbell model"),
+				Entry("a doorbell device without server socket", v1.SharedMemoryDevice{Name: "bell", Model: v1.SharedMemoryModelDoorbell},
+					"fake.domain.devices.sharedMemoryDevices[0].serverSocketPath", "fake.domain.devices.sharedMemoryDevices[0].serverSocketPath is a required field"),
+				Entry("a doorbell device with size", v1.SharedMemoryDevice{Name: "bell", Model: v1.SharedMemoryModelDoorbell, ServerSocketPath: "/run/ivshmem/ivshmem.sock", Size: &size},
+					"fake.domain.devices.sharedMemoryDevices[0].size", "size is only supported by the ivshmem-plain model"),
+			)
+
+			It("should reject duplicate names", func() {
+				enableFeatureGates(featuregate.SharedMemoryDevicesGate)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.SharedMemoryDevices = []v1.SharedMemoryDevice{
+					{Name: "ring", Size: &size},
+					{Name: "ring", Size: &size},
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.sharedMemoryDevices[1].name"))
+				Expect(causes[0].Message).To(Equal(`shared memory device name "ring" is used more than once`))
+			})
+		})
+
+		Context("with a CPU core type defined", func() {
+			newCoreTypeVMI := func(coreType v1.CPUCoreType, dedicated bool) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.CPU = &v1.CPU{CoreType: coreType, DedicatedCPUPlacement: dedicated}
+				return vmi
+			}
+
+			It("should fail when HybridCPUPinning featuregate is disabled", func() {
+				vmi := newCoreTypeVMI(v1.CPUCoreTypePerformance, true)
+				causes := validateCPUCoreType(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.cpu.coreType"))
+				Expect(causes[0].Message).To(Equal("HybridCPUPinning feature gate is not enabled in kubevirt-config"))
+			})
+
+			DescribeTable("should accept", func(coreType v1.CPUCoreType) {
+				enableFeatureGates(featuregate.HybridCPUPinningGate)
+				vmi := newCoreTypeVMI(coreType, true)
+				Expect(validateCPUCoreType(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			},
+				Entry("performance cores", v1.CPUCoreTypePerformance),
+				Entry("efficiency cores", v1.CPUCoreTypeEfficiency),
+			)
+
+			DescribeTable("should reject", func(coreType v1.CPUCoreType, dedicated bool, message string) {
+				enableFeatureGates(featuregate.HybridCPUPinningGate)
+				vmi := newCoreTypeVMI(coreType, dedicated)
+				causes := validateCPUCoreType(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.cpu.coreType"))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("an unknown core type", v1.CPUCoreType("turbo"), true,
+					"CPU core type turbo is not supported. Options: 'performance' or 'efficiency'"),
+				Entry("a core type without dedicated CPU placement", v1.CPUCoreTypePerformance, false,
+					"CoreType should be only set in combination with DedicatedCPUPlacement"),
+			)
+		})
+
+		Context("with DIMM slots defined", func() {
+			newDIMMSlotsVMI := func(slots uint32) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Memory = &v1.Memory{DIMMSlots: &slots}
+				return vmi
+			}
+
+			It("should fail when DIMMMemoryHotplug featuregate is disabled", func() {
+				vmi := newDIMMSlotsVMI(16)
+				causes := validateDIMMSlots(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.dimmSlots"))
+				Expect(causes[0].Message).To(Equal("DIMMMemoryHotplug feature gate is not enabled in kubevirt-config"))
+			})
+
+			DescribeTable("should validate the number of slots", func(slots uint32, valid bool) {
+				enableFeatureGates(featuregate.DIMMMemoryHotplugGate)
+				vmi := newDIMMSlotsVMI(slots)
+				causes := validateDIMMSlots(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				if valid {
+					Expect(causes).To(BeEmpty())
+					return
+				}
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.memory.dimmSlots"))
+				Expect(causes[0].Message).To(Equal("fake.domain.memory.dimmSlots must be between 1 and 256"))
+			},
+				Entry("accept a single slot", uint32(1), true),
+				Entry("accept the maximum number of slots", uint32(256), true),
+				Entry("reject zero slots", uint32(0), false),
+				Entry("reject more slots than supported", uint32(257), false),
+			)
+		})
+
+		Context("with channels defined", func() {
+			enableWithAllowedHostPaths := func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.VirtioSerialChannelsGate}
+				kvConfig.Spec.Configuration.AllowedHostPaths = []string{"/var/lib/agent"}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			}
+
+			It("should fail when VirtioSerialChannels featuregate is disabled", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Channels = []v1.Channel{{Name: "agent", Target: "org.example.agent.0"}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.channels"))
+				Expect(causes[0].Message).To(Equal("VirtioSerialChannels feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should accept channels in the pod and on the node", func() {
+				enableWithAllowedHostPaths()
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Channels = []v1.Channel{
+					{Name: "agent", Target: "org.example.agent.0"},
+					{Name: "node-agent", Target: "org.example.node.0", HostPath: "/var/lib/agent"},
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(channels []v1.Channel, field, message string) {
+				enableWithAllowedHostPaths()
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Channels = channels
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("an invalid name", []v1.Channel{{Name: "Agent", Target: "org.example.agent.0"}},
+					"fake.domain.devices.channels[0].name", `channel name "Agent" must be a DNS_LABEL of at most 55 characters`),
+				Entry("a duplicate name", []v1.Channel{{Name: "agent", Target: "org.example.agent.0"}, {Name: "agent", Target: "org.example.agent.1"}},
+					"fake.domain.devices.channels[1].name", `channel name "agent" is used more than once`),
+				Entry("an empty target", []v1.Channel{{Name: "agent"}},
+					"fake.domain.devices.channels[0].target", `channel target "" may only contain alphanumeric characters, '.', '_' and '-'`),
+				Entry("a target with a path separator", []v1.Channel{{Name: "agent", Target: "../agent"}},
+					"fake.domain.devices.channels[0].target", `channel target "../agent" may only contain alphanumeric characters, '.', '_' and '-'`),
+				Entry("the guest agent target", []v1.Channel{{Name: "agent", Target: "org.qemu.guest_agent.0"}},
+					"fake.domain.devices.channels[0].target", `channel target "org.qemu.guest_agent.0" is reserved`),
+				Entry("a duplicate target", []v1.Channel{{Name: "agent", Target: "org.example.agent.0"}, {Name: "other", Target: "org.example.agent.0"}},
+					"fake.domain.devices.channels[1].target", `channel target "org.example.agent.0" is used more than once`),
+				Entry("a relative host path", []v1.Channel{{Name: "agent", Target: "org.example.agent.0", HostPath: "var/lib/agent"}},
+					"fake.domain.devices.channels[0].hostPath", "hostPath var/lib/agent must be an absolute path"),
+				Entry("a host path outside the allowed paths", []v1.Channel{{Name: "agent", Target: "org.example.agent.0", HostPath: "/var/run"}},
+					"fake.domain.devices.channels[0].hostPath", "hostPath /var/run is not in any of the host paths allowed by the cluster admin"),
+			)
+		})
+
+		Context("with serial ports defined", func() {
+			It("should accept serial ports next to the serial console", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{{Name: "kernel", Port: 1}, {Name: "scada", Port: 3}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept serial port 0 without the serial console", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.AutoattachSerialConsole = pointer.P(false)
+				vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{{Name: "ttys0", Port: 0}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(serials []v1.SerialPort, field, message string) {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Serials = serials
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("an invalid name", []v1.SerialPort{{Name: "ttyS1", Port: 1}},
+					"fake.domain.devices.serials[0].name", `serial port name "ttyS1" must be a DNS_LABEL`),
+				Entry("a duplicate name", []v1.SerialPort{{Name: "kernel", Port: 1}, {Name: "kernel", Port: 2}},
+					"fake.domain.devices.serials[1].name", `serial port name "kernel" is used more than once`),
+				Entry("the port of the serial console", []v1.SerialPort{{Name: "kernel", Port: 0}},
+					"fake.domain.devices.serials[0].port", "serial port 0 is already in use"),
+				Entry("a duplicate port", []v1.SerialPort{{Name: "kernel", Port: 1}, {Name: "scada", Port: 1}},
+					"fake.domain.devices.serials[1].port", "serial port 1 is already in use"),
+				Entry("a port exceeding the serial ports", []v1.SerialPort{{Name: "kernel", Port: 4}},
+					"fake.domain.devices.serials[0].port", "serial port 4 exceeds the maximum of 4 serial ports"),
+			)
+		})
+
+		Context("with usb redirection defined", func() {
+			It("should accept a count and a filter", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{
+					Count: pointer.P(uint32(2)),
+					Filter: &v1.USBRedirFilter{
+						Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard},
+						Deny:  []v1.USBDeviceClass{v1.USBDeviceClassMassStorage},
+					},
+				}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(clientPassthrough *v1.ClientPassthroughDevices, field, message string) {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.ClientPassthrough = clientPassthrough
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("a count of zero", &v1.ClientPassthroughDevices{Count: pointer.P(uint32(0))},
+					"fake.domain.devices.clientPassthrough.count", "USB redirection count 0 must be between 1 and 4"),
+				Entry("a count exceeding the maximum", &v1.ClientPassthroughDevices{Count: pointer.P(uint32(5))},
+					"fake.domain.devices.clientPassthrough.count", "USB redirection count 5 must be between 1 and 4"),
+				Entry("an unknown allowed class", &v1.ClientPassthroughDevices{Filter: &v1.USBRedirFilter{Allow: []v1.USBDeviceClass{"smartcards"}}},
+					"fake.domain.devices.clientPassthrough.filter.allow[0]", `USB device class "smartcards" is not supported`),
+				Entry("an unknown denied class", &v1.ClientPassthroughDevices{Filter: &v1.USBRedirFilter{Deny: []v1.USBDeviceClass{"hid", "storage"}}},
+					"fake.domain.devices.clientPassthrough.filter.deny[1]", `USB device class "storage" is not supported`),
+			)
+		})
+
+		Context("with a smartcard defined", func() {
+			It("should accept a passthrough smartcard", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Smartcard = &v1.Smartcard{Passthrough: &v1.SmartcardPassthrough{}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should accept a smartcard emulated from host certificates", func() {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Smartcard = &v1.Smartcard{HostCertificates: &v1.SmartcardHostCertificates{
+					SecretName:   "nssdb",
+					Certificates: []string{"cert1", "cert2", "cert3"},
+				}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(card *v1.Smartcard, field, message string) {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Smartcard = card
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("a smartcard without a mode", &v1.Smartcard{},
+					"fake.domain.devices.smartcard", "exactly one of passthrough or hostCertificates must be set on a smartcard"),
+				Entry("a smartcard with both modes", &v1.Smartcard{
+					Passthrough:      &v1.SmartcardPassthrough{},
+					HostCertificates: &v1.SmartcardHostCertificates{SecretName: "nssdb", Certificates: []string{"cert1", "cert2", "cert3"}},
+				}, "fake.domain.devices.smartcard", "exactly one of passthrough or hostCertificates must be set on a smartcard"),
+				Entry("host certificates without a Secret", &v1.Smartcard{
+					HostCertificates: &v1.SmartcardHostCertificates{Certificates: []string{"cert1", "cert2", "cert3"}},
+				}, "fake.domain.devices.smartcard.hostCertificates.secretName", "the Secret holding the NSS database of the smartcard must be set"),
+				Entry("host certificates with two certificates", &v1.Smartcard{
+					HostCertificates: &v1.SmartcardHostCertificates{SecretName: "nssdb", Certificates: []string{"cert1", "cert2"}},
+				}, "fake.domain.devices.smartcard.hostCertificates.certificates", "an emulated smartcard requires exactly 3 certificates, got 2"),
+				Entry("host certificates with an empty nickname", &v1.Smartcard{
+					HostCertificates: &v1.SmartcardHostCertificates{SecretName: "nssdb", Certificates: []string{"cert1", "", "cert3"}},
+				}, "fake.domain.devices.smartcard.hostCertificates.certificates[1]", "the nickname of a smartcard certificate must not be empty"),
+			)
+		})
+
+		Context("with host sensors defined", func() {
+			It("should fail when HostSensors featuregate is disabled", func() {
+				vmi := libvmi.New(libvmi.WithHostSensors())
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.hostSensors"))
+				Expect(causes[0].Message).To(Equal("HostSensors feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should accept all sensors and known sensor types", func() {
+				enableFeatureGates(featuregate.HostSensorsGate)
+				for _, vmi := range []*v1.VirtualMachineInstance{
+					libvmi.New(libvmi.WithHostSensors()),
+					libvmi.New(libvmi.WithHostSensors(v1.HostSensorTypeTemperature, v1.HostSensorTypePower)),
+				} {
+					causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+					Expect(causes).To(BeEmpty())
+				}
+			})
+
+			It("should reject unknown sensor types", func() {
+				enableFeatureGates(featuregate.HostSensorsGate)
+				vmi := libvmi.New(libvmi.WithHostSensors(v1.HostSensorTypeTemperature, "fan"))
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.hostSensors.types[1]"))
+				Expect(causes[0].Message).To(Equal(`host sensor type "fan" is not supported`))
+			})
+
+			Context("in namespaces", func() {
+				applianceLabels := map[string]string{"appliances": "true"}
+
+				allowHostSensors := func(hostSensors *v1.HostSensorsConfiguration) {
+					kvConfig := kv.DeepCopy()
+					kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.HostSensorsGate}
+					kvConfig.Spec.Configuration.HostSensors = hostSensors
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+				}
+
+				It("should reject host sensors without a configuration", func() {
+					allowHostSensors(nil)
+					vmi := libvmi.New(libvmi.WithHostSensors())
+					causes := ValidateHostSensorsNamespace(k8sfield.NewPath("fake"), &vmi.Spec, applianceLabels, config)
+					Expect(causes).To(HaveLen(1))
+					Expect(causes[0].Field).To(Equal("fake.domain.devices.hostSensors"))
+					Expect(causes[0].Message).To(ContainSubstring("host sensors are not allowed in this namespace"))
+				})
+
+				DescribeTable("should only accept host sensors in the selected namespaces", func(namespaceLabels map[string]string, allowed bool) {
+					allowHostSensors(&v1.HostSensorsConfiguration{
+						NamespaceSelector: metav1.LabelSelector{MatchLabels: applianceLabels},
+					})
+					vmi := libvmi.New(libvmi.WithHostSensors())
+					causes := ValidateHostSensorsNamespace(k8sfield.NewPath("fake"), &vmi.Spec, namespaceLabels, config)
+					if allowed {
+						Expect(causes).To(BeEmpty())
+					} else {
+						Expect(causes).To(HaveLen(1))
+						Expect(causes[0].Field).To(Equal("fake.domain.devices.hostSensors"))
+					}
+				},
+					Entry("in a selected namespace", applianceLabels, true),
+					Entry("in another namespace", map[string]string{"appliances": "false"}, false),
+					Entry("in an unknown namespace", nil, false),
+				)
+
+				It("should ignore VMIs without host sensors", func() {
+					allowHostSensors(nil)
+					vmi := libvmi.New()
+					Expect(ValidateHostSensorsNamespace(k8sfield.NewPath("fake"), &vmi.Spec, nil, config)).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("with guest secrets defined", func() {
+			fwCfgSecret := v1.GuestSecret{Name: "token", SecretName: "bootstrap", Key: "token"}
+
+			newVMI := func(guestSecrets ...v1.GuestSecret) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.GuestSecrets = guestSecrets
+				return vmi
+			}
+
+			It("should fail when GuestSecrets featuregate is disabled", func() {
+				vmi := newVMI(fwCfgSecret)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.guestSecrets"))
+				Expect(causes[0].Message).To(Equal("GuestSecrets feature gate is not enabled in kubevirt-config"))
+			})
+
+			It("should accept secrets in fw_cfg", func() {
+				enableFeatureGates(featuregate.GuestSecretsGate)
+				vmi := newVMI(fwCfgSecret)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject", func(guestSecrets []v1.GuestSecret, field, message string) {
+				enableFeatureGates(featuregate.GuestSecretsGate)
+				vmi := newVMI(guestSecrets...)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(field))
+				Expect(causes[0].Message).To(Equal(message))
+			},
+				Entry("an invalid name", []v1.GuestSecret{{Name: "Token", SecretName: "bootstrap", Key: "token"}},
+					"fake.guestSecrets[0].name", `guest secret name "Token" must be a DNS_LABEL of at most 39 characters`),
+				Entry("a too long name", []v1.GuestSecret{{Name: strings.Repeat("a", 40), SecretName: "bootstrap", Key: "token"}},
+					"fake.guestSecrets[0].name", fmt.Sprintf(`guest secret name %q must be a DNS_LABEL of at most 39 characters`, strings.Repeat("a", 40))),
+				Entry("a duplicate name", []v1.GuestSecret{fwCfgSecret, fwCfgSecret},
+					"fake.guestSecrets[1].name", `guest secret name "token" is used more than once`),
+				Entry("a missing secret name", []v1.GuestSecret{{Name: "token", Key: "token"}},
+					"fake.guestSecrets[0].secretName", "fake.guestSecrets[0].secretName is a required field"),
+				Entry("a missing key", []v1.GuestSecret{{Name: "token", SecretName: "bootstrap"}},
+					"fake.guestSecrets[0].key", "fake.guestSecrets[0].key is a required field"),
+				Entry("an unsupported target", []v1.GuestSecret{{Name: "token", SecretName: "bootstrap", Key: "token", Target: "TPMNVIndex"}},
+					"fake.guestSecrets[0].target", `guest secret target "TPMNVIndex" is not supported`),
+			)
+		})
+
+		Context("with launcher pod settings", func() {
+			BeforeEach(func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.LauncherPodConfiguration = &v1.LauncherPodConfiguration{
+					AllowedRuntimeClasses:     []string{"kata"},
+					AllowedLabelPrefixes:      []string{"cost.example.com/", "team"},
+					AllowedAnnotationPrefixes: []string{"io.katacontainers."},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			It("should accept allowed settings", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.RuntimeClassName = pointer.P("kata")
+				vmi.Spec.LauncherMetadata = &v1.LauncherMetadata{
+					Labels:      map[string]string{"cost.example.com/center": "1234", "team": "virt"},
+					Annotations: map[string]string{"io.katacontainers.config.hypervisor.default_memory": "4096"},
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject settings without an allowlist", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.RuntimeClassName = pointer.P("kata")
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(HaveField("Field", "fake.runtimeClassName")))
+			})
+
+			DescribeTable("should reject", func(launcherMetadata *v1.LauncherMetadata, runtimeClassName *string, expectedField, expectedMessage string) {
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.RuntimeClassName = runtimeClassName
+				vmi.Spec.LauncherMetadata = launcherMetadata
 
-			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
-			Expect(causes).To(HaveLen(1))
-			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
-			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
-			Expect(causes[0].Message).To(Equal("fake.startStrategy is set with an unrecognized option: invalid"))
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+			},
+				Entry("a runtime class which is not allowed", nil, pointer.P("gvisor"),
+					"fake.runtimeClassName", "is not allowed"),
+				Entry("an invalid runtime class", nil, pointer.P("Kata_Containers"),
+					"fake.runtimeClassName", "is invalid"),
+				Entry("a label which is not allowed", &v1.LauncherMetadata{Labels: map[string]string{"owner": "me"}}, nil,
+					"fake.launcherMetadata.labels[owner]", "is not allowed"),
+				Entry("an invalid label value", &v1.LauncherMetadata{Labels: map[string]string{"team": "a b"}}, nil,
+					"fake.launcherMetadata.labels[team]", "is invalid"),
+				Entry("an annotation which is not allowed", &v1.LauncherMetadata{Annotations: map[string]string{"example.com/foo": "bar"}}, nil,
+					"fake.launcherMetadata.annotations[example.com/foo]", "is not allowed"),
+				Entry("a label reserved for KubeVirt", &v1.LauncherMetadata{Labels: map[string]string{"kubevirt.io/domain": "foo"}}, nil,
+					"fake.launcherMetadata.labels[kubevirt.io/domain]", "reserved for KubeVirt"),
+			)
 		})
 
-		It("should reject spec with paused start strategy and LivenessProbe", func() {
-			strategy := v1.StartStrategyPaused
-			vmi.Spec.StartStrategy = &strategy
-			vmi.Spec.LivenessProbe = &v1.Probe{
-				InitialDelaySeconds: 2,
-				Handler: v1.Handler{
-					HTTPGet: &k8sv1.HTTPGetAction{Host: "test", Port: intstr.Parse("80")},
-				},
+		Context("with the MicroVM launcher isolation", func() {
+			configureIsolatedLauncher := func(isolatedRuntimeClass string, featureGates ...string) {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+				kvConfig.Spec.Configuration.LauncherPodConfiguration = &v1.LauncherPodConfiguration{
+					AllowedRuntimeClasses: []string{"kata"},
+					IsolatedRuntimeClass:  isolatedRuntimeClass,
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
 			}
-			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
-			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
 
-			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
-			Expect(causes).To(HaveLen(1))
-			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
-			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
-			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
+			It("should reject it as not functional yet even with an isolated runtime class", func() {
+				configureIsolatedLauncher("kata-qemu", featuregate.IsolatedLauncherGate)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.LauncherIsolation = v1.LauncherIsolationMicroVM
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.launcherIsolation"))
+				Expect(causes[0].Message).To(ContainSubstring("not functional yet"))
+			})
+
+			DescribeTable("should reject", func(isolatedRuntimeClass string, featureGates []string, mutate func(*v1.VirtualMachineInstance), expectedField, expectedMessage string) {
+				configureIsolatedLauncher(isolatedRuntimeClass, featureGates...)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.LauncherIsolation = v1.LauncherIsolationMicroVM
+				mutate(vmi)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(And(
+					HaveField("Field", expectedField),
+					HaveField("Message", ContainSubstring(expectedMessage)),
+				)))
+			},
+				Entry("it when IsolatedLauncher featuregate is disabled", "kata-qemu", nil,
+					func(*v1.VirtualMachineInstance) {},
+					"fake.launcherIsolation", "IsolatedLauncher feature gate is not enabled"),
+				Entry("it without an isolated runtime class", "", []string{featuregate.IsolatedLauncherGate},
+					func(*v1.VirtualMachineInstance) {},
+					"fake.launcherIsolation", "has no isolatedRuntimeClass"),
+				Entry("a runtime class", "kata-qemu", []string{featuregate.IsolatedLauncherGate},
+					func(vmi *v1.VirtualMachineInstance) { vmi.Spec.RuntimeClassName = pointer.P("kata") },
+					"fake.runtimeClassName", "cannot be combined"),
+				Entry("host devices", "kata-qemu", []string{featuregate.IsolatedLauncherGate, featuregate.HostDevicesGate},
+					func(vmi *v1.VirtualMachineInstance) {
+						vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "dev", DeviceName: "example.com/dev"}}
+					},
+					"fake.domain.devices", "cannot be passed through"),
+				Entry("dedicated CPUs", "kata-qemu", []string{featuregate.IsolatedLauncherGate},
+					func(vmi *v1.VirtualMachineInstance) {
+						vmi.Spec.Domain.CPU = &v1.CPU{Cores: 2, DedicatedCPUPlacement: true}
+					},
+					"fake.domain.cpu", "cannot be combined"),
+			)
 		})
 
-		Context("with panic devices defined", func() {
-			It("should fail when PanicDevices featuregate is disabled", func() {
+		Context("with emulation requested", func() {
+			It("should fail when VMEmulation featuregate is disabled", func() {
 				vmi := api.NewMinimalVMI("testvm")
-				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Hyperv)}}
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(HaveLen(1))
-				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices"))
-				Expect(causes[0].Message).To(Equal("Panic Devices feature gate is not enabled in kubevirt-config"))
+				Expect(causes[0].Field).To(Equal("fake.domain.useEmulation"))
+				Expect(causes[0].Message).To(Equal("VMEmulation feature gate is not enabled in kubevirt-config"))
 			})
 
-			It("should allow valid panic device model", func() {
-				enableFeatureGates(featuregate.PanicDevicesGate)
+			It("should accept emulation when VMEmulation featuregate is enabled", func() {
+				enableFeatureGates(featuregate.VMEmulationGate)
 				vmi := api.NewMinimalVMI("testvm")
-				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Hyperv)}}
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(BeEmpty())
 			})
 
-			It("should reject invalid panic device model", func() {
-				enableFeatureGates(featuregate.PanicDevicesGate)
+			It("should reject emulation combined with launch security", func() {
+				enableFeatureGates(featuregate.VMEmulationGate, featuregate.WorkloadEncryptionSEV)
 				vmi := api.NewMinimalVMI("testvm")
-				panicDeviceModel := v1.PanicDeviceModel("bad")
-				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: &panicDeviceModel}}
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
+				vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{SEV: &v1.SEV{}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ContainElement(HaveField("Field", "fake.domain.useEmulation")))
+			})
+		})
+
+		Context("with an emulator bundle", func() {
+			configureEmulatorBundle := func(featureGates ...string) {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+				kvConfig.Spec.Configuration.EmulatorBundles = []v1.EmulatorBundle{{
+					Name:     "vendor",
+					Image:    "registry.example.com/qemu@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					Emulator: "usr/bin/qemu-kvm",
+				}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			}
+
+			DescribeTable("should validate the referenced bundle", func(featureGates []string, bundle string, expectedMessage string) {
+				configureEmulatorBundle(featureGates...)
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.EmulatorBundle = bundle
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				if expectedMessage == "" {
+					Expect(causes).To(BeEmpty())
+					return
+				}
 				Expect(causes).To(HaveLen(1))
-				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices[0].model"))
-				Expect(causes[0].Message).To(Equal(fmt.Sprintf(invalidPanicDeviceModelErrFmt, panicDeviceModel)))
+				Expect(causes[0].Field).To(Equal("fake.domain.emulatorBundle"))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			},
+				Entry("and reject it when ImageVolume featuregate is disabled", nil, "vendor",
+					"ImageVolume feature gate is not enabled in kubevirt-config"),
+				Entry("and accept a configured bundle", []string{featuregate.ImageVolume}, "vendor", ""),
+				Entry("and reject a bundle which is not configured", []string{featuregate.ImageVolume}, "unknown",
+					"emulator bundle unknown is not configured in kubevirt-config"),
+			)
+		})
+
+		Context("with a firmware profile", func() {
+			configureFirmwareProfiles := func(featureGates ...string) {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+				kvConfig.Spec.Configuration.FirmwareProfiles = []v1.FirmwareProfile{
+					{Name: "bios", Type: v1.FirmwareTypeBIOS},
+					{Name: "uefi", Type: v1.FirmwareTypeUEFI},
+					{Name: "secure", Type: v1.FirmwareTypeUEFISecureBoot},
+					{Name: "sev", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{{
+						Architecture: "amd64",
+						Image:        "registry.example.com/ovmf@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					}}},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			}
+
+			BeforeEach(func() {
+				configureFirmwareProfiles()
 			})
 
-			It("should reject panic devices on s390x architecture", func() {
-				enableFeatureGates(featuregate.PanicDevicesGate)
+			newVMI := func(profile string, bootloader *v1.Bootloader) *v1.VirtualMachineInstance {
 				vmi := api.NewMinimalVMI("testvm")
-				vmi.Spec.Architecture = "s390x"
-				vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: pointer.P(v1.Isa)}}
+				vmi.Spec.Architecture = "amd64"
+				vmi.Spec.Domain.Firmware = &v1.Firmware{Profile: profile, Bootloader: bootloader}
+				vmi.Spec.Domain.Features = &v1.Features{SMM: &v1.FeatureState{}}
+				return vmi
+			}
+
+			DescribeTable("should accept a bootloader matching the profile", func(profile string, bootloader *v1.Bootloader) {
+				vmi := newVMI(profile, bootloader)
+				Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			},
+				Entry("for BIOS", "bios", &v1.Bootloader{BIOS: &v1.BIOS{}}),
+				Entry("for UEFI", "uefi", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}),
+				Entry("for UEFI with SecureBoot", "secure", &v1.Bootloader{EFI: &v1.EFI{}}),
+			)
+
+			DescribeTable("should reject a bootloader not matching the profile", func(profile string, bootloader *v1.Bootloader) {
+				vmi := newVMI(profile, bootloader)
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(HaveLen(1))
-				Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices"))
-				Expect(causes[0].Message).To(Equal("custom panic devices are not supported on s390x architecture"))
+				Expect(causes[0].Field).To(Equal("fake.domain.firmware.bootloader"))
+			},
+				Entry("for BIOS", "bios", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}),
+				Entry("for UEFI", "uefi", &v1.Bootloader{EFI: &v1.EFI{}}),
+				Entry("for UEFI with SecureBoot", "secure", &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.P(false)}}),
+			)
+
+			It("should reject a profile which is not configured", func() {
+				vmi := newVMI("unknown", nil)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotFound,
+					Message: "firmware profile unknown is not configured in kubevirt-config",
+					Field:   "fake.domain.firmware.profile",
+				}))
+			})
+
+			It("should require the ImageVolume feature gate for a profile with a firmware image", func() {
+				vmi := newVMI("sev", nil)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(ConsistOf(HaveField("Message", "ImageVolume feature gate is not enabled in kubevirt-config")))
+
+				configureFirmwareProfiles(featuregate.ImageVolume)
+				Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
 			})
 		})
 
@@ -1998,6 +2957,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(HaveLen(1))
 		})
+
+		DescribeTable("with nested virtualization", func(policy string, shouldReject bool) {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				NestedVirtualization: pointer.P(true),
+				Features: []v1.CPUFeature{
+					{
+						Name:   "vmx",
+						Policy: policy,
+					},
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if shouldReject {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.cpu.features[0].policy"))
+				Expect(causes[0].Message).To(Equal(fmt.Sprintf("CPU feature vmx can't use policy %s when nested virtualization is enabled", policy)))
+			} else {
+				Expect(causes).To(BeEmpty())
+			}
+		},
+			Entry("should accept the require policy", "require", false),
+			Entry("should accept the optional policy", "optional", false),
+			Entry("should reject the disable policy", "disable", true),
+			Entry("should reject the forbid policy", "forbid", true),
+		)
 	})
 
 	Context("with downwardmetrics virtio serial", func() {
@@ -2469,6 +3453,14 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("empty model should be accepted with arm64", "", 0),
 		)
 
+		It("should reject serial ports other than port 0", func() {
+			vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{{Name: "kernel", Port: 1}}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.serials[0].port"))
+			Expect(causes[0].Message).To(Equal("only serial port 0 is supported on arm64 architecture"))
+		})
+
 		It("should reject setting sound device", func() {
 			vmi.Spec.Domain.Devices.Sound = &v1.SoundDevice{
 				Name:  "test-audio-device",
@@ -2479,6 +3471,32 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.sound"))
 			Expect(causes[0].Message).To(Equal("Arm64 not support sound device"))
 		})
+
+		It("should reject setting machine options", func() {
+			vmi.Spec.Domain.MachineOptions = &v1.MachineOptions{PS2: pointer.P(false)}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.machineOptions"))
+			Expect(causes[0].Message).To(Equal("Arm64 not support machine options"))
+		})
+
+		It("should reject nested virtualization", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{NestedVirtualization: pointer.P(true)}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.nestedVirtualization"))
+			Expect(causes[0].Message).To(Equal("Arm64 not support nested virtualization"))
+		})
+
+		It("should reject KVM hints", func() {
+			vmi.Spec.Domain.Features = &v1.Features{KVM: &v1.FeatureKVM{HintDedicated: pointer.P(true), PollControl: pointer.P(true)}}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Field).To(Equal("fake.domain.features.kvm.hintDedicated"))
+			Expect(causes[0].Message).To(Equal("Arm64 not support KVM hint-dedicated"))
+			Expect(causes[1].Field).To(Equal("fake.domain.features.kvm.pollControl"))
+			Expect(causes[1].Message).To(Equal("Arm64 not support KVM poll-control"))
+		})
 	})
 
 	Context("with realtime", func() {
@@ -2598,6 +3616,27 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(ContainSubstring("launchSecurity"))
 		})
 
+		It("should accept SEV attestation with a key broker", func() {
+			vmi.Spec.StartStrategy = pointer.P(v1.StartStrategyPaused)
+			vmi.Spec.Domain.LaunchSecurity.SEV.Attestation = &v1.SEVAttestation{
+				KeyBroker: &v1.KeyBroker{URL: "https://kbs.example.com:8080", ResourcePath: "default/sev/launch-secret"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid key broker", func(keyBroker *v1.KeyBroker, field string) {
+			vmi.Spec.StartStrategy = pointer.P(v1.StartStrategyPaused)
+			vmi.Spec.Domain.LaunchSecurity.SEV.Attestation = &v1.SEVAttestation{KeyBroker: keyBroker}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(HaveField("Field", "fake.launchSecurity.sev.attestation.keyBroker."+field)))
+		},
+			Entry("with an unknown protocol", &v1.KeyBroker{Protocol: "unknown", URL: "https://kbs", ResourcePath: "a/b/c"}, "protocol"),
+			Entry("with a relative URL", &v1.KeyBroker{URL: "kbs:8080", ResourcePath: "a/b/c"}, "url"),
+			Entry("with an unsupported URL scheme", &v1.KeyBroker{URL: "ftp://kbs", ResourcePath: "a/b/c"}, "url"),
+			Entry("without a resource path", &v1.KeyBroker{URL: "https://kbs"}, "resourcePath"),
+		)
+
 		Context("with AMD SEV-SNP LaunchSecurity", func() {
 			BeforeEach(func() {
 				vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
@@ -2771,6 +3810,33 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(BeEmpty())
 		})
+
+		It("should accept a policy, measurements and attestation", func() {
+			digest := base64.StdEncoding.EncodeToString(make([]byte, 48))
+			vmi.Spec.Domain.LaunchSecurity.TDX = &v1.TDX{
+				Policy:        &v1.TDXPolicy{SEPTVEDisable: pointer.P(true)},
+				MrConfigID:    digest,
+				MrOwner:       digest,
+				MrOwnerConfig: digest,
+				Attestation:   &v1.TDXAttestation{},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a measurement which is not a base64 encoded SHA384 digest", func(value string) {
+			vmi.Spec.Domain.LaunchSecurity.TDX.MrOwner = value
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "mrOwner must be a base64 encoded SHA384 digest",
+				Field:   "fake.launchSecurity.tdx.mrOwner",
+			}))
+		},
+			Entry("when not base64", "not base64!"),
+			Entry("when too short", base64.StdEncoding.EncodeToString(make([]byte, 32))),
+			Entry("when too long", base64.StdEncoding.EncodeToString(make([]byte, 64))),
+		)
 	})
 
 	Context("with vsocks defined", func() {
@@ -3475,6 +4541,106 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with nvme disks defined", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe},
+				},
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "testdisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+				},
+			})
+		})
+
+		It("should accept nvme disks when the feature gate is enabled", func() {
+			enableFeatureGates(featuregate.NVMeGate)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject nvme disks when the feature gate is disabled", func() {
+			disableFeatureGates()
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].disk.bus"))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.NVMeGate)))
+		})
+	})
+
+	Context("with the PTP clock", func() {
+		DescribeTable("should validate the KVM clock", func(clock *v1.Clock, expectedCauses int) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Clock = clock
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.clock.timer.kvm.present"))
+				Expect(causes[0].Message).To(Equal("fake.domain.clock.ptp requires the KVM clock"))
+			}
+		},
+			Entry("accept the PTP clock", &v1.Clock{PTP: &v1.PTPClock{}}, 0),
+			Entry("accept the PTP clock with the KVM clock", &v1.Clock{
+				PTP:   &v1.PTPClock{},
+				Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(true)}},
+			}, 0),
+			Entry("reject the PTP clock without the KVM clock", &v1.Clock{
+				PTP:   &v1.PTPClock{},
+				Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(false)}},
+			}, 1),
+			Entry("accept a disabled PTP clock without the KVM clock", &v1.Clock{
+				PTP:   &v1.PTPClock{Enabled: pointer.P(false)},
+				Timer: &v1.Timer{KVM: &v1.KVMTimer{Enabled: pointer.P(false)}},
+			}, 0),
+		)
+	})
+
+	Context("with SGX", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+		})
+
+		It("should accept an EPC section when the feature gate is enabled", func() {
+			enableFeatureGates(featuregate.SGXGate)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject an EPC section when the feature gate is disabled", func() {
+			disableFeatureGates()
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.sgx"))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.SGXGate)))
+		})
+
+		It("should reject an empty EPC section", func() {
+			enableFeatureGates(featuregate.SGXGate)
+			vmi.Spec.Domain.Devices.SGX.EPCSize = resource.MustParse("0")
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.sgx.epcSize"))
+			Expect(causes[0].Message).To(Equal("fake.domain.devices.sgx.epcSize must be greater than 0"))
+		})
+
+		It("should reject an EPC section combined with launch security", func() {
+			enableFeatureGates(featuregate.SGXGate, featuregate.WorkloadEncryptionTDX)
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{TDX: &v1.TDX{}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(HaveField("Message", "fake.domain.devices.sgx can not be combined with fake.domain.launchSecurity")))
+		})
+	})
+
 	Context("with CPU hotplug", func() {
 		var vmi *v1.VirtualMachineInstance
 
@@ -3610,6 +4776,72 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("no watchdog configured", nil, "", false),
 		)
 
+		It("should reject machine options on s390x", func() {
+			vmi.Spec.Domain.MachineOptions = &v1.MachineOptions{PS2: pointer.P(false)}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.machineOptions"))
+			Expect(causes[0].Message).To(Equal("s390x does not support machine options"))
+		})
+
+		It("should reject nested virtualization on s390x", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{NestedVirtualization: pointer.P(true)}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.nestedVirtualization"))
+			Expect(causes[0].Message).To(Equal("s390x does not support nested virtualization"))
+		})
+
+		It("should reject KVM hints on s390x", func() {
+			vmi.Spec.Domain.Features = &v1.Features{KVM: &v1.FeatureKVM{HintDedicated: pointer.P(false), PollControl: pointer.P(true)}}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(2))
+			Expect(causes[0].Field).To(Equal("fake.domain.features.kvm.hintDedicated"))
+			Expect(causes[0].Message).To(Equal("s390x does not support KVM hint-dedicated"))
+			Expect(causes[1].Field).To(Equal("fake.domain.features.kvm.pollControl"))
+			Expect(causes[1].Message).To(Equal("s390x does not support KVM poll-control"))
+		})
+
+		It("should reject multitouch input devices on s390x", func() {
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "touch", Type: v1.InputTypeMultitouch, Bus: v1.InputBusVirtio}}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.inputs[0].type"))
+			Expect(causes[0].Message).To(Equal("s390x does not support multitouch input devices"))
+		})
+
+		It("should reject smartcards on s390x", func() {
+			vmi.Spec.Domain.Devices.Smartcard = &v1.Smartcard{Passthrough: &v1.SmartcardPassthrough{}}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.smartcard"))
+			Expect(causes[0].Message).To(Equal("s390x does not support smartcard devices"))
+		})
+
+		It("should reject the PTP clock on s390x", func() {
+			vmi.Spec.Domain.Clock = &v1.Clock{PTP: &v1.PTPClock{}}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.clock.ptp"))
+			Expect(causes[0].Message).To(Equal("s390x does not support the PTP clock"))
+		})
+
+		It("should reject SGX on s390x", func() {
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.sgx"))
+			Expect(causes[0].Message).To(Equal("s390x does not support SGX"))
+		})
+
+		It("should reject SGX on arm64", func() {
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.sgx"))
+			Expect(causes[0].Message).To(Equal("Arm64 does not support SGX"))
+		})
+
 		DescribeTable("validate for arm64",
 			func(watchdog *v1.Watchdog, expectedMessage string, shouldReject bool) {
 				vmi.Spec.Domain.Devices.Watchdog = watchdog
@@ -3641,6 +4873,53 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		)
 	})
 
+	Context("IOMMU device validation", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+		})
+
+		It("should reject an IOMMU on s390x", func() {
+			vmi.Spec.Domain.Devices.IOMMU = &v1.IOMMUDevice{}
+			causes := webhooks.ValidateVirtualMachineInstanceS390XSetting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.iommu"))
+			Expect(causes[0].Message).To(Equal("s390x does not support IOMMU device"))
+		})
+
+		DescribeTable("should validate the machine type of an IOMMU on amd64", func(machineType string, shouldReject bool) {
+			vmi.Spec.Domain.Machine = &v1.Machine{Type: machineType}
+			vmi.Spec.Domain.Devices.IOMMU = &v1.IOMMUDevice{}
+			causes := webhooks.ValidateVirtualMachineInstanceAmd64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			if shouldReject {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.iommu"))
+				Expect(causes[0].Message).To(ContainSubstring("a q35 machine is required"))
+			} else {
+				Expect(causes).To(BeEmpty())
+			}
+		},
+			Entry("accept q35", "q35", false),
+			Entry("accept versioned q35", "pc-q35-rhel9.6.0", false),
+			Entry("reject i440fx", "pc-i440fx-rhel7.6.0", true),
+		)
+
+		It("should reject the intel IOMMU model on arm64", func() {
+			vmi.Spec.Domain.Devices.IOMMU = &v1.IOMMUDevice{Model: v1.IOMMUModelIntel}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.iommu.model"))
+			Expect(causes[0].Message).To(Equal("Arm64 only support virtio IOMMU model"))
+		})
+
+		It("should accept the virtio IOMMU model on arm64", func() {
+			vmi.Spec.Domain.Devices.IOMMU = &v1.IOMMUDevice{Model: v1.IOMMUModelVirtio}
+			causes := webhooks.ValidateVirtualMachineInstanceArm64Setting(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(BeEmpty())
+		})
+	})
+
 	Context("with VideoConfig", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -3677,6 +4956,28 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty(), "should accept video configuration when autoattachGraphicsDevice is unset")
 		})
 
+		It("should accept a resolution for the video device", func() {
+			vmi.Spec.Domain.Devices.Video.Resolution = &v1.VideoResolution{Width: 1920, Height: 1080}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid resolution", func(videoType string, resolution v1.VideoResolution, expectedField, expectedMessage string) {
+			vmi.Spec.Domain.Devices.Video.Type = videoType
+			vmi.Spec.Domain.Devices.Video.Resolution = &resolution
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+			Expect(causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("with a video model without EDID", "ramfb", v1.VideoResolution{Width: 1920, Height: 1080},
+				"fake.domain.devices.video.resolution", "video model 'ramfb' does not support a resolution"),
+			Entry("with a zero width", "virtio", v1.VideoResolution{Height: 1080},
+				"fake.domain.devices.video.resolution.width", "width must be between 1 and 2560"),
+			Entry("with a too large height", "virtio", v1.VideoResolution{Width: 1920, Height: 4320},
+				"fake.domain.devices.video.resolution.height", "height must be between 1 and 2048"),
+		)
+
 		DescribeTable("should accept supported video models per architecture", func(arch, videoType string) {
 			vmi.Spec.Domain.Devices.Video.Type = videoType
 			vmi.Spec.Architecture = arch
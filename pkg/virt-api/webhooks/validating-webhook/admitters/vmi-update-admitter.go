@@ -26,6 +26,8 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -39,12 +41,14 @@ const nodeNameExtraInfo = "authentication.kubernetes.io/node-name"
 
 type VMIUpdateAdmitter struct {
 	clusterConfig           *virtconfig.ClusterConfig
+	vmPolicyInformer        cache.SharedIndexInformer
 	kubeVirtServiceAccounts map[string]struct{}
 }
 
-func NewVMIUpdateAdmitter(config *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) *VMIUpdateAdmitter {
+func NewVMIUpdateAdmitter(config *virtconfig.ClusterConfig, vmPolicyInformer cache.SharedIndexInformer, kubeVirtServiceAccounts map[string]struct{}) *VMIUpdateAdmitter {
 	return &VMIUpdateAdmitter{
 		clusterConfig:           config,
+		vmPolicyInformer:        vmPolicyInformer,
 		kubeVirtServiceAccounts: kubeVirtServiceAccounts,
 	}
 }
@@ -107,6 +111,11 @@ func (admitter *VMIUpdateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 			if hotplugResponse != nil {
 				return hotplugResponse
 			}
+			// Live updates of the VM are applied to the VMI spec, they have to comply with the policies
+			causes := ValidateVirtualMachinePolicies(k8sfield.NewPath("spec"), newVMI.Namespace, &newVMI.Spec, admitter.vmPolicyInformer, admitter.clusterConfig)
+			if len(causes) > 0 {
+				return webhookutils.ToAdmissionResponse(causes)
+			}
 		} else {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
@@ -222,6 +231,15 @@ func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionR
 		})
 	}
 
+	if !equality.Semantic.DeepEqual(oldMemory.DIMMSlots, newMemory.DIMMSlots) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Memory dimmSlots changed",
+			},
+		})
+	}
+
 	return nil
 }
 
@@ -37,7 +37,9 @@ import (
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -62,7 +64,7 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		},
 	}
 	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
-	vmiUpdateAdmitter := NewVMIUpdateAdmitter(config, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace))
+	vmiUpdateAdmitter := NewVMIUpdateAdmitter(config, nil, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace))
 
 	enableFeatureGate := func(featureGate string) {
 		kvConfig := kv.DeepCopy()
@@ -486,6 +488,43 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			},
 			BeFalse()))
 
+	It("should reject a live update violating a VirtualMachinePolicy", func() {
+		enableFeatureGate(featuregate.VirtualMachinePoliciesGate)
+		defer disableFeatureGates()
+		policyInformer, _ := testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachinePolicy{})
+		Expect(policyInformer.GetStore().Add(&policyv1alpha1.VirtualMachinePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: metav1.NamespaceDefault},
+			Spec:       policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(2))},
+		})).To(Succeed())
+		admitter := NewVMIUpdateAdmitter(config, policyInformer, webhooks.KubeVirtServiceAccounts(kubeVirtNamespace))
+
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Namespace = metav1.NamespaceDefault
+		vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, Cores: 1, Threads: 1, MaxSockets: 8}
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.CPU.Sockets = 4
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo:  authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource:  webhooks.VirtualMachineInstanceGroupVersionResource,
+				Namespace: metav1.NamespaceDefault,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.domain.cpu"))
+	})
+
 	It("should reject updates to maxGuest", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.CPU = &v1.CPU{}
@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	authclientv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"kubevirt.io/api/core"
+	operationsv1 "kubevirt.io/api/operations/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const resourceVMIMigrations = "virtualmachineinstancemigrations"
+
+// VMOperationAdmitter validates VirtualMachineOperations
+type VMOperationAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
+	SARClient     authclientv1.SubjectAccessReviewInterface
+}
+
+// NewVMOperationAdmitter creates a VMOperationAdmitter
+func NewVMOperationAdmitter(clusterConfig *virtconfig.ClusterConfig, sarClient authclientv1.SubjectAccessReviewInterface) *VMOperationAdmitter {
+	return &VMOperationAdmitter{
+		ClusterConfig: clusterConfig,
+		SARClient:     sarClient,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineOperation
+func (admitter *VMOperationAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != operationsv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachineoperations" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation != admissionv1.Create {
+		return &admissionv1.AdmissionResponse{Allowed: true}
+	}
+
+	if !admitter.ClusterConfig.VirtualMachineOperationsEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("VirtualMachineOperations feature gate not enabled"))
+	}
+
+	operation := &operationsv1.VirtualMachineOperation{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, operation); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	// virt-controller creates the migrations with its own service account,
+	// the user has to be allowed to create them as well
+	if operation.Spec.Action == operationsv1.ActionMigrate {
+		allowed, err := admitter.canCreateMigrations(ctx, ar.Request)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if !allowed {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("user %s is not allowed to create %s in namespace %s",
+					ar.Request.UserInfo.Username, resourceVMIMigrations, ar.Request.Namespace),
+				Field: k8sfield.NewPath("spec", "action").String(),
+			}})
+		}
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func (admitter *VMOperationAdmitter) canCreateMigrations(ctx context.Context, request *admissionv1.AdmissionRequest) (bool, error) {
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range request.UserInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   request.UserInfo.Username,
+			Groups: request.UserInfo.Groups,
+			UID:    request.UserInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace: request.Namespace,
+				Verb:      "create",
+				Group:     core.GroupName,
+				Resource:  resourceVMIMigrations,
+			},
+		},
+	}
+	result, err := admitter.SARClient.Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review the access of user %s: %v", request.UserInfo.Username, err)
+	}
+	return result.Status.Allowed, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	operationsv1 "kubevirt.io/api/operations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineOperation Admitter", func() {
+	const testUser = "test-user"
+
+	var (
+		k8sClient *k8sfake.Clientset
+		admitter  *VMOperationAdmitter
+		reviews   []*authv1.SubjectAccessReview
+	)
+
+	newAdmitter := func(featureGates ...string) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		admitter = NewVMOperationAdmitter(config, k8sClient.AuthorizationV1().SubjectAccessReviews())
+	}
+
+	allowMigrations := func(allowed bool) {
+		k8sClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+			reviews = append(reviews, sar)
+			sar.Status.Allowed = allowed
+			return true, sar, nil
+		})
+	}
+
+	admit := func(action operationsv1.VirtualMachineOperationAction) *admissionv1.AdmissionResponse {
+		operation := &operationsv1.VirtualMachineOperation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test-ns"},
+			Spec:       operationsv1.VirtualMachineOperationSpec{Action: action},
+		}
+		raw, err := json.Marshal(operation)
+		Expect(err).ToNot(HaveOccurred())
+
+		return admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Namespace: "test-ns",
+				Resource: metav1.GroupVersionResource{
+					Group:    operationsv1.SchemeGroupVersion.Group,
+					Version:  operationsv1.SchemeGroupVersion.Version,
+					Resource: "virtualmachineoperations",
+				},
+				UserInfo: authenticationv1.UserInfo{Username: testUser, Groups: []string{"test-group"}},
+				Object:   runtime.RawExtension{Raw: raw},
+			},
+		})
+	}
+
+	BeforeEach(func() {
+		k8sClient = k8sfake.NewSimpleClientset()
+		reviews = nil
+		newAdmitter(featuregate.VirtualMachineOperationsGate)
+	})
+
+	It("should reject operations without the feature gate", func() {
+		newAdmitter()
+		Expect(admit(operationsv1.ActionStart).Allowed).To(BeFalse())
+	})
+
+	It("should not review the access of the user for actions other than Migrate", func() {
+		allowMigrations(false)
+		Expect(admit(operationsv1.ActionRestart).Allowed).To(BeTrue())
+		Expect(reviews).To(BeEmpty())
+	})
+
+	It("should allow Migrate for users allowed to create migrations", func() {
+		allowMigrations(true)
+		Expect(admit(operationsv1.ActionMigrate).Allowed).To(BeTrue())
+
+		Expect(reviews).To(HaveLen(1))
+		Expect(reviews[0].Spec.User).To(Equal(testUser))
+		Expect(reviews[0].Spec.Groups).To(ConsistOf("test-group"))
+		Expect(reviews[0].Spec.ResourceAttributes).To(Equal(&authv1.ResourceAttributes{
+			Namespace: "test-ns",
+			Verb:      "create",
+			Group:     "kubevirt.io",
+			Resource:  "virtualmachineinstancemigrations",
+		}))
+	})
+
+	It("should reject Migrate for users not allowed to create migrations", func() {
+		allowMigrations(false)
+		response := admit(operationsv1.ActionMigrate)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.action"))
+		Expect(response.Result.Details.Causes[0].Message).To(ContainSubstring("user test-user is not allowed to create virtualmachineinstancemigrations"))
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -42,7 +43,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -68,6 +71,7 @@ type VMsAdmitter struct {
 	VirtClient              kubecli.KubevirtClient
 	DataSourceInformer      cache.SharedIndexInformer
 	NamespaceInformer       cache.SharedIndexInformer
+	VMPolicyInformer        cache.SharedIndexInformer
 	InstancetypeAdmitter    instancetypeVMsAdmitter
 	ClusterConfig           *virtconfig.ClusterConfig
 	KubeVirtServiceAccounts map[string]struct{}
@@ -78,6 +82,7 @@ func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.Kube
 		VirtClient:              client,
 		DataSourceInformer:      informers.DataSourceInformer,
 		NamespaceInformer:       informers.NamespaceInformer,
+		VMPolicyInformer:        informers.VMPolicyInformer,
 		InstancetypeAdmitter:    instancetypeWebhooks.NewAdmitter(client),
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
@@ -118,7 +123,14 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	// Sealed defaults are part of every VMI started for the VM, validate them along with the template
+	defaults.ApplySealedDefaults(&vmCopy.Spec.Template.Spec, vmCopy.Spec.Sealed)
+
 	// Set VirtualMachine defaults on the copy before validating
+	namespaceLabels := webhooks.NamespaceLabels(admitter.NamespaceInformer, ar.Request.Namespace)
+	if err = netvmispec.SetDefaultNetworkInterfaceForNamespace(admitter.ClusterConfig, &vmCopy.Spec.Template.Spec, namespaceLabels); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
 	if err = defaults.SetDefaultVirtualMachineInstanceSpec(admitter.ClusterConfig, &vmCopy.Spec.Template.Spec); err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
@@ -146,6 +158,32 @@ func (admitter *VMsAdmitter) Admit(ctx context.Context, ar *admissionv1.Admissio
 		}
 	}
 
+	// Policies are enforced on every change of the template as well, like the hotplug of CPUs, memory,
+	// volumes or interfaces, VMs which were created before a policy are only rejected once they change.
+	enforcePolicies := ar.Request.Operation == admissionv1.Create
+	if ar.Request.Operation == admissionv1.Update {
+		oldVM := v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.Request.OldObject.Raw, &oldVM); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if causes = validateSealedDefaultsUpdate(k8sfield.NewPath("spec", "sealed"), vm.Spec.Sealed, oldVM.Spec.Sealed); len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+		enforcePolicies = !equality.Semantic.DeepEqual(vm.Spec.Template, oldVM.Spec.Template) ||
+			!equality.Semantic.DeepEqual(vm.Spec.Instancetype, oldVM.Spec.Instancetype)
+	}
+
+	if enforcePolicies {
+		causes = ValidateVirtualMachineTemplatePolicies(k8sfield.NewPath("spec", "template", "spec"), vm.Namespace, &vmCopy.Spec.Template.Spec, admitter.VMPolicyInformer, admitter.ClusterConfig)
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+		causes = ValidateHostSensorsNamespace(k8sfield.NewPath("spec", "template", "spec"), &vmCopy.Spec.Template.Spec, namespaceLabels, admitter.ClusterConfig)
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	_, isKubeVirtServiceAccount := admitter.KubeVirtServiceAccounts[ar.Request.UserInfo.Username]
 	causes = ValidateVirtualMachineSpec(k8sfield.NewPath("spec"), &vmCopy.Spec, admitter.ClusterConfig, isKubeVirtServiceAccount)
 	if len(causes) > 0 {
@@ -223,6 +261,137 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	causes = append(causes, storageadmitters.ValidateDataVolumeTemplate(field, spec)...)
 	causes = append(causes, validateRunStrategy(field, spec, config)...)
 	causes = append(causes, validateLiveUpdateFeatures(field, spec, config)...)
+	causes = append(causes, validateSealedDefaults(field.Child("sealed"), spec.Sealed)...)
+
+	return causes
+}
+
+func validateSealedDefaults(field *k8sfield.Path, sealed *v1.VirtualMachineSealedDefaults) (causes []metav1.StatusCause) {
+	if sealed == nil {
+		return causes
+	}
+
+	pciAddresses := map[string]struct{}{}
+	validatePCIAddress := func(field *k8sfield.Path, kind, name, address string) {
+		if _, err := hwutil.ParsePciAddress(address); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %s has a malformed PCI address (%s)", kind, name, address),
+				Field:   field.String(),
+			})
+			return
+		}
+		if _, exists := pciAddresses[address]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("PCI address %s is sealed for more than one device", address),
+				Field:   field.String(),
+			})
+		}
+		pciAddresses[address] = struct{}{}
+	}
+
+	names := map[string]struct{}{}
+	for i, iface := range sealed.Interfaces {
+		if _, exists := names[iface.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("interface %s is sealed more than once", iface.Name),
+				Field:   field.Child("interfaces").Index(i).Child("name").String(),
+			})
+		}
+		names[iface.Name] = struct{}{}
+
+		if iface.MACAddress != "" {
+			if _, err := net.ParseMAC(iface.MACAddress); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %s has an invalid MAC address: %v", iface.Name, err),
+					Field:   field.Child("interfaces").Index(i).Child("macAddress").String(),
+				})
+			}
+		}
+		if iface.PCIAddress != "" {
+			validatePCIAddress(field.Child("interfaces").Index(i).Child("pciAddress"), "interface", iface.Name, iface.PCIAddress)
+		}
+	}
+
+	names = map[string]struct{}{}
+	for i, disk := range sealed.Disks {
+		if _, exists := names[disk.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("disk %s is sealed more than once", disk.Name),
+				Field:   field.Child("disks").Index(i).Child("name").String(),
+			})
+		}
+		names[disk.Name] = struct{}{}
+
+		validatePCIAddress(field.Child("disks").Index(i).Child("pciAddress"), "disk", disk.Name, disk.PCIAddress)
+	}
+
+	return causes
+}
+
+// validateSealedDefaultsUpdate rejects changes of sealed defaults, they are chosen once for the lifetime of the VM.
+// Defaults which were sealed can only be overridden through the template.
+func validateSealedDefaultsUpdate(field *k8sfield.Path, sealed, oldSealed *v1.VirtualMachineSealedDefaults) (causes []metav1.StatusCause) {
+	if oldSealed == nil {
+		return causes
+	}
+	if sealed == nil {
+		sealed = &v1.VirtualMachineSealedDefaults{}
+	}
+
+	changed := func(value, oldValue string) bool {
+		return oldValue != "" && value != oldValue
+	}
+	immutable := func(child string) metav1.StatusCause {
+		return metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is sealed and can not be changed", child),
+			Field:   field.Child(child).String(),
+		}
+	}
+
+	if changed(string(sealed.FirmwareUUID), string(oldSealed.FirmwareUUID)) {
+		causes = append(causes, immutable("firmwareUUID"))
+	}
+	if changed(sealed.FirmwareSerial, oldSealed.FirmwareSerial) {
+		causes = append(causes, immutable("firmwareSerial"))
+	}
+	if changed(sealed.MachineType, oldSealed.MachineType) {
+		causes = append(causes, immutable("machineType"))
+	}
+	for _, oldIface := range oldSealed.Interfaces {
+		iface := defaults.LookupSealedInterface(sealed, oldIface.Name)
+		if iface == nil {
+			continue
+		}
+		if changed(iface.MACAddress, oldIface.MACAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("MAC address of interface %s is sealed and can not be changed", oldIface.Name),
+				Field:   field.Child("interfaces").String(),
+			})
+		}
+		if changed(iface.PCIAddress, oldIface.PCIAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("PCI address of interface %s is sealed and can not be changed", oldIface.Name),
+				Field:   field.Child("interfaces").String(),
+			})
+		}
+	}
+	for _, oldDisk := range oldSealed.Disks {
+		if disk := defaults.LookupSealedDisk(sealed, oldDisk.Name); disk != nil && changed(disk.PCIAddress, oldDisk.PCIAddress) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("PCI address of disk %s is sealed and can not be changed", oldDisk.Name),
+				Field:   field.Child("disks").String(),
+			})
+		}
+	}
 
 	return causes
 }
@@ -385,6 +554,12 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ctx context.Context, vm *v1.
 				newVolume.VolumeSource.PersistentVolumeClaim = volumeRequest.AddVolumeOptions.VolumeSource.PersistentVolumeClaim
 			} else if volumeRequest.AddVolumeOptions.VolumeSource.DataVolume != nil {
 				newVolume.VolumeSource.DataVolume = volumeRequest.AddVolumeOptions.VolumeSource.DataVolume
+			} else if volumeRequest.AddVolumeOptions.VolumeSource.ConfigMap != nil {
+				newVolume.VolumeSource.ConfigMap = volumeRequest.AddVolumeOptions.VolumeSource.ConfigMap
+			} else if volumeRequest.AddVolumeOptions.VolumeSource.Secret != nil {
+				newVolume.VolumeSource.Secret = volumeRequest.AddVolumeOptions.VolumeSource.Secret
+			} else if volumeRequest.AddVolumeOptions.VolumeSource.ServiceAccount != nil {
+				newVolume.VolumeSource.ServiceAccount = volumeRequest.AddVolumeOptions.VolumeSource.ServiceAccount
 			}
 
 			vmVolume, ok := vmVolumeMap[name]
@@ -45,6 +45,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	instancetypeWebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks/vm"
@@ -1618,6 +1619,190 @@ var _ = Describe("Validating VM Admitter", func() {
 			Entry("reject invalid runstrategy", v1.VirtualMachineRunStrategy("invalid"), "", false),
 		)
 	})
+
+	Context("with a VirtualMachinePolicy", func() {
+		BeforeEach(func() {
+			enableFeatureGate(featuregate.VirtualMachinePoliciesGate)
+			policyInformer, _ := testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachinePolicy{})
+			Expect(policyInformer.GetStore().Add(&policyv1alpha1.VirtualMachinePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: metav1.NamespaceDefault},
+				Spec:       policyv1alpha1.VirtualMachinePolicySpec{MaxCPUs: pointer.P(uint32(2))},
+			})).To(Succeed())
+			vmsAdmitter.VMPolicyInformer = policyInformer
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		newVM := func(sockets uint32) *v1.VirtualMachine {
+			return libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmi.WithCPUCount(1, 1, sockets),
+			))
+		}
+
+		admitVMUpdate := func(oldVM, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+			oldVMBytes, _ := json.Marshal(oldVM)
+			vmBytes, _ := json.Marshal(vm)
+			return vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Namespace: metav1.NamespaceDefault,
+					Object:    runtime.RawExtension{Raw: vmBytes},
+					OldObject: runtime.RawExtension{Raw: oldVMBytes},
+					Operation: admissionv1.Update,
+				},
+			})
+		}
+
+		It("should reject a CPU hotplug beyond the policy", func() {
+			resp := admitVMUpdate(newVM(2), newVM(4))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.template.spec.domain.cpu")))
+		})
+
+		It("should reject a maximum number of sockets beyond the policy", func() {
+			vm := newVM(2)
+			vm.Spec.Template.Spec.Domain.CPU.MaxSockets = 4
+
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.template.spec.domain.cpu.maxSockets")))
+		})
+
+		It("should allow updates of a VM violating the policy which don't change its template", func() {
+			oldVM := newVM(4)
+			vm := oldVM.DeepCopy()
+			vm.Labels = map[string]string{"updated": "true"}
+
+			Expect(admitVMUpdate(oldVM, vm).Allowed).To(BeTrue())
+		})
+	})
+
+	Context("sealed defaults", func() {
+		newSealedVM := func() *v1.VirtualMachine {
+			vmi := api.NewMinimalVMI("testvmi")
+			return &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(v1.RunStrategyHalted),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: vmi.Spec,
+					},
+					Sealed: &v1.VirtualMachineSealedDefaults{
+						FirmwareUUID: "sealed-uuid",
+						Interfaces:   []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+					},
+				},
+			}
+		}
+
+		admitVMUpdate := func(oldVM, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+			oldVMBytes, _ := json.Marshal(oldVM)
+			vmBytes, _ := json.Marshal(vm)
+			return vmsAdmitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: vmBytes},
+					OldObject: runtime.RawExtension{Raw: oldVMBytes},
+					Operation: admissionv1.Update,
+				},
+			})
+		}
+
+		It("should reject an invalid sealed MAC address", func() {
+			vm := newSealedVM()
+			vm.Spec.Sealed.Interfaces[0].MACAddress = "invalid"
+
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.sealed.interfaces[0].macAddress")))
+		})
+
+		It("should reject interfaces which are sealed more than once", func() {
+			vm := newSealedVM()
+			vm.Spec.Sealed.Interfaces = append(vm.Spec.Sealed.Interfaces, v1.SealedInterface{Name: "default", MACAddress: "02:00:00:00:00:02"})
+
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", "spec.sealed.interfaces[1].name")))
+		})
+
+		DescribeTable("should reject an invalid sealed PCI address", func(update func(*v1.VirtualMachineSealedDefaults), expectedField string) {
+			vm := newSealedVM()
+			update(vm.Spec.Sealed)
+
+			resp := admitVm(vmsAdmitter, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("of an interface", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Interfaces[0].PCIAddress = "0000:01:00:0"
+			}, "spec.sealed.interfaces[0].pciAddress"),
+			Entry("of a disk", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Disks = []v1.SealedDisk{{Name: "rootdisk", PCIAddress: "invalid"}}
+			}, "spec.sealed.disks[0].pciAddress"),
+			Entry("which is used by more than one device", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Interfaces[0].PCIAddress = "0000:01:00.0"
+				sealed.Disks = []v1.SealedDisk{{Name: "rootdisk", PCIAddress: "0000:01:00.0"}}
+			}, "spec.sealed.disks[0].pciAddress"),
+		)
+
+		It("should allow updates which keep the sealed defaults", func() {
+			oldVM := newSealedVM()
+			vm := newSealedVM()
+			vm.Spec.Sealed.MachineType = "q35"
+
+			resp := admitVMUpdate(oldVM, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject updates which change", func(update func(*v1.VirtualMachineSealedDefaults), expectedField string) {
+			oldVM := newSealedVM()
+			vm := newSealedVM()
+			update(vm.Spec.Sealed)
+
+			resp := admitVMUpdate(oldVM, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("the firmware UUID", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.FirmwareUUID = "other-uuid"
+			}, "spec.sealed.firmwareUUID"),
+			Entry("a MAC address", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Interfaces[0].MACAddress = "02:00:00:00:00:02"
+			}, "spec.sealed.interfaces"),
+		)
+
+		It("should allow sealing the PCI addresses of a VM", func() {
+			oldVM := newSealedVM()
+			vm := newSealedVM()
+			vm.Spec.Sealed.Interfaces[0].PCIAddress = "0000:01:00.0"
+			vm.Spec.Sealed.Disks = []v1.SealedDisk{{Name: "rootdisk", PCIAddress: "0000:07:00.0"}}
+
+			resp := admitVMUpdate(oldVM, vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject updates which change a sealed PCI address", func(update func(*v1.VirtualMachineSealedDefaults), expectedField string) {
+			oldVM := newSealedVM()
+			oldVM.Spec.Sealed.Interfaces[0].PCIAddress = "0000:01:00.0"
+			oldVM.Spec.Sealed.Disks = []v1.SealedDisk{{Name: "rootdisk", PCIAddress: "0000:07:00.0"}}
+			vm := oldVM.DeepCopy()
+			update(vm.Spec.Sealed)
+
+			resp := admitVMUpdate(oldVM, vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(ContainElement(HaveField("Field", expectedField)))
+		},
+			Entry("of an interface", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Interfaces[0].PCIAddress = "0000:02:00.0"
+			}, "spec.sealed.interfaces"),
+			Entry("of a disk", func(sealed *v1.VirtualMachineSealedDefaults) {
+				sealed.Disks[0].PCIAddress = "0000:08:00.0"
+			}, "spec.sealed.disks"),
+		)
+	})
 })
 
 func admitVm(admitter *VMsAdmitter, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
@@ -37,6 +37,7 @@ func ServeVMICreate(
 	resp http.ResponseWriter,
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
+	informers *webhooks.Informers,
 	kubeVirtServiceAccounts map[string]struct{},
 	specValidators ...admitters.SpecValidator,
 ) {
@@ -44,11 +45,14 @@ func ServeVMICreate(
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
 		SpecValidators:          specValidators,
+		VMPolicyInformer:        informers.VMPolicyInformer,
+		NodeInformer:            informers.NodeInformer,
+		NamespaceInformer:       informers.NamespaceInformer,
 	})
 }
 
-func ServeVMIUpdate(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) {
-	validating_webhooks.Serve(resp, req, admitters.NewVMIUpdateAdmitter(clusterConfig, kubeVirtServiceAccounts))
+func ServeVMIUpdate(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, informers *webhooks.Informers, kubeVirtServiceAccounts map[string]struct{}) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMIUpdateAdmitter(clusterConfig, informers.VMPolicyInformer, kubeVirtServiceAccounts))
 }
 
 func ServeVMs(
@@ -98,6 +102,14 @@ func ServeVMBackupTrackers(resp http.ResponseWriter, req *http.Request, clusterC
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupTrackerAdmitter(clusterConfig))
 }
 
+func ServeVMBackupHooks(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, storageadmitters.NewVMBackupHookAdmitter(clusterConfig))
+}
+
+func ServeVMOperations(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMOperationAdmitter(clusterConfig, virtCli.AuthorizationV1().SubjectAccessReviews()))
+}
+
 func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
 	validating_webhooks.Serve(resp, req, storageadmitters.NewVMExportAdmitter(clusterConfig))
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -291,6 +292,44 @@ var _ = Describe("test configuration", func() {
 		),
 	)
 
+	DescribeTable("when vmiStatusUpdates", func(vmiStatusUpdates *v1.VMIStatusUpdateConfiguration, expectedInterval, expectedJitter time.Duration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			VMIStatusUpdates: vmiStatusUpdates,
+		})
+		interval, maxJitter := clusterConfig.GetVMIStatusBatching()
+		Expect(interval).To(Equal(expectedInterval))
+		Expect(maxJitter).To(Equal(expectedJitter))
+	},
+		Entry("is nil, batching should be disabled", nil, time.Duration(0), time.Duration(0)),
+		Entry("is an empty struct, batching should be disabled", &v1.VMIStatusUpdateConfiguration{}, time.Duration(0), time.Duration(0)),
+		Entry("sets an interval and a jitter, both should be returned",
+			&v1.VMIStatusUpdateConfiguration{
+				BatchInterval: &metav1.Duration{Duration: 10 * time.Second},
+				MaxJitter:     &metav1.Duration{Duration: 2 * time.Second},
+			}, 10*time.Second, 2*time.Second,
+		),
+	)
+
+	DescribeTable("when diskGarbageCollection", func(diskGC *v1.DiskGarbageCollectionConfiguration, expectedGracePeriod time.Duration, expectedCleanup bool) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DiskGarbageCollection: diskGC,
+		})
+		gracePeriod, cleanup := clusterConfig.GetDiskGarbageCollection()
+		Expect(gracePeriod).To(Equal(expectedGracePeriod))
+		Expect(cleanup).To(Equal(expectedCleanup))
+	},
+		Entry("is nil, the default grace period should be used without cleanup", nil, virtconfig.DefaultDiskGarbageCollectionGracePeriod, false),
+		Entry("is an empty struct, the default grace period should be used without cleanup",
+			&v1.DiskGarbageCollectionConfiguration{}, virtconfig.DefaultDiskGarbageCollectionGracePeriod, false,
+		),
+		Entry("sets a grace period and cleanup, both should be returned",
+			&v1.DiskGarbageCollectionConfiguration{
+				GracePeriod: &metav1.Duration{Duration: time.Hour},
+				Cleanup:     pointer.P(true),
+			}, time.Hour, true,
+		),
+	)
+
 	DescribeTable("when vmRolloutStrategy", func(vmRolloutStrategy *v1.VMRolloutStrategy, expected bool) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
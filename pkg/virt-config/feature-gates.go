@@ -216,3 +216,123 @@ func (config *ClusterConfig) IncrementalBackupEnabled() bool {
 func (config *ClusterConfig) MigrationPriorityQueueEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.MigrationPriorityQueue)
 }
+
+func (config *ClusterConfig) SharedMemoryDevicesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SharedMemoryDevicesGate)
+}
+
+func (config *ClusterConfig) VirtioSerialChannelsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtioSerialChannelsGate)
+}
+
+func (config *ClusterConfig) VMEmulationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMEmulationGate)
+}
+
+func (config *ClusterConfig) LauncherReattachEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.LauncherReattachGate)
+}
+
+func (config *ClusterConfig) BackupHooksEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.BackupHooksGate)
+}
+
+func (config *ClusterConfig) DiskGarbageCollectionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DiskGarbageCollectionGate)
+}
+
+func (config *ClusterConfig) GuestDiskExpansionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestDiskExpansionGate)
+}
+
+func (config *ClusterConfig) VirtualMachineAuditEventsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineAuditEventsGate)
+}
+
+func (config *ClusterConfig) VirtualMachinePoliciesEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachinePoliciesGate)
+}
+
+func (config *ClusterConfig) ContainerDiskVerificationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ContainerDiskVerificationGate)
+}
+
+func (config *ClusterConfig) VolumeScanEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VolumeScanGate)
+}
+
+func (config *ClusterConfig) TPMAttestationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.TPMAttestationGate)
+}
+
+func (config *ClusterConfig) GuestSecretsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestSecretsGate)
+}
+
+func (config *ClusterConfig) VirtualMachineCheckupsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineCheckupsGate)
+}
+
+func (config *ClusterConfig) RealtimeReadinessValidationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.RealtimeReadinessValidationGate)
+}
+
+func (config *ClusterConfig) UsageAccountingEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.UsageAccountingGate)
+}
+
+func (config *ClusterConfig) SealedVMDefaultsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SealedVMDefaultsGate)
+}
+
+func (config *ClusterConfig) SRIOVFailoverEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SRIOVFailoverGate)
+}
+
+func (config *ClusterConfig) VMDNSRegistrationEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VMDNSRegistrationGate)
+}
+
+func (config *ClusterConfig) HybridCPUPinningEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HybridCPUPinningGate)
+}
+
+func (config *ClusterConfig) HugepagesPoolManagementEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HugepagesPoolManagementGate)
+}
+
+func (config *ClusterConfig) DIMMMemoryHotplugEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DIMMMemoryHotplugGate)
+}
+
+func (config *ClusterConfig) SnapshotMemoryEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SnapshotMemoryGate)
+}
+
+func (config *ClusterConfig) IsolatedLauncherEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.IsolatedLauncherGate)
+}
+
+func (config *ClusterConfig) NodeMaintenanceEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NodeMaintenanceGate)
+}
+
+func (config *ClusterConfig) VirtualMachineOperationsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VirtualMachineOperationsGate)
+}
+
+func (config *ClusterConfig) LauncherWarmPoolEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.LauncherWarmPoolGate)
+}
+
+func (config *ClusterConfig) HostSensorsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.HostSensorsGate)
+}
+
+func (config *ClusterConfig) NVMeEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.NVMeGate)
+}
+
+func (config *ClusterConfig) SGXEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SGXGate)
+}
@@ -151,6 +151,189 @@ const (
 	// Alpha: v1.7.0
 	//
 	MigrationPriorityQueue = "MigrationPriorityQueue"
+
+	// Alpha: v1.7.0
+	//
+	// SharedMemoryDevices allows exposing ivshmem shared memory regions, optionally backed
+	// by files or ivshmem-server sockets on the node, to VirtualMachineInstances.
+	SharedMemoryDevicesGate = "SharedMemoryDevices"
+
+	// Alpha: v1.7.0
+	//
+	// VirtioSerialChannels allows adding virtio-serial channels backed by unix sockets,
+	// shared with hook sidecars or created on the node, to VirtualMachineInstances.
+	VirtioSerialChannelsGate = "VirtioSerialChannels"
+
+	// Alpha: v1.7.0
+	//
+	// VMEmulation allows individual VirtualMachineInstances to request software
+	// emulation (TCG), which also runs on nodes without /dev/kvm.
+	VMEmulationGate = "VMEmulation"
+
+	// Alpha: v1.7.0
+	//
+	// LauncherReattach restarts a crashed virt-launcher process inside the running
+	// pod and lets it reattach to the still running qemu process, instead of
+	// tearing the VirtualMachineInstance down together with the launcher.
+	LauncherReattachGate = "LauncherReattach"
+
+	// Alpha: v1.7.0
+	//
+	// BackupHooks allows external backup tools to request VirtualMachineBackupHooks,
+	// which virt-controller executes to prepare VMs for a backup or to fix them up
+	// after a restore.
+	BackupHooksGate = "BackupHooks"
+
+	// Alpha: v1.7.0
+	//
+	// DiskGarbageCollection enables virt-controller to detect the DataVolumes and
+	// PersistentVolumeClaims which are no longer referenced by any VM or snapshot,
+	// and optionally to delete them.
+	DiskGarbageCollectionGate = "DiskGarbageCollection"
+
+	// Alpha: v1.7.0
+	//
+	// GuestDiskExpansion lets opted-in guests request the growth of their PVCs
+	// over VSOCK, up to the quota set in the guestDiskExpansion configuration.
+	GuestDiskExpansionGate = "GuestDiskExpansion"
+
+	// Alpha: v1.7.0
+	//
+	// VirtualMachineAuditEvents makes virt-api record privileged operations, like
+	// console access or memory dumps, as VirtualMachineAuditEvents.
+	VirtualMachineAuditEventsGate = "VirtualMachineAuditEvents"
+
+	// Alpha: v1.7.0
+	//
+	// VirtualMachinePolicies makes virt-api enforce the VirtualMachinePolicies of a
+	// namespace on the VirtualMachines and VirtualMachineInstances created in it.
+	VirtualMachinePoliciesGate = "VirtualMachinePolicies"
+
+	// Alpha: v1.7.0
+	//
+	// ContainerDiskVerification makes virt-handler verify the cosign signatures of containerdisk
+	// images against the keys in the KubeVirt CR before exposing the disks to the guest.
+	ContainerDiskVerificationGate = "ContainerDiskVerification"
+
+	// Alpha: v1.7.0
+	//
+	// VolumeScan makes virt-controller pass the containerdisks and DataVolumes of a VMI to the
+	// scanner configured in the KubeVirt CR, and only boot the VMI once all of them were allowed.
+	VolumeScanGate = "VolumeScan"
+
+	// Alpha: v1.7.0
+	//
+	// TPMAttestation enables the tpm/attestation subresource, which returns the TPM event log
+	// and a PCR quote of VMIs with a vTPM for the remote attestation of their boot.
+	TPMAttestationGate = "TPMAttestation"
+
+	// Alpha: v1.7.0
+	//
+	// GuestSecrets allows VMIs to receive short secrets through fw_cfg blobs or NV indices of
+	// their vTPM, instead of writing them in plaintext to a cloud-init disk.
+	GuestSecretsGate = "GuestSecrets"
+
+	// Alpha: v1.7.0
+	//
+	// VirtualMachineCheckups makes virt-controller run VirtualMachineCheckups, standardized
+	// benchmarks of the network latency, the storage IOPS or the boot time of VMs.
+	VirtualMachineCheckupsGate = "VirtualMachineCheckups"
+
+	// Alpha: v1.7.0
+	//
+	// RealtimeReadinessValidation schedules realtime VMIs only to nodes which are ready for them,
+	// and rejects them when every node they could be scheduled to misses isolcpus, nohz_full,
+	// hugepages or a realtime kernel.
+	RealtimeReadinessValidationGate = "RealtimeReadinessValidation"
+
+	// Alpha: v1.7.0
+	//
+	// UsageAccounting makes virt-controller accumulate the vCPU and memory usage of VMIs per
+	// namespace into counters and daily VirtualMachineUsageReports, for showback.
+	UsageAccountingGate = "UsageAccounting"
+
+	// Alpha: v1.7.0
+	//
+	// SealedVMDefaults records the firmware UUID, machine type and MAC addresses which are defaulted
+	// for VMs in spec.sealed instead of the template, so that the template stays as it was applied.
+	SealedVMDefaultsGate = "SealedVMDefaults"
+
+	// Alpha: v1.7.0
+	//
+	// SRIOVFailover allows pairing SR-IOV interfaces with a standby virtio interface, so that the
+	// guest keeps its network connectivity while the SR-IOV device is unplugged for live migration.
+	SRIOVFailoverGate = "SRIOVFailover"
+
+	// Alpha: v1.7.0
+	//
+	// VMDNSRegistration makes virt-controller publish the names and guest IPs of running VMIs,
+	// including those of secondary networks, as endpoints of a headless Service in their namespace.
+	VMDNSRegistrationGate = "VMDNSRegistration"
+
+	// Alpha: v1.7.0
+	//
+	// HybridCPUPinning allows dedicated CPU VMIs to request that their vCPUs are pinned only to
+	// performance or only to efficiency cores on hosts with heterogeneous CPUs.
+	HybridCPUPinningGate = "HybridCPUPinning"
+
+	// Alpha: v1.7.0
+	//
+	// HugepagesPoolManagement lets virt-handler grow and shrink the 2Mi hugepages pool of its node
+	// within the bounds of the hugepagesPool configuration, following the hugepages demand of the VMIs.
+	HugepagesPoolManagementGate = "HugepagesPoolManagement"
+
+	// Alpha: v1.7.0
+	//
+	// DIMMMemoryHotplug allows VMIs to set memory.dimmSlots, to hot plug memory as DIMMs
+	// instead of with a virtio-mem device, for guests which do not support virtio-mem.
+	DIMMMemoryHotplugGate = "DIMMMemoryHotplug"
+
+	// Alpha: v1.7.0
+	//
+	// SnapshotMemory allows VirtualMachineSnapshots to capture the memory state of running VMs,
+	// so that their restores resume the guest where it was.
+	SnapshotMemoryGate = "SnapshotMemory"
+
+	// Alpha: v1.7.0
+	//
+	// IsolatedLauncher allows VMIs to set launcherIsolation to MicroVM, to run their virt-launcher pod
+	// in a micro VM with the isolatedRuntimeClass of the launcherPodConfiguration.
+	// The MicroVM launcher isolation is not functional yet and still rejected.
+	IsolatedLauncherGate = "IsolatedLauncher"
+
+	// Alpha: v1.7.0
+	//
+	// NodeMaintenanceGate enables the NodeMaintenance API. virt-controller taints nodes in maintenance,
+	// evacuates their VMIs and reports when the nodes are ready for reboot.
+	NodeMaintenanceGate = "NodeMaintenance"
+
+	// Alpha: v1.7.0
+	//
+	// VirtualMachineOperationsGate enables the VirtualMachineOperation API to start, stop, restart or
+	// migrate the VMs selected by a label selector with a limited concurrency.
+	VirtualMachineOperationsGate = "VirtualMachineOperations"
+
+	// Alpha: v1.7.0
+	//
+	// LauncherWarmPoolGate lets virt-controller keep the idle virt-launcher pods of the launcherWarmPools
+	// configuration and start new VMIs in them instead of creating new pods.
+	LauncherWarmPoolGate = "LauncherWarmPool"
+
+	// Alpha: v1.7.0
+	//
+	// HostSensorsGate allows VMIs to read temperature and power sensors of their node through a
+	// virtio-serial channel served by virt-handler.
+	HostSensorsGate = "HostSensors"
+
+	// Alpha: v1.7.0
+	//
+	// NVMeGate allows disks to be attached to the guest as namespaces of emulated NVMe controllers.
+	NVMeGate = "NVMe"
+
+	// Alpha: v1.7.0
+	//
+	// SGXGate allows VMIs to run Intel SGX enclaves backed by an EPC section of their node.
+	SGXGate = "SGX"
 )
 
 func init() {
@@ -187,4 +370,34 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: PasstIPStackMigration, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: IncrementalBackupGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: MigrationPriorityQueue, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SharedMemoryDevicesGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtioSerialChannelsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMEmulationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: LauncherReattachGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: BackupHooksGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DiskGarbageCollectionGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestDiskExpansionGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineAuditEventsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachinePoliciesGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ContainerDiskVerificationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VolumeScanGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: TPMAttestationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestSecretsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineCheckupsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: RealtimeReadinessValidationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: UsageAccountingGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SealedVMDefaultsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SRIOVFailoverGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VMDNSRegistrationGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HybridCPUPinningGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HugepagesPoolManagementGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DIMMMemoryHotplugGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SnapshotMemoryGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: IsolatedLauncherGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NodeMaintenanceGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VirtualMachineOperationsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: LauncherWarmPoolGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: HostSensorsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: NVMeGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SGXGate, State: Alpha})
 }
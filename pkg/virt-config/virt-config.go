@@ -24,6 +24,8 @@ package virtconfig
 */
 
 import (
+	"time"
+
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
@@ -89,6 +91,13 @@ const (
 
 	DefaultMaxHotplugRatio   = 4
 	DefaultVMRolloutStrategy = v1.VMRolloutStrategyLiveUpdate
+
+	DefaultDiskGarbageCollectionGracePeriod = 24 * time.Hour
+	DefaultVolumeScanTimeout                = 5 * time.Minute
+
+	DefaultVirtualMachineAuditEventRetention = 30 * 24 * time.Hour
+
+	DefaultDNSRegistrationServiceName = "vms"
 )
 
 func IsARM64(arch string) bool {
@@ -214,6 +223,15 @@ func (c *ClusterConfig) GetDefaultRuntimeClass() string {
 	return c.GetConfig().DefaultRuntimeClass
 }
 
+// GetIsolatedRuntimeClass returns the RuntimeClass of the virt-launcher pods
+// of VMIs with the MicroVM launcher isolation
+func (c *ClusterConfig) GetIsolatedRuntimeClass() string {
+	if podConfig := c.GetConfig().LauncherPodConfiguration; podConfig != nil {
+		return podConfig.IsolatedRuntimeClass
+	}
+	return ""
+}
+
 func (c *ClusterConfig) GetSupportedAgentVersions() []string {
 	return c.GetConfig().SupportedGuestAgentVersions
 }
@@ -404,6 +422,89 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+// GetVMIStatusBatching returns the minimum interval between two informational
+// VMI status writes and the maximum jitter added to it. A zero interval
+// disables batching.
+func (c *ClusterConfig) GetVMIStatusBatching() (interval, maxJitter time.Duration) {
+	updates := c.GetConfig().VMIStatusUpdates
+	if updates == nil {
+		return 0, 0
+	}
+	if updates.BatchInterval != nil {
+		interval = updates.BatchInterval.Duration
+	}
+	if updates.MaxJitter != nil {
+		maxJitter = updates.MaxJitter.Duration
+	}
+	return interval, maxJitter
+}
+
+// GetDiskGarbageCollection returns the duration a disk has to stay unreferenced
+// before it is reported as orphaned, and whether orphaned disks are deleted.
+func (c *ClusterConfig) GetDiskGarbageCollection() (gracePeriod time.Duration, cleanup bool) {
+	gracePeriod = DefaultDiskGarbageCollectionGracePeriod
+	gc := c.GetConfig().DiskGarbageCollection
+	if gc == nil {
+		return gracePeriod, false
+	}
+	if gc.GracePeriod != nil {
+		gracePeriod = gc.GracePeriod.Duration
+	}
+	return gracePeriod, gc.Cleanup != nil && *gc.Cleanup
+}
+
+// GetGuestDiskExpansionMaxSize returns the largest size a guest can grow a PVC
+// to, or nil if no quota is set and guest requests are always rejected.
+func (c *ClusterConfig) GetGuestDiskExpansionMaxSize() *resource.Quantity {
+	if guestDiskExpansion := c.GetConfig().GuestDiskExpansion; guestDiskExpansion != nil {
+		return guestDiskExpansion.MaxSize
+	}
+	return nil
+}
+
+// GetContainerDiskVerificationPublicKeys returns the PEM encoded keys the
+// signatures of containerdisk images are verified with.
+func (c *ClusterConfig) GetContainerDiskVerificationPublicKeys() []string {
+	if verification := c.GetConfig().ContainerDiskVerification; verification != nil {
+		return verification.PublicKeys
+	}
+	return nil
+}
+
+// GetContainerDiskVerificationTrustedTokenHosts returns the hosts, besides the
+// registries themselves, anonymous registry tokens may be requested from.
+func (c *ClusterConfig) GetContainerDiskVerificationTrustedTokenHosts() []string {
+	if verification := c.GetConfig().ContainerDiskVerification; verification != nil {
+		return verification.TrustedTokenHosts
+	}
+	return nil
+}
+
+// GetVolumeScan returns the endpoint of the volume scanner, empty if none is
+// configured, the CA bundle its certificate is verified with and the duration
+// a single scan may take.
+func (c *ClusterConfig) GetVolumeScan() (endpoint, caBundle string, timeout time.Duration) {
+	timeout = DefaultVolumeScanTimeout
+	volumeScan := c.GetConfig().VolumeScan
+	if volumeScan == nil {
+		return "", "", timeout
+	}
+	if volumeScan.Timeout != nil {
+		timeout = volumeScan.Timeout.Duration
+	}
+	return volumeScan.Endpoint, volumeScan.CABundle, timeout
+}
+
+// GetVirtualMachineAuditEventRetention returns the duration after its request
+// time a VirtualMachineAuditEvent is deleted.
+func (c *ClusterConfig) GetVirtualMachineAuditEventRetention() time.Duration {
+	auditEvents := c.GetConfig().VirtualMachineAuditEvents
+	if auditEvents == nil || auditEvents.Retention == nil {
+		return DefaultVirtualMachineAuditEventRetention
+	}
+	return auditEvents.Retention.Duration
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
@@ -416,6 +517,47 @@ func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
 
+func (c *ClusterConfig) GetHugepagesPoolConfiguration() *v1.HugepagesPoolConfiguration {
+	return c.GetConfig().HugepagesPool
+}
+
+func (c *ClusterConfig) GetVMStartThrottlingConfiguration() *v1.VMStartThrottlingConfiguration {
+	return c.GetConfig().VMStartThrottling
+}
+
+func (c *ClusterConfig) GetLauncherWarmPools() []v1.LauncherWarmPool {
+	return c.GetConfig().LauncherWarmPools
+}
+
+func (c *ClusterConfig) GetAllowedHostPaths() []string {
+	return c.GetConfig().AllowedHostPaths
+}
+
+// GetFirmwareProfile returns the firmware profile with the given name, or nil if it is not configured
+func (c *ClusterConfig) GetFirmwareProfile(name string) *v1.FirmwareProfile {
+	profiles := c.GetConfig().FirmwareProfiles
+	for i := range profiles {
+		if profiles[i].Name == name {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
+
+// GetFirmwareImage returns the firmware image the profile provides for the architecture, or nil if there is none
+func (c *ClusterConfig) GetFirmwareImage(profileName, architecture string) *v1.FirmwareImage {
+	profile := c.GetFirmwareProfile(profileName)
+	if profile == nil {
+		return nil
+	}
+	for i := range profile.Images {
+		if profile.Images[i].Architecture == architecture {
+			return &profile.Images[i]
+		}
+	}
+	return nil
+}
+
 func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 	liveConfig := c.GetConfig().LiveUpdateConfiguration
 	if liveConfig != nil && liveConfig.MaxCpuSockets != nil {
@@ -455,6 +597,22 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	return nil
 }
 
+func (c *ClusterConfig) GetDefaultBindingPolicies() []v1.DefaultBindingPolicy {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil {
+		return networkConfig.DefaultBindingPolicies
+	}
+	return nil
+}
+
+func (c *ClusterConfig) GetDNSRegistrationServiceName() string {
+	networkConfig := c.GetConfig().NetworkConfiguration
+	if networkConfig != nil && networkConfig.DNSRegistration != nil && networkConfig.DNSRegistration.ServiceName != "" {
+		return networkConfig.DNSRegistration.ServiceName
+	}
+	return DefaultDNSRegistrationServiceName
+}
+
 func (config *ClusterConfig) VGADisplayForEFIGuestsEnabled() bool {
 	VGADisplayForEFIGuestsAnnotationExists := false
 	kv := config.GetConfigFromKubeVirtCR()
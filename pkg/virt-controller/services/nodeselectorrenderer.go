@@ -21,11 +21,15 @@ type NodeSelectorRenderer struct {
 	tscFrequency           *int64
 	vmiFeatures            *v1.Features
 	realtimeEnabled        bool
+	realtimeReady          bool
+	nestedVirtualization   bool
 	sevEnabled             bool
 	sevESEnabled           bool
 	SecureExecutionEnabled bool
 	sevSNPEnabled          bool
 	tdxEnabled             bool
+	sgxEnabled             bool
+	cpuCoreType            v1.CPUCoreType
 }
 
 type NodeSelectorRendererOption func(renderer *NodeSelectorRenderer)
@@ -76,6 +80,12 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.realtimeEnabled {
 		nsr.enableSelectorLabel(v1.RealtimeLabel)
 	}
+	if nsr.realtimeReady {
+		nsr.enableSelectorLabel(v1.RealtimeReadyLabel)
+	}
+	if nsr.nestedVirtualization {
+		nsr.enableSelectorLabel(v1.NestedVirtualizationLabel)
+	}
 	if nsr.sevEnabled {
 		nsr.enableSelectorLabel(v1.SEVLabel)
 	}
@@ -91,6 +101,12 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 	if nsr.tdxEnabled {
 		nsr.enableSelectorLabel(v1.TDXLabel)
 	}
+	if nsr.sgxEnabled {
+		nsr.enableSelectorLabel(v1.SGXLabel)
+	}
+	if nsr.cpuCoreType != "" {
+		nsr.enableSelectorLabel(v1.CPUCoreTypeLabel + string(nsr.cpuCoreType))
+	}
 
 	return nsr.podNodeSelectors
 }
@@ -108,6 +124,25 @@ func WithRealtime() NodeSelectorRendererOption {
 		renderer.realtimeEnabled = true
 	}
 }
+
+func WithRealtimeReady() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.realtimeReady = true
+	}
+}
+
+func WithCPUCoreType(coreType v1.CPUCoreType) NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.cpuCoreType = coreType
+	}
+}
+
+func WithNestedVirtualization() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.nestedVirtualization = true
+	}
+}
+
 func WithSEVSelector() NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.sevEnabled = true
@@ -137,6 +172,12 @@ func WithTDXSelector() NodeSelectorRendererOption {
 	}
 }
 
+func WithSGXSelector() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.sgxEnabled = true
+	}
+}
+
 func WithDedicatedCPU() NodeSelectorRendererOption {
 	return func(renderer *NodeSelectorRenderer) {
 		renderer.hasDedicatedCPU = true
@@ -238,23 +238,31 @@ func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort
 }
 
 func updateReadinessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Probe) {
-	if vmi.Spec.ReadinessProbe.GuestAgentPing != nil {
-		wrapGuestAgentPingWithVirtProbe(vmi, computeProbe)
-		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
-		return
-	}
-	wrapExecProbeWithVirtProbe(vmi, computeProbe)
+	wrapProbeWithVirtProbe(vmi, vmi.Spec.ReadinessProbe, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
 
 func updateLivenessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Probe) {
-	if vmi.Spec.LivenessProbe.GuestAgentPing != nil {
+	wrapProbeWithVirtProbe(vmi, vmi.Spec.LivenessProbe, computeProbe)
+	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
+}
+
+// wrapProbeWithVirtProbe turns the guest based handlers of a VMI probe into
+// exec probes running virt-probe inside the compute container.
+func wrapProbeWithVirtProbe(vmi *v1.VirtualMachineInstance, vmiProbe *v1.Probe, computeProbe *k8sv1.Probe) {
+	switch {
+	case vmiProbe.GuestAgentPing != nil:
 		wrapGuestAgentPingWithVirtProbe(vmi, computeProbe)
-		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
-		return
+	case vmiProbe.GuestAgentExec != nil:
+		computeProbe.ProbeHandler.Exec = &k8sv1.ExecAction{
+			Command: append([]string{}, vmiProbe.GuestAgentExec.Command...),
+		}
+		wrapExecProbeWithVirtProbe(vmi, computeProbe)
+	case vmiProbe.VSockHTTP != nil:
+		wrapVSockHTTPWithVirtProbe(vmi, vmiProbe.VSockHTTP, computeProbe)
+	default:
+		wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	}
-	wrapExecProbeWithVirtProbe(vmi, computeProbe)
-	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
 
 func wrapExecProbeWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv1.Probe) {
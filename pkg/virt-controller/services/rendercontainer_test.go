@@ -240,6 +240,50 @@ var _ = Describe("Container spec renderer", func() {
 				Expect(specRenderer.Render(exampleCommand).LivenessProbe.Exec.Command).To(Equal(expectedExecCmd))
 			})
 		})
+
+		Context("guest agent exec probe", func() {
+			It("should wrap the guest agent exec command inside virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GuestAgentExec: &v1.GuestAgentExecAction{Command: []string{"systemctl", "is-active", "nginx"}},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithReadinessProbe(
+					vmiWithReadinessProbe(probe)))
+				readinessProbe := specRenderer.Render(exampleCommand).ReadinessProbe
+				Expect(readinessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--command", "systemctl",
+					"--",
+					"is-active", "nginx"))
+				Expect(readinessProbe.TimeoutSeconds).To(Equal(dummyProbe().TimeoutSeconds + 1))
+				Expect(probe.GuestAgentExec.Command).To(HaveExactElements("systemctl", "is-active", "nginx"))
+			})
+		})
+
+		Context("vsock http probe", func() {
+			DescribeTable("should perform the request through virt-probe", func(path, expectedPath string) {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					VSockHTTP: &v1.VSockHTTPAction{Port: 1234, Path: path},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithLivelinessProbe(
+					vmiWithLivenessProbe(probe)))
+				livenessProbe := specRenderer.Render(exampleCommand).LivenessProbe
+				Expect(livenessProbe.HTTPGet).To(BeNil())
+				Expect(livenessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--vsockPort", "1234",
+					"--vsockPath", expectedPath))
+				Expect(livenessProbe.TimeoutSeconds).To(Equal(dummyProbe().TimeoutSeconds + 1))
+			},
+				Entry("with the given path", "/healthz", "/healthz"),
+				Entry("defaulting to the root path", "", "/"),
+			)
+		})
 	})
 })
 
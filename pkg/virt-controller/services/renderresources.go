@@ -375,6 +375,15 @@ func WithSEV() ResourceRendererOption {
 	}
 }
 
+func WithSGX() ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		resources := renderer.ResourceRequirements()
+		requestResource(&resources, SgxDevice)
+		copyResources(resources.Limits, renderer.calculatedLimits)
+		copyResources(resources.Requests, renderer.calculatedRequests)
+	}
+}
+
 func WithPersistentReservation() ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
@@ -619,6 +628,9 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 		for _, dev := range hostDevs.USB {
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
+		for _, pool := range hostDevs.VirtualFunctionPools {
+			supportedHostDevicesMap[pool.ResourceName] = true
+		}
 		//TODO @alayp: add proper validation for DRA GPUs in beta
 		if !config.GPUsWithDRAGateEnabled() {
 			for _, hostDev := range spec.Domain.Devices.GPUs {
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"kubevirt.io/kubevirt/pkg/smartcard"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/tpm"
 
@@ -17,6 +18,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/config"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/guestsecrets"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
@@ -197,6 +199,10 @@ func withVMIConfigVolumes(vmiDisks []v1.Disk, vmiVolumes []v1.Volume) VolumeRend
 	return func(renderer *VolumeRenderer) error {
 		volumes := make(map[string]v1.Volume)
 		for _, volume := range vmiVolumes {
+			// hotpluggable volumes are mounted by the attachment pod
+			if types.IsHotplugConfigVolume(&volume) {
+				continue
+			}
 			volumes[volume.Name] = volume
 
 			if volume.Secret != nil {
@@ -340,7 +346,8 @@ func hotplugVolumes(vmiVolumeStatus []v1.VolumeStatus, vmiSpecVolumes []v1.Volum
 	}
 	// This detects hotplug volumes for a started but not ready VMI
 	for _, volume := range vmiSpecVolumes {
-		if (volume.DataVolume != nil && volume.DataVolume.Hotpluggable) || (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable) {
+		if (volume.DataVolume != nil && volume.DataVolume.Hotpluggable) || (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable) ||
+			types.IsHotplugConfigVolume(&volume) {
 			hotplugVolumeSet[volume.Name] = struct{}{}
 		}
 	}
@@ -379,6 +386,73 @@ func withAccessCredentials(accessCredentials []v1.AccessCredential) VolumeRender
 	}
 }
 
+func withGuestSecrets(guestSecrets []v1.GuestSecret) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		secretNames := map[string]struct{}{}
+		for _, guestSecret := range guestSecrets {
+			if _, exists := secretNames[guestSecret.SecretName]; exists {
+				continue
+			}
+			secretNames[guestSecret.SecretName] = struct{}{}
+
+			volumeName := guestsecrets.VolumeName(guestSecret.SecretName)
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{
+						SecretName: guestSecret.SecretName,
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				MountPath: filepath.Join(config.SecretSourceDir, volumeName),
+				ReadOnly:  true,
+			})
+		}
+		return nil
+	}
+}
+
+func withTPMEncryptionSecret(secretRef *k8sv1.LocalObjectReference) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: tpm.EncryptionSecretVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: secretRef.Name,
+					Items:      []k8sv1.KeyToPath{{Key: tpm.EncryptionSecretKey, Path: tpm.EncryptionSecretKey}},
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      tpm.EncryptionSecretVolumeName,
+			MountPath: filepath.Dir(tpm.EncryptionSecretPath()),
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
+func withSmartcardDatabase(secretName string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: smartcard.DatabaseVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      smartcard.DatabaseVolumeName,
+			MountPath: smartcard.DatabasePath(),
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
 func PathForSwtpm(vmi *v1.VirtualMachineInstance) string {
 	swtpmPath := "/var/lib/libvirt/swtpm"
 	if util.IsNonRootVMI(vmi) {
@@ -389,12 +463,7 @@ func PathForSwtpm(vmi *v1.VirtualMachineInstance) string {
 }
 
 func PathForSwtpmLocalca(vmi *v1.VirtualMachineInstance) string {
-	localCaPath := "/var/lib/swtpm-localca"
-	if util.IsNonRootVMI(vmi) {
-		localCaPath = filepath.Join(util.VirtPrivateDir, "var", "lib", "swtpm-localca")
-	}
-
-	return localCaPath
+	return tpm.LocalCAPath(vmi)
 }
 
 func PathForNVram(vmi *v1.VirtualMachineInstance) string {
@@ -539,6 +608,145 @@ func withHugepages() VolumeRendererOption {
 	}
 }
 
+func withSharedMemory(sharedMemoryDevices []v1.SharedMemoryDevice) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		for _, shmem := range sharedMemoryDevices {
+			var hostPath, mountPath string
+			var hostPathType k8sv1.HostPathType
+			switch {
+			case shmem.Model == v1.SharedMemoryModelDoorbell:
+				hostPath = shmem.ServerSocketPath
+				hostPathType = k8sv1.HostPathSocket
+				mountPath = util.SharedMemoryServerSocketPath(shmem.Name)
+			case shmem.HostPath != "":
+				hostPath = shmem.HostPath
+				hostPathType = k8sv1.HostPathFileOrCreate
+				mountPath = util.SharedMemoryPath(shmem.Name)
+			default:
+				// Regions without a host file stay in the pod's /dev/shm
+				continue
+			}
+
+			volumeName := "shmem-" + shmem.Name
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					HostPath: &k8sv1.HostPathVolumeSource{
+						Path: hostPath,
+						Type: &hostPathType,
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				MountPath: mountPath,
+			})
+		}
+		return nil
+	}
+}
+
+func withChannels(channels []v1.Channel) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(channelSocks))
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(channelSocks, util.VirtChannelsDir))
+
+		hostPathType := k8sv1.HostPathDirectoryOrCreate
+		for _, channel := range channels {
+			if channel.HostPath == "" {
+				continue
+			}
+			volumeName := "channel-" + channel.Name
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					HostPath: &k8sv1.HostPathVolumeSource{
+						Path: channel.HostPath,
+						Type: &hostPathType,
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(volumeName, filepath.Dir(util.ChannelSocketPath(channel))))
+		}
+		return nil
+	}
+}
+
+// withTDXQuoteGenerationService mounts the directory of the quote generation
+// service socket of the node, libvirt connects the TD to it to get quotes
+func withTDXQuoteGenerationService() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		hostPathType := k8sv1.HostPathDirectory
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: tdxQGS,
+			VolumeSource: k8sv1.VolumeSource{
+				HostPath: &k8sv1.HostPathVolumeSource{
+					Path: util.TDXQuoteGenerationServiceDir,
+					Type: &hostPathType,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(tdxQGS, util.TDXQuoteGenerationServiceDir))
+		return nil
+	}
+}
+
+func withEmulatorBundle(bundle *v1.EmulatorBundle) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: emulatorBundle,
+			VolumeSource: k8sv1.VolumeSource{
+				Image: &k8sv1.ImageVolumeSource{
+					Reference:  bundle.Image,
+					PullPolicy: bundle.ImagePullPolicy,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      emulatorBundle,
+			MountPath: util.VirtEmulatorBundleDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
+func withFirmwareImage(image *v1.FirmwareImage) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: firmwareImage,
+			VolumeSource: k8sv1.VolumeSource{
+				Image: &k8sv1.ImageVolumeSource{
+					Reference:  image.Image,
+					PullPolicy: image.ImagePullPolicy,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      firmwareImage,
+			MountPath: util.VirtFirmwareImageDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
+// withMemoryState mounts the claim holding the memory state a restored VMI resumes from
+func withMemoryState(claimName string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: memoryState,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(memoryState, util.VirtMemoryStateDir))
+		return nil
+	}
+}
+
 func withHotplugSupport(hotplugDiskDir string) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		prop := k8sv1.MountPropagationHostToContainer
@@ -576,7 +784,7 @@ func imgPullSecrets(volumes ...v1.Volume) []k8sv1.LocalObjectReference {
 
 func serviceAccount(volumes ...v1.Volume) string {
 	for _, volume := range volumes {
-		if volume.ServiceAccount != nil {
+		if volume.ServiceAccount != nil && !volume.ServiceAccount.Hotpluggable {
 			return volume.ServiceAccount.ServiceAccountName
 		}
 	}
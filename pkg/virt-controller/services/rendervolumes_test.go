@@ -342,6 +342,212 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+	Context("with shared memory devices", func() {
+		BeforeEach(func() {
+			sharedMemoryDevices := []v1.SharedMemoryDevice{
+				{Name: "pod-local"},
+				{Name: "ring", HostPath: "/var/lib/shmem/ring"},
+				{Name: "bell", Model: v1.SharedMemoryModelDoorbell, ServerSocketPath: "/run/ivshmem.sock"},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withSharedMemory(sharedMemoryDevices))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount the host files and server sockets", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{Name: "shmem-ring", MountPath: "/dev/shm/ring"},
+					k8sv1.VolumeMount{Name: "shmem-bell", MountPath: "/var/run/kubevirt-shmem/bell.sock"},
+				)))
+		})
+
+		It("should feature the host path volumes", func() {
+			fileOrCreate := k8sv1.HostPathFileOrCreate
+			socket := k8sv1.HostPathSocket
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "shmem-ring",
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{Path: "/var/lib/shmem/ring", Type: &fileOrCreate},
+						},
+					},
+					k8sv1.Volume{
+						Name: "shmem-bell",
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{Path: "/run/ivshmem.sock", Type: &socket},
+						},
+					},
+				)))
+		})
+	})
+
+	Context("with channels", func() {
+		BeforeEach(func() {
+			channels := []v1.Channel{
+				{Name: "agent", Target: "org.example.agent.0"},
+				{Name: "node-agent", Target: "org.example.node.0", HostPath: "/var/lib/agent"},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withChannels(channels))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount the channel sockets directory and the host paths", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{Name: "channel-sockets", MountPath: "/var/run/kubevirt-channels"},
+					k8sv1.VolumeMount{Name: "channel-node-agent", MountPath: "/var/run/kubevirt-channels/node-agent"},
+				)))
+		})
+
+		It("should feature the channel sockets and host path volumes", func() {
+			directoryOrCreate := k8sv1.HostPathDirectoryOrCreate
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name:         "channel-sockets",
+						VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{}},
+					},
+					k8sv1.Volume{
+						Name: "channel-node-agent",
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{Path: "/var/lib/agent", Type: &directoryOrCreate},
+						},
+					},
+				)))
+		})
+	})
+
+	Context("with TDX quote generation service", func() {
+		BeforeEach(func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withTDXQuoteGenerationService())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount the quote generation service directory of the node", func() {
+			directory := k8sv1.HostPathDirectory
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{Name: "tdx-qgs", MountPath: "/var/run/tdx-qgs"},
+				)))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "tdx-qgs",
+						VolumeSource: k8sv1.VolumeSource{
+							HostPath: &k8sv1.HostPathVolumeSource{Path: "/var/run/tdx-qgs", Type: &directory},
+						},
+					},
+				)))
+		})
+	})
+
+	Context("with memory state", func() {
+		BeforeEach(func() {
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withMemoryState("restored-memory"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount the memory state claim", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{Name: "memory-state", MountPath: "/var/run/kubevirt-private/memory-state"},
+				)))
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "memory-state",
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "restored-memory"},
+						},
+					},
+				)))
+		})
+	})
+
+	Context("with guest secrets", func() {
+		BeforeEach(func() {
+			guestSecrets := []v1.GuestSecret{
+				{Name: "token", SecretName: "bootstrap", Key: "token", Target: v1.GuestSecretTargetFWCfg},
+				{Name: "identity", SecretName: "bootstrap", Key: "identity", Target: v1.GuestSecretTargetFWCfg},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withGuestSecrets(guestSecrets))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should mount every secret once", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{Name: "bootstrap-guest-secret", MountPath: "/var/run/kubevirt-private/secret/bootstrap-guest-secret", ReadOnly: true},
+				)))
+		})
+
+		It("should feature the secret volumes", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name:         "bootstrap-guest-secret",
+						VolumeSource: k8sv1.VolumeSource{Secret: &k8sv1.SecretVolumeSource{SecretName: "bootstrap"}},
+					},
+				)))
+		})
+	})
+
+	It("should mount the passphrase of the TPM state", func() {
+		var err error
+		vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir,
+			withTPMEncryptionSecret(&k8sv1.LocalObjectReference{Name: "tpm-passphrase"}))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(vsr.Mounts()).To(ContainElement(k8sv1.VolumeMount{
+			Name:      "tpm-encryption-secret",
+			MountPath: "/var/run/kubevirt-private/secret/tpm-encryption-secret",
+			ReadOnly:  true,
+		}))
+		Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+			Name: "tpm-encryption-secret",
+			VolumeSource: k8sv1.VolumeSource{Secret: &k8sv1.SecretVolumeSource{
+				SecretName: "tpm-passphrase",
+				Items:      []k8sv1.KeyToPath{{Key: "key", Path: "key"}},
+			}},
+		}))
+	})
+
+	It("should mount the NSS database of an emulated smartcard", func() {
+		var err error
+		vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir,
+			withSmartcardDatabase("smartcard-nssdb"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(vsr.Mounts()).To(ContainElement(k8sv1.VolumeMount{
+			Name:      "smartcard-database",
+			MountPath: "/var/run/kubevirt-private/secret/smartcard-database",
+			ReadOnly:  true,
+		}))
+		Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+			Name:         "smartcard-database",
+			VolumeSource: k8sv1.VolumeSource{Secret: &k8sv1.SecretVolumeSource{SecretName: "smartcard-nssdb"}},
+		}))
+	})
+
 	Context("With CBT", func() {
 		It("should not mount the CBT subpath when ChangedBlockTracking is not set", func() {
 			vmi := &v1.VirtualMachineInstance{}
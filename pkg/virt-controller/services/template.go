@@ -25,6 +25,7 @@ import (
 	"maps"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -53,9 +54,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/multus"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/smartcard"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/dns"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -69,6 +72,11 @@ const (
 	containerDisks   = "container-disks"
 	hotplugDisks     = "hotplug-disks"
 	hookSidecarSocks = "hook-sidecar-sockets"
+	channelSocks     = "channel-sockets"
+	emulatorBundle   = "emulator-bundle"
+	firmwareImage    = "firmware-image"
+	tdxQGS           = "tdx-qgs"
+	memoryState      = "memory-state"
 	varRun           = "/var/run"
 	virtBinDir       = "virt-bin-share-dir"
 	hotplugDisk      = "hotplug-disk"
@@ -79,6 +87,7 @@ const KvmDevice = "devices.kubevirt.io/kvm"
 const TunDevice = "devices.kubevirt.io/tun"
 const VhostNetDevice = "devices.kubevirt.io/vhost-net"
 const SevDevice = "devices.kubevirt.io/sev"
+const SgxDevice = "devices.kubevirt.io/sgx"
 const VhostVsockDevice = "devices.kubevirt.io/vhost-vsock"
 const PrDevice = "devices.kubevirt.io/pr-helper"
 
@@ -164,6 +173,7 @@ func isFeatureStateEnabled(fs *v1.FeatureState) bool {
 func setNodeAffinityForPod(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
 	setNodeAffinityForHostModelCpuModel(vmi, pod)
 	setNodeAffinityForbiddenFeaturePolicy(vmi, pod)
+	setNodeAffinityForEmulation(vmi, pod)
 }
 
 func setNodeAffinityForHostModelCpuModel(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
@@ -184,6 +194,35 @@ func setNodeAffinityForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, pod *
 	}
 }
 
+// setNodeAffinityForEmulation lets emulated VMIs prefer nodes without KVM, to
+// keep the nodes with KVM for the VMIs which need it. VMIs which need KVM are
+// kept away from nodes without KVM by the kvm device resource.
+func setNodeAffinityForEmulation(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if !vmi.IsEmulationEnabled() {
+		return
+	}
+
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &k8sv1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &k8sv1.NodeAffinity{}
+	}
+	pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+		pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+		k8sv1.PreferredSchedulingTerm{
+			Weight: 100,
+			Preference: k8sv1.NodeSelectorTerm{
+				MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+					Key:      v1.KVMUnavailableLabel,
+					Operator: k8sv1.NodeSelectorOpIn,
+					Values:   []string{"true"},
+				}},
+			},
+		},
+	)
+}
+
 func modifyNodeAffintyToRejectLabel(origAffinity *k8sv1.Affinity, labelToReject string) *k8sv1.Affinity {
 	affinity := origAffinity.DeepCopy()
 	requirement := k8sv1.NodeSelectorRequirement{
@@ -309,7 +348,7 @@ func generateQemuTimeoutWithJitter(qemuTimeoutBaseSeconds int) string {
 	return fmt.Sprintf("%ds", timeout)
 }
 
-func computePodSecurityContext(vmi *v1.VirtualMachineInstance, seccomp *k8sv1.SeccompProfile) *k8sv1.PodSecurityContext {
+func computePodSecurityContext(vmi *v1.VirtualMachineInstance, seccomp *k8sv1.SeccompProfile, vfioGroupID *int64) *k8sv1.PodSecurityContext {
 	psc := &k8sv1.PodSecurityContext{}
 
 	// virtiofs container will run unprivileged even if the pod runs as root,
@@ -321,6 +360,11 @@ func computePodSecurityContext(vmi *v1.VirtualMachineInstance, seccomp *k8sv1.Se
 		psc.RunAsUser = &nonRootUser
 		psc.RunAsGroup = &nonRootUser
 		psc.RunAsNonRoot = pointer.P(true)
+		// the VFIO device nodes keep the ownership they have on the node,
+		// access is granted through their group
+		if vfioGroupID != nil && util.IsVFIOVMI(vmi) {
+			psc.SupplementalGroups = []int64{*vfioGroupID}
+		}
 	} else {
 		rootUser := int64(util.RootUser)
 		psc.RunAsUser = &rootUser
@@ -373,6 +417,20 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	resources := resourceRenderer.ResourceRequirements()
 
 	ovmfPath := t.clusterConfig.GetOVMFPath(vmi.Spec.Architecture)
+	bundle := t.emulatorBundle(vmi)
+	if bundle == nil && vmi.Spec.Domain.EmulatorBundle != "" {
+		return nil, fmt.Errorf("emulator bundle %s is not configured", vmi.Spec.Domain.EmulatorBundle)
+	}
+	if bundle != nil && bundle.Firmware != "" {
+		ovmfPath = filepath.Join(util.VirtEmulatorBundleDir, bundle.Firmware)
+	}
+	if profile := firmwareProfileName(vmi); profile != "" && t.clusterConfig.GetFirmwareProfile(profile) == nil {
+		return nil, fmt.Errorf("firmware profile %s is not configured", profile)
+	}
+	// The firmware image of the profile takes precedence over the firmware of the emulator bundle
+	if image := t.firmwareImage(vmi); image != nil {
+		ovmfPath = filepath.Join(util.VirtFirmwareImageDir, image.Path)
+	}
 
 	var requestedHookSidecarList hooks.HookSidecarList
 	for _, sidecarCreator := range t.sidecarCreators {
@@ -414,9 +472,12 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
 		}
+		if t.clusterConfig.LauncherReattachEnabled() {
+			command = append(command, "--reattach-on-crash")
+		}
 	}
 
-	if t.clusterConfig.AllowEmulation() {
+	if t.allowEmulation(vmi) {
 		command = append(command, "--allow-emulation")
 	}
 
@@ -461,6 +522,10 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: ENV_VAR_VIRTIOFSD_DEBUG_LOGS, Value: "1"})
 	}
 
+	if bundle != nil {
+		compute.Env = append(compute.Env, k8sv1.EnvVar{Name: util.EnvVarEmulatorPath, Value: filepath.Join(util.VirtEmulatorBundleDir, bundle.Emulator)})
+	}
+
 	compute.Env = append(compute.Env, k8sv1.EnvVar{
 		Name: ENV_VAR_POD_NAME,
 		ValueFrom: &k8sv1.EnvVarSource{
@@ -607,23 +672,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	hostName := dns.SanitizeHostname(vmi)
 	enableServiceLinks := false
 
-	var podSeccompProfile *k8sv1.SeccompProfile = nil
-	if seccompConf := t.clusterConfig.GetConfig().SeccompConfiguration; seccompConf != nil && seccompConf.VirtualMachineInstanceProfile != nil {
-		vmProfile := seccompConf.VirtualMachineInstanceProfile
-		if customProfile := vmProfile.CustomProfile; customProfile != nil {
-			if customProfile.LocalhostProfile != nil {
-				podSeccompProfile = &k8sv1.SeccompProfile{
-					Type:             k8sv1.SeccompProfileTypeLocalhost,
-					LocalhostProfile: customProfile.LocalhostProfile,
-				}
-			} else if customProfile.RuntimeDefaultProfile {
-				podSeccompProfile = &k8sv1.SeccompProfile{
-					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
-				}
-			}
-		}
-
-	}
+	securityProfile := t.launcherSecurityProfile(vmi)
 	pod := k8sv1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "virt-launcher-" + domain + "-",
@@ -636,7 +685,7 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		Spec: k8sv1.PodSpec{
 			Hostname:                      hostName,
 			Subdomain:                     vmi.Spec.Subdomain,
-			SecurityContext:               computePodSecurityContext(vmi, podSeccompProfile),
+			SecurityContext:               computePodSecurityContext(vmi, t.launcherSeccompProfile(securityProfile), t.clusterConfig.GetConfig().VFIOGroupID),
 			TerminationGracePeriodSeconds: &gracePeriodKillAfter,
 			RestartPolicy:                 k8sv1.RestartPolicyNever,
 			Containers:                    containers,
@@ -655,10 +704,16 @@ func (t *TemplateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		},
 	}
 
-	alignPodMultiCategorySecurity(&pod, t.clusterConfig.GetSELinuxLauncherType(), t.clusterConfig.DockerSELinuxMCSWorkaroundEnabled())
+	alignPodMultiCategorySecurity(&pod, t.launcherSELinuxType(securityProfile), t.clusterConfig.DockerSELinuxMCSWorkaroundEnabled())
 
 	// If we have a runtime class specified, use it, otherwise don't set a runtimeClassName
 	runtimeClassName := t.clusterConfig.GetDefaultRuntimeClass()
+	if vmi.Spec.RuntimeClassName != nil {
+		runtimeClassName = *vmi.Spec.RuntimeClassName
+	}
+	if util.IsIsolatedLauncherVMI(vmi) {
+		runtimeClassName = t.clusterConfig.GetIsolatedRuntimeClass()
+	}
 	if runtimeClassName != "" {
 		pod.Spec.RuntimeClassName = &runtimeClassName
 	}
@@ -700,7 +755,12 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithHyperv(vmi.Spec.Domain.Features))
 	}
 
-	if modelLabel, err := CPUModelLabelFromCPUModel(vmi); err == nil {
+	// Emulated guests with an emulator bundle depend neither on the host CPU nor on the
+	// host architecture and its machine types, the bundle provides them. virt-launcher
+	// itself only ships the qemu binary of its own architecture.
+	emulated := vmi.IsEmulationEnabled() && t.emulatorBundle(vmi) != nil
+
+	if modelLabel, err := CPUModelLabelFromCPUModel(vmi); err == nil && !emulated {
 		opts = append(
 			opts,
 			WithModelAndFeatureLabels(modelLabel, CPUFeatureLabelsFromCPUFeatures(vmi)...),
@@ -714,7 +774,7 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		machineType = vmi.Spec.Domain.Machine.Type
 	}
 
-	if machineType != "" {
+	if machineType != "" && !emulated {
 		opts = append(opts, WithMachineType(machineType))
 	}
 
@@ -725,6 +785,17 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 	if vmi.IsRealtimeEnabled() {
 		log.Log.V(4).Info("Add realtime node label selector")
 		opts = append(opts, WithRealtime())
+		if t.clusterConfig.RealtimeReadinessValidationEnabled() {
+			opts = append(opts, WithRealtimeReady())
+		}
+	}
+	if vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.CoreType != "" {
+		log.Log.V(4).Info("Add CPU core type node label selector")
+		opts = append(opts, WithCPUCoreType(vmi.Spec.Domain.CPU.CoreType))
+	}
+	if vmi.IsNestedVirtualizationEnabled() {
+		log.Log.V(4).Info("Add nested virtualization node label selector")
+		opts = append(opts, WithNestedVirtualization())
 	}
 	if util.IsSEVVMI(vmi) {
 		log.Log.V(4).Info("Add SEV node label selector")
@@ -750,10 +821,20 @@ func (t *TemplateService) newNodeSelectorRenderer(vmi *v1.VirtualMachineInstance
 		opts = append(opts, WithTDXSelector())
 	}
 
+	if util.IsSGXVMI(vmi) {
+		log.Log.V(4).Info("Add SGX node label selector")
+		opts = append(opts, WithSGXSelector())
+	}
+
+	architecture := vmi.Spec.Architecture
+	if emulated {
+		architecture = ""
+	}
+
 	return NewNodeSelectorRenderer(
 		vmi.Spec.NodeSelector,
 		t.clusterConfig.GetNodeSelectors(),
-		vmi.Spec.Architecture,
+		architecture,
 		opts...,
 	)
 }
@@ -787,6 +868,9 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 	if requestedHookSidecar.PVC != nil {
 		mounts = append(mounts, pvcVolumeMount(*requestedHookSidecar.PVC))
 	}
+	if len(vmiSpec.Spec.Domain.Devices.Channels) != 0 {
+		mounts = append(mounts, mountPath(channelSocks, util.VirtChannelsDir))
+	}
 	sidecarOpts = append(sidecarOpts, WithVolumeMounts(mounts...))
 
 	if util.IsNonRootVMI(vmiSpec) {
@@ -882,6 +966,42 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 		volumeOpts = append(volumeOpts, withVirioFS())
 	}
 
+	if util.IsSharedMemoryVMI(vmi) {
+		volumeOpts = append(volumeOpts, withSharedMemory(vmi.Spec.Domain.Devices.SharedMemoryDevices))
+	}
+
+	if len(vmi.Spec.Domain.Devices.Channels) != 0 {
+		volumeOpts = append(volumeOpts, withChannels(vmi.Spec.Domain.Devices.Channels))
+	}
+
+	if util.IsTDXAttestationRequested(vmi) {
+		volumeOpts = append(volumeOpts, withTDXQuoteGenerationService())
+	}
+
+	if bundle := t.emulatorBundle(vmi); bundle != nil {
+		volumeOpts = append(volumeOpts, withEmulatorBundle(bundle))
+	}
+
+	if image := t.firmwareImage(vmi); image != nil {
+		volumeOpts = append(volumeOpts, withFirmwareImage(image))
+	}
+
+	if len(vmi.Spec.GuestSecrets) != 0 {
+		volumeOpts = append(volumeOpts, withGuestSecrets(vmi.Spec.GuestSecrets))
+	}
+
+	if tpm.HasEncryptedState(&vmi.Spec) {
+		volumeOpts = append(volumeOpts, withTPMEncryptionSecret(vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef))
+	}
+
+	if smartcard.HasHostCertificates(&vmi.Spec) {
+		volumeOpts = append(volumeOpts, withSmartcardDatabase(vmi.Spec.Domain.Devices.Smartcard.HostCertificates.SecretName))
+	}
+
+	if claimName, exists := vmi.Annotations[v1.MemoryStateClaimAnnotation]; exists {
+		volumeOpts = append(volumeOpts, withMemoryState(claimName))
+	}
+
 	volumeRenderer, err := NewVolumeRenderer(
 		t.clusterConfig,
 		imageVolumeFeatureGateEnabled,
@@ -903,7 +1023,7 @@ func (t *TemplateService) newResourceRenderer(vmi *v1.VirtualMachineInstance, ne
 	vmiResources := vmi.Spec.Domain.Resources
 	baseOptions := []ResourceRendererOption{
 		WithEphemeralStorageRequest(),
-		WithVirtualizationResources(getRequiredResources(vmi, t.clusterConfig.AllowEmulation())),
+		WithVirtualizationResources(getRequiredResources(vmi, t.allowEmulation(vmi))),
 	}
 
 	if err := validatePermittedHostDevices(&vmi.Spec, t.clusterConfig); err != nil {
@@ -991,7 +1111,7 @@ func (t *TemplateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 						SELinuxOptions: &k8sv1.SELinuxOptions{
 							// If SELinux is enabled on the host, this level will be adjusted below to match the level
 							// of its companion virt-launcher pod to allow it to consume our disk images.
-							Type:  t.clusterConfig.GetSELinuxLauncherType(),
+							Type:  t.launcherSELinuxType(t.launcherSecurityProfile(vmi)),
 							Level: "s0",
 						},
 					},
@@ -1039,6 +1159,10 @@ func (t *TemplateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 		}
 	}
 	for _, volume := range volumes {
+		if types.IsHotplugConfigVolume(volume) {
+			addHotplugConfigVolume(pod, volume)
+			continue
+		}
 		claimName := types.PVCNameFromVirtVolume(volume)
 		if claimName == "" {
 			continue
@@ -1077,6 +1201,57 @@ func (t *TemplateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 	return pod, nil
 }
 
+// addHotplugConfigVolume mounts a hotplugged ConfigMap, Secret or ServiceAccount
+// into the attachment pod, from where virt-handler mounts it into virt-launcher
+func addHotplugConfigVolume(pod *k8sv1.Pod, volume *v1.Volume) {
+	podVolume := k8sv1.Volume{Name: volume.Name}
+	switch {
+	case volume.ConfigMap != nil:
+		podVolume.ConfigMap = &k8sv1.ConfigMapVolumeSource{
+			LocalObjectReference: volume.ConfigMap.LocalObjectReference,
+			Optional:             volume.ConfigMap.Optional,
+		}
+	case volume.Secret != nil:
+		podVolume.Secret = &k8sv1.SecretVolumeSource{
+			SecretName: volume.Secret.SecretName,
+			Optional:   volume.Secret.Optional,
+		}
+	case volume.ServiceAccount != nil:
+		pod.Spec.ServiceAccountName = volume.ServiceAccount.ServiceAccountName
+		podVolume.Projected = &k8sv1.ProjectedVolumeSource{
+			Sources: []k8sv1.VolumeProjection{
+				{
+					ServiceAccountToken: &k8sv1.ServiceAccountTokenProjection{
+						Path: "token",
+					},
+				},
+				{
+					ConfigMap: &k8sv1.ConfigMapProjection{
+						LocalObjectReference: k8sv1.LocalObjectReference{Name: "kube-root-ca.crt"},
+						Items:                []k8sv1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+					},
+				},
+				{
+					DownwardAPI: &k8sv1.DownwardAPIProjection{
+						Items: []k8sv1.DownwardAPIVolumeFile{
+							{
+								Path:     "namespace",
+								FieldRef: &k8sv1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, podVolume)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, k8sv1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: fmt.Sprintf("/%s", volume.Name),
+		ReadOnly:  true,
+	})
+}
+
 func (t *TemplateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
@@ -1361,6 +1536,109 @@ func wrapGuestAgentPingWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv
 	return
 }
 
+func wrapVSockHTTPWithVirtProbe(vmi *v1.VirtualMachineInstance, action *v1.VSockHTTPAction, probe *k8sv1.Probe) {
+	path := action.Path
+	if path == "" {
+		path = "/"
+	}
+	vsockCommand := []string{
+		"virt-probe",
+		"--domainName", api.VMINamespaceKeyFunc(vmi),
+		"--timeoutSeconds", strconv.FormatInt(int64(probe.TimeoutSeconds), 10),
+		"--vsockPort", strconv.FormatUint(uint64(action.Port), 10),
+		"--vsockPath", path,
+	}
+	probe.ProbeHandler.Exec = &k8sv1.ExecAction{Command: vsockCommand}
+	// we add 1s to the pod probe to compensate for the additional steps in probing
+	probe.TimeoutSeconds += 1
+}
+
+// launcherSecurityProfile returns the first launcher security profile whose
+// selector matches the labels of the VMI, or nil if none matches.
+func (t *TemplateService) launcherSecurityProfile(vmi *v1.VirtualMachineInstance) *v1.LauncherSecurityProfile {
+	profiles := t.clusterConfig.GetConfig().LauncherSecurityProfiles
+	if len(profiles) == 0 {
+		return nil
+	}
+	// the labels of the VMI are controlled by its owner, the profiles are
+	// only applied within the namespaces selected by the admin
+	namespaceLabels, exists := t.namespaceLabels(vmi.Namespace)
+	if !exists {
+		return nil
+	}
+	for i := range profiles {
+		namespaceSelector, err := metav1.LabelSelectorAsSelector(&profiles[i].NamespaceSelector)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("ignoring launcher security profile %s with invalid namespace selector", profiles[i].Name)
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&profiles[i].Selector)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("ignoring launcher security profile %s with invalid selector", profiles[i].Name)
+			continue
+		}
+		if namespaceSelector.Empty() {
+			continue
+		}
+		if namespaceSelector.Matches(namespaceLabels) && selector.Matches(labels.Set(vmi.Labels)) {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
+
+func (t *TemplateService) namespaceLabels(namespace string) (labels.Set, bool) {
+	if t.namespaceStore == nil {
+		return nil, false
+	}
+	obj, exists, err := t.namespaceStore.GetByKey(namespace)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to retrieve namespace %s from informer", namespace)
+		return nil, false
+	} else if !exists {
+		return nil, false
+	}
+	ns, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		log.Log.Errorf("couldn't cast object to Namespace: %+v", obj)
+		return nil, false
+	}
+	return labels.Set(ns.Labels), true
+}
+
+func (t *TemplateService) launcherSeccompProfile(securityProfile *v1.LauncherSecurityProfile) *k8sv1.SeccompProfile {
+	var vmProfile *v1.VirtualMachineInstanceProfile
+	if seccompConf := t.clusterConfig.GetConfig().SeccompConfiguration; seccompConf != nil {
+		vmProfile = seccompConf.VirtualMachineInstanceProfile
+	}
+	if securityProfile != nil && securityProfile.Seccomp != nil {
+		vmProfile = securityProfile.Seccomp
+	}
+	if vmProfile == nil || vmProfile.CustomProfile == nil {
+		return nil
+	}
+
+	customProfile := vmProfile.CustomProfile
+	if customProfile.LocalhostProfile != nil {
+		return &k8sv1.SeccompProfile{
+			Type:             k8sv1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: customProfile.LocalhostProfile,
+		}
+	} else if customProfile.RuntimeDefaultProfile {
+		return &k8sv1.SeccompProfile{
+			Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+		}
+	}
+	return nil
+}
+
+func (t *TemplateService) launcherSELinuxType(securityProfile *v1.LauncherSecurityProfile) string {
+	if securityProfile != nil && securityProfile.SELinuxType != "" {
+		return securityProfile.SELinuxType
+	}
+	return t.clusterConfig.GetSELinuxLauncherType()
+}
+
 func alignPodMultiCategorySecurity(pod *k8sv1.Pod, selinuxType string, dockerSELinuxMCSWorkaround bool) {
 	if selinuxType == "" && !dockerSELinuxMCSWorkaround {
 		// No SELinux type and no docker workaround, nothing to do
@@ -1432,6 +1710,9 @@ func (t *TemplateService) generatePodAnnotations(vmi *v1.VirtualMachineInstance)
 		v1.DomainAnnotation: vmi.GetObjectMeta().GetName(),
 	}
 	maps.Copy(annotationsSet, filterVMIAnnotationsForPod(vmi.Annotations))
+	if vmi.Spec.LauncherMetadata != nil {
+		maps.Copy(annotationsSet, vmi.Spec.LauncherMetadata.Annotations)
+	}
 
 	annotationsSet[podcmd.DefaultContainerAnnotationName] = "compute"
 
@@ -1478,6 +1759,45 @@ func checkForKeepLauncherAfterFailure(vmi *v1.VirtualMachineInstance) bool {
 	return keepLauncherAfterFailure
 }
 
+func firmwareProfileName(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Spec.Domain.Firmware == nil {
+		return ""
+	}
+	return vmi.Spec.Domain.Firmware.Profile
+}
+
+// firmwareImage returns the firmware image the firmware profile of the VMI provides for its architecture, if any
+func (t *TemplateService) firmwareImage(vmi *v1.VirtualMachineInstance) *v1.FirmwareImage {
+	profile := firmwareProfileName(vmi)
+	if profile == "" {
+		return nil
+	}
+	return t.clusterConfig.GetFirmwareImage(profile, vmi.Spec.Architecture)
+}
+
+// emulatorBundle returns the configured emulator bundle referenced by the VMI, if any
+func (t *TemplateService) emulatorBundle(vmi *v1.VirtualMachineInstance) *v1.EmulatorBundle {
+	if vmi.Spec.Domain.EmulatorBundle == "" {
+		return nil
+	}
+	for i := range t.clusterConfig.GetConfig().EmulatorBundles {
+		if bundle := &t.clusterConfig.GetConfig().EmulatorBundles[i]; bundle.Name == vmi.Spec.Domain.EmulatorBundle {
+			return bundle
+		}
+	}
+	return nil
+}
+
+// allowEmulation reports whether the launcher may fall back to software
+// emulation, either cluster wide or because the VMI requested it. Isolated
+// launchers always need the /dev/kvm passed into their micro VM.
+func (t *TemplateService) allowEmulation(vmi *v1.VirtualMachineInstance) bool {
+	if util.IsIsolatedLauncherVMI(vmi) {
+		return false
+	}
+	return t.clusterConfig.AllowEmulation() || vmi.IsEmulationEnabled()
+}
+
 func (t *TemplateService) doesVMIRequireAutoCPULimits(vmi *v1.VirtualMachineInstance) bool {
 	if t.doesVMIRequireAutoResourceLimits(vmi, k8sv1.ResourceCPU) {
 		return true
@@ -1553,6 +1873,7 @@ func (t *TemplateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance,
 				return t.clusterConfig.HostDevicesWithDRAEnabled() && isHostDevVMIDRA(vmi)
 			}, WithHostDevicesDRA(vmi.Spec.Domain.Devices.HostDevices)),
 			NewVMIResourceRule(util.IsSEVVMI, WithSEV()),
+			NewVMIResourceRule(util.IsSGXVMI, WithSGX()),
 			NewVMIResourceRule(reservation.HasVMIPersistentReservation, WithPersistentReservation()),
 		},
 	}
@@ -1611,6 +1932,9 @@ func podLabels(vmi *v1.VirtualMachineInstance, hostName string) map[string]strin
 	for k, v := range vmi.Labels {
 		labels[k] = v
 	}
+	if vmi.Spec.LauncherMetadata != nil {
+		maps.Copy(labels, vmi.Spec.LauncherMetadata.Labels)
+	}
 	labels[v1.AppLabel] = "virt-launcher"
 	labels[v1.CreatedByLabel] = string(vmi.UID)
 	labels[v1.DeprecatedVirtualMachineNameLabel] = hostName
@@ -254,8 +254,78 @@ var _ = Describe("Template", func() {
 				Expect(containers[0].Resources.Limits.Name(kvmResource, resource.DecimalSI)).To(Equal(resource.NewQuantity(0, resource.DecimalSI)))
 				Expect(containers[0].Command).To(ContainElements(allowEmulationOption))
 			})
+
+			It("should not add the kvm resource nor a node selector when the VMI requests emulation", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := libvmi.New(libvmi.WithNamespace(testNamespace))
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+
+				containers := pod.Spec.Containers
+				Expect(containers[0].Name).To(Equal(computeContainerName))
+				Expect(containers[0].Resources.Limits.Name(kvmResource, resource.DecimalSI)).To(Equal(resource.NewQuantity(0, resource.DecimalSI)))
+				Expect(containers[0].Command).To(ContainElements(allowEmulationOption))
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.KVMUnavailableLabel))
+			})
+
+			It("should let emulated VMIs prefer nodes without KVM", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := libvmi.New(libvmi.WithNamespace(testNamespace))
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(ContainElement(
+					k8sv1.PreferredSchedulingTerm{
+						Weight: 100,
+						Preference: k8sv1.NodeSelectorTerm{
+							MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+								Key:      v1.KVMUnavailableLabel,
+								Operator: k8sv1.NodeSelectorOpIn,
+								Values:   []string{"true"},
+							}},
+						},
+					},
+				))
+			})
+
+			It("should pin emulated VMIs without an emulator bundle to nodes of the guest architecture", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := libvmi.New(libvmi.WithNamespace(testNamespace))
+				vmi.Spec.Architecture = "arm64"
+				vmi.Spec.Domain.Machine = &v1.Machine{Type: "virt"}
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(k8sv1.LabelArchStable, "arm64"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SupportedMachineTypeLabel+"virt", "true"))
+			})
 		})
 
+		DescribeTable("should pass the reattach flag to virt-launcher-monitor", func(gateEnabled bool) {
+			config, kvStore, svc = configFactory(defaultArch)
+			if gateEnabled {
+				enableFeatureGate(featuregate.LauncherReattachGate)
+			}
+
+			pod, err := svc.RenderLaunchManifest(libvmi.New(libvmi.WithNamespace("default")))
+			Expect(err).NotTo(HaveOccurred())
+
+			if gateEnabled {
+				Expect(pod.Spec.Containers[0].Command).To(ContainElement("--reattach-on-crash"))
+			} else {
+				Expect(pod.Spec.Containers[0].Command).ToNot(ContainElement("--reattach-on-crash"))
+			}
+		},
+			Entry("when the LauncherReattach feature gate is enabled", true),
+			Entry("not when the LauncherReattach feature gate is disabled", false),
+		)
+
 		It("should not set seccomp profile by default", func() {
 			_, kvStore, svc = configFactory(defaultArch)
 			pod, err := svc.RenderLaunchManifest(newMinimalWithContainerDisk("random"))
@@ -287,6 +357,182 @@ var _ = Describe("Template", func() {
 
 		})
 
+		Context("with launcher pod settings on the VMI", func() {
+			It("should set the runtime class, labels and annotations", func() {
+				_, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = "default"
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Spec.RuntimeClassName = pointer.P("kata")
+				vmi.Spec.LauncherMetadata = &v1.LauncherMetadata{
+					Labels:      map[string]string{"cost.example.com/center": "1234"},
+					Annotations: map[string]string{"io.katacontainers.config.hypervisor.default_memory": "4096"},
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal("kata")))
+				Expect(pod.Labels).To(HaveKeyWithValue("cost.example.com/center", "1234"))
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, "virt-launcher"))
+				Expect(pod.Annotations).To(HaveKeyWithValue("io.katacontainers.config.hypervisor.default_memory", "4096"))
+			})
+		})
+
+		Context("with the MicroVM launcher isolation", func() {
+			It("should use the isolated runtime class and require the kvm resource", func() {
+				_, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = "default"
+				kvConfig.Spec.Configuration.DeveloperConfiguration.UseEmulation = true
+				kvConfig.Spec.Configuration.LauncherPodConfiguration = &v1.LauncherPodConfiguration{
+					IsolatedRuntimeClass: "kata-qemu",
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Spec.LauncherIsolation = v1.LauncherIsolationMicroVM
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal("kata-qemu")))
+				Expect(*pod.Spec.Containers[0].Resources.Limits.Name("devices.kubevirt.io/kvm", resource.DecimalSI)).To(Equal(resource.MustParse("1")))
+				Expect(pod.Spec.Containers[0].Command).NotTo(ContainElement("--allow-emulation"))
+			})
+		})
+
+		Context("with a VFIO group configured", func() {
+			BeforeEach(func() {
+				_, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.VFIOGroupID = pointer.P(int64(1234))
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			newVFIOVMI := func(runtimeUser uint64) *v1.VirtualMachineInstance {
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Annotations = nil
+				vmi.Status.RuntimeUser = runtimeUser
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "hostdev", DeviceName: "vendor.com/device"}}
+				return vmi
+			}
+
+			It("should add the VFIO group to non-root launcher pods using VFIO devices", func() {
+				pod, err := svc.RenderLaunchManifest(newVFIOVMI(util.NonRootUID))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SupplementalGroups).To(Equal([]int64{1234}))
+			})
+
+			It("should not add the VFIO group to root launcher pods", func() {
+				pod, err := svc.RenderLaunchManifest(newVFIOVMI(util.RootUser))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SupplementalGroups).To(BeEmpty())
+			})
+
+			It("should not add the VFIO group to launcher pods without VFIO devices", func() {
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Status.RuntimeUser = util.NonRootUID
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SupplementalGroups).To(BeEmpty())
+			})
+		})
+
+		Context("with launcher security profiles", func() {
+			const profiledNamespace = "profiled"
+
+			newVMIInProfiledNamespace := func() *v1.VirtualMachineInstance {
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Namespace = profiledNamespace
+				return vmi
+			}
+
+			BeforeEach(func() {
+				namespace := &k8sv1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   profiledNamespace,
+						Labels: map[string]string{"security-profiles": "true"},
+					},
+				}
+				Expect(namespaceStore.Add(namespace)).To(Succeed())
+				DeferCleanup(namespaceStore.Delete, namespace)
+
+				_, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.SELinuxLauncherType = "spc_t"
+				kvConfig.Spec.Configuration.SeccompConfiguration = &v1.SeccompConfiguration{
+					VirtualMachineInstanceProfile: &v1.VirtualMachineInstanceProfile{
+						CustomProfile: &v1.CustomProfile{RuntimeDefaultProfile: true},
+					},
+				}
+				kvConfig.Spec.Configuration.LauncherSecurityProfiles = []v1.LauncherSecurityProfile{
+					{
+						Name:              "confidential",
+						NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"security-profiles": "true"}},
+						Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"confidential": "true"}},
+						SELinuxType:       "virt_launcher_strict.process",
+						Seccomp: &v1.VirtualMachineInstanceProfile{
+							CustomProfile: &v1.CustomProfile{LocalhostProfile: pointer.P("kubevirt/confidential.json")},
+						},
+					},
+					{
+						Name:              "catch-all",
+						NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"security-profiles": "true"}},
+						Selector:          metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "confidential", Operator: metav1.LabelSelectorOpExists}}},
+						SELinuxType:       "container_t",
+					},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			It("should apply the first matching profile", func() {
+				vmi := newVMIInProfiledNamespace()
+				vmi.Labels = map[string]string{"confidential": "true"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&k8sv1.SeccompProfile{
+					Type:             k8sv1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: pointer.P("kubevirt/confidential.json"),
+				}))
+				Expect(pod.Spec.SecurityContext.SELinuxOptions.Type).To(Equal("virt_launcher_strict.process"))
+			})
+
+			It("should fall back to the cluster wide seccomp profile", func() {
+				vmi := newVMIInProfiledNamespace()
+				vmi.Labels = map[string]string{"confidential": "false"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&k8sv1.SeccompProfile{
+					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+				}))
+				Expect(pod.Spec.SecurityContext.SELinuxOptions.Type).To(Equal("container_t"))
+			})
+
+			It("should use the cluster wide settings if no profile matches", func() {
+				pod, err := svc.RenderLaunchManifest(newVMIInProfiledNamespace())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&k8sv1.SeccompProfile{
+					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+				}))
+				Expect(pod.Spec.SecurityContext.SELinuxOptions.Type).To(Equal("spc_t"))
+			})
+
+			It("should not apply profiles outside of the selected namespaces", func() {
+				vmi := newMinimalWithContainerDisk("random")
+				vmi.Labels = map[string]string{"confidential": "true"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.SecurityContext.SeccompProfile).To(Equal(&k8sv1.SeccompProfile{
+					Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+				}))
+				Expect(pod.Spec.SecurityContext.SELinuxOptions.Type).To(Equal("spc_t"))
+			})
+		})
+
 		Context("with NonRoot feature-gate", func() {
 			var vmi *v1.VirtualMachineInstance
 			BeforeEach(func() {
@@ -1235,6 +1481,39 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.RealtimeReadyLabel))
+			})
+
+			It("should add realtime ready node label selector with realtime readiness validation", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				enableFeatureGate(featuregate.RealtimeReadinessValidationGate)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						CPU: &v1.CPU{Realtime: &v1.Realtime{}},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.RealtimeReadyLabel, "true"))
+			})
+
+			It("should add CPU core type node label selector with a core type", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						CPU: &v1.CPU{DedicatedCPUPlacement: true, CoreType: v1.CPUCoreTypePerformance},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUCoreTypeLabel+"performance", "true"))
 			})
 
 			It("should not add realtime node label selector when no realtime workload", func() {
@@ -1252,6 +1531,29 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.RealtimeLabel))))
 			})
 
+			DescribeTable("should handle the nested virtualization node label selector", func(nestedVirtualization *bool, expectLabel bool) {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{
+						CPU: &v1.CPU{NestedVirtualization: nestedVirtualization},
+					}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				if expectLabel {
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.NestedVirtualizationLabel, "true"))
+				} else {
+					Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.NestedVirtualizationLabel))
+				}
+			},
+				Entry("adding it when nested virtualization is enabled", pointer.P(true), true),
+				Entry("not adding it when nested virtualization is disabled", pointer.P(false), false),
+				Entry("not adding it when nested virtualization is unspecified", nil, false),
+			)
+
 			Context("When scheduling SEV workloads", func() {
 				var vmi *v1.VirtualMachineInstance
 
@@ -1377,6 +1679,46 @@ var _ = Describe("Template", func() {
 					Expect(err).ToNot(HaveOccurred())
 					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.TDXLabel))))
 				})
+
+				It("should mount the quote generation service only when TDX attestation is requested", func() {
+					vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{TDX: &v1.TDX{}}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "tdx-qgs")))
+
+					vmi.Spec.Domain.LaunchSecurity.TDX.Attestation = &v1.TDXAttestation{}
+
+					pod, err = svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.Volumes).To(ContainElement(HaveField("Name", "tdx-qgs")))
+					Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{Name: "tdx-qgs", MountPath: "/var/run/tdx-qgs"}))
+				})
+			})
+
+			Context("When scheduling SGX workloads", func() {
+				var vmi *v1.VirtualMachineInstance
+
+				BeforeEach(func() {
+					config, kvStore, svc = configFactory(defaultArch)
+					vmi = api.NewMinimalVMI("testvmi")
+				})
+
+				It("should add SGX node label selector and request the SGX device with SGX workload", func() {
+					vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SGXLabel, "true"))
+					Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKeyWithValue(k8sv1.ResourceName(SgxDevice), *resource.NewQuantity(1, resource.DecimalSI)))
+				})
+
+				It("should not add SGX node label selector nor request the SGX device when no SGX workload", func() {
+					pod, err := svc.RenderLaunchManifest(vmi)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(pod.Spec.NodeSelector).To(Not(HaveKey(v1.SGXLabel)))
+					Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(k8sv1.ResourceName(SgxDevice)))
+				})
 			})
 
 			It("should not add node selector for hyperv nodes if VMI does not request hyperv features", func() {
@@ -4275,6 +4617,140 @@ var _ = Describe("Template", func() {
 			)
 		})
 
+		Context("with an emulator bundle", func() {
+			const bundleImage = "registry.example.com/qemu@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+			BeforeEach(func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.ImageVolume}
+				kvConfig.Spec.Configuration.EmulatorBundles = []v1.EmulatorBundle{{
+					Name:            "vendor",
+					Image:           bundleImage,
+					ImagePullPolicy: k8sv1.PullIfNotPresent,
+					Emulator:        "usr/bin/qemu-kvm",
+					Firmware:        "usr/share/OVMF",
+				}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			It("should not pin emulated VMIs to nodes of the guest architecture", func() {
+				vmi := libvmi.New(libvmi.WithNamespace("default"))
+				vmi.Spec.Domain.EmulatorBundle = "vendor"
+				vmi.Spec.Architecture = "arm64"
+				vmi.Spec.Domain.Machine = &v1.Machine{Type: "virt"}
+				vmi.Spec.Domain.UseEmulation = pointer.P(true)
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(k8sv1.LabelArchStable))
+				Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.SupportedMachineTypeLabel + "virt"))
+			})
+
+			It("should mount the bundle and point the launcher to its emulator and firmware", func() {
+				vmi := libvmi.New(libvmi.WithNamespace("default"))
+				vmi.Spec.Domain.EmulatorBundle = "vendor"
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "emulator-bundle",
+					VolumeSource: k8sv1.VolumeSource{
+						Image: &k8sv1.ImageVolumeSource{
+							Reference:  bundleImage,
+							PullPolicy: k8sv1.PullIfNotPresent,
+						},
+					},
+				}))
+				compute := pod.Spec.Containers[0]
+				Expect(compute.VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "emulator-bundle",
+					MountPath: util.VirtEmulatorBundleDir,
+					ReadOnly:  true,
+				}))
+				Expect(compute.Env).To(ContainElement(k8sv1.EnvVar{
+					Name:  util.EnvVarEmulatorPath,
+					Value: filepath.Join(util.VirtEmulatorBundleDir, "usr/bin/qemu-kvm"),
+				}))
+				Expect(compute.Command).To(ContainElements("--ovmf-path", filepath.Join(util.VirtEmulatorBundleDir, "usr/share/OVMF")))
+			})
+
+			It("should fail if the bundle is not configured", func() {
+				vmi := libvmi.New(libvmi.WithNamespace("default"))
+				vmi.Spec.Domain.EmulatorBundle = "unknown"
+
+				_, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).To(MatchError("emulator bundle unknown is not configured"))
+			})
+		})
+
+		Context("with a firmware profile", func() {
+			const firmwareImage = "registry.example.com/ovmf@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+			BeforeEach(func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.ImageVolume}
+				kvConfig.Spec.Configuration.FirmwareProfiles = []v1.FirmwareProfile{{
+					Name: "sev",
+					Type: v1.FirmwareTypeUEFI,
+					Images: []v1.FirmwareImage{{
+						Architecture:    "amd64",
+						Image:           firmwareImage,
+						ImagePullPolicy: k8sv1.PullIfNotPresent,
+						Path:            "usr/share/OVMF",
+					}},
+				}}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+			})
+
+			newVMI := func(architecture string) *v1.VirtualMachineInstance {
+				vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithArchitecture(architecture))
+				vmi.Spec.Domain.Firmware = &v1.Firmware{Profile: "sev"}
+				return vmi
+			}
+
+			It("should mount the firmware image of the architecture and point the launcher to it", func() {
+				pod, err := svc.RenderLaunchManifest(newVMI("amd64"))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+					Name: "firmware-image",
+					VolumeSource: k8sv1.VolumeSource{
+						Image: &k8sv1.ImageVolumeSource{
+							Reference:  firmwareImage,
+							PullPolicy: k8sv1.PullIfNotPresent,
+						},
+					},
+				}))
+				compute := pod.Spec.Containers[0]
+				Expect(compute.VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "firmware-image",
+					MountPath: util.VirtFirmwareImageDir,
+					ReadOnly:  true,
+				}))
+				Expect(compute.Command).To(ContainElements("--ovmf-path", filepath.Join(util.VirtFirmwareImageDir, "usr/share/OVMF")))
+			})
+
+			It("should use the firmware of virt-launcher for architectures without an image", func() {
+				pod, err := svc.RenderLaunchManifest(newVMI("arm64"))
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "firmware-image")))
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements("--ovmf-path", config.GetOVMFPath("arm64")))
+			})
+
+			It("should fail if the profile is not configured", func() {
+				vmi := newVMI("amd64")
+				vmi.Spec.Domain.Firmware.Profile = "unknown"
+
+				_, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).To(MatchError("firmware profile unknown is not configured"))
+			})
+		})
+
 		Context("Using defaultRuntimeClass", func() {
 			It("Should set a runtimeClassName on launcher pod, if configured", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -4581,6 +5057,46 @@ var _ = Describe("Template", func() {
 			}))
 		})
 
+		It("should mount hotplugged config volumes in the attachment pod", func() {
+			vmi := api.NewMinimalVMI("fake-vmi")
+			ownerPod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			vmi.Status.SelinuxContext = "test_u:test_r:test_t:s0"
+
+			volumes := []*v1.Volume{
+				{
+					Name: "configmap-volume",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "test-configmap"},
+							Hotpluggable:         true,
+						},
+					},
+				},
+				{
+					Name: "serviceaccount-volume",
+					VolumeSource: v1.VolumeSource{
+						ServiceAccount: &v1.ServiceAccountVolumeSource{
+							ServiceAccountName: "test-sa",
+							Hotpluggable:       true,
+						},
+					},
+				},
+			}
+			pod, err := svc.RenderHotplugAttachmentPodTemplate(volumes, ownerPod, vmi, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.ServiceAccountName).To(Equal("test-sa"))
+			Expect(pod.Spec.Volumes).To(ContainElements(
+				HaveField("ConfigMap.LocalObjectReference.Name", "test-configmap"),
+				HaveField("Projected.Sources", ContainElement(HaveField("ServiceAccountToken.Path", "token"))),
+			))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElements(
+				k8sv1.VolumeMount{Name: "configmap-volume", MountPath: "/configmap-volume", ReadOnly: true},
+				k8sv1.VolumeMount{Name: "serviceaccount-volume", MountPath: "/serviceaccount-volume", ReadOnly: true},
+			))
+		})
+
 		DescribeTable("should compute the correct security context when rendering hotplug attachment trigger pods", func(isBlock bool) {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			ownerPod, err := svc.RenderLaunchManifest(vmi)
@@ -4938,6 +5454,26 @@ var _ = Describe("Template", func() {
 				})
 			})
 		})
+		Context("with channels and a sidecar", func() {
+			It("should share the channel sockets directory with the sidecar", func() {
+				vmi := api.NewMinimalVMI("channels-sidecar-test")
+				vmi.Annotations = map[string]string{
+					hooks.HookSidecarListAnnotationName: `[{"image": "test:test"}]`,
+				}
+				vmi.Spec.Domain.Devices.Channels = []v1.Channel{{Name: "agent", Target: "org.example.agent.0"}}
+
+				config, kvStore, svc = configFactory(defaultArch)
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				channelsMount := k8sv1.VolumeMount{
+					Name:      "channel-sockets",
+					MountPath: "/var/run/kubevirt-channels",
+				}
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(channelsMount))
+				Expect(pod.Spec.Containers[1].VolumeMounts).To(ContainElement(channelsMount))
+			})
+		})
 		Context("with backend-storage", func() {
 			const (
 				vmiName       = "testvmi"
@@ -16,9 +16,9 @@ import (
 )
 
 func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, config *virtconfig.ClusterConfig) []k8sv1.Container {
-	passthroughFSVolumes := make(map[string]struct{})
+	passthroughFSVolumes := make(map[string]*v1.FilesystemVirtiofs)
 	for i := range vmi.Spec.Domain.Devices.Filesystems {
-		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = struct{}{}
+		passthroughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = vmi.Spec.Domain.Devices.Filesystems[i].Virtiofs
 	}
 	if len(passthroughFSVolumes) == 0 {
 		return nil
@@ -26,9 +26,9 @@ func generateVirtioFSContainers(vmi *v1.VirtualMachineInstance, image string, co
 
 	containers := []k8sv1.Container{}
 	for _, volume := range vmi.Spec.Volumes {
-		if _, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
+		if fs, isPassthroughFSVolume := passthroughFSVolumes[volume.Name]; isPassthroughFSVolume {
 			resources := resourcesForVirtioFSContainer(vmi.IsCPUDedicated(), vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
-			container := generateContainerFromVolume(&volume, image, resources)
+			container := generateContainerFromVolume(&volume, fs, image, resources)
 			containers = append(containers, container)
 
 		}
@@ -91,11 +91,14 @@ func virtioFSMountPoint(volume *v1.Volume) string {
 	return volumeMountPoint
 }
 
-func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
+func generateContainerFromVolume(volume *v1.Volume, fs *v1.FilesystemVirtiofs, image string, resources k8sv1.ResourceRequirements) k8sv1.Container {
 
 	socketPathArg := fmt.Sprintf("--socket-path=%s", virtiofs.VirtioFSSocketPath(volume.Name))
 	sourceArg := fmt.Sprintf("--shared-dir=%s", virtioFSMountPoint(volume))
 
+	// virtiofsd runs without privileges, so it can neither create namespaces nor chroot.
+	// The container is its sandbox, hence the sandbox of virtiofsd is disabled and not
+	// configurable on the VMI.
 	args := []string{socketPathArg, sourceArg, "--sandbox=none", "--cache=auto"}
 
 	// If some files cannot be migrated, let's allow the migration to finish.
@@ -108,6 +111,8 @@ func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv
 	// This migration mode doesn't require any privileges.
 	args = append(args, "--migration-mode=find-paths")
 
+	args = append(args, virtiofsdOptionArgs(fs)...)
+
 	volumeMounts := []k8sv1.VolumeMount{
 		// This is required to pass socket to compute
 		{
@@ -144,3 +149,25 @@ func generateContainerFromVolume(volume *v1.Volume, image string, resources k8sv
 		},
 	}
 }
+
+// virtiofsdOptionArgs translates the options of the filesystem into arguments
+// of virtiofsd. The IDs are translated by virtiofsd itself, as it runs
+// without privileges in a container and cannot set up a user namespace.
+func virtiofsdOptionArgs(fs *v1.FilesystemVirtiofs) []string {
+	var args []string
+	if fs == nil {
+		return args
+	}
+	if fs.ThreadPoolSize != nil {
+		args = append(args, fmt.Sprintf("--thread-pool-size=%d", *fs.ThreadPoolSize))
+	}
+	if fs.IDMap != nil {
+		for _, r := range fs.IDMap.UID {
+			args = append(args, fmt.Sprintf("--translate-uid=map:%d:%d:%d", r.Start, r.Target, r.Count))
+		}
+		for _, r := range fs.IDMap.GID {
+			args = append(args, fmt.Sprintf("--translate-gid=map:%d:%d:%d", r.Start, r.Target, r.Count))
+		}
+	}
+	return args
+}
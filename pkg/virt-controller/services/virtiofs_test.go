@@ -8,6 +8,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
@@ -92,4 +93,33 @@ var _ = Describe("virtiofs container", func() {
 		Expect(container[1].SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
 		Expect(container[1].SecurityContext.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
 	})
+
+	It("should pass the thread pool size and the ID mapping to virtiofsd", func() {
+		vmi := api.NewMinimalVMI("testvm")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "sharedtestdisk",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: testutils.NewFakePersistentVolumeSource(),
+			},
+		})
+		vmi.Spec.Domain.Devices.Filesystems = append(vmi.Spec.Domain.Devices.Filesystems, v1.Filesystem{
+			Name: "sharedtestdisk",
+			Virtiofs: &v1.FilesystemVirtiofs{
+				ThreadPoolSize: pointer.P(uint32(16)),
+				IDMap: &v1.FilesystemIDMap{
+					UID: []v1.FilesystemIDMapRange{{Start: 0, Target: 107, Count: 1}},
+					GID: []v1.FilesystemIDMapRange{{Start: 0, Target: 107, Count: 1}, {Start: 1000, Target: 2000, Count: 10}},
+				},
+			},
+		})
+
+		containers := generateVirtioFSContainers(vmi, "virtiofs-container", config)
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Args).To(ContainElements(
+			"--thread-pool-size=16",
+			"--translate-uid=map:0:107:1",
+			"--translate-gid=map:0:107:1",
+			"--translate-gid=map:1000:2000:10",
+		))
+	})
 })
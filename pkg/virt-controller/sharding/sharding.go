@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+)
+
+// Shard identifies the disjoint set of namespaces a virt-controller replica
+// owns when the controllers run sharded. A zero Count disables sharding and
+// every namespace is owned.
+type Shard struct {
+	Index int
+	Count int
+}
+
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+func (s Shard) Validate() error {
+	if s.Count < 0 {
+		return fmt.Errorf("shard count must not be negative, got %d", s.Count)
+	}
+	if s.Enabled() && (s.Index < 0 || s.Index >= s.Count) {
+		return fmt.Errorf("shard index %d is out of range for %d shards", s.Index, s.Count)
+	}
+	return nil
+}
+
+// LeaseName returns the name of the lease the replicas of this shard compete
+// for. Replicas of different shards are therefore active at the same time.
+func (s Shard) LeaseName(base string) string {
+	if !s.Enabled() {
+		return base
+	}
+	return fmt.Sprintf("%s-shard-%d", base, s.Index)
+}
+
+// IsPrimary reports whether this shard runs the controllers which are not
+// partitioned by namespace.
+func (s Shard) IsPrimary() bool {
+	return !s.Enabled() || s.Index == 0
+}
+
+func (s Shard) OwnsNamespace(namespace string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32()%uint32(s.Count)) == s.Index
+}
+
+// OwnsKey reports whether the namespace of a namespace/name queue key belongs
+// to the shard. Malformed keys are kept so that the controller reports them.
+func (s Shard) OwnsKey(key string) bool {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return true
+	}
+	return s.OwnsNamespace(namespace)
+}
+
+func (s Shard) filter(items []string) []string {
+	owned := items[:0:0]
+	for _, item := range items {
+		if s.OwnsKey(item) {
+			owned = append(owned, item)
+		}
+	}
+	return owned
+}
+
+type rateLimitingQueue struct {
+	workqueue.TypedRateLimitingInterface[string]
+	shard Shard
+}
+
+// NewRateLimitingQueue drops every key which is not owned by the shard before
+// it reaches the wrapped queue.
+func NewRateLimitingQueue(queue workqueue.TypedRateLimitingInterface[string], shard Shard) workqueue.TypedRateLimitingInterface[string] {
+	if !shard.Enabled() {
+		return queue
+	}
+	return &rateLimitingQueue{TypedRateLimitingInterface: queue, shard: shard}
+}
+
+func (q *rateLimitingQueue) Add(key string) {
+	if q.shard.OwnsKey(key) {
+		q.TypedRateLimitingInterface.Add(key)
+	}
+}
+
+func (q *rateLimitingQueue) AddRateLimited(key string) {
+	if q.shard.OwnsKey(key) {
+		q.TypedRateLimitingInterface.AddRateLimited(key)
+	}
+}
+
+func (q *rateLimitingQueue) AddAfter(key string, duration time.Duration) {
+	if q.shard.OwnsKey(key) {
+		q.TypedRateLimitingInterface.AddAfter(key, duration)
+	}
+}
+
+type priorityQueue struct {
+	priorityqueue.PriorityQueue[string]
+	shard Shard
+}
+
+// NewPriorityQueue is the priority queue counterpart of NewRateLimitingQueue.
+func NewPriorityQueue(queue priorityqueue.PriorityQueue[string], shard Shard) priorityqueue.PriorityQueue[string] {
+	if !shard.Enabled() {
+		return queue
+	}
+	return &priorityQueue{PriorityQueue: queue, shard: shard}
+}
+
+func (q *priorityQueue) Add(key string) {
+	if q.shard.OwnsKey(key) {
+		q.PriorityQueue.Add(key)
+	}
+}
+
+func (q *priorityQueue) AddRateLimited(key string) {
+	if q.shard.OwnsKey(key) {
+		q.PriorityQueue.AddRateLimited(key)
+	}
+}
+
+func (q *priorityQueue) AddAfter(key string, duration time.Duration) {
+	if q.shard.OwnsKey(key) {
+		q.PriorityQueue.AddAfter(key, duration)
+	}
+}
+
+func (q *priorityQueue) AddWithOpts(o priorityqueue.AddOpts, keys ...string) {
+	if owned := q.shard.filter(keys); len(owned) > 0 {
+		q.PriorityQueue.AddWithOpts(o, owned...)
+	}
+}
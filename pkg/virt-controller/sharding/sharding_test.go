@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package sharding_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-controller/sharding"
+)
+
+var _ = Describe("Sharding", func() {
+	const shardCount = 4
+
+	namespaces := func() []string {
+		var namespaces []string
+		for i := range 200 {
+			namespaces = append(namespaces, fmt.Sprintf("ns-%d", i))
+		}
+		return namespaces
+	}
+
+	It("should assign every namespace to exactly one shard", func() {
+		owners := map[int]int{}
+		for _, namespace := range namespaces() {
+			var owned []int
+			for i := range shardCount {
+				if (sharding.Shard{Index: i, Count: shardCount}).OwnsNamespace(namespace) {
+					owned = append(owned, i)
+				}
+			}
+			Expect(owned).To(HaveLen(1), namespace)
+			owners[owned[0]]++
+		}
+		Expect(owners).To(HaveLen(shardCount))
+	})
+
+	It("should own everything when sharding is disabled", func() {
+		shard := sharding.Shard{}
+		Expect(shard.Enabled()).To(BeFalse())
+		Expect(shard.IsPrimary()).To(BeTrue())
+		Expect(shard.LeaseName("virt-controller")).To(Equal("virt-controller"))
+		for _, namespace := range namespaces() {
+			Expect(shard.OwnsNamespace(namespace)).To(BeTrue())
+		}
+	})
+
+	It("should use a lease per shard", func() {
+		Expect(sharding.Shard{Index: 2, Count: shardCount}.LeaseName("virt-controller")).To(Equal("virt-controller-shard-2"))
+		Expect(sharding.Shard{Index: 0, Count: shardCount}.IsPrimary()).To(BeTrue())
+		Expect(sharding.Shard{Index: 1, Count: shardCount}.IsPrimary()).To(BeFalse())
+	})
+
+	DescribeTable("should validate", func(shard sharding.Shard, valid bool) {
+		if valid {
+			Expect(shard.Validate()).To(Succeed())
+		} else {
+			Expect(shard.Validate()).ToNot(Succeed())
+		}
+	},
+		Entry("disabled sharding", sharding.Shard{}, true),
+		Entry("a single shard", sharding.Shard{Count: 1}, true),
+		Entry("an index in range", sharding.Shard{Index: 3, Count: 4}, true),
+		Entry("an index out of range", sharding.Shard{Index: 4, Count: 4}, false),
+		Entry("a negative index", sharding.Shard{Index: -1, Count: 4}, false),
+		Entry("a negative count", sharding.Shard{Count: -1}, false),
+	)
+
+	Context("queues", func() {
+		var shard sharding.Shard
+		var owned, foreign string
+
+		BeforeEach(func() {
+			shard = sharding.Shard{Index: 1, Count: shardCount}
+			for _, namespace := range namespaces() {
+				key := namespace + "/vm"
+				if shard.OwnsKey(key) && owned == "" {
+					owned = key
+				} else if !shard.OwnsKey(key) && foreign == "" {
+					foreign = key
+				}
+			}
+			Expect(owned).ToNot(BeEmpty())
+			Expect(foreign).ToNot(BeEmpty())
+		})
+
+		It("should drop keys of other shards from a rate limiting queue", func() {
+			queue := sharding.NewRateLimitingQueue(workqueue.NewTypedRateLimitingQueue[string](
+				workqueue.DefaultTypedControllerRateLimiter[string]()), shard)
+			defer queue.ShutDown()
+
+			queue.Add(foreign)
+			queue.AddRateLimited(foreign)
+			queue.AddAfter(foreign, 0)
+			queue.Add(owned)
+			Expect(queue.Len()).To(Equal(1))
+			key, _ := queue.Get()
+			Expect(key).To(Equal(owned))
+		})
+
+		It("should drop keys of other shards from a priority queue", func() {
+			queue := sharding.NewPriorityQueue(priorityqueue.New[string]("sharding-test"), shard)
+			defer queue.ShutDown()
+
+			queue.AddWithOpts(priorityqueue.AddOpts{Priority: pointer.P(1)}, foreign, owned)
+			queue.Add(foreign)
+			Eventually(queue.Len).Should(Equal(1))
+			key, priority, _ := queue.GetWithPriority()
+			Expect(key).To(Equal(owned))
+			Expect(priority).To(Equal(1))
+		})
+
+		It("should return the queue itself when sharding is disabled", func() {
+			queue := workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
+			defer queue.ShutDown()
+			Expect(sharding.NewRateLimitingQueue(queue, sharding.Shard{})).To(BeIdenticalTo(queue))
+		})
+	})
+})
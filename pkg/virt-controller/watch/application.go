@@ -38,12 +38,15 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/maintenance"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmoperation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/warmpool"
 
 	"github.com/emicklei/go-restful/v3"
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -79,7 +82,10 @@ import (
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
 
+	"kubevirt.io/kubevirt/pkg/accounting"
+	"kubevirt.io/kubevirt/pkg/audit"
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
+	"kubevirt.io/kubevirt/pkg/checkup"
 	"kubevirt.io/kubevirt/pkg/controller"
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
 
@@ -87,19 +93,23 @@ import (
 	clientmetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/common/client"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/backuphook"
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/diskgc"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/sharding"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	netcontrollers "kubevirt.io/kubevirt/pkg/network/controllers"
+	"kubevirt.io/kubevirt/pkg/network/dnsregistration"
 	netmigration "kubevirt.io/kubevirt/pkg/network/migration"
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netannotations "kubevirt.io/kubevirt/pkg/network/pod/annotations"
@@ -113,6 +123,7 @@ const (
 
 	launcherImage       = "virt-launcher"
 	exporterImage       = "virt-exportserver"
+	checkupImage        = "virt-checkup"
 	launcherQemuTimeout = 240
 
 	migrationControllerRestTimeout = 30 * time.Second
@@ -123,10 +134,12 @@ const (
 
 	ephemeralDiskDir = virtShareDir + "-ephemeral-disks"
 
-	defaultControllerThreads         = 3
-	defaultSnapshotControllerThreads = 6
-	defaultBackupControllerThreads   = 6
-	defaultVMIControllerThreads      = 10
+	defaultControllerThreads           = 3
+	defaultSnapshotControllerThreads   = 6
+	defaultBackupControllerThreads     = 6
+	defaultBackupHookControllerThreads = 3
+	defaultDiskGCControllerThreads     = 1
+	defaultVMIControllerThreads        = 10
 
 	defaultLauncherSubGid                 = 107
 	defaultSnapshotControllerResyncPeriod = 5 * time.Minute
@@ -181,8 +194,12 @@ type VirtControllerApp struct {
 	poolController *pool.Controller
 	poolInformer   cache.SharedIndexInformer
 
-	vmController *vm.Controller
-	vmInformer   cache.SharedIndexInformer
+	vmController          *vm.Controller
+	vmInformer            cache.SharedIndexInformer
+	vmPolicyInformer      cache.SharedIndexInformer
+	priorityClassInformer cache.SharedIndexInformer
+
+	vmDisruptionBudgetInformer cache.SharedIndexInformer
 
 	controllerRevisionInformer cache.SharedIndexInformer
 
@@ -223,7 +240,27 @@ type VirtControllerApp struct {
 
 	vmBackupInformer        cache.SharedIndexInformer
 	vmBackupTrackerInformer cache.SharedIndexInformer
+	vmBackupHookInformer    cache.SharedIndexInformer
 	vmBackupController      *backup.VMBackupController
+	vmBackupHookController  *backuphook.Controller
+	diskGCController        *diskgc.Controller
+
+	vmCheckupInformer   cache.SharedIndexInformer
+	vmCheckupController *checkup.Controller
+
+	nodeMaintenanceInformer   cache.SharedIndexInformer
+	nodeMaintenanceController *maintenance.Controller
+
+	vmOperationInformer   cache.SharedIndexInformer
+	vmOperationController *vmoperation.Controller
+
+	usageAccountant *accounting.Accountant
+
+	auditEventCleaner *audit.Cleaner
+
+	dnsRegistrationController *dnsregistration.Controller
+
+	warmPoolController *warmpool.Controller
 
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
@@ -231,9 +268,11 @@ type VirtControllerApp struct {
 	clusterPreferenceInformer   cache.SharedIndexInformer
 
 	LeaderElection leaderelectionconfig.Configuration
+	shard          sharding.Shard
 
 	launcherImage              string
 	exporterImage              string
+	checkupImage               string
 	launcherQemuTimeout        int
 	imagePullSecret            string
 	virtShareDir               string
@@ -274,6 +313,13 @@ type VirtControllerApp struct {
 	additionalLauncherAnnotationsSync []string
 	additionalLauncherLabelsSync      []string
 	backupControllerThreads           int
+	backupHookControllerThreads       int
+	diskGCControllerThreads           int
+	checkupControllerThreads          int
+	nodeMaintenanceThreads            int
+	vmOperationThreads                int
+	dnsRegistrationThreads            int
+	warmPoolThreads                   int
 
 	promCertFilePath         string
 	promKeyFilePath          string
@@ -308,6 +354,10 @@ func Execute() {
 
 	log.InitializeLogging("virt-controller")
 
+	if err := app.shard.Validate(); err != nil {
+		golog.Fatal(err)
+	}
+
 	app.reloadableRateLimiter = ratelimiter.NewReloadableRateLimiter(flowcontrol.NewTokenBucketRateLimiter(virtconfig.DefaultVirtControllerQPS, virtconfig.DefaultVirtControllerBurst))
 	clientmetrics.RegisterRestConfigHooks()
 	clientConfig, err := kubecli.GetKubevirtClientConfig()
@@ -339,6 +389,10 @@ func Execute() {
 	app.ctx = ctx
 
 	app.informerFactory = controller.NewKubeInformerFactory(app.restClient, app.clientSet, nil, app.kubevirtNamespace)
+	// Only the primary shard runs controllers which need the objects of all namespaces
+	if !app.shard.IsPrimary() {
+		app.informerFactory.SetNamespaceFilter(app.shard.OwnsNamespace)
+	}
 
 	app.crdInformer = app.informerFactory.CRD()
 	app.kubeVirtInformer = app.informerFactory.KubeVirt()
@@ -402,6 +456,9 @@ func Execute() {
 	app.pdbInformer = app.informerFactory.K8SInformerFactory().Policy().V1().PodDisruptionBudgets().Informer()
 
 	app.vmInformer = app.informerFactory.VirtualMachine()
+	app.vmPolicyInformer = app.informerFactory.VirtualMachinePolicy()
+	app.priorityClassInformer = app.informerFactory.PriorityClass()
+	app.vmDisruptionBudgetInformer = app.informerFactory.VirtualMachineDisruptionBudget()
 
 	app.migrationInformer = app.informerFactory.VirtualMachineInstanceMigration()
 
@@ -409,6 +466,10 @@ func Execute() {
 
 	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
 	app.vmBackupTrackerInformer = app.informerFactory.VirtualMachineBackupTracker()
+	app.vmBackupHookInformer = app.informerFactory.VirtualMachineBackupHook()
+	app.vmCheckupInformer = app.informerFactory.VirtualMachineCheckup()
+	app.nodeMaintenanceInformer = app.informerFactory.NodeMaintenance()
+	app.vmOperationInformer = app.informerFactory.VirtualMachineOperation()
 	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
@@ -477,6 +538,9 @@ func Execute() {
 		Preference:            app.preferenceInformer.GetStore(),
 		ClusterPreference:     app.clusterPreferenceInformer.GetStore(),
 		ControllerRevision:    app.controllerRevisionInformer.GetStore(),
+		VMSnapshot:            app.vmSnapshotInformer.GetStore(),
+		VMExport:              app.vmExportInformer.GetStore(),
+		VMPool:                app.poolInformer.GetStore(),
 	}
 
 	if err := metrics.SetupMetrics(
@@ -500,6 +564,16 @@ func Execute() {
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
 	app.initBackupController()
+	app.initBackupHookController()
+	app.initDiskGCController()
+	app.initCheckupController()
+	app.initNodeMaintenanceController()
+	app.initVMOperationController()
+	app.initUsageAccountant()
+	app.initAuditEventCleaner()
+	app.initDNSRegistrationController()
+	app.initWarmPoolController()
+	app.initSharding()
 	go app.Run()
 
 	<-app.reInitChan
@@ -613,44 +687,82 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			golog.Fatalf("failed to add vmi phase transition time handler: %v", err)
 		}
 
-		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
-		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
-		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
-		if vca.isDRAEnabled {
-			go vca.draStatusController.Run(vca.draStatusControllerThreads, stop)
-		}
-		go vca.rsController.Run(vca.rsControllerThreads, stop)
-		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
-		go func() {
-			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
-				log.Log.Warningf("error running the snapshot controller: %v", err)
-			}
-		}()
-		go func() {
-			if err := vca.restoreController.Run(vca.restoreControllerThreads, stop); err != nil {
-				log.Log.Warningf("error running the restore controller: %v", err)
-			}
-		}()
-		go func() {
-			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
-				log.Log.Warningf("error running the export controller: %v", err)
-			}
-		}()
-		go vca.workloadUpdateController.Run(stop)
-		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
-		go func() {
-			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
-				log.Log.Warningf("error running the clone controller: %v", err)
-			}
-		}()
-		go func() {
-			if err := vca.vmBackupController.Run(vca.backupControllerThreads, stop); err != nil {
-				log.Log.Warningf("error running the backup controller: %v", err)
+
+		// Only the VMI, VM and migration controllers are partitioned by
+		// namespace, everything else keeps running in a single replica.
+		if vca.shard.IsPrimary() {
+			go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
+			go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
+			go vca.nodeController.Run(vca.nodeControllerThreads, stop)
+			if vca.isDRAEnabled {
+				go vca.draStatusController.Run(vca.draStatusControllerThreads, stop)
 			}
-		}()
+			go vca.rsController.Run(vca.rsControllerThreads, stop)
+			go vca.poolController.Run(vca.poolControllerThreads, stop)
+			go func() {
+				if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the snapshot controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.restoreController.Run(vca.restoreControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the restore controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the export controller: %v", err)
+				}
+			}()
+			go vca.workloadUpdateController.Run(stop)
+			go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
+			go func() {
+				if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the clone controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.vmBackupController.Run(vca.backupControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the backup controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.vmBackupHookController.Run(vca.backupHookControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the backup hook controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.diskGCController.Run(vca.diskGCControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the disk garbage collection controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.vmCheckupController.Run(vca.checkupControllerThreads, stop); err != nil {
+					log.Log.Warningf("error running the checkup controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.nodeMaintenanceController.Run(vca.nodeMaintenanceThreads, stop); err != nil {
+					log.Log.Warningf("error running the node maintenance controller: %v", err)
+				}
+			}()
+			go func() {
+				if err := vca.vmOperationController.Run(vca.vmOperationThreads, stop); err != nil {
+					log.Log.Warningf("error running the VM operation controller: %v", err)
+				}
+			}()
+			go vca.usageAccountant.Run(stop)
+			go vca.auditEventCleaner.Run(stop)
+			go func() {
+				if err := vca.dnsRegistrationController.Run(vca.dnsRegistrationThreads, stop); err != nil {
+					log.Log.Warningf("error running the DNS registration controller: %v", err)
+				}
+			}()
+			go vca.warmPoolController.Run(vca.warmPoolThreads, stop)
+		}
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -816,13 +928,15 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.namespaceInformer,
 		vca.persistentVolumeClaimInformer,
 		vca.controllerRevisionInformer,
+		vca.vmPolicyInformer,
+		vca.priorityClassInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
 		netcontrollers.NewVMController(
 			vca.clientSet.GeneratedKubeVirtClient(),
 		),
-		vm.NewFirmwareController(vca.clientSet.GeneratedKubeVirtClient()),
+		vm.NewFirmwareController(vca.clientSet.GeneratedKubeVirtClient(), vca.clusterConfig),
 		instancetypecontroller.New(
 			vca.instancetypeInformer.GetStore(),
 			vca.clusterInstancetypeInformer.GetStore(),
@@ -841,6 +955,19 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 	}
 }
 
+// initSharding restricts the namespaced controllers to the keys of the
+// namespaces owned by this replica. The event handlers read the queue from
+// the controller, so it has to be swapped before the informers start.
+func (vca *VirtControllerApp) initSharding() {
+	if !vca.shard.Enabled() {
+		return
+	}
+	log.Log.Infof("Owning namespace shard %d of %d", vca.shard.Index, vca.shard.Count)
+	vca.vmiController.Queue = sharding.NewRateLimitingQueue(vca.vmiController.Queue, vca.shard)
+	vca.vmController.Queue = sharding.NewRateLimitingQueue(vca.vmController.Queue, vca.shard)
+	vca.migrationController.Queue = sharding.NewPriorityQueue(vca.migrationController.Queue, vca.shard)
+}
+
 func (vca *VirtControllerApp) initDisruptionBudgetController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "disruptionbudget-controller")
@@ -866,6 +993,7 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.vmDisruptionBudgetInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -882,6 +1010,7 @@ func (vca *VirtControllerApp) initEvacuationController() {
 		vca.migrationInformer,
 		vca.nodeInformer,
 		vca.kvPodInformer,
+		vca.vmDisruptionBudgetInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -992,6 +1121,99 @@ func (vca *VirtControllerApp) initBackupController() {
 	}
 }
 
+func (vca *VirtControllerApp) initBackupHookController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-hook-controller")
+	vca.vmBackupHookController, err = backuphook.NewController(
+		vca.clientSet, vca.vmBackupHookInformer, vca.vmInformer, vca.vmiInformer, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initDiskGCController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "disk-gc-controller")
+	vca.diskGCController, err = diskgc.NewController(
+		vca.clientSet,
+		vca.clusterConfig,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.allPodInformer,
+		vca.dataVolumeInformer,
+		vca.persistentVolumeClaimInformer,
+		vca.vmSnapshotContentInformer,
+		vca.dataSourceInformer,
+		vca.vmExportInformer,
+		vca.vmBackupInformer,
+		recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initCheckupController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "checkup-controller")
+	vca.vmCheckupController, err = checkup.NewController(
+		vca.clientSet, vca.clusterConfig, vca.vmCheckupInformer, vca.kvPodInformer, recorder, vca.checkupImage,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initNodeMaintenanceController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-maintenance-controller")
+	vca.nodeMaintenanceController, err = maintenance.NewController(
+		vca.clientSet, vca.clusterConfig, vca.nodeMaintenanceInformer, vca.nodeInformer, vca.vmiInformer, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initVMOperationController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "vm-operation-controller")
+	vca.vmOperationController, err = vmoperation.NewController(
+		vca.clientSet, vca.clusterConfig, vca.vmOperationInformer, vca.vmInformer, vca.vmiInformer, vca.migrationInformer,
+		vca.vmDisruptionBudgetInformer, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initUsageAccountant() {
+	vca.usageAccountant = accounting.NewAccountant(vca.clientSet, vca.clusterConfig, vca.vmiInformer, vca.kvPodInformer)
+}
+
+func (vca *VirtControllerApp) initAuditEventCleaner() {
+	vca.auditEventCleaner = audit.NewCleaner(vca.clientSet, vca.clusterConfig)
+}
+
+func (vca *VirtControllerApp) initDNSRegistrationController() {
+	var err error
+	vca.dnsRegistrationController, err = dnsregistration.NewController(vca.clientSet, vca.clusterConfig, vca.vmiInformer)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initWarmPoolController() {
+	var err error
+	vca.warmPoolController, err = warmpool.NewController(
+		vca.templateService, vca.kvPodInformer, vca.clusterInstancetypeInformer, vca.clientSet, vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -1028,6 +1250,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.StringVar(&vca.exporterImage, "exporter-image", exporterImage,
 		"Container for exporting VMs and VM images")
 
+	flag.StringVar(&vca.checkupImage, "checkup-image", checkupImage,
+		"Container running VirtualMachineCheckups")
+
 	flag.IntVar(&vca.launcherQemuTimeout, "launcher-qemu-timeout", launcherQemuTimeout,
 		"Amount of time to wait for qemu")
 
@@ -1109,6 +1334,33 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultBackupControllerThreads,
 		"Number of goroutines to run for backup controller")
+
+	flag.IntVar(&vca.backupHookControllerThreads, "backup-hook-controller-threads", defaultBackupHookControllerThreads,
+		"Number of goroutines to run for backup hook controller")
+
+	flag.IntVar(&vca.diskGCControllerThreads, "disk-gc-controller-threads", defaultDiskGCControllerThreads,
+		"Number of goroutines to run for disk garbage collection controller")
+
+	flag.IntVar(&vca.checkupControllerThreads, "checkup-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for checkup controller")
+
+	flag.IntVar(&vca.nodeMaintenanceThreads, "node-maintenance-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for node maintenance controller")
+
+	flag.IntVar(&vca.vmOperationThreads, "vm-operation-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for VM operation controller")
+
+	flag.IntVar(&vca.dnsRegistrationThreads, "dns-registration-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for DNS registration controller")
+
+	flag.IntVar(&vca.warmPoolThreads, "warm-pool-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for launcher warm pool controller")
+
+	flag.IntVar(&vca.shard.Count, "shard-count", 0,
+		"Number of namespace shards the VMI, VM and migration controllers are split into. Each shard elects its own leader, so the replicas of different shards are active at the same time. 0 disables sharding")
+
+	flag.IntVar(&vca.shard.Index, "shard-index", 0,
+		"Index of the namespace shard owned by this replica. Shard 0 additionally runs all controllers which are not sharded")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
@@ -1129,12 +1381,12 @@ func (vca *VirtControllerApp) setupLeaderElector() (err error) {
 
 	rl, err := resourcelock.New(vca.LeaderElection.ResourceLock,
 		vca.kubevirtNamespace,
-		leaderelectionconfig.DefaultLeaseName,
+		vca.shard.LeaseName(leaderelectionconfig.DefaultLeaseName),
 		clientSet.CoreV1(),
 		clientSet.CoordinationV1(),
 		resourcelock.ResourceLockConfig{
 			Identity:      vca.host,
-			EventRecorder: vca.newRecorder(k8sv1.NamespaceAll, leaderelectionconfig.DefaultLeaseName),
+			EventRecorder: vca.newRecorder(k8sv1.NamespaceAll, vca.shard.LeaseName(leaderelectionconfig.DefaultLeaseName)),
 		})
 
 	if err != nil {
@@ -22,6 +22,7 @@ package watch
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -35,41 +36,57 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	checkupv1 "kubevirt.io/api/checkup/v1alpha1"
 	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	operationsv1alpha1 "kubevirt.io/api/operations/v1alpha1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
 
+	"kubevirt.io/kubevirt/pkg/accounting"
+	"kubevirt.io/kubevirt/pkg/checkup"
 	"kubevirt.io/kubevirt/pkg/controller"
 	instancetypecontroller "kubevirt.io/kubevirt/pkg/instancetype/controller/vm"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/network/dnsregistration"
 	"kubevirt.io/kubevirt/pkg/rest"
+	"kubevirt.io/kubevirt/pkg/storage/backuphook"
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/diskgc"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/sharding"
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/maintenance"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmoperation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/warmpool"
 )
 
 func newValidGetRequest() *http.Request {
@@ -108,6 +125,9 @@ var _ = Describe("Application", func() {
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		crInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		vmPolicyInformer, _ := testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachinePolicy{})
+		priorityClassInformer, _ := testutils.NewFakeInformerFor(&schedulingv1.PriorityClass{})
+		vmDisruptionBudgetInformer, _ := testutils.NewFakeInformerFor(&policyv1alpha1.VirtualMachineDisruptionBudget{})
 		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		dataSourceInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataSource{})
 		storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
@@ -125,6 +145,10 @@ var _ = Describe("Application", func() {
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
 		backupInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		backupTrackerInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupTracker{})
+		backupHookInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupHook{})
+		checkupInformer, _ := testutils.NewFakeInformerFor(&checkupv1.VirtualMachineCheckup{})
+		nodeMaintenanceInformer, _ := testutils.NewFakeInformerFor(&maintenancev1alpha1.NodeMaintenance{})
+		vmOperationInformer, _ := testutils.NewFakeInformerFor(&operationsv1alpha1.VirtualMachineOperation{})
 		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
 		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
@@ -137,7 +161,7 @@ var _ = Describe("Application", func() {
 		app.vmiInformer = vmiInformer
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
-		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmDisruptionBudgetInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient)
 		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, recorder)
 		app.vmiController, _ = vmi.NewController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -173,6 +197,8 @@ var _ = Describe("Application", func() {
 			namespaceInformer,
 			pvcInformer,
 			crInformer,
+			vmPolicyInformer,
+			priorityClassInformer,
 			recorder,
 			virtClient,
 			config,
@@ -275,6 +301,62 @@ var _ = Describe("Application", func() {
 			pvcInformer,
 			recorder,
 		)
+		app.diskGCController, _ = diskgc.NewController(
+			virtClient,
+			config,
+			vmInformer,
+			vmiInformer,
+			podInformer,
+			dataVolumeInformer,
+			pvcInformer,
+			vmSnapshotContentInformer,
+			dataSourceInformer,
+			vmExportInformer,
+			backupInformer,
+			recorder,
+		)
+		app.vmBackupHookController, _ = backuphook.NewController(
+			virtClient,
+			backupHookInformer,
+			vmInformer,
+			vmiInformer,
+			recorder,
+		)
+		app.vmCheckupController, _ = checkup.NewController(
+			virtClient,
+			config,
+			checkupInformer,
+			podInformer,
+			recorder,
+			checkupImage,
+		)
+		app.nodeMaintenanceController, _ = maintenance.NewController(
+			virtClient,
+			config,
+			nodeMaintenanceInformer,
+			nodeInformer,
+			vmiInformer,
+			recorder,
+		)
+		app.vmOperationController, _ = vmoperation.NewController(
+			virtClient,
+			config,
+			vmOperationInformer,
+			vmInformer,
+			vmiInformer,
+			migrationInformer,
+			vmDisruptionBudgetInformer,
+			recorder,
+		)
+		app.usageAccountant = accounting.NewAccountant(virtClient, config, vmiInformer, podInformer)
+		app.dnsRegistrationController, _ = dnsregistration.NewController(virtClient, config, vmiInformer)
+		app.warmPoolController, _ = warmpool.NewController(
+			services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
+			podInformer,
+			clusterInstancetypeInformer,
+			virtClient,
+			config,
+		)
 
 		app.readyChan = make(chan bool)
 
@@ -339,6 +421,52 @@ var _ = Describe("Application", func() {
 		)
 	})
 
+	Describe("Sharding", func() {
+		newApp := func(shard sharding.Shard) *VirtControllerApp {
+			return &VirtControllerApp{
+				shard: shard,
+				vmiController: &vmi.Controller{
+					Queue: workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+				},
+				vmController: &vm.Controller{
+					Queue: workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]()),
+				},
+				migrationController: &migration.Controller{
+					Queue: priorityqueue.New[string]("sharding-test"),
+				},
+			}
+		}
+
+		It("should only enqueue keys of owned namespaces", func() {
+			shard := sharding.Shard{Index: 1, Count: 2}
+			app := newApp(shard)
+			app.initSharding()
+
+			for i := range 20 {
+				key := fmt.Sprintf("ns-%d/vm", i)
+				app.vmiController.Queue.Add(key)
+				app.vmController.Queue.Add(key)
+				app.migrationController.Queue.Add(key)
+			}
+			for _, queue := range []interface{ Len() int }{app.vmiController.Queue, app.vmController.Queue, app.migrationController.Queue} {
+				Eventually(queue.Len).Should(BeNumerically(">", 0))
+				Expect(queue.Len()).To(BeNumerically("<", 20))
+			}
+			for app.vmController.Queue.Len() > 0 {
+				key, _ := app.vmController.Queue.Get()
+				Expect(shard.OwnsKey(key)).To(BeTrue())
+				app.vmController.Queue.Done(key)
+			}
+		})
+
+		It("should keep the queues when sharding is disabled", func() {
+			app := newApp(sharding.Shard{})
+			queue := app.vmController.Queue
+			app.initSharding()
+			Expect(app.vmController.Queue).To(BeIdenticalTo(queue))
+		})
+	})
+
 	Describe("Readiness probe", func() {
 		var recorder *httptest.ResponseRecorder
 		var request *http.Request
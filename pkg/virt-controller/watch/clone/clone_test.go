@@ -925,6 +925,21 @@ var _ = Describe("Clone", func() {
 				sanityExecute()
 				expectSMbiosSerial(manuallySetSerial)
 			})
+
+			It("should keep smbios serial if the firmware identity policy is Preserve", func() {
+				vmClone.Spec.FirmwareIdentityPolicy = pointer.P(clone.FirmwareIdentityPolicyPreserve)
+				addClone(vmClone)
+				sanityExecute()
+				expectSMbiosSerial(originalSerial)
+			})
+
+			It("should prefer the serial in clone spec over the Preserve firmware identity policy", func() {
+				vmClone.Spec.FirmwareIdentityPolicy = pointer.P(clone.FirmwareIdentityPolicyPreserve)
+				vmClone.Spec.NewSMBiosSerial = pointer.P(manuallySetSerial)
+				addClone(vmClone)
+				sanityExecute()
+				expectSMbiosSerial(manuallySetSerial)
+			})
 		})
 
 		Context("Labels and annotations", func() {
@@ -1121,6 +1136,14 @@ var _ = Describe("Clone", func() {
 				sanityExecute()
 				expectVMCreationFromPatches(expectedVM)
 			})
+
+			It("should keep firmware UUID if the firmware identity policy is Preserve", func() {
+				vmClone.Spec.FirmwareIdentityPolicy = pointer.P(clone.FirmwareIdentityPolicyPreserve)
+				addClone(vmClone)
+
+				sanityExecute()
+				expectVMCreationFromPatches(sourceVM.DeepCopy())
+			})
 		})
 
 		Context("Target VM name", func() {
@@ -36,12 +36,16 @@ import (
 func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clone.VirtualMachineCloneSpec) ([]string, error) {
 	patchSet := patch.New()
 	addMacAddressPatches(patchSet, source.Spec.Template.Spec.Domain.Devices.Interfaces, cloneSpec.NewMacAddresses)
-	addSmbiosSerialPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware, cloneSpec.NewSMBiosSerial)
+	preserveFirmwareIdentity := cloneSpec.FirmwareIdentityPolicy != nil &&
+		*cloneSpec.FirmwareIdentityPolicy == clone.FirmwareIdentityPolicyPreserve
+	addSmbiosSerialPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware, cloneSpec.NewSMBiosSerial, preserveFirmwareIdentity)
 	addRemovePatchesFromFilter(patchSet, source.Labels, cloneSpec.LabelFilters, "/metadata/labels")
 	addAnnotationPatches(patchSet, source.Annotations, cloneSpec.AnnotationFilters)
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Labels, cloneSpec.Template.LabelFilters, "/spec/template/metadata/labels")
 	addRemovePatchesFromFilter(patchSet, source.Spec.Template.ObjectMeta.Annotations, cloneSpec.Template.AnnotationFilters, "/spec/template/metadata/annotations")
-	addFirmwareUUIDPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware)
+	if !preserveFirmwareIdentity {
+		addFirmwareUUIDPatches(patchSet, source.Spec.Template.Spec.Domain.Firmware)
+	}
 
 	patches, err := generateStringPatchOperations(patchSet)
 	if err != nil {
@@ -77,8 +81,8 @@ func addMacAddressPatches(patchSet *patch.PatchSet, interfaces []k6tv1.Interface
 	}
 }
 
-func addSmbiosSerialPatches(patchSet *patch.PatchSet, firmware *k6tv1.Firmware, newSMBiosSerial *string) {
-	if firmware == nil {
+func addSmbiosSerialPatches(patchSet *patch.PatchSet, firmware *k6tv1.Firmware, newSMBiosSerial *string, preserve bool) {
+	if firmware == nil || (preserve && newSMBiosSerial == nil) {
 		return
 	}
 
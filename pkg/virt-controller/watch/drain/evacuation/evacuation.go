@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -22,6 +23,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
 )
 
 const (
@@ -30,6 +32,12 @@ const (
 	objectNotMigrationFmt = "tombstone contained object that is not a migration %#v"
 )
 
+// maintenanceTaint is put on nodes targeted by a NodeMaintenance
+var maintenanceTaint = &k8sv1.Taint{
+	Key:    maintenancev1alpha1.NodeMaintenanceTaintKey,
+	Effect: k8sv1.TaintEffectNoSchedule,
+}
+
 const (
 	// FailedCreateVirtualMachineInstanceMigrationReason is added in an event if creating a VirtualMachineInstanceMigration failed.
 	FailedCreateVirtualMachineInstanceMigrationReason = "FailedCreate"
@@ -38,16 +46,17 @@ const (
 )
 
 type EvacuationController struct {
-	clientset             kubecli.KubevirtClient
-	Queue                 workqueue.TypedRateLimitingInterface[string]
-	vmiIndexer            cache.Indexer
-	vmiPodIndexer         cache.Indexer
-	migrationIndexer      cache.Indexer
-	recorder              record.EventRecorder
-	migrationExpectations *controller.UIDTrackingControllerExpectations
-	nodeStore             cache.Store
-	clusterConfig         *virtconfig.ClusterConfig
-	hasSynced             func() bool
+	clientset                 kubecli.KubevirtClient
+	Queue                     workqueue.TypedRateLimitingInterface[string]
+	vmiIndexer                cache.Indexer
+	vmiPodIndexer             cache.Indexer
+	migrationIndexer          cache.Indexer
+	recorder                  record.EventRecorder
+	migrationExpectations     *controller.UIDTrackingControllerExpectations
+	nodeStore                 cache.Store
+	vmDisruptionBudgetIndexer cache.Indexer
+	clusterConfig             *virtconfig.ClusterConfig
+	hasSynced                 func() bool
 }
 
 func NewEvacuationController(
@@ -55,6 +64,7 @@ func NewEvacuationController(
 	migrationInformer cache.SharedIndexInformer,
 	nodeInformer cache.SharedIndexInformer,
 	vmiPodInformer cache.SharedIndexInformer,
+	vmDisruptionBudgetInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -65,18 +75,19 @@ func NewEvacuationController(
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-evacuation"},
 		),
-		vmiIndexer:            vmiInformer.GetIndexer(),
-		migrationIndexer:      migrationInformer.GetIndexer(),
-		nodeStore:             nodeInformer.GetStore(),
-		vmiPodIndexer:         vmiPodInformer.GetIndexer(),
-		recorder:              recorder,
-		clientset:             clientset,
-		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
-		clusterConfig:         clusterConfig,
+		vmiIndexer:                vmiInformer.GetIndexer(),
+		migrationIndexer:          migrationInformer.GetIndexer(),
+		nodeStore:                 nodeInformer.GetStore(),
+		vmiPodIndexer:             vmiPodInformer.GetIndexer(),
+		vmDisruptionBudgetIndexer: vmDisruptionBudgetInformer.GetIndexer(),
+		recorder:                  recorder,
+		clientset:                 clientset,
+		migrationExpectations:     controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		clusterConfig:             clusterConfig,
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced()
+		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced() && vmDisruptionBudgetInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -376,6 +387,16 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		return nil
 	}
 
+	allowedCandidates, err := c.filterAllowedByDisruptionBudgets(migrationCandidates, activeMigrations)
+	if err != nil {
+		return err
+	}
+	if len(allowedCandidates) < len(migrationCandidates) {
+		// Budgets are released when migrations finish, which may happen on other nodes
+		c.Queue.AddAfter(node.Name, 5*time.Second)
+	}
+	migrationCandidates = allowedCandidates
+
 	selectedCandidates := migrationCandidates
 	if !c.clusterConfig.MigrationPriorityQueueEnabled() {
 		runningMigrations := migrationutils.FilterRunningMigrations(activeMigrations)
@@ -451,13 +472,27 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	return nil
 }
 
+// filterAllowedByDisruptionBudgets drops the candidates whose migration would
+// exceed a VirtualMachineDisruptionBudget. VMIs which are migrating or shutting
+// down count as disrupted.
+func (c *EvacuationController) filterAllowedByDisruptionBudgets(candidates []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration) ([]*virtv1.VirtualMachineInstance, error) {
+	lookup := map[string]bool{}
+	for _, migration := range activeMigrations {
+		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = true
+	}
+	budgets := vmpolicy.NewDisruptionBudgets(c.vmDisruptionBudgetIndexer, c.vmiIndexer, func(vmi *virtv1.VirtualMachineInstance) bool {
+		return (vmi.DeletionTimestamp != nil && !vmi.IsFinal()) || migrationutils.IsMigrating(vmi) || lookup[vmi.Namespace+"/"+vmi.Name]
+	})
+	return budgets.Filter(candidates)
+}
+
 func hasMigratedOnEviction(vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi.Status.NodeName != vmi.Status.EvacuationNodeName
 }
 
 func vmisToMigrate(node *k8sv1.Node, vmisOnNode []*virtv1.VirtualMachineInstance, taint *k8sv1.Taint) []*virtv1.VirtualMachineInstance {
 	var vmisToMigrate []*virtv1.VirtualMachineInstance
-	if nodeHasTaint(taint, node) {
+	if nodeHasTaint(taint, node) || nodeHasTaint(maintenanceTaint, node) {
 		vmisToMigrate = vmisOnNode
 	} else if evictedVMIs := getMarkedForEvictionVMIs(vmisOnNode); len(evictedVMIs) > 0 {
 		vmisToMigrate = evictedVMIs
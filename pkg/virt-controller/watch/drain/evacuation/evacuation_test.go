@@ -19,6 +19,8 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
@@ -66,6 +68,9 @@ var _ = Describe("Evacuation", func() {
 		migrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, virtcontroller.GetVirtualMachineInstanceMigrationInformerIndexers())
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		vmDisruptionBudgetInformer, _ := testutils.NewFakeInformerWithIndexersFor(&policyv1alpha1.VirtualMachineDisruptionBudget{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
@@ -76,7 +81,7 @@ var _ = Describe("Evacuation", func() {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
 		}
 
-		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmDisruptionBudgetInformer, recorder, virtClient, config)
 		mockQueue := testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
 
@@ -169,6 +174,25 @@ var _ = Describe("Evacuation", func() {
 			expectMigrationCreation()
 		})
 
+		It("should evict the VMI from a node in maintenance", func() {
+			node := newNode("testnode")
+			addNode(newNode("anothernode"))
+			node.Spec.Taints = append(node.Spec.Taints, k8sv1.Taint{
+				Key:    maintenancev1alpha1.NodeMaintenanceTaintKey,
+				Effect: k8sv1.TaintEffectNoSchedule,
+			})
+			addNode(node)
+			enqueue(node)
+
+			vmi := newVirtualMachine("testvm", node.Name)
+			vmi.Spec.EvictionStrategy = newEvictionStrategyLiveMigrate()
+			controller.vmiIndexer.Add(vmi)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+
 		It("should ignore VMIs which are not migratable", func() {
 			node := newNode("testnode")
 			addNode(newNode("anothernode"))
@@ -511,6 +535,57 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("with VirtualMachineDisruptionBudgets", func() {
+
+		It("should not exceed the budget with new migrations", func() {
+			node := newNode("testnode")
+			addNode(node)
+			enqueue(node)
+			controller.vmDisruptionBudgetIndexer.Add(newDisruptionBudget("db", map[string]string{"app": "db"}, 1))
+
+			for i := 1; i <= 2; i++ {
+				vmi := newVirtualMachineMarkedForEviction(fmt.Sprintf("testvmi%d", i), node.Name)
+				vmi.Labels = map[string]string{"app": "db"}
+				controller.vmiIndexer.Add(vmi)
+			}
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+
+		It("should not migrate a VMI if the budget is exhausted by a running migration", func() {
+			node := newNode("testnode")
+			addNode(node)
+			enqueue(node)
+			controller.vmDisruptionBudgetIndexer.Add(newDisruptionBudget("db", map[string]string{"app": "db"}, 1))
+
+			migrating := newVirtualMachine("migrating", "othernode")
+			migrating.Labels = map[string]string{"app": "db"}
+			controller.vmiIndexer.Add(migrating)
+			controller.migrationIndexer.Add(newMigration("mig", migrating.Name, v1.MigrationRunning))
+
+			vmi := newVirtualMachineMarkedForEviction("testvmi", node.Name)
+			vmi.Labels = map[string]string{"app": "db"}
+			controller.vmiIndexer.Add(vmi)
+
+			sanityExecute()
+		})
+
+		It("should migrate VMIs which are not selected by the budget", func() {
+			node := newNode("testnode")
+			addNode(node)
+			enqueue(node)
+			controller.vmDisruptionBudgetIndexer.Add(newDisruptionBudget("db", map[string]string{"app": "db"}, 0))
+
+			controller.vmiIndexer.Add(newVirtualMachineMarkedForEviction("testvmi", node.Name))
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+		})
+	})
+
 	AfterEach(func() {
 		// Ensure that we add checks for expected events to every test
 		Expect(recorder.Events).To(BeEmpty())
@@ -602,3 +677,16 @@ func newTaint() *k8sv1.Taint {
 		Key:    "kubevirt.io/drain",
 	}
 }
+
+func newDisruptionBudget(name string, matchLabels map[string]string, maxDisruptions int32) *policyv1alpha1.VirtualMachineDisruptionBudget {
+	return &policyv1alpha1.VirtualMachineDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: k8sv1.NamespaceDefault,
+		},
+		Spec: policyv1alpha1.VirtualMachineDisruptionBudgetSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: matchLabels},
+			MaxDisruptions: maxDisruptions,
+		},
+	}
+}
@@ -0,0 +1,414 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	nodeIndex = "node"
+
+	// NodeReadyForRebootReason is added in an event when no VirtualMachineInstances and no domains are left on the node
+	NodeReadyForRebootReason = "NodeReadyForReboot"
+)
+
+// Controller puts the nodes targeted by NodeMaintenances into maintenance. It
+// taints the nodes to keep new VirtualMachineInstances off them, the evacuation
+// controller migrates the running VirtualMachineInstances away because of the
+// taint. Once neither VirtualMachineInstances nor domains are left on a node,
+// its NodeMaintenances report that it is ready for reboot. The controller is
+// keyed by node name, several NodeMaintenances can target the same node.
+type Controller struct {
+	clientset          kubecli.KubevirtClient
+	clusterConfig      *virtconfig.ClusterConfig
+	maintenanceIndexer cache.Indexer
+	nodeStore          cache.Store
+	vmiIndexer         cache.Indexer
+	recorder           record.EventRecorder
+	queue              workqueue.TypedRateLimitingInterface[string]
+	hasSynced          func() bool
+	now                func() time.Time
+}
+
+func NewController(clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	maintenanceInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*Controller, error) {
+	c := &Controller{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-nodemaintenance"},
+		),
+		clientset:          clientset,
+		clusterConfig:      clusterConfig,
+		maintenanceIndexer: maintenanceInformer.GetIndexer(),
+		nodeStore:          nodeInformer.GetStore(),
+		vmiIndexer:         vmiInformer.GetIndexer(),
+		recorder:           recorder,
+		now:                time.Now,
+	}
+
+	c.hasSynced = func() bool {
+		return maintenanceInformer.HasSynced() && nodeInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := maintenanceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleMaintenance,
+		UpdateFunc: func(_, newObj interface{}) { c.handleMaintenance(newObj) },
+		DeleteFunc: c.handleMaintenance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleNode,
+		UpdateFunc: func(_, newObj interface{}) { c.handleNode(newObj) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.handleVMI,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.handleVMI(oldObj)
+			c.handleVMI(newObj)
+		},
+		DeleteFunc: c.handleVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleMaintenance(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	nm, ok := obj.(*maintenancev1alpha1.NodeMaintenance)
+	if !ok || nm.Spec.NodeName == "" {
+		return
+	}
+	c.queue.Add(nm.Spec.NodeName)
+}
+
+func (c *Controller) handleNode(obj interface{}) {
+	node, ok := obj.(*k8sv1.Node)
+	if !ok {
+		return
+	}
+	// Nodes which were in maintenance need to be cleaned up
+	_, annotated := node.Annotations[maintenancev1alpha1.NodeMaintenanceDomainsAnnotation]
+	if annotated || hasMaintenanceTaint(node) || c.isTargeted(node.Name) {
+		c.queue.Add(node.Name)
+	}
+}
+
+func (c *Controller) handleVMI(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok || vmi.Status.NodeName == "" {
+		return
+	}
+	if c.isTargeted(vmi.Status.NodeName) {
+		c.queue.Add(vmi.Status.NodeName)
+	}
+}
+
+func (c *Controller) isTargeted(nodeName string) bool {
+	objs, err := c.maintenanceIndexer.ByIndex(nodeIndex, nodeName)
+	return err == nil && len(objs) > 0
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Log.Info("Starting node maintenance controller.")
+	defer log.Log.Info("Shutting down node maintenance controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing node %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed node %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(nodeName string) error {
+	if !c.clusterConfig.NodeMaintenanceEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.nodeStore.GetByKey(nodeName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	node := obj.(*k8sv1.Node)
+
+	maintenances, err := c.listMaintenances(nodeName)
+	if err != nil {
+		return err
+	}
+	if len(maintenances) == 0 {
+		return c.endMaintenance(node)
+	}
+
+	if err := c.startMaintenance(node); err != nil {
+		return err
+	}
+
+	status, err := c.nodeStatus(node)
+	if err != nil {
+		return err
+	}
+	for _, nm := range maintenances {
+		if err := c.updateStatus(nm, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Controller) listMaintenances(nodeName string) ([]*maintenancev1alpha1.NodeMaintenance, error) {
+	objs, err := c.maintenanceIndexer.ByIndex(nodeIndex, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	var maintenances []*maintenancev1alpha1.NodeMaintenance
+	for _, obj := range objs {
+		nm := obj.(*maintenancev1alpha1.NodeMaintenance)
+		if nm.DeletionTimestamp == nil {
+			maintenances = append(maintenances, nm)
+		}
+	}
+	return maintenances, nil
+}
+
+// startMaintenance taints the node, which keeps new VirtualMachineInstances
+// off the node and makes the evacuation controller migrate the running ones.
+func (c *Controller) startMaintenance(node *k8sv1.Node) error {
+	if hasMaintenanceTaint(node) {
+		return nil
+	}
+
+	taints := append(append([]k8sv1.Taint{}, node.Spec.Taints...), k8sv1.Taint{
+		Key:    maintenancev1alpha1.NodeMaintenanceTaintKey,
+		Effect: k8sv1.TaintEffectNoSchedule,
+	})
+	patchSet := patch.New()
+	if len(node.Spec.Taints) == 0 {
+		patchSet.AddOption(patch.WithAdd("/spec/taints", taints))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/spec/taints", node.Spec.Taints),
+			patch.WithReplace("/spec/taints", taints),
+		)
+	}
+	if err := c.patchNode(node.Name, patchSet); err != nil {
+		return err
+	}
+	log.Log.Object(node).Infof("Put node %s into maintenance", node.Name)
+	return nil
+}
+
+// endMaintenance removes the taint and the domains virt-handler reported once
+// no NodeMaintenance targets the node anymore.
+func (c *Controller) endMaintenance(node *k8sv1.Node) error {
+	patchSet := patch.New()
+
+	if hasMaintenanceTaint(node) {
+		taints := []k8sv1.Taint{}
+		for _, taint := range node.Spec.Taints {
+			if !isMaintenanceTaint(taint) {
+				taints = append(taints, taint)
+			}
+		}
+		patchSet.AddOption(
+			patch.WithTest("/spec/taints", node.Spec.Taints),
+			patch.WithReplace("/spec/taints", taints),
+		)
+	}
+	if _, exists := node.Annotations[maintenancev1alpha1.NodeMaintenanceDomainsAnnotation]; exists {
+		patchSet.AddOption(patch.WithRemove("/metadata/annotations/" + patch.EscapeJSONPointer(maintenancev1alpha1.NodeMaintenanceDomainsAnnotation)))
+	}
+
+	if len(patchSet.GetPatches()) == 0 {
+		return nil
+	}
+	if err := c.patchNode(node.Name, patchSet); err != nil {
+		return err
+	}
+	log.Log.Object(node).Infof("Ended the maintenance of node %s", node.Name)
+	return nil
+}
+
+func (c *Controller) patchNode(nodeName string, patchSet *patch.PatchSet) error {
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CoreV1().Nodes().Patch(context.Background(), nodeName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// nodeStatus returns the status all NodeMaintenances of the node share
+func (c *Controller) nodeStatus(node *k8sv1.Node) (*maintenancev1alpha1.NodeMaintenanceStatus, error) {
+	objs, err := c.vmiIndexer.ByIndex(nodeIndex, node.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &maintenancev1alpha1.NodeMaintenanceStatus{
+		Phase: maintenancev1alpha1.NodeMaintenanceDraining,
+	}
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() {
+			continue
+		}
+		status.RemainingVirtualMachineInstances++
+
+		// The evacuation controller leaves these VMIs on the node
+		if !migrationutils.VMIMigratableOnEviction(c.clusterConfig, vmi) ||
+			!conditionManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8sv1.ConditionTrue) {
+			status.BlockingVirtualMachineInstances = append(status.BlockingVirtualMachineInstances, vmi.Namespace+"/"+vmi.Name)
+		}
+	}
+	sort.Strings(status.BlockingVirtualMachineInstances)
+
+	if value, exists := node.Annotations[maintenancev1alpha1.NodeMaintenanceDomainsAnnotation]; exists {
+		domains, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			log.Log.Object(node).Reason(err).Warningf("Ignoring invalid %s annotation", maintenancev1alpha1.NodeMaintenanceDomainsAnnotation)
+		} else {
+			status.RemainingDomains = pointer.P(int32(domains))
+		}
+	}
+
+	if status.RemainingVirtualMachineInstances == 0 && status.RemainingDomains != nil && *status.RemainingDomains == 0 {
+		status.Phase = maintenancev1alpha1.NodeMaintenanceReadyForReboot
+	}
+	return status, nil
+}
+
+func (c *Controller) updateStatus(nm *maintenancev1alpha1.NodeMaintenance, status *maintenancev1alpha1.NodeMaintenanceStatus) error {
+	nmCopy := nm.DeepCopy()
+	nmCopy.Status = status.DeepCopy()
+
+	becameReady := false
+	if status.Phase == maintenancev1alpha1.NodeMaintenanceReadyForReboot {
+		if nm.Status != nil && nm.Status.ReadyTimestamp != nil {
+			nmCopy.Status.ReadyTimestamp = nm.Status.ReadyTimestamp
+		} else {
+			nmCopy.Status.ReadyTimestamp = pointer.P(metav1.NewTime(c.now()))
+			becameReady = true
+		}
+	}
+
+	if equality.Semantic.DeepEqual(nm.Status, nmCopy.Status) {
+		return nil
+	}
+	if _, err := c.clientset.NodeMaintenance().UpdateStatus(context.Background(), nmCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	if becameReady {
+		c.recorder.Eventf(nmCopy, k8sv1.EventTypeNormal, NodeReadyForRebootReason, "Node %s is ready for reboot", nm.Spec.NodeName)
+	}
+	return nil
+}
+
+func hasMaintenanceTaint(node *k8sv1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if isMaintenanceTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMaintenanceTaint(taint k8sv1.Taint) bool {
+	return taint.Key == maintenancev1alpha1.NodeMaintenanceTaintKey && taint.Effect == k8sv1.TaintEffectNoSchedule
+}
@@ -0,0 +1,281 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	testNodeName    = "test-node"
+	maintenanceName = "test-maintenance"
+)
+
+var _ = Describe("NodeMaintenance Controller", func() {
+	var (
+		k8sClient           *k8sfake.Clientset
+		kubevirtClient      *kubevirtfake.Clientset
+		maintenanceInformer cache.SharedIndexInformer
+		nodeInformer        cache.SharedIndexInformer
+		vmiInformer         cache.SharedIndexInformer
+		recorder            *record.FakeRecorder
+		controller          *Controller
+		virtClient          *kubecli.MockKubevirtClient
+		now                 time.Time
+	)
+
+	newController := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		controller = &Controller{
+			clientset:          virtClient,
+			clusterConfig:      clusterConfig,
+			maintenanceIndexer: maintenanceInformer.GetIndexer(),
+			nodeStore:          nodeInformer.GetStore(),
+			vmiIndexer:         vmiInformer.GetIndexer(),
+			recorder:           recorder,
+			queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-nodemaintenance-queue"},
+			),
+			now: func() time.Time { return now },
+		}
+	}
+
+	addNode := func(node *k8sv1.Node) {
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+		_, err := k8sClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newNode := func(taints ...k8sv1.Taint) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName},
+			Spec:       k8sv1.NodeSpec{Taints: taints},
+		}
+	}
+
+	addMaintenance := func(nm *maintenancev1alpha1.NodeMaintenance) {
+		Expect(maintenanceInformer.GetStore().Add(nm)).To(Succeed())
+		_, err := kubevirtClient.MaintenanceV1alpha1().NodeMaintenances().Create(context.Background(), nm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newMaintenance := func() *maintenancev1alpha1.NodeMaintenance {
+		return &maintenancev1alpha1.NodeMaintenance{
+			ObjectMeta: metav1.ObjectMeta{Name: maintenanceName},
+			Spec:       maintenancev1alpha1.NodeMaintenanceSpec{NodeName: testNodeName},
+		}
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) {
+		vmi.Status.NodeName = testNodeName
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	migratableVMI := func(name string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName(name), libvmi.WithNamespace(k8sv1.NamespaceDefault),
+			libvmi.WithEvictionStrategy(v1.EvictionStrategyLiveMigrate))
+		vmi.Status.Phase = v1.Running
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceIsMigratable,
+			Status: k8sv1.ConditionTrue,
+		}}
+		return vmi
+	}
+
+	getNode := func() *k8sv1.Node {
+		node, err := k8sClient.CoreV1().Nodes().Get(context.Background(), testNodeName, metav1.GetOptions{})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return node
+	}
+
+	getStatus := func() *maintenancev1alpha1.NodeMaintenanceStatus {
+		nm, err := kubevirtClient.MaintenanceV1alpha1().NodeMaintenances().Get(context.Background(), maintenanceName, metav1.GetOptions{})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return nm.Status
+	}
+
+	maintenanceTaint := k8sv1.Taint{Key: maintenancev1alpha1.NodeMaintenanceTaintKey, Effect: k8sv1.TaintEffectNoSchedule}
+	otherTaint := k8sv1.Taint{Key: "other", Effect: k8sv1.TaintEffectNoExecute}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		maintenanceInformer, _ = testutils.NewFakeInformerWithIndexersFor(&maintenancev1alpha1.NodeMaintenance{}, virtcontroller.GetNodeMaintenanceInformerIndexers())
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		vmiInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, virtcontroller.GetVMIInformerIndexers())
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		now = time.Now()
+
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().NodeMaintenance().Return(kubevirtClient.MaintenanceV1alpha1().NodeMaintenances()).AnyTimes()
+
+		newController(featuregate.NodeMaintenanceGate)
+	})
+
+	It("should not taint the node without the feature gate", func() {
+		newController()
+		addNode(newNode())
+		addMaintenance(newMaintenance())
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		Expect(getNode().Spec.Taints).To(BeEmpty())
+		Expect(getStatus()).To(BeNil())
+	})
+
+	DescribeTable("should taint the node in maintenance", func(taints ...k8sv1.Taint) {
+		addNode(newNode(taints...))
+		addMaintenance(newMaintenance())
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		Expect(getNode().Spec.Taints).To(ConsistOf(append(taints, maintenanceTaint)))
+	},
+		Entry("without taints"),
+		Entry("with other taints", otherTaint),
+	)
+
+	It("should report the remaining and the blocking VMIs", func() {
+		addNode(newNode(maintenanceTaint))
+		addMaintenance(newMaintenance())
+		addVMI(migratableVMI("migratable"))
+		notMigratable := migratableVMI("not-migratable")
+		notMigratable.Status.Conditions = nil
+		addVMI(notMigratable)
+		noEviction := migratableVMI("no-eviction")
+		noEviction.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyNone)
+		addVMI(noEviction)
+		finished := migratableVMI("finished")
+		finished.Status.Phase = v1.Succeeded
+		addVMI(finished)
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceDraining))
+		Expect(status.RemainingVirtualMachineInstances).To(BeEquivalentTo(3))
+		Expect(status.BlockingVirtualMachineInstances).To(Equal([]string{"default/no-eviction", "default/not-migratable"}))
+		Expect(status.RemainingDomains).To(BeNil())
+		Expect(status.ReadyTimestamp).To(BeNil())
+	})
+
+	It("should wait for virt-handler to report the domains", func() {
+		node := newNode(maintenanceTaint)
+		node.Annotations = map[string]string{maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: "1"}
+		addNode(node)
+		addMaintenance(newMaintenance())
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceDraining))
+		Expect(status.RemainingVirtualMachineInstances).To(BeZero())
+		Expect(status.RemainingDomains).To(HaveValue(BeEquivalentTo(1)))
+	})
+
+	It("should report that the node is ready for reboot", func() {
+		node := newNode(maintenanceTaint)
+		node.Annotations = map[string]string{maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: "0"}
+		addNode(node)
+		addMaintenance(newMaintenance())
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		status := getStatus()
+		Expect(status.Phase).To(Equal(maintenancev1alpha1.NodeMaintenanceReadyForReboot))
+		Expect(status.ReadyTimestamp.Time).To(BeTemporally("~", now, time.Second))
+		testutils.ExpectEvent(recorder, NodeReadyForRebootReason)
+	})
+
+	It("should keep the ready timestamp", func() {
+		node := newNode(maintenanceTaint)
+		node.Annotations = map[string]string{maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: "0"}
+		addNode(node)
+		readyTimestamp := metav1.NewTime(now.Add(-time.Hour).Truncate(time.Second))
+		nm := newMaintenance()
+		nm.Status = &maintenancev1alpha1.NodeMaintenanceStatus{
+			Phase:            maintenancev1alpha1.NodeMaintenanceReadyForReboot,
+			RemainingDomains: pointer.P(int32(0)),
+			ReadyTimestamp:   &readyTimestamp,
+		}
+		addMaintenance(nm)
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		Expect(getStatus().ReadyTimestamp.Time).To(BeTemporally("==", readyTimestamp.Time))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should end the maintenance when no NodeMaintenance targets the node", func() {
+		node := newNode(otherTaint, maintenanceTaint)
+		node.Annotations = map[string]string{maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: "0"}
+		addNode(node)
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		node = getNode()
+		Expect(node.Spec.Taints).To(ConsistOf(otherTaint))
+		Expect(node.Annotations).ToNot(HaveKey(maintenancev1alpha1.NodeMaintenanceDomainsAnnotation))
+	})
+
+	It("should not patch nodes which are not in maintenance", func() {
+		addNode(newNode(otherTaint))
+		k8sClient.ClearActions()
+
+		Expect(controller.execute(testNodeName)).To(Succeed())
+		Expect(k8sClient.Actions()).To(BeEmpty())
+	})
+
+	It("should enqueue the node of a VMI on a node in maintenance", func() {
+		addMaintenance(newMaintenance())
+		vmi := migratableVMI("vmi")
+		vmi.Status.NodeName = testNodeName
+		controller.handleVMI(vmi)
+		Expect(controller.queue.Len()).To(Equal(1))
+
+		vmi.Status.NodeName = "other-node"
+		controller.handleVMI(vmi)
+		Expect(controller.queue.Len()).To(Equal(1))
+	})
+})
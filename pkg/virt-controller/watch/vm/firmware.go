@@ -19,6 +19,7 @@ package vm
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,24 +29,33 @@ import (
 	"kubevirt.io/client-go/kubevirt"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
 
 type FirmwareController struct {
-	clientset kubevirt.Interface
+	clientset     kubevirt.Interface
+	clusterConfig *virtconfig.ClusterConfig
 }
 
 const (
 	firmwareUUIDErrorReason = "FirmwareUUIDError"
 )
 
-func NewFirmwareController(clientset kubevirt.Interface) *FirmwareController {
+func NewFirmwareController(clientset kubevirt.Interface, clusterConfig *virtconfig.ClusterConfig) *FirmwareController {
 	return &FirmwareController{
-		clientset: clientset,
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
 	}
 }
 
-func (fc *FirmwareController) Sync(vm *v1.VirtualMachine, _ *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+func (fc *FirmwareController) Sync(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	if fc.clusterConfig.SealedVMDefaultsEnabled() {
+		return fc.syncSealed(vm, vmi)
+	}
+
 	firmware := vm.Spec.Template.Spec.Domain.Firmware
 	if firmware == nil {
 		firmware = &v1.Firmware{}
@@ -80,6 +90,110 @@ func (fc *FirmwareController) vmFirmwarePatch(updatedFirmware *v1.Firmware, vm *
 		Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
 }
 
+// syncSealed records the firmware UUID, the MAC addresses and the PCI addresses of the VM
+// in spec.sealed, instead of the template.
+func (fc *FirmwareController) syncSealed(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) (*v1.VirtualMachine, error) {
+	sealed := vm.Spec.Sealed.DeepCopy()
+	if sealed == nil {
+		sealed = &v1.VirtualMachineSealedDefaults{}
+	}
+	changed := false
+
+	firmware := vm.Spec.Template.Spec.Domain.Firmware
+	if (firmware == nil || firmware.UUID == "") && sealed.FirmwareUUID == "" {
+		sealed.FirmwareUUID = CalculateLegacyUUID(vm.Name)
+		changed = true
+	}
+
+	for i := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		iface := &vm.Spec.Template.Spec.Domain.Devices.Interfaces[i]
+		sealedIface := defaults.LookupSealedInterface(sealed, iface.Name)
+		if sealedIface == nil {
+			sealed.Interfaces = append(sealed.Interfaces, v1.SealedInterface{Name: iface.Name})
+			sealedIface = &sealed.Interfaces[len(sealed.Interfaces)-1]
+		}
+		if defaults.NeedsSealedMACAddress(iface) && sealedIface.MACAddress == "" {
+			if mac := sealedMACAddress(vm, vmi, iface.Name); mac != "" {
+				sealedIface.MACAddress = mac
+				changed = true
+			}
+		}
+		if iface.PciAddress == "" && sealedIface.PCIAddress == "" {
+			if address := sealedPCIAddress(vmi, v1.DeviceTypeInterface, iface.Name); address != "" {
+				sealedIface.PCIAddress = address
+				changed = true
+			}
+		}
+	}
+	sealed.Interfaces = slices.DeleteFunc(sealed.Interfaces, func(iface v1.SealedInterface) bool {
+		return iface.MACAddress == "" && iface.PCIAddress == ""
+	})
+
+	for i := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		disk := &vm.Spec.Template.Spec.Domain.Devices.Disks[i]
+		if !defaults.NeedsSealedPCIAddress(disk) || defaults.LookupSealedDisk(sealed, disk.Name) != nil {
+			continue
+		}
+		if address := sealedPCIAddress(vmi, v1.DeviceTypeDisk, disk.Name); address != "" {
+			sealed.Disks = append(sealed.Disks, v1.SealedDisk{Name: disk.Name, PCIAddress: address})
+			changed = true
+		}
+	}
+
+	if !changed {
+		return vm, nil
+	}
+
+	updatedVM, err := fc.vmSealedPatch(sealed, vm)
+	if err != nil {
+		return vm, common.NewSyncError(fmt.Errorf("error encountered when trying to patch VM sealed defaults: %w", err), firmwareUUIDErrorReason)
+	}
+
+	return updatedVM, nil
+}
+
+// sealedMACAddress returns the MAC address to seal for an interface. The MAC address of a
+// running VMI is kept, so that the guest does not see a new one on its next start.
+func sealedMACAddress(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, ifaceName string) string {
+	if vmi == nil {
+		return defaults.GenerateSealedMACAddress(vm, ifaceName)
+	}
+	if iface := vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName); iface != nil && iface.MacAddress != "" {
+		return iface.MacAddress
+	}
+	if status := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, ifaceName); status != nil {
+		return status.MAC
+	}
+	return ""
+}
+
+// sealedPCIAddress returns the PCI address which the running VMI reports for a device.
+func sealedPCIAddress(vmi *v1.VirtualMachineInstance, deviceType v1.DeviceType, name string) string {
+	if vmi == nil {
+		return ""
+	}
+	for _, deviceStatus := range vmi.Status.DeviceStatuses {
+		if deviceStatus.Type == deviceType && deviceStatus.Name == name {
+			return deviceStatus.PCIAddress
+		}
+	}
+	return ""
+}
+
+func (fc *FirmwareController) vmSealedPatch(sealed *v1.VirtualMachineSealedDefaults, vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+	patchBytes, err := patch.New(
+		patch.WithTest("/spec/sealed", vm.Spec.Sealed),
+		patch.WithAdd("/spec/sealed", sealed),
+	).GeneratePayload()
+	if err != nil {
+		return vm, err
+	}
+
+	return fc.clientset.KubevirtV1().
+		VirtualMachines(vm.Namespace).
+		Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
+
 const magicUUID = "6a1a24a1-4061-4607-8bf4-a3963d0c5895"
 
 var firmwareUUIDns = uuid.MustParse(magicUUID)
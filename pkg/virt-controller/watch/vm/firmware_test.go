@@ -30,13 +30,23 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt/fake"
 
+	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("VM Firmware Controller", func() {
+	var clusterConfig *virtconfig.ClusterConfig
+
+	BeforeEach(func() {
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+	})
+
 	It("sync does nothing when a vm already has a uuid", func() {
 		clientset := fake.NewSimpleClientset()
-		fc := NewFirmwareController(clientset)
+		fc := NewFirmwareController(clientset, clusterConfig)
 		vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithFirmwareUUID("some-existing-uid")))
 		originalVM := vm.DeepCopy()
 		updatedVM, err := fc.Sync(vm, nil)
@@ -47,7 +57,7 @@ var _ = Describe("VM Firmware Controller", func() {
 
 	It("sync fails when VM patch returns an error", func() {
 		clientset := fake.NewSimpleClientset()
-		fc := NewFirmwareController(clientset)
+		fc := NewFirmwareController(clientset, clusterConfig)
 
 		injectedPatchError := errors.New("test patch error")
 		clientset.Fake.PrependReactor(
@@ -72,7 +82,7 @@ var _ = Describe("VM Firmware Controller", func() {
 
 	DescribeTable("sync succeeds to patch firmware UUID", func(firmware *v1.Firmware) {
 		clientset := fake.NewSimpleClientset()
-		c := NewFirmwareController(clientset)
+		c := NewFirmwareController(clientset, clusterConfig)
 
 		vm := libvmi.NewVirtualMachine(libvmi.New())
 
@@ -98,4 +108,128 @@ var _ = Describe("VM Firmware Controller", func() {
 		Entry("when the VM has no firmware", nil),
 		Entry("when the VM has firmware with an empty UUID", &v1.Firmware{UUID: ""}),
 	)
+
+	Context("with the SealedVMDefaults feature gate", func() {
+		BeforeEach(func() {
+			clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.SealedVMDefaultsGate},
+				},
+			})
+		})
+
+		newVM := func() *v1.VirtualMachine {
+			vm := libvmi.NewVirtualMachine(libvmi.New(
+				libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			))
+			vm.UID = "vm-uid"
+			return vm
+		}
+
+		It("should seal the firmware UUID and MAC addresses instead of patching the template", func() {
+			clientset := fake.NewSimpleClientset()
+			fc := NewFirmwareController(clientset, clusterConfig)
+
+			vm := newVM()
+			_, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, k8smetav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			updatedVM, err := fc.Sync(vm, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM.Spec.Template).To(Equal(vm.Spec.Template))
+			Expect(updatedVM.Spec.Sealed).To(Equal(&v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: CalculateLegacyUUID(vm.Name),
+				Interfaces: []v1.SealedInterface{{
+					Name:       "default",
+					MACAddress: defaults.GenerateSealedMACAddress(vm, "default"),
+				}},
+			}))
+		})
+
+		It("should seal the MAC address of the running VMI", func() {
+			clientset := fake.NewSimpleClientset()
+			fc := NewFirmwareController(clientset, clusterConfig)
+
+			vm := newVM()
+			_, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, k8smetav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			vmi := libvmi.New()
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", MAC: "02:00:00:00:00:01"}}
+
+			updatedVM, err := fc.Sync(vm, vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM.Spec.Sealed.Interfaces).To(ConsistOf(v1.SealedInterface{Name: "default", MACAddress: "02:00:00:00:00:01"}))
+		})
+
+		It("should seal the PCI addresses reported by the running VMI", func() {
+			clientset := fake.NewSimpleClientset()
+			fc := NewFirmwareController(clientset, clusterConfig)
+
+			vm := newVM()
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "rootdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+				{Name: "cdrom", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{Bus: v1.DiskBusSATA}}},
+			}
+			vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: "some-uuid",
+				Interfaces:   []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+			}
+			_, err := clientset.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.Background(), vm, k8smetav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			vmi := libvmi.New()
+			vmi.Status.DeviceStatuses = []v1.VirtualMachineInstanceDeviceStatus{
+				{Name: "rootdisk", Type: v1.DeviceTypeDisk, Attached: true, PCIAddress: "0000:07:00.0"},
+				{Name: "cdrom", Type: v1.DeviceTypeDisk, Attached: true},
+				{Name: "default", Type: v1.DeviceTypeInterface, Attached: true, PCIAddress: "0000:01:00.0"},
+			}
+
+			updatedVM, err := fc.Sync(vm, vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM.Spec.Sealed.Interfaces).To(ConsistOf(
+				v1.SealedInterface{Name: "default", MACAddress: "02:00:00:00:00:01", PCIAddress: "0000:01:00.0"},
+			))
+			Expect(updatedVM.Spec.Sealed.Disks).To(ConsistOf(v1.SealedDisk{Name: "rootdisk", PCIAddress: "0000:07:00.0"}))
+		})
+
+		It("should not seal the PCI address of a device which sets one in the template", func() {
+			clientset := fake.NewSimpleClientset()
+			fc := NewFirmwareController(clientset, clusterConfig)
+
+			vm := newVM()
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].PciAddress = "0000:01:00.0"
+			vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: "some-uuid",
+				Interfaces:   []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+			}
+
+			vmi := libvmi.New()
+			vmi.Status.DeviceStatuses = []v1.VirtualMachineInstanceDeviceStatus{
+				{Name: "default", Type: v1.DeviceTypeInterface, Attached: true, PCIAddress: "0000:01:00.0"},
+			}
+
+			updatedVM, err := fc.Sync(vm, vmi)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM).To(Equal(vm))
+			Expect(clientset.Actions()).To(BeEmpty())
+		})
+
+		It("should do nothing when everything is sealed", func() {
+			clientset := fake.NewSimpleClientset()
+			fc := NewFirmwareController(clientset, clusterConfig)
+
+			vm := newVM()
+			vm.Spec.Sealed = &v1.VirtualMachineSealedDefaults{
+				FirmwareUUID: "some-uuid",
+				Interfaces:   []v1.SealedInterface{{Name: "default", MACAddress: "02:00:00:00:00:01"}},
+			}
+
+			updatedVM, err := fc.Sync(vm, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVM).To(Equal(vm))
+			Expect(clientset.Actions()).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,340 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	startThrottledReason = "StartThrottled"
+
+	// startThrottleRetryInterval is how often a VM waiting for its start is reconsidered.
+	startThrottleRetryInterval = 5 * time.Second
+	// startWaiterExpiry forgets waiting VMs which were not reconsidered,
+	// because they were stopped or deleted in the meantime.
+	startWaiterExpiry = 1 * time.Minute
+	// startAdmissionExpiry is how long an admitted VM counts as starting
+	// while its VMI does not show up in the cache.
+	startAdmissionExpiry = 30 * time.Second
+	// startingTimeout is how long after its creation a VMI counts as starting
+	// at most, so that VMIs which never start, e.g. because they can not be
+	// scheduled, do not block the starts of other VMs.
+	startingTimeout = 5 * time.Minute
+)
+
+type startWaiter struct {
+	namespace      string
+	priority       int32
+	since          time.Time
+	lastSeen       time.Time
+	storageClasses []string
+}
+
+type startAdmission struct {
+	namespace      string
+	admitted       time.Time
+	storageClasses []string
+}
+
+// startThrottler limits the number of VMIs starting at the same time per
+// storage class, so that VMs which are started all at once, e.g. after an
+// outage, do not overload the storage. VMs waiting for a start are admitted
+// by the value of their PriorityClass first and by their waiting time second.
+type startThrottler struct {
+	clusterConfig      *virtconfig.ClusterConfig
+	vmiIndexer         cache.Indexer
+	pvcStore           cache.Store
+	dataVolumeStore    cache.Store
+	priorityClassStore cache.Store
+	now                func() time.Time
+
+	lock     sync.Mutex
+	waiting  map[string]*startWaiter
+	admitted map[string]startAdmission
+}
+
+func newStartThrottler(clusterConfig *virtconfig.ClusterConfig, vmiIndexer cache.Indexer, pvcStore, dataVolumeStore, priorityClassStore cache.Store) *startThrottler {
+	return &startThrottler{
+		clusterConfig:      clusterConfig,
+		vmiIndexer:         vmiIndexer,
+		pvcStore:           pvcStore,
+		dataVolumeStore:    dataVolumeStore,
+		priorityClassStore: priorityClassStore,
+		now:                time.Now,
+		waiting:            map[string]*startWaiter{},
+		admitted:           map[string]startAdmission{},
+	}
+}
+
+// admit decides whether the VMI of the VM may be created now. It returns the
+// storage class the VM has to wait for, empty if the VM may start, and
+// whether the VM just started waiting.
+func (t *startThrottler) admit(vm *virtv1.VirtualMachine) (storageClass string, newlyThrottled bool) {
+	config := t.clusterConfig.GetVMStartThrottlingConfiguration()
+	if config == nil {
+		return "", false
+	}
+
+	key := vm.Namespace + "/" + vm.Name
+	storageClasses := t.storageClasses(vm.Namespace, vm.Spec.Template.Spec.Volumes, vm.Spec.DataVolumeTemplates)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	t.expire(now)
+
+	if len(storageClasses) == 0 {
+		delete(t.waiting, key)
+		return "", false
+	}
+
+	waiter, exists := t.waiting[key]
+	if !exists {
+		waiter = &startWaiter{since: now}
+		t.waiting[key] = waiter
+	}
+	waiter.namespace = vm.Namespace
+	waiter.priority = t.priority(vm.Spec.Template.Spec.PriorityClassName)
+	waiter.lastSeen = now
+	waiter.storageClasses = storageClasses
+
+	starting := t.starting(now)
+	// A namespace which already starts its share of VMs on a storage class
+	// neither starts more nor holds back the VMs of other namespaces
+	atNamespaceLimit := func(storageClass, namespace string) bool {
+		return config.MaxStartingPerNamespace > 0 &&
+			starting.perNamespace[storageClass][namespace] >= int(config.MaxStartingPerNamespace)
+	}
+	for _, storageClass := range storageClasses {
+		limit := maxStarting(config, storageClass)
+		if limit == 0 {
+			continue
+		}
+		if atNamespaceLimit(storageClass, vm.Namespace) {
+			return storageClass, !exists
+		}
+		ahead := 0
+		for otherKey, other := range t.waiting {
+			if otherKey != key && slices.Contains(other.storageClasses, storageClass) && outranks(otherKey, other, key, waiter) &&
+				!atNamespaceLimit(storageClass, other.namespace) {
+				ahead++
+			}
+		}
+		if starting.perStorageClass[storageClass]+ahead >= int(limit) {
+			return storageClass, !exists
+		}
+	}
+
+	delete(t.waiting, key)
+	t.admitted[key] = startAdmission{namespace: vm.Namespace, admitted: now, storageClasses: storageClasses}
+	return "", false
+}
+
+func (t *startThrottler) expire(now time.Time) {
+	for key, waiter := range t.waiting {
+		if now.Sub(waiter.lastSeen) > startWaiterExpiry {
+			delete(t.waiting, key)
+		}
+	}
+	for key, admission := range t.admitted {
+		if now.Sub(admission.admitted) > startAdmissionExpiry {
+			delete(t.admitted, key)
+		}
+	}
+}
+
+// startingVMIs is the number of starting VMIs per storage class, and per
+// storage class and namespace
+type startingVMIs struct {
+	perStorageClass map[string]int
+	perNamespace    map[string]map[string]int
+}
+
+func (s *startingVMIs) add(storageClasses []string, namespace string) {
+	for _, storageClass := range storageClasses {
+		s.perStorageClass[storageClass]++
+		if s.perNamespace[storageClass] == nil {
+			s.perNamespace[storageClass] = map[string]int{}
+		}
+		s.perNamespace[storageClass][namespace]++
+	}
+}
+
+// starting counts the VMIs which did not reach the Running phase yet, and
+// the admitted VMs whose VMI is not in the cache yet. A VMI only counts once
+// all of its volumes are bound, as it does not load the storage before, and
+// only until startingTimeout passed since its creation.
+func (t *startThrottler) starting(now time.Time) *startingVMIs {
+	starting := &startingVMIs{
+		perStorageClass: map[string]int{},
+		perNamespace:    map[string]map[string]int{},
+	}
+	for _, obj := range t.vmiIndexer.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil || !isStarting(vmi) || now.Sub(vmi.CreationTimestamp.Time) > startingTimeout {
+			continue
+		}
+		if !t.volumesBound(vmi.Namespace, vmi.Spec.Volumes) {
+			continue
+		}
+		starting.add(t.storageClasses(vmi.Namespace, vmi.Spec.Volumes, nil), vmi.Namespace)
+	}
+	for key, admission := range t.admitted {
+		if _, exists, _ := t.vmiIndexer.GetByKey(key); exists {
+			delete(t.admitted, key)
+			continue
+		}
+		starting.add(admission.storageClasses, admission.namespace)
+	}
+	return starting
+}
+
+func isStarting(vmi *virtv1.VirtualMachineInstance) bool {
+	switch vmi.Status.Phase {
+	case virtv1.VmPhaseUnset, virtv1.Pending, virtv1.Scheduling, virtv1.Scheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+// storageClasses returns the sorted storage classes of the PVCs and
+// DataVolumes among the volumes.
+func (t *startThrottler) storageClasses(namespace string, volumes []virtv1.Volume, dataVolumeTemplates []virtv1.DataVolumeTemplateSpec) []string {
+	var storageClasses []string
+	for _, volume := range volumes {
+		var storageClass string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			storageClass = t.pvcStorageClass(namespace, volume.PersistentVolumeClaim.ClaimName)
+		case volume.DataVolume != nil:
+			storageClass = t.pvcStorageClass(namespace, volume.DataVolume.Name)
+			if storageClass == "" {
+				storageClass = t.dataVolumeStorageClass(namespace, volume.DataVolume.Name, dataVolumeTemplates)
+			}
+		}
+		if storageClass != "" && !slices.Contains(storageClasses, storageClass) {
+			storageClasses = append(storageClasses, storageClass)
+		}
+	}
+	slices.Sort(storageClasses)
+	return storageClasses
+}
+
+// volumesBound returns whether the PVCs of all PVC and DataVolume volumes are bound
+func (t *startThrottler) volumesBound(namespace string, volumes []virtv1.Volume) bool {
+	for _, volume := range volumes {
+		var claimName string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.Name
+		default:
+			continue
+		}
+		obj, exists, _ := t.pvcStore.GetByKey(namespace + "/" + claimName)
+		if !exists || obj.(*k8score.PersistentVolumeClaim).Status.Phase != k8score.ClaimBound {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *startThrottler) pvcStorageClass(namespace, name string) string {
+	obj, exists, _ := t.pvcStore.GetByKey(namespace + "/" + name)
+	if !exists {
+		return ""
+	}
+	if storageClass := obj.(*k8score.PersistentVolumeClaim).Spec.StorageClassName; storageClass != nil {
+		return *storageClass
+	}
+	return ""
+}
+
+func (t *startThrottler) dataVolumeStorageClass(namespace, name string, dataVolumeTemplates []virtv1.DataVolumeTemplateSpec) string {
+	var spec *cdiv1.DataVolumeSpec
+	if obj, exists, _ := t.dataVolumeStore.GetByKey(namespace + "/" + name); exists {
+		spec = &obj.(*cdiv1.DataVolume).Spec
+	} else {
+		for i := range dataVolumeTemplates {
+			if dataVolumeTemplates[i].Name == name {
+				spec = &dataVolumeTemplates[i].Spec
+				break
+			}
+		}
+	}
+	switch {
+	case spec == nil:
+		return ""
+	case spec.PVC != nil && spec.PVC.StorageClassName != nil:
+		return *spec.PVC.StorageClassName
+	case spec.Storage != nil && spec.Storage.StorageClassName != nil:
+		return *spec.Storage.StorageClassName
+	default:
+		return ""
+	}
+}
+
+// priority returns the value of the PriorityClass, or of the global default
+// PriorityClass if none is named.
+func (t *startThrottler) priority(priorityClassName string) int32 {
+	if priorityClassName != "" {
+		if obj, exists, _ := t.priorityClassStore.GetByKey(priorityClassName); exists {
+			return obj.(*schedulingv1.PriorityClass).Value
+		}
+		return 0
+	}
+	for _, obj := range t.priorityClassStore.List() {
+		if priorityClass := obj.(*schedulingv1.PriorityClass); priorityClass.GlobalDefault {
+			return priorityClass.Value
+		}
+	}
+	return 0
+}
+
+func outranks(key string, waiter *startWaiter, otherKey string, other *startWaiter) bool {
+	if waiter.priority != other.priority {
+		return waiter.priority > other.priority
+	}
+	if !waiter.since.Equal(other.since) {
+		return waiter.since.Before(other.since)
+	}
+	return key < otherKey
+}
+
+func maxStarting(config *virtv1.VMStartThrottlingConfiguration, storageClass string) uint32 {
+	for _, limit := range config.StorageClasses {
+		if limit.Name == storageClass {
+			return limit.MaxStarting
+		}
+	}
+	return config.MaxStartingPerStorageClass
+}
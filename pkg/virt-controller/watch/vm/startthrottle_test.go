@@ -0,0 +1,306 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VM start throttling", func() {
+	var (
+		throttler          *startThrottler
+		vmiStore           cache.Indexer
+		pvcStore           cache.Store
+		dataVolumeStore    cache.Store
+		priorityClassStore cache.Store
+		now                time.Time
+	)
+
+	newThrottler := func(config *v1.VMStartThrottlingConfiguration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			VMStartThrottling: config,
+		})
+		throttler = newStartThrottler(clusterConfig, vmiStore, pvcStore, dataVolumeStore, priorityClassStore)
+		throttler.now = func() time.Time { return now }
+	}
+
+	addPVCIn := func(namespace, name, storageClass string, phase k8sv1.PersistentVolumeClaimPhase) {
+		Expect(pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{StorageClassName: pointer.P(storageClass)},
+			Status:     k8sv1.PersistentVolumeClaimStatus{Phase: phase},
+		})).To(Succeed())
+	}
+
+	newVMIn := func(namespace, name, pvcName string) *v1.VirtualMachine {
+		addPVCIn(namespace, pvcName, "fast", k8sv1.ClaimBound)
+		return libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(namespace),
+			libvmi.WithPersistentVolumeClaim("disk0", pvcName),
+		))
+	}
+
+	newVM := func(name, pvcName string) *v1.VirtualMachine {
+		return newVMIn(metav1.NamespaceDefault, name, pvcName)
+	}
+
+	addStartingVMIIn := func(namespace, name, pvcName string, phase v1.VirtualMachineInstancePhase, pvcPhase k8sv1.PersistentVolumeClaimPhase) {
+		addPVCIn(namespace, pvcName, "fast", pvcPhase)
+		vmi := libvmi.New(
+			libvmi.WithName(name),
+			libvmi.WithNamespace(namespace),
+			libvmi.WithPersistentVolumeClaim("disk0", pvcName),
+		)
+		vmi.CreationTimestamp = metav1.NewTime(now)
+		vmi.Status.Phase = phase
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+	}
+
+	addStartingVMI := func(name, pvcName string, phase v1.VirtualMachineInstancePhase) {
+		addStartingVMIIn(metav1.NamespaceDefault, name, pvcName, phase, k8sv1.ClaimBound)
+	}
+
+	BeforeEach(func() {
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		priorityClassInformer, _ := testutils.NewFakeInformerFor(&schedulingv1.PriorityClass{})
+		vmiStore = vmiInformer.GetIndexer()
+		pvcStore = pvcInformer.GetStore()
+		dataVolumeStore = dataVolumeInformer.GetStore()
+		priorityClassStore = priorityClassInformer.GetStore()
+		now = time.Now()
+
+		newThrottler(&v1.VMStartThrottlingConfiguration{MaxStartingPerStorageClass: 2})
+	})
+
+	It("should admit all VMs without a configuration", func() {
+		newThrottler(nil)
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Scheduling)
+
+		storageClass, _ := throttler.admit(newVM("vm", "pvc"))
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should admit VMs without volumes on a storage class", func() {
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Scheduling)
+
+		storageClass, _ := throttler.admit(libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName("vm"))))
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should throttle VMs once the limit of starting VMIs is reached", func() {
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Scheduled)
+		addStartingVMI("vmi3", "pvc3", v1.Running)
+
+		vm := newVM("vm", "pvc")
+		storageClass, newlyThrottled := throttler.admit(vm)
+		Expect(storageClass).To(Equal("fast"))
+		Expect(newlyThrottled).To(BeTrue())
+
+		storageClass, newlyThrottled = throttler.admit(vm)
+		Expect(storageClass).To(Equal("fast"))
+		Expect(newlyThrottled).To(BeFalse())
+
+		vmi2, _, _ := vmiStore.GetByKey(metav1.NamespaceDefault + "/vmi2")
+		vmi2.(*v1.VirtualMachineInstance).Status.Phase = v1.Running
+		storageClass, _ = throttler.admit(vm)
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should count admitted VMs until their VMI shows up", func() {
+		storageClass, _ := throttler.admit(newVM("vm1", "pvc1"))
+		Expect(storageClass).To(BeEmpty())
+		storageClass, _ = throttler.admit(newVM("vm2", "pvc2"))
+		Expect(storageClass).To(BeEmpty())
+		storageClass, _ = throttler.admit(newVM("vm3", "pvc3"))
+		Expect(storageClass).To(Equal("fast"))
+
+		now = now.Add(startAdmissionExpiry + time.Second)
+		storageClass, _ = throttler.admit(newVM("vm3", "pvc3"))
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should not count VMIs whose volumes are not bound", func() {
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMIIn(metav1.NamespaceDefault, "vmi2", "pvc2", v1.Pending, k8sv1.ClaimPending)
+
+		storageClass, _ := throttler.admit(newVM("vm", "pvc"))
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should stop counting VMIs which do not start in time", func() {
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Pending)
+
+		vm := newVM("vm", "pvc")
+		storageClass, _ := throttler.admit(vm)
+		Expect(storageClass).To(Equal("fast"))
+
+		now = now.Add(startingTimeout + time.Second)
+		storageClass, _ = throttler.admit(vm)
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should apply the limit of the storage class", func() {
+		newThrottler(&v1.VMStartThrottlingConfiguration{
+			MaxStartingPerStorageClass: 2,
+			StorageClasses:             []v1.StorageClassStartLimit{{Name: "fast", MaxStarting: 0}},
+		})
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Pending)
+
+		storageClass, _ := throttler.admit(newVM("vm", "pvc"))
+		Expect(storageClass).To(BeEmpty())
+	})
+
+	It("should take the storage class of DataVolume templates", func() {
+		addStartingVMI("vmi1", "pvc1", v1.Pending)
+		addStartingVMI("vmi2", "pvc2", v1.Pending)
+
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithName("vm"),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithDataVolume("disk0", "dv"),
+		))
+		vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+			ObjectMeta: metav1.ObjectMeta{Name: "dv"},
+			Spec: cdiv1.DataVolumeSpec{
+				Storage: &cdiv1.StorageSpec{StorageClassName: pointer.P("fast")},
+			},
+		}}
+		storageClass, _ := throttler.admit(vm)
+		Expect(storageClass).To(Equal("fast"))
+	})
+
+	Context("with several VMs waiting", func() {
+		BeforeEach(func() {
+			newThrottler(&v1.VMStartThrottlingConfiguration{MaxStartingPerStorageClass: 1})
+			Expect(priorityClassStore.Add(&schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "critical"},
+				Value:      1000,
+			})).To(Succeed())
+			addStartingVMI("vmi", "pvc", v1.Pending)
+		})
+
+		It("should admit the VM with the highest priority first", func() {
+			low := newVM("low", "pvc-low")
+			critical := newVM("critical", "pvc-critical")
+			critical.Spec.Template.Spec.PriorityClassName = "critical"
+
+			storageClass, _ := throttler.admit(low)
+			Expect(storageClass).To(Equal("fast"))
+			now = now.Add(time.Second)
+			storageClass, _ = throttler.admit(critical)
+			Expect(storageClass).To(Equal("fast"))
+
+			Expect(vmiStore.Delete(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmi", Namespace: metav1.NamespaceDefault},
+			})).To(Succeed())
+
+			storageClass, _ = throttler.admit(low)
+			Expect(storageClass).To(Equal("fast"))
+			storageClass, _ = throttler.admit(critical)
+			Expect(storageClass).To(BeEmpty())
+		})
+
+		It("should admit VMs of the same priority in the order they started waiting", func() {
+			first := newVM("first", "pvc-first")
+			second := newVM("second", "pvc-second")
+
+			storageClass, _ := throttler.admit(second)
+			Expect(storageClass).To(Equal("fast"))
+			now = now.Add(time.Second)
+			storageClass, _ = throttler.admit(first)
+			Expect(storageClass).To(Equal("fast"))
+
+			Expect(vmiStore.Delete(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmi", Namespace: metav1.NamespaceDefault},
+			})).To(Succeed())
+
+			storageClass, _ = throttler.admit(first)
+			Expect(storageClass).To(Equal("fast"))
+			storageClass, _ = throttler.admit(second)
+			Expect(storageClass).To(BeEmpty())
+		})
+
+		It("should forget VMs which stopped waiting", func() {
+			critical := newVM("critical", "pvc-critical")
+			critical.Spec.Template.Spec.PriorityClassName = "critical"
+			storageClass, _ := throttler.admit(critical)
+			Expect(storageClass).To(Equal("fast"))
+
+			Expect(vmiStore.Delete(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "vmi", Namespace: metav1.NamespaceDefault},
+			})).To(Succeed())
+			now = now.Add(startWaiterExpiry + time.Second)
+
+			storageClass, _ = throttler.admit(newVM("low", "pvc-low"))
+			Expect(storageClass).To(BeEmpty())
+		})
+	})
+
+	Context("with a limit per namespace", func() {
+		const otherNamespace = "other"
+
+		BeforeEach(func() {
+			newThrottler(&v1.VMStartThrottlingConfiguration{MaxStartingPerStorageClass: 2, MaxStartingPerNamespace: 1})
+			addStartingVMI("vmi", "pvc", v1.Pending)
+		})
+
+		It("should throttle VMs of a namespace at its limit", func() {
+			storageClass, _ := throttler.admit(newVM("vm", "pvc-vm"))
+			Expect(storageClass).To(Equal("fast"))
+
+			storageClass, _ = throttler.admit(newVMIn(otherNamespace, "vm", "pvc-vm"))
+			Expect(storageClass).To(BeEmpty())
+		})
+
+		It("should not let VMs of a namespace at its limit hold back other namespaces", func() {
+			critical := newVM("critical", "pvc-critical")
+			critical.Spec.Template.Spec.PriorityClassName = "critical"
+			Expect(priorityClassStore.Add(&schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "critical"},
+				Value:      1000,
+			})).To(Succeed())
+			storageClass, _ := throttler.admit(critical)
+			Expect(storageClass).To(Equal("fast"))
+
+			storageClass, _ = throttler.admit(newVMIn(otherNamespace, "vm", "pvc-vm"))
+			Expect(storageClass).To(BeEmpty())
+		})
+	})
+})
@@ -61,12 +61,14 @@ import (
 	"k8s.io/utils/trace"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/defaults"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagehotplug "kubevirt.io/kubevirt/pkg/storage/hotplug"
 	"kubevirt.io/kubevirt/pkg/storage/memorydump"
@@ -78,6 +80,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
 )
 
 const (
@@ -137,6 +140,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	namespaceInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
+	vmPolicyInformer cache.SharedIndexInformer,
+	priorityClassInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -159,6 +164,7 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		namespaceStore:         namespaceInformer.GetStore(),
 		pvcStore:               pvcInformer.GetStore(),
 		crIndexer:              crInformer.GetIndexer(),
+		vmPolicyIndexer:        vmPolicyInformer.GetIndexer(),
 		instancetypeController: instancetypeController,
 		recorder:               recorder,
 		clientset:              clientset,
@@ -174,11 +180,13 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		additionalLauncherAnnotationsSync: additionalLauncherAnnotationsSync,
 		additionalLauncherLabelsSync:      additionalLauncherLabelsSync,
 	}
+	c.startThrottler = newStartThrottler(clusterConfig, c.vmiIndexer, c.pvcStore, c.dataVolumeStore, priorityClassInformer.GetStore())
 
 	c.hasSynced = func() bool {
 		return vmiInformer.HasSynced() && vmInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && dataSourceInformer.HasSynced() &&
-			pvcInformer.HasSynced() && crInformer.HasSynced()
+			pvcInformer.HasSynced() && crInformer.HasSynced() &&
+			vmPolicyInformer.HasSynced() && priorityClassInformer.HasSynced()
 	}
 
 	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -229,6 +237,15 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		return nil, err
 	}
 
+	_, err = vmPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleVMPolicy,
+		DeleteFunc: c.handleVMPolicy,
+		UpdateFunc: func(_, newObj interface{}) { c.handleVMPolicy(newObj) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -288,12 +305,14 @@ type Controller struct {
 	namespaceStore         cache.Store
 	pvcStore               cache.Store
 	crIndexer              cache.Indexer
+	vmPolicyIndexer        cache.Indexer
 	instancetypeController instancetypeHandler
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
 	dataVolumeExpectations *controller.UIDTrackingControllerExpectations
 	cloneAuthFunc          CloneAuthFunc
 	clusterConfig          *virtconfig.ClusterConfig
+	startThrottler         *startThrottler
 	hasSynced              func() bool
 
 	netSynchronizer      synchronizer
@@ -1254,10 +1273,22 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 		return vm, nil
 	}
 
+	if storageClass, newlyThrottled := c.startThrottler.admit(vm); storageClass != "" {
+		log.Log.Object(vm).V(4).Infof("Too many VMIs are starting on storage class %s, delaying start", storageClass)
+		if newlyThrottled {
+			c.recorder.Eventf(vm, k8score.EventTypeNormal, startThrottledReason, "Waiting for VirtualMachines on storage class %s to start", storageClass)
+		}
+		c.Queue.AddAfter(vmKey, startThrottleRetryInterval)
+		return vm, nil
+	}
+
 	vm = c.cleanupRestartRequired(vm)
 
 	// start it
 	vmi := SetupVMIFromVM(vm)
+	if c.clusterConfig.SealedVMDefaultsEnabled() {
+		setupSealedMACAddresses(vm, vmi)
+	}
 	vmRevisionName, err := c.createVMRevision(vm)
 	if err != nil {
 		log.Log.Object(vm).Reason(err).Error(failedCreateCRforVmErrMsg)
@@ -1287,7 +1318,11 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 
 	AutoAttachInputDevice(vmi)
 
-	err = netvmispec.SetDefaultNetworkInterface(c.clusterConfig, &vmi.Spec)
+	namespaceLabels, err := c.getNamespaceLabels(vmi.Namespace)
+	if err != nil {
+		return vm, err
+	}
+	err = netvmispec.SetDefaultNetworkInterfaceForNamespace(c.clusterConfig, &vmi.Spec, namespaceLabels)
 	if err != nil {
 		return vm, err
 	}
@@ -1321,6 +1356,15 @@ func (c *Controller) startVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine
 	return vm, nil
 }
 
+// getNamespaceLabels returns the labels of the namespace, or none when it is not yet in the cache.
+func (c *Controller) getNamespaceLabels(namespace string) (map[string]string, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(namespace)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*k8score.Namespace).Labels, nil
+}
+
 func setGenerationAnnotation(generation int64, annotations map[string]string) {
 	annotations[virtv1.VirtualMachineGenerationAnnotation] = strconv.FormatInt(generation, 10)
 }
@@ -1632,6 +1676,44 @@ func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineIn
 }
 
 // here is stop
+// inPowerOffWindow returns whether a power off window of the
+// VirtualMachinePolicies of the namespace of the VM is active. The VM is
+// requeued for the next start or end of a window.
+func (c *Controller) inPowerOffWindow(vm *virtv1.VirtualMachine, key string) bool {
+	if !c.clusterConfig.VirtualMachinePoliciesEnabled() {
+		return false
+	}
+
+	policies, err := vmpolicy.List(c.vmPolicyIndexer, vm.Namespace)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Errorf("Failed to list the VirtualMachinePolicies of namespace %s", vm.Namespace)
+		return false
+	}
+
+	now := time.Now()
+	active, next := vmpolicy.PowerOffWindowState(policies, now)
+	if !next.IsZero() {
+		c.Queue.AddAfter(key, next.Sub(now))
+	}
+	return active
+}
+
+// syncPowerOffWindow keeps the VM stopped during a power off window. Its run
+// strategy applies again once the window ends.
+func (c *Controller) syncPowerOffWindow(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, common.SyncError) {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return vm, nil
+	}
+
+	log.Log.Object(vm).Infof("%s due to a power off window", stoppingVmMsg)
+	vm, err := c.stopVMI(vm, vmi)
+	if err != nil {
+		log.Log.Object(vm).Errorf(failureDeletingVmiErrFormat, err)
+		return vm, common.NewSyncError(fmt.Errorf(failureDeletingVmiErrFormat, err), vmiFailedDeleteReason)
+	}
+	return vm, nil
+}
+
 func (c *Controller) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		// nothing to do
@@ -1860,6 +1942,7 @@ func SetupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
 		vmi.Spec = *memorydump.RemoveMemoryDumpVolumeFromVMISpec(&vmi.Spec, vm.Status.MemoryDumpRequest.ClaimName)
 	}
 
+	defaults.ApplySealedDefaults(&vmi.Spec, vm.Spec.Sealed)
 	setupStableFirmwareUUID(vm, vmi)
 
 	// TODO check if vmi labels exist, and when make sure that they match. For now just override them
@@ -1924,6 +2007,17 @@ func setupStableFirmwareUUID(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachi
 	vmi.Spec.Domain.Firmware.UUID = CalculateLegacyUUID(vmi.Name)
 }
 
+// setupSealedMACAddresses gives the interfaces which are not sealed yet the MAC address
+// the firmware synchronizer is going to seal, so that it does not change on the next start.
+func setupSealedMACAddresses(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	for i := range vmi.Spec.Domain.Devices.Interfaces {
+		iface := &vmi.Spec.Domain.Devices.Interfaces[i]
+		if defaults.NeedsSealedMACAddress(iface) {
+			iface.MacAddress = defaults.GenerateSealedMACAddress(vm, iface.Name)
+		}
+	}
+}
+
 // listControllerFromNamespace takes a namespace and returns all VirtualMachines
 // from the VirtualMachine cache which run in this namespace
 func (c *Controller) listControllerFromNamespace(namespace string) ([]*virtv1.VirtualMachine, error) {
@@ -2457,6 +2551,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	}
 
 	c.trimDoneVolumeRequests(vm)
+	c.remediateGuestWatchdogExpiry(vm, vmi)
 	memorydump.UpdateRequest(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
@@ -3185,7 +3280,11 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 	}
 
 	origRunStrategy := vm.Spec.RunStrategy
-	vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
+	if c.inPowerOffWindow(vm, key) {
+		vm, syncErr = c.syncPowerOffWindow(vm, vmi)
+	} else {
+		vm, syncErr = c.syncRunStrategy(vm, vmi, runStrategy)
+	}
 	if syncErr != nil {
 		return vm, vmi, syncErr, nil
 	}
@@ -3383,6 +3482,12 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return nil
 	}
 
+	if vmi.Spec.Domain.Memory.DIMMSlots != nil &&
+		vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Cmp(*vmi.Spec.Domain.Memory.Guest) == -1 {
+		setRestartRequired(vm, "memory updated in template spec to a lower value, DIMMs can not be hot unplugged")
+		return nil
+	}
+
 	// If the following is true, MaxGuest was calculated, not manually specified (or the validation webhook would have rejected the change).
 	// Since we're here, we can also assume MaxGuest was not changed in the VM spec since last boot.
 	// Therefore, bumping Guest to a value higher than MaxGuest is fine, it just requires a reboot.
@@ -3480,6 +3585,26 @@ func (c *Controller) handleKubeVirtUpdate(oldObj, newObj interface{}) {
 	}
 }
 
+// handleVMPolicy enqueues the VMs of the namespace of a VirtualMachinePolicy
+// so that changes to its power off windows take effect
+func (c *Controller) handleVMPolicy(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	policy, ok := obj.(*policyv1alpha1.VirtualMachinePolicy)
+	if !ok {
+		return
+	}
+
+	vmKeys, err := c.vmIndexer.IndexKeys(cache.NamespaceIndex, policy.Namespace)
+	if err != nil {
+		return
+	}
+	for _, vmKey := range vmKeys {
+		c.Queue.Add(vmKey)
+	}
+}
+
 func (c *Controller) handleNamespaceUpdate(oldObj, newObj interface{}) {
 	oldNS, ok := oldObj.(*k8score.Namespace)
 	if !ok {
@@ -16,6 +16,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +30,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/api"
 	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
@@ -84,6 +86,8 @@ var _ = Describe("VirtualMachine", func() {
 		var kvStore cache.Store
 		var virtFakeClient *fake.Clientset
 		var dataVolumeInformer cache.SharedIndexInformer
+		var vmPolicyInformer cache.SharedIndexInformer
+		var priorityClassInformer cache.SharedIndexInformer
 
 		BeforeEach(func() {
 			virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
@@ -108,6 +112,10 @@ var _ = Describe("VirtualMachine", func() {
 			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
 			pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			vmPolicyInformer, _ = testutils.NewFakeInformerWithIndexersFor(&policyv1alpha1.VirtualMachinePolicy{}, cache.Indexers{
+				cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			})
+			priorityClassInformer, _ = testutils.NewFakeInformerFor(&schedulingv1.PriorityClass{})
 
 			ns1 := &k8sv1.Namespace{
 				ObjectMeta: metav1.ObjectMeta{
@@ -147,6 +155,8 @@ var _ = Describe("VirtualMachine", func() {
 				namespaceInformer,
 				pvcInformer,
 				crInformer,
+				vmPolicyInformer,
+				priorityClassInformer,
 				recorder,
 				virtClient,
 				config,
@@ -1912,6 +1922,51 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(vmi.Status.VirtualMachineRevisionName).To(Equal(vmRevision.Name))
 		})
 
+		It("should delay the VMI creation while too many VMIs start on a storage class", func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						VMStartThrottling: &v1.VMStartThrottlingConfiguration{MaxStartingPerStorageClass: 1},
+					},
+				},
+			})
+			for _, name := range []string{"pvc1", "pvc2"} {
+				Expect(controller.pvcStore.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+					Spec:       k8sv1.PersistentVolumeClaimSpec{StorageClassName: pointer.P("fast")},
+					Status:     k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimBound},
+				})).To(Succeed())
+			}
+			startingVMI := libvmi.New(
+				libvmi.WithName("starting"),
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmi.WithPersistentVolumeClaim("disk0", "pvc1"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Scheduling))),
+			)
+			startingVMI.CreationTimestamp = metav1.Now()
+			Expect(controller.vmiIndexer.Add(startingVMI)).To(Succeed())
+
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Spec.Template.Spec.Volumes = []v1.Volume{{
+				Name: "disk0",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc2"},
+					},
+				},
+			}}
+			vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+			Expect(err).To(Succeed())
+			addVirtualMachine(vm)
+
+			sanityExecute(vm)
+
+			testutils.ExpectEvent(recorder, startThrottledReason)
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
 		It("should delete older vmRevision and create VMI with new one", func() {
 			vm, _ := watchtesting.DefaultVirtualMachine(true)
 			vm.Generation = 1
@@ -2637,6 +2692,80 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(err).To(MatchError(ContainSubstring("not found")))
 		})
 
+		Context("with power off windows", func() {
+			addPowerOffWindow := func(start, end time.Time) {
+				Expect(vmPolicyInformer.GetStore().Add(&policyv1alpha1.VirtualMachinePolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "power", Namespace: metav1.NamespaceDefault},
+					Spec: policyv1alpha1.VirtualMachinePolicySpec{
+						PowerOffWindows: []policyv1alpha1.PowerOffWindow{{
+							Start: start.UTC().Format("15:04"),
+							End:   end.UTC().Format("15:04"),
+						}},
+					},
+				})).To(Succeed())
+			}
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{featuregate.VirtualMachinePoliciesGate},
+							},
+						},
+					},
+				})
+			})
+
+			It("should stop the VirtualMachineInstance during a power off window", func() {
+				addPowerOffWindow(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should not start the VirtualMachineInstance during a power off window", func() {
+				addPowerOffWindow(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should start the VirtualMachineInstance outside of power off windows", func() {
+				addPowerOffWindow(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+				vm, _ := watchtesting.DefaultVirtualMachine(true)
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				sanityExecute(vm)
+
+				_, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+			})
+		})
+
 		It("should add controller finalizer if VirtualMachine does not have it", func() {
 			vm, _ := watchtesting.DefaultVirtualMachine(false)
 			vm.Finalizers = nil
@@ -5654,6 +5783,37 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(vmConditionController.HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeTrue())
 				})
 
+				It("should set a restartRequired condition if the memory plugged as DIMMs decreased", func() {
+					bootMemory := resource.MustParse("1Gi")
+					guestMemory := resource.MustParse("3Gi")
+					newMemory := resource.MustParse("2Gi")
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory, DIMMSlots: pointer.P(uint32(8))}
+					vm.Spec.Template.Spec.Architecture = "amd64"
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec, DIMMSlots: pointer.P(uint32(8))}
+					vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = guestMemory
+					vmi.Status.Memory = &v1.MemoryStatus{
+						GuestAtBoot:  &bootMemory,
+						GuestCurrent: &guestMemory,
+					}
+					vmi.Spec.Architecture = "amd64"
+					vmiCondManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+					vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionTrue,
+					})
+
+					err := controller.handleMemoryHotplugRequest(vm, vmi)
+					Expect(err).ToNot(HaveOccurred())
+
+					vmCondManager := virtcontroller.NewVirtualMachineConditionManager()
+					cond := vmCondManager.GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).To(Not(BeNil()))
+					Expect(cond.Message).To(ContainSubstring("DIMMs can not be hot unplugged"))
+				})
+
 				It("should set a restartRequired condition if VM does not support memory hotplug", func() {
 					guestMemory := resource.MustParse("2Gi")
 					newMemory := resource.MustParse("4Gi")
@@ -0,0 +1,67 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	k8score "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
+)
+
+// remediateGuestWatchdogExpiry carries out the watchdog remediations which act
+// on the VirtualMachine, once virt-handler reported the expiry on the VMI.
+func (c *Controller) remediateGuestWatchdogExpiry(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if err := memorydump.RequestOnWatchdogExpiry(c.clientset, vm, vmi, c.pvcStore); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to request a memory dump after the guest watchdog expired")
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, memorydump.ErrorReason,
+			"Failed to request a memory dump after the guest watchdog expired: %v", err)
+	}
+	requestRestartOnWatchdogExpiry(vm, vmi)
+}
+
+// requestRestartOnWatchdogExpiry restarts the VirtualMachine like the restart
+// subresource does, by requesting to stop the expired VMI and to start a new one.
+func requestRestartOnWatchdogExpiry(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return
+	}
+	watchdog := vmi.Spec.Domain.Devices.Watchdog
+	if watchdog == nil || watchdog.Remediation == nil || watchdog.Remediation.Type != virtv1.WatchdogRemediationRestartVM {
+		return
+	}
+	if !controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi,
+		virtv1.VirtualMachineInstanceGuestWatchdogExpired, k8score.ConditionTrue) {
+		return
+	}
+	// a pending request is either the restart itself or takes precedence over it
+	if len(vm.Status.StateChangeRequests) > 0 {
+		return
+	}
+	runStrategy, err := vm.RunStrategy()
+	if err != nil || runStrategy == virtv1.RunStrategyHalted || runStrategy == virtv1.RunStrategyOnce {
+		return
+	}
+
+	log.Log.Object(vm).Infof("Restarting the VM after the guest watchdog of VMI %s expired", vmi.UID)
+	vm.Status.StateChangeRequests = append(vm.Status.StateChangeRequests,
+		virtv1.VirtualMachineStateChangeRequest{Action: virtv1.StopRequest, UID: &vmi.UID},
+		virtv1.VirtualMachineStateChangeRequest{Action: virtv1.StartRequest})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Guest watchdog remediation", func() {
+	const vmiUID = types.UID("vmi-uid")
+
+	newExpiredVMI := func(remediation v1.WatchdogRemediationType, expired k8sv1.ConditionStatus) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithNamespace("test-ns"))
+		vmi.UID = vmiUID
+		vmi.Status.Phase = v1.Running
+		vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+			Name: "watchdog",
+			WatchdogDevice: v1.WatchdogDevice{
+				I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionPause},
+			},
+			Remediation: &v1.WatchdogRemediation{Type: remediation},
+		}
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceGuestWatchdogExpired,
+			Status: expired,
+		}}
+		return vmi
+	}
+
+	newVM := func(runStrategy v1.VirtualMachineRunStrategy) *v1.VirtualMachine {
+		return &v1.VirtualMachine{Spec: v1.VirtualMachineSpec{RunStrategy: &runStrategy}}
+	}
+
+	It("should request to stop the expired VMI and to start a new one", func() {
+		vm := newVM(v1.RunStrategyAlways)
+		requestRestartOnWatchdogExpiry(vm, newExpiredVMI(v1.WatchdogRemediationRestartVM, k8sv1.ConditionTrue))
+
+		Expect(vm.Status.StateChangeRequests).To(HaveLen(2))
+		Expect(vm.Status.StateChangeRequests[0].Action).To(Equal(v1.StopRequest))
+		Expect(vm.Status.StateChangeRequests[0].UID).To(HaveValue(Equal(vmiUID)))
+		Expect(vm.Status.StateChangeRequests[1].Action).To(Equal(v1.StartRequest))
+	})
+
+	It("should not request the restart twice", func() {
+		vm := newVM(v1.RunStrategyAlways)
+		vmi := newExpiredVMI(v1.WatchdogRemediationRestartVM, k8sv1.ConditionTrue)
+		requestRestartOnWatchdogExpiry(vm, vmi)
+		requestRestartOnWatchdogExpiry(vm, vmi)
+
+		Expect(vm.Status.StateChangeRequests).To(HaveLen(2))
+	})
+
+	DescribeTable("should not request a restart", func(runStrategy v1.VirtualMachineRunStrategy, remediation v1.WatchdogRemediationType, expired k8sv1.ConditionStatus) {
+		vm := newVM(runStrategy)
+		requestRestartOnWatchdogExpiry(vm, newExpiredVMI(remediation, expired))
+
+		Expect(vm.Status.StateChangeRequests).To(BeEmpty())
+	},
+		Entry("before the watchdog expired", v1.RunStrategyAlways, v1.WatchdogRemediationRestartVM, k8sv1.ConditionFalse),
+		Entry("with the MemoryDump remediation", v1.RunStrategyAlways, v1.WatchdogRemediationMemoryDump, k8sv1.ConditionTrue),
+		Entry("with the Halted run strategy", v1.RunStrategyHalted, v1.WatchdogRemediationRestartVM, k8sv1.ConditionTrue),
+		Entry("with the Once run strategy", v1.RunStrategyOnce, v1.WatchdogRemediationRestartVM, k8sv1.ConditionTrue),
+	)
+})
@@ -57,6 +57,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/warmpool"
 )
 
 func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, dataVolumes []*cdiv1.DataVolume) (common.SyncError, *k8sv1.Pod) {
@@ -159,6 +160,12 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		}
 
 		vmiKey := controller.VirtualMachineInstanceKey(vmi)
+		if !isWaitForFirstConsumer && c.clusterConfig.LauncherWarmPoolEnabled() {
+			if pod := c.adoptWarmPod(vmiKey, vmi, templatePod); pod != nil {
+				c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.SuccessfulAdoptWarmPodReason, "Adopted virtual machine pod %s of a launcher warm pool", pod.Name)
+				return nil, pod
+			}
+		}
 		pod, err := c.createPod(vmiKey, vmi.Namespace, templatePod)
 		if k8serrors.IsForbidden(err) && strings.Contains(err.Error(), "violates PodSecurity") {
 			psaErr := fmt.Errorf("failed to create pod for vmi %s/%s, it needs a privileged namespace to run: %w", vmi.GetNamespace(), vmi.GetName(), err)
@@ -388,6 +395,30 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 					return err
 				}
 
+				// Only hand the vmi over once the volume scanner allowed all of its volumes
+				if c.clusterConfig.VolumeScanEnabled() {
+					scanned, rejection, err := c.scanVolumes(vmiCopy, pod)
+					if err != nil {
+						c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.FailedVolumeScanReason, "Failed to scan the volumes: %v", err)
+						return err
+					}
+					if rejection != "" {
+						c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.VolumeScanRejectedReason, rejection)
+						vmiCopy.Status.Phase = virtv1.Failed
+						conditionManager.UpdateCondition(vmiCopy, &virtv1.VirtualMachineInstanceCondition{
+							Type:               virtv1.VirtualMachineInstanceVolumeScanFailed,
+							Status:             k8sv1.ConditionTrue,
+							LastTransitionTime: v1.Now(),
+							Reason:             virtv1.VirtualMachineInstanceReasonVolumeRejected,
+							Message:            rejection,
+						})
+						break
+					}
+					if !scanned {
+						break
+					}
+				}
+
 				// Network
 				if err := c.updateNetworkStatus(vmiCopy, pod); err != nil {
 					log.Log.Errorf("failed to update the interface status: %v", err)
@@ -483,6 +514,8 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 
 		c.checkEphemeralHotplugVolumes(vmiCopy)
 
+		c.syncDiskExpansionRequests(vmiCopy)
+
 	case vmi.IsScheduled():
 		if !vmiPodExists {
 			if vmiCopy.IsDecentralizedMigration() && vmiCopy.IsMigrationTarget() {
@@ -536,6 +569,7 @@ func (c *Controller) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1
 	} else {
 		conditionManager.RemoveCondition(vmiCopy, virtv1.VirtualMachineInstanceEvictionRequested)
 	}
+	syncMigrationBlockedCondition(vmiCopy, conditionManager)
 
 	// VMI is owned by virt-handler, so patch instead of update
 	if vmi.IsRunning() || vmi.IsScheduled() {
@@ -597,6 +631,17 @@ func prepareVMIPatch(oldVMI, newVMI *virtv1.VirtualMachineInstance) *patch.Patch
 		}
 		log.Log.V(3).Object(oldVMI).Infof("Patching Volume Status")
 	}
+	if !equality.Semantic.DeepEqual(newVMI.Status.DiskExpansionRequests, oldVMI.Status.DiskExpansionRequests) {
+		if oldVMI.Status.DiskExpansionRequests == nil {
+			patchSet.AddOption(patch.WithAdd("/status/diskExpansionRequests", newVMI.Status.DiskExpansionRequests))
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/status/diskExpansionRequests", oldVMI.Status.DiskExpansionRequests),
+				patch.WithReplace("/status/diskExpansionRequests", newVMI.Status.DiskExpansionRequests),
+			)
+		}
+		log.Log.V(3).Object(oldVMI).Infof("Patching disk expansion requests")
+	}
 	// We don't own the object anymore, so patch instead of update
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if !vmiConditions.ConditionsEqual(oldVMI, newVMI) {
@@ -990,6 +1035,36 @@ func (c *Controller) createPod(key, namespace string, pod *k8sv1.Pod) (*k8sv1.Po
 	return pod, err
 }
 
+// adoptWarmPod hands an idle pod of a launcher warm pool, which is compatible
+// with the launcher pod of the VMI, over to the VMI. It returns nil if there
+// is no such pod, or if other VMIs were faster.
+func (c *Controller) adoptWarmPod(key string, vmi *virtv1.VirtualMachineInstance, templatePod *k8sv1.Pod) *k8sv1.Pod {
+	objs, err := c.podIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil
+	}
+	for _, obj := range objs {
+		warmPod := obj.(*k8sv1.Pod)
+		if !warmpool.IsIdle(warmPod) || !warmpool.IsCompatible(templatePod, warmPod) {
+			continue
+		}
+		patch, err := warmpool.AdoptionPatch(templatePod, warmPod, vmi)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to generate the patch to adopt a warm pod")
+			return nil
+		}
+		c.podExpectations.ExpectCreations(key, 1)
+		pod, err := c.clientset.CoreV1().Pods(warmPod.Namespace).Patch(context.Background(), warmPod.Name, types.MergePatchType, patch, v1.PatchOptions{})
+		if err != nil {
+			c.podExpectations.CreationObserved(key)
+			log.Log.Object(vmi).Reason(err).V(3).Infof("Failed to adopt warm pod %s", warmPod.Name)
+			continue
+		}
+		return pod
+	}
+	return nil
+}
+
 func isTempPod(pod *k8sv1.Pod) bool {
 	_, ok := pod.Annotations[virtv1.EphemeralProvisioningObject]
 	return ok
@@ -1067,6 +1142,10 @@ func (c *Controller) requireMemoryHotplug(vmi *virtv1.VirtualMachineInstance) bo
 	if vmi.Status.Memory == nil || vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil || vmi.Spec.Domain.Memory.MaxGuest == nil {
 		return false
 	}
+	// virtio-mem devices are shrunk in place by virt-handler, only growing the memory requires a migration
+	if vmi.Spec.Domain.Memory.DIMMSlots == nil && vmi.Spec.Domain.Memory.Guest.Cmp(*vmi.Status.Memory.GuestRequested) < 0 {
+		return false
+	}
 	return vmi.Spec.Domain.Memory.Guest.Value() != vmi.Status.Memory.GuestRequested.Value()
 }
 
@@ -1119,6 +1198,38 @@ func (c *Controller) syncMigrationRequiredCondition(vmi *virtv1.VirtualMachineIn
 	c.Queue.AddAfter(key, pendingMigrationReEvalPeriod)
 }
 
+// syncMigrationBlockedCondition reports VMIs which have to be migrated, because
+// they are evicted or a live update requires it, but are not live migratable.
+// The reason and message are taken from the LiveMigratable condition.
+func syncMigrationBlockedCondition(vmi *virtv1.VirtualMachineInstance, cm *controller.VirtualMachineInstanceConditionManager) {
+	migrationNeeded := cm.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceEvictionRequested, k8sv1.ConditionTrue) ||
+		cm.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMigrationRequired, k8sv1.ConditionTrue)
+	migratable := cm.GetCondition(vmi, virtv1.VirtualMachineInstanceIsMigratable)
+	if !migrationNeeded || migratable == nil || migratable.Status != k8sv1.ConditionFalse {
+		cm.RemoveCondition(vmi, virtv1.VirtualMachineInstanceMigrationBlocked)
+		return
+	}
+
+	reason := migratable.Reason
+	if reason == "" {
+		reason = virtv1.VirtualMachineInstanceReasonNotMigratable
+	}
+	existing := cm.GetCondition(vmi, virtv1.VirtualMachineInstanceMigrationBlocked)
+	if existing != nil && existing.Reason == reason && existing.Message == migratable.Message {
+		return
+	}
+	cm.RemoveCondition(vmi, virtv1.VirtualMachineInstanceMigrationBlocked)
+	now := v1.Now()
+	cm.UpdateCondition(vmi, &virtv1.VirtualMachineInstanceCondition{
+		Type:               virtv1.VirtualMachineInstanceMigrationBlocked,
+		Status:             k8sv1.ConditionTrue,
+		Reason:             reason,
+		Message:            migratable.Message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
 func newMigrationRequiredCondition(status k8sv1.ConditionStatus) *virtv1.VirtualMachineInstanceCondition {
 	reason := virtv1.VirtualMachineInstanceReasonAutoMigrationDueToLiveUpdate
 	if status == k8sv1.ConditionFalse {
@@ -20,6 +20,7 @@
 package vmi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -28,12 +29,15 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -178,6 +182,10 @@ func (c *Controller) processHotplugVolumeStatus(
 			statusCopy.HotplugVolume.AttachPodUID = ""
 			// Volume is not hotplugged in VM and Pod is gone, or hasn't been created yet, check for the PVC associated with the volume to set phase and message
 			phase, reason, message := c.getVolumePhaseMessageReason(pvcName, vmi.Namespace)
+			if pvcName == "" {
+				// ConfigMaps, Secrets and ServiceAccounts are only waiting for the attachment pod
+				phase, reason, message = virtv1.VolumePending, "", fmt.Sprintf("Waiting for hotplug attachment pod, for volume %s", volumeName)
+			}
 			statusCopy.Phase = phase
 			log.Log.V(3).Infof("Setting phase %s for volume %s", phase, volumeName)
 			statusCopy.Message = message
@@ -260,6 +268,8 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 
 	attachmentPod, _ := getActiveAndOldAttachmentPods(hotplugVolumes, attachmentPods)
 
+	containerDiskImageIDs := c.containerDiskImageIDs(vmi, virtlauncherPod)
+
 	newStatus := make([]virtv1.VolumeStatus, 0)
 
 	backendStoragePVC := backendstorage.PVCForVMI(c.pvcIndexer, vmi)
@@ -295,6 +305,9 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 				return err
 			}
 		}
+		if imageID, ok := containerDiskImageIDs[volume.Name]; ok {
+			setContainerDiskImageID(&status, imageID)
+		}
 
 		newStatus = append(newStatus, status)
 	}
@@ -344,6 +357,35 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 	return nil
 }
 
+// containerDiskImageIDs returns the containerDisk images pinned by the digests
+// the launcher pod pulled. virt-handler verifies the signatures of exactly
+// these images, it can't look them up itself.
+func (c *Controller) containerDiskImageIDs(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) map[string]string {
+	if !c.clusterConfig.ContainerDiskVerificationEnabled() || pod == nil {
+		return nil
+	}
+	imageIDs, err := containerdisk.ExtractImageIDsFromSourcePod(vmi, pod, c.clusterConfig.ImageVolumeEnabled())
+	if err != nil {
+		// The digests are known once the containers started, the pod update will trigger another sync
+		log.Log.Object(vmi).V(4).Infof("containerDisk digests are not known yet: %v", err)
+		return nil
+	}
+	return imageIDs
+}
+
+func setContainerDiskImageID(status *virtv1.VolumeStatus, imageID string) {
+	if !strings.Contains(imageID, "@sha256:") {
+		return
+	}
+	if status.ContainerDiskVolume == nil {
+		status.ContainerDiskVolume = &virtv1.ContainerDiskInfo{}
+	}
+	// The image of a running VMI never changes, keep the digest virt-handler verified
+	if status.ContainerDiskVolume.ImageID == "" {
+		status.ContainerDiskVolume.ImageID = imageID
+	}
+}
+
 func (c *Controller) checkEphemeralHotplugVolumes(vmi *virtv1.VirtualMachineInstance) {
 	vm := c.getOwnerVM(vmi)
 	if vmi == nil || vm == nil {
@@ -471,3 +513,77 @@ func (c *Controller) requireVolumesUpdate(vmi *virtv1.VirtualMachineInstance) bo
 
 	return false
 }
+
+// syncDiskExpansionRequests handles the pending disk expansion requests the
+// guest sent through virt-handler and records the outcome on each of them.
+func (c *Controller) syncDiskExpansionRequests(vmi *virtv1.VirtualMachineInstance) {
+	for i := range vmi.Status.DiskExpansionRequests {
+		request := &vmi.Status.DiskExpansionRequests[i]
+		if request.Phase != virtv1.DiskExpansionRequestPending {
+			continue
+		}
+		if err := c.expandGuestDisk(vmi, request); err != nil {
+			request.Phase = virtv1.DiskExpansionRequestRejected
+			request.Message = err.Error()
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, controller.DiskExpansionRejectedReason, "Rejected the expansion of disk %s to %s: %v", request.Name, request.Size.String(), err)
+			continue
+		}
+		request.Phase = virtv1.DiskExpansionRequestAccepted
+		request.Message = ""
+		c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, controller.DiskExpansionAcceptedReason, "Expanded disk %s to %s", request.Name, request.Size.String())
+	}
+}
+
+func (c *Controller) expandGuestDisk(vmi *virtv1.VirtualMachineInstance, request *virtv1.DiskExpansionRequest) error {
+	if !c.clusterConfig.GuestDiskExpansionEnabled() {
+		return fmt.Errorf("guest disk expansion is not enabled")
+	}
+	if vmi.Annotations[virtv1.AllowGuestDiskExpansionAnnotation] != "true" {
+		return fmt.Errorf("guest disk expansion is not allowed for this VMI")
+	}
+	maxSize := c.clusterConfig.GetGuestDiskExpansionMaxSize()
+	if maxSize == nil {
+		return fmt.Errorf("no guest disk expansion quota is configured")
+	}
+	if request.Size.Cmp(*maxSize) > 0 {
+		return fmt.Errorf("the requested size exceeds the quota of %s", maxSize.String())
+	}
+
+	var claimName string
+	for i := range vmi.Spec.Volumes {
+		if vmi.Spec.Volumes[i].Name == request.Name {
+			claimName = storagetypes.PVCNameFromVirtVolume(&vmi.Spec.Volumes[i])
+			break
+		}
+	}
+	if claimName == "" {
+		return fmt.Errorf("volume %s is not backed by a PVC", request.Name)
+	}
+	obj, exists, err := c.pvcIndexer.GetByKey(controller.NamespacedKey(vmi.Namespace, claimName))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("PVC %s does not exist", claimName)
+	}
+	pvc := obj.(*k8sv1.PersistentVolumeClaim)
+
+	currentSize, ok := pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+	if !ok {
+		return fmt.Errorf("PVC %s has no storage request", claimName)
+	}
+	if request.Size.Cmp(currentSize) <= 0 {
+		return nil
+	}
+	payload, err := patch.New(
+		patch.WithTest("/spec/resources/requests/storage", currentSize),
+		patch.WithReplace("/spec/resources/requests/storage", request.Size),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, payload, v1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to expand PVC %s: %v", claimName, err)
+	}
+	return nil
+}
@@ -39,6 +39,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/scan"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -103,6 +104,7 @@ func NewController(templateService templateService,
 		netMigrationEvaluator:             netMigrationEvaluator,
 		additionalLauncherAnnotationsSync: additionalLauncherAnnotationsSync,
 		additionalLauncherLabelsSync:      additionalLauncherLabelsSync,
+		volumeScanner:                     scan.NewScanner(clusterConfig),
 	}
 
 	c.hasSynced = func() bool {
@@ -228,6 +230,7 @@ type Controller struct {
 	netMigrationEvaluator             migrationEvaluator
 	additionalLauncherAnnotationsSync []string
 	additionalLauncherLabelsSync      []string
+	volumeScanner                     volumeScanner
 }
 
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
@@ -417,6 +420,12 @@ func (c *Controller) updatePod(old, cur interface{}) {
 	if vmi == nil {
 		return
 	}
+	if oldControllerRef == nil && curControllerRef != nil {
+		// An idle pod of a launcher warm pool was adopted
+		if vmiKey, err := controller.KeyFunc(vmi); err == nil {
+			c.podExpectations.CreationObserved(vmiKey)
+		}
+	}
 	log.Log.V(4).Object(curPod).Infof("Pod updated")
 	c.enqueueVirtualMachine(vmi)
 }
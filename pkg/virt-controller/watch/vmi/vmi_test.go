@@ -60,9 +60,12 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/scan"
+	scanv1alpha1 "kubevirt.io/kubevirt/pkg/storage/scan/v1alpha1"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
@@ -922,6 +925,54 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		}))))
 	})
 
+	Context("with launcher warm pools", func() {
+		newWarmPod := func(vmi *virtv1.VirtualMachineInstance) *k8sv1.Pod {
+			pod, err := controller.templateService.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			pod.Name = "virt-launcher-warm-small-abcde"
+			pod.Namespace = vmi.Namespace
+			pod.OwnerReferences = nil
+			delete(pod.Labels, virtv1.CreatedByLabel)
+			pod.Labels[virtv1.LauncherWarmPoolLabel] = "small"
+			pod.Status.Phase = k8sv1.PodRunning
+			return pod
+		}
+
+		BeforeEach(func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.LauncherWarmPoolGate}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		})
+
+		It("should adopt a compatible idle pod instead of creating one", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			addPod(newWarmPod(vmi))
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulAdoptWarmPodReason)
+			expectMatchingPodCreation(vmi, SatisfyAll(
+				HaveField("Name", "virt-launcher-warm-small-abcde"),
+				HaveField("Labels", Not(HaveKey(virtv1.LauncherWarmPoolLabel))),
+				HaveField("Annotations", HaveKeyWithValue(virtv1.LauncherWarmPoolIdentityAnnotation, "default/testvmi/"+string(vmi.UID))),
+			))
+		})
+
+		It("should create a pod if no idle pod is compatible", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			warmPod := newWarmPod(vmi)
+			warmPod.Spec.NodeSelector = map[string]string{"zone": "a"}
+			addPod(warmPod)
+			addVirtualMachine(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, kvcontroller.SuccessfulCreatePodReason)
+			expectMatchingPodCreation(vmi, Not(HaveField("Name", warmPod.Name)))
+		})
+	})
+
 	Context("On valid VirtualMachineInstance given", func() {
 		It("should create a corresponding Pod on VirtualMachineInstance creation with proper annotation", func() {
 			vmi := newPendingVirtualMachine("testvmi")
@@ -2530,6 +2581,37 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				)
 			})
 
+			It("should not add MemoryChange condition when the guest memory shrinks", func() {
+				bootGuestMemory := resource.MustParse("128Mi")
+				currentGuestMemory := resource.MustParse("512Mi")
+				requestedGuestMemory := resource.MustParse("256Mi")
+
+				vmi := newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+				vmi.Status.Memory = &virtv1.MemoryStatus{
+					GuestAtBoot:    &bootGuestMemory,
+					GuestCurrent:   &currentGuestMemory,
+					GuestRequested: &currentGuestMemory,
+				}
+				vmi.Spec.Domain.Memory = &virtv1.Memory{
+					Guest:    &requestedGuestMemory,
+					MaxGuest: &currentGuestMemory,
+				}
+
+				pod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				addActivePods(vmi, pod.UID, "")
+
+				addVirtualMachine(vmi)
+				addPod(pod)
+
+				sanityExecute()
+				expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, Not(ContainElement(MatchFields(IgnoreExtras,
+					Fields{
+						"Type": BeEquivalentTo(virtv1.VirtualMachineInstanceMemoryChange),
+					}))),
+				)
+			})
+
 			It("should store guestMemoryOverheadRatio if used during memory hotplug", func() {
 				currentGuestMemory := resource.MustParse("128Mi")
 				requestedGuestMemory := resource.MustParse("512Mi")
@@ -2838,6 +2920,20 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			return makePodWithVirtlauncher(virtlauncherPod, indexes...)
 		}
 
+		makeConfigMapPods := func(indexes ...int) []*k8sv1.Pod {
+			attachmentPods := makePods(indexes...)
+			for _, pod := range attachmentPods {
+				for i := range pod.Spec.Volumes {
+					if pod.Spec.Volumes[i].PersistentVolumeClaim != nil {
+						pod.Spec.Volumes[i].VolumeSource = k8sv1.VolumeSource{
+							ConfigMap: &k8sv1.ConfigMapVolumeSource{},
+						}
+					}
+				}
+			}
+			return attachmentPods
+		}
+
 		makePodsWithDeletion := func(indexes ...int) []*k8sv1.Pod {
 			attachmentPods := makePods(indexes...)
 			for _, pod := range attachmentPods {
@@ -3265,6 +3361,17 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 					Name: "volume1",
 				},
 			}, []*k8sv1.Pod{makePods(0)[0], makePods(1)[0]}, makePods(1)[0], makePods(0)),
+			Entry("matching config volume, single attachmentPod", []*virtv1.Volume{
+				{
+					Name: "volume0",
+					VolumeSource: virtv1.VolumeSource{
+						ConfigMap: &virtv1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "configmap0"},
+							Hotpluggable:         true,
+						},
+					},
+				},
+			}, makeConfigMapPods(0), makeConfigMapPods(0)[0], []*k8sv1.Pod{}),
 		)
 
 		It("Should get default filesystem overhead if there are multiple CDI instances", func() {
@@ -4393,6 +4500,291 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		)
 	})
 
+	Context("guest disk expansion", func() {
+		var vmi *virtv1.VirtualMachineInstance
+
+		enableGuestDiskExpansion := func(maxSize *resource.Quantity) {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.GuestDiskExpansionGate}
+			kvCR.Spec.Configuration.GuestDiskExpansion = &virtv1.GuestDiskExpansionConfiguration{MaxSize: maxSize}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		}
+
+		addClaim := func(name string, size string) {
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vmi.Namespace},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+			}
+			addDataVolumePVC(pvc)
+		}
+
+		claimSize := func(name string) resource.Quantity {
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+		}
+
+		BeforeEach(func() {
+			vmi = newPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi.Annotations = map[string]string{virtv1.AllowGuestDiskExpansionAnnotation: "true"}
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+				Name: "data",
+				VolumeSource: virtv1.VolumeSource{
+					PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+					},
+				},
+			})
+			vmi.Status.DiskExpansionRequests = []virtv1.DiskExpansionRequest{{
+				Name:  "data",
+				Size:  resource.MustParse("2Gi"),
+				Phase: virtv1.DiskExpansionRequestPending,
+			}}
+			addClaim("data-pvc", "1Gi")
+		})
+
+		It("should expand the PVC when the request fits into the quota", func() {
+			enableGuestDiskExpansion(pointer.P(resource.MustParse("10Gi")))
+
+			controller.syncDiskExpansionRequests(vmi)
+
+			testutils.ExpectEvent(recorder, kvcontroller.DiskExpansionAcceptedReason)
+			Expect(vmi.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestAccepted))
+			size := claimSize("data-pvc")
+			Expect(size.Cmp(resource.MustParse("2Gi"))).To(BeZero())
+		})
+
+		It("should not shrink the PVC", func() {
+			enableGuestDiskExpansion(pointer.P(resource.MustParse("10Gi")))
+			vmi.Status.DiskExpansionRequests[0].Size = resource.MustParse("512Mi")
+
+			controller.syncDiskExpansionRequests(vmi)
+
+			testutils.ExpectEvent(recorder, kvcontroller.DiskExpansionAcceptedReason)
+			Expect(vmi.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestAccepted))
+			size := claimSize("data-pvc")
+			Expect(size.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+		})
+
+		It("should leave handled requests alone", func() {
+			enableGuestDiskExpansion(pointer.P(resource.MustParse("10Gi")))
+			vmi.Status.DiskExpansionRequests[0].Phase = virtv1.DiskExpansionRequestRejected
+
+			controller.syncDiskExpansionRequests(vmi)
+
+			Expect(vmi.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestRejected))
+			size := claimSize("data-pvc")
+			Expect(size.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+		})
+
+		DescribeTable("should reject the request", func(setup func(), message string) {
+			setup()
+
+			controller.syncDiskExpansionRequests(vmi)
+
+			testutils.ExpectEvent(recorder, kvcontroller.DiskExpansionRejectedReason)
+			Expect(vmi.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestRejected))
+			Expect(vmi.Status.DiskExpansionRequests[0].Message).To(ContainSubstring(message))
+			size := claimSize("data-pvc")
+			Expect(size.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+		},
+			Entry("when the feature gate is disabled", func() {}, "not enabled"),
+			Entry("when no quota is configured", func() { enableGuestDiskExpansion(nil) }, "no guest disk expansion quota"),
+			Entry("when the size exceeds the quota", func() {
+				enableGuestDiskExpansion(pointer.P(resource.MustParse("1500Mi")))
+			}, "exceeds the quota"),
+			Entry("when the VMI does not opt in", func() {
+				enableGuestDiskExpansion(pointer.P(resource.MustParse("10Gi")))
+				vmi.Annotations = nil
+			}, "not allowed"),
+			Entry("when the volume is not backed by a PVC", func() {
+				enableGuestDiskExpansion(pointer.P(resource.MustParse("10Gi")))
+				vmi.Status.DiskExpansionRequests[0].Name = "unknown"
+			}, "not backed by a PVC"),
+		)
+	})
+
+	Context("containerDisk verification", func() {
+		const digest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+		var (
+			vmi *virtv1.VirtualMachineInstance
+			pod *k8sv1.Pod
+		)
+
+		enableContainerDiskVerification := func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.ContainerDiskVerificationGate}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+		}
+
+		BeforeEach(func() {
+			vmi = newPendingVirtualMachine("testvmi")
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					ContainerDisk: &virtv1.ContainerDiskSource{Image: "quay.io/containerdisks/fedora:latest"},
+				},
+			}}
+			pod = newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name:    "volumedisk0",
+				ImageID: "quay.io/containerdisks/fedora@" + digest,
+			}}
+		})
+
+		It("should record the digest of the pulled image", func() {
+			enableContainerDiskVerification()
+			Expect(controller.updateVolumeStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume).ToNot(BeNil())
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume.ImageID).To(Equal("quay.io/containerdisks/fedora@" + digest))
+		})
+
+		It("should keep the recorded digest and the checksum", func() {
+			enableContainerDiskVerification()
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{{
+				Name: "disk0",
+				ContainerDiskVolume: &virtv1.ContainerDiskInfo{
+					Checksum: 1234,
+					ImageID:  "quay.io/containerdisks/fedora@sha256:recorded",
+				},
+			}}
+			Expect(controller.updateVolumeStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume.Checksum).To(Equal(uint32(1234)))
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume.ImageID).To(Equal("quay.io/containerdisks/fedora@sha256:recorded"))
+		})
+
+		It("should not record a digest before the container started", func() {
+			enableContainerDiskVerification()
+			pod.Status.ContainerStatuses = nil
+			Expect(controller.updateVolumeStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume).To(BeNil())
+		})
+
+		It("should not record the digest if the feature gate is disabled", func() {
+			Expect(controller.updateVolumeStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.VolumeStatus[0].ContainerDiskVolume).To(BeNil())
+		})
+	})
+
+	Context("volume scan", func() {
+		const digest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+		var (
+			vmi     *virtv1.VirtualMachineInstance
+			pod     *k8sv1.Pod
+			scanner *fakeVolumeScanner
+		)
+
+		BeforeEach(func() {
+			kvCR := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+			kvCR.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{featuregate.VolumeScanGate}
+			kvCR.Spec.Configuration.VolumeScan = &virtv1.VolumeScanConfiguration{Endpoint: "scanner:9000"}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvCR)
+
+			scanner = &fakeVolumeScanner{
+				verdicts: map[string]*scan.Verdict{},
+				requests: map[string]*scanv1alpha1.ScanRequest{},
+			}
+			controller.volumeScanner = scanner
+
+			vmi = newPendingVirtualMachine("testvmi")
+			setReadyCondition(vmi, k8sv1.ConditionFalse, virtv1.GuestNotRunningReason)
+			vmi.Status.Phase = virtv1.Scheduling
+			vmi.Spec.Volumes = []virtv1.Volume{{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					ContainerDisk: &virtv1.ContainerDiskSource{Image: "quay.io/containerdisks/fedora:latest"},
+				},
+			}}
+			pod = newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, k8sv1.ContainerStatus{
+				Name:    "volumedisk0",
+				ImageID: "quay.io/containerdisks/fedora@" + digest,
+				Ready:   true,
+				State:   k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			})
+		})
+
+		It("should keep the vmi scheduling while the scan is pending", func() {
+			addVirtualMachine(vmi)
+			addPod(pod)
+			addActivePods(vmi, pod.UID, "")
+
+			sanityExecute()
+			expectVMIBeInPhase(vmi.Namespace, vmi.Name, virtv1.Scheduling)
+			Expect(scanner.requests).To(HaveKeyWithValue("image/"+digest, &scanv1alpha1.ScanRequest{
+				Namespace: vmi.Namespace,
+				VMI:       vmi.Name,
+				Volume:    "disk0",
+				Image:     "quay.io/containerdisks/fedora@" + digest,
+			}))
+		})
+
+		It("should hand the vmi over once the scanner allowed the volumes", func() {
+			scanner.verdicts["image/"+digest] = &scan.Verdict{Allowed: true}
+			addVirtualMachine(vmi)
+			addPod(pod)
+			addActivePods(vmi, pod.UID, "")
+
+			sanityExecute()
+			expectVMIScheduledState(vmi)
+		})
+
+		It("should fail the vmi if the scanner rejected a volume", func() {
+			scanner.verdicts["image/"+digest] = &scan.Verdict{Allowed: false, Message: "malware found"}
+			addVirtualMachine(vmi)
+			addPod(pod)
+			addActivePods(vmi, pod.UID, "")
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, kvcontroller.VolumeScanRejectedReason)
+			expectVMIBeInPhase(vmi.Namespace, vmi.Name, virtv1.Failed)
+			expectVMIWithMatcherConditions(vmi.Namespace, vmi.Name, ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(virtv1.VirtualMachineInstanceVolumeScanFailed),
+				"Status":  Equal(k8sv1.ConditionTrue),
+				"Reason":  Equal(virtv1.VirtualMachineInstanceReasonVolumeRejected),
+				"Message": ContainSubstring("malware found"),
+			})))
+		})
+
+		It("should scan the PVC of a DataVolume", func() {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+				Name: "data",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{Name: "data-dv"},
+				},
+			})
+			pvc := newPvc(vmi.Namespace, "data-dv")
+			pvc.UID = "pvc-uid"
+			addDataVolumePVC(pvc)
+			scanner.verdicts["image/"+digest] = &scan.Verdict{Allowed: true}
+
+			scanned, rejection, err := controller.scanVolumes(vmi, pod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scanned).To(BeFalse())
+			Expect(rejection).To(BeEmpty())
+			Expect(scanner.requests).To(HaveKeyWithValue("pvc/pvc-uid", &scanv1alpha1.ScanRequest{
+				Namespace: vmi.Namespace,
+				VMI:       vmi.Name,
+				Volume:    "data",
+				ClaimName: "data-dv",
+			}))
+		})
+
+		It("should pass on scanner errors", func() {
+			scanner.err = fmt.Errorf("scanner unavailable")
+			_, _, err := controller.scanVolumes(vmi, pod)
+			Expect(err).To(MatchError("scanner unavailable"))
+		})
+	})
+
 	Context("Automatic Migration Requirement", func() {
 		noConditionMatcher := Not(ContainElement(MatchFields(IgnoreExtras,
 			Fields{
@@ -4495,6 +4887,52 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			),
 		)
 	})
+
+	Context("Migration blocked", func() {
+		notMigratable := virtv1.VirtualMachineInstanceCondition{
+			Type:    virtv1.VirtualMachineInstanceIsMigratable,
+			Status:  k8sv1.ConditionFalse,
+			Reason:  virtv1.VirtualMachineInstanceReasonDisksNotMigratable,
+			Message: "cannot migrate VMI: PVC disk0 is not shared",
+		}
+		migratable := virtv1.VirtualMachineInstanceCondition{
+			Type:   virtv1.VirtualMachineInstanceIsMigratable,
+			Status: k8sv1.ConditionTrue,
+		}
+		migrationRequired := virtv1.VirtualMachineInstanceCondition{
+			Type:   virtv1.VirtualMachineInstanceMigrationRequired,
+			Status: k8sv1.ConditionTrue,
+			Reason: virtv1.VirtualMachineInstanceReasonAutoMigrationDueToLiveUpdate,
+		}
+		evictionRequested := virtv1.VirtualMachineInstanceCondition{
+			Type:   virtv1.VirtualMachineInstanceEvictionRequested,
+			Status: k8sv1.ConditionTrue,
+			Reason: virtv1.VirtualMachineInstanceReasonEvictionRequested,
+		}
+
+		DescribeTable("should report VMIs which have to but can not be migrated", func(conditions []virtv1.VirtualMachineInstanceCondition, expectBlocked bool) {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Status.Conditions = conditions
+			cm := kvcontroller.NewVirtualMachineInstanceConditionManager()
+
+			syncMigrationBlockedCondition(vmi, cm)
+
+			if !expectBlocked {
+				Expect(cm.HasCondition(vmi, virtv1.VirtualMachineInstanceMigrationBlocked)).To(BeFalse())
+				return
+			}
+			cond := cm.GetCondition(vmi, virtv1.VirtualMachineInstanceMigrationBlocked)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(virtv1.VirtualMachineInstanceReasonDisksNotMigratable))
+			Expect(cond.Message).To(Equal(notMigratable.Message))
+		},
+			Entry("when a live update requires a migration", []virtv1.VirtualMachineInstanceCondition{notMigratable, migrationRequired}, true),
+			Entry("when the VMI is evicted", []virtv1.VirtualMachineInstanceCondition{notMigratable, evictionRequested}, true),
+			Entry("not when no migration is needed", []virtv1.VirtualMachineInstanceCondition{notMigratable}, false),
+			Entry("not when the VMI is migratable", []virtv1.VirtualMachineInstanceCondition{migratable, evictionRequested}, false),
+		)
+	})
 })
 
 func newDv(namespace string, name string, phase cdiv1.DataVolumePhase) *cdiv1.DataVolume {
@@ -4827,6 +5265,17 @@ func validateNetVMISpecStub(causes ...metav1.StatusCause) func(*k8sfield.Path, *
 	}
 }
 
+type fakeVolumeScanner struct {
+	verdicts map[string]*scan.Verdict
+	requests map[string]*scanv1alpha1.ScanRequest
+	err      error
+}
+
+func (f *fakeVolumeScanner) Verdict(key string, request *scanv1alpha1.ScanRequest, _ func()) (*scan.Verdict, error) {
+	f.requests[key] = request
+	return f.verdicts[key], f.err
+}
+
 type stubMigrationEvaluator struct {
 	result k8sv1.ConditionStatus
 }
@@ -159,6 +159,11 @@ func (c *Controller) handleHotplugVolumes(hotplugVolumes []*v1.Volume, hotplugAt
 	readyHotplugVolumes := make([]*v1.Volume, 0)
 	// Find all ready volumes
 	for _, volume := range hotplugVolumes {
+		if storagetypes.IsHotplugConfigVolume(volume) {
+			// ConfigMaps, Secrets and ServiceAccounts are ready as soon as they are mounted
+			readyHotplugVolumes = append(readyHotplugVolumes, volume)
+			continue
+		}
 		isUtilityVolumeWithBlockPVC, err := c.isUtilityVolumeWithBlockPVC(vmi, volume)
 		if err != nil {
 			return common.NewSyncError(err, controller.PVCNotReadyReason)
@@ -275,7 +280,17 @@ func findAttachmentPodByVolumeName(volumeName string, attachmentPods []*k8sv1.Po
 func (c *Controller) createAttachmentPodTemplate(vmi *v1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod, volumes []*v1.Volume) (*k8sv1.Pod, error) {
 	logger := log.Log.Object(vmi)
 
-	volumeNamesPVCMap, err := storagetypes.VirtVolumesToPVCMap(volumes, c.pvcIndexer, virtlauncherPod.Namespace)
+	hasConfigVolumes := false
+	pvcVolumes := make([]*v1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if storagetypes.IsHotplugConfigVolume(volume) {
+			hasConfigVolumes = true
+			continue
+		}
+		pvcVolumes = append(pvcVolumes, volume)
+	}
+
+	volumeNamesPVCMap, err := storagetypes.VirtVolumesToPVCMap(pvcVolumes, c.pvcIndexer, virtlauncherPod.Namespace)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PVC map: %v", err)
 	}
@@ -297,7 +312,7 @@ func (c *Controller) createAttachmentPodTemplate(vmi *v1.VirtualMachineInstance,
 		}
 	}
 
-	if len(volumeNamesPVCMap) > 0 {
+	if len(volumeNamesPVCMap) > 0 || hasConfigVolumes {
 		return c.templateService.RenderHotplugAttachmentPodTemplate(volumes, virtlauncherPod, vmi, volumeNamesPVCMap)
 	}
 	return nil, err
@@ -398,14 +413,15 @@ func podVolumesMatchesReadyVolumes(attachmentPod *k8sv1.Pod, volumes []*v1.Volum
 	}
 	podVolumeMap := make(map[string]k8sv1.Volume)
 	for _, volume := range attachmentPod.Spec.Volumes {
-		if volume.PersistentVolumeClaim != nil {
-			podVolumeMap[volume.Name] = volume
-		}
+		podVolumeMap[volume.Name] = volume
 	}
+	// The number of volumes matches, so the pod has exactly the ready volumes if it has all of them
 	for _, volume := range volumes {
-		delete(podVolumeMap, volume.Name)
+		if _, ok := podVolumeMap[volume.Name]; !ok {
+			return false
+		}
 	}
-	return len(podVolumeMap) == 0
+	return true
 }
 
 func hasPendingPods(pods []*k8sv1.Pod) bool {
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmi
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/storage/scan"
+	scanv1alpha1 "kubevirt.io/kubevirt/pkg/storage/scan/v1alpha1"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+type volumeScanner interface {
+	Verdict(key string, request *scanv1alpha1.ScanRequest, onDone func()) (*scan.Verdict, error)
+}
+
+// scanVolumes passes the containerdisks and DataVolumes of the VMI to the
+// volume scanner. It returns false as long as verdicts are pending, the VMI is
+// enqueued again once they are known. The message of a rejected volume is
+// returned as the rejection.
+func (c *Controller) scanVolumes(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) (scanned bool, rejection string, err error) {
+	imageIDs, err := containerdisk.ExtractImageIDsFromSourcePod(vmi, pod, c.clusterConfig.ImageVolumeEnabled())
+	if err != nil {
+		return false, "", err
+	}
+	vmiKey, err := controller.KeyFunc(vmi)
+	if err != nil {
+		return false, "", err
+	}
+	onDone := func() { c.Queue.Add(vmiKey) }
+
+	scanned = true
+	for _, volume := range vmi.Spec.Volumes {
+		request := &scanv1alpha1.ScanRequest{
+			Namespace: vmi.Namespace,
+			VMI:       vmi.Name,
+			Volume:    volume.Name,
+		}
+		var key string
+		switch {
+		case volume.ContainerDisk != nil:
+			// The verdict holds for every repository and tag pointing to the digest
+			_, digest, found := strings.Cut(imageIDs[volume.Name], "@")
+			if !found {
+				return false, "", fmt.Errorf("the digest of containerDisk %s is not known", volume.Name)
+			}
+			request.Image = imageIDs[volume.Name]
+			key = "image/" + digest
+		case volume.DataVolume != nil:
+			pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, volume.DataVolume.Name, c.pvcIndexer)
+			if err != nil {
+				return false, "", err
+			}
+			if pvc == nil {
+				return false, "", fmt.Errorf("the PVC of DataVolume %s does not exist", volume.DataVolume.Name)
+			}
+			request.ClaimName = pvc.Name
+			key = "pvc/" + string(pvc.UID)
+		default:
+			continue
+		}
+
+		verdict, err := c.volumeScanner.Verdict(key, request, onDone)
+		if err != nil {
+			return false, "", err
+		}
+		if verdict == nil {
+			scanned = false
+			continue
+		}
+		if !verdict.Allowed {
+			return true, fmt.Sprintf("volume %s was rejected by the volume scanner: %s", volume.Name, verdict.Message), nil
+		}
+	}
+	return scanned, "", nil
+}
@@ -0,0 +1,707 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmoperation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	operationsv1 "kubevirt.io/api/operations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
+)
+
+const (
+	defaultMaxConcurrent = 10
+	defaultTimeout       = 10 * time.Minute
+
+	// maxFailureNames limits the names listed per failure reason, the count
+	// still covers all of them
+	maxFailureNames = 10
+
+	operationStartedEvent   = "VirtualMachineOperationStarted"
+	operationSucceededEvent = "VirtualMachineOperationSucceeded"
+	operationFailedEvent    = "VirtualMachineOperationFailed"
+
+	operationStartedMsg   = "Selected %d VirtualMachines to %s"
+	operationSucceededMsg = "%s succeeded for %d VirtualMachines"
+	operationFailedMsg    = "%s failed for %d of %d VirtualMachines"
+	tooManyFailuresMsg    = "stopped after %d VirtualMachines failed, at most %d are allowed to fail"
+	invalidSelectorMsg    = "invalid selector: %v"
+
+	vmNotFoundReason      = "VirtualMachine not found"
+	vmiNotRunningReason   = "VirtualMachineInstance is not running"
+	migrationFailedReason = "migration failed"
+	timedOutReason        = "timed out after %s"
+	actionFailedReason    = "%s failed: %v"
+
+	migrationPrefix = "kubevirt-vmop-"
+)
+
+// failedStatuses are the printable statuses a VirtualMachine does not recover
+// from on its own while it is started
+var failedStatuses = map[v1.VirtualMachinePrintableStatus]bool{
+	v1.VirtualMachineStatusCrashLoopBackOff: true,
+	v1.VirtualMachineStatusUnschedulable:    true,
+	v1.VirtualMachineStatusErrImagePull:     true,
+	v1.VirtualMachineStatusImagePullBackOff: true,
+	v1.VirtualMachineStatusPvcNotFound:      true,
+	v1.VirtualMachineStatusDataVolumeError:  true,
+}
+
+// Controller applies the action of VirtualMachineOperations to the selected
+// VirtualMachines, never to more than MaxConcurrent of them at the same time.
+type Controller struct {
+	client            kubecli.KubevirtClient
+	clusterConfig     *virtconfig.ClusterConfig
+	operationInformer cache.SharedIndexInformer
+	vmIndexer         cache.Indexer
+	vmiStore          cache.Store
+	migrationIndexer  cache.Indexer
+	budgetIndexer     cache.Indexer
+	recorder          record.EventRecorder
+	operationQueue    workqueue.TypedRateLimitingInterface[string]
+	hasSynced         func() bool
+	now               func() time.Time
+}
+
+func NewController(client kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+	operationInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	vmDisruptionBudgetInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*Controller, error) {
+	c := &Controller{
+		operationQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmoperation"},
+		),
+		client:            client,
+		clusterConfig:     clusterConfig,
+		operationInformer: operationInformer,
+		vmIndexer:         vmInformer.GetIndexer(),
+		vmiStore:          vmiInformer.GetStore(),
+		migrationIndexer:  migrationInformer.GetIndexer(),
+		budgetIndexer:     vmDisruptionBudgetInformer.GetIndexer(),
+		recorder:          recorder,
+		now:               time.Now,
+	}
+
+	c.hasSynced = func() bool {
+		return operationInformer.HasSynced() && vmInformer.HasSynced() &&
+			vmiInformer.HasSynced() && migrationInformer.HasSynced() &&
+			vmDisruptionBudgetInformer.HasSynced()
+	}
+
+	_, err := operationInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleOperation,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleOperation(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The operations of the namespace check whether the action completed
+	// for the changed VirtualMachine
+	handler := cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) { c.handleNamespacedObject(newObj) },
+		DeleteFunc: c.handleNamespacedObject,
+	}
+	for _, informer := range []cache.SharedIndexInformer{vmInformer, vmiInformer, migrationInformer} {
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			return nil, err
+		}
+	}
+
+	// The operations of the namespace may continue once a budget allows more
+	// disruptions
+	_, err = vmDisruptionBudgetInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleNamespacedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleNamespacedObject(newObj) },
+			DeleteFunc: c.handleNamespacedObject,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) handleOperation(obj interface{}) {
+	operation, ok := obj.(*operationsv1.VirtualMachineOperation)
+	if !ok || IsOperationDone(operation) {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(operation)
+	if err != nil {
+		log.Log.Errorf("failed to get key from object: %v, %v", err, operation)
+		return
+	}
+
+	log.Log.V(3).Infof("enqueued %q for sync", key)
+	c.operationQueue.Add(key)
+}
+
+func (c *Controller) handleNamespacedObject(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	object, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+
+	objs, err := c.operationInformer.GetIndexer().ByIndex(cache.NamespaceIndex, object.GetNamespace())
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to list VirtualMachineOperations in namespace %s", object.GetNamespace())
+		return
+	}
+	for _, obj := range objs {
+		c.handleOperation(obj)
+	}
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.operationQueue.ShutDown()
+
+	log.Log.Info("Starting VM operation controller.")
+	defer log.Log.Info("Shutting down VM operation controller.")
+
+	if !cache.WaitForCacheSync(stopCh, c.hasSynced) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.operationQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.operationQueue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineOperation %v", key)
+		c.operationQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineOperation %v", key)
+		c.operationQueue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.clusterConfig.VirtualMachineOperationsEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.operationInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	operation, ok := obj.(*operationsv1.VirtualMachineOperation)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", obj)
+	}
+	if IsOperationDone(operation) {
+		return nil
+	}
+
+	operationCopy := operation.DeepCopy()
+	if operationCopy.Status == nil {
+		operationCopy.Status = &operationsv1.VirtualMachineOperationStatus{}
+	}
+	selected, err := c.sync(operationCopy)
+	if err != nil {
+		return err
+	}
+
+	// The selected VirtualMachines are recorded as in progress before the
+	// action is applied to them. A sync retried after a conflict can then not
+	// apply a restart twice, an action which was never applied times out.
+	operationCopy, err = c.updateStatus(operation, operationCopy)
+	if err != nil {
+		return err
+	}
+	if len(selected) > 0 {
+		updated := operationCopy.DeepCopy()
+		c.applyAction(updated, selected)
+		if operationCopy, err = c.updateStatus(operationCopy, updated); err != nil {
+			return err
+		}
+	}
+
+	// Enforce the timeout even if the VirtualMachines never change again
+	if next := c.nextTimeout(operationCopy); next != nil {
+		c.operationQueue.AddAfter(key, next.Sub(c.now()))
+	}
+	return nil
+}
+
+func (c *Controller) updateStatus(old, operation *operationsv1.VirtualMachineOperation) (*operationsv1.VirtualMachineOperation, error) {
+	if equality.Semantic.DeepEqual(old.Status, operation.Status) {
+		return operation, nil
+	}
+	return c.client.VirtualMachineOperation(operation.Namespace).UpdateStatus(context.Background(), operation, metav1.UpdateOptions{})
+}
+
+// sync selects the VirtualMachines once, collects the outcome of the actions
+// in progress and moves the next pending VirtualMachines to the ones in
+// progress. It returns the names of these, the action is not applied yet.
+func (c *Controller) sync(operation *operationsv1.VirtualMachineOperation) ([]string, error) {
+	status := operation.Status
+
+	if status.Phase == "" {
+		if err := c.start(operation); err != nil {
+			return nil, err
+		}
+	}
+
+	inProgress := status.InProgress[:0]
+	for _, target := range status.InProgress {
+		succeeded, reason := c.outcome(operation, &target)
+		switch {
+		case succeeded:
+			status.Succeeded++
+		case reason != "":
+			addFailure(status, target.Name, reason)
+		default:
+			inProgress = append(inProgress, target)
+		}
+	}
+	status.InProgress = inProgress
+	if len(status.InProgress) == 0 {
+		status.InProgress = nil
+	}
+
+	budgets, err := c.disruptionBudgets(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected, waiting []string
+	for !tooManyFailures(operation) && len(status.Pending) > 0 && len(status.InProgress) < maxConcurrent(operation) {
+		name := status.Pending[0]
+		status.Pending = status.Pending[1:]
+		allowed, err := c.disruptionAllowed(budgets, operation, name)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			waiting = append(waiting, name)
+			continue
+		}
+		status.InProgress = append(status.InProgress, operationsv1.VirtualMachineOperationTarget{
+			Name:           name,
+			StartTimestamp: metav1.NewTime(c.now()),
+		})
+		selected = append(selected, name)
+	}
+	// VirtualMachines waiting for their budgets stay first in line, a change
+	// of the budgets or of the disrupted VirtualMachineInstances syncs again
+	status.Pending = append(waiting, status.Pending...)
+	if len(status.Pending) == 0 {
+		status.Pending = nil
+	}
+
+	c.completeIfDone(operation)
+	return selected, nil
+}
+
+// disruptionBudgets returns the VirtualMachineDisruptionBudgets for the
+// selection of a sync. VirtualMachineInstances which are shutting down or
+// migrating count as disrupted, like the ones of the VirtualMachines the
+// operation applies its action to.
+func (c *Controller) disruptionBudgets(operation *operationsv1.VirtualMachineOperation) (*vmpolicy.DisruptionBudgets, error) {
+	lookup := map[string]bool{}
+	for _, target := range operation.Status.InProgress {
+		lookup[target.Name] = true
+	}
+	objs, err := c.migrationIndexer.ByIndex(cache.NamespaceIndex, operation.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if migration := obj.(*v1.VirtualMachineInstanceMigration); !migration.IsFinal() {
+			lookup[migration.Spec.VMIName] = true
+		}
+	}
+	return vmpolicy.NewDisruptionBudgets(c.budgetIndexer, c.vmiStore, func(vmi *v1.VirtualMachineInstance) bool {
+		return (vmi.DeletionTimestamp != nil && !vmi.IsFinal()) || migrationutils.IsMigrating(vmi) || lookup[vmi.Name]
+	}), nil
+}
+
+// disruptionAllowed reports whether the action can be applied to the
+// VirtualMachine without exceeding a VirtualMachineDisruptionBudget. Only
+// restarting and migrating a running VirtualMachineInstance disrupts it.
+func (c *Controller) disruptionAllowed(budgets *vmpolicy.DisruptionBudgets, operation *operationsv1.VirtualMachineOperation, name string) (bool, error) {
+	if operation.Spec.Action != operationsv1.ActionRestart && operation.Spec.Action != operationsv1.ActionMigrate {
+		return true, nil
+	}
+	obj, exists, err := c.vmiStore.GetByKey(operation.Namespace + "/" + name)
+	if err != nil || !exists {
+		return true, err
+	}
+	allowed, err := budgets.Filter([]*v1.VirtualMachineInstance{obj.(*v1.VirtualMachineInstance)})
+	return len(allowed) > 0, err
+}
+
+// applyAction applies the action to the selected VirtualMachines, which are
+// in progress already. The ones which are in the desired state already or
+// failed right away are no longer in progress.
+func (c *Controller) applyAction(operation *operationsv1.VirtualMachineOperation, selected []string) {
+	status := operation.Status
+	for _, name := range selected {
+		succeeded, reason := c.apply(operation, name)
+		if !succeeded && reason == "" {
+			continue
+		}
+		for i := range status.InProgress {
+			if status.InProgress[i].Name == name {
+				status.InProgress = append(status.InProgress[:i], status.InProgress[i+1:]...)
+				break
+			}
+		}
+		if succeeded {
+			status.Succeeded++
+		} else {
+			addFailure(status, name, reason)
+		}
+	}
+	c.completeIfDone(operation)
+}
+
+func (c *Controller) completeIfDone(operation *operationsv1.VirtualMachineOperation) {
+	status := operation.Status
+	if len(status.InProgress) == 0 {
+		status.InProgress = nil
+	}
+	if IsOperationDone(operation) {
+		return
+	}
+	if len(status.InProgress) == 0 && (len(status.Pending) == 0 || tooManyFailures(operation)) {
+		c.complete(operation)
+	}
+}
+
+// start selects the VirtualMachines the action is applied to. VirtualMachines
+// which are labeled later are not picked up by a running operation.
+func (c *Controller) start(operation *operationsv1.VirtualMachineOperation) error {
+	status := operation.Status
+	status.Phase = operationsv1.OperationRunning
+	status.StartTimestamp = pointer.P(metav1.NewTime(c.now()))
+
+	selector, err := metav1.LabelSelectorAsSelector(&operation.Spec.Selector)
+	if err != nil {
+		status.FailureReason = fmt.Sprintf(invalidSelectorMsg, err)
+		return nil
+	}
+
+	objs, err := c.vmIndexer.ByIndex(cache.NamespaceIndex, operation.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		vm := obj.(*v1.VirtualMachine)
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			status.Pending = append(status.Pending, vm.Name)
+		}
+	}
+	sort.Strings(status.Pending)
+	status.Total = int32(len(status.Pending))
+
+	c.recorder.Eventf(operation, k8sv1.EventTypeNormal, operationStartedEvent, operationStartedMsg,
+		status.Total, operation.Spec.Action)
+	return nil
+}
+
+// apply applies the action to the VirtualMachine. It returns whether the
+// VirtualMachine already is in the desired state or why the action failed,
+// neither for an action in progress.
+func (c *Controller) apply(operation *operationsv1.VirtualMachineOperation, name string) (bool, string) {
+	action := operation.Spec.Action
+	vm, err := c.getVM(operation.Namespace, name)
+	if err != nil {
+		return false, fmt.Sprintf(actionFailedReason, action, err)
+	}
+	if vm == nil {
+		return false, vmNotFoundReason
+	}
+
+	vmClient := c.client.VirtualMachine(operation.Namespace)
+	switch action {
+	case operationsv1.ActionStart:
+		if vm.Status.Ready {
+			return true, ""
+		}
+		err = vmClient.Start(context.Background(), name, &v1.StartOptions{})
+	case operationsv1.ActionStop:
+		if !vm.Status.Created {
+			return true, ""
+		}
+		err = vmClient.Stop(context.Background(), name, &v1.StopOptions{})
+	case operationsv1.ActionRestart:
+		err = vmClient.Restart(context.Background(), name, &v1.RestartOptions{})
+	case operationsv1.ActionMigrate:
+		return c.migrate(operation, name)
+	}
+	if err != nil {
+		return false, fmt.Sprintf(actionFailedReason, action, err)
+	}
+	return false, ""
+}
+
+func (c *Controller) migrate(operation *operationsv1.VirtualMachineOperation, name string) (bool, string) {
+	vmi, err := c.getVMI(operation.Namespace, name)
+	if err != nil {
+		return false, fmt.Sprintf(actionFailedReason, operationsv1.ActionMigrate, err)
+	}
+	if vmi == nil || vmi.IsFinal() || vmi.Status.Phase != v1.Running {
+		return false, vmiNotRunningReason
+	}
+
+	if c.findMigration(operation, name) != nil {
+		return false, ""
+	}
+	migration := &v1.VirtualMachineInstanceMigration{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: migrationPrefix,
+			Labels: map[string]string{
+				operationsv1.VirtualMachineOperationLabel: operation.Name,
+			},
+		},
+		Spec: v1.VirtualMachineInstanceMigrationSpec{
+			VMIName: name,
+		},
+	}
+	_, err = c.client.VirtualMachineInstanceMigration(operation.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Sprintf(actionFailedReason, operationsv1.ActionMigrate, err)
+	}
+	return false, ""
+}
+
+// outcome returns whether the action in progress for the target succeeded or
+// why it failed, neither while it is still in progress
+func (c *Controller) outcome(operation *operationsv1.VirtualMachineOperation, target *operationsv1.VirtualMachineOperationTarget) (bool, string) {
+	vm, err := c.getVM(operation.Namespace, target.Name)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get VirtualMachine %s/%s", operation.Namespace, target.Name)
+		return false, ""
+	}
+	if vm == nil {
+		return false, vmNotFoundReason
+	}
+
+	switch operation.Spec.Action {
+	case operationsv1.ActionStart:
+		if vm.Status.Ready {
+			return true, ""
+		}
+	case operationsv1.ActionStop:
+		if !vm.Status.Created {
+			return true, ""
+		}
+	case operationsv1.ActionRestart:
+		vmi, err := c.getVMI(operation.Namespace, target.Name)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to get VirtualMachineInstance %s/%s", operation.Namespace, target.Name)
+			return false, ""
+		}
+		// The VirtualMachine is ready again once the VirtualMachineInstance
+		// replacing the restarted one is ready
+		if vm.Status.Ready && vmi != nil && !vmi.CreationTimestamp.Before(&target.StartTimestamp) {
+			return true, ""
+		}
+	case operationsv1.ActionMigrate:
+		if migration := c.findMigration(operation, target.Name); migration != nil {
+			switch migration.Status.Phase {
+			case v1.MigrationSucceeded:
+				return true, ""
+			case v1.MigrationFailed:
+				return false, migrationFailedReason
+			}
+		}
+	}
+
+	if operation.Spec.Action != operationsv1.ActionStop && failedStatuses[vm.Status.PrintableStatus] {
+		return false, string(vm.Status.PrintableStatus)
+	}
+	if c.now().Sub(target.StartTimestamp.Time) > timeout(operation) {
+		return false, fmt.Sprintf(timedOutReason, timeout(operation))
+	}
+	return false, ""
+}
+
+func (c *Controller) complete(operation *operationsv1.VirtualMachineOperation) {
+	status := operation.Status
+	status.CompletionTimestamp = pointer.P(metav1.NewTime(c.now()))
+
+	if tooManyFailures(operation) {
+		status.FailureReason = fmt.Sprintf(tooManyFailuresMsg, status.Failed, *operation.Spec.MaxFailures)
+	}
+	if status.Failed > 0 || status.FailureReason != "" {
+		status.Phase = operationsv1.OperationFailed
+		reason := status.FailureReason
+		if reason == "" {
+			reason = fmt.Sprintf(operationFailedMsg, operation.Spec.Action, status.Failed, status.Total)
+		}
+		c.recorder.Event(operation, k8sv1.EventTypeWarning, operationFailedEvent, reason)
+		return
+	}
+
+	status.Phase = operationsv1.OperationSucceeded
+	c.recorder.Eventf(operation, k8sv1.EventTypeNormal, operationSucceededEvent, operationSucceededMsg,
+		operation.Spec.Action, status.Succeeded)
+}
+
+// nextTimeout returns the time the first action in progress times out
+func (c *Controller) nextTimeout(operation *operationsv1.VirtualMachineOperation) *time.Time {
+	if IsOperationDone(operation) {
+		return nil
+	}
+	var next *time.Time
+	for _, target := range operation.Status.InProgress {
+		deadline := target.StartTimestamp.Add(timeout(operation))
+		if next == nil || deadline.Before(*next) {
+			next = &deadline
+		}
+	}
+	return next
+}
+
+func (c *Controller) findMigration(operation *operationsv1.VirtualMachineOperation, vmiName string) *v1.VirtualMachineInstanceMigration {
+	objs, err := c.migrationIndexer.ByIndex(controller.ByVMINameIndex, fmt.Sprintf("%s/%s", operation.Namespace, vmiName))
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to list migrations of VirtualMachineInstance %s/%s", operation.Namespace, vmiName)
+		return nil
+	}
+	for _, obj := range objs {
+		migration := obj.(*v1.VirtualMachineInstanceMigration)
+		if migration.Labels[operationsv1.VirtualMachineOperationLabel] == operation.Name {
+			return migration
+		}
+	}
+	return nil
+}
+
+func (c *Controller) getVM(namespace, name string) (*v1.VirtualMachine, error) {
+	obj, exists, err := c.vmIndexer.GetByKey(fmt.Sprintf("%s/%s", namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachine), nil
+}
+
+func (c *Controller) getVMI(namespace, name string) (*v1.VirtualMachineInstance, error) {
+	obj, exists, err := c.vmiStore.GetByKey(fmt.Sprintf("%s/%s", namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*v1.VirtualMachineInstance), nil
+}
+
+// addFailure counts the failed VirtualMachine by the reason it failed for
+func addFailure(status *operationsv1.VirtualMachineOperationStatus, name, reason string) {
+	status.Failed++
+	for i := range status.Failures {
+		failure := &status.Failures[i]
+		if failure.Reason == reason {
+			failure.Count++
+			if len(failure.VirtualMachines) < maxFailureNames {
+				failure.VirtualMachines = append(failure.VirtualMachines, name)
+			}
+			return
+		}
+	}
+	status.Failures = append(status.Failures, operationsv1.VirtualMachineOperationFailure{
+		Reason:          reason,
+		Count:           1,
+		VirtualMachines: []string{name},
+	})
+}
+
+func tooManyFailures(operation *operationsv1.VirtualMachineOperation) bool {
+	return operation.Spec.MaxFailures != nil && operation.Status.Failed > *operation.Spec.MaxFailures
+}
+
+// IsOperationDone returns true once the operation succeeded or failed
+func IsOperationDone(operation *operationsv1.VirtualMachineOperation) bool {
+	return operation.Status != nil &&
+		(operation.Status.Phase == operationsv1.OperationSucceeded || operation.Status.Phase == operationsv1.OperationFailed)
+}
+
+func maxConcurrent(operation *operationsv1.VirtualMachineOperation) int {
+	if operation.Spec.MaxConcurrent != nil {
+		return int(*operation.Spec.MaxConcurrent)
+	}
+	return defaultMaxConcurrent
+}
+
+func timeout(operation *operationsv1.VirtualMachineOperation) time.Duration {
+	if operation.Spec.Timeout != nil {
+		return operation.Spec.Timeout.Duration
+	}
+	return defaultTimeout
+}
@@ -0,0 +1,546 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmoperation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	operationsv1 "kubevirt.io/api/operations/v1alpha1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	testNamespace = "default"
+	operationName = "test-operation"
+	selectorLabel = "app"
+	selectorValue = "web"
+)
+
+var _ = Describe("VM Operation Controller", func() {
+	var (
+		ctrl              *gomock.Controller
+		virtClient        *kubecli.MockKubevirtClient
+		kubevirtClient    *kubevirtfake.Clientset
+		operationInformer cache.SharedIndexInformer
+		vmInformer        cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		budgetInformer    cache.SharedIndexInformer
+		recorder          *record.FakeRecorder
+		opController      *Controller
+		now               time.Time
+	)
+
+	newController := func(featureGates ...string) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+		})
+		opController = &Controller{
+			client:            virtClient,
+			clusterConfig:     clusterConfig,
+			operationInformer: operationInformer,
+			vmIndexer:         vmInformer.GetIndexer(),
+			vmiStore:          vmiInformer.GetStore(),
+			migrationIndexer:  migrationInformer.GetIndexer(),
+			budgetIndexer:     budgetInformer.GetIndexer(),
+			recorder:          recorder,
+			operationQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-vmoperation-queue"},
+			),
+			now: func() time.Time { return now },
+		}
+	}
+
+	newOperation := func(action operationsv1.VirtualMachineOperationAction) *operationsv1.VirtualMachineOperation {
+		return &operationsv1.VirtualMachineOperation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      operationName,
+				Namespace: testNamespace,
+			},
+			Spec: operationsv1.VirtualMachineOperationSpec{
+				Action: action,
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{selectorLabel: selectorValue},
+				},
+			},
+		}
+	}
+
+	addVM := func(name string, ready bool, labels map[string]string) *v1.VirtualMachine {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Status: v1.VirtualMachineStatus{
+				Created: ready,
+				Ready:   ready,
+			},
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		return vm
+	}
+
+	addSelectedVMs := func(ready bool, names ...string) {
+		for _, name := range names {
+			addVM(name, ready, map[string]string{selectorLabel: selectorValue})
+		}
+	}
+
+	addOperation := func(operation *operationsv1.VirtualMachineOperation) {
+		Expect(operationInformer.GetStore().Add(operation)).To(Succeed())
+		_, err := kubevirtClient.OperationsV1alpha1().VirtualMachineOperations(operation.Namespace).Create(context.Background(), operation, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		opController.operationQueue.Add(fmt.Sprintf("%s/%s", operation.Namespace, operation.Name))
+	}
+
+	// resync feeds the status the controller reported back into the store
+	// and syncs the operation again
+	resync := func() {
+		operation, err := kubevirtClient.OperationsV1alpha1().VirtualMachineOperations(testNamespace).Get(context.Background(), operationName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operationInformer.GetStore().Update(operation)).To(Succeed())
+		Expect(opController.execute(fmt.Sprintf("%s/%s", testNamespace, operationName))).To(Succeed())
+	}
+
+	getOperationStatus := func() *operationsv1.VirtualMachineOperationStatus {
+		operation, err := kubevirtClient.OperationsV1alpha1().VirtualMachineOperations(testNamespace).Get(context.Background(), operationName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return operation.Status
+	}
+
+	subresourceCalls := func(subresource string) []string {
+		var names []string
+		for _, action := range kubevirtClient.Actions() {
+			if put, ok := action.(interface{ GetName() string }); ok && action.GetVerb() == "put" && action.GetSubresource() == subresource {
+				names = append(names, put.GetName())
+			}
+		}
+		return names
+	}
+
+	inProgressNames := func(status *operationsv1.VirtualMachineOperationStatus) []string {
+		var names []string
+		for _, target := range status.InProgress {
+			names = append(names, target.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		namespaceIndexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+		operationInformer, _ = testutils.NewFakeInformerWithIndexersFor(&operationsv1.VirtualMachineOperation{}, namespaceIndexers)
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, namespaceIndexers)
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		migrationInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, controller.GetVirtualMachineInstanceMigrationInformerIndexers())
+		budgetInformer, _ = testutils.NewFakeInformerWithIndexersFor(&policyv1alpha1.VirtualMachineDisruptionBudget{}, namespaceIndexers)
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		now = time.Now()
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineOperation(testNamespace).
+			Return(kubevirtClient.OperationsV1alpha1().VirtualMachineOperations(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(testNamespace)).AnyTimes()
+
+		newController(featuregate.VirtualMachineOperationsGate)
+	})
+
+	It("should not run operations without the feature gate", func() {
+		newController()
+		addSelectedVMs(false, "vm1")
+		addOperation(newOperation(operationsv1.ActionStart))
+		opController.Execute()
+
+		Expect(getOperationStatus()).To(BeNil())
+		Expect(subresourceCalls("start")).To(BeEmpty())
+	})
+
+	It("should select the labeled VirtualMachines and start at most maxConcurrent of them", func() {
+		addSelectedVMs(false, "vm3", "vm1", "vm2")
+		addVM("other", false, map[string]string{selectorLabel: "db"})
+		operation := newOperation(operationsv1.ActionStart)
+		operation.Spec.MaxConcurrent = pointer.P(int32(2))
+		addOperation(operation)
+		opController.Execute()
+
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationRunning))
+		Expect(status.StartTimestamp).ToNot(BeNil())
+		Expect(status.Total).To(Equal(int32(3)))
+		Expect(inProgressNames(status)).To(Equal([]string{"vm1", "vm2"}))
+		Expect(status.Pending).To(Equal([]string{"vm3"}))
+		Expect(subresourceCalls("start")).To(ConsistOf("vm1", "vm2"))
+		testutils.ExpectEvent(recorder, operationStartedEvent)
+	})
+
+	It("should continue with the pending VirtualMachines once the started ones are ready", func() {
+		addSelectedVMs(false, "vm1", "vm2")
+		operation := newOperation(operationsv1.ActionStart)
+		operation.Spec.MaxConcurrent = pointer.P(int32(1))
+		addOperation(operation)
+		opController.Execute()
+		Expect(subresourceCalls("start")).To(ConsistOf("vm1"))
+
+		addSelectedVMs(true, "vm1")
+		resync()
+		status := getOperationStatus()
+		Expect(status.Succeeded).To(Equal(int32(1)))
+		Expect(inProgressNames(status)).To(Equal([]string{"vm2"}))
+		Expect(status.Pending).To(BeEmpty())
+		Expect(subresourceCalls("start")).To(ConsistOf("vm1", "vm2"))
+
+		addSelectedVMs(true, "vm2")
+		resync()
+		status = getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationSucceeded))
+		Expect(status.Succeeded).To(Equal(int32(2)))
+		Expect(status.CompletionTimestamp).ToNot(BeNil())
+		testutils.ExpectEvents(recorder, operationStartedEvent, operationSucceededEvent)
+	})
+
+	It("should not start VirtualMachines which are already ready", func() {
+		addSelectedVMs(true, "vm1")
+		addOperation(newOperation(operationsv1.ActionStart))
+		opController.Execute()
+
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationSucceeded))
+		Expect(status.Succeeded).To(Equal(int32(1)))
+		Expect(subresourceCalls("start")).To(BeEmpty())
+	})
+
+	It("should succeed right away if no VirtualMachine is selected", func() {
+		addOperation(newOperation(operationsv1.ActionStop))
+		opController.Execute()
+
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationSucceeded))
+		Expect(status.Total).To(BeZero())
+	})
+
+	It("should stop the VirtualMachines and wait until they are stopped", func() {
+		addSelectedVMs(true, "vm1")
+		addOperation(newOperation(operationsv1.ActionStop))
+		opController.Execute()
+		Expect(subresourceCalls("stop")).To(ConsistOf("vm1"))
+		Expect(getOperationStatus().Phase).To(Equal(operationsv1.OperationRunning))
+
+		addSelectedVMs(false, "vm1")
+		resync()
+		Expect(getOperationStatus().Phase).To(Equal(operationsv1.OperationSucceeded))
+	})
+
+	It("should wait for the new VirtualMachineInstance after a restart", func() {
+		addSelectedVMs(true, "vm1")
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "vm1",
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+			},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		addOperation(newOperation(operationsv1.ActionRestart))
+		opController.Execute()
+		Expect(subresourceCalls("restart")).To(ConsistOf("vm1"))
+
+		resync()
+		Expect(getOperationStatus().Phase).To(Equal(operationsv1.OperationRunning))
+
+		vmi = vmi.DeepCopy()
+		vmi.CreationTimestamp = metav1.NewTime(now.Add(time.Second))
+		Expect(vmiInformer.GetStore().Update(vmi)).To(Succeed())
+		resync()
+		Expect(getOperationStatus().Phase).To(Equal(operationsv1.OperationSucceeded))
+	})
+
+	Context("when the status update conflicts", func() {
+		// failStatusUpdate lets the status update with the given number fail
+		failStatusUpdate := func(failing int) {
+			updates := 0
+			kubevirtClient.PrependReactor("update", "virtualmachineoperations", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "status" {
+					return false, nil, nil
+				}
+				updates++
+				if updates != failing {
+					return false, nil, nil
+				}
+				return true, nil, errors.NewConflict(schema.GroupResource{Resource: "virtualmachineoperations"}, operationName, fmt.Errorf("conflict"))
+			})
+		}
+
+		It("should not apply the action before the VirtualMachines are recorded as in progress", func() {
+			addSelectedVMs(true, "vm1")
+			addOperation(newOperation(operationsv1.ActionRestart))
+			failStatusUpdate(1)
+
+			Expect(opController.execute(fmt.Sprintf("%s/%s", testNamespace, operationName))).ToNot(Succeed())
+			Expect(subresourceCalls("restart")).To(BeEmpty())
+			testutils.ExpectEvent(recorder, operationStartedEvent)
+		})
+
+		It("should not apply the action again once the VirtualMachines are recorded as in progress", func() {
+			addSelectedVMs(false, "vm1")
+			addOperation(newOperation(operationsv1.ActionStart))
+			// The start fails, recording the failure conflicts
+			kubevirtClient.PrependReactor("put", "virtualmachines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("start failed")
+			})
+			failStatusUpdate(2)
+
+			Expect(opController.execute(fmt.Sprintf("%s/%s", testNamespace, operationName))).ToNot(Succeed())
+			Expect(inProgressNames(getOperationStatus())).To(Equal([]string{"vm1"}))
+
+			resync()
+			Expect(subresourceCalls("start")).To(ConsistOf("vm1"))
+			Expect(inProgressNames(getOperationStatus())).To(Equal([]string{"vm1"}))
+			testutils.ExpectEvent(recorder, operationStartedEvent)
+		})
+	})
+
+	Context("migrate", func() {
+		addRunningVMI := func(name string) {
+			Expect(vmiInformer.GetStore().Add(&v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+				Status:     v1.VirtualMachineInstanceStatus{Phase: v1.Running},
+			})).To(Succeed())
+		}
+
+		It("should create a labeled migration and wait until it succeeded", func() {
+			addSelectedVMs(true, "vm1")
+			addRunningVMI("vm1")
+			addOperation(newOperation(operationsv1.ActionMigrate))
+			opController.Execute()
+
+			migrations, err := kubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(1))
+			migration := migrations.Items[0].DeepCopy()
+			Expect(migration.Spec.VMIName).To(Equal("vm1"))
+			Expect(migration.Labels).To(HaveKeyWithValue(operationsv1.VirtualMachineOperationLabel, operationName))
+
+			migration.Name = "migration"
+			migration.Status.Phase = v1.MigrationSucceeded
+			Expect(migrationInformer.GetStore().Add(migration)).To(Succeed())
+			resync()
+			Expect(getOperationStatus().Phase).To(Equal(operationsv1.OperationSucceeded))
+		})
+
+		It("should fail VirtualMachines without a running VirtualMachineInstance", func() {
+			addSelectedVMs(false, "vm1")
+			addOperation(newOperation(operationsv1.ActionMigrate))
+			opController.Execute()
+
+			status := getOperationStatus()
+			Expect(status.Phase).To(Equal(operationsv1.OperationFailed))
+			Expect(status.Failures).To(ConsistOf(operationsv1.VirtualMachineOperationFailure{
+				Reason:          vmiNotRunningReason,
+				Count:           1,
+				VirtualMachines: []string{"vm1"},
+			}))
+			testutils.ExpectEvents(recorder, operationStartedEvent, operationFailedEvent)
+		})
+	})
+
+	Context("with a VirtualMachineDisruptionBudget", func() {
+		BeforeEach(func() {
+			Expect(budgetInformer.GetStore().Add(&policyv1alpha1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "budget", Namespace: testNamespace},
+				Spec: policyv1alpha1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{selectorLabel: selectorValue},
+					},
+					MaxDisruptions: 1,
+				},
+			})).To(Succeed())
+			for _, name := range []string{"vm1", "vm2"} {
+				addSelectedVMs(true, name)
+				Expect(vmiInformer.GetStore().Add(&v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              name,
+						Namespace:         testNamespace,
+						Labels:            map[string]string{selectorLabel: selectorValue},
+						CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+					},
+					Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running},
+				})).To(Succeed())
+			}
+		})
+
+		It("should not restart more VirtualMachines than the budget allows", func() {
+			addOperation(newOperation(operationsv1.ActionRestart))
+			opController.Execute()
+
+			status := getOperationStatus()
+			Expect(inProgressNames(status)).To(Equal([]string{"vm1"}))
+			Expect(status.Pending).To(Equal([]string{"vm2"}))
+			Expect(subresourceCalls("restart")).To(ConsistOf("vm1"))
+
+			resync()
+			Expect(getOperationStatus().Pending).To(Equal([]string{"vm2"}))
+			Expect(subresourceCalls("restart")).To(ConsistOf("vm1"))
+
+			vmi, exists, err := vmiInformer.GetStore().GetByKey(testNamespace + "/vm1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			restarted := vmi.(*v1.VirtualMachineInstance).DeepCopy()
+			restarted.CreationTimestamp = metav1.NewTime(now.Add(time.Second))
+			Expect(vmiInformer.GetStore().Update(restarted)).To(Succeed())
+			resync()
+
+			status = getOperationStatus()
+			Expect(status.Succeeded).To(Equal(int32(1)))
+			Expect(inProgressNames(status)).To(Equal([]string{"vm2"}))
+			Expect(subresourceCalls("restart")).To(ConsistOf("vm1", "vm2"))
+		})
+
+		It("should not migrate VirtualMachines while the budget is exhausted by other migrations", func() {
+			Expect(migrationInformer.GetStore().Add(&v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace},
+				Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: "vm2"},
+			})).To(Succeed())
+			addOperation(newOperation(operationsv1.ActionMigrate))
+			opController.Execute()
+
+			status := getOperationStatus()
+			Expect(status.InProgress).To(BeEmpty())
+			Expect(status.Pending).To(Equal([]string{"vm1", "vm2"}))
+			migrations, err := kubevirtClient.KubevirtV1().VirtualMachineInstanceMigrations(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(BeEmpty())
+		})
+	})
+
+	It("should fail VirtualMachines which do not become ready in time", func() {
+		addSelectedVMs(false, "vm1")
+		addOperation(newOperation(operationsv1.ActionStart))
+		opController.Execute()
+
+		now = now.Add(defaultTimeout + time.Second)
+		resync()
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationFailed))
+		Expect(status.Failed).To(Equal(int32(1)))
+		Expect(status.Failures[0].Reason).To(Equal(fmt.Sprintf(timedOutReason, defaultTimeout)))
+	})
+
+	It("should fail VirtualMachines which crash while they start", func() {
+		addSelectedVMs(false, "vm1")
+		addOperation(newOperation(operationsv1.ActionStart))
+		opController.Execute()
+
+		vm := addVM("vm1", false, map[string]string{selectorLabel: selectorValue})
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusCrashLoopBackOff
+		Expect(vmInformer.GetStore().Update(vm)).To(Succeed())
+		resync()
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationFailed))
+		Expect(status.Failures[0].Reason).To(Equal(string(v1.VirtualMachineStatusCrashLoopBackOff)))
+	})
+
+	It("should aggregate the failures by reason", func() {
+		addSelectedVMs(false, "vm1", "vm2")
+		addOperation(newOperation(operationsv1.ActionStart))
+		opController.Execute()
+
+		Expect(vmInformer.GetStore().Delete(&v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: testNamespace},
+		})).To(Succeed())
+		Expect(vmInformer.GetStore().Delete(&v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm2", Namespace: testNamespace},
+		})).To(Succeed())
+		resync()
+		status := getOperationStatus()
+		Expect(status.Failed).To(Equal(int32(2)))
+		Expect(status.Failures).To(ConsistOf(operationsv1.VirtualMachineOperationFailure{
+			Reason:          vmNotFoundReason,
+			Count:           2,
+			VirtualMachines: []string{"vm1", "vm2"},
+		}))
+	})
+
+	It("should stop applying the action after more than maxFailures failed", func() {
+		addSelectedVMs(false, "vm1", "vm2", "vm3")
+		operation := newOperation(operationsv1.ActionStart)
+		operation.Spec.MaxConcurrent = pointer.P(int32(1))
+		operation.Spec.MaxFailures = pointer.P(int32(0))
+		addOperation(operation)
+		opController.Execute()
+
+		now = now.Add(defaultTimeout + time.Second)
+		resync()
+		status := getOperationStatus()
+		Expect(status.Phase).To(Equal(operationsv1.OperationFailed))
+		Expect(status.FailureReason).To(Equal(fmt.Sprintf(tooManyFailuresMsg, 1, 0)))
+		Expect(status.Pending).To(Equal([]string{"vm2", "vm3"}))
+		Expect(subresourceCalls("start")).To(ConsistOf("vm1"))
+	})
+
+	It("should enqueue the running operations of the namespace when a VirtualMachine changes", func() {
+		operation := newOperation(operationsv1.ActionStart)
+		operation.Status = &operationsv1.VirtualMachineOperationStatus{Phase: operationsv1.OperationRunning}
+		Expect(operationInformer.GetStore().Add(operation)).To(Succeed())
+		done := newOperation(operationsv1.ActionStart)
+		done.Name = "done"
+		done.Status = &operationsv1.VirtualMachineOperationStatus{Phase: operationsv1.OperationSucceeded}
+		Expect(operationInformer.GetStore().Add(done)).To(Succeed())
+
+		opController.handleNamespacedObject(&v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: testNamespace},
+		})
+		Expect(opController.operationQueue.Len()).To(Equal(1))
+		key, _ := opController.operationQueue.Get()
+		Expect(key).To(Equal(fmt.Sprintf("%s/%s", testNamespace, operationName)))
+	})
+})
@@ -0,0 +1,305 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/defaults"
+	"kubevirt.io/kubevirt/pkg/instancetype/apply"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	computeContainerName          = "compute"
+	serialConsoleLogContainerName = "guest-console-log"
+
+	identityVolumeName = "launcher-warm-pool-identity"
+	identityMountPath  = "/var/run/kubevirt-warm-pool"
+	identityFileName   = "identity"
+
+	// The arguments of the compute container which carry the identity of
+	// the VMI. Idle virt-launchers read it from the identity file instead.
+	nameArg      = "--name"
+	uidArg       = "--uid"
+	namespaceArg = "--namespace"
+	identityArg  = "--warm-pool-identity-file"
+)
+
+type templateService interface {
+	RenderLaunchManifest(vmi *virtv1.VirtualMachineInstance) (*k8sv1.Pod, error)
+}
+
+// newPrototypeVMI returns the VMI the pods of the pool are rendered for. It
+// carries only what the instancetype and the cluster defaults set.
+func newPrototypeVMI(pool *virtv1.LauncherWarmPool, instancetype *instancetypev1beta1.VirtualMachineClusterInstancetype, clusterConfig *virtconfig.ClusterConfig) (*virtv1.VirtualMachineInstance, error) {
+	vmi := virtv1.NewVMIReferenceFromNameWithNS(pool.Namespace, pool.Name)
+	vmi.Spec.Architecture = pool.Architecture
+	if conflicts := apply.NewVMIApplier().ApplyToVMI(k8sfield.NewPath("spec"), &instancetype.Spec, nil, &vmi.Spec, &vmi.ObjectMeta); len(conflicts) > 0 {
+		return nil, fmt.Errorf("failed to apply instancetype %s: %v", instancetype.Name, conflicts)
+	}
+	if err := defaults.SetDefaultVirtualMachineInstance(clusterConfig, vmi); err != nil {
+		return nil, err
+	}
+	return vmi, nil
+}
+
+// renderWarmPod renders the launcher pod of the prototype VMI and turns it
+// into an idle pod of the pool.
+func renderWarmPod(templateService templateService, pool *virtv1.LauncherWarmPool, vmi *virtv1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	pod, err := templateService.RenderLaunchManifest(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	pod.GenerateName = fmt.Sprintf("virt-launcher-warm-%s-", pool.Name)
+	pod.OwnerReferences = nil
+	delete(pod.Labels, virtv1.CreatedByLabel)
+	delete(pod.Labels, virtv1.DeprecatedVirtualMachineNameLabel)
+	delete(pod.Labels, virtv1.VirtualMachineInstanceIDLabel)
+	pod.Labels[virtv1.LauncherWarmPoolLabel] = pool.Name
+	delete(pod.Annotations, virtv1.DomainAnnotation)
+	pod.Spec.Hostname = ""
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, k8sv1.Volume{
+		Name: identityVolumeName,
+		VolumeSource: k8sv1.VolumeSource{
+			DownwardAPI: &k8sv1.DownwardAPIVolumeSource{
+				Items: []k8sv1.DownwardAPIVolumeFile{{
+					Path: identityFileName,
+					FieldRef: &k8sv1.ObjectFieldSelector{
+						FieldPath: fmt.Sprintf("metadata.annotations['%s']", virtv1.LauncherWarmPoolIdentityAnnotation),
+					},
+				}},
+			},
+		},
+	})
+
+	compute := findContainer(pod.Spec.Containers, computeContainerName)
+	if compute == nil {
+		return nil, fmt.Errorf("launcher pod has no %s container", computeContainerName)
+	}
+	mountIdentity(compute)
+	compute.Command = append(removeIdentityArgs(compute.Command), identityArg, filepath.Join(identityMountPath, identityFileName))
+
+	// The UID of the VMI is missing in the path of the serial console log
+	if serialConsoleLog := findContainer(pod.Spec.InitContainers, serialConsoleLogContainerName); serialConsoleLog != nil {
+		mountIdentity(serialConsoleLog)
+		serialConsoleLog.Args = append(serialConsoleLog.Args, identityArg, filepath.Join(identityMountPath, identityFileName))
+	}
+
+	return pod, nil
+}
+
+func mountIdentity(container *k8sv1.Container) {
+	container.VolumeMounts = append(container.VolumeMounts, k8sv1.VolumeMount{
+		Name:      identityVolumeName,
+		MountPath: identityMountPath,
+		ReadOnly:  true,
+	})
+}
+
+// waitsForIdentity returns true for the containers whose arguments carry the
+// identity of the VMI.
+func waitsForIdentity(container *k8sv1.Container) bool {
+	return container.Name == computeContainerName || container.Name == serialConsoleLogContainerName
+}
+
+func removeIdentityArgs(command []string) []string {
+	var args []string
+	for i := 0; i < len(command); i++ {
+		switch command[i] {
+		case nameArg, uidArg, namespaceArg:
+			i++
+		default:
+			args = append(args, command[i])
+		}
+	}
+	return args
+}
+
+// IsIdle returns true if the pod is a running pod of a launcher warm pool
+// which was not adopted by a VMI yet.
+func IsIdle(pod *k8sv1.Pod) bool {
+	_, isWarm := pod.Labels[virtv1.LauncherWarmPoolLabel]
+	return isWarm &&
+		pod.DeletionTimestamp == nil &&
+		pod.Status.Phase == k8sv1.PodRunning &&
+		metav1.GetControllerOf(pod) == nil
+}
+
+// IsCompatible returns true if the VMI, whose launcher pod would be
+// templatePod, can run in the warm pod. Fields the API server or admission
+// plugins may add to the warm pod, like service account volumes or default
+// tolerations, are ignored.
+func IsCompatible(templatePod, warmPod *k8sv1.Pod) bool {
+	if templatePod.Annotations[networkv1.NetworkAttachmentAnnot] != warmPod.Annotations[networkv1.NetworkAttachmentAnnot] {
+		return false
+	}
+
+	template, warm := &templatePod.Spec, &warmPod.Spec
+	if !equality.Semantic.DeepEqual(template.NodeSelector, warm.NodeSelector) ||
+		!equality.Semantic.DeepEqual(template.Affinity, warm.Affinity) ||
+		!equality.Semantic.DeepEqual(template.SecurityContext, warm.SecurityContext) ||
+		!equality.Semantic.DeepEqual(template.RuntimeClassName, warm.RuntimeClassName) ||
+		!equality.Semantic.DeepEqual(template.ResourceClaims, warm.ResourceClaims) ||
+		!equality.Semantic.DeepEqual(template.DNSConfig, warm.DNSConfig) ||
+		template.PriorityClassName != warm.PriorityClassName ||
+		template.Subdomain != warm.Subdomain ||
+		(template.SchedulerName != "" && template.SchedulerName != warm.SchedulerName) ||
+		(template.ServiceAccountName != "" && template.ServiceAccountName != warm.ServiceAccountName) {
+		return false
+	}
+	for _, toleration := range template.Tolerations {
+		if !containsToleration(warm.Tolerations, toleration) {
+			return false
+		}
+	}
+	for _, volume := range template.Volumes {
+		warmVolume := findVolume(warm.Volumes, volume.Name)
+		if warmVolume == nil || !equality.Semantic.DeepEqual(volume.VolumeSource, warmVolume.VolumeSource) {
+			return false
+		}
+	}
+
+	return containersCompatible(template.InitContainers, warm.InitContainers) &&
+		containersCompatible(template.Containers, warm.Containers)
+}
+
+func containersCompatible(template, warm []k8sv1.Container) bool {
+	if len(template) != len(warm) {
+		return false
+	}
+	for i := range template {
+		if template[i].Name != warm[i].Name ||
+			template[i].Image != warm[i].Image ||
+			!equality.Semantic.DeepEqual(template[i].Resources, warm[i].Resources) ||
+			!equality.Semantic.DeepEqual(template[i].SecurityContext, warm[i].SecurityContext) ||
+			!equality.Semantic.DeepEqual(template[i].ReadinessProbe, warm[i].ReadinessProbe) ||
+			!equality.Semantic.DeepEqual(template[i].LivenessProbe, warm[i].LivenessProbe) ||
+			!equality.Semantic.DeepEqual(template[i].Ports, warm[i].Ports) ||
+			!equality.Semantic.DeepEqual(template[i].VolumeDevices, warm[i].VolumeDevices) {
+			return false
+		}
+		// The command of the compute container differs in the identity
+		// of the VMI and in the jitter of the qemu timeout.
+		if !waitsForIdentity(&template[i]) &&
+			(!equality.Semantic.DeepEqual(template[i].Command, warm[i].Command) ||
+				!equality.Semantic.DeepEqual(template[i].Args, warm[i].Args)) {
+			return false
+		}
+		for _, env := range template[i].Env {
+			if !containsEnvVar(warm[i].Env, env) {
+				return false
+			}
+		}
+		for _, mount := range template[i].VolumeMounts {
+			if !containsVolumeMount(warm[i].VolumeMounts, mount) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// AdoptionPatch returns the merge patch which hands the warm pod over to the
+// VMI of templatePod. It takes over the labels, annotations and owner
+// references of templatePod, and passes the identity of the VMI to the
+// waiting virt-launcher. The resource version makes sure that only one VMI
+// adopts the pod.
+func AdoptionPatch(templatePod, warmPod *k8sv1.Pod, vmi *virtv1.VirtualMachineInstance) ([]byte, error) {
+	labels := map[string]interface{}{
+		virtv1.LauncherWarmPoolLabel: nil,
+	}
+	for key, value := range templatePod.Labels {
+		labels[key] = value
+	}
+	annotations := map[string]interface{}{
+		virtv1.LauncherWarmPoolIdentityAnnotation: fmt.Sprintf("%s/%s/%s", vmi.Namespace, vmi.Name, vmi.UID),
+	}
+	for key, value := range templatePod.Annotations {
+		annotations[key] = value
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": warmPod.ResourceVersion,
+			"labels":          labels,
+			"annotations":     annotations,
+			"ownerReferences": templatePod.OwnerReferences,
+		},
+	})
+}
+
+func findContainer(containers []k8sv1.Container, name string) *k8sv1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+func findVolume(volumes []k8sv1.Volume, name string) *k8sv1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func containsToleration(tolerations []k8sv1.Toleration, toleration k8sv1.Toleration) bool {
+	for i := range tolerations {
+		if equality.Semantic.DeepEqual(tolerations[i], toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEnvVar(envVars []k8sv1.EnvVar, envVar k8sv1.EnvVar) bool {
+	for i := range envVars {
+		if equality.Semantic.DeepEqual(envVars[i], envVar) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVolumeMount(mounts []k8sv1.VolumeMount, mount k8sv1.VolumeMount) bool {
+	for i := range mounts {
+		if equality.Semantic.DeepEqual(mounts[i], mount) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+var _ = Describe("Launcher warm pool pods", func() {
+	var (
+		clusterConfig   *virtconfig.ClusterConfig
+		templateService *services.TemplateService
+		pool            *virtv1.LauncherWarmPool
+		prototype       *virtv1.VirtualMachineInstance
+		warmPod         *k8sv1.Pod
+	)
+
+	newVMI := func() *virtv1.VirtualMachineInstance {
+		vmi := prototype.DeepCopy()
+		vmi.Name = "testvmi"
+		vmi.UID = types.UID("1234")
+		return vmi
+	}
+
+	render := func(vmi *virtv1.VirtualMachineInstance) *k8sv1.Pod {
+		pod, err := templateService.RenderLaunchManifest(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		templateService = services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, clusterConfig, 107, "g", rqInformer.GetStore(), nsInformer.GetStore())
+
+		pool = &virtv1.LauncherWarmPool{
+			Name:         "small",
+			Namespace:    metav1.NamespaceDefault,
+			Instancetype: "u1.small",
+			Size:         1,
+		}
+		var err error
+		prototype, err = newPrototypeVMI(pool, &instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{Name: "u1.small"},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: 1},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("2Gi")},
+			},
+		}, clusterConfig)
+		Expect(err).ToNot(HaveOccurred())
+		warmPod, err = renderWarmPod(templateService, pool, prototype)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should render the pod without the identity of a VMI", func() {
+		Expect(warmPod.GenerateName).To(Equal("virt-launcher-warm-small-"))
+		Expect(warmPod.Spec.Hostname).To(BeEmpty())
+		Expect(warmPod.Annotations).ToNot(HaveKey(virtv1.DomainAnnotation))
+		compute := findContainer(warmPod.Spec.Containers, computeContainerName)
+		Expect(compute.Command).ToNot(ContainElements(nameArg, namespaceArg, uidArg))
+		Expect(compute.VolumeMounts).To(ContainElement(HaveField("Name", identityVolumeName)))
+		serialConsoleLog := findContainer(warmPod.Spec.InitContainers, serialConsoleLogContainerName)
+		Expect(serialConsoleLog.Args).To(ContainElement(identityArg))
+		Expect(serialConsoleLog.VolumeMounts).To(ContainElement(HaveField("Name", identityVolumeName)))
+	})
+
+	It("should consider VMIs of the instancetype compatible", func() {
+		Expect(IsCompatible(render(newVMI()), warmPod)).To(BeTrue())
+	})
+
+	DescribeTable("should consider VMIs incompatible", func(modify func(vmi *virtv1.VirtualMachineInstance)) {
+		vmi := newVMI()
+		modify(vmi)
+		Expect(IsCompatible(render(vmi), warmPod)).To(BeFalse())
+	},
+		Entry("with more memory", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Memory.Guest = resource.NewQuantity(4*1024*1024*1024, resource.BinarySI)
+		}),
+		Entry("with a ConfigMap", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, virtv1.Volume{
+				Name: "config",
+				VolumeSource: virtv1.VolumeSource{ConfigMap: &virtv1.ConfigMapVolumeSource{
+					LocalObjectReference: k8sv1.LocalObjectReference{Name: "config"},
+				}},
+			})
+		}),
+		Entry("with a node selector", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Spec.NodeSelector = map[string]string{"zone": "a"}
+		}),
+	)
+
+	It("should hand the pod over to the VMI", func() {
+		kubeClient := fake.NewSimpleClientset()
+		warmPod.Name = "virt-launcher-warm-small-abcde"
+		warmPod, err := kubeClient.CoreV1().Pods(warmPod.Namespace).Create(context.Background(), warmPod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		vmi := newVMI()
+		templatePod := render(vmi)
+		patch, err := AdoptionPatch(templatePod, warmPod, vmi)
+		Expect(err).ToNot(HaveOccurred())
+		pod, err := kubeClient.CoreV1().Pods(warmPod.Namespace).Patch(context.Background(), warmPod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(pod.Labels).ToNot(HaveKey(virtv1.LauncherWarmPoolLabel))
+		Expect(pod.Labels).To(HaveKeyWithValue(virtv1.CreatedByLabel, "1234"))
+		Expect(pod.Annotations).To(HaveKeyWithValue(virtv1.LauncherWarmPoolIdentityAnnotation, "default/testvmi/1234"))
+		Expect(pod.Annotations).To(HaveKeyWithValue(virtv1.DomainAnnotation, "testvmi"))
+		Expect(metav1.IsControlledBy(pod, vmi)).To(BeTrue())
+	})
+
+	It("should only consider running pods without owner idle", func() {
+		warmPod.Status.Phase = k8sv1.PodPending
+		Expect(IsIdle(warmPod)).To(BeFalse())
+		warmPod.Status.Phase = k8sv1.PodRunning
+		Expect(IsIdle(warmPod)).To(BeTrue())
+		warmPod.OwnerReferences = render(newVMI()).OwnerReferences
+		Expect(IsIdle(warmPod)).To(BeFalse())
+	})
+})
@@ -0,0 +1,343 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// burstPods limits the number of pods created or deleted per pool in one sync.
+const burstPods = 10
+
+// Controller keeps the configured launcher warm pools at their size. The
+// pods of a pool are virt-launcher pods rendered for the instancetype of the
+// pool, which idle until the VMI controller hands one of them over to a new
+// VMI. Adopted pods leave the pool and are replaced.
+type Controller struct {
+	clientset         kubecli.KubevirtClient
+	Queue             workqueue.TypedRateLimitingInterface[string]
+	clusterConfig     *virtconfig.ClusterConfig
+	templateService   templateService
+	podIndexer        cache.Indexer
+	instancetypeStore cache.Store
+	expectations      *controller.ControllerExpectations
+	hasSynced         func() bool
+}
+
+func NewController(templateService templateService, podInformer, clusterInstancetypeInformer cache.SharedIndexInformer, clientset kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig) (*Controller, error) {
+	c := &Controller{
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-warm-pool"},
+		),
+		clientset:         clientset,
+		clusterConfig:     clusterConfig,
+		templateService:   templateService,
+		podIndexer:        podInformer.GetIndexer(),
+		instancetypeStore: clusterInstancetypeInformer.GetStore(),
+		expectations:      controller.NewControllerExpectations(),
+	}
+
+	c.hasSynced = func() bool {
+		return podInformer.HasSynced() && clusterInstancetypeInformer.HasSynced()
+	}
+
+	_, err := podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addPod,
+		DeleteFunc: c.deletePod,
+		UpdateFunc: c.updatePod,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = clusterInstancetypeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addInstancetype,
+		UpdateFunc: func(_, cur interface{}) { c.addInstancetype(cur) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterConfig.SetConfigModifiedCallback(c.enqueueAllPools)
+
+	return c, nil
+}
+
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting launcher warm pool controller.")
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+	c.enqueueAllPools()
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping launcher warm pool controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("re-enqueuing launcher warm pool %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed launcher warm pool %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	if !c.expectations.SatisfiedExpectations(key) {
+		return nil
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil
+	}
+	pods, err := c.listIdlePods(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	pool := c.findPool(namespace, name)
+	if pool == nil || !c.clusterConfig.LauncherWarmPoolEnabled() {
+		return c.deletePods(key, pods)
+	}
+
+	obj, exists, err := c.instancetypeStore.GetByKey(pool.Instancetype)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("VirtualMachineClusterInstancetype %s of launcher warm pool %s does not exist", pool.Instancetype, key)
+	}
+	prototype, err := newPrototypeVMI(pool, obj.(*instancetypev1beta1.VirtualMachineClusterInstancetype), c.clusterConfig)
+	if err != nil {
+		return err
+	}
+	desiredPod, err := renderWarmPod(c.templateService, pool, prototype)
+	if err != nil {
+		return err
+	}
+
+	var current, outdated []*k8sv1.Pod
+	for _, pod := range pods {
+		if pod.Status.Phase == k8sv1.PodFailed || pod.Status.Phase == k8sv1.PodSucceeded || !IsCompatible(desiredPod, pod) {
+			outdated = append(outdated, pod)
+		} else {
+			current = append(current, pod)
+		}
+	}
+
+	diff := int(pool.Size) - len(current)
+	if diff < 0 {
+		// Prefer to delete the pods which are not running yet
+		sort.SliceStable(current, func(i, j int) bool {
+			return current[i].Status.Phase != k8sv1.PodRunning && current[j].Status.Phase == k8sv1.PodRunning
+		})
+		outdated = append(outdated, current[:-diff]...)
+	}
+	if err := c.deletePods(key, outdated); err != nil {
+		return err
+	}
+	if diff > 0 {
+		return c.createPods(key, namespace, desiredPod, min(diff, burstPods))
+	}
+	return nil
+}
+
+func (c *Controller) findPool(namespace, name string) *virtv1.LauncherWarmPool {
+	pools := c.clusterConfig.GetLauncherWarmPools()
+	for i := range pools {
+		if pools[i].Namespace == namespace && pools[i].Name == name {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+// listIdlePods returns the pods of the pool which were not adopted by a VMI.
+func (c *Controller) listIdlePods(namespace, name string) ([]*k8sv1.Pod, error) {
+	objs, err := c.podIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*k8sv1.Pod
+	for _, obj := range objs {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Labels[virtv1.LauncherWarmPoolLabel] == name && pod.DeletionTimestamp == nil && metav1.GetControllerOf(pod) == nil {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+func (c *Controller) createPods(key, namespace string, pod *k8sv1.Pod, count int) error {
+	log.Log.V(4).Infof("Creating %d pods for launcher warm pool %s", count, key)
+	c.expectations.ExpectCreations(key, count)
+	var errs []error
+	for i := 0; i < count; i++ {
+		if _, err := c.clientset.CoreV1().Pods(namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil {
+			c.expectations.CreationObserved(key)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Controller) deletePods(key string, pods []*k8sv1.Pod) error {
+	if len(pods) > burstPods {
+		pods = pods[:burstPods]
+	}
+	if len(pods) == 0 {
+		return nil
+	}
+	log.Log.V(4).Infof("Deleting %d pods of launcher warm pool %s", len(pods), key)
+	c.expectations.ExpectDeletions(key, len(pods))
+	var errs []error
+	for _, pod := range pods {
+		err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+		if err != nil {
+			c.expectations.DeletionObserved(key)
+			if !k8serrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*k8sv1.Pod)
+	key, isWarm := poolKey(pod)
+	if !isWarm {
+		return
+	}
+	if pod.DeletionTimestamp != nil {
+		c.deletePod(pod)
+		return
+	}
+	c.expectations.CreationObserved(key)
+	c.Queue.Add(key)
+}
+
+func (c *Controller) updatePod(old, cur interface{}) {
+	curPod := cur.(*k8sv1.Pod)
+	oldPod := old.(*k8sv1.Pod)
+	if curPod.ResourceVersion == oldPod.ResourceVersion {
+		return
+	}
+	// Adopted pods lose the label of the pool, which has to replace them
+	if key, isWarm := poolKey(oldPod); isWarm {
+		c.Queue.Add(key)
+	}
+	if key, isWarm := poolKey(curPod); isWarm {
+		c.Queue.Add(key)
+	}
+}
+
+func (c *Controller) deletePod(obj interface{}) {
+	pod, ok := obj.(*k8sv1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		pod, ok = tombstone.Obj.(*k8sv1.Pod)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a pod %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	key, isWarm := poolKey(pod)
+	if !isWarm {
+		return
+	}
+	c.expectations.DeletionObserved(key)
+	c.Queue.Add(key)
+}
+
+func (c *Controller) addInstancetype(obj interface{}) {
+	instancetype := obj.(*instancetypev1beta1.VirtualMachineClusterInstancetype)
+	for _, pool := range c.clusterConfig.GetLauncherWarmPools() {
+		if pool.Instancetype == instancetype.Name {
+			c.Queue.Add(controller.NamespacedKey(pool.Namespace, pool.Name))
+		}
+	}
+}
+
+// enqueueAllPools enqueues the configured pools, and the pools which still
+// have pods but were removed from the configuration.
+func (c *Controller) enqueueAllPools() {
+	for _, pool := range c.clusterConfig.GetLauncherWarmPools() {
+		c.Queue.Add(controller.NamespacedKey(pool.Namespace, pool.Name))
+	}
+	for _, obj := range c.podIndexer.List() {
+		if key, isWarm := poolKey(obj.(*k8sv1.Pod)); isWarm {
+			c.Queue.Add(key)
+		}
+	}
+}
+
+func poolKey(pod *k8sv1.Pod) (string, bool) {
+	name, isWarm := pod.Labels[virtv1.LauncherWarmPoolLabel]
+	if !isWarm {
+		return "", false
+	}
+	return controller.NamespacedKey(pod.Namespace, name), true
+}
@@ -0,0 +1,238 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	testPool         = "small"
+	testInstancetype = "u1.small"
+)
+
+var _ = Describe("Launcher warm pool controller", func() {
+	var (
+		controller        *Controller
+		kubeClient        *fake.Clientset
+		kvStore           cache.Store
+		templateService   *services.TemplateService
+		instancetypeStore cache.Store
+	)
+
+	poolKey := metav1.NamespaceDefault + "/" + testPool
+
+	newKubeVirtConfig := func(featureGates []string, pools ...virtv1.LauncherWarmPool) *virtv1.KubeVirtConfiguration {
+		return &virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{FeatureGates: featureGates},
+			LauncherWarmPools:      pools,
+		}
+	}
+
+	newPool := func(size uint32) virtv1.LauncherWarmPool {
+		return virtv1.LauncherWarmPool{
+			Name:         testPool,
+			Namespace:    metav1.NamespaceDefault,
+			Instancetype: testInstancetype,
+			Size:         size,
+		}
+	}
+
+	updateConfig := func(config *virtv1.KubeVirtConfiguration) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &virtv1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec:       virtv1.KubeVirtSpec{Configuration: *config},
+			Status:     virtv1.KubeVirtStatus{Phase: virtv1.KubeVirtPhaseDeploying},
+		})
+	}
+
+	listPods := func() []k8sv1.Pod {
+		pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pods.Items
+	}
+
+	expectPodsReplaced := func() {
+		pods := listPods()
+		Expect(pods).To(HaveLen(2))
+		for _, pod := range pods {
+			_, exists, err := controller.podIndexer.Get(&pod)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse(), fmt.Sprintf("pod %s was not replaced", pod.Name))
+		}
+	}
+
+	// addPods syncs the pods created by the controller into the cache, like
+	// the pod informer would.
+	addPods := func(phase k8sv1.PodPhase) {
+		for _, pod := range listPods() {
+			pod.Status.Phase = phase
+			Expect(controller.podIndexer.Add(pod.DeepCopy())).To(Succeed())
+			controller.expectations.CreationObserved(poolKey)
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		kvtesting.PrependGenerateNameCreateReactor(&kubeClient.Fake, "pods")
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		var config *virtconfig.ClusterConfig
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(newKubeVirtConfig([]string{featuregate.LauncherWarmPoolGate}, newPool(2)))
+
+		podInformer, _ := testutils.NewFakeInformerWithIndexersFor(&k8sv1.Pod{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		rqInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		instancetypeStore = instancetypeInformer.GetStore()
+		Expect(instancetypeStore.Add(&instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{Name: testInstancetype},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: 1},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("2Gi")},
+			},
+		})).To(Succeed())
+
+		templateService = services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, 107, "g", rqInformer.GetStore(), nsInformer.GetStore())
+
+		var err error
+		controller, err = NewController(templateService, podInformer, instancetypeInformer, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fill the pool with idle launcher pods", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+
+		pods := listPods()
+		Expect(pods).To(HaveLen(2))
+		for _, pod := range pods {
+			Expect(pod.Labels).To(HaveKeyWithValue(virtv1.LauncherWarmPoolLabel, testPool))
+			Expect(pod.Labels).ToNot(HaveKey(virtv1.CreatedByLabel))
+			Expect(pod.OwnerReferences).To(BeEmpty())
+			compute := findContainer(pod.Spec.Containers, computeContainerName)
+			Expect(compute.Command).To(ContainElements(identityArg, "/var/run/kubevirt-warm-pool/identity"))
+			Expect(compute.Command).ToNot(ContainElement(uidArg))
+			Expect(findVolume(pod.Spec.Volumes, identityVolumeName)).ToNot(BeNil())
+		}
+	})
+
+	It("should not create pods while it waits for created pods to show up", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		Expect(controller.execute(poolKey)).To(Succeed())
+		Expect(listPods()).To(HaveLen(2))
+	})
+
+	It("should replace adopted pods", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		addPods(k8sv1.PodRunning)
+
+		adopted := controller.podIndexer.List()[0].(*k8sv1.Pod)
+		adopted.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: virtv1.GroupVersion.String(),
+			Kind:       "VirtualMachineInstance",
+			Name:       "testvmi",
+			UID:        types.UID("1234"),
+			Controller: pointer.P(true),
+		}}
+
+		Expect(controller.execute(poolKey)).To(Succeed())
+		Expect(listPods()).To(HaveLen(3))
+	})
+
+	It("should delete pods exceeding the size of the pool", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		addPods(k8sv1.PodRunning)
+
+		updateConfig(newKubeVirtConfig([]string{featuregate.LauncherWarmPoolGate}, newPool(1)))
+		Expect(controller.execute(poolKey)).To(Succeed())
+		Expect(listPods()).To(HaveLen(1))
+	})
+
+	It("should replace failed pods", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		addPods(k8sv1.PodFailed)
+
+		Expect(controller.execute(poolKey)).To(Succeed())
+		expectPodsReplaced()
+	})
+
+	It("should replace pods of a changed instancetype", func() {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		addPods(k8sv1.PodRunning)
+
+		Expect(instancetypeStore.Update(&instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{Name: testInstancetype},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: 1},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("4Gi")},
+			},
+		})).To(Succeed())
+
+		Expect(controller.execute(poolKey)).To(Succeed())
+		expectPodsReplaced()
+	})
+
+	DescribeTable("should delete all pods", func(config *virtv1.KubeVirtConfiguration) {
+		Expect(controller.execute(poolKey)).To(Succeed())
+		addPods(k8sv1.PodRunning)
+
+		updateConfig(config)
+		Expect(controller.execute(poolKey)).To(Succeed())
+		Expect(listPods()).To(BeEmpty())
+	},
+		Entry("when the feature gate is disabled", newKubeVirtConfig(nil, newPool(2))),
+		Entry("when the pool is removed", newKubeVirtConfig([]string{featuregate.LauncherWarmPoolGate})),
+	)
+
+	It("should fail when the instancetype does not exist", func() {
+		Expect(instancetypeStore.Delete(&instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{Name: testInstancetype},
+		})).To(Succeed())
+
+		Expect(controller.execute(poolKey)).To(MatchError(ContainSubstring("does not exist")))
+		Expect(listPods()).To(BeEmpty())
+	})
+})
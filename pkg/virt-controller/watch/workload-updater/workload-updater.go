@@ -32,6 +32,7 @@ import (
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
 )
 
 const (
@@ -61,16 +62,17 @@ const defaultBatchDeletionIntervalSeconds = 60
 const defaultBatchDeletionCount = 10
 
 type WorkloadUpdateController struct {
-	clientset             kubecli.KubevirtClient
-	queue                 workqueue.TypedRateLimitingInterface[string]
-	vmiStore              cache.Store
-	podIndexer            cache.Indexer
-	migrationIndexer      cache.Indexer
-	recorder              record.EventRecorder
-	migrationExpectations *controller.UIDTrackingControllerExpectations
-	kubeVirtStore         cache.Store
-	clusterConfig         *virtconfig.ClusterConfig
-	launcherImage         string
+	clientset                 kubecli.KubevirtClient
+	queue                     workqueue.TypedRateLimitingInterface[string]
+	vmiStore                  cache.Store
+	podIndexer                cache.Indexer
+	migrationIndexer          cache.Indexer
+	vmDisruptionBudgetIndexer cache.Indexer
+	recorder                  record.EventRecorder
+	migrationExpectations     *controller.UIDTrackingControllerExpectations
+	kubeVirtStore             cache.Store
+	clusterConfig             *virtconfig.ClusterConfig
+	launcherImage             string
 
 	lastDeletionBatch time.Time
 
@@ -83,15 +85,24 @@ type updateData struct {
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
 
+	// vmisWithMigration holds the keys of the VMIs with unfinished migrations
+	vmisWithMigration map[string]bool
+
 	numActiveMigrations int
 }
 
+// isDisrupted reports whether a VMI counts towards its disruption budgets
+func (d *updateData) isDisrupted(vmi *virtv1.VirtualMachineInstance) bool {
+	return (vmi.DeletionTimestamp != nil && !vmi.IsFinal()) || migrationutils.IsMigrating(vmi) || d.vmisWithMigration[vmi.Namespace+"/"+vmi.Name]
+}
+
 func NewWorkloadUpdateController(
 	launcherImage string,
 	vmiInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	vmDisruptionBudgetInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -107,17 +118,18 @@ func NewWorkloadUpdateController(
 			rl,
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-workload-update"},
 		),
-		vmiStore:              vmiInformer.GetStore(),
-		podIndexer:            podInformer.GetIndexer(),
-		migrationIndexer:      migrationInformer.GetIndexer(),
-		kubeVirtStore:         kubeVirtInformer.GetStore(),
-		recorder:              recorder,
-		clientset:             clientset,
-		launcherImage:         launcherImage,
-		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
-		clusterConfig:         clusterConfig,
+		vmiStore:                  vmiInformer.GetStore(),
+		podIndexer:                podInformer.GetIndexer(),
+		migrationIndexer:          migrationInformer.GetIndexer(),
+		vmDisruptionBudgetIndexer: vmDisruptionBudgetInformer.GetIndexer(),
+		kubeVirtStore:             kubeVirtInformer.GetStore(),
+		recorder:                  recorder,
+		clientset:                 clientset,
+		launcherImage:             launcherImage,
+		migrationExpectations:     controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		clusterConfig:             clusterConfig,
 		hasSynced: func() bool {
-			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced()
+			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced() && vmDisruptionBudgetInformer.HasSynced()
 		},
 	}
 
@@ -373,6 +385,7 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 	for _, migration := range migrations {
 		lookup[migration.Namespace+"/"+migration.Spec.VMIName] = true
 	}
+	data.vmisWithMigration = lookup
 
 	automatedMigrationAllowed := false
 	automatedShutdownAllowed := false
@@ -549,6 +562,19 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		evictionCandidates = data.evictOutdatedVMIs[0:batchDeletionCount]
 	}
 
+	// Skip the VMIs whose disruption would exceed a VirtualMachineDisruptionBudget,
+	// they are retried with the periodic re-enqueue
+	budgets := vmpolicy.NewDisruptionBudgets(c.vmDisruptionBudgetIndexer, c.vmiStore, data.isDisrupted)
+	migrationCandidates, err = budgets.Filter(migrationCandidates)
+	if err != nil {
+		return err
+	}
+	evictionCandidates, err = budgets.Filter(evictionCandidates)
+	if err != nil {
+		return err
+	}
+	migrateCount = len(migrationCandidates)
+
 	wgLen := len(migrationCandidates) + len(evictionCandidates) + len(data.abortChangeVMIs)
 	wg := &sync.WaitGroup{}
 	wg.Add(wgLen)
@@ -23,6 +23,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
@@ -98,8 +99,11 @@ var _ = Describe("Workload Updater", func() {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 		kubeVirtInformer, _ := testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		vmDisruptionBudgetInformer, _ := testutils.NewFakeInformerWithIndexersFor(&policyv1alpha1.VirtualMachineDisruptionBudget{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, vmDisruptionBudgetInformer, recorder, virtClient, config)
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
@@ -139,6 +143,39 @@ var _ = Describe("Workload Updater", func() {
 			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm"))
 		})
 
+		It("should not exceed VirtualMachineDisruptionBudgets", func() {
+			controller.vmDisruptionBudgetIndexer.Add(&policyv1alpha1.VirtualMachineDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: k8sv1.NamespaceDefault},
+				Spec: policyv1alpha1.VirtualMachineDisruptionBudgetSpec{
+					Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					MaxDisruptions: 2,
+				},
+			})
+			for i := 0; i < 3; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup")
+				vmi.Labels = map[string]string{"app": "db"}
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			for i := 0; i < 3; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-%d", i), false, "madeup")
+				vmi.Labels = map[string]string{"app": "db"}
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 6)
+
+			kv := newKubeVirt(6)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(2))
+		})
+
 		It("should do nothing if deployment is updating", func() {
 			vmi := newVirtualMachineInstance("testvm", true, "madeup")
 			pod := newLauncherPodForVMI(vmi)
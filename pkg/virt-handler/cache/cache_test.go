@@ -328,6 +328,31 @@ var _ = Describe("Domain informer", func() {
 			Expect(val).To(Equal("some-value"))
 		})
 
+		It("should only resync domains without recent events.", func() {
+			domain := api.NewMinimalDomain(domainName)
+			domainManager.EXPECT().ListAllDomains().Return([]*api.Domain{domain}, nil)
+			domainManager.EXPECT().GetGuestOSInfo().Return(&api.GuestOSInfo{})
+			domainManager.EXPECT().InterfacesStatus().Return([]api.InterfaceStatus{})
+
+			runCMDServer(wg, socketPath, domainManager, stopChan, nil)
+
+			d := &domainWatcher{
+				eventChan:    make(chan watch.Event, 10),
+				resyncPeriod: time.Hour,
+				lastNotified: make(map[string]time.Time),
+			}
+			notifiedDomain := api.NewMinimalDomainWithNS(domainNamespace, domainName)
+			d.handleNotifyEvent(watch.Event{Type: watch.Modified, Object: notifiedDomain})
+			Expect(d.eventChan).To(Receive())
+
+			d.handleResync()
+			Expect(d.eventChan).ToNot(Receive())
+
+			d.lastNotified[domainNamespace+"/"+domainName] = time.Now().Add(-2 * time.Hour)
+			d.handleResync()
+			Expect(d.eventChan).To(Receive())
+		})
+
 		It("should detect unresponsive sockets.", func() {
 			f, err := os.Create(socketPath)
 			Expect(err).ToNot(HaveOccurred())
@@ -360,6 +385,28 @@ var _ = Describe("Domain informer", func() {
 			Expect(timedOut).To(BeFalse())
 		})
 
+		It("should not mark the sockets of reattaching launchers for deletion.", func() {
+			f, err := os.Create(socketPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(f.Close()).To(Succeed())
+			marker := filepath.Join(filepath.Dir(socketPath), cmdclient.StandardLauncherReattachingFileName)
+			Expect(os.WriteFile(marker, nil, 0644)).To(Succeed())
+
+			d := &domainWatcher{
+				backgroundWatcherStarted: false,
+				virtShareDir:             shareDir,
+				watchdogTimeout:          1,
+				unresponsiveSockets:      make(map[string]int64),
+				resyncPeriod:             1 * time.Hour,
+			}
+
+			err = d.startBackground()
+			Expect(err).ToNot(HaveOccurred())
+			defer d.Stop()
+
+			Consistently(d.eventChan).WithTimeout(5 * time.Second).ShouldNot(Receive())
+		})
+
 		It("should detect responsive sockets and not mark for deletion.", func() {
 			l, err := net.Listen("unix", socketPath)
 			Expect(err).ToNot(HaveOccurred())
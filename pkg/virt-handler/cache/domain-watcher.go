@@ -45,6 +45,7 @@ type domainWatcher struct {
 	wg                       sync.WaitGroup
 	stopChan                 chan struct{}
 	eventChan                chan watch.Event
+	notifyChan               chan watch.Event
 	backgroundWatcherStarted bool
 	virtShareDir             string
 	watchdogTimeout          int
@@ -54,6 +55,10 @@ type domainWatcher struct {
 
 	watchDogLock        sync.Mutex
 	unresponsiveSockets map[string]int64
+
+	// lastNotified tracks when a domain event was last received per domain
+	// key. It is only accessed from the worker.
+	lastNotified map[string]time.Time
 }
 
 func newListWatchFromNotify(virtShareDir string, watchdogTimeout int, recorder record.EventRecorder, vmiStore cache.Store, resyncPeriod time.Duration) cache.ListerWatcher {
@@ -65,6 +70,7 @@ func newListWatchFromNotify(virtShareDir string, watchdogTimeout int, recorder r
 		vmiStore:                 vmiStore,
 		unresponsiveSockets:      make(map[string]int64),
 		resyncPeriod:             resyncPeriod,
+		lastNotified:             make(map[string]time.Time),
 	}
 
 	return d
@@ -88,12 +94,14 @@ func (d *domainWatcher) worker() {
 	srvErr := make(chan error)
 	go func() {
 		defer close(srvErr)
-		err := notifyserver.RunServer(d.virtShareDir, d.stopChan, d.eventChan, d.recorder, d.vmiStore)
+		err := notifyserver.RunServer(d.virtShareDir, d.stopChan, d.notifyChan, d.recorder, d.vmiStore)
 		srvErr <- err
 	}()
 
 	for {
 		select {
+		case event := <-d.notifyChan:
+			d.handleNotifyEvent(event)
 		case <-resyncTickerChan:
 			d.handleResync()
 		case <-expiredWatchdogTickerChan:
@@ -119,6 +127,7 @@ func (d *domainWatcher) startBackground() error {
 
 	d.stopChan = make(chan struct{}, 1)
 	d.eventChan = make(chan watch.Event, 100)
+	d.notifyChan = make(chan watch.Event, 100)
 
 	d.wg.Add(1)
 	go d.worker()
@@ -127,38 +136,60 @@ func (d *domainWatcher) startBackground() error {
 	return nil
 }
 
-func (d *domainWatcher) handleResync() {
-	socketFiles, err := listSockets(GhostRecordGlobalStore.list())
-	if err != nil {
-		log.Log.Reason(err).Error("failed to list sockets")
-		return
+// handleNotifyEvent forwards a domain event sent by a virt-launcher and
+// remembers when the domain was last heard of.
+func (d *domainWatcher) handleNotifyEvent(event watch.Event) {
+	if domain, ok := event.Object.(*api.Domain); ok {
+		d.lastNotified[domain.ObjectMeta.Namespace+"/"+domain.ObjectMeta.Name] = time.Now()
 	}
+	d.eventChan <- event
+}
 
-	log.Log.Infof("resyncing virt-launcher domains")
-	for _, socket := range socketFiles {
-		client, err := cmdclient.NewClient(socket)
-		if err != nil {
-			log.Log.Reason(err).Error("failed to connect to cmd client socket during resync")
-			// Ignore failure to connect to client.
-			// These are all local connections via unix socket.
-			// A failure to connect means there's nothing on the other
-			// end listening.
+// handleResync is the reconciliation fallback for the domain events. It only
+// fetches domains which did not send any event within the resync period,
+// since the events of the others are recent enough.
+func (d *domainWatcher) handleResync() {
+	records := GhostRecordGlobalStore.list()
+	known := make(map[string]struct{}, len(records))
+
+	var resynced int
+	for _, record := range records {
+		key := record.Namespace + "/" + record.Name
+		known[key] = struct{}{}
+		if lastNotified, exists := d.lastNotified[key]; exists && time.Since(lastNotified) < d.resyncPeriod {
 			continue
 		}
-		defer client.Close()
 
-		domain, exists, err := client.GetDomain()
+		domain, exists, err := getDomainFromSocket(record.SocketFile)
 		if err != nil {
 			// this resync is best effort only.
-			log.Log.Reason(err).Errorf("unable to retrieve domain at socket %s during resync", socket)
+			log.Log.Reason(err).Errorf("unable to retrieve domain at socket %s during resync", record.SocketFile)
 			continue
 		} else if !exists {
 			// nothing to sync if it doesn't exist
 			continue
 		}
+		resynced++
 
 		d.eventChan <- watch.Event{Type: watch.Modified, Object: domain}
 	}
+
+	for key := range d.lastNotified {
+		if _, exists := known[key]; !exists {
+			delete(d.lastNotified, key)
+		}
+	}
+	log.Log.Infof("resynced %d of %d virt-launcher domains", resynced, len(records))
+}
+
+func getDomainFromSocket(socket string) (*api.Domain, bool, error) {
+	client, err := cmdclient.NewClient(socket)
+	if err != nil {
+		return nil, false, err
+	}
+	defer client.Close()
+
+	return client.GetDomain()
 }
 
 func (d *domainWatcher) handleStaleSocketConnections() error {
@@ -177,6 +208,11 @@ func (d *domainWatcher) handleStaleSocketConnections() error {
 			sock.Close()
 			continue
 		}
+		if cmdclient.IsSocketReattaching(socket) {
+			// a restarted virt-launcher reattaches to the running domain
+			log.Log.V(3).Infof("virt-launcher command socket %s is reattaching", socket)
+			continue
+		}
 		unresponsive = append(unresponsive, socket)
 	}
 
@@ -65,6 +65,11 @@ var (
 const StandardLauncherSocketFileName = "launcher-sock"
 const StandardInitLauncherSocketFileName = "launcher-init-sock"
 const StandardLauncherUnresponsiveFileName = "launcher-unresponsive"
+const StandardLauncherReattachingFileName = "launcher-reattaching"
+
+// LauncherReattachGracePeriod is the time a virt-launcher, which restarts after
+// a crash, may need until its command socket is responsive again.
+const LauncherReattachGracePeriod = 2 * time.Minute
 
 type MigrationOptions struct {
 	Bandwidth                resource.Quantity
@@ -79,13 +84,14 @@ type MigrationOptions struct {
 
 type LauncherClient interface {
 	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
-	PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	PauseVirtualMachine(vmi *v1.VirtualMachineInstance, autoUnpauseTimeoutSeconds int32) error
 	UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error
 	UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	SyncMigrationTarget(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	ResetVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	SoftRebootVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	RebootVirtualMachine(vmi *v1.VirtualMachineInstance, method v1.RebootMethod) error
 	SignalTargetPodCleanup(vmi *v1.VirtualMachineInstance) error
 	ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	KillVirtualMachine(vmi *v1.VirtualMachineInstance) error
@@ -109,9 +115,13 @@ type LauncherClient interface {
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	GetSEVSNPAttestationReport(*v1.VirtualMachineInstance, *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error)
+	GetTPMAttestation(*v1.VirtualMachineInstance, *v1.TPMAttestationOptions) (*v1.TPMAttestation, error)
 	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	ResizeVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats() (dirtyRateMbps int64, err error)
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	SetVNCResolution(*v1.VirtualMachineInstance, *v1.VNCResolutionOptions) error
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
 }
 
@@ -170,6 +180,24 @@ func MarkSocketUnresponsive(socket string) error {
 	return err
 }
 
+// IsSocketReattaching reports whether the virt-launcher behind the socket is
+// restarting after a crash and is still within its grace period.
+func IsSocketReattaching(socket string) bool {
+	dir, err := safepath.NewPathNoFollow(filepath.Dir(socket))
+	if err != nil {
+		return false
+	}
+	marker, err := safepath.JoinNoFollow(dir, StandardLauncherReattachingFileName)
+	if err != nil {
+		return false
+	}
+	info, err := safepath.StatAtNoFollow(marker)
+	if err != nil {
+		return false
+	}
+	return time.Since(info.ModTime()) < LauncherReattachGracePeriod
+}
+
 func SocketDirectoryOnHost(podUID string) string {
 	return filepath.Clean(fmt.Sprintf("/%s/%s/volumes/kubernetes.io~empty-dir/sockets", podsBaseDir, podUID))
 }
@@ -310,8 +338,8 @@ func (c *VirtLauncherClient) SyncVirtualMachine(vmi *v1.VirtualMachineInstance,
 	return c.genericSendVMICmd("SyncVMI", c.v1client.SyncVirtualMachine, vmi, options)
 }
 
-func (c *VirtLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error {
-	return c.genericSendVMICmd("Pause", c.v1client.PauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+func (c *VirtLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance, autoUnpauseTimeoutSeconds int32) error {
+	return c.genericSendVMICmd("Pause", c.v1client.PauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{AutoUnpauseTimeoutSeconds: autoUnpauseTimeoutSeconds})
 }
 
 func (c *VirtLauncherClient) UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error {
@@ -367,6 +395,27 @@ func (c *VirtLauncherClient) SoftRebootVirtualMachine(vmi *v1.VirtualMachineInst
 	return c.genericSendVMICmd("SoftReboot", c.v1client.SoftRebootVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) RebootVirtualMachine(vmi *v1.VirtualMachineInstance, method v1.RebootMethod) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.RebootRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Method: string(method),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := c.v1client.RebootVirtualMachine(ctx, request)
+
+	err = handleError(err, "Reboot", response)
+	return err
+}
+
 func (c *VirtLauncherClient) ResetVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("Reset", c.v1client.ResetVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -698,6 +747,32 @@ func (c *VirtLauncherClient) GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmd
 	return c.v1client.GetScreenshot(ctx, request)
 }
 
+func (c *VirtLauncherClient) SetVNCResolution(vmi *v1.VirtualMachineInstance, options *v1.VNCResolutionOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.VNCResolutionRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	response, err := c.v1client.SetVNCResolution(ctx, request)
+
+	return handleError(err, "SetVNCResolution", response)
+}
+
 func (c *VirtLauncherClient) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	request := &cmdv1.EmptyRequest{}
 	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
@@ -772,10 +847,84 @@ func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance,
 	return handleError(err, "InjectLaunchSecret", response)
 }
 
+func (c *VirtLauncherClient) GetSEVSNPAttestationReport(vmi *v1.VirtualMachineInstance, options *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.SEVSNPAttestationReportRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	reportResponse, err := c.v1client.GetSEVSNPAttestationReport(ctx, request)
+	if err = handleError(err, "GetSEVSNPAttestationReport", reportResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	report := &v1.SEVSNPAttestationReport{}
+	if err := json.Unmarshal(reportResponse.GetReport(), report); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling SEV-SNP attestation report response")
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (c *VirtLauncherClient) GetTPMAttestation(vmi *v1.VirtualMachineInstance, options *v1.TPMAttestationOptions) (*v1.TPMAttestation, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.TPMAttestationRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	attestationResponse, err := c.v1client.GetTPMAttestation(ctx, request)
+	if err = handleError(err, "GetTPMAttestation", attestationResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	attestation := &v1.TPMAttestation{}
+	if err := json.Unmarshal(attestationResponse.GetAttestation(), attestation); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling TPM attestation response")
+		return nil, err
+	}
+
+	return attestation, nil
+}
+
 func (c *VirtLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
 	return c.genericSendVMICmd("SyncVirtualMachineMemory", c.v1client.SyncVirtualMachineMemory, vmi, options)
 }
 
+func (c *VirtLauncherClient) ResizeVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("ResizeVirtualMachineMemory", c.v1client.ResizeVirtualMachineMemory, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -122,6 +123,22 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(MarkSocketUnresponsive(sock)).To(Succeed())
 		})
 
+		It("Detect reattaching socket", func() {
+			sock, err := FindSocket(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			marker := filepath.Join(filepath.Dir(sock), StandardLauncherReattachingFileName)
+
+			Expect(IsSocketReattaching(sock)).To(BeFalse())
+
+			Expect(os.WriteFile(marker, nil, 0644)).To(Succeed())
+			Expect(IsSocketReattaching(sock)).To(BeTrue())
+
+			// an outdated marker does not count anymore
+			outdated := time.Now().Add(-LauncherReattachGracePeriod)
+			Expect(os.Chtimes(marker, outdated, outdated)).To(Succeed())
+			Expect(IsSocketReattaching(sock)).To(BeFalse())
+		})
+
 		It("socket dir from UID and file path provider func", func() {
 			path, err := FindPodDirOnHost(vmi, SocketFilePathOnHost)
 			Expect(err).ToNot(HaveOccurred())
@@ -251,6 +251,21 @@ func (mr *MockLauncherClientMockRecorder) GetSEVInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockLauncherClient)(nil).GetSEVInfo))
 }
 
+// GetSEVSNPAttestationReport mocks base method.
+func (m *MockLauncherClient) GetSEVSNPAttestationReport(arg0 *v1.VirtualMachineInstance, arg1 *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationReport", arg0, arg1)
+	ret0, _ := ret[0].(*v1.SEVSNPAttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationReport indicates an expected call of GetSEVSNPAttestationReport.
+func (mr *MockLauncherClientMockRecorder) GetSEVSNPAttestationReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationReport", reflect.TypeOf((*MockLauncherClient)(nil).GetSEVSNPAttestationReport), arg0, arg1)
+}
+
 // GetScreenshot mocks base method.
 func (m *MockLauncherClient) GetScreenshot(arg0 *v1.VirtualMachineInstance) (*v10.ScreenshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -266,6 +281,21 @@ func (mr *MockLauncherClientMockRecorder) GetScreenshot(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreenshot", reflect.TypeOf((*MockLauncherClient)(nil).GetScreenshot), arg0)
 }
 
+// GetTPMAttestation mocks base method.
+func (m *MockLauncherClient) GetTPMAttestation(arg0 *v1.VirtualMachineInstance, arg1 *v1.TPMAttestationOptions) (*v1.TPMAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTPMAttestation", arg0, arg1)
+	ret0, _ := ret[0].(*v1.TPMAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTPMAttestation indicates an expected call of GetTPMAttestation.
+func (mr *MockLauncherClientMockRecorder) GetTPMAttestation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTPMAttestation", reflect.TypeOf((*MockLauncherClient)(nil).GetTPMAttestation), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockLauncherClient) GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error) {
 	m.ctrl.T.Helper()
@@ -352,17 +382,17 @@ func (mr *MockLauncherClientMockRecorder) MigrateVirtualMachine(vmi, options any
 }
 
 // PauseVirtualMachine mocks base method.
-func (m *MockLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+func (m *MockLauncherClient) PauseVirtualMachine(vmi *v1.VirtualMachineInstance, autoUnpauseTimeoutSeconds int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PauseVirtualMachine", vmi)
+	ret := m.ctrl.Call(m, "PauseVirtualMachine", vmi, autoUnpauseTimeoutSeconds)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PauseVirtualMachine indicates an expected call of PauseVirtualMachine.
-func (mr *MockLauncherClientMockRecorder) PauseVirtualMachine(vmi any) *gomock.Call {
+func (mr *MockLauncherClientMockRecorder) PauseVirtualMachine(vmi, autoUnpauseTimeoutSeconds any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).PauseVirtualMachine), vmi)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).PauseVirtualMachine), vmi, autoUnpauseTimeoutSeconds)
 }
 
 // Ping mocks base method.
@@ -379,6 +409,20 @@ func (mr *MockLauncherClientMockRecorder) Ping() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockLauncherClient)(nil).Ping))
 }
 
+// RebootVirtualMachine mocks base method.
+func (m *MockLauncherClient) RebootVirtualMachine(vmi *v1.VirtualMachineInstance, method v1.RebootMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVirtualMachine", vmi, method)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebootVirtualMachine indicates an expected call of RebootVirtualMachine.
+func (mr *MockLauncherClientMockRecorder) RebootVirtualMachine(vmi, method any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).RebootVirtualMachine), vmi, method)
+}
+
 // ResetVirtualMachine mocks base method.
 func (m *MockLauncherClient) ResetVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -393,6 +437,34 @@ func (mr *MockLauncherClientMockRecorder) ResetVirtualMachine(vmi any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).ResetVirtualMachine), vmi)
 }
 
+// ResizeVirtualMachineMemory mocks base method.
+func (m *MockLauncherClient) ResizeVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeVirtualMachineMemory", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeVirtualMachineMemory indicates an expected call of ResizeVirtualMachineMemory.
+func (mr *MockLauncherClientMockRecorder) ResizeVirtualMachineMemory(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVirtualMachineMemory", reflect.TypeOf((*MockLauncherClient)(nil).ResizeVirtualMachineMemory), vmi)
+}
+
+// SetVNCResolution mocks base method.
+func (m *MockLauncherClient) SetVNCResolution(arg0 *v1.VirtualMachineInstance, arg1 *v1.VNCResolutionOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVNCResolution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVNCResolution indicates an expected call of SetVNCResolution.
+func (mr *MockLauncherClientMockRecorder) SetVNCResolution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVNCResolution", reflect.TypeOf((*MockLauncherClient)(nil).SetVNCResolution), arg0, arg1)
+}
+
 // ShutdownVirtualMachine mocks base method.
 func (m *MockLauncherClient) ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -19,7 +19,11 @@
 
 package cmdclient
 
-import "path/filepath"
+import (
+	"errors"
+	"os"
+	"path/filepath"
+)
 
 func SocketOnGuest() string {
 	sockFile := StandardLauncherSocketFileName
@@ -30,3 +34,17 @@ func UninitializedSocketOnGuest() string {
 	sockFile := StandardInitLauncherSocketFileName
 	return filepath.Join(SocketsDirectory(), sockFile)
 }
+
+// MarkReattachingOnGuest tells virt-handler that the restarted virt-launcher
+// is reattaching to the running domain, until its command socket is ready.
+func MarkReattachingOnGuest() error {
+	return os.WriteFile(filepath.Join(SocketsDirectory(), StandardLauncherReattachingFileName), nil, 0644)
+}
+
+func ClearReattachingOnGuest() error {
+	err := os.Remove(filepath.Join(SocketsDirectory(), StandardLauncherReattachingFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -34,6 +34,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/checkpoint"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
+	"kubevirt.io/kubevirt/pkg/container-disk/signature"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
@@ -72,6 +73,9 @@ type mounter struct {
 	kernelBootSocketPathGetter containerdisk.KernelBootSocketPathGetter
 	clusterConfig              *virtconfig.ClusterConfig
 	nodeIsolationResult        isolation.IsolationResult
+	signatureVerifier          signatureVerifier
+	verifiedImages             map[string]string
+	verifiedImagesLock         sync.Mutex
 }
 
 type Mounter interface {
@@ -121,6 +125,8 @@ func NewMounter(isoDetector isolation.PodIsolationDetector, mountStateDir string
 		kernelBootSocketPathGetter: containerdisk.NewKernelBootSocketPathGetter(""),
 		clusterConfig:              clusterConfig,
 		nodeIsolationResult:        isolation.NodeIsolationResult(),
+		signatureVerifier:          signature.NewVerifier(),
+		verifiedImages:             make(map[string]string),
 	}
 }
 
@@ -243,7 +249,12 @@ func (m *mounter) setAddMountTargetRecordHelper(vmi *v1.VirtualMachineInstance,
 
 // Mount takes a vmi and mounts all container disks of the VMI, so that they are visible for the qemu process.
 // Additionally qcow2 images are validated if "verify" is true. The validation happens with rlimits set, to avoid DOS.
+// If containerdisk verification is enabled, nothing is mounted before the signatures of all images are verified.
 func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) error {
+	if err := m.verifyContainerDiskSignatures(vmi); err != nil {
+		return err
+	}
+
 	if m.clusterConfig.ImageVolumeEnabled() {
 		bindMountNeeded, err := m.needsBindMountFunc(vmi)
 		if err != nil {
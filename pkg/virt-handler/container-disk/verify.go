@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const signatureVerificationTimeout = 1 * time.Minute
+
+type signatureVerifier interface {
+	Verify(ctx context.Context, image string, publicKeys, trustedTokenHosts []string) error
+}
+
+// verifyContainerDiskSignatures verifies the signatures of the containerdisk
+// images against the keys of the cluster. The images are identified by the
+// digests virt-controller recorded from the launcher pod, so that exactly the
+// pulled images are verified. Images which passed are remembered until the
+// keys change.
+func (m *mounter) verifyContainerDiskSignatures(vmi *v1.VirtualMachineInstance) error {
+	if !m.clusterConfig.ContainerDiskVerificationEnabled() {
+		return nil
+	}
+	publicKeys := m.clusterConfig.GetContainerDiskVerificationPublicKeys()
+	trustedTokenHosts := m.clusterConfig.GetContainerDiskVerificationTrustedTokenHosts()
+	keysHash := hashPublicKeys(publicKeys)
+
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.ContainerDisk == nil {
+			continue
+		}
+		imageID := containerDiskImageID(vmi, volume.Name)
+		if imageID == "" {
+			return fmt.Errorf("the digest of containerDisk %s is not known yet", volume.Name)
+		}
+		if m.isImageVerified(imageID, keysHash) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), signatureVerificationTimeout)
+		err := m.signatureVerifier.Verify(ctx, imageID, publicKeys, trustedTokenHosts)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to verify containerDisk %s: %w", volume.Name, err)
+		}
+		log.Log.Object(vmi).Infof("Verified the signature of containerDisk %s image %s", volume.Name, imageID)
+		m.setImageVerified(imageID, keysHash)
+	}
+	return nil
+}
+
+func containerDiskImageID(vmi *v1.VirtualMachineInstance, volumeName string) string {
+	for _, status := range vmi.Status.VolumeStatus {
+		if status.Name == volumeName && status.ContainerDiskVolume != nil {
+			return status.ContainerDiskVolume.ImageID
+		}
+	}
+	return ""
+}
+
+func hashPublicKeys(publicKeys []string) string {
+	hash := sha256.Sum256([]byte(strings.Join(publicKeys, "\n")))
+	return hex.EncodeToString(hash[:])
+}
+
+func (m *mounter) isImageVerified(imageID, keysHash string) bool {
+	m.verifiedImagesLock.Lock()
+	defer m.verifiedImagesLock.Unlock()
+	return m.verifiedImages[imageID] == keysHash
+}
+
+func (m *mounter) setImageVerified(imageID, keysHash string) {
+	m.verifiedImagesLock.Lock()
+	defer m.verifiedImagesLock.Unlock()
+	if m.verifiedImages == nil {
+		m.verifiedImages = make(map[string]string)
+	}
+	m.verifiedImages[imageID] = keysHash
+}
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/container-disk/signature"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const testImageID = "quay.io/containerdisks/fedora@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+type fakeSignatureVerifier struct {
+	verified []string
+	err      error
+}
+
+func (f *fakeSignatureVerifier) Verify(_ context.Context, image string, _, _ []string) error {
+	f.verified = append(f.verified, image)
+	return f.err
+}
+
+var _ = Describe("ContainerDisk signature verification", func() {
+	var (
+		m        *mounter
+		verifier *fakeSignatureVerifier
+		vmi      *v1.VirtualMachineInstance
+	)
+
+	newMounter := func(featureGates ...string) *mounter {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates: featureGates,
+			},
+			ContainerDiskVerification: &v1.ContainerDiskVerificationConfiguration{
+				PublicKeys: []string{"key"},
+			},
+		})
+		return &mounter{
+			clusterConfig:     config,
+			signatureVerifier: verifier,
+		}
+	}
+
+	BeforeEach(func() {
+		verifier = &fakeSignatureVerifier{}
+		vmi = api.NewMinimalVMI("fake-vmi")
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "disk0",
+			VolumeSource: v1.VolumeSource{
+				ContainerDisk: testutils.NewFakeContainerDiskSource(),
+			},
+		})
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+			Name:                "disk0",
+			ContainerDiskVolume: &v1.ContainerDiskInfo{ImageID: testImageID},
+		}}
+		m = newMounter(featuregate.ContainerDiskVerificationGate)
+	})
+
+	It("should not verify the images if the feature gate is disabled", func() {
+		m = newMounter()
+		Expect(m.verifyContainerDiskSignatures(vmi)).To(Succeed())
+		Expect(verifier.verified).To(BeEmpty())
+	})
+
+	It("should verify the image pinned by its digest only once", func() {
+		Expect(m.verifyContainerDiskSignatures(vmi)).To(Succeed())
+		Expect(m.verifyContainerDiskSignatures(vmi)).To(Succeed())
+		Expect(verifier.verified).To(Equal([]string{testImageID}))
+	})
+
+	It("should wait for the digest of the image", func() {
+		vmi.Status.VolumeStatus = nil
+		Expect(m.verifyContainerDiskSignatures(vmi)).To(MatchError(ContainSubstring("not known yet")))
+		Expect(verifier.verified).To(BeEmpty())
+	})
+
+	It("should pass on verification errors", func() {
+		verifier.err = &signature.VerificationError{Image: testImageID, Reason: "no signature found"}
+		err := m.verifyContainerDiskSignatures(vmi)
+
+		var verificationErr *signature.VerificationError
+		Expect(errors.As(err, &verificationErr)).To(BeTrue())
+		Expect(m.isImageVerified(testImageID, hashPublicKeys([]string{"key"}))).To(BeFalse())
+	})
+
+	It("should verify the image again after the keys changed", func() {
+		m.setImageVerified(testImageID, hashPublicKeys([]string{"old-key"}))
+		Expect(m.verifyContainerDiskSignatures(vmi)).To(Succeed())
+		Expect(verifier.verified).To(Equal([]string{testImageID}))
+	})
+})
@@ -53,6 +53,9 @@ const (
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 	// This value was determined after consulting with libvirt developers and performing extensive testing.
 	parallelMultifdMigrationThreads = uint(8)
+	// maxIOErrorResumeAttempts is the number of times virt-handler tries to resume
+	// a VMI paused on an IO error before giving up and leaving it to the user.
+	maxIOErrorResumeAttempts = 10
 )
 
 const (
@@ -87,6 +90,17 @@ const (
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+
+	// pausedByUserReason is the paused condition reason set when the VMI was paused by the user
+	pausedByUserReason = "PausedByUser"
+	// pausedByMigrationMonitorReason is the paused condition reason set when the VMI was paused by the migration monitor
+	pausedByMigrationMonitorReason = "PausedByMigrationMonitor"
+	// pausedIOErrorReason is the paused condition reason set when the VMI was paused after an IO error
+	pausedIOErrorReason = "PausedIOError"
+	// ioErrorResumeScheduledReason is the IO error recovery condition reason set while resume attempts are ongoing
+	ioErrorResumeScheduledReason = "ResumeScheduled"
+	// ioErrorResumeExhaustedReason is the IO error recovery condition reason set once all resume attempts failed
+	ioErrorResumeExhaustedReason = "ResumeAttemptsExhausted"
 )
 
 type netconf interface {
@@ -260,9 +274,11 @@ func (c *BaseController) setupDevicesOwnerships(vmi *v1.VirtualMachineInstance,
 		return err
 	}
 
-	err = c.claimDeviceOwnership(virtLauncherRootMount, "kvm")
-	if err != nil {
-		return fmt.Errorf("failed to set up file ownership for /dev/kvm: %v", err)
+	// The /dev/kvm of isolated launchers is passed into their micro VM by the runtime
+	if !vmi.IsEmulationEnabled() && !util.IsIsolatedLauncherVMI(vmi) {
+		if err := c.claimDeviceOwnership(virtLauncherRootMount, "kvm"); err != nil {
+			return fmt.Errorf("failed to set up file ownership for /dev/kvm: %v", err)
+		}
 	}
 
 	if util.IsAutoAttachVSOCK(vmi) {
@@ -271,6 +287,12 @@ func (c *BaseController) setupDevicesOwnerships(vmi *v1.VirtualMachineInstance,
 		}
 	}
 
+	if util.IsSGXVMI(vmi) {
+		if err := c.claimDeviceOwnership(virtLauncherRootMount, "sgx_vepc"); err != nil {
+			return fmt.Errorf("failed to set up file ownership for /dev/sgx_vepc: %v", err)
+		}
+	}
+
 	if err := c.configureHostDisks(vmi, virtLauncherRootMount, recorder); err != nil {
 		return err
 	}
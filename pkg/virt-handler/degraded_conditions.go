@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const linkStateDown = "down"
+
+// updateStorageDegradedCondition summarizes the storage problems of the VMI
+// with a stable reason, an IO error takes precedence over guest filesystems
+// running out of space.
+func updateStorageDegradedCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	switch {
+	case isIOError(true, domain != nil, domain):
+		setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceStorageDegraded,
+			v1.VirtualMachineInstanceReasonIOError, "VMI is paused because its storage reported an IO error")
+	case condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceDiskSpaceLow, k8sv1.ConditionTrue):
+		diskSpaceLow := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+		setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceStorageDegraded,
+			v1.VirtualMachineInstanceReasonGuestFilesystemFull, diskSpaceLow.Message)
+	default:
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceStorageDegraded)
+	}
+}
+
+// updateNetworkDegradedCondition reports the interfaces whose link is down
+// although the spec does not request it.
+func updateNetworkDegradedCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	requestedDown := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateLinkDown || iface.State == v1.InterfaceStateAbsent {
+			requestedDown[iface.Name] = true
+		}
+	}
+
+	var down []string
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.LinkState == linkStateDown && iface.Name != "" && !requestedDown[iface.Name] {
+			down = append(down, iface.Name)
+		}
+	}
+	if len(down) == 0 {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceNetworkDegraded)
+		return
+	}
+	setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceNetworkDegraded,
+		v1.VirtualMachineInstanceReasonInterfaceLinkDown, fmt.Sprintf("The link of the interfaces is down: %s", strings.Join(down, ", ")))
+}
+
+// setDegradedCondition sets a true condition, keeping the transition time
+// while the condition stays true and updating the message when it changes.
+func setDegradedCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager, condType v1.VirtualMachineInstanceConditionType, reason, message string) {
+	setCondition(vmi, condManager, condType, k8sv1.ConditionTrue, reason, message)
+}
+
+// setCondition sets a condition, keeping the transition time while the status
+// stays the same and updating the reason and message when they change.
+func setCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager, condType v1.VirtualMachineInstanceConditionType, status k8sv1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+	transitionTime := now
+	if cond := condManager.GetCondition(vmi, condType); cond != nil {
+		if cond.Status == status && cond.Reason == reason && cond.Message == message {
+			return
+		}
+		if cond.Status == status {
+			transitionTime = cond.LastTransitionTime
+		}
+		condManager.RemoveCondition(vmi, condType)
+	}
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               condType,
+		Status:             status,
+		LastProbeTime:      now,
+		LastTransitionTime: transitionTime,
+		Reason:             reason,
+		Message:            message,
+	})
+}
@@ -0,0 +1,156 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Degraded conditions", func() {
+	var condManager *controller.VirtualMachineInstanceConditionManager
+
+	BeforeEach(func() {
+		condManager = controller.NewVirtualMachineInstanceConditionManager()
+	})
+
+	Context("StorageDegraded", func() {
+		newDomain := func(status api.LifeCycle, reason api.StateChangeReason) *api.Domain {
+			domain := api.NewMinimalDomain("testvmi")
+			domain.Status.Status = status
+			domain.Status.Reason = reason
+			return domain
+		}
+
+		It("should report VMIs paused on an IO error", func() {
+			vmi := libvmi.New()
+
+			updateStorageDegradedCondition(vmi, newDomain(api.Paused, api.ReasonPausedIOError), condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceStorageDegraded)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonIOError))
+		})
+
+		It("should report guest filesystems low on space", func() {
+			vmi := libvmi.New()
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:    v1.VirtualMachineInstanceDiskSpaceLow,
+				Status:  k8sv1.ConditionTrue,
+				Reason:  v1.VirtualMachineInstanceReasonGuestFilesystemFull,
+				Message: "The guest filesystems are low on space: / (95%)",
+			}}
+
+			updateStorageDegradedCondition(vmi, newDomain(api.Running, api.ReasonUnknown), condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceStorageDegraded)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestFilesystemFull))
+			Expect(cond.Message).To(Equal("The guest filesystems are low on space: / (95%)"))
+		})
+
+		It("should remove the condition once the storage recovered", func() {
+			vmi := libvmi.New()
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceStorageDegraded,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonIOError,
+			}}
+
+			updateStorageDegradedCondition(vmi, newDomain(api.Running, api.ReasonUnknown), condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceStorageDegraded)).To(BeFalse())
+		})
+	})
+
+	Context("NetworkDegraded", func() {
+		DescribeTable("should report interfaces whose link is unexpectedly down", func(state v1.InterfaceState, linkState string, expectDegraded bool) {
+			vmi := libvmi.New(libvmi.WithInterface(v1.Interface{Name: "default", State: state}))
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", LinkState: linkState}}
+
+			updateNetworkDegradedCondition(vmi, condManager)
+
+			if expectDegraded {
+				cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceNetworkDegraded)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonInterfaceLinkDown))
+				Expect(cond.Message).To(ContainSubstring("default"))
+			} else {
+				Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceNetworkDegraded)).To(BeFalse())
+			}
+		},
+			Entry("when the link is down", v1.InterfaceState(""), "down", true),
+			Entry("when the link is down although requested up", v1.InterfaceStateLinkUp, "down", true),
+			Entry("not when the link is up", v1.InterfaceState(""), "up", false),
+			Entry("not when the link was requested down", v1.InterfaceStateLinkDown, "down", false),
+		)
+	})
+
+	Context("setCondition", func() {
+		It("should keep the transition time while the condition stays true", func() {
+			vmi := libvmi.New()
+			transitionTime := metav1.NewTime(metav1.Now().Add(-5 * time.Minute))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceNetworkDegraded,
+				Status:             k8sv1.ConditionTrue,
+				Reason:             v1.VirtualMachineInstanceReasonInterfaceLinkDown,
+				Message:            "The link of the interfaces is down: default",
+				LastTransitionTime: transitionTime,
+			}}
+
+			setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceNetworkDegraded,
+				v1.VirtualMachineInstanceReasonInterfaceLinkDown, "The link of the interfaces is down: default, blue")
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceNetworkDegraded)
+			Expect(cond.Message).To(Equal("The link of the interfaces is down: default, blue"))
+			Expect(cond.LastTransitionTime).To(Equal(transitionTime))
+		})
+
+		It("should reset the transition time when the status changes", func() {
+			vmi := libvmi.New()
+			transitionTime := metav1.NewTime(metav1.Now().Add(-5 * time.Minute))
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceIOErrorRecovery,
+				Status:             k8sv1.ConditionTrue,
+				Reason:             ioErrorResumeScheduledReason,
+				LastTransitionTime: transitionTime,
+			}}
+
+			setCondition(vmi, condManager, v1.VirtualMachineInstanceIOErrorRecovery, k8sv1.ConditionFalse,
+				ioErrorResumeExhaustedReason, "VMI could not be resumed")
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceIOErrorRecovery)
+			Expect(cond.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(cond.LastTransitionTime.After(transitionTime.Time)).To(BeTrue())
+		})
+	})
+})
@@ -51,6 +51,10 @@ type DeviceHandler interface {
 	CreateMDEVType(mdevType string, parentID string) error
 	RemoveMDEVType(mdevUUID string) error
 	ReadMDEVAvailableInstances(mdevType string, parentID string) (int, error)
+	GetSRIOVTotalVFs(basepath string, pfAddress string) (int, error)
+	GetVirtualFunctions(basepath string, pfAddress string) ([]string, error)
+	SetSRIOVNumVFs(pfAddress string, numVFs int) error
+	BindVFIO(pciAddress string) error
 }
 
 type DeviceUtilsHandler struct{}
@@ -196,6 +200,59 @@ func (h *DeviceUtilsHandler) ReadMDEVAvailableInstances(mdevType string, parentI
 	return i, nil
 }
 
+// GetSRIOVTotalVFs reads the number of virtual functions an SR-IOV physical function supports
+func (h *DeviceUtilsHandler) GetSRIOVTotalVFs(basepath string, pfAddress string) (int, error) {
+	// #nosec No risk for path injection. Reading static path of PCI data
+	totalVFs, err := os.ReadFile(filepath.Join(basepath, pfAddress, "sriov_totalvfs"))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(bytes.TrimSpace(totalVFs)))
+}
+
+// GetVirtualFunctions lists the PCI addresses of the virtual functions of a physical function
+// e.g. /sys/bus/pci/devices/0000:65:00.0/virtfn0 -> ../0000:65:02.0
+func (h *DeviceUtilsHandler) GetVirtualFunctions(basepath string, pfAddress string) ([]string, error) {
+	vfLinks, err := filepath.Glob(filepath.Join(basepath, pfAddress, "virtfn*"))
+	if err != nil {
+		return nil, err
+	}
+
+	vfs := make([]string, 0, len(vfLinks))
+	for _, vfLink := range vfLinks {
+		vfPath, err := os.Readlink(vfLink)
+		if err != nil {
+			return nil, err
+		}
+		vfs = append(vfs, filepath.Base(vfPath))
+	}
+	return vfs, nil
+}
+
+func (h *DeviceUtilsHandler) SetSRIOVNumVFs(pfAddress string, numVFs int) error {
+	_, err := virt_chroot.SetSRIOVNumVFs(pfAddress, numVFs).Output()
+	if err != nil {
+		if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+			return fmt.Errorf("failed to set the number of VFs of %s to %d, err: %v", pfAddress, numVFs, string(e.Stderr))
+		}
+		return err
+	}
+	log.Log.Infof("Successfully set the number of VFs of %s to %d", pfAddress, numVFs)
+	return nil
+}
+
+func (h *DeviceUtilsHandler) BindVFIO(pciAddress string) error {
+	_, err := virt_chroot.BindVFIO(pciAddress).Output()
+	if err != nil {
+		if e, ok := err.(*exec.ExitError); ok && len(e.Stderr) > 0 {
+			return fmt.Errorf("failed to bind %s to vfio-pci, err: %v", pciAddress, string(e.Stderr))
+		}
+		return err
+	}
+	log.Log.Infof("Successfully bound %s to vfio-pci", pciAddress)
+	return nil
+}
+
 func waitForGRPCServer(socketPath string, timeout time.Duration) error {
 	conn, err := gRPCConnect(socketPath, timeout)
 	if err != nil {
@@ -28,8 +28,11 @@ import (
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8scorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
@@ -39,6 +42,10 @@ import (
 
 var defaultBackoffTime = []time.Duration{1 * time.Second, 2 * time.Second, 5 * time.Second, 10 * time.Second}
 
+// vfPoolsResyncInterval is the interval in which the virtual function pools are reconciled, besides on
+// configuration changes, to apply the changes pending until their virtual functions are released
+const vfPoolsResyncInterval = 1 * time.Minute
+
 type controlledDevice struct {
 	devicePlugin Device
 	started      bool
@@ -132,6 +139,7 @@ type DeviceController struct {
 	backoff             []time.Duration
 	virtConfig          *virtconfig.ClusterConfig
 	mdevTypesManager    *MDEVTypesManager
+	vfPoolManager       *VFPoolManager
 	nodeStore           cache.Store
 	mdevRefreshWG       *sync.WaitGroup
 }
@@ -143,6 +151,8 @@ func NewDeviceController(
 	permanentPlugins []Device,
 	clusterConfig *virtconfig.ClusterConfig,
 	nodeStore cache.Store,
+	nodeClient k8scorev1.NodeInterface,
+	usedPCIDevices func() map[string]struct{},
 ) *DeviceController {
 	permanentPluginsMap := make(map[string]Device, len(permanentPlugins))
 	for i := range permanentPlugins {
@@ -158,6 +168,7 @@ func NewDeviceController(
 		backoff:          defaultBackoffTime,
 		virtConfig:       clusterConfig,
 		mdevTypesManager: NewMDEVTypesManager(),
+		vfPoolManager:    NewVFPoolManager(host, nodeStore, nodeClient, usedPCIDevices),
 		nodeStore:        nodeStore,
 		mdevRefreshWG:    &sync.WaitGroup{},
 	}
@@ -182,6 +193,7 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 	}{
 		{"sev", "/dev/sev", c.virtConfig.WorkloadEncryptionSEVEnabled},
 		{"vhost-vsock", "/dev/vhost-vsock", c.virtConfig.VSOCKEnabled},
+		{"sgx", "/dev/sgx_vepc", c.virtConfig.SGXEnabled},
 	}
 	for _, dev := range featureGatedDevices {
 		if dev.IsAllowed() {
@@ -244,6 +256,11 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 		}
 	}
 
+	for resourceName, vfs := range c.vfPoolManager.discoverVFPoolDevices() {
+		log.Log.V(4).Infof("Discovered %d virtual functions on the node for the resource: %s", len(vfs), resourceName)
+		permittedDevices = append(permittedDevices, NewPCIDevicePlugin(vfs, resourceName))
+	}
+
 	for resourceName, pluginDevices := range discoverAllowedUSBDevices(hostDevs.USB) {
 		permittedDevices = append(permittedDevices, NewUSBDevicePlugin(resourceName, pluginDevices))
 	}
@@ -324,6 +341,28 @@ func (c *DeviceController) refreshMediatedDeviceTypes() bool {
 	return requiresDevicePluginsUpdate
 }
 
+// refreshVirtualFunctionPools reconciles the virtual functions of the node with the configured pools.
+// The device plugins of the pools whose virtual functions changed are stopped, to be started again
+// with the new devices by the next refresh of the permitted devices.
+func (c *DeviceController) refreshVirtualFunctionPools() bool {
+	var pools []v1.VirtualFunctionPool
+	if hostDevs := c.virtConfig.GetPermittedHostDevices(); hostDevs != nil {
+		pools = hostDevs.VirtualFunctionPools
+	}
+
+	changedResources := c.vfPoolManager.updateVFPools(pools)
+	if len(changedResources) == 0 {
+		return false
+	}
+
+	c.startedPluginsMutex.Lock()
+	defer c.startedPluginsMutex.Unlock()
+	for resourceName := range changedResources {
+		c.stopDevice(resourceName)
+	}
+	return true
+}
+
 func (c *DeviceController) getNode() (*k8sv1.Node, error) {
 	nodeObj, exists, err := c.nodeStore.GetByKey(c.host)
 	if err != nil {
@@ -415,9 +454,16 @@ func (c *DeviceController) Run(stop chan struct{}) {
 	refreshMediatedDeviceTypesFn := func() {
 		c.refreshMediatedDeviceTypes()
 	}
+	refreshVirtualFunctionPoolsFn := func() {
+		if c.refreshVirtualFunctionPools() {
+			c.refreshPermittedDevices()
+		}
+	}
 	c.virtConfig.SetConfigModifiedCallback(refreshMediatedDeviceTypesFn)
+	c.virtConfig.SetConfigModifiedCallback(refreshVirtualFunctionPoolsFn)
 	c.virtConfig.SetConfigModifiedCallback(c.refreshPermittedDevices)
 	c.refreshPermittedDevices()
+	go wait.Until(refreshVirtualFunctionPoolsFn, vfPoolsResyncInterval, stop)
 
 	// keep running until stop
 	<-stop
@@ -39,6 +39,20 @@ func (m *MockDeviceHandler) EXPECT() *MockDeviceHandlerMockRecorder {
 	return m.recorder
 }
 
+// BindVFIO mocks base method.
+func (m *MockDeviceHandler) BindVFIO(pciAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindVFIO", pciAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindVFIO indicates an expected call of BindVFIO.
+func (mr *MockDeviceHandlerMockRecorder) BindVFIO(pciAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindVFIO", reflect.TypeOf((*MockDeviceHandler)(nil).BindVFIO), pciAddress)
+}
+
 // CreateMDEVType mocks base method.
 func (m *MockDeviceHandler) CreateMDEVType(mdevType, parentID string) error {
 	m.ctrl.T.Helper()
@@ -127,6 +141,36 @@ func (mr *MockDeviceHandlerMockRecorder) GetMdevParentPCIAddr(mdevUUID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMdevParentPCIAddr", reflect.TypeOf((*MockDeviceHandler)(nil).GetMdevParentPCIAddr), mdevUUID)
 }
 
+// GetSRIOVTotalVFs mocks base method.
+func (m *MockDeviceHandler) GetSRIOVTotalVFs(basepath, pfAddress string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSRIOVTotalVFs", basepath, pfAddress)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSRIOVTotalVFs indicates an expected call of GetSRIOVTotalVFs.
+func (mr *MockDeviceHandlerMockRecorder) GetSRIOVTotalVFs(basepath, pfAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSRIOVTotalVFs", reflect.TypeOf((*MockDeviceHandler)(nil).GetSRIOVTotalVFs), basepath, pfAddress)
+}
+
+// GetVirtualFunctions mocks base method.
+func (m *MockDeviceHandler) GetVirtualFunctions(basepath, pfAddress string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualFunctions", basepath, pfAddress)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualFunctions indicates an expected call of GetVirtualFunctions.
+func (mr *MockDeviceHandlerMockRecorder) GetVirtualFunctions(basepath, pfAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualFunctions", reflect.TypeOf((*MockDeviceHandler)(nil).GetVirtualFunctions), basepath, pfAddress)
+}
+
 // ReadMDEVAvailableInstances mocks base method.
 func (m *MockDeviceHandler) ReadMDEVAvailableInstances(mdevType, parentID string) (int, error) {
 	m.ctrl.T.Helper()
@@ -155,3 +199,17 @@ func (mr *MockDeviceHandlerMockRecorder) RemoveMDEVType(mdevUUID any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMDEVType", reflect.TypeOf((*MockDeviceHandler)(nil).RemoveMDEVType), mdevUUID)
 }
+
+// SetSRIOVNumVFs mocks base method.
+func (m *MockDeviceHandler) SetSRIOVNumVFs(pfAddress string, numVFs int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSRIOVNumVFs", pfAddress, numVFs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSRIOVNumVFs indicates an expected call of SetSRIOVNumVFs.
+func (mr *MockDeviceHandlerMockRecorder) SetSRIOVNumVFs(pfAddress, numVFs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSRIOVNumVFs", reflect.TypeOf((*MockDeviceHandler)(nil).SetSRIOVNumVFs), pfAddress, numVFs)
+}
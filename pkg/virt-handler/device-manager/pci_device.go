@@ -43,6 +43,7 @@ const (
 	vfioDevicePath = "/dev/vfio/"
 	vfioMount      = "/dev/vfio/vfio"
 	pciBasePath    = "/sys/bus/pci/devices"
+	vfioPCIDriver  = "vfio-pci"
 )
 
 type PCIDevice struct {
@@ -267,7 +268,7 @@ func discoverPermittedHostPCIDevices(supportedPCIDeviceMap map[string]string) ma
 		if resourceName, supported := supportedPCIDeviceMap[pciID]; supported {
 			// check device driver
 			driver, err := handler.GetDeviceDriver(pciBasePath, info.Name())
-			if err != nil || driver != "vfio-pci" {
+			if err != nil || driver != vfioPCIDriver {
 				return nil
 			}
 
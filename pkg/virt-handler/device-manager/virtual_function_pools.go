@@ -0,0 +1,368 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// VirtualFunctionPoolsAnnotation records on the node the physical functions whose virtual functions were
+	// created by virt-handler, with the resource name of their pool, so that they are still removed once
+	// their pool is removed after virt-handler restarted
+	VirtualFunctionPoolsAnnotation = "kubevirt.io/virtual-function-pools"
+	// PendingVirtualFunctionPoolsAnnotation records on the node the physical functions whose virtual functions
+	// can not be changed yet, because some of them are assigned to VMIs, with the reason
+	PendingVirtualFunctionPoolsAnnotation = "kubevirt.io/virtual-function-pools-pending"
+	// UnmanagedVirtualFunctionPoolsAnnotation records on the node the physical functions matching a pool which
+	// are left untouched, because they already have virtual functions virt-handler did not create, with the reason
+	UnmanagedVirtualFunctionPoolsAnnotation = "kubevirt.io/virtual-function-pools-unmanaged"
+
+	vfsInUseMsg   = "virtual functions %s are assigned to VMIs, waiting for them to be released to %s"
+	vfsUnownedMsg = "%d virtual functions were not created by virt-handler, not adding them to %s"
+)
+
+// Not a const for static test purposes
+var sriovBasePath = pciBasePath
+
+// VFPoolManager creates the virtual functions of the configured pools on the physical functions of the node,
+// binds them to vfio-pci and removes them once their physical function is no longer part of a pool.
+// Physical functions which already have virtual functions, e.g. created by an SR-IOV operator, are left
+// untouched. The virtual functions of a physical function are never removed or recreated
+// while one of them is assigned to a VMI, the change is kept pending until they are released.
+type VFPoolManager struct {
+	host       string
+	nodeStore  cache.Store
+	nodeClient k8scorev1.NodeInterface
+	// usedPCIDevices returns the PCI addresses of the host devices assigned to the domains of the node
+	usedPCIDevices func() map[string]struct{}
+
+	// ownedPFs maps the physical functions whose virtual functions were created by virt-handler
+	// to the resource name of their pool
+	ownedPFs map[string]string
+	// pendingPFs maps the physical functions whose virtual functions can not be changed yet to the reason
+	pendingPFs map[string]string
+	// unmanagedPFs maps the physical functions of the pools which are left untouched to the reason
+	unmanagedPFs map[string]string
+	loaded       bool
+	// unpersisted is set if the last change could not be recorded on the node
+	unpersisted bool
+	mutex       sync.Mutex
+}
+
+func NewVFPoolManager(host string, nodeStore cache.Store, nodeClient k8scorev1.NodeInterface, usedPCIDevices func() map[string]struct{}) *VFPoolManager {
+	return &VFPoolManager{
+		host:           host,
+		nodeStore:      nodeStore,
+		nodeClient:     nodeClient,
+		usedPCIDevices: usedPCIDevices,
+		ownedPFs:       make(map[string]string),
+		pendingPFs:     make(map[string]string),
+		unmanagedPFs:   make(map[string]string),
+	}
+}
+
+// updateVFPools reconciles the virtual functions of the node with the pools.
+// It returns the resource names whose virtual functions changed.
+func (m *VFPoolManager) updateVFPools(pools []v1.VirtualFunctionPool) map[string]struct{} {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	changedResources := make(map[string]struct{})
+	// Without knowing which virtual functions virt-handler created before, none can be touched safely
+	if !m.loaded {
+		if err := m.loadOwnedPFs(); err != nil {
+			log.Log.Reason(err).Warning("failed to load the virtual function pools of the node, retrying later")
+			return changedResources
+		}
+	}
+
+	ownedPFs := make(map[string]string, len(m.ownedPFs))
+	for pfAddress, resourceName := range m.ownedPFs {
+		ownedPFs[pfAddress] = resourceName
+	}
+	pendingPFs := m.pendingPFs
+	m.pendingPFs = make(map[string]string)
+	unmanagedPFs := m.unmanagedPFs
+	m.unmanagedPFs = make(map[string]string)
+	defer m.persistPFs(ownedPFs, pendingPFs, unmanagedPFs)
+
+	desiredPFs := discoverPoolPFs(pools)
+	usedVFs := m.usedVFs()
+
+	for pfAddress, resourceName := range m.ownedPFs {
+		if pool, isDesired := desiredPFs[pfAddress]; isDesired && pool.ResourceName == resourceName {
+			continue
+		}
+		if inUse := usedVFs[pfAddress]; len(inUse) > 0 {
+			m.setPending(pfAddress, fmt.Sprintf(vfsInUseMsg, strings.Join(inUse, ", "), "remove them"))
+			// The pool keeps its virtual functions until they are released
+			delete(desiredPFs, pfAddress)
+			continue
+		}
+		if err := handler.SetSRIOVNumVFs(pfAddress, 0); err != nil {
+			log.Log.Reason(err).Errorf("failed to remove the virtual functions of %s", pfAddress)
+			continue
+		}
+		delete(m.ownedPFs, pfAddress)
+		changedResources[resourceName] = struct{}{}
+	}
+
+	for pfAddress, pool := range desiredPFs {
+		changed, err := m.configurePF(pfAddress, pool, usedVFs[pfAddress])
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to configure the virtual functions of %s for %s", pfAddress, pool.ResourceName)
+		}
+		if changed {
+			changedResources[pool.ResourceName] = struct{}{}
+		}
+	}
+
+	return changedResources
+}
+
+func (m *VFPoolManager) configurePF(pfAddress string, pool v1.VirtualFunctionPool, usedVFs []string) (bool, error) {
+	totalVFs, err := handler.GetSRIOVTotalVFs(sriovBasePath, pfAddress)
+	if err != nil {
+		return false, err
+	}
+	numVFs := min(int(pool.NumVFs), totalVFs)
+
+	vfs, err := handler.GetVirtualFunctions(sriovBasePath, pfAddress)
+	if err != nil {
+		return false, err
+	}
+
+	_, isOwned := m.ownedPFs[pfAddress]
+	if !isOwned && len(vfs) != 0 {
+		reason := fmt.Sprintf(vfsUnownedMsg, len(vfs), pool.ResourceName)
+		log.Log.Warningf("leaving physical function %s untouched: %s", pfAddress, reason)
+		m.unmanagedPFs[pfAddress] = reason
+		return false, nil
+	}
+
+	changed := !isOwned
+	if len(vfs) != numVFs {
+		// The kernel only changes the number of virtual functions by removing all of them first
+		if len(usedVFs) > 0 {
+			m.setPending(pfAddress, fmt.Sprintf(vfsInUseMsg, strings.Join(usedVFs, ", "), fmt.Sprintf("create %d virtual functions", numVFs)))
+			return false, nil
+		}
+		if err := handler.SetSRIOVNumVFs(pfAddress, numVFs); err != nil {
+			return false, err
+		}
+		if vfs, err = handler.GetVirtualFunctions(sriovBasePath, pfAddress); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	m.ownedPFs[pfAddress] = pool.ResourceName
+
+	for _, vf := range vfs {
+		if driver, err := handler.GetDeviceDriver(sriovBasePath, vf); err == nil && driver == vfioPCIDriver {
+			continue
+		}
+		if err := handler.BindVFIO(vf); err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+func (m *VFPoolManager) setPending(pfAddress, reason string) {
+	log.Log.Warningf("not changing the virtual functions of %s: %s", pfAddress, reason)
+	m.pendingPFs[pfAddress] = reason
+}
+
+// usedVFs returns the virtual functions assigned to the domains of the node, by physical function
+func (m *VFPoolManager) usedVFs() map[string][]string {
+	usedVFs := make(map[string][]string)
+	if m.usedPCIDevices == nil {
+		return usedVFs
+	}
+	usedDevices := m.usedPCIDevices()
+	for pfAddress := range m.ownedPFs {
+		vfs, err := handler.GetVirtualFunctions(sriovBasePath, pfAddress)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to list the virtual functions of %s", pfAddress)
+			continue
+		}
+		for _, vf := range vfs {
+			if _, isUsed := usedDevices[vf]; isUsed {
+				usedVFs[pfAddress] = append(usedVFs[pfAddress], vf)
+			}
+		}
+	}
+	return usedVFs
+}
+
+// loadOwnedPFs restores the physical functions owned by virt-handler from the node
+func (m *VFPoolManager) loadOwnedPFs() error {
+	obj, exists, err := m.nodeStore.GetByKey(m.host)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("node %s does not exist", m.host)
+	}
+	node, ok := obj.(*k8sv1.Node)
+	if !ok {
+		return fmt.Errorf("unknown object type found in node informer")
+	}
+
+	if value, exists := node.Annotations[VirtualFunctionPoolsAnnotation]; exists {
+		ownedPFs := make(map[string]string)
+		if err := json.Unmarshal([]byte(value), &ownedPFs); err != nil {
+			return fmt.Errorf("invalid %s annotation: %v", VirtualFunctionPoolsAnnotation, err)
+		}
+		m.ownedPFs = ownedPFs
+	}
+	m.loaded = true
+	return nil
+}
+
+// persistPFs records the owned and pending physical functions on the node if they changed
+func (m *VFPoolManager) persistPFs(previousOwnedPFs, previousPendingPFs, previousUnmanagedPFs map[string]string) {
+	if !m.unpersisted && reflect.DeepEqual(previousOwnedPFs, m.ownedPFs) &&
+		reflect.DeepEqual(previousPendingPFs, m.pendingPFs) && reflect.DeepEqual(previousUnmanagedPFs, m.unmanagedPFs) {
+		return
+	}
+	m.unpersisted = true
+
+	annotations := map[string]interface{}{
+		VirtualFunctionPoolsAnnotation:          nil,
+		PendingVirtualFunctionPoolsAnnotation:   nil,
+		UnmanagedVirtualFunctionPoolsAnnotation: nil,
+	}
+	for annotation, pfs := range map[string]map[string]string{
+		VirtualFunctionPoolsAnnotation:          m.ownedPFs,
+		PendingVirtualFunctionPoolsAnnotation:   m.pendingPFs,
+		UnmanagedVirtualFunctionPoolsAnnotation: m.unmanagedPFs,
+	} {
+		if len(pfs) == 0 {
+			continue
+		}
+		value, err := json.Marshal(pfs)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to marshal the %s annotation", annotation)
+			return
+		}
+		annotations[annotation] = string(value)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": annotations},
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("failed to marshal the virtual function pools of the node")
+		return
+	}
+
+	if _, err := m.nodeClient.Patch(context.Background(), m.host, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("failed to record the virtual function pools on node %s", m.host)
+		return
+	}
+	m.unpersisted = false
+}
+
+// discoverVFPoolDevices returns the virtual functions created for the pools, by resource name
+func (m *VFPoolManager) discoverVFPoolDevices() map[string][]*PCIDevice {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	pciDevicesMap := make(map[string][]*PCIDevice)
+	for pfAddress, resourceName := range m.ownedPFs {
+		vfs, err := handler.GetVirtualFunctions(sriovBasePath, pfAddress)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to list the virtual functions of %s", pfAddress)
+			continue
+		}
+		for _, vf := range vfs {
+			driver, err := handler.GetDeviceDriver(sriovBasePath, vf)
+			if err != nil || driver != vfioPCIDriver {
+				continue
+			}
+			pciID, err := handler.GetDevicePCIID(sriovBasePath, vf)
+			if err != nil {
+				continue
+			}
+			iommuGroup, err := handler.GetDeviceIOMMUGroup(sriovBasePath, vf)
+			if err != nil {
+				continue
+			}
+			pciDevicesMap[resourceName] = append(pciDevicesMap[resourceName], &PCIDevice{
+				pciID:      pciID,
+				driver:     driver,
+				pciAddress: vf,
+				iommuGroup: iommuGroup,
+				numaNode:   handler.GetDeviceNumaNode(sriovBasePath, vf),
+			})
+		}
+	}
+	return pciDevicesMap
+}
+
+// discoverPoolPFs returns the SR-IOV capable physical functions of the node matching a pool.
+// The first matching pool is used.
+func discoverPoolPFs(pools []v1.VirtualFunctionPool) map[string]v1.VirtualFunctionPool {
+	pfs := make(map[string]v1.VirtualFunctionPool)
+	if len(pools) == 0 {
+		return pfs
+	}
+
+	entries, err := os.ReadDir(sriovBasePath)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to discover SR-IOV physical functions")
+		return pfs
+	}
+	for _, entry := range entries {
+		pciID, err := handler.GetDevicePCIID(sriovBasePath, entry.Name())
+		if err != nil {
+			continue
+		}
+		for _, pool := range pools {
+			if strings.ToLower(pool.PFVendorSelector) != pciID {
+				continue
+			}
+			if _, err := handler.GetSRIOVTotalVFs(sriovBasePath, entry.Name()); err != nil {
+				break
+			}
+			pfs[entry.Name()] = pool
+			break
+		}
+	}
+	return pfs
+}
@@ -0,0 +1,250 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package device_manager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Virtual function pools", func() {
+	const (
+		pfID         = "8086:1593"
+		vfID         = "8086:1889"
+		resourceName = "example.org/vf"
+		pfAddress    = "0000:65:00.0"
+		otherPF      = "0000:66:00.0"
+		totalVFs     = 8
+		nodeName     = "testnode"
+	)
+
+	var (
+		manager     *VFPoolManager
+		mockHandler *MockDeviceHandler
+		// numVFs and drivers simulate the sysfs state of the physical and virtual functions
+		numVFs  map[string]int
+		drivers map[string]string
+		// usedDevices simulates the host devices assigned to the domains of the node
+		usedDevices map[string]struct{}
+		nodeStore   cache.Store
+		k8sClient   *k8sfake.Clientset
+	)
+
+	newManager := func() *VFPoolManager {
+		return NewVFPoolManager(nodeName, nodeStore, k8sClient.CoreV1().Nodes(), func() map[string]struct{} {
+			return usedDevices
+		})
+	}
+
+	nodeAnnotations := func() map[string]string {
+		node, err := k8sClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Annotations
+	}
+
+	vfAddress := func(pf string, index int) string {
+		return fmt.Sprintf("%s.vf%d", pf, index)
+	}
+
+	BeforeEach(func() {
+		sriovBasePath = GinkgoT().TempDir()
+		for _, pf := range []string{pfAddress, otherPF} {
+			Expect(os.Mkdir(filepath.Join(sriovBasePath, pf), 0o755)).To(Succeed())
+		}
+		DeferCleanup(func() {
+			sriovBasePath = pciBasePath
+		})
+
+		numVFs = map[string]int{pfAddress: 0, otherPF: 0}
+		drivers = map[string]string{}
+
+		mockHandler = NewMockDeviceHandler(gomock.NewController(GinkgoT()))
+		handler = mockHandler
+		mockHandler.EXPECT().GetDevicePCIID(sriovBasePath, gomock.Any()).DoAndReturn(func(_, address string) (string, error) {
+			if _, isPF := numVFs[address]; isPF {
+				return pfID, nil
+			}
+			return vfID, nil
+		}).AnyTimes()
+		mockHandler.EXPECT().GetSRIOVTotalVFs(sriovBasePath, gomock.Any()).Return(totalVFs, nil).AnyTimes()
+		mockHandler.EXPECT().GetVirtualFunctions(sriovBasePath, gomock.Any()).DoAndReturn(func(_, pf string) ([]string, error) {
+			var vfs []string
+			for i := 0; i < numVFs[pf]; i++ {
+				vfs = append(vfs, vfAddress(pf, i))
+			}
+			return vfs, nil
+		}).AnyTimes()
+		mockHandler.EXPECT().SetSRIOVNumVFs(gomock.Any(), gomock.Any()).DoAndReturn(func(pf string, n int) error {
+			numVFs[pf] = n
+			return nil
+		}).AnyTimes()
+		mockHandler.EXPECT().GetDeviceDriver(sriovBasePath, gomock.Any()).DoAndReturn(func(_, address string) (string, error) {
+			return drivers[address], nil
+		}).AnyTimes()
+		mockHandler.EXPECT().BindVFIO(gomock.Any()).DoAndReturn(func(address string) error {
+			drivers[address] = vfioPCIDriver
+			return nil
+		}).AnyTimes()
+		mockHandler.EXPECT().GetDeviceIOMMUGroup(sriovBasePath, gomock.Any()).Return("1", nil).AnyTimes()
+		mockHandler.EXPECT().GetDeviceNumaNode(sriovBasePath, gomock.Any()).Return(0).AnyTimes()
+
+		usedDevices = map[string]struct{}{}
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		k8sClient = k8sfake.NewSimpleClientset(node)
+		nodeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(nodeStore.Add(node)).To(Succeed())
+		manager = newManager()
+	})
+
+	AfterEach(func() {
+		handler = &DeviceUtilsHandler{}
+	})
+
+	pool := func(vfs uint32) []v1.VirtualFunctionPool {
+		return []v1.VirtualFunctionPool{{PFVendorSelector: pfID, NumVFs: vfs, ResourceName: resourceName}}
+	}
+
+	It("should create the virtual functions and bind them to vfio-pci", func() {
+		Expect(manager.updateVFPools(pool(2))).To(HaveKey(resourceName))
+
+		Expect(numVFs).To(Equal(map[string]int{pfAddress: 2, otherPF: 2}))
+		devices := manager.discoverVFPoolDevices()
+		Expect(devices[resourceName]).To(HaveLen(4))
+		for _, device := range devices[resourceName] {
+			Expect(device.driver).To(Equal(vfioPCIDriver))
+			Expect(device.pciID).To(Equal(vfID))
+		}
+	})
+
+	It("should cap the virtual functions to the total supported by the physical function", func() {
+		manager.updateVFPools(pool(totalVFs + 4))
+
+		Expect(numVFs[pfAddress]).To(Equal(totalVFs))
+	})
+
+	It("should not report changes when the virtual functions are already configured", func() {
+		manager.updateVFPools(pool(2))
+
+		Expect(manager.updateVFPools(pool(2))).To(BeEmpty())
+	})
+
+	It("should remove the virtual functions when the pool is removed", func() {
+		manager.updateVFPools(pool(2))
+
+		Expect(manager.updateVFPools(nil)).To(HaveKey(resourceName))
+
+		Expect(numVFs).To(Equal(map[string]int{pfAddress: 0, otherPF: 0}))
+		Expect(manager.discoverVFPoolDevices()).To(BeEmpty())
+	})
+
+	DescribeTable("should leave physical functions with externally created virtual functions untouched", func(externalVFs int) {
+		numVFs[pfAddress] = externalVFs
+
+		manager.updateVFPools(pool(2))
+
+		Expect(numVFs).To(Equal(map[string]int{pfAddress: externalVFs, otherPF: 2}))
+		Expect(manager.discoverVFPoolDevices()[resourceName]).To(HaveLen(2))
+		Expect(nodeAnnotations()).To(HaveKeyWithValue(VirtualFunctionPoolsAnnotation,
+			fmt.Sprintf(`{"%s":"%s"}`, otherPF, resourceName)))
+		Expect(nodeAnnotations()).To(HaveKeyWithValue(UnmanagedVirtualFunctionPoolsAnnotation,
+			And(ContainSubstring(pfAddress), ContainSubstring(resourceName))))
+
+		Expect(manager.updateVFPools(nil)).To(HaveKey(resourceName))
+		Expect(numVFs).To(Equal(map[string]int{pfAddress: externalVFs, otherPF: 0}))
+		Expect(nodeAnnotations()).ToNot(HaveKey(UnmanagedVirtualFunctionPoolsAnnotation))
+	},
+		Entry("with a different number of virtual functions", 3),
+		Entry("with the number of virtual functions of the pool", 2),
+	)
+
+	It("should record the physical functions it owns on the node", func() {
+		manager.updateVFPools(pool(2))
+
+		Expect(nodeAnnotations()).To(HaveKeyWithValue(VirtualFunctionPoolsAnnotation,
+			fmt.Sprintf(`{"%s":"%s","%s":"%s"}`, pfAddress, resourceName, otherPF, resourceName)))
+		Expect(nodeAnnotations()).ToNot(HaveKey(PendingVirtualFunctionPoolsAnnotation))
+	})
+
+	It("should remove the virtual functions it created before a restart", func() {
+		numVFs[pfAddress] = 2
+		Expect(nodeStore.Update(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeName,
+			Annotations: map[string]string{VirtualFunctionPoolsAnnotation: fmt.Sprintf(`{"%s":"%s"}`, pfAddress, resourceName)},
+		}})).To(Succeed())
+		manager = newManager()
+
+		Expect(manager.updateVFPools(nil)).To(HaveKey(resourceName))
+
+		Expect(numVFs[pfAddress]).To(BeZero())
+		Expect(nodeAnnotations()).ToNot(HaveKey(VirtualFunctionPoolsAnnotation))
+	})
+
+	It("should not touch any virtual functions until the ownership is known", func() {
+		Expect(nodeStore.Delete(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}})).To(Succeed())
+
+		Expect(manager.updateVFPools(pool(2))).To(BeEmpty())
+
+		Expect(numVFs).To(Equal(map[string]int{pfAddress: 0, otherPF: 0}))
+	})
+
+	Context("with virtual functions assigned to VMIs", func() {
+		BeforeEach(func() {
+			manager.updateVFPools(pool(2))
+			usedDevices[vfAddress(pfAddress, 1)] = struct{}{}
+		})
+
+		It("should keep the number of virtual functions pending until they are released", func() {
+			Expect(manager.updateVFPools(pool(4))).To(HaveKey(resourceName))
+
+			Expect(numVFs).To(Equal(map[string]int{pfAddress: 2, otherPF: 4}))
+			Expect(nodeAnnotations()).To(HaveKeyWithValue(PendingVirtualFunctionPoolsAnnotation,
+				ContainSubstring(vfAddress(pfAddress, 1))))
+
+			delete(usedDevices, vfAddress(pfAddress, 1))
+			Expect(manager.updateVFPools(pool(4))).To(HaveKey(resourceName))
+
+			Expect(numVFs).To(Equal(map[string]int{pfAddress: 4, otherPF: 4}))
+			Expect(nodeAnnotations()).ToNot(HaveKey(PendingVirtualFunctionPoolsAnnotation))
+		})
+
+		It("should keep the removal of the pool pending until they are released", func() {
+			manager.updateVFPools(nil)
+
+			Expect(numVFs).To(Equal(map[string]int{pfAddress: 2, otherPF: 0}))
+			Expect(manager.discoverVFPoolDevices()[resourceName]).To(HaveLen(2))
+			Expect(nodeAnnotations()).To(HaveKeyWithValue(VirtualFunctionPoolsAnnotation,
+				fmt.Sprintf(`{"%s":"%s"}`, pfAddress, resourceName)))
+			Expect(nodeAnnotations()).To(HaveKey(PendingVirtualFunctionPoolsAnnotation))
+		})
+	})
+})
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hostsensors_manager
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hostsensors"
+)
+
+func NewHostSensorsManager(hwmonDir string) *HostSensorsManager {
+	return &HostSensorsManager{
+		done:       false,
+		reader:     hostsensors.NewReader(hwmonDir),
+		stopServer: make(map[types.UID]context.CancelFunc),
+	}
+}
+
+// HostSensorsManager controls the lifetime of the host sensors servers.
+// Each server is tied to the lifetime of the VMI and HostSensorsManager itself.
+type HostSensorsManager struct {
+	lock       sync.Mutex
+	done       bool
+	reader     *hostsensors.Reader
+	stopServer map[types.UID]context.CancelFunc
+}
+
+// Run blocks until stopCh is closed. When done, it stops all remaining
+// running host sensors servers.
+func (m *HostSensorsManager) Run(stopCh chan struct{}) {
+	defer m.stop()
+	<-stopCh
+}
+
+func (m *HostSensorsManager) stop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.done = true
+
+	for vmiUID, stopServerFn := range m.stopServer {
+		stopServerFn()
+		delete(m.stopServer, vmiUID)
+	}
+}
+
+// StopServer stops the host sensors server of the VMI if necessary
+func (m *HostSensorsManager) StopServer(vmi *v1.VirtualMachineInstance) {
+	if !hostsensors.HasDevice(&vmi.Spec) {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.done {
+		return
+	}
+
+	if cancelCtx, exists := m.stopServer[vmi.UID]; exists {
+		cancelCtx()
+		delete(m.stopServer, vmi.UID)
+	}
+}
+
+// StartServer starts a new host sensors server if the VM requests it and it is not already started
+func (m *HostSensorsManager) StartServer(vmi *v1.VirtualMachineInstance, pid int) error {
+	if !hostsensors.HasDevice(&vmi.Spec) || !vmi.IsRunning() {
+		return nil
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.done {
+		return nil
+	}
+
+	if _, alreadyStarted := m.stopServer[vmi.UID]; alreadyStarted {
+		return nil
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	hostsensors.RunHostSensorsVirtioServer(ctx, hostsensors.ChannelSocketPathOnHost(pid), m.reader, hostsensors.Types(vmi.Spec.Domain.Devices.HostSensors))
+	m.stopServer[vmi.UID] = cancelCtx
+
+	return nil
+}
@@ -363,7 +363,7 @@ func (m *volumeMounter) isDirectoryMounted(vmi *v1.VirtualMachineInstance, volum
 	}
 	for _, volume := range vmi.Spec.Volumes {
 		if volume.Name == volumeName {
-			return volume.MemoryDump != nil
+			return volume.MemoryDump != nil || storagetypes.IsHotplugConfigVolume(&volume)
 		}
 	}
 	return false
@@ -0,0 +1,281 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hugepages
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	pageSize     = 2 * 1024 * 1024
+	syncInterval = time.Minute
+	// pendingDemandTimeout bounds how long a VMI waiting to be scheduled keeps pages
+	// allocated, so that VMIs which are never scheduled on the node don't pin the pool
+	pendingDemandTimeout = 10 * time.Minute
+)
+
+var (
+	// This is a var so it can be changed by the unit tests
+
+	// In some environments, sysfs is mounted read-only even for privileged
+	// containers: https://github.com/containerd/containerd/issues/8445.
+	// Use the path from the host filesystem.
+	nrHugepagesPath = "/proc/1/root/sys/kernel/mm/hugepages/hugepages-2048kB/nr_hugepages"
+)
+
+// PoolManager sizes the 2Mi hugepages pool of the node after the hugepages the VMIs need.
+// The VMIs running on or migrating to the node, and the VMIs waiting to be scheduled which
+// the node satisfies the node selector and affinity of, count towards the demand. The pool
+// size is kept within the bounds of the hugepagesPool configuration.
+type PoolManager struct {
+	nodeName      string
+	clusterConfig *virtconfig.ClusterConfig
+	vmiStore      cache.Store
+	nodeStore     cache.Store
+	clock         clock.Clock
+	lock          sync.Mutex
+	// chan for being notified by KV config or VMI changes
+	changesChan chan struct{}
+}
+
+func NewPoolManager(nodeName string, vmiInformer cache.SharedIndexInformer, nodeStore cache.Store, clusterConfig *virtconfig.ClusterConfig) (*PoolManager, error) {
+	m := &PoolManager{
+		nodeName:      nodeName,
+		clusterConfig: clusterConfig,
+		vmiStore:      vmiInformer.GetStore(),
+		nodeStore:     nodeStore,
+		clock:         clock.RealClock{},
+		changesChan:   make(chan struct{}, 1),
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { m.notify() },
+		DeleteFunc: func(_ interface{}) { m.notify() },
+		UpdateFunc: func(_, _ interface{}) { m.notify() },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *PoolManager) Run(stopCh chan struct{}) {
+	m.clusterConfig.SetConfigModifiedCallback(m.notify)
+
+	m.sync()
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.changesChan:
+			m.sync()
+		case <-ticker.C:
+			m.sync()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (m *PoolManager) notify() {
+	select {
+	case m.changesChan <- struct{}{}:
+	default:
+	}
+}
+
+func (m *PoolManager) sync() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	poolConfig := m.clusterConfig.GetHugepagesPoolConfiguration()
+	if !m.clusterConfig.HugepagesPoolManagementEnabled() || poolConfig == nil {
+		return
+	}
+
+	current, err := readNrHugepages()
+	if err != nil {
+		log.Log.Reason(err).Error("failed to read the size of the hugepages pool")
+		return
+	}
+
+	desired := min(max(m.demandedPages(), uint64(poolConfig.MinPages)), uint64(poolConfig.MaxPages))
+	if desired == current {
+		return
+	}
+
+	// The kernel only releases free pages: when shrinking, pages still in use stay
+	// allocated as surplus pages until the VMIs using them are gone.
+	if err := os.WriteFile(nrHugepagesPath, []byte(strconv.FormatUint(desired, 10)), 0644); err != nil {
+		log.Log.Reason(err).Errorf("failed to resize the hugepages pool from %d to %d pages", current, desired)
+		return
+	}
+	log.Log.Infof("Resized the 2Mi hugepages pool from %d to %d pages", current, desired)
+}
+
+// demandedPages sums the 2Mi hugepages of the VMIs which are running on the node, migrating
+// to it or waiting to be scheduled on it
+func (m *PoolManager) demandedPages() uint64 {
+	node := m.getNode()
+	var pages uint64
+	for _, obj := range m.vmiStore.List() {
+		vmi, ok := obj.(*v1.VirtualMachineInstance)
+		if !ok || vmi.IsFinal() || !m.isDemandedOnNode(vmi, node) {
+			continue
+		}
+		pages += requestedPages(vmi)
+	}
+	return pages
+}
+
+func (m *PoolManager) getNode() *k8sv1.Node {
+	obj, exists, err := m.nodeStore.GetByKey(m.nodeName)
+	if err != nil || !exists {
+		return nil
+	}
+	node, _ := obj.(*k8sv1.Node)
+	return node
+}
+
+func (m *PoolManager) isDemandedOnNode(vmi *v1.VirtualMachineInstance, node *k8sv1.Node) bool {
+	if vmi.Status.NodeName == "" {
+		return m.isPendingDemand(vmi, node)
+	}
+	if vmi.Status.NodeName == m.nodeName {
+		return true
+	}
+	migrationState := vmi.Status.MigrationState
+	return migrationState != nil && !migrationState.Completed && migrationState.TargetNode == m.nodeName
+}
+
+// isPendingDemand reports whether a VMI waiting to be scheduled could land on the node.
+// The demand expires after pendingDemandTimeout, the pages are released again if the
+// VMI stays unscheduled, e.g. because another node was picked or none fits.
+func (m *PoolManager) isPendingDemand(vmi *v1.VirtualMachineInstance, node *k8sv1.Node) bool {
+	if node == nil || m.clock.Since(vmi.CreationTimestamp.Time) > pendingDemandTimeout {
+		return false
+	}
+	if !labels.SelectorFromSet(vmi.Spec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	affinity := vmi.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	// The terms are ORed
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if nodeSelectorTermMatches(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeSelectorTermMatches evaluates the ANDed requirements of a node selector term against the node
+func nodeSelectorTermMatches(term k8sv1.NodeSelectorTerm, node *k8sv1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	for _, requirement := range term.MatchExpressions {
+		if !nodeSelectorRequirementMatches(requirement, labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	for _, requirement := range term.MatchFields {
+		if requirement.Key != "metadata.name" ||
+			!nodeSelectorRequirementMatches(requirement, labels.Set{"metadata.name": node.Name}) {
+			return false
+		}
+	}
+	return true
+}
+
+func nodeSelectorRequirementMatches(requirement k8sv1.NodeSelectorRequirement, set labels.Set) bool {
+	operators := map[k8sv1.NodeSelectorOperator]selection.Operator{
+		k8sv1.NodeSelectorOpIn:           selection.In,
+		k8sv1.NodeSelectorOpNotIn:        selection.NotIn,
+		k8sv1.NodeSelectorOpExists:       selection.Exists,
+		k8sv1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		k8sv1.NodeSelectorOpGt:           selection.GreaterThan,
+		k8sv1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	operator, ok := operators[requirement.Operator]
+	if !ok {
+		return false
+	}
+	selectorRequirement, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+	if err != nil {
+		return false
+	}
+	return selectorRequirement.Matches(set)
+}
+
+// requestedPages returns the number of 2Mi hugepages the virt-launcher pod of the VMI requests
+func requestedPages(vmi *v1.VirtualMachineInstance) uint64 {
+	memory := vmi.Spec.Domain.Memory
+	if memory == nil || memory.Hugepages == nil {
+		return 0
+	}
+	size, err := resource.ParseQuantity(memory.Hugepages.PageSize)
+	if err != nil || size.Value() != pageSize {
+		return 0
+	}
+
+	// Like the virt-launcher pod, use the guest memory if it is lower than the memory request
+	hugepagesMemory := vmi.Spec.Domain.Resources.Requests.Memory()
+	if memory.Guest != nil && (hugepagesMemory.IsZero() || hugepagesMemory.Cmp(*memory.Guest) > 0) {
+		hugepagesMemory = memory.Guest
+	}
+	if hugepagesMemory.Value() <= 0 {
+		return 0
+	}
+	return uint64((hugepagesMemory.Value() + pageSize - 1) / pageSize)
+}
+
+func readNrHugepages() (uint64, error) {
+	content, err := os.ReadFile(nrHugepagesPath)
+	if err != nil {
+		return 0, err
+	}
+	pages, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %v", filepath.Base(nrHugepagesPath), err)
+	}
+	return pages, nil
+}
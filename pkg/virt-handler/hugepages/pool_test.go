@@ -0,0 +1,214 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package hugepages
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const testNodeName = "test-node"
+
+var _ = Describe("Hugepages pool", func() {
+	var vmiStore cache.Store
+	var fakeClock *clocktesting.FakeClock
+	var origNrHugepagesPath string
+
+	newManager := func(poolConfig *v1.HugepagesPoolConfiguration, featureGates ...string) *PoolManager {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			HugepagesPool:          poolConfig,
+		})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmiStore = vmiInformer.GetStore()
+		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName, Labels: map[string]string{"zone": "a"}},
+		})).To(Succeed())
+		m, err := NewPoolManager(testNodeName, vmiInformer, nodeInformer.GetStore(), clusterConfig)
+		Expect(err).ToNot(HaveOccurred())
+		m.clock = fakeClock
+		return m
+	}
+
+	newHugepagesVMI := func(name, nodeName, memory string) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(libvmi.WithName(name), libvmi.WithMemoryRequest(memory), libvmi.WithHugepages("2Mi"))
+		vmi.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+		vmi.Status.NodeName = nodeName
+		return vmi
+	}
+
+	writeNrHugepages := func(pages string) {
+		Expect(os.WriteFile(nrHugepagesPath, []byte(pages+"\n"), 0644)).To(Succeed())
+	}
+
+	expectNrHugepages := func(pages string) {
+		content, err := os.ReadFile(nrHugepagesPath)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, strings.TrimSpace(string(content))).To(Equal(pages))
+	}
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakeClock(time.Now())
+		origNrHugepagesPath = nrHugepagesPath
+		nrHugepagesPath = filepath.Join(GinkgoT().TempDir(), "nr_hugepages")
+		writeNrHugepages("0")
+	})
+
+	AfterEach(func() {
+		nrHugepagesPath = origNrHugepagesPath
+	})
+
+	It("should not touch the pool when the feature gate is disabled", func() {
+		m := newManager(&v1.HugepagesPoolConfiguration{MinPages: 16, MaxPages: 1024})
+		m.sync()
+		expectNrHugepages("0")
+	})
+
+	It("should not touch the pool without a configuration", func() {
+		m := newManager(nil, featuregate.HugepagesPoolManagementGate)
+		Expect(vmiStore.Add(newHugepagesVMI("running", testNodeName, "1Gi"))).To(Succeed())
+		m.sync()
+		expectNrHugepages("0")
+	})
+
+	It("should keep the minimum number of pages without demand", func() {
+		m := newManager(&v1.HugepagesPoolConfiguration{MinPages: 16, MaxPages: 1024}, featuregate.HugepagesPoolManagementGate)
+		m.sync()
+		expectNrHugepages("16")
+	})
+
+	It("should size the pool after the VMIs demanding hugepages on the node", func() {
+		m := newManager(&v1.HugepagesPoolConfiguration{MaxPages: 4096}, featuregate.HugepagesPoolManagementGate)
+
+		migratingVMI := newHugepagesVMI("migrating", "other-node", "512Mi")
+		migratingVMI.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{TargetNode: testNodeName}
+		finalVMI := newHugepagesVMI("final", testNodeName, "1Gi")
+		finalVMI.Status.Phase = v1.Succeeded
+		noHugepagesVMI := libvmi.New(libvmi.WithName("no-hugepages"), libvmi.WithMemoryRequest("1Gi"))
+		noHugepagesVMI.Status.NodeName = testNodeName
+
+		for _, vmi := range []*v1.VirtualMachineInstance{
+			newHugepagesVMI("running", testNodeName, "1Gi"),
+			newHugepagesVMI("pending", "", "256Mi"),
+			newHugepagesVMI("elsewhere", "other-node", "1Gi"),
+			libvmi.New(libvmi.WithName("large-pages"), libvmi.WithMemoryRequest("1Gi"), libvmi.WithHugepages("1Gi")),
+			migratingVMI,
+			finalVMI,
+			noHugepagesVMI,
+		} {
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+		}
+
+		m.sync()
+		expectNrHugepages("896")
+	})
+
+	It("should cap the pool at the maximum number of pages", func() {
+		m := newManager(&v1.HugepagesPoolConfiguration{MaxPages: 256}, featuregate.HugepagesPoolManagementGate)
+		Expect(vmiStore.Add(newHugepagesVMI("running", testNodeName, "1Gi"))).To(Succeed())
+		m.sync()
+		expectNrHugepages("256")
+	})
+
+	It("should shrink the pool once the demand is gone", func() {
+		m := newManager(&v1.HugepagesPoolConfiguration{MinPages: 8, MaxPages: 1024}, featuregate.HugepagesPoolManagementGate)
+		vmi := newHugepagesVMI("running", testNodeName, "1Gi")
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		m.sync()
+		expectNrHugepages("512")
+
+		Expect(vmiStore.Delete(vmi)).To(Succeed())
+		m.sync()
+		expectNrHugepages("8")
+	})
+
+	It("should use the guest memory when it is lower than the memory request", func() {
+		vmi := newHugepagesVMI("running", testNodeName, "1Gi")
+		guest := vmi.Spec.Domain.Resources.Requests.Memory().DeepCopy()
+		guest.Set(64 * 1024 * 1024)
+		vmi.Spec.Domain.Memory.Guest = &guest
+		Expect(requestedPages(vmi)).To(Equal(uint64(32)))
+	})
+
+	Context("with VMIs waiting to be scheduled", func() {
+		var m *PoolManager
+
+		BeforeEach(func() {
+			m = newManager(&v1.HugepagesPoolConfiguration{MaxPages: 4096}, featuregate.HugepagesPoolManagementGate)
+		})
+
+		It("should only count the VMIs the node selector of which matches the node", func() {
+			matching := newHugepagesVMI("matching", "", "256Mi")
+			matching.Spec.NodeSelector = map[string]string{"zone": "a"}
+			other := newHugepagesVMI("other", "", "1Gi")
+			other.Spec.NodeSelector = map[string]string{"zone": "b"}
+			Expect(vmiStore.Add(matching)).To(Succeed())
+			Expect(vmiStore.Add(other)).To(Succeed())
+
+			m.sync()
+			expectNrHugepages("128")
+		})
+
+		DescribeTable("should evaluate the required node affinity", func(requirement k8sv1.NodeSelectorRequirement, expectedPages string) {
+			vmi := newHugepagesVMI("pending", "", "256Mi")
+			vmi.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{MatchExpressions: []k8sv1.NodeSelectorRequirement{requirement}}},
+				},
+			}}
+			Expect(vmiStore.Add(vmi)).To(Succeed())
+
+			m.sync()
+			expectNrHugepages(expectedPages)
+		},
+			Entry("with a matching label", k8sv1.NodeSelectorRequirement{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}}, "128"),
+			Entry("with another label value", k8sv1.NodeSelectorRequirement{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"b"}}, "0"),
+			Entry("with an excluded label value", k8sv1.NodeSelectorRequirement{Key: "zone", Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"a"}}, "0"),
+			Entry("with a missing label", k8sv1.NodeSelectorRequirement{Key: "gpu", Operator: k8sv1.NodeSelectorOpExists}, "0"),
+		)
+
+		It("should release the pages of VMIs which are not scheduled in time", func() {
+			Expect(vmiStore.Add(newHugepagesVMI("pending", "", "256Mi"))).To(Succeed())
+			m.sync()
+			expectNrHugepages("128")
+
+			fakeClock.Step(pendingDemandTimeout + time.Second)
+			m.sync()
+			expectNrHugepages("0")
+		})
+	})
+})
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import "sync"
+
+// keyedMutex serializes the processing of a key across worker pools which
+// consume different queues. A workqueue only guarantees that a key is not
+// processed concurrently by the workers of that very queue.
+type keyedMutex struct {
+	lock  sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*keyLock)}
+}
+
+// Lock blocks until the key is held by the caller.
+func (m *keyedMutex) Lock(key string) {
+	m.lock.Lock()
+	l, exists := m.locks[key]
+	if !exists {
+		l = &keyLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.lock.Unlock()
+
+	l.Lock()
+}
+
+// Unlock releases the key and forgets it once nobody waits for it anymore.
+func (m *keyedMutex) Unlock(key string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	l := m.locks[key]
+	l.Unlock()
+	l.refs--
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("virt-handler keyed mutex", func() {
+	It("should serialize the holders of the same key", func() {
+		m := newKeyedMutex()
+		m.Lock("default/testvmi")
+
+		acquired := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			m.Lock("default/testvmi")
+			close(acquired)
+			m.Unlock("default/testvmi")
+		}()
+
+		Consistently(acquired, 200*time.Millisecond).ShouldNot(BeClosed())
+		m.Unlock("default/testvmi")
+		Eventually(acquired).Should(BeClosed())
+		Eventually(func() int {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+			return len(m.locks)
+		}).Should(BeZero())
+	})
+
+	It("should not block holders of other keys", func() {
+		m := newKeyedMutex()
+		m.Lock("default/testvmi")
+		defer m.Unlock("default/testvmi")
+
+		acquired := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			m.Lock("default/othervmi")
+			close(acquired)
+			m.Unlock("default/othervmi")
+		}()
+
+		Eventually(acquired).Should(BeClosed())
+	})
+})
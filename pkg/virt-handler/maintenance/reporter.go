@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const syncInterval = time.Minute
+
+// DomainReporter reports the number of domains running on the node while the
+// node is in maintenance. virt-controller only sees the VirtualMachineInstances,
+// the annotation lets it confirm that no domain outlived its VirtualMachineInstance
+// before it declares the node ready for reboot.
+type DomainReporter struct {
+	nodeName    string
+	client      k8scorev1.CoreV1Interface
+	nodeStore   cache.Store
+	domainStore cache.Store
+	lock        sync.Mutex
+	// chan for being notified by node or domain changes
+	changesChan chan struct{}
+}
+
+func NewDomainReporter(nodeName string, client k8scorev1.CoreV1Interface, nodeInformer cache.SharedInformer, domainInformer cache.SharedInformer) (*DomainReporter, error) {
+	r := &DomainReporter{
+		nodeName:    nodeName,
+		client:      client,
+		nodeStore:   nodeInformer.GetStore(),
+		domainStore: domainInformer.GetStore(),
+		changesChan: make(chan struct{}, 1),
+	}
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { r.notify() },
+		DeleteFunc: func(_ interface{}) { r.notify() },
+		UpdateFunc: func(_, _ interface{}) { r.notify() },
+	}
+	if _, err := nodeInformer.AddEventHandler(handler); err != nil {
+		return nil, err
+	}
+	if _, err := domainInformer.AddEventHandler(handler); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *DomainReporter) Run(stopCh chan struct{}) {
+	r.sync()
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.changesChan:
+			r.sync()
+		case <-ticker.C:
+			r.sync()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (r *DomainReporter) notify() {
+	select {
+	case r.changesChan <- struct{}{}:
+	default:
+	}
+}
+
+func (r *DomainReporter) sync() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	obj, exists, err := r.nodeStore.GetByKey(r.nodeName)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to get node %s", r.nodeName)
+		return
+	}
+	if !exists {
+		return
+	}
+	node := obj.(*k8sv1.Node)
+
+	// virt-controller removes the annotation when the maintenance ends
+	if !hasMaintenanceTaint(node) {
+		return
+	}
+
+	domains := strconv.Itoa(len(r.domainStore.List()))
+	if node.Annotations[maintenancev1alpha1.NodeMaintenanceDomainsAnnotation] == domains {
+		return
+	}
+
+	// merge patch is being used here to handle the case in which the node has no annotations
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: domains,
+			},
+		},
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("failed to marshal the node patch")
+		return
+	}
+
+	if _, err := r.client.Nodes().Patch(context.Background(), r.nodeName, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		log.Log.Reason(err).Errorf("failed to report %s domains on node %s", domains, r.nodeName)
+		return
+	}
+	log.Log.V(4).Infof("Reported %s domains on node %s in maintenance", domains, r.nodeName)
+}
+
+// hasMaintenanceTaint returns whether the node has the maintenance taint
+func hasMaintenanceTaint(node *k8sv1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == maintenancev1alpha1.NodeMaintenanceTaintKey && taint.Effect == k8sv1.TaintEffectNoSchedule {
+			return true
+		}
+	}
+	return false
+}
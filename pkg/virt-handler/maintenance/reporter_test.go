@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package maintenance
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const testNodeName = "test-node"
+
+var _ = Describe("Domain reporter", func() {
+	var fakeClient *fake.Clientset
+	var nodeStore cache.Store
+	var domainStore cache.Store
+	var reporter *DomainReporter
+
+	maintenanceTaint := k8sv1.Taint{Key: maintenancev1alpha1.NodeMaintenanceTaintKey, Effect: k8sv1.TaintEffectNoSchedule}
+
+	addNode := func(taints ...k8sv1.Taint) {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: testNodeName},
+			Spec:       k8sv1.NodeSpec{Taints: taints},
+		}
+		fakeClient = fake.NewSimpleClientset(node)
+		Expect(nodeStore.Add(node)).To(Succeed())
+		reporter.client = fakeClient.CoreV1()
+	}
+
+	addDomains := func(names ...string) {
+		for _, name := range names {
+			Expect(domainStore.Add(api.NewMinimalDomainWithNS("default", name))).To(Succeed())
+		}
+	}
+
+	getNode := func() *k8sv1.Node {
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), testNodeName, metav1.GetOptions{})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return node
+	}
+
+	BeforeEach(func() {
+		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		domainInformer, _ := testutils.NewFakeInformerFor(&api.Domain{})
+		nodeStore = nodeInformer.GetStore()
+		domainStore = domainInformer.GetStore()
+
+		var err error
+		reporter, err = NewDomainReporter(testNodeName, nil, nodeInformer, domainInformer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report the domains of a node in maintenance", func() {
+		addNode(maintenanceTaint)
+		addDomains("vmi1", "vmi2")
+
+		reporter.sync()
+		Expect(getNode().Annotations).To(HaveKeyWithValue(maintenancev1alpha1.NodeMaintenanceDomainsAnnotation, "2"))
+	})
+
+	It("should report zero domains", func() {
+		addNode(maintenanceTaint)
+
+		reporter.sync()
+		Expect(getNode().Annotations).To(HaveKeyWithValue(maintenancev1alpha1.NodeMaintenanceDomainsAnnotation, "0"))
+	})
+
+	It("should not report the domains of a node which is not in maintenance", func() {
+		addNode(k8sv1.Taint{Key: "other", Effect: k8sv1.TaintEffectNoSchedule})
+		addDomains("vmi1")
+
+		reporter.sync()
+		Expect(getNode().Annotations).ToNot(HaveKey(maintenancev1alpha1.NodeMaintenanceDomainsAnnotation))
+	})
+
+	It("should not patch the node if the reported domains are up to date", func() {
+		addNode(maintenanceTaint)
+		addDomains("vmi1")
+		obj, _, _ := nodeStore.GetByKey(testNodeName)
+		node := obj.(*k8sv1.Node).DeepCopy()
+		node.Annotations = map[string]string{maintenancev1alpha1.NodeMaintenanceDomainsAnnotation: "1"}
+		Expect(nodeStore.Update(node)).To(Succeed())
+
+		reporter.sync()
+		Expect(fakeClient.Actions()).To(BeEmpty())
+	})
+})
@@ -135,6 +135,7 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 	n.SEV = hostDomCapabilities.SEV
 	n.SecureExecution = hostDomCapabilities.SecureExecution
 	n.TDX = hostDomCapabilities.TDX
+	n.SGX = hostDomCapabilities.SGX
 
 	return nil
 }
@@ -266,6 +266,19 @@ var _ = Describe("Node-labeller config", func() {
 		)
 	})
 
+	DescribeTable("return correct Intel SGX capabilities",
+		func(domCapabilitiesFileName, supported, flc string) {
+			nlController.domCapabilitiesFileName = domCapabilitiesFileName
+
+			err := nlController.loadDomCapabilities()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nlController.SGX.Supported).To(Equal(supported))
+			Expect(nlController.SGX.FLC).To(Equal(flc))
+		},
+		Entry("when Intel SGX is supported", "domcapabilities_tdx.xml", "yes", "yes"),
+		Entry("when Intel SGX is not reported", "virsh_domcapabilities.xml", "", ""),
+	)
+
 	It("Make sure proper labels are removed on removeLabellerLabels()", func() {
 		node := &k8sv1.Node{
 			ObjectMeta: metav1.ObjectMeta{
@@ -41,6 +41,7 @@ type HostDomCapabilities struct {
 	SEV             SEVConfiguration             `xml:"features>sev"`
 	SecureExecution SecureExecutionConfiguration `xml:"features>s390-pv"`
 	TDX             TDXConfiguration             `xml:"features>tdx"`
+	SGX             SGXConfiguration             `xml:"features>sgx"`
 	LaunchSecurity  LaunchSecurityConfiguration  `xml:"features>launchSecurity"`
 }
 
@@ -109,6 +110,12 @@ type TDXConfiguration struct {
 	Supported string `xml:"supported,attr"`
 }
 
+type SGXConfiguration struct {
+	Supported string `xml:"supported,attr"`
+	// FLC tells if the launch control of the host is flexible, which is required to hand EPC sections to guests
+	FLC string `xml:"flc"`
+}
+
 type LaunchSecurityConfiguration struct {
 	Supported string      `xml:"supported,attr"`
 	SecTypes  SecTypeEnum `xml:"enum"`
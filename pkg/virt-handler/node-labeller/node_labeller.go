@@ -22,7 +22,8 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
-	"os/exec"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
@@ -43,6 +44,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -51,12 +53,17 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.CPUModelLabel,
 	kubevirtv1.SupportedHostModelMigrationCPU,
 	kubevirtv1.CPUTimerLabel,
+	kubevirtv1.CPUCoreTypeLabel,
 	kubevirtv1.HypervLabel,
 	kubevirtv1.RealtimeLabel,
+	kubevirtv1.RealtimeReadyLabel,
+	kubevirtv1.NestedVirtualizationLabel,
+	kubevirtv1.KVMUnavailableLabel,
 	kubevirtv1.SEVLabel,
 	kubevirtv1.SEVESLabel,
 	kubevirtv1.SEVSNPLabel,
 	kubevirtv1.TDXLabel,
+	kubevirtv1.SGXLabel,
 	kubevirtv1.HostModelCPULabel,
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
@@ -84,7 +91,12 @@ type NodeLabeller struct {
 	SEV                     SEVConfiguration
 	SecureExecution         SecureExecutionConfiguration
 	TDX                     TDXConfiguration
+	SGX                     SGXConfiguration
 	arch                    archLabeller
+	kvmModulePath           string
+	kvmDevicePath           string
+	cpuDevicesPath          string
+	realtime                realtimeChecker
 }
 
 func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.NodeInterface, nodeStore cache.Store, host string, recorder record.EventRecorder, cpuCounter *libvirtxml.CapsHostCPUCounter, supportedMachines []libvirtxml.CapsGuestMachine) (*NodeLabeller, error) {
@@ -109,6 +121,10 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, nodeClient k8scli.
 		supportedMachines:       supportedMachines,
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool)},
 		arch:                    newArchLabeller(runtime.GOARCH),
+		kvmModulePath:           kvmModulePath,
+		kvmDevicePath:           kvmDevicePath,
+		cpuDevicesPath:          hardware.CPUDevicesPath,
+		realtime:                newRealtimeChecker(),
 	}
 
 	err := n.loadAll()
@@ -201,6 +217,7 @@ func (n *NodeLabeller) run() error {
 	n.removeLabellerLabels(node)
 	//add new labels
 	n.addLabellerLabels(node, newLabels)
+	n.updateRealtimeReadiness(node)
 	return n.patchNode(originalNode, node)
 }
 
@@ -210,15 +227,28 @@ func skipNodeLabelling(node *v1.Node) bool {
 }
 
 func (n *NodeLabeller) patchNode(originalNode, node *v1.Node) error {
-	if equality.Semantic.DeepEqual(originalNode.Labels, node.Labels) {
+	patchSet := patch.New()
+	if !equality.Semantic.DeepEqual(originalNode.Labels, node.Labels) {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/labels", originalNode.Labels),
+			patch.WithReplace("/metadata/labels", node.Labels),
+		)
+	}
+	if !equality.Semantic.DeepEqual(originalNode.Annotations, node.Annotations) {
+		if len(originalNode.Annotations) == 0 {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations", node.Annotations))
+		} else {
+			patchSet.AddOption(
+				patch.WithTest("/metadata/annotations", originalNode.Annotations),
+				patch.WithReplace("/metadata/annotations", node.Annotations),
+			)
+		}
+	}
+	if patchSet.IsEmpty() {
 		return nil
 	}
 
-	patchBytes, err := patch.New(
-		patch.WithTest("/metadata/labels", originalNode.Labels),
-		patch.WithReplace("/metadata/labels", node.Labels),
-	).GeneratePayload()
-
+	patchBytes, err := patchSet.GeneratePayload()
 	if err != nil {
 		return err
 	}
@@ -227,6 +257,26 @@ func (n *NodeLabeller) patchNode(originalNode, node *v1.Node) error {
 	return err
 }
 
+// updateRealtimeReadiness labels realtime capable nodes which are ready to run
+// realtime workloads at low latency, and records on the others what prevents it
+func (n *NodeLabeller) updateRealtimeReadiness(node *v1.Node) {
+	if node.Labels[kubevirtv1.RealtimeLabel] != "true" {
+		delete(node.Annotations, kubevirtv1.RealtimeUnmetRequirementsAnnotation)
+		return
+	}
+
+	unmet := n.realtime.unmetRequirements()
+	if len(unmet) == 0 {
+		node.Labels[kubevirtv1.RealtimeReadyLabel] = "true"
+		delete(node.Annotations, kubevirtv1.RealtimeUnmetRequirementsAnnotation)
+		return
+	}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[kubevirtv1.RealtimeUnmetRequirementsAnnotation] = strings.Join(unmet, "; ")
+}
+
 func (n *NodeLabeller) loadHypervFeatures() {
 	n.hypervFeatures.items = getCapLabels()
 }
@@ -284,7 +334,17 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.HostModelCPULabel+hostCpuModel.Name] = "true"
 	}
 
-	capable, err := isNodeRealtimeCapable()
+	if n.clusterConfig.HybridCPUPinningEnabled() {
+		coreTypes, err := hardware.GetCPUCoreTypes(n.cpuDevicesPath)
+		if err != nil {
+			n.logger.Reason(err).Error("failed to detect the core types of the host CPUs")
+		}
+		for _, coreType := range coreTypes {
+			newLabels[kubevirtv1.CPUCoreTypeLabel+string(coreType)] = "true"
+		}
+	}
+
+	capable, err := n.realtime.isCapable()
 	if err != nil {
 		n.logger.Reason(err).Error("failed to identify if a node is capable of running realtime workloads")
 	}
@@ -292,6 +352,14 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.RealtimeLabel] = "true"
 	}
 
+	if n.isNodeNestedVirtualizationCapable() {
+		newLabels[kubevirtv1.NestedVirtualizationLabel] = "true"
+	}
+
+	if n.clusterConfig.VMEmulationEnabled() && !n.isKVMAvailable() {
+		newLabels[kubevirtv1.KVMUnavailableLabel] = "true"
+	}
+
 	if n.SEV.Supported == "yes" {
 		newLabels[kubevirtv1.SEVLabel] = "true"
 	}
@@ -312,6 +380,10 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node) map[string]string {
 		newLabels[kubevirtv1.TDXLabel] = "true"
 	}
 
+	if n.SGX.Supported == "yes" && n.SGX.FLC == "yes" {
+		newLabels[kubevirtv1.SGXLabel] = "true"
+	}
+
 	return newLabels
 }
 
@@ -348,20 +420,29 @@ func (n *NodeLabeller) removeLabellerLabels(node *v1.Node) {
 	}
 }
 
-const kernelSchedRealtimeRuntimeInMicrosecods = "kernel.sched_rt_runtime_us"
-
-// isNodeRealtimeCapable Checks if a node is capable of running realtime workloads. Currently by validating if the kernel system setting value
-// for `kernel.sched_rt_runtime_us` is set to allow running realtime scheduling with unlimited time (==-1)
-// TODO: This part should be improved to validate against key attributes that determine best if a host is able to run realtime
-// workloads at peak performance.
+const kvmModulePath = "/sys/module"
 
-func isNodeRealtimeCapable() (bool, error) {
-	ret, err := exec.Command("sysctl", kernelSchedRealtimeRuntimeInMicrosecods).CombinedOutput()
-	if err != nil {
-		return false, err
+// isNodeNestedVirtualizationCapable checks if the loaded KVM module allows running nested guests
+func (n *NodeLabeller) isNodeNestedVirtualizationCapable() bool {
+	for _, module := range []string{"kvm_intel", "kvm_amd"} {
+		nested, err := os.ReadFile(filepath.Join(n.kvmModulePath, module, "parameters", "nested"))
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSpace(string(nested)) {
+		case "Y", "1":
+			return true
+		}
 	}
-	st := strings.Trim(string(ret), "\n")
-	return fmt.Sprintf("%s = -1", kernelSchedRealtimeRuntimeInMicrosecods) == st, nil
+	return false
+}
+
+const kvmDevicePath = "/dev/kvm"
+
+// isKVMAvailable checks if the node exposes the KVM device
+func (n *NodeLabeller) isKVMAvailable() bool {
+	_, err := os.Stat(n.kvmDevicePath)
+	return err == nil
 }
 
 func isNodeLabellerLabel(label string) bool {
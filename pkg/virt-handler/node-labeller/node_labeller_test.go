@@ -24,6 +24,8 @@ package nodelabeller
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -40,6 +42,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/util"
 )
 
@@ -218,6 +221,198 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(node.Labels).To(HaveKeyWithValue(v1.TDXLabel, "true"))
 	})
 
+	It("should not add SGX label", func() {
+		// virsh_domcapabilities.xml in which sgx is not reported
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.SGXLabel)))
+	})
+
+	It("should add SGX label with value set to true", func() {
+		nlController.domCapabilitiesFileName = "domcapabilities_tdx.xml"
+		Expect(nlController.loadAll()).Should(Succeed())
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(HaveKeyWithValue(v1.SGXLabel, "true"))
+	})
+
+	It("should not add SGX label when the launch control is not flexible", func() {
+		nlController.SGX = SGXConfiguration{Supported: "yes", FLC: "no"}
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		Expect(node.Labels).To(Not(HaveKey(v1.SGXLabel)))
+	})
+
+	DescribeTable("should label nested virtualization support", func(module, nested string, expectLabel bool) {
+		nlController.kvmModulePath = GinkgoT().TempDir()
+		if module != "" {
+			parameters := filepath.Join(nlController.kvmModulePath, module, "parameters")
+			Expect(os.MkdirAll(parameters, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(parameters, "nested"), []byte(nested+"\n"), 0644)).To(Succeed())
+		}
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		if expectLabel {
+			Expect(node.Labels).To(HaveKeyWithValue(v1.NestedVirtualizationLabel, "true"))
+		} else {
+			Expect(node.Labels).ToNot(HaveKey(v1.NestedVirtualizationLabel))
+		}
+	},
+		Entry("when kvm_intel allows nesting", "kvm_intel", "Y", true),
+		Entry("when kvm_amd allows nesting", "kvm_amd", "1", true),
+		Entry("when kvm_intel does not allow nesting", "kvm_intel", "N", false),
+		Entry("when kvm_amd does not allow nesting", "kvm_amd", "0", false),
+		Entry("when no KVM module is loaded", "", "", false),
+	)
+
+	Context("realtime readiness", func() {
+		writeHostFile := func(path, content string) {
+			ExpectWithOffset(1, os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+			ExpectWithOffset(1, os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			root := GinkgoT().TempDir()
+			nlController.realtime = realtimeChecker{
+				schedRTRuntimePath: filepath.Join(root, "sched_rt_runtime_us"),
+				kernelCmdlinePath:  filepath.Join(root, "cmdline"),
+				hugepagesPath:      filepath.Join(root, "hugepages"),
+				kernelRealtimePath: filepath.Join(root, "realtime"),
+			}
+		})
+
+		It("should not label or annotate nodes with limited realtime scheduling", func() {
+			writeHostFile(nlController.realtime.schedRTRuntimePath, "950000\n")
+
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeLabel))
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeReadyLabel))
+			Expect(node.Annotations).ToNot(HaveKey(v1.RealtimeUnmetRequirementsAnnotation))
+		})
+
+		It("should label ready nodes as ready without annotating them", func() {
+			writeHostFile(nlController.realtime.schedRTRuntimePath, "-1\n")
+			writeHostFile(nlController.realtime.kernelCmdlinePath, "BOOT_IMAGE=/vmlinuz isolcpus=2-7 nohz_full=2-7 hugepagesz=1G hugepages=4\n")
+			writeHostFile(filepath.Join(nlController.realtime.hugepagesPath, "hugepages-1048576kB", "nr_hugepages"), "4\n")
+			writeHostFile(nlController.realtime.kernelRealtimePath, "1\n")
+
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeReadyLabel, "true"))
+			Expect(node.Annotations).ToNot(HaveKey(v1.RealtimeUnmetRequirementsAnnotation))
+		})
+
+		It("should annotate the unmet requirements of realtime capable nodes", func() {
+			writeHostFile(nlController.realtime.schedRTRuntimePath, "-1\n")
+			writeHostFile(nlController.realtime.kernelCmdlinePath, "BOOT_IMAGE=/vmlinuz isolcpus= quiet\n")
+			writeHostFile(filepath.Join(nlController.realtime.hugepagesPath, "hugepages-2048kB", "nr_hugepages"), "0\n")
+
+			Expect(nlController.execute()).To(BeTrue())
+
+			node := retrieveNode(kubeClient)
+			Expect(node.Labels).To(HaveKeyWithValue(v1.RealtimeLabel, "true"))
+			Expect(node.Labels).ToNot(HaveKey(v1.RealtimeReadyLabel))
+			Expect(node.Annotations).To(HaveKey(v1.RealtimeUnmetRequirementsAnnotation))
+			unmet := node.Annotations[v1.RealtimeUnmetRequirementsAnnotation]
+			Expect(unmet).To(ContainSubstring("isolcpus"))
+			Expect(unmet).To(ContainSubstring("nohz_full"))
+			Expect(unmet).To(ContainSubstring("hugepages"))
+			Expect(unmet).To(ContainSubstring("PREEMPT_RT"))
+		})
+	})
+
+	DescribeTable("should label nodes without KVM", func(featureGates []string, kvmPresent, expectLabel bool) {
+		initNodeLabeller(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+				},
+			},
+		})
+		nlController.kvmDevicePath = filepath.Join(GinkgoT().TempDir(), "kvm")
+		if kvmPresent {
+			Expect(os.WriteFile(nlController.kvmDevicePath, nil, 0644)).To(Succeed())
+		}
+		mockQueue := testutils.NewMockWorkQueue(nlController.queue)
+		nlController.queue = mockQueue
+
+		mockQueue.ExpectAdds(1)
+		nlController.queue.Add(nodeName)
+		mockQueue.Wait()
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		if expectLabel {
+			Expect(node.Labels).To(HaveKeyWithValue(v1.KVMUnavailableLabel, "true"))
+		} else {
+			Expect(node.Labels).ToNot(HaveKey(v1.KVMUnavailableLabel))
+		}
+	},
+		Entry("when KVM is missing", []string{featuregate.VMEmulationGate}, false, true),
+		Entry("when KVM is present", []string{featuregate.VMEmulationGate}, true, false),
+		Entry("when the feature gate is disabled", nil, false, false),
+	)
+
+	DescribeTable("should label the core types of hybrid CPUs", func(featureGates []string, expectLabels bool) {
+		initNodeLabeller(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+				},
+			},
+		})
+		nlController.cpuDevicesPath = GinkgoT().TempDir()
+		for device, cpus := range map[string]string{"cpu_core": "0-3", "cpu_atom": "4-7"} {
+			Expect(os.MkdirAll(filepath.Join(nlController.cpuDevicesPath, device), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(nlController.cpuDevicesPath, device, "cpus"), []byte(cpus+"\n"), 0644)).To(Succeed())
+		}
+		mockQueue := testutils.NewMockWorkQueue(nlController.queue)
+		nlController.queue = mockQueue
+
+		mockQueue.ExpectAdds(1)
+		nlController.queue.Add(nodeName)
+		mockQueue.Wait()
+
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+
+		node := retrieveNode(kubeClient)
+		if expectLabels {
+			Expect(node.Labels).To(HaveKeyWithValue(v1.CPUCoreTypeLabel+"performance", "true"))
+			Expect(node.Labels).To(HaveKeyWithValue(v1.CPUCoreTypeLabel+"efficiency", "true"))
+		} else {
+			Expect(node.Labels).ToNot(HaveKey(v1.CPUCoreTypeLabel + "performance"))
+			Expect(node.Labels).ToNot(HaveKey(v1.CPUCoreTypeLabel + "efficiency"))
+		}
+	},
+		Entry("when the feature gate is enabled", []string{featuregate.HybridCPUPinningGate}, true),
+		Entry("when the feature gate is disabled", nil, false),
+	)
+
 	It("should add usable cpu model labels for the host cpu model", func() {
 		res := nlController.execute()
 		Expect(res).To(BeTrue())
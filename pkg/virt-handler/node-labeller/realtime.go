@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	schedRTRuntimePath = "/proc/sys/kernel/sched_rt_runtime_us"
+	kernelCmdlinePath  = "/proc/cmdline"
+	hugepagesPath      = "/sys/kernel/mm/hugepages"
+	kernelRealtimePath = "/sys/kernel/realtime"
+)
+
+// realtimeChecker inspects the host to tell if it is ready to run realtime
+// workloads at low latency
+type realtimeChecker struct {
+	schedRTRuntimePath string
+	kernelCmdlinePath  string
+	hugepagesPath      string
+	kernelRealtimePath string
+}
+
+func newRealtimeChecker() realtimeChecker {
+	return realtimeChecker{
+		schedRTRuntimePath: schedRTRuntimePath,
+		kernelCmdlinePath:  kernelCmdlinePath,
+		hugepagesPath:      hugepagesPath,
+		kernelRealtimePath: kernelRealtimePath,
+	}
+}
+
+// isCapable checks if realtime scheduling is allowed to run with unlimited
+// time, which is required to label the node as realtime capable
+func (c realtimeChecker) isCapable() (bool, error) {
+	runtime, err := os.ReadFile(c.schedRTRuntimePath)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(runtime)) == "-1", nil
+}
+
+// unmetRequirements returns what has to be changed on a realtime capable node
+// to run realtime workloads without degraded latency, or nothing if it is ready
+func (c realtimeChecker) unmetRequirements() []string {
+	var unmet []string
+
+	cmdline, err := os.ReadFile(c.kernelCmdlinePath)
+	if err != nil {
+		unmet = append(unmet, fmt.Sprintf("failed to read the kernel command line: %v", err))
+	} else {
+		params := kernelParameters(string(cmdline))
+		if params["isolcpus"] == "" {
+			unmet = append(unmet, "isolcpus must be set on the kernel command line to isolate the CPUs of realtime VMs from the host scheduler")
+		}
+		if params["nohz_full"] == "" {
+			unmet = append(unmet, "nohz_full must be set on the kernel command line to stop the scheduling-clock tick on the isolated CPUs")
+		}
+	}
+
+	if !c.hasHugepages() {
+		unmet = append(unmet, "hugepages must be reserved, e.g. with hugepagesz and hugepages on the kernel command line")
+	}
+
+	if realtime, err := os.ReadFile(c.kernelRealtimePath); err != nil || strings.TrimSpace(string(realtime)) != "1" {
+		unmet = append(unmet, "the node must boot a kernel built with PREEMPT_RT")
+	}
+
+	return unmet
+}
+
+// hasHugepages checks if hugepages of any size are reserved on the node
+func (c realtimeChecker) hasHugepages() bool {
+	sizes, err := os.ReadDir(c.hugepagesPath)
+	if err != nil {
+		return false
+	}
+	for _, size := range sizes {
+		count, err := os.ReadFile(filepath.Join(c.hugepagesPath, size.Name(), "nr_hugepages"))
+		if err != nil {
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSpace(string(count))); err == nil && n > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func kernelParameters(cmdline string) map[string]string {
+	params := map[string]string{}
+	for _, field := range strings.Fields(cmdline) {
+		key, value, _ := strings.Cut(field, "=")
+		params[key] = value
+	}
+	return params
+}
@@ -130,12 +130,14 @@ func (*BaseController) prepareVFIO(res isolation.IsolationResult) error {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil
 		}
+		return err
 	}
 	vfioPath, err := safepath.JoinNoFollow(vfioBasePath, "vfio")
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil
 		}
+		return err
 	}
 	err = safepath.ChmodAtNoFollow(vfioPath, 0666)
 	if err != nil {
@@ -172,7 +174,7 @@ func (c *BaseController) prepareNetwork(vmi *v1.VirtualMachineInstance, res isol
 		return err
 	}
 
-	if netvmispec.RequiresVirtioNetDevice(vmi, c.clusterConfig.AllowEmulation()) {
+	if netvmispec.RequiresVirtioNetDevice(vmi, c.clusterConfig.AllowEmulation() || vmi.IsEmulationEnabled()) {
 		if err := c.claimDeviceOwnership(rootMount, "vhost-net"); err != nil {
 			return neterrors.CreateCriticalNetworkError(fmt.Errorf("failed to set up vhost-net device, %s", err))
 		}
@@ -41,16 +41,20 @@ import (
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/smartcard"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-handler/usbredir"
 )
 
 type ConsoleHandler struct {
 	podIsolationDetector isolation.PodIsolationDetector
-	serialStopChans      map[types.UID]chan struct{}
+	serialStopChans      map[serialConsoleKey]chan struct{}
 	vncStopChans         map[types.UID]chan struct{}
+	smartcardStopChans   map[types.UID]chan struct{}
 	serialLock           *sync.Mutex
 	vncLock              *sync.Mutex
+	smartcardLock        *sync.Mutex
 	vmiStore             cache.Store
 	usbredir             map[types.UID]UsbredirHandlerVMI
 	usbredirLock         *sync.Mutex
@@ -64,10 +68,12 @@ type UsbredirHandlerVMI struct {
 func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiStore cache.Store, certManager certificate.Manager) *ConsoleHandler {
 	return &ConsoleHandler{
 		podIsolationDetector: podIsolationDetector,
-		serialStopChans:      make(map[types.UID]chan struct{}),
+		serialStopChans:      make(map[serialConsoleKey]chan struct{}),
 		vncStopChans:         make(map[types.UID]chan struct{}),
+		smartcardStopChans:   make(map[types.UID]chan struct{}),
 		serialLock:           &sync.Mutex{},
 		vncLock:              &sync.Mutex{},
+		smartcardLock:        &sync.Mutex{},
 		usbredirLock:         &sync.Mutex{},
 		vmiStore:             vmiStore,
 		usbredir:             make(map[types.UID]UsbredirHandlerVMI),
@@ -83,6 +89,17 @@ func (t *ConsoleHandler) USBRedirHandler(request *restful.Request, response *res
 		return
 	}
 
+	if vmi.Spec.Domain.Devices.ClientPassthrough == nil {
+		err := errors.New("VMI does not have USB redirection enabled")
+		log.Log.Object(vmi).Reason(err).Error("Failed to redirect USB device")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	slots := v1.UsbClientPassthroughMaxNumberOf
+	if count := vmi.Spec.Domain.Devices.ClientPassthrough.Count; count != nil {
+		slots = int(*count)
+	}
+
 	uid := vmi.GetUID()
 	stopChan := make(chan struct{})
 	var slotId int
@@ -102,13 +119,13 @@ func (t *ConsoleHandler) USBRedirHandler(request *restful.Request, response *res
 
 		usbHandler := t.usbredir[uid]
 		// Find the first USB device slot available
-		for slotId = 0; slotId < v1.UsbClientPassthroughMaxNumberOf; slotId++ {
+		for slotId = 0; slotId < slots; slotId++ {
 			if _, inUse := usbHandler.stopChans[slotId]; !inUse {
 				break
 			}
 		}
 
-		if slotId == v1.UsbClientPassthroughMaxNumberOf {
+		if slotId == slots {
 			log.Log.Object(vmi).Reason(err).Errorf("All USB devices are in use.")
 			response.WriteError(http.StatusServiceUnavailable, err)
 			return false
@@ -135,7 +152,49 @@ func (t *ConsoleHandler) USBRedirHandler(request *restful.Request, response *res
 		usbHandler := t.usbredir[uid]
 		delete(usbHandler.stopChans, slotId)
 	}()
-	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChan)
+	dial := unixSocketDialer(vmi, unixSocketPath)
+	if filter := vmi.Spec.Domain.Devices.ClientPassthrough.Filter; filter != nil {
+		dial = filteredDialer(dial, filter)
+	}
+	t.stream(vmi, request, response, dial, stopChan)
+}
+
+func filteredDialer(dial func() (net.Conn, error), filter *v1.USBRedirFilter) func() (net.Conn, error) {
+	return func() (net.Conn, error) {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		return usbredir.NewFilteredConn(conn, filter), nil
+	}
+}
+
+func (t *ConsoleHandler) SmartcardHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil || vmi == nil {
+		log.Log.Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+
+	if !smartcard.HasPassthrough(&vmi.Spec) {
+		err := errors.New("VMI does not have smartcard passthrough enabled")
+		log.Log.Object(vmi).Reason(err).Error("Failed to pass through smartcard")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	unixSocketPath, err := t.getUnixSocketPath(vmi, smartcard.SocketName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for smartcard")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	// The guest has a single card reader, a new client takes it over from the previous one
+	uid := vmi.GetUID()
+	stopChn := newStopChan(uid, t.smartcardLock, t.smartcardStopChans)
+	defer deleteStopChan(uid, stopChn, t.smartcardLock, t.smartcardStopChans)
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
 func (t *ConsoleHandler) vncInUse(uid types.UID) bool {
@@ -184,15 +243,21 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		response.WriteError(code, err)
 		return
 	}
-	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-serial0")
+	port, err := serialConsolePort(vmi, request.QueryParameter("serial"))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding serial port")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, fmt.Sprintf("virt-serial%d", port))
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for serial console")
 		response.WriteError(http.StatusBadRequest, err)
 		return
 	}
-	uid := vmi.GetUID()
-	stopCh := newStopChan(uid, t.serialLock, t.serialStopChans)
-	defer deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
+	key := serialConsoleKey{uid: vmi.GetUID(), port: port}
+	stopCh := newStopChan(key, t.serialLock, t.serialStopChans)
+	defer deleteStopChan(key, stopCh, t.serialLock, t.serialStopChans)
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
 }
 
@@ -264,27 +329,48 @@ func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restfu
 	}, make(chan struct{})) // It is legitimate and up to the guest-application to accept multiple connections.
 }
 
-func newStopChan(uid types.UID, lock *sync.Mutex, stopChans map[types.UID]chan struct{}) chan struct{} {
+func newStopChan[K comparable](key K, lock *sync.Mutex, stopChans map[K]chan struct{}) chan struct{} {
 	lock.Lock()
 	defer lock.Unlock()
 	// close current connection, if exists
-	if c, ok := stopChans[uid]; ok {
-		delete(stopChans, uid)
+	if c, ok := stopChans[key]; ok {
+		delete(stopChans, key)
 		close(c)
 	}
 	// create a stop channel for the new connection
 	stopCh := make(chan struct{})
-	stopChans[uid] = stopCh
+	stopChans[key] = stopCh
 	return stopCh
 }
 
-func deleteStopChan(uid types.UID, stopChn chan struct{}, lock *sync.Mutex, stopChans map[types.UID]chan struct{}) {
+func deleteStopChan[K comparable](key K, stopChn chan struct{}, lock *sync.Mutex, stopChans map[K]chan struct{}) {
 	lock.Lock()
 	defer lock.Unlock()
 	// delete the stop channel from the cache if needed
-	if c, ok := stopChans[uid]; ok && c == stopChn {
-		delete(stopChans, uid)
+	if c, ok := stopChans[key]; ok && c == stopChn {
+		delete(stopChans, key)
+	}
+}
+
+// serialConsoleKey identifies the connection to a serial port, each port
+// allows one connection at a time.
+type serialConsoleKey struct {
+	uid  types.UID
+	port uint32
+}
+
+// serialConsolePort returns the port of the named serial port, or the port of
+// the serial console if no name is given.
+func serialConsolePort(vmi *v1.VirtualMachineInstance, name string) (uint32, error) {
+	if name == "" {
+		return 0, nil
+	}
+	for _, serialPort := range vmi.Spec.Domain.Devices.Serials {
+		if serialPort.Name == name {
+			return serialPort.Port, nil
+		}
 	}
+	return 0, fmt.Errorf("serial port %s is not present", name)
 }
 
 func (t *ConsoleHandler) getUnixSocketPath(vmi *v1.VirtualMachineInstance, socketName string) (string, error) {
@@ -35,6 +35,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/tpm"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 )
 
@@ -66,14 +67,37 @@ func (lh *LifecycleHandler) PauseHandler(request *restful.Request, response *res
 	}
 	defer client.Close()
 
-	err = client.PauseVirtualMachine(vmi)
+	pauseOptions := &v1.PauseOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(pauseOptions)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal pause options in pause request")
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal pause options"))
+			return
+		}
+	}
+
+	var autoUnpauseTimeoutSeconds int32
+	if pauseOptions.AutoUnpauseTimeout != nil {
+		autoUnpauseTimeoutSeconds = int32(pauseOptions.AutoUnpauseTimeout.Seconds())
+	}
+
+	err = client.PauseVirtualMachine(vmi, autoUnpauseTimeoutSeconds)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed to pause VMI")
 		response.WriteError(http.StatusInternalServerError, err)
 		return
 	}
 
-	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Paused", "VirtualMachineInstance paused")
+	if autoUnpauseTimeoutSeconds > 0 {
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Paused", "VirtualMachineInstance paused, automatic unpause in %v", pauseOptions.AutoUnpauseTimeout.Duration)
+	} else {
+		lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Paused", "VirtualMachineInstance paused")
+	}
 	response.WriteHeader(http.StatusAccepted)
 }
 
@@ -191,6 +215,38 @@ func (lh *LifecycleHandler) SoftRebootHandler(request *restful.Request, response
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) RebootHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	rebootOptions := &v1.RebootOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(rebootOptions)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal reboot options")
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal reboot options"))
+			return
+		}
+	}
+
+	err = client.RebootVirtualMachine(vmi, rebootOptions.Method)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to reboot VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	lh.recorder.Eventf(vmi, k8sv1.EventTypeNormal, "Rebooted", "VirtualMachineInstance rebooted")
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) GetGuestInfo(request *restful.Request, response *restful.Response) {
 	log.Log.Info("Retreiving guestinfo")
 	vmi, client, err := lh.getVMILauncherClient(request, response)
@@ -347,6 +403,87 @@ func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Reques
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) VNCResolutionHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: resolution is required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve resolution from request"))
+		return
+	}
+
+	opts := &v1.VNCResolutionOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode resolution")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Requesting display resolution %dx%d", opts.Width, opts.Height)
+
+	if err := client.SetVNCResolution(vmi, opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to change display resolution")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) SEVSNPAttestationReportHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	log.Log.Object(vmi).Infof("Retrieving SEV-SNP attestation report")
+
+	opts := &v1.SEVSNPAttestationReportOptions{Nonce: request.QueryParameter("nonce")}
+	report, err := client.GetSEVSNPAttestationReport(vmi, opts)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get SEV-SNP attestation report")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(report)
+}
+
+func (lh *LifecycleHandler) TPMAttestationHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	opts, err := tpm.ParseAttestationOptions(request.QueryParameter(tpm.NonceParam), request.QueryParameter(tpm.PCRsParam), request.QueryParameter(tpm.CredentialParam))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to parse TPM attestation parameters")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Retrieving TPM attestation")
+
+	attestation, err := client.GetTPMAttestation(vmi, opts)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get TPM attestation")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(attestation)
+}
+
 func (lh *LifecycleHandler) BackupHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -44,6 +44,10 @@ type FailRetryManager struct {
 	// after a retry. If no failure signal is received after maxFailResponseTime
 	// the retry is considered to have succeeded.
 	maxFailResponseTime time.Duration
+	// maxRetries is the max number of retries after which the failure is
+	// considered permanent and no further retries are allowed. Zero means
+	// unlimited retries.
+	maxRetries int
 
 	stateLock   sync.Mutex
 	retryStates map[string]*retryState
@@ -55,6 +59,18 @@ type retryState struct {
 	nextRun       time.Time
 	waitInterval  time.Duration
 	lastRunFailed bool
+	retries       int
+	exhausted     bool
+}
+
+// RetryStatus is a snapshot of the retry state of a failure.
+type RetryStatus struct {
+	// Retries is the number of retries performed so far.
+	Retries int
+	// NextRun is the earliest time of the next retry.
+	NextRun time.Time
+	// Exhausted is true when no further retries will be allowed.
+	Exhausted bool
 }
 
 // NewFailRetryManager creates a new FailRetryManager with the parameters explained above.
@@ -68,6 +84,14 @@ func NewFailRetryManager(name string, initialWait, maxWait, maxFailResponseTime
 	}
 }
 
+// NewFailRetryManagerWithMaxRetries creates a new FailRetryManager which stops
+// retrying a failure after maxRetries retries.
+func NewFailRetryManagerWithMaxRetries(name string, initialWait, maxWait, maxFailResponseTime time.Duration, maxRetries int) *FailRetryManager {
+	f := NewFailRetryManager(name, initialWait, maxWait, maxFailResponseTime)
+	f.maxRetries = maxRetries
+	return f
+}
+
 func (f *FailRetryManager) newState(now time.Time) *retryState {
 	return &retryState{
 		firstFail:     now,
@@ -81,17 +105,27 @@ func (f *FailRetryManager) newState(now time.Time) *retryState {
 // ShouldDelay returns whether the retry on this failure should be delayed or not,
 // and if true return the duration of the delay as well.
 func (f *FailRetryManager) ShouldDelay(key string, isFailure func() bool) (bool, time.Duration) {
+	f.stateLock.Lock()
+	defer f.stateLock.Unlock()
+
+	state := f.retryStates[key]
+
 	if !isFailure() {
 		log.Log.V(4).Infof("%s: Not a failure", key)
+		// Once retries are exhausted, only an external fix can clear the failure,
+		// so start over the next time it occurs.
+		if state != nil && state.exhausted {
+			delete(f.retryStates, key)
+		}
 		return false, 0
 	}
 
 	now := time.Now()
 
-	f.stateLock.Lock()
-	defer f.stateLock.Unlock()
-
-	state := f.retryStates[key]
+	if state != nil && state.exhausted {
+		log.Log.V(4).Infof("%s: Retries exhausted, delaying for %f.", key, f.maxWait.Seconds())
+		return true, f.maxWait
+	}
 
 	// When first failure occurs we set when should be the next run(`nextRun`) but do not delay
 	// letting the VMI try to start again.
@@ -130,7 +164,14 @@ func (f *FailRetryManager) ShouldDelay(key string, isFailure func() bool) (bool,
 		return true, state.nextRun.Sub(now)
 	}
 
+	if f.maxRetries > 0 && state.retries >= f.maxRetries {
+		state.exhausted = true
+		log.Log.V(4).Infof("%s: Giving up after %d retries.", key, state.retries)
+		return true, f.maxWait
+	}
+
 	// Backoff ended. Increase it and do not delay the processing.
+	state.retries++
 	state.waitInterval = state.waitInterval * 2
 	if state.waitInterval > f.maxWait {
 		state.waitInterval = f.maxWait
@@ -142,6 +183,29 @@ func (f *FailRetryManager) ShouldDelay(key string, isFailure func() bool) (bool,
 	return false, 0
 }
 
+// Status returns the retry status of the given key, if a failure is being tracked for it.
+func (f *FailRetryManager) Status(key string) (RetryStatus, bool) {
+	f.stateLock.Lock()
+	defer f.stateLock.Unlock()
+
+	state, exists := f.retryStates[key]
+	if !exists {
+		return RetryStatus{}, false
+	}
+	return RetryStatus{
+		Retries:   state.retries,
+		NextRun:   state.nextRun,
+		Exhausted: state.exhausted,
+	}, true
+}
+
+// Forget drops any retry state kept for the given key.
+func (f *FailRetryManager) Forget(key string) {
+	f.stateLock.Lock()
+	defer f.stateLock.Unlock()
+	delete(f.retryStates, key)
+}
+
 // Run starts the manager.
 func (f *FailRetryManager) Run(stopCh chan struct{}) {
 	ticker := time.NewTicker(f.maxWait)
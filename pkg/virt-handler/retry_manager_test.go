@@ -80,6 +80,44 @@ var _ = Describe("virt-handler retry manager", func() {
 		lastEventFirstFail := retryManager.retryStates[key].firstFail
 		Expect(lastEventFirstFail).ToNot(BeEquivalentTo(firstEventFirstFail))
 	})
+
+	Context("with max retries", func() {
+		BeforeEach(func() {
+			retryManager = NewFailRetryManagerWithMaxRetries("test", initialWait, maxWait, maxFailResponseTime, 1)
+		})
+
+		It("Should stop retrying once the max retries are reached", func() {
+			retryManager.ShouldDelay(key, getBoolFunc(true))
+			retryManager.ShouldDelay(key, getBoolFunc(true))
+
+			time.Sleep(initialWait + buffer)
+			shouldDelay, _ := retryManager.ShouldDelay(key, getBoolFunc(true))
+			Expect(shouldDelay).To(BeFalse())
+			status, exists := retryManager.Status(key)
+			Expect(exists).To(BeTrue())
+			Expect(status.Retries).To(Equal(1))
+			Expect(status.Exhausted).To(BeFalse())
+
+			// The retry failed again
+			retryManager.ShouldDelay(key, getBoolFunc(true))
+			time.Sleep(initialWait*2 + buffer)
+			shouldDelay, delay := retryManager.ShouldDelay(key, getBoolFunc(true))
+			Expect(shouldDelay).To(BeTrue())
+			Expect(delay).To(Equal(maxWait))
+			status, _ = retryManager.Status(key)
+			Expect(status.Exhausted).To(BeTrue())
+		})
+
+		It("Should start over when an exhausted failure is fixed", func() {
+			retryManager.ShouldDelay(key, getBoolFunc(true))
+			retryManager.retryStates[key].exhausted = true
+
+			shouldDelay, _ := retryManager.ShouldDelay(key, getBoolFunc(false))
+			Expect(shouldDelay).To(BeFalse())
+			_, exists := retryManager.Status(key)
+			Expect(exists).To(BeFalse())
+		})
+	})
 })
 
 func getBoolFunc(b bool) func() bool {
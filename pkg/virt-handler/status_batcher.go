@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// statusBatcher remembers when the status of a VMI was last written, so that
+// changes of informational status fields can be merged and written at most
+// once per batch interval.
+type statusBatcher struct {
+	clock clock.Clock
+
+	lock      sync.Mutex
+	lastWrite map[string]time.Time
+}
+
+func newStatusBatcher(clock clock.Clock) *statusBatcher {
+	return &statusBatcher{
+		clock:     clock,
+		lastWrite: make(map[string]time.Time),
+	}
+}
+
+// Delay returns how long the write of an informational status change has to
+// be postponed. Zero means the change can be written right away.
+func (b *statusBatcher) Delay(key string, interval, maxJitter time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	last, exists := b.lastWrite[key]
+	if !exists {
+		return 0
+	}
+	remaining := last.Add(interval).Sub(b.clock.Now())
+	if remaining <= 0 {
+		return 0
+	}
+	if maxJitter > 0 {
+		remaining += time.Duration(rand.Int63n(int64(maxJitter) + 1))
+	}
+	return remaining
+}
+
+func (b *statusBatcher) Written(key string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.lastWrite[key] = b.clock.Now()
+}
+
+func (b *statusBatcher) Forget(key string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.lastWrite, key)
+}
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("virt-handler status batcher", func() {
+	const key = "1234"
+
+	var fakeClock *testingclock.FakeClock
+	var batcher *statusBatcher
+
+	BeforeEach(func() {
+		fakeClock = testingclock.NewFakeClock(time.Now())
+		batcher = newStatusBatcher(fakeClock)
+	})
+
+	It("should not delay the first write", func() {
+		Expect(batcher.Delay(key, time.Minute, 0)).To(BeZero())
+	})
+
+	It("should not delay when batching is disabled", func() {
+		batcher.Written(key)
+		Expect(batcher.Delay(key, 0, time.Minute)).To(BeZero())
+	})
+
+	It("should delay writes until the interval elapsed", func() {
+		batcher.Written(key)
+		fakeClock.Step(20 * time.Second)
+		Expect(batcher.Delay(key, time.Minute, 0)).To(Equal(40 * time.Second))
+		fakeClock.Step(40 * time.Second)
+		Expect(batcher.Delay(key, time.Minute, 0)).To(BeZero())
+	})
+
+	It("should add up to the maximum jitter to the delay", func() {
+		batcher.Written(key)
+		for range 10 {
+			Expect(batcher.Delay(key, time.Minute, 5*time.Second)).To(
+				And(BeNumerically(">=", time.Minute), BeNumerically("<=", time.Minute+5*time.Second)))
+		}
+	})
+
+	It("should forget the last write", func() {
+		batcher.Written(key)
+		batcher.Forget(key)
+		Expect(batcher.Delay(key, time.Minute, 0)).To(BeZero())
+	})
+})
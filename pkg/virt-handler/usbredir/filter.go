@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbredir
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Packet types and capabilities of the usbredir protocol, see
+// https://gitlab.freedesktop.org/spice/usbredir/-/blob/main/docs/usb-redirection-protocol.md
+const (
+	packetTypeHello         = 0
+	packetTypeDeviceConnect = 1
+	packetTypeInterfaceInfo = 4
+
+	cap64BitsIDs = 5
+
+	headerSize32BitsIDs = 12
+	headerSize64BitsIDs = 16
+	helloVersionSize    = 64
+	maxInterfaces       = 32
+
+	// the inspected packets are small, anything bigger is malformed
+	maxInspectedPacketSize = 4096
+)
+
+// Class codes assigned by the USB-IF
+var classCodes = map[v1.USBDeviceClass]uint8{
+	v1.USBDeviceClassAudio:          0x01,
+	v1.USBDeviceClassCommunications: 0x02,
+	v1.USBDeviceClassHID:            0x03,
+	v1.USBDeviceClassImage:          0x06,
+	v1.USBDeviceClassPrinter:        0x07,
+	v1.USBDeviceClassMassStorage:    0x08,
+	v1.USBDeviceClassHub:            0x09,
+	v1.USBDeviceClassSmartCard:      0x0b,
+	v1.USBDeviceClassVideo:          0x0e,
+	v1.USBDeviceClassWireless:       0xe0,
+	v1.USBDeviceClassVendorSpecific: 0xff,
+}
+
+// IsKnownClass returns whether the given device class can be used in a filter
+func IsKnownClass(class v1.USBDeviceClass) bool {
+	_, exists := classCodes[class]
+	return exists
+}
+
+type classFilter struct {
+	allow map[uint8]struct{}
+	deny  map[uint8]struct{}
+}
+
+func newClassFilter(filter *v1.USBRedirFilter) *classFilter {
+	f := &classFilter{
+		allow: map[uint8]struct{}{},
+		deny:  map[uint8]struct{}{},
+	}
+	for _, class := range filter.Allow {
+		if code, exists := classCodes[class]; exists {
+			f.allow[code] = struct{}{}
+		}
+	}
+	for _, class := range filter.Deny {
+		if code, exists := classCodes[class]; exists {
+			f.deny[code] = struct{}{}
+		}
+	}
+	return f
+}
+
+func (f *classFilter) check(class uint8) error {
+	if _, denied := f.deny[class]; denied {
+		return fmt.Errorf("redirection of USB devices of class 0x%02x is denied", class)
+	}
+	if _, allowed := f.allow[class]; len(f.allow) > 0 && !allowed {
+		return fmt.Errorf("redirection of USB devices of class 0x%02x is not allowed", class)
+	}
+	return nil
+}
+
+// filteredConn inspects the usbredir packets exchanged between the client,
+// which acts as the usbredir host, and QEMU, which acts as the usbredir guest.
+// The packets describing the device of the client are checked against the
+// filter before being forwarded to QEMU. The filter trusts the descriptors
+// reported by the client, a client which lies about the classes of its device
+// is not stopped.
+type filteredConn struct {
+	net.Conn
+	filter *classFilter
+
+	lock      sync.Mutex
+	guestCaps []uint32
+	guestBuf  []byte
+
+	hostCaps []uint32
+	hostBuf  []byte
+	// number of payload bytes of the current packet which are forwarded without inspection
+	hostSkip int
+	// the interfaces of the next connected device passed the filter
+	interfacesChecked bool
+}
+
+// NewFilteredConn wraps the connection to the usbredir socket of QEMU, so that
+// devices which are not permitted by the filter can not be redirected.
+func NewFilteredConn(conn net.Conn, filter *v1.USBRedirFilter) net.Conn {
+	return &filteredConn{
+		Conn:   conn,
+		filter: newClassFilter(filter),
+	}
+}
+
+// Read only waits for the hello of QEMU, the negotiated capabilities define
+// the header size of the following packets
+func (c *filteredConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.guestCaps != nil || n == 0 {
+		return n, err
+	}
+	c.guestBuf = append(c.guestBuf, p[:n]...)
+	packetType, length, ok := parseHeader(c.guestBuf, headerSize32BitsIDs)
+	if !ok {
+		return n, err
+	}
+	if packetType != packetTypeHello || length > maxInspectedPacketSize {
+		return 0, fmt.Errorf("expected a usbredir hello packet from the guest")
+	}
+	if len(c.guestBuf) >= headerSize32BitsIDs+length {
+		c.guestCaps = parseHelloCaps(c.guestBuf[headerSize32BitsIDs : headerSize32BitsIDs+length])
+		c.guestBuf = nil
+	}
+	return n, err
+}
+
+// Write forwards complete packets to QEMU once they passed the inspection
+func (c *filteredConn) Write(p []byte) (int, error) {
+	c.hostBuf = append(c.hostBuf, p...)
+	for len(c.hostBuf) > 0 {
+		if c.hostSkip > 0 {
+			n := min(c.hostSkip, len(c.hostBuf))
+			if err := c.forward(n); err != nil {
+				return 0, err
+			}
+			c.hostSkip -= n
+			continue
+		}
+
+		headerSize, err := c.hostHeaderSize()
+		if err != nil {
+			return 0, err
+		}
+		packetType, length, ok := parseHeader(c.hostBuf, headerSize)
+		if !ok {
+			break
+		}
+		switch packetType {
+		case packetTypeHello, packetTypeDeviceConnect, packetTypeInterfaceInfo:
+			if length > maxInspectedPacketSize {
+				return 0, fmt.Errorf("usbredir packet of type %d exceeds %d bytes", packetType, maxInspectedPacketSize)
+			}
+			if len(c.hostBuf) < headerSize+length {
+				return len(p), nil
+			}
+			if err := c.inspect(packetType, c.hostBuf[headerSize:headerSize+length]); err != nil {
+				return 0, err
+			}
+			if err := c.forward(headerSize + length); err != nil {
+				return 0, err
+			}
+		default:
+			if err := c.forward(headerSize); err != nil {
+				return 0, err
+			}
+			c.hostSkip = length
+		}
+	}
+	return len(p), nil
+}
+
+func (c *filteredConn) forward(n int) error {
+	if _, err := c.Conn.Write(c.hostBuf[:n]); err != nil {
+		return err
+	}
+	c.hostBuf = c.hostBuf[n:]
+	return nil
+}
+
+func (c *filteredConn) hostHeaderSize() (int, error) {
+	if c.hostCaps == nil {
+		return headerSize32BitsIDs, nil
+	}
+	c.lock.Lock()
+	guestCaps := c.guestCaps
+	c.lock.Unlock()
+	if guestCaps == nil {
+		return 0, fmt.Errorf("usbredir packet received before the hello of the guest")
+	}
+	if hasCap(c.hostCaps, cap64BitsIDs) && hasCap(guestCaps, cap64BitsIDs) {
+		return headerSize64BitsIDs, nil
+	}
+	return headerSize32BitsIDs, nil
+}
+
+func (c *filteredConn) inspect(packetType uint32, payload []byte) error {
+	switch packetType {
+	case packetTypeHello:
+		if c.hostCaps == nil {
+			c.hostCaps = parseHelloCaps(payload)
+		}
+	case packetTypeDeviceConnect:
+		if len(payload) < 2 {
+			return fmt.Errorf("malformed usbredir device connect packet")
+		}
+		interfacesChecked := c.interfacesChecked
+		c.interfacesChecked = false
+		// Composite devices define their classes per interface
+		if deviceClass := payload[1]; deviceClass != 0x00 && deviceClass != 0xef {
+			return c.filter.check(deviceClass)
+		}
+		if !interfacesChecked {
+			return fmt.Errorf("usbredir device connect packet without the interfaces of the device")
+		}
+	case packetTypeInterfaceInfo:
+		c.interfacesChecked = false
+		if len(payload) < 4+2*maxInterfaces {
+			return fmt.Errorf("malformed usbredir interface info packet")
+		}
+		count := binary.LittleEndian.Uint32(payload)
+		if count == 0 || count > maxInterfaces {
+			return fmt.Errorf("usbredir interface info packet with %d interfaces", count)
+		}
+		classes := payload[4+maxInterfaces : 4+maxInterfaces+int(count)]
+		for _, class := range classes {
+			if err := c.filter.check(class); err != nil {
+				return err
+			}
+		}
+		c.interfacesChecked = true
+	}
+	return nil
+}
+
+func parseHeader(buf []byte, headerSize int) (packetType uint32, length int, ok bool) {
+	if len(buf) < headerSize {
+		return 0, 0, false
+	}
+	return binary.LittleEndian.Uint32(buf), int(binary.LittleEndian.Uint32(buf[4:])), true
+}
+
+func parseHelloCaps(payload []byte) []uint32 {
+	caps := []uint32{}
+	for i := helloVersionSize; i+4 <= len(payload); i += 4 {
+		caps = append(caps, binary.LittleEndian.Uint32(payload[i:]))
+	}
+	return caps
+}
+
+func hasCap(caps []uint32, capability int) bool {
+	word := capability / 32
+	return word < len(caps) && caps[word]&(1<<(capability%32)) != 0
+}
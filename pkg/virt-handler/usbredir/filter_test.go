@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package usbredir_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-handler/usbredir"
+)
+
+type fakeConn struct {
+	net.Conn
+	guest   *bytes.Reader
+	written bytes.Buffer
+}
+
+func (c *fakeConn) Read(p []byte) (int, error) {
+	return c.guest.Read(p)
+}
+
+func (c *fakeConn) Write(p []byte) (int, error) {
+	return c.written.Write(p)
+}
+
+const (
+	cap64BitsIDs = 1 << 5
+	smartCard    = 0x0b
+	massStorage  = 0x08
+)
+
+func packet(packetType uint32, ids64Bits bool, payload []byte) []byte {
+	buf := binary.LittleEndian.AppendUint32(nil, packetType)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(payload)))
+	if ids64Bits {
+		buf = binary.LittleEndian.AppendUint64(buf, 1)
+	} else {
+		buf = binary.LittleEndian.AppendUint32(buf, 1)
+	}
+	return append(buf, payload...)
+}
+
+func hello(caps uint32) []byte {
+	return packet(0, false, binary.LittleEndian.AppendUint32(make([]byte, 64), caps))
+}
+
+func deviceConnect(ids64Bits bool, class uint8) []byte {
+	return packet(1, ids64Bits, []byte{2, class, 0, 0, 0x51, 0x09, 0x66, 0x16, 0, 1})
+}
+
+func interfaceInfo(ids64Bits bool, classes ...uint8) []byte {
+	payload := binary.LittleEndian.AppendUint32(nil, uint32(len(classes)))
+	interfaces := make([]byte, 4*32)
+	copy(interfaces[32:], classes)
+	return packet(4, ids64Bits, append(payload, interfaces...))
+}
+
+var _ = Describe("USB redirection filter", func() {
+	var (
+		conn     *fakeConn
+		filtered net.Conn
+	)
+
+	connect := func(filter *v1.USBRedirFilter, guestCaps uint32) {
+		conn = &fakeConn{guest: bytes.NewReader(hello(guestCaps))}
+		filtered = usbredir.NewFilteredConn(conn, filter)
+		buf := make([]byte, 1024)
+		n, err := filtered.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf[:n]).To(Equal(hello(guestCaps)))
+	}
+
+	write := func(packets ...[]byte) error {
+		for _, p := range packets {
+			if _, err := filtered.Write(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	DescribeTable("should check the device class", func(filter *v1.USBRedirFilter, class uint8, allowed bool) {
+		connect(filter, cap64BitsIDs)
+		packets := [][]byte{hello(cap64BitsIDs), interfaceInfo(true, class), deviceConnect(true, class)}
+		err := write(packets...)
+		if allowed {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conn.written.Bytes()).To(Equal(bytes.Join(packets, nil)))
+		} else {
+			Expect(err).To(HaveOccurred())
+			Expect(conn.written.Bytes()).To(Equal(packets[0]))
+		}
+	},
+		Entry("and allow a class which is not denied",
+			&v1.USBRedirFilter{Deny: []v1.USBDeviceClass{v1.USBDeviceClassMassStorage}}, uint8(smartCard), true),
+		Entry("and reject a denied class",
+			&v1.USBRedirFilter{Deny: []v1.USBDeviceClass{v1.USBDeviceClassMassStorage}}, uint8(massStorage), false),
+		Entry("and allow an allowed class",
+			&v1.USBRedirFilter{Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard}}, uint8(smartCard), true),
+		Entry("and reject a class which is not allowed",
+			&v1.USBRedirFilter{Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard}}, uint8(massStorage), false),
+		Entry("and let deny take precedence over allow",
+			&v1.USBRedirFilter{
+				Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard},
+				Deny:  []v1.USBDeviceClass{v1.USBDeviceClassSmartCard},
+			}, uint8(smartCard), false),
+	)
+
+	It("should check the interface classes of composite devices", func() {
+		connect(&v1.USBRedirFilter{Deny: []v1.USBDeviceClass{v1.USBDeviceClassMassStorage}}, cap64BitsIDs)
+		Expect(write(hello(cap64BitsIDs))).To(Succeed())
+		Expect(write(interfaceInfo(true, smartCard, massStorage))).ToNot(Succeed())
+		Expect(conn.written.Bytes()).To(Equal(hello(cap64BitsIDs)))
+	})
+
+	It("should not check the device class of composite devices", func() {
+		connect(&v1.USBRedirFilter{Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard}}, cap64BitsIDs)
+		Expect(write(hello(cap64BitsIDs), interfaceInfo(true, smartCard), deviceConnect(true, 0xef))).To(Succeed())
+	})
+
+	It("should reject composite devices without checked interfaces", func() {
+		connect(&v1.USBRedirFilter{Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard}}, cap64BitsIDs)
+		Expect(write(hello(cap64BitsIDs), interfaceInfo(true, smartCard), deviceConnect(true, smartCard))).To(Succeed())
+		Expect(write(deviceConnect(true, 0xef))).ToNot(Succeed())
+	})
+
+	It("should reject interface info without interfaces", func() {
+		connect(&v1.USBRedirFilter{Allow: []v1.USBDeviceClass{v1.USBDeviceClassSmartCard}}, cap64BitsIDs)
+		Expect(write(hello(cap64BitsIDs))).To(Succeed())
+		Expect(write(interfaceInfo(true))).ToNot(Succeed())
+		Expect(conn.written.Bytes()).To(Equal(hello(cap64BitsIDs)))
+	})
+
+	It("should use 32 bit ids unless both sides support 64 bit ids", func() {
+		connect(&v1.USBRedirFilter{Deny: []v1.USBDeviceClass{v1.USBDeviceClassMassStorage}}, 0)
+		Expect(write(hello(cap64BitsIDs), interfaceInfo(false, smartCard))).To(Succeed())
+		Expect(write(deviceConnect(false, massStorage))).ToNot(Succeed())
+	})
+
+	It("should forward other packets and packets split over several writes", func() {
+		connect(&v1.USBRedirFilter{Deny: []v1.USBDeviceClass{v1.USBDeviceClassMassStorage}}, cap64BitsIDs)
+		bulk := packet(16, true, bytes.Repeat([]byte{massStorage}, 100))
+		connectPacket := deviceConnect(true, smartCard)
+		stream := bytes.Join([][]byte{hello(cap64BitsIDs), interfaceInfo(true, smartCard), bulk, connectPacket}, nil)
+		for i := 0; i < len(stream); i += 7 {
+			_, err := filtered.Write(stream[i:min(i+7, len(stream))])
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(conn.written.Bytes()).To(Equal(stream))
+	})
+
+	It("should reject packets of the client before the hello of the guest", func() {
+		conn = &fakeConn{guest: bytes.NewReader(nil)}
+		filtered = usbredir.NewFilteredConn(conn, &v1.USBRedirFilter{})
+		Expect(write(hello(cap64BitsIDs))).To(Succeed())
+		Expect(write(interfaceInfo(true, smartCard))).ToNot(Succeed())
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	"kubevirt.io/kubevirt/pkg/safepath"
@@ -113,6 +114,18 @@ func RemoveMDEVType(mdevUUID string) *exec.Cmd {
 	return exec.Command(binaryPath, args...)
 }
 
+func SetSRIOVNumVFs(pfAddress string, numVFs int) *exec.Cmd {
+	args := append(getBaseArgs(), "set-sriov-numvfs")
+	args = append(args, "--pf", pfAddress, "--numvfs", strconv.Itoa(numVFs))
+	return exec.Command(binaryPath, args...)
+}
+
+func BindVFIO(pciAddress string) *exec.Cmd {
+	args := append(getBaseArgs(), "bind-vfio")
+	args = append(args, "--pci-address", pciAddress)
+	return exec.Command(binaryPath, args...)
+}
+
 func trimProcPrefix(path *safepath.Path) string {
 	return strings.TrimPrefix(unsafepath.UnsafeAbsolute(path.Raw()), "/proc/1/root")
 }
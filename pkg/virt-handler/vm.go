@@ -41,22 +41,28 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/container-disk/signature"
 	"kubevirt.io/kubevirt/pkg/controller"
 	drautil "kubevirt.io/kubevirt/pkg/dra"
 	"kubevirt.io/kubevirt/pkg/executor"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
+	"kubevirt.io/kubevirt/pkg/network/deviceinfo"
 	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
@@ -83,6 +89,7 @@ import (
 	multipathmonitor "kubevirt.io/kubevirt/pkg/virt-handler/multipath-monitor"
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 )
 
 type netstat interface {
@@ -96,12 +103,19 @@ type downwardMetricsManager interface {
 	StopServer(vmi *v1.VirtualMachineInstance)
 }
 
+type hostSensorsManager interface {
+	Run(stopCh chan struct{})
+	StartServer(vmi *v1.VirtualMachineInstance, pid int) error
+	StopServer(vmi *v1.VirtualMachineInstance)
+}
+
 type VirtualMachineController struct {
 	*BaseController
 	capabilities             *libvirtxml.Caps
 	clientset                kubecli.KubevirtClient
 	containerDiskMounter     containerdisk.Mounter
 	downwardMetricsManager   downwardMetricsManager
+	hostSensorsManager       hostSensorsManager
 	hotplugVolumeMounter     hotplugvolume.VolumeMounter
 	hostCpuModel             string
 	ioErrorRetryManager      *FailRetryManager
@@ -113,9 +127,22 @@ type VirtualMachineController struct {
 	vmiExpectations          *controller.UIDTrackingControllerExpectations
 	vmiGlobalStore           cache.Store
 	multipathSocketMonitor   *multipathmonitor.MultipathSocketMonitor
+	// statusQueue is the low priority lane for domain updates which only
+	// refresh guest reported status, so that they do not hold back lifecycle
+	// changes queued on the main queue.
+	statusQueue workqueue.TypedRateLimitingInterface[string]
+	// keyLocks serializes the processing of a VMI across both lanes.
+	keyLocks *keyedMutex
+	// statusBatcher rate limits the writes of informational status changes.
+	statusBatcher *statusBatcher
 }
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string) (cgroup.Manager, error) {
+	// The runtime of isolated launchers manages the cgroups and devices inside
+	// their micro VM, there is no launcher cgroup on the host to manage.
+	if util.IsIsolatedLauncherVMI(vmi) {
+		return nil, nil
+	}
 	return cgroup.NewManagerFromVM(vmi, host)
 }
 
@@ -135,6 +162,7 @@ func NewVirtualMachineController(
 	podIsolationDetector isolation.PodIsolationDetector,
 	migrationProxy migrationproxy.ProxyManager,
 	downwardMetricsManager downwardMetricsManager,
+	hostSensorsManager hostSensorsManager,
 	capabilities *libvirtxml.Caps,
 	hostCpuModel string,
 	netConf netconf,
@@ -182,15 +210,22 @@ func NewVirtualMachineController(
 		clientset:                clientset,
 		containerDiskMounter:     containerdisk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
 		downwardMetricsManager:   downwardMetricsManager,
+		hostSensorsManager:       hostSensorsManager,
 		hotplugVolumeMounter:     hotplugvolume.NewVolumeMounter(hotplugState, kubeletPodsDir, host),
 		hostCpuModel:             hostCpuModel,
-		ioErrorRetryManager:      NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
+		ioErrorRetryManager:      NewFailRetryManagerWithMaxRetries("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second, maxIOErrorResumeAttempts),
 		heartBeatInterval:        1 * time.Minute,
 		netConf:                  netConf,
 		sriovHotplugExecutorPool: executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
 		vmiExpectations:          controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		vmiGlobalStore:           vmiGlobalStore,
 		multipathSocketMonitor:   multipathmonitor.NewMultipathSocketMonitor(),
+		statusQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-handler-vm-status"},
+		),
+		keyLocks:      newKeyedMutex(),
+		statusBatcher: newStatusBatcher(clock.RealClock{}),
 	}
 
 	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -229,19 +264,40 @@ func NewVirtualMachineController(
 		permissions,
 		deviceManager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
-		nodeStore)
+		nodeStore,
+		clientset.CoreV1().Nodes(),
+		c.domainPCIDevices)
 	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
 
 	return c, nil
 }
 
+// domainPCIDevices returns the PCI addresses of the host devices assigned to the domains of the node
+func (c *VirtualMachineController) domainPCIDevices() map[string]struct{} {
+	pciDevices := make(map[string]struct{})
+	for _, obj := range c.domainStore.List() {
+		domain := obj.(*api.Domain)
+		for _, hostDevice := range domain.Spec.Devices.HostDevices {
+			if hostDevice.Type != api.HostDevicePCI {
+				continue
+			}
+			if address := device.PciAddressString(hostDevice.Source.Address); address != "" {
+				pciDevices[address] = struct{}{}
+			}
+		}
+	}
+	return pciDevices
+}
+
 func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	defer c.queue.ShutDown()
+	defer c.statusQueue.ShutDown()
 	c.logger.Info("Starting virt-handler vms controller.")
 
 	go c.deviceManagerController.Run(stopCh)
 
 	go c.downwardMetricsManager.Run(stopCh)
+	go c.hostSensorsManager.Run(stopCh)
 
 	cache.WaitForCacheSync(stopCh, c.hasSynced)
 
@@ -271,6 +327,9 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
+	for i := 0; i < statusThreadiness(threadiness); i++ {
+		go wait.Until(c.runStatusWorker, time.Second, stopCh)
+	}
 
 	<-heartBeatDone
 	<-stopCh
@@ -278,23 +337,41 @@ func (c *VirtualMachineController) Run(threadiness int, stopCh chan struct{}) {
 	c.logger.Info("Stopping virt-handler vms controller.")
 }
 
+// statusThreadiness returns the number of workers of the status lane, a
+// fraction of the lifecycle workers.
+func statusThreadiness(threadiness int) int {
+	return max(1, threadiness/4)
+}
+
 func (c *VirtualMachineController) runWorker() {
 	for c.Execute() {
 	}
 }
 
+func (c *VirtualMachineController) runStatusWorker() {
+	for c.executeFromQueue(c.statusQueue) {
+	}
+}
+
 func (c *VirtualMachineController) Execute() bool {
-	key, quit := c.queue.Get()
+	return c.executeFromQueue(c.queue)
+}
+
+func (c *VirtualMachineController) executeFromQueue(queue workqueue.TypedRateLimitingInterface[string]) bool {
+	key, quit := queue.Get()
 	if quit {
 		return false
 	}
-	defer c.queue.Done(key)
+	defer queue.Done(key)
+
+	c.keyLocks.Lock(key)
+	defer c.keyLocks.Unlock(key)
 	if err := c.execute(key); err != nil {
 		c.logger.Reason(err).Infof("re-enqueuing VirtualMachineInstance %v", key)
-		c.queue.AddRateLimited(key)
+		queue.AddRateLimited(key)
 	} else {
 		c.logger.V(4).Infof("processed VirtualMachineInstance %v", key)
-		c.queue.Forget(key)
+		queue.Forget(key)
 	}
 	return true
 }
@@ -580,9 +657,10 @@ func (c *VirtualMachineController) updateChecksumInfo(vmi *v1.VirtualMachineInst
 			continue
 		}
 
-		vmi.Status.VolumeStatus[i].ContainerDiskVolume = &v1.ContainerDiskInfo{
-			Checksum: checksum,
+		if vmi.Status.VolumeStatus[i].ContainerDiskVolume == nil {
+			vmi.Status.VolumeStatus[i].ContainerDiskVolume = &v1.ContainerDiskInfo{}
 		}
+		vmi.Status.VolumeStatus[i].ContainerDiskVolume.Checksum = checksum
 	}
 
 	// kernelboot
@@ -677,6 +755,26 @@ func (c *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMach
 	vmi.Status.GuestOSInfo.ID = domain.Status.OSInfo.Id
 }
 
+// updateGuestFilesystemsFromDomain reports the utilization of the guest
+// filesystems. virt-launcher only passes on changes of at least one percent
+// of the capacity of a filesystem, to keep the VMI updates low.
+func updateGuestFilesystemsFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil {
+		return
+	}
+	var filesystems []v1.VirtualMachineInstanceGuestFilesystem
+	for _, fs := range domain.Status.Filesystems {
+		filesystems = append(filesystems, v1.VirtualMachineInstanceGuestFilesystem{
+			MountPoint:     fs.Mountpoint,
+			DiskName:       fs.Name,
+			FileSystemType: fs.Type,
+			UsedBytes:      int64(fs.UsedBytes),
+			TotalBytes:     int64(fs.TotalBytes),
+		})
+	}
+	vmi.Status.GuestFilesystems = filesystems
+}
+
 func (c *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	if domain == nil || domain.Spec.Metadata.KubeVirt.AccessCredential == nil {
@@ -789,6 +887,7 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 
 		var supported = false
 		var reason = ""
+		var outdatedReason = v1.VirtualMachineInstanceReasonGuestAgentCommandsMissing
 
 		// For current versions, virt-launcher's supported commands will always contain data.
 		// For backwards compatibility: during upgrade from a previous version of KubeVirt,
@@ -803,6 +902,7 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 			}
 			if !supported {
 				reason = fmt.Sprintf("Guest agent version '%s' is not supported", guestInfo.GAVersion)
+				outdatedReason = v1.VirtualMachineInstanceReasonGuestAgentVersionNotSupported
 			}
 		}
 
@@ -816,8 +916,10 @@ func (c *VirtualMachineController) updateGuestAgentConditions(vmi *v1.VirtualMac
 				}
 				vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
 			}
+			setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceGuestAgentOutdated, outdatedReason, reason)
 		} else {
 			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceUnsupportedAgent)
+			condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestAgentOutdated)
 		}
 
 	}
@@ -835,6 +937,54 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 		c.logger.Object(vmi).V(3).Info("Removing paused condition")
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstancePaused)
 	}
+	updatePauseStatus(vmi, domain, condManager)
+}
+
+// updatePauseStatus reflects the reason and the automatic unpause deadline
+// of a paused VMI, based on its paused condition and the domain metadata.
+func updatePauseStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	pausedCondition := condManager.GetCondition(vmi, v1.VirtualMachineInstancePaused)
+	if pausedCondition == nil || pausedCondition.Status != k8sv1.ConditionTrue {
+		vmi.Status.PauseStatus = nil
+		return
+	}
+
+	var reason v1.PauseReason
+	switch pausedCondition.Reason {
+	case pausedByUserReason:
+		reason = v1.PauseReasonUser
+	case pausedByMigrationMonitorReason:
+		reason = v1.PauseReasonMigration
+	case pausedIOErrorReason:
+		reason = v1.PauseReasonIOError
+	}
+
+	pauseTimestamp := pausedCondition.LastTransitionTime
+	pauseStatus := &v1.VirtualMachineInstancePauseStatus{
+		Reason:         reason,
+		PauseTimestamp: &pauseTimestamp,
+	}
+	if domain != nil && domain.Spec.Metadata.KubeVirt.Pause != nil {
+		pauseStatus.AutoUnpauseTimestamp = domain.Spec.Metadata.KubeVirt.Pause.AutoUnpauseTimestamp.DeepCopy()
+	}
+	vmi.Status.PauseStatus = pauseStatus
+}
+
+// updateIOErrorRecoveryCondition reports the progress of the automatic resume of a VMI paused on an IO error.
+func (c *VirtualMachineController) updateIOErrorRecoveryCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	retryStatus, exists := c.ioErrorRetryManager.Status(string(vmi.UID))
+	if !exists || !isIOError(true, domain != nil, domain) {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceIOErrorRecovery)
+		return
+	}
+
+	if retryStatus.Exhausted {
+		setCondition(vmi, condManager, v1.VirtualMachineInstanceIOErrorRecovery, k8sv1.ConditionFalse, ioErrorResumeExhaustedReason,
+			fmt.Sprintf("VMI could not be resumed after %d attempts, manual intervention is required", retryStatus.Retries))
+		return
+	}
+	setCondition(vmi, condManager, v1.VirtualMachineInstanceIOErrorRecovery, k8sv1.ConditionTrue, ioErrorResumeScheduledReason,
+		"VMI will be resumed once the storage recovers")
 }
 
 func dumpTargetFile(vmiName, volName string) string {
@@ -896,6 +1046,86 @@ func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func updateDeviceStatusesFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil {
+		return
+	}
+
+	domainDisks := make(map[string]*api.Disk)
+	for i, disk := range domain.Spec.Devices.Disks {
+		if disk.Alias != nil {
+			domainDisks[disk.Alias.GetName()] = &domain.Spec.Devices.Disks[i]
+		}
+	}
+	hotplugVolumes := make(map[string]struct{})
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil {
+			hotplugVolumes[volumeStatus.Name] = struct{}{}
+		}
+	}
+	ioErrors := make(map[string]api.DiskIOError)
+	for _, ioError := range domain.Status.DiskIOErrors {
+		ioErrors[ioError.Alias] = ioError
+	}
+
+	var deviceStatuses []v1.VirtualMachineInstanceDeviceStatus
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		domainDisk, attached := domainDisks[disk.Name]
+		_, hotplug := hotplugVolumes[disk.Name]
+		deviceStatus := v1.VirtualMachineInstanceDeviceStatus{
+			Name:           disk.Name,
+			Type:           v1.DeviceTypeDisk,
+			Attached:       attached,
+			HotplugPending: hotplug && !attached,
+		}
+		if attached {
+			deviceStatus.PCIAddress = device.PciAddressString(domainDisk.Address)
+		}
+		if ioError, exists := ioErrors[disk.Name]; exists {
+			deviceStatus.IOErrors = ioError.Count
+			deviceStatus.LastIOError = ioError.LastReason
+		}
+		deviceStatuses = append(deviceStatuses, deviceStatus)
+	}
+
+	domainInterfaces := make(map[string]*api.Interface)
+	for i, iface := range domain.Spec.Devices.Interfaces {
+		if iface.Alias != nil {
+			domainInterfaces[iface.Alias.GetName()] = &domain.Spec.Devices.Interfaces[i]
+		}
+	}
+	domainSRIOVInterfaces := make(map[string]*api.HostDevice)
+	for i, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias != nil && strings.HasPrefix(hostDevice.Alias.GetName(), deviceinfo.SRIOVAliasPrefix) {
+			domainSRIOVInterfaces[strings.TrimPrefix(hostDevice.Alias.GetName(), deviceinfo.SRIOVAliasPrefix)] = &domain.Spec.Devices.HostDevices[i]
+		}
+	}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		deviceStatus := v1.VirtualMachineInstanceDeviceStatus{
+			Name: iface.Name,
+			Type: v1.DeviceTypeInterface,
+		}
+		if domainIface, exists := domainInterfaces[iface.Name]; exists {
+			deviceStatus.Attached = true
+			deviceStatus.LinkState = v1.DeviceLinkStateUp
+			if domainIface.LinkState != nil && domainIface.LinkState.State == string(v1.DeviceLinkStateDown) {
+				deviceStatus.LinkState = v1.DeviceLinkStateDown
+			}
+			deviceStatus.PCIAddress = device.PciAddressString(domainIface.Address)
+		} else if hostDevice, exists := domainSRIOVInterfaces[iface.Name]; exists {
+			deviceStatus.Attached = true
+			deviceStatus.PCIAddress = device.PciAddressString(hostDevice.Address)
+		} else {
+			deviceStatus.HotplugPending = vmi.IsRunning()
+		}
+		deviceStatuses = append(deviceStatuses, deviceStatus)
+	}
+	vmi.Status.DeviceStatuses = deviceStatuses
+}
+
 func IsoGuestVolumePath(namespace, name string, volume *v1.Volume) string {
 	const basepath = "/var/run"
 	switch {
@@ -1021,8 +1251,10 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 		c.logger.Reason(err).Errorf("couldn't find the SELinux context for %s", vmi.Name)
 	}
 	c.updateGuestInfoFromDomain(vmi, domain)
+	updateGuestFilesystemsFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
+	updateDeviceStatusesFromDomain(vmi, domain)
 	c.updateBackupStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
@@ -1041,10 +1273,179 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateIOErrorRecoveryCondition(vmi, domain, condManager)
+	updateSoftwareEmulationCondition(vmi, domain, condManager)
+	updateDomainDriftCondition(vmi, domain, condManager)
+	updateDiskExpansionCondition(vmi, domain, condManager)
+	updateGuestWatchdogCondition(vmi, domain, condManager)
+	updateDiskSpaceLowCondition(vmi, condManager)
+	updateStorageDegradedCondition(vmi, domain, condManager)
+	updateNetworkDegradedCondition(vmi, condManager)
 
 	return nil
 }
 
+// updateDiskSpaceLowCondition reports the guest filesystems whose utilization
+// reached spec.diskSpaceLow. Once reported, the condition is only cleared after
+// the utilization of all the filesystems dropped below the threshold by the
+// hysteresis, so that it does not flap around the threshold.
+func updateDiskSpaceLowCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	threshold := vmi.Spec.DiskSpaceLow
+	if threshold == nil {
+		metrics.DeleteDiskSpaceLow(vmi.Namespace, vmi.Name)
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+		return
+	}
+	usedPercentage := int64(defaultDiskSpaceLowUsedPercentage)
+	if threshold.UsedPercentage != nil {
+		usedPercentage = int64(*threshold.UsedPercentage)
+	}
+	cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+	if cond != nil {
+		hysteresisPercentage := int64(defaultDiskSpaceLowHysteresisPercentage)
+		if threshold.HysteresisPercentage != nil {
+			hysteresisPercentage = int64(*threshold.HysteresisPercentage)
+		}
+		usedPercentage -= hysteresisPercentage
+	}
+
+	var mountPoints, usages []string
+	for _, fs := range vmi.Status.GuestFilesystems {
+		if fs.TotalBytes > 0 && fs.UsedBytes*100 >= fs.TotalBytes*usedPercentage {
+			mountPoints = append(mountPoints, fs.MountPoint)
+			usages = append(usages, fmt.Sprintf("%s (%d%%)", fs.MountPoint, fs.UsedBytes*100/fs.TotalBytes))
+		}
+	}
+	metrics.SetDiskSpaceLow(vmi.Namespace, vmi.Name, mountPoints)
+	if len(mountPoints) == 0 {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+		return
+	}
+
+	message := fmt.Sprintf("The guest filesystems are low on space: %s", strings.Join(usages, ", "))
+	now := metav1.Now()
+	transitionTime := now
+	if cond != nil {
+		if cond.Message == message {
+			return
+		}
+		transitionTime = cond.LastTransitionTime
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+	}
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceDiskSpaceLow,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      now,
+		LastTransitionTime: transitionTime,
+		Reason:             v1.VirtualMachineInstanceReasonGuestFilesystemFull,
+		Message:            message,
+	})
+}
+
+// updateGuestWatchdogCondition surfaces the expirations of the guest watchdog.
+// The last reported expiry is kept as the probe time of the condition.
+func updateGuestWatchdogCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if !isGuestWatchdogExpiryNew(vmi, domain) {
+		return
+	}
+	expiry := domain.Status.WatchdogExpiry
+
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogExpired)
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGuestWatchdogExpired,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      expiry.LastExpiry,
+		LastTransitionTime: metav1.Now(),
+		Reason:             v1.VirtualMachineInstanceReasonGuestWatchdogExpired,
+		Message:            fmt.Sprintf("The guest watchdog expired %d time(s), the last expiry triggered the %s action", expiry.Count, expiry.LastAction),
+	})
+}
+
+// isGuestWatchdogExpiryNew returns true if the domain reports a watchdog expiry
+// which is not yet recorded in the GuestWatchdogExpired condition of the VMI.
+func isGuestWatchdogExpiryNew(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if domain == nil || domain.Status.WatchdogExpiry == nil {
+		return false
+	}
+	cond := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogExpired)
+	return cond == nil || cond.LastProbeTime.Before(&domain.Status.WatchdogExpiry.LastExpiry)
+}
+
+// remediateGuestWatchdogExpiry applies the remediations which are handled on
+// the node, once per expiry. It runs before the expiry is recorded in the VMI
+// status. The RestartVM and MemoryDump remediations are requested by
+// virt-controller on the owning VirtualMachine.
+func (c *VirtualMachineController) remediateGuestWatchdogExpiry(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if !isGuestWatchdogExpiryNew(vmi, domain) {
+		return
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonGuestWatchdogExpired,
+		"The guest watchdog expired and triggered the %s action", domain.Status.WatchdogExpiry.LastAction)
+
+	watchdog := vmi.Spec.Domain.Devices.Watchdog
+	if watchdog == nil || watchdog.Remediation == nil || watchdog.Remediation.Type != v1.WatchdogRemediationReset {
+		return
+	}
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err == nil {
+		err = client.ResetVirtualMachine(vmi)
+	}
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Error("Failed to apply the Reset watchdog remediation")
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonGuestWatchdogExpired,
+			"Failed to apply the Reset watchdog remediation: %v", err)
+		return
+	}
+	c.logger.Object(vmi).Info("Applied the Reset watchdog remediation")
+}
+
+// updateDomainDriftCondition surfaces the properties of the live domain which
+// were changed outside of KubeVirt, as detected by virt-launcher.
+func updateDomainDriftCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Drift == nil {
+		return
+	}
+	fields := domain.Spec.Metadata.KubeVirt.Drift.Fields
+	metrics.SetDomainDrift(vmi.Namespace, vmi.Name, fields != "")
+	if fields == "" {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDomainDrift)
+		return
+	}
+	setDegradedCondition(vmi, condManager, v1.VirtualMachineInstanceDomainDrift, v1.VirtualMachineInstanceReasonDomainDrifted,
+		fmt.Sprintf("The live domain deviates from the expected domain: %s", strings.ReplaceAll(fields, ",", ", ")))
+}
+
+// updateDiskExpansionCondition reports the expanded disks which the guest
+// only sees with their new size after a restart.
+func updateDiskExpansionCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.DiskExpansion == nil {
+		return
+	}
+	pendingDisks := domain.Spec.Metadata.KubeVirt.DiskExpansion.PendingDisks
+	if pendingDisks == "" {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceDiskExpansionPending)
+		return
+	}
+	setCondition(vmi, condManager, v1.VirtualMachineInstanceDiskExpansionPending, k8sv1.ConditionTrue,
+		v1.VirtualMachineInstanceReasonDiskExpansionRequiresRestart,
+		fmt.Sprintf("A restart is required to expand the disks: %s", strings.ReplaceAll(pendingDisks, ",", ", ")))
+}
+
+// updateSoftwareEmulationCondition warns about the performance impact when
+// the domain runs with TCG instead of KVM.
+func updateSoftwareEmulationCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil {
+		return
+	}
+	if domain.Spec.Type != "qemu" {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceSoftwareEmulation)
+		return
+	}
+	setCondition(vmi, condManager, v1.VirtualMachineInstanceSoftwareEmulation, k8sv1.ConditionTrue,
+		v1.VirtualMachineInstanceReasonSoftwareEmulation, "VMI runs with software emulation instead of KVM, expect significantly degraded performance")
+}
+
 func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance, domain *api.Domain, syncError error) (err error) {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
 
@@ -1083,6 +1484,9 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 
 	// Only issue vmi update if status has changed
 	if !equality.Semantic.DeepEqual(*oldStatus, vmi.Status) {
+		if interval, maxJitter := c.clusterConfig.GetVMIStatusBatching(); interval > 0 && isInformationalStatusChange(oldStatus, &vmi.Status) {
+			return c.patchInformationalStatus(oldStatus, vmi, interval, maxJitter)
+		}
 		key := controller.VirtualMachineInstanceKey(vmi)
 		c.vmiExpectations.SetExpectations(key, 1, 0)
 		_, err := c.clientset.VirtualMachineInstance(vmi.ObjectMeta.Namespace).Update(context.Background(), vmi, metav1.UpdateOptions{})
@@ -1090,6 +1494,7 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 			c.vmiExpectations.SetExpectations(key, 0, 0)
 			return err
 		}
+		c.statusBatcher.Written(string(vmi.UID))
 	}
 
 	// Record an event on the VMI when the VMI's phase changes
@@ -1100,6 +1505,70 @@ func (c *VirtualMachineController) updateVMIStatus(oldStatus *v1.VirtualMachineI
 	return nil
 }
 
+// isInformationalStatusChange reports whether only status fields changed which
+// the guest and the devices refresh frequently and nobody acts upon.
+func isInformationalStatusChange(oldStatus, newStatus *v1.VirtualMachineInstanceStatus) bool {
+	oldCopy, newCopy := oldStatus.DeepCopy(), newStatus.DeepCopy()
+	for _, status := range []*v1.VirtualMachineInstanceStatus{oldCopy, newCopy} {
+		status.Interfaces = nil
+		status.GuestOSInfo = v1.VirtualMachineInstanceGuestOSInfo{}
+		status.DeviceStatuses = nil
+		status.GuestFilesystems = nil
+	}
+	return equality.Semantic.DeepEqual(oldCopy, newCopy)
+}
+
+// patchInformationalStatus writes informational status changes at most once
+// per batch interval. Postponed changes are not lost, the VMI is processed
+// again once the interval elapsed and all changes until then are merged into
+// a single patch.
+func (c *VirtualMachineController) patchInformationalStatus(oldStatus *v1.VirtualMachineInstanceStatus, vmi *v1.VirtualMachineInstance, interval, maxJitter time.Duration) error {
+	key := controller.VirtualMachineInstanceKey(vmi)
+	if delay := c.statusBatcher.Delay(string(vmi.UID), interval, maxJitter); delay > 0 {
+		c.logger.Object(vmi).V(4).Infof("Postponing the informational status update by %v", delay)
+		c.statusQueue.AddAfter(key, delay)
+		return nil
+	}
+
+	patchSet := patch.New()
+	patchStatusList(patchSet, "/status/interfaces", oldStatus.Interfaces, vmi.Status.Interfaces)
+	patchStatusList(patchSet, "/status/deviceStatuses", oldStatus.DeviceStatuses, vmi.Status.DeviceStatuses)
+	patchStatusList(patchSet, "/status/guestFilesystems", oldStatus.GuestFilesystems, vmi.Status.GuestFilesystems)
+	if !equality.Semantic.DeepEqual(oldStatus.GuestOSInfo, vmi.Status.GuestOSInfo) {
+		patchSet.AddOption(
+			patch.WithTest("/status/guestOSInfo", oldStatus.GuestOSInfo),
+			patch.WithReplace("/status/guestOSInfo", vmi.Status.GuestOSInfo),
+		)
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	c.vmiExpectations.SetExpectations(key, 1, 0)
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	if err != nil {
+		c.vmiExpectations.SetExpectations(key, 0, 0)
+		return err
+	}
+	c.statusBatcher.Written(string(vmi.UID))
+	return nil
+}
+
+func patchStatusList[T any](patchSet *patch.PatchSet, path string, oldList, newList []T) {
+	if equality.Semantic.DeepEqual(oldList, newList) {
+		return
+	}
+	switch {
+	case len(oldList) == 0:
+		patchSet.AddOption(patch.WithAdd(path, newList))
+	case len(newList) == 0:
+		patchSet.AddOption(patch.WithTest(path, oldList), patch.WithRemove(path))
+	default:
+		patchSet.AddOption(patch.WithTest(path, oldList), patch.WithReplace(path, newList))
+	}
+}
+
 type virtLauncherCriticalSecurebootError struct {
 	msg string
 }
@@ -1121,6 +1590,21 @@ func (c *VirtualMachineController) handleSyncError(vmi *v1.VirtualMachineInstanc
 		c.logger.Errorf("virt-launcher reached an irrecoverable error. Updating VMI %s status to Failed", vmi.Name)
 		vmi.Status.Phase = v1.Failed
 	}
+
+	var verificationErr *signature.VerificationError
+	if goerror.As(syncError, &verificationErr) {
+		c.logger.Errorf("A containerDisk of VMI %s failed the signature verification. Updating VMI status to Failed", vmi.Name)
+		vmi.Status.Phase = v1.Failed
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceContainerDiskVerificationFailed) {
+			condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceContainerDiskVerificationFailed,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             v1.VirtualMachineInstanceReasonSignatureMismatch,
+				Message:            syncError.Error(),
+			})
+		}
+	}
 	condManager.CheckFailure(vmi, syncError, "Synchronizing with the Domain failed.")
 }
 
@@ -1149,7 +1633,7 @@ func (c *VirtualMachineController) calculatePausedCondition(vmi *v1.VirtualMachi
 			Status:             k8sv1.ConditionTrue,
 			LastProbeTime:      now,
 			LastTransitionTime: now,
-			Reason:             "PausedByMigrationMonitor",
+			Reason:             pausedByMigrationMonitorReason,
 			Message:            "VMI was paused by the migration monitor",
 		})
 	case api.ReasonPausedUser:
@@ -1159,7 +1643,7 @@ func (c *VirtualMachineController) calculatePausedCondition(vmi *v1.VirtualMachi
 			Status:             k8sv1.ConditionTrue,
 			LastProbeTime:      now,
 			LastTransitionTime: now,
-			Reason:             "PausedByUser",
+			Reason:             pausedByUserReason,
 			Message:            "VMI was paused by user",
 		})
 	case api.ReasonPausedIOError:
@@ -1169,7 +1653,7 @@ func (c *VirtualMachineController) calculatePausedCondition(vmi *v1.VirtualMachi
 			Status:             k8sv1.ConditionTrue,
 			LastProbeTime:      now,
 			LastTransitionTime: now,
-			Reason:             "PausedIOError",
+			Reason:             pausedIOErrorReason,
 			Message:            "VMI was paused, low-level IO error detected",
 		})
 	default:
@@ -1208,6 +1692,10 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses TDX", v1.VirtualMachineInstanceReasonTDXNotMigratable), isBlockMigration
 	}
 
+	if util.IsSGXVMI(vmi) {
+		return newNonMigratableCondition("VMI uses SGX", v1.VirtualMachineInstanceReasonSGXNotMigratable), isBlockMigration
+	}
+
 	if util.IsSecureExecutionVMI(vmi) {
 		return newNonMigratableCondition("VMI uses Secure Execution", v1.VirtualMachineInstanceReasonSecureExecutionNotMigratable), isBlockMigration
 	}
@@ -1216,6 +1704,10 @@ func (c *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses SCSI persistent reservation", v1.VirtualMachineInstanceReasonPRNotMigratable), isBlockMigration
 	}
 
+	if util.IsSharedMemoryVMI(vmi) {
+		return newNonMigratableCondition("VMI uses shared memory devices", v1.VirtualMachineInstanceReasonSharedMemoryNotMigratable), isBlockMigration
+	}
+
 	if tscRequirement := topology.GetTscFrequencyRequirement(vmi); !topology.AreTSCFrequencyTopologyHintsDefined(vmi) && tscRequirement.Type == topology.RequiredForMigration {
 		return newNonMigratableCondition(tscRequirement.Reason, v1.VirtualMachineInstanceReasonNoTSCFrequencyMigratable), isBlockMigration
 	}
@@ -1301,6 +1793,10 @@ func (c *VirtualMachineController) calculateLiveStorageMigrationCondition(vmi *v
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonTDXNotMigratable, "VMI uses TDX")
 	}
 
+	if util.IsSGXVMI(vmi) {
+		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonSGXNotMigratable, "VMI uses SGX")
+	}
+
 	if reservation.HasVMIPersistentReservation(vmi) {
 		multiCond.addNonMigratableCondition(v1.VirtualMachineInstanceReasonPRNotMigratable, "VMI uses SCSI persistent reservation")
 	}
@@ -1449,6 +1945,9 @@ func (c *VirtualMachineController) sync(key string,
 			shouldUpdate = false
 			c.logger.Object(vmi).Infof("Delay vm update for %f seconds", delay.Seconds())
 			c.queue.AddAfter(key, delay)
+		} else if isIOError(shouldUpdate, domainExists, domain) {
+			c.logger.Object(vmi).Info("Trying to resume the VMI paused on an IO error")
+			metrics.NewIOErrorResumeAttempt(vmi.Namespace, vmi.Name)
 		}
 	}
 
@@ -1511,8 +2010,14 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 
 	c.migrationProxy.StopTargetListener(vmiId)
 	c.migrationProxy.StopSourceListener(vmiId)
+	c.ioErrorRetryManager.Forget(vmiId)
+	c.statusBatcher.Forget(vmiId)
+	metrics.DeleteDomainDrift(vmi.Namespace, vmi.Name)
+	metrics.DeleteIOErrorResumeAttempts(vmi.Namespace, vmi.Name)
+	metrics.DeleteDiskSpaceLow(vmi.Namespace, vmi.Name)
 
 	c.downwardMetricsManager.StopServer(vmi)
+	c.hostSensorsManager.StopServer(vmi)
 
 	// Unmount container disks and clean up remaining files
 	if err := c.containerDiskMounter.Unmount(vmi); err != nil {
@@ -1554,6 +2059,11 @@ func (c *VirtualMachineController) processVmShutdown(vmi *v1.VirtualMachineInsta
 
 const firstGracefulShutdownAttempt = -1
 
+const (
+	defaultDiskSpaceLowUsedPercentage       = 90
+	defaultDiskSpaceLowHysteresisPercentage = 5
+)
+
 // Determines if a domain's grace period has expired during shutdown.
 // If the grace period has started but not expired, timeLeft represents
 // the time in seconds left until the period expires.
@@ -2003,6 +2513,11 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.resizeGuestMemory(vmi); err != nil {
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, memoryHotplugFailedReason, err.Error())
+		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
+	}
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -2012,6 +2527,10 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.hostSensorsManager.StartServer(vmi, isolationRes.Pid()); err != nil {
+		return err
+	}
+
 	if err := c.setupNetwork(vmi, netsetup.FilterNetsForLiveUpdate(vmi), c.netConf); err != nil {
 		c.recorder.Event(vmi, k8sv1.EventTypeWarning, "NicHotplug", err.Error())
 		*errorTolerantFeaturesError = append(*errorTolerantFeaturesError, err)
@@ -2233,6 +2752,37 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// resizeGuestMemory lowers the requested size of the virtio-mem device when the guest memory of the
+// vmi shrinks. Growing the memory requires a migration to a pod with larger requests instead.
+func (c *VirtualMachineController) resizeGuestMemory(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to resize guest memory"
+
+	memory := vmi.Spec.Domain.Memory
+	if vmi.Status.Memory == nil || vmi.Status.Memory.GuestRequested == nil ||
+		memory == nil || memory.Guest == nil || memory.MaxGuest == nil || memory.DIMMSlots != nil {
+		return nil
+	}
+	if memory.Guest.Cmp(*vmi.Status.Memory.GuestRequested) >= 0 {
+		return nil
+	}
+	if controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, v1.VirtualMachineInstanceMemoryChange) {
+		return nil
+	}
+
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	c.logger.V(3).Object(vmi).Info("sending resize guest memory command")
+	if err := client.ResizeVirtualMachineMemory(vmi); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	vmi.Status.Memory.GuestRequested = memory.Guest
+	return nil
+}
+
 func (c *VirtualMachineController) hotplugVolumesReady(vmi *v1.VirtualMachineInstance) bool {
 	hasHotplugVolume := false
 	for _, v := range vmi.Spec.Volumes {
@@ -2265,6 +2815,7 @@ func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstanc
 		return err
 	}
 
+	c.remediateGuestWatchdogExpiry(vmi, domain)
 	return c.vmUpdateHelperDefault(vmi, domain != nil)
 }
 
@@ -2402,13 +2953,36 @@ func (c *VirtualMachineController) deleteDomainFunc(obj interface{}) {
 		c.queue.Add(key)
 	}
 }
-func (c *VirtualMachineController) updateDomainFunc(_, new interface{}) {
+func (c *VirtualMachineController) updateDomainFunc(old, new interface{}) {
 	key, err := controller.KeyFunc(new)
-	if err == nil {
+	if err != nil {
+		return
+	}
+	if isStatusOnlyDomainUpdate(old, new) {
+		c.statusQueue.Add(key)
+	} else {
 		c.queue.Add(key)
 	}
 }
 
+// isStatusOnlyDomainUpdate reports whether a domain update only carries
+// guest reported status, like guest agent data or I/O error counters, while
+// the domain definition and its lifecycle state are unchanged.
+func isStatusOnlyDomainUpdate(old, new interface{}) bool {
+	oldDomain, ok := old.(*api.Domain)
+	if !ok {
+		return false
+	}
+	newDomain, ok := new.(*api.Domain)
+	if !ok {
+		return false
+	}
+	return oldDomain.ObjectMeta.DeletionTimestamp == nil && newDomain.ObjectMeta.DeletionTimestamp == nil &&
+		oldDomain.Status.Status == newDomain.Status.Status &&
+		oldDomain.Status.Reason == newDomain.Status.Reason &&
+		equality.Semantic.DeepEqual(oldDomain.Spec, newDomain.Spec)
+}
+
 func (c *VirtualMachineController) isHostModelMigratable(vmi *v1.VirtualMachineInstance) error {
 	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == v1.CPUModeHostModel {
 		if c.hostCpuModel == "" {
@@ -42,6 +42,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	testingclock "k8s.io/utils/clock/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	api2 "kubevirt.io/client-go/api"
@@ -171,6 +172,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, config)
 		fakeDownwardMetricsManager := newFakeManager()
+		fakeHostSensorsManager := newFakeManager()
 
 		launcherClientManager := &launcherclients.MockLauncherClientManager{
 			Initialized: true,
@@ -193,6 +195,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			mockIsolationDetector,
 			migrationProxy,
 			fakeDownwardMetricsManager,
+			fakeHostSensorsManager,
 			nil, // capabilities
 			"",  // host cpu model
 			&netConfStub{},
@@ -736,6 +739,11 @@ var _ = Describe("VirtualMachineInstance", func() {
 					"Type":   Equal(v1.VirtualMachineInstanceUnsupportedAgent),
 					"Status": Equal(k8sv1.ConditionTrue)},
 				),
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(v1.VirtualMachineInstanceGuestAgentOutdated),
+					"Status": Equal(k8sv1.ConditionTrue),
+					"Reason": Equal(v1.VirtualMachineInstanceReasonGuestAgentVersionNotSupported)},
+				),
 				MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(v1.VirtualMachineInstanceIsStorageLiveMigratable),
 					"Status": Equal(k8sv1.ConditionTrue)},
@@ -984,6 +992,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			vmiMigrationState       v1.VirtualMachineInstanceMigrationState
 			expectPausedCondition   bool
 			expectEvents            bool
+			expectedPauseReason     v1.PauseReason
 		}
 		DescribeTable("when domain is paused", func(td domainIsPausedTest) {
 			vmi := libvmi.New(
@@ -1003,6 +1012,8 @@ var _ = Describe("VirtualMachineInstance", func() {
 			domain = domain.DeepCopy()
 			domain.Status.Status = api.Paused
 			domain.Status.Reason = td.domainStateChangeReason
+			autoUnpauseTimestamp := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			domain.Spec.Metadata.KubeVirt.Pause = &api.PauseMetadata{AutoUnpauseTimestamp: &autoUnpauseTimestamp}
 
 			addVMI(vmi, domain)
 
@@ -1023,6 +1034,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 						"Status": Equal(k8sv1.ConditionTrue)},
 					)),
 				)
+				Expect(updatedVMI.Status.PauseStatus).To(BeNil())
 				return
 			}
 
@@ -1032,6 +1044,10 @@ var _ = Describe("VirtualMachineInstance", func() {
 					"Status": Equal(k8sv1.ConditionTrue)},
 				)),
 			)
+			Expect(updatedVMI.Status.PauseStatus).ToNot(BeNil())
+			Expect(updatedVMI.Status.PauseStatus.Reason).To(Equal(td.expectedPauseReason))
+			Expect(updatedVMI.Status.PauseStatus.PauseTimestamp).ToNot(BeNil())
+			Expect(updatedVMI.Status.PauseStatus.AutoUnpauseTimestamp.Equal(&autoUnpauseTimestamp)).To(BeTrue())
 			expectEvent(VMIMigrating, td.expectEvents)
 
 			By("unpausing domain")
@@ -1055,11 +1071,18 @@ var _ = Describe("VirtualMachineInstance", func() {
 					"Type":   Equal(v1.VirtualMachineInstancePaused),
 					"Status": Equal(k8sv1.ConditionTrue)},
 				)))
+			Expect(updatedVMI.Status.PauseStatus).To(BeNil())
 			expectEvent(VMIMigrating, td.expectEvents)
 		},
 			Entry("by user should add and remove paused condition", domainIsPausedTest{
 				domainStateChangeReason: api.ReasonPausedUser,
 				expectPausedCondition:   true,
+				expectedPauseReason:     v1.PauseReasonUser,
+			}),
+			Entry("by an IO error should add and remove paused condition", domainIsPausedTest{
+				domainStateChangeReason: api.ReasonPausedIOError,
+				expectPausedCondition:   true,
+				expectedPauseReason:     v1.PauseReasonIOError,
 			}),
 			Entry("by qemu during migration should skip paused condition", domainIsPausedTest{
 				domainStateChangeReason: api.ReasonPausedMigration,
@@ -1067,6 +1090,58 @@ var _ = Describe("VirtualMachineInstance", func() {
 			}),
 		)
 
+		It("should report the IO error recovery condition while resuming a VMI paused on an IO error", func() {
+			vmi := libvmi.New(
+				libvmi.WithNamespace(k8sv1.NamespaceDefault),
+				vmiWithResourceVersion("1"),
+				vmiWithUID(vmiTestUUID),
+				libvmistatus.WithStatus(libvmistatus.New(
+					libvmistatus.WithPhase(v1.Running),
+					libvmistatus.WithActivePod(podTestUUID, host),
+				)),
+			)
+
+			domain := api.NewMinimalDomainWithUUID(vmi.Name, vmiTestUUID)
+			domain.Status.Status = api.Paused
+			domain.Status.Reason = api.ReasonPausedIOError
+			addVMI(vmi, domain)
+
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), mockCgroupManager).Return(nil).AnyTimes()
+
+			sanityExecute()
+
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(v1.VirtualMachineInstanceIOErrorRecovery),
+					"Status": Equal(k8sv1.ConditionTrue),
+					"Reason": Equal(ioErrorResumeScheduledReason),
+				}),
+			))
+
+			By("exhausting the resume attempts")
+			controller.ioErrorRetryManager.retryStates[string(vmiTestUUID)].exhausted = true
+			removeVMI(updatedVMI)
+			addVMI(updatedVMI, domain)
+			key, err := virtcontroller.KeyFunc(domain)
+			Expect(err).To(Not(HaveOccurred()))
+			controller.vmiExpectations.SetExpectations(key, 0, 0)
+			sanityExecute()
+
+			updatedVMI, err = virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.Conditions).To(ContainElement(
+				MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(v1.VirtualMachineInstanceIOErrorRecovery),
+					"Status": Equal(k8sv1.ConditionFalse),
+					"Reason": Equal(ioErrorResumeExhaustedReason),
+				}),
+			))
+		})
+
 		It("should move VirtualMachineInstance from Scheduled to Failed if watchdog file is missing", func() {
 			Expect(cmdclient.MarkSocketUnresponsive(sockFile)).To(Succeed())
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -2348,6 +2423,17 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonTDXNotMigratable))
 		})
 
+		It("should not be allowed to live-migrate if the VMI uses SGX", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SGX = &v1.SGX{EPCSize: resource.MustParse("64Mi")}
+
+			condition, isBlockMigration := controller.calculateLiveMigrationCondition(vmi)
+			Expect(isBlockMigration).To(BeFalse())
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonSGXNotMigratable))
+		})
+
 		It("should not be allowed to live-migrate if the VMI uses SCSI persistent reservation", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 
@@ -2368,6 +2454,17 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonPRNotMigratable))
 		})
 
+		It("should not be allowed to live-migrate if the VMI uses shared memory devices", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SharedMemoryDevices = []v1.SharedMemoryDevice{{Name: "ring"}}
+
+			condition, isBlockMigration := controller.calculateLiveMigrationCondition(vmi)
+			Expect(isBlockMigration).To(BeFalse())
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonSharedMemoryNotMigratable))
+		})
+
 		Context("with network configuration", func() {
 			It("should block migration for bridge binding assigned to the pod network", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
@@ -3042,6 +3139,60 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 	})
 
+	Context("resizeGuestMemory", func() {
+		newVirtioMemVMI := func(guest, guestRequested string) *v1.VirtualMachineInstance {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:    pointer.P(resource.MustParse(guest)),
+				MaxGuest: pointer.P(resource.MustParse("4Gi")),
+			}
+			vmi.Status.Memory = &v1.MemoryStatus{
+				GuestAtBoot:    pointer.P(resource.MustParse("1Gi")),
+				GuestCurrent:   pointer.P(resource.MustParse(guestRequested)),
+				GuestRequested: pointer.P(resource.MustParse(guestRequested)),
+			}
+			return vmi
+		}
+
+		It("should shrink the virtio-mem device in place", func() {
+			vmi := newVirtioMemVMI("2Gi", "3Gi")
+			client.EXPECT().ResizeVirtualMachineMemory(vmi).Return(nil)
+
+			Expect(controller.resizeGuestMemory(vmi)).To(Succeed())
+			Expect(vmi.Status.Memory.GuestRequested).To(Equal(vmi.Spec.Domain.Memory.Guest))
+		})
+
+		It("should keep the requested memory when the launcher fails to resize", func() {
+			vmi := newVirtioMemVMI("2Gi", "3Gi")
+			client.EXPECT().ResizeVirtualMachineMemory(vmi).Return(fmt.Errorf("the guest memory can not be resized"))
+
+			Expect(controller.resizeGuestMemory(vmi)).To(MatchError(ContainSubstring("failed to resize guest memory")))
+			Expect(vmi.Status.Memory.GuestRequested.String()).To(Equal("3Gi"))
+		})
+
+		DescribeTable("should not resize", func(vmi *v1.VirtualMachineInstance) {
+			Expect(controller.resizeGuestMemory(vmi)).To(Succeed())
+		},
+			Entry("when the guest memory grows", newVirtioMemVMI("3Gi", "2Gi")),
+			Entry("when the guest memory is unchanged", newVirtioMemVMI("2Gi", "2Gi")),
+			Entry("when the memory is hotplugged with DIMMs", func() *v1.VirtualMachineInstance {
+				vmi := newVirtioMemVMI("2Gi", "3Gi")
+				vmi.Spec.Domain.Memory.DIMMSlots = pointer.P(uint32(4))
+				return vmi
+			}()),
+			Entry("when a memory change is in progress", func() *v1.VirtualMachineInstance {
+				vmi := newVirtioMemVMI("2Gi", "3Gi")
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+					Type:   v1.VirtualMachineInstanceMemoryChange,
+					Status: k8sv1.ConditionTrue,
+				}}
+				return vmi
+			}()),
+		)
+	})
+
 	Context("updateBackupStatus", func() {
 		DescribeTable("should not update when",
 			func(cbtStatus *v1.ChangedBlockTrackingStatus) {
@@ -3110,6 +3261,516 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Entry("update backupStatus when backupStatus name and backupMetadata name match", "test-backup", "test-backup", true),
 		)
 	})
+
+	Context("updateSoftwareEmulationCondition", func() {
+		var condManager *virtcontroller.VirtualMachineInstanceConditionManager
+
+		BeforeEach(func() {
+			condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		})
+
+		It("should add the condition when the domain runs with software emulation", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Type = "qemu"
+
+			updateSoftwareEmulationCondition(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceSoftwareEmulation)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonSoftwareEmulation))
+		})
+
+		It("should remove the condition when the domain runs with KVM", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceSoftwareEmulation,
+				Status: k8sv1.ConditionTrue,
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Type = "kvm"
+
+			updateSoftwareEmulationCondition(vmi, domain, condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceSoftwareEmulation)).To(BeFalse())
+		})
+	})
+
+	Context("updateDomainDriftCondition", func() {
+		var condManager *virtcontroller.VirtualMachineInstanceConditionManager
+
+		BeforeEach(func() {
+			condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		})
+
+		It("should add the condition when the domain drifted", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.Drift = &api.DriftMetadata{Fields: "type,qemu:commandline"}
+
+			updateDomainDriftCondition(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDomainDrift)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonDomainDrifted))
+			Expect(cond.Message).To(Equal("The live domain deviates from the expected domain: type, qemu:commandline"))
+		})
+
+		It("should keep the transition time while the domain stays drifted", func() {
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceDomainDrift,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: transitionTime,
+				Reason:             v1.VirtualMachineInstanceReasonDomainDrifted,
+				Message:            "The live domain deviates from the expected domain: type",
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.Drift = &api.DriftMetadata{Fields: "type,qemu:commandline"}
+
+			updateDomainDriftCondition(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDomainDrift)
+			Expect(cond.LastTransitionTime).To(Equal(transitionTime))
+			Expect(cond.Message).To(Equal("The live domain deviates from the expected domain: type, qemu:commandline"))
+		})
+
+		It("should remove the condition once the drift is gone", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceDomainDrift,
+				Status: k8sv1.ConditionTrue,
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.Drift = &api.DriftMetadata{}
+
+			updateDomainDriftCondition(vmi, domain, condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDomainDrift)).To(BeFalse())
+		})
+
+		It("should keep the condition untouched without drift metadata", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceDomainDrift,
+				Status: k8sv1.ConditionTrue,
+			}}
+
+			updateDomainDriftCondition(vmi, api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID), condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDomainDrift)).To(BeTrue())
+		})
+	})
+
+	Context("updateDiskExpansionCondition", func() {
+		var condManager *virtcontroller.VirtualMachineInstanceConditionManager
+
+		BeforeEach(func() {
+			condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		})
+
+		It("should add the condition when a disk awaits a restart", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.DiskExpansion = &api.DiskExpansionMetadata{PendingDisks: "rootdisk,lun0"}
+
+			updateDiskExpansionCondition(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskExpansionPending)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonDiskExpansionRequiresRestart))
+			Expect(cond.Message).To(Equal("A restart is required to expand the disks: rootdisk, lun0"))
+		})
+
+		It("should keep the transition time while disks await a restart", func() {
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:               v1.VirtualMachineInstanceDiskExpansionPending,
+				Status:             k8sv1.ConditionTrue,
+				LastTransitionTime: transitionTime,
+				Reason:             v1.VirtualMachineInstanceReasonDiskExpansionRequiresRestart,
+				Message:            "A restart is required to expand the disks: rootdisk",
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.DiskExpansion = &api.DiskExpansionMetadata{PendingDisks: "rootdisk,lun0"}
+
+			updateDiskExpansionCondition(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskExpansionPending)
+			Expect(cond.Message).To(Equal("A restart is required to expand the disks: rootdisk, lun0"))
+			Expect(cond.LastTransitionTime).To(Equal(transitionTime))
+		})
+
+		It("should remove the condition once all disks are expanded", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceDiskExpansionPending,
+				Status: k8sv1.ConditionTrue,
+			}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.DiskExpansion = &api.DiskExpansionMetadata{}
+
+			updateDiskExpansionCondition(vmi, domain, condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDiskExpansionPending)).To(BeFalse())
+		})
+	})
+
+	Context("guest filesystems", func() {
+		var condManager *virtcontroller.VirtualMachineInstanceConditionManager
+
+		newDiskSpaceLowVMI := func(usedBytes int64) *v1.VirtualMachineInstance {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.DiskSpaceLow = &v1.DiskSpaceLowThreshold{UsedPercentage: pointer.P(int32(80))}
+			vmi.Status.GuestFilesystems = []v1.VirtualMachineInstanceGuestFilesystem{
+				{MountPoint: "/", UsedBytes: 100, TotalBytes: 1000},
+				{MountPoint: "/data", UsedBytes: usedBytes, TotalBytes: 1000},
+			}
+			return vmi
+		}
+
+		BeforeEach(func() {
+			condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+		})
+
+		It("should report the filesystems of the domain in the status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Filesystems = []api.Filesystem{{Name: "vda1", Mountpoint: "/", Type: "xfs", UsedBytes: 10, TotalBytes: 20}}
+
+			updateGuestFilesystemsFromDomain(vmi, domain)
+
+			Expect(vmi.Status.GuestFilesystems).To(Equal([]v1.VirtualMachineInstanceGuestFilesystem{
+				{MountPoint: "/", DiskName: "vda1", FileSystemType: "xfs", UsedBytes: 10, TotalBytes: 20},
+			}))
+		})
+
+		It("should not report DiskSpaceLow without threshold", func() {
+			vmi := newDiskSpaceLowVMI(990)
+			vmi.Spec.DiskSpaceLow = nil
+
+			updateDiskSpaceLowCondition(vmi, condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)).To(BeFalse())
+		})
+
+		It("should report DiskSpaceLow when a filesystem reaches the threshold", func() {
+			vmi := newDiskSpaceLowVMI(800)
+
+			updateDiskSpaceLowCondition(vmi, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestFilesystemFull))
+			Expect(cond.Message).To(Equal("The guest filesystems are low on space: /data (80%)"))
+		})
+
+		DescribeTable("should apply the hysteresis before clearing DiskSpaceLow", func(usedBytes int64, expectCondition bool) {
+			vmi := newDiskSpaceLowVMI(900)
+			updateDiskSpaceLowCondition(vmi, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)).To(BeTrue())
+
+			vmi.Status.GuestFilesystems[1].UsedBytes = usedBytes
+			updateDiskSpaceLowCondition(vmi, condManager)
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)).To(Equal(expectCondition))
+		},
+			Entry("below the threshold but within the hysteresis", int64(760), true),
+			Entry("below the threshold by more than the hysteresis", int64(740), false),
+		)
+
+		It("should keep the transition time when the message changes", func() {
+			vmi := newDiskSpaceLowVMI(800)
+			updateDiskSpaceLowCondition(vmi, condManager)
+			transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			vmi.Status.Conditions[0].LastTransitionTime = transitionTime
+
+			vmi.Status.GuestFilesystems[1].UsedBytes = 950
+			updateDiskSpaceLowCondition(vmi, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceDiskSpaceLow)
+			Expect(cond.Message).To(Equal("The guest filesystems are low on space: /data (95%)"))
+			Expect(cond.LastTransitionTime).To(Equal(transitionTime))
+		})
+	})
+
+	Context("guest watchdog expiry", func() {
+		var condManager *virtcontroller.VirtualMachineInstanceConditionManager
+		var expiredAt metav1.Time
+
+		newWatchdogVMI := func(remediation *v1.WatchdogRemediation) *v1.VirtualMachineInstance {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+				Name:           "watchdog",
+				WatchdogDevice: v1.WatchdogDevice{I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionPause}},
+				Remediation:    remediation,
+			}
+			return vmi
+		}
+
+		newExpiredDomain := func() *api.Domain {
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.WatchdogExpiry = &api.WatchdogExpiry{Count: 2, LastAction: "pause", LastExpiry: expiredAt}
+			return domain
+		}
+
+		BeforeEach(func() {
+			condManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+			expiredAt = metav1.Unix(time.Now().Unix(), 0)
+		})
+
+		It("should surface a new expiry", func() {
+			vmi := newWatchdogVMI(nil)
+
+			updateGuestWatchdogCondition(vmi, newExpiredDomain(), condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogExpired)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(v1.VirtualMachineInstanceReasonGuestWatchdogExpired))
+			Expect(cond.LastProbeTime).To(Equal(expiredAt))
+			Expect(cond.Message).To(Equal("The guest watchdog expired 2 time(s), the last expiry triggered the pause action"))
+		})
+
+		DescribeTable("should apply the remediation once per expiry", func(remediation v1.WatchdogRemediationType, expectRemediation func(vmi *v1.VirtualMachineInstance)) {
+			vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: remediation})
+			expectRemediation(vmi)
+
+			controller.remediateGuestWatchdogExpiry(vmi, newExpiredDomain())
+			expectEvent("The guest watchdog expired and triggered the pause action", true)
+			updateGuestWatchdogCondition(vmi, newExpiredDomain(), condManager)
+			controller.remediateGuestWatchdogExpiry(vmi, newExpiredDomain())
+
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogExpired)).To(BeTrue())
+		},
+			Entry("resetting the guest", v1.WatchdogRemediationReset, func(vmi *v1.VirtualMachineInstance) {
+				client.EXPECT().ResetVirtualMachine(vmi).Return(nil)
+			}),
+			Entry("leaving the restart to virt-controller", v1.WatchdogRemediationRestartVM, func(*v1.VirtualMachineInstance) {}),
+			Entry("leaving the memory dump to virt-controller", v1.WatchdogRemediationMemoryDump, func(*v1.VirtualMachineInstance) {}),
+		)
+
+		It("should apply the remediation again on a later expiry", func() {
+			vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationReset})
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+				Type:          v1.VirtualMachineInstanceGuestWatchdogExpired,
+				Status:        k8sv1.ConditionTrue,
+				Reason:        v1.VirtualMachineInstanceReasonGuestWatchdogExpired,
+				LastProbeTime: metav1.NewTime(expiredAt.Add(-time.Minute)),
+			}}
+			client.EXPECT().ResetVirtualMachine(vmi).Return(nil)
+
+			controller.remediateGuestWatchdogExpiry(vmi, newExpiredDomain())
+			updateGuestWatchdogCondition(vmi, newExpiredDomain(), condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGuestWatchdogExpired)
+			Expect(cond.LastProbeTime).To(Equal(expiredAt))
+			expectEvent("The guest watchdog expired", true)
+		})
+
+		It("should report a failed remediation", func() {
+			vmi := newWatchdogVMI(&v1.WatchdogRemediation{Type: v1.WatchdogRemediationReset})
+			client.EXPECT().ResetVirtualMachine(vmi).Return(fmt.Errorf("launcher is gone"))
+
+			controller.remediateGuestWatchdogExpiry(vmi, newExpiredDomain())
+
+			expectEvent("The guest watchdog expired", true)
+			expectEvent("Failed to apply the Reset watchdog remediation: launcher is gone", true)
+		})
+	})
+
+	Context("isStatusOnlyDomainUpdate", func() {
+		DescribeTable("should classify domain updates", func(modify func(domain *api.Domain), expected bool) {
+			oldDomain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			oldDomain.Status.Status = api.Running
+			newDomain := oldDomain.DeepCopy()
+			modify(newDomain)
+
+			Expect(isStatusOnlyDomainUpdate(oldDomain, newDomain)).To(Equal(expected))
+		},
+			Entry("as status only when the guest agent data changed", func(domain *api.Domain) {
+				domain.Status.OSInfo.Name = "fedora"
+			}, true),
+			Entry("as status only on a resync without any change", func(*api.Domain) {}, true),
+			Entry("as lifecycle when the domain state changed", func(domain *api.Domain) {
+				domain.Status.Status = api.Paused
+			}, false),
+			Entry("as lifecycle when the domain definition changed", func(domain *api.Domain) {
+				domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{}
+			}, false),
+			Entry("as lifecycle when the domain is deleted", func(domain *api.Domain) {
+				now := metav1.Now()
+				domain.ObjectMeta.DeletionTimestamp = &now
+			}, false),
+		)
+
+		It("should process the status lane", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = "other uuid"
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			Expect(controller.vmiStore.Add(vmi)).To(Succeed())
+			Expect(controller.domainStore.Add(domain)).To(Succeed())
+
+			controller.statusQueue.Add("default/testvmi")
+			Expect(controller.executeFromQueue(controller.statusQueue)).To(BeTrue())
+			Expect(controller.statusQueue.Len()).To(BeZero())
+			Expect(controller.statusQueue.NumRequeues("default/testvmi")).To(BeZero())
+			Expect(mockQueue.Len()).To(BeZero())
+		})
+	})
+
+	Context("informational status batching", func() {
+		DescribeTable("isInformationalStatusChange", func(mutate func(*v1.VirtualMachineInstanceStatus), expected bool) {
+			oldStatus := &v1.VirtualMachineInstanceStatus{Phase: v1.Running}
+			newStatus := oldStatus.DeepCopy()
+			mutate(newStatus)
+			Expect(isInformationalStatusChange(oldStatus, newStatus)).To(Equal(expected))
+		},
+			Entry("interfaces", func(s *v1.VirtualMachineInstanceStatus) {
+				s.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}}
+			}, true),
+			Entry("guest OS info", func(s *v1.VirtualMachineInstanceStatus) {
+				s.GuestOSInfo.ID = "fedora"
+			}, true),
+			Entry("device statuses", func(s *v1.VirtualMachineInstanceStatus) {
+				s.DeviceStatuses = []v1.VirtualMachineInstanceDeviceStatus{{Name: "rootdisk", Type: v1.DeviceTypeDisk}}
+			}, true),
+			Entry("phase", func(s *v1.VirtualMachineInstanceStatus) {
+				s.Phase = v1.Succeeded
+			}, false),
+			Entry("conditions along with interfaces", func(s *v1.VirtualMachineInstanceStatus) {
+				s.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}}
+				s.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue}}
+			}, false),
+		)
+
+		It("should merge informational changes into one patch per interval", func() {
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			controller.statusBatcher = newStatusBatcher(fakeClock)
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: "10.0.0.1"}}
+			_, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			update := func(ip, guestOS string) {
+				oldStatus := vmi.Status.DeepCopy()
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IP: ip}}
+				vmi.Status.GuestOSInfo.ID = guestOS
+				vmi.Status.DeviceStatuses = []v1.VirtualMachineInstanceDeviceStatus{{Name: "rootdisk", Type: v1.DeviceTypeDisk, Attached: true}}
+				Expect(controller.patchInformationalStatus(oldStatus, vmi, time.Minute, 0)).To(Succeed())
+			}
+			stored := func() *v1.VirtualMachineInstance {
+				stored, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				return stored
+			}
+
+			By("writing the first change right away")
+			update("10.0.0.2", "fedora")
+			Expect(stored().Status.Interfaces[0].IP).To(Equal("10.0.0.2"))
+			Expect(stored().Status.GuestOSInfo.ID).To(Equal("fedora"))
+			Expect(stored().Status.DeviceStatuses).To(HaveLen(1))
+
+			By("postponing changes within the interval")
+			vmi = stored()
+			fakeClock.Step(30 * time.Second)
+			update("10.0.0.3", "rhel")
+			Expect(stored().Status.Interfaces[0].IP).To(Equal("10.0.0.2"))
+			Expect(controller.statusQueue.Len()).To(BeZero(), "the VMI should only be requeued once the interval elapsed")
+
+			By("writing the merged changes once the interval elapsed")
+			vmi = stored()
+			fakeClock.Step(30 * time.Second)
+			update("10.0.0.3", "rhel")
+			Expect(stored().Status.Interfaces[0].IP).To(Equal("10.0.0.3"))
+			Expect(stored().Status.GuestOSInfo.ID).To(Equal("rhel"))
+		})
+	})
+
+	Context("updateDeviceStatusesFromDomain", func() {
+		It("should report the state of disks and interfaces", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}, {Name: "hotplugdisk"}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default"}, {Name: "sriov"}, {Name: "hotplugnet"}, {Name: "unplugged", State: v1.InterfaceStateAbsent},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "hotplugdisk", HotplugVolume: &v1.HotplugVolumeStatus{}}}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Devices.Disks = []api.Disk{{Alias: api.NewUserDefinedAlias("rootdisk")}}
+			domain.Spec.Devices.Interfaces = []api.Interface{{
+				Alias:     api.NewUserDefinedAlias("default"),
+				LinkState: &api.LinkState{State: "down"},
+			}}
+			domain.Spec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias("sriov-sriov")}}
+			domain.Status.DiskIOErrors = []api.DiskIOError{{Alias: "rootdisk", Count: 3, LastReason: "enospc"}}
+
+			updateDeviceStatusesFromDomain(vmi, domain)
+
+			Expect(vmi.Status.DeviceStatuses).To(Equal([]v1.VirtualMachineInstanceDeviceStatus{
+				{Name: "rootdisk", Type: v1.DeviceTypeDisk, Attached: true, IOErrors: 3, LastIOError: "enospc"},
+				{Name: "hotplugdisk", Type: v1.DeviceTypeDisk, HotplugPending: true},
+				{Name: "default", Type: v1.DeviceTypeInterface, Attached: true, LinkState: v1.DeviceLinkStateDown},
+				{Name: "sriov", Type: v1.DeviceTypeInterface, Attached: true},
+				{Name: "hotplugnet", Type: v1.DeviceTypeInterface, HotplugPending: true},
+			}))
+		})
+
+		It("should keep the device statuses without a domain", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Status.DeviceStatuses = []v1.VirtualMachineInstanceDeviceStatus{{Name: "rootdisk", Type: v1.DeviceTypeDisk, Attached: true}}
+
+			updateDeviceStatusesFromDomain(vmi, nil)
+
+			Expect(vmi.Status.DeviceStatuses).To(HaveLen(1))
+		})
+
+		It("should report the guest PCI addresses of disks and interfaces", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}, {Name: "cdrom"}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}, {Name: "sriov"}}
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Devices.Disks = []api.Disk{
+				{
+					Alias:   api.NewUserDefinedAlias("rootdisk"),
+					Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x07", Slot: "0x00", Function: "0x0"},
+				},
+				{
+					Alias:   api.NewUserDefinedAlias("cdrom"),
+					Address: &api.Address{Type: "drive", Controller: "0", Bus: "0", Unit: "1"},
+				},
+			}
+			domain.Spec.Devices.Interfaces = []api.Interface{{
+				Alias:   api.NewUserDefinedAlias("default"),
+				Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x01", Slot: "0x00", Function: "0x0"},
+			}}
+			domain.Spec.Devices.HostDevices = []api.HostDevice{{
+				Alias:   api.NewUserDefinedAlias("sriov-sriov"),
+				Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x0a", Slot: "0x00", Function: "0x0"},
+			}}
+
+			updateDeviceStatusesFromDomain(vmi, domain)
+
+			Expect(vmi.Status.DeviceStatuses).To(Equal([]v1.VirtualMachineInstanceDeviceStatus{
+				{Name: "rootdisk", Type: v1.DeviceTypeDisk, Attached: true, PCIAddress: "0000:07:00.0"},
+				{Name: "cdrom", Type: v1.DeviceTypeDisk, Attached: true},
+				{Name: "default", Type: v1.DeviceTypeInterface, Attached: true, LinkState: v1.DeviceLinkStateUp, PCIAddress: "0000:01:00.0"},
+				{Name: "sriov", Type: v1.DeviceTypeInterface, Attached: true, PCIAddress: "0000:0a:00.0"},
+			}))
+		})
+	})
 })
 
 var _ = Describe("CurrentMemory in Libvirt Domain", func() {
@@ -27,9 +27,12 @@ import (
 	"github.com/mdlayher/vsock"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util/tls"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-handler/vsock/system"
 	v1 "kubevirt.io/kubevirt/pkg/vsock/system/v1"
 )
@@ -42,6 +45,10 @@ type Hypervisor struct {
 	port      uint32
 	caManager tls.ClientCAManager
 	server    *grpc.Server
+
+	vmiStore      cache.Store
+	virtClient    kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
 }
 
 func (h *Hypervisor) Stop() {
@@ -97,7 +104,7 @@ func (h *Hypervisor) serve() {
 		return
 	}
 	defer conn.Close()
-	v1.RegisterSystemServer(h.server, system.NewSystemService(h.caManager))
+	v1.RegisterSystemServer(h.server, system.NewSystemService(h.caManager, h.vmiStore, h.virtClient, h.clusterConfig))
 	err = h.server.Serve(conn)
 	if err != nil {
 		log.DefaultLogger().Reason(err).Error("Failed to listen for VSOCK connections.")
@@ -105,9 +112,12 @@ func (h *Hypervisor) serve() {
 	}
 }
 
-func NewVSOCKHypervisorService(port uint32, caManager tls.ClientCAManager) *Hypervisor {
+func NewVSOCKHypervisorService(port uint32, caManager tls.ClientCAManager, vmiStore cache.Store, virtClient kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig) *Hypervisor {
 	return &Hypervisor{
-		port:      port,
-		caManager: caManager,
+		port:          port,
+		caManager:     caManager,
+		vmiStore:      vmiStore,
+		virtClient:    virtClient,
+		clusterConfig: clusterConfig,
 	}
 }
@@ -21,13 +21,33 @@ package system
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/mdlayher/vsock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util/tls"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	v1 "kubevirt.io/kubevirt/pkg/vsock/system/v1"
 )
 
 type SystemService struct {
-	caManager tls.ClientCAManager
+	caManager     tls.ClientCAManager
+	vmiStore      cache.Store
+	virtClient    kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
 }
 
 func (s SystemService) CABundle(ctx context.Context, _ *v1.EmptyRequest) (*v1.Bundle, error) {
@@ -38,6 +58,99 @@ func (s SystemService) CABundle(ctx context.Context, _ *v1.EmptyRequest) (*v1.Bu
 	return &v1.Bundle{Raw: raw}, nil
 }
 
-func NewSystemService(mgr tls.ClientCAManager) *SystemService {
-	return &SystemService{caManager: mgr}
+// RequestDiskExpansion records the request of a guest to grow the PVC of one
+// of its volumes on the VMI status, virt-controller decides whether it fits
+// into the quota and expands the PVC.
+func (s SystemService) RequestDiskExpansion(ctx context.Context, request *v1.DiskExpansionRequest) (*v1.EmptyRequest, error) {
+	if !s.clusterConfig.GuestDiskExpansionEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "guest disk expansion is not enabled")
+	}
+	vmi, err := s.callerVMI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if vmi.Annotations[virtv1.AllowGuestDiskExpansionAnnotation] != "true" {
+		return nil, status.Errorf(codes.PermissionDenied, "guest disk expansion is not allowed for VMI %s/%s", vmi.Namespace, vmi.Name)
+	}
+	if request.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "the requested size must be positive")
+	}
+	if !hasPVCVolume(vmi, request.Disk) {
+		return nil, status.Errorf(codes.InvalidArgument, "disk %s is not backed by a PVC", request.Disk)
+	}
+
+	if err := s.patchDiskExpansionRequest(ctx, vmi, virtv1.DiskExpansionRequest{
+		Name:  request.Disk,
+		Size:  *resource.NewQuantity(request.Size, resource.BinarySI),
+		Phase: virtv1.DiskExpansionRequestPending,
+	}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to record the expansion request: %v", err)
+	}
+	log.Log.Object(vmi).Infof("Guest requested to expand disk %s to %d bytes", request.Disk, request.Size)
+
+	return &v1.EmptyRequest{}, nil
+}
+
+// callerVMI finds the VMI whose VSOCK CID matches the one of the caller.
+func (s SystemService) callerVMI(ctx context.Context) (*virtv1.VirtualMachineInstance, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unknown caller")
+	}
+	addr, ok := p.Addr.(*vsock.Addr)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "caller %v is not a VSOCK peer", p.Addr)
+	}
+	for _, obj := range s.vmiStore.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.Status.VSOCKCID != nil && *vmi.Status.VSOCKCID == addr.ContextID && !vmi.IsFinal() {
+			return vmi, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "no VMI with VSOCK CID %d on this node", addr.ContextID)
+}
+
+func hasPVCVolume(vmi *virtv1.VirtualMachineInstance, name string) bool {
+	for i := range vmi.Spec.Volumes {
+		if vmi.Spec.Volumes[i].Name == name {
+			return storagetypes.PVCNameFromVirtVolume(&vmi.Spec.Volumes[i]) != ""
+		}
+	}
+	return false
+}
+
+func (s SystemService) patchDiskExpansionRequest(ctx context.Context, vmi *virtv1.VirtualMachineInstance, request virtv1.DiskExpansionRequest) error {
+	oldRequests := vmi.Status.DiskExpansionRequests
+	var newRequests []virtv1.DiskExpansionRequest
+	for _, r := range oldRequests {
+		if r.Name != request.Name {
+			newRequests = append(newRequests, r)
+		}
+	}
+	newRequests = append(newRequests, request)
+
+	patchSet := patch.New()
+	if oldRequests == nil {
+		patchSet.AddOption(patch.WithAdd("/status/diskExpansionRequests", newRequests))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/status/diskExpansionRequests", oldRequests),
+			patch.WithReplace("/status/diskExpansionRequests", newRequests),
+		)
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return fmt.Errorf("failed to generate the patch payload: %v", err)
+	}
+	_, err = s.virtClient.VirtualMachineInstance(vmi.Namespace).Patch(ctx, vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	return err
+}
+
+func NewSystemService(mgr tls.ClientCAManager, vmiStore cache.Store, virtClient kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig) *SystemService {
+	return &SystemService{
+		caManager:     mgr,
+		vmiStore:      vmiStore,
+		virtClient:    virtClient,
+		clusterConfig: clusterConfig,
+	}
 }
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package system
+
+import (
+	"context"
+
+	"github.com/mdlayher/vsock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+	v1 "kubevirt.io/kubevirt/pkg/vsock/system/v1"
+)
+
+var _ = Describe("RequestDiskExpansion", func() {
+	const guestCID = uint32(42)
+
+	var (
+		virtFakeClient *kubevirtfake.Clientset
+		vmiStore       cache.Store
+		service        *SystemService
+		callerCtx      context.Context
+	)
+
+	newService := func(featureGates ...string) *SystemService {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			DeveloperConfiguration: &virtv1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		return NewSystemService(nil, vmiStore, virtClient, config)
+	}
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		_, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newGuestVMI := func(opts ...libvmi.Option) *virtv1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithPersistentVolumeClaim("data", "data-pvc"),
+			libvmi.WithContainerDisk("scratch", "scratch-image"),
+			libvmi.WithAnnotation(virtv1.AllowGuestDiskExpansionAnnotation, "true"),
+		}, opts...)
+		vmi := libvmi.New(opts...)
+		vmi.Status.Phase = virtv1.Running
+		vmi.Status.VSOCKCID = pointer.P(guestCID)
+		return vmi
+	}
+
+	BeforeEach(func() {
+		virtFakeClient = kubevirtfake.NewSimpleClientset()
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		service = newService(featuregate.GuestDiskExpansionGate)
+		callerCtx = peer.NewContext(context.Background(), &peer.Peer{Addr: &vsock.Addr{ContextID: guestCID, Port: 1024}})
+	})
+
+	expectCode := func(err error, code codes.Code) {
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(code))
+	}
+
+	It("should record a pending request on the VMI of the caller", func() {
+		vmi := newGuestVMI()
+		addVMI(vmi)
+
+		_, err := service.RequestDiskExpansion(callerCtx, &v1.DiskExpansionRequest{Disk: "data", Size: 20 * 1024 * 1024 * 1024})
+		Expect(err).ToNot(HaveOccurred())
+
+		updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedVMI.Status.DiskExpansionRequests).To(HaveLen(1))
+		Expect(updatedVMI.Status.DiskExpansionRequests[0].Name).To(Equal("data"))
+		Expect(updatedVMI.Status.DiskExpansionRequests[0].Size.Cmp(resource.MustParse("20Gi"))).To(BeZero())
+		Expect(updatedVMI.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestPending))
+	})
+
+	It("should replace an earlier request for the same disk", func() {
+		vmi := newGuestVMI()
+		vmi.Status.DiskExpansionRequests = []virtv1.DiskExpansionRequest{{
+			Name:  "data",
+			Size:  resource.MustParse("10Gi"),
+			Phase: virtv1.DiskExpansionRequestAccepted,
+		}}
+		addVMI(vmi)
+
+		_, err := service.RequestDiskExpansion(callerCtx, &v1.DiskExpansionRequest{Disk: "data", Size: 20 * 1024 * 1024 * 1024})
+		Expect(err).ToNot(HaveOccurred())
+
+		updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedVMI.Status.DiskExpansionRequests).To(HaveLen(1))
+		Expect(updatedVMI.Status.DiskExpansionRequests[0].Phase).To(Equal(virtv1.DiskExpansionRequestPending))
+	})
+
+	It("should refuse requests when the feature gate is disabled", func() {
+		addVMI(newGuestVMI())
+		service = newService()
+
+		_, err := service.RequestDiskExpansion(callerCtx, &v1.DiskExpansionRequest{Disk: "data", Size: 1024})
+		expectCode(err, codes.FailedPrecondition)
+	})
+
+	It("should refuse requests of VMIs which did not opt in", func() {
+		vmi := newGuestVMI()
+		delete(vmi.Annotations, virtv1.AllowGuestDiskExpansionAnnotation)
+		addVMI(vmi)
+
+		_, err := service.RequestDiskExpansion(callerCtx, &v1.DiskExpansionRequest{Disk: "data", Size: 1024})
+		expectCode(err, codes.PermissionDenied)
+	})
+
+	It("should refuse requests from an unknown CID", func() {
+		addVMI(newGuestVMI())
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &vsock.Addr{ContextID: guestCID + 1, Port: 1024}})
+
+		_, err := service.RequestDiskExpansion(ctx, &v1.DiskExpansionRequest{Disk: "data", Size: 1024})
+		expectCode(err, codes.NotFound)
+	})
+
+	It("should refuse requests for disks which are not backed by a PVC", func() {
+		addVMI(newGuestVMI())
+
+		_, err := service.RequestDiskExpansion(callerCtx, &v1.DiskExpansionRequest{Disk: "scratch", Size: 1024})
+		expectCode(err, codes.InvalidArgument)
+	})
+})
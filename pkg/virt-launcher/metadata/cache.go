@@ -32,6 +32,9 @@ type Cache struct {
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
 	Backup           SafeData[api.BackupMetadata]
+	Pause            SafeData[api.PauseMetadata]
+	Drift            SafeData[api.DriftMetadata]
+	DiskExpansion    SafeData[api.DiskExpansionMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -46,6 +49,9 @@ func NewCache() *Cache {
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
 	cache.Backup.dirtyChanel = cache.notificationSignal
+	cache.Pause.dirtyChanel = cache.notificationSignal
+	cache.Drift.dirtyChanel = cache.notificationSignal
+	cache.DiskExpansion.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
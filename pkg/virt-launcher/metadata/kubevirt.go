@@ -47,5 +47,14 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.Pause.Load(); exists {
+		kubevirtMetadata.Pause = &value
+	}
+	if value, exists := metadataCache.Drift.Load(); exists {
+		kubevirtMetadata.Drift = &value
+	}
+	if value, exists := metadataCache.DiskExpansion.Load(); exists {
+		kubevirtMetadata.DiskExpansion = &value
+	}
 	return kubevirtMetadata
 }
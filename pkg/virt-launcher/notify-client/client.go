@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -67,6 +69,8 @@ type libvirtEvent struct {
 	Event             *libvirt.DomainEventLifecycle
 	AgentEvent        *libvirt.DomainEventAgentLifecycle
 	JobCompletedEvent *libvirt.DomainEventJobCompleted
+	IOErrorEvent      *libvirt.DomainEventIOErrorReason
+	WatchdogEvent     *libvirt.DomainEventWatchdog
 }
 
 func NewNotifier(virtShareDir string) *Notifier {
@@ -245,6 +249,9 @@ func newWatchEventError(err error) watch.Event {
 type eventCaller struct {
 	domainStatus             api.LifeCycle
 	domainStatusChangeReason api.StateChangeReason
+	diskIOErrors             []api.DiskIOError
+	watchdogExpiry           *api.WatchdogExpiry
+	guestFilesystems         []api.Filesystem
 }
 
 func (e *eventCaller) printStatus(status *api.DomainStatus) {
@@ -261,6 +268,51 @@ func (e *eventCaller) updateStatus(status *api.DomainStatus) {
 	e.domainStatusChangeReason = status.Reason
 }
 
+// recordIOError counts the I/O errors per disk, since libvirt only keeps
+// the last error of each disk around.
+func (e *eventCaller) recordIOError(event *libvirt.DomainEventIOErrorReason) {
+	alias := strings.TrimPrefix(event.DevAlias, api.UserAliasPrefix)
+	for i := range e.diskIOErrors {
+		if e.diskIOErrors[i].Alias == alias {
+			e.diskIOErrors[i].Count++
+			e.diskIOErrors[i].LastReason = event.Reason
+			return
+		}
+	}
+	e.diskIOErrors = append(e.diskIOErrors, api.DiskIOError{Alias: alias, Count: 1, LastReason: event.Reason})
+}
+
+// recordWatchdogExpiry counts the expirations of the guest watchdog, so that
+// virt-handler can tell a new expiry from one it already handled.
+func (e *eventCaller) recordWatchdogExpiry(event *libvirt.DomainEventWatchdog) {
+	if e.watchdogExpiry == nil {
+		e.watchdogExpiry = &api.WatchdogExpiry{}
+	}
+	e.watchdogExpiry.Count++
+	e.watchdogExpiry.LastAction = watchdogActionName(event.Action)
+	e.watchdogExpiry.LastExpiry = metav1.Now()
+}
+
+func watchdogActionName(action libvirt.DomainEventWatchdogAction) string {
+	switch action {
+	case libvirt.DOMAIN_EVENT_WATCHDOG_NONE:
+		return "none"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_PAUSE:
+		return "pause"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_RESET:
+		return "reset"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_POWEROFF:
+		return "poweroff"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_SHUTDOWN:
+		return "shutdown"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_DEBUG:
+		return "debug"
+	case libvirt.DOMAIN_EVENT_WATCHDOG_INJECTNMI:
+		return "inject-nmi"
+	}
+	return "unknown"
+}
+
 type eventNotifier struct {
 	client *Notifier
 	domain *api.Domain
@@ -324,6 +376,17 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 		e.updateStatus(&domain.Status)
 	}
 
+	if libvirtEvent.IOErrorEvent != nil {
+		e.recordIOError(libvirtEvent.IOErrorEvent)
+	}
+	domain.Status.DiskIOErrors = slices.Clone(e.diskIOErrors)
+	if libvirtEvent.WatchdogEvent != nil {
+		e.recordWatchdogExpiry(libvirtEvent.WatchdogEvent)
+	}
+	if e.watchdogExpiry != nil {
+		domain.Status.WatchdogExpiry = e.watchdogExpiry.DeepCopy()
+	}
+
 	switch domain.Status.Reason {
 	case api.ReasonNonExistent:
 		now := metav1.Now()
@@ -372,6 +435,7 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 		if fsFreezeStatus != nil {
 			domain.Status.FSFreezeStatus = *fsFreezeStatus
 		}
+		domain.Status.Filesystems = e.guestFilesystems
 
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
@@ -434,6 +498,7 @@ func (n *Notifier) StartDomainNotifier(
 				interfaceStatuses = agentUpdate.DomainInfo.Interfaces
 				guestOsInfo = agentUpdate.DomainInfo.OSInfo
 				fsFreezeStatus = agentUpdate.DomainInfo.FSFreezeStatus
+				eventCaller.guestFilesystems = agentUpdate.DomainInfo.Filesystems
 
 				eventCaller.eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
 					interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
@@ -519,6 +584,42 @@ func (n *Notifier) StartDomainNotifier(
 			log.Log.Infof(libvirtEventChannelFull)
 		}
 	}
+	domainEventIOErrorReasonCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventIOErrorReason) {
+		log.Log.Warningf("Domain IO error event received for device %s: %s", event.DevAlias, event.Reason)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{IOErrorEvent: event, Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+	domainEventWatchdogCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventWatchdog) {
+		log.Log.Warningf("Domain watchdog event received with action %s", watchdogActionName(event.Action))
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{WatchdogEvent: event, Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+	domainEventBlockJobCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventBlockJob) {
+		log.Log.Infof("Domain Block Job event type %v with status %v received for disk %s", event.Type, event.Status, event.Disk)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
 	domainEventJobCompletedCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventJobCompleted) {
 		log.Log.Infof("Domain Job Completed event type %v received. Job operation: %v, succeeded: %t", event.Info.Type, event.Info.Operation, event.Info.JobSuccess)
 		name, err := d.GetName()
@@ -558,6 +659,21 @@ func (n *Notifier) StartDomainNotifier(
 		log.Log.Reason(err).Errorf("failed to register event job completed callback with libvirt")
 		return err
 	}
+	err = domainConn.DomainEventIOErrorReasonRegister(domainEventIOErrorReasonCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register io error event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventBlockJobRegister(domainEventBlockJobCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register block job event callback with libvirt")
+		return err
+	}
+	err = domainConn.DomainEventWatchdogRegister(domainEventWatchdogCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register watchdog event callback with libvirt")
+		return err
+	}
 
 	agentEventLifecycleCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventAgentLifecycle) {
 		log.Log.Infof("GuestAgentLifecycle event state %d with reason %d received", event.State, event.Reason)
@@ -253,6 +253,76 @@ var _ = Describe("Notify", func() {
 				}
 				Expect(timedOut).To(BeFalse())
 			})
+
+		It("should count disk IO errors", func() {
+			domain := api.NewMinimalDomain("test")
+			x, err := xml.Marshal(domain.Spec)
+			Expect(err).ToNot(HaveOccurred())
+			mockLibvirt.DomainEXPECT().Free().Times(2)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, -1, nil).Times(2)
+			mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+			mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil).Times(2)
+
+			ioErrorEvent := &libvirt.DomainEventIOErrorReason{DevAlias: "ua-rootdisk", Reason: "enospc"}
+			cache := metadataCache()
+			for range 2 {
+				e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{IOErrorEvent: ioErrorEvent}, client, deleteNotificationSent, nil, nil, nil, nil, cache)
+			}
+
+			var event watch.Event
+			for range 2 {
+				Eventually(eventChan).WithTimeout(2 * time.Second).Should(Receive(&event))
+			}
+			newDomain, _ := event.Object.(*api.Domain)
+			Expect(newDomain.Status.DiskIOErrors).To(Equal([]api.DiskIOError{
+				{Alias: "rootdisk", Count: 2, LastReason: "enospc"},
+			}))
+		})
+
+		It("should count guest watchdog expirations", func() {
+			domain := api.NewMinimalDomain("test")
+			x, err := xml.Marshal(domain.Spec)
+			Expect(err).ToNot(HaveOccurred())
+			mockLibvirt.DomainEXPECT().Free().Times(3)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, -1, nil).Times(3)
+			mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+			mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil).Times(3)
+
+			cache := metadataCache()
+			e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{WatchdogEvent: &libvirt.DomainEventWatchdog{Action: libvirt.DOMAIN_EVENT_WATCHDOG_RESET}}, client, deleteNotificationSent, nil, nil, nil, nil, cache)
+			e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{WatchdogEvent: &libvirt.DomainEventWatchdog{Action: libvirt.DOMAIN_EVENT_WATCHDOG_POWEROFF}}, client, deleteNotificationSent, nil, nil, nil, nil, cache)
+			// unrelated events keep reporting the last expiry
+			e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, nil, nil, nil, cache)
+
+			var event watch.Event
+			for range 3 {
+				Eventually(eventChan).WithTimeout(2 * time.Second).Should(Receive(&event))
+			}
+			newDomain, _ := event.Object.(*api.Domain)
+			Expect(newDomain.Status.WatchdogExpiry).ToNot(BeNil())
+			Expect(newDomain.Status.WatchdogExpiry.Count).To(BeEquivalentTo(2))
+			Expect(newDomain.Status.WatchdogExpiry.LastAction).To(Equal("poweroff"))
+			Expect(newDomain.Status.WatchdogExpiry.LastExpiry.IsZero()).To(BeFalse())
+		})
+
+		It("should report the guest filesystems", func() {
+			domain := api.NewMinimalDomain("test")
+			x, err := xml.Marshal(domain.Spec)
+			Expect(err).ToNot(HaveOccurred())
+			mockLibvirt.DomainEXPECT().Free()
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, -1, nil)
+			mockLibvirt.DomainEXPECT().GetName().Return("test", nil).AnyTimes()
+			mockLibvirt.DomainEXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil)
+
+			filesystems := []api.Filesystem{{Name: "vda1", Mountpoint: "/", Type: "ext4", UsedBytes: 900, TotalBytes: 1000}}
+			e.guestFilesystems = filesystems
+			e.eventCallback(mockLibvirt.VirtConnection, util.NewDomainFromName("test", "1234"), libvirtEvent{}, client, deleteNotificationSent, nil, nil, nil, nil, metadataCache())
+
+			var event watch.Event
+			Eventually(eventChan).WithTimeout(2 * time.Second).Should(Receive(&event))
+			newDomain, _ := event.Object.(*api.Domain)
+			Expect(newDomain.Status.Filesystems).To(Equal(filesystems))
+		})
 	})
 
 	Describe("K8s Events", func() {
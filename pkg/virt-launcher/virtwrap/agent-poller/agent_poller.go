@@ -46,8 +46,17 @@ const (
 	GetFSFreezeStatus AgentCommand = "guest-fsfreeze-status"
 
 	pollInitialInterval = 10 * time.Second
+
+	// reportedFilesystems keys the filesystems which were last sent with an AgentUpdatedEvent
+	reportedFilesystems storeKey = "reported-filesystems"
+
+	// filesystemUsageHysteresisPercent is the change of the used capacity of a
+	// filesystem, in percent of its capacity, from which the change is reported
+	filesystemUsageHysteresisPercent = 1
 )
 
+type storeKey string
+
 // AgentUpdatedEvent fire up when data is changes in the store
 type AgentUpdatedEvent struct {
 	DomainInfo api.DomainGuestInfo
@@ -78,21 +87,61 @@ func (s *AsyncAgentStore) Store(key, value any) {
 
 	s.store.Store(key, value)
 
-	domainInfo := api.DomainGuestInfo{}
 	switch key {
 	case libvirt.DOMAIN_GUEST_INFO_OS, libvirt.DOMAIN_GUEST_INFO_INTERFACES, GetFSFreezeStatus:
 		updated := (oldData == nil) || !equality.Semantic.DeepEqual(oldData, value)
 		if !updated {
 			return
 		}
-		domainInfo.OSInfo = s.GetGuestOSInfo()
-		domainInfo.Interfaces = s.GetInterfaceStatus()
-		domainInfo.FSFreezeStatus = s.GetFSFreezeStatus()
+	case GetFilesystem:
+		// The used capacity changes all the time, only significant changes are reported
+		reported, ok := s.store.Load(reportedFilesystems)
+		if ok && !filesystemsChanged(reported.([]api.Filesystem), value.([]api.Filesystem)) {
+			return
+		}
+		s.store.Store(reportedFilesystems, value)
+	default:
+		return
+	}
 
-		s.AgentUpdated <- AgentUpdatedEvent{
-			DomainInfo: domainInfo,
+	s.AgentUpdated <- AgentUpdatedEvent{
+		DomainInfo: api.DomainGuestInfo{
+			OSInfo:         s.GetGuestOSInfo(),
+			Interfaces:     s.GetInterfaceStatus(),
+			FSFreezeStatus: s.GetFSFreezeStatus(),
+			Filesystems:    s.getReportedFilesystems(),
+		},
+	}
+}
+
+func (s *AsyncAgentStore) getReportedFilesystems() []api.Filesystem {
+	data, ok := s.store.Load(reportedFilesystems)
+	if !ok {
+		return nil
+	}
+	return data.([]api.Filesystem)
+}
+
+// filesystemsChanged tells whether filesystems were mounted or unmounted, were
+// resized or whether their used capacity changed by more than the hysteresis
+func filesystemsChanged(reported, current []api.Filesystem) bool {
+	if len(reported) != len(current) {
+		return true
+	}
+	for i := range current {
+		if reported[i].Mountpoint != current[i].Mountpoint || reported[i].Name != current[i].Name ||
+			reported[i].TotalBytes != current[i].TotalBytes {
+			return true
+		}
+		delta := current[i].UsedBytes - reported[i].UsedBytes
+		if delta < 0 {
+			delta = -delta
+		}
+		if delta*100 >= current[i].TotalBytes*filesystemUsageHysteresisPercent {
+			return true
 		}
 	}
+	return false
 }
 
 // GetSysInfo returns the sysInfo information packed together.
@@ -222,6 +222,43 @@ var _ = Describe("Qemu agent poller", func() {
 				DomainInfo: api.DomainGuestInfo{},
 			})))
 		})
+
+		Context("with guest filesystems", func() {
+			newFilesystems := func(usedBytes int) []api.Filesystem {
+				return []api.Filesystem{{Name: "vda1", Mountpoint: "/", Type: "ext4", UsedBytes: usedBytes, TotalBytes: 1000}}
+			}
+
+			BeforeEach(func() {
+				agentStore.Store(GetFilesystem, newFilesystems(500))
+				Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+					DomainInfo: api.DomainGuestInfo{Filesystems: newFilesystems(500)},
+				})))
+			})
+
+			It("should not fire an event when the usage changes by less than the hysteresis", func() {
+				agentStore.Store(GetFilesystem, newFilesystems(505))
+				Expect(agentStore.AgentUpdated).ToNot(Receive())
+				Expect(agentStore.GetFS(-1)).To(Equal(newFilesystems(505)))
+			})
+
+			It("should fire an event once the usage changes add up to the hysteresis", func() {
+				agentStore.Store(GetFilesystem, newFilesystems(505))
+				Expect(agentStore.AgentUpdated).ToNot(Receive())
+
+				agentStore.Store(GetFilesystem, newFilesystems(490))
+				Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+					DomainInfo: api.DomainGuestInfo{Filesystems: newFilesystems(490)},
+				})))
+			})
+
+			It("should fire an event when a filesystem is mounted", func() {
+				filesystems := append(newFilesystems(500), api.Filesystem{Name: "vdb", Mountpoint: "/data", TotalBytes: 2000})
+				agentStore.Store(GetFilesystem, filesystems)
+				Expect(agentStore.AgentUpdated).To(Receive(Equal(AgentUpdatedEvent{
+					DomainInfo: api.DomainGuestInfo{Filesystems: filesystems},
+				})))
+			})
+		})
 	})
 
 	Context("PollerWorker", func() {
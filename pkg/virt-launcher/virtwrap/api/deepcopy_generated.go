@@ -975,6 +975,13 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = make([]RedirectedDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.Smartcards != nil {
+		in, out := &in.Smartcards, &out.Smartcards
+		*out = make([]Smartcard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SoundCards != nil {
 		in, out := &in.SoundCards, &out.SoundCards
 		*out = make([]SoundCard, len(*in))
@@ -985,18 +992,34 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.TPMs != nil {
 		in, out := &in.TPMs, &out.TPMs
 		*out = make([]TPM, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.VSOCK != nil {
 		in, out := &in.VSOCK, &out.VSOCK
 		*out = new(VSOCK)
 		**out = **in
 	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = new(MemoryDevice)
+	if in.MemoryDevices != nil {
+		in, out := &in.MemoryDevices, &out.MemoryDevices
+		*out = make([]MemoryDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IOMMU != nil {
+		in, out := &in.IOMMU, &out.IOMMU
+		*out = new(IOMMU)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedMemories != nil {
+		in, out := &in.SharedMemories, &out.SharedMemories
+		*out = make([]SharedMemory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1020,6 +1043,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskDriver)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	if in.ReadOnly != nil {
 		in, out := &in.ReadOnly, &out.ReadOnly
 		*out = new(ReadOnly)
@@ -1135,6 +1163,38 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskExpansionMetadata) DeepCopyInto(out *DiskExpansionMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskExpansionMetadata.
+func (in *DiskExpansionMetadata) DeepCopy() *DiskExpansionMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskExpansionMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOError) DeepCopyInto(out *DiskIOError) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOError.
+func (in *DiskIOError) DeepCopy() *DiskIOError {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThread) DeepCopyInto(out *DiskIOThread) {
 	*out = *in
@@ -1172,6 +1232,22 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1363,6 +1439,13 @@ func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 		*out = new(FSFreeze)
 		**out = **in
 	}
+	if in.Filesystems != nil {
+		in, out := &in.Filesystems, &out.Filesystems
+		*out = make([]Filesystem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1409,6 +1492,33 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(SnapshotMemory)
+		**out = **in
+	}
+	if in.SnapshotDisks != nil {
+		in, out := &in.SnapshotDisks, &out.SnapshotDisks
+		*out = new(SnapshotDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshot.
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -1435,6 +1545,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(SysInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FWCfg != nil {
+		in, out := &in.FWCfg, &out.FWCfg
+		*out = new(FWCfg)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Devices.DeepCopyInto(&out.Devices)
 	if in.Clock != nil {
 		in, out := &in.Clock, &out.Clock
@@ -1486,7 +1601,7 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	if in.LaunchSecurity != nil {
 		in, out := &in.LaunchSecurity, &out.LaunchSecurity
 		*out = new(LaunchSecurity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1513,6 +1628,23 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	}
 	out.OSInfo = in.OSInfo
 	out.FSFreezeStatus = in.FSFreezeStatus
+	if in.DiskIOErrors != nil {
+		in, out := &in.DiskIOErrors, &out.DiskIOErrors
+		*out = make([]DiskIOError, len(*in))
+		copy(*out, *in)
+	}
+	if in.WatchdogExpiry != nil {
+		in, out := &in.WatchdogExpiry, &out.WatchdogExpiry
+		*out = new(WatchdogExpiry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filesystems != nil {
+		in, out := &in.Filesystems, &out.Filesystems
+		*out = make([]Filesystem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1544,6 +1676,22 @@ func (in *DomainSysInfo) DeepCopy() *DomainSysInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftMetadata) DeepCopyInto(out *DriftMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftMetadata.
+func (in *DriftMetadata) DeepCopy() *DriftMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Entry) DeepCopyInto(out *Entry) {
 	*out = *in
@@ -1608,6 +1756,43 @@ func (in *FSFreeze) DeepCopy() *FSFreeze {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FWCfg) DeepCopyInto(out *FWCfg) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]FWCfgEntry, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FWCfg.
+func (in *FWCfg) DeepCopy() *FWCfg {
+	if in == nil {
+		return nil
+	}
+	out := new(FWCfg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FWCfgEntry) DeepCopyInto(out *FWCfgEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FWCfgEntry.
+func (in *FWCfgEntry) DeepCopy() *FWCfgEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(FWCfgEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureEnabled) DeepCopyInto(out *FeatureEnabled) {
 	*out = *in
@@ -1710,6 +1895,22 @@ func (in *FeatureHyperv) DeepCopy() *FeatureHyperv {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureIOAPIC) DeepCopyInto(out *FeatureIOAPIC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureIOAPIC.
+func (in *FeatureIOAPIC) DeepCopy() *FeatureIOAPIC {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureIOAPIC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureKVM) DeepCopyInto(out *FeatureKVM) {
 	*out = *in
@@ -1723,6 +1924,11 @@ func (in *FeatureKVM) DeepCopyInto(out *FeatureKVM) {
 		*out = new(FeatureState)
 		**out = **in
 	}
+	if in.PollControl != nil {
+		in, out := &in.PollControl, &out.PollControl
+		*out = new(FeatureState)
+		**out = **in
+	}
 	return
 }
 
@@ -1848,6 +2054,16 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(FeatureState)
 		**out = **in
 	}
+	if in.PS2 != nil {
+		in, out := &in.PS2, &out.PS2
+		*out = new(FeatureState)
+		**out = **in
+	}
+	if in.IOAPIC != nil {
+		in, out := &in.IOAPIC, &out.IOAPIC
+		*out = new(FeatureIOAPIC)
+		**out = **in
+	}
 	return
 }
 
@@ -1895,6 +2111,11 @@ func (in *FilesystemBinary) DeepCopyInto(out *FilesystemBinary) {
 		*out = new(FilesystemBinaryLock)
 		**out = **in
 	}
+	if in.ThreadPool != nil {
+		in, out := &in.ThreadPool, &out.ThreadPool
+		*out = new(FilesystemBinaryThreadPool)
+		**out = **in
+	}
 	return
 }
 
@@ -1940,6 +2161,22 @@ func (in *FilesystemBinaryLock) DeepCopy() *FilesystemBinaryLock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemBinaryThreadPool) DeepCopyInto(out *FilesystemBinaryThreadPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemBinaryThreadPool.
+func (in *FilesystemBinaryThreadPool) DeepCopy() *FilesystemBinaryThreadPool {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemBinaryThreadPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemDevice) DeepCopyInto(out *FilesystemDevice) {
 	*out = *in
@@ -1963,6 +2200,11 @@ func (in *FilesystemDevice) DeepCopyInto(out *FilesystemDevice) {
 		*out = new(FilesystemBinary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IDMap != nil {
+		in, out := &in.IDMap, &out.IDMap
+		*out = new(FilesystemIDMap)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1992,6 +2234,48 @@ func (in *FilesystemDriver) DeepCopy() *FilesystemDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemIDMap) DeepCopyInto(out *FilesystemIDMap) {
+	*out = *in
+	if in.UID != nil {
+		in, out := &in.UID, &out.UID
+		*out = make([]FilesystemIDMapRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GID != nil {
+		in, out := &in.GID, &out.GID
+		*out = make([]FilesystemIDMapRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemIDMap.
+func (in *FilesystemIDMap) DeepCopy() *FilesystemIDMap {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemIDMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemIDMapRange) DeepCopyInto(out *FilesystemIDMapRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemIDMapRange.
+func (in *FilesystemIDMapRange) DeepCopy() *FilesystemIDMapRange {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemIDMapRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilesystemSource) DeepCopyInto(out *FilesystemSource) {
 	*out = *in
@@ -2138,6 +2422,11 @@ func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 		*out = new(Alias)
 		**out = **in
 	}
+	if in.Teaming != nil {
+		in, out := &in.Teaming, &out.Teaming
+		*out = new(Teaming)
+		**out = **in
+	}
 	return
 }
 
@@ -2209,6 +2498,43 @@ func (in *HugePages) DeepCopy() *HugePages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOMMU) DeepCopyInto(out *IOMMU) {
+	*out = *in
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(IOMMUDriver)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOMMU.
+func (in *IOMMU) DeepCopy() *IOMMU {
+	if in == nil {
+		return nil
+	}
+	out := new(IOMMU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOMMUDriver) DeepCopyInto(out *IOMMUDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOMMUDriver.
+func (in *IOMMUDriver) DeepCopy() *IOMMUDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(IOMMUDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IOThreads) DeepCopyInto(out *IOThreads) {
 	*out = *in
@@ -2333,6 +2659,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Teaming != nil {
+		in, out := &in.Teaming, &out.Teaming
+		*out = new(Teaming)
+		**out = **in
+	}
 	return
 }
 
@@ -2370,6 +2701,16 @@ func (in *InterfaceDriver) DeepCopyInto(out *InterfaceDriver) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(InterfaceDriverHost)
+		**out = **in
+	}
+	if in.Guest != nil {
+		in, out := &in.Guest, &out.Guest
+		*out = new(InterfaceDriverGuest)
+		**out = **in
+	}
 	return
 }
 
@@ -2384,51 +2725,83 @@ func (in *InterfaceDriver) DeepCopy() *InterfaceDriver {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfacePortForward) DeepCopyInto(out *InterfacePortForward) {
+func (in *InterfaceDriverGuest) DeepCopyInto(out *InterfaceDriverGuest) {
 	*out = *in
-	if in.Ranges != nil {
-		in, out := &in.Ranges, &out.Ranges
-		*out = make([]InterfacePortForwardRange, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfacePortForward.
-func (in *InterfacePortForward) DeepCopy() *InterfacePortForward {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverGuest.
+func (in *InterfaceDriverGuest) DeepCopy() *InterfaceDriverGuest {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfacePortForward)
+	out := new(InterfaceDriverGuest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfacePortForwardRange) DeepCopyInto(out *InterfacePortForwardRange) {
+func (in *InterfaceDriverHost) DeepCopyInto(out *InterfaceDriverHost) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfacePortForwardRange.
-func (in *InterfacePortForwardRange) DeepCopy() *InterfacePortForwardRange {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceDriverHost.
+func (in *InterfaceDriverHost) DeepCopy() *InterfaceDriverHost {
 	if in == nil {
 		return nil
 	}
-	out := new(InterfacePortForwardRange)
+	out := new(InterfaceDriverHost)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InterfaceSource) DeepCopyInto(out *InterfaceSource) {
+func (in *InterfacePortForward) DeepCopyInto(out *InterfacePortForward) {
 	*out = *in
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(Address)
-		**out = **in
-	}
-	return
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]InterfacePortForwardRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfacePortForward.
+func (in *InterfacePortForward) DeepCopy() *InterfacePortForward {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfacePortForward)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfacePortForwardRange) DeepCopyInto(out *InterfacePortForwardRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfacePortForwardRange.
+func (in *InterfacePortForwardRange) DeepCopy() *InterfacePortForwardRange {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfacePortForwardRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSource) DeepCopyInto(out *InterfaceSource) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(Address)
+		**out = **in
+	}
+	return
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSource.
@@ -2506,6 +2879,21 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(PauseMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(DriftMetadata)
+		**out = **in
+	}
+	if in.DiskExpansion != nil {
+		in, out := &in.DiskExpansion, &out.DiskExpansion
+		*out = new(DiskExpansionMetadata)
+		**out = **in
+	}
 	return
 }
 
@@ -2522,6 +2910,11 @@ func (in *KubeVirtMetadata) DeepCopy() *KubeVirtMetadata {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 	*out = *in
+	if in.QuoteGenerationService != nil {
+		in, out := &in.QuoteGenerationService, &out.QuoteGenerationService
+		*out = new(QuoteGenerationService)
+		**out = **in
+	}
 	return
 }
 
@@ -2799,6 +3192,55 @@ func (in *MemoryBackingSource) DeepCopy() *MemoryBackingSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDIMM) DeepCopyInto(out *MemoryDIMM) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(MemoryDIMMTarget)
+		**out = **in
+	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(Alias)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(Address)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDIMM.
+func (in *MemoryDIMM) DeepCopy() *MemoryDIMM {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDIMM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDIMMTarget) DeepCopyInto(out *MemoryDIMMTarget) {
+	*out = *in
+	out.Size = in.Size
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDIMMTarget.
+func (in *MemoryDIMMTarget) DeepCopy() *MemoryDIMMTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDIMMTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryDevice) DeepCopyInto(out *MemoryDevice) {
 	*out = *in
@@ -2859,9 +3301,21 @@ func (in *MemoryDumpMetadata) DeepCopy() *MemoryDumpMetadata {
 func (in *MemoryTarget) DeepCopyInto(out *MemoryTarget) {
 	*out = *in
 	out.Size = in.Size
-	out.Requested = in.Requested
-	out.Current = in.Current
-	out.Block = in.Block
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = new(Memory)
+		**out = **in
+	}
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		*out = new(Memory)
+		**out = **in
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(Memory)
+		**out = **in
+	}
 	if in.Address != nil {
 		in, out := &in.Address, &out.Address
 		*out = new(MemoryAddress)
@@ -3170,6 +3624,42 @@ func (in *PanicDevice) DeepCopy() *PanicDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseMetadata) DeepCopyInto(out *PauseMetadata) {
+	*out = *in
+	if in.AutoUnpauseTimestamp != nil {
+		in, out := &in.AutoUnpauseTimestamp, &out.AutoUnpauseTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseMetadata.
+func (in *PauseMetadata) DeepCopy() *PauseMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuoteGenerationService) DeepCopyInto(out *QuoteGenerationService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuoteGenerationService.
+func (in *QuoteGenerationService) DeepCopy() *QuoteGenerationService {
+	if in == nil {
+		return nil
+	}
+	out := new(QuoteGenerationService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnly) DeepCopyInto(out *ReadOnly) {
 	*out = *in
@@ -3522,6 +4012,81 @@ func (in *Shareable) DeepCopy() *Shareable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedMemory) DeepCopyInto(out *SharedMemory) {
+	*out = *in
+	out.Model = in.Model
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(SharedMemorySize)
+		**out = **in
+	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(SharedMemoryServer)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedMemory.
+func (in *SharedMemory) DeepCopy() *SharedMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedMemoryModel) DeepCopyInto(out *SharedMemoryModel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedMemoryModel.
+func (in *SharedMemoryModel) DeepCopy() *SharedMemoryModel {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedMemoryModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedMemoryServer) DeepCopyInto(out *SharedMemoryServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedMemoryServer.
+func (in *SharedMemoryServer) DeepCopy() *SharedMemoryServer {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedMemoryServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedMemorySize) DeepCopyInto(out *SharedMemorySize) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedMemorySize.
+func (in *SharedMemorySize) DeepCopy() *SharedMemorySize {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedMemorySize)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Slice) DeepCopyInto(out *Slice) {
 	*out = *in
@@ -3555,6 +4120,101 @@ func (in *SliceType) DeepCopy() *SliceType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Smartcard) DeepCopyInto(out *Smartcard) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(SmartcardSource)
+		**out = **in
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Smartcard.
+func (in *Smartcard) DeepCopy() *Smartcard {
+	if in == nil {
+		return nil
+	}
+	out := new(Smartcard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmartcardSource) DeepCopyInto(out *SmartcardSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmartcardSource.
+func (in *SmartcardSource) DeepCopy() *SmartcardSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SmartcardSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotDisk) DeepCopyInto(out *SnapshotDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotDisk.
+func (in *SnapshotDisk) DeepCopy() *SnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotDisks) DeepCopyInto(out *SnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]SnapshotDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotDisks.
+func (in *SnapshotDisks) DeepCopy() *SnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotMemory) DeepCopyInto(out *SnapshotMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMemory.
+func (in *SnapshotMemory) DeepCopy() *SnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundCard) DeepCopyInto(out *SoundCard) {
 	*out = *in
@@ -3668,7 +4328,7 @@ func (in *SysInfo) DeepCopy() *SysInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPM) DeepCopyInto(out *TPM) {
 	*out = *in
-	out.Backend = in.Backend
+	in.Backend.DeepCopyInto(&out.Backend)
 	return
 }
 
@@ -3685,6 +4345,11 @@ func (in *TPM) DeepCopy() *TPM {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPMBackend) DeepCopyInto(out *TPMBackend) {
 	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(TPMBackendEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -3698,6 +4363,38 @@ func (in *TPMBackend) DeepCopy() *TPMBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMBackendEncryption) DeepCopyInto(out *TPMBackendEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMBackendEncryption.
+func (in *TPMBackendEncryption) DeepCopy() *TPMBackendEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMBackendEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Teaming) DeepCopyInto(out *Teaming) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Teaming.
+func (in *Teaming) DeepCopy() *Teaming {
+	if in == nil {
+		return nil
+	}
+	out := new(Teaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -3856,6 +4553,11 @@ func (in *VideoModel) DeepCopyInto(out *VideoModel) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(VideoResolution)
+		**out = **in
+	}
 	return
 }
 
@@ -3869,6 +4571,22 @@ func (in *VideoModel) DeepCopy() *VideoModel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoResolution) DeepCopyInto(out *VideoResolution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoResolution.
+func (in *VideoResolution) DeepCopy() *VideoResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
@@ -3894,3 +4612,20 @@ func (in *Watchdog) DeepCopy() *Watchdog {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchdogExpiry) DeepCopyInto(out *WatchdogExpiry) {
+	*out = *in
+	in.LastExpiry.DeepCopyInto(&out.LastExpiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchdogExpiry.
+func (in *WatchdogExpiry) DeepCopy() *WatchdogExpiry {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchdogExpiry)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -117,6 +117,9 @@ type DomainStatus struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         GuestOSInfo
 	FSFreezeStatus FSFreeze
+	DiskIOErrors   []DiskIOError
+	WatchdogExpiry *WatchdogExpiry
+	Filesystems    []Filesystem
 }
 
 type DomainSysInfo struct {
@@ -136,6 +139,20 @@ type GuestOSInfo struct {
 	Id            string
 }
 
+// DiskIOError counts the I/O errors libvirt reported for a disk alias
+type DiskIOError struct {
+	Alias      string
+	Count      int64
+	LastReason string
+}
+
+// WatchdogExpiry counts how often the guest watchdog expired since the domain started
+type WatchdogExpiry struct {
+	Count      int64
+	LastAction string
+	LastExpiry metav1.Time
+}
+
 type InterfaceStatus struct {
 	Mac           string
 	Ip            string
@@ -182,6 +199,7 @@ type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
 	OSInfo         *GuestOSInfo
 	FSFreezeStatus *FSFreeze
+	Filesystems    []Filesystem
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -206,6 +224,7 @@ type DomainSpec struct {
 	MemoryBacking  *MemoryBacking  `xml:"memoryBacking,omitempty"`
 	OS             OS              `xml:"os"`
 	SysInfo        *SysInfo        `xml:"sysinfo,omitempty"`
+	FWCfg          *FWCfg          `xml:"fwcfg,omitempty"`
 	Devices        Devices         `xml:"devices"`
 	Clock          *Clock          `xml:"clock,omitempty"`
 	Resource       *Resource       `xml:"resource,omitempty"`
@@ -313,6 +332,12 @@ type Features struct {
 	PVSpinlock *FeaturePVSpinlock `xml:"pvspinlock,omitempty"`
 	PMU        *FeatureState      `xml:"pmu,omitempty"`
 	VMPort     *FeatureState      `xml:"vmport,omitempty"`
+	PS2        *FeatureState      `xml:"ps2,omitempty"`
+	IOAPIC     *FeatureIOAPIC     `xml:"ioapic,omitempty"`
+}
+
+type FeatureIOAPIC struct {
+	Driver string `xml:"driver,attr"`
 }
 
 const HypervModePassthrough = "passthrough"
@@ -376,6 +401,7 @@ type FeatureState struct {
 type FeatureKVM struct {
 	Hidden        *FeatureState `xml:"hidden,omitempty"`
 	HintDedicated *FeatureState `xml:"hint-dedicated,omitempty"`
+	PollControl   *FeatureState `xml:"poll-control,omitempty"`
 }
 
 type Metadata struct {
@@ -391,6 +417,30 @@ type KubeVirtMetadata struct {
 	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Pause            *PauseMetadata            `xml:"pause,omitempty"`
+	Drift            *DriftMetadata            `xml:"drift,omitempty"`
+	DiskExpansion    *DiskExpansionMetadata    `xml:"diskExpansion,omitempty"`
+}
+
+// DiskExpansionMetadata reports the disks whose PVC grew but which could not
+// be expanded while the domain is running.
+type DiskExpansionMetadata struct {
+	// PendingDisks is a comma separated list of the disks which only get
+	// their new size once the domain is restarted.
+	PendingDisks string `xml:"pendingDisks,omitempty"`
+}
+
+// DriftMetadata reports the parts of the live domain which deviate from
+// the domain generated for the VMI, e.g. because of hook sidecars or manual
+// edits through virsh.
+type DriftMetadata struct {
+	// Fields is a comma separated list of the drifted domain properties.
+	// It is empty when the live domain matches the expected one.
+	Fields string `xml:"fields,omitempty"`
+}
+
+type PauseMetadata struct {
+	AutoUnpauseTimestamp *metav1.Time `xml:"autoUnpauseTimestamp,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -478,6 +528,27 @@ type CheckpointParent struct {
 	Name string `xml:"name"`
 }
 
+// DomainSnapshot mirroring libvirt XML under https://libvirt.org/formatsnapshot.html#snapshot-xml
+type DomainSnapshot struct {
+	XMLName       xml.Name        `xml:"domainsnapshot"`
+	Memory        *SnapshotMemory `xml:"memory"`
+	SnapshotDisks *SnapshotDisks  `xml:"disks"`
+}
+
+type SnapshotMemory struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type SnapshotDisks struct {
+	Disks []SnapshotDisk `xml:"disk"`
+}
+
+type SnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
 type Commandline struct {
 	QEMUEnv []Env `xml:"qemu:env,omitempty"`
 	QEMUArg []Arg `xml:"qemu:arg,omitempty"`
@@ -555,10 +626,10 @@ type MemoryAddress struct {
 
 type MemoryTarget struct {
 	Size      Memory         `xml:"size"`
-	Requested Memory         `xml:"requested"`
-	Current   Memory         `xml:"current"`
-	Node      string         `xml:"node"`
-	Block     Memory         `xml:"block"`
+	Requested *Memory        `xml:"requested,omitempty"`
+	Current   *Memory        `xml:"current,omitempty"`
+	Node      string         `xml:"node,omitempty"`
+	Block     *Memory        `xml:"block,omitempty"`
 	Address   *MemoryAddress `xml:"address,omitempty"`
 }
 
@@ -570,28 +641,76 @@ type MemoryDevice struct {
 	Address *Address      `xml:"address,omitempty"`
 }
 
+// MemoryDIMM is a DIMM, hot plugged in guests which do not support virtio-mem
+type MemoryDIMM struct {
+	XMLName xml.Name          `xml:"memory"`
+	Model   string            `xml:"model,attr"`
+	Target  *MemoryDIMMTarget `xml:"target"`
+	Alias   *Alias            `xml:"alias,omitempty"`
+	Address *Address          `xml:"address,omitempty"`
+}
+
+type MemoryDIMMTarget struct {
+	Size Memory `xml:"size"`
+	Node string `xml:"node"`
+}
+
 type Devices struct {
-	Emulator     string             `xml:"emulator,omitempty"`
-	Interfaces   []Interface        `xml:"interface"`
-	Channels     []Channel          `xml:"channel"`
-	HostDevices  []HostDevice       `xml:"hostdev,omitempty"`
-	PanicDevices []PanicDevice      `xml:"panic,omitempty"`
-	Controllers  []Controller       `xml:"controller,omitempty"`
-	Video        []Video            `xml:"video"`
-	Graphics     []Graphics         `xml:"graphics"`
-	Ballooning   *MemBalloon        `xml:"memballoon,omitempty"`
-	Disks        []Disk             `xml:"disk"`
-	Inputs       []Input            `xml:"input"`
-	Serials      []Serial           `xml:"serial"`
-	Consoles     []Console          `xml:"console"`
-	Watchdogs    []Watchdog         `xml:"watchdog,omitempty"`
-	Rng          *Rng               `xml:"rng,omitempty"`
-	Filesystems  []FilesystemDevice `xml:"filesystem,omitempty"`
-	Redirs       []RedirectedDevice `xml:"redirdev,omitempty"`
-	SoundCards   []SoundCard        `xml:"sound,omitempty"`
-	TPMs         []TPM              `xml:"tpm,omitempty"`
-	VSOCK        *VSOCK             `xml:"vsock,omitempty"`
-	Memory       *MemoryDevice      `xml:"memory,omitempty"`
+	Emulator       string             `xml:"emulator,omitempty"`
+	Interfaces     []Interface        `xml:"interface"`
+	Channels       []Channel          `xml:"channel"`
+	HostDevices    []HostDevice       `xml:"hostdev,omitempty"`
+	PanicDevices   []PanicDevice      `xml:"panic,omitempty"`
+	Controllers    []Controller       `xml:"controller,omitempty"`
+	Video          []Video            `xml:"video"`
+	Graphics       []Graphics         `xml:"graphics"`
+	Ballooning     *MemBalloon        `xml:"memballoon,omitempty"`
+	Disks          []Disk             `xml:"disk"`
+	Inputs         []Input            `xml:"input"`
+	Serials        []Serial           `xml:"serial"`
+	Consoles       []Console          `xml:"console"`
+	Watchdogs      []Watchdog         `xml:"watchdog,omitempty"`
+	Rng            *Rng               `xml:"rng,omitempty"`
+	Filesystems    []FilesystemDevice `xml:"filesystem,omitempty"`
+	Redirs         []RedirectedDevice `xml:"redirdev,omitempty"`
+	Smartcards     []Smartcard        `xml:"smartcard,omitempty"`
+	SoundCards     []SoundCard        `xml:"sound,omitempty"`
+	TPMs           []TPM              `xml:"tpm,omitempty"`
+	VSOCK          *VSOCK             `xml:"vsock,omitempty"`
+	MemoryDevices  []MemoryDevice     `xml:"memory,omitempty"`
+	IOMMU          *IOMMU             `xml:"iommu,omitempty"`
+	SharedMemories []SharedMemory     `xml:"shmem,omitempty"`
+}
+
+type SharedMemory struct {
+	Name   string              `xml:"name,attr"`
+	Model  SharedMemoryModel   `xml:"model"`
+	Size   *SharedMemorySize   `xml:"size,omitempty"`
+	Server *SharedMemoryServer `xml:"server,omitempty"`
+}
+
+type SharedMemoryModel struct {
+	Type string `xml:"type,attr"`
+}
+
+type SharedMemorySize struct {
+	Value uint64 `xml:",chardata"`
+	Unit  string `xml:"unit,attr,omitempty"`
+}
+
+type SharedMemoryServer struct {
+	Path string `xml:"path,attr,omitempty"`
+}
+
+type IOMMU struct {
+	Model  string       `xml:"model,attr"`
+	Driver *IOMMUDriver `xml:"driver,omitempty"`
+}
+
+type IOMMUDriver struct {
+	IntRemap    string `xml:"intremap,attr,omitempty"`
+	CachingMode string `xml:"caching_mode,attr,omitempty"`
+	EIM         string `xml:"eim,attr,omitempty"`
 }
 
 type PanicDevice struct {
@@ -604,9 +723,15 @@ type TPM struct {
 }
 
 type TPMBackend struct {
-	Type            string `xml:"type,attr"`
-	Version         string `xml:"version,attr"`
-	PersistentState string `xml:"persistent_state,attr,omitempty"`
+	Type            string                `xml:"type,attr"`
+	Version         string                `xml:"version,attr"`
+	PersistentState string                `xml:"persistent_state,attr,omitempty"`
+	Encryption      *TPMBackendEncryption `xml:"encryption,omitempty"`
+}
+
+// TPMBackendEncryption references the libvirt secret swtpm encrypts its state with
+type TPMBackendEncryption struct {
+	Secret string `xml:"secret,attr"`
 }
 
 // RedirectedDevice describes a device to be redirected
@@ -622,6 +747,19 @@ type RedirectedDeviceSource struct {
 	Path string `xml:"path,attr"`
 }
 
+type Smartcard struct {
+	Mode         string           `xml:"mode,attr"`
+	Type         string           `xml:"type,attr,omitempty"`
+	Source       *SmartcardSource `xml:"source,omitempty"`
+	Certificates []string         `xml:"certificate,omitempty"`
+	Database     string           `xml:"database,omitempty"`
+}
+
+type SmartcardSource struct {
+	Mode string `xml:"mode,attr"`
+	Path string `xml:"path,attr"`
+}
+
 type FilesystemDevice struct {
 	Type       string            `xml:"type,attr"`
 	AccessMode string            `xml:"accessMode,attr"`
@@ -629,6 +767,7 @@ type FilesystemDevice struct {
 	Target     *FilesystemTarget `xml:"target,omitempty"`
 	Driver     *FilesystemDriver `xml:"driver,omitempty"`
 	Binary     *FilesystemBinary `xml:"binary,omitempty"`
+	IDMap      *FilesystemIDMap  `xml:"idmap,omitempty"`
 }
 
 type FilesystemTarget struct {
@@ -646,10 +785,11 @@ type FilesystemDriver struct {
 }
 
 type FilesystemBinary struct {
-	Path  string                 `xml:"path,attr,omitempty"`
-	Xattr string                 `xml:"xattr,attr,omitempty"`
-	Cache *FilesystemBinaryCache `xml:"cache,omitempty"`
-	Lock  *FilesystemBinaryLock  `xml:"lock,omitempty"`
+	Path       string                      `xml:"path,attr,omitempty"`
+	Xattr      string                      `xml:"xattr,attr,omitempty"`
+	Cache      *FilesystemBinaryCache      `xml:"cache,omitempty"`
+	Lock       *FilesystemBinaryLock       `xml:"lock,omitempty"`
+	ThreadPool *FilesystemBinaryThreadPool `xml:"thread_pool,omitempty"`
 }
 
 type FilesystemBinaryCache struct {
@@ -661,6 +801,21 @@ type FilesystemBinaryLock struct {
 	Flock string `xml:"flock,attr,omitempty"`
 }
 
+type FilesystemBinaryThreadPool struct {
+	Size uint32 `xml:"size,attr"`
+}
+
+type FilesystemIDMap struct {
+	UID []FilesystemIDMapRange `xml:"uid,omitempty"`
+	GID []FilesystemIDMapRange `xml:"gid,omitempty"`
+}
+
+type FilesystemIDMapRange struct {
+	Start  uint32 `xml:"start,attr"`
+	Target uint32 `xml:"target,attr"`
+	Count  uint32 `xml:"count,attr"`
+}
+
 // Input represents input device, e.g. tablet
 type Input struct {
 	Type    v1.InputType `xml:"type,attr"`
@@ -683,6 +838,7 @@ type HostDevice struct {
 	Alias     *Alias           `xml:"alias,omitempty"`
 	Display   string           `xml:"display,attr,omitempty"`
 	RamFB     string           `xml:"ramfb,attr,omitempty"`
+	Teaming   *Teaming         `xml:"teaming,omitempty"`
 }
 
 type HostDeviceSource struct {
@@ -702,6 +858,8 @@ type Controller struct {
 	Alias     *Alias            `xml:"alias,omitempty"`
 	Address   *Address          `xml:"address,omitempty"`
 	PCIHole64 *PCIHole64        `xml:"pcihole64,omitempty"`
+	// Serial is required by nvme controllers, the namespaces on them have none of their own
+	Serial string `xml:"serial,omitempty"`
 }
 
 // END Controller -----------------------------
@@ -732,7 +890,9 @@ type Disk struct {
 	Source             DiskSource    `xml:"source"`
 	Target             DiskTarget    `xml:"target"`
 	Serial             string        `xml:"serial,omitempty"`
+	WWN                string        `xml:"wwn,omitempty"`
 	Driver             *DiskDriver   `xml:"driver,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 	ReadOnly           *ReadOnly     `xml:"readonly,omitempty"`
 	Auth               *DiskAuth     `xml:"auth,omitempty"`
 	Alias              *Alias        `xml:"alias,omitempty"`
@@ -747,6 +907,27 @@ type Disk struct {
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIOPSSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIOPSSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIOPSSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIOPSSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIOPSSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIOPSSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIOPSSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIOPSSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIOPSSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+}
+
 type DiskAuth struct {
 	Username string      `xml:"username,attr"`
 	Secret   *DiskSecret `xml:"secret,omitempty"`
@@ -779,16 +960,17 @@ type DiskTarget struct {
 }
 
 type DiskDriver struct {
-	Cache       string             `xml:"cache,attr,omitempty"`
-	ErrorPolicy v1.DiskErrorPolicy `xml:"error_policy,attr,omitempty"`
-	IO          v1.DriverIO        `xml:"io,attr,omitempty"`
-	Name        string             `xml:"name,attr"`
-	Type        string             `xml:"type,attr"`
-	IOThread    *uint              `xml:"iothread,attr,omitempty"`
-	IOThreads   *DiskIOThreads     `xml:"iothreads"`
-	Queues      *uint              `xml:"queues,attr,omitempty"`
-	Discard     string             `xml:"discard,attr,omitempty"`
-	IOMMU       string             `xml:"iommu,attr,omitempty"`
+	Cache        string             `xml:"cache,attr,omitempty"`
+	ErrorPolicy  v1.DiskErrorPolicy `xml:"error_policy,attr,omitempty"`
+	RerrorPolicy v1.DiskErrorPolicy `xml:"rerror_policy,attr,omitempty"`
+	IO           v1.DriverIO        `xml:"io,attr,omitempty"`
+	Name         string             `xml:"name,attr"`
+	Type         string             `xml:"type,attr"`
+	IOThread     *uint              `xml:"iothread,attr,omitempty"`
+	IOThreads    *DiskIOThreads     `xml:"iothreads"`
+	Queues       *uint              `xml:"queues,attr,omitempty"`
+	Discard      string             `xml:"discard,attr,omitempty"`
+	IOMMU        string             `xml:"iommu,attr,omitempty"`
 }
 
 type DiskIOThreads struct {
@@ -912,6 +1094,14 @@ type Interface struct {
 	ACPI                *ACPI                  `xml:"acpi,omitempty"`
 	Backend             *InterfaceBackend      `xml:"backend,omitempty"`
 	PortForward         []InterfacePortForward `xml:"portForward,omitempty"`
+	Teaming             *Teaming               `xml:"teaming,omitempty"`
+}
+
+// Teaming pairs a virtio interface with a passed-through network device, using the
+// virtio-net failover mechanism https://libvirt.org/formatdomain.html#teaming-a-virtio-hostdev-nic-pair
+type Teaming struct {
+	Type       string `xml:"type,attr"`
+	Persistent string `xml:"persistent,attr,omitempty"`
 }
 
 type InterfacePortForward struct {
@@ -938,9 +1128,26 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
-	Queues *uint  `xml:"queues,attr,omitempty"`
-	IOMMU  string `xml:"iommu,attr,omitempty"`
+	Name   string                `xml:"name,attr,omitempty"`
+	Queues *uint                 `xml:"queues,attr,omitempty"`
+	IOMMU  string                `xml:"iommu,attr,omitempty"`
+	Host   *InterfaceDriverHost  `xml:"host,omitempty"`
+	Guest  *InterfaceDriverGuest `xml:"guest,omitempty"`
+}
+
+// InterfaceDriverHost toggles the offloads of the host side of the interface
+type InterfaceDriverHost struct {
+	CSum string `xml:"csum,attr,omitempty"`
+	GSO  string `xml:"gso,attr,omitempty"`
+	TSO4 string `xml:"tso4,attr,omitempty"`
+	TSO6 string `xml:"tso6,attr,omitempty"`
+}
+
+// InterfaceDriverGuest toggles the offloads of the guest side of the interface
+type InterfaceDriverGuest struct {
+	CSum string `xml:"csum,attr,omitempty"`
+	TSO4 string `xml:"tso4,attr,omitempty"`
+	TSO6 string `xml:"tso6,attr,omitempty"`
 }
 
 type LinkState struct {
@@ -1125,6 +1332,58 @@ type Entry struct {
 	Value string `xml:",chardata"`
 }
 
+// MarshalXML leaves out the empty entry groups, which the libvirt schema
+// rejects.
+func (s SysInfo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type entries struct {
+		Entry []Entry `xml:"entry"`
+	}
+	group := func(e []Entry) *entries {
+		if len(e) == 0 {
+			return nil
+		}
+		return &entries{Entry: e}
+	}
+	return e.EncodeElement(struct {
+		Type      string   `xml:"type,attr"`
+		System    *entries `xml:"system,omitempty"`
+		BIOS      *entries `xml:"bios,omitempty"`
+		BaseBoard *entries `xml:"baseBoard,omitempty"`
+		Chassis   *entries `xml:"chassis,omitempty"`
+	}{
+		Type:      s.Type,
+		System:    group(s.System),
+		BIOS:      group(s.BIOS),
+		BaseBoard: group(s.BaseBoard),
+		Chassis:   group(s.Chassis),
+	}, start)
+}
+
+// FWCfg is the sysinfo of type fwcfg, which passes blobs to the guest
+// through the fw_cfg device.
+type FWCfg struct {
+	Entries []FWCfgEntry `xml:"entry"`
+}
+
+type FWCfgEntry struct {
+	Name  string `xml:"name,attr"`
+	File  string `xml:"file,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+// MarshalXML writes the fw_cfg blobs as a second sysinfo element, next to
+// the SMBIOS one.
+func (f FWCfg) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "sysinfo"
+	return e.EncodeElement(struct {
+		Type    string       `xml:"type,attr"`
+		Entries []FWCfgEntry `xml:"entry"`
+	}{
+		Type:    "fwcfg",
+		Entries: f.Entries,
+	}, start)
+}
+
 //END OS --------------------
 //BEGIN LaunchSecurity --------------------
 
@@ -1135,6 +1394,15 @@ type LaunchSecurity struct {
 	Cbitpos         string `xml:"cbitpos,omitempty"`
 	ReducedPhysBits string `xml:"reducedPhysBits,omitempty"`
 	Policy          string `xml:"policy,omitempty"`
+	// TDX measurement registers and quote generation
+	MrConfigID             string                  `xml:"mrConfigId,omitempty"`
+	MrOwner                string                  `xml:"mrOwner,omitempty"`
+	MrOwnerConfig          string                  `xml:"mrOwnerConfig,omitempty"`
+	QuoteGenerationService *QuoteGenerationService `xml:"quoteGenerationService,omitempty"`
+}
+
+type QuoteGenerationService struct {
+	Path string `xml:"path,attr,omitempty"`
 }
 
 //END LaunchSecurity --------------------
@@ -1201,11 +1469,19 @@ type VideoModel struct {
 	Ram    *uint  `xml:"ram,attr,omitempty"`
 	VRam   *uint  `xml:"vram,attr,omitempty"`
 	VGAMem *uint  `xml:"vgamem,attr,omitempty"`
+
+	Resolution *VideoResolution `xml:"resolution,omitempty"`
+}
+
+type VideoResolution struct {
+	X uint32 `xml:"x,attr"`
+	Y uint32 `xml:"y,attr"`
 }
 
 type Graphics struct {
 	AutoPort      string          `xml:"autoport,attr,omitempty"`
 	DefaultMode   string          `xml:"defaultMode,attr,omitempty"`
+	Keymap        string          `xml:"keymap,attr,omitempty"`
 	Listen        *GraphicsListen `xml:"listen,omitempty"`
 	PasswdValidTo string          `xml:"passwdValidTo,attr,omitempty"`
 	Port          int32           `xml:"port,attr,omitempty"`
@@ -1234,6 +1510,7 @@ type Address struct {
 	CSSID      string `xml:"cssid,attr,omitempty"`
 	SSID       string `xml:"ssid,attr,omitempty"`
 	DevNo      string `xml:"devno,attr,omitempty"`
+	Base       string `xml:"base,attr,omitempty"`
 }
 
 //END Video -------------------
@@ -1314,6 +1591,7 @@ type IOThreads struct {
 type SecretUsage struct {
 	Type   string `xml:"type,attr"`
 	Target string `xml:"target,omitempty"`
+	Name   string `xml:"name,omitempty"`
 }
 
 type SecretSpec struct {
@@ -1321,6 +1599,7 @@ type SecretSpec struct {
 	Ephemeral   string      `xml:"ephemeral,attr"`
 	Private     string      `xml:"private,attr"`
 	Description string      `xml:"description,omitempty"`
+	UUID        string      `xml:"uuid,omitempty"`
 	Usage       SecretUsage `xml:"usage,omitempty"`
 }
 
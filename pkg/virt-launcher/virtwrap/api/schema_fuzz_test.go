@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/randfill"
+)
+
+// FuzzDomainSpecRoundTrip fills a DomainSpec from the fuzz input and
+// expects it to survive marshalling and unmarshalling unchanged.
+func FuzzDomainSpecRoundTrip(f *testing.F) {
+	for seed := 0; seed < 20; seed++ {
+		f.Add([]byte(fmt.Sprintf("seed-%d", seed)))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		g := NewWithT(t)
+		spec := &DomainSpec{}
+		newSchemaFiller(randfill.NewFromGoFuzz(data)).Fill(spec)
+		sanitizeStrings(reflect.ValueOf(spec))
+		// qemu:commandline only round-trips with the namespace libvirt declares
+		spec.XmlNS = qemuNamespace
+		out, err := xml.Marshal(spec)
+		g.Expect(err).ToNot(HaveOccurred())
+		roundTripped := &DomainSpec{}
+		g.Expect(xml.Unmarshal(out, roundTripped)).To(Succeed())
+		clearXMLNames(reflect.ValueOf(spec))
+		clearXMLNames(reflect.ValueOf(roundTripped))
+		g.Expect(roundTripped).To(Equal(spec), cmp.Diff(spec, roundTripped, cmp.AllowUnexported(Alias{})))
+	})
+}
+
+// FuzzDomainSpecUnmarshal parses arbitrary domain XML, as read back from
+// libvirt, and expects the parsed domain to marshal to a stable document.
+func FuzzDomainSpecUnmarshal(f *testing.F) {
+	f.Add([]byte(exampleXML))
+	f.Add([]byte(`<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">` +
+		`<qemu:commandline><qemu:arg value="-fw_cfg"></qemu:arg><qemu:env name="FOO" value="bar"></qemu:env></qemu:commandline>` +
+		`</domain>`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		g := NewWithT(t)
+		spec := &DomainSpec{}
+		if err := xml.Unmarshal(data, spec); err != nil {
+			return
+		}
+		out, err := xml.Marshal(spec)
+		if err != nil {
+			return
+		}
+		reparsed := &DomainSpec{}
+		g.Expect(xml.Unmarshal(out, reparsed)).To(Succeed(), string(out))
+		reencoded, err := xml.Marshal(reparsed)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(reencoded)).To(Equal(string(out)))
+	})
+}
+
+const qemuNamespace = "http://libvirt.org/schemas/domain/qemu/1.0"
+
+const fuzzAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func newSchemaFiller(filler *randfill.Filler) *randfill.Filler {
+	return filler.NilChance(0).NumElements(1, 2).Funcs(
+		func(a *Alias, c randfill.Continue) {
+			name := randomToken(c)
+			if c.Bool() {
+				*a = *NewUserDefinedAlias(name)
+			} else {
+				*a = *NewNonUserDefinedAlias(name)
+			}
+		},
+		func(t *metav1.Time, c randfill.Continue) {
+			*t = metav1.Unix(int64(c.Int31()), 0).Rfc3339Copy()
+		},
+	)
+}
+
+var xmlNameType = reflect.TypeOf(xml.Name{})
+
+func clearXMLNames(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			clearXMLNames(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			clearXMLNames(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == xmlNameType {
+			v.Set(reflect.Zero(xmlNameType))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				clearXMLNames(v.Field(i))
+			}
+		}
+	}
+}
+
+func randomToken(c randfill.Continue) string {
+	b := make([]byte, 1+c.Intn(8))
+	for i := range b {
+		b[i] = fuzzAlphabet[c.Intn(len(fuzzAlphabet))]
+	}
+	return string(b)
+}
+
+func sanitizeStrings(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			sanitizeStrings(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeStrings(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				sanitizeStrings(v.Field(i))
+			}
+		}
+	case reflect.String:
+		if !v.CanSet() {
+			return
+		}
+		b := []byte(v.String())
+		for i := range b {
+			b[i] = fuzzAlphabet[int(b[i])%len(fuzzAlphabet)]
+		}
+		if len(b) == 0 {
+			b = []byte("x")
+		}
+		v.SetString(string(b))
+	}
+}
@@ -338,6 +338,81 @@ var _ = ginkgo.Describe("Schema", func() {
 			Expect(newDomain).To(Equal(*domain))
 		})
 	})
+
+	ginkgo.Context("With virtiofs filesystem", func() {
+		ginkgo.It("should unmarshal the options of virtiofsd", func() {
+			filesystemXML := `<filesystem type="mount" accessMode="passthrough">
+  <driver type="virtiofs" queue="1024"/>
+  <binary path="/usr/libexec/virtiofsd" xattr="on">
+    <cache mode="always"/>
+    <lock posix="on" flock="on"/>
+    <thread_pool size="16"/>
+  </binary>
+  <idmap>
+    <uid start="0" target="100000" count="65535"/>
+    <gid start="0" target="100000" count="65535"/>
+  </idmap>
+  <source dir="/path"/>
+  <target dir="mount_tag"/>
+</filesystem>`
+			var filesystem FilesystemDevice
+			Expect(xml.Unmarshal([]byte(filesystemXML), &filesystem)).To(Succeed())
+			Expect(filesystem).To(Equal(FilesystemDevice{
+				Type:       "mount",
+				AccessMode: "passthrough",
+				Driver:     &FilesystemDriver{Type: "virtiofs", Queue: "1024"},
+				Binary: &FilesystemBinary{
+					Path:       "/usr/libexec/virtiofsd",
+					Xattr:      "on",
+					Cache:      &FilesystemBinaryCache{Mode: "always"},
+					Lock:       &FilesystemBinaryLock{Posix: "on", Flock: "on"},
+					ThreadPool: &FilesystemBinaryThreadPool{Size: 16},
+				},
+				IDMap: &FilesystemIDMap{
+					UID: []FilesystemIDMapRange{{Start: 0, Target: 100000, Count: 65535}},
+					GID: []FilesystemIDMapRange{{Start: 0, Target: 100000, Count: 65535}},
+				},
+				Source: &FilesystemSource{Dir: "/path"},
+				Target: &FilesystemTarget{Dir: "mount_tag"},
+			}))
+
+			buf, err := xml.Marshal(filesystem)
+			Expect(err).ToNot(HaveOccurred())
+			var parsed FilesystemDevice
+			Expect(xml.Unmarshal(buf, &parsed)).To(Succeed())
+			Expect(parsed).To(Equal(filesystem))
+		})
+	})
+
+	ginkgo.Context("With an encrypted TPM", func() {
+		ginkgo.It("should marshal the encryption secret of the backend", func() {
+			tpm := TPM{
+				Model: "tpm-crb",
+				Backend: TPMBackend{
+					Type:            "emulator",
+					Version:         "2.0",
+					PersistentState: "yes",
+					Encryption:      &TPMBackendEncryption{Secret: "6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2"},
+				},
+			}
+			buf, err := xml.Marshal(tpm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(buf)).To(Equal(`<TPM model="tpm-crb"><backend type="emulator" version="2.0" persistent_state="yes">` +
+				`<encryption secret="6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2"></encryption></backend></TPM>`))
+		})
+
+		ginkgo.It("should marshal the secret of the TPM state", func() {
+			buf, err := xml.Marshal(SecretSpec{
+				Ephemeral: "yes",
+				Private:   "yes",
+				UUID:      "6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2",
+				Usage:     SecretUsage{Type: "vtpm", Name: "vtpm-6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(buf)).To(Equal(`<secret ephemeral="yes" private="yes"><uuid>6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2</uuid>` +
+				`<usage type="vtpm"><name>vtpm-6dd3e4a5-1d76-44fd-9e0e-2d1e35cd6bd2</name></usage></secret>`))
+		})
+	})
 })
 
 var testAliasName = "alias0"
@@ -534,3 +609,72 @@ var _ = ginkgo.Describe("LaunchSecurity SEV-SNP", func() {
 		})
 	})
 })
+
+var _ = ginkgo.Describe("LaunchSecurity TDX", func() {
+	ginkgo.It("should marshal TDX launch security with all fields", func() {
+		launchSecurity := &LaunchSecurity{
+			Type:          "tdx",
+			Policy:        "0x10000000",
+			MrConfigID:    "config",
+			MrOwner:       "owner",
+			MrOwnerConfig: "owner-config",
+			QuoteGenerationService: &QuoteGenerationService{
+				Path: "/var/run/tdx-qgs/qgs.socket",
+			},
+		}
+
+		xmlBytes, err := xml.Marshal(launchSecurity)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedXML := `<LaunchSecurity type="tdx"><policy>0x10000000</policy><mrConfigId>config</mrConfigId>` +
+			`<mrOwner>owner</mrOwner><mrOwnerConfig>owner-config</mrOwnerConfig>` +
+			`<quoteGenerationService path="/var/run/tdx-qgs/qgs.socket"></quoteGenerationService></LaunchSecurity>`
+		Expect(string(xmlBytes)).To(Equal(expectedXML))
+
+		var unmarshalled LaunchSecurity
+		Expect(xml.Unmarshal(xmlBytes, &unmarshalled)).To(Succeed())
+		Expect(unmarshalled).To(Equal(*launchSecurity))
+	})
+
+	ginkgo.It("should unmarshal a quote generation service without path", func() {
+		var unmarshalled LaunchSecurity
+		Expect(xml.Unmarshal([]byte(`<launchSecurity type="tdx"><quoteGenerationService/></launchSecurity>`), &unmarshalled)).To(Succeed())
+		Expect(unmarshalled).To(Equal(LaunchSecurity{
+			Type:                   "tdx",
+			QuoteGenerationService: &QuoteGenerationService{},
+		}))
+	})
+
+	ginkgo.It("should round-trip domain with TDX launch security", func() {
+		domain := NewMinimalDomainSpec("test-domain")
+		domain.LaunchSecurity = &LaunchSecurity{
+			Type:    "tdx",
+			MrOwner: "owner",
+		}
+
+		xmlBytes, err := xml.Marshal(domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(xmlBytes)).ToNot(ContainSubstring("quoteGenerationService"))
+
+		var parsed DomainSpec
+		Expect(xml.Unmarshal(xmlBytes, &parsed)).To(Succeed())
+		Expect(parsed.LaunchSecurity).To(Equal(domain.LaunchSecurity))
+	})
+})
+
+var _ = ginkgo.Describe("LaunchSecurity s390-pv", func() {
+	ginkgo.It("should round-trip domain with IBM Secure Execution", func() {
+		domain := NewMinimalDomainSpec("test-domain")
+		domain.LaunchSecurity = &LaunchSecurity{
+			Type: "s390-pv",
+		}
+
+		xmlBytes, err := xml.Marshal(domain)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(xmlBytes)).To(ContainSubstring(`<launchSecurity type="s390-pv"></launchSecurity>`))
+
+		var parsed DomainSpec
+		Expect(xml.Unmarshal(xmlBytes, &parsed)).To(Succeed())
+		Expect(parsed.LaunchSecurity).To(Equal(domain.LaunchSecurity))
+	})
+})
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package api
+
+import "encoding/xml"
+
+// sysInfo holds the entries of both sysinfo types, the SMBIOS tables and the
+// fw_cfg blobs.
+type sysInfo struct {
+	SysInfo
+	Entries []FWCfgEntry `xml:"entry"`
+}
+
+// UnmarshalXML resolves the qemu namespace declaration and the qemu:commandline
+// element, whose prefixed tags only match when marshalling. The sysinfo
+// elements are split into the SMBIOS and the fw_cfg ones.
+func (spec *DomainSpec) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// The alias drops this method and is exported for the decoder to fill it
+	type DomainSpecFields DomainSpec
+	aux := struct {
+		*DomainSpecFields
+		XmlNS    string       `xml:"xmlns qemu,attr"`
+		QEMUCmd  *Commandline `xml:"http://libvirt.org/schemas/domain/qemu/1.0 commandline"`
+		SysInfos []sysInfo    `xml:"sysinfo"`
+	}{DomainSpecFields: (*DomainSpecFields)(spec)}
+	if err := d.DecodeElement(&aux, &start); err != nil {
+		return err
+	}
+	spec.XMLName = start.Name
+	spec.XmlNS = aux.XmlNS
+	spec.QEMUCmd = aux.QEMUCmd
+	for i := range aux.SysInfos {
+		if aux.SysInfos[i].Type == "fwcfg" {
+			spec.FWCfg = &FWCfg{Entries: aux.SysInfos[i].Entries}
+		} else {
+			spec.SysInfo = &aux.SysInfos[i].SysInfo
+		}
+	}
+	return nil
+}
+
+// UnmarshalXML resolves the qemu prefix of the child elements, which is
+// declared as a namespace by libvirt and therefore does not match the tags
+// used for marshalling.
+func (c *Commandline) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var commandline struct {
+		QEMUEnv []Env `xml:"http://libvirt.org/schemas/domain/qemu/1.0 env"`
+		QEMUArg []Arg `xml:"http://libvirt.org/schemas/domain/qemu/1.0 arg"`
+	}
+	if err := d.DecodeElement(&commandline, &start); err != nil {
+		return err
+	}
+	*c = Commandline{QEMUEnv: commandline.QEMUEnv, QEMUArg: commandline.QEMUArg}
+	return nil
+}
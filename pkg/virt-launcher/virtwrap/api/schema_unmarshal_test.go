@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package api
+
+import (
+	"encoding/xml"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Schema unmarshalling", func() {
+	ginkgo.It("should parse the qemu commandline of a libvirt domain", func() {
+		domainXML := `<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">
+  <name>mynamespace_testvmi</name>
+  <qemu:commandline>
+    <qemu:arg value="-fw_cfg"></qemu:arg>
+    <qemu:env name="FOO" value="bar"></qemu:env>
+  </qemu:commandline>
+</domain>`
+		newDomain := DomainSpec{}
+		Expect(xml.Unmarshal([]byte(domainXML), &newDomain)).To(Succeed())
+		Expect(newDomain.XmlNS).To(Equal("http://libvirt.org/schemas/domain/qemu/1.0"))
+		Expect(newDomain.QEMUCmd).To(Equal(&Commandline{
+			QEMUArg: []Arg{{Value: "-fw_cfg"}},
+			QEMUEnv: []Env{{Name: "FOO", Value: "bar"}},
+		}))
+	})
+
+	ginkgo.It("should parse a domain without qemu commandline", func() {
+		newDomain := DomainSpec{}
+		Expect(xml.Unmarshal([]byte(`<domain type="kvm"><name>mynamespace_testvmi</name></domain>`), &newDomain)).To(Succeed())
+		Expect(newDomain.Name).To(Equal("mynamespace_testvmi"))
+		Expect(newDomain.QEMUCmd).To(BeNil())
+	})
+
+	ginkgo.It("should keep the SMBIOS and the fw_cfg sysinfo apart", func() {
+		spec := DomainSpec{
+			Name: "mynamespace_testvmi",
+			SysInfo: &SysInfo{
+				Type:   "smbios",
+				System: []Entry{{Name: "uuid", Value: "e4686d2c-6e8d-4335-b8fd-81bee22f4814"}},
+			},
+			FWCfg: &FWCfg{Entries: []FWCfgEntry{
+				{Name: "opt/io.kubevirt/token", File: "/var/run/kubevirt-private/secret/bootstrap-guest-secret/token"},
+			}},
+		}
+		domainXML, err := xml.Marshal(spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(domainXML)).To(ContainSubstring(`<sysinfo type="smbios"><system><entry name="uuid">e4686d2c-6e8d-4335-b8fd-81bee22f4814</entry></system></sysinfo>`))
+		Expect(string(domainXML)).To(ContainSubstring(`<sysinfo type="fwcfg"><entry name="opt/io.kubevirt/token" file="/var/run/kubevirt-private/secret/bootstrap-guest-secret/token"></entry></sysinfo>`))
+
+		newDomain := DomainSpec{}
+		Expect(xml.Unmarshal(domainXML, &newDomain)).To(Succeed())
+		Expect(newDomain.SysInfo).To(Equal(spec.SysInfo))
+		Expect(newDomain.FWCfg).To(Equal(spec.FWCfg))
+	})
+})
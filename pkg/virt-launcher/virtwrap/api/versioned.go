@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LibvirtVersion is a libvirt version in the encoding of virConnectGetLibVersion:
+// major * 1,000,000 + minor * 1,000 + release.
+type LibvirtVersion uint32
+
+func NewLibvirtVersion(major, minor, release uint32) LibvirtVersion {
+	return LibvirtVersion(major*1000000 + minor*1000 + release)
+}
+
+// ParseLibvirtVersion parses a version in the form "major.minor.release", the release is optional.
+func ParseLibvirtVersion(version string) (LibvirtVersion, error) {
+	parts := strings.Split(strings.TrimSpace(version), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid libvirt version %q", version)
+	}
+
+	var numbers [3]uint32
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || n >= 1000 {
+			return 0, fmt.Errorf("invalid libvirt version %q", version)
+		}
+		numbers[i] = uint32(n)
+	}
+	return NewLibvirtVersion(numbers[0], numbers[1], numbers[2]), nil
+}
+
+func (v LibvirtVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v/1000000, v/1000%1000, v%1000)
+}
+
+// schemaChange is an element of the domain XML which is only known since a given libvirt version.
+type schemaChange struct {
+	since       LibvirtVersion
+	description string
+	present     func(spec *DomainSpec) bool
+	// downgrade rewrites the spec for libvirt versions before since. It is nil if
+	// the element can not be dropped without changing the behaviour of the domain.
+	downgrade func(spec *DomainSpec)
+}
+
+var schemaChanges = []schemaChange{
+	{
+		since:       NewLibvirtVersion(6, 9, 0),
+		description: "memballoon freePageReporting",
+		present: func(spec *DomainSpec) bool {
+			return spec.Devices.Ballooning != nil && spec.Devices.Ballooning.FreePageReporting != ""
+		},
+		downgrade: func(spec *DomainSpec) {
+			spec.Devices.Ballooning.FreePageReporting = ""
+		},
+	},
+	{
+		since:       NewLibvirtVersion(7, 3, 0),
+		description: "interface acpi index",
+		present: func(spec *DomainSpec) bool {
+			for _, iface := range spec.Devices.Interfaces {
+				if iface.ACPI != nil {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		since:       NewLibvirtVersion(9, 0, 0),
+		description: "interface backend and portForward",
+		present: func(spec *DomainSpec) bool {
+			for _, iface := range spec.Devices.Interfaces {
+				if iface.Backend != nil || len(iface.PortForward) > 0 {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		since:       NewLibvirtVersion(10, 0, 0),
+		description: "disk driver iothreads",
+		present: func(spec *DomainSpec) bool {
+			for _, disk := range spec.Devices.Disks {
+				if disk.Driver != nil && disk.Driver.IOThreads != nil {
+					return true
+				}
+			}
+			return false
+		},
+		downgrade: func(spec *DomainSpec) {
+			// Older versions only support a single iothread per disk, keep the first one
+			for i := range spec.Devices.Disks {
+				driver := spec.Devices.Disks[i].Driver
+				if driver == nil || driver.IOThreads == nil {
+					continue
+				}
+				if driver.IOThread == nil && len(driver.IOThreads.IOThread) > 0 {
+					id := uint(driver.IOThreads.IOThread[0].Id)
+					driver.IOThread = &id
+				}
+				driver.IOThreads = nil
+			}
+		},
+	},
+	{
+		since:       NewLibvirtVersion(10, 10, 0),
+		description: "disk source dataStore",
+		present: func(spec *DomainSpec) bool {
+			for _, disk := range spec.Devices.Disks {
+				if disk.Source.DataStore != nil {
+					return true
+				}
+			}
+			return false
+		},
+	},
+}
+
+// ConvertDomainSpec rewrites spec in place so that it only uses elements known to
+// the libvirt version target. An error is returned if spec uses an element which
+// can not be expressed for target.
+func ConvertDomainSpec(spec *DomainSpec, target LibvirtVersion) error {
+	for _, change := range schemaChanges {
+		if target >= change.since || !change.present(spec) {
+			continue
+		}
+		if change.downgrade == nil {
+			return fmt.Errorf("%s requires libvirt %s or newer, got %s", change.description, change.since, target)
+		}
+		change.downgrade(spec)
+	}
+	return nil
+}
+
+// NormalizeDomainSpec returns a copy of spec as it is expected to be reported by the
+// libvirt version target, with values which are equal to the libvirt default removed.
+func NormalizeDomainSpec(spec *DomainSpec, target LibvirtVersion) (*DomainSpec, error) {
+	normalized := spec.DeepCopy()
+	if err := ConvertDomainSpec(normalized, target); err != nil {
+		return nil, err
+	}
+
+	if normalized.Devices.Ballooning != nil && normalized.Devices.Ballooning.FreePageReporting == "off" {
+		normalized.Devices.Ballooning.FreePageReporting = ""
+	}
+	return normalized, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package api
+
+import (
+	ginkgo "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("Versioned domain schema", func() {
+	var (
+		libvirt8  = NewLibvirtVersion(8, 0, 0)
+		libvirt10 = NewLibvirtVersion(10, 0, 0)
+	)
+
+	ginkgo.DescribeTable("should parse libvirt versions", func(version string, expected LibvirtVersion) {
+		parsed, err := ParseLibvirtVersion(version)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(Equal(expected))
+		Expect(parsed.String()).To(Equal(expected.String()))
+	},
+		ginkgo.Entry("with release", "10.5.2", LibvirtVersion(10005002)),
+		ginkgo.Entry("without release", "9.0", LibvirtVersion(9000000)),
+	)
+
+	ginkgo.DescribeTable("should reject invalid libvirt versions", func(version string) {
+		_, err := ParseLibvirtVersion(version)
+		Expect(err).To(HaveOccurred())
+	},
+		ginkgo.Entry("without minor", "10"),
+		ginkgo.Entry("with too many parts", "10.0.0.1"),
+		ginkgo.Entry("with a non numeric part", "10.x.0"),
+		ginkgo.Entry("with a part out of range", "10.1000.0"),
+	)
+
+	ginkgo.It("should format libvirt versions", func() {
+		Expect(NewLibvirtVersion(7, 2, 2).String()).To(Equal("7.2.2"))
+	})
+
+	ginkgo.It("should map disk iothreads to a single iothread for older versions", func() {
+		spec := &DomainSpec{Devices: Devices{Disks: []Disk{{
+			Driver: &DiskDriver{IOThreads: &DiskIOThreads{IOThread: []DiskIOThread{{Id: 2}, {Id: 3}}}},
+		}}}}
+
+		Expect(ConvertDomainSpec(spec, libvirt8)).To(Succeed())
+		Expect(spec.Devices.Disks[0].Driver.IOThreads).To(BeNil())
+		Expect(*spec.Devices.Disks[0].Driver.IOThread).To(BeEquivalentTo(2))
+	})
+
+	ginkgo.It("should keep elements known to the target version", func() {
+		spec := &DomainSpec{Devices: Devices{Ballooning: &MemBalloon{Model: "virtio", FreePageReporting: "on"}}}
+
+		Expect(ConvertDomainSpec(spec, libvirt8)).To(Succeed())
+		Expect(spec.Devices.Ballooning.FreePageReporting).To(Equal("on"))
+	})
+
+	ginkgo.It("should fail to convert elements which can not be expressed for the target version", func() {
+		spec := &DomainSpec{Devices: Devices{Interfaces: []Interface{{
+			Type:    "vhostuser",
+			Backend: &InterfaceBackend{Type: "passt"},
+		}}}}
+
+		err := ConvertDomainSpec(spec, libvirt8)
+		Expect(err).To(MatchError(ContainSubstring("interface backend and portForward requires libvirt 9.0.0 or newer")))
+	})
+
+	ginkgo.It("should not modify the spec when normalizing", func() {
+		spec := &DomainSpec{Devices: Devices{Ballooning: &MemBalloon{Model: "virtio", FreePageReporting: "off"}}}
+
+		normalized, err := NormalizeDomainSpec(spec, libvirt10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(normalized.Devices.Ballooning.FreePageReporting).To(BeEmpty())
+		Expect(spec.Devices.Ballooning.FreePageReporting).To(Equal("off"))
+	})
+
+})
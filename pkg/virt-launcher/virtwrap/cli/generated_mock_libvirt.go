@@ -73,6 +73,20 @@ func (mr *MockConnectionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConnection)(nil).Close))
 }
 
+// DefineSecret mocks base method.
+func (m *MockConnection) DefineSecret(xml string, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefineSecret", xml, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DefineSecret indicates an expected call of DefineSecret.
+func (mr *MockConnectionMockRecorder) DefineSecret(xml, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefineSecret", reflect.TypeOf((*MockConnection)(nil).DefineSecret), xml, value)
+}
+
 // DomainDefineXML mocks base method.
 func (m *MockConnection) DomainDefineXML(xml string) (VirDomain, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +102,20 @@ func (mr *MockConnectionMockRecorder) DomainDefineXML(xml any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainDefineXML", reflect.TypeOf((*MockConnection)(nil).DomainDefineXML), xml)
 }
 
+// DomainEventBlockJobRegister mocks base method.
+func (m *MockConnection) DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventBlockJobRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventBlockJobRegister indicates an expected call of DomainEventBlockJobRegister.
+func (mr *MockConnectionMockRecorder) DomainEventBlockJobRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventBlockJobRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventBlockJobRegister), callback)
+}
+
 // DomainEventDeregister mocks base method.
 func (m *MockConnection) DomainEventDeregister(registrationID int) error {
 	m.ctrl.T.Helper()
@@ -130,6 +158,20 @@ func (mr *MockConnectionMockRecorder) DomainEventDeviceRemovedRegister(callback
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventDeviceRemovedRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventDeviceRemovedRegister), callback)
 }
 
+// DomainEventIOErrorReasonRegister mocks base method.
+func (m *MockConnection) DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventIOErrorReasonRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventIOErrorReasonRegister indicates an expected call of DomainEventIOErrorReasonRegister.
+func (mr *MockConnectionMockRecorder) DomainEventIOErrorReasonRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventIOErrorReasonRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventIOErrorReasonRegister), callback)
+}
+
 // DomainEventJobCompletedRegister mocks base method.
 func (m *MockConnection) DomainEventJobCompletedRegister(callback libvirt.DomainEventJobCompletedCallback) error {
 	m.ctrl.T.Helper()
@@ -172,6 +214,34 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainEventWatchdogRegister mocks base method.
+func (m *MockConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainEventWatchdogRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainEventWatchdogRegister indicates an expected call of DomainEventWatchdogRegister.
+func (mr *MockConnectionMockRecorder) DomainEventWatchdogRegister(callback any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventWatchdogRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventWatchdogRegister), callback)
+}
+
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xmlConf string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xmlConf, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xmlConf, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xmlConf, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +287,21 @@ func (mr *MockConnectionMockRecorder) GetDomainStats(statsTypes, l, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainStats", reflect.TypeOf((*MockConnection)(nil).GetDomainStats), statsTypes, l, flags)
 }
 
+// GetLibVersion mocks base method.
+func (m *MockConnection) GetLibVersion() (api.LibvirtVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLibVersion")
+	ret0, _ := ret[0].(api.LibvirtVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLibVersion indicates an expected call of GetLibVersion.
+func (mr *MockConnectionMockRecorder) GetLibVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLibVersion", reflect.TypeOf((*MockConnection)(nil).GetLibVersion))
+}
+
 // GetQemuVersion mocks base method.
 func (m *MockConnection) GetQemuVersion() (string, error) {
 	m.ctrl.T.Helper()
@@ -524,6 +609,21 @@ func (mr *MockVirDomainMockRecorder) CoreDumpWithFormat(to, format, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CoreDumpWithFormat", reflect.TypeOf((*MockVirDomain)(nil).CoreDumpWithFormat), to, format, flags)
 }
 
+// CreateSnapshotXML mocks base method.
+func (m *MockVirDomain) CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotXML", xml, flags)
+	ret0, _ := ret[0].(*libvirt.DomainSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotXML indicates an expected call of CreateSnapshotXML.
+func (mr *MockVirDomainMockRecorder) CreateSnapshotXML(xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotXML", reflect.TypeOf((*MockVirDomain)(nil).CreateSnapshotXML), xml, flags)
+}
+
 // CreateWithFlags mocks base method.
 func (m *MockVirDomain) CreateWithFlags(flags libvirt.DomainCreateFlags) error {
 	m.ctrl.T.Helper()
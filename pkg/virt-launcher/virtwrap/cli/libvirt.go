@@ -26,6 +26,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -47,6 +48,7 @@ const ConnectionInterval = 500 * time.Millisecond
 type Connection interface {
 	LookupDomainByName(name string) (VirDomain, error)
 	DomainDefineXML(xml string) (VirDomain, error)
+	DomainRestoreFlags(srcFile, xmlConf string, flags libvirt.DomainSaveRestoreFlags) error
 	Close() (int, error)
 	DomainEventJobCompletedRegister(callback libvirt.DomainEventJobCompletedCallback) error
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
@@ -55,6 +57,9 @@ type Connection interface {
 	AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) error
 	VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error)
 	DomainEventMemoryDeviceSizeChangeRegister(callback libvirt.DomainEventMemoryDeviceSizeChangeCallback) error
+	DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) error
+	DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error
+	DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) error
 	DomainEventDeregister(registrationID int) error
 	ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error)
 	NewStream(flags libvirt.StreamFlags) (Stream, error)
@@ -68,7 +73,10 @@ type Connection interface {
 	GetDomainStats(statsTypes libvirt.DomainStatsTypes, l *stats.DomainJobInfo, flags libvirt.ConnectGetAllDomainStatsFlags) ([]*stats.DomainStats, error)
 	GetDomainDirtyRate(calculationDuration time.Duration, flags libvirt.DomainDirtyRateCalcFlags) ([]*stats.DomainStatsDirtyRate, error)
 	GetQemuVersion() (string, error)
+	GetLibVersion() (api.LibvirtVersion, error)
 	GetSEVInfo() (*api.SEVNodeParameters, error)
+	// helper method, defining a secret and setting its value
+	DefineSecret(xml string, value []byte) error
 }
 
 type Stream interface {
@@ -97,6 +105,9 @@ type LibvirtConnection struct {
 	domainEventMigrationIterationCallbacks      []libvirt.DomainEventMigrationIterationCallback
 	agentEventCallbacks                         []libvirt.DomainEventAgentLifecycleCallback
 	domainDeviceMemoryDeviceSizeChangeCallbacks []libvirt.DomainEventMemoryDeviceSizeChangeCallback
+	domainEventIOErrorReasonCallbacks           []libvirt.DomainEventIOErrorReasonCallback
+	domainEventBlockJobCallbacks                []libvirt.DomainEventBlockJobCallback
+	domainEventWatchdogCallbacks                []libvirt.DomainEventWatchdogCallback
 }
 
 func (s *VirStream) Write(p []byte) (n int, err error) {
@@ -224,6 +235,39 @@ func (l *LibvirtConnection) DomainEventMemoryDeviceSizeChangeRegister(callback l
 	return
 }
 
+func (l *LibvirtConnection) DomainEventIOErrorReasonRegister(callback libvirt.DomainEventIOErrorReasonCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventIOErrorReasonCallbacks = append(l.domainEventIOErrorReasonCallbacks, callback)
+	_, err = l.Connect.DomainEventIOErrorReasonRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainEventBlockJobRegister(callback libvirt.DomainEventBlockJobCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventBlockJobCallbacks = append(l.domainEventBlockJobCallbacks, callback)
+	_, err = l.Connect.DomainEventBlockJob2Register(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainEventWatchdogCallbacks = append(l.domainEventWatchdogCallbacks, callback)
+	_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) DomainEventDeregister(registrationID int) error {
 	return l.Connect.DomainEventDeregister(registrationID)
 }
@@ -248,6 +292,33 @@ func (l *LibvirtConnection) DomainDefineXML(xml string) (dom VirDomain, err erro
 	return
 }
 
+func (l *LibvirtConnection) DefineSecret(xml string, value []byte) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	secret, err := l.Connect.SecretDefineXML(xml, 0)
+	l.checkConnectionLost(err)
+	if err != nil {
+		return
+	}
+	defer secret.Free()
+
+	err = secret.SetValue(value, 0)
+	l.checkConnectionLost(err)
+	return
+}
+
+func (l *LibvirtConnection) DomainRestoreFlags(srcFile, xmlConf string, flags libvirt.DomainSaveRestoreFlags) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	err = l.Connect.DomainRestoreFlags(srcFile, xmlConf, flags)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -317,6 +388,19 @@ func (l *LibvirtConnection) GetQemuVersion() (string, error) {
 	return fmt.Sprintf("QEMU %d.%d.%d", major, minor, release), err
 }
 
+func (l *LibvirtConnection) GetLibVersion() (version api.LibvirtVersion, err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+	defer l.checkConnectionLost(err)
+
+	libVersion, err := l.Connect.GetLibVersion()
+	if err != nil {
+		return
+	}
+	return api.LibvirtVersion(libVersion), nil
+}
+
 func (l *LibvirtConnection) GetDomainStats(statsTypes libvirt.DomainStatsTypes, migrateJobInfo *stats.DomainJobInfo, flags libvirt.ConnectGetAllDomainStatsFlags) ([]*stats.DomainStats, error) {
 	domStats, err := l.GetAllDomainStats(statsTypes, flags)
 	if err != nil {
@@ -341,10 +425,11 @@ func (l *LibvirtConnection) GetDomainStats(statsTypes libvirt.DomainStatsTypes,
 			return list, err
 		}
 
-		devAliasMap, err := l.GetDeviceAliasMap(domStat.Domain)
+		domSpec, err := getDomainSpecFromXML(domStat.Domain)
 		if err != nil {
 			return list, err
 		}
+		devAliasMap := GetDeviceAliasMap(domSpec)
 
 		domInfo, err := domStat.Domain.GetInfo()
 		if err != nil {
@@ -366,6 +451,7 @@ func (l *LibvirtConnection) GetDomainStats(statsTypes libvirt.DomainStatsTypes,
 
 		stat.CPUMap = cpuMap
 		stat.CPUMapSet = true
+		stat.HostDevices = GetHostDevices(domSpec)
 
 		list = append(list, stat)
 	}
@@ -470,18 +556,21 @@ func (l *LibvirtConnection) GetSEVInfo() (*api.SEVNodeParameters, error) {
 	return sevNodeParameters, nil
 }
 
-func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[string]string, error) {
-	devAliasMap := make(map[string]string)
-
+func getDomainSpecFromXML(domain *libvirt.Domain) (*api.DomainSpec, error) {
 	domSpec := &api.DomainSpec{}
 	domxml, err := domain.GetXMLDesc(0)
 	if err != nil {
-		return devAliasMap, err
+		return nil, err
 	}
 	err = xml.Unmarshal([]byte(domxml), domSpec)
 	if err != nil {
-		return devAliasMap, err
+		return nil, err
 	}
+	return domSpec, nil
+}
+
+func GetDeviceAliasMap(domSpec *api.DomainSpec) map[string]string {
+	devAliasMap := make(map[string]string)
 
 	for _, iface := range domSpec.Devices.Interfaces {
 		if iface.Target != nil {
@@ -493,7 +582,42 @@ func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[strin
 		devAliasMap[disk.Target.Device] = disk.Alias.GetName()
 	}
 
-	return devAliasMap, nil
+	return devAliasMap
+}
+
+// GetHostDevices lists the PCI and mediated host devices of the domain
+// together with their host-side address.
+func GetHostDevices(domSpec *api.DomainSpec) []stats.DomainStatsHostDevice {
+	var hostDevices []stats.DomainStatsHostDevice
+
+	for _, hostDev := range domSpec.Devices.HostDevices {
+		if hostDev.Alias == nil || hostDev.Source.Address == nil {
+			continue
+		}
+		srcAddr := hostDev.Source.Address
+		switch hostDev.Type {
+		case api.HostDevicePCI:
+			if srcAddr.Domain == "" || srcAddr.Bus == "" || srcAddr.Slot == "" || srcAddr.Function == "" {
+				continue
+			}
+			hostDevices = append(hostDevices, stats.DomainStatsHostDevice{
+				Alias: hostDev.Alias.GetName(),
+				PCIAddress: fmt.Sprintf("%s:%s:%s.%s",
+					strings.TrimPrefix(srcAddr.Domain, "0x"),
+					strings.TrimPrefix(srcAddr.Bus, "0x"),
+					strings.TrimPrefix(srcAddr.Slot, "0x"),
+					strings.TrimPrefix(srcAddr.Function, "0x"),
+				),
+			})
+		case api.HostDeviceMDev:
+			hostDevices = append(hostDevices, stats.DomainStatsHostDevice{
+				Alias:    hostDev.Alias.GetName(),
+				MDevUUID: srcAddr.UUID,
+			})
+		}
+	}
+
+	return hostDevices
 }
 
 // Installs a watchdog which will check periodically if the libvirt connection is still alive.
@@ -591,6 +715,24 @@ func (l *LibvirtConnection) reconnectIfNecessary() (err error) {
 			return err
 		}
 	}
+	for _, callback := range l.domainEventIOErrorReasonCallbacks {
+		log.Log.Infof("Re-registered domain io error callback: %p", callback)
+		if _, err = l.Connect.DomainEventIOErrorReasonRegister(nil, callback); err != nil {
+			return err
+		}
+	}
+	for _, callback := range l.domainEventBlockJobCallbacks {
+		log.Log.Infof("Re-registered domain block job callback: %p", callback)
+		if _, err = l.Connect.DomainEventBlockJob2Register(nil, callback); err != nil {
+			return err
+		}
+	}
+	for _, callback := range l.domainEventWatchdogCallbacks {
+		log.Log.Infof("Re-registered domain watchdog callback: %p", callback)
+		if _, err = l.Connect.DomainEventWatchdogRegister(nil, callback); err != nil {
+			return err
+		}
+	}
 
 	log.Log.Error("Re-registered domain and agent callbacks for new connection")
 
@@ -661,6 +803,7 @@ type VirDomain interface {
 	AbortJob() error
 	Free() error
 	CoreDumpWithFormat(to string, format libvirt.DomainCoreDumpFormat, flags libvirt.DomainCoreDumpFlags) error
+	CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error)
 	PinVcpuFlags(vcpu uint, cpuMap []bool, flags libvirt.DomainModificationImpact) error
 	PinEmulator(cpumap []bool, flags libvirt.DomainModificationImpact) error
 	SetVcpusFlags(vcpu uint, flags libvirt.DomainVcpuFlags) error
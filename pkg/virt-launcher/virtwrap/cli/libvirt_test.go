@@ -24,6 +24,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 var _ = Describe("Libvirt Suite", func() {
@@ -33,4 +36,35 @@ var _ = Describe("Libvirt Suite", func() {
 			Expect(err).To(MatchError("cannot connect to libvirt daemon: context deadline exceeded"))
 		})
 	})
+
+	Context("GetHostDevices", func() {
+		It("should report PCI and mediated host devices with their host address", func() {
+			domSpec := &api.DomainSpec{}
+			domSpec.Devices.HostDevices = []api.HostDevice{
+				{
+					Type:  api.HostDevicePCI,
+					Alias: api.NewUserDefinedAlias("gpu-gpu1"),
+					Source: api.HostDeviceSource{
+						Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x00", Function: "0x0"},
+					},
+				},
+				{
+					Type:  api.HostDeviceMDev,
+					Alias: api.NewUserDefinedAlias("gpu-vgpu1"),
+					Source: api.HostDeviceSource{
+						Address: &api.Address{UUID: "5a2d1bd4-0fbd-4a88-b2c8-2aa8e6e0b6a1"},
+					},
+				},
+				{
+					Type:   api.HostDevicePCI,
+					Source: api.HostDeviceSource{Address: &api.Address{}},
+				},
+			}
+
+			Expect(GetHostDevices(domSpec)).To(Equal([]stats.DomainStatsHostDevice{
+				{Alias: "gpu-gpu1", PCIAddress: "0000:81:00.0"},
+				{Alias: "gpu-vgpu1", MDevUUID: "5a2d1bd4-0fbd-4a88-b2c8-2aa8e6e0b6a1"},
+			}))
+		})
+	})
 })
@@ -217,7 +217,7 @@ func (l *Launcher) PauseVirtualMachine(_ context.Context, request *cmdv1.VMIRequ
 		return response, nil
 	}
 
-	if err := l.domainManager.PauseVMI(vmi); err != nil {
+	if err := l.domainManager.PauseVMI(vmi, request.GetOptions().GetAutoUnpauseTimeoutSeconds()); err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to pause vmi")
 		response.Success = false
 		response.Message = getErrorMessage(err)
@@ -329,6 +329,23 @@ func (l *Launcher) SoftRebootVirtualMachine(_ context.Context, request *cmdv1.VM
 	return response, nil
 }
 
+func (l *Launcher) RebootVirtualMachine(_ context.Context, request *cmdv1.RebootRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.RebootVMI(vmi, v1.RebootMethod(request.Method)); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to reboot vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Rebooted vmi")
+	return response, nil
+}
+
 func (l *Launcher) KillVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
@@ -762,6 +779,80 @@ func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLa
 	return response, nil
 }
 
+func (l *Launcher) GetSEVSNPAttestationReport(_ context.Context, request *cmdv1.SEVSNPAttestationReportRequest) (*cmdv1.SEVSNPAttestationReportResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	reportResponse := &cmdv1.SEVSNPAttestationReportResponse{
+		Response: response,
+	}
+
+	if !reportResponse.Response.Success {
+		return reportResponse, nil
+	}
+
+	var options v1.SEVSNPAttestationReportOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		reportResponse.Response.Success = false
+		reportResponse.Response.Message = "No valid attestation report options present in command server request"
+		return reportResponse, nil
+	}
+
+	report, err := l.domainManager.GetSEVSNPAttestationReport(vmi, &options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get SEV-SNP attestation report")
+		reportResponse.Response.Success = false
+		reportResponse.Response.Message = getErrorMessage(err)
+		return reportResponse, nil
+	}
+
+	if reportJson, err := json.Marshal(report); err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal SEV-SNP attestation report")
+		reportResponse.Response.Success = false
+		reportResponse.Response.Message = getErrorMessage(err)
+		return reportResponse, nil
+	} else {
+		reportResponse.Report = reportJson
+	}
+
+	return reportResponse, nil
+}
+
+func (l *Launcher) GetTPMAttestation(_ context.Context, request *cmdv1.TPMAttestationRequest) (*cmdv1.TPMAttestationResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	attestationResponse := &cmdv1.TPMAttestationResponse{
+		Response: response,
+	}
+
+	if !attestationResponse.Response.Success {
+		return attestationResponse, nil
+	}
+
+	var options v1.TPMAttestationOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		attestationResponse.Response.Success = false
+		attestationResponse.Response.Message = "No valid attestation options present in command server request"
+		return attestationResponse, nil
+	}
+
+	attestation, err := l.domainManager.GetTPMAttestation(vmi, &options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get TPM attestation")
+		attestationResponse.Response.Success = false
+		attestationResponse.Response.Message = getErrorMessage(err)
+		return attestationResponse, nil
+	}
+
+	if attestationJson, err := json.Marshal(attestation); err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal TPM attestation")
+		attestationResponse.Response.Success = false
+		attestationResponse.Response.Message = getErrorMessage(err)
+		return attestationResponse, nil
+	} else {
+		attestationResponse.Attestation = attestationJson
+	}
+
+	return attestationResponse, nil
+}
+
 func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -785,6 +876,23 @@ func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VM
 	return response, nil
 }
 
+func (l *Launcher) ResizeVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.ResizeGuestMemory(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to resize VMI guest memory")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("guest memory has been resized")
+	return response, nil
+}
+
 func (l *Launcher) GetScreenshot(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.ScreenshotResponse, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	screenshotResponse := &cmdv1.ScreenshotResponse{
@@ -807,6 +915,29 @@ func (l *Launcher) GetScreenshot(_ context.Context, request *cmdv1.VMIRequest) (
 	return screenshotResponse, nil
 }
 
+func (l *Launcher) SetVNCResolution(_ context.Context, request *cmdv1.VNCResolutionRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var options v1.VNCResolutionOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil {
+		response.Success = false
+		response.Message = "No valid resolution options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.SetVNCResolution(vmi, &options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to set the VNC resolution")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func ReceivedEarlyExitSignal() bool {
 	_, earlyExit := os.LookupEnv(receivedEarlyExitSignalEnvVar)
 	return earlyExit
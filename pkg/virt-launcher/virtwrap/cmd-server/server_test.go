@@ -126,6 +126,12 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.SoftRebootVirtualMachine(vmi)).To(Succeed())
 		})
 
+		It("should reboot a vmi with the requested method", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RebootVMI(vmi, v1.RebootMethodACPI)
+			Expect(client.RebootVirtualMachine(vmi, v1.RebootMethodACPI)).To(Succeed())
+		})
+
 		It("should call memory dump", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			dumpPath := "path/to/dump/volMem"
@@ -136,8 +142,14 @@ var _ = Describe("Virt remote commands", func() {
 
 		It("should pause a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
-			domainManager.EXPECT().PauseVMI(vmi)
-			Expect(client.PauseVirtualMachine(vmi)).To(Succeed())
+			domainManager.EXPECT().PauseVMI(vmi, int32(0))
+			Expect(client.PauseVirtualMachine(vmi, 0)).To(Succeed())
+		})
+
+		It("should pause a vmi with an automatic unpause timeout", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().PauseVMI(vmi, int32(60))
+			Expect(client.PauseVirtualMachine(vmi, 60)).To(Succeed())
 		})
 
 		It("should unpause a vmi", func() {
@@ -331,12 +343,67 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should set the VNC resolution of a vmi", func() {
+			options := &v1.VNCResolutionOptions{Width: 1920, Height: 1080}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SetVNCResolution(vmi, options).Return(nil)
+			err := client.SetVNCResolution(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail to set the VNC resolution when the display can not be resized", func() {
+			options := &v1.VNCResolutionOptions{Width: 1920, Height: 1080}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().SetVNCResolution(vmi, options).Return(errors.New("the display does not support resizing"))
+			err := client.SetVNCResolution(vmi, options)
+			Expect(err).To(MatchError(ContainSubstring("the display does not support resizing")))
+		})
+
+		It("should return a SEV-SNP attestation report", func() {
+			options := &v1.SEVSNPAttestationReportOptions{Nonce: "c0ffee"}
+			report := &v1.SEVSNPAttestationReport{Report: "AAAA", Nonce: "c0ffee"}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetSEVSNPAttestationReport(vmi, options).Return(report, nil)
+			fetchedReport, err := client.GetSEVSNPAttestationReport(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedReport).To(Equal(report))
+		})
+
+		It("should return a TPM attestation", func() {
+			options := &v1.TPMAttestationOptions{Nonce: "c0ffee", PCRs: []uint32{0, 7}}
+			attestation := &v1.TPMAttestation{
+				EventLog:       "AAAA",
+				Quote:          "BBBB",
+				Signature:      "CCCC",
+				AttestationKey: "DDDD",
+				PCRs:           map[string]string{"0": "00", "7": "ff"},
+				Nonce:          "c0ffee",
+			}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetTPMAttestation(vmi, options).Return(attestation, nil)
+			fetchedAttestation, err := client.GetTPMAttestation(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedAttestation).To(Equal(attestation))
+		})
+
 		It("should call UpdateGuestMemory", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().UpdateGuestMemory(vmi).Return(nil)
 			Expect(client.SyncVirtualMachineMemory(vmi, &cmdv1.VirtualMachineOptions{})).To(Succeed())
 		})
 
+		It("should call ResizeGuestMemory", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().ResizeGuestMemory(vmi).Return(nil)
+			Expect(client.ResizeVirtualMachineMemory(vmi)).To(Succeed())
+		})
+
+		It("should fail to resize the guest memory when ResizeGuestMemory fails", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().ResizeGuestMemory(vmi).Return(errors.New("the guest memory can not be resized below the boot memory"))
+			Expect(client.ResizeVirtualMachineMemory(vmi)).To(MatchError(ContainSubstring("the guest memory can not be resized below the boot memory")))
+		})
+
 		Context("exec & guestPing", func() {
 			var (
 				testDomainName           = "test"
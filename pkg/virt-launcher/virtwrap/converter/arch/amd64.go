@@ -55,6 +55,10 @@ func (converterAMD64) IsUSBNeeded(vmi *v1.VirtualMachineInstance) bool {
 		return true
 	}
 
+	if vmi.Spec.Domain.Devices.Smartcard != nil {
+		return true
+	}
+
 	if device.USBDevicesFound(vmi.Spec.Domain.Devices.HostDevices) {
 		return true
 	}
@@ -23,6 +23,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/hostsensors"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -35,6 +37,14 @@ func (c ChannelsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newDownwardMetricsChannel())
 	}
 
+	if hostsensors.HasDevice(&vmi.Spec) {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newHostSensorsChannel())
+	}
+
+	for _, channel := range vmi.Spec.Domain.Devices.Channels {
+		domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, newUnixSocketChannel(channel))
+	}
+
 	return nil
 }
 
@@ -62,3 +72,31 @@ func newDownwardMetricsChannel() api.Channel {
 		},
 	}
 }
+
+func newHostSensorsChannel() api.Channel {
+	return api.Channel{
+		Type: "unix",
+		Source: &api.ChannelSource{
+			Mode: "bind",
+			Path: hostsensors.ChannelSocket,
+		},
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: hostsensors.SerialDeviceName,
+		},
+	}
+}
+
+func newUnixSocketChannel(channel v1.Channel) api.Channel {
+	return api.Channel{
+		Type: "unix",
+		Source: &api.ChannelSource{
+			Mode: "bind",
+			Path: util.ChannelSocketPath(channel),
+		},
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: channel.Target,
+		},
+	}
+}
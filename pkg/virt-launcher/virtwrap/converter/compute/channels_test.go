@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
+	"kubevirt.io/kubevirt/pkg/hostsensors"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
@@ -92,4 +93,60 @@ var _ = Describe("Channels Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should configure a host sensors channel when host sensors are specified on the VMI", func() {
+		vmi := libvmi.New(libvmi.WithHostSensors(v1.HostSensorTypeTemperature))
+		var domain api.Domain
+
+		Expect(compute.ChannelsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Channels).To(HaveLen(2))
+		Expect(domain.Spec.Devices.Channels[1]).To(Equal(api.Channel{
+			Type: "unix",
+			Source: &api.ChannelSource{
+				Mode: "bind",
+				Path: hostsensors.ChannelSocket,
+			},
+			Target: &api.ChannelTarget{
+				Type: v1.VirtIO,
+				Name: hostsensors.SerialDeviceName,
+			},
+		}))
+	})
+
+	It("Should configure user defined channels after the built-in ones", func() {
+		vmi := libvmi.New(
+			libvmi.WithChannel(v1.Channel{Name: "agent", Target: "org.example.agent.0"}),
+			libvmi.WithChannel(v1.Channel{Name: "node-agent", Target: "org.example.node.0", HostPath: "/var/lib/agent"}),
+		)
+		var domain api.Domain
+
+		Expect(compute.ChannelsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Channels).To(HaveLen(3))
+		Expect(domain.Spec.Devices.Channels[1:]).To(Equal([]api.Channel{
+			{
+				Type: "unix",
+				Source: &api.ChannelSource{
+					Mode: "bind",
+					Path: "/var/run/kubevirt-channels/agent.sock",
+				},
+				Target: &api.ChannelTarget{
+					Type: v1.VirtIO,
+					Name: "org.example.agent.0",
+				},
+			},
+			{
+				Type: "unix",
+				Source: &api.ChannelSource{
+					Mode: "bind",
+					Path: "/var/run/kubevirt-channels/node-agent/node-agent.sock",
+				},
+				Target: &api.ChannelTarget{
+					Type: v1.VirtIO,
+					Name: "org.example.node.0",
+				},
+			},
+		}))
+	})
 })
@@ -28,7 +28,15 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
-type ClockDomainConfigurator struct{}
+type ClockDomainConfigurator struct {
+	architecture string
+}
+
+func NewClockDomainConfigurator(architecture string) ClockDomainConfigurator {
+	return ClockDomainConfigurator{
+		architecture: architecture,
+	}
+}
 
 func (c ClockDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	if vmi.Spec.Domain.Clock != nil {
@@ -52,9 +60,28 @@ func (c ClockDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domai
 		domain.Spec.Clock = clock
 	}
 
+	// The ptp_kvm driver of x86 guests reads the host clock through the KVM clock, arm64
+	// guests read it through a hypercall which is always available.
+	if ptpClockEnabled(vmi.Spec.Domain.Clock) && c.architecture == "amd64" && !hasTimer(domain.Spec.Clock, "kvmclock") {
+		domain.Spec.Clock.Timer = append(domain.Spec.Clock.Timer, api.Timer{Name: "kvmclock", Present: "yes"})
+	}
+
 	return nil
 }
 
+func ptpClockEnabled(clock *v1.Clock) bool {
+	return clock != nil && clock.PTP != nil && (clock.PTP.Enabled == nil || *clock.PTP.Enabled)
+}
+
+func hasTimer(clock *api.Clock, name string) bool {
+	for _, timer := range clock.Timer {
+		if timer.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func convert_v1_Clock_To_api_Clock(source *v1.Clock, clock *api.Clock) error {
 	if source.UTC != nil {
 		clock.Offset = "utc"
@@ -37,7 +37,7 @@ var _ = Describe("Clock Domain Configurator", func() {
 
 		var domain api.Domain
 
-		Expect(compute.ClockDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
 		Expect(domain).To(Equal(api.Domain{}))
 	})
 
@@ -53,7 +53,7 @@ var _ = Describe("Clock Domain Configurator", func() {
 
 		var domain api.Domain
 
-		Expect(compute.ClockDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(compute.NewClockDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
 
 		expectedDomain := api.Domain{
 			Spec: api.DomainSpec{
@@ -67,4 +67,30 @@ var _ = Describe("Clock Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	DescribeTable("Should set the timers of the PTP clock", func(architecture string, clock v1.Clock, expectedTimers []api.Timer) {
+		vmi := libvmi.New(libvmi.WithClock(clock))
+
+		var domain api.Domain
+
+		Expect(compute.NewClockDomainConfigurator(architecture).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Clock.Timer).To(Equal(expectedTimers))
+	},
+		Entry("by adding the KVM clock on amd64", "amd64",
+			v1.Clock{PTP: &v1.PTPClock{}},
+			[]api.Timer{{Name: "kvmclock", Present: "yes"}},
+		),
+		Entry("by keeping the KVM clock of the VMI on amd64", "amd64",
+			v1.Clock{PTP: &v1.PTPClock{}, Timer: &v1.Timer{KVM: &v1.KVMTimer{}}},
+			[]api.Timer{{Name: "kvmclock", Present: "yes"}},
+		),
+		Entry("to none on arm64", "arm64",
+			v1.Clock{PTP: &v1.PTPClock{}},
+			nil,
+		),
+		Entry("to none when it is disabled", "amd64",
+			v1.Clock{PTP: &v1.PTPClock{Enabled: pointer.P(false)}},
+			nil,
+		),
+	)
 })
@@ -39,20 +39,28 @@ func NewConsoleDomainConfigurator(useSerialConsoleLog bool) ConsoleDomainConfigu
 	}
 }
 
+const (
+	serialPortIndex = uint(0)
+	serialType      = "serial"
+	consoleType     = "pty"
+	serialTypeUnix  = "unix"
+	bindMode        = "bind"
+	logAppend       = "on"
+)
+
 func (c ConsoleDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
-	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && !*vmi.Spec.Domain.Devices.AutoattachSerialConsole {
-		return nil
+	if vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole {
+		c.configureSerialConsole(vmi, domain)
+	}
+
+	for _, serialPort := range vmi.Spec.Domain.Devices.Serials {
+		domain.Spec.Devices.Serials = append(domain.Spec.Devices.Serials, newUnixSerial(vmi, uint(serialPort.Port)))
 	}
 
-	const (
-		serialPortIndex = uint(0)
-		serialType      = "serial"
-		consoleType     = "pty"
-		serialTypeUnix  = "unix"
-		bindMode        = "bind"
-		logAppend       = "on"
-	)
+	return nil
+}
 
+func (c ConsoleDomainConfigurator) configureSerialConsole(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	domain.Spec.Devices.Consoles = []api.Console{
 		{
 			Type: consoleType,
@@ -63,26 +71,26 @@ func (c ConsoleDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, dom
 		},
 	}
 
-	socketPath := fmt.Sprintf("%s/%s/virt-serial%d", util.VirtPrivateDir, vmi.ObjectMeta.UID, serialPortIndex)
-	serial := api.Serial{
-		Type: serialTypeUnix,
-		Target: &api.SerialTarget{
-			Port: pointer.P(serialPortIndex),
-		},
-		Source: &api.SerialSource{
-			Mode: bindMode,
-			Path: socketPath,
-		},
-	}
-
+	serial := newUnixSerial(vmi, serialPortIndex)
 	if c.useSerialConsoleLog {
 		serial.Log = &api.SerialLog{
-			File:   fmt.Sprintf("%s-log", socketPath),
+			File:   fmt.Sprintf("%s-log", serial.Source.Path),
 			Append: logAppend,
 		}
 	}
 
 	domain.Spec.Devices.Serials = []api.Serial{serial}
+}
 
-	return nil
+func newUnixSerial(vmi *v1.VirtualMachineInstance, port uint) api.Serial {
+	return api.Serial{
+		Type: serialTypeUnix,
+		Target: &api.SerialTarget{
+			Port: pointer.P(port),
+		},
+		Source: &api.SerialSource{
+			Mode: bindMode,
+			Path: fmt.Sprintf("%s/%s/virt-serial%d", util.VirtPrivateDir, vmi.ObjectMeta.UID, port),
+		},
+	}
 }
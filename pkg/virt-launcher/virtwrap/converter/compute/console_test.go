@@ -131,6 +131,64 @@ var _ = Describe("Console Domain Configurator", func() {
 
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("should configure additional serial ports", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid))
+		vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{
+			{Name: "kernel", Port: 1},
+			{Name: "scada", Port: 3},
+		}
+
+		var domain api.Domain
+		Expect(compute.NewConsoleDomainConfigurator(false).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Consoles).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Serials).To(HaveLen(3))
+		Expect(domain.Spec.Devices.Serials[1:]).To(Equal([]api.Serial{
+			{
+				Type: "unix",
+				Source: &api.SerialSource{
+					Mode: "bind",
+					Path: fmt.Sprintf("%s/%s/virt-serial1", util.VirtPrivateDir, uid),
+				},
+				Target: &api.SerialTarget{
+					Port: pointer.P(uint(1)),
+				},
+			},
+			{
+				Type: "unix",
+				Source: &api.SerialSource{
+					Mode: "bind",
+					Path: fmt.Sprintf("%s/%s/virt-serial3", util.VirtPrivateDir, uid),
+				},
+				Target: &api.SerialTarget{
+					Port: pointer.P(uint(3)),
+				},
+			},
+		}))
+	})
+
+	It("should configure additional serial ports without the serial console", func() {
+		vmi := libvmi.New(libvmi.WithUID(uid), withAutoattachSerialConsole(false))
+		vmi.Spec.Domain.Devices.Serials = []v1.SerialPort{{Name: "ttyS0", Port: 0}}
+
+		var domain api.Domain
+		Expect(compute.NewConsoleDomainConfigurator(true).Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Consoles).To(BeEmpty())
+		Expect(domain.Spec.Devices.Serials).To(Equal([]api.Serial{
+			{
+				Type: "unix",
+				Source: &api.SerialSource{
+					Mode: "bind",
+					Path: socketPath,
+				},
+				Target: &api.SerialTarget{
+					Port: &serialPort,
+				},
+			},
+		}))
+	})
 })
 
 func withAutoattachSerialConsole(enabled bool) libvmi.Option {
@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	archdefaulter "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api/arch-defaulter"
+)
+
+type EmulatorDomainConfigurator struct {
+	hostArchitecture string
+	emulatorPath     string
+}
+
+// NewEmulatorDomainConfigurator creates a new emulator domain configurator for the given host architecture.
+// A non-empty emulatorPath, provided by an emulator bundle, takes precedence over the qemu binaries of virt-launcher.
+func NewEmulatorDomainConfigurator(hostArchitecture, emulatorPath string) EmulatorDomainConfigurator {
+	return EmulatorDomainConfigurator{
+		hostArchitecture: hostArchitecture,
+		emulatorPath:     emulatorPath,
+	}
+}
+
+// Configure selects the emulator of the bundle and the guest architecture when it differs from the host architecture.
+// virt-launcher only ships the qemu binary of its own architecture, foreign guests require an emulator bundle.
+// It must run after the HypervisorDomainConfigurator, since foreign guests can only be emulated.
+func (e EmulatorDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	if !IsCrossArchitecture(vmi, e.hostArchitecture) {
+		if e.emulatorPath != "" {
+			domain.Spec.Devices.Emulator = e.emulatorPath
+		}
+		return nil
+	}
+
+	if domain.Spec.Type != "qemu" {
+		return fmt.Errorf("guest architecture %s differs from host architecture %s and requires software emulation", vmi.Spec.Architecture, e.hostArchitecture)
+	}
+
+	osTypeArch := archdefaulter.NewArchDefaulter(vmi.Spec.Architecture).OSTypeArch()
+	if e.emulatorPath == "" {
+		return fmt.Errorf("guest architecture %s differs from host architecture %s and requires an emulator bundle providing qemu-system-%s", vmi.Spec.Architecture, e.hostArchitecture, osTypeArch)
+	}
+	domain.Spec.OS.Type.Arch = osTypeArch
+	domain.Spec.Devices.Emulator = e.emulatorPath
+
+	return nil
+}
+
+// IsCrossArchitecture returns true if the VMI architecture differs from the host architecture.
+// An unknown host or guest architecture is never considered foreign.
+func IsCrossArchitecture(vmi *v1.VirtualMachineInstance, hostArchitecture string) bool {
+	return hostArchitecture != "" && vmi.Spec.Architecture != "" && vmi.Spec.Architecture != hostArchitecture
+}
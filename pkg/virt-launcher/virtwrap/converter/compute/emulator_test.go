@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("Emulator Domain Configurator", func() {
+	const hostArchitecture = "amd64"
+
+	var (
+		vmi    *v1.VirtualMachineInstance
+		domain api.Domain
+	)
+
+	BeforeEach(func() {
+		vmi = libvmi.New()
+		domain = api.Domain{}
+	})
+
+	It("Should not modify the domain when the guest architecture matches the host", func() {
+		vmi.Spec.Architecture = hostArchitecture
+		Expect(compute.NewEmulatorDomainConfigurator(hostArchitecture, "").Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should not modify the domain when the guest architecture is unspecified", func() {
+		Expect(compute.NewEmulatorDomainConfigurator(hostArchitecture, "").Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should fail for a foreign guest architecture without software emulation", func() {
+		vmi.Spec.Architecture = "arm64"
+		domain.Spec.Type = "kvm"
+		err := compute.NewEmulatorDomainConfigurator(hostArchitecture, "").Configure(vmi, &domain)
+		Expect(err).To(MatchError(ContainSubstring("requires software emulation")))
+	})
+
+	It("Should fail for a foreign guest architecture without an emulator bundle", func() {
+		vmi.Spec.Architecture = "arm64"
+		domain.Spec.Type = "qemu"
+		err := compute.NewEmulatorDomainConfigurator(hostArchitecture, "").Configure(vmi, &domain)
+		Expect(err).To(MatchError(ContainSubstring("requires an emulator bundle providing qemu-system-aarch64")))
+	})
+
+	DescribeTable("Should set the architecture of a foreign guest", func(guestArchitecture, osTypeArch string) {
+		const bundleEmulator = "/var/run/kubevirt-emulator-bundle/usr/bin/qemu-system"
+		vmi.Spec.Architecture = guestArchitecture
+		domain.Spec.Type = "qemu"
+		Expect(compute.NewEmulatorDomainConfigurator(hostArchitecture, bundleEmulator).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.OS.Type.Arch).To(Equal(osTypeArch))
+	},
+		Entry("arm64", "arm64", "aarch64"),
+		Entry("s390x", "s390x", "s390x"),
+	)
+
+	DescribeTable("Should use the emulator of the bundle", func(guestArchitecture string) {
+		const bundleEmulator = "/var/run/kubevirt-emulator-bundle/usr/bin/qemu-kvm"
+		vmi.Spec.Architecture = guestArchitecture
+		domain.Spec.Type = "qemu"
+		Expect(compute.NewEmulatorDomainConfigurator(hostArchitecture, bundleEmulator).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.Emulator).To(Equal(bundleEmulator))
+	},
+		Entry("for a guest of the host architecture", hostArchitecture),
+		Entry("for a foreign guest architecture", "arm64"),
+	)
+})
@@ -20,11 +20,10 @@
 package compute
 
 import (
-	"fmt"
-
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -55,11 +54,14 @@ func (g GraphicsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, do
 		{
 			Listen: &api.GraphicsListen{
 				Type:   "socket",
-				Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-vnc", vmi.ObjectMeta.UID),
+				Socket: util.VNCSocketPath(vmi),
 			},
 			Type: "vnc",
 		},
 	}
+	if graphics := vmi.Spec.Domain.Devices.Graphics; graphics != nil {
+		domain.Spec.Devices.Graphics[0].Keymap = graphics.Keymap
+	}
 
 	g.configureVideoDevice(vmi, domain)
 
@@ -75,6 +77,12 @@ func (g GraphicsDomainConfigurator) configureVideoDevice(vmi *v1.VirtualMachineI
 				Heads: pointer.P(graphicsDeviceDefaultHeads),
 			},
 		}
+		if resolution := vmi.Spec.Domain.Devices.Video.Resolution; resolution != nil {
+			video.Model.Resolution = &api.VideoResolution{
+				X: resolution.Width,
+				Y: resolution.Height,
+			}
+		}
 		domain.Spec.Devices.Video = []api.Video{video}
 		return
 	}
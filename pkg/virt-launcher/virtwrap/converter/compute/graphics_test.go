@@ -86,6 +86,18 @@ var _ = Describe("Graphics Domain Configurator", func() {
 		)
 	})
 
+	It("should configure the VNC keymap", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{Keymap: "de-ch"}
+
+		domain := api.Domain{}
+		configurator := compute.NewGraphicsDomainConfigurator("amd64", false)
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Graphics).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Graphics[0].Keymap).To(Equal("de-ch"))
+	})
+
 	Context("Video device configuration", func() {
 		DescribeTable("Should use user-specified video type when provided", func(arch string, bochsForEFI bool) {
 			vmi := libvmi.New(libvmi.WithVideo("virtio"))
@@ -129,6 +141,17 @@ var _ = Describe("Graphics Domain Configurator", func() {
 			Entry("on s390x without bochsForEFI", "s390x", false),
 		)
 
+		It("should set the preferred resolution of the video device", func() {
+			vmi := libvmi.New(libvmi.WithVideo("virtio"), libvmi.WithVideoResolution(1920, 1080))
+			var domain api.Domain
+
+			configurator := compute.NewGraphicsDomainConfigurator("amd64", false)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Video).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Video[0].Model.Resolution).To(Equal(&api.VideoResolution{X: 1920, Y: 1080}))
+		})
+
 		DescribeTable("amd64 defaults to VGA with VRAM", func(vmi *v1.VirtualMachineInstance, bochsForEFI bool) {
 			var domain api.Domain
 
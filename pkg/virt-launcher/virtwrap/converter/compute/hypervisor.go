@@ -25,6 +25,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -43,7 +44,16 @@ func NewHypervisorDomainConfigurator(allowEmulation bool, kvmAvailable bool) Hyp
 
 // Configure configures the domain hypervisor settings based on KVM availability and emulation settings
 func (h HypervisorDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	if vmi.IsEmulationEnabled() {
+		log.DefaultLogger().Infof("software emulation requested by the vmi.")
+		domain.Spec.Type = "qemu"
+		return nil
+	}
+
 	if !h.kvmAvailable {
+		if util.IsIsolatedLauncherVMI(vmi) {
+			return fmt.Errorf("kvm not present in the isolated launcher, nested virtualization has to be passed into its micro VM")
+		}
 		if h.allowEmulation {
 			logger := log.DefaultLogger()
 			logger.Infof("kvm not present. Using software emulation.")
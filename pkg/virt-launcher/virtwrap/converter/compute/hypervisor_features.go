@@ -87,6 +87,16 @@ func convert_v1_Features_To_api_Features(source *v1.Features, features *api.Feat
 				State: boolToOnOff(&source.KVM.Hidden, false),
 			},
 		}
+		if source.KVM.HintDedicated != nil {
+			features.KVM.HintDedicated = &api.FeatureState{
+				State: boolToOnOff(source.KVM.HintDedicated, false),
+			}
+		}
+		if source.KVM.PollControl != nil {
+			features.KVM.PollControl = &api.FeatureState{
+				State: boolToOnOff(source.KVM.PollControl, false),
+			}
+		}
 	}
 	if source.Pvspinlock != nil {
 		features.PVSpinlock = &api.FeaturePVSpinlock{
@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
 )
@@ -75,5 +76,30 @@ var _ = Describe("Hypervisor Domain Configurator", func() {
 			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
 			Expect(domain.Spec.Type).To(Equal("qemu"))
 		})
+
+		It("Should return error for isolated launchers even when emulation is allowed", func() {
+			vmi.Spec.LauncherIsolation = v1.LauncherIsolationMicroVM
+			configurator := compute.NewHypervisorDomainConfigurator(emulationAllowed, !kvmEnabled)
+			err := configurator.Configure(vmi, &domain)
+			Expect(err).To(MatchError(ContainSubstring("nested virtualization")))
+		})
+	})
+
+	Context("When the VMI requests emulation", func() {
+		BeforeEach(func() {
+			vmi.Spec.Domain.UseEmulation = pointer.P(true)
+		})
+
+		It("Should set domain type to qemu even when KVM is available", func() {
+			configurator := compute.NewHypervisorDomainConfigurator(!emulationAllowed, kvmEnabled)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Type).To(Equal("qemu"))
+		})
+
+		It("Should set domain type to qemu when KVM is not available", func() {
+			configurator := compute.NewHypervisorDomainConfigurator(!emulationAllowed, !kvmEnabled)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+			Expect(domain.Spec.Type).To(Equal("qemu"))
+		})
 	})
 })
@@ -41,8 +41,15 @@ func (i InputDeviceDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance,
 	if vmi.Spec.Domain.Devices.Inputs != nil {
 		inputDevices := make([]api.Input, 0)
 		for i := range vmi.Spec.Domain.Devices.Inputs {
+			input := &vmi.Spec.Domain.Devices.Inputs[i]
+			if input.Type == v1.InputTypeMultitouch {
+				if err := addMultitouchDevice(input, domain); err != nil {
+					return err
+				}
+				continue
+			}
 			inputDevice := api.Input{}
-			err := convert_v1_Input_To_api_InputDevice(&vmi.Spec.Domain.Devices.Inputs[i], &inputDevice)
+			err := convert_v1_Input_To_api_InputDevice(input, &inputDevice)
 			if err != nil {
 				return err
 			}
@@ -69,7 +76,9 @@ func convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input
 		input.Bus = v1.InputBusUSB
 	}
 
-	if input.Type != v1.InputTypeTablet {
+	switch input.Type {
+	case v1.InputTypeTablet, v1.InputTypeKeyboard, v1.InputTypeMouse:
+	default:
 		return fmt.Errorf("input contains unsupported type %s", input.Type)
 	}
 
@@ -83,12 +92,27 @@ func convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input
 	return nil
 }
 
+// addMultitouchDevice adds a virtio multitouch device, which libvirt has no input type for.
+func addMultitouchDevice(input *v1.Input, domain *api.Domain) error {
+	if input.Bus != v1.InputBusVirtio {
+		return fmt.Errorf("multitouch input %s requires the virtio bus", input.Name)
+	}
+	if domain.Spec.QEMUCmd == nil {
+		domain.Spec.QEMUCmd = &api.Commandline{}
+	}
+	domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg,
+		api.Arg{Value: "-device"},
+		api.Arg{Value: fmt.Sprintf("virtio-multitouch-pci,id=%s%s", api.UserAliasPrefix, input.Name)},
+	)
+	return nil
+}
+
 func (i InputDeviceDomainConfigurator) addArchitectureSpecificInputDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	switch i.architecture {
 	case "amd64":
 		// No architecture-specific input devices required
 	case "arm64":
-		if !hasTabletDevice(vmi) {
+		if !hasInputDevice(vmi, v1.InputTypeTablet) {
 			domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs,
 				api.Input{
 					Bus:  "usb",
@@ -96,29 +120,31 @@ func (i InputDeviceDomainConfigurator) addArchitectureSpecificInputDevices(vmi *
 				},
 			)
 		}
-		domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs,
-			api.Input{
-				Bus:  "usb",
-				Type: "keyboard",
-			},
-		)
+		if !hasInputDevice(vmi, v1.InputTypeKeyboard) {
+			domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs,
+				api.Input{
+					Bus:  "usb",
+					Type: "keyboard",
+				},
+			)
+		}
 	case "s390x":
-		domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs,
-			api.Input{
-				Bus:  "virtio",
-				Type: "keyboard",
-			},
-		)
+		if !hasInputDevice(vmi, v1.InputTypeKeyboard) {
+			domain.Spec.Devices.Inputs = append(domain.Spec.Devices.Inputs,
+				api.Input{
+					Bus:  "virtio",
+					Type: "keyboard",
+				},
+			)
+		}
 	}
 	return nil
 }
 
-func hasTabletDevice(vmi *v1.VirtualMachineInstance) bool {
-	if vmi.Spec.Domain.Devices.Inputs != nil {
-		for _, device := range vmi.Spec.Domain.Devices.Inputs {
-			if device.Type == "tablet" {
-				return true
-			}
+func hasInputDevice(vmi *v1.VirtualMachineInstance, inputType v1.InputType) bool {
+	for _, device := range vmi.Spec.Domain.Devices.Inputs {
+		if device.Type == inputType {
+			return true
 		}
 	}
 	return false
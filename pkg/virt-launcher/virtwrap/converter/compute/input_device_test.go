@@ -81,8 +81,46 @@ var _ = Describe("Input Device Configurator", func() {
 			Expect(err.Error()).To(ContainSubstring(expectedError))
 		},
 			Entry("unsupported bus", v1.InputBus("ps2"), v1.InputTypeTablet, "unsupported bus"),
-			Entry("unsupported type", v1.InputBusUSB, v1.InputType("keyboard"), "unsupported type"),
+			Entry("unsupported type", v1.InputBusUSB, v1.InputType("joystick"), "unsupported type"),
+			Entry("multitouch on usb bus", v1.InputBusUSB, v1.InputTypeMultitouch, "requires the virtio bus"),
 		)
+
+		DescribeTable("should configure keyboard and pointer devices", func(deviceType v1.InputType) {
+			vmi := libvmi.New(libvmi.WithAutoattachGraphicsDevice(false))
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "my-input", Type: deviceType}}
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator("amd64")
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Inputs).To(Equal([]api.Input{{
+				Type:  deviceType,
+				Bus:   v1.InputBusUSB,
+				Alias: api.NewUserDefinedAlias("my-input"),
+			}}))
+		},
+			Entry("keyboard", v1.InputTypeKeyboard),
+			Entry("relative mouse", v1.InputTypeMouse),
+		)
+
+		It("should add multitouch devices as QEMU arguments", func() {
+			vmi := libvmi.New(libvmi.WithAutoattachGraphicsDevice(false))
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{
+				{Name: "touch", Type: v1.InputTypeMultitouch, Bus: v1.InputBusVirtio},
+				{Name: "my-tablet", Type: v1.InputTypeTablet, Bus: v1.InputBusVirtio},
+			}
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator("amd64")
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Inputs).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Inputs[0].Type).To(Equal(v1.InputTypeTablet))
+			Expect(domain.Spec.QEMUCmd).To(Equal(&api.Commandline{QEMUArg: []api.Arg{
+				{Value: "-device"},
+				{Value: "virtio-multitouch-pci,id=ua-touch"},
+			}}))
+		})
 	})
 
 	Context("Architecture-specific input devices", func() {
@@ -116,6 +154,23 @@ var _ = Describe("Input Device Configurator", func() {
 			}),
 		)
 
+		DescribeTable("should not add a keyboard when one is specified", func(arch string) {
+			vmi := libvmi.New(libvmi.WithTablet("my-tablet", v1.InputBusVirtio))
+			vmi.Spec.Domain.Devices.Inputs = append(vmi.Spec.Domain.Devices.Inputs,
+				v1.Input{Name: "my-keyboard", Type: v1.InputTypeKeyboard, Bus: v1.InputBusVirtio},
+			)
+			var domain api.Domain
+
+			configurator := compute.NewInputDeviceDomainConfigurator(arch)
+			Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+			Expect(domain.Spec.Devices.Inputs).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Inputs[1].Alias).To(Equal(api.NewUserDefinedAlias("my-keyboard")))
+		},
+			Entry("arm64", "arm64"),
+			Entry("s390x", "s390x"),
+		)
+
 		DescribeTable("should not add architecture-specific input devices when AutoattachGraphicsDevice is false",
 			func(arch string) {
 				vmi := libvmi.New()
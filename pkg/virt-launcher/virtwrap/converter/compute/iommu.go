@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+type IOMMUDomainConfigurator struct {
+	architecture string
+}
+
+func NewIOMMUDomainConfigurator(architecture string) IOMMUDomainConfigurator {
+	return IOMMUDomainConfigurator{
+		architecture: architecture,
+	}
+}
+
+func (i IOMMUDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	vmiIOMMU := vmi.Spec.Domain.Devices.IOMMU
+	if vmiIOMMU == nil {
+		return nil
+	}
+
+	model := vmiIOMMU.Model
+	if model == "" {
+		model = i.defaultModel()
+	}
+
+	switch model {
+	case v1.IOMMUModelIntel:
+		if i.architecture != "amd64" {
+			return fmt.Errorf("IOMMU model %s is not supported on architecture %s", model, i.architecture)
+		}
+	case v1.IOMMUModelVirtio:
+	default:
+		return fmt.Errorf("invalid IOMMU model: %s", model)
+	}
+
+	iommu := &api.IOMMU{Model: string(model)}
+	if model == v1.IOMMUModelIntel {
+		driver := api.IOMMUDriver{
+			IntRemap:    optionalBoolToOnOff(vmiIOMMU.InterruptRemapping),
+			CachingMode: optionalBoolToOnOff(vmiIOMMU.CachingMode),
+			EIM:         optionalBoolToOnOff(vmiIOMMU.EIM),
+		}
+		if driver != (api.IOMMUDriver{}) {
+			iommu.Driver = &driver
+		}
+
+		// Interrupt remapping requires the IOAPIC to be emulated by QEMU instead of KVM
+		if vmiIOMMU.InterruptRemapping != nil && *vmiIOMMU.InterruptRemapping {
+			if domain.Spec.Features == nil {
+				domain.Spec.Features = &api.Features{}
+			}
+			domain.Spec.Features.IOAPIC = &api.FeatureIOAPIC{Driver: "qemu"}
+		}
+	}
+
+	domain.Spec.Devices.IOMMU = iommu
+
+	return nil
+}
+
+func (i IOMMUDomainConfigurator) defaultModel() v1.IOMMUModel {
+	if i.architecture == "amd64" {
+		return v1.IOMMUModelIntel
+	}
+	return v1.IOMMUModelVirtio
+}
+
+func optionalBoolToOnOff(value *bool) string {
+	if value == nil {
+		return ""
+	}
+	return boolToOnOff(value, false)
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("IOMMU Domain Configurator", func() {
+	It("Should not configure an IOMMU when it is unspecified in VMI", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.NewIOMMUDomainConfigurator("amd64").Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	DescribeTable("Should configure the IOMMU", func(arch string, iommu v1.IOMMUDevice, expectedDomain api.Domain) {
+		vmi := libvmi.New(withIOMMU(iommu))
+		var domain api.Domain
+
+		Expect(compute.NewIOMMUDomainConfigurator(arch).Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(expectedDomain))
+	},
+		Entry("with the intel model by default on amd64", "amd64", v1.IOMMUDevice{},
+			api.Domain{Spec: api.DomainSpec{Devices: api.Devices{IOMMU: &api.IOMMU{Model: "intel"}}}},
+		),
+		Entry("with the virtio model by default on arm64", "arm64", v1.IOMMUDevice{},
+			api.Domain{Spec: api.DomainSpec{Devices: api.Devices{IOMMU: &api.IOMMU{Model: "virtio"}}}},
+		),
+		Entry("with the virtio model on amd64", "amd64", v1.IOMMUDevice{Model: v1.IOMMUModelVirtio},
+			api.Domain{Spec: api.DomainSpec{Devices: api.Devices{IOMMU: &api.IOMMU{Model: "virtio"}}}},
+		),
+		Entry("with caching mode", "amd64", v1.IOMMUDevice{CachingMode: pointer.P(true)},
+			api.Domain{Spec: api.DomainSpec{Devices: api.Devices{IOMMU: &api.IOMMU{
+				Model:  "intel",
+				Driver: &api.IOMMUDriver{CachingMode: "on"},
+			}}}},
+		),
+		Entry("with interrupt remapping and the QEMU IOAPIC", "amd64",
+			v1.IOMMUDevice{InterruptRemapping: pointer.P(true), EIM: pointer.P(true)},
+			api.Domain{Spec: api.DomainSpec{
+				Features: &api.Features{IOAPIC: &api.FeatureIOAPIC{Driver: "qemu"}},
+				Devices: api.Devices{IOMMU: &api.IOMMU{
+					Model:  "intel",
+					Driver: &api.IOMMUDriver{IntRemap: "on", EIM: "on"},
+				}},
+			}},
+		),
+		Entry("with interrupt remapping explicitly disabled", "amd64", v1.IOMMUDevice{InterruptRemapping: pointer.P(false)},
+			api.Domain{Spec: api.DomainSpec{Devices: api.Devices{IOMMU: &api.IOMMU{
+				Model:  "intel",
+				Driver: &api.IOMMUDriver{IntRemap: "off"},
+			}}}},
+		),
+	)
+
+	It("should fail when the intel model is used on arm64", func() {
+		vmi := libvmi.New(withIOMMU(v1.IOMMUDevice{Model: v1.IOMMUModelIntel}))
+		var domain api.Domain
+
+		Expect(compute.NewIOMMUDomainConfigurator("arm64").Configure(vmi, &domain)).
+			To(MatchError("IOMMU model intel is not supported on architecture arm64"))
+	})
+
+	It("should fail when an invalid model is specified", func() {
+		vmi := libvmi.New(withIOMMU(v1.IOMMUDevice{Model: "invalid-model"}))
+		var domain api.Domain
+
+		Expect(compute.NewIOMMUDomainConfigurator("amd64").Configure(vmi, &domain)).
+			To(MatchError("invalid IOMMU model: invalid-model"))
+	})
+})
+
+func withIOMMU(iommu v1.IOMMUDevice) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.Devices.IOMMU = &iommu
+	}
+}
@@ -83,9 +83,23 @@ func amd64LaunchSecurity(vmi *v1.VirtualMachineInstance) *api.LaunchSecurity {
 		}
 		return domain
 	} else if launchSec.TDX != nil {
-		return &api.LaunchSecurity{
-			Type: "tdx",
+		domain := &api.LaunchSecurity{
+			Type:          "tdx",
+			MrConfigID:    launchSec.TDX.MrConfigID,
+			MrOwner:       launchSec.TDX.MrOwner,
+			MrOwnerConfig: launchSec.TDX.MrOwnerConfig,
+		}
+		// Without a policy libvirt applies its default TD attributes
+		if launchSec.TDX.Policy != nil {
+			tdxPolicyBits := launchsecurity.TDXPolicyToBits(launchSec.TDX.Policy)
+			domain.Policy = "0x" + strconv.FormatUint(uint64(tdxPolicyBits), 16)
 		}
+		if launchSec.TDX.Attestation != nil {
+			domain.QuoteGenerationService = &api.QuoteGenerationService{
+				Path: util.TDXQuoteGenerationServiceSocket,
+			}
+		}
+		return domain
 	}
 
 	return nil
@@ -26,6 +26,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
 )
@@ -44,6 +45,17 @@ var _ = Describe("LaunchSecurity Domain Configurator", func() {
 		Entry("on s390x", "s390x"),
 	)
 
+	It("should not configure LaunchSecurity for IBM Secure Execution on s390x", func() {
+		vmi := libvmi.New()
+		vmi.Spec.Architecture = "s390x"
+		vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{}
+		var domain api.Domain
+
+		configurator := compute.NewLaunchSecurityDomainConfigurator("s390x")
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.LaunchSecurity).To(BeNil())
+	})
+
 	It("should configure LaunchSecurity when specified in the VMI on amd64", func() {
 		vmi := libvmi.New(libvmi.WithSEV(true, true))
 		var domain api.Domain
@@ -82,6 +94,48 @@ var _ = Describe("LaunchSecurity Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("should configure the TDX policy, measurements and quote generation service", func() {
+		vmi := libvmi.New(withTDX())
+		vmi.Spec.Domain.LaunchSecurity.TDX = &v1.TDX{
+			Policy:        &v1.TDXPolicy{SEPTVEDisable: pointer.P(true)},
+			MrConfigID:    "config",
+			MrOwner:       "owner",
+			MrOwnerConfig: "owner-config",
+			Attestation:   &v1.TDXAttestation{},
+		}
+		var domain api.Domain
+
+		configurator := compute.NewLaunchSecurityDomainConfigurator("amd64")
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := api.Domain{
+			Spec: api.DomainSpec{
+				LaunchSecurity: &api.LaunchSecurity{
+					Type:          "tdx",
+					Policy:        "0x10000000",
+					MrConfigID:    "config",
+					MrOwner:       "owner",
+					MrOwnerConfig: "owner-config",
+					QuoteGenerationService: &api.QuoteGenerationService{
+						Path: "/var/run/tdx-qgs/qgs.socket",
+					},
+				},
+			},
+		}
+		Expect(domain).To(Equal(expectedDomain))
+	})
+
+	It("should set the TDX policy without flags when they are disabled", func() {
+		vmi := libvmi.New(withTDX())
+		vmi.Spec.Domain.LaunchSecurity.TDX.Policy = &v1.TDXPolicy{SEPTVEDisable: pointer.P(false)}
+		var domain api.Domain
+
+		configurator := compute.NewLaunchSecurityDomainConfigurator("amd64")
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x0"))
+		Expect(domain.Spec.LaunchSecurity.QuoteGenerationService).To(BeNil())
+	})
 })
 
 func withTDX() libvmi.Option {
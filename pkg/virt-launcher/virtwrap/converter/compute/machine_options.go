@@ -0,0 +1,48 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// MachineOptionsDomainConfigurator turns off legacy machine devices.
+// Parallel ports are not covered since libvirt starts QEMU with -nodefaults.
+// It has to run after the hypervisor features configurator, which resets the domain features.
+type MachineOptionsDomainConfigurator struct{}
+
+func (m MachineOptionsDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	machineOptions := vmi.Spec.Domain.MachineOptions
+	if machineOptions == nil {
+		return nil
+	}
+
+	if machineOptions.PS2 != nil && !*machineOptions.PS2 {
+		if domain.Spec.Features == nil {
+			domain.Spec.Features = &api.Features{}
+		}
+		// libvirt renders this as i8042=off on q35
+		domain.Spec.Features.PS2 = &api.FeatureState{State: "off"}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("Machine Options Domain Configurator", func() {
+	It("Should not configure anything when machine options are unspecified in VMI", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.MachineOptionsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should not configure anything when the legacy devices are enabled", func() {
+		vmi := libvmi.New(withMachineOptions(v1.MachineOptions{PS2: pointer.P(true)}))
+		var domain api.Domain
+
+		Expect(compute.MachineOptionsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should turn off PS/2", func() {
+		vmi := libvmi.New(withMachineOptions(v1.MachineOptions{PS2: pointer.P(false)}))
+		domain := api.Domain{Spec: api.DomainSpec{Features: &api.Features{ACPI: &api.FeatureEnabled{}}}}
+
+		Expect(compute.MachineOptionsDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		expectedDomain := api.Domain{
+			Spec: api.DomainSpec{
+				Features: &api.Features{
+					ACPI: &api.FeatureEnabled{},
+					PS2:  &api.FeatureState{State: "off"},
+				},
+			},
+		}
+		Expect(domain).To(Equal(expectedDomain))
+	})
+})
+
+func withMachineOptions(machineOptions v1.MachineOptions) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Domain.MachineOptions = &machineOptions
+	}
+}
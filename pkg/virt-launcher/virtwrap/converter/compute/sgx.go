@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+const sgxEPCMemoryModel = "sgx-epc"
+
+// sgxCPUFeatures are required by the guest to use the EPC section, sgxlc lets it
+// run enclaves signed by its own launch keys.
+var sgxCPUFeatures = []string{"sgx", "sgx1", "sgxlc"}
+
+type SGXDomainConfigurator struct{}
+
+func (s SGXDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	sgx := vmi.Spec.Domain.Devices.SGX
+	if sgx == nil {
+		return nil
+	}
+
+	epcSize, err := vcpu.QuantityToByte(sgx.EPCSize)
+	if err != nil {
+		return err
+	}
+	domain.Spec.Devices.MemoryDevices = append(domain.Spec.Devices.MemoryDevices, api.MemoryDevice{
+		Model:  sgxEPCMemoryModel,
+		Target: &api.MemoryTarget{Size: epcSize},
+	})
+
+	if vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Model == v1.CPUModeHostPassthrough {
+		return nil
+	}
+	for _, feature := range sgxCPUFeatures {
+		if !hasCPUFeature(vmi, feature) {
+			domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
+				Name:   feature,
+				Policy: "require",
+			})
+		}
+	}
+
+	return nil
+}
+
+func hasCPUFeature(vmi *v1.VirtualMachineInstance, name string) bool {
+	if vmi.Spec.Domain.CPU == nil {
+		return false
+	}
+	for _, feature := range vmi.Spec.Domain.CPU.Features {
+		if feature.Name == name {
+			return true
+		}
+	}
+	return false
+}
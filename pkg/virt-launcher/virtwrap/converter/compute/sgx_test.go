@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("SGX Domain Configurator", func() {
+	It("Should not configure SGX when it is not requested by the VMI", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should add an EPC section and require the SGX CPU features", func() {
+		vmi := libvmi.New(libvmi.WithSGX("64Mi"))
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.MemoryDevices).To(Equal([]api.MemoryDevice{{
+			Model:  "sgx-epc",
+			Target: &api.MemoryTarget{Size: api.Memory{Value: 67108864, Unit: "b"}},
+		}}))
+		Expect(domain.Spec.CPU.Features).To(Equal([]api.CPUFeature{
+			{Name: "sgx", Policy: "require"},
+			{Name: "sgx1", Policy: "require"},
+			{Name: "sgxlc", Policy: "require"},
+		}))
+	})
+
+	It("Should not override SGX CPU features set in the VMI", func() {
+		vmi := libvmi.New(libvmi.WithSGX("64Mi"), libvmi.WithCPUFeature("sgxlc", "disable"))
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.CPU.Features).To(Equal([]api.CPUFeature{
+			{Name: "sgx", Policy: "require"},
+			{Name: "sgx1", Policy: "require"},
+		}))
+	})
+
+	It("Should not add SGX CPU features with host-passthrough", func() {
+		vmi := libvmi.New(libvmi.WithSGX("64Mi"), libvmi.WithCPUModel(v1.CPUModeHostPassthrough))
+		var domain api.Domain
+
+		Expect(compute.SGXDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.MemoryDevices).To(HaveLen(1))
+		Expect(domain.Spec.CPU.Features).To(BeEmpty())
+	})
+})
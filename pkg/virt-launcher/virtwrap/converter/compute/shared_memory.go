@@ -0,0 +1,53 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+type SharedMemoryDomainConfigurator struct{}
+
+func (s SharedMemoryDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	for _, shmem := range vmi.Spec.Domain.Devices.SharedMemoryDevices {
+		sharedMemory := api.SharedMemory{
+			Name:  shmem.Name,
+			Model: api.SharedMemoryModel{Type: string(v1.SharedMemoryModelPlain)},
+		}
+		if shmem.Model == v1.SharedMemoryModelDoorbell {
+			sharedMemory.Model.Type = string(v1.SharedMemoryModelDoorbell)
+			sharedMemory.Server = &api.SharedMemoryServer{
+				Path: util.SharedMemoryServerSocketPath(shmem.Name),
+			}
+		}
+		if shmem.Size != nil {
+			sharedMemory.Size = &api.SharedMemorySize{
+				Value: uint64(shmem.Size.Value()),
+				Unit:  "b",
+			}
+		}
+		domain.Spec.Devices.SharedMemories = append(domain.Spec.Devices.SharedMemories, sharedMemory)
+	}
+
+	return nil
+}
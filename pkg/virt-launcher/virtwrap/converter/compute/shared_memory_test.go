@@ -0,0 +1,78 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package compute_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
+)
+
+var _ = Describe("Shared Memory Domain Configurator", func() {
+	It("Should not configure shared memory when none is specified in VMI", func() {
+		vmi := libvmi.New()
+		var domain api.Domain
+
+		Expect(compute.SharedMemoryDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain).To(Equal(api.Domain{}))
+	})
+
+	It("Should configure plain and doorbell shared memory devices", func() {
+		size := resource.MustParse("4Mi")
+		vmi := libvmi.New(
+			libvmi.WithSharedMemoryDevice(v1.SharedMemoryDevice{Name: "ring", Size: &size}),
+			libvmi.WithSharedMemoryDevice(v1.SharedMemoryDevice{
+				Name:             "bell",
+				Model:            v1.SharedMemoryModelDoorbell,
+				ServerSocketPath: "/run/ivshmem/bell.sock",
+			}),
+		)
+		var domain api.Domain
+
+		Expect(compute.SharedMemoryDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := api.Domain{
+			Spec: api.DomainSpec{
+				Devices: api.Devices{
+					SharedMemories: []api.SharedMemory{
+						{
+							Name:  "ring",
+							Model: api.SharedMemoryModel{Type: "ivshmem-plain"},
+							Size:  &api.SharedMemorySize{Value: 4194304, Unit: "b"},
+						},
+						{
+							Name:   "bell",
+							Model:  api.SharedMemoryModel{Type: "ivshmem-doorbell"},
+							Server: &api.SharedMemoryServer{Path: "/var/run/kubevirt-shmem/bell.sock"},
+						},
+					},
+				},
+			},
+		}
+		Expect(domain).To(Equal(expectedDomain))
+	})
+})
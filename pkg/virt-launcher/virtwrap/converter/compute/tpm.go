@@ -37,7 +37,7 @@ func (t TPMDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 		Model: "tpm-tis",
 		Backend: api.TPMBackend{
 			Type:    "emulator",
-			Version: "2.0",
+			Version: string(tpm.Version(&vmi.Spec)),
 		},
 	}
 
@@ -46,8 +46,16 @@ func (t TPMDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain
 
 		// tpm-crb is not technically required for persistence, but since there was a desire for both,
 		//   we decided to introduce them together. Ultimately, we should use tpm-crb for all cases,
-		//   as it is now the generally preferred model
-		newTPMDevice.Model = "tpm-crb"
+		//   as it is now the generally preferred model. tpm-crb only supports TPM 2.0 though.
+		if tpm.Version(&vmi.Spec) == v1.TPMVersion20 {
+			newTPMDevice.Model = "tpm-crb"
+		}
+	}
+
+	if tpm.HasEncryptedState(&vmi.Spec) {
+		newTPMDevice.Backend.Encryption = &api.TPMBackendEncryption{
+			Secret: string(vmi.UID),
+		}
 	}
 
 	domain.Spec.Devices.TPMs = []api.TPM{newTPMDevice}
@@ -23,6 +23,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
@@ -85,4 +89,45 @@ var _ = Describe("TPM Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should configure a persistent TPM 1.2 device with tpm-tis", func() {
+		vmi := libvmi.New(libvmi.WithTPM(true))
+		vmi.Spec.Domain.Devices.TPM.Version = v1.TPMVersion12
+		var domain api.Domain
+
+		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.TPMs).To(Equal([]api.TPM{
+			{
+				Model: "tpm-tis",
+				Backend: api.TPMBackend{
+					Type:            "emulator",
+					Version:         "1.2",
+					PersistentState: "yes",
+				},
+			},
+		}))
+	})
+
+	It("Should encrypt the persistent TPM state with the secret of the VMI", func() {
+		vmi := libvmi.New(libvmi.WithTPM(true), libvmi.WithUID("test-uid"))
+		vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef = &k8sv1.LocalObjectReference{Name: "tpm-passphrase"}
+		var domain api.Domain
+
+		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+		Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(Equal(&api.TPMBackendEncryption{Secret: "test-uid"}))
+	})
+
+	It("Should not encrypt the state of a non-persistent TPM", func() {
+		vmi := libvmi.New(libvmi.WithTPM(false))
+		vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef = &k8sv1.LocalObjectReference{Name: "tpm-passphrase"}
+		var domain api.Domain
+
+		Expect(compute.TPMDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+		Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(BeNil())
+	})
 })
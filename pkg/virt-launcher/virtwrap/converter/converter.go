@@ -26,6 +26,8 @@ package converter
 */
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
@@ -48,12 +50,14 @@ import (
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/guestsecrets"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/smartcard"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -73,6 +77,7 @@ const (
 	deviceTypeNotCompatibleFmt = "device %s is of type lun. Not compatible with a file based disk"
 	defaultIOThread            = uint(1)
 	QEMUSeaBiosDebugPipe       = "/var/run/kubevirt-private/QEMUSeaBiosDebugPipe"
+	nvmePrefix                 = "nvme"
 )
 
 type deviceNamer struct {
@@ -88,8 +93,11 @@ type EFIConfiguration struct {
 
 type ConverterContext struct {
 	Architecture                    arch.Converter
+	HostArchitecture                string
+	EmulatorPath                    string
 	AllowEmulation                  bool
 	KvmAvailable                    bool
+	VhostNetAvailable               bool
 	Secrets                         map[string]*k8sv1.Secret
 	VirtualMachine                  *v1.VirtualMachineInstance
 	CPUSet                          []int
@@ -132,6 +140,79 @@ func assignDiskToSCSIController(disk *api.Disk, unit int) {
 	disk.Address.Unit = strconv.Itoa(unit)
 }
 
+// assignDiskToNVMeController attaches a disk as the first namespace of its own nvme
+// controller, the controllers are indexed like the disks on the nvme bus.
+func assignDiskToNVMeController(disk *api.Disk, index int) {
+	disk.Address = &api.Address{
+		Type:       "drive",
+		Controller: strconv.Itoa(index),
+		Bus:        "0",
+		Target:     "0",
+		Unit:       "0",
+	}
+}
+
+// convertNVMeControllers creates the controllers of the disks on the nvme bus. The serial
+// of a disk belongs to its controller, since nvme namespaces don't have one.
+func convertNVMeControllers(disks []api.Disk) []api.Controller {
+	var controllers []api.Controller
+	for i := range disks {
+		disk := &disks[i]
+		if disk.Target.Bus != v1.DiskBusNVMe || disk.Address == nil {
+			continue
+		}
+		controllers = append(controllers, api.Controller{
+			Type:   "nvme",
+			Index:  disk.Address.Controller,
+			Serial: disk.Serial,
+		})
+		disk.Serial = ""
+	}
+	return controllers
+}
+
+// setSCSIController attaches a disk on the scsi bus to the controller it names,
+// controllers are indexed in the order they are listed in the vmi.
+func setSCSIController(vmi *v1.VirtualMachineInstance, diskDevice *v1.Disk, disk *api.Disk) error {
+	if diskDevice.SCSIController == "" || disk.Target.Bus != v1.DiskBusSCSI || disk.Address == nil {
+		return nil
+	}
+	for i, controller := range vmi.Spec.Domain.Devices.SCSIControllers {
+		if controller.Name == diskDevice.SCSIController {
+			disk.Address.Controller = strconv.Itoa(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("scsi controller %s of disk %s not found", diskDevice.SCSIController, diskDevice.Name)
+}
+
+func convertSCSIControllers(vmi *v1.VirtualMachineInstance, c *ConverterContext, driver *api.ControllerDriver, numQueues *uint) []api.Controller {
+	model := virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture())
+
+	var controllers []api.Controller
+	for i, scsiController := range vmi.Spec.Domain.Devices.SCSIControllers {
+		queues := numQueues
+		if scsiController.Queues != nil {
+			queues = pointer.P(uint(*scsiController.Queues))
+		}
+
+		controllerDriver := driver
+		if queues != nil {
+			controllerDriver = &api.ControllerDriver{}
+			if driver != nil {
+				*controllerDriver = *driver
+			}
+			controllerDriver.Queues = queues
+		}
+
+		controller := c.Architecture.ScsiController(model, controllerDriver)
+		controller.Index = strconv.Itoa(i)
+		controller.Alias = api.NewUserDefinedAlias(scsiController.Name)
+		controllers = append(controllers, controller)
+	}
+	return controllers
+}
+
 func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk *api.Disk, prefixMap map[string]deviceNamer, numQueues *uint, volumeStatusMap map[string]v1.VolumeStatus) error {
 	if diskDevice.Disk != nil {
 		var unit int
@@ -141,6 +222,9 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		if diskDevice.Disk.Bus == "scsi" {
 			assignDiskToSCSIController(disk, unit)
 		}
+		if diskDevice.Disk.Bus == v1.DiskBusNVMe {
+			assignDiskToNVMeController(disk, unit)
+		}
 		if diskDevice.Disk.PciAddress != "" {
 			if diskDevice.Disk.Bus != v1.DiskBusVirtio {
 				return fmt.Errorf("setting a pci address is not allowed for non-virtio bus types, for disk %s", diskDevice.Name)
@@ -152,10 +236,17 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			disk.Address = addr
 		}
 		if diskDevice.Disk.Bus == v1.DiskBusVirtio {
-			disk.Model = virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture())
+			disk.Model = virtio.InterpretTransitionalModelType(
+				virtio.DeviceTransitional(diskDevice.Disk.VirtioTransitional, &c.UseVirtioTransitional),
+				c.Architecture.GetArchitecture(),
+			)
 		}
 		disk.ReadOnly = toApiReadOnly(diskDevice.Disk.ReadOnly)
-		disk.Serial = diskDevice.Serial
+		disk.Serial = diskSerial(diskDevice)
+		if disk.Serial == "" && diskDevice.Disk.Bus == v1.DiskBusNVMe {
+			// QEMU requires a serial for every nvme controller
+			disk.Serial = volumeNameHashSerial(diskDevice.Name)
+		}
 		if diskDevice.Shareable != nil {
 			if *diskDevice.Shareable {
 				if diskDevice.Cache == "" {
@@ -206,13 +297,19 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 			disk.ExpandDisksEnabled = c.ExpandDisksEnabled
 		}
 	}
-	if numQueues != nil && disk.Target.Bus == v1.DiskBusVirtio {
-		disk.Driver.Queues = numQueues
+	if disk.Target.Bus == v1.DiskBusVirtio {
+		if diskDevice.Queues != nil {
+			disk.Driver.Queues = pointer.P(uint(*diskDevice.Queues))
+		} else if numQueues != nil {
+			disk.Driver.Queues = numQueues
+		}
 	}
 	disk.Alias = api.NewUserDefinedAlias(diskDevice.Name)
+	disk.WWN = diskDevice.WWN
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	disk.IOTune = toApiDiskIOTune(diskDevice.IOTune)
 	if (c.UseLaunchSecuritySEV || c.UseLaunchSecurityPV) && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
@@ -220,6 +317,72 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	return nil
 }
 
+// diskSerial returns the serial number of a disk. With the VolumeNameHash policy, disks which
+// do not specify a serial number get one derived from the volume name, which fits into the
+// 20 characters of a virtio-blk serial number.
+func diskSerial(diskDevice *v1.Disk) string {
+	if diskDevice.Serial != "" || diskDevice.SerialPolicy != v1.DiskSerialPolicyVolumeNameHash {
+		return diskDevice.Serial
+	}
+	return volumeNameHashSerial(diskDevice.Name)
+}
+
+func volumeNameHashSerial(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:])[:20]
+}
+
+// toApiDiskIOTune converts the IO limits of a disk. Unset limits are 0, which libvirt
+// treats as unlimited. The burst length applies to every limit which can burst.
+func toApiDiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	value := func(v *uint64) uint64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	apiIOTune := &api.DiskIOTune{
+		TotalBytesSec: value(ioTune.TotalBytesSec),
+		ReadBytesSec:  value(ioTune.ReadBytesSec),
+		WriteBytesSec: value(ioTune.WriteBytesSec),
+		TotalIOPSSec:  value(ioTune.TotalIOPSSec),
+		ReadIOPSSec:   value(ioTune.ReadIOPSSec),
+		WriteIOPSSec:  value(ioTune.WriteIOPSSec),
+	}
+	burst := ioTune.Burst
+	if burst == nil {
+		return apiIOTune
+	}
+	apiIOTune.TotalBytesSecMax = value(burst.TotalBytesSec)
+	apiIOTune.ReadBytesSecMax = value(burst.ReadBytesSec)
+	apiIOTune.WriteBytesSecMax = value(burst.WriteBytesSec)
+	apiIOTune.TotalIOPSSecMax = value(burst.TotalIOPSSec)
+	apiIOTune.ReadIOPSSecMax = value(burst.ReadIOPSSec)
+	apiIOTune.WriteIOPSSecMax = value(burst.WriteIOPSSec)
+	if burst.LengthSeconds == nil {
+		return apiIOTune
+	}
+	for _, limit := range []struct {
+		max    uint64
+		length *uint64
+	}{
+		{apiIOTune.TotalBytesSecMax, &apiIOTune.TotalBytesSecMaxLength},
+		{apiIOTune.ReadBytesSecMax, &apiIOTune.ReadBytesSecMaxLength},
+		{apiIOTune.WriteBytesSecMax, &apiIOTune.WriteBytesSecMaxLength},
+		{apiIOTune.TotalIOPSSecMax, &apiIOTune.TotalIOPSSecMaxLength},
+		{apiIOTune.ReadIOPSSecMax, &apiIOTune.ReadIOPSSecMaxLength},
+		{apiIOTune.WriteIOPSSecMax, &apiIOTune.WriteIOPSSecMaxLength},
+	} {
+		if limit.max != 0 {
+			*limit.length = *burst.LengthSeconds
+		}
+	}
+	return apiIOTune
+}
+
 func setReservation(disk *api.Disk) {
 	disk.Source.Reservations = &api.Reservations{
 		Managed: "no",
@@ -234,7 +397,7 @@ func setReservation(disk *api.Disk) {
 func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	if diskDevice.ErrorPolicy == nil {
 		disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
-		return nil
+		return setReadErrorPolicy(diskDevice, disk)
 	}
 	switch *diskDevice.ErrorPolicy {
 	case v1.DiskErrorPolicyStop, v1.DiskErrorPolicyIgnore, v1.DiskErrorPolicyReport, v1.DiskErrorPolicyEnospace:
@@ -242,6 +405,19 @@ func setErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
 	default:
 		return fmt.Errorf("error policy %s not recognized", *diskDevice.ErrorPolicy)
 	}
+	return setReadErrorPolicy(diskDevice, disk)
+}
+
+func setReadErrorPolicy(diskDevice *v1.Disk, disk *api.Disk) error {
+	if diskDevice.RerrorPolicy == nil {
+		return nil
+	}
+	switch *diskDevice.RerrorPolicy {
+	case v1.DiskErrorPolicyStop, v1.DiskErrorPolicyIgnore, v1.DiskErrorPolicyReport:
+		disk.Driver.RerrorPolicy = *diskDevice.RerrorPolicy
+	default:
+		return fmt.Errorf("read error policy %s not recognized", *diskDevice.RerrorPolicy)
+	}
 	return nil
 }
 
@@ -556,7 +732,7 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	deviceNamer := prefixMap[prefix]
 	if name, ok := deviceNamer.getExistingVolumeValue(diskName); ok {
 		for i := 0; i < 26*26*26; i++ {
-			calculatedName := FormatDeviceName(prefix, i)
+			calculatedName := formatDeviceName(prefix, i)
 			if calculatedName == name {
 				return name, i
 			}
@@ -566,7 +742,7 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	}
 	// Name not found yet, generate next new one.
 	for i := 0; i < 26*26*26; i++ {
-		name := FormatDeviceName(prefix, i)
+		name := formatDeviceName(prefix, i)
 		if _, ok := deviceNamer.getExistingTargetValue(name); !ok {
 			deviceNamer.existingNameMap[diskName] = name
 			deviceNamer.usedDeviceMap[name] = diskName
@@ -576,6 +752,15 @@ func makeDeviceName(diskName string, bus v1.DiskBus, prefixMap map[string]device
 	return "", 0
 }
 
+// formatDeviceName names the disks on the nvme bus after the namespace they are attached
+// as, the guest itself enumerates them on its own though.
+func formatDeviceName(prefix string, index int) string {
+	if prefix == nvmePrefix {
+		return fmt.Sprintf("%s%dn1", nvmePrefix, index)
+	}
+	return FormatDeviceName(prefix, index)
+}
+
 // port of http://elixir.free-electrons.com/linux/v4.15/source/drivers/scsi/sd.c#L3211
 func FormatDeviceName(prefix string, index int) string {
 	base := int('z' - 'a' + 1)
@@ -661,6 +846,10 @@ func Convert_v1_Hotplug_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c
 	if source.DataVolume != nil {
 		return Convert_v1_Hotplug_DataVolume_To_api_Disk(source.Name, disk, c)
 	}
+
+	if source.ConfigMap != nil || source.Secret != nil || source.ServiceAccount != nil {
+		return Convert_v1_Hotplug_Config_To_api_Disk(source.Name, disk)
+	}
 	return fmt.Errorf("hotplug disk %s references an unsupported source", disk.Alias.GetName())
 }
 
@@ -672,6 +861,14 @@ func Convert_v1_Missing_Volume_To_api_Disk(disk *api.Disk) error {
 	return nil
 }
 
+// Convert_v1_Hotplug_Config_To_api_Disk converts a hotplugged ConfigMap, Secret or ServiceAccount to the api disk of its iso image
+func Convert_v1_Hotplug_Config_To_api_Disk(volumeName string, disk *api.Disk) error {
+	disk.Type = "file"
+	setDiskDriver(disk, "raw", false)
+	disk.Source.File = config.GetHotplugDiskPath(volumeName)
+	return nil
+}
+
 func Convert_v1_Config_To_api_Disk(volumeName string, disk *api.Disk, configType config.Type) error {
 	disk.Type = "file"
 	setDiskDriver(disk, "raw", false)
@@ -962,11 +1159,14 @@ func Convert_v1_Usbredir_To_api_Usbredir(vmi *v1.VirtualMachineInstance, domainD
 		return nil
 	}
 
-	// Note that at the moment, we don't require any specific input to configure the USB devices
-	// so we simply create the maximum allowed dictated by v1.UsbClientPassthroughMaxNumberOf
-	redirectDevices := make([]api.RedirectedDevice, v1.UsbClientPassthroughMaxNumberOf)
+	// Unless requested otherwise, create the maximum allowed dictated by v1.UsbClientPassthroughMaxNumberOf
+	count := v1.UsbClientPassthroughMaxNumberOf
+	if clientDevices.Count != nil {
+		count = int(*clientDevices.Count)
+	}
+	redirectDevices := make([]api.RedirectedDevice, count)
 
-	for i := 0; i < v1.UsbClientPassthroughMaxNumberOf; i++ {
+	for i := 0; i < count; i++ {
 		path := fmt.Sprintf("/var/run/kubevirt-private/%s/virt-usbredir-%d", vmi.ObjectMeta.UID, i)
 		redirectDevices[i] = api.RedirectedDevice{
 			Type: "unix",
@@ -981,6 +1181,35 @@ func Convert_v1_Usbredir_To_api_Usbredir(vmi *v1.VirtualMachineInstance, domainD
 	return nil
 }
 
+func Convert_v1_Smartcard_To_api_Smartcard(vmi *v1.VirtualMachineInstance, domainDevices *api.Devices, _ *ConverterContext) error {
+	switch {
+	case smartcard.HasPassthrough(&vmi.Spec):
+		domainDevices.Smartcards = []api.Smartcard{{
+			Mode: "passthrough",
+			Type: "unix",
+			Source: &api.SmartcardSource{
+				Mode: "bind",
+				Path: fmt.Sprintf("/var/run/kubevirt-private/%s/%s", vmi.ObjectMeta.UID, smartcard.SocketName),
+			},
+		}}
+	case smartcard.HasHostCertificates(&vmi.Spec):
+		domainDevices.Smartcards = []api.Smartcard{{
+			Mode:         "host-certificates",
+			Certificates: vmi.Spec.Domain.Devices.Smartcard.HostCertificates.Certificates,
+			Database:     smartcard.DatabasePath(),
+		}}
+	}
+	return nil
+}
+
+// addFWCfgEntry passes the file to the guest as the fw_cfg blob name.
+func addFWCfgEntry(domain *api.Domain, name, file string) {
+	if domain.Spec.FWCfg == nil {
+		domain.Spec.FWCfg = &api.FWCfg{}
+	}
+	domain.Spec.FWCfg.Entries = append(domain.Spec.FWCfg.Entries, api.FWCfgEntry{Name: name, File: file})
+}
+
 func initializeQEMUCmdAndQEMUArg(domain *api.Domain) {
 	if domain.Spec.QEMUCmd == nil {
 		domain.Spec.QEMUCmd = &api.Commandline{}
@@ -1013,6 +1242,9 @@ func setupDomainMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) error
 		Unit:  maxMemory.Unit,
 		Value: maxMemory.Value,
 	}
+	if slots := vmi.Spec.Domain.Memory.DIMMSlots; slots != nil {
+		domain.Spec.MaxMemory.Slots = uint64(*slots)
+	}
 
 	currentMemory, err := vcpu.QuantityToByte(*vmi.Spec.Domain.Memory.Guest)
 	if err != nil {
@@ -1033,6 +1265,11 @@ func hasIOThreads(vmi *v1.VirtualMachineInstance) bool {
 			return true
 		}
 	}
+	for _, scsiController := range vmi.Spec.Domain.Devices.SCSIControllers {
+		if scsiController.DedicatedIOThread != nil && *scsiController.DedicatedIOThread {
+			return true
+		}
+	}
 	return false
 }
 
@@ -1076,6 +1313,13 @@ func getIOThreadsCountType(vmi *v1.VirtualMachineInstance) (ioThreadCount, autoT
 			autoThreads += 1
 		}
 	}
+	for _, scsiController := range vmi.Spec.Domain.Devices.SCSIControllers {
+		if scsiController.DedicatedIOThread != nil && *scsiController.DedicatedIOThread {
+			dedicatedThreads += 1
+		} else {
+			autoThreads += 1
+		}
+	}
 
 	if (autoThreads + dedicatedThreads) > threadPoolLimit {
 		autoThreads = threadPoolLimit - dedicatedThreads
@@ -1101,8 +1345,10 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 		}
 		domain.Spec.IOThreads.IOThreads = uint(ioThreadCount)
 	}
-	if vmi.Spec.Domain.IOThreadsPolicy != nil &&
-		*vmi.Spec.Domain.IOThreadsPolicy == v1.IOThreadsPolicySupplementalPool {
+	currentDedicatedThread := uint(autoThreads + 1)
+	supplementalPool := vmi.Spec.Domain.IOThreadsPolicy != nil &&
+		*vmi.Spec.Domain.IOThreadsPolicy == v1.IOThreadsPolicySupplementalPool
+	if supplementalPool {
 		iothreads := &api.DiskIOThreads{}
 		for id := 1; id <= int(*vmi.Spec.Domain.IOThreads.SupplementalPoolThreadCount); id++ {
 			iothreads.IOThread = append(iothreads.IOThread, api.DiskIOThread{Id: uint32(id)})
@@ -1113,8 +1359,9 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 				domain.Spec.Devices.Disks[i].Driver.IOThreads = iothreads
 			}
 		}
+		// scsi controllers take a single IO thread, spread them over the pool
+		autoThreads = len(iothreads.IOThread)
 	} else {
-		currentDedicatedThread := uint(autoThreads + 1)
 		for i, disk := range domain.Spec.Devices.Disks {
 			// Only disks with virtio bus support IOThreads
 			if disk.Target.Bus == v1.DiskBusVirtio {
@@ -1131,6 +1378,30 @@ func setIOThreads(vmi *v1.VirtualMachineInstance, domain *api.Domain, vcpus uint
 		}
 	}
 
+	if len(vmi.Spec.Domain.Devices.SCSIControllers) > 0 {
+		for i, controller := range domain.Spec.Devices.Controllers {
+			if controller.Type != "scsi" {
+				continue
+			}
+			index, err := strconv.Atoi(controller.Index)
+			if err != nil || index >= len(vmi.Spec.Domain.Devices.SCSIControllers) {
+				continue
+			}
+			if controller.Driver == nil {
+				domain.Spec.Devices.Controllers[i].Driver = &api.ControllerDriver{}
+			}
+			scsiController := vmi.Spec.Domain.Devices.SCSIControllers[index]
+			if scsiController.DedicatedIOThread != nil && *scsiController.DedicatedIOThread && !supplementalPool {
+				domain.Spec.Devices.Controllers[i].Driver.IOThread = pointer.P(currentDedicatedThread)
+				currentDedicatedThread += 1
+			} else {
+				domain.Spec.Devices.Controllers[i].Driver.IOThread = pointer.P(currentAutoThread)
+				currentAutoThread = (currentAutoThread % uint(autoThreads)) + 1
+			}
+		}
+		return
+	}
+
 	// Virtio-scsi doesn't support IO threads yet, only the SCSI controller supports.
 	setIOThreadSCSIController := false
 	for i, disk := range domain.Spec.Devices.Disks {
@@ -1177,13 +1448,16 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			network.WithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
 			network.WithROMTuningSupport(c.Architecture.IsROMTuningSupported()),
 			network.WithVirtioModel(virtioModel),
+			network.WithArchitecture(architecture),
+			network.WithVhostNetAvailable(c.VhostNetAvailable),
 		),
 		compute.TPMDomainConfigurator{},
 		compute.VSOCKDomainConfigurator{},
 		compute.NewHypervisorDomainConfigurator(c.AllowEmulation, c.KvmAvailable),
+		compute.NewEmulatorDomainConfigurator(c.HostArchitecture, c.EmulatorPath),
 		compute.NewLaunchSecurityDomainConfigurator(architecture),
 		compute.ChannelsDomainConfigurator{},
-		compute.ClockDomainConfigurator{},
+		compute.NewClockDomainConfigurator(architecture),
 		compute.NewRNGDomainConfigurator(
 			compute.RNGWithUseLaunchSecuritySEV(c.UseLaunchSecuritySEV),
 			compute.RNGWithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
@@ -1195,7 +1469,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			compute.BalloonWithUseLaunchSecurityPV(c.UseLaunchSecurityPV),
 			compute.BalloonWithFreePageReporting(c.FreePageReporting),
 			compute.BalloonWithMemBalloonStatsPeriod(c.MemBalloonStatsPeriod),
-			compute.BalloonWithVirtioModel(virtioModel),
+			compute.BalloonWithVirtioModel(virtio.InterpretTransitionalModelType(
+				virtio.DeviceTransitional(vmi.Spec.Domain.Devices.MemBalloonVirtioTransitional, vmi.Spec.Domain.Devices.UseVirtioTransitional),
+				architecture,
+			)),
 		),
 		compute.NewGraphicsDomainConfigurator(architecture, c.BochsForEFIGuests),
 		compute.SoundDomainConfigurator{},
@@ -1207,7 +1484,11 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		compute.NewWatchdogDomainConfigurator(architecture),
 		compute.NewConsoleDomainConfigurator(c.SerialConsoleLog),
 		compute.PanicDevicesDomainConfigurator{},
+		compute.SharedMemoryDomainConfigurator{},
+		compute.SGXDomainConfigurator{},
 		compute.NewHypervisorFeaturesDomainConfigurator(c.Architecture.HasVMPort(), c.UseLaunchSecurityTDX),
+		compute.MachineOptionsDomainConfigurator{},
+		compute.NewIOMMUDomainConfigurator(architecture),
 		compute.NewSysInfoDomainConfigurator(convertCmdv1SMBIOSToComputeSMBIOS(c.SMBios)),
 		compute.NewOSDomainConfigurator(c.Architecture.IsSMBiosNeeded(), convertEFIConfiguration(c.EFIConfiguration)),
 		storage.NewVirtiofsConfigurator(),
@@ -1315,6 +1596,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		if err != nil {
 			return err
 		}
+		if err := setSCSIController(vmi, &disk, &newDisk); err != nil {
+			return err
+		}
 		volume := volumes[disk.Name]
 		if volume == nil {
 			if disk.CDRom == nil {
@@ -1359,6 +1643,11 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		return err
 	}
 
+	err = Convert_v1_Smartcard_To_api_Smartcard(vmi, &domain.Spec.Devices, c)
+	if err != nil {
+		return err
+	}
+
 	// Creating USB controller, disabled by default
 	usbController := api.Controller{
 		Type:  "usb",
@@ -1370,10 +1659,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	}
 	domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, usbController)
 
-	if needsSCSIController(vmi) {
+	if len(vmi.Spec.Domain.Devices.SCSIControllers) > 0 {
+		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, convertSCSIControllers(vmi, c, controllerDriver, numBlkQueues)...)
+	} else if needsSCSIController(vmi) {
 		scsiController := c.Architecture.ScsiController(virtio.InterpretTransitionalModelType(&c.UseVirtioTransitional, c.Architecture.GetArchitecture()), controllerDriver)
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, scsiController)
 	}
+	domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, convertNVMeControllers(domain.Spec.Devices.Disks)...)
 
 	if c.Architecture.SupportPCIHole64Disabling() && shouldDisablePCIHole64(vmi) {
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers,
@@ -1435,6 +1727,19 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			})
 		}
 
+		// The node selector only guarantees that KVM allows nesting, the vendor of the host CPU is not known here.
+		// Host-passthrough exposes the virtualization extensions anyway.
+		if vmi.IsNestedVirtualizationEnabled() && vmi.Spec.Domain.CPU.Model != v1.CPUModeHostPassthrough {
+			for _, feature := range []string{"vmx", "svm"} {
+				if _, exists := existingFeatures[feature]; !exists {
+					domain.Spec.CPU.Features = append(domain.Spec.CPU.Features, api.CPUFeature{
+						Name:   feature,
+						Policy: "optional",
+					})
+				}
+			}
+		}
+
 		// Adjust guest vcpu config. Currently will handle vCPUs to pCPUs pinning
 		if vmi.IsCPUDedicated() {
 			err = vcpu.AdjustDomainForTopologyAndCPUSet(domain, vmi, c.Topology, c.CPUSet, hasIOThreads(vmi))
@@ -1448,6 +1753,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.CPU.Mode = v1.CPUModeHostModel
 	}
 
+	// The host CPU cannot be mirrored into a guest of a different architecture,
+	// expose the most capable CPU of the emulated architecture instead.
+	if compute.IsCrossArchitecture(vmi, c.HostArchitecture) &&
+		(domain.Spec.CPU.Mode == v1.CPUModeHostModel || domain.Spec.CPU.Mode == v1.CPUModeHostPassthrough) {
+		domain.Spec.CPU.Mode = "maximum"
+	}
+
 	if vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole {
 		// Add mandatory console device
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, api.Controller{
@@ -1461,10 +1773,17 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	// Add Ignition Command Line if present
 	ignitiondata := vmi.Annotations[v1.IgnitionAnnotation]
 	if ignitiondata != "" && strings.Contains(ignitiondata, "ignition") {
-		initializeQEMUCmdAndQEMUArg(domain)
-		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: "-fw_cfg"})
 		ignitionpath := fmt.Sprintf("%s/%s", ignition.GetDomainBasePath(c.VirtualMachine.Name, c.VirtualMachine.Namespace), ignition.IgnitionFile)
-		domain.Spec.QEMUCmd.QEMUArg = append(domain.Spec.QEMUCmd.QEMUArg, api.Arg{Value: fmt.Sprintf("name=opt/com.coreos/config,file=%s", ignitionpath)})
+		addFWCfgEntry(domain, "opt/com.coreos/config", ignitionpath)
+	}
+
+	// Add the guest secrets delivered through fw_cfg
+	for i := range vmi.Spec.GuestSecrets {
+		guestSecret := &vmi.Spec.GuestSecrets[i]
+		if !guestsecrets.IsFWCfg(guestSecret) {
+			continue
+		}
+		addFWCfgEntry(domain, guestsecrets.FWCfgName(guestSecret), guestsecrets.SourcePath(guestSecret))
 	}
 
 	if val := vmi.Annotations[v1.PlacePCIDevicesOnRootComplex]; val == "true" {
@@ -1543,6 +1862,8 @@ func getPrefixFromBus(bus v1.DiskBus) string {
 		return "vd"
 	case v1.DiskBusSATA, v1.DiskBusSCSI, v1.DiskBusUSB:
 		return "sd"
+	case v1.DiskBusNVMe:
+		return nvmePrefix
 	default:
 		log.Log.Errorf("Unrecognized bus '%s'", bus)
 		return ""
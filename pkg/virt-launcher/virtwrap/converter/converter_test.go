@@ -50,6 +50,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
@@ -173,6 +174,87 @@ var _ = Describe("Converter", func() {
 			}),
 		)
 
+		DescribeTable("Should set the serial number", func(serial string, policy v1.DiskSerialPolicy, expectedSerial string) {
+			v1Disk := v1.Disk{
+				Name:         "myvolume",
+				Serial:       serial,
+				SerialPolicy: policy,
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}
+			apiDisk := api.Disk{}
+			context := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(apiDisk.Serial).To(Equal(expectedSerial))
+		},
+			Entry("to the specified serial", "D23YZ9W6WA5DJ487", v1.DiskSerialPolicy(""), "D23YZ9W6WA5DJ487"),
+			Entry("to nothing without a policy", "", v1.DiskSerialPolicy(""), ""),
+			Entry("to nothing with the None policy", "", v1.DiskSerialPolicyNone, ""),
+			Entry("to the specified serial with the VolumeNameHash policy", "D23YZ9W6WA5DJ487", v1.DiskSerialPolicyVolumeNameHash, "D23YZ9W6WA5DJ487"),
+			Entry("to a hash of the volume name with the VolumeNameHash policy", "", v1.DiskSerialPolicyVolumeNameHash, "5740c681f54449dc1cc9"),
+		)
+
+		It("Should attach nvme disks as the first namespace of their own controller", func() {
+			context := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+			devicePerBus := map[string]deviceNamer{}
+			var apiDisks []api.Disk
+			for _, v1Disk := range []v1.Disk{
+				{Name: "first", Serial: "NVME0123", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+				{Name: "second", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+				{Name: "third", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}},
+			} {
+				apiDisk := api.Disk{}
+				Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, devicePerBus, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+				apiDisks = append(apiDisks, apiDisk)
+			}
+
+			Expect(apiDisks[0].Target.Device).To(Equal("nvme0n1"))
+			Expect(apiDisks[0].Address).To(Equal(&api.Address{Type: "drive", Controller: "0", Bus: "0", Target: "0", Unit: "0"}))
+			Expect(apiDisks[1].Target.Device).To(Equal("nvme1n1"))
+			Expect(apiDisks[1].Address).To(Equal(&api.Address{Type: "drive", Controller: "1", Bus: "0", Target: "0", Unit: "0"}))
+			Expect(apiDisks[2].Target.Device).To(Equal("vda"))
+
+			Expect(convertNVMeControllers(apiDisks)).To(Equal([]api.Controller{
+				{Type: "nvme", Index: "0", Serial: "NVME0123"},
+				{Type: "nvme", Index: "1", Serial: "16367aacb67a4a017c8d"},
+			}))
+			Expect(apiDisks[0].Serial).To(BeEmpty())
+			Expect(apiDisks[1].Serial).To(BeEmpty())
+		})
+
+		It("Should keep the existing target of nvme disks", func() {
+			disks := []v1.Disk{
+				{Name: "first", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+				{Name: "second", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusNVMe}}},
+			}
+			devicePerBus := newDeviceNamer([]v1.VolumeStatus{{Name: "second", Target: "nvme0n1"}}, disks)
+			context := &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH)}
+
+			second := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &disks[1], &second, devicePerBus, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(second.Target.Device).To(Equal("nvme0n1"))
+			Expect(second.Address.Controller).To(Equal("0"))
+
+			first := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &disks[0], &first, devicePerBus, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(first.Target.Device).To(Equal("nvme1n1"))
+			Expect(first.Address.Controller).To(Equal("1"))
+		})
+
+		It("Should set the WWN of a scsi disk", func() {
+			v1Disk := v1.Disk{
+				Name: "myvolume",
+				WWN:  "5000c50015ea71ac",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusSCSI},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(&ConverterContext{}, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(apiDisk.WWN).To(Equal("5000c50015ea71ac"))
+		})
+
 		DescribeTable("Should add boot order when provided", func(arch, expectedModel string) {
 			order := uint(1)
 			kubevirtDisk := &v1.Disk{
@@ -631,6 +713,7 @@ var _ = Describe("Converter", func() {
 				},
 				AllowEmulation:                  true,
 				KvmAvailable:                    true,
+				VhostNetAvailable:               true,
 				IsBlockPVC:                      isBlockPVCMap,
 				IsBlockDV:                       isBlockDVMap,
 				SMBios:                          TestSmbios,
@@ -976,6 +1059,48 @@ var _ = Describe("Converter", func() {
 			Entry("should be nil for arm64", arm64, BeNil()),
 		)
 
+		DescribeTable("CPU virtualization extensions with nested virtualization", func(cpu *v1.CPU, matcher types.GomegaMatcher) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c.Architecture = archconverter.NewConverter(amd64)
+			vmi.Spec.Domain.CPU = cpu
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.CPU.Features).To(matcher)
+		},
+			Entry("should be optional for host-model",
+				&v1.CPU{Model: v1.CPUModeHostModel, NestedVirtualization: pointer.P(true)},
+				HaveExactElements(
+					api.CPUFeature{Name: "vmx", Policy: "optional"},
+					api.CPUFeature{Name: "svm", Policy: "optional"},
+				),
+			),
+			Entry("should not override user provided features",
+				&v1.CPU{Model: v1.CPUModeHostModel, NestedVirtualization: pointer.P(true), Features: []v1.CPUFeature{{Name: "vmx", Policy: "require"}}},
+				HaveExactElements(
+					api.CPUFeature{Name: "vmx", Policy: "require"},
+					api.CPUFeature{Name: "svm", Policy: "optional"},
+				),
+			),
+			Entry("should not be added for host-passthrough",
+				&v1.CPU{Model: v1.CPUModeHostPassthrough, NestedVirtualization: pointer.P(true)},
+				BeEmpty(),
+			),
+			Entry("should not be added when nested virtualization is disabled",
+				&v1.CPU{Model: v1.CPUModeHostModel, NestedVirtualization: pointer.P(false)},
+				BeEmpty(),
+			),
+		)
+
+		DescribeTable("KVM poll-control feature", func(pollControl *bool, expectedPollControl *api.FeatureState) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Features = &v1.Features{KVM: &v1.FeatureKVM{PollControl: pollControl}}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Features.KVM.PollControl).To(Equal(expectedPollControl))
+		},
+			Entry("should be enabled when requested", pointer.P(true), &api.FeatureState{State: "on"}),
+			Entry("should be disabled when requested", pointer.P(false), &api.FeatureState{State: "off"}),
+			Entry("should be left to the hypervisor when unspecified", nil, nil),
+		)
+
 		Context("when downwardMetrics are exposed via virtio-serial", func() {
 			It("should set socket options", func() {
 				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -1279,6 +1404,49 @@ var _ = Describe("Converter", func() {
 			Entry("on s390x", s390x, "virtio-scsi"),
 		)
 
+		It("should add the scsi controllers of the vmi", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.IOThreadsPolicy = nil
+			for i := range vmi.Spec.Domain.Devices.Disks {
+				vmi.Spec.Domain.Devices.Disks[i].DedicatedIOThread = nil
+			}
+			vmi.Spec.Domain.Devices.BlockMultiQueue = pointer.P(true)
+			vmi.Spec.Domain.Devices.SCSIControllers = []v1.SCSIController{
+				{Name: "fast", Queues: pointer.P(uint32(8))},
+				{Name: "slow"},
+			}
+			vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSCSI
+			vmi.Spec.Domain.Devices.Disks[0].SCSIController = "slow"
+			dom := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, dom, c)).To(Succeed())
+			Expect(dom.Spec.Devices.Controllers).To(ContainElements(
+				api.Controller{
+					Type:   "scsi",
+					Index:  "0",
+					Model:  "virtio-non-transitional",
+					Alias:  api.NewUserDefinedAlias("fast"),
+					Driver: &api.ControllerDriver{Queues: pointer.P(uint(8))},
+				},
+				api.Controller{
+					Type:   "scsi",
+					Index:  "1",
+					Model:  "virtio-non-transitional",
+					Alias:  api.NewUserDefinedAlias("slow"),
+					Driver: &api.ControllerDriver{Queues: pointer.P(uint(1))},
+				},
+			))
+			Expect(dom.Spec.Devices.Disks[0].Address.Controller).To(Equal("1"))
+		})
+
+		It("should fail if a disk names an unknown scsi controller", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.SCSIControllers = []v1.SCSIController{{Name: "scsi0"}}
+			vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSCSI
+			vmi.Spec.Domain.Devices.Disks[0].SCSIController = "scsi1"
+			dom := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, dom, c)).To(MatchError(ContainSubstring("scsi controller scsi1 of disk")))
+		})
+
 		It("should not add a virtio-scsi controller if no scsi disk is present", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = "sata"
@@ -1356,9 +1524,9 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed(), "Expect error")
 		})
 
-		It("should fail when input device is set to keyboard type", func() {
+		It("should fail when input device is set to joystick type", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
-			vmi.Spec.Domain.Devices.Inputs[0].Type = "keyboard"
+			vmi.Spec.Domain.Devices.Inputs[0].Type = "joystick"
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed(), "Expect error")
 		})
 
@@ -1450,6 +1618,15 @@ var _ = Describe("Converter", func() {
 			Entry("should be disabled on s390x", s390x, "none"),
 		)
 
+		It("should create the requested number of usb redirection channels", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{Count: pointer.P(uint32(2))}
+			c.Architecture = archconverter.NewConverter(amd64)
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Redirs).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Redirs[1].Source.Path).To(HaveSuffix("/virt-usbredir-1"))
+		})
+
 		It("should not enable usb redirection when numberOfDevices == 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = nil
@@ -1463,6 +1640,39 @@ var _ = Describe("Converter", func() {
 			}))
 		})
 
+		It("should add a passthrough smartcard backed by a unix socket", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Smartcard = &v1.Smartcard{Passthrough: &v1.SmartcardPassthrough{}}
+			c.Architecture = archconverter.NewConverter(amd64)
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Smartcards).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Smartcards[0].Mode).To(Equal("passthrough"))
+			Expect(domain.Spec.Devices.Smartcards[0].Type).To(Equal("unix"))
+			Expect(domain.Spec.Devices.Smartcards[0].Source.Mode).To(Equal("bind"))
+			Expect(domain.Spec.Devices.Smartcards[0].Source.Path).To(HaveSuffix("/virt-smartcard"))
+			Expect(domain.Spec.Devices.Controllers).To(ContainElement(api.Controller{
+				Type:  "usb",
+				Index: "0",
+				Model: "qemu-xhci",
+			}))
+		})
+
+		It("should add a smartcard emulated from host certificates", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Smartcard = &v1.Smartcard{
+				HostCertificates: &v1.SmartcardHostCertificates{
+					SecretName:   "nssdb",
+					Certificates: []string{"cert1", "cert2", "cert3"},
+				},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Smartcards).To(ConsistOf(api.Smartcard{
+				Mode:         "host-certificates",
+				Certificates: []string{"cert1", "cert2", "cert3"},
+				Database:     "/var/run/kubevirt-private/secret/smartcard-database",
+			}))
+		})
+
 		It("should select explicitly chosen network model", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
@@ -1627,6 +1837,29 @@ var _ = Describe("Converter", func() {
 			Entry("disabled - virtLauncherLogVerbosity variable is not defined", false, -1, false),
 		)
 
+		It("should pass the fw_cfg guest secrets to libvirt", func() {
+			vmi.Spec.GuestSecrets = []v1.GuestSecret{
+				{Name: "token", SecretName: "bootstrap", Key: "token", Target: v1.GuestSecretTargetFWCfg},
+			}
+			domain := api.Domain{}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &domain, c)).To(Succeed())
+			Expect(domain.Spec.FWCfg).To(Equal(&api.FWCfg{Entries: []api.FWCfgEntry{
+				{Name: "opt/io.kubevirt/token", File: "/var/run/kubevirt-private/secret/bootstrap-guest-secret/token"},
+			}}))
+		})
+
+		It("should pass the ignition config to libvirt", func() {
+			vmi.Annotations = map[string]string{v1.IgnitionAnnotation: `{"ignition":{"version":"3.2.0"}}`}
+			domain := api.Domain{}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &domain, c)).To(Succeed())
+			Expect(domain.Spec.FWCfg).ToNot(BeNil())
+			Expect(domain.Spec.FWCfg.Entries).To(HaveLen(1))
+			Expect(domain.Spec.FWCfg.Entries[0].Name).To(Equal("opt/com.coreos/config"))
+			Expect(domain.Spec.FWCfg.Entries[0].File).To(HaveSuffix("/" + ignition.IgnitionFile))
+		})
+
 		DescribeTable("should add VSOCK section when present",
 			func(useVirtioTransitional bool) {
 				vmi.Status.VSOCKCID = pointer.P(uint32(100))
@@ -1670,6 +1903,67 @@ var _ = Describe("Converter", func() {
 			Entry("ErrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
 			Entry("ErrorPolicy equal to enospace", pointer.P(v1.DiskErrorPolicyEnospace), "enospace"),
 		)
+		DescribeTable("Should set the read error policy", func(rpolicy *v1.DiskErrorPolicy, expected string) {
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				ErrorPolicy:  pointer.P(v1.DiskErrorPolicyReport),
+				RerrorPolicy: rpolicy,
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(string(domainSpec.Devices.Disks[0].Driver.ErrorPolicy)).To(Equal("report"))
+			Expect(string(domainSpec.Devices.Disks[0].Driver.RerrorPolicy)).To(Equal(expected))
+		},
+			Entry("RerrorPolicy not specified", nil, ""),
+			Entry("RerrorPolicy equal to stop", pointer.P(v1.DiskErrorPolicyStop), "stop"),
+			Entry("RerrorPolicy equal to ignore", pointer.P(v1.DiskErrorPolicyIgnore), "ignore"),
+			Entry("RerrorPolicy equal to report", pointer.P(v1.DiskErrorPolicyReport), "report"),
+		)
+		DescribeTable("Should override virtio transitional per device", func(vmTransitional bool, deviceTransitional *bool, expectedModel string) {
+			vmi.Spec.Domain.Devices.UseVirtioTransitional = pointer.P(vmTransitional)
+			vmi.Spec.Domain.Devices.MemBalloonVirtioTransitional = deviceTransitional
+			c.UseVirtioTransitional = vmTransitional
+			vmi.Spec.Domain.Devices.Disks[0] = v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus:                v1.VirtIO,
+						VirtioTransitional: deviceTransitional,
+					},
+				},
+			}
+			vmi.Spec.Volumes[0] = v1.Volume{
+				Name: "mydisk",
+				VolumeSource: v1.VolumeSource{
+					Ephemeral: &v1.EphemeralVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].Model).To(Equal(expectedModel))
+			Expect(domainSpec.Devices.Ballooning.Model).To(Equal(expectedModel))
+		},
+			Entry("VM wide transitional without override", true, nil, "virtio-transitional"),
+			Entry("VM wide transitional with non-transitional device", true, pointer.P(false), "virtio-non-transitional"),
+			Entry("VM wide non-transitional with transitional device", false, pointer.P(true), "virtio-transitional"),
+		)
 		DescribeTable("Should set the vmport by arch", func(arch string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			c.Architecture = archconverter.NewConverter(arch)
@@ -2421,6 +2715,62 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Disks[0].Driver.IOThreads).To(Equal(iothreads))
 		})
 
+		It("Should spread scsi disks over the scsi controllers", func() {
+			vmi := libvmi.New(
+				libvmi.WithIOThreadsPolicy(v1.IOThreadsPolicyShared),
+				libvmi.WithSCSIController("scsi0", false),
+				libvmi.WithSCSIController("scsi1", true),
+				libvmi.WithPersistentVolumeClaim("disk0", "pvc0", libvmi.WithDiskOnSCSIController("scsi1")),
+				libvmi.WithPersistentVolumeClaim("disk1", "pvc1", libvmi.WithDiskOnSCSIController("scsi0")),
+				libvmi.WithPersistentVolumeClaim("disk2", "pvc2"),
+			)
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true, EphemeraldiskCreator: EphemeralDiskImageCreator})
+
+			// one shared thread and one dedicated to scsi1
+			Expect(domain.Spec.IOThreads.IOThreads).To(Equal(uint(2)))
+
+			var controllers []api.Controller
+			for _, controller := range domain.Spec.Devices.Controllers {
+				if controller.Type == "scsi" {
+					controllers = append(controllers, controller)
+				}
+			}
+			Expect(controllers).To(HaveLen(2))
+			Expect(controllers[0].Index).To(Equal("0"))
+			Expect(controllers[0].Alias).To(Equal(api.NewUserDefinedAlias("scsi0")))
+			Expect(controllers[0].Driver.IOThread).To(HaveValue(Equal(uint(1))))
+			Expect(controllers[1].Index).To(Equal("1"))
+			Expect(controllers[1].Alias).To(Equal(api.NewUserDefinedAlias("scsi1")))
+			Expect(controllers[1].Driver.IOThread).To(HaveValue(Equal(uint(2))))
+
+			Expect(domain.Spec.Devices.Disks[0].Address.Controller).To(Equal("1"))
+			Expect(domain.Spec.Devices.Disks[1].Address.Controller).To(Equal("0"))
+			Expect(domain.Spec.Devices.Disks[2].Address).To(BeNil())
+			Expect(domain.Spec.Devices.Disks[2].Driver.IOThread).To(HaveValue(Equal(uint(1))))
+		})
+
+		It("Should spread the scsi controllers over the supplemental pool", func() {
+			vmi := libvmi.New(
+				libvmi.WithIOThreadsPolicy(v1.IOThreadsPolicySupplementalPool),
+				libvmi.WithIOThreads(v1.DiskIOThreads{SupplementalPoolThreadCount: pointer.P(uint32(2))}),
+				libvmi.WithSCSIController("scsi0", true),
+				libvmi.WithSCSIController("scsi1", false),
+				libvmi.WithSCSIController("scsi2", false),
+				libvmi.WithPersistentVolumeClaim("disk0", "pvc0", libvmi.WithDiskOnSCSIController("scsi0")),
+			)
+			domain := vmiToDomain(vmi, &ConverterContext{Architecture: archconverter.NewConverter(runtime.GOARCH), AllowEmulation: true, EphemeraldiskCreator: EphemeralDiskImageCreator})
+
+			Expect(domain.Spec.IOThreads.IOThreads).To(Equal(uint(2)))
+			var iothreads []uint
+			for _, controller := range domain.Spec.Devices.Controllers {
+				if controller.Type == "scsi" {
+					Expect(controller.Driver.IOThread).ToNot(BeNil())
+					iothreads = append(iothreads, *controller.Driver.IOThread)
+				}
+			}
+			Expect(iothreads).To(Equal([]uint{1, 2, 1}))
+		})
+
 		It("Should honor shared ioThreadsPolicy for single disk", func() {
 			vmi := libvmi.New(
 				libvmi.WithIOThreadsPolicy(v1.IOThreadsPolicyShared),
@@ -2664,6 +3014,84 @@ var _ = Describe("Converter", func() {
 			Expect(apiDisk.Driver.Queues).To(BeNil(), "expected no queues to be requested")
 		})
 
+		DescribeTable("should let the queues of a disk override the derived number of queues", func(numQueues *uint) {
+			v1Disk := v1.Disk{
+				Queues: pointer.P(uint32(8)),
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.VirtIO},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, numQueues, make(map[string]v1.VolumeStatus))).
+				To(Succeed())
+			Expect(apiDisk.Driver.Queues).To(HaveValue(Equal(uint(8))))
+		},
+			Entry("with block multi-queue", pointer.P(uint(2))),
+			Entry("without block multi-queue", nil),
+		)
+
+		It("should only set the queues of a disk on the virtio bus", func() {
+			v1Disk := v1.Disk{
+				Queues: pointer.P(uint32(8)),
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, make(map[string]v1.VolumeStatus))).
+				To(Succeed())
+			Expect(apiDisk.Driver.Queues).To(BeNil())
+		})
+
+		It("should convert the IO limits of a disk", func() {
+			v1Disk := v1.Disk{
+				IOTune: &v1.DiskIOTune{
+					TotalBytesSec: pointer.P(uint64(10485760)),
+					ReadIOPSSec:   pointer.P(uint64(400)),
+					WriteIOPSSec:  pointer.P(uint64(200)),
+					Burst: &v1.DiskIOTuneBurst{
+						TotalBytesSec: pointer.P(uint64(20971520)),
+						ReadIOPSSec:   pointer.P(uint64(1000)),
+						LengthSeconds: pointer.P(uint64(30)),
+					},
+				},
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, make(map[string]v1.VolumeStatus))).
+				To(Succeed())
+			Expect(apiDisk.IOTune).To(Equal(&api.DiskIOTune{
+				TotalBytesSec:          10485760,
+				ReadIOPSSec:            400,
+				WriteIOPSSec:           200,
+				TotalBytesSecMax:       20971520,
+				ReadIOPSSecMax:         1000,
+				TotalBytesSecMaxLength: 30,
+				ReadIOPSSecMaxLength:   30,
+			}))
+
+			ioTuneXML, err := xml.Marshal(apiDisk.IOTune)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(ioTuneXML)).To(Equal("<DiskIOTune><total_bytes_sec>10485760</total_bytes_sec>" +
+				"<read_iops_sec>400</read_iops_sec><write_iops_sec>200</write_iops_sec>" +
+				"<total_bytes_sec_max>20971520</total_bytes_sec_max><read_iops_sec_max>1000</read_iops_sec_max>" +
+				"<total_bytes_sec_max_length>30</total_bytes_sec_max_length><read_iops_sec_max_length>30</read_iops_sec_max_length></DiskIOTune>"))
+		})
+
+		It("should not set IO limits if omitted", func() {
+			v1Disk := v1.Disk{
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}
+			apiDisk := api.Disk{}
+			Expect(Convert_v1_Disk_To_api_Disk(context, &v1Disk, &apiDisk, map[string]deviceNamer{}, nil, make(map[string]v1.VolumeStatus))).
+				To(Succeed())
+			Expect(apiDisk.IOTune).To(BeNil())
+		})
+
 		It("should assign correct number of queues with CPU hotplug topology", func() {
 			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{}
 			vmi.Spec.Domain.CPU = &v1.CPU{
@@ -2848,6 +3276,31 @@ var _ = Describe("Converter", func() {
 				},
 			}
 		})
+		DescribeTable("sets the KVM hint-dedicated feature", func(kvm *v1.FeatureKVM, expectedHintDedicated *api.FeatureState) {
+			vmi.Spec.Domain.CPU.Cores = 2
+			vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU] = resource.MustParse("2")
+			vmi.Spec.Domain.Features = &v1.Features{KVM: kvm}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Architecture = amd64
+			c := &ConverterContext{Architecture: archconverter.NewConverter(amd64),
+				CPUSet:         []int{5, 6},
+				AllowEmulation: true,
+				SMBios:         &cmdv1.SMBios{},
+				Topology: &cmdv1.Topology{
+					NumaCells: []*cmdv1.Cell{{
+						Cpus: []*cmdv1.CPU{{Id: 5}, {Id: 6}},
+					}},
+				},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Features.KVM).ToNot(BeNil())
+			Expect(domain.Spec.Features.KVM.HintDedicated).To(Equal(expectedHintDedicated))
+		},
+			Entry("by default", nil, &api.FeatureState{State: "on"}),
+			Entry("when explicitly enabled", &v1.FeatureKVM{HintDedicated: pointer.P(true)}, &api.FeatureState{State: "on"}),
+			Entry("unless explicitly disabled", &v1.FeatureKVM{HintDedicated: pointer.P(false)}, &api.FeatureState{State: "off"}),
+		)
+
 		It("assigns a set of cpus per iothread, if there are more vcpus than iothreads", func() {
 			vmi.Spec.Domain.CPU.Cores = 16
 			vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceCPU] = resource.MustParse("16")
@@ -3419,6 +3872,16 @@ var _ = Describe("Converter", func() {
 				Entry("block mode DV", Convert_v1_Hotplug_DataVolume_To_api_Disk, "test-block-dv", true, false),
 				Entry("'discard ignore' DV", Convert_v1_Hotplug_DataVolume_To_api_Disk, "test-discard-ignore", false, true),
 			)
+
+			It("should convert a hotplugged config volume to its iso disk", func() {
+				disk := &api.Disk{
+					Driver: &api.DiskDriver{},
+				}
+				Expect(Convert_v1_Hotplug_Config_To_api_Disk("test-config", disk)).To(Succeed())
+				Expect(disk.Type).To(Equal("file"))
+				Expect(disk.Driver.Type).To(Equal("raw"))
+				Expect(disk.Source.File).To(Equal(filepath.Join(v1.HotplugDiskDir, "test-config.iso")))
+			})
 		})
 
 		Context("memory", func() {
@@ -3494,6 +3957,15 @@ var _ = Describe("Converter", func() {
 				Expect(domain.Spec.Memory.Value).To(Equal(uint64(guestMemory.Value())))
 			})
 
+			It("should set the DIMM slots when dimmSlots is set", func() {
+				vmi.Spec.Domain.Memory.DIMMSlots = pointer.P(uint32(16))
+				err := setupDomainMemory(vmi, domain)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(domain.Spec.MaxMemory).ToNot(BeNil())
+				Expect(domain.Spec.MaxMemory.Slots).To(Equal(uint64(16)))
+			})
+
 			DescribeTable("should correctly convert memory configuration from VMI spec to domain",
 				func(expectedMemoryMiB int64, opts ...libvmi.Option) {
 
@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"encoding/xml"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+	archconverter "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+)
+
+// updateGoldenFilesEnvVar regenerates the converted domain golden files instead of comparing against them.
+const updateGoldenFilesEnvVar = "UPDATE_CONVERTER_GOLDEN_FILES"
+
+// ciEnvVar is set on CI, where the libvirt schema validation must not be skipped.
+const ciEnvVar = "CI"
+
+var _ = Describe("Domain conversion", func() {
+	newGoldenContext := func(arch string, vmi *v1.VirtualMachineInstance) *ConverterContext {
+		return &ConverterContext{
+			Architecture:         archconverter.NewConverter(arch),
+			VirtualMachine:       vmi,
+			AllowEmulation:       true,
+			VhostNetAvailable:    true,
+			EphemeraldiskCreator: &fake.MockEphemeralDiskImageCreator{BaseDir: "/var/run/libvirt/kubevirt-ephemeral-disk/"},
+			DisksInfo:            map[string]*disk.DiskInfo{"rootdisk": {Format: "raw"}},
+		}
+	}
+
+	DescribeTable("should match the golden file", func(arch string) {
+		vmi := libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithNamespace("mynamespace"),
+			libvmi.WithUID("f4686d2c-6e8d-4335-b8fd-81bee22f4814"),
+			libvmi.WithFirmwareUUID("f4686d2c-6e8d-4335-b8fd-81bee22f4814"),
+			libvmi.WithMemoryRequest("128Mi"),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithContainerDisk("rootdisk", "quay.io/containerdisks/fedora"),
+			libvmi.WithRng(),
+		)
+
+		domain := vmiToDomain(vmi, newGoldenContext(arch, vmi))
+		data, err := xml.MarshalIndent(domain.Spec, "", "  ")
+		Expect(err).ToNot(HaveOccurred())
+		data = append(data, '\n')
+
+		goldenFile := filepath.Join("testdata", "domain", arch+".xml")
+		if os.Getenv(updateGoldenFilesEnvVar) == "true" {
+			Expect(os.WriteFile(goldenFile, data, 0o644)).To(Succeed())
+		}
+		expected, err := os.ReadFile(goldenFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(string(expected)),
+			"the converted domain changed, rerun the tests with %s=true to update the golden files", updateGoldenFilesEnvVar)
+	},
+		Entry("for amd64", amd64),
+		Entry("for arm64", arm64),
+		Entry("for s390x", s390x),
+	)
+
+	DescribeTable("should generate domains accepted by the libvirt schema", func(arch string) {
+		validator, err := exec.LookPath("virt-xml-validate")
+		if err != nil {
+			if os.Getenv(ciEnvVar) == "true" {
+				Fail("virt-xml-validate is required on CI to validate the converted domains")
+			}
+			Skip("virt-xml-validate is not available")
+		}
+
+		// The interfaces are completed by the network setup of virt-launcher, after the conversion
+		vmi := libvmi.New(
+			libvmi.WithName("testvmi"),
+			libvmi.WithNamespace("mynamespace"),
+			libvmi.WithUID("f4686d2c-6e8d-4335-b8fd-81bee22f4814"),
+			libvmi.WithFirmwareUUID("f4686d2c-6e8d-4335-b8fd-81bee22f4814"),
+			libvmi.WithMemoryRequest("128Mi"),
+			libvmi.WithContainerDisk("rootdisk", "quay.io/containerdisks/fedora"),
+			libvmi.WithRng(),
+		)
+		domain := vmiToDomain(vmi, newGoldenContext(arch, vmi))
+		data, err := xml.MarshalIndent(domain.Spec, "", "  ")
+		Expect(err).ToNot(HaveOccurred())
+
+		path := filepath.Join(GinkgoT().TempDir(), "domain.xml")
+		Expect(os.WriteFile(path, data, 0o600)).To(Succeed())
+		out, err := exec.Command(validator, path, "domain").CombinedOutput()
+		Expect(err).ToNot(HaveOccurred(), string(out))
+	},
+		Entry("for amd64", amd64),
+		Entry("for arm64", arm64),
+		Entry("for s390x", s390x),
+	)
+})
@@ -26,15 +26,23 @@ import (
 
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/virtio"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 )
 
+const (
+	driverNameVhost     = "vhost"
+	driverNameUserspace = "qemu"
+)
+
 type DomainConfigurator struct {
 	domainAttachmentByInterfaceName map[string]string
 	useLaunchSecuritySEV            bool
 	useLaunchSecurityPV             bool
 	isROMTuningSupported            bool
 	virtioModel                     string
+	architecture                    string
+	vhostNetAvailable               bool
 }
 
 type option func(*DomainConfigurator)
@@ -58,6 +66,7 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 	nonAbsentNets := netvmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, nonAbsentIfaces)
 
 	networks := indexNetworksByName(nonAbsentNets)
+	failoverStandbyIfaces := indexFailoverStandbyInterfaces(nonAbsentIfaces)
 
 	for i, iface := range nonAbsentIfaces {
 		_, isExist := networks[iface.Name]
@@ -74,6 +83,9 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 		modelType := ifaceType
 		if ifaceType == v1.VirtIO {
 			modelType = d.virtioModel
+			if iface.VirtioTransitional != nil {
+				modelType = virtio.InterpretTransitionalModelType(iface.VirtioTransitional, d.architecture)
+			}
 		}
 
 		domainIface := api.Interface{
@@ -83,8 +95,19 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 			Alias: api.NewUserDefinedAlias(iface.Name),
 		}
 
-		if queueCount := uint(calculateNetworkQueues(vmi, ifaceType)); queueCount != 0 {
-			domainIface.Driver = &api.InterfaceDriver{Name: "vhost", Queues: &queueCount}
+		queueCount := uint(calculateNetworkQueues(vmi, iface, ifaceType))
+		if driverName := d.interfaceDriverName(iface, ifaceType, queueCount); driverName != "" {
+			domainIface.Driver = &api.InterfaceDriver{Name: driverName}
+			if queueCount != 0 {
+				domainIface.Driver.Queues = &queueCount
+			}
+		}
+
+		if ifaceType == v1.VirtIO && iface.Offloads != nil {
+			if domainIface.Driver == nil {
+				domainIface.Driver = &api.InterfaceDriver{}
+			}
+			setInterfaceOffloads(domainIface.Driver, iface.Offloads)
 		}
 
 		// Add a pciAddress if specified
@@ -120,7 +143,7 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 				if domainIface.Driver != nil {
 					domainIface.Driver.IOMMU = "on"
 				} else {
-					domainIface.Driver = &api.InterfaceDriver{Name: "vhost", IOMMU: "on"}
+					domainIface.Driver = &api.InterfaceDriver{Name: driverNameVhost, IOMMU: "on"}
 				}
 			}
 		}
@@ -128,6 +151,10 @@ func (d DomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *ap
 		if iface.State == v1.InterfaceStateLinkDown {
 			domainIface.LinkState = &api.LinkState{State: "down"}
 		}
+
+		if _, isStandby := failoverStandbyIfaces[iface.Name]; isStandby {
+			domainIface.Teaming = &api.Teaming{Type: "persistent"}
+		}
 		domainInterfaces = append(domainInterfaces, domainIface)
 	}
 
@@ -165,6 +192,42 @@ func WithVirtioModel(virtioModel string) option {
 	}
 }
 
+func WithArchitecture(architecture string) option {
+	return func(d *DomainConfigurator) {
+		d.architecture = architecture
+	}
+}
+
+func WithVhostNetAvailable(vhostNetAvailable bool) option {
+	return func(d *DomainConfigurator) {
+		d.vhostNetAvailable = vhostNetAvailable
+	}
+}
+
+// interfaceDriverName returns the libvirt driver of the interface backend.
+// Without /dev/vhost-net libvirt silently falls back to the userspace backend,
+// the fallback is set explicitly so that the actual backend can be reported.
+func (d DomainConfigurator) interfaceDriverName(iface v1.Interface, ifaceType string, queueCount uint) string {
+	if ifaceType != v1.VirtIO {
+		return ""
+	}
+
+	switch iface.Backend {
+	case v1.InterfaceBackendVhost:
+		return driverNameVhost
+	case v1.InterfaceBackendUserspace:
+		return driverNameUserspace
+	}
+
+	if !d.vhostNetAvailable {
+		return driverNameUserspace
+	}
+	if queueCount != 0 {
+		return driverNameVhost
+	}
+	return ""
+}
+
 func getInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -172,6 +235,18 @@ func getInterfaceType(iface *v1.Interface) string {
 	return v1.VirtIO
 }
 
+// indexFailoverStandbyInterfaces returns the names of the interfaces which back an SR-IOV interface
+// as its virtio-net failover standby.
+func indexFailoverStandbyInterfaces(ifaces []v1.Interface) map[string]struct{} {
+	standbyIfaces := map[string]struct{}{}
+	for _, iface := range ifaces {
+		if iface.SRIOV != nil && iface.SRIOV.Failover != nil {
+			standbyIfaces[iface.SRIOV.Failover.StandbyInterface] = struct{}{}
+		}
+	}
+	return standbyIfaces
+}
+
 func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
 	netsByName := map[string]*v1.Network{}
 	for _, network := range networks {
@@ -180,9 +255,46 @@ func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
 	return netsByName
 }
 
-func calculateNetworkQueues(vmi *v1.VirtualMachineInstance, ifaceType string) uint32 {
+// setInterfaceOffloads sets the offload options of the driver, QEMU programs
+// the offloads of the tap device according to them.
+func setInterfaceOffloads(driver *api.InterfaceDriver, offloads *v1.InterfaceOffloads) {
+	checksum := optionalBoolToOnOff(offloads.Checksum)
+	tso := optionalBoolToOnOff(offloads.TSO)
+
+	host := api.InterfaceDriverHost{
+		CSum: checksum,
+		GSO:  optionalBoolToOnOff(offloads.GSO),
+		TSO4: tso,
+		TSO6: tso,
+	}
+	if host != (api.InterfaceDriverHost{}) {
+		driver.Host = &host
+	}
+
+	guest := api.InterfaceDriverGuest{
+		CSum: checksum,
+		TSO4: tso,
+		TSO6: tso,
+	}
+	if guest != (api.InterfaceDriverGuest{}) {
+		driver.Guest = &guest
+	}
+}
+
+func optionalBoolToOnOff(value *bool) string {
+	switch {
+	case value == nil:
+		return ""
+	case *value:
+		return "on"
+	default:
+		return "off"
+	}
+}
+
+func calculateNetworkQueues(vmi *v1.VirtualMachineInstance, iface v1.Interface, ifaceType string) uint32 {
 	if ifaceType != v1.VirtIO {
 		return 0
 	}
-	return NetworkQueuesCapacity(vmi)
+	return InterfaceQueuesCapacity(vmi, iface)
 }
@@ -60,6 +60,7 @@ var _ = Describe("Network Domain Configurator", func() {
 				network.WithUseLaunchSecurityPV(false),
 				network.WithROMTuningSupport(false),
 				network.WithVirtioModel(virtioModel),
+				network.WithVhostNetAvailable(true),
 			)
 
 			var domain api.Domain
@@ -106,6 +107,7 @@ var _ = Describe("Network Domain Configurator", func() {
 				network.WithUseLaunchSecurityPV(false),
 				network.WithROMTuningSupport(false),
 				network.WithVirtioModel(virtioModel),
+				network.WithVhostNetAvailable(true),
 			)
 
 			var domain api.Domain
@@ -162,6 +164,7 @@ var _ = Describe("Network Domain Configurator", func() {
 				network.WithUseLaunchSecurityPV(false),
 				network.WithROMTuningSupport(false),
 				network.WithVirtioModel(virtioModel),
+				network.WithVhostNetAvailable(true),
 			)
 
 			var domain api.Domain
@@ -199,6 +202,7 @@ var _ = Describe("Network Domain Configurator", func() {
 			network.WithUseLaunchSecurityPV(false),
 			network.WithROMTuningSupport(false),
 			network.WithVirtioModel(virtioModel),
+			network.WithVhostNetAvailable(true),
 		)
 
 		var domain api.Domain
@@ -223,6 +227,152 @@ var _ = Describe("Network Domain Configurator", func() {
 			newDomainInterface(network1Name, "e1000", withTypeEthernet()),
 		),
 	)
+
+	DescribeTable("should override the virtio model per interface", func(virtioTransitional *bool, expectedModel string) {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
+		iface.VirtioTransitional = virtioTransitional
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithVirtioModel(virtioModel),
+			network.WithVhostNetAvailable(true),
+			network.WithArchitecture("amd64"),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := newDomainWithIfaces([]api.Interface{newDomainInterface(network1Name, expectedModel, withTypeEthernet())})
+		Expect(domain).To(Equal(expectedDomain))
+	},
+		Entry("when not specified", nil, virtioModel),
+		Entry("when transitional", pointer.P(true), "virtio-transitional"),
+		Entry("when non-transitional", pointer.P(false), "virtio-non-transitional"),
+	)
+
+	DescribeTable("should configure the interface backend", func(
+		vhostNetAvailable bool,
+		modify func(iface *v1.Interface),
+		expectedInterface api.Interface,
+	) {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
+		modify(&iface)
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithVirtioModel(virtioModel),
+			network.WithVhostNetAvailable(vhostNetAvailable),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		expectedDomain := newDomainWithIfaces([]api.Interface{expectedInterface})
+		Expect(domain).To(Equal(expectedDomain))
+	},
+		Entry("falling back to userspace when vhost-net is unavailable",
+			false, func(*v1.Interface) {},
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withDriver("qemu", nil)),
+		),
+		Entry("to userspace when requested",
+			true, func(iface *v1.Interface) { iface.Backend = v1.InterfaceBackendUserspace },
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withDriver("qemu", nil)),
+		),
+		Entry("to vhost when requested, even when vhost-net is unavailable",
+			false, func(iface *v1.Interface) { iface.Backend = v1.InterfaceBackendVhost },
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withDriver("vhost", nil)),
+		),
+		Entry("with the queues of the interface",
+			true, func(iface *v1.Interface) { iface.Queues = pointer.P(uint32(3)) },
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withVHostDriver(3)),
+		),
+		Entry("to userspace with the queues of the interface",
+			true, func(iface *v1.Interface) {
+				iface.Backend = v1.InterfaceBackendUserspace
+				iface.Queues = pointer.P(uint32(2))
+			},
+			newDomainInterface(network1Name, virtioModel, withTypeEthernet(), withDriver("qemu", pointer.P(uint(2)))),
+		),
+		Entry("not for non-virtio models",
+			false, func(iface *v1.Interface) { iface.Model = "e1000" },
+			newDomainInterface(network1Name, "e1000", withTypeEthernet()),
+		),
+	)
+
+	DescribeTable("should configure the interface offloads", func(offloads *v1.InterfaceOffloads, expectedDriver *api.InterfaceDriver) {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding(network1Name)
+		iface.Offloads = offloads
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithVirtioModel(virtioModel),
+			network.WithVhostNetAvailable(true),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Interfaces[0].Driver).To(Equal(expectedDriver))
+	},
+		Entry("when all offloads are disabled",
+			&v1.InterfaceOffloads{Checksum: pointer.P(false), TSO: pointer.P(false), GSO: pointer.P(false)},
+			&api.InterfaceDriver{
+				Host:  &api.InterfaceDriverHost{CSum: "off", GSO: "off", TSO4: "off", TSO6: "off"},
+				Guest: &api.InterfaceDriverGuest{CSum: "off", TSO4: "off", TSO6: "off"},
+			},
+		),
+		Entry("when only GSO is disabled",
+			&v1.InterfaceOffloads{GSO: pointer.P(false)},
+			&api.InterfaceDriver{Host: &api.InterfaceDriverHost{GSO: "off"}},
+		),
+		Entry("when TSO is enabled",
+			&v1.InterfaceOffloads{TSO: pointer.P(true)},
+			&api.InterfaceDriver{
+				Host:  &api.InterfaceDriverHost{TSO4: "on", TSO6: "on"},
+				Guest: &api.InterfaceDriverGuest{TSO4: "on", TSO6: "on"},
+			},
+		),
+	)
+
+	It("should team the failover standby interface of an SR-IOV interface", func() {
+		const sriovNetworkName = "sriov"
+		sriovIface := libvmi.InterfaceDeviceWithSRIOVBinding(sriovNetworkName)
+		sriovIface.SRIOV.Failover = &v1.InterfaceSRIOVFailover{StandbyInterface: network1Name}
+
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding(network1Name)),
+			libvmi.WithNetwork(libvmi.MultusNetwork(network1Name, nad1Name)),
+			libvmi.WithInterface(sriovIface),
+			libvmi.WithNetwork(libvmi.MultusNetwork(sriovNetworkName, "sriov-nad")),
+		)
+
+		configurator := network.NewDomainConfigurator(
+			network.WithDomainAttachmentByInterfaceName(map[string]string{network1Name: string(v1.Tap)}),
+			network.WithVirtioModel(virtioModel),
+		)
+
+		var domain api.Domain
+		Expect(configurator.Configure(vmi, &domain)).To(Succeed())
+
+		Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Interfaces[0].Teaming).To(Equal(&api.Teaming{Type: "persistent"}))
+	})
 })
 
 func newDomainWithIfaces(interfaces []api.Interface) api.Domain {
@@ -262,6 +412,12 @@ func withVHostDriver(queues uint) option {
 	}
 }
 
+func withDriver(name string, queues *uint) option {
+	return func(iface *api.Interface) {
+		iface.Driver = &api.InterfaceDriver{Name: name, Queues: queues}
+	}
+}
+
 func withLinkState(state string) option {
 	return func(iface *api.Interface) {
 		iface.LinkState = &api.LinkState{State: state}
@@ -44,6 +44,15 @@ func NetworkQueuesCapacity(vmi *v1.VirtualMachineInstance) uint32 {
 	return queueNumber
 }
 
+// InterfaceQueuesCapacity returns the number of queues of a virtio interface,
+// the queues of the interface take precedence over networkInterfaceMultiqueue.
+func InterfaceQueuesCapacity(vmi *v1.VirtualMachineInstance, iface v1.Interface) uint32 {
+	if iface.Queues != nil {
+		return *iface.Queues
+	}
+	return NetworkQueuesCapacity(vmi)
+}
+
 func isTrue(networkInterfaceMultiQueue *bool) bool {
 	return (networkInterfaceMultiQueue != nil) && (*networkInterfaceMultiQueue)
 }
@@ -20,12 +20,16 @@
 package storage
 
 import (
+	"strconv"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
+const defaultVirtiofsQueueSize = "1024"
+
 type VirtiofsConfigurator struct{}
 
 func NewVirtiofsConfigurator() VirtiofsConfigurator {
@@ -38,13 +42,16 @@ func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *
 			continue
 		}
 
+		// virtiofsd is not launched by libvirt but runs in its own container,
+		// which takes care of the thread pool and the ID mapping. Only the
+		// options of the device end up in the domain.
 		domain.Spec.Devices.Filesystems = append(domain.Spec.Devices.Filesystems,
 			api.FilesystemDevice{
 				Type:       "mount",
 				AccessMode: "passthrough",
 				Driver: &api.FilesystemDriver{
 					Type:  "virtiofs",
-					Queue: "1024",
+					Queue: queueSize(fs.Virtiofs),
 				},
 				Source: &api.FilesystemSource{
 					Socket: virtiofs.VirtioFSSocketPath(fs.Name),
@@ -58,3 +65,10 @@ func (f VirtiofsConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *
 
 	return nil
 }
+
+func queueSize(virtiofs *v1.FilesystemVirtiofs) string {
+	if virtiofs.QueueSize == nil {
+		return defaultVirtiofsQueueSize
+	}
+	return strconv.FormatUint(uint64(*virtiofs.QueueSize), 10)
+}
@@ -24,6 +24,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/storage"
 )
@@ -69,4 +70,19 @@ var _ = Describe("VirtioFS Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should configure the queue size of the filesystem", func() {
+		vmi := libvmi.New(
+			libvmi.WithFilesystemPVC("myfs"),
+		)
+		vmi.Spec.Domain.Devices.Filesystems[0].Virtiofs.QueueSize = pointer.P(uint32(256))
+		var domain api.Domain
+
+		Expect(storage.VirtiofsConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.Devices.Filesystems).To(HaveLen(1))
+		Expect(domain.Spec.Devices.Filesystems[0].Driver).To(Equal(&api.FilesystemDriver{
+			Type:  "virtiofs",
+			Queue: "256",
+		}))
+	})
 })
@@ -18,6 +18,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
+// cpuCoreTypesGetter is replaceable for testing
+var cpuCoreTypesGetter = func() (map[int]v12.CPUCoreType, error) {
+	return hardware.GetCPUCoreTypes(hardware.CPUDevicesPath)
+}
+
 type VCPUPool interface {
 	FitCores() (tune *api.CPUTune, err error)
 	FitThread() (thread uint32, err error)
@@ -452,6 +457,14 @@ func FormatEmulatorThreadPin(cpuPool VCPUPool, vmiAnnotations map[string]string,
 
 func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachineInstance, topology *v1.Topology, cpuset []int, useIOThreads bool) error {
 	var cpuPool VCPUPool
+	if coreType := vmi.Spec.Domain.CPU.CoreType; coreType != "" {
+		var err error
+		if cpuset, err = filterCPUSetByCoreType(cpuset, coreType); err != nil {
+			log.Log.Reason(err).Error("failed to select the pCPUs of the requested core type.")
+			return err
+		}
+	}
+
 	requestedToplogy := &api.CPUTopology{
 		Sockets: domain.Spec.CPU.Topology.Sockets,
 		Cores:   domain.Spec.CPU.Topology.Cores,
@@ -481,8 +494,9 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 	}
 	domain.Spec.CPUTune = cpuTune
 
-	// Add the hint-dedicated feature when dedicatedCPUs are requested for AMD64 architecture.
-	if isAMD64VMI(vmi) {
+	// Add the hint-dedicated feature when dedicatedCPUs are requested for AMD64 architecture,
+	// unless the user configured it explicitly.
+	if isAMD64VMI(vmi) && !isKVMHintDedicatedConfigured(vmi) {
 		if domain.Spec.Features == nil {
 			domain.Spec.Features = &api.Features{}
 		}
@@ -533,6 +547,30 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 	return nil
 }
 
+// filterCPUSetByCoreType keeps the CPUs of the cpuset which are of the requested core type.
+// The VMI is scheduled to a node with cores of this type, but kubelet may still allocate
+// cores of both types.
+func filterCPUSetByCoreType(cpuset []int, coreType v12.CPUCoreType) ([]int, error) {
+	coreTypes, err := cpuCoreTypesGetter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect the core types of the host: %v", err)
+	}
+	if len(coreTypes) == 0 {
+		return nil, fmt.Errorf("the host does not have hybrid CPUs")
+	}
+
+	var filtered []int
+	for _, cpu := range cpuset {
+		if coreTypes[cpu] == coreType {
+			filtered = append(filtered, cpu)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("none of the allocated pCPUs %v is a %s core", cpuset, coreType)
+	}
+	return filtered, nil
+}
+
 func convertCPUListToCPUSet(allocatedCPUs []uint32) string {
 	const delimiter = ","
 	var allocatedCPUsString []string
@@ -696,6 +734,11 @@ func hugePagesInfo(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) (siz
 	return 0, "b", false, nil
 }
 
+func isKVMHintDedicatedConfigured(vmi *v12.VirtualMachineInstance) bool {
+	features := vmi.Spec.Domain.Features
+	return features != nil && features.KVM != nil && features.KVM.HintDedicated != nil
+}
+
 func isAMD64VMI(vmi *v12.VirtualMachineInstance) bool {
 	return vmi.Spec.Architecture == "amd64"
 }
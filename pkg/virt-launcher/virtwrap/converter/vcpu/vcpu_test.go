@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v12 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	v1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
@@ -20,6 +21,58 @@ import (
 
 type factoryFunc func(requestedToplogy *api.CPUTopology, nodeTopology *v1.Topology, cpuSet []int) VCPUPool
 
+var _ = Describe("CPU core type filtering", func() {
+	var origCPUCoreTypesGetter func() (map[int]v12.CPUCoreType, error)
+
+	BeforeEach(func() {
+		origCPUCoreTypesGetter = cpuCoreTypesGetter
+		cpuCoreTypesGetter = func() (map[int]v12.CPUCoreType, error) {
+			coreTypes := map[int]v12.CPUCoreType{}
+			for cpu := 0; cpu < 8; cpu++ {
+				coreTypes[cpu] = v12.CPUCoreTypePerformance
+				if cpu >= 4 {
+					coreTypes[cpu] = v12.CPUCoreTypeEfficiency
+				}
+			}
+			return coreTypes, nil
+		}
+	})
+
+	AfterEach(func() {
+		cpuCoreTypesGetter = origCPUCoreTypesGetter
+	})
+
+	DescribeTable("should keep only the pCPUs of the requested core type", func(cpuset []int, coreType v12.CPUCoreType, expected []int) {
+		filtered, err := filterCPUSetByCoreType(cpuset, coreType)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filtered).To(Equal(expected))
+	},
+		Entry("performance cores", []int{2, 3, 4, 5}, v12.CPUCoreTypePerformance, []int{2, 3}),
+		Entry("efficiency cores", []int{2, 3, 4, 5}, v12.CPUCoreTypeEfficiency, []int{4, 5}),
+	)
+
+	It("should fail if none of the pCPUs is of the requested core type", func() {
+		_, err := filterCPUSetByCoreType([]int{4, 5}, v12.CPUCoreTypePerformance)
+		Expect(err).To(MatchError("none of the allocated pCPUs [4 5] is a performance core"))
+	})
+
+	It("should fail if the host does not have hybrid CPUs", func() {
+		cpuCoreTypesGetter = func() (map[int]v12.CPUCoreType, error) {
+			return map[int]v12.CPUCoreType{}, nil
+		}
+		_, err := filterCPUSetByCoreType([]int{0, 1}, v12.CPUCoreTypePerformance)
+		Expect(err).To(MatchError("the host does not have hybrid CPUs"))
+	})
+
+	It("should fail if the core types can not be detected", func() {
+		cpuCoreTypesGetter = func() (map[int]v12.CPUCoreType, error) {
+			return nil, fmt.Errorf("failed to parse")
+		}
+		_, err := filterCPUSetByCoreType([]int{0, 1}, v12.CPUCoreTypePerformance)
+		Expect(err).To(MatchError("failed to detect the core types of the host: failed to parse"))
+	})
+})
+
 var _ = Describe("VCPU pinning", func() {
 
 	BeforeEach(func() {
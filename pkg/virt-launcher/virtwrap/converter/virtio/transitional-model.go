@@ -25,3 +25,12 @@ func InterpretTransitionalModelType(useVirtioTransitional *bool, archString stri
 	vtenabled := useVirtioTransitional != nil && *useVirtioTransitional
 	return arch.NewConverter(archString).TransitionalModelType(vtenabled)
 }
+
+// DeviceTransitional returns the transitional setting of a single device,
+// falling back to the VM wide setting when the device does not override it.
+func DeviceTransitional(deviceTransitional, vmTransitional *bool) *bool {
+	if deviceTransitional != nil {
+		return deviceTransitional
+	}
+	return vmTransitional
+}
@@ -136,6 +136,13 @@ func newDecorateHook(iface v1.Interface) func(hostDevice *api.HostDevice) error
 		if iface.BootOrder != nil {
 			hostDevice.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
 		}
+
+		if iface.SRIOV != nil && iface.SRIOV.Failover != nil {
+			hostDevice.Teaming = &api.Teaming{
+				Type:       "transient",
+				Persistent: api.UserAliasPrefix + iface.SRIOV.Failover.StandbyInterface,
+			}
+		}
 		return nil
 	}
 }
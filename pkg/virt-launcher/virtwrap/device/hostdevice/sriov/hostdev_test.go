@@ -249,6 +249,24 @@ var _ = Describe("SRIOV HostDevice", func() {
 			),
 		)
 
+		It("creates 1 device that is teamed with its failover standby interface", func() {
+			iface := newSRIOVInterface(netname1)
+			iface.SRIOV.Failover = &v1.InterfaceSRIOVFailover{StandbyInterface: "standby"}
+			pool := newPCIAddressPoolStub("0000:81:01.0")
+
+			devices, err := sriov.CreateHostDevicesFromIfacesAndPool([]v1.Interface{iface}, pool)
+
+			hostPCIAddress1 := api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x0"}
+			expectHostDevice1 := api.HostDevice{
+				Alias:   newSRIOVAlias(netname1),
+				Source:  api.HostDeviceSource{Address: &hostPCIAddress1},
+				Type:    api.HostDevicePCI,
+				Managed: "no",
+				Teaming: &api.Teaming{Type: "transient", Persistent: "ua-standby"},
+			}
+			Expect(devices, err).To(Equal([]api.HostDevice{expectHostDevice1}))
+		})
+
 		It("creates 1 device that includes boot-order", func() {
 			iface := newSRIOVInterface(netname1)
 			val := uint(1)
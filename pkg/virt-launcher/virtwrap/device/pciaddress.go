@@ -20,6 +20,10 @@
 package device
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -38,3 +42,21 @@ func NewPciAddressField(address string) (*api.Address, error) {
 		Function: "0x" + dbsfFields[3],
 	}, nil
 }
+
+// PciAddressString formats a domain PCI address as a PCI address string, e.g. 0000:81:11.1.
+// It returns an empty string for addresses which are not PCI addresses.
+func PciAddressString(address *api.Address) string {
+	if address == nil || address.Type != api.AddressPCI {
+		return ""
+	}
+
+	var fields [4]uint64
+	for i, field := range []string{address.Domain, address.Bus, address.Slot, address.Function} {
+		value, err := strconv.ParseUint(strings.TrimPrefix(field, "0x"), 16, 16)
+		if err != nil {
+			return ""
+		}
+		fields[i] = value
+	}
+	return fmt.Sprintf("%04x:%02x:%02x.%x", fields[0], fields[1], fields[2], fields[3])
+}
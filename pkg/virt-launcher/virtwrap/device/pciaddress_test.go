@@ -45,4 +45,18 @@ var _ = Describe("PCI Address", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(address).To(BeNil())
 	})
+
+	It("is formatted from a domain PCI Address spec", func() {
+		address, err := device.NewPciAddressField("0000:81:11.1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(device.PciAddressString(address)).To(Equal("0000:81:11.1"))
+	})
+
+	DescribeTable("is not formatted", func(address *api.Address) {
+		Expect(device.PciAddressString(address)).To(BeEmpty())
+	},
+		Entry("without an address", nil),
+		Entry("for a drive address", &api.Address{Type: "drive", Bus: "0", Controller: "0", Unit: "1"}),
+		Entry("with an invalid field", &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0xzz", Slot: "0x01", Function: "0x0"}),
+	)
 })
@@ -0,0 +1,299 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package drift compares the live libvirt domain of a VMI with the domain
+// generated by the converter, in order to surface changes which were applied
+// behind the back of KubeVirt, e.g. by hook sidecars or by manual virsh edits.
+package drift
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"libvirt.org/go/libvirt"
+
+	hw_utils "kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+const (
+	FieldType            = "type"
+	FieldEmulator        = "devices.emulator"
+	FieldQEMUCommandline = "qemu:commandline"
+	FieldVCPUPin         = "cputune.vcpupin"
+	FieldEmulatorPin     = "cputune.emulatorpin"
+
+	// cpuSetLimit bounds the host CPU ids accepted when parsing cpusets.
+	cpuSetLimit = 8192
+)
+
+// Report lists the drifted domain properties. Benign properties can be
+// reconciled on the running domain without any impact on the guest.
+type Report struct {
+	Fields []string
+	Benign []string
+}
+
+// HasDrift reports whether any drift was detected.
+func (r Report) HasDrift() bool {
+	return len(r.Fields) > 0 || len(r.Benign) > 0
+}
+
+// All returns every drifted property, benign ones included.
+func (r Report) All() []string {
+	return append(slices.Clone(r.Fields), r.Benign...)
+}
+
+// Detect compares the expected domain with the live one, which is reported by
+// the libvirt version libvirtVersion. Only properties which libvirt does not
+// default or expand on its own are taken into account, and devices are only
+// compared when they exist in both domains, since hotplug operations are
+// reconciled separately.
+func Detect(expected, live *api.DomainSpec, libvirtVersion api.LibvirtVersion) Report {
+	var report Report
+
+	// libvirt reports the live domain in its own schema, compare the expected
+	// domain in the same schema. Elements it can not express are compared as is.
+	if libvirtVersion != 0 {
+		if normalized, err := api.NormalizeDomainSpec(expected, libvirtVersion); err == nil {
+			expected = normalized
+		}
+	}
+
+	if expected.Type != live.Type {
+		report.Fields = append(report.Fields, FieldType)
+	}
+	if expected.Devices.Emulator != "" && expected.Devices.Emulator != live.Devices.Emulator {
+		report.Fields = append(report.Fields, FieldEmulator)
+	}
+	if !reflect.DeepEqual(commandline(expected), commandline(live)) {
+		report.Fields = append(report.Fields, FieldQEMUCommandline)
+	}
+	report.Fields = append(report.Fields, diskDrift(expected.Devices.Disks, live.Devices.Disks)...)
+	report.Fields = append(report.Fields, interfaceDrift(expected.Devices.Interfaces, live.Devices.Interfaces)...)
+	report.Fields = append(report.Fields, unmanagedHostDevices(expected.Devices.HostDevices, live.Devices.HostDevices)...)
+
+	if !reflect.DeepEqual(vcpuPins(expected), vcpuPins(live)) {
+		report.Benign = append(report.Benign, FieldVCPUPin)
+	}
+	if !slices.Equal(emulatorPin(expected), emulatorPin(live)) {
+		report.Benign = append(report.Benign, FieldEmulatorPin)
+	}
+	return report
+}
+
+// ReconcileBenign restores the benign properties of the live domain from the
+// expected domain.
+func ReconcileBenign(dom cli.VirDomain, expected *api.DomainSpec, report Report, flags libvirt.DomainModificationImpact) error {
+	for _, field := range report.Benign {
+		switch field {
+		case FieldVCPUPin:
+			for vcpu, cpus := range vcpuPins(expected) {
+				if err := dom.PinVcpuFlags(vcpu, cpuMap(cpus), flags); err != nil {
+					return fmt.Errorf("failed to pin vcpu %d: %v", vcpu, err)
+				}
+			}
+		case FieldEmulatorPin:
+			if cpus := emulatorPin(expected); len(cpus) > 0 {
+				if err := dom.PinEmulator(cpuMap(cpus), flags); err != nil {
+					return fmt.Errorf("failed to pin the emulator thread: %v", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func commandline(spec *api.DomainSpec) *api.Commandline {
+	if spec.QEMUCmd == nil || (len(spec.QEMUCmd.QEMUArg) == 0 && len(spec.QEMUCmd.QEMUEnv) == 0) {
+		return nil
+	}
+	return spec.QEMUCmd
+}
+
+// diskDrift compares the disks of both domains. Disks are matched by their
+// alias, or by their target if libvirt does not report the alias.
+func diskDrift(expected, live []api.Disk) []string {
+	var fields []string
+	for _, liveDisk := range live {
+		idx := slices.IndexFunc(expected, func(disk api.Disk) bool {
+			return sameDisk(disk, liveDisk)
+		})
+		if idx < 0 {
+			if !isUserDefined(liveDisk.Alias) {
+				fields = append(fields, fmt.Sprintf("devices.disk[%s]", liveDisk.Target.Device))
+			}
+			continue
+		}
+		expectedDisk := expected[idx]
+		name := expectedDisk.Target.Device
+		if isUserDefined(expectedDisk.Alias) {
+			name = expectedDisk.Alias.GetName()
+		}
+		if expectedDisk.Source.File != liveDisk.Source.File || expectedDisk.Source.Dev != liveDisk.Source.Dev {
+			fields = append(fields, fmt.Sprintf("devices.disk[%s].source", name))
+		}
+		if expectedDisk.Driver != nil && liveDisk.Driver != nil &&
+			(expectedDisk.Driver.Cache != liveDisk.Driver.Cache || expectedDisk.Driver.IO != liveDisk.Driver.IO) {
+			fields = append(fields, fmt.Sprintf("devices.disk[%s].driver", name))
+		}
+		if expectedDisk.Driver != nil && liveDisk.Driver != nil && len(diskIOThreads(expectedDisk.Driver)) > 0 &&
+			!slices.Equal(diskIOThreads(expectedDisk.Driver), diskIOThreads(liveDisk.Driver)) {
+			fields = append(fields, fmt.Sprintf("devices.disk[%s].driver.iothread", name))
+		}
+	}
+	return fields
+}
+
+// diskIOThreads returns the ids of the iothreads of a disk, libvirt before 10.0.0
+// only supports a single one
+func diskIOThreads(driver *api.DiskDriver) []uint32 {
+	if driver.IOThreads != nil {
+		var ids []uint32
+		for _, ioThread := range driver.IOThreads.IOThread {
+			ids = append(ids, ioThread.Id)
+		}
+		return ids
+	}
+	if driver.IOThread != nil {
+		return []uint32{uint32(*driver.IOThread)}
+	}
+	return nil
+}
+
+func sameDisk(expected, live api.Disk) bool {
+	if isUserDefined(live.Alias) {
+		return isUserDefined(expected.Alias) && expected.Alias.GetName() == live.Alias.GetName()
+	}
+	return live.Target.Device != "" && expected.Target.Device == live.Target.Device &&
+		(expected.Target.Bus == "" || expected.Target.Bus == live.Target.Bus)
+}
+
+// interfaceDrift compares the interfaces of both domains. Interfaces are
+// matched by their alias, or by their MAC or PCI address if libvirt does not
+// report the alias.
+func interfaceDrift(expected, live []api.Interface) []string {
+	var fields []string
+	for i, liveIface := range live {
+		idx := slices.IndexFunc(expected, func(iface api.Interface) bool {
+			return sameInterface(iface, liveIface)
+		})
+		if idx < 0 {
+			if !isUserDefined(liveIface.Alias) {
+				fields = append(fields, fmt.Sprintf("devices.interface[%d]", i))
+			}
+			continue
+		}
+		expectedIface := expected[idx]
+		name := fmt.Sprintf("%d", idx)
+		if isUserDefined(expectedIface.Alias) {
+			name = expectedIface.Alias.GetName()
+		}
+		if expectedIface.MAC != nil && (liveIface.MAC == nil || !strings.EqualFold(expectedIface.MAC.MAC, liveIface.MAC.MAC)) {
+			fields = append(fields, fmt.Sprintf("devices.interface[%s].mac", name))
+		}
+		if expectedIface.Model != nil && (liveIface.Model == nil || expectedIface.Model.Type != liveIface.Model.Type) {
+			fields = append(fields, fmt.Sprintf("devices.interface[%s].model", name))
+		}
+	}
+	return fields
+}
+
+func sameInterface(expected, live api.Interface) bool {
+	if isUserDefined(live.Alias) {
+		return isUserDefined(expected.Alias) && expected.Alias.GetName() == live.Alias.GetName()
+	}
+	if expected.MAC != nil && live.MAC != nil && strings.EqualFold(expected.MAC.MAC, live.MAC.MAC) {
+		return true
+	}
+	return sameAddress(expected.Address, live.Address)
+}
+
+// unmanagedHostDevices reports the host devices of the live domain which are
+// not part of the expected domain. Host devices are matched by their alias,
+// or by their type and source address if libvirt does not report the alias.
+func unmanagedHostDevices(expected, live []api.HostDevice) []string {
+	var fields []string
+	for i, hostDev := range live {
+		if isUserDefined(hostDev.Alias) {
+			continue
+		}
+		if !slices.ContainsFunc(expected, func(expectedDev api.HostDevice) bool {
+			return expectedDev.Type == hostDev.Type && sameAddress(expectedDev.Source.Address, hostDev.Source.Address)
+		}) {
+			fields = append(fields, fmt.Sprintf("devices.hostdev[%d]", i))
+		}
+	}
+	return fields
+}
+
+func isUserDefined(alias *api.Alias) bool {
+	return alias != nil && alias.IsUserDefined()
+}
+
+// sameAddress compares two addresses, PCI addresses are compared by their
+// value since libvirt formats them differently than the converter
+func sameAddress(expected, live *api.Address) bool {
+	if expected == nil || live == nil || expected.Type != live.Type {
+		return false
+	}
+	if expected.Type == api.AddressPCI {
+		address := device.PciAddressString(expected)
+		return address != "" && address == device.PciAddressString(live)
+	}
+	return *expected == *live
+}
+
+func vcpuPins(spec *api.DomainSpec) map[uint][]int {
+	if spec.CPUTune == nil || len(spec.CPUTune.VCPUPin) == 0 {
+		return nil
+	}
+	pins := map[uint][]int{}
+	for _, pin := range spec.CPUTune.VCPUPin {
+		cpus, err := hw_utils.ParseCPUSetLine(pin.CPUSet, cpuSetLimit)
+		if err != nil || len(cpus) == 0 {
+			continue
+		}
+		pins[uint(pin.VCPU)] = cpus
+	}
+	return pins
+}
+
+func emulatorPin(spec *api.DomainSpec) []int {
+	if spec.CPUTune == nil || spec.CPUTune.EmulatorPin == nil {
+		return nil
+	}
+	cpus, err := hw_utils.ParseCPUSetLine(spec.CPUTune.EmulatorPin.CPUSet, cpuSetLimit)
+	if err != nil {
+		return nil
+	}
+	return cpus
+}
+
+func cpuMap(cpus []int) []bool {
+	cpuMap := make([]bool, slices.Max(cpus)+1)
+	for _, cpu := range cpus {
+		cpuMap[cpu] = true
+	}
+	return cpuMap
+}
@@ -0,0 +1,11 @@
+package drift_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestDrift(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
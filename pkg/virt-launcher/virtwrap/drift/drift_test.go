@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package drift_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/drift"
+)
+
+var _ = Describe("Domain drift", func() {
+	newDomainSpec := func() *api.DomainSpec {
+		return &api.DomainSpec{
+			Type: "kvm",
+			Devices: api.Devices{
+				Disks: []api.Disk{{
+					Source: api.DiskSource{File: "/var/run/kubevirt/container-disks/disk_0.img"},
+					Target: api.DiskTarget{Device: "vda"},
+					Driver: &api.DiskDriver{Cache: "none", IO: "native"},
+					Alias:  api.NewUserDefinedAlias("rootdisk"),
+				}},
+				Interfaces: []api.Interface{{
+					Model: &api.Model{Type: "virtio-non-transitional"},
+					MAC:   &api.MAC{MAC: "02:00:00:00:00:01"},
+					Alias: api.NewUserDefinedAlias("default"),
+				}},
+			},
+			CPUTune: &api.CPUTune{
+				VCPUPin:     []api.CPUTuneVCPUPin{{VCPU: 0, CPUSet: "2"}, {VCPU: 1, CPUSet: "3"}},
+				EmulatorPin: &api.CPUEmulatorPin{CPUSet: "4-5"},
+			},
+		}
+	}
+
+	DescribeTable("should detect", func(modify func(live *api.DomainSpec), expectedFields, expectedBenign []string) {
+		live := newDomainSpec()
+		modify(live)
+
+		report := drift.Detect(newDomainSpec(), live, 0)
+		Expect(report.Fields).To(Equal(expectedFields))
+		Expect(report.Benign).To(Equal(expectedBenign))
+	},
+		Entry("no drift on an identical domain", func(*api.DomainSpec) {}, nil, nil),
+		Entry("no drift on properties defaulted by libvirt", func(live *api.DomainSpec) {
+			live.Devices.Emulator = "/usr/libexec/qemu-kvm"
+			live.CPUTune.EmulatorPin.CPUSet = "4,5"
+		}, nil, nil),
+		Entry("no drift on devices which are not yet hotplugged", func(live *api.DomainSpec) {
+			live.Devices.Interfaces = nil
+		}, nil, nil),
+		Entry("a changed domain type", func(live *api.DomainSpec) {
+			live.Type = "qemu"
+		}, []string{drift.FieldType}, nil),
+		Entry("qemu commandline arguments added by a hook sidecar", func(live *api.DomainSpec) {
+			live.QEMUCmd = &api.Commandline{QEMUArg: []api.Arg{{Value: "-fw_cfg"}}}
+		}, []string{drift.FieldQEMUCommandline}, nil),
+		Entry("a changed disk source and driver", func(live *api.DomainSpec) {
+			live.Devices.Disks[0].Source.File = "/tmp/other.img"
+			live.Devices.Disks[0].Driver.Cache = "writeback"
+		}, []string{"devices.disk[rootdisk].source", "devices.disk[rootdisk].driver"}, nil),
+		Entry("a disk attached outside of KubeVirt", func(live *api.DomainSpec) {
+			live.Devices.Disks = append(live.Devices.Disks, api.Disk{Target: api.DiskTarget{Device: "vdb"}})
+		}, []string{"devices.disk[vdb]"}, nil),
+		Entry("a changed interface mac and model", func(live *api.DomainSpec) {
+			live.Devices.Interfaces[0].MAC.MAC = "02:00:00:00:00:02"
+			live.Devices.Interfaces[0].Model.Type = "e1000"
+		}, []string{"devices.interface[default].mac", "devices.interface[default].model"}, nil),
+		Entry("a host device attached outside of KubeVirt", func(live *api.DomainSpec) {
+			live.Devices.HostDevices = []api.HostDevice{{Type: "pci"}}
+		}, []string{"devices.hostdev[0]"}, nil),
+		Entry("a changed source of a disk reported without alias", func(live *api.DomainSpec) {
+			live.Devices.Disks[0].Alias = nil
+			live.Devices.Disks[0].Source.File = "/tmp/other.img"
+		}, []string{"devices.disk[rootdisk].source"}, nil),
+		Entry("no drift on an interface reported without alias", func(live *api.DomainSpec) {
+			live.Devices.Interfaces[0].Alias = nil
+		}, nil, nil),
+		Entry("a changed model of an interface reported without alias", func(live *api.DomainSpec) {
+			live.Devices.Interfaces[0].Alias = nil
+			live.Devices.Interfaces[0].Model.Type = "e1000"
+		}, []string{"devices.interface[default].model"}, nil),
+		Entry("a changed cpu pinning as benign", func(live *api.DomainSpec) {
+			live.CPUTune.VCPUPin[1].CPUSet = "6"
+			live.CPUTune.EmulatorPin.CPUSet = "0-7"
+		}, nil, []string{drift.FieldVCPUPin, drift.FieldEmulatorPin}),
+	)
+
+	It("should not report a host device reported without alias", func() {
+		address := &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x00", Function: "0x1"}
+		expected := newDomainSpec()
+		expected.Devices.HostDevices = []api.HostDevice{{
+			Type:   api.HostDevicePCI,
+			Source: api.HostDeviceSource{Address: address},
+			Alias:  api.NewUserDefinedAlias("sriov-net"),
+		}}
+		live := newDomainSpec()
+		live.Devices.HostDevices = []api.HostDevice{{
+			Type:   api.HostDevicePCI,
+			Source: api.HostDeviceSource{Address: &api.Address{Type: api.AddressPCI, Domain: "0x0", Bus: "0x81", Slot: "0x0", Function: "0x1"}},
+		}}
+
+		Expect(drift.Detect(expected, live, 0).Fields).To(BeEmpty())
+	})
+
+	It("should compare the disk iothreads in the schema of the libvirt version", func() {
+		expected := newDomainSpec()
+		expected.Devices.Disks[0].Driver.IOThreads = &api.DiskIOThreads{IOThread: []api.DiskIOThread{{Id: 2}, {Id: 3}}}
+		live := newDomainSpec()
+		live.Devices.Disks[0].Driver.IOThread = pointer.P(uint(2))
+
+		Expect(drift.Detect(expected, live, api.NewLibvirtVersion(9, 0, 0)).Fields).To(BeEmpty())
+		Expect(drift.Detect(expected, live, api.NewLibvirtVersion(10, 0, 0)).Fields).To(Equal([]string{"devices.disk[rootdisk].driver.iothread"}))
+	})
+
+	It("should report all drifted fields", func() {
+		report := drift.Report{Fields: []string{drift.FieldType}, Benign: []string{drift.FieldVCPUPin}}
+		Expect(report.HasDrift()).To(BeTrue())
+		Expect(report.All()).To(Equal([]string{drift.FieldType, drift.FieldVCPUPin}))
+		Expect(drift.Report{}.HasDrift()).To(BeFalse())
+	})
+
+	It("should restore the cpu pinning when reconciling benign drift", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		dom := cli.NewMockVirDomain(ctrl)
+		flags := libvirt.DOMAIN_AFFECT_LIVE | libvirt.DOMAIN_AFFECT_CONFIG
+
+		dom.EXPECT().PinVcpuFlags(uint(0), []bool{false, false, true}, flags).Return(nil)
+		dom.EXPECT().PinVcpuFlags(uint(1), []bool{false, false, false, true}, flags).Return(nil)
+		dom.EXPECT().PinEmulator([]bool{false, false, false, false, true, true}, flags).Return(nil)
+
+		report := drift.Report{Fields: []string{drift.FieldType}, Benign: []string{drift.FieldVCPUPin, drift.FieldEmulatorPin}}
+		Expect(drift.ReconcileBenign(dom, newDomainSpec(), report, flags)).To(Succeed())
+	})
+})
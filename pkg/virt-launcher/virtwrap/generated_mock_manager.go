@@ -249,6 +249,21 @@ func (mr *MockDomainManagerMockRecorder) GetSEVInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVInfo", reflect.TypeOf((*MockDomainManager)(nil).GetSEVInfo))
 }
 
+// GetSEVSNPAttestationReport mocks base method.
+func (m *MockDomainManager) GetSEVSNPAttestationReport(arg0 *v1.VirtualMachineInstance, arg1 *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSEVSNPAttestationReport", arg0, arg1)
+	ret0, _ := ret[0].(*v1.SEVSNPAttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSEVSNPAttestationReport indicates an expected call of GetSEVSNPAttestationReport.
+func (mr *MockDomainManagerMockRecorder) GetSEVSNPAttestationReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSEVSNPAttestationReport", reflect.TypeOf((*MockDomainManager)(nil).GetSEVSNPAttestationReport), arg0, arg1)
+}
+
 // GetScreenshot mocks base method.
 func (m *MockDomainManager) GetScreenshot(vmi *v1.VirtualMachineInstance) (*v10.ScreenshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +279,21 @@ func (mr *MockDomainManagerMockRecorder) GetScreenshot(vmi any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScreenshot", reflect.TypeOf((*MockDomainManager)(nil).GetScreenshot), vmi)
 }
 
+// GetTPMAttestation mocks base method.
+func (m *MockDomainManager) GetTPMAttestation(arg0 *v1.VirtualMachineInstance, arg1 *v1.TPMAttestationOptions) (*v1.TPMAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTPMAttestation", arg0, arg1)
+	ret0, _ := ret[0].(*v1.TPMAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTPMAttestation indicates an expected call of GetTPMAttestation.
+func (mr *MockDomainManagerMockRecorder) GetTPMAttestation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTPMAttestation", reflect.TypeOf((*MockDomainManager)(nil).GetTPMAttestation), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *MockDomainManager) GetUsers() []v1.VirtualMachineInstanceGuestOSUser {
 	m.ctrl.T.Helper()
@@ -404,17 +434,17 @@ func (mr *MockDomainManagerMockRecorder) MigrateVMI(arg0, arg1 any) *gomock.Call
 }
 
 // PauseVMI mocks base method.
-func (m *MockDomainManager) PauseVMI(arg0 *v1.VirtualMachineInstance) error {
+func (m *MockDomainManager) PauseVMI(arg0 *v1.VirtualMachineInstance, arg1 int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PauseVMI", arg0)
+	ret := m.ctrl.Call(m, "PauseVMI", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PauseVMI indicates an expected call of PauseVMI.
-func (mr *MockDomainManagerMockRecorder) PauseVMI(arg0 any) *gomock.Call {
+func (mr *MockDomainManagerMockRecorder) PauseVMI(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseVMI", reflect.TypeOf((*MockDomainManager)(nil).PauseVMI), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseVMI", reflect.TypeOf((*MockDomainManager)(nil).PauseVMI), arg0, arg1)
 }
 
 // PrepareMigrationTarget mocks base method.
@@ -431,6 +461,20 @@ func (mr *MockDomainManagerMockRecorder) PrepareMigrationTarget(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareMigrationTarget", reflect.TypeOf((*MockDomainManager)(nil).PrepareMigrationTarget), arg0, arg1, arg2)
 }
 
+// RebootVMI mocks base method.
+func (m *MockDomainManager) RebootVMI(arg0 *v1.VirtualMachineInstance, arg1 v1.RebootMethod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVMI", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebootVMI indicates an expected call of RebootVMI.
+func (mr *MockDomainManagerMockRecorder) RebootVMI(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVMI", reflect.TypeOf((*MockDomainManager)(nil).RebootVMI), arg0, arg1)
+}
+
 // ResetVMI mocks base method.
 func (m *MockDomainManager) ResetVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -445,6 +489,34 @@ func (mr *MockDomainManagerMockRecorder) ResetVMI(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVMI", reflect.TypeOf((*MockDomainManager)(nil).ResetVMI), arg0)
 }
 
+// ResizeGuestMemory mocks base method.
+func (m *MockDomainManager) ResizeGuestMemory(vmi *v1.VirtualMachineInstance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeGuestMemory", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResizeGuestMemory indicates an expected call of ResizeGuestMemory.
+func (mr *MockDomainManagerMockRecorder) ResizeGuestMemory(vmi any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeGuestMemory", reflect.TypeOf((*MockDomainManager)(nil).ResizeGuestMemory), vmi)
+}
+
+// SetVNCResolution mocks base method.
+func (m *MockDomainManager) SetVNCResolution(vmi *v1.VirtualMachineInstance, options *v1.VNCResolutionOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVNCResolution", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVNCResolution indicates an expected call of SetVNCResolution.
+func (mr *MockDomainManagerMockRecorder) SetVNCResolution(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVNCResolution", reflect.TypeOf((*MockDomainManager)(nil).SetVNCResolution), vmi, options)
+}
+
 // SignalShutdownVMI mocks base method.
 func (m *MockDomainManager) SignalShutdownVMI(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,306 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	kbsAPIPath         = "/kbs/v0"
+	kbsProtocolVersion = "0.1.0"
+	kbsTEE             = "sev"
+	kbsRequestTimeout  = 30 * time.Second
+	// Limits how much of an error response ends up in the error message
+	kbsMaxErrorLength = 512
+	// The key the broker encrypts the resources to is only used for a single handshake
+	kbsTEEKeySize = 2048
+
+	jweAlgorithmRSAOAEP    = "RSA-OAEP"
+	jweAlgorithmRSAOAEP256 = "RSA-OAEP-256"
+	jweEncryptionA256GCM   = "A256GCM"
+)
+
+// kbsClient speaks the request-challenge-attestation-response protocol of
+// the Trustee key broker service. The session established by the handshake
+// is kept in a cookie. The broker encrypts the released resources to the
+// public key passed along with the evidence, as JWE.
+type kbsClient struct {
+	client       *http.Client
+	baseURL      string
+	resourcePath string
+}
+
+type kbsRequest struct {
+	Version     string `json:"version"`
+	TEE         string `json:"tee"`
+	ExtraParams string `json:"extra-params"`
+}
+
+type kbsChallenge struct {
+	Nonce       string `json:"nonce"`
+	ExtraParams string `json:"extra-params"`
+}
+
+type kbsAttestation struct {
+	TEEPubKey   kbsTEEPubKey `json:"tee-pubkey"`
+	TEEEvidence string       `json:"tee-evidence"`
+}
+
+// kbsTEEPubKey is the RSA public key of the TEE as JWK
+type kbsTEEPubKey struct {
+	KeyType   string `json:"kty"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+// kbsResponse is a resource encrypted to the TEE public key, in the
+// flattened JSON serialization of JWE
+type kbsResponse struct {
+	Protected    string `json:"protected"`
+	EncryptedKey string `json:"encrypted_key"`
+	IV           string `json:"iv"`
+	Ciphertext   string `json:"ciphertext"`
+	Tag          string `json:"tag"`
+	AAD          string `json:"aad,omitempty"`
+}
+
+type jweHeader struct {
+	Algorithm  string `json:"alg"`
+	Encryption string `json:"enc"`
+}
+
+type kbsSEVEvidence struct {
+	Nonce       string `json:"nonce"`
+	Measurement string `json:"measurement"`
+	APIMajor    uint   `json:"api_major"`
+	APIMinor    uint   `json:"api_minor"`
+	BuildID     uint   `json:"build_id"`
+	Policy      uint   `json:"policy"`
+	LoaderSHA   string `json:"loader_sha"`
+}
+
+func NewKBSClient(config *v1.KeyBroker) (KeyBroker, error) {
+	brokerURL, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key broker URL: %v", err)
+	}
+	if brokerURL.Scheme != "http" && brokerURL.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported key broker URL scheme %q", brokerURL.Scheme)
+	}
+	resourcePath := strings.Trim(config.ResourcePath, "/")
+	if resourcePath == "" {
+		return nil, fmt.Errorf("the resource path of the launch secret is required")
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &kbsClient{
+		client:       &http.Client{Jar: jar, Timeout: kbsRequestTimeout},
+		baseURL:      strings.TrimSuffix(brokerURL.String(), "/") + kbsAPIPath,
+		resourcePath: resourcePath,
+	}, nil
+}
+
+func (k *kbsClient) FetchLaunchSecret(ctx context.Context, measurement *v1.SEVMeasurementInfo) (*v1.SEVSecretOptions, error) {
+	challenge := kbsChallenge{}
+	if err := k.do(ctx, http.MethodPost, "/auth", kbsRequest{Version: kbsProtocolVersion, TEE: kbsTEE}, &challenge); err != nil {
+		return nil, err
+	}
+
+	teeKey, err := rsa.GenerateKey(rand.Reader, kbsTEEKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the TEE key: %v", err)
+	}
+
+	evidence, err := json.Marshal(kbsSEVEvidence{
+		Nonce:       challenge.Nonce,
+		Measurement: measurement.Measurement,
+		APIMajor:    measurement.APIMajor,
+		APIMinor:    measurement.APIMinor,
+		BuildID:     measurement.BuildID,
+		Policy:      measurement.Policy,
+		LoaderSHA:   measurement.LoaderSHA,
+	})
+	if err != nil {
+		return nil, err
+	}
+	attestation := kbsAttestation{
+		TEEPubKey:   newKBSTEEPubKey(&teeKey.PublicKey),
+		TEEEvidence: string(evidence),
+	}
+	if err := k.do(ctx, http.MethodPost, "/attest", attestation, nil); err != nil {
+		return nil, err
+	}
+
+	response := &kbsResponse{}
+	if err := k.do(ctx, http.MethodGet, "/resource/"+k.resourcePath, nil, response); err != nil {
+		return nil, err
+	}
+	payload, err := decryptKBSResponse(teeKey, response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the launch secret released by the key broker: %v", err)
+	}
+	secret := &v1.SEVSecretOptions{}
+	if err := json.Unmarshal(payload, secret); err != nil {
+		return nil, fmt.Errorf("failed to decode the launch secret released by the key broker: %v", err)
+	}
+	if secret.Header == "" || secret.Secret == "" {
+		return nil, fmt.Errorf("the launch secret released by the key broker lacks the header or the secret")
+	}
+	return secret, nil
+}
+
+func newKBSTEEPubKey(key *rsa.PublicKey) kbsTEEPubKey {
+	return kbsTEEPubKey{
+		KeyType:   "RSA",
+		Algorithm: jweAlgorithmRSAOAEP,
+		Modulus:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// decryptKBSResponse decrypts the content encryption key with the TEE key
+// and the payload with the content encryption key, following RFC 7516.
+func decryptKBSResponse(teeKey *rsa.PrivateKey, response *kbsResponse) ([]byte, error) {
+	protected, err := decodeBase64URL(response.Protected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protected header: %v", err)
+	}
+	header := jweHeader{}
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return nil, fmt.Errorf("invalid protected header: %v", err)
+	}
+
+	var oaepHash hash.Hash
+	switch header.Algorithm {
+	case jweAlgorithmRSAOAEP:
+		oaepHash = sha1.New()
+	case jweAlgorithmRSAOAEP256:
+		oaepHash = sha256.New()
+	default:
+		return nil, fmt.Errorf("unsupported key management algorithm %q", header.Algorithm)
+	}
+	if header.Encryption != jweEncryptionA256GCM {
+		return nil, fmt.Errorf("unsupported content encryption algorithm %q", header.Encryption)
+	}
+
+	encryptedKey, err := decodeBase64URL(response.EncryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted key: %v", err)
+	}
+	contentKey, err := rsa.DecryptOAEP(oaepHash, nil, teeKey, encryptedKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the content encryption key: %v", err)
+	}
+
+	if len(contentKey) != 32 {
+		return nil, fmt.Errorf("invalid content encryption key size %d", len(contentKey))
+	}
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := decodeBase64URL(response.IV)
+	if err != nil || len(iv) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid initialization vector")
+	}
+	ciphertext, err := decodeBase64URL(response.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ciphertext: %v", err)
+	}
+	tag, err := decodeBase64URL(response.Tag)
+	if err != nil || len(tag) != gcm.Overhead() {
+		return nil, fmt.Errorf("invalid authentication tag")
+	}
+
+	aad := response.Protected
+	if response.AAD != "" {
+		aad += "." + response.AAD
+	}
+	return gcm.Open(nil, iv, append(ciphertext, tag...), []byte(aad))
+}
+
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+func (k *kbsClient) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, k.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := k.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("key broker request %s failed: %v", path, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, kbsMaxErrorLength))
+		return fmt.Errorf("key broker request %s failed with %s: %s", path, response.Status, strings.TrimSpace(string(message)))
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode the key broker response to %s: %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity
+
+import (
+	"context"
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// KeyBroker verifies the launch measurement of a SEV guest on behalf of the
+// guest owner and releases the launch secret of the guest in return.
+type KeyBroker interface {
+	FetchLaunchSecret(ctx context.Context, measurement *v1.SEVMeasurementInfo) (*v1.SEVSecretOptions, error)
+}
+
+// KeyBrokerFactory creates the client of the key broker configured on a VMI.
+type KeyBrokerFactory func(config *v1.KeyBroker) (KeyBroker, error)
+
+var keyBrokerFactories = map[v1.KeyBrokerProtocol]KeyBrokerFactory{
+	v1.KeyBrokerProtocolKBS: NewKBSClient,
+}
+
+// RegisterKeyBroker makes a key broker protocol available to VMIs. It is
+// not safe for concurrent use and meant to be called on initialization.
+func RegisterKeyBroker(protocol v1.KeyBrokerProtocol, factory KeyBrokerFactory) {
+	keyBrokerFactories[protocol] = factory
+}
+
+func NewKeyBroker(config *v1.KeyBroker) (KeyBroker, error) {
+	protocol := config.Protocol
+	if protocol == "" {
+		protocol = v1.KeyBrokerProtocolKBS
+	}
+	factory, exists := keyBrokerFactories[protocol]
+	if !exists {
+		return nil, fmt.Errorf("unsupported key broker protocol %q", protocol)
+	}
+	return factory(config)
+}
@@ -0,0 +1,211 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package launchsecurity_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+type fakeKeyBroker struct{}
+
+func (fakeKeyBroker) FetchLaunchSecret(_ context.Context, _ *v1.SEVMeasurementInfo) (*v1.SEVSecretOptions, error) {
+	return &v1.SEVSecretOptions{Header: "fake", Secret: "fake"}, nil
+}
+
+var _ = Describe("LaunchSecurity: key broker", func() {
+	It("should fail on an unknown protocol", func() {
+		_, err := launchsecurity.NewKeyBroker(&v1.KeyBroker{Protocol: "unknown", URL: "https://kbs", ResourcePath: "a/b/c"})
+		Expect(err).To(MatchError(ContainSubstring(`unsupported key broker protocol "unknown"`)))
+	})
+
+	It("should create the key broker of a registered protocol", func() {
+		launchsecurity.RegisterKeyBroker("fake", func(_ *v1.KeyBroker) (launchsecurity.KeyBroker, error) {
+			return fakeKeyBroker{}, nil
+		})
+		broker, err := launchsecurity.NewKeyBroker(&v1.KeyBroker{Protocol: "fake"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(broker).To(Equal(fakeKeyBroker{}))
+	})
+
+	DescribeTable("should reject an invalid KBS configuration", func(config *v1.KeyBroker, expectedError string) {
+		_, err := launchsecurity.NewKeyBroker(config)
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("with an unsupported URL scheme", &v1.KeyBroker{URL: "ftp://kbs", ResourcePath: "a/b/c"}, "unsupported key broker URL scheme"),
+		Entry("without a resource path", &v1.KeyBroker{URL: "https://kbs", ResourcePath: "/"}, "resource path of the launch secret is required"),
+	)
+
+	Context("KBS", func() {
+		const sessionCookie = "kbs-session-id"
+
+		var (
+			server      *ghttp.Server
+			broker      launchsecurity.KeyBroker
+			measurement *v1.SEVMeasurementInfo
+		)
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			var err error
+			broker, err = launchsecurity.NewKeyBroker(&v1.KeyBroker{URL: server.URL() + "/", ResourcePath: "/default/sev/launch-secret"})
+			Expect(err).ToNot(HaveOccurred())
+			measurement = &v1.SEVMeasurementInfo{Measurement: "AAAA", APIMajor: 1, APIMinor: 55, BuildID: 21, Policy: 5, LoaderSHA: "abcd"}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		var teePubKey *rsa.PublicKey
+
+		verifyEvidence := func(w http.ResponseWriter, r *http.Request) {
+			attestation := struct {
+				TEEPubKey   map[string]string `json:"tee-pubkey"`
+				TEEEvidence string            `json:"tee-evidence"`
+			}{}
+			Expect(json.NewDecoder(r.Body).Decode(&attestation)).To(Succeed())
+			Expect(attestation.TEEEvidence).To(MatchJSON(`{"nonce":"c0ffee","measurement":"AAAA","api_major":1,"api_minor":55,"build_id":21,"policy":5,"loader_sha":"abcd"}`))
+			Expect(attestation.TEEPubKey).To(HaveKeyWithValue("kty", "RSA"))
+			Expect(attestation.TEEPubKey).To(HaveKeyWithValue("alg", "RSA-OAEP"))
+
+			modulus, err := base64.RawURLEncoding.DecodeString(attestation.TEEPubKey["n"])
+			Expect(err).ToNot(HaveOccurred())
+			exponent, err := base64.RawURLEncoding.DecodeString(attestation.TEEPubKey["e"])
+			Expect(err).ToNot(HaveOccurred())
+			teePubKey = &rsa.PublicKey{N: new(big.Int).SetBytes(modulus), E: int(new(big.Int).SetBytes(exponent).Int64())}
+		}
+
+		// respondWithResource encrypts the resource to the TEE public key like the broker does
+		respondWithResource := func(resource interface{}, protectedHeader string) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				payload, err := json.Marshal(resource)
+				Expect(err).ToNot(HaveOccurred())
+
+				contentKey := make([]byte, 32)
+				iv := make([]byte, 12)
+				_, err = rand.Read(contentKey)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = rand.Read(iv)
+				Expect(err).ToNot(HaveOccurred())
+				encryptedKey, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, teePubKey, contentKey, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				protected := base64.RawURLEncoding.EncodeToString([]byte(protectedHeader))
+				block, err := aes.NewCipher(contentKey)
+				Expect(err).ToNot(HaveOccurred())
+				gcm, err := cipher.NewGCM(block)
+				Expect(err).ToNot(HaveOccurred())
+				sealed := gcm.Seal(nil, iv, payload, []byte(protected))
+				ciphertext, tag := sealed[:len(payload)], sealed[len(payload):]
+
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+					"protected":     protected,
+					"encrypted_key": base64.RawURLEncoding.EncodeToString(encryptedKey),
+					"iv":            base64.RawURLEncoding.EncodeToString(iv),
+					"ciphertext":    base64.RawURLEncoding.EncodeToString(ciphertext),
+					"tag":           base64.RawURLEncoding.EncodeToString(tag),
+				})(w, r)
+			}
+		}
+
+		It("should release the launch secret after the attestation", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/kbs/v0/auth"),
+					ghttp.VerifyJSON(`{"version":"0.1.0","tee":"sev","extra-params":""}`),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"nonce": "c0ffee", "extra-params": ""},
+						http.Header{"Set-Cookie": {sessionCookie + "=1234"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, "/kbs/v0/attest"),
+					ghttp.VerifyHeader(http.Header{"Cookie": {sessionCookie + "=1234"}}),
+					verifyEvidence,
+					ghttp.RespondWith(http.StatusOK, `{"token":"xyz"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, "/kbs/v0/resource/default/sev/launch-secret"),
+					ghttp.VerifyHeader(http.Header{"Cookie": {sessionCookie + "=1234"}}),
+					respondWithResource(v1.SEVSecretOptions{Header: "HHHH", Secret: "SSSS"}, `{"alg":"RSA-OAEP","enc":"A256GCM"}`),
+				),
+			)
+
+			secret, err := broker.FetchLaunchSecret(context.Background(), measurement)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret).To(Equal(&v1.SEVSecretOptions{Header: "HHHH", Secret: "SSSS"}))
+		})
+
+		It("should fail when the attestation is rejected", func() {
+			server.AppendHandlers(
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"nonce": "c0ffee"}),
+				ghttp.RespondWith(http.StatusUnauthorized, "measurement does not match the reference value"),
+			)
+
+			_, err := broker.FetchLaunchSecret(context.Background(), measurement)
+			Expect(err).To(MatchError(ContainSubstring("key broker request /attest failed with 401 Unauthorized: measurement does not match the reference value")))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+
+		DescribeTable("should fail on a launch secret", func(resource interface{}, protectedHeader, expectedError string) {
+			server.AppendHandlers(
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"nonce": "c0ffee"}),
+				ghttp.CombineHandlers(verifyEvidence, ghttp.RespondWith(http.StatusOK, "")),
+				respondWithResource(resource, protectedHeader),
+			)
+
+			_, err := broker.FetchLaunchSecret(context.Background(), measurement)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+			Entry("which is incomplete", v1.SEVSecretOptions{Secret: "SSSS"}, `{"alg":"RSA-OAEP","enc":"A256GCM"}`,
+				"lacks the header or the secret"),
+			Entry("which is encrypted with an unsupported algorithm", v1.SEVSecretOptions{Header: "HHHH", Secret: "SSSS"}, `{"alg":"RSA-OAEP","enc":"A128CBC-HS256"}`,
+				`unsupported content encryption algorithm "A128CBC-HS256"`),
+			Entry("whose key management algorithm does not match the encrypted key", v1.SEVSecretOptions{Header: "HHHH", Secret: "SSSS"}, `{"alg":"RSA-OAEP-256","enc":"A256GCM"}`,
+				"failed to decrypt the content encryption key"),
+		)
+
+		It("should fail on a launch secret which is not encrypted", func() {
+			server.AppendHandlers(
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"nonce": "c0ffee"}),
+				ghttp.RespondWith(http.StatusOK, ""),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, v1.SEVSecretOptions{Header: "HHHH", Secret: "SSSS"}),
+			)
+
+			_, err := broker.FetchLaunchSecret(context.Background(), measurement)
+			Expect(err).To(MatchError(ContainSubstring("failed to decrypt the launch secret released by the key broker")))
+		})
+	})
+})
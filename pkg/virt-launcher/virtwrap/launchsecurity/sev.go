@@ -20,6 +20,11 @@
 package launchsecurity
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
 	v1 "kubevirt.io/api/core/v1"
 )
 
@@ -31,6 +36,9 @@ const (
 	// SEV-SNP guest policy as defined in AMD SEV-SNP API specification
 	SNPPolicySmt      uint = 1 << 16
 	SNPPolicyReserved uint = 1 << 17
+
+	// Size of the guest provided data in a SEV-SNP attestation report
+	SNPReportDataSize = 64
 )
 
 func SEVPolicyToBits(policy *v1.SEVPolicy) uint {
@@ -52,3 +60,57 @@ func SEVSNPPolicyToBits(policy *v1.SEVSNP) uint {
 	}
 	return 0
 }
+
+// snpReportScript requests an attestation report from the AMD secure
+// processor inside the guest, QEMU can't query the reports of SEV-SNP guests.
+// It uses the configfs-tsm interface of the kernel if available and falls
+// back to snpguest otherwise, and prints the report base64 encoded. It is
+// passed to the guest agent as is, so it must neither contain double quotes
+// nor backslashes nor newlines.
+const snpReportScript = "set -e; d=$(mktemp -d); trap 'rm -rf $d' EXIT; printf %s $1 | base64 -d >$d/data; " +
+	"t=/sys/kernel/config/tsm/report; " +
+	"if [ -d $t ]; then r=$t/kubevirt-$$; mkdir $r; trap 'rmdir $r; rm -rf $d' EXIT; " +
+	"[ $(cat $r/provider) = sev_guest ]; cat $d/data >$r/inblob; base64 -w 0 $r/outblob; " +
+	"else snpguest report $d/report $d/data >/dev/null; base64 -w 0 $d/report; fi; echo"
+
+// ValidateSEVSNPAttestationReportOptions checks that the nonce is hex encoded
+// and fits into the report data.
+func ValidateSEVSNPAttestationReportOptions(options *v1.SEVSNPAttestationReportOptions) error {
+	nonce, err := hex.DecodeString(options.Nonce)
+	if err != nil {
+		return fmt.Errorf("nonce is not hex encoded: %v", err)
+	}
+	if len(nonce) > SNPReportDataSize {
+		return fmt.Errorf("nonce must not be longer than %d bytes", SNPReportDataSize)
+	}
+	return nil
+}
+
+// SEVSNPAttestationReportCommand returns the command which requests the
+// attestation report in the guest. The nonce is padded with zeros to the
+// size of the report data.
+func SEVSNPAttestationReportCommand(options *v1.SEVSNPAttestationReportOptions) (string, []string, error) {
+	if err := ValidateSEVSNPAttestationReportOptions(options); err != nil {
+		return "", nil, err
+	}
+	nonce, _ := hex.DecodeString(options.Nonce)
+	reportData := make([]byte, SNPReportDataSize)
+	copy(reportData, nonce)
+	return "/bin/sh", []string{"-c", snpReportScript, "sh", base64.StdEncoding.EncodeToString(reportData)}, nil
+}
+
+// ParseSEVSNPAttestationReport extracts the attestation report from the
+// output of SEVSNPAttestationReportCommand.
+func ParseSEVSNPAttestationReport(output string, options *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error) {
+	report := strings.TrimSpace(output)
+	if report == "" {
+		return nil, fmt.Errorf("the guest returned an empty attestation report")
+	}
+	if _, err := base64.StdEncoding.DecodeString(report); err != nil {
+		return nil, fmt.Errorf("unexpected attestation report output: %v", err)
+	}
+	return &v1.SEVSNPAttestationReport{
+		Report: report,
+		Nonce:  options.Nonce,
+	}, nil
+}
@@ -20,6 +20,9 @@
 package launchsecurity_test
 
 import (
+	"encoding/base64"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -75,4 +78,43 @@ var _ = Describe("LaunchSecurity: AMD Secure Encrypted Virtualization (SEV)", fu
 			Expect(launchsecurity.SEVSNPPolicyToBits(nil)).To(Equal(uint(0)))
 		})
 	})
+
+	Context("SEV-SNP attestation report", func() {
+		It("should pass the nonce padded to the size of the report data to the guest", func() {
+			command, args, err := launchsecurity.SEVSNPAttestationReportCommand(&v1.SEVSNPAttestationReportOptions{Nonce: "c0ffee"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(command).To(Equal("/bin/sh"))
+			Expect(args).To(HaveLen(4))
+			Expect(args[1]).To(ContainSubstring("/sys/kernel/config/tsm/report"))
+			Expect(args[1]).To(ContainSubstring("snpguest report"))
+			Expect(args[1]).ToNot(ContainSubstring(`"`))
+			Expect(args[1]).ToNot(ContainSubstring(`\`))
+			Expect(args[1]).ToNot(ContainSubstring("\n"))
+			reportData, err := base64.StdEncoding.DecodeString(args[3])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reportData).To(Equal(append([]byte{0xc0, 0xff, 0xee}, make([]byte, 61)...)))
+		})
+
+		DescribeTable("should reject an invalid nonce", func(nonce, expectedError string) {
+			_, _, err := launchsecurity.SEVSNPAttestationReportCommand(&v1.SEVSNPAttestationReportOptions{Nonce: nonce})
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+			Entry("which is not hex encoded", "xyz", "not hex encoded"),
+			Entry("which is too long", strings.Repeat("ab", 65), "must not be longer than 64 bytes"),
+		)
+
+		It("should parse the report", func() {
+			report, err := launchsecurity.ParseSEVSNPAttestationReport("AAAA\n", &v1.SEVSNPAttestationReportOptions{Nonce: "c0ffee"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report).To(Equal(&v1.SEVSNPAttestationReport{Report: "AAAA", Nonce: "c0ffee"}))
+		})
+
+		DescribeTable("should fail on unexpected output", func(output, expectedError string) {
+			_, err := launchsecurity.ParseSEVSNPAttestationReport(output, &v1.SEVSNPAttestationReportOptions{})
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+			Entry("which is empty", "\n", "empty attestation report"),
+			Entry("which is not base64 encoded", "not a report", "unexpected attestation report output"),
+		)
+	})
 })
@@ -0,0 +1,43 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021
+ *
+ */
+
+package launchsecurity
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// TD attributes as defined in the Intel TDX module specification
+	TDXPolicyDebug         uint = 1 << 0
+	TDXPolicySEPTVEDisable uint = 1 << 28
+)
+
+func TDXPolicyToBits(policy *v1.TDXPolicy) uint {
+	// Debug is never enabled
+	bits := uint(0)
+
+	if policy != nil {
+		if policy.SEPTVEDisable != nil && *policy.SEPTVEDisable {
+			bits = bits | TDXPolicySEPTVEDisable
+		}
+	}
+
+	return bits
+}
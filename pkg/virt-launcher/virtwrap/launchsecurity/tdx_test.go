@@ -0,0 +1,48 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021
+ *
+ */
+
+package launchsecurity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+var _ = Describe("LaunchSecurity: Intel Trust Domain Extensions (TDX)", func() {
+	Context("TDX policy conversion", func() {
+		It("should never set Debug", func() {
+			Expect(launchsecurity.TDXPolicyToBits(nil)).To(Equal(uint(0)))
+			Expect(launchsecurity.TDXPolicyToBits(&v1.TDXPolicy{})).To(Equal(uint(0)))
+			Expect(launchsecurity.TDXPolicyToBits(&v1.TDXPolicy{SEPTVEDisable: pointer.P(true)}) & launchsecurity.TDXPolicyDebug).To(BeZero())
+		})
+
+		DescribeTable("should set SEPTVEDisable", func(septVEDisable *bool, expectedBits uint) {
+			Expect(launchsecurity.TDXPolicyToBits(&v1.TDXPolicy{SEPTVEDisable: septVEDisable})).To(Equal(expectedBits))
+		},
+			Entry("when true", pointer.P(true), launchsecurity.TDXPolicySEPTVEDisable),
+			Entry("not when false", pointer.P(false), uint(0)),
+			Entry("not when unset", nil, uint(0)),
+		)
+	})
+})
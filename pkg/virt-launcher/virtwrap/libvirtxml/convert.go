@@ -286,6 +286,7 @@ func ConverKubeVirtFeatureKVMToDomainFeatureKVM(fkvm *api.FeatureKVM) *libvirtxm
 	return &libvirtxml.DomainFeatureKVM{
 		Hidden:        setDomainFeatureState(fkvm.Hidden),
 		HintDedicated: setDomainFeatureState(fkvm.HintDedicated),
+		PollControl:   setDomainFeatureState(fkvm.PollControl),
 	}
 }
 
@@ -312,6 +313,12 @@ func ConvertKubeVirtFeaturesToDomainFeatureList(features *api.Features) *libvirt
 	f.HyperV = ConvertKubeVirtFeatureHypervToDomainFeatureHyperV(features.Hyperv)
 	f.KVM = ConverKubeVirtFeatureKVMToDomainFeatureKVM(features.KVM)
 	f.VMPort = setDomainFeatureState(features.VMPort)
+	f.PS2 = setDomainFeatureState(features.PS2)
+	if features.IOAPIC != nil {
+		f.IOAPIC = &libvirtxml.DomainFeatureIOAPIC{
+			Driver: features.IOAPIC.Driver,
+		}
+	}
 	return f
 
 }
@@ -265,8 +265,8 @@ var _ = Describe("Convert KubeVirt domain types to Libvirtxml", func() {
 
 		},
 			Entry("empty", nil, nil),
-			Entry("with values", &api.FeatureKVM{Hidden: &fstate, HintDedicated: &fstate},
-				&libvirtxml.DomainFeatureKVM{Hidden: &dfstate, HintDedicated: &dfstate}),
+			Entry("with values", &api.FeatureKVM{Hidden: &fstate, HintDedicated: &fstate, PollControl: &fstate},
+				&libvirtxml.DomainFeatureKVM{Hidden: &dfstate, HintDedicated: &dfstate, PollControl: &dfstate}),
 		)
 
 		DescribeTable("ConvertKubeVirtFeatureHypervToDomainFeatureHyperV", func(v *api.FeatureHyperv,
@@ -329,6 +329,8 @@ var _ = Describe("Convert KubeVirt domain types to Libvirtxml", func() {
 				},
 				PVSpinlock: &api.FeaturePVSpinlock{State: state},
 				PMU:        &fstate,
+				PS2:        &fstate,
+				IOAPIC:     &api.FeatureIOAPIC{Driver: "qemu"},
 			},
 				&libvirtxml.DomainFeatureList{
 					ACPI: &libvirtxml.DomainFeature{},
@@ -344,6 +346,8 @@ var _ = Describe("Convert KubeVirt domain types to Libvirtxml", func() {
 					},
 					PVSpinlock: &dfstate,
 					PMU:        &dfstate,
+					PS2:        &dfstate,
+					IOAPIC:     &libvirtxml.DomainFeatureIOAPIC{Driver: "qemu"},
 				},
 			),
 		)
@@ -65,6 +65,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	"kubevirt.io/kubevirt/pkg/guestsecrets"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/ignition"
@@ -78,6 +79,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/tpm"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
@@ -91,15 +93,19 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/compute"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/sriov"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/drift"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/vnc"
 	virtcache "kubevirt.io/kubevirt/tools/cache"
 )
 
@@ -117,10 +123,23 @@ const (
 	hotplugLargeMemoryMinRequiredFreePorts = 6
 	hotplugDefaultTotalPorts               = 8
 	hotplugMinRequiredFreePorts            = 3
+
+	// tpm2_quote may take a few seconds on a busy host
+	tpmAttestationTimeoutSeconds = 15
+
+	// the secure processor serializes the report requests of all guests
+	sevSNPAttestationReportTimeoutSeconds = 15
+
+	// the key broker may be unreachable while the guest starts
+	keyBrokerTimeout       = 5 * time.Minute
+	keyBrokerRetryInterval = 10 * time.Second
 )
 
 const maxConcurrentHotplugHostDevices = 1
 
+// This is a var so it can be changed by the unit tests
+var memoryStateDir = kutil.VirtMemoryStateDir
+
 type contextStore struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -128,12 +147,13 @@ type contextStore struct {
 
 type DomainManager interface {
 	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
-	PauseVMI(*v1.VirtualMachineInstance) error
+	PauseVMI(*v1.VirtualMachineInstance, int32) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
 	FreezeVMI(*v1.VirtualMachineInstance, int32) error
 	UnfreezeVMI(*v1.VirtualMachineInstance) error
 	ResetVMI(*v1.VirtualMachineInstance) error
 	SoftRebootVMI(*v1.VirtualMachineInstance) error
+	RebootVMI(*v1.VirtualMachineInstance, v1.RebootMethod) error
 	KillVMI(*v1.VirtualMachineInstance) error
 	DeleteVMI(*v1.VirtualMachineInstance) error
 	SignalShutdownVMI(*v1.VirtualMachineInstance) error
@@ -159,9 +179,13 @@ type DomainManager interface {
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	GetSEVSNPAttestationReport(*v1.VirtualMachineInstance, *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error)
+	GetTPMAttestation(*v1.VirtualMachineInstance, *v1.TPMAttestationOptions) (*v1.TPMAttestation, error)
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
+	ResizeGuestMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 	GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	SetVNCResolution(vmi *v1.VirtualMachineInstance, options *v1.VNCResolutionOptions) error
 }
 
 type LibvirtDomainManager struct {
@@ -180,15 +204,19 @@ type LibvirtDomainManager struct {
 	virtShareDir           string
 	ephemeralDiskDir       string
 	paused                 pausedVMIs
+	autoUnpauseCancel      chan struct{}
 	agentData              *agentpoller.AsyncAgentStore
 	cloudInitDataStore     *cloudinit.CloudInitData
 	setGuestTimeContextPtr *contextStore
 	efiEnvironment         *efi.EFIEnvironment
+	ovmfPath               string
 	ephemeralDiskCreator   ephemeraldisk.EphemeralDiskCreatorInterface
 	directIOChecker        converter.DirectIOChecker
 	disksInfo              map[string]*osdisk.DiskInfo
 	domainInfoStats        *stats.DomainJobInfo
 	diskMemoryLimitBytes   int64
+	// implicitly locked by domainModifyLock
+	libvirtVersion api.LibvirtVersion
 
 	metadataCache             *metadata.Cache
 	domainStatsCache          *virtcache.TimeDefinedCache[*stats.DomainStats]
@@ -243,6 +271,7 @@ func newLibvirtDomainManager(connection cli.Connection, virtShareDir, ephemeralD
 
 		agentData:            agentStore,
 		efiEnvironment:       efi.DetectEFIEnvironment(runtime.GOARCH, ovmfPath),
+		ovmfPath:             ovmfPath,
 		ephemeralDiskCreator: ephemeralDiskCreator,
 		directIOChecker:      directIOChecker,
 		disksInfo:            map[string]*osdisk.DiskInfo{},
@@ -300,9 +329,8 @@ func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance)
 	}
 	defer dom.Free()
 
-	memoryDevice, err := memory.BuildMemoryDevice(vmi)
-	if err != nil {
-		return err
+	if vmi.Spec.Domain.Memory.DIMMSlots != nil {
+		return hotplugDIMM(dom, vmi)
 	}
 
 	spec, err := util.GetDomainSpecWithFlags(dom, 0)
@@ -310,18 +338,14 @@ func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance)
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
-	if spec.Devices.Memory != nil {
-		spec.Devices.Memory.Target.Requested = memoryDevice.Target.Requested
-
-		memoryDeviceXML, err := xml.Marshal(spec.Devices.Memory)
-		if err != nil {
-			log.Log.Reason(err).Error("marshalling target virtio-mem failed")
-			return err
-		}
+	memoryDevice, err := memory.BuildMemoryDevice(vmi)
+	if err != nil {
+		return err
+	}
 
-		err = dom.UpdateDeviceFlags(strings.ToLower(string(memoryDeviceXML)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE)
-		if err != nil {
-			log.Log.Reason(err).Error("updating virtio-mem device")
+	if virtioMem := findVirtioMemDevice(spec.Devices.MemoryDevices); virtioMem != nil {
+		virtioMem.Target.Requested = memoryDevice.Target.Requested
+		if err := updateVirtioMemDevice(dom, virtioMem); err != nil {
 			return err
 		}
 	} else {
@@ -342,6 +366,104 @@ func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// ResizeGuestMemory adjusts the requested size of the virtio-mem device of the running domain to the
+// guest memory of the vmi. Unlike UpdateGuestMemory it can shrink the guest memory, down to the boot memory.
+func (l *LibvirtDomainManager) ResizeGuestMemory(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to resize Guest Memory"
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	spec, err := util.GetDomainSpecWithFlags(dom, 0)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	virtioMem := findVirtioMemDevice(spec.Devices.MemoryDevices)
+	if virtioMem == nil {
+		return fmt.Errorf("%s: the domain has no virtio-mem device", errMsgPrefix)
+	}
+	if err := memory.ResizeMemoryDevice(vmi, virtioMem); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	if err := updateVirtioMemDevice(dom, virtioMem); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	log.Log.V(2).Infof("resizing guest memory to %v", vmi.Spec.Domain.Memory.Guest.Value())
+	return nil
+}
+
+func updateVirtioMemDevice(dom cli.VirDomain, virtioMem *api.MemoryDevice) error {
+	memoryDeviceXML, err := xml.Marshal(virtioMem)
+	if err != nil {
+		log.Log.Reason(err).Error("marshalling target virtio-mem failed")
+		return err
+	}
+
+	err = dom.UpdateDeviceFlags(strings.ToLower(string(memoryDeviceXML)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE)
+	if err != nil {
+		log.Log.Reason(err).Error("updating virtio-mem device")
+		return err
+	}
+	return nil
+}
+
+func findVirtioMemDevice(memoryDevices []api.MemoryDevice) *api.MemoryDevice {
+	for i := range memoryDevices {
+		if memoryDevices[i].Model == "virtio-mem" {
+			return &memoryDevices[i]
+		}
+	}
+	return nil
+}
+
+// hotplugDIMM plugs the memory missing to reach the guest memory as a single DIMM, as guests
+// without virtio-mem support can not resize a memory device
+func hotplugDIMM(dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		return err
+	}
+	spec := &api.DomainSpec{}
+	if err := xml.Unmarshal([]byte(domainXML), spec); err != nil {
+		return err
+	}
+
+	dimm, err := memory.BuildDIMMDevice(vmi, spec.Memory)
+	if err != nil {
+		return err
+	}
+	if dimm == nil {
+		return nil
+	}
+	if err := memory.AssignDIMMSlot(dimm, domainXML); err != nil {
+		return err
+	}
+
+	dimmXML, err := xml.Marshal(dimm)
+	if err != nil {
+		log.Log.Reason(err).Error("marshalling DIMM failed")
+		return err
+	}
+
+	err = dom.AttachDeviceFlags(strings.ToLower(string(dimmXML)), affectDeviceLiveAndConfigLibvirtFlags)
+	if err != nil {
+		log.Log.Reason(err).Error("attaching DIMM")
+		return err
+	}
+
+	log.Log.V(2).Infof("hotplugging guest memory to %v with a DIMM", vmi.Spec.Domain.Memory.Guest.Value())
+	return nil
+}
+
 func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) {
 	// Try to set VM time to the current value.  This is typically useful
 	// when clock wasn't running on the VM for some time (e.g. during
@@ -721,6 +843,33 @@ func (l *LibvirtDomainManager) generateCloudInitEmptyISO(vmi *v1.VirtualMachineI
 //
 // The Domain.Spec can be alterned in this function and any changes
 // made to the domain will get set in libvirt after this function exits.
+// defineTPMEncryptionSecret hands the passphrase of the TPM state to libvirt.
+// The secret is ephemeral and private, it only lives as long as libvirt and can not be read back.
+func (l *LibvirtDomainManager) defineTPMEncryptionSecret(vmi *v1.VirtualMachineInstance) error {
+	passphrase, err := os.ReadFile(tpm.EncryptionSecretPath())
+	if err != nil {
+		return fmt.Errorf("failed to read the passphrase of the TPM state: %v", err)
+	}
+
+	secretXML, err := xml.Marshal(api.SecretSpec{
+		Ephemeral: "yes",
+		Private:   "yes",
+		UUID:      string(vmi.UID),
+		Usage: api.SecretUsage{
+			Type: "vtpm",
+			Name: "vtpm-" + string(vmi.UID),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := l.virConn.DefineSecret(string(secretXML), passphrase); err != nil {
+		return fmt.Errorf("failed to define the secret of the TPM state: %v", err)
+	}
+	return nil
+}
+
 func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, domain *api.Domain, generateEmptyIsos bool, options *cmdv1.VirtualMachineOptions) (*api.Domain, error) {
 	logger := log.Log.Object(vmi)
 
@@ -761,6 +910,23 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		}
 	}
 
+	// QEMU reads the fw_cfg guest secrets from the pod, verify them upfront
+	for i := range vmi.Spec.GuestSecrets {
+		if !guestsecrets.IsFWCfg(&vmi.Spec.GuestSecrets[i]) {
+			continue
+		}
+		if _, err := guestsecrets.Read(&vmi.Spec.GuestSecrets[i]); err != nil {
+			return domain, err
+		}
+	}
+
+	// swtpm decrypts its state with a libvirt secret, which has to exist before the domain starts
+	if tpm.HasEncryptedState(&vmi.Spec) {
+		if err := l.defineTPMEncryptionSecret(vmi); err != nil {
+			return domain, err
+		}
+	}
+
 	nonAbsentIfaces := netvmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
 		return iface.State != v1.InterfaceStateAbsent
 	})
@@ -810,6 +976,10 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	if err := config.CreateServiceAccountDisk(vmi, generateEmptyIsos); err != nil {
 		return domain, fmt.Errorf("creating service account disk failed: %v", err)
 	}
+	// create the iso disks of hotplugged config volumes
+	if err := config.CreateHotplugDisks(vmi); err != nil {
+		return domain, fmt.Errorf("creating hotplug config disks failed: %v", err)
+	}
 	// create downwardMetric disk if exists
 	if err := downwardmetrics.CreateDownwardMetricDisk(vmi); err != nil {
 		return domain, fmt.Errorf("failed to craete downwardMetric disk: %v", err)
@@ -1001,6 +1171,13 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 	}
 
+	guestArch := runtime.GOARCH
+	efiEnvironment := l.efiEnvironment
+	if compute.IsCrossArchitecture(vmi, runtime.GOARCH) {
+		guestArch = vmi.Spec.Architecture
+		efiEnvironment = efi.DetectEFIEnvironment(guestArch, l.ovmfPath)
+	}
+
 	var efiConf *converter.EFIConfiguration
 	if vmi.IsBootloaderEFI() {
 		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
@@ -1016,14 +1193,14 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		} else if tdx {
 			vmType = efi.TDX
 		}
-		if !l.efiEnvironment.Bootable(secureBoot, vmType) {
+		if !efiEnvironment.Bootable(secureBoot, vmType) {
 			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
 			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV/SEV-ES=%v, SEV-SNP=%v, TDX=%v", secureBoot, sev, snp, tdx)
 		}
 
 		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, vmType),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, vmType),
+			EFICode:      efiEnvironment.EFICode(secureBoot, vmType),
+			EFIVars:      efiEnvironment.EFIVars(secureBoot, vmType),
 			SecureLoader: secureBoot,
 		}
 	}
@@ -1039,12 +1216,26 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 	}
 
+	// Check vhost-net device availability, interfaces fall back to the userspace backend without it
+	const vhostNetPath = "/dev/vhost-net"
+	vhostNetAvailable := true
+	if _, err := os.Stat(vhostNetPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			vhostNetAvailable = false
+		} else {
+			return nil, fmt.Errorf("failed to stat vhost-net device %s: %w", vhostNetPath, err)
+		}
+	}
+
 	// Map the VirtualMachineInstance to the Domain
 	c := &converter.ConverterContext{
-		Architecture:          arch.NewConverter(runtime.GOARCH),
+		Architecture:          arch.NewConverter(guestArch),
+		HostArchitecture:      runtime.GOARCH,
+		EmulatorPath:          os.Getenv(kutil.EnvVarEmulatorPath),
 		VirtualMachine:        vmi,
 		AllowEmulation:        allowEmulation,
 		KvmAvailable:          kvmAvailable,
+		VhostNetAvailable:     vhostNetAvailable,
 		CPUSet:                podCPUSet,
 		IsBlockPVC:            isBlockPVCMap,
 		IsBlockDV:             isBlockDVMap,
@@ -1150,6 +1341,11 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		}
 	}
 
+	if err := config.CreateHotplugDisks(vmi); err != nil {
+		logger.Reason(err).Error("failed to create the iso disks of hotplugged config volumes")
+		return nil, err
+	}
+
 	c, err := l.generateConverterContext(vmi, allowEmulation, options, false)
 	if err != nil {
 		logger.Reason(err).Error("failed to generate libvirt domain from VMI spec")
@@ -1176,7 +1372,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 	defer dom.Free()
-	domState, _, err := dom.GetState()
+	domState, domReason, err := dom.GetState()
 	if err != nil {
 		logger.Reason(err).Error(failedGetDomainState)
 		return nil, err
@@ -1190,8 +1386,26 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		if err := l.startDomain(vmi, dom); err != nil {
 			return nil, err
 		}
+	case cli.IsPaused(domState) && !l.paused.contains(vmi.UID) && vmiHasCondition(vmi, v1.VirtualMachineInstancePaused):
+		// a virt-launcher which reattached to a running domain after a crash does not
+		// know yet that it was paused on request, keep it paused
+		if paused, err := isDomainPaused(dom); err != nil {
+			return nil, err
+		} else if paused {
+			logger.Info("Adopting the pause of the reattached domain.")
+			l.paused.add(vmi.UID)
+		} else if err := dom.Resume(); err != nil {
+			logger.Reason(err).Error("unpausing the VirtualMachineInstance failed.")
+			return nil, err
+		}
 	case cli.IsPaused(domState) && !l.paused.contains(vmi.UID):
-		// TODO: if state change reason indicates a system error, we could try something smarter
+		// resuming on unavailable storage only pauses the domain again
+		if domReason == int(libvirt.DOMAIN_PAUSED_IOERROR) {
+			if err := probeDiskSources(domain); err != nil {
+				logger.Reason(err).Error("Not resuming the VirtualMachineInstance paused on an IO error.")
+				return nil, err
+			}
+		}
 		if err := dom.Resume(); err != nil {
 			logger.Reason(err).Error("unpausing the VirtualMachineInstance failed.")
 			return nil, err
@@ -1218,11 +1432,52 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	}
 
 	l.syncGracePeriod(vmi)
+	l.syncDomainDrift(vmi, dom, &domain.Spec, oldSpec)
 
-	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return oldSpec, nil
 }
 
+// syncDomainDrift records the properties of the live domain which deviate from
+// the generated domain, and restores the benign ones if the VMI opted in.
+func (l *LibvirtDomainManager) syncDomainDrift(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, expected, live *api.DomainSpec) {
+	if !vmi.IsRunning() {
+		return
+	}
+	logger := log.Log.Object(vmi)
+
+	report := drift.Detect(expected, live, l.getLibvirtVersion())
+	if len(report.Benign) > 0 && vmi.Annotations[v1.ReconcileDomainDriftAnnotation] == "true" {
+		if err := drift.ReconcileBenign(dom, expected, report, affectDomainLiveAndConfigLibvirtFlags); err != nil {
+			logger.Reason(err).Warning("failed to reconcile the domain drift")
+		} else {
+			logger.Infof("Reconciled the domain drift of %s", strings.Join(report.Benign, ", "))
+			report.Benign = nil
+		}
+	}
+
+	fields := strings.Join(report.All(), ",")
+	l.metadataCache.Drift.WithSafeBlock(func(driftMetadata *api.DriftMetadata, _ bool) {
+		if driftMetadata.Fields != fields && fields != "" {
+			logger.Warningf("Live domain drifted from the expected domain: %s", fields)
+		}
+		driftMetadata.Fields = fields
+	})
+}
+
+// getLibvirtVersion returns the version of the libvirt daemon, or 0 if it is not
+// known yet. It can not change during the lifetime of virt-launcher.
+func (l *LibvirtDomainManager) getLibvirtVersion() api.LibvirtVersion {
+	if l.libvirtVersion == 0 {
+		version, err := l.virConn.GetLibVersion()
+		if err != nil {
+			log.Log.Reason(err).Warning("failed to get the libvirt version")
+			return 0
+		}
+		l.libvirtVersion = version
+	}
+	return l.libvirtVersion
+}
+
 func (l *LibvirtDomainManager) syncDisks(
 	domain *api.Domain,
 	spec *api.DomainSpec,
@@ -1302,21 +1557,46 @@ func (l *LibvirtDomainManager) syncDisks(
 	}
 
 	// Resize and notify the VM about changed disks
-	for _, disk := range domain.Spec.Devices.Disks {
-		if shouldExpandOnline(dom, disk) {
-			possibleGuestSize, ok := possibleGuestSize(disk)
-			if !ok {
-				logger.Warningf("Failed to get possible guest size from disk %v", disk)
-				break
-			}
-			err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), libvirt.DOMAIN_BLOCK_RESIZE_BYTES)
-			if err != nil {
-				logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
-			}
+	l.expandDisksOnline(vmi, dom, domain.Spec.Devices.Disks)
+
+	return nil
+}
+
+// expandDisksOnline propagates the new size of expanded PVCs to the running
+// guest, and records the disks which only pick it up after a restart.
+func (l *LibvirtDomainManager) expandDisksOnline(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, disks []api.Disk) {
+	logger := log.Log.Object(vmi)
+
+	var pendingDisks []string
+	for _, disk := range disks {
+		if !shouldExpandOnline(dom, disk) {
+			continue
+		}
+		if disk.Device == "lun" {
+			// the size of a passed through LUN is owned by the storage, qemu can't grow it
+			logger.Infof("Disk %s was expanded, the guest sees the new size after a restart", disk.Alias.GetName())
+			pendingDisks = append(pendingDisks, disk.Alias.GetName())
+			continue
+		}
+		possibleGuestSize, ok := possibleGuestSize(disk)
+		if !ok {
+			logger.Warningf("Failed to get possible guest size from disk %v", disk)
+			continue
+		}
+		err := dom.BlockResize(getSourceFile(disk), uint64(possibleGuestSize), libvirt.DOMAIN_BLOCK_RESIZE_BYTES)
+		if err != nil {
+			logger.Reason(err).Errorf("libvirt failed to expand disk image %v", disk)
+			pendingDisks = append(pendingDisks, disk.Alias.GetName())
 		}
 	}
 
-	return nil
+	pending := strings.Join(pendingDisks, ",")
+	if _, exists := l.metadataCache.DiskExpansion.Load(); !exists && pending == "" {
+		return
+	}
+	l.metadataCache.DiskExpansion.WithSafeBlock(func(diskExpansionMetadata *api.DiskExpansionMetadata, _ bool) {
+		diskExpansionMetadata.PendingDisks = pending
+	})
 }
 
 func (l *LibvirtDomainManager) startDomain(
@@ -1328,6 +1608,10 @@ func (l *LibvirtDomainManager) startDomain(
 		return err
 	}
 
+	if statePath, exists := memoryStatePath(vmi); exists {
+		return l.restoreDomain(vmi, dom, statePath)
+	}
+
 	createFlags := getDomainCreateFlags(vmi)
 	if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
@@ -1339,6 +1623,55 @@ func (l *LibvirtDomainManager) startDomain(
 	if vmi.ShouldStartPaused() {
 		l.paused.add(vmi.UID)
 	}
+	if kutil.IsSEVAttestationRequested(vmi) && vmi.Spec.Domain.LaunchSecurity.SEV.Attestation.KeyBroker != nil {
+		go l.releaseLaunchSecret(vmi)
+	}
+	return nil
+}
+
+// memoryStatePath returns the memory state a VMI restored from a snapshot with memory resumes from
+func memoryStatePath(vmi *v1.VirtualMachineInstance) (string, bool) {
+	if _, exists := vmi.Annotations[v1.MemoryStateClaimAnnotation]; !exists {
+		return "", false
+	}
+	files, err := os.ReadDir(memoryStateDir)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to read the memory state directory, booting the domain")
+		return "", false
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), "memory.dump") {
+			return filepath.Join(memoryStateDir, file.Name()), true
+		}
+	}
+	return "", false
+}
+
+// restoreDomain starts the domain from the saved memory state. The state is removed once
+// restored, so that later starts of the domain in the same pod boot the guest.
+func (l *LibvirtDomainManager) restoreDomain(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, statePath string) error {
+	logger := log.Log.Object(vmi)
+
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		return err
+	}
+	restoreFlags := libvirt.DOMAIN_SAVE_RUNNING
+	if vmi.ShouldStartPaused() {
+		restoreFlags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	if err := l.virConn.DomainRestoreFlags(statePath, domainXML, restoreFlags); err != nil {
+		logger.Reason(err).Errorf("Failed to restore VirtualMachineInstance from memory state %s.", statePath)
+		return err
+	}
+	if err := os.Remove(statePath); err != nil {
+		logger.Reason(err).Warningf("Failed to remove the restored memory state %s.", statePath)
+	}
+
+	logger.Info("Domain restored from memory state.")
+	if vmi.ShouldStartPaused() {
+		l.paused.add(vmi.UID)
+	}
 	return nil
 }
 
@@ -1624,7 +1957,7 @@ func (l *LibvirtDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	return l.storageManager.MemoryDump(vmi, dumpPath)
 }
 
-func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
+func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance, autoUnpauseTimeoutSeconds int32) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
 
@@ -1657,6 +1990,7 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 		}
 		logger.Infof("Signaled pause for %s", vmi.GetObjectMeta().GetName())
 		l.paused.add(vmi.UID)
+		l.scheduleAutoUnpause(vmi, time.Duration(autoUnpauseTimeoutSeconds)*time.Second)
 	} else {
 		logger.Infof("Domain is not running for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -1664,6 +1998,46 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// scheduleAutoUnpause unpauses the VMI once the timeout requested on pause
+// expires, unless it gets unpaused earlier. The deadline is recorded in the
+// domain metadata so that virt-handler can report it.
+// Must be called with domainModifyLock held.
+func (l *LibvirtDomainManager) scheduleAutoUnpause(vmi *v1.VirtualMachineInstance, timeout time.Duration) {
+	l.cancelAutoUnpause()
+	if timeout <= 0 {
+		return
+	}
+
+	deadline := metav1.NewTime(time.Now().Add(timeout))
+	l.metadataCache.Pause.Store(api.PauseMetadata{AutoUnpauseTimestamp: &deadline})
+
+	cancel := make(chan struct{})
+	l.autoUnpauseCancel = cancel
+	go func() {
+		select {
+		case <-time.After(timeout):
+			log.Log.Object(vmi).Infof("VMI was not unpaused within %v, initiating unpause", timeout)
+			if err := l.UnpauseVMI(vmi); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Automatic unpause failed.")
+			}
+		case <-cancel:
+			log.Log.Object(vmi).V(3).Info("Canceling scheduled automatic unpause")
+		}
+	}()
+}
+
+// cancelAutoUnpause stops a pending automatic unpause and clears its deadline.
+// Must be called with domainModifyLock held.
+func (l *LibvirtDomainManager) cancelAutoUnpause() {
+	if l.autoUnpauseCancel != nil {
+		close(l.autoUnpauseCancel)
+		l.autoUnpauseCancel = nil
+	}
+	if pause, exists := l.metadataCache.Pause.Load(); exists && pause.AutoUnpauseTimestamp != nil {
+		l.metadataCache.Pause.Store(api.PauseMetadata{})
+	}
+}
+
 func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
@@ -1689,6 +2063,7 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 		return err
 	}
 
+	l.cancelAutoUnpause()
 	if domState == libvirt.DOMAIN_PAUSED {
 		err = dom.Resume()
 		if err != nil {
@@ -1733,15 +2108,48 @@ func (l *LibvirtDomainManager) ResetVMI(vmi *v1.VirtualMachineInstance) error {
 }
 
 func (l *LibvirtDomainManager) SoftRebootVMI(vmi *v1.VirtualMachineInstance) error {
-	domainRebootFlagValues := libvirt.DOMAIN_REBOOT_GUEST_AGENT
+	return l.RebootVMI(vmi, "")
+}
+
+// RebootVMI reboots the guest without tearing down the domain. An empty
+// method prefers the guest agent and falls back to ACPI when the agent is
+// not connected.
+func (l *LibvirtDomainManager) RebootVMI(vmi *v1.VirtualMachineInstance, method v1.RebootMethod) error {
 	condManager := controller.NewVirtualMachineInstanceConditionManager()
-	if !condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue) {
-		if features := vmi.Spec.Domain.Features; features != nil && features.ACPI.Enabled != nil && !(*features.ACPI.Enabled) {
-			err := fmt.Errorf("VMI neither have the agent connected nor the ACPI feature enabled")
+	agentConnected := condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstanceAgentConnected, k8sv1.ConditionTrue)
+	acpiDisabled := false
+	if features := vmi.Spec.Domain.Features; features != nil && features.ACPI.Enabled != nil && !(*features.ACPI.Enabled) {
+		acpiDisabled = true
+	}
+
+	var domainRebootFlagValues libvirt.DomainRebootFlagValues
+	switch method {
+	case v1.RebootMethodGuestAgent:
+		if !agentConnected {
+			err := fmt.Errorf("VMI does not have the guest agent connected")
+			log.Log.Object(vmi).Reason(err).Error("Setting the domain reboot flag failed.")
+			return err
+		}
+		domainRebootFlagValues = libvirt.DOMAIN_REBOOT_GUEST_AGENT
+	case v1.RebootMethodACPI:
+		if acpiDisabled {
+			err := fmt.Errorf("VMI does not have the ACPI feature enabled")
 			log.Log.Object(vmi).Reason(err).Error("Setting the domain reboot flag failed.")
 			return err
 		}
 		domainRebootFlagValues = libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN
+	case "":
+		domainRebootFlagValues = libvirt.DOMAIN_REBOOT_GUEST_AGENT
+		if !agentConnected {
+			if acpiDisabled {
+				err := fmt.Errorf("VMI neither have the agent connected nor the ACPI feature enabled")
+				log.Log.Object(vmi).Reason(err).Error("Setting the domain reboot flag failed.")
+				return err
+			}
+			domainRebootFlagValues = libvirt.DOMAIN_REBOOT_ACPI_POWER_BTN
+		}
+	default:
+		return fmt.Errorf("unknown reboot method %q", method)
 	}
 
 	domName := api.VMINamespaceKeyFunc(vmi)
@@ -1754,7 +2162,8 @@ func (l *LibvirtDomainManager) SoftRebootVMI(vmi *v1.VirtualMachineInstance) err
 	defer dom.Free()
 	if err = dom.Reboot(domainRebootFlagValues); err != nil {
 		libvirtError, ok := err.(libvirt.Error)
-		if !ok || libvirtError.Code != libvirt.ERR_AGENT_UNRESPONSIVE {
+		// An explicitly requested method must report an unresponsive agent
+		if method != "" || !ok || libvirtError.Code != libvirt.ERR_AGENT_UNRESPONSIVE {
 			log.Log.Object(vmi).Reason(err).Error("Soft rebooting the domain failed.")
 			return err
 		}
@@ -1993,6 +2402,20 @@ func (l *LibvirtDomainManager) getDomainStats() ([]*stats.DomainStats, error) {
 		}
 	}
 
+	for _, ds := range domstats {
+		pid, err := findQEMUPid(ds.Name)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to find the QEMU process of domain %s", ds.Name)
+			continue
+		}
+		schedStats, err := readVCPUSchedStats(pid)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to read the vCPU scheduler stats of domain %s", ds.Name)
+			continue
+		}
+		addVCPUSchedStats(ds, schedStats)
+	}
+
 	return domstats, nil
 }
 
@@ -2276,6 +2699,23 @@ func (l *LibvirtDomainManager) GetScreenshot(vmi *v1.VirtualMachineInstance) (*c
 	}, nil
 }
 
+// SetVNCResolution asks QEMU, through its VNC server, to resize the guest
+// display. The request is passed to the guest display driver, which is free
+// to pick the closest mode it supports.
+func (l *LibvirtDomainManager) SetVNCResolution(vmi *v1.VirtualMachineInstance, options *v1.VNCResolutionOptions) error {
+	if options.Width == 0 || options.Width > kutil.MaxDisplayWidth ||
+		options.Height == 0 || options.Height > kutil.MaxDisplayHeight {
+		return fmt.Errorf("resolution must be between 1x1 and %dx%d", kutil.MaxDisplayWidth, kutil.MaxDisplayHeight)
+	}
+
+	if err := vnc.SetDesktopSize(kutil.VNCSocketPath(vmi), uint16(options.Width), uint16(options.Height)); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to set the VNC resolution")
+		return err
+	}
+	log.Log.Object(vmi).V(4).Infof("Display resized to %dx%d", options.Width, options.Height)
+	return nil
+}
+
 func (l *LibvirtDomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	sevNodeParameters, err := l.virConn.GetSEVInfo()
 	if err != nil {
@@ -2372,6 +2812,95 @@ func (l *LibvirtDomainManager) InjectLaunchSecret(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
+// releaseLaunchSecret attests the launch measurement of the guest to the
+// key broker of the VMI, injects the secret the broker releases and resumes
+// the guest. The guest stays paused if the broker rejects the measurement.
+func (l *LibvirtDomainManager) releaseLaunchSecret(vmi *v1.VirtualMachineInstance) {
+	logger := log.Log.Object(vmi)
+
+	broker, err := launchsecurity.NewKeyBroker(vmi.Spec.Domain.LaunchSecurity.SEV.Attestation.KeyBroker)
+	if err != nil {
+		logger.Reason(err).Error("Failed to create the key broker client")
+		return
+	}
+	measurement, err := l.GetLaunchMeasurement(vmi)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyBrokerTimeout)
+	defer cancel()
+	var secret *v1.SEVSecretOptions
+	for {
+		secret, err = broker.FetchLaunchSecret(ctx, measurement)
+		if err == nil {
+			break
+		}
+		logger.Reason(err).Warning("Failed to fetch the launch secret from the key broker")
+		select {
+		case <-ctx.Done():
+			logger.Error("Timed out fetching the launch secret, the guest stays paused")
+			return
+		case <-time.After(keyBrokerRetryInterval):
+		}
+	}
+
+	if err := l.InjectLaunchSecret(vmi, secret); err != nil {
+		return
+	}
+	if err := l.UnpauseVMI(vmi); err != nil {
+		return
+	}
+	logger.Info("Injected the launch secret released by the key broker.")
+}
+
+// GetSEVSNPAttestationReport requests an attestation report, signed by the
+// AMD secure processor, in the guest through the guest agent. QEMU only
+// provides the reports of SEV and SEV-ES guests.
+func (l *LibvirtDomainManager) GetSEVSNPAttestationReport(vmi *v1.VirtualMachineInstance, options *v1.SEVSNPAttestationReportOptions) (*v1.SEVSNPAttestationReport, error) {
+	command, args, err := launchsecurity.SEVSNPAttestationReportCommand(options)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := agent.GuestExec(l.virConn, api.VMINamespaceKeyFunc(vmi), command, args, sevSNPAttestationReportTimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Requesting the SEV-SNP attestation report failed")
+		return nil, fmt.Errorf("failed to request the SEV-SNP attestation report in the guest: %v", err)
+	}
+
+	return launchsecurity.ParseSEVSNPAttestationReport(output, options)
+}
+
+// GetTPMAttestation collects the event log and a quote of the PCRs from the
+// vTPM through the guest agent, the vTPM itself is only accessible by the guest.
+func (l *LibvirtDomainManager) GetTPMAttestation(vmi *v1.VirtualMachineInstance, options *v1.TPMAttestationOptions) (*v1.TPMAttestation, error) {
+	if err := tpm.ValidateAttestationOptions(options); err != nil {
+		return nil, err
+	}
+
+	command, args := tpm.AttestationCommand(options)
+	output, err := agent.GuestExec(l.virConn, api.VMINamespaceKeyFunc(vmi), command, args, tpmAttestationTimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Collecting the TPM attestation failed")
+		return nil, fmt.Errorf("failed to collect the TPM attestation in the guest: %v", err)
+	}
+
+	attestation, err := tpm.ParseAttestationOutput(output, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The issuer of the endorsement key certificate is read outside of the guest,
+	// so that the guest can't forge the certificate
+	attestation.EndorsementKeyIssuer, err = tpm.LocalCACertificates(vmi)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the certificates of the local CA of swtpm: %v", err)
+	}
+
+	return attestation, nil
+}
+
 func (l *LibvirtDomainManager) parseFSDisks(fsDisks []api.FSDisk) []v1.VirtualMachineInstanceFileSystemDisk {
 	disks := []v1.VirtualMachineInstanceFileSystemDisk{}
 	for _, fsDisk := range fsDisks {
@@ -578,6 +578,23 @@ var _ = Describe("Manager", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(newspec).ToNot(BeNil())
 		})
+		It("should keep a domain paused by user after reattaching to it, if the VirtualMachineInstance is paused", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstancePaused, Status: k8sv1.ConditionTrue}}
+			domainSpec := expectedDomainFor(vmi)
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, int(libvirt.DOMAIN_PAUSED_USER), nil).Times(2)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+			// no expected call to unpause
+			manager, _ := newLibvirtDomainManagerDefault()
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newspec).ToNot(BeNil())
+			Expect(manager.(*LibvirtDomainManager).paused.contains(vmi.UID)).To(BeTrue())
+		})
 		It("should not unpause a paused VirtualMachineInstance on SyncVMI, which was paused by user", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			domainSpec := expectedDomainFor(vmi)
@@ -589,7 +606,7 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().Suspend().Return(nil)
 			manager, _ := newLibvirtDomainManagerDefault()
 
-			Expect(manager.PauseVMI(vmi)).To(Succeed())
+			Expect(manager.PauseVMI(vmi, 0)).To(Succeed())
 
 			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
@@ -608,7 +625,39 @@ var _ = Describe("Manager", func() {
 			mockLibvirt.DomainEXPECT().Suspend().Return(nil)
 			manager, _ := newLibvirtDomainManagerDefault()
 
-			Expect(manager.PauseVMI(vmi)).To(Succeed())
+			Expect(manager.PauseVMI(vmi, 0)).To(Succeed())
+		})
+		It("should automatically unpause a VirtualMachineInstance once the requested timeout expires", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			isSetTimeCalled := make(chan bool, 1)
+			defer close(isSetTimeCalled)
+
+			// setGuestTime looks up the domain again after the unpause
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).MinTimes(2).Return(mockLibvirt.VirtDomain, nil)
+			mockLibvirt.DomainEXPECT().Free().AnyTimes()
+			gomock.InOrder(
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil),
+				mockLibvirt.DomainEXPECT().Suspend().Return(nil),
+				mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil),
+				mockLibvirt.DomainEXPECT().Resume().Return(nil),
+			)
+			mockLibvirt.DomainEXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Do(func(interface{}, interface{}, interface{}) {
+				isSetTimeCalled <- true
+			})
+			manager, _ := newLibvirtDomainManagerDefault()
+
+			Expect(manager.PauseVMI(vmi, 1)).To(Succeed())
+			pauseMetadata, exists := metadataCache.Pause.Load()
+			Expect(exists).To(BeTrue())
+			Expect(pauseMetadata.AutoUnpauseTimestamp).ToNot(BeNil())
+			Expect(pauseMetadata.AutoUnpauseTimestamp.Time).To(BeTemporally("~", time.Now().Add(time.Second), time.Second))
+
+			Eventually(func() *metav1.Time {
+				pauseMetadata, _ := metadataCache.Pause.Load()
+				return pauseMetadata.AutoUnpauseTimestamp
+			}, 10*time.Second, 100*time.Millisecond).Should(BeNil())
+			Eventually(isSetTimeCalled, 20*time.Second).Should(Receive(BeTrue()), "SetTime wasn't called")
 		})
 		It("should not try to pause a paused VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -618,7 +667,7 @@ var _ = Describe("Manager", func() {
 			manager, _ := newLibvirtDomainManagerDefault()
 			// no call to suspend
 
-			Expect(manager.PauseVMI(vmi)).To(Succeed())
+			Expect(manager.PauseVMI(vmi, 0)).To(Succeed())
 		})
 		It("should unpause a VirtualMachineInstance", func() {
 			isSetTimeCalled := make(chan bool, 1)
@@ -1597,6 +1646,35 @@ var _ = Describe("Manager", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 
+			It("should attach a DIMM when memory hotplug has been requested with DIMM slots", func() {
+				mockLibvirt.ConnectionEXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockLibvirt.VirtDomain, nil)
+
+				vmi.Spec.Domain.Memory.DIMMSlots = virtpointer.P(uint32(4))
+
+				domainSpec = &api.DomainSpec{
+					Memory:    api.Memory{Unit: "KiB", Value: 128 * 1024},
+					MaxMemory: &api.MaxMemory{Unit: "KiB", Value: 256 * 1024, Slots: 4},
+				}
+				domainSpecXML, err := xml.Marshal(domainSpec)
+				Expect(err).ToNot(HaveOccurred())
+
+				mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainSpecXML), nil)
+
+				dimm, err := memory.BuildDIMMDevice(vmi, domainSpec.Memory)
+				Expect(err).ToNot(HaveOccurred())
+				dimm.Address = &api.Address{Type: "dimm", Slot: "0"}
+				dimmXML, err := xml.Marshal(dimm)
+				Expect(err).ToNot(HaveOccurred())
+
+				attachFlags := libvirt.DOMAIN_DEVICE_MODIFY_LIVE | libvirt.DOMAIN_DEVICE_MODIFY_CONFIG
+				mockLibvirt.DomainEXPECT().AttachDeviceFlags(strings.ToLower(string(dimmXML)), attachFlags).Return(nil)
+
+				mockLibvirt.DomainEXPECT().Free()
+
+				err = manager.UpdateGuestMemory(vmi)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
 			It("should update the virtio-mem device if it already exists", func() {
 				mockLibvirt.ConnectionEXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockLibvirt.VirtDomain, nil)
 
@@ -1609,7 +1687,7 @@ var _ = Describe("Manager", func() {
 
 				domainSpec = &api.DomainSpec{
 					Devices: api.Devices{
-						Memory: &api.MemoryDevice{
+						MemoryDevices: []api.MemoryDevice{{
 							Model: "virtio-mem",
 							Alias: api.NewUserDefinedAlias("virtio-mem"),
 							Address: &api.Address{
@@ -1623,10 +1701,10 @@ var _ = Describe("Manager", func() {
 								Node:      "0",
 								Address:   &api.MemoryAddress{Base: "0x100000000"},
 								Size:      size,
-								Requested: requested,
-								Block:     block,
+								Requested: &requested,
+								Block:     &block,
 							},
-						},
+						}},
 					},
 				}
 				domainSpecXML, err := xml.Marshal(domainSpec)
@@ -1640,9 +1718,9 @@ var _ = Describe("Manager", func() {
 				memoryDevice, err := memory.BuildMemoryDevice(vmi)
 				Expect(err).ToNot(HaveOccurred())
 
-				domainSpec.Devices.Memory.Target.Requested = memoryDevice.Target.Requested
+				domainSpec.Devices.MemoryDevices[0].Target.Requested = memoryDevice.Target.Requested
 
-				memoryDeviceXML, err := xml.Marshal(domainSpec.Devices.Memory)
+				memoryDeviceXML, err := xml.Marshal(domainSpec.Devices.MemoryDevices[0])
 				Expect(err).ToNot(HaveOccurred())
 
 				attachFlags := libvirt.DOMAIN_DEVICE_MODIFY_LIVE
@@ -1653,6 +1731,60 @@ var _ = Describe("Manager", func() {
 				err = manager.UpdateGuestMemory(vmi)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			Context("when resizing the guest memory", func() {
+				var virtioMem api.MemoryDevice
+
+				BeforeEach(func() {
+					size, err := vcpu.QuantityToByte(resource.MustParse("128Mi"))
+					Expect(err).ToNot(HaveOccurred())
+					requested, err := vcpu.QuantityToByte(resource.MustParse("128Mi"))
+					Expect(err).ToNot(HaveOccurred())
+					block, err := vcpu.QuantityToByte(resource.MustParse("2Mi"))
+					Expect(err).ToNot(HaveOccurred())
+
+					virtioMem = api.MemoryDevice{
+						Model: "virtio-mem",
+						Target: &api.MemoryTarget{
+							Node:      "0",
+							Size:      size,
+							Requested: &requested,
+							Block:     &block,
+						},
+					}
+					domainSpec = &api.DomainSpec{
+						Devices: api.Devices{
+							MemoryDevices: []api.MemoryDevice{virtioMem},
+						},
+					}
+					domainSpecXML, err := xml.Marshal(domainSpec)
+					Expect(err).ToNot(HaveOccurred())
+
+					mockLibvirt.ConnectionEXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockLibvirt.VirtDomain, nil)
+					mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainSpecXML), nil)
+					mockLibvirt.DomainEXPECT().Free()
+				})
+
+				It("should lower the requested size of the virtio-mem device", func() {
+					vmi.Spec.Domain.Memory.Guest = virtpointer.P(resource.MustParse("192Mi"))
+
+					requested, err := vcpu.QuantityToByte(resource.MustParse("64Mi"))
+					Expect(err).ToNot(HaveOccurred())
+					virtioMem.Target.Requested = &requested
+					memoryDeviceXML, err := xml.Marshal(virtioMem)
+					Expect(err).ToNot(HaveOccurred())
+
+					mockLibvirt.DomainEXPECT().UpdateDeviceFlags(strings.ToLower(string(memoryDeviceXML)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).Return(nil)
+
+					Expect(manager.ResizeGuestMemory(vmi)).To(Succeed())
+				})
+
+				It("should refuse to resize the guest memory below the boot memory", func() {
+					vmi.Spec.Domain.Memory.Guest = virtpointer.P(resource.MustParse("64Mi"))
+
+					Expect(manager.ResizeGuestMemory(vmi)).To(MatchError(ContainSubstring("can not be resized below the boot memory")))
+				})
+			})
 		})
 
 		It("should update grace period metadata if cached value differs", func() {
@@ -1708,6 +1840,103 @@ var _ = Describe("Manager", func() {
 			Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
 		})
 	})
+	Context("domain drift", func() {
+		var manager *LibvirtDomainManager
+		var expected, live *api.DomainSpec
+
+		BeforeEach(func() {
+			mockLibvirt.ConnectionEXPECT().GetLibVersion().Return(api.NewLibvirtVersion(10, 0, 0), nil).AnyTimes()
+			manager = &LibvirtDomainManager{virConn: mockLibvirt.VirtConnection, metadataCache: metadataCache}
+			expected = &api.DomainSpec{
+				Type:    "kvm",
+				CPUTune: &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{{VCPU: 0, CPUSet: "1"}}},
+			}
+			live = expected.DeepCopy()
+		})
+
+		newRunningVMI := func() *v1.VirtualMachineInstance {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Status.Phase = v1.Running
+			return vmi
+		}
+
+		It("should record the drifted fields in the metadata", func() {
+			live.Type = "qemu"
+			live.CPUTune.VCPUPin[0].CPUSet = "2"
+
+			manager.syncDomainDrift(newRunningVMI(), mockLibvirt.VirtDomain, expected, live)
+
+			driftMetadata, exists := metadataCache.Drift.Load()
+			Expect(exists).To(BeTrue())
+			Expect(driftMetadata.Fields).To(Equal("type,cputune.vcpupin"))
+		})
+
+		It("should clear the metadata once the drift is gone", func() {
+			metadataCache.Drift.Store(api.DriftMetadata{Fields: "type"})
+
+			manager.syncDomainDrift(newRunningVMI(), mockLibvirt.VirtDomain, expected, live)
+
+			driftMetadata, _ := metadataCache.Drift.Load()
+			Expect(driftMetadata.Fields).To(BeEmpty())
+		})
+
+		It("should reconcile benign drift when the VMI opted in", func() {
+			live.Type = "qemu"
+			live.CPUTune.VCPUPin[0].CPUSet = "2"
+			vmi := newRunningVMI()
+			vmi.Annotations = map[string]string{v1.ReconcileDomainDriftAnnotation: "true"}
+			mockLibvirt.DomainEXPECT().PinVcpuFlags(uint(0), []bool{false, true}, affectDomainLiveAndConfigLibvirtFlags).Return(nil)
+
+			manager.syncDomainDrift(vmi, mockLibvirt.VirtDomain, expected, live)
+
+			driftMetadata, _ := metadataCache.Drift.Load()
+			Expect(driftMetadata.Fields).To(Equal("type"))
+		})
+	})
+
+	Context("online disk expansion", func() {
+		var manager *LibvirtDomainManager
+
+		BeforeEach(func() {
+			manager = &LibvirtDomainManager{metadataCache: metadataCache}
+		})
+
+		newExpandedDisk := func(name, device string) api.Disk {
+			overhead := v1.Percent("0")
+			return api.Disk{
+				Device:             device,
+				Alias:              api.NewUserDefinedAlias(name),
+				Source:             api.DiskSource{Dev: "/dev/" + name},
+				Capacity:           virtpointer.P(int64(2 * 1024 * 1024 * 1024)),
+				FilesystemOverhead: &overhead,
+				ExpandDisksEnabled: true,
+			}
+		}
+
+		It("should resize the grown disks and clear the pending disks", func() {
+			metadataCache.DiskExpansion.Store(api.DiskExpansionMetadata{PendingDisks: "rootdisk"})
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/rootdisk", uint64(2*1024*1024*1024), libvirt.DOMAIN_BLOCK_RESIZE_BYTES).Return(nil)
+
+			manager.expandDisksOnline(newVMI(testNamespace, testVmName), mockLibvirt.VirtDomain, []api.Disk{newExpandedDisk("rootdisk", "disk")})
+
+			diskExpansionMetadata, _ := metadataCache.DiskExpansion.Load()
+			Expect(diskExpansionMetadata.PendingDisks).To(BeEmpty())
+		})
+
+		It("should record the disks which could not be expanded online", func() {
+			mockLibvirt.DomainEXPECT().BlockResize("/dev/rootdisk", gomock.Any(), gomock.Any()).Return(fmt.Errorf("resize failed"))
+
+			manager.expandDisksOnline(newVMI(testNamespace, testVmName), mockLibvirt.VirtDomain, []api.Disk{
+				newExpandedDisk("rootdisk", "disk"),
+				newExpandedDisk("lun0", "lun"),
+			})
+
+			diskExpansionMetadata, exists := metadataCache.DiskExpansion.Load()
+			Expect(exists).To(BeTrue())
+			Expect(diskExpansionMetadata.PendingDisks).To(Equal("rootdisk,lun0"))
+		})
+	})
+
 	Context("test migration monitor", func() {
 		It("migration should be canceled if it's not progressing", func() {
 			migrationErrorChan := make(chan error)
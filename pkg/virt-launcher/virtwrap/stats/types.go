@@ -68,11 +68,20 @@ type DomainStats struct {
 	Block []DomainStatsBlock
 	// omitted from libvirt-go: Perf
 	// extra stats
-	CPUMapSet bool
-	CPUMap    [][]bool
-	NrVirtCpu uint
-	DirtyRate *DomainStatsDirtyRate
-	Load      *DomainStatsLoad
+	CPUMapSet   bool
+	CPUMap      [][]bool
+	NrVirtCpu   uint
+	DirtyRate   *DomainStatsDirtyRate
+	Load        *DomainStatsLoad
+	HostDevices []DomainStatsHostDevice
+}
+
+// DomainStatsHostDevice identifies a PCI or mediated host device assigned
+// to the domain, so that consumers can look up host-side device statistics.
+type DomainStatsHostDevice struct {
+	Alias      string
+	PCIAddress string
+	MDevUUID   string
 }
 
 type DomainStatsLoad struct {
@@ -102,6 +111,9 @@ type DomainStatsVcpu struct {
 	Wait     uint64
 	DelaySet bool
 	Delay    uint64
+	// number of timeslices run on the host CPU, see /proc/<pid>/task/<tid>/schedstat
+	TimeslicesSet bool
+	Timeslices    uint64
 }
 
 type DomainStatsNet struct {
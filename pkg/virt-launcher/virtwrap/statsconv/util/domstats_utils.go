@@ -238,7 +238,9 @@ var Testdataexpected = `{
        "WaitSet": false,
        "Wait": 0,
        "DelaySet": false,
-       "Delay": 0
+       "Delay": 0,
+       "TimeslicesSet": false,
+       "Timeslices": 0
      },
      {
        "State": 1,
@@ -248,8 +250,9 @@ var Testdataexpected = `{
        "WaitSet": false,
        "Wait": 0,
        "DelaySet": false,
-       "Delay": 0
-
+       "Delay": 0,
+       "TimeslicesSet": false,
+       "Timeslices": 0
      },
      {
        "State": 1,
@@ -259,7 +262,9 @@ var Testdataexpected = `{
        "WaitSet": false,
        "Wait": 0,
        "DelaySet": false,
-       "Delay": 0
+       "Delay": 0,
+       "TimeslicesSet": false,
+       "Timeslices": 0
      },
      {
        "State": 1,
@@ -269,7 +274,9 @@ var Testdataexpected = `{
        "WaitSet": true,
        "Wait": 1500,
        "DelaySet": true,
-       "Delay": 100
+       "Delay": 100,
+       "TimeslicesSet": false,
+       "Timeslices": 0
      }
    ],
    "CPUMapSet": false,
@@ -285,7 +292,8 @@ var Testdataexpected = `{
      "MegabytesPerSecondSet": false,
      "MegabytesPerSecond": 0
    },
-   "Load": null
+   "Load": null,
+   "HostDevices": null
  }`
 
 func LoadStats() ([]libvirt.DomainStats, error) {
@@ -20,6 +20,7 @@
 package storage
 
 import (
+	"encoding/xml"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -31,7 +32,10 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
 func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
@@ -68,10 +72,15 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	// keep trying to do memory dump even if remove previous one failed
 	removePreviousMemoryDump(filepath.Dir(dumpPath))
 
-	logger.Infof("Starting memory dump")
+	format := memoryDumpFormat(vmi, dumpPath)
+	logger.Infof("Starting memory dump in %s format", format)
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if format == v1.MemoryDumpFormatState {
+		err = saveMemoryState(dom, dumpPath)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", FailedDomainMemoryDump, err)
@@ -83,6 +92,57 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	return err
 }
 
+// memoryDumpFormat returns the format requested by the memory dump volume the dump is written to
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) v1.MemoryDumpFormat {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.MemoryDump == nil || hotplugdisk.GetVolumeMountDir(volume.Name) != filepath.Dir(dumpPath) {
+			continue
+		}
+		if volume.MemoryDump.Format != "" {
+			return volume.MemoryDump.Format
+		}
+	}
+	return v1.MemoryDumpFormatCore
+}
+
+// saveMemoryState saves the memory and device state of the running domain to dumpPath, in the
+// format libvirt can restore a domain from. The disks are left out of the snapshot, and no
+// snapshot metadata is kept by libvirt.
+func saveMemoryState(dom cli.VirDomain, dumpPath string) error {
+	disks, err := util.GetAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+	snapshot := api.DomainSnapshot{
+		Memory:        &api.SnapshotMemory{Snapshot: "external", File: dumpPath},
+		SnapshotDisks: &api.SnapshotDisks{},
+	}
+	for _, disk := range disks {
+		if disk.Target.Device == "" {
+			continue
+		}
+		snapshot.SnapshotDisks.Disks = append(snapshot.SnapshotDisks.Disks, api.SnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+	snapshotXML, err := xml.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	domSnapshot, err := dom.CreateSnapshotXML(string(snapshotXML), libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if domSnapshot != nil {
+		if err := domSnapshot.Free(); err != nil {
+			log.Log.Reason(err).Warning("failed to free the domain snapshot")
+		}
+	}
+	return nil
+}
+
 func (m *StorageManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := m.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -21,6 +21,7 @@ package storage
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -32,6 +33,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
@@ -128,4 +130,27 @@ var _ = Describe("MemoryDump", func() {
 			return memoryDump.Failed
 		}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 	})
+	It("should save the memory state when the memory dump volume requests it", func() {
+		dumpPath := filepath.Join(hotplugdisk.GetVolumeMountDir("vol1"), "vol1.memory.dump")
+		domainXML := `<domain><devices><disk device="disk"><target dev="vda"></target></disk></devices></domain>`
+		snapshotXML := `<domainsnapshot><memory snapshot="external" file="` + dumpPath + `"></memory>` +
+			`<disks><disk name="vda" snapshot="no"></disk></disks></domainsnapshot>`
+
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(domainXML, nil)
+		mockDomain.EXPECT().CreateSnapshotXML(snapshotXML, libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA).Return(nil, nil)
+
+		vmi := newVMI(testNamespace, testVmName)
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "vol1",
+			VolumeSource: v1.VolumeSource{
+				MemoryDump: &v1.MemoryDumpVolumeSource{Format: v1.MemoryDumpFormatState},
+			},
+		}}
+		Expect(manager.MemoryDump(vmi, dumpPath)).To(Succeed())
+		Eventually(func() bool {
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			return memoryDump.Completed && !memoryDump.Failed
+		}, 5*time.Second).Should(BeTrue())
+	})
 })
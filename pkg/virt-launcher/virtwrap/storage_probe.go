@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtwrap
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	storageProbeSize = 4096
+	// storage which is still unreachable tends to block the read instead of failing it
+	storageProbeTimeout = 5 * time.Second
+)
+
+// probeDiskSources reads from the local sources of the disks, so that a domain paused
+// on an IO error is only resumed once its storage is readable again. Network disks
+// are accessed by QEMU directly and are not probed.
+func probeDiskSources(domain *api.Domain) error {
+	for _, disk := range domain.Spec.Devices.Disks {
+		path := disk.Source.File
+		if path == "" {
+			path = disk.Source.Dev
+		}
+		if path == "" {
+			continue
+		}
+		if err := probeStorage(path); err != nil {
+			return fmt.Errorf("the storage of disk %s is not readable: %v", disk.Target.Device, err)
+		}
+	}
+	return nil
+}
+
+func probeStorage(path string) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- readStorage(path)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(storageProbeTimeout):
+		return fmt.Errorf("reading %s timed out after %v", path, storageProbeTimeout)
+	}
+}
+
+func readStorage(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// drop the cached pages, the read has to reach the storage
+	_ = unix.Fadvise(int(f.Fd()), 0, storageProbeSize, unix.FADV_DONTNEED)
+	if _, err := f.ReadAt(make([]byte, storageProbeSize), 0); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Storage probe", func() {
+	newDomain := func(sources ...api.DiskSource) *api.Domain {
+		domain := &api.Domain{}
+		for i, source := range sources {
+			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, api.Disk{
+				Source: source,
+				Target: api.DiskTarget{Device: "vd" + string(rune('a'+i))},
+			})
+		}
+		return domain
+	}
+
+	It("should succeed when the local disk sources are readable", func() {
+		image := filepath.Join(GinkgoT().TempDir(), "disk.img")
+		Expect(os.WriteFile(image, []byte("data"), 0644)).To(Succeed())
+
+		Expect(probeDiskSources(newDomain(
+			api.DiskSource{File: image},
+			api.DiskSource{Protocol: "rbd", Name: "pool/image"},
+		))).To(Succeed())
+	})
+
+	It("should fail when a disk source is not readable", func() {
+		missing := filepath.Join(GinkgoT().TempDir(), "missing.img")
+
+		err := probeDiskSources(newDomain(api.DiskSource{Dev: missing}))
+		Expect(err).To(MatchError(ContainSubstring("the storage of disk vda is not readable")))
+	})
+})
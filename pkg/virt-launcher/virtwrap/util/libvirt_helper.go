@@ -289,6 +289,71 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 	}()
 }
 
+// StopStaleLibvirtDaemons terminates the virtqemud and virtlogd processes left
+// behind by a crashed virt-launcher, so that fresh ones can be started. Restarting
+// virtqemud does not affect a running qemu process, the new instance reconnects
+// to it from the domain state it keeps in its runtime directory.
+func StopStaleLibvirtDaemons() error {
+	return stopProcesses("/proc", []string{"virtqemud", "virtlogd"}, 10*time.Second)
+}
+
+func stopProcesses(procDir string, names []string, timeout time.Duration) error {
+	pids, err := findProcesses(procDir, names)
+	if err != nil {
+		return err
+	}
+	for _, pid := range pids {
+		log.Log.Infof("Terminating stale process %d", pid)
+		if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return fmt.Errorf("failed to terminate stale process %d: %v", pid, err)
+		}
+	}
+
+	deadline := time.After(timeout)
+	for len(pids) > 0 {
+		select {
+		case <-deadline:
+			return fmt.Errorf("stale processes %v did not terminate within %v", pids, timeout)
+		case <-time.After(200 * time.Millisecond):
+		}
+		if pids, err = findProcesses(procDir, names); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findProcesses returns the pids of the processes whose executable matches one
+// of the names. Zombies have an empty cmdline and are not reported.
+func findProcesses(procDir string, names []string) ([]int, error) {
+	entries, err := os.ReadDir(procDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || pid == os.Getpid() {
+			continue
+		}
+		// #nosec No risk for path injection. Reading specific entries under /proc
+		cmdline, err := os.ReadFile(filepath.Join(procDir, entry.Name(), "cmdline"))
+		if err != nil {
+			// the process is already gone
+			continue
+		}
+		executable := filepath.Base(strings.SplitN(string(cmdline), "\x00", 2)[0])
+		for _, name := range names {
+			if executable == name {
+				pids = append(pids, pid)
+				break
+			}
+		}
+	}
+	return pids, nil
+}
+
 func startVirtlogdLogging(stopChan chan struct{}, domainName string, nonRoot bool) {
 	for {
 		cmd := exec.Command("/usr/sbin/virtlogd", "-f", "/etc/libvirt/virtlogd.conf")
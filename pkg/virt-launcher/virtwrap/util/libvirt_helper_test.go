@@ -7,9 +7,11 @@ import (
 	"encoding/xml"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/go-kit/log"
 	. "github.com/onsi/ginkgo/v2"
@@ -135,6 +137,29 @@ QEMU_AUDIO_DRV=none \
 
 var _ = Describe("LibvirtHelper", func() {
 
+	Context("stale daemons", func() {
+		It("should terminate the processes matching the name", func() {
+			name := fmt.Sprintf("kubevirt-test-virtqemud-%d", os.Getpid())
+			sleepPath, err := exec.LookPath("sleep")
+			Expect(err).ToNot(HaveOccurred())
+			cmd := &exec.Cmd{Path: sleepPath, Args: []string{name, "60"}}
+			Expect(cmd.Start()).To(Succeed())
+			exited := make(chan error, 1)
+			go func() { exited <- cmd.Wait() }()
+
+			pids, err := findProcesses("/proc", []string{name})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pids).To(ConsistOf(cmd.Process.Pid))
+
+			Expect(stopProcesses("/proc", []string{name}, 5*time.Second)).To(Succeed())
+			Eventually(exited).Should(Receive())
+		})
+
+		It("should not fail without matching processes", func() {
+			Expect(stopProcesses("/proc", []string{"kubevirt-test-not-running"}, time.Second)).To(Succeed())
+		})
+	})
+
 	It("should parse libvirt logs", func() {
 		buffer := bytes.NewBuffer(nil)
 
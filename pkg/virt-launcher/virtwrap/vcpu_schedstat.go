@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var (
+	procPath = "/proc"
+	// libvirt keeps the QEMU pid file in a different directory for root and non-root launchers
+	qemuPidDirs = []string{"/run/libvirt/qemu/run", "/run/libvirt/qemu"}
+
+	// QEMU names its vCPU threads "CPU <id>/KVM" (/proc/<pid>/task/<tid>/comm)
+	vcpuThreadRegex = regexp.MustCompile(`^CPU (\d+)/KVM$`)
+)
+
+// vcpuSchedStat holds the fields of /proc/<pid>/task/<tid>/schedstat
+type vcpuSchedStat struct {
+	// time spent on the host CPU, in nanoseconds
+	runTime uint64
+	// time spent waiting on a runqueue, in nanoseconds. The guest sees it as steal time.
+	runDelay uint64
+	// number of timeslices run on the host CPU
+	timeslices uint64
+}
+
+func findQEMUPid(domainName string) (int, error) {
+	for _, pidDir := range qemuPidDirs {
+		content, err := os.ReadFile(filepath.Join(pidDir, domainName+".pid"))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		return strconv.Atoi(strings.TrimSpace(string(content)))
+	}
+	return 0, fmt.Errorf("no pid file found for domain %s", domainName)
+}
+
+// readVCPUSchedStats returns the scheduler statistics of the QEMU vCPU threads, keyed by vCPU id
+func readVCPUSchedStats(pid int) (map[int]vcpuSchedStat, error) {
+	taskDir := filepath.Join(procPath, strconv.Itoa(pid), "task")
+	tasks, err := os.ReadDir(taskDir)
+	if err != nil {
+		return nil, err
+	}
+
+	schedStats := map[int]vcpuSchedStat{}
+	for _, task := range tasks {
+		comm, err := os.ReadFile(filepath.Join(taskDir, task.Name(), "comm"))
+		if err != nil {
+			// the thread may have exited in the meantime
+			continue
+		}
+		match := vcpuThreadRegex.FindStringSubmatch(strings.TrimSpace(string(comm)))
+		if match == nil {
+			continue
+		}
+		vcpuID, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, err
+		}
+		content, err := os.ReadFile(filepath.Join(taskDir, task.Name(), "schedstat"))
+		if err != nil {
+			continue
+		}
+		schedStat, err := parseSchedStat(string(content))
+		if err != nil {
+			return nil, err
+		}
+		schedStats[vcpuID] = schedStat
+	}
+	return schedStats, nil
+}
+
+func parseSchedStat(content string) (vcpuSchedStat, error) {
+	fields := strings.Fields(content)
+	if len(fields) != 3 {
+		return vcpuSchedStat{}, fmt.Errorf("unexpected schedstat content: %q", content)
+	}
+	var values [3]uint64
+	for i, field := range fields {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return vcpuSchedStat{}, fmt.Errorf("unexpected schedstat content: %q: %v", content, err)
+		}
+		values[i] = value
+	}
+	return vcpuSchedStat{runTime: values[0], runDelay: values[1], timeslices: values[2]}, nil
+}
+
+// addVCPUSchedStats complements the libvirt vCPU stats with the number of timeslices
+// and, for libvirt versions which do not report it, the steal time of each vCPU.
+func addVCPUSchedStats(ds *stats.DomainStats, schedStats map[int]vcpuSchedStat) {
+	for vcpuID := range ds.Vcpu {
+		schedStat, exists := schedStats[vcpuID]
+		if !exists {
+			continue
+		}
+		vcpu := &ds.Vcpu[vcpuID]
+		vcpu.TimeslicesSet = true
+		vcpu.Timeslices = schedStat.timeslices
+		if !vcpu.DelaySet {
+			vcpu.DelaySet = true
+			vcpu.Delay = schedStat.runDelay
+		}
+	}
+}
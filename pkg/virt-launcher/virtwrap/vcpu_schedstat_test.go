@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("vCPU scheduler stats", func() {
+	var (
+		origProcPath    string
+		origQEMUPidDirs []string
+		root            string
+	)
+
+	BeforeEach(func() {
+		origProcPath, origQEMUPidDirs = procPath, qemuPidDirs
+		root = GinkgoT().TempDir()
+		procPath = filepath.Join(root, "proc")
+		qemuPidDirs = []string{filepath.Join(root, "run"), filepath.Join(root, "qemu")}
+	})
+
+	AfterEach(func() {
+		procPath, qemuPidDirs = origProcPath, origQEMUPidDirs
+	})
+
+	addTask := func(pid, tid, comm, schedstat string) {
+		taskDir := filepath.Join(procPath, pid, "task", tid)
+		Expect(os.MkdirAll(taskDir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(taskDir, "comm"), []byte(comm+"\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(taskDir, "schedstat"), []byte(schedstat+"\n"), 0644)).To(Succeed())
+	}
+
+	It("should find the QEMU pid in any of the libvirt pid directories", func() {
+		pidDir := filepath.Join(root, "qemu")
+		Expect(os.MkdirAll(pidDir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(pidDir, "default_testvmi.pid"), []byte("1234"), 0644)).To(Succeed())
+
+		pid, err := findQEMUPid("default_testvmi")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pid).To(Equal(1234))
+
+		_, err = findQEMUPid("default_othervmi")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should read the schedstat of the vCPU threads only", func() {
+		addTask("1234", "1234", "qemu-kvm", "100 200 3")
+		addTask("1234", "1240", "CPU 0/KVM", "1000 2000 30")
+		addTask("1234", "1241", "CPU 1/KVM", "1001 2001 31")
+
+		schedStats, err := readVCPUSchedStats(1234)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedStats).To(Equal(map[int]vcpuSchedStat{
+			0: {runTime: 1000, runDelay: 2000, timeslices: 30},
+			1: {runTime: 1001, runDelay: 2001, timeslices: 31},
+		}))
+	})
+
+	It("should fail on malformed schedstat", func() {
+		addTask("1234", "1240", "CPU 0/KVM", "1000 2000")
+
+		_, err := readVCPUSchedStats(1234)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should complement the libvirt vCPU stats", func() {
+		ds := &stats.DomainStats{
+			Vcpu: []stats.DomainStatsVcpu{
+				{DelaySet: true, Delay: 5},
+				{},
+			},
+		}
+		addVCPUSchedStats(ds, map[int]vcpuSchedStat{
+			0: {runDelay: 2000, timeslices: 30},
+			1: {runDelay: 2001, timeslices: 31},
+		})
+
+		Expect(ds.Vcpu).To(Equal([]stats.DomainStatsVcpu{
+			{DelaySet: true, Delay: 5, TimeslicesSet: true, Timeslices: 30},
+			{DelaySet: true, Delay: 2001, TimeslicesSet: true, Timeslices: 31},
+		}))
+	})
+})
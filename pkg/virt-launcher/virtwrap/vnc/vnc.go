@@ -0,0 +1,300 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package vnc implements the small subset of the RFB protocol needed to ask
+// the VNC server of QEMU for a new guest display size.
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+const (
+	protocolVersion = "RFB 003.008\n"
+
+	securityTypeNone = 1
+
+	clientMsgSetEncodings   = 2
+	clientMsgSetDesktopSize = 251
+
+	serverMsgFramebufferUpdate = 0
+	serverMsgColorMapEntries   = 1
+	serverMsgBell              = 2
+	serverMsgServerCutText     = 3
+
+	encodingExtendedDesktopSize = -308
+
+	// Reasons of an ExtendedDesktopSize rectangle, carried in its x-position
+	reasonClientRequest = 1
+
+	// Status codes of an ExtendedDesktopSize rectangle, carried in its y-position
+	statusNoError          = 0
+	statusProhibited       = 1
+	statusOutOfResources   = 2
+	statusInvalidLayout    = 3
+	statusRequestForwarded = 4
+
+	defaultTimeout = 10 * time.Second
+)
+
+// SetDesktopSize dials the VNC unix socket at socketPath and requests the
+// given display size.
+func SetDesktopSize(socketPath string, width, height uint16) error {
+	conn, err := net.DialTimeout("unix", socketPath, defaultTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the VNC server: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(defaultTimeout)); err != nil {
+		return err
+	}
+	return RequestDesktopSize(conn, width, height)
+}
+
+// RequestDesktopSize joins the session on conn as a shared client, sends a
+// SetDesktopSize message and waits for the server to acknowledge it.
+func RequestDesktopSize(conn io.ReadWriter, width, height uint16) error {
+	r := bufio.NewReader(conn)
+	if err := handshake(r, conn); err != nil {
+		return err
+	}
+
+	if err := writeSetEncodings(conn, encodingExtendedDesktopSize); err != nil {
+		return err
+	}
+	if err := writeSetDesktopSize(conn, width, height); err != nil {
+		return err
+	}
+
+	return waitForDesktopSizeResult(r)
+}
+
+func handshake(r *bufio.Reader, w io.Writer) error {
+	version := make([]byte, len(protocolVersion))
+	if _, err := io.ReadFull(r, version); err != nil {
+		return fmt.Errorf("failed to read the protocol version: %v", err)
+	}
+	if _, err := w.Write([]byte(protocolVersion)); err != nil {
+		return err
+	}
+
+	var count uint8
+	if err := binary.Read(r, binary.BigEndian, &count); err != nil {
+		return err
+	}
+	if count == 0 {
+		reason, err := readString(r)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("VNC server refused the connection: %s", reason)
+	}
+	types := make([]byte, count)
+	if _, err := io.ReadFull(r, types); err != nil {
+		return err
+	}
+	supported := false
+	for _, t := range types {
+		if t == securityTypeNone {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf("VNC server does not offer the security type None")
+	}
+	if _, err := w.Write([]byte{securityTypeNone}); err != nil {
+		return err
+	}
+
+	var result uint32
+	if err := binary.Read(r, binary.BigEndian, &result); err != nil {
+		return err
+	}
+	if result != 0 {
+		reason, err := readString(r)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("VNC security handshake failed: %s", reason)
+	}
+
+	// ClientInit, keep other clients connected
+	if _, err := w.Write([]byte{1}); err != nil {
+		return err
+	}
+
+	// ServerInit: width, height and the pixel format, followed by the name
+	serverInit := make([]byte, 2+2+16)
+	if _, err := io.ReadFull(r, serverInit); err != nil {
+		return fmt.Errorf("failed to read the server init message: %v", err)
+	}
+	_, err := readString(r)
+	return err
+}
+
+func writeSetEncodings(w io.Writer, encodings ...int32) error {
+	msg := []interface{}{
+		uint8(clientMsgSetEncodings),
+		uint8(0),
+		uint16(len(encodings)),
+	}
+	for _, e := range encodings {
+		msg = append(msg, e)
+	}
+	return writeAll(w, msg...)
+}
+
+func writeSetDesktopSize(w io.Writer, width, height uint16) error {
+	return writeAll(w,
+		uint8(clientMsgSetDesktopSize),
+		uint8(0),
+		width,
+		height,
+		uint8(1), // number of screens
+		uint8(0),
+		// the only screen, covering the whole display
+		uint32(0),
+		uint16(0),
+		uint16(0),
+		width,
+		height,
+		uint32(0),
+	)
+}
+
+func waitForDesktopSizeResult(r *bufio.Reader) error {
+	for {
+		var msgType uint8
+		if err := binary.Read(r, binary.BigEndian, &msgType); err != nil {
+			return fmt.Errorf("failed to read the desktop size result: %v", err)
+		}
+
+		switch msgType {
+		case serverMsgFramebufferUpdate:
+			done, err := readFramebufferUpdate(r)
+			if err != nil || done {
+				return err
+			}
+		case serverMsgColorMapEntries:
+			var header struct {
+				Padding    uint8
+				FirstColor uint16
+				Count      uint16
+			}
+			if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+				return err
+			}
+			if _, err := r.Discard(int(header.Count) * 6); err != nil {
+				return err
+			}
+		case serverMsgBell:
+		case serverMsgServerCutText:
+			if _, err := r.Discard(3); err != nil {
+				return err
+			}
+			if _, err := readString(r); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unexpected VNC server message type %d", msgType)
+		}
+	}
+}
+
+// readFramebufferUpdate returns true once it has seen the result of the
+// SetDesktopSize request. Only rectangles without pixel data are expected,
+// since no framebuffer update has been requested.
+func readFramebufferUpdate(r *bufio.Reader) (bool, error) {
+	var header struct {
+		Padding uint8
+		Count   uint16
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return false, err
+	}
+
+	done := false
+	for i := 0; i < int(header.Count); i++ {
+		var rect struct {
+			X, Y, Width, Height uint16
+			Encoding            int32
+		}
+		if err := binary.Read(r, binary.BigEndian, &rect); err != nil {
+			return false, err
+		}
+		if rect.Encoding != encodingExtendedDesktopSize {
+			return false, fmt.Errorf("unexpected VNC rectangle encoding %d", rect.Encoding)
+		}
+
+		var screens uint8
+		if err := binary.Read(r, binary.BigEndian, &screens); err != nil {
+			return false, err
+		}
+		if _, err := r.Discard(3 + int(screens)*16); err != nil {
+			return false, err
+		}
+
+		if rect.X != reasonClientRequest {
+			continue
+		}
+		switch rect.Y {
+		case statusNoError, statusRequestForwarded:
+			done = true
+		case statusProhibited:
+			return false, fmt.Errorf("resizing the display is prohibited")
+		case statusOutOfResources:
+			return false, fmt.Errorf("not enough resources to resize the display to %dx%d", rect.Width, rect.Height)
+		case statusInvalidLayout:
+			return false, fmt.Errorf("the display does not support resizing")
+		default:
+			return false, fmt.Errorf("unknown desktop size status %d", rect.Y)
+		}
+	}
+	return done, nil
+}
+
+func readString(r io.Reader) (string, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return "", err
+	}
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func writeAll(w io.Writer, fields ...interface{}) error {
+	buf := &bytes.Buffer{}
+	for _, f := range fields {
+		if err := binary.Write(buf, binary.BigEndian, f); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vnc_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/vnc"
+)
+
+type fakeServer struct {
+	conn net.Conn
+}
+
+func (s *fakeServer) write(fields ...interface{}) {
+	buf := &bytes.Buffer{}
+	for _, f := range fields {
+		Expect(binary.Write(buf, binary.BigEndian, f)).To(Succeed())
+	}
+	_, err := s.conn.Write(buf.Bytes())
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func (s *fakeServer) read(n int) []byte {
+	buf := make([]byte, n)
+	_, err := io.ReadFull(s.conn, buf)
+	Expect(err).ToNot(HaveOccurred())
+	return buf
+}
+
+func (s *fakeServer) handshake() {
+	s.write([]byte("RFB 003.008\n"))
+	Expect(s.read(12)).To(Equal([]byte("RFB 003.008\n")))
+	s.write(uint8(2), uint8(2), uint8(1))
+	Expect(s.read(1)).To(Equal([]byte{1}))
+	s.write(uint32(0))
+	Expect(s.read(1)).To(Equal([]byte{1}), "client should ask for a shared session")
+	s.write(uint16(1024), uint16(768), make([]byte, 16), uint32(4), []byte("QEMU"))
+}
+
+// desktopSizeResult writes a framebuffer update with a single
+// ExtendedDesktopSize rectangle
+func (s *fakeServer) desktopSizeResult(reason, status, width, height uint16) {
+	s.write(uint8(0), uint8(0), uint16(1),
+		reason, status, width, height, int32(-308),
+		uint8(1), make([]byte, 3),
+		uint32(0), uint16(0), uint16(0), width, height, uint32(0),
+	)
+}
+
+var _ = Describe("VNC desktop size", func() {
+	var (
+		client net.Conn
+		server *fakeServer
+	)
+
+	BeforeEach(func() {
+		var serverConn net.Conn
+		client, serverConn = net.Pipe()
+		server = &fakeServer{conn: serverConn}
+		DeferCleanup(func() {
+			client.Close()
+			serverConn.Close()
+		})
+	})
+
+	run := func(width, height uint16) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- vnc.RequestDesktopSize(client, width, height)
+		}()
+		return done
+	}
+
+	expectSetDesktopSize := func(width, height uint16) {
+		setEncodings := server.read(8)
+		Expect(setEncodings[0]).To(Equal(uint8(2)))
+		Expect(binary.BigEndian.Uint16(setEncodings[2:])).To(Equal(uint16(1)))
+		Expect(int32(binary.BigEndian.Uint32(setEncodings[4:]))).To(Equal(int32(-308)))
+
+		msg := server.read(24)
+		Expect(msg[0]).To(Equal(uint8(251)))
+		Expect(binary.BigEndian.Uint16(msg[2:])).To(Equal(width))
+		Expect(binary.BigEndian.Uint16(msg[4:])).To(Equal(height))
+		Expect(msg[6]).To(Equal(uint8(1)))
+		Expect(binary.BigEndian.Uint16(msg[16:])).To(Equal(width))
+		Expect(binary.BigEndian.Uint16(msg[18:])).To(Equal(height))
+	}
+
+	It("should request the new size and wait for the server to accept it", func() {
+		done := run(1920, 1080)
+		server.handshake()
+		expectSetDesktopSize(1920, 1080)
+
+		// a server initiated change must not end the request
+		server.desktopSizeResult(0, 0, 1024, 768)
+		server.write(uint8(2))
+		Consistently(done).ShouldNot(Receive())
+
+		server.desktopSizeResult(1, 0, 1920, 1080)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should succeed when the request is forwarded to the guest", func() {
+		done := run(800, 600)
+		server.handshake()
+		expectSetDesktopSize(800, 600)
+		server.desktopSizeResult(1, 4, 800, 600)
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should fail when the display can not be resized", func() {
+		done := run(800, 600)
+		server.handshake()
+		expectSetDesktopSize(800, 600)
+		server.desktopSizeResult(1, 3, 1024, 768)
+		Eventually(done).Should(Receive(MatchError("the display does not support resizing")))
+	})
+
+	It("should fail when the server does not offer the security type None", func() {
+		done := run(800, 600)
+		server.write([]byte("RFB 003.008\n"))
+		server.read(12)
+		server.write(uint8(1), uint8(2))
+		Eventually(done).Should(Receive(MatchError("VNC server does not offer the security type None")))
+	})
+})
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"kubevirt.io/client-go/log"
+)
+
+// Identity is the VMI which adopted an idle virt-launcher pod of a launcher warm pool.
+type Identity struct {
+	Namespace string
+	Name      string
+	UID       string
+}
+
+// ParseIdentity parses the <namespace>/<name>/<uid> value of the
+// kubevirt.io/launcher-warm-pool-identity annotation.
+func ParseIdentity(value string) (Identity, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return Identity{}, fmt.Errorf("invalid launcher warm pool identity %q", value)
+	}
+	return Identity{Namespace: parts[0], Name: parts[1], UID: parts[2]}, nil
+}
+
+// WaitForIdentity blocks until the downward API file at path holds the
+// identity of the VMI which adopted the pod. The file stays empty while the
+// pod is idle.
+func WaitForIdentity(path string, interval time.Duration) Identity {
+	log.Log.Infof("Waiting for a VMI to adopt the pod, watching %s", path)
+	for {
+		content, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			log.Log.Reason(err).Warningf("Failed to read %s", path)
+		}
+		if len(strings.TrimSpace(string(content))) > 0 {
+			identity, err := ParseIdentity(string(content))
+			if err == nil {
+				log.Log.Infof("Pod adopted by VMI %s/%s", identity.Namespace, identity.Name)
+				return identity
+			}
+			log.Log.Reason(err).Warning("Ignoring the launcher warm pool identity")
+		}
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package warmpool
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Launcher warm pool identity", func() {
+	DescribeTable("should reject invalid identities", func(value string) {
+		_, err := ParseIdentity(value)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("empty", ""),
+		Entry("without uid", "default/testvmi"),
+		Entry("with an empty name", "default//1234"),
+		Entry("with too many parts", "default/testvmi/1234/extra"),
+	)
+
+	It("should parse the identity", func() {
+		Expect(ParseIdentity("default/testvmi/1234\n")).To(Equal(Identity{Namespace: "default", Name: "testvmi", UID: "1234"}))
+	})
+
+	It("should wait until the identity shows up", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "identity")
+		Expect(os.WriteFile(path, []byte{}, 0o644)).To(Succeed())
+
+		identity := make(chan Identity)
+		go func() {
+			defer GinkgoRecover()
+			identity <- WaitForIdentity(path, 10*time.Millisecond)
+		}()
+		Consistently(identity, 50*time.Millisecond).ShouldNot(Receive())
+
+		Expect(os.WriteFile(path, []byte("default/testvmi/1234"), 0o644)).To(Succeed())
+		Eventually(identity).Should(Receive(Equal(Identity{Namespace: "default", Name: "testvmi", UID: "1234"})))
+	})
+})
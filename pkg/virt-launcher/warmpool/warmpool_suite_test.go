@@ -0,0 +1,11 @@
+package warmpool
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestWarmPool(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -88,8 +88,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 89
-	patchCount    = 57
+	resourceCount = 97
+	patchCount    = 65
 	updateCount   = 33
 )
 
@@ -103,7 +103,12 @@ var (
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
-		components.NewVirtualMachineBackupTrackerCrd,
+		components.NewVirtualMachineBackupTrackerCrd, components.NewVirtualMachineBackupHookCrd,
+		components.NewVirtualMachineAuditEventCrd, components.NewVirtualMachinePolicyCrd,
+		components.NewVirtualMachineDisruptionBudgetCrd,
+		components.NewVirtualMachineCheckupCrd, components.NewVirtualMachineUsageReportCrd,
+		components.NewNodeMaintenanceCrd,
+		components.NewVirtualMachineOperationCrd,
 	}
 	numCRDs = len(crdFunctions)
 )
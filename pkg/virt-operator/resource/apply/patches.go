@@ -115,6 +115,10 @@ func (c *Customizer) GenericApplyPatches(objects interface{}) error {
 
 			v := reflect.Indirect(o).FieldByName("ObjectMeta").FieldByName("Name")
 			name := v.String()
+			// the shards of virt-controller are customized like virt-controller
+			if kind == "Deployment" && strings.HasPrefix(name, components.VirtControllerShardPrefix) {
+				name = components.VirtControllerName
+			}
 
 			patches := c.GetPatchesForResource(kind, name)
 
@@ -24,6 +24,8 @@ import (
 
 	"kubevirt.io/api/clone"
 
+	accountingv1alpha1 "kubevirt.io/api/accounting/v1alpha1"
+	checkupv1alpha1 "kubevirt.io/api/checkup/v1alpha1"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
 
@@ -37,11 +39,15 @@ import (
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
 	backupv1alpha1 "kubevirt.io/api/backup/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
+	operationsv1alpha1 "kubevirt.io/api/operations/v1alpha1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -71,6 +77,14 @@ var (
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clone.GroupName
 	VIRTUALMACHINEBACKUP             = "virtualmachinebackups." + backupv1alpha1.SchemeGroupVersion.Group
 	VIRTUALMACHINEBACKUPTRACKER      = "virtualmachinebackuptrackers." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUPHOOK         = "virtualmachinebackuphooks." + backupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEAUDITEVENT         = "virtualmachineauditevents." + auditv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEPOLICY             = "virtualmachinepolicies." + policyv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEDISRUPTIONBUDGET   = "virtualmachinedisruptionbudgets." + policyv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINECHECKUP            = "virtualmachinecheckups." + checkupv1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEUSAGEREPORT        = "virtualmachineusagereports." + accountingv1alpha1.SchemeGroupVersion.Group
+	NODEMAINTENANCE                  = "nodemaintenances." + maintenancev1alpha1.SchemeGroupVersion.Group
+	VIRTUALMACHINEOPERATION          = "virtualmachineoperations." + operationsv1alpha1.SchemeGroupVersion.Group
 )
 
 func addFieldsToVersion(version *extv1.CustomResourceDefinitionVersion, fields ...interface{}) error {
@@ -760,6 +774,342 @@ func NewVirtualMachineBackupTrackerCrd() (*extv1.CustomResourceDefinition, error
 	return crd, nil
 }
 
+func NewVirtualMachineBackupHookCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUPHOOK
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: backupv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    backupv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackuphooks",
+			Singular:   "virtualmachinebackuphook",
+			Kind:       "VirtualMachineBackupHook",
+			ShortNames: []string{"vmbackuphook", "vmbackuphooks"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+		{Name: "Stage", Type: "string", JSONPath: ".spec.stage"},
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "CompletionTime", Type: "date", JSONPath: ".status.completionTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineAuditEventCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEAUDITEVENT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: auditv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    auditv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineauditevents",
+			Singular:   "virtualmachineauditevent",
+			Kind:       "VirtualMachineAuditEvent",
+			ShortNames: []string{"vmauditevent", "vmauditevents"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Target", Type: "string", JSONPath: ".spec.target.name"},
+		{Name: "Operation", Type: "string", JSONPath: ".spec.operation"},
+		{Name: "User", Type: "string", JSONPath: ".spec.user.username"},
+		{Name: "Result", Type: "string", JSONPath: ".spec.result"},
+		{Name: "RequestTime", Type: "date", JSONPath: ".spec.requestTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachinePolicyCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEPOLICY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: policyv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    policyv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinepolicies",
+			Singular:   "virtualmachinepolicy",
+			Kind:       "VirtualMachinePolicy",
+			ShortNames: []string{"vmpolicy", "vmpolicies"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "MaxCPUs", Type: "integer", JSONPath: ".spec.maxCPUs"},
+		{Name: "MaxMemory", Type: "string", JSONPath: ".spec.maxMemory"},
+		{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineCheckupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECHECKUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: checkupv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    checkupv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinecheckups",
+			Singular:   "virtualmachinecheckup",
+			Kind:       "VirtualMachineCheckup",
+			ShortNames: []string{"vmcheckup", "vmcheckups"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "StartTimestamp", Type: "date", JSONPath: ".status.startTimestamp"},
+		{Name: "CompletionTimestamp", Type: "date", JSONPath: ".status.completionTimestamp"},
+		{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineUsageReportCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEUSAGEREPORT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: accountingv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    accountingv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineusagereports",
+			Singular:   "virtualmachineusagereport",
+			Kind:       "VirtualMachineUsageReport",
+			ShortNames: []string{"vmusage", "vmusages"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "PeriodStart", Type: "date", JSONPath: ".spec.periodStart"},
+		{Name: "vCPUHours", Type: "string", JSONPath: ".status.allocated.vcpuHours"},
+		{Name: "MemoryGiBHours", Type: "string", JSONPath: ".status.allocated.memoryGiBHours"},
+		{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewNodeMaintenanceCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = NODEMAINTENANCE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: maintenancev1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    maintenancev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: extv1.ClusterScoped,
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   "nodemaintenances",
+			Singular: "nodemaintenance",
+			Kind:     "NodeMaintenance",
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Node", Type: "string", JSONPath: ".spec.nodeName"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineOperationCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEOPERATION
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: operationsv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    operationsv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+				Subresources: &extv1.CustomResourceSubresources{
+					Status: &extv1.CustomResourceSubresourceStatus{},
+				},
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineoperations",
+			Singular:   "virtualmachineoperation",
+			Kind:       "VirtualMachineOperation",
+			ShortNames: []string{"vmop", "vmops"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Action", Type: "string", JSONPath: ".spec.action"},
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "Total", Type: "integer", JSONPath: ".status.total"},
+		{Name: "Succeeded", Type: "integer", JSONPath: ".status.succeeded"},
+		{Name: "Failed", Type: "integer", JSONPath: ".status.failed"},
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineDisruptionBudgetCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDISRUPTIONBUDGET
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: policyv1alpha1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    policyv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinedisruptionbudgets",
+			Singular:   "virtualmachinedisruptionbudget",
+			Kind:       "VirtualMachineDisruptionBudget",
+			ShortNames: []string{"vmdb", "vmdbs"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "MaxDisruptions", Type: "integer", JSONPath: ".spec.maxDisruptions"},
+		{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineInstancetypeCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
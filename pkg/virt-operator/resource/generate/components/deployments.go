@@ -22,6 +22,7 @@ package components
 import (
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 
 	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
@@ -49,6 +50,9 @@ const (
 	VirtExportProxyName               = "virt-exportproxy"
 	VirtSynchronizationControllerName = "virt-synchronization-controller"
 
+	// VirtControllerShardPrefix prefixes the names of the deployments of the virt-controller shards but the first one
+	VirtControllerShardPrefix = VirtControllerName + "-shard-"
+
 	kubevirtLabelKey = "kubevirt.io"
 
 	portName = "--port"
@@ -426,6 +430,8 @@ func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersi
 		launcherImage,
 		"--exporter-image",
 		exporterImage,
+		"--checkup-image",
+		fmt.Sprintf("%s/%s%s%s", repository, imagePrefix, "virt-checkup", AddVersionSeparatorPrefix(controllerVersion)),
 		portName,
 		"8443",
 		"-v",
@@ -496,6 +502,37 @@ func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersi
 	return deployment
 }
 
+// NewControllerShardDeployments splits the virt-controller deployment into one deployment per namespace shard.
+// The first shard keeps the name of the deployment, since it also runs the controllers which are not sharded.
+func NewControllerShardDeployments(controller *appsv1.Deployment, shards int) []*appsv1.Deployment {
+	if shards < 2 {
+		return []*appsv1.Deployment{controller}
+	}
+
+	deployments := []*appsv1.Deployment{controller}
+	for index := 1; index < shards; index++ {
+		deployment := controller.DeepCopy()
+		name := fmt.Sprintf("%s%d", VirtControllerShardPrefix, index)
+		deployment.Name = name
+		deployment.Labels[virtv1.AppLabel] = name
+		deployment.Labels[virtv1.AppNameLabel] = name
+		deployment.Spec.Selector.MatchLabels[kubevirtLabelKey] = name
+		deployment.Spec.Template.Name = name
+		deployment.Spec.Template.Labels[virtv1.AppLabel] = name
+		deployment.Spec.Template.Spec.Affinity = newPodAntiAffinity(kubevirtLabelKey, corev1.LabelHostname, metav1.LabelSelectorOpIn, []string{name})
+		deployments = append(deployments, deployment)
+	}
+
+	for index, deployment := range deployments {
+		container := &deployment.Spec.Template.Spec.Containers[0]
+		container.Args = append(container.Args,
+			"--shard-count", strconv.Itoa(shards),
+			"--shard-index", strconv.Itoa(index),
+		)
+	}
+	return deployments
+}
+
 // Used for manifest generation only
 func NewOperatorDeployment(namespace, repository, imagePrefix, version, verbosity, kubeVirtVersionEnv, runbookURLTemplate, virtApiImageEnv, virtControllerImageEnv, virtHandlerImageEnv, virtLauncherImageEnv, virtExportProxyImageEnv, virtExportServerImageEnv, virtSynchronizationControllerImageEnv, gsImage, prHelperImage, sidecarShimImage,
 	image string, pullPolicy corev1.PullPolicy) *appsv1.Deployment {
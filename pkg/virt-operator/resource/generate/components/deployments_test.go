@@ -1,10 +1,17 @@
 package components
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("Deployments", func() {
@@ -13,4 +20,35 @@ var _ = Describe("Deployments", func() {
 		Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeClusterIP))
 		Expect(service.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
 	})
+
+	Context("virt-controller shards", func() {
+		newController := func() *appsv1.Deployment {
+			return NewControllerDeployment("kubevirt", "quay.io/kubevirt", "", "v1", "v1", "v1", "v1", "", "", "", "", "", "", "", corev1.PullIfNotPresent, nil, "2", nil)
+		}
+
+		It("should keep the single deployment without shards", func() {
+			deployments := NewControllerShardDeployments(newController(), 1)
+			Expect(deployments).To(HaveLen(1))
+			Expect(deployments[0].Spec.Template.Spec.Containers[0].Args).ToNot(ContainElement("--shard-count"))
+		})
+
+		It("should create one deployment per shard", func() {
+			deployments := NewControllerShardDeployments(newController(), 3)
+			Expect(deployments).To(HaveLen(3))
+
+			for index, deployment := range deployments {
+				name := VirtControllerName
+				if index > 0 {
+					name = fmt.Sprintf("%s%d", VirtControllerShardPrefix, index)
+				}
+				Expect(deployment.Name).To(Equal(name))
+				Expect(deployment.Spec.Selector.MatchLabels).To(HaveKeyWithValue(virtv1.AppLabel, name))
+				Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue(virtv1.AppLabel, name))
+
+				args := deployment.Spec.Template.Spec.Containers[0].Args
+				Expect(args).To(ContainElements("--shard-count", "3", "--shard-index", strconv.Itoa(index)))
+				Expect(strings.Count(strings.Join(args, " "), "--shard-count")).To(Equal(1))
+			}
+		})
+	})
 })
@@ -714,6 +714,15 @@ var CRDsValidation map[string]string = map[string]string{
                 by node pressures, but would mean that fewer VMs could be scheduled to a node.
                 If not set, the default is 1.
               type: string
+            allowedHostPaths:
+              description: |-
+                AllowedHostPaths lists the host directories VirtualMachineInstances may place the backing files and the
+                server sockets of shared memory devices, and the sockets of channels in. A host path is allowed when it is one
+                of these directories or is below one of them. No host path is allowed by default.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
             apiConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -962,6 +971,28 @@ var CRDsValidation map[string]string = map[string]string{
                   nullable: true
                   type: boolean
               type: object
+            containerDiskVerification:
+              description: ContainerDiskVerification holds the keys the signatures
+                of containerdisk images are verified with
+              nullable: true
+              properties:
+                publicKeys:
+                  description: |-
+                    PublicKeys are PEM encoded cosign public keys.
+                    A containerdisk image is accepted if it carries a valid signature of any of them.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                trustedTokenHosts:
+                  description: |-
+                    TrustedTokenHosts are the hosts, with an optional port, registries may redirect anonymous token requests to.
+                    Token services on the host of the registry itself, and auth.docker.io for Docker Hub, are always trusted.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
             controllerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -994,6 +1025,13 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            controllerShards:
+              description: |-
+                ControllerShards splits the VirtualMachineInstance, VirtualMachine and migration controllers of virt-controller
+                into this many shards by a hash of the namespace. Each shard is run by its own virt-controller deployment, the
+                first one also runs the controllers which are not sharded. Sharding is disabled by default.
+              format: int32
+              type: integer
             cpuModel:
               type: string
             cpuRequest:
@@ -1102,17 +1140,150 @@ var CRDsValidation map[string]string = map[string]string{
                     in case hardware-assisted emulation is not available. Defaults to false
                   type: boolean
               type: object
+            diskGarbageCollection:
+              description: DiskGarbageCollection configures the detection and the cleanup of orphaned
+                disks
+              nullable: true
+              properties:
+                cleanup:
+                  description: |-
+                    Cleanup enables the deletion of the orphaned disks labeled with kubevirt.io/collect-disk=true
+                    once their grace period elapsed. Disks labeled with kubevirt.io/retain-disk=true are never deleted.
+                    Defaults to false, orphaned disks are only reported.
+                  type: boolean
+                gracePeriod:
+                  description: |-
+                    GracePeriod is the duration a disk has to stay unreferenced before it is reported as orphaned.
+                    Defaults to 24h.
+                  type: string
+              type: object
             emulatedMachines:
               description: Deprecated. Use architectureConfiguration instead.
               items:
                 type: string
               type: array
+            emulatorBundles:
+              description: |-
+                EmulatorBundles lists the alternative emulator and firmware bundles VirtualMachineInstances
+                may reference instead of the qemu binary and firmware shipped with virt-launcher
+              items:
+                description: |-
+                  EmulatorBundle is a container image providing a qemu binary and firmware,
+                  for instance a vendor patched qemu required by specific hardware
+                properties:
+                  emulator:
+                    description: Emulator is the path of the qemu binary, relative to the root of
+                      the image
+                    type: string
+                  firmware:
+                    description: |-
+                      Firmware is the directory holding the EFI firmware, relative to the root of the image.
+                      The firmware shipped with virt-launcher is used if unset.
+                    type: string
+                  image:
+                    description: |-
+                      Image is the container image holding the bundle. It has to be referenced by digest,
+                      so that only the image trusted by the cluster admin is ever used.
+                    type: string
+                  imagePullPolicy:
+                    description: ImagePullPolicy of the bundle image
+                    type: string
+                  name:
+                    description: Name of the bundle, referenced by VirtualMachineInstances
+                    type: string
+                required:
+                - emulator
+                - image
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             evictionStrategy:
               description: |-
                 EvictionStrategy defines at the cluster level if the VirtualMachineInstance should be
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            firmwareProfiles:
+              description: FirmwareProfiles lists the firmware configurations VirtualMachineInstances
+                may select by name
+              items:
+                description: |-
+                  FirmwareProfile selects the firmware of the VirtualMachineInstances referencing it,
+                  optionally with firmware builds provided by the cluster admin per architecture
+                properties:
+                  images:
+                    description: |-
+                      Images provide UEFI firmware builds per architecture, for instance an OVMF build for AMD SEV.
+                      The firmware shipped with virt-launcher is used for architectures without an image.
+                    items:
+                      description: FirmwareImage is a container image holding UEFI
+                        firmware for one architecture
+                      properties:
+                        architecture:
+                          description: Architecture the firmware is built for, one
+                            of amd64, arm64 or s390x
+                          type: string
+                        image:
+                          description: |-
+                            Image is the container image holding the firmware. It has to be referenced by digest,
+                            so that only the image trusted by the cluster admin is ever used.
+                          type: string
+                        imagePullPolicy:
+                          description: ImagePullPolicy of the firmware image
+                          type: string
+                        path:
+                          description: |-
+                            Path is the directory holding the firmware, relative to the root of the image.
+                            The firmware files are looked up by the names virt-launcher ships them with,
+                            e.g. OVMF_CODE.fd and OVMF_VARS.fd, or OVMF_CODE.cc.fd for SEV.
+                            Defaults to the root of the image.
+                          type: string
+                      required:
+                      - architecture
+                      - image
+                      type: object
+                    type: array
+                    x-kubernetes-list-map-keys:
+                    - architecture
+                    x-kubernetes-list-type: map
+                  name:
+                    description: Name of the profile, referenced by VirtualMachineInstances
+                    type: string
+                  type:
+                    description: |-
+                      Type of the firmware, one of BIOS, UEFI or UEFISecureBoot.
+                      UEFISecureBoot enables SMM, which SecureBoot requires.
+                    enum:
+                    - BIOS
+                    - UEFI
+                    - UEFISecureBoot
+                    type: string
+                required:
+                - name
+                - type
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            guestDiskExpansion:
+              description: GuestDiskExpansion sets the quota for the disk growths requested
+                by guests
+              nullable: true
+              properties:
+                maxSize:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    MaxSize is the largest size a guest can grow a PVC to.
+                    Requests for larger sizes are rejected. Without it, all requests are rejected.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1145,6 +1316,81 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            hostSensors:
+              description: |-
+                HostSensors allows the VirtualMachineInstances of the selected namespaces to read the sensors of their node.
+                The hostSensors device is rejected in all namespaces by default.
+              nullable: true
+              properties:
+                namespaceSelector:
+                  description: |-
+                    NamespaceSelector is matched against the labels of the namespace of the VirtualMachineInstance,
+                    which are controlled by the cluster admin instead of the VM owner. It must not be empty.
+                  properties:
+                    matchExpressions:
+                      description: |-
+                        matchExpressions is a list of label selector requirements.
+                        The requirements are ANDed.
+                      items:
+                        description: |-
+                          A label selector requirement is a selector that contains values, a key, and an operator that
+                          relates the key and values.
+                        properties:
+                          key:
+                            description: key is the label key that the selector applies to.
+                            type: string
+                          operator:
+                            description: |-
+                              operator represents a key's relationship to a set of values.
+                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                            type: string
+                          values:
+                            description: |-
+                              values is an array of string values. If the operator is In or NotIn,
+                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                              the values array must be empty. This array is replaced during a strategic
+                              merge patch.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - key
+                        - operator
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    matchLabels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                      type: object
+                  type: object
+                  x-kubernetes-map-type: atomic
+              required:
+              - namespaceSelector
+              type: object
+            hugepagesPool:
+              description: HugepagesPool lets virt-handler size the 2Mi hugepages
+                pool of the nodes based on the VMI demand
+              nullable: true
+              properties:
+                maxPages:
+                  description: MaxPages is the largest number of 2Mi hugepages virt-handler
+                    allocates on a node.
+                  format: int32
+                  type: integer
+                minPages:
+                  description: MinPages is the number of 2Mi hugepages kept allocated
+                    on a node without any demand.
+                  format: int32
+                  type: integer
+              required:
+              - maxPages
+              type: object
             imagePullPolicy:
               description: PullPolicy describes a policy for if/when to pull a container
                 image
@@ -1219,6 +1465,209 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                   x-kubernetes-map-type: atomic
               type: object
+            launcherPodConfiguration:
+              description: |-
+                LauncherPodConfiguration defines which virt-launcher pod settings VirtualMachineInstances
+                are allowed to set directly
+              properties:
+                allowedAnnotationPrefixes:
+                  description: AllowedAnnotationPrefixes lists the key prefixes of the annotations
+                    VirtualMachineInstances may set on virt-launcher pods
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                allowedLabelPrefixes:
+                  description: AllowedLabelPrefixes lists the key prefixes of the labels VirtualMachineInstances
+                    may set on virt-launcher pods
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                allowedRuntimeClasses:
+                  description: AllowedRuntimeClasses lists the RuntimeClasses VirtualMachineInstances
+                    may request
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                isolatedRuntimeClass:
+                  description: |-
+                    IsolatedRuntimeClass is the RuntimeClass of the virt-launcher pods of VirtualMachineInstances
+                    with the MicroVM launcher isolation. It has to run pods in micro VMs which provide /dev/kvm
+                    through nested virtualization
+                  type: string
+              type: object
+            launcherSecurityProfiles:
+              description: |-
+                LauncherSecurityProfiles assign custom seccomp and SELinux settings to virt-launcher pods
+                based on the labels of the namespace and of the VirtualMachineInstance. The first matching profile is used and
+                overrides seccompConfiguration and selinuxLauncherType.
+              items:
+                description: |-
+                  LauncherSecurityProfile holds the seccomp and SELinux settings for the virt-launcher pods
+                  of the VirtualMachineInstances matching its selectors
+                properties:
+                  name:
+                    description: Name of the profile, must be unique
+                    type: string
+                  namespaceSelector:
+                    description: |-
+                      NamespaceSelector is matched against the labels of the namespace of the
+                      VirtualMachineInstance, which are controlled by the cluster admin instead
+                      of the VM owner. It must not be empty
+                    properties:
+                      matchExpressions:
+                        description: |-
+                          matchExpressions is a list of label selector requirements.
+                          The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  seccomp:
+                    description: Seccomp defines the seccomp profile for matching virt-launcher
+                      pods
+                    properties:
+                      customProfile:
+                        description: CustomProfile allows to request arbitrary profile for virt-launcher
+                        properties:
+                          localhostProfile:
+                            type: string
+                          runtimeDefaultProfile:
+                            type: boolean
+                        type: object
+                    type: object
+                  selector:
+                    description: Selector is matched against the labels of the VirtualMachineInstance
+                    properties:
+                      matchExpressions:
+                        description: |-
+                          matchExpressions is a list of label selector requirements.
+                          The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  selinuxType:
+                    description: SELinuxType defines the SELinux type for matching virt-launcher
+                      pods
+                    type: string
+                required:
+                - name
+                - namespaceSelector
+                - selector
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            launcherWarmPools:
+              description: LauncherWarmPools keep idle virt-launcher pods new VMIs
+                are started in, to shorten their start
+              items:
+                description: |-
+                  LauncherWarmPool keeps a number of idle virt-launcher pods sized by a VirtualMachineClusterInstancetype.
+                  A VMI is started in one of the pods if the pod rendered for it only differs in the identity of the VMI.
+                properties:
+                  architecture:
+                    description: Architecture of the nodes the pods run on. Defaults
+                      to the default architecture of the cluster.
+                    type: string
+                  instancetype:
+                    description: Instancetype is the name of the VirtualMachineClusterInstancetype
+                      the pods are sized for.
+                    type: string
+                  name:
+                    description: Name of the pool, unique per namespace.
+                    type: string
+                  namespace:
+                    description: Namespace the pods are kept in. Only VMIs of this
+                      namespace are started in them.
+                    type: string
+                  size:
+                    description: Size is the number of idle pods kept in the pool.
+                    format: int32
+                    type: integer
+                required:
+                - instancetype
+                - name
+                - namespace
+                - size
+                type: object
+              nullable: true
+              type: array
+              x-kubernetes-list-type: atomic
             liveUpdateConfiguration:
               description: LiveUpdateConfiguration holds defaults for live update
                 features
@@ -1474,8 +1923,89 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                   type: object
+                defaultBindingPolicies:
+                  description: |-
+                    DefaultBindingPolicies select the binding of the pod network interface which is added
+                    to VMIs that specify neither networks nor interfaces, based on the labels of their namespace.
+                    The first matching policy is used. When none matches, defaultNetworkInterface is used.
+                  items:
+                    description: |-
+                      DefaultBindingPolicy selects the binding of the default pod network interface
+                      for the VMIs of a set of namespaces.
+                    properties:
+                      binding:
+                        description: |-
+                          Binding is either one of the core bindings "bridge" and "masquerade",
+                          or the name of a binding plugin registered in binding.
+                        type: string
+                      namespaceSelector:
+                        description: |-
+                          NamespaceSelector selects the namespaces the policy applies to.
+                          An empty selector matches all namespaces.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: |-
+                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: |-
+                                    operator represents a key's relationship to a set of values.
+                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: |-
+                                    values is an array of string values. If the operator is In or NotIn,
+                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                    the values array must be empty. This array is replaced during a strategic
+                                    merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: |-
+                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                        x-kubernetes-map-type: atomic
+                    required:
+                    - binding
+                    - namespaceSelector
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 defaultNetworkInterface:
                   type: string
+                dnsRegistration:
+                  description: |-
+                    DNSRegistration configures the publishing of DNS records for the names and guest IPs of VMIs.
+                    It is used when the VMDNSRegistration feature gate is enabled.
+                  properties:
+                    serviceName:
+                      description: |-
+                        ServiceName is the name of the headless Service which holds the records. A VMI can be
+                        resolved as <hostname>.<serviceName>.<namespace>.svc.<cluster domain>, where hostname
+                        is spec.hostname or the name of the VMI. Defaults to "vms".
+                      type: string
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1568,6 +2098,38 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                virtualFunctionPools:
+                  description: |-
+                    VirtualFunctionPools lists SR-IOV physical functions whose virtual functions
+                    are created by virt-handler and exposed for passthrough.
+                  items:
+                    description: |-
+                      VirtualFunctionPool represents the SR-IOV virtual functions virt-handler creates
+                      on the matching physical functions of a node and exposes for passthrough
+                    properties:
+                      numVFs:
+                        description: |-
+                          NumVFs is the number of virtual functions to create on each physical function.
+                          It is capped by the number of virtual functions the physical function supports.
+                        format: int32
+                        type: integer
+                      pfVendorSelector:
+                        description: The vendor_id:product_id tuple of the physical
+                          functions in the pool
+                        type: string
+                      resourceName:
+                        description: |-
+                          The name of the resource that is representing the virtual functions.
+                          Requested by VMs as host devices. Typically of the form
+                          vendor.com/product_name
+                        type: string
+                    required:
+                    - numVFs
+                    - pfVendorSelector
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
               type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
@@ -1683,6 +2245,24 @@ var CRDsValidation map[string]string = map[string]string{
                   - VersionTLS13
                   type: string
               type: object
+            vfioGroupID:
+              description: |-
+                VFIOGroupID is the ID of the group owning the VFIO device nodes on the nodes. When set, it is
+                added to the supplemental groups of non-root virt-launcher pods using VFIO devices (host devices,
+                GPUs and SR-IOV), granting access to them without changing their ownership.
+              format: int64
+              type: integer
+            virtualMachineAuditEvents:
+              description: VirtualMachineAuditEvents configures the VirtualMachineAuditEvents
+                virt-api records for privileged operations
+              nullable: true
+              properties:
+                retention:
+                  description: |-
+                    Retention is the duration after its request time an event is deleted by virt-controller.
+                    Defaults to 720h.
+                  type: string
+              type: object
             virtualMachineInstancesPerNode:
               type: integer
             virtualMachineOptions:
@@ -1712,10 +2292,95 @@ var CRDsValidation map[string]string = map[string]string{
               - LiveUpdate
               nullable: true
               type: string
+            vmStartThrottling:
+              description: VMStartThrottling limits the number of VirtualMachines
+                virt-controller starts at the same time per storage class
+              nullable: true
+              properties:
+                maxStartingPerNamespace:
+                  description: |-
+                    MaxStartingPerNamespace is the number of VMIs of a single namespace which may be starting at the same time on a
+                    storage class with a limit, so that a single namespace can not take all the starts of a storage class.
+                    Zero means no limit.
+                  format: int32
+                  type: integer
+                maxStartingPerStorageClass:
+                  description: |-
+                    MaxStartingPerStorageClass is the number of VMIs with volumes on a storage class which may be starting at the same time.
+                    Zero means no limit.
+                  format: int32
+                  type: integer
+                storageClasses:
+                  description: StorageClasses overrides MaxStartingPerStorageClass
+                    for single storage classes.
+                  items:
+                    description: StorageClassStartLimit is the number of VMIs which
+                      may be starting at the same time on a storage class
+                    properties:
+                      maxStarting:
+                        description: |-
+                          MaxStarting is the number of VMIs with volumes on the storage class which may be starting at the same time.
+                          Zero means no limit.
+                        format: int32
+                        type: integer
+                      name:
+                        description: Name is the name of the storage class.
+                        type: string
+                    required:
+                    - maxStarting
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - maxStartingPerStorageClass
+              type: object
             vmStateStorageClass:
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM.
               type: string
+            vmiStatusUpdates:
+              description: VMIStatusUpdates controls how virt-handler batches frequent updates
+                of the VirtualMachineInstance status
+              nullable: true
+              properties:
+                batchInterval:
+                  description: |-
+                    BatchInterval is the minimum duration between two writes of a VirtualMachineInstance status
+                    which only change informational fields, like the interfaces, the guest OS information and the
+                    device statuses. Such changes are merged into a single patch once the interval elapsed.
+                    Changes of the phase, the conditions or the migration state are always written immediately.
+                    Defaults to 0, which disables batching.
+                  type: string
+                maxJitter:
+                  description: |-
+                    MaxJitter is the upper bound of a random delay added to the batch interval, spreading the
+                    writes of VirtualMachineInstances whose status changed at the same time. Defaults to 0.
+                  type: string
+              type: object
+            volumeScan:
+              description: VolumeScan configures the scanner the volumes of a VMI
+                are passed to before its first boot
+              nullable: true
+              properties:
+                caBundle:
+                  description: |-
+                    CABundle holds the PEM encoded CA certificates the TLS certificate of the scanner is verified with.
+                    Defaults to the CA certificates of the system.
+                  type: string
+                endpoint:
+                  description: |-
+                    Endpoint is the host:port of the gRPC service implementing the kubevirt.storage.scan.v1alpha1.VolumeScanner service.
+                    The connection is secured with TLS.
+                  type: string
+                timeout:
+                  description: |-
+                    Timeout is the duration a single scan may take before it is retried.
+                    Defaults to 5m.
+                  type: string
+              required:
+              - endpoint
+              type: object
             webhookConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -4042,6 +4707,82 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"nodemaintenance": `openAPIV3Schema:
+  description: |-
+    NodeMaintenance puts a node into maintenance. virt-controller keeps new
+    VirtualMachineInstances off the node and live migrates the running ones
+    away, virt-handler confirms that no domains are left on the node. Deleting
+    the NodeMaintenance ends the maintenance.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: NodeMaintenanceSpec is the spec for a NodeMaintenance resource
+      properties:
+        nodeName:
+          description: NodeName is the name of the node to put into maintenance
+          minLength: 1
+          type: string
+        reason:
+          description: Reason describes why the node is put into maintenance
+          type: string
+      required:
+      - nodeName
+      type: object
+      x-kubernetes-validations:
+      - message: nodeName is immutable
+        rule: self.nodeName == oldSelf.nodeName
+    status:
+      description: NodeMaintenanceStatus is the status for a NodeMaintenance resource
+      properties:
+        blockingVirtualMachineInstances:
+          description: |-
+            BlockingVirtualMachineInstances lists the namespace/name of the
+            VirtualMachineInstances which will not be live migrated and have to be
+            stopped before the maintenance can complete
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: Phase is the phase of the maintenance
+          type: string
+        readyTimestamp:
+          description: ReadyTimestamp is the time the node became ready for reboot
+          format: date-time
+          type: string
+        remainingDomains:
+          description: |-
+            RemainingDomains is the number of domains virt-handler reports on the node,
+            unset until virt-handler reported them
+          format: int32
+          type: integer
+        remainingVirtualMachineInstances:
+          description: |-
+            RemainingVirtualMachineInstances is the number of VirtualMachineInstances
+            still running on the node
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachine": `openAPIV3Schema:
   description: |-
@@ -4797,6 +5538,70 @@ var CRDsValidation map[string]string = map[string]string{
             Mutually exclusive with RunStrategy
             Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
           type: boolean
+        sealed:
+          description: |-
+            Sealed holds the defaults which were chosen once for the VirtualMachine and are kept
+            for its whole lifetime, instead of being written into the template.
+            Values set in the template take precedence.
+            Only populated with the SealedVMDefaults feature gate.
+          properties:
+            disks:
+              description: Disks holds the PCI addresses of the disks of the template
+                which do not set one.
+              items:
+                description: SealedDisk is the PCI address chosen for a disk of the
+                  template.
+                properties:
+                  name:
+                    description: Name of the disk in the template.
+                    type: string
+                  pciAddress:
+                    description: PCIAddress of the disk in the guest, e.g. 0000:02:00.0
+                    type: string
+                required:
+                - name
+                - pciAddress
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            firmwareSerial:
+              description: FirmwareSerial is the serial number exposed to the guest
+                if the template does not set one.
+              type: string
+            firmwareUUID:
+              description: FirmwareUUID is the UUID exposed to the guest if the template
+                does not set one.
+              type: string
+            interfaces:
+              description: Interfaces holds the MAC and PCI addresses of the interfaces
+                of the template which do not set them.
+              items:
+                description: SealedInterface is the MAC and PCI address chosen for
+                  an interface of the template.
+                properties:
+                  macAddress:
+                    description: MACAddress of the interface.
+                    type: string
+                  name:
+                    description: Name of the interface in the template.
+                    type: string
+                  pciAddress:
+                    description: PCIAddress of the interface in the guest, e.g. 0000:01:00.0
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            machineType:
+              description: MachineType is the machine type used if the template does
+                not set one.
+              type: string
+          type: object
         template:
           description: Template is the direct specification of VirtualMachineInstance
           properties:
@@ -5837,6 +6642,24 @@ var CRDsValidation map[string]string = map[string]string{
                     attempting to run. Defaults to the compiled architecture of the
                     KubeVirt components
                   type: string
+                diskSpaceLow:
+                  description: |-
+                    DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+                    is filling up. The utilization of the guest filesystems is reported by the guest agent.
+                  properties:
+                    hysteresisPercentage:
+                      description: |-
+                        HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+                        below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+                      format: int32
+                      type: integer
+                    usedPercentage:
+                      description: |-
+                        UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+                        condition is reported. Defaults to 90.
+                      format: int32
+                      type: integer
+                  type: object
                 dnsConfig:
                   description: |-
                     Specifies the DNS parameters of a pod.
@@ -5914,6 +6737,17 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        ptp:
+                          description: |-
+                            PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                            which guests can discipline their clock to with sub-microsecond accuracy.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled set to false makes sure that a preference can't add the clock.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -6011,6 +6845,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: CPU allow specified the detailed CPU topology inside
                         the vmi.
                       properties:
+                        coreType:
+                          description: |-
+                            CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                            on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                            Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                          type: string
                         cores:
                           description: |-
                             Cores specifies the number of cores inside the vmi.
@@ -6064,6 +6904,12 @@ var CRDsValidation map[string]string = map[string]string{
                             and "host-model" to get CPU closest to the node one.
                             Defaults to host-model.
                           type: string
+                        nestedVirtualization:
+                          description: |-
+                            NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                            and schedules the VMI only on nodes whose KVM module allows nested guests.
+                            Only supported on amd64.
+                          type: boolean
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
@@ -6136,9 +6982,70 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                            in-guest agents to talk to sidecars or node agents without networking.
+                          items:
+                            properties:
+                              hostPath:
+                                description: |-
+                                  HostPath is a directory on the node the channel socket is created in.
+                                  By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                                  directory shared with the hook sidecars of the vmi.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                                  The channel socket is named <name>.sock.
+                                type: string
+                              target:
+                                description: |-
+                                  Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                                  In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                                type: string
+                            required:
+                            - name
+                            - target
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
+                          properties:
+                            count:
+                              description: |-
+                                Count is the number of USB devices which can be redirected at the same time.
+                                Defaults to UsbClientPassthroughMaxNumberOf.
+                              format: int32
+                              type: integer
+                            filter:
+                              description: |-
+                                Filter restricts the USB devices which can be redirected by their class.
+                                It is enforced by virt-handler when proxying the redirection.
+                              properties:
+                                allow:
+                                  description: |-
+                                    Allow lists the device classes which can be redirected. If empty, all
+                                    device classes which are not denied can be redirected.
+                                  items:
+                                    description: USBDeviceClass is the class of a
+                                      USB device or of one of its interfaces.
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                                deny:
+                                  description: |-
+                                    Deny lists the device classes which can not be redirected. Deny takes
+                                    precedence over allow.
+                                  items:
+                                    description: USBDeviceClass is the class of a
+                                      USB device or of one of its interfaces.
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              type: object
                           type: object
                         disableHotplug:
                           description: DisableHotplug disabled the ability to hotplug
@@ -6228,7 +7135,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -6240,6 +7147,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  virtioTransitional:
+                                    description: |-
+                                      If specified, overrides useVirtioTransitional for this disk.
+                                      Only applies to the virtio bus.
+                                    type: boolean
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
@@ -6250,6 +7162,85 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: IOTune throttles the IO of the disk.
+                                  The limits are enforced by QEMU.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the maximum duration of a burst in seconds.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec limits the read
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec limits the read IO
+                                          operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec limits the total
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec limits the total
+                                          IO operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec limits the write
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec limits the write
+                                          IO operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the read IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the total throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total IO
+                                      operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the write IO
+                                      operations per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6272,10 +7263,34 @@ var CRDsValidation map[string]string = map[string]string{
                               name:
                                 description: Name is the device name
                                 type: string
+                              queues:
+                                description: |-
+                                  Queues is the number of queues of the disk. If specified, it overrides the
+                                  number of queues derived from blockMultiQueue for this disk.
+                                  Only supported on the virtio bus.
+                                format: int64
+                                type: integer
+                              rerrorPolicy:
+                                description: |-
+                                  If specified, it changes the error policy for read errors of the disk.
+                                  Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                                type: string
+                              scsiController:
+                                description: |-
+                                  SCSIController is the name of the scsi controller the disk is attached to.
+                                  Only supported on the scsi bus. Defaults to the first scsi controller.
+                                type: string
                               serial:
                                 description: Serial provides the ability to specify
                                   a serial number for the disk device.
                                 type: string
+                              serialPolicy:
+                                description: |-
+                                  SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                                  Supported values are: None, VolumeNameHash.
+                                  VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                                  when disks are reordered. Defaults to None.
+                                type: string
                               shareable:
                                 description: If specified the disk is made sharable
                                   and multiple write from different VMs are permitted
@@ -6284,6 +7299,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: If specified, disk address and its tag
                                   will be provided to the guest via config drive metadata
                                 type: string
+                              wwn:
+                                description: |-
+                                  WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                                  Only supported on the scsi bus.
+                                type: string
                             required:
                             - name
                             type: object
@@ -6303,6 +7323,72 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  idMap:
+                                    description: |-
+                                      IDMap maps the user and group IDs of the guest to the IDs owning the
+                                      files of the shared volume.
+                                    properties:
+                                      gid:
+                                        description: GID maps ranges of group IDs.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of IDs in the range.
+                                              format: int32
+                                              type: integer
+                                            start:
+                                              description: Start is the first ID of the range in the guest.
+                                              format: int32
+                                              type: integer
+                                            target:
+                                              description: Target is the first ID of the range on the shared volume.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - count
+                                          - start
+                                          - target
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      uid:
+                                        description: UID maps ranges of user IDs.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of IDs in the range.
+                                              format: int32
+                                              type: integer
+                                            start:
+                                              description: Start is the first ID of the range in the guest.
+                                              format: int32
+                                              type: integer
+                                            target:
+                                              description: Target is the first ID of the range on the shared volume.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - count
+                                          - start
+                                          - target
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of the virtqueue of the device.
+                                      Must be a power of two not larger than 1024. Defaults to 1024.
+                                    format: int32
+                                    type: integer
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                                      requests of the guest. 0 handles them in the thread of the queue.
+                                      Defaults to the default of virtiofsd.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -6364,6 +7450,18 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: |-
+                            Graphics describes the configuration of the default graphics device.
+                            Not relevant if autoattachGraphicsDevice is disabled.
+                          properties:
+                            keymap:
+                              description: |-
+                                Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                                It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                                Defaults to en-us.
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -6394,6 +7492,21 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        hostSensors:
+                          description: HostSensors creates a virtio serial for exposing
+                            temperature and power readings of the node to the vmi.
+                          properties:
+                            types:
+                              description: |-
+                                Types are the kinds of sensors exposed to the vmi.
+                                Defaults to all of them.
+                              items:
+                                description: HostSensorType is a kind of hardware
+                                  monitoring sensor.
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: set
+                          type: object
                         inputs:
                           description: Inputs describe input devices
                           items:
@@ -6409,7 +7522,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type:
                                 description: |-
                                   Type indicated the type of input device.
-                                  Supported values: tablet.
+                                  A tablet is an absolute pointer, a mouse a relative one.
+                                  Multitouch devices are only supported on the virtio bus.
+                                  Supported values: tablet, keyboard, mouse, multitouch.
                                 type: string
                             required:
                             - name
@@ -6427,6 +7542,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              backend:
+                                description: |-
+                                  Backend selects the host side backend of the interface.
+                                  One of: vhost, userspace.
+                                  Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                                  Only applies to the virtio model.
+                                type: string
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6518,6 +7640,23 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              offloads:
+                                description: |-
+                                  Offloads toggles the offloads of the interface on the tap path.
+                                  Only applies to the virtio model.
+                                properties:
+                                  checksum:
+                                    description: Checksum toggles the checksum offload.
+                                    type: boolean
+                                  gso:
+                                    description: GSO toggles the generic segmentation
+                                      offload of the host.
+                                    type: boolean
+                                  tso:
+                                    description: TSO toggles the TCP segmentation
+                                      offload, for IPv4 and IPv6.
+                                    type: boolean
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -6559,6 +7698,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              queues:
+                                description: |-
+                                  Queues is the number of queues of the interface. If specified, it overrides the
+                                  number of queues derived from networkInterfaceMultiqueue for this interface.
+                                  Only applies to the virtio model.
+                                format: int64
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -6567,6 +7713,25 @@ var CRDsValidation map[string]string = map[string]string{
                               sriov:
                                 description: InterfaceSRIOV connects to a given network
                                   by passing-through an SR-IOV PCI device via vfio.
+                                properties:
+                                  failover:
+                                    description: |-
+                                      Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                                      MAC address and takes over the traffic while the device is unplugged for live migration.
+                                    properties:
+                                      standbyInterface:
+                                        description: |-
+                                          StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                          SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                          It is set to the generated standby interface when StandbyNetworkName is used.
+                                        type: string
+                                      standbyNetworkName:
+                                        description: |-
+                                          StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                          when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                          interface, which is generated if it is not set.
+                                        type: string
+                                    type: object
                                 type: object
                               state:
                                 description: |-
@@ -6582,11 +7747,42 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              virtioTransitional:
+                                description: |-
+                                  If specified, overrides useVirtioTransitional for this interface.
+                                  Only applies to the virtio model.
+                                type: boolean
                             required:
                             - name
                             type: object
                           maxItems: 256
                           type: array
+                        iommu:
+                          description: |-
+                            IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                            DPDK or to assign devices to nested guests.
+                          properties:
+                            cachingMode:
+                              description: |-
+                                CachingMode makes the guest report every mapping change, which is required
+                                to assign devices to nested guests. Only supported by the intel model.
+                              type: boolean
+                            eim:
+                              description: |-
+                                EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                                Requires interruptRemapping.
+                              type: boolean
+                            interruptRemapping:
+                              description: |-
+                                InterruptRemapping enables remapping of the interrupts of assigned devices.
+                                Only supported by the intel model.
+                              type: boolean
+                            model:
+                              description: |-
+                                Model is the IOMMU model. One of: intel, virtio.
+                                Defaults to intel on amd64 and to virtio on arm64.
+                              type: string
+                          type: object
                         logSerialConsole:
                           description: |-
                             Whether to log the auto-attached default serial console or not.
@@ -6594,6 +7790,10 @@ var CRDsValidation map[string]string = map[string]string{
                             Not relevant if autoattachSerialConsole is disabled.
                             Defaults to cluster wide setting on VirtualMachineOptions.
                           type: boolean
+                        memBalloonVirtioTransitional:
+                          description: If specified, overrides useVirtioTransitional for the Memory balloon
+                            device.
+                          type: boolean
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -6618,6 +7818,155 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        scsiControllers:
+                          description: |-
+                            SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                            scsi bus are attached to the controller named in their scsiController field,
+                            or to the first controller if none is named. Spreading busy disks over several
+                            controllers with dedicated IO threads improves storage throughput.
+                            Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                          items:
+                            properties:
+                              dedicatedIOThread:
+                                description: |-
+                                  DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                                  shared by all disks attached to it. Defaults to false.
+                                type: boolean
+                              name:
+                                description: Name of the controller, referenced by
+                                  the scsiController field of disks.
+                                type: string
+                              queues:
+                                description: |-
+                                  Queues is the number of request queues of the controller.
+                                  Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                                format: int32
+                                type: integer
+                            required:
+                            - name
+                            type: object
+                          maxItems: 32
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        serials:
+                          description: |-
+                            Serials describe additional serial ports, e.g. for guests which log
+                            their kernel output to ttyS1. Each port can be connected to with
+                            virtctl console --serial.
+                          items:
+                            properties:
+                              name:
+                                description: Name of the serial port.
+                                type: string
+                              port:
+                                description: |-
+                                  Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                                  Port 0 is taken by the default serial console, unless
+                                  autoattachSerialConsole is disabled.
+                                format: int32
+                                type: integer
+                            required:
+                            - name
+                            - port
+                            type: object
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        sgx:
+                          description: SGX exposes an Intel SGX enclave page cache
+                            (EPC) section to the vmi, allowing it to run SGX enclaves.
+                          properties:
+                            epcSize:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                                It is taken from the EPC of the node, which is not part of its memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - epcSize
+                          type: object
+                        sharedMemoryDevices:
+                          description: |-
+                            SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                            co-located VMs or pods to exchange data through them.
+                          items:
+                            properties:
+                              hostPath:
+                                description: |-
+                                  HostPath is a file on the node backing an ivshmem-plain region, shared with
+                                  every VM and pod on the node mapping the same file. It is created if it
+                                  does not exist and has to be writable by the qemu user.
+                                type: string
+                              model:
+                                description: |-
+                                  Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                                  Defaults to ivshmem-plain.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                                  Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                                  virt-launcher pod and can be shared with its sidecar containers.
+                                type: string
+                              serverSocketPath:
+                                description: |-
+                                  ServerSocketPath is the path on the node of the ivshmem-server socket an
+                                  ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                                type: string
+                              size:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Size of the shared memory region. Must be a power of two.
+                                  Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                                  server owns the region.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        smartcard:
+                          description: Smartcard attaches a CCID smartcard reader
+                            to the vmi.
+                          properties:
+                            hostCertificates:
+                              description: |-
+                                HostCertificates emulates a smartcard with certificates of an NSS
+                                database stored in a Secret.
+                              properties:
+                                certificates:
+                                  description: Certificates are the nicknames of the
+                                    three certificates of the emulated card.
+                                  items:
+                                    type: string
+                                  maxItems: 3
+                                  minItems: 3
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                secretName:
+                                  description: |-
+                                    SecretName is the name of the Secret holding the files of the NSS database,
+                                    for example cert9.db, key4.db and pkcs11.txt.
+                                  type: string
+                              required:
+                              - certificates
+                              - secretName
+                              type: object
+                            passthrough:
+                              description: |-
+                                Passthrough forwards the smartcard of a client, which connects to the
+                                smartcard subresource of the vmi.
+                              type: object
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -6641,11 +7990,33 @@ var CRDsValidation map[string]string = map[string]string{
                                 Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                 Defaults to True
                               type: boolean
+                            encryptionSecretRef:
+                              description: |-
+                                EncryptionSecretRef references a Secret in the namespace of the VMI.
+                                Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                                It has to be set before the TPM state is created and requires persistent to be enabled.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
                             persistent:
                               description: |-
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version is the version of the emulated TPM, 2.0 or 1.2.
+                                Defaults to 2.0
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -6657,6 +8028,24 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Video describes the video device configuration
                             for the vmi.
                           properties:
+                            resolution:
+                              description: |-
+                                Resolution is the preferred resolution advertised to the guest through EDID.
+                                Graphical consoles come up at this resolution if the guest display driver honors it.
+                                Supported by the vga, virtio and bochs video types.
+                              properties:
+                                height:
+                                  description: Height in pixels.
+                                  format: int32
+                                  type: integer
+                                width:
+                                  description: Width in pixels.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - height
+                              - width
+                              type: object
                             type:
                               description: |-
                                 Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -6673,7 +8062,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -6682,17 +8071,39 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                     Defaults to reset.
                                   type: string
                               type: object
                             name:
                               description: Name of the watchdog.
                               type: string
+                            remediation:
+                              description: |-
+                                Remediation is carried out by KubeVirt once the watchdog expired,
+                                in addition to the action taken by the watchdog device itself.
+                              properties:
+                                memoryDumpClaimName:
+                                  description: |-
+                                    MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                                    Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                                  type: string
+                                type:
+                                  description: 'Type of the remediation. One of: Reset,
+                                    RestartVM, MemoryDump.'
+                                  type: string
+                              required:
+                              - type
+                              type: object
                           required:
                           - name
                           type: object
                       type: object
+                    emulatorBundle:
+                      description: |-
+                        EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                        Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+                      type: string
                     features:
                       description: Features like acpi, apic, hyperv, smm.
                       properties:
@@ -6916,6 +8327,21 @@ var CRDsValidation map[string]string = map[string]string{
                                 Hide the KVM hypervisor from standard MSR based discovery.
                                 Defaults to false
                               type: boolean
+                            hintDedicated:
+                              description: |-
+                                HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                                paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                                Only supported on amd64.
+                                Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                              type: boolean
+                            pollControl:
+                              description: |-
+                                PollControl allows the guest to take over halt polling from the host, e.g. with the
+                                cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                                The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                                Only supported on amd64.
+                                Defaults to false
+                              type: boolean
                           type: object
                         pvspinlock:
                           description: |-
@@ -6959,8 +8385,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type: string
                           type: object
                         bootloader:
-                          description: Settings to control the bootloader that is
-                            used.
+                          description: |-
+                            Settings to control the bootloader that is used.
+                            Defaulted from the firmware profile, if set.
                           properties:
                             bios:
                               description: If set (default), BIOS will be used.
@@ -7027,6 +8454,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 boot time
                               type: string
                           type: object
+                        profile:
+                          description: |-
+                            Profile is the name of a firmware profile configured in the KubeVirt CR.
+                            The profile selects the bootloader, and may provide a custom firmware build
+                            for the architecture of the VMI.
+                          type: string
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
@@ -7060,6 +8493,30 @@ var CRDsValidation map[string]string = map[string]string{
                             attestation:
                               description: If specified, run the attestation process
                                 for a vmi.
+                              properties:
+                                keyBroker:
+                                  description: |-
+                                    If specified, the launch secret is released by the key broker once it
+                                    verified the launch measurement, and the VMI is unpaused afterwards.
+                                    Otherwise the launch secret has to be injected through the
+                                    sev/injectlaunchsecret subresource.
+                                  properties:
+                                    protocol:
+                                      description: |-
+                                        Protocol spoken with the key broker.
+                                        Defaults to kbs.
+                                      type: string
+                                    resourcePath:
+                                      description: Path of the resource holding the
+                                        launch secret, e.g. default/sev/launch-secret.
+                                      type: string
+                                    url:
+                                      description: URL of the key broker service.
+                                      type: string
+                                  required:
+                                  - resourcePath
+                                  - url
+                                  type: object
                               type: object
                             dhCert:
                               description: Base64 encoded guest owner's Diffie-Hellman
@@ -7085,6 +8542,32 @@ var CRDsValidation map[string]string = map[string]string{
                           type: object
                         tdx:
                           description: Intel Trust Domain Extensions (TDX).
+                          properties:
+                            attestation:
+                              description: If specified, the guest can request quotes from the quote generation
+                                service of the node.
+                              type: object
+                            mrConfigId:
+                              description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                              type: string
+                            mrOwner:
+                              description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                              type: string
+                            mrOwnerConfig:
+                              description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                                (MROWNERCONFIG).
+                              type: string
+                            policy:
+                              description: |-
+                                Guest policy flags as defined in the Intel TDX module specification.
+                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                              properties:
+                                septVEDisable:
+                                  description: |-
+                                    Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                                    Defaults to false.
+                                  type: boolean
+                              type: object
                           type: object
                       type: object
                     machine:
@@ -7095,9 +8578,27 @@ var CRDsValidation map[string]string = map[string]string{
                             the VirtualMachineInstance.
                           type: string
                       type: object
+                    machineOptions:
+                      description: |-
+                        MachineOptions allows to turn off legacy devices of the machine,
+                        reducing the attack surface of hardened guests.
+                      properties:
+                        ps2:
+                          description: |-
+                            PS2 controls the legacy PS/2 (i8042) controller.
+                            Defaults to true.
+                          type: boolean
+                      type: object
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        dimmSlots:
+                          description: |-
+                            DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                            is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                            DIMMs can not be hot unplugged.
+                          format: int32
+                          type: integer
                         guest:
                           anyOf:
                           - type: integer
@@ -7160,6 +8661,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Valid resource keys are "memory" and "cpu".
                           type: object
                       type: object
+                    useEmulation:
+                      description: |-
+                        UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                        The vmi does not request /dev/kvm, can run on any node and performs
+                        significantly slower than a hardware accelerated guest.
+                        Intended for CI and cross-architecture testing.
+                      type: boolean
                   required:
                   - devices
                   type: object
@@ -7172,11 +8680,72 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestSecrets:
+                  description: |-
+                    GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+                    without writing them to any disk image.
+                  items:
+                    description: GuestSecret delivers the value of a key of a Secret to the guest.
+                    properties:
+                      key:
+                        description: Key of the Secret whose value is delivered.
+                        type: string
+                      name:
+                        description: Name of the guest secret, unique within the VMI. It names the
+                          fw_cfg blob.
+                        type: string
+                      secretName:
+                        description: SecretName is the name of the Secret in the namespace of the VMI.
+                        type: string
+                      target:
+                        description: |-
+                          Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+                          the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+                          secret is delivered on the next start.
+                          Defaults to FWCfg.
+                        enum:
+                        - FWCfg
+                        type: string
+                    required:
+                    - key
+                    - name
+                    - secretName
+                    type: object
+                  maxItems: 32
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                launcherIsolation:
+                  description: |-
+                    LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+                    the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+                    Defaults to Container.
+                  enum:
+                  - Container
+                  - MicroVM
+                  type: string
+                launcherMetadata:
+                  description: |-
+                    LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+                    The keys have to be allowed in launcherPodConfiguration.
+                  properties:
+                    annotations:
+                      additionalProperties:
+                        type: string
+                      description: Annotations to add to the virt-launcher pod
+                      type: object
+                    labels:
+                      additionalProperties:
+                        type: string
+                      description: Labels to add to the virt-launcher pod
+                      type: object
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -7208,6 +8777,22 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                        The probe succeeds if the command exits with status 0.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining ones are passed as arguments. It is not run in a shell.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -7311,6 +8896,23 @@ var CRDsValidation map[string]string = map[string]string{
                         More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                       format: int32
                       type: integer
+                    vsockHTTP:
+                      description: |-
+                        VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                        It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                      properties:
+                        path:
+                          description: Path to access on the HTTP server. Defaults
+                            to "/".
+                          type: string
+                        port:
+                          description: Port is the VSOCK port the guest service listens
+                            on.
+                          format: int32
+                          type: integer
+                      required:
+                      - port
+                      type: object
                   type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
@@ -7406,6 +9008,22 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                        The probe succeeds if the command exits with status 0.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining ones are passed as arguments. It is not run in a shell.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -7509,6 +9127,23 @@ var CRDsValidation map[string]string = map[string]string{
                         More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                       format: int32
                       type: integer
+                    vsockHTTP:
+                      description: |-
+                        VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                        It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                      properties:
+                        path:
+                          description: Path to access on the HTTP server. Defaults
+                            to "/".
+                          type: string
+                        port:
+                          description: Port is the VSOCK port the guest service listens
+                            on.
+                          format: int32
+                          type: integer
+                      required:
+                      - port
+                      type: object
                   type: object
                 resourceClaims:
                   description: |-
@@ -7516,6 +9151,11 @@ var CRDsValidation map[string]string = map[string]string{
                     and reserved before the VMI, hence virt-launcher pod is allowed to start. The resources
                     will be made available to the domain which consumes them
                     by name.
+                runtimeClassName:
+                  description: |-
+                    If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+                    cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+                  type: string
 
                     This is an alpha field and requires enabling the
                     DynamicResourceAllocation feature gate in kubernetes
@@ -7960,6 +9600,10 @@ var CRDsValidation map[string]string = map[string]string{
                           ConfigMapSource represents a reference to a ConfigMap in the same namespace.
                           More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           name:
                             default: ""
                             description: |-
@@ -8187,6 +9831,9 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format of the memory dump, Core by default
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8233,6 +9880,10 @@ var CRDsValidation map[string]string = map[string]string{
                           SecretVolumeSource represents a reference to a secret data in the same namespace.
                           More info: https://kubernetes.io/docs/concepts/configuration/secret/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           optional:
                             description: Specify whether the Secret or it's keys must
                               be defined
@@ -8255,6 +9906,10 @@ var CRDsValidation map[string]string = map[string]string{
                           There can only be one volume of this type!
                           More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           serviceAccountName:
                             description: |-
                               Name of the service account in the pod's namespace to use.
@@ -8440,6 +10095,9 @@ var CRDsValidation map[string]string = map[string]string{
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format of the memory dump, Core by default
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8648,7 +10306,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -8660,6 +10318,11 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          virtioTransitional:
+                            description: |-
+                              If specified, overrides useVirtioTransitional for this disk.
+                              Only applies to the virtio bus.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -8670,6 +10333,82 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: IOTune throttles the IO of the disk. The limits
+                          are enforced by QEMU.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst in seconds.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec limits the read throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec limits the read IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec limits the total throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec limits the total IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec limits the write throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec limits the write IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8691,10 +10430,34 @@ var CRDsValidation map[string]string = map[string]string{
                       name:
                         description: Name is the device name
                         type: string
+                      queues:
+                        description: |-
+                          Queues is the number of queues of the disk. If specified, it overrides the
+                          number of queues derived from blockMultiQueue for this disk.
+                          Only supported on the virtio bus.
+                        format: int64
+                        type: integer
+                      rerrorPolicy:
+                        description: |-
+                          If specified, it changes the error policy for read errors of the disk.
+                          Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                        type: string
+                      scsiController:
+                        description: |-
+                          SCSIController is the name of the scsi controller the disk is attached to.
+                          Only supported on the scsi bus. Defaults to the first scsi controller.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
                         type: string
+                      serialPolicy:
+                        description: |-
+                          SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                          Supported values are: None, VolumeNameHash.
+                          VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                          when disks are reordered. Defaults to None.
+                        type: string
                       shareable:
                         description: If specified the disk is made sharable and multiple
                           write from different VMs are permitted
@@ -8703,6 +10466,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, disk address and its tag will be
                           provided to the guest via config drive metadata
                         type: string
+                      wwn:
+                        description: |-
+                          WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                          Only supported on the scsi bus.
+                        type: string
                     required:
                     - name
                     type: object
@@ -8727,6 +10495,36 @@ var CRDsValidation map[string]string = map[string]string{
                     description: VolumeSource represents the source of the volume
                       to map to the disk.
                     properties:
+                      configMap:
+                        description: |-
+                          ConfigMap represents a reference to a ConfigMap in the same namespace, which is attached
+                          to the vmi as an iso image.
+                        properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          name:
+                            default: ""
+                            description: |-
+                              Name of the referent.
+                              This field is effectively required, but due to backwards compatibility is
+                              allowed to be empty. Instances of this type with an empty value here are
+                              almost certainly wrong.
+                              More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            type: string
+                          optional:
+                            description: Specify whether the ConfigMap or it's keys
+                              must be defined
+                            type: boolean
+                          volumeLabel:
+                            description: |-
+                              The volume label of the resulting disk inside the VMI.
+                              Different bootstrapping mechanisms require different values.
+                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                        x-kubernetes-map-type: atomic
                       dataVolume:
                         description: |-
                           DataVolume represents the dynamic creation a PVC for this volume as well as
@@ -8766,6 +10564,46 @@ var CRDsValidation map[string]string = map[string]string{
                         required:
                         - claimName
                         type: object
+                      secret:
+                        description: |-
+                          Secret represents a reference to a Secret in the same namespace, which is attached
+                          to the vmi as an iso image.
+                        properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          optional:
+                            description: Specify whether the Secret or it's keys must
+                              be defined
+                            type: boolean
+                          secretName:
+                            description: |-
+                              Name of the secret in the pod's namespace to use.
+                              More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+                            type: string
+                          volumeLabel:
+                            description: |-
+                              The volume label of the resulting disk inside the VMI.
+                              Different bootstrapping mechanisms require different values.
+                              Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      serviceAccount:
+                        description: |-
+                          ServiceAccount represents a reference to a service account, whose token is attached
+                          to the vmi as an iso image.
+                        properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          serviceAccountName:
+                            description: |-
+                              Name of the service account in the pod's namespace to use.
+                              More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                            type: string
+                        type: object
                     type: object
                 required:
                 - disk
@@ -8950,6 +10788,130 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachineauditevent": `openAPIV3Schema:
+  description: |-
+    VirtualMachineAuditEvent records a privileged operation a user requested on a
+    VirtualMachine or VirtualMachineInstance through the subresource API.
+    Events are written by virt-api and can't be changed afterwards.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineAuditEventSpec is the spec for a VirtualMachineAuditEvent
+        resource
+      properties:
+        completionTime:
+          description: |-
+            CompletionTime is the time virt-api finished the request, for streams like the console
+            it is the time the connection was closed
+          format: date-time
+          type: string
+        operation:
+          description: Operation is the privileged operation which was requested
+          enum:
+          - Console
+          - VNC
+          - MemoryDump
+          - Freeze
+          - Unfreeze
+          - PortForward
+          - VSOCK
+          - USBRedir
+          type: string
+        requestTime:
+          description: RequestTime is the time virt-api received the request
+          format: date-time
+          type: string
+        responseCode:
+          description: |-
+            ResponseCode is the HTTP status code virt-api answered the request with,
+            an established stream is reported with 200
+          format: int32
+          type: integer
+        result:
+          description: Result tells whether the operation succeeded
+          enum:
+          - Succeeded
+          - Failed
+          type: string
+        sourceIPs:
+          description: SourceIPs holds the client address the apiserver appended
+            to the X-Forwarded-For header
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        target:
+          description: Target specifies the VM or VMI the operation was requested
+            on
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        user:
+          description: User is the identity the request was authorized for
+          properties:
+            extra:
+              additionalProperties:
+                items:
+                  type: string
+                type: array
+              type: object
+            groups:
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            username:
+              type: string
+          required:
+          - username
+          type: object
+      required:
+      - completionTime
+      - operation
+      - requestTime
+      - responseCode
+      - result
+      - target
+      - user
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinebackup": `openAPIV3Schema:
   description: VirtualMachineBackup defines the operation of backing up a VM
@@ -9081,6 +11043,119 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinebackuphook": `openAPIV3Schema:
+  description: |-
+    VirtualMachineBackupHook requests virt-controller to prepare a VirtualMachine
+    for a backup or to fix it up after a restore. It allows external backup tools
+    to back up and restore VirtualMachines consistently without custom scripts.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineBackupHookSpec is the spec for a VirtualMachineBackupHook
+        resource
+      properties:
+        actions:
+          description: |-
+            Actions are executed in the given order, the hook fails on the first
+            failing action
+          items:
+            description: BackupHookAction is the const type for the actions a hook
+              can execute
+            type: string
+          minItems: 1
+          type: array
+          x-kubernetes-list-type: atomic
+        freezeTimeout:
+          description: |-
+            FreezeTimeout is the time after which a frozen guest is thawed even if
+            no PostBackup hook unfroze it. Defaults to 5 minutes
+          type: string
+        source:
+          description: Source specifies the VM the hook is executed for
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+          x-kubernetes-validations:
+          - message: apiGroup must be kubevirt.io
+            rule: has(self.apiGroup) && self.apiGroup == 'kubevirt.io'
+          - message: kind must be VirtualMachine
+            rule: self.kind == 'VirtualMachine'
+          - message: name is required
+            rule: self.name != ''
+        stage:
+          description: Stage specifies the stage of the backup or restore the hook
+            is executed at
+          enum:
+          - PreBackup
+          - PostBackup
+          - PostRestore
+          type: string
+      required:
+      - actions
+      - source
+      - stage
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+    status:
+      description: VirtualMachineBackupHookStatus is the status for a VirtualMachineBackupHook
+        resource
+      properties:
+        completedActions:
+          description: CompletedActions lists the actions which were already executed
+          items:
+            description: BackupHookAction is the const type for the actions a hook
+              can execute
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        completionTime:
+          description: CompletionTime is the time the hook succeeded or failed
+          format: date-time
+          type: string
+        message:
+          description: Message explains the current phase of the hook
+          type: string
+        phase:
+          description: BackupHookPhase is the const type for the phases of a hook
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinebackuptracker": `openAPIV3Schema:
   description: |-
@@ -9159,6 +11234,182 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinecheckup": `openAPIV3Schema:
+  description: |-
+    VirtualMachineCheckup runs a standardized benchmark, like the network latency
+    between two VirtualMachineInstances, and publishes its results in the status.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineCheckupSpec is the spec for a VirtualMachineCheckup
+        resource
+      properties:
+        bootTime:
+          description: BootTime measures the time a VirtualMachineInstance takes to
+            boot
+          properties:
+            maxDesiredBootTime:
+              description: MaxDesiredBootTime fails the checkup if the maximum boot
+                time is above it
+              type: string
+            samples:
+              description: Samples is the number of boots which are measured, defaults
+                to 3
+              format: int32
+              minimum: 1
+              type: integer
+          type: object
+        networkLatency:
+          description: NetworkLatency measures the latency between two VirtualMachineInstances
+          properties:
+            maxDesiredLatency:
+              description: MaxDesiredLatency fails the checkup if the maximum latency
+                is above it
+              type: string
+            networkAttachmentDefinition:
+              description: |-
+                NetworkAttachmentDefinition is the secondary network the latency is
+                measured on, as <namespace>/<name> or <name>. The pod network is used if empty
+              type: string
+            sampleDuration:
+              description: SampleDuration is the time the latency is sampled for,
+                defaults to 5 seconds
+              type: string
+            sourceNode:
+              description: SourceNode is the node the source VirtualMachineInstance
+                runs on
+              type: string
+            targetNode:
+              description: TargetNode is the node the target VirtualMachineInstance
+                runs on
+              type: string
+          type: object
+        serviceAccountName:
+          description: |-
+            ServiceAccountName is the service account the checkup runs with. It needs
+            permissions to create, delete and access the consoles of
+            VirtualMachineInstances in the namespace of the checkup
+          type: string
+        storage:
+          description: Storage measures the IOPS of a disk of a VirtualMachineInstance
+          properties:
+            minDesiredReadIOPS:
+              description: MinDesiredReadIOPS fails the checkup if the read IOPS are
+                below it
+              format: int64
+              type: integer
+            minDesiredWriteIOPS:
+              description: MinDesiredWriteIOPS fails the checkup if the write IOPS
+                are below it
+              format: int64
+              type: integer
+            storageClassName:
+              description: |-
+                StorageClassName of the benchmarked disk, the default storage class is
+                used if empty
+              type: string
+            volumeSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: VolumeSize is the size of the benchmarked disk, defaults
+                to 10Gi
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          type: object
+        timeout:
+          description: Timeout after which the checkup fails, defaults to 10 minutes
+          type: string
+      required:
+      - serviceAccountName
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+      - message: exactly one of networkLatency, storage and bootTime is required
+        rule: '[has(self.networkLatency), has(self.storage), has(self.bootTime)].filter(x,
+          x).size() == 1'
+    status:
+      description: VirtualMachineCheckupStatus is the status for a VirtualMachineCheckup
+        resource
+      properties:
+        bootTime:
+          description: BootTime holds the results of the boot time checkup
+          properties:
+            averageBootTime:
+              type: string
+            maxBootTime:
+              type: string
+            minBootTime:
+              type: string
+          required:
+          - averageBootTime
+          - maxBootTime
+          - minBootTime
+          type: object
+        completionTimestamp:
+          description: CompletionTimestamp is the time the checkup succeeded or failed
+          format: date-time
+          type: string
+        failureReason:
+          description: FailureReason explains why the checkup failed
+          type: string
+        networkLatency:
+          description: NetworkLatency holds the results of the network latency checkup
+          properties:
+            averageLatency:
+              type: string
+            maxLatency:
+              type: string
+            minLatency:
+              type: string
+          required:
+          - averageLatency
+          - maxLatency
+          - minLatency
+          type: object
+        phase:
+          description: VirtualMachineCheckupPhase is the const type for the phases
+            of a checkup
+          type: string
+        startTimestamp:
+          description: StartTimestamp is the time the checkup pod was created
+          format: date-time
+          type: string
+        storage:
+          description: Storage holds the results of the storage checkup
+          properties:
+            readIOPS:
+              format: int64
+              type: integer
+            writeIOPS:
+              format: int64
+              type: integer
+          required:
+          - readIOPS
+          - writeIOPS
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineclone": `openAPIV3Schema:
   description: VirtualMachineClone is a CRD that clones one VM into another.
@@ -9190,6 +11441,11 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        firmwareIdentityPolicy:
+          description: |-
+            FirmwareIdentityPolicy defines whether the target keeps the firmware UUID and SMBIOS serial of the
+            source, or gets new ones. Defaults to Regenerate. NewSMBiosSerial takes precedence over the policy.
+          type: string
         labelFilters:
           description: |-
             Example use: "!some/key*".
@@ -9391,6 +11647,12 @@ var CRDsValidation map[string]string = map[string]string{
                 IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
                 the emulator thread on it.
               type: boolean
+            maxCPUs:
+              description: |-
+                MaxCPUs specifies the maximum amount of vCPUs that can be hotplugged.
+                It is rounded down to whole sockets of the CPU topology and can not be used together with MaxSockets.
+              format: int32
+              type: integer
             maxSockets:
               description: MaxSockets specifies the maximum amount of sockets that
                 can be hotplugged
@@ -9526,6 +11788,30 @@ var CRDsValidation map[string]string = map[string]string{
               properties:
                 attestation:
                   description: If specified, run the attestation process for a vmi.
+                  properties:
+                    keyBroker:
+                      description: |-
+                        If specified, the launch secret is released by the key broker once it
+                        verified the launch measurement, and the VMI is unpaused afterwards.
+                        Otherwise the launch secret has to be injected through the
+                        sev/injectlaunchsecret subresource.
+                      properties:
+                        protocol:
+                          description: |-
+                            Protocol spoken with the key broker.
+                            Defaults to kbs.
+                          type: string
+                        resourcePath:
+                          description: Path of the resource holding the launch secret,
+                            e.g. default/sev/launch-secret.
+                          type: string
+                        url:
+                          description: URL of the key broker service.
+                          type: string
+                      required:
+                      - resourcePath
+                      - url
+                      type: object
                   type: object
                 dhCert:
                   description: Base64 encoded guest owner's Diffie-Hellman key.
@@ -9550,6 +11836,32 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             tdx:
               description: Intel Trust Domain Extensions (TDX).
+              properties:
+                attestation:
+                  description: If specified, the guest can request quotes from the quote generation
+                    service of the node.
+                  type: object
+                mrConfigId:
+                  description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                  type: string
+                mrOwner:
+                  description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                  type: string
+                mrOwnerConfig:
+                  description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                    (MROWNERCONFIG).
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in the Intel TDX module specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                  properties:
+                    septVEDisable:
+                      description: |-
+                        Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                        Defaults to false.
+                      type: boolean
+                  type: object
               type: object
           type: object
         memory:
@@ -9674,6 +11986,10 @@ var CRDsValidation map[string]string = map[string]string{
                       type: integer
                   type: object
               type: object
+            preferredPTP:
+              description: PreferredPTP optionally enables the PTP hardware clock
+                of the ptp_kvm driver in the guest.
+              type: boolean
             preferredTimer:
               description: Timer specifies whih timers are attached to the vmi.
               properties:
@@ -9920,11 +12236,33 @@ var CRDsValidation map[string]string = map[string]string{
                     Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                     Defaults to True
                   type: boolean
+                encryptionSecretRef:
+                  description: |-
+                    EncryptionSecretRef references a Secret in the namespace of the VMI.
+                    Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                    It has to be set before the TPM state is created and requires persistent to be enabled.
+                  properties:
+                    name:
+                      default: ""
+                      description: |-
+                        Name of the referent.
+                        This field is effectively required, but due to backwards compatibility is
+                        allowed to be empty. Instances of this type with an empty value here are
+                        almost certainly wrong.
+                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                      type: string
+                  type: object
+                  x-kubernetes-map-type: atomic
                 persistent:
                   description: |-
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version is the version of the emulated TPM, 2.0 or 1.2.
+                    Defaults to 2.0
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -10166,6 +12504,21 @@ var CRDsValidation map[string]string = map[string]string{
                     Hide the KVM hypervisor from standard MSR based discovery.
                     Defaults to false
                   type: boolean
+                hintDedicated:
+                  description: |-
+                    HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                    paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                    Only supported on amd64.
+                    Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                  type: boolean
+                pollControl:
+                  description: |-
+                    PollControl allows the guest to take over halt polling from the host, e.g. with the
+                    cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                    The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                    Only supported on amd64.
+                    Defaults to false
+                  type: boolean
               type: object
             preferredPvspinlock:
               description: PreferredPvspinlock optionally enables the Pvspinlock feature
@@ -10239,6 +12592,11 @@ var CRDsValidation map[string]string = map[string]string{
                 type to use.
               type: string
           type: object
+        performanceProfile:
+          description: |-
+            PerformanceProfile optionally expands into a curated set of preferences tuning the VirtualMachine for a class of
+            workloads. Preferences defined explicitly take precedence over the ones of the profile.
+          type: string
         preferSpreadSocketToCoreRatio:
           description: PreferSpreadSocketToCoreRatio defines the ratio to spread vCPUs
             between cores and sockets, it defaults to 2.
@@ -10300,6 +12658,94 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinedisruptionbudget": `openAPIV3Schema:
+  description: |-
+    VirtualMachineDisruptionBudget limits the number of VirtualMachineInstances
+    of its namespace which are voluntarily disrupted at the same time, like
+    migrations for node drains or restarts for workload updates.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineDisruptionBudgetSpec is the spec for a VirtualMachineDisruptionBudget
+        resource
+      properties:
+        maxDisruptions:
+          description: |-
+            MaxDisruptions is the number of selected VirtualMachineInstances which
+            can be disrupted at the same time
+          format: int32
+          minimum: 0
+          type: integer
+        selector:
+          description: |-
+            Selector selects the VirtualMachineInstances the budget applies to by
+            their labels
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - maxDisruptions
+      - selector
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineexport": `openAPIV3Schema:
   description: VirtualMachineExport defines the operation of exporting a VM source
@@ -11604,6 +14050,24 @@ var CRDsValidation map[string]string = map[string]string{
           description: Specifies the architecture of the vm guest you are attempting
             to run. Defaults to the compiled architecture of the KubeVirt components
           type: string
+        diskSpaceLow:
+          description: |-
+            DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+            is filling up. The utilization of the guest filesystems is reported by the guest agent.
+          properties:
+            hysteresisPercentage:
+              description: |-
+                HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+                below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+              format: int32
+              type: integer
+            usedPercentage:
+              description: |-
+                UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+                condition is reported. Defaults to 90.
+              format: int32
+              type: integer
+          type: object
         dnsConfig:
           description: |-
             Specifies the DNS parameters of a pod.
@@ -11680,6 +14144,17 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                ptp:
+                  description: |-
+                    PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                    which guests can discipline their clock to with sub-microsecond accuracy.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled set to false makes sure that a preference can't add the clock.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -11774,6 +14249,12 @@ var CRDsValidation map[string]string = map[string]string{
               description: CPU allow specified the detailed CPU topology inside the
                 vmi.
               properties:
+                coreType:
+                  description: |-
+                    CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                    on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                    Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                  type: string
                 cores:
                   description: |-
                     Cores specifies the number of cores inside the vmi.
@@ -11827,6 +14308,12 @@ var CRDsValidation map[string]string = map[string]string{
                     and "host-model" to get CPU closest to the node one.
                     Defaults to host-model.
                   type: string
+                nestedVirtualization:
+                  description: |-
+                    NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                    and schedules the VMI only on nodes whose KVM module allows nested guests.
+                    Only supported on amd64.
+                  type: boolean
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
@@ -11898,9 +14385,70 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                    in-guest agents to talk to sidecars or node agents without networking.
+                  items:
+                    properties:
+                      hostPath:
+                        description: |-
+                          HostPath is a directory on the node the channel socket is created in.
+                          By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                          directory shared with the hook sidecars of the vmi.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                          The channel socket is named <name>.sock.
+                        type: string
+                      target:
+                        description: |-
+                          Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                          In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                        type: string
+                    required:
+                    - name
+                    - target
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
+                  properties:
+                    count:
+                      description: |-
+                        Count is the number of USB devices which can be redirected at the same time.
+                        Defaults to UsbClientPassthroughMaxNumberOf.
+                      format: int32
+                      type: integer
+                    filter:
+                      description: |-
+                        Filter restricts the USB devices which can be redirected by their class.
+                        It is enforced by virt-handler when proxying the redirection.
+                      properties:
+                        allow:
+                          description: |-
+                            Allow lists the device classes which can be redirected. If empty, all
+                            device classes which are not denied can be redirected.
+                          items:
+                            description: USBDeviceClass is the class of a USB device
+                              or of one of its interfaces.
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
+                        deny:
+                          description: |-
+                            Deny lists the device classes which can not be redirected. Deny takes
+                            precedence over allow.
+                          items:
+                            description: USBDeviceClass is the class of a USB device
+                              or of one of its interfaces.
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
+                      type: object
                   type: object
                 disableHotplug:
                   description: DisableHotplug disabled the ability to hotplug disks.
@@ -11988,7 +14536,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -12000,6 +14548,11 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          virtioTransitional:
+                            description: |-
+                              If specified, overrides useVirtioTransitional for this disk.
+                              Only applies to the virtio bus.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -12010,6 +14563,82 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: IOTune throttles the IO of the disk. The limits
+                          are enforced by QEMU.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst in seconds.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec limits the read throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec limits the read IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec limits the total throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec limits the total IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec limits the write throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec limits the write IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12031,10 +14660,34 @@ var CRDsValidation map[string]string = map[string]string{
                       name:
                         description: Name is the device name
                         type: string
+                      queues:
+                        description: |-
+                          Queues is the number of queues of the disk. If specified, it overrides the
+                          number of queues derived from blockMultiQueue for this disk.
+                          Only supported on the virtio bus.
+                        format: int64
+                        type: integer
+                      rerrorPolicy:
+                        description: |-
+                          If specified, it changes the error policy for read errors of the disk.
+                          Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                        type: string
+                      scsiController:
+                        description: |-
+                          SCSIController is the name of the scsi controller the disk is attached to.
+                          Only supported on the scsi bus. Defaults to the first scsi controller.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
                         type: string
+                      serialPolicy:
+                        description: |-
+                          SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                          Supported values are: None, VolumeNameHash.
+                          VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                          when disks are reordered. Defaults to None.
+                        type: string
                       shareable:
                         description: If specified the disk is made sharable and multiple
                           write from different VMs are permitted
@@ -12043,6 +14696,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, disk address and its tag will be
                           provided to the guest via config drive metadata
                         type: string
+                      wwn:
+                        description: |-
+                          WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                          Only supported on the scsi bus.
+                        type: string
                     required:
                     - name
                     type: object
@@ -12062,6 +14720,72 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          idMap:
+                            description: |-
+                              IDMap maps the user and group IDs of the guest to the IDs owning the
+                              files of the shared volume.
+                            properties:
+                              gid:
+                                description: GID maps ranges of group IDs.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of IDs in the range.
+                                      format: int32
+                                      type: integer
+                                    start:
+                                      description: Start is the first ID of the range in the guest.
+                                      format: int32
+                                      type: integer
+                                    target:
+                                      description: Target is the first ID of the range on the shared volume.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - count
+                                  - start
+                                  - target
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              uid:
+                                description: UID maps ranges of user IDs.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of IDs in the range.
+                                      format: int32
+                                      type: integer
+                                    start:
+                                      description: Start is the first ID of the range in the guest.
+                                      format: int32
+                                      type: integer
+                                    target:
+                                      description: Target is the first ID of the range on the shared volume.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - count
+                                  - start
+                                  - target
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of the virtqueue of the device.
+                              Must be a power of two not larger than 1024. Defaults to 1024.
+                            format: int32
+                            type: integer
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                              requests of the guest. 0 handles them in the thread of the queue.
+                              Defaults to the default of virtiofsd.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -12122,6 +14846,18 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: |-
+                    Graphics describes the configuration of the default graphics device.
+                    Not relevant if autoattachGraphicsDevice is disabled.
+                  properties:
+                    keymap:
+                      description: |-
+                        Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                        It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                        Defaults to en-us.
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -12151,6 +14887,21 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                hostSensors:
+                  description: HostSensors creates a virtio serial for exposing temperature
+                    and power readings of the node to the vmi.
+                  properties:
+                    types:
+                      description: |-
+                        Types are the kinds of sensors exposed to the vmi.
+                        Defaults to all of them.
+                      items:
+                        description: HostSensorType is a kind of hardware monitoring
+                          sensor.
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 inputs:
                   description: Inputs describe input devices
                   items:
@@ -12166,7 +14917,9 @@ var CRDsValidation map[string]string = map[string]string{
                       type:
                         description: |-
                           Type indicated the type of input device.
-                          Supported values: tablet.
+                          A tablet is an absolute pointer, a mouse a relative one.
+                          Multitouch devices are only supported on the virtio bus.
+                          Supported values: tablet, keyboard, mouse, multitouch.
                         type: string
                     required:
                     - name
@@ -12184,6 +14937,13 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      backend:
+                        description: |-
+                          Backend selects the host side backend of the interface.
+                          One of: vhost, userspace.
+                          Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                          Only applies to the virtio model.
+                        type: string
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -12275,6 +15035,23 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      offloads:
+                        description: |-
+                          Offloads toggles the offloads of the interface on the tap path.
+                          Only applies to the virtio model.
+                        properties:
+                          checksum:
+                            description: Checksum toggles the checksum offload.
+                            type: boolean
+                          gso:
+                            description: GSO toggles the generic segmentation offload
+                              of the host.
+                            type: boolean
+                          tso:
+                            description: TSO toggles the TCP segmentation offload,
+                              for IPv4 and IPv6.
+                            type: boolean
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -12316,6 +15093,13 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      queues:
+                        description: |-
+                          Queues is the number of queues of the interface. If specified, it overrides the
+                          number of queues derived from networkInterfaceMultiqueue for this interface.
+                          Only applies to the virtio model.
+                        format: int64
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -12324,6 +15108,25 @@ var CRDsValidation map[string]string = map[string]string{
                       sriov:
                         description: InterfaceSRIOV connects to a given network by
                           passing-through an SR-IOV PCI device via vfio.
+                        properties:
+                          failover:
+                            description: |-
+                              Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                              MAC address and takes over the traffic while the device is unplugged for live migration.
+                            properties:
+                              standbyInterface:
+                                description: |-
+                                  StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                  SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                  It is set to the generated standby interface when StandbyNetworkName is used.
+                                type: string
+                              standbyNetworkName:
+                                description: |-
+                                  StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                  when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                  interface, which is generated if it is not set.
+                                type: string
+                            type: object
                         type: object
                       state:
                         description: |-
@@ -12338,11 +15141,42 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      virtioTransitional:
+                        description: |-
+                          If specified, overrides useVirtioTransitional for this interface.
+                          Only applies to the virtio model.
+                        type: boolean
                     required:
                     - name
                     type: object
                   maxItems: 256
                   type: array
+                iommu:
+                  description: |-
+                    IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                    DPDK or to assign devices to nested guests.
+                  properties:
+                    cachingMode:
+                      description: |-
+                        CachingMode makes the guest report every mapping change, which is required
+                        to assign devices to nested guests. Only supported by the intel model.
+                      type: boolean
+                    eim:
+                      description: |-
+                        EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                        Requires interruptRemapping.
+                      type: boolean
+                    interruptRemapping:
+                      description: |-
+                        InterruptRemapping enables remapping of the interrupts of assigned devices.
+                        Only supported by the intel model.
+                      type: boolean
+                    model:
+                      description: |-
+                        Model is the IOMMU model. One of: intel, virtio.
+                        Defaults to intel on amd64 and to virtio on arm64.
+                      type: string
+                  type: object
                 logSerialConsole:
                   description: |-
                     Whether to log the auto-attached default serial console or not.
@@ -12350,6 +15184,10 @@ var CRDsValidation map[string]string = map[string]string{
                     Not relevant if autoattachSerialConsole is disabled.
                     Defaults to cluster wide setting on VirtualMachineOptions.
                   type: boolean
+                memBalloonVirtioTransitional:
+                  description: If specified, overrides useVirtioTransitional for the Memory balloon
+                    device.
+                  type: boolean
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -12373,6 +15211,154 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                scsiControllers:
+                  description: |-
+                    SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                    scsi bus are attached to the controller named in their scsiController field,
+                    or to the first controller if none is named. Spreading busy disks over several
+                    controllers with dedicated IO threads improves storage throughput.
+                    Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                  items:
+                    properties:
+                      dedicatedIOThread:
+                        description: |-
+                          DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                          shared by all disks attached to it. Defaults to false.
+                        type: boolean
+                      name:
+                        description: Name of the controller, referenced by the scsiController
+                          field of disks.
+                        type: string
+                      queues:
+                        description: |-
+                          Queues is the number of request queues of the controller.
+                          Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                        format: int32
+                        type: integer
+                    required:
+                    - name
+                    type: object
+                  maxItems: 32
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                serials:
+                  description: |-
+                    Serials describe additional serial ports, e.g. for guests which log
+                    their kernel output to ttyS1. Each port can be connected to with
+                    virtctl console --serial.
+                  items:
+                    properties:
+                      name:
+                        description: Name of the serial port.
+                        type: string
+                      port:
+                        description: |-
+                          Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                          Port 0 is taken by the default serial console, unless
+                          autoattachSerialConsole is disabled.
+                        format: int32
+                        type: integer
+                    required:
+                    - name
+                    - port
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                sgx:
+                  description: SGX exposes an Intel SGX enclave page cache (EPC) section
+                    to the vmi, allowing it to run SGX enclaves.
+                  properties:
+                    epcSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                        It is taken from the EPC of the node, which is not part of its memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - epcSize
+                  type: object
+                sharedMemoryDevices:
+                  description: |-
+                    SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                    co-located VMs or pods to exchange data through them.
+                  items:
+                    properties:
+                      hostPath:
+                        description: |-
+                          HostPath is a file on the node backing an ivshmem-plain region, shared with
+                          every VM and pod on the node mapping the same file. It is created if it
+                          does not exist and has to be writable by the qemu user.
+                        type: string
+                      model:
+                        description: |-
+                          Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                          Defaults to ivshmem-plain.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                          Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                          virt-launcher pod and can be shared with its sidecar containers.
+                        type: string
+                      serverSocketPath:
+                        description: |-
+                          ServerSocketPath is the path on the node of the ivshmem-server socket an
+                          ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                        type: string
+                      size:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          Size of the shared memory region. Must be a power of two.
+                          Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                          server owns the region.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                smartcard:
+                  description: Smartcard attaches a CCID smartcard reader to the vmi.
+                  properties:
+                    hostCertificates:
+                      description: |-
+                        HostCertificates emulates a smartcard with certificates of an NSS
+                        database stored in a Secret.
+                      properties:
+                        certificates:
+                          description: Certificates are the nicknames of the three
+                            certificates of the emulated card.
+                          items:
+                            type: string
+                          maxItems: 3
+                          minItems: 3
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        secretName:
+                          description: |-
+                            SecretName is the name of the Secret holding the files of the NSS database,
+                            for example cert9.db, key4.db and pkcs11.txt.
+                          type: string
+                      required:
+                      - certificates
+                      - secretName
+                      type: object
+                    passthrough:
+                      description: |-
+                        Passthrough forwards the smartcard of a client, which connects to the
+                        smartcard subresource of the vmi.
+                      type: object
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -12396,11 +15382,33 @@ var CRDsValidation map[string]string = map[string]string{
                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                         Defaults to True
                       type: boolean
+                    encryptionSecretRef:
+                      description: |-
+                        EncryptionSecretRef references a Secret in the namespace of the VMI.
+                        Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                        It has to be set before the TPM state is created and requires persistent to be enabled.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
                     persistent:
                       description: |-
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version is the version of the emulated TPM, 2.0 or 1.2.
+                        Defaults to 2.0
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -12412,6 +15420,24 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Video describes the video device configuration for
                     the vmi.
                   properties:
+                    resolution:
+                      description: |-
+                        Resolution is the preferred resolution advertised to the guest through EDID.
+                        Graphical consoles come up at this resolution if the guest display driver honors it.
+                        Supported by the vga, virtio and bochs video types.
+                      properties:
+                        height:
+                          description: Height in pixels.
+                          format: int32
+                          type: integer
+                        width:
+                          description: Width in pixels.
+                          format: int32
+                          type: integer
+                      required:
+                      - height
+                      - width
+                      type: object
                     type:
                       description: |-
                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -12427,7 +15453,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                             Defaults to reset.
                           type: string
                       type: object
@@ -12436,17 +15462,39 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                             Defaults to reset.
                           type: string
                       type: object
                     name:
                       description: Name of the watchdog.
                       type: string
+                    remediation:
+                      description: |-
+                        Remediation is carried out by KubeVirt once the watchdog expired,
+                        in addition to the action taken by the watchdog device itself.
+                      properties:
+                        memoryDumpClaimName:
+                          description: |-
+                            MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                            Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                          type: string
+                        type:
+                          description: 'Type of the remediation. One of: Reset, RestartVM,
+                            MemoryDump.'
+                          type: string
+                      required:
+                      - type
+                      type: object
                   required:
                   - name
                   type: object
               type: object
+            emulatorBundle:
+              description: |-
+                EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+              type: string
             features:
               description: Features like acpi, apic, hyperv, smm.
               properties:
@@ -12668,6 +15716,21 @@ var CRDsValidation map[string]string = map[string]string{
                         Hide the KVM hypervisor from standard MSR based discovery.
                         Defaults to false
                       type: boolean
+                    hintDedicated:
+                      description: |-
+                        HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                        paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                        Only supported on amd64.
+                        Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                      type: boolean
+                    pollControl:
+                      description: |-
+                        PollControl allows the guest to take over halt polling from the host, e.g. with the
+                        cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                        The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                        Only supported on amd64.
+                        Defaults to false
+                      type: boolean
                   type: object
                 pvspinlock:
                   description: |-
@@ -12711,7 +15774,9 @@ var CRDsValidation map[string]string = map[string]string{
                       type: string
                   type: object
                 bootloader:
-                  description: Settings to control the bootloader that is used.
+                  description: |-
+                    Settings to control the bootloader that is used.
+                    Defaulted from the firmware profile, if set.
                   properties:
                     bios:
                       description: If set (default), BIOS will be used.
@@ -12776,6 +15841,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Arguments to be passed to the kernel at boot time
                       type: string
                   type: object
+                profile:
+                  description: |-
+                    Profile is the name of a firmware profile configured in the KubeVirt CR.
+                    The profile selects the bootloader, and may provide a custom firmware build
+                    for the architecture of the VMI.
+                  type: string
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
@@ -12809,6 +15880,30 @@ var CRDsValidation map[string]string = map[string]string{
                     attestation:
                       description: If specified, run the attestation process for a
                         vmi.
+                      properties:
+                        keyBroker:
+                          description: |-
+                            If specified, the launch secret is released by the key broker once it
+                            verified the launch measurement, and the VMI is unpaused afterwards.
+                            Otherwise the launch secret has to be injected through the
+                            sev/injectlaunchsecret subresource.
+                          properties:
+                            protocol:
+                              description: |-
+                                Protocol spoken with the key broker.
+                                Defaults to kbs.
+                              type: string
+                            resourcePath:
+                              description: Path of the resource holding the launch
+                                secret, e.g. default/sev/launch-secret.
+                              type: string
+                            url:
+                              description: URL of the key broker service.
+                              type: string
+                          required:
+                          - resourcePath
+                          - url
+                          type: object
                       type: object
                     dhCert:
                       description: Base64 encoded guest owner's Diffie-Hellman key.
@@ -12833,6 +15928,32 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 tdx:
                   description: Intel Trust Domain Extensions (TDX).
+                  properties:
+                    attestation:
+                      description: If specified, the guest can request quotes from the quote generation
+                        service of the node.
+                      type: object
+                    mrConfigId:
+                      description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                      type: string
+                    mrOwner:
+                      description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                      type: string
+                    mrOwnerConfig:
+                      description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                        (MROWNERCONFIG).
+                      type: string
+                    policy:
+                      description: |-
+                        Guest policy flags as defined in the Intel TDX module specification.
+                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                      properties:
+                        septVEDisable:
+                          description: |-
+                            Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                            Defaults to false.
+                          type: boolean
+                      type: object
                   type: object
               type: object
             machine:
@@ -12842,9 +15963,27 @@ var CRDsValidation map[string]string = map[string]string{
                   description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
                   type: string
               type: object
+            machineOptions:
+              description: |-
+                MachineOptions allows to turn off legacy devices of the machine,
+                reducing the attack surface of hardened guests.
+              properties:
+                ps2:
+                  description: |-
+                    PS2 controls the legacy PS/2 (i8042) controller.
+                    Defaults to true.
+                  type: boolean
+              type: object
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                dimmSlots:
+                  description: |-
+                    DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                    is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                    DIMMs can not be hot unplugged.
+                  format: int32
+                  type: integer
                 guest:
                   anyOf:
                   - type: integer
@@ -12907,6 +16046,13 @@ var CRDsValidation map[string]string = map[string]string{
                     Valid resource keys are "memory" and "cpu".
                   type: object
               type: object
+            useEmulation:
+              description: |-
+                UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                The vmi does not request /dev/kvm, can run on any node and performs
+                significantly slower than a hardware accelerated guest.
+                Intended for CI and cross-architecture testing.
+              type: boolean
           required:
           - devices
           type: object
@@ -12919,11 +16065,72 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        guestSecrets:
+          description: |-
+            GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+            without writing them to any disk image.
+          items:
+            description: GuestSecret delivers the value of a key of a Secret to the guest.
+            properties:
+              key:
+                description: Key of the Secret whose value is delivered.
+                type: string
+              name:
+                description: Name of the guest secret, unique within the VMI. It names the
+                  fw_cfg blob.
+                type: string
+              secretName:
+                description: SecretName is the name of the Secret in the namespace of the VMI.
+                type: string
+              target:
+                description: |-
+                  Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+                  the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+                  secret is delivered on the next start.
+                  Defaults to FWCfg.
+                enum:
+                - FWCfg
+                type: string
+            required:
+            - key
+            - name
+            - secretName
+            type: object
+          maxItems: 32
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
         hostname:
           description: |-
             Specifies the hostname of the vmi
             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
           type: string
+        launcherIsolation:
+          description: |-
+            LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+            the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+            Defaults to Container.
+          enum:
+          - Container
+          - MicroVM
+          type: string
+        launcherMetadata:
+          description: |-
+            LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+            The keys have to be allowed in launcherPodConfiguration.
+          properties:
+            annotations:
+              additionalProperties:
+                type: string
+              description: Annotations to add to the virt-launcher pod
+              type: object
+            labels:
+              additionalProperties:
+                type: string
+              description: Labels to add to the virt-launcher pod
+              type: object
+          type: object
         livenessProbe:
           description: |-
             Periodic probe of VirtualMachineInstance liveness.
@@ -12955,6 +16162,22 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentExec:
+              description: |-
+                GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                The probe succeeds if the command exits with status 0.
+              properties:
+                command:
+                  description: |-
+                    Command is the command line to execute inside the guest. The first element is the
+                    executable, the remaining ones are passed as arguments. It is not run in a shell.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - command
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -13058,6 +16281,21 @@ var CRDsValidation map[string]string = map[string]string{
                 More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
               format: int32
               type: integer
+            vsockHTTP:
+              description: |-
+                VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+              properties:
+                path:
+                  description: Path to access on the HTTP server. Defaults to "/".
+                  type: string
+                port:
+                  description: Port is the VSOCK port the guest service listens on.
+                  format: int32
+                  type: integer
+              required:
+              - port
+              type: object
           type: object
         networks:
           description: List of networks that can be attached to a vm's virtual interface.
@@ -13152,6 +16390,22 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            guestAgentExec:
+              description: |-
+                GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                The probe succeeds if the command exits with status 0.
+              properties:
+                command:
+                  description: |-
+                    Command is the command line to execute inside the guest. The first element is the
+                    executable, the remaining ones are passed as arguments. It is not run in a shell.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              required:
+              - command
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -13255,6 +16509,21 @@ var CRDsValidation map[string]string = map[string]string{
                 More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
               format: int32
               type: integer
+            vsockHTTP:
+              description: |-
+                VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+              properties:
+                path:
+                  description: Path to access on the HTTP server. Defaults to "/".
+                  type: string
+                port:
+                  description: Port is the VSOCK port the guest service listens on.
+                  format: int32
+                  type: integer
+              required:
+              - port
+              type: object
           type: object
         resourceClaims:
           description: |-
@@ -13262,6 +16531,11 @@ var CRDsValidation map[string]string = map[string]string{
             and reserved before the VMI, hence virt-launcher pod is allowed to start. The resources
             will be made available to the domain which consumes them
             by name.
+        runtimeClassName:
+          description: |-
+            If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+            cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+          type: string
 
             This is an alpha field and requires enabling the
             DynamicResourceAllocation feature gate in kubernetes
@@ -13703,6 +16977,10 @@ var CRDsValidation map[string]string = map[string]string{
                   ConfigMapSource represents a reference to a ConfigMap in the same namespace.
                   More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
                 properties:
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
                   name:
                     default: ""
                     description: |-
@@ -13923,6 +17201,9 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  format:
+                    description: Format of the memory dump, Core by default
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13969,6 +17250,10 @@ var CRDsValidation map[string]string = map[string]string{
                   SecretVolumeSource represents a reference to a secret data in the same namespace.
                   More info: https://kubernetes.io/docs/concepts/configuration/secret/
                 properties:
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
                   optional:
                     description: Specify whether the Secret or it's keys must be defined
                     type: boolean
@@ -13990,6 +17275,10 @@ var CRDsValidation map[string]string = map[string]string{
                   There can only be one volume of this type!
                   More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
                 properties:
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
                   serviceAccountName:
                     description: |-
                       Name of the service account in the pod's namespace to use.
@@ -14234,6 +17523,81 @@ var CRDsValidation map[string]string = map[string]string{
               type: array
               x-kubernetes-list-type: atomic
           type: object
+        deviceStatuses:
+          description: |-
+            DeviceStatuses reflects the libvirt state of every disk and interface
+            of the VirtualMachineInstance
+          items:
+            description: VirtualMachineInstanceDeviceStatus reflects the libvirt state of a
+              device
+            properties:
+              attached:
+                description: Attached indicates whether the device is present in the running
+                  domain
+                type: boolean
+              hotplugPending:
+                description: HotplugPending indicates that the device was requested but is not
+                  attached yet
+                type: boolean
+              ioErrors:
+                description: IOErrors is the number of I/O errors reported by the hypervisor
+                  for a disk
+                format: int64
+                type: integer
+              lastIOError:
+                description: LastIOError is the reason of the last I/O error reported for a
+                  disk
+                type: string
+              linkState:
+                description: LinkState is the link state of an interface
+                type: string
+              name:
+                description: Name of the device as specified in spec.domain.devices.disks or
+                  spec.domain.devices.interfaces
+                type: string
+              pciAddress:
+                description: PCIAddress is the guest PCI address assigned to the device,
+                  e.g. 0000:01:00.0
+                type: string
+              type:
+                description: Type is the kind of the device
+                type: string
+            required:
+            - attached
+            - name
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        diskExpansionRequests:
+          description: DiskExpansionRequests lists the disk growths requested by the
+            guest over VSOCK, one per volume.
+          items:
+            description: DiskExpansionRequest is a request of the guest to grow the PVC
+              backing one of its volumes
+            properties:
+              message:
+                description: Message explains why the request was rejected
+                type: string
+              name:
+                description: Name of the volume to expand
+                type: string
+              phase:
+                description: Phase is the progress of the request
+                type: string
+              size:
+                anyOf:
+                - type: integer
+                - type: string
+                description: Size is the requested size of the PVC
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - name
+            - size
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         evacuationNodeName:
           description: |-
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
@@ -14245,6 +17609,38 @@ var CRDsValidation map[string]string = map[string]string{
             It will be set to "frozen" if the request was made, or unset otherwise.
             This does not reflect the actual state of the guest filesystem.
           type: string
+        guestFilesystems:
+          description: |-
+            GuestFilesystems reports the utilization of the guest filesystems as reported by the guest agent.
+            Changes of the utilization are only reflected once they amount to one percent of the capacity.
+          items:
+            description: VirtualMachineInstanceGuestFilesystem reports the utilization of
+              a guest filesystem
+            properties:
+              diskName:
+                description: DiskName is the name of the guest device holding the filesystem
+                type: string
+              fileSystemType:
+                description: FileSystemType is the type of the filesystem, e.g. ext4
+                type: string
+              mountPoint:
+                description: MountPoint of the filesystem in the guest
+                type: string
+              totalBytes:
+                description: TotalBytes is the capacity of the filesystem
+                format: int64
+                type: integer
+              usedBytes:
+                description: UsedBytes is the used capacity of the filesystem
+                format: int64
+                type: integer
+            required:
+            - mountPoint
+            - totalBytes
+            - usedBytes
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -14277,6 +17673,10 @@ var CRDsValidation map[string]string = map[string]string{
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              backend:
+                description: 'Backend reports the host side backend used by the interface.
+                  values: vhost, userspace.'
+                type: string
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -14806,6 +18206,30 @@ var CRDsValidation map[string]string = map[string]string{
           description: NodeName is the name where the VirtualMachineInstance is currently
             running.
           type: string
+        pauseStatus:
+          description: |-
+            PauseStatus reports what paused the VirtualMachineInstance and whether
+            it is going to be unpaused automatically. It is only set while the
+            VirtualMachineInstance is paused.
+          properties:
+            autoUnpauseTimestamp:
+              description: |-
+                AutoUnpauseTimestamp is the time at which the VirtualMachineInstance is
+                going to be unpaused automatically
+              format: date-time
+              nullable: true
+              type: string
+            pauseTimestamp:
+              description: PauseTimestamp is the time the VirtualMachineInstance was paused
+              format: date-time
+              nullable: true
+              type: string
+            reason:
+              description: Reason describes what paused the VirtualMachineInstance
+              type: string
+          required:
+          - reason
+          type: object
         phase:
           description: Phase is the status of the VirtualMachineInstance in kubernetes
             world. It is not the VirtualMachineInstance status, but partially correlates
@@ -14876,6 +18300,10 @@ var CRDsValidation map[string]string = map[string]string{
                       artifacts inside the containerdisk
                     format: int32
                     type: integer
+                  imageID:
+                    description: ImageID is the image of the containerdisk pinned
+                      to the digest pulled by the container runtime
+                    type: string
                 type: object
               hotplugVolume:
                 description: If the volume is hotplug, this will contain the hotplug
@@ -15477,6 +18905,17 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                ptp:
+                  description: |-
+                    PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                    which guests can discipline their clock to with sub-microsecond accuracy.
+                  properties:
+                    enabled:
+                      description: |-
+                        Enabled set to false makes sure that a preference can't add the clock.
+                        Defaults to true.
+                      type: boolean
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -15571,6 +19010,12 @@ var CRDsValidation map[string]string = map[string]string{
               description: CPU allow specified the detailed CPU topology inside the
                 vmi.
               properties:
+                coreType:
+                  description: |-
+                    CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                    on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                    Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                  type: string
                 cores:
                   description: |-
                     Cores specifies the number of cores inside the vmi.
@@ -15624,6 +19069,12 @@ var CRDsValidation map[string]string = map[string]string{
                     and "host-model" to get CPU closest to the node one.
                     Defaults to host-model.
                   type: string
+                nestedVirtualization:
+                  description: |-
+                    NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                    and schedules the VMI only on nodes whose KVM module allows nested guests.
+                    Only supported on amd64.
+                  type: boolean
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
@@ -15695,9 +19146,70 @@ var CRDsValidation map[string]string = map[string]string{
                     Whether or not to enable virtio multi-queue for block devices.
                     Defaults to false.
                   type: boolean
+                channels:
+                  description: |-
+                    Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                    in-guest agents to talk to sidecars or node agents without networking.
+                  items:
+                    properties:
+                      hostPath:
+                        description: |-
+                          HostPath is a directory on the node the channel socket is created in.
+                          By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                          directory shared with the hook sidecars of the vmi.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                          The channel socket is named <name>.sock.
+                        type: string
+                      target:
+                        description: |-
+                          Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                          In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                        type: string
+                    required:
+                    - name
+                    - target
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 clientPassthrough:
                   description: To configure and access client devices such as redirecting
                     USB
+                  properties:
+                    count:
+                      description: |-
+                        Count is the number of USB devices which can be redirected at the same time.
+                        Defaults to UsbClientPassthroughMaxNumberOf.
+                      format: int32
+                      type: integer
+                    filter:
+                      description: |-
+                        Filter restricts the USB devices which can be redirected by their class.
+                        It is enforced by virt-handler when proxying the redirection.
+                      properties:
+                        allow:
+                          description: |-
+                            Allow lists the device classes which can be redirected. If empty, all
+                            device classes which are not denied can be redirected.
+                          items:
+                            description: USBDeviceClass is the class of a USB device
+                              or of one of its interfaces.
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
+                        deny:
+                          description: |-
+                            Deny lists the device classes which can not be redirected. Deny takes
+                            precedence over allow.
+                          items:
+                            description: USBDeviceClass is the class of a USB device
+                              or of one of its interfaces.
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: set
+                      type: object
                   type: object
                 disableHotplug:
                   description: DisableHotplug disabled the ability to hotplug disks.
@@ -15785,7 +19297,7 @@ var CRDsValidation map[string]string = map[string]string{
                           bus:
                             description: |-
                               Bus indicates the type of disk device to emulate.
-                              supported values: virtio, sata, scsi, usb.
+                              supported values: virtio, sata, scsi, usb, nvme.
                             type: string
                           pciAddress:
                             description: 'If specified, the virtual disk will be placed
@@ -15797,6 +19309,11 @@ var CRDsValidation map[string]string = map[string]string{
                               ReadOnly.
                               Defaults to false.
                             type: boolean
+                          virtioTransitional:
+                            description: |-
+                              If specified, overrides useVirtioTransitional for this disk.
+                              Only applies to the virtio bus.
+                            type: boolean
                         type: object
                       errorPolicy:
                         description: If specified, it can change the default error
@@ -15807,6 +19324,82 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: IOTune throttles the IO of the disk. The limits
+                          are enforced by QEMU.
+                        properties:
+                          burst:
+                            description: Burst allows the disk to exceed the limits
+                              for a short time.
+                            properties:
+                              lengthSeconds:
+                                description: |-
+                                  LengthSeconds is the maximum duration of a burst in seconds.
+                                  Defaults to 1.
+                                format: int64
+                                type: integer
+                              readBytesSec:
+                                description: ReadBytesSec limits the read throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              readIOPSSec:
+                                description: ReadIOPSSec limits the read IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              totalBytesSec:
+                                description: TotalBytesSec limits the total throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              totalIOPSSec:
+                                description: TotalIOPSSec limits the total IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                              writeBytesSec:
+                                description: WriteBytesSec limits the write throughput
+                                  in bytes per second during a burst.
+                                format: int64
+                                type: integer
+                              writeIOPSSec:
+                                description: WriteIOPSSec limits the write IO operations
+                                  per second during a burst.
+                                format: int64
+                                type: integer
+                            type: object
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput in
+                              bytes per second.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -15828,10 +19421,34 @@ var CRDsValidation map[string]string = map[string]string{
                       name:
                         description: Name is the device name
                         type: string
+                      queues:
+                        description: |-
+                          Queues is the number of queues of the disk. If specified, it overrides the
+                          number of queues derived from blockMultiQueue for this disk.
+                          Only supported on the virtio bus.
+                        format: int64
+                        type: integer
+                      rerrorPolicy:
+                        description: |-
+                          If specified, it changes the error policy for read errors of the disk.
+                          Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                        type: string
+                      scsiController:
+                        description: |-
+                          SCSIController is the name of the scsi controller the disk is attached to.
+                          Only supported on the scsi bus. Defaults to the first scsi controller.
+                        type: string
                       serial:
                         description: Serial provides the ability to specify a serial
                           number for the disk device.
                         type: string
+                      serialPolicy:
+                        description: |-
+                          SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                          Supported values are: None, VolumeNameHash.
+                          VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                          when disks are reordered. Defaults to None.
+                        type: string
                       shareable:
                         description: If specified the disk is made sharable and multiple
                           write from different VMs are permitted
@@ -15840,6 +19457,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, disk address and its tag will be
                           provided to the guest via config drive metadata
                         type: string
+                      wwn:
+                        description: |-
+                          WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                          Only supported on the scsi bus.
+                        type: string
                     required:
                     - name
                     type: object
@@ -15859,6 +19481,72 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       virtiofs:
                         description: Virtiofs is supported
+                        properties:
+                          idMap:
+                            description: |-
+                              IDMap maps the user and group IDs of the guest to the IDs owning the
+                              files of the shared volume.
+                            properties:
+                              gid:
+                                description: GID maps ranges of group IDs.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of IDs in the range.
+                                      format: int32
+                                      type: integer
+                                    start:
+                                      description: Start is the first ID of the range in the guest.
+                                      format: int32
+                                      type: integer
+                                    target:
+                                      description: Target is the first ID of the range on the shared volume.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - count
+                                  - start
+                                  - target
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              uid:
+                                description: UID maps ranges of user IDs.
+                                items:
+                                  properties:
+                                    count:
+                                      description: Count is the number of IDs in the range.
+                                      format: int32
+                                      type: integer
+                                    start:
+                                      description: Start is the first ID of the range in the guest.
+                                      format: int32
+                                      type: integer
+                                    target:
+                                      description: Target is the first ID of the range on the shared volume.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - count
+                                  - start
+                                  - target
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          queueSize:
+                            description: |-
+                              QueueSize is the size of the virtqueue of the device.
+                              Must be a power of two not larger than 1024. Defaults to 1024.
+                            format: int32
+                            type: integer
+                          threadPoolSize:
+                            description: |-
+                              ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                              requests of the guest. 0 handles them in the thread of the queue.
+                              Defaults to the default of virtiofsd.
+                            format: int32
+                            type: integer
                         type: object
                     required:
                     - name
@@ -15919,6 +19607,18 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: |-
+                    Graphics describes the configuration of the default graphics device.
+                    Not relevant if autoattachGraphicsDevice is disabled.
+                  properties:
+                    keymap:
+                      description: |-
+                        Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                        It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                        Defaults to en-us.
+                      type: string
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -15948,6 +19648,21 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                hostSensors:
+                  description: HostSensors creates a virtio serial for exposing temperature
+                    and power readings of the node to the vmi.
+                  properties:
+                    types:
+                      description: |-
+                        Types are the kinds of sensors exposed to the vmi.
+                        Defaults to all of them.
+                      items:
+                        description: HostSensorType is a kind of hardware monitoring
+                          sensor.
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: set
+                  type: object
                 inputs:
                   description: Inputs describe input devices
                   items:
@@ -15963,7 +19678,9 @@ var CRDsValidation map[string]string = map[string]string{
                       type:
                         description: |-
                           Type indicated the type of input device.
-                          Supported values: tablet.
+                          A tablet is an absolute pointer, a mouse a relative one.
+                          Multitouch devices are only supported on the virtio bus.
+                          Supported values: tablet, keyboard, mouse, multitouch.
                         type: string
                     required:
                     - name
@@ -15981,6 +19698,13 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      backend:
+                        description: |-
+                          Backend selects the host side backend of the interface.
+                          One of: vhost, userspace.
+                          Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                          Only applies to the virtio model.
+                        type: string
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -16072,6 +19796,23 @@ var CRDsValidation map[string]string = map[string]string{
                           Logical name of the interface as well as a reference to the associated networks.
                           Must match the Name of a Network.
                         type: string
+                      offloads:
+                        description: |-
+                          Offloads toggles the offloads of the interface on the tap path.
+                          Only applies to the virtio model.
+                        properties:
+                          checksum:
+                            description: Checksum toggles the checksum offload.
+                            type: boolean
+                          gso:
+                            description: GSO toggles the generic segmentation offload
+                              of the host.
+                            type: boolean
+                          tso:
+                            description: TSO toggles the TCP segmentation offload,
+                              for IPv4 and IPv6.
+                            type: boolean
+                        type: object
                       passt:
                         description: |-
                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -16113,6 +19854,13 @@ var CRDsValidation map[string]string = map[string]string{
                           - port
                           type: object
                         type: array
+                      queues:
+                        description: |-
+                          Queues is the number of queues of the interface. If specified, it overrides the
+                          number of queues derived from networkInterfaceMultiqueue for this interface.
+                          Only applies to the virtio model.
+                        format: int64
+                        type: integer
                       slirp:
                         description: |-
                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -16121,6 +19869,25 @@ var CRDsValidation map[string]string = map[string]string{
                       sriov:
                         description: InterfaceSRIOV connects to a given network by
                           passing-through an SR-IOV PCI device via vfio.
+                        properties:
+                          failover:
+                            description: |-
+                              Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                              MAC address and takes over the traffic while the device is unplugged for live migration.
+                            properties:
+                              standbyInterface:
+                                description: |-
+                                  StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                  SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                  It is set to the generated standby interface when StandbyNetworkName is used.
+                                type: string
+                              standbyNetworkName:
+                                description: |-
+                                  StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                  when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                  interface, which is generated if it is not set.
+                                type: string
+                            type: object
                         type: object
                       state:
                         description: |-
@@ -16135,11 +19902,42 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      virtioTransitional:
+                        description: |-
+                          If specified, overrides useVirtioTransitional for this interface.
+                          Only applies to the virtio model.
+                        type: boolean
                     required:
                     - name
                     type: object
                   maxItems: 256
                   type: array
+                iommu:
+                  description: |-
+                    IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                    DPDK or to assign devices to nested guests.
+                  properties:
+                    cachingMode:
+                      description: |-
+                        CachingMode makes the guest report every mapping change, which is required
+                        to assign devices to nested guests. Only supported by the intel model.
+                      type: boolean
+                    eim:
+                      description: |-
+                        EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                        Requires interruptRemapping.
+                      type: boolean
+                    interruptRemapping:
+                      description: |-
+                        InterruptRemapping enables remapping of the interrupts of assigned devices.
+                        Only supported by the intel model.
+                      type: boolean
+                    model:
+                      description: |-
+                        Model is the IOMMU model. One of: intel, virtio.
+                        Defaults to intel on amd64 and to virtio on arm64.
+                      type: string
+                  type: object
                 logSerialConsole:
                   description: |-
                     Whether to log the auto-attached default serial console or not.
@@ -16147,6 +19945,10 @@ var CRDsValidation map[string]string = map[string]string{
                     Not relevant if autoattachSerialConsole is disabled.
                     Defaults to cluster wide setting on VirtualMachineOptions.
                   type: boolean
+                memBalloonVirtioTransitional:
+                  description: If specified, overrides useVirtioTransitional for the Memory balloon
+                    device.
+                  type: boolean
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -16170,6 +19972,154 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                scsiControllers:
+                  description: |-
+                    SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                    scsi bus are attached to the controller named in their scsiController field,
+                    or to the first controller if none is named. Spreading busy disks over several
+                    controllers with dedicated IO threads improves storage throughput.
+                    Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                  items:
+                    properties:
+                      dedicatedIOThread:
+                        description: |-
+                          DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                          shared by all disks attached to it. Defaults to false.
+                        type: boolean
+                      name:
+                        description: Name of the controller, referenced by the scsiController
+                          field of disks.
+                        type: string
+                      queues:
+                        description: |-
+                          Queues is the number of request queues of the controller.
+                          Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                        format: int32
+                        type: integer
+                    required:
+                    - name
+                    type: object
+                  maxItems: 32
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                serials:
+                  description: |-
+                    Serials describe additional serial ports, e.g. for guests which log
+                    their kernel output to ttyS1. Each port can be connected to with
+                    virtctl console --serial.
+                  items:
+                    properties:
+                      name:
+                        description: Name of the serial port.
+                        type: string
+                      port:
+                        description: |-
+                          Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                          Port 0 is taken by the default serial console, unless
+                          autoattachSerialConsole is disabled.
+                        format: int32
+                        type: integer
+                    required:
+                    - name
+                    - port
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+                sgx:
+                  description: SGX exposes an Intel SGX enclave page cache (EPC) section
+                    to the vmi, allowing it to run SGX enclaves.
+                  properties:
+                    epcSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: |-
+                        EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                        It is taken from the EPC of the node, which is not part of its memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - epcSize
+                  type: object
+                sharedMemoryDevices:
+                  description: |-
+                    SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                    co-located VMs or pods to exchange data through them.
+                  items:
+                    properties:
+                      hostPath:
+                        description: |-
+                          HostPath is a file on the node backing an ivshmem-plain region, shared with
+                          every VM and pod on the node mapping the same file. It is created if it
+                          does not exist and has to be writable by the qemu user.
+                        type: string
+                      model:
+                        description: |-
+                          Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                          Defaults to ivshmem-plain.
+                        type: string
+                      name:
+                        description: |-
+                          Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                          Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                          virt-launcher pod and can be shared with its sidecar containers.
+                        type: string
+                      serverSocketPath:
+                        description: |-
+                          ServerSocketPath is the path on the node of the ivshmem-server socket an
+                          ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                        type: string
+                      size:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          Size of the shared memory region. Must be a power of two.
+                          Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                          server owns the region.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                smartcard:
+                  description: Smartcard attaches a CCID smartcard reader to the vmi.
+                  properties:
+                    hostCertificates:
+                      description: |-
+                        HostCertificates emulates a smartcard with certificates of an NSS
+                        database stored in a Secret.
+                      properties:
+                        certificates:
+                          description: Certificates are the nicknames of the three
+                            certificates of the emulated card.
+                          items:
+                            type: string
+                          maxItems: 3
+                          minItems: 3
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        secretName:
+                          description: |-
+                            SecretName is the name of the Secret holding the files of the NSS database,
+                            for example cert9.db, key4.db and pkcs11.txt.
+                          type: string
+                      required:
+                      - certificates
+                      - secretName
+                      type: object
+                    passthrough:
+                      description: |-
+                        Passthrough forwards the smartcard of a client, which connects to the
+                        smartcard subresource of the vmi.
+                      type: object
+                  type: object
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -16193,11 +20143,33 @@ var CRDsValidation map[string]string = map[string]string{
                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                         Defaults to True
                       type: boolean
+                    encryptionSecretRef:
+                      description: |-
+                        EncryptionSecretRef references a Secret in the namespace of the VMI.
+                        Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                        It has to be set before the TPM state is created and requires persistent to be enabled.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
                     persistent:
                       description: |-
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version is the version of the emulated TPM, 2.0 or 1.2.
+                        Defaults to 2.0
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -16209,6 +20181,24 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Video describes the video device configuration for
                     the vmi.
                   properties:
+                    resolution:
+                      description: |-
+                        Resolution is the preferred resolution advertised to the guest through EDID.
+                        Graphical consoles come up at this resolution if the guest display driver honors it.
+                        Supported by the vga, virtio and bochs video types.
+                      properties:
+                        height:
+                          description: Height in pixels.
+                          format: int32
+                          type: integer
+                        width:
+                          description: Width in pixels.
+                          format: int32
+                          type: integer
+                      required:
+                      - height
+                      - width
+                      type: object
                     type:
                       description: |-
                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -16224,7 +20214,7 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                             Defaults to reset.
                           type: string
                       type: object
@@ -16233,17 +20223,39 @@ var CRDsValidation map[string]string = map[string]string{
                       properties:
                         action:
                           description: |-
-                            The action to take. Valid values are poweroff, reset, shutdown.
+                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                             Defaults to reset.
                           type: string
                       type: object
                     name:
                       description: Name of the watchdog.
                       type: string
+                    remediation:
+                      description: |-
+                        Remediation is carried out by KubeVirt once the watchdog expired,
+                        in addition to the action taken by the watchdog device itself.
+                      properties:
+                        memoryDumpClaimName:
+                          description: |-
+                            MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                            Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                          type: string
+                        type:
+                          description: 'Type of the remediation. One of: Reset, RestartVM,
+                            MemoryDump.'
+                          type: string
+                      required:
+                      - type
+                      type: object
                   required:
                   - name
                   type: object
               type: object
+            emulatorBundle:
+              description: |-
+                EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+              type: string
             features:
               description: Features like acpi, apic, hyperv, smm.
               properties:
@@ -16465,6 +20477,21 @@ var CRDsValidation map[string]string = map[string]string{
                         Hide the KVM hypervisor from standard MSR based discovery.
                         Defaults to false
                       type: boolean
+                    hintDedicated:
+                      description: |-
+                        HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                        paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                        Only supported on amd64.
+                        Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                      type: boolean
+                    pollControl:
+                      description: |-
+                        PollControl allows the guest to take over halt polling from the host, e.g. with the
+                        cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                        The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                        Only supported on amd64.
+                        Defaults to false
+                      type: boolean
                   type: object
                 pvspinlock:
                   description: |-
@@ -16508,7 +20535,9 @@ var CRDsValidation map[string]string = map[string]string{
                       type: string
                   type: object
                 bootloader:
-                  description: Settings to control the bootloader that is used.
+                  description: |-
+                    Settings to control the bootloader that is used.
+                    Defaulted from the firmware profile, if set.
                   properties:
                     bios:
                       description: If set (default), BIOS will be used.
@@ -16573,6 +20602,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Arguments to be passed to the kernel at boot time
                       type: string
                   type: object
+                profile:
+                  description: |-
+                    Profile is the name of a firmware profile configured in the KubeVirt CR.
+                    The profile selects the bootloader, and may provide a custom firmware build
+                    for the architecture of the VMI.
+                  type: string
                 serial:
                   description: The system-serial-number in SMBIOS
                   type: string
@@ -16606,6 +20641,30 @@ var CRDsValidation map[string]string = map[string]string{
                     attestation:
                       description: If specified, run the attestation process for a
                         vmi.
+                      properties:
+                        keyBroker:
+                          description: |-
+                            If specified, the launch secret is released by the key broker once it
+                            verified the launch measurement, and the VMI is unpaused afterwards.
+                            Otherwise the launch secret has to be injected through the
+                            sev/injectlaunchsecret subresource.
+                          properties:
+                            protocol:
+                              description: |-
+                                Protocol spoken with the key broker.
+                                Defaults to kbs.
+                              type: string
+                            resourcePath:
+                              description: Path of the resource holding the launch
+                                secret, e.g. default/sev/launch-secret.
+                              type: string
+                            url:
+                              description: URL of the key broker service.
+                              type: string
+                          required:
+                          - resourcePath
+                          - url
+                          type: object
                       type: object
                     dhCert:
                       description: Base64 encoded guest owner's Diffie-Hellman key.
@@ -16630,6 +20689,32 @@ var CRDsValidation map[string]string = map[string]string{
                   type: object
                 tdx:
                   description: Intel Trust Domain Extensions (TDX).
+                  properties:
+                    attestation:
+                      description: If specified, the guest can request quotes from the quote generation
+                        service of the node.
+                      type: object
+                    mrConfigId:
+                      description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                      type: string
+                    mrOwner:
+                      description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                      type: string
+                    mrOwnerConfig:
+                      description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                        (MROWNERCONFIG).
+                      type: string
+                    policy:
+                      description: |-
+                        Guest policy flags as defined in the Intel TDX module specification.
+                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                      properties:
+                        septVEDisable:
+                          description: |-
+                            Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                            Defaults to false.
+                          type: boolean
+                      type: object
                   type: object
               type: object
             machine:
@@ -16639,9 +20724,27 @@ var CRDsValidation map[string]string = map[string]string{
                   description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
                   type: string
               type: object
+            machineOptions:
+              description: |-
+                MachineOptions allows to turn off legacy devices of the machine,
+                reducing the attack surface of hardened guests.
+              properties:
+                ps2:
+                  description: |-
+                    PS2 controls the legacy PS/2 (i8042) controller.
+                    Defaults to true.
+                  type: boolean
+              type: object
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                dimmSlots:
+                  description: |-
+                    DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                    is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                    DIMMs can not be hot unplugged.
+                  format: int32
+                  type: integer
                 guest:
                   anyOf:
                   - type: integer
@@ -16704,6 +20807,13 @@ var CRDsValidation map[string]string = map[string]string{
                     Valid resource keys are "memory" and "cpu".
                   type: object
               type: object
+            useEmulation:
+              description: |-
+                UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                The vmi does not request /dev/kvm, can run on any node and performs
+                significantly slower than a hardware accelerated guest.
+                Intended for CI and cross-architecture testing.
+              type: boolean
           required:
           - devices
           type: object
@@ -17880,6 +21990,24 @@ var CRDsValidation map[string]string = map[string]string{
                     attempting to run. Defaults to the compiled architecture of the
                     KubeVirt components
                   type: string
+                diskSpaceLow:
+                  description: |-
+                    DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+                    is filling up. The utilization of the guest filesystems is reported by the guest agent.
+                  properties:
+                    hysteresisPercentage:
+                      description: |-
+                        HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+                        below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+                      format: int32
+                      type: integer
+                    usedPercentage:
+                      description: |-
+                        UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+                        condition is reported. Defaults to 90.
+                      format: int32
+                      type: integer
+                  type: object
                 dnsConfig:
                   description: |-
                     Specifies the DNS parameters of a pod.
@@ -17957,6 +22085,17 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        ptp:
+                          description: |-
+                            PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                            which guests can discipline their clock to with sub-microsecond accuracy.
+                          properties:
+                            enabled:
+                              description: |-
+                                Enabled set to false makes sure that a preference can't add the clock.
+                                Defaults to true.
+                              type: boolean
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -18054,6 +22193,12 @@ var CRDsValidation map[string]string = map[string]string{
                       description: CPU allow specified the detailed CPU topology inside
                         the vmi.
                       properties:
+                        coreType:
+                          description: |-
+                            CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                            on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                            Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                          type: string
                         cores:
                           description: |-
                             Cores specifies the number of cores inside the vmi.
@@ -18107,6 +22252,12 @@ var CRDsValidation map[string]string = map[string]string{
                             and "host-model" to get CPU closest to the node one.
                             Defaults to host-model.
                           type: string
+                        nestedVirtualization:
+                          description: |-
+                            NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                            and schedules the VMI only on nodes whose KVM module allows nested guests.
+                            Only supported on amd64.
+                          type: boolean
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
@@ -18179,9 +22330,70 @@ var CRDsValidation map[string]string = map[string]string{
                             Whether or not to enable virtio multi-queue for block devices.
                             Defaults to false.
                           type: boolean
+                        channels:
+                          description: |-
+                            Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                            in-guest agents to talk to sidecars or node agents without networking.
+                          items:
+                            properties:
+                              hostPath:
+                                description: |-
+                                  HostPath is a directory on the node the channel socket is created in.
+                                  By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                                  directory shared with the hook sidecars of the vmi.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                                  The channel socket is named <name>.sock.
+                                type: string
+                              target:
+                                description: |-
+                                  Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                                  In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                                type: string
+                            required:
+                            - name
+                            - target
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         clientPassthrough:
                           description: To configure and access client devices such
                             as redirecting USB
+                          properties:
+                            count:
+                              description: |-
+                                Count is the number of USB devices which can be redirected at the same time.
+                                Defaults to UsbClientPassthroughMaxNumberOf.
+                              format: int32
+                              type: integer
+                            filter:
+                              description: |-
+                                Filter restricts the USB devices which can be redirected by their class.
+                                It is enforced by virt-handler when proxying the redirection.
+                              properties:
+                                allow:
+                                  description: |-
+                                    Allow lists the device classes which can be redirected. If empty, all
+                                    device classes which are not denied can be redirected.
+                                  items:
+                                    description: USBDeviceClass is the class of a
+                                      USB device or of one of its interfaces.
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                                deny:
+                                  description: |-
+                                    Deny lists the device classes which can not be redirected. Deny takes
+                                    precedence over allow.
+                                  items:
+                                    description: USBDeviceClass is the class of a
+                                      USB device or of one of its interfaces.
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: set
+                              type: object
                           type: object
                         disableHotplug:
                           description: DisableHotplug disabled the ability to hotplug
@@ -18271,7 +22483,7 @@ var CRDsValidation map[string]string = map[string]string{
                                   bus:
                                     description: |-
                                       Bus indicates the type of disk device to emulate.
-                                      supported values: virtio, sata, scsi, usb.
+                                      supported values: virtio, sata, scsi, usb, nvme.
                                     type: string
                                   pciAddress:
                                     description: 'If specified, the virtual disk will
@@ -18283,6 +22495,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       ReadOnly.
                                       Defaults to false.
                                     type: boolean
+                                  virtioTransitional:
+                                    description: |-
+                                      If specified, overrides useVirtioTransitional for this disk.
+                                      Only applies to the virtio bus.
+                                    type: boolean
                                 type: object
                               errorPolicy:
                                 description: If specified, it can change the default
@@ -18293,6 +22510,85 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: IOTune throttles the IO of the disk.
+                                  The limits are enforced by QEMU.
+                                properties:
+                                  burst:
+                                    description: Burst allows the disk to exceed the
+                                      limits for a short time.
+                                    properties:
+                                      lengthSeconds:
+                                        description: |-
+                                          LengthSeconds is the maximum duration of a burst in seconds.
+                                          Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec limits the read
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec limits the read IO
+                                          operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec limits the total
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec limits the total
+                                          IO operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec limits the write
+                                          throughput in bytes per second during a
+                                          burst.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec limits the write
+                                          IO operations per second during a burst.
+                                        format: int64
+                                        type: integer
+                                    type: object
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the read IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the total throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total IO
+                                      operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the write IO
+                                      operations per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -18315,10 +22611,34 @@ var CRDsValidation map[string]string = map[string]string{
                               name:
                                 description: Name is the device name
                                 type: string
+                              queues:
+                                description: |-
+                                  Queues is the number of queues of the disk. If specified, it overrides the
+                                  number of queues derived from blockMultiQueue for this disk.
+                                  Only supported on the virtio bus.
+                                format: int64
+                                type: integer
+                              rerrorPolicy:
+                                description: |-
+                                  If specified, it changes the error policy for read errors of the disk.
+                                  Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                                type: string
+                              scsiController:
+                                description: |-
+                                  SCSIController is the name of the scsi controller the disk is attached to.
+                                  Only supported on the scsi bus. Defaults to the first scsi controller.
+                                type: string
                               serial:
                                 description: Serial provides the ability to specify
                                   a serial number for the disk device.
                                 type: string
+                              serialPolicy:
+                                description: |-
+                                  SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                                  Supported values are: None, VolumeNameHash.
+                                  VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                                  when disks are reordered. Defaults to None.
+                                type: string
                               shareable:
                                 description: If specified the disk is made sharable
                                   and multiple write from different VMs are permitted
@@ -18327,6 +22647,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: If specified, disk address and its tag
                                   will be provided to the guest via config drive metadata
                                 type: string
+                              wwn:
+                                description: |-
+                                  WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                                  Only supported on the scsi bus.
+                                type: string
                             required:
                             - name
                             type: object
@@ -18346,6 +22671,72 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               virtiofs:
                                 description: Virtiofs is supported
+                                properties:
+                                  idMap:
+                                    description: |-
+                                      IDMap maps the user and group IDs of the guest to the IDs owning the
+                                      files of the shared volume.
+                                    properties:
+                                      gid:
+                                        description: GID maps ranges of group IDs.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of IDs in the range.
+                                              format: int32
+                                              type: integer
+                                            start:
+                                              description: Start is the first ID of the range in the guest.
+                                              format: int32
+                                              type: integer
+                                            target:
+                                              description: Target is the first ID of the range on the shared volume.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - count
+                                          - start
+                                          - target
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      uid:
+                                        description: UID maps ranges of user IDs.
+                                        items:
+                                          properties:
+                                            count:
+                                              description: Count is the number of IDs in the range.
+                                              format: int32
+                                              type: integer
+                                            start:
+                                              description: Start is the first ID of the range in the guest.
+                                              format: int32
+                                              type: integer
+                                            target:
+                                              description: Target is the first ID of the range on the shared volume.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - count
+                                          - start
+                                          - target
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                  queueSize:
+                                    description: |-
+                                      QueueSize is the size of the virtqueue of the device.
+                                      Must be a power of two not larger than 1024. Defaults to 1024.
+                                    format: int32
+                                    type: integer
+                                  threadPoolSize:
+                                    description: |-
+                                      ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                                      requests of the guest. 0 handles them in the thread of the queue.
+                                      Defaults to the default of virtiofsd.
+                                    format: int32
+                                    type: integer
                                 type: object
                             required:
                             - name
@@ -18407,6 +22798,18 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: |-
+                            Graphics describes the configuration of the default graphics device.
+                            Not relevant if autoattachGraphicsDevice is disabled.
+                          properties:
+                            keymap:
+                              description: |-
+                                Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                                It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                                Defaults to en-us.
+                              type: string
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -18437,6 +22840,21 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        hostSensors:
+                          description: HostSensors creates a virtio serial for exposing
+                            temperature and power readings of the node to the vmi.
+                          properties:
+                            types:
+                              description: |-
+                                Types are the kinds of sensors exposed to the vmi.
+                                Defaults to all of them.
+                              items:
+                                description: HostSensorType is a kind of hardware
+                                  monitoring sensor.
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: set
+                          type: object
                         inputs:
                           description: Inputs describe input devices
                           items:
@@ -18452,7 +22870,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type:
                                 description: |-
                                   Type indicated the type of input device.
-                                  Supported values: tablet.
+                                  A tablet is an absolute pointer, a mouse a relative one.
+                                  Multitouch devices are only supported on the virtio bus.
+                                  Supported values: tablet, keyboard, mouse, multitouch.
                                 type: string
                             required:
                             - name
@@ -18470,6 +22890,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              backend:
+                                description: |-
+                                  Backend selects the host side backend of the interface.
+                                  One of: vhost, userspace.
+                                  Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                                  Only applies to the virtio model.
+                                type: string
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -18561,6 +22988,23 @@ var CRDsValidation map[string]string = map[string]string{
                                   Logical name of the interface as well as a reference to the associated networks.
                                   Must match the Name of a Network.
                                 type: string
+                              offloads:
+                                description: |-
+                                  Offloads toggles the offloads of the interface on the tap path.
+                                  Only applies to the virtio model.
+                                properties:
+                                  checksum:
+                                    description: Checksum toggles the checksum offload.
+                                    type: boolean
+                                  gso:
+                                    description: GSO toggles the generic segmentation
+                                      offload of the host.
+                                    type: boolean
+                                  tso:
+                                    description: TSO toggles the TCP segmentation
+                                      offload, for IPv4 and IPv6.
+                                    type: boolean
+                                type: object
                               passt:
                                 description: |-
                                   DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -18602,6 +23046,13 @@ var CRDsValidation map[string]string = map[string]string{
                                   - port
                                   type: object
                                 type: array
+                              queues:
+                                description: |-
+                                  Queues is the number of queues of the interface. If specified, it overrides the
+                                  number of queues derived from networkInterfaceMultiqueue for this interface.
+                                  Only applies to the virtio model.
+                                format: int64
+                                type: integer
                               slirp:
                                 description: |-
                                   DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -18610,6 +23061,25 @@ var CRDsValidation map[string]string = map[string]string{
                               sriov:
                                 description: InterfaceSRIOV connects to a given network
                                   by passing-through an SR-IOV PCI device via vfio.
+                                properties:
+                                  failover:
+                                    description: |-
+                                      Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                                      MAC address and takes over the traffic while the device is unplugged for live migration.
+                                    properties:
+                                      standbyInterface:
+                                        description: |-
+                                          StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                          SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                          It is set to the generated standby interface when StandbyNetworkName is used.
+                                        type: string
+                                      standbyNetworkName:
+                                        description: |-
+                                          StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                          when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                          interface, which is generated if it is not set.
+                                        type: string
+                                    type: object
                                 type: object
                               state:
                                 description: |-
@@ -18625,11 +23095,42 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              virtioTransitional:
+                                description: |-
+                                  If specified, overrides useVirtioTransitional for this interface.
+                                  Only applies to the virtio model.
+                                type: boolean
                             required:
                             - name
                             type: object
                           maxItems: 256
                           type: array
+                        iommu:
+                          description: |-
+                            IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                            DPDK or to assign devices to nested guests.
+                          properties:
+                            cachingMode:
+                              description: |-
+                                CachingMode makes the guest report every mapping change, which is required
+                                to assign devices to nested guests. Only supported by the intel model.
+                              type: boolean
+                            eim:
+                              description: |-
+                                EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                                Requires interruptRemapping.
+                              type: boolean
+                            interruptRemapping:
+                              description: |-
+                                InterruptRemapping enables remapping of the interrupts of assigned devices.
+                                Only supported by the intel model.
+                              type: boolean
+                            model:
+                              description: |-
+                                Model is the IOMMU model. One of: intel, virtio.
+                                Defaults to intel on amd64 and to virtio on arm64.
+                              type: string
+                          type: object
                         logSerialConsole:
                           description: |-
                             Whether to log the auto-attached default serial console or not.
@@ -18637,6 +23138,10 @@ var CRDsValidation map[string]string = map[string]string{
                             Not relevant if autoattachSerialConsole is disabled.
                             Defaults to cluster wide setting on VirtualMachineOptions.
                           type: boolean
+                        memBalloonVirtioTransitional:
+                          description: If specified, overrides useVirtioTransitional for the Memory balloon
+                            device.
+                          type: boolean
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -18661,6 +23166,155 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        scsiControllers:
+                          description: |-
+                            SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                            scsi bus are attached to the controller named in their scsiController field,
+                            or to the first controller if none is named. Spreading busy disks over several
+                            controllers with dedicated IO threads improves storage throughput.
+                            Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                          items:
+                            properties:
+                              dedicatedIOThread:
+                                description: |-
+                                  DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                                  shared by all disks attached to it. Defaults to false.
+                                type: boolean
+                              name:
+                                description: Name of the controller, referenced by
+                                  the scsiController field of disks.
+                                type: string
+                              queues:
+                                description: |-
+                                  Queues is the number of request queues of the controller.
+                                  Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                                format: int32
+                                type: integer
+                            required:
+                            - name
+                            type: object
+                          maxItems: 32
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        serials:
+                          description: |-
+                            Serials describe additional serial ports, e.g. for guests which log
+                            their kernel output to ttyS1. Each port can be connected to with
+                            virtctl console --serial.
+                          items:
+                            properties:
+                              name:
+                                description: Name of the serial port.
+                                type: string
+                              port:
+                                description: |-
+                                  Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                                  Port 0 is taken by the default serial console, unless
+                                  autoattachSerialConsole is disabled.
+                                format: int32
+                                type: integer
+                            required:
+                            - name
+                            - port
+                            type: object
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        sgx:
+                          description: SGX exposes an Intel SGX enclave page cache
+                            (EPC) section to the vmi, allowing it to run SGX enclaves.
+                          properties:
+                            epcSize:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: |-
+                                EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                                It is taken from the EPC of the node, which is not part of its memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          required:
+                          - epcSize
+                          type: object
+                        sharedMemoryDevices:
+                          description: |-
+                            SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                            co-located VMs or pods to exchange data through them.
+                          items:
+                            properties:
+                              hostPath:
+                                description: |-
+                                  HostPath is a file on the node backing an ivshmem-plain region, shared with
+                                  every VM and pod on the node mapping the same file. It is created if it
+                                  does not exist and has to be writable by the qemu user.
+                                type: string
+                              model:
+                                description: |-
+                                  Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                                  Defaults to ivshmem-plain.
+                                type: string
+                              name:
+                                description: |-
+                                  Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                                  Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                                  virt-launcher pod and can be shared with its sidecar containers.
+                                type: string
+                              serverSocketPath:
+                                description: |-
+                                  ServerSocketPath is the path on the node of the ivshmem-server socket an
+                                  ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                                type: string
+                              size:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Size of the shared memory region. Must be a power of two.
+                                  Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                                  server owns the region.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        smartcard:
+                          description: Smartcard attaches a CCID smartcard reader
+                            to the vmi.
+                          properties:
+                            hostCertificates:
+                              description: |-
+                                HostCertificates emulates a smartcard with certificates of an NSS
+                                database stored in a Secret.
+                              properties:
+                                certificates:
+                                  description: Certificates are the nicknames of the
+                                    three certificates of the emulated card.
+                                  items:
+                                    type: string
+                                  maxItems: 3
+                                  minItems: 3
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                secretName:
+                                  description: |-
+                                    SecretName is the name of the Secret holding the files of the NSS database,
+                                    for example cert9.db, key4.db and pkcs11.txt.
+                                  type: string
+                              required:
+                              - certificates
+                              - secretName
+                              type: object
+                            passthrough:
+                              description: |-
+                                Passthrough forwards the smartcard of a client, which connects to the
+                                smartcard subresource of the vmi.
+                              type: object
+                          type: object
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -18684,11 +23338,33 @@ var CRDsValidation map[string]string = map[string]string{
                                 Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                 Defaults to True
                               type: boolean
+                            encryptionSecretRef:
+                              description: |-
+                                EncryptionSecretRef references a Secret in the namespace of the VMI.
+                                Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                                It has to be set before the TPM state is created and requires persistent to be enabled.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
                             persistent:
                               description: |-
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version is the version of the emulated TPM, 2.0 or 1.2.
+                                Defaults to 2.0
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -18700,6 +23376,24 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Video describes the video device configuration
                             for the vmi.
                           properties:
+                            resolution:
+                              description: |-
+                                Resolution is the preferred resolution advertised to the guest through EDID.
+                                Graphical consoles come up at this resolution if the guest display driver honors it.
+                                Supported by the vga, virtio and bochs video types.
+                              properties:
+                                height:
+                                  description: Height in pixels.
+                                  format: int32
+                                  type: integer
+                                width:
+                                  description: Width in pixels.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - height
+                              - width
+                              type: object
                             type:
                               description: |-
                                 Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -18716,7 +23410,7 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                     Defaults to reset.
                                   type: string
                               type: object
@@ -18725,17 +23419,39 @@ var CRDsValidation map[string]string = map[string]string{
                               properties:
                                 action:
                                   description: |-
-                                    The action to take. Valid values are poweroff, reset, shutdown.
+                                    The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                     Defaults to reset.
                                   type: string
                               type: object
                             name:
                               description: Name of the watchdog.
                               type: string
+                            remediation:
+                              description: |-
+                                Remediation is carried out by KubeVirt once the watchdog expired,
+                                in addition to the action taken by the watchdog device itself.
+                              properties:
+                                memoryDumpClaimName:
+                                  description: |-
+                                    MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                                    Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                                  type: string
+                                type:
+                                  description: 'Type of the remediation. One of: Reset,
+                                    RestartVM, MemoryDump.'
+                                  type: string
+                              required:
+                              - type
+                              type: object
                           required:
                           - name
                           type: object
                       type: object
+                    emulatorBundle:
+                      description: |-
+                        EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                        Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+                      type: string
                     features:
                       description: Features like acpi, apic, hyperv, smm.
                       properties:
@@ -18959,6 +23675,21 @@ var CRDsValidation map[string]string = map[string]string{
                                 Hide the KVM hypervisor from standard MSR based discovery.
                                 Defaults to false
                               type: boolean
+                            hintDedicated:
+                              description: |-
+                                HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                                paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                                Only supported on amd64.
+                                Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                              type: boolean
+                            pollControl:
+                              description: |-
+                                PollControl allows the guest to take over halt polling from the host, e.g. with the
+                                cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                                The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                                Only supported on amd64.
+                                Defaults to false
+                              type: boolean
                           type: object
                         pvspinlock:
                           description: |-
@@ -19002,8 +23733,9 @@ var CRDsValidation map[string]string = map[string]string{
                               type: string
                           type: object
                         bootloader:
-                          description: Settings to control the bootloader that is
-                            used.
+                          description: |-
+                            Settings to control the bootloader that is used.
+                            Defaulted from the firmware profile, if set.
                           properties:
                             bios:
                               description: If set (default), BIOS will be used.
@@ -19070,6 +23802,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 boot time
                               type: string
                           type: object
+                        profile:
+                          description: |-
+                            Profile is the name of a firmware profile configured in the KubeVirt CR.
+                            The profile selects the bootloader, and may provide a custom firmware build
+                            for the architecture of the VMI.
+                          type: string
                         serial:
                           description: The system-serial-number in SMBIOS
                           type: string
@@ -19103,6 +23841,30 @@ var CRDsValidation map[string]string = map[string]string{
                             attestation:
                               description: If specified, run the attestation process
                                 for a vmi.
+                              properties:
+                                keyBroker:
+                                  description: |-
+                                    If specified, the launch secret is released by the key broker once it
+                                    verified the launch measurement, and the VMI is unpaused afterwards.
+                                    Otherwise the launch secret has to be injected through the
+                                    sev/injectlaunchsecret subresource.
+                                  properties:
+                                    protocol:
+                                      description: |-
+                                        Protocol spoken with the key broker.
+                                        Defaults to kbs.
+                                      type: string
+                                    resourcePath:
+                                      description: Path of the resource holding the
+                                        launch secret, e.g. default/sev/launch-secret.
+                                      type: string
+                                    url:
+                                      description: URL of the key broker service.
+                                      type: string
+                                  required:
+                                  - resourcePath
+                                  - url
+                                  type: object
                               type: object
                             dhCert:
                               description: Base64 encoded guest owner's Diffie-Hellman
@@ -19128,6 +23890,32 @@ var CRDsValidation map[string]string = map[string]string{
                           type: object
                         tdx:
                           description: Intel Trust Domain Extensions (TDX).
+                          properties:
+                            attestation:
+                              description: If specified, the guest can request quotes from the quote generation
+                                service of the node.
+                              type: object
+                            mrConfigId:
+                              description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                              type: string
+                            mrOwner:
+                              description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                              type: string
+                            mrOwnerConfig:
+                              description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                                (MROWNERCONFIG).
+                              type: string
+                            policy:
+                              description: |-
+                                Guest policy flags as defined in the Intel TDX module specification.
+                                Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                              properties:
+                                septVEDisable:
+                                  description: |-
+                                    Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                                    Defaults to false.
+                                  type: boolean
+                              type: object
                           type: object
                       type: object
                     machine:
@@ -19138,9 +23926,27 @@ var CRDsValidation map[string]string = map[string]string{
                             the VirtualMachineInstance.
                           type: string
                       type: object
+                    machineOptions:
+                      description: |-
+                        MachineOptions allows to turn off legacy devices of the machine,
+                        reducing the attack surface of hardened guests.
+                      properties:
+                        ps2:
+                          description: |-
+                            PS2 controls the legacy PS/2 (i8042) controller.
+                            Defaults to true.
+                          type: boolean
+                      type: object
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        dimmSlots:
+                          description: |-
+                            DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                            is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                            DIMMs can not be hot unplugged.
+                          format: int32
+                          type: integer
                         guest:
                           anyOf:
                           - type: integer
@@ -19203,6 +24009,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Valid resource keys are "memory" and "cpu".
                           type: object
                       type: object
+                    useEmulation:
+                      description: |-
+                        UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                        The vmi does not request /dev/kvm, can run on any node and performs
+                        significantly slower than a hardware accelerated guest.
+                        Intended for CI and cross-architecture testing.
+                      type: boolean
                   required:
                   - devices
                   type: object
@@ -19215,11 +24028,72 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                guestSecrets:
+                  description: |-
+                    GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+                    without writing them to any disk image.
+                  items:
+                    description: GuestSecret delivers the value of a key of a Secret to the guest.
+                    properties:
+                      key:
+                        description: Key of the Secret whose value is delivered.
+                        type: string
+                      name:
+                        description: Name of the guest secret, unique within the VMI. It names the
+                          fw_cfg blob.
+                        type: string
+                      secretName:
+                        description: SecretName is the name of the Secret in the namespace of the VMI.
+                        type: string
+                      target:
+                        description: |-
+                          Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+                          the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+                          secret is delivered on the next start.
+                          Defaults to FWCfg.
+                        enum:
+                        - FWCfg
+                        type: string
+                    required:
+                    - key
+                    - name
+                    - secretName
+                    type: object
+                  maxItems: 32
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
                     If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                   type: string
+                launcherIsolation:
+                  description: |-
+                    LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+                    the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+                    Defaults to Container.
+                  enum:
+                  - Container
+                  - MicroVM
+                  type: string
+                launcherMetadata:
+                  description: |-
+                    LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+                    The keys have to be allowed in launcherPodConfiguration.
+                  properties:
+                    annotations:
+                      additionalProperties:
+                        type: string
+                      description: Annotations to add to the virt-launcher pod
+                      type: object
+                    labels:
+                      additionalProperties:
+                        type: string
+                      description: Labels to add to the virt-launcher pod
+                      type: object
+                  type: object
                 livenessProbe:
                   description: |-
                     Periodic probe of VirtualMachineInstance liveness.
@@ -19251,6 +24125,22 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                        The probe succeeds if the command exits with status 0.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining ones are passed as arguments. It is not run in a shell.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -19354,6 +24244,23 @@ var CRDsValidation map[string]string = map[string]string{
                         More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                       format: int32
                       type: integer
+                    vsockHTTP:
+                      description: |-
+                        VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                        It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                      properties:
+                        path:
+                          description: Path to access on the HTTP server. Defaults
+                            to "/".
+                          type: string
+                        port:
+                          description: Port is the VSOCK port the guest service listens
+                            on.
+                          format: int32
+                          type: integer
+                      required:
+                      - port
+                      type: object
                   type: object
                 networks:
                   description: List of networks that can be attached to a vm's virtual
@@ -19449,6 +24356,22 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    guestAgentExec:
+                      description: |-
+                        GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                        The probe succeeds if the command exits with status 0.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the guest. The first element is the
+                            executable, the remaining ones are passed as arguments. It is not run in a shell.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - command
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -19552,6 +24475,23 @@ var CRDsValidation map[string]string = map[string]string{
                         More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                       format: int32
                       type: integer
+                    vsockHTTP:
+                      description: |-
+                        VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                        It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                      properties:
+                        path:
+                          description: Path to access on the HTTP server. Defaults
+                            to "/".
+                          type: string
+                        port:
+                          description: Port is the VSOCK port the guest service listens
+                            on.
+                          format: int32
+                          type: integer
+                      required:
+                      - port
+                      type: object
                   type: object
                 resourceClaims:
                   description: |-
@@ -19559,6 +24499,11 @@ var CRDsValidation map[string]string = map[string]string{
                     and reserved before the VMI, hence virt-launcher pod is allowed to start. The resources
                     will be made available to the domain which consumes them
                     by name.
+                runtimeClassName:
+                  description: |-
+                    If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+                    cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+                  type: string
 
                     This is an alpha field and requires enabling the
                     DynamicResourceAllocation feature gate in kubernetes
@@ -20003,6 +24948,10 @@ var CRDsValidation map[string]string = map[string]string{
                           ConfigMapSource represents a reference to a ConfigMap in the same namespace.
                           More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           name:
                             default: ""
                             description: |-
@@ -20230,6 +25179,9 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format of the memory dump, Core by default
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -20276,6 +25228,10 @@ var CRDsValidation map[string]string = map[string]string{
                           SecretVolumeSource represents a reference to a secret data in the same namespace.
                           More info: https://kubernetes.io/docs/concepts/configuration/secret/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           optional:
                             description: Specify whether the Secret or it's keys must
                               be defined
@@ -20298,6 +25254,10 @@ var CRDsValidation map[string]string = map[string]string{
                           There can only be one volume of this type!
                           More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
                         properties:
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
                           serviceAccountName:
                             description: |-
                               Name of the service account in the pod's namespace to use.
@@ -20451,6 +25411,12 @@ var CRDsValidation map[string]string = map[string]string{
                 IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
                 the emulator thread on it.
               type: boolean
+            maxCPUs:
+              description: |-
+                MaxCPUs specifies the maximum amount of vCPUs that can be hotplugged.
+                It is rounded down to whole sockets of the CPU topology and can not be used together with MaxSockets.
+              format: int32
+              type: integer
             maxSockets:
               description: MaxSockets specifies the maximum amount of sockets that
                 can be hotplugged
@@ -20586,6 +25552,30 @@ var CRDsValidation map[string]string = map[string]string{
               properties:
                 attestation:
                   description: If specified, run the attestation process for a vmi.
+                  properties:
+                    keyBroker:
+                      description: |-
+                        If specified, the launch secret is released by the key broker once it
+                        verified the launch measurement, and the VMI is unpaused afterwards.
+                        Otherwise the launch secret has to be injected through the
+                        sev/injectlaunchsecret subresource.
+                      properties:
+                        protocol:
+                          description: |-
+                            Protocol spoken with the key broker.
+                            Defaults to kbs.
+                          type: string
+                        resourcePath:
+                          description: Path of the resource holding the launch secret,
+                            e.g. default/sev/launch-secret.
+                          type: string
+                        url:
+                          description: URL of the key broker service.
+                          type: string
+                      required:
+                      - resourcePath
+                      - url
+                      type: object
                   type: object
                 dhCert:
                   description: Base64 encoded guest owner's Diffie-Hellman key.
@@ -20610,6 +25600,32 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             tdx:
               description: Intel Trust Domain Extensions (TDX).
+              properties:
+                attestation:
+                  description: If specified, the guest can request quotes from the quote generation
+                    service of the node.
+                  type: object
+                mrConfigId:
+                  description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                  type: string
+                mrOwner:
+                  description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                  type: string
+                mrOwnerConfig:
+                  description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                    (MROWNERCONFIG).
+                  type: string
+                policy:
+                  description: |-
+                    Guest policy flags as defined in the Intel TDX module specification.
+                    Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                  properties:
+                    septVEDisable:
+                      description: |-
+                        Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                        Defaults to false.
+                      type: boolean
+                  type: object
               type: object
           type: object
         memory:
@@ -20678,6 +25694,335 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachineoperation": `openAPIV3Schema:
+  description: |-
+    VirtualMachineOperation applies an action to the VirtualMachines selected by
+    its label selector. virt-controller works through the VirtualMachines with a
+    limited concurrency and reports the progress and the failures in the status.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineOperationSpec is the spec for a VirtualMachineOperation
+        resource
+      properties:
+        action:
+          description: Action is applied to all selected VirtualMachines
+          enum:
+          - Start
+          - Stop
+          - Restart
+          - Migrate
+          type: string
+        maxConcurrent:
+          description: |-
+            MaxConcurrent is the number of VirtualMachines the action is in progress
+            for at the same time, defaults to 10
+          format: int32
+          minimum: 1
+          type: integer
+        maxFailures:
+          description: |-
+            MaxFailures stops the operation once more VirtualMachines failed. The
+            operation continues regardless of failures if unset
+          format: int32
+          minimum: 0
+          type: integer
+        selector:
+          description: |-
+            Selector selects the VirtualMachines in the namespace of the operation.
+            The VirtualMachines are selected once, when the operation starts
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        timeout:
+          description: |-
+            Timeout after which the action fails for a VirtualMachine, defaults to
+            10 minutes
+          type: string
+      required:
+      - action
+      - selector
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+    status:
+      description: VirtualMachineOperationStatus is the status for a VirtualMachineOperation
+        resource
+      properties:
+        completionTimestamp:
+          description: CompletionTimestamp is the time the operation succeeded or
+            failed
+          format: date-time
+          type: string
+        failed:
+          description: Failed is the number of VirtualMachines the action failed
+            for
+          format: int32
+          type: integer
+        failureReason:
+          description: FailureReason explains why the operation stopped
+          type: string
+        failures:
+          description: Failures aggregates the failed VirtualMachines by reason
+          items:
+            description: |-
+              VirtualMachineOperationFailure counts the VirtualMachines which failed for
+              the same reason
+            properties:
+              count:
+                description: Count is the number of VirtualMachines which failed
+                  for the reason
+                format: int32
+                type: integer
+              reason:
+                description: Reason the action failed
+                type: string
+              virtualMachines:
+                description: |-
+                  VirtualMachines are the names of the first VirtualMachines which failed
+                  for the reason
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+            required:
+            - count
+            - reason
+            - virtualMachines
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        inProgress:
+          description: |-
+            InProgress are the VirtualMachines the action was applied to and did not
+            complete yet
+          items:
+            description: VirtualMachineOperationTarget is a VirtualMachine the action
+              is in progress for
+            properties:
+              name:
+                description: Name of the VirtualMachine
+                type: string
+              startTimestamp:
+                description: StartTimestamp is the time the action was applied to
+                  the VirtualMachine
+                format: date-time
+                type: string
+            required:
+            - name
+            - startTimestamp
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        pending:
+          description: |-
+            Pending are the names of the selected VirtualMachines the action was not
+            applied to yet
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineOperationPhase is the const type for the phases
+            of an operation
+          type: string
+        startTimestamp:
+          description: StartTimestamp is the time the VirtualMachines were selected
+          format: date-time
+          type: string
+        succeeded:
+          description: Succeeded is the number of VirtualMachines the action succeeded
+            for
+          format: int32
+          type: integer
+        total:
+          description: Total is the number of selected VirtualMachines
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinepolicy": `openAPIV3Schema:
+  description: |-
+    VirtualMachinePolicy restricts the features VirtualMachines and
+    VirtualMachineInstances in its namespace can use. All policies of a namespace
+    are enforced, a VirtualMachineInstance has to comply with each of them.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachinePolicySpec is the spec for a VirtualMachinePolicy
+        resource
+      properties:
+        allowedDiskBuses:
+          description: |-
+            AllowedDiskBuses lists the buses disks, CD-ROMs and LUNs can be attached
+            with. All buses are allowed if empty
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        allowedNetworkBindings:
+          description: |-
+            AllowedNetworkBindings lists the interface bindings, like bridge,
+            masquerade or sriov, or the names of network binding plugins interfaces
+            can use. All bindings are allowed if empty
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        defaultRunStrategy:
+          description: |-
+            DefaultRunStrategy is the run strategy of the VirtualMachines created
+            without one. If several policies of a namespace set it, the one of the
+            first policy in alphabetical order is used
+          type: string
+        forbidHostDevices:
+          description: ForbidHostDevices rejects VirtualMachineInstances with host
+            devices or GPUs
+          type: boolean
+        maxCPUs:
+          description: |-
+            MaxCPUs is the largest number of vCPUs (sockets * cores * threads) a
+            VirtualMachineInstance can have
+          format: int32
+          minimum: 1
+          type: integer
+        maxMemory:
+          anyOf:
+          - type: integer
+          - type: string
+          description: |-
+            MaxMemory is the largest amount of guest memory a VirtualMachineInstance
+            can have
+          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+          x-kubernetes-int-or-string: true
+        powerOffWindows:
+          description: |-
+            PowerOffWindows are recurring windows during which the VirtualMachines of
+            the namespace are stopped. They are started again according to their run
+            strategy once the window ends
+          items:
+            description: |-
+              PowerOffWindow is a recurring window of the day during which VirtualMachines
+              are stopped
+            properties:
+              days:
+                description: |-
+                  Days are the days of the week the window starts on. The window starts
+                  every day if empty
+                items:
+                  description: Weekday is a day of the week
+                  enum:
+                  - Monday
+                  - Tuesday
+                  - Wednesday
+                  - Thursday
+                  - Friday
+                  - Saturday
+                  - Sunday
+                  type: string
+                type: array
+                x-kubernetes-list-type: set
+              end:
+                description: |-
+                  End is the time of the day the window ends at, as HH:MM in UTC. Windows
+                  ending before they start end on the next day
+                pattern: ^([01][0-9]|2[0-3]):[0-5][0-9]$
+                type: string
+              start:
+                description: Start is the time of the day the window starts at, as
+                  HH:MM in UTC
+                pattern: ^([01][0-9]|2[0-3]):[0-5][0-9]$
+                type: string
+            required:
+            - end
+            - start
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        requireLaunchSecurity:
+          description: RequireLaunchSecurity rejects VirtualMachineInstances without
+            launch security
+          type: boolean
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinepool": `openAPIV3Schema:
   description: |-
@@ -21812,6 +27157,72 @@ var CRDsValidation map[string]string = map[string]string{
                     Mutually exclusive with RunStrategy
                     Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                   type: boolean
+                sealed:
+                  description: |-
+                    Sealed holds the defaults which were chosen once for the VirtualMachine and are kept
+                    for its whole lifetime, instead of being written into the template.
+                    Values set in the template take precedence.
+                    Only populated with the SealedVMDefaults feature gate.
+                  properties:
+                    disks:
+                      description: Disks holds the PCI addresses of the disks of the
+                        template which do not set one.
+                      items:
+                        description: SealedDisk is the PCI address chosen for a disk
+                          of the template.
+                        properties:
+                          name:
+                            description: Name of the disk in the template.
+                            type: string
+                          pciAddress:
+                            description: PCIAddress of the disk in the guest, e.g.
+                              0000:02:00.0
+                            type: string
+                        required:
+                        - name
+                        - pciAddress
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - name
+                      x-kubernetes-list-type: map
+                    firmwareSerial:
+                      description: FirmwareSerial is the serial number exposed to
+                        the guest if the template does not set one.
+                      type: string
+                    firmwareUUID:
+                      description: FirmwareUUID is the UUID exposed to the guest if
+                        the template does not set one.
+                      type: string
+                    interfaces:
+                      description: Interfaces holds the MAC and PCI addresses of the
+                        interfaces of the template which do not set them.
+                      items:
+                        description: SealedInterface is the MAC and PCI address chosen
+                          for an interface of the template.
+                        properties:
+                          macAddress:
+                            description: MACAddress of the interface.
+                            type: string
+                          name:
+                            description: Name of the interface in the template.
+                            type: string
+                          pciAddress:
+                            description: PCIAddress of the interface in the guest,
+                              e.g. 0000:01:00.0
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      type: array
+                      x-kubernetes-list-map-keys:
+                      - name
+                      x-kubernetes-list-type: map
+                    machineType:
+                      description: MachineType is the machine type used if the template
+                        does not set one.
+                      type: string
+                  type: object
                 template:
                   description: Template is the direct specification of VirtualMachineInstance
                   properties:
@@ -22860,6 +28271,24 @@ var CRDsValidation map[string]string = map[string]string{
                             you are attempting to run. Defaults to the compiled architecture
                             of the KubeVirt components
                           type: string
+                        diskSpaceLow:
+                          description: |-
+                            DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+                            is filling up. The utilization of the guest filesystems is reported by the guest agent.
+                          properties:
+                            hysteresisPercentage:
+                              description: |-
+                                HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+                                below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+                              format: int32
+                              type: integer
+                            usedPercentage:
+                              description: |-
+                                UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+                                condition is reported. Defaults to 90.
+                              format: int32
+                              type: integer
+                          type: object
                         dnsConfig:
                           description: |-
                             Specifies the DNS parameters of a pod.
@@ -22939,6 +28368,17 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                ptp:
+                                  description: |-
+                                    PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                                    which guests can discipline their clock to with sub-microsecond accuracy.
+                                  properties:
+                                    enabled:
+                                      description: |-
+                                        Enabled set to false makes sure that a preference can't add the clock.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -23037,6 +28477,12 @@ var CRDsValidation map[string]string = map[string]string{
                               description: CPU allow specified the detailed CPU topology
                                 inside the vmi.
                               properties:
+                                coreType:
+                                  description: |-
+                                    CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                                    on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                                    Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                                  type: string
                                 cores:
                                   description: |-
                                     Cores specifies the number of cores inside the vmi.
@@ -23091,6 +28537,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     and "host-model" to get CPU closest to the node one.
                                     Defaults to host-model.
                                   type: string
+                                nestedVirtualization:
+                                  description: |-
+                                    NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                                    and schedules the VMI only on nodes whose KVM module allows nested guests.
+                                    Only supported on amd64.
+                                  type: boolean
                                 numa:
                                   description: NUMA allows specifying settings for
                                     the guest NUMA topology
@@ -23164,9 +28616,70 @@ var CRDsValidation map[string]string = map[string]string{
                                     Whether or not to enable virtio multi-queue for block devices.
                                     Defaults to false.
                                   type: boolean
+                                channels:
+                                  description: |-
+                                    Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                                    in-guest agents to talk to sidecars or node agents without networking.
+                                  items:
+                                    properties:
+                                      hostPath:
+                                        description: |-
+                                          HostPath is a directory on the node the channel socket is created in.
+                                          By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                                          directory shared with the hook sidecars of the vmi.
+                                        type: string
+                                      name:
+                                        description: |-
+                                          Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                                          The channel socket is named <name>.sock.
+                                        type: string
+                                      target:
+                                        description: |-
+                                          Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                                          In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                                        type: string
+                                    required:
+                                    - name
+                                    - target
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 clientPassthrough:
                                   description: To configure and access client devices
                                     such as redirecting USB
+                                  properties:
+                                    count:
+                                      description: |-
+                                        Count is the number of USB devices which can be redirected at the same time.
+                                        Defaults to UsbClientPassthroughMaxNumberOf.
+                                      format: int32
+                                      type: integer
+                                    filter:
+                                      description: |-
+                                        Filter restricts the USB devices which can be redirected by their class.
+                                        It is enforced by virt-handler when proxying the redirection.
+                                      properties:
+                                        allow:
+                                          description: |-
+                                            Allow lists the device classes which can be redirected. If empty, all
+                                            device classes which are not denied can be redirected.
+                                          items:
+                                            description: USBDeviceClass is the class
+                                              of a USB device or of one of its interfaces.
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: set
+                                        deny:
+                                          description: |-
+                                            Deny lists the device classes which can not be redirected. Deny takes
+                                            precedence over allow.
+                                          items:
+                                            description: USBDeviceClass is the class
+                                              of a USB device or of one of its interfaces.
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: set
+                                      type: object
                                   type: object
                                 disableHotplug:
                                   description: DisableHotplug disabled the ability
@@ -23259,7 +28772,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           bus:
                                             description: |-
                                               Bus indicates the type of disk device to emulate.
-                                              supported values: virtio, sata, scsi, usb.
+                                              supported values: virtio, sata, scsi, usb, nvme.
                                             type: string
                                           pciAddress:
                                             description: 'If specified, the virtual
@@ -23272,6 +28785,11 @@ var CRDsValidation map[string]string = map[string]string{
                                               ReadOnly.
                                               Defaults to false.
                                             type: boolean
+                                          virtioTransitional:
+                                            description: |-
+                                              If specified, overrides useVirtioTransitional for this disk.
+                                              Only applies to the virtio bus.
+                                            type: boolean
                                         type: object
                                       errorPolicy:
                                         description: If specified, it can change the
@@ -23282,6 +28800,88 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: IOTune throttles the IO of the
+                                          disk. The limits are enforced by QEMU.
+                                        properties:
+                                          burst:
+                                            description: Burst allows the disk to
+                                              exceed the limits for a short time.
+                                            properties:
+                                              lengthSeconds:
+                                                description: |-
+                                                  LengthSeconds is the maximum duration of a burst in seconds.
+                                                  Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              readBytesSec:
+                                                description: ReadBytesSec limits the
+                                                  read throughput in bytes per second
+                                                  during a burst.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec limits the
+                                                  read IO operations per second during
+                                                  a burst.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec limits
+                                                  the total throughput in bytes per
+                                                  second during a burst.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec limits the
+                                                  total IO operations per second during
+                                                  a burst.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec limits
+                                                  the write throughput in bytes per
+                                                  second during a burst.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec limits the
+                                                  write IO operations per second during
+                                                  a burst.
+                                                format: int64
+                                                type: integer
+                                            type: object
+                                          readBytesSec:
+                                            description: ReadBytesSec limits the read
+                                              throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec limits the read
+                                              IO operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec limits the
+                                              total throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec limits the total
+                                              IO operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec limits the
+                                              write throughput in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec limits the write
+                                              IO operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -23305,10 +28905,34 @@ var CRDsValidation map[string]string = map[string]string{
                                       name:
                                         description: Name is the device name
                                         type: string
+                                      queues:
+                                        description: |-
+                                          Queues is the number of queues of the disk. If specified, it overrides the
+                                          number of queues derived from blockMultiQueue for this disk.
+                                          Only supported on the virtio bus.
+                                        format: int64
+                                        type: integer
+                                      rerrorPolicy:
+                                        description: |-
+                                          If specified, it changes the error policy for read errors of the disk.
+                                          Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                                        type: string
+                                      scsiController:
+                                        description: |-
+                                          SCSIController is the name of the scsi controller the disk is attached to.
+                                          Only supported on the scsi bus. Defaults to the first scsi controller.
+                                        type: string
                                       serial:
                                         description: Serial provides the ability to
                                           specify a serial number for the disk device.
                                         type: string
+                                      serialPolicy:
+                                        description: |-
+                                          SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                                          Supported values are: None, VolumeNameHash.
+                                          VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                                          when disks are reordered. Defaults to None.
+                                        type: string
                                       shareable:
                                         description: If specified the disk is made
                                           sharable and multiple write from different
@@ -23319,6 +28943,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           its tag will be provided to the guest via
                                           config drive metadata
                                         type: string
+                                      wwn:
+                                        description: |-
+                                          WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                                          Only supported on the scsi bus.
+                                        type: string
                                     required:
                                     - name
                                     type: object
@@ -23338,6 +28967,72 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                       virtiofs:
                                         description: Virtiofs is supported
+                                        properties:
+                                          idMap:
+                                            description: |-
+                                              IDMap maps the user and group IDs of the guest to the IDs owning the
+                                              files of the shared volume.
+                                            properties:
+                                              gid:
+                                                description: GID maps ranges of group IDs.
+                                                items:
+                                                  properties:
+                                                    count:
+                                                      description: Count is the number of IDs in the range.
+                                                      format: int32
+                                                      type: integer
+                                                    start:
+                                                      description: Start is the first ID of the range in the guest.
+                                                      format: int32
+                                                      type: integer
+                                                    target:
+                                                      description: Target is the first ID of the range on the shared volume.
+                                                      format: int32
+                                                      type: integer
+                                                  required:
+                                                  - count
+                                                  - start
+                                                  - target
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              uid:
+                                                description: UID maps ranges of user IDs.
+                                                items:
+                                                  properties:
+                                                    count:
+                                                      description: Count is the number of IDs in the range.
+                                                      format: int32
+                                                      type: integer
+                                                    start:
+                                                      description: Start is the first ID of the range in the guest.
+                                                      format: int32
+                                                      type: integer
+                                                    target:
+                                                      description: Target is the first ID of the range on the shared volume.
+                                                      format: int32
+                                                      type: integer
+                                                  required:
+                                                  - count
+                                                  - start
+                                                  - target
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            type: object
+                                          queueSize:
+                                            description: |-
+                                              QueueSize is the size of the virtqueue of the device.
+                                              Must be a power of two not larger than 1024. Defaults to 1024.
+                                            format: int32
+                                            type: integer
+                                          threadPoolSize:
+                                            description: |-
+                                              ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                                              requests of the guest. 0 handles them in the thread of the queue.
+                                              Defaults to the default of virtiofsd.
+                                            format: int32
+                                            type: integer
                                         type: object
                                     required:
                                     - name
@@ -23400,6 +29095,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                   type: array
                                   x-kubernetes-list-type: atomic
+                                graphics:
+                                  description: |-
+                                    Graphics describes the configuration of the default graphics device.
+                                    Not relevant if autoattachGraphicsDevice is disabled.
+                                  properties:
+                                    keymap:
+                                      description: |-
+                                        Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                                        It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                                        Defaults to en-us.
+                                      type: string
+                                  type: object
                                 hostDevices:
                                   description: Whether to attach a host device to
                                     the vmi.
@@ -23431,6 +29138,22 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                   type: array
                                   x-kubernetes-list-type: atomic
+                                hostSensors:
+                                  description: HostSensors creates a virtio serial
+                                    for exposing temperature and power readings of
+                                    the node to the vmi.
+                                  properties:
+                                    types:
+                                      description: |-
+                                        Types are the kinds of sensors exposed to the vmi.
+                                        Defaults to all of them.
+                                      items:
+                                        description: HostSensorType is a kind of hardware
+                                          monitoring sensor.
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: set
+                                  type: object
                                 inputs:
                                   description: Inputs describe input devices
                                   items:
@@ -23446,7 +29169,9 @@ var CRDsValidation map[string]string = map[string]string{
                                       type:
                                         description: |-
                                           Type indicated the type of input device.
-                                          Supported values: tablet.
+                                          A tablet is an absolute pointer, a mouse a relative one.
+                                          Multitouch devices are only supported on the virtio bus.
+                                          Supported values: tablet, keyboard, mouse, multitouch.
                                         type: string
                                     required:
                                     - name
@@ -23464,6 +29189,13 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      backend:
+                                        description: |-
+                                          Backend selects the host side backend of the interface.
+                                          One of: vhost, userspace.
+                                          Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                                          Only applies to the virtio model.
+                                        type: string
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -23559,6 +29291,24 @@ var CRDsValidation map[string]string = map[string]string{
                                           Logical name of the interface as well as a reference to the associated networks.
                                           Must match the Name of a Network.
                                         type: string
+                                      offloads:
+                                        description: |-
+                                          Offloads toggles the offloads of the interface on the tap path.
+                                          Only applies to the virtio model.
+                                        properties:
+                                          checksum:
+                                            description: Checksum toggles the checksum
+                                              offload.
+                                            type: boolean
+                                          gso:
+                                            description: GSO toggles the generic segmentation
+                                              offload of the host.
+                                            type: boolean
+                                          tso:
+                                            description: TSO toggles the TCP segmentation
+                                              offload, for IPv4 and IPv6.
+                                            type: boolean
+                                        type: object
                                       passt:
                                         description: |-
                                           DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -23601,6 +29351,13 @@ var CRDsValidation map[string]string = map[string]string{
                                           - port
                                           type: object
                                         type: array
+                                      queues:
+                                        description: |-
+                                          Queues is the number of queues of the interface. If specified, it overrides the
+                                          number of queues derived from networkInterfaceMultiqueue for this interface.
+                                          Only applies to the virtio model.
+                                        format: int64
+                                        type: integer
                                       slirp:
                                         description: |-
                                           DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -23610,6 +29367,25 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: InterfaceSRIOV connects to a
                                           given network by passing-through an SR-IOV
                                           PCI device via vfio.
+                                        properties:
+                                          failover:
+                                            description: |-
+                                              Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                                              MAC address and takes over the traffic while the device is unplugged for live migration.
+                                            properties:
+                                              standbyInterface:
+                                                description: |-
+                                                  StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                                  SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                                  It is set to the generated standby interface when StandbyNetworkName is used.
+                                                type: string
+                                              standbyNetworkName:
+                                                description: |-
+                                                  StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                                  when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                                  interface, which is generated if it is not set.
+                                                type: string
+                                            type: object
                                         type: object
                                       state:
                                         description: |-
@@ -23625,11 +29401,42 @@ var CRDsValidation map[string]string = map[string]string{
                                           interface address and its tag will be provided
                                           to the guest via config drive
                                         type: string
+                                      virtioTransitional:
+                                        description: |-
+                                          If specified, overrides useVirtioTransitional for this interface.
+                                          Only applies to the virtio model.
+                                        type: boolean
                                     required:
                                     - name
                                     type: object
                                   maxItems: 256
                                   type: array
+                                iommu:
+                                  description: |-
+                                    IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                                    DPDK or to assign devices to nested guests.
+                                  properties:
+                                    cachingMode:
+                                      description: |-
+                                        CachingMode makes the guest report every mapping change, which is required
+                                        to assign devices to nested guests. Only supported by the intel model.
+                                      type: boolean
+                                    eim:
+                                      description: |-
+                                        EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                                        Requires interruptRemapping.
+                                      type: boolean
+                                    interruptRemapping:
+                                      description: |-
+                                        InterruptRemapping enables remapping of the interrupts of assigned devices.
+                                        Only supported by the intel model.
+                                      type: boolean
+                                    model:
+                                      description: |-
+                                        Model is the IOMMU model. One of: intel, virtio.
+                                        Defaults to intel on amd64 and to virtio on arm64.
+                                      type: string
+                                  type: object
                                 logSerialConsole:
                                   description: |-
                                     Whether to log the auto-attached default serial console or not.
@@ -23637,6 +29444,10 @@ var CRDsValidation map[string]string = map[string]string{
                                     Not relevant if autoattachSerialConsole is disabled.
                                     Defaults to cluster wide setting on VirtualMachineOptions.
                                   type: boolean
+                                memBalloonVirtioTransitional:
+                                  description: If specified, overrides useVirtioTransitional for the Memory balloon
+                                    device.
+                                  type: boolean
                                 networkInterfaceMultiqueue:
                                   description: If specified, virtual network interfaces
                                     configured with a virtio bus will also enable
@@ -23662,6 +29473,157 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to have random number generator
                                     from host
                                   type: object
+                                scsiControllers:
+                                  description: |-
+                                    SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                                    scsi bus are attached to the controller named in their scsiController field,
+                                    or to the first controller if none is named. Spreading busy disks over several
+                                    controllers with dedicated IO threads improves storage throughput.
+                                    Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                                  items:
+                                    properties:
+                                      dedicatedIOThread:
+                                        description: |-
+                                          DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                                          shared by all disks attached to it. Defaults to false.
+                                        type: boolean
+                                      name:
+                                        description: Name of the controller, referenced
+                                          by the scsiController field of disks.
+                                        type: string
+                                      queues:
+                                        description: |-
+                                          Queues is the number of request queues of the controller.
+                                          Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - name
+                                    type: object
+                                  maxItems: 32
+                                  type: array
+                                  x-kubernetes-list-map-keys:
+                                  - name
+                                  x-kubernetes-list-type: map
+                                serials:
+                                  description: |-
+                                    Serials describe additional serial ports, e.g. for guests which log
+                                    their kernel output to ttyS1. Each port can be connected to with
+                                    virtctl console --serial.
+                                  items:
+                                    properties:
+                                      name:
+                                        description: Name of the serial port.
+                                        type: string
+                                      port:
+                                        description: |-
+                                          Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                                          Port 0 is taken by the default serial console, unless
+                                          autoattachSerialConsole is disabled.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - name
+                                    - port
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-map-keys:
+                                  - name
+                                  x-kubernetes-list-type: map
+                                sgx:
+                                  description: SGX exposes an Intel SGX enclave page
+                                    cache (EPC) section to the vmi, allowing it to
+                                    run SGX enclaves.
+                                  properties:
+                                    epcSize:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                                        It is taken from the EPC of the node, which is not part of its memory.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  required:
+                                  - epcSize
+                                  type: object
+                                sharedMemoryDevices:
+                                  description: |-
+                                    SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                                    co-located VMs or pods to exchange data through them.
+                                  items:
+                                    properties:
+                                      hostPath:
+                                        description: |-
+                                          HostPath is a file on the node backing an ivshmem-plain region, shared with
+                                          every VM and pod on the node mapping the same file. It is created if it
+                                          does not exist and has to be writable by the qemu user.
+                                        type: string
+                                      model:
+                                        description: |-
+                                          Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                                          Defaults to ivshmem-plain.
+                                        type: string
+                                      name:
+                                        description: |-
+                                          Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                                          Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                                          virt-launcher pod and can be shared with its sidecar containers.
+                                        type: string
+                                      serverSocketPath:
+                                        description: |-
+                                          ServerSocketPath is the path on the node of the ivshmem-server socket an
+                                          ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                                        type: string
+                                      size:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Size of the shared memory region. Must be a power of two.
+                                          Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                                          server owns the region.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                smartcard:
+                                  description: Smartcard attaches a CCID smartcard
+                                    reader to the vmi.
+                                  properties:
+                                    hostCertificates:
+                                      description: |-
+                                        HostCertificates emulates a smartcard with certificates of an NSS
+                                        database stored in a Secret.
+                                      properties:
+                                        certificates:
+                                          description: Certificates are the nicknames
+                                            of the three certificates of the emulated
+                                            card.
+                                          items:
+                                            type: string
+                                          maxItems: 3
+                                          minItems: 3
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        secretName:
+                                          description: |-
+                                            SecretName is the name of the Secret holding the files of the NSS database,
+                                            for example cert9.db, key4.db and pkcs11.txt.
+                                          type: string
+                                      required:
+                                      - certificates
+                                      - secretName
+                                      type: object
+                                    passthrough:
+                                      description: |-
+                                        Passthrough forwards the smartcard of a client, which connects to the
+                                        smartcard subresource of the vmi.
+                                      type: object
+                                  type: object
                                 sound:
                                   description: Whether to emulate a sound device.
                                   properties:
@@ -23686,11 +29648,33 @@ var CRDsValidation map[string]string = map[string]string{
                                         Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                         Defaults to True
                                       type: boolean
+                                    encryptionSecretRef:
+                                      description: |-
+                                        EncryptionSecretRef references a Secret in the namespace of the VMI.
+                                        Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                                        It has to be set before the TPM state is created and requires persistent to be enabled.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
                                     persistent:
                                       description: |-
                                         Persistent indicates the state of the TPM device should be kept accross reboots
                                         Defaults to false
                                       type: boolean
+                                    version:
+                                      description: |-
+                                        Version is the version of the emulated TPM, 2.0 or 1.2.
+                                        Defaults to 2.0
+                                      type: string
                                   type: object
                                 useVirtioTransitional:
                                   description: |-
@@ -23702,6 +29686,24 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Video describes the video device configuration
                                     for the vmi.
                                   properties:
+                                    resolution:
+                                      description: |-
+                                        Resolution is the preferred resolution advertised to the guest through EDID.
+                                        Graphical consoles come up at this resolution if the guest display driver honors it.
+                                        Supported by the vga, virtio and bochs video types.
+                                      properties:
+                                        height:
+                                          description: Height in pixels.
+                                          format: int32
+                                          type: integer
+                                        width:
+                                          description: Width in pixels.
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - height
+                                      - width
+                                      type: object
                                     type:
                                       description: |-
                                         Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -23718,7 +29720,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                             Defaults to reset.
                                           type: string
                                       type: object
@@ -23727,17 +29729,39 @@ var CRDsValidation map[string]string = map[string]string{
                                       properties:
                                         action:
                                           description: |-
-                                            The action to take. Valid values are poweroff, reset, shutdown.
+                                            The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                             Defaults to reset.
                                           type: string
                                       type: object
                                     name:
                                       description: Name of the watchdog.
                                       type: string
+                                    remediation:
+                                      description: |-
+                                        Remediation is carried out by KubeVirt once the watchdog expired,
+                                        in addition to the action taken by the watchdog device itself.
+                                      properties:
+                                        memoryDumpClaimName:
+                                          description: |-
+                                            MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                                            Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                                          type: string
+                                        type:
+                                          description: 'Type of the remediation. One
+                                            of: Reset, RestartVM, MemoryDump.'
+                                          type: string
+                                      required:
+                                      - type
+                                      type: object
                                   required:
                                   - name
                                   type: object
                               type: object
+                            emulatorBundle:
+                              description: |-
+                                EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                                Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+                              type: string
                             features:
                               description: Features like acpi, apic, hyperv, smm.
                               properties:
@@ -23961,6 +29985,21 @@ var CRDsValidation map[string]string = map[string]string{
                                         Hide the KVM hypervisor from standard MSR based discovery.
                                         Defaults to false
                                       type: boolean
+                                    hintDedicated:
+                                      description: |-
+                                        HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                                        paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                                        Only supported on amd64.
+                                        Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                                      type: boolean
+                                    pollControl:
+                                      description: |-
+                                        PollControl allows the guest to take over halt polling from the host, e.g. with the
+                                        cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                                        The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                                        Only supported on amd64.
+                                        Defaults to false
+                                      type: boolean
                                   type: object
                                 pvspinlock:
                                   description: |-
@@ -24005,8 +30044,9 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: string
                                   type: object
                                 bootloader:
-                                  description: Settings to control the bootloader
-                                    that is used.
+                                  description: |-
+                                    Settings to control the bootloader that is used.
+                                    Defaulted from the firmware profile, if set.
                                   properties:
                                     bios:
                                       description: If set (default), BIOS will be
@@ -24076,6 +30116,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         at boot time
                                       type: string
                                   type: object
+                                profile:
+                                  description: |-
+                                    Profile is the name of a firmware profile configured in the KubeVirt CR.
+                                    The profile selects the bootloader, and may provide a custom firmware build
+                                    for the architecture of the VMI.
+                                  type: string
                                 serial:
                                   description: The system-serial-number in SMBIOS
                                   type: string
@@ -24111,6 +30157,30 @@ var CRDsValidation map[string]string = map[string]string{
                                     attestation:
                                       description: If specified, run the attestation
                                         process for a vmi.
+                                      properties:
+                                        keyBroker:
+                                          description: |-
+                                            If specified, the launch secret is released by the key broker once it
+                                            verified the launch measurement, and the VMI is unpaused afterwards.
+                                            Otherwise the launch secret has to be injected through the
+                                            sev/injectlaunchsecret subresource.
+                                          properties:
+                                            protocol:
+                                              description: |-
+                                                Protocol spoken with the key broker.
+                                                Defaults to kbs.
+                                              type: string
+                                            resourcePath:
+                                              description: Path of the resource holding
+                                                the launch secret, e.g. default/sev/launch-secret.
+                                              type: string
+                                            url:
+                                              description: URL of the key broker service.
+                                              type: string
+                                          required:
+                                          - resourcePath
+                                          - url
+                                          type: object
                                       type: object
                                     dhCert:
                                       description: Base64 encoded guest owner's Diffie-Hellman
@@ -24137,6 +30207,32 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: object
                                 tdx:
                                   description: Intel Trust Domain Extensions (TDX).
+                                  properties:
+                                    attestation:
+                                      description: If specified, the guest can request quotes from the quote generation
+                                        service of the node.
+                                      type: object
+                                    mrConfigId:
+                                      description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                                      type: string
+                                    mrOwner:
+                                      description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                                      type: string
+                                    mrOwnerConfig:
+                                      description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                                        (MROWNERCONFIG).
+                                      type: string
+                                    policy:
+                                      description: |-
+                                        Guest policy flags as defined in the Intel TDX module specification.
+                                        Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                                      properties:
+                                        septVEDisable:
+                                          description: |-
+                                            Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                                            Defaults to false.
+                                          type: boolean
+                                      type: object
                                   type: object
                               type: object
                             machine:
@@ -24147,10 +30243,28 @@ var CRDsValidation map[string]string = map[string]string{
                                     of the VirtualMachineInstance.
                                   type: string
                               type: object
+                            machineOptions:
+                              description: |-
+                                MachineOptions allows to turn off legacy devices of the machine,
+                                reducing the attack surface of hardened guests.
+                              properties:
+                                ps2:
+                                  description: |-
+                                    PS2 controls the legacy PS/2 (i8042) controller.
+                                    Defaults to true.
+                                  type: boolean
+                              type: object
                             memory:
                               description: Memory allow specifying the VMI memory
                                 features.
                               properties:
+                                dimmSlots:
+                                  description: |-
+                                    DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                                    is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                                    DIMMs can not be hot unplugged.
+                                  format: int32
+                                  type: integer
                                 guest:
                                   anyOf:
                                   - type: integer
@@ -24215,6 +30329,13 @@ var CRDsValidation map[string]string = map[string]string{
                                     Valid resource keys are "memory" and "cpu".
                                   type: object
                               type: object
+                            useEmulation:
+                              description: |-
+                                UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                                The vmi does not request /dev/kvm, can run on any node and performs
+                                significantly slower than a hardware accelerated guest.
+                                Intended for CI and cross-architecture testing.
+                              type: boolean
                           required:
                           - devices
                           type: object
@@ -24227,11 +30348,72 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        guestSecrets:
+                          description: |-
+                            GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+                            without writing them to any disk image.
+                          items:
+                            description: GuestSecret delivers the value of a key of a Secret to the guest.
+                            properties:
+                              key:
+                                description: Key of the Secret whose value is delivered.
+                                type: string
+                              name:
+                                description: Name of the guest secret, unique within the VMI. It names the
+                                  fw_cfg blob.
+                                type: string
+                              secretName:
+                                description: SecretName is the name of the Secret in the namespace of the VMI.
+                                type: string
+                              target:
+                                description: |-
+                                  Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+                                  the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+                                  secret is delivered on the next start.
+                                  Defaults to FWCfg.
+                                enum:
+                                - FWCfg
+                                type: string
+                            required:
+                            - key
+                            - name
+                            - secretName
+                            type: object
+                          maxItems: 32
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
                         hostname:
                           description: |-
                             Specifies the hostname of the vmi
                             If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                           type: string
+                        launcherIsolation:
+                          description: |-
+                            LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+                            the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+                            Defaults to Container.
+                          enum:
+                          - Container
+                          - MicroVM
+                          type: string
+                        launcherMetadata:
+                          description: |-
+                            LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+                            The keys have to be allowed in launcherPodConfiguration.
+                          properties:
+                            annotations:
+                              additionalProperties:
+                                type: string
+                              description: Annotations to add to the virt-launcher pod
+                              type: object
+                            labels:
+                              additionalProperties:
+                                type: string
+                              description: Labels to add to the virt-launcher pod
+                              type: object
+                          type: object
                         livenessProbe:
                           description: |-
                             Periodic probe of VirtualMachineInstance liveness.
@@ -24263,6 +30445,22 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentExec:
+                              description: |-
+                                GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                                The probe succeeds if the command exits with status 0.
+                              properties:
+                                command:
+                                  description: |-
+                                    Command is the command line to execute inside the guest. The first element is the
+                                    executable, the remaining ones are passed as arguments. It is not run in a shell.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - command
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -24366,6 +30564,23 @@ var CRDsValidation map[string]string = map[string]string{
                                 More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                               format: int32
                               type: integer
+                            vsockHTTP:
+                              description: |-
+                                VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                                It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                              properties:
+                                path:
+                                  description: Path to access on the HTTP server.
+                                    Defaults to "/".
+                                  type: string
+                                port:
+                                  description: Port is the VSOCK port the guest service
+                                    listens on.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - port
+                              type: object
                           type: object
                         networks:
                           description: List of networks that can be attached to a
@@ -24461,6 +30676,22 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            guestAgentExec:
+                              description: |-
+                                GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                                The probe succeeds if the command exits with status 0.
+                              properties:
+                                command:
+                                  description: |-
+                                    Command is the command line to execute inside the guest. The first element is the
+                                    executable, the remaining ones are passed as arguments. It is not run in a shell.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              required:
+                              - command
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -24564,6 +30795,23 @@ var CRDsValidation map[string]string = map[string]string{
                                 More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                               format: int32
                               type: integer
+                            vsockHTTP:
+                              description: |-
+                                VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                                It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                              properties:
+                                path:
+                                  description: Path to access on the HTTP server.
+                                    Defaults to "/".
+                                  type: string
+                                port:
+                                  description: Port is the VSOCK port the guest service
+                                    listens on.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - port
+                              type: object
                           type: object
                         resourceClaims:
                           description: |-
@@ -24571,6 +30819,11 @@ var CRDsValidation map[string]string = map[string]string{
                             and reserved before the VMI, hence virt-launcher pod is allowed to start. The resources
                             will be made available to the domain which consumes them
                             by name.
+                        runtimeClassName:
+                          description: |-
+                            If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+                            cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+                          type: string
 
                             This is an alpha field and requires enabling the
                             DynamicResourceAllocation feature gate in kubernetes
@@ -25019,6 +31272,10 @@ var CRDsValidation map[string]string = map[string]string{
                                   ConfigMapSource represents a reference to a ConfigMap in the same namespace.
                                   More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
                                 properties:
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
                                   name:
                                     default: ""
                                     description: |-
@@ -25252,6 +31509,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  format:
+                                    description: Format of the memory dump, Core by
+                                      default
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -25298,6 +31559,10 @@ var CRDsValidation map[string]string = map[string]string{
                                   SecretVolumeSource represents a reference to a secret data in the same namespace.
                                   More info: https://kubernetes.io/docs/concepts/configuration/secret/
                                 properties:
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
                                   optional:
                                     description: Specify whether the Secret or it's
                                       keys must be defined
@@ -25320,6 +31585,10 @@ var CRDsValidation map[string]string = map[string]string{
                                   There can only be one volume of this type!
                                   More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
                                 properties:
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
                                   serviceAccountName:
                                     description: |-
                                       Name of the service account in the pod's namespace to use.
@@ -25480,6 +31749,10 @@ var CRDsValidation map[string]string = map[string]string{
                       type: integer
                   type: object
               type: object
+            preferredPTP:
+              description: PreferredPTP optionally enables the PTP hardware clock
+                of the ptp_kvm driver in the guest.
+              type: boolean
             preferredTimer:
               description: Timer specifies whih timers are attached to the vmi.
               properties:
@@ -25726,11 +31999,33 @@ var CRDsValidation map[string]string = map[string]string{
                     Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                     Defaults to True
                   type: boolean
+                encryptionSecretRef:
+                  description: |-
+                    EncryptionSecretRef references a Secret in the namespace of the VMI.
+                    Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                    It has to be set before the TPM state is created and requires persistent to be enabled.
+                  properties:
+                    name:
+                      default: ""
+                      description: |-
+                        Name of the referent.
+                        This field is effectively required, but due to backwards compatibility is
+                        allowed to be empty. Instances of this type with an empty value here are
+                        almost certainly wrong.
+                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                      type: string
+                  type: object
+                  x-kubernetes-map-type: atomic
                 persistent:
                   description: |-
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version is the version of the emulated TPM, 2.0 or 1.2.
+                    Defaults to 2.0
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -25972,6 +32267,21 @@ var CRDsValidation map[string]string = map[string]string{
                     Hide the KVM hypervisor from standard MSR based discovery.
                     Defaults to false
                   type: boolean
+                hintDedicated:
+                  description: |-
+                    HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                    paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                    Only supported on amd64.
+                    Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                  type: boolean
+                pollControl:
+                  description: |-
+                    PollControl allows the guest to take over halt polling from the host, e.g. with the
+                    cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                    The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                    Only supported on amd64.
+                    Defaults to false
+                  type: boolean
               type: object
             preferredPvspinlock:
               description: PreferredPvspinlock optionally enables the Pvspinlock feature
@@ -26045,6 +32355,11 @@ var CRDsValidation map[string]string = map[string]string{
                 type to use.
               type: string
           type: object
+        performanceProfile:
+          description: |-
+            PerformanceProfile optionally expands into a curated set of preferences tuning the VirtualMachine for a class of
+            workloads. Preferences defined explicitly take precedence over the ones of the profile.
+          type: string
         preferSpreadSocketToCoreRatio:
           description: PreferSpreadSocketToCoreRatio defines the ratio to spread vCPUs
             between cores and sockets, it defaults to 2.
@@ -26131,6 +32446,33 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore
         resource
       properties:
+        firmwareIdentityPolicy:
+          description: |-
+            FirmwareIdentityPolicy defines whether the restored VM keeps the firmware UUID and SMBIOS serial
+            of the snapshotted VM, or gets new ones. Defaults to Preserve.
+          type: string
+        networkMappings:
+          description: |-
+            NetworkMappings replaces the Multus networks of the snapshotted VM
+            by the networks of the restored VM
+          items:
+            description: NetworkMapping maps a Multus network of the snapshotted
+              VM to a network of the restored VM
+            properties:
+              source:
+                description: Source is the network name of the snapshotted VM, as
+                  referenced by its Multus networks
+                type: string
+              target:
+                description: Target is the network name of the restored VM, in the
+                  format <namespace>/<name> or <name>
+                type: string
+            required:
+            - source
+            - target
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         patches:
           description: |-
             If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be
@@ -26141,6 +32483,26 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        storageClassMappings:
+          description: |-
+            StorageClassMappings replaces the storage classes of the snapshotted volumes
+            by the storage classes of the restored volumes
+          items:
+            description: StorageClassMapping maps a storage class of the snapshotted
+              volumes to a storage class of the restored volumes
+            properties:
+              source:
+                description: Source is the storage class of the snapshotted volumes
+                type: string
+              target:
+                description: Target is the storage class of the restored volumes
+                type: string
+            required:
+            - source
+            - target
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         target:
           description: initially only VirtualMachine type supported
           properties:
@@ -26304,6 +32666,13 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        includeMemory:
+          description: |-
+            IncludeMemory captures the memory state of a running VM along with its
+            volumes, so that a restore of the snapshot resumes the guest where it was.
+            The VM is paused until its volumes are snapshotted.
+            Requires the SnapshotMemory feature gate.
+          type: boolean
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -27229,6 +33598,72 @@ var CRDsValidation map[string]string = map[string]string{
                         Mutually exclusive with RunStrategy
                         Deprecated: VirtualMachineInstance field "Running" is now deprecated, please use RunStrategy instead.
                       type: boolean
+                    sealed:
+                      description: |-
+                        Sealed holds the defaults which were chosen once for the VirtualMachine and are kept
+                        for its whole lifetime, instead of being written into the template.
+                        Values set in the template take precedence.
+                        Only populated with the SealedVMDefaults feature gate.
+                      properties:
+                        disks:
+                          description: Disks holds the PCI addresses of the disks
+                            of the template which do not set one.
+                          items:
+                            description: SealedDisk is the PCI address chosen for
+                              a disk of the template.
+                            properties:
+                              name:
+                                description: Name of the disk in the template.
+                                type: string
+                              pciAddress:
+                                description: PCIAddress of the disk in the guest,
+                                  e.g. 0000:02:00.0
+                                type: string
+                            required:
+                            - name
+                            - pciAddress
+                            type: object
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        firmwareSerial:
+                          description: FirmwareSerial is the serial number exposed
+                            to the guest if the template does not set one.
+                          type: string
+                        firmwareUUID:
+                          description: FirmwareUUID is the UUID exposed to the guest
+                            if the template does not set one.
+                          type: string
+                        interfaces:
+                          description: Interfaces holds the MAC and PCI addresses
+                            of the interfaces of the template which do not set them.
+                          items:
+                            description: SealedInterface is the MAC and PCI address
+                              chosen for an interface of the template.
+                            properties:
+                              macAddress:
+                                description: MACAddress of the interface.
+                                type: string
+                              name:
+                                description: Name of the interface in the template.
+                                type: string
+                              pciAddress:
+                                description: PCIAddress of the interface in the guest,
+                                  e.g. 0000:01:00.0
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-map-keys:
+                          - name
+                          x-kubernetes-list-type: map
+                        machineType:
+                          description: MachineType is the machine type used if the
+                            template does not set one.
+                          type: string
+                      type: object
                     template:
                       description: Template is the direct specification of VirtualMachineInstance
                       properties:
@@ -28292,6 +34727,24 @@ var CRDsValidation map[string]string = map[string]string{
                                 you are attempting to run. Defaults to the compiled
                                 architecture of the KubeVirt components
                               type: string
+                            diskSpaceLow:
+                              description: |-
+                                DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+                                is filling up. The utilization of the guest filesystems is reported by the guest agent.
+                              properties:
+                                hysteresisPercentage:
+                                  description: |-
+                                    HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+                                    below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+                                  format: int32
+                                  type: integer
+                                usedPercentage:
+                                  description: |-
+                                    UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+                                    condition is reported. Defaults to 90.
+                                  format: int32
+                                  type: integer
+                              type: object
                             dnsConfig:
                               description: |-
                                 Specifies the DNS parameters of a pod.
@@ -28371,6 +34824,17 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    ptp:
+                                      description: |-
+                                        PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+                                        which guests can discipline their clock to with sub-microsecond accuracy.
+                                      properties:
+                                        enabled:
+                                          description: |-
+                                            Enabled set to false makes sure that a preference can't add the clock.
+                                            Defaults to true.
+                                          type: boolean
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -28470,6 +34934,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: CPU allow specified the detailed CPU
                                     topology inside the vmi.
                                   properties:
+                                    coreType:
+                                      description: |-
+                                        CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+                                        on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+                                        Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+                                      type: string
                                     cores:
                                       description: |-
                                         Cores specifies the number of cores inside the vmi.
@@ -28524,6 +34994,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         and "host-model" to get CPU closest to the node one.
                                         Defaults to host-model.
                                       type: string
+                                    nestedVirtualization:
+                                      description: |-
+                                        NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+                                        and schedules the VMI only on nodes whose KVM module allows nested guests.
+                                        Only supported on amd64.
+                                      type: boolean
                                     numa:
                                       description: NUMA allows specifying settings
                                         for the guest NUMA topology
@@ -28597,9 +35073,72 @@ var CRDsValidation map[string]string = map[string]string{
                                         Whether or not to enable virtio multi-queue for block devices.
                                         Defaults to false.
                                       type: boolean
+                                    channels:
+                                      description: |-
+                                        Channels adds virtio-serial channels backed by unix sockets, allowing custom
+                                        in-guest agents to talk to sidecars or node agents without networking.
+                                      items:
+                                        properties:
+                                          hostPath:
+                                            description: |-
+                                              HostPath is a directory on the node the channel socket is created in.
+                                              By default the socket is created in /var/run/kubevirt-channels, a pod-local
+                                              directory shared with the hook sidecars of the vmi.
+                                            type: string
+                                          name:
+                                            description: |-
+                                              Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+                                              The channel socket is named <name>.sock.
+                                            type: string
+                                          target:
+                                            description: |-
+                                              Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+                                              In Linux guests the port shows up as /dev/virtio-ports/<target>.
+                                            type: string
+                                        required:
+                                        - name
+                                        - target
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     clientPassthrough:
                                       description: To configure and access client
                                         devices such as redirecting USB
+                                      properties:
+                                        count:
+                                          description: |-
+                                            Count is the number of USB devices which can be redirected at the same time.
+                                            Defaults to UsbClientPassthroughMaxNumberOf.
+                                          format: int32
+                                          type: integer
+                                        filter:
+                                          description: |-
+                                            Filter restricts the USB devices which can be redirected by their class.
+                                            It is enforced by virt-handler when proxying the redirection.
+                                          properties:
+                                            allow:
+                                              description: |-
+                                                Allow lists the device classes which can be redirected. If empty, all
+                                                device classes which are not denied can be redirected.
+                                              items:
+                                                description: USBDeviceClass is the
+                                                  class of a USB device or of one
+                                                  of its interfaces.
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: set
+                                            deny:
+                                              description: |-
+                                                Deny lists the device classes which can not be redirected. Deny takes
+                                                precedence over allow.
+                                              items:
+                                                description: USBDeviceClass is the
+                                                  class of a USB device or of one
+                                                  of its interfaces.
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: set
+                                          type: object
                                       type: object
                                     disableHotplug:
                                       description: DisableHotplug disabled the ability
@@ -28693,7 +35232,7 @@ var CRDsValidation map[string]string = map[string]string{
                                               bus:
                                                 description: |-
                                                   Bus indicates the type of disk device to emulate.
-                                                  supported values: virtio, sata, scsi, usb.
+                                                  supported values: virtio, sata, scsi, usb, nvme.
                                                 type: string
                                               pciAddress:
                                                 description: 'If specified, the virtual
@@ -28706,6 +35245,11 @@ var CRDsValidation map[string]string = map[string]string{
                                                   ReadOnly.
                                                   Defaults to false.
                                                 type: boolean
+                                              virtioTransitional:
+                                                description: |-
+                                                  If specified, overrides useVirtioTransitional for this disk.
+                                                  Only applies to the virtio bus.
+                                                type: boolean
                                             type: object
                                           errorPolicy:
                                             description: If specified, it can change
@@ -28717,6 +35261,92 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: IOTune throttles the IO of
+                                              the disk. The limits are enforced by
+                                              QEMU.
+                                            properties:
+                                              burst:
+                                                description: Burst allows the disk
+                                                  to exceed the limits for a short
+                                                  time.
+                                                properties:
+                                                  lengthSeconds:
+                                                    description: |-
+                                                      LengthSeconds is the maximum duration of a burst in seconds.
+                                                      Defaults to 1.
+                                                    format: int64
+                                                    type: integer
+                                                  readBytesSec:
+                                                    description: ReadBytesSec limits
+                                                      the read throughput in bytes
+                                                      per second during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  readIOPSSec:
+                                                    description: ReadIOPSSec limits
+                                                      the read IO operations per second
+                                                      during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  totalBytesSec:
+                                                    description: TotalBytesSec limits
+                                                      the total throughput in bytes
+                                                      per second during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  totalIOPSSec:
+                                                    description: TotalIOPSSec limits
+                                                      the total IO operations per
+                                                      second during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  writeBytesSec:
+                                                    description: WriteBytesSec limits
+                                                      the write throughput in bytes
+                                                      per second during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                  writeIOPSSec:
+                                                    description: WriteIOPSSec limits
+                                                      the write IO operations per
+                                                      second during a burst.
+                                                    format: int64
+                                                    type: integer
+                                                type: object
+                                              readBytesSec:
+                                                description: ReadBytesSec limits the
+                                                  read throughput in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec limits the
+                                                  read IO operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec limits
+                                                  the total throughput in bytes per
+                                                  second.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec limits the
+                                                  total IO operations per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec limits
+                                                  the write throughput in bytes per
+                                                  second.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec limits the
+                                                  write IO operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -28741,11 +35371,35 @@ var CRDsValidation map[string]string = map[string]string{
                                           name:
                                             description: Name is the device name
                                             type: string
+                                          queues:
+                                            description: |-
+                                              Queues is the number of queues of the disk. If specified, it overrides the
+                                              number of queues derived from blockMultiQueue for this disk.
+                                              Only supported on the virtio bus.
+                                            format: int64
+                                            type: integer
+                                          rerrorPolicy:
+                                            description: |-
+                                              If specified, it changes the error policy for read errors of the disk.
+                                              Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                                            type: string
+                                          scsiController:
+                                            description: |-
+                                              SCSIController is the name of the scsi controller the disk is attached to.
+                                              Only supported on the scsi bus. Defaults to the first scsi controller.
+                                            type: string
                                           serial:
                                             description: Serial provides the ability
                                               to specify a serial number for the disk
                                               device.
                                             type: string
+                                          serialPolicy:
+                                            description: |-
+                                              SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                                              Supported values are: None, VolumeNameHash.
+                                              VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                                              when disks are reordered. Defaults to None.
+                                            type: string
                                           shareable:
                                             description: If specified the disk is
                                               made sharable and multiple write from
@@ -28756,6 +35410,11 @@ var CRDsValidation map[string]string = map[string]string{
                                               and its tag will be provided to the
                                               guest via config drive metadata
                                             type: string
+                                          wwn:
+                                            description: |-
+                                              WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                                              Only supported on the scsi bus.
+                                            type: string
                                         required:
                                         - name
                                         type: object
@@ -28776,6 +35435,72 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                           virtiofs:
                                             description: Virtiofs is supported
+                                            properties:
+                                              idMap:
+                                                description: |-
+                                                  IDMap maps the user and group IDs of the guest to the IDs owning the
+                                                  files of the shared volume.
+                                                properties:
+                                                  gid:
+                                                    description: GID maps ranges of group IDs.
+                                                    items:
+                                                      properties:
+                                                        count:
+                                                          description: Count is the number of IDs in the range.
+                                                          format: int32
+                                                          type: integer
+                                                        start:
+                                                          description: Start is the first ID of the range in the guest.
+                                                          format: int32
+                                                          type: integer
+                                                        target:
+                                                          description: Target is the first ID of the range on the shared volume.
+                                                          format: int32
+                                                          type: integer
+                                                      required:
+                                                      - count
+                                                      - start
+                                                      - target
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                  uid:
+                                                    description: UID maps ranges of user IDs.
+                                                    items:
+                                                      properties:
+                                                        count:
+                                                          description: Count is the number of IDs in the range.
+                                                          format: int32
+                                                          type: integer
+                                                        start:
+                                                          description: Start is the first ID of the range in the guest.
+                                                          format: int32
+                                                          type: integer
+                                                        target:
+                                                          description: Target is the first ID of the range on the shared volume.
+                                                          format: int32
+                                                          type: integer
+                                                      required:
+                                                      - count
+                                                      - start
+                                                      - target
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                type: object
+                                              queueSize:
+                                                description: |-
+                                                  QueueSize is the size of the virtqueue of the device.
+                                                  Must be a power of two not larger than 1024. Defaults to 1024.
+                                                format: int32
+                                                type: integer
+                                              threadPoolSize:
+                                                description: |-
+                                                  ThreadPoolSize is the number of threads virtiofsd uses to handle the
+                                                  requests of the guest. 0 handles them in the thread of the queue.
+                                                  Defaults to the default of virtiofsd.
+                                                format: int32
+                                                type: integer
                                             type: object
                                         required:
                                         - name
@@ -28839,6 +35564,18 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                       type: array
                                       x-kubernetes-list-type: atomic
+                                    graphics:
+                                      description: |-
+                                        Graphics describes the configuration of the default graphics device.
+                                        Not relevant if autoattachGraphicsDevice is disabled.
+                                      properties:
+                                        keymap:
+                                          description: |-
+                                            Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+                                            It is only needed for VNC clients which send keysyms instead of raw keycodes.
+                                            Defaults to en-us.
+                                          type: string
+                                      type: object
                                     hostDevices:
                                       description: Whether to attach a host device
                                         to the vmi.
@@ -28871,6 +35608,22 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                       type: array
                                       x-kubernetes-list-type: atomic
+                                    hostSensors:
+                                      description: HostSensors creates a virtio serial
+                                        for exposing temperature and power readings
+                                        of the node to the vmi.
+                                      properties:
+                                        types:
+                                          description: |-
+                                            Types are the kinds of sensors exposed to the vmi.
+                                            Defaults to all of them.
+                                          items:
+                                            description: HostSensorType is a kind
+                                              of hardware monitoring sensor.
+                                            type: string
+                                          type: array
+                                          x-kubernetes-list-type: set
+                                      type: object
                                     inputs:
                                       description: Inputs describe input devices
                                       items:
@@ -28886,7 +35639,9 @@ var CRDsValidation map[string]string = map[string]string{
                                           type:
                                             description: |-
                                               Type indicated the type of input device.
-                                              Supported values: tablet.
+                                              A tablet is an absolute pointer, a mouse a relative one.
+                                              Multitouch devices are only supported on the virtio bus.
+                                              Supported values: tablet, keyboard, mouse, multitouch.
                                             type: string
                                         required:
                                         - name
@@ -28904,6 +35659,13 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          backend:
+                                            description: |-
+                                              Backend selects the host side backend of the interface.
+                                              One of: vhost, userspace.
+                                              Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+                                              Only applies to the virtio model.
+                                            type: string
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -28999,6 +35761,24 @@ var CRDsValidation map[string]string = map[string]string{
                                               Logical name of the interface as well as a reference to the associated networks.
                                               Must match the Name of a Network.
                                             type: string
+                                          offloads:
+                                            description: |-
+                                              Offloads toggles the offloads of the interface on the tap path.
+                                              Only applies to the virtio model.
+                                            properties:
+                                              checksum:
+                                                description: Checksum toggles the
+                                                  checksum offload.
+                                                type: boolean
+                                              gso:
+                                                description: GSO toggles the generic
+                                                  segmentation offload of the host.
+                                                type: boolean
+                                              tso:
+                                                description: TSO toggles the TCP segmentation
+                                                  offload, for IPv4 and IPv6.
+                                                type: boolean
+                                            type: object
                                           passt:
                                             description: |-
                                               DeprecatedPasst is an alias to the deprecated Passt interface,
@@ -29041,6 +35821,13 @@ var CRDsValidation map[string]string = map[string]string{
                                               - port
                                               type: object
                                             type: array
+                                          queues:
+                                            description: |-
+                                              Queues is the number of queues of the interface. If specified, it overrides the
+                                              number of queues derived from networkInterfaceMultiqueue for this interface.
+                                              Only applies to the virtio model.
+                                            format: int64
+                                            type: integer
                                           slirp:
                                             description: |-
                                               DeprecatedSlirp is an alias to the deprecated Slirp interface
@@ -29050,6 +35837,25 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: InterfaceSRIOV connects to
                                               a given network by passing-through an
                                               SR-IOV PCI device via vfio.
+                                            properties:
+                                              failover:
+                                                description: |-
+                                                  Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+                                                  MAC address and takes over the traffic while the device is unplugged for live migration.
+                                                properties:
+                                                  standbyInterface:
+                                                    description: |-
+                                                      StandbyInterface is the name of the bridge-bound virtio interface which backs the
+                                                      SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+                                                      It is set to the generated standby interface when StandbyNetworkName is used.
+                                                    type: string
+                                                  standbyNetworkName:
+                                                    description: |-
+                                                      StandbyNetworkName is the Multus network of the standby interface, which is generated
+                                                      when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+                                                      interface, which is generated if it is not set.
+                                                    type: string
+                                                type: object
                                             type: object
                                           state:
                                             description: |-
@@ -29066,11 +35872,42 @@ var CRDsValidation map[string]string = map[string]string{
                                               will be provided to the guest via config
                                               drive
                                             type: string
+                                          virtioTransitional:
+                                            description: |-
+                                              If specified, overrides useVirtioTransitional for this interface.
+                                              Only applies to the virtio model.
+                                            type: boolean
                                         required:
                                         - name
                                         type: object
                                       maxItems: 256
                                       type: array
+                                    iommu:
+                                      description: |-
+                                        IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+                                        DPDK or to assign devices to nested guests.
+                                      properties:
+                                        cachingMode:
+                                          description: |-
+                                            CachingMode makes the guest report every mapping change, which is required
+                                            to assign devices to nested guests. Only supported by the intel model.
+                                          type: boolean
+                                        eim:
+                                          description: |-
+                                            EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+                                            Requires interruptRemapping.
+                                          type: boolean
+                                        interruptRemapping:
+                                          description: |-
+                                            InterruptRemapping enables remapping of the interrupts of assigned devices.
+                                            Only supported by the intel model.
+                                          type: boolean
+                                        model:
+                                          description: |-
+                                            Model is the IOMMU model. One of: intel, virtio.
+                                            Defaults to intel on amd64 and to virtio on arm64.
+                                          type: string
+                                      type: object
                                     logSerialConsole:
                                       description: |-
                                         Whether to log the auto-attached default serial console or not.
@@ -29078,6 +35915,10 @@ var CRDsValidation map[string]string = map[string]string{
                                         Not relevant if autoattachSerialConsole is disabled.
                                         Defaults to cluster wide setting on VirtualMachineOptions.
                                       type: boolean
+                                    memBalloonVirtioTransitional:
+                                      description: If specified, overrides useVirtioTransitional for the Memory balloon
+                                        device.
+                                      type: boolean
                                     networkInterfaceMultiqueue:
                                       description: If specified, virtual network interfaces
                                         configured with a virtio bus will also enable
@@ -29103,6 +35944,157 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to have random number generator
                                         from host
                                       type: object
+                                    scsiControllers:
+                                      description: |-
+                                        SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+                                        scsi bus are attached to the controller named in their scsiController field,
+                                        or to the first controller if none is named. Spreading busy disks over several
+                                        controllers with dedicated IO threads improves storage throughput.
+                                        Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+                                      items:
+                                        properties:
+                                          dedicatedIOThread:
+                                            description: |-
+                                              DedicatedIOThread indicates the controller should have an exclusive IO thread,
+                                              shared by all disks attached to it. Defaults to false.
+                                            type: boolean
+                                          name:
+                                            description: Name of the controller, referenced
+                                              by the scsiController field of disks.
+                                            type: string
+                                          queues:
+                                            description: |-
+                                              Queues is the number of request queues of the controller.
+                                              Defaults to the number of vCPUs if blockMultiQueue is enabled.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - name
+                                        type: object
+                                      maxItems: 32
+                                      type: array
+                                      x-kubernetes-list-map-keys:
+                                      - name
+                                      x-kubernetes-list-type: map
+                                    serials:
+                                      description: |-
+                                        Serials describe additional serial ports, e.g. for guests which log
+                                        their kernel output to ttyS1. Each port can be connected to with
+                                        virtctl console --serial.
+                                      items:
+                                        properties:
+                                          name:
+                                            description: Name of the serial port.
+                                            type: string
+                                          port:
+                                            description: |-
+                                              Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+                                              Port 0 is taken by the default serial console, unless
+                                              autoattachSerialConsole is disabled.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - name
+                                        - port
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-map-keys:
+                                      - name
+                                      x-kubernetes-list-type: map
+                                    sgx:
+                                      description: SGX exposes an Intel SGX enclave
+                                        page cache (EPC) section to the vmi, allowing
+                                        it to run SGX enclaves.
+                                      properties:
+                                        epcSize:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: |-
+                                            EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+                                            It is taken from the EPC of the node, which is not part of its memory.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      required:
+                                      - epcSize
+                                      type: object
+                                    sharedMemoryDevices:
+                                      description: |-
+                                        SharedMemoryDevices exposes shared memory regions to the guest, allowing
+                                        co-located VMs or pods to exchange data through them.
+                                      items:
+                                        properties:
+                                          hostPath:
+                                            description: |-
+                                              HostPath is a file on the node backing an ivshmem-plain region, shared with
+                                              every VM and pod on the node mapping the same file. It is created if it
+                                              does not exist and has to be writable by the qemu user.
+                                            type: string
+                                          model:
+                                            description: |-
+                                              Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+                                              Defaults to ivshmem-plain.
+                                            type: string
+                                          name:
+                                            description: |-
+                                              Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+                                              Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+                                              virt-launcher pod and can be shared with its sidecar containers.
+                                            type: string
+                                          serverSocketPath:
+                                            description: |-
+                                              ServerSocketPath is the path on the node of the ivshmem-server socket an
+                                              ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+                                            type: string
+                                          size:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: |-
+                                              Size of the shared memory region. Must be a power of two.
+                                              Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+                                              server owns the region.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    smartcard:
+                                      description: Smartcard attaches a CCID smartcard
+                                        reader to the vmi.
+                                      properties:
+                                        hostCertificates:
+                                          description: |-
+                                            HostCertificates emulates a smartcard with certificates of an NSS
+                                            database stored in a Secret.
+                                          properties:
+                                            certificates:
+                                              description: Certificates are the nicknames
+                                                of the three certificates of the emulated
+                                                card.
+                                              items:
+                                                type: string
+                                              maxItems: 3
+                                              minItems: 3
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            secretName:
+                                              description: |-
+                                                SecretName is the name of the Secret holding the files of the NSS database,
+                                                for example cert9.db, key4.db and pkcs11.txt.
+                                              type: string
+                                          required:
+                                          - certificates
+                                          - secretName
+                                          type: object
+                                        passthrough:
+                                          description: |-
+                                            Passthrough forwards the smartcard of a client, which connects to the
+                                            smartcard subresource of the vmi.
+                                          type: object
+                                      type: object
                                     sound:
                                       description: Whether to emulate a sound device.
                                       properties:
@@ -29127,11 +36119,33 @@ var CRDsValidation map[string]string = map[string]string{
                                             Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine
                                             Defaults to True
                                           type: boolean
+                                        encryptionSecretRef:
+                                          description: |-
+                                            EncryptionSecretRef references a Secret in the namespace of the VMI.
+                                            Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+                                            It has to be set before the TPM state is created and requires persistent to be enabled.
+                                          properties:
+                                            name:
+                                              default: ""
+                                              description: |-
+                                                Name of the referent.
+                                                This field is effectively required, but due to backwards compatibility is
+                                                allowed to be empty. Instances of this type with an empty value here are
+                                                almost certainly wrong.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                              type: string
+                                          type: object
+                                          x-kubernetes-map-type: atomic
                                         persistent:
                                           description: |-
                                             Persistent indicates the state of the TPM device should be kept accross reboots
                                             Defaults to false
                                           type: boolean
+                                        version:
+                                          description: |-
+                                            Version is the version of the emulated TPM, 2.0 or 1.2.
+                                            Defaults to 2.0
+                                          type: string
                                       type: object
                                     useVirtioTransitional:
                                       description: |-
@@ -29143,6 +36157,24 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Video describes the video device
                                         configuration for the vmi.
                                       properties:
+                                        resolution:
+                                          description: |-
+                                            Resolution is the preferred resolution advertised to the guest through EDID.
+                                            Graphical consoles come up at this resolution if the guest display driver honors it.
+                                            Supported by the vga, virtio and bochs video types.
+                                          properties:
+                                            height:
+                                              description: Height in pixels.
+                                              format: int32
+                                              type: integer
+                                            width:
+                                              description: Width in pixels.
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - height
+                                          - width
+                                          type: object
                                         type:
                                           description: |-
                                             Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).
@@ -29159,7 +36191,7 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                                 Defaults to reset.
                                               type: string
                                           type: object
@@ -29168,17 +36200,39 @@ var CRDsValidation map[string]string = map[string]string{
                                           properties:
                                             action:
                                               description: |-
-                                                The action to take. Valid values are poweroff, reset, shutdown.
+                                                The action to take. Valid values are poweroff, reset, shutdown, pause, none.
                                                 Defaults to reset.
                                               type: string
                                           type: object
                                         name:
                                           description: Name of the watchdog.
                                           type: string
+                                        remediation:
+                                          description: |-
+                                            Remediation is carried out by KubeVirt once the watchdog expired,
+                                            in addition to the action taken by the watchdog device itself.
+                                          properties:
+                                            memoryDumpClaimName:
+                                              description: |-
+                                                MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+                                                Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+                                              type: string
+                                            type:
+                                              description: 'Type of the remediation.
+                                                One of: Reset, RestartVM, MemoryDump.'
+                                              type: string
+                                          required:
+                                          - type
+                                          type: object
                                       required:
                                       - name
                                       type: object
                                   type: object
+                                emulatorBundle:
+                                  description: |-
+                                    EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+                                    Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+                                  type: string
                                 features:
                                   description: Features like acpi, apic, hyperv, smm.
                                   properties:
@@ -29402,6 +36456,21 @@ var CRDsValidation map[string]string = map[string]string{
                                             Hide the KVM hypervisor from standard MSR based discovery.
                                             Defaults to false
                                           type: boolean
+                                        hintDedicated:
+                                          description: |-
+                                            HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+                                            paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+                                            Only supported on amd64.
+                                            Defaults to true with dedicated CPU placement on amd64, false otherwise.
+                                          type: boolean
+                                        pollControl:
+                                          description: |-
+                                            PollControl allows the guest to take over halt polling from the host, e.g. with the
+                                            cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+                                            The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+                                            Only supported on amd64.
+                                            Defaults to false
+                                          type: boolean
                                       type: object
                                     pvspinlock:
                                       description: |-
@@ -29446,8 +36515,9 @@ var CRDsValidation map[string]string = map[string]string{
                                           type: string
                                       type: object
                                     bootloader:
-                                      description: Settings to control the bootloader
-                                        that is used.
+                                      description: |-
+                                        Settings to control the bootloader that is used.
+                                        Defaulted from the firmware profile, if set.
                                       properties:
                                         bios:
                                           description: If set (default), BIOS will
@@ -29518,6 +36588,12 @@ var CRDsValidation map[string]string = map[string]string{
                                             kernel at boot time
                                           type: string
                                       type: object
+                                    profile:
+                                      description: |-
+                                        Profile is the name of a firmware profile configured in the KubeVirt CR.
+                                        The profile selects the bootloader, and may provide a custom firmware build
+                                        for the architecture of the VMI.
+                                      type: string
                                     serial:
                                       description: The system-serial-number in SMBIOS
                                       type: string
@@ -29553,6 +36629,32 @@ var CRDsValidation map[string]string = map[string]string{
                                         attestation:
                                           description: If specified, run the attestation
                                             process for a vmi.
+                                          properties:
+                                            keyBroker:
+                                              description: |-
+                                                If specified, the launch secret is released by the key broker once it
+                                                verified the launch measurement, and the VMI is unpaused afterwards.
+                                                Otherwise the launch secret has to be injected through the
+                                                sev/injectlaunchsecret subresource.
+                                              properties:
+                                                protocol:
+                                                  description: |-
+                                                    Protocol spoken with the key broker.
+                                                    Defaults to kbs.
+                                                  type: string
+                                                resourcePath:
+                                                  description: Path of the resource
+                                                    holding the launch secret, e.g.
+                                                    default/sev/launch-secret.
+                                                  type: string
+                                                url:
+                                                  description: URL of the key broker
+                                                    service.
+                                                  type: string
+                                              required:
+                                              - resourcePath
+                                              - url
+                                              type: object
                                           type: object
                                         dhCert:
                                           description: Base64 encoded guest owner's
@@ -29579,6 +36681,32 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: object
                                     tdx:
                                       description: Intel Trust Domain Extensions (TDX).
+                                      properties:
+                                        attestation:
+                                          description: If specified, the guest can request quotes from the quote generation
+                                            service of the node.
+                                          type: object
+                                        mrConfigId:
+                                          description: Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+                                          type: string
+                                        mrOwner:
+                                          description: Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+                                          type: string
+                                        mrOwnerConfig:
+                                          description: Base64 encoded SHA384 digest of the guest owner's owner-defined configuration
+                                            (MROWNERCONFIG).
+                                          type: string
+                                        policy:
+                                          description: |-
+                                            Guest policy flags as defined in the Intel TDX module specification.
+                                            Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+                                          properties:
+                                            septVEDisable:
+                                              description: |-
+                                                Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+                                                Defaults to false.
+                                              type: boolean
+                                          type: object
                                       type: object
                                   type: object
                                 machine:
@@ -29589,10 +36717,28 @@ var CRDsValidation map[string]string = map[string]string{
                                         chipset of the VirtualMachineInstance.
                                       type: string
                                   type: object
+                                machineOptions:
+                                  description: |-
+                                    MachineOptions allows to turn off legacy devices of the machine,
+                                    reducing the attack surface of hardened guests.
+                                  properties:
+                                    ps2:
+                                      description: |-
+                                        PS2 controls the legacy PS/2 (i8042) controller.
+                                        Defaults to true.
+                                      type: boolean
+                                  type: object
                                 memory:
                                   description: Memory allow specifying the VMI memory
                                     features.
                                   properties:
+                                    dimmSlots:
+                                      description: |-
+                                        DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+                                        is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+                                        DIMMs can not be hot unplugged.
+                                      format: int32
+                                      type: integer
                                     guest:
                                       anyOf:
                                       - type: integer
@@ -29657,6 +36803,13 @@ var CRDsValidation map[string]string = map[string]string{
                                         Valid resource keys are "memory" and "cpu".
                                       type: object
                                   type: object
+                                useEmulation:
+                                  description: |-
+                                    UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+                                    The vmi does not request /dev/kvm, can run on any node and performs
+                                    significantly slower than a hardware accelerated guest.
+                                    Intended for CI and cross-architecture testing.
+                                  type: boolean
                               required:
                               - devices
                               type: object
@@ -29669,11 +36822,72 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            guestSecrets:
+                              description: |-
+                                GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+                                without writing them to any disk image.
+                              items:
+                                description: GuestSecret delivers the value of a key of a Secret to the guest.
+                                properties:
+                                  key:
+                                    description: Key of the Secret whose value is delivered.
+                                    type: string
+                                  name:
+                                    description: Name of the guest secret, unique within the VMI. It names the
+                                      fw_cfg blob.
+                                    type: string
+                                  secretName:
+                                    description: SecretName is the name of the Secret in the namespace of the VMI.
+                                    type: string
+                                  target:
+                                    description: |-
+                                      Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+                                      the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+                                      secret is delivered on the next start.
+                                      Defaults to FWCfg.
+                                    enum:
+                                    - FWCfg
+                                    type: string
+                                required:
+                                - key
+                                - name
+                                - secretName
+                                type: object
+                              maxItems: 32
+                              type: array
+                              x-kubernetes-list-map-keys:
+                              - name
+                              x-kubernetes-list-type: map
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
                                 If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
                               type: string
+                            launcherIsolation:
+                              description: |-
+                                LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+                                the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+                                Defaults to Container.
+                              enum:
+                              - Container
+                              - MicroVM
+                              type: string
+                            launcherMetadata:
+                              description: |-
+                                LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+                                The keys have to be allowed in launcherPodConfiguration.
+                              properties:
+                                annotations:
+                                  additionalProperties:
+                                    type: string
+                                  description: Annotations to add to the virt-launcher pod
+                                  type: object
+                                labels:
+                                  additionalProperties:
+                                    type: string
+                                  description: Labels to add to the virt-launcher pod
+                                  type: object
+                              type: object
                             livenessProbe:
                               description: |-
                                 Periodic probe of VirtualMachineInstance liveness.
@@ -29705,6 +36919,22 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentExec:
+                                  description: |-
+                                    GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                                    The probe succeeds if the command exits with status 0.
+                                  properties:
+                                    command:
+                                      description: |-
+                                        Command is the command line to execute inside the guest. The first element is the
+                                        executable, the remaining ones are passed as arguments. It is not run in a shell.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - command
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -29809,6 +37039,23 @@ var CRDsValidation map[string]string = map[string]string{
                                     More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                                   format: int32
                                   type: integer
+                                vsockHTTP:
+                                  description: |-
+                                    VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                                    It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                                  properties:
+                                    path:
+                                      description: Path to access on the HTTP server.
+                                        Defaults to "/".
+                                      type: string
+                                    port:
+                                      description: Port is the VSOCK port the guest
+                                        service listens on.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - port
+                                  type: object
                               type: object
                             networks:
                               description: List of networks that can be attached to
@@ -29905,6 +37152,22 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                guestAgentExec:
+                                  description: |-
+                                    GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+                                    The probe succeeds if the command exits with status 0.
+                                  properties:
+                                    command:
+                                      description: |-
+                                        Command is the command line to execute inside the guest. The first element is the
+                                        executable, the remaining ones are passed as arguments. It is not run in a shell.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - command
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -30009,6 +37272,23 @@ var CRDsValidation map[string]string = map[string]string{
                                     More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                                   format: int32
                                   type: integer
+                                vsockHTTP:
+                                  description: |-
+                                    VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+                                    It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+                                  properties:
+                                    path:
+                                      description: Path to access on the HTTP server.
+                                        Defaults to "/".
+                                      type: string
+                                    port:
+                                      description: Port is the VSOCK port the guest
+                                        service listens on.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - port
+                                  type: object
                               type: object
                             resourceClaims:
                               description: |-
@@ -30016,6 +37296,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 and reserved before the VMI, hence virt-launcher pod is allowed to start. The resources
                                 will be made available to the domain which consumes them
                                 by name.
+                            runtimeClassName:
+                              description: |-
+                                If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+                                cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+                              type: string
 
                                 This is an alpha field and requires enabling the
                                 DynamicResourceAllocation feature gate in kubernetes
@@ -30470,6 +37755,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       ConfigMapSource represents a reference to a ConfigMap in the same namespace.
                                       More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/
                                     properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
                                       name:
                                         default: ""
                                         description: |-
@@ -30705,6 +37994,10 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      format:
+                                        description: Format of the memory dump, Core
+                                          by default
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -30751,6 +38044,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       SecretVolumeSource represents a reference to a secret data in the same namespace.
                                       More info: https://kubernetes.io/docs/concepts/configuration/secret/
                                     properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
                                       optional:
                                         description: Specify whether the Secret or
                                           it's keys must be defined
@@ -30773,6 +38070,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       There can only be one volume of this type!
                                       More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
                                     properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
                                       serviceAccountName:
                                         description: |-
                                           Name of the service account in the pod's namespace to use.
@@ -30969,6 +38270,9 @@ var CRDsValidation map[string]string = map[string]string{
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format of the memory dump, Core by default
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -31185,7 +38489,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       bus:
                                         description: |-
                                           Bus indicates the type of disk device to emulate.
-                                          supported values: virtio, sata, scsi, usb.
+                                          supported values: virtio, sata, scsi, usb, nvme.
                                         type: string
                                       pciAddress:
                                         description: 'If specified, the virtual disk
@@ -31198,6 +38502,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           ReadOnly.
                                           Defaults to false.
                                         type: boolean
+                                      virtioTransitional:
+                                        description: |-
+                                          If specified, overrides useVirtioTransitional for this disk.
+                                          Only applies to the virtio bus.
+                                        type: boolean
                                     type: object
                                   errorPolicy:
                                     description: If specified, it can change the default
@@ -31208,6 +38517,85 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: IOTune throttles the IO of the disk.
+                                      The limits are enforced by QEMU.
+                                    properties:
+                                      burst:
+                                        description: Burst allows the disk to exceed
+                                          the limits for a short time.
+                                        properties:
+                                          lengthSeconds:
+                                            description: |-
+                                              LengthSeconds is the maximum duration of a burst in seconds.
+                                              Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          readBytesSec:
+                                            description: ReadBytesSec limits the read
+                                              throughput in bytes per second during
+                                              a burst.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec limits the read
+                                              IO operations per second during a burst.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec limits the
+                                              total throughput in bytes per second
+                                              during a burst.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec limits the total
+                                              IO operations per second during a burst.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec limits the
+                                              write throughput in bytes per second
+                                              during a burst.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec limits the write
+                                              IO operations per second during a burst.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      readBytesSec:
+                                        description: ReadBytesSec limits the read
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec limits the read IO
+                                          operations per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec limits the total
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec limits the total
+                                          IO operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec limits the write
+                                          throughput in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec limits the write
+                                          IO operations per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -31230,10 +38618,34 @@ var CRDsValidation map[string]string = map[string]string{
                                   name:
                                     description: Name is the device name
                                     type: string
+                                  queues:
+                                    description: |-
+                                      Queues is the number of queues of the disk. If specified, it overrides the
+                                      number of queues derived from blockMultiQueue for this disk.
+                                      Only supported on the virtio bus.
+                                    format: int64
+                                    type: integer
+                                  rerrorPolicy:
+                                    description: |-
+                                      If specified, it changes the error policy for read errors of the disk.
+                                      Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+                                    type: string
+                                  scsiController:
+                                    description: |-
+                                      SCSIController is the name of the scsi controller the disk is attached to.
+                                      Only supported on the scsi bus. Defaults to the first scsi controller.
+                                    type: string
                                   serial:
                                     description: Serial provides the ability to specify
                                       a serial number for the disk device.
                                     type: string
+                                  serialPolicy:
+                                    description: |-
+                                      SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+                                      Supported values are: None, VolumeNameHash.
+                                      VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+                                      when disks are reordered. Defaults to None.
+                                    type: string
                                   shareable:
                                     description: If specified the disk is made sharable
                                       and multiple write from different VMs are permitted
@@ -31243,6 +38655,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       tag will be provided to the guest via config
                                       drive metadata
                                     type: string
+                                  wwn:
+                                    description: |-
+                                      WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+                                      Only supported on the scsi bus.
+                                    type: string
                                 required:
                                 - name
                                 type: object
@@ -31267,6 +38684,36 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: VolumeSource represents the source of
                                   the volume to map to the disk.
                                 properties:
+                                  configMap:
+                                    description: |-
+                                      ConfigMap represents a reference to a ConfigMap in the same namespace, which is attached
+                                      to the vmi as an iso image.
+                                    properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether the volume
+                                          can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      name:
+                                        default: ""
+                                        description: |-
+                                          Name of the referent.
+                                          This field is effectively required, but due to backwards compatibility is
+                                          allowed to be empty. Instances of this type with an empty value here are
+                                          almost certainly wrong.
+                                          More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        type: string
+                                      optional:
+                                        description: Specify whether the ConfigMap or it's keys
+                                          must be defined
+                                        type: boolean
+                                      volumeLabel:
+                                        description: |-
+                                          The volume label of the resulting disk inside the VMI.
+                                          Different bootstrapping mechanisms require different values.
+                                          Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                        type: string
+                                    type: object
+                                    x-kubernetes-map-type: atomic
                                   dataVolume:
                                     description: |-
                                       DataVolume represents the dynamic creation a PVC for this volume as well as
@@ -31306,6 +38753,46 @@ var CRDsValidation map[string]string = map[string]string{
                                     required:
                                     - claimName
                                     type: object
+                                  secret:
+                                    description: |-
+                                      Secret represents a reference to a Secret in the same namespace, which is attached
+                                      to the vmi as an iso image.
+                                    properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether the volume
+                                          can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      optional:
+                                        description: Specify whether the Secret or it's keys must
+                                          be defined
+                                        type: boolean
+                                      secretName:
+                                        description: |-
+                                          Name of the secret in the pod's namespace to use.
+                                          More info: https://kubernetes.io/docs/concepts/storage/volumes#secret
+                                        type: string
+                                      volumeLabel:
+                                        description: |-
+                                          The volume label of the resulting disk inside the VMI.
+                                          Different bootstrapping mechanisms require different values.
+                                          Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                        type: string
+                                    type: object
+                                  serviceAccount:
+                                    description: |-
+                                      ServiceAccount represents a reference to a service account, whose token is attached
+                                      to the vmi as an iso image.
+                                    properties:
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether the volume
+                                          can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      serviceAccountName:
+                                        description: |-
+                                          Name of the service account in the pod's namespace to use.
+                                          More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
+                                        type: string
+                                    type: object
                                 type: object
                             required:
                             - disk
@@ -31767,5 +39254,176 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachineusagereport": `openAPIV3Schema:
+  description: |-
+    VirtualMachineUsageReport accumulates the resources consumed by the
+    VirtualMachineInstances of a namespace during one period, for showback and
+    chargeback. Reports are created and updated by virt-controller.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineUsageReportSpec is the spec for a VirtualMachineUsageReport
+        resource
+      properties:
+        periodEnd:
+          description: PeriodEnd is the end of the reported period
+          format: date-time
+          type: string
+        periodStart:
+          description: PeriodStart is the beginning of the reported period
+          format: date-time
+          type: string
+      required:
+      - periodEnd
+      - periodStart
+      type: object
+      x-kubernetes-validations:
+      - message: spec is immutable after creation
+        rule: self == oldSelf
+    status:
+      description: VirtualMachineUsageReportStatus is the status for a VirtualMachineUsageReport
+        resource
+      properties:
+        actual:
+          description: |-
+            Actual is the usage of the resources the VirtualMachineInstances of the
+            namespace consumed on their nodes, as measured for their pods
+          properties:
+            memoryGiBHours:
+              anyOf:
+              - type: integer
+              - type: string
+              description: MemoryGiBHours is the memory in GiB multiplied with the
+                hours it was used
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            vcpuHours:
+              anyOf:
+              - type: integer
+              - type: string
+              description: VCPUHours is the number of vCPUs multiplied with the hours
+                they were used
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          required:
+          - memoryGiBHours
+          - vcpuHours
+          type: object
+        allocated:
+          description: Allocated is the usage of the resources allocated to the
+            guests of the namespace
+          properties:
+            memoryGiBHours:
+              anyOf:
+              - type: integer
+              - type: string
+              description: MemoryGiBHours is the memory in GiB multiplied with the
+                hours it was used
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            vcpuHours:
+              anyOf:
+              - type: integer
+              - type: string
+              description: VCPUHours is the number of vCPUs multiplied with the hours
+                they were used
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          required:
+          - memoryGiBHours
+          - vcpuHours
+          type: object
+        lastUpdateTime:
+          description: LastUpdateTime is the time the usage was last accumulated
+            into the report
+          format: date-time
+          type: string
+        virtualMachineInstances:
+          description: VirtualMachineInstances breaks the usage down by VirtualMachineInstance
+          items:
+            description: VirtualMachineInstanceUsage is the usage of a single VirtualMachineInstance
+            properties:
+              actual:
+                description: ResourceUsage is the usage of CPU and memory over time
+                properties:
+                  memoryGiBHours:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: MemoryGiBHours is the memory in GiB multiplied with the
+                      hours it was used
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  vcpuHours:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: VCPUHours is the number of vCPUs multiplied with the hours
+                      they were used
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                required:
+                - memoryGiBHours
+                - vcpuHours
+                type: object
+              allocated:
+                description: ResourceUsage is the usage of CPU and memory over time
+                properties:
+                  memoryGiBHours:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: MemoryGiBHours is the memory in GiB multiplied with the
+                      hours it was used
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  vcpuHours:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: VCPUHours is the number of vCPUs multiplied with the hours
+                      they were used
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                required:
+                - memoryGiBHours
+                - vcpuHours
+                type: object
+              name:
+                description: Name of the VirtualMachineInstance
+                type: string
+            required:
+            - actual
+            - allocated
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      required:
+      - actual
+      - allocated
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
@@ -24,6 +24,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	operationsv1 "kubevirt.io/api/operations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 )
@@ -303,7 +304,9 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 	vmRestoreValidatePath := VMRestoreValidatePath
 	vmBackupValidatePath := VMBackupValidatePath
 	vmBackupTrackerValidatePath := VMBackupTrackerValidatePath
+	vmBackupHookValidatePath := VMBackupHookValidatePath
 	vmExportValidatePath := VMExportValidatePath
+	vmOperationValidatePath := VMOperationValidatePath
 	VmInstancetypeValidatePath := VMInstancetypeValidatePath
 	VmClusterInstancetypeValidatePath := VMClusterInstancetypeValidatePath
 	vmPreferenceValidatePath := VMPreferenceValidatePath
@@ -689,6 +692,31 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachinebackuphook-validator.backup.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+						admissionregistrationv1.Update,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{backupv1.SchemeGroupVersion.Group},
+						APIVersions: []string{backupv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachinebackuphooks"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmBackupHookValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineexport-validator.export.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1"},
@@ -714,6 +742,30 @@ func NewVirtAPIValidatingWebhookConfiguration(installNamespace string) *admissio
 					},
 				},
 			},
+			{
+				Name:                    "virtualmachineoperation-validator.operations.kubevirt.io",
+				AdmissionReviewVersions: []string{"v1"},
+				FailurePolicy:           &failurePolicy,
+				TimeoutSeconds:          &defaultTimeoutSeconds,
+				SideEffects:             &sideEffectNone,
+				Rules: []admissionregistrationv1.RuleWithOperations{{
+					Operations: []admissionregistrationv1.OperationType{
+						admissionregistrationv1.Create,
+					},
+					Rule: admissionregistrationv1.Rule{
+						APIGroups:   []string{operationsv1.SchemeGroupVersion.Group},
+						APIVersions: []string{operationsv1.SchemeGroupVersion.Version},
+						Resources:   []string{"virtualmachineoperations"},
+					},
+				}},
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: installNamespace,
+						Name:      VirtApiServiceName,
+						Path:      &vmOperationValidatePath,
+					},
+				},
+			},
 			{
 				Name:                    "virtualmachineinstancetype-validator.instancetype.kubevirt.io",
 				AdmissionReviewVersions: []string{"v1"},
@@ -959,8 +1011,12 @@ const VMBackupValidatePath = "/virtualmachinebackups-validate"
 
 const VMBackupTrackerValidatePath = "/virtualmachinebackuptrackers-validate"
 
+const VMBackupHookValidatePath = "/virtualmachinebackuphooks-validate"
+
 const VMExportValidatePath = "/virtualmachineexports-validate"
 
+const VMOperationValidatePath = "/virtualmachineoperations-validate"
+
 const VMInstancetypeValidatePath = "/virtualmachineinstancetypes-validate"
 
 const VMClusterInstancetypeValidatePath = "/virtualmachineclusterinstancetypes-validate"
@@ -467,7 +467,12 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineExportCrd,
 		components.NewVirtualMachineCloneCrd, components.NewVirtualMachineBackupCrd,
-		components.NewVirtualMachineBackupTrackerCrd,
+		components.NewVirtualMachineBackupTrackerCrd, components.NewVirtualMachineBackupHookCrd,
+		components.NewVirtualMachineAuditEventCrd, components.NewVirtualMachinePolicyCrd,
+		components.NewVirtualMachineDisruptionBudgetCrd,
+		components.NewVirtualMachineCheckupCrd, components.NewVirtualMachineUsageReportCrd,
+		components.NewNodeMaintenanceCrd,
+		components.NewVirtualMachineOperationCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -571,7 +576,7 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 	strategy.deployments = append(strategy.deployments, apiDeployment)
 
 	controller := components.NewControllerDeployment(config.GetNamespace(), config.GetImageRegistry(), config.GetImagePrefix(), config.GetControllerVersion(), config.GetLauncherVersion(), config.GetExportServerVersion(), config.GetSidecarShimVersion(), productName, productVersion, productComponent, config.VirtControllerImage, config.VirtLauncherImage, config.VirtExportServerImage, config.SidecarShimImage, config.GetImagePullPolicy(), config.GetImagePullSecrets(), config.GetVerbosity(), config.GetExtraEnv())
-	strategy.deployments = append(strategy.deployments, controller)
+	strategy.deployments = append(strategy.deployments, components.NewControllerShardDeployments(controller, config.GetControllerShards())...)
 
 	strategy.configMaps = append(strategy.configMaps, components.NewCAConfigMaps(operatorNamespace)...)
 
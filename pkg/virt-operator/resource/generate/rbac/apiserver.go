@@ -229,6 +229,29 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"audit.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineauditevents",
+				},
+				Verbs: []string{
+					"create",
+				},
+			},
+			{
+				APIGroups: []string{
+					"policy.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepolicies",
+					"virtualmachinedisruptionbudgets",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"cdi.kubevirt.io",
@@ -300,7 +323,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"nodes",
 				},
 				Verbs: []string{
-					"get",
+					"get", "list", "watch",
 				},
 			},
 		},
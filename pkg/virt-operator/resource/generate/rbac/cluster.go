@@ -23,9 +23,13 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"kubevirt.io/api/accounting"
+	"kubevirt.io/api/audit"
 	"kubevirt.io/api/backup"
+	"kubevirt.io/api/checkup"
 	"kubevirt.io/api/clone"
 	"kubevirt.io/api/export"
+	"kubevirt.io/api/operations"
 	"kubevirt.io/api/pool"
 	"kubevirt.io/api/snapshot"
 
@@ -34,29 +38,37 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/policy"
 )
 
 const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMBackups          = "virtualmachinebackups"
-	apiVMBackupTrackers   = "virtualmachinebackuptrackers"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMBackups           = "virtualmachinebackups"
+	apiVMBackupTrackers    = "virtualmachinebackuptrackers"
+	apiVMBackupHooks       = "virtualmachinebackuphooks"
+	apiVMAuditEvents       = "virtualmachineauditevents"
+	apiVMPolicies          = "virtualmachinepolicies"
+	apiVMDisruptionBudgets = "virtualmachinedisruptionbudgets"
+	apiVMCheckups          = "virtualmachinecheckups"
+	apiVMOperations        = "virtualmachineoperations"
+	apiVMUsageReports      = "virtualmachineusagereports"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
 
 	apiVMExpandSpec     = "virtualmachines/expand-spec"
 	apiVMPortForward    = "virtualmachines/portforward"
@@ -73,6 +85,7 @@ const (
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
 	apiVMInstancesVNCScreenshot             = "virtualmachineinstances/vnc/screenshot"
+	apiVMInstancesVNCResolution             = "virtualmachineinstances/vnc/resolution"
 	apiVMInstancesPortForward               = "virtualmachineinstances/portforward"
 	apiVMInstancesPause                     = "virtualmachineinstances/pause"
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
@@ -81,6 +94,7 @@ const (
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
+	apiVMInstancesReboot                    = "virtualmachineinstances/reboot"
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
@@ -89,7 +103,10 @@ const (
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	apiVMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
+	apiVMInstancesSEVSNPAttestationReport   = "virtualmachineinstances/sev/attestationreport"
+	apiVMInstancesTPMAttestation            = "virtualmachineinstances/tpm/attestation"
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
+	apiVMInstancesSmartcard                 = "virtualmachineinstances/smartcard"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
 	apiVMInstancesEvacuateCancel            = "virtualmachineinstances/evacuate/cancel"
 )
@@ -209,7 +226,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesSEVSNPAttestationReport,
+					apiVMInstancesTPMAttestation,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesSmartcard,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
 				},
@@ -229,9 +249,11 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVNCResolution,
 					apiVMInstancesEvacuateCancel,
 				},
 				Verbs: []string{
@@ -322,6 +344,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupHooks,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -339,6 +362,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "create", "update", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					audit.GroupName,
+				},
+				Resources: []string{
+					apiVMAuditEvents,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					export.GroupName,
@@ -397,6 +431,61 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMDisruptionBudgets,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					checkup.GroupName,
+				},
+				Resources: []string{
+					apiVMCheckups,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					operations.GroupName,
+				},
+				Resources: []string{
+					apiVMOperations,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					accounting.GroupName,
+				},
+				Resources: []string{
+					apiVMUsageReports,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -429,7 +518,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesSEVSNPAttestationReport,
+					apiVMInstancesTPMAttestation,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesSmartcard,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
 				},
@@ -449,9 +541,11 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
+					apiVMInstancesReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVNCResolution,
 					apiVMInstancesEvacuateCancel,
 				},
 				Verbs: []string{
@@ -542,6 +636,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupHooks,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -616,6 +711,61 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMDisruptionBudgets,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					checkup.GroupName,
+				},
+				Resources: []string{
+					apiVMCheckups,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					operations.GroupName,
+				},
+				Resources: []string{
+					apiVMOperations,
+				},
+				Verbs: []string{
+					"get", "delete", "create", "update", "patch", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					accounting.GroupName,
+				},
+				Resources: []string{
+					apiVMUsageReports,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -695,6 +845,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesSEVSNPAttestationReport,
 					apiVMObjectGraph,
 					apiVMInstancesObjectGraph,
 				},
@@ -747,6 +898,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupHooks,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -810,6 +962,61 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					policy.GroupName,
+				},
+				Resources: []string{
+					apiVMDisruptionBudgets,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					checkup.GroupName,
+				},
+				Resources: []string{
+					apiVMCheckups,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					operations.GroupName,
+				},
+				Resources: []string{
+					apiVMOperations,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					accounting.GroupName,
+				},
+				Resources: []string{
+					apiVMUsageReports,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
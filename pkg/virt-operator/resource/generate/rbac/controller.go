@@ -195,6 +195,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "delete", "create", "update",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -366,6 +377,88 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "create", "update", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"backup.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinebackuphooks",
+					"virtualmachinebackuphooks/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"checkup.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinecheckups",
+					"virtualmachinecheckups/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"operations.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineoperations",
+					"virtualmachineoperations/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"accounting.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineusagereports",
+					"virtualmachineusagereports/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "create", "update", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"audit.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineauditevents",
+				},
+				Verbs: []string{
+					"list", "delete",
+				},
+			},
+			{
+				APIGroups: []string{
+					"metrics.k8s.io",
+				},
+				Resources: []string{
+					"pods",
+				},
+				Verbs: []string{
+					"list",
+				},
+			},
+			{
+				APIGroups: []string{
+					"maintenance.kubevirt.io",
+				},
+				Resources: []string{
+					"nodemaintenances",
+					"nodemaintenances/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"pool.kubevirt.io",
@@ -416,7 +509,9 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"subresources.kubevirt.io",
 				},
 				Resources: []string{
+					"virtualmachines/start",
 					"virtualmachines/stop",
+					"virtualmachines/restart",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/backup",
@@ -517,6 +612,19 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"scheduling.k8s.io",
+				},
+				Resources: []string{
+					"priorityclasses",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"instancetype.kubevirt.io",
@@ -542,6 +650,18 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"policy.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinepolicies",
+					"virtualmachinedisruptionbudgets",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
@@ -58,5 +58,65 @@ var _ = Describe("RBAC", func() {
 			Entry("for vms", "kubevirt.io", "virtualmachines"),
 			Entry("for vmis", "kubevirt.io", "virtualmachineinstances"),
 		)
+
+		It("can read virtualmachinepolicies and virtualmachinedisruptionbudgets", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("policy.kubevirt.io"),
+					"Resources": ContainElements("virtualmachinepolicies", "virtualmachinedisruptionbudgets"),
+					"Verbs":     ConsistOf("get", "list", "watch"),
+				})),
+			)
+		})
+
+		It("can update virtualmachineoperations and their status", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("operations.kubevirt.io"),
+					"Resources": ContainElements("virtualmachineoperations", "virtualmachineoperations/status"),
+					"Verbs":     ContainElements("get", "list", "watch", "update", "patch"),
+				})),
+			)
+		})
+
+		It("can start, stop and restart virtualmachines", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("subresources.kubevirt.io"),
+					"Resources": ContainElements("virtualmachines/start", "virtualmachines/stop", "virtualmachines/restart"),
+					"Verbs":     ContainElement("update"),
+				})),
+			)
+		})
+
+		It("can read priorityclasses", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("scheduling.k8s.io"),
+					"Resources": ContainElement("priorityclasses"),
+					"Verbs":     ConsistOf("get", "list", "watch"),
+				})),
+			)
+		})
+
+		It("can update nodemaintenances and their status", func() {
+			clusterRole := getObject(forController, reflect.TypeOf(&rbacv1.ClusterRole{}), components.ControllerServiceAccountName).(*rbacv1.ClusterRole)
+			Expect(clusterRole).ToNot(BeNil())
+			Expect(clusterRole.Rules).To(
+				ContainElement(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"APIGroups": ContainElement("maintenance.kubevirt.io"),
+					"Resources": ContainElements("nodemaintenances", "nodemaintenances/status"),
+					"Verbs":     ContainElements("get", "list", "watch", "update", "patch"),
+				})),
+			)
+		})
 	})
 })
@@ -85,6 +85,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesPersistentReservationEnabled = "PersistentReservationEnabled"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesControllerShards = "ControllerShards"
+
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesSynchronizationPort       = "SynchronizationPort"
 	DefaultSynchronizationPort              int32 = 9185
@@ -188,6 +191,9 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 		kv.Spec.Configuration.MigrationConfiguration.Network != nil {
 		additionalProperties[AdditionalPropertiesMigrationNetwork] = *kv.Spec.Configuration.MigrationConfiguration.Network
 	}
+	if shards := kv.Spec.Configuration.ControllerShards; shards != nil && *shards > 1 {
+		additionalProperties[AdditionalPropertiesControllerShards] = strconv.FormatUint(uint64(*shards), 10)
+	}
 	if kv.Spec.Configuration.DeveloperConfiguration != nil && len(kv.Spec.Configuration.DeveloperConfiguration.FeatureGates) > 0 {
 		for _, v := range kv.Spec.Configuration.DeveloperConfiguration.FeatureGates {
 			if v == featuregate.PersistentReservation {
@@ -546,6 +552,20 @@ func (c *KubeVirtDeploymentConfig) GetMigrationNetwork() *string {
 	}
 }
 
+// GetControllerShards returns the number of namespace shards virt-controller is split into, 0 if it is not sharded
+func (c *KubeVirtDeploymentConfig) GetControllerShards() int {
+	value, enabled := c.AdditionalProperties[AdditionalPropertiesControllerShards]
+	if !enabled {
+		return 0
+	}
+	shards, err := strconv.Atoi(value)
+	if err != nil {
+		log.Log.Errorf("Unable to convert %s to integer", value)
+		return 0
+	}
+	return shards
+}
+
 func (c *KubeVirtDeploymentConfig) GetSynchronizationPort() int32 {
 	value, enabled := c.AdditionalProperties[AdditionalPropertiesSynchronizationPort]
 	if enabled {
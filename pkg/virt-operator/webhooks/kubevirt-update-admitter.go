@@ -23,6 +23,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"strconv"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
@@ -38,6 +40,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -107,6 +110,47 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.LauncherSecurityProfiles, newKV.Spec.Configuration.LauncherSecurityProfiles) {
+		results = append(results,
+			validateLauncherSecurityProfiles(field.NewPath("spec").Child("configuration", "launcherSecurityProfiles"), newKV.Spec.Configuration.LauncherSecurityProfiles)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.EmulatorBundles, newKV.Spec.Configuration.EmulatorBundles) {
+		results = append(results,
+			validateEmulatorBundles(field.NewPath("spec").Child("configuration", "emulatorBundles"), newKV.Spec.Configuration.EmulatorBundles)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.FirmwareProfiles, newKV.Spec.Configuration.FirmwareProfiles) {
+		results = append(results,
+			validateFirmwareProfiles(field.NewPath("spec").Child("configuration", "firmwareProfiles"), newKV.Spec.Configuration.FirmwareProfiles)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.PermittedHostDevices, newKV.Spec.Configuration.PermittedHostDevices) {
+		if newKV.Spec.Configuration.PermittedHostDevices != nil {
+			results = append(results,
+				validateVirtualFunctionPools(field.NewPath("spec").Child("configuration", "permittedHostDevices", "virtualFunctionPools"), newKV.Spec.Configuration.PermittedHostDevices)...)
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.NetworkConfiguration, newKV.Spec.Configuration.NetworkConfiguration) {
+		if newKV.Spec.Configuration.NetworkConfiguration != nil {
+			results = append(results,
+				validateDefaultBindingPolicies(field.NewPath("spec").Child("configuration", "network", "defaultBindingPolicies"), newKV.Spec.Configuration.NetworkConfiguration)...)
+			results = append(results,
+				validateDNSRegistration(field.NewPath("spec").Child("configuration", "network", "dnsRegistration"), newKV.Spec.Configuration.NetworkConfiguration.DNSRegistration)...)
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.HugepagesPool, newKV.Spec.Configuration.HugepagesPool) {
+		results = append(results,
+			validateHugepagesPool(field.NewPath("spec").Child("configuration", "hugepagesPool"), newKV.Spec.Configuration.HugepagesPool)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.HostSensors, newKV.Spec.Configuration.HostSensors) {
+		results = append(results,
+			validateHostSensors(field.NewPath("spec").Child("configuration", "hostSensors"), newKV.Spec.Configuration.HostSensors)...)
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
@@ -276,13 +320,18 @@ func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.St
 }
 
 func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConfiguration) []metav1.StatusCause {
-	statuses := []metav1.StatusCause{}
 	if seccompConf == nil || seccompConf.VirtualMachineInstanceProfile == nil {
-		return statuses
+		return []metav1.StatusCause{}
 	}
 
-	customProfile := seccompConf.VirtualMachineInstanceProfile.CustomProfile
-	customProfileField := field.Child("virtualMachineInstanceProfile").Child("customProfile")
+	return validateVirtualMachineInstanceProfile(field.Child("virtualMachineInstanceProfile"), seccompConf.VirtualMachineInstanceProfile)
+}
+
+func validateVirtualMachineInstanceProfile(field *field.Path, vmProfile *v1.VirtualMachineInstanceProfile) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	customProfile := vmProfile.CustomProfile
+	customProfileField := field.Child("customProfile")
 
 	if customProfile != nil {
 		if customProfile.LocalhostProfile != nil && customProfile.RuntimeDefaultProfile {
@@ -311,6 +360,357 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+var selinuxTypeRegex = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+
+func validateLauncherSecurityProfiles(field *field.Path, profiles []v1.LauncherSecurityProfile) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	names := map[string]struct{}{}
+
+	for i, profile := range profiles {
+		profileField := field.Index(i)
+
+		if profile.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   profileField.Child("name").String(),
+				Message: fmt.Sprintf("%s must not be empty", profileField.Child("name").String()),
+			})
+		} else if _, exists := names[profile.Name]; exists {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   profileField.Child("name").String(),
+				Message: fmt.Sprintf("%s must be unique, %s is used more than once", profileField.Child("name").String(), profile.Name),
+			})
+		}
+		names[profile.Name] = struct{}{}
+
+		statuses = append(statuses, validateNamespaceSelector(profileField.Child("namespaceSelector"), &profile.NamespaceSelector)...)
+
+		if _, err := metav1.LabelSelectorAsSelector(&profile.Selector); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   profileField.Child("selector").String(),
+				Message: fmt.Sprintf("%s is invalid: %v", profileField.Child("selector").String(), err),
+			})
+		}
+
+		if profile.Seccomp == nil && profile.SELinuxType == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   profileField.String(),
+				Message: fmt.Sprintf("%s must set seccomp or selinuxType", profileField.String()),
+			})
+		}
+
+		if profile.Seccomp != nil {
+			statuses = append(statuses, validateVirtualMachineInstanceProfile(profileField.Child("seccomp"), profile.Seccomp)...)
+		}
+
+		if profile.SELinuxType != "" && !selinuxTypeRegex.MatchString(profile.SELinuxType) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   profileField.Child("selinuxType").String(),
+				Message: fmt.Sprintf("%s is not a valid SELinux type: %s", profileField.Child("selinuxType").String(), profile.SELinuxType),
+			})
+		}
+	}
+
+	return statuses
+}
+
+var imageDigestRegex = regexp.MustCompile(`@sha256:[a-f0-9]{64}$`)
+
+func validateEmulatorBundles(field *field.Path, bundles []v1.EmulatorBundle) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	names := map[string]struct{}{}
+
+	for i, bundle := range bundles {
+		bundleField := field.Index(i)
+
+		if bundle.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   bundleField.Child("name").String(),
+				Message: fmt.Sprintf("%s must not be empty", bundleField.Child("name").String()),
+			})
+		} else if _, exists := names[bundle.Name]; exists {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   bundleField.Child("name").String(),
+				Message: fmt.Sprintf("%s must be unique, %s is used more than once", bundleField.Child("name").String(), bundle.Name),
+			})
+		}
+		names[bundle.Name] = struct{}{}
+
+		if !imageDigestRegex.MatchString(bundle.Image) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   bundleField.Child("image").String(),
+				Message: fmt.Sprintf("%s must reference the image by its sha256 digest: %s", bundleField.Child("image").String(), bundle.Image),
+			})
+		}
+
+		if !filepath.IsLocal(bundle.Emulator) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   bundleField.Child("emulator").String(),
+				Message: fmt.Sprintf("%s must be a relative path inside the image: %s", bundleField.Child("emulator").String(), bundle.Emulator),
+			})
+		}
+
+		if bundle.Firmware != "" && !filepath.IsLocal(bundle.Firmware) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   bundleField.Child("firmware").String(),
+				Message: fmt.Sprintf("%s must be a relative path inside the image: %s", bundleField.Child("firmware").String(), bundle.Firmware),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateFirmwareProfiles(field *field.Path, profiles []v1.FirmwareProfile) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	names := map[string]struct{}{}
+
+	for i, profile := range profiles {
+		profileField := field.Index(i)
+
+		if profile.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   profileField.Child("name").String(),
+				Message: fmt.Sprintf("%s must not be empty", profileField.Child("name").String()),
+			})
+		} else if _, exists := names[profile.Name]; exists {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   profileField.Child("name").String(),
+				Message: fmt.Sprintf("%s must be unique, %s is used more than once", profileField.Child("name").String(), profile.Name),
+			})
+		}
+		names[profile.Name] = struct{}{}
+
+		switch profile.Type {
+		case v1.FirmwareTypeBIOS:
+			if len(profile.Images) > 0 {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   profileField.Child("images").String(),
+					Message: fmt.Sprintf("%s are only supported for UEFI firmware", profileField.Child("images").String()),
+				})
+			}
+		case v1.FirmwareTypeUEFI, v1.FirmwareTypeUEFISecureBoot:
+		default:
+			statuses = append(statuses, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: profileField.Child("type").String(),
+				Message: fmt.Sprintf("%s must be one of %s, %s or %s: %s", profileField.Child("type").String(),
+					v1.FirmwareTypeBIOS, v1.FirmwareTypeUEFI, v1.FirmwareTypeUEFISecureBoot, profile.Type),
+			})
+		}
+
+		statuses = append(statuses, validateFirmwareImages(profileField.Child("images"), profile.Images)...)
+	}
+
+	return statuses
+}
+
+func validateFirmwareImages(field *field.Path, images []v1.FirmwareImage) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	architectures := map[string]struct{}{}
+
+	for i, image := range images {
+		imageField := field.Index(i)
+
+		switch image.Architecture {
+		case "amd64", "arm64", "s390x":
+			if _, exists := architectures[image.Architecture]; exists {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Field:   imageField.Child("architecture").String(),
+					Message: fmt.Sprintf("%s must be unique, %s is used more than once", imageField.Child("architecture").String(), image.Architecture),
+				})
+			}
+			architectures[image.Architecture] = struct{}{}
+		default:
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   imageField.Child("architecture").String(),
+				Message: fmt.Sprintf("%s must be one of amd64, arm64 or s390x: %s", imageField.Child("architecture").String(), image.Architecture),
+			})
+		}
+
+		if !imageDigestRegex.MatchString(image.Image) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   imageField.Child("image").String(),
+				Message: fmt.Sprintf("%s must reference the image by its sha256 digest: %s", imageField.Child("image").String(), image.Image),
+			})
+		}
+
+		if image.Path != "" && !filepath.IsLocal(image.Path) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   imageField.Child("path").String(),
+				Message: fmt.Sprintf("%s must be a relative path inside the image: %s", imageField.Child("path").String(), image.Path),
+			})
+		}
+	}
+
+	return statuses
+}
+
+var pciVendorSelectorRegex = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{4}$`)
+
+func validateVirtualFunctionPools(field *field.Path, hostDevices *v1.PermittedHostDevices) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	pciResourceNames := map[string]struct{}{}
+	for _, pciDevice := range hostDevices.PciHostDevices {
+		pciResourceNames[pciDevice.ResourceName] = struct{}{}
+	}
+
+	resourceNames := map[string]struct{}{}
+	for i, pool := range hostDevices.VirtualFunctionPools {
+		poolField := field.Index(i)
+
+		if !pciVendorSelectorRegex.MatchString(pool.PFVendorSelector) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   poolField.Child("pfVendorSelector").String(),
+				Message: fmt.Sprintf("%s must be a vendor_id:product_id tuple: %s", poolField.Child("pfVendorSelector").String(), pool.PFVendorSelector),
+			})
+		}
+
+		if pool.NumVFs == 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   poolField.Child("numVFs").String(),
+				Message: fmt.Sprintf("%s must be greater than zero", poolField.Child("numVFs").String()),
+			})
+		}
+
+		if pool.ResourceName == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   poolField.Child("resourceName").String(),
+				Message: fmt.Sprintf("%s must not be empty", poolField.Child("resourceName").String()),
+			})
+			continue
+		}
+		_, isDuplicate := resourceNames[pool.ResourceName]
+		_, isPCIResource := pciResourceNames[pool.ResourceName]
+		if isDuplicate || isPCIResource {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   poolField.Child("resourceName").String(),
+				Message: fmt.Sprintf("%s must be unique among the PCI host devices and virtual function pools, %s is used more than once", poolField.Child("resourceName").String(), pool.ResourceName),
+			})
+		}
+		resourceNames[pool.ResourceName] = struct{}{}
+	}
+
+	return statuses
+}
+
+func validateDefaultBindingPolicies(field *field.Path, networkConfig *v1.NetworkConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	for i, policy := range networkConfig.DefaultBindingPolicies {
+		policyField := field.Index(i)
+
+		if _, err := metav1.LabelSelectorAsSelector(&policy.NamespaceSelector); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   policyField.Child("namespaceSelector").String(),
+				Message: fmt.Sprintf("%s is invalid: %v", policyField.Child("namespaceSelector").String(), err),
+			})
+		}
+
+		switch v1.NetworkInterfaceType(policy.Binding) {
+		case v1.BridgeInterface, v1.MasqueradeInterface:
+			continue
+		}
+		if _, exists := networkConfig.Binding[policy.Binding]; !exists {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: policyField.Child("binding").String(),
+				Message: fmt.Sprintf("%s must be %q, %q or a registered binding plugin: %s",
+					policyField.Child("binding").String(), v1.BridgeInterface, v1.MasqueradeInterface, policy.Binding),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateDNSRegistration(field *field.Path, dnsRegistration *v1.DNSRegistrationConfiguration) []metav1.StatusCause {
+	if dnsRegistration == nil || dnsRegistration.ServiceName == "" {
+		return nil
+	}
+
+	statuses := []metav1.StatusCause{}
+	for _, msg := range k8svalidation.IsDNS1035Label(dnsRegistration.ServiceName) {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("serviceName").String(),
+			Message: fmt.Sprintf("%s is not a valid service name: %s", field.Child("serviceName").String(), msg),
+		})
+	}
+	return statuses
+}
+
+// validateNamespaceSelector rejects empty selectors, which would match the namespaces of all VM owners
+func validateNamespaceSelector(field *field.Path, selector *metav1.LabelSelector) []metav1.StatusCause {
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s must not be empty", field.String()),
+		}}
+	}
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s is invalid: %v", field.String(), err),
+		}}
+	}
+	return nil
+}
+
+func validateHostSensors(field *field.Path, hostSensors *v1.HostSensorsConfiguration) []metav1.StatusCause {
+	if hostSensors == nil {
+		return nil
+	}
+	return validateNamespaceSelector(field.Child("namespaceSelector"), &hostSensors.NamespaceSelector)
+}
+
+func validateHugepagesPool(field *field.Path, hugepagesPool *v1.HugepagesPoolConfiguration) []metav1.StatusCause {
+	if hugepagesPool == nil {
+		return nil
+	}
+
+	statuses := []metav1.StatusCause{}
+	if hugepagesPool.MaxPages == 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("maxPages").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("maxPages").String()),
+		})
+	}
+	if hugepagesPool.MinPages > hugepagesPool.MaxPages {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("minPages").String(),
+			Message: fmt.Sprintf("%s must not be greater than %s", field.Child("minPages").String(), field.Child("maxPages").String()),
+		})
+	}
+	return statuses
+}
+
 func validateWorkloadPlacement(ctx context.Context, namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -39,6 +39,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
+const emulatorBundleImage = "registry.example.com/qemu@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
 var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 
 	test := field.NewPath("test")
@@ -68,6 +70,258 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	profileNamespaces := metav1.LabelSelector{MatchLabels: map[string]string{"security-profiles": "true"}}
+
+	DescribeTable("validateLauncherSecurityProfiles", func(profiles []v1.LauncherSecurityProfile, expectedFields []string) {
+		causes := validateLauncherSecurityProfiles(test, profiles)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid profile", []v1.LauncherSecurityProfile{{
+			Name:              "confidential",
+			NamespaceSelector: profileNamespaces,
+			Selector:          metav1.LabelSelector{MatchLabels: map[string]string{"confidential": "true"}},
+			SELinuxType:       "virt_launcher.process",
+			Seccomp: &v1.VirtualMachineInstanceProfile{
+				CustomProfile: &v1.CustomProfile{LocalhostProfile: pointer.P("kubevirt/confidential.json")},
+			},
+		}}, nil),
+		Entry("reject a profile without name", []v1.LauncherSecurityProfile{{
+			NamespaceSelector: profileNamespaces,
+			SELinuxType:       "container_t",
+		}}, []string{test.Index(0).Child("name").String()}),
+		Entry("reject duplicate names", []v1.LauncherSecurityProfile{
+			{Name: "strict", NamespaceSelector: profileNamespaces, SELinuxType: "container_t"},
+			{Name: "strict", NamespaceSelector: profileNamespaces, SELinuxType: "spc_t"},
+		}, []string{test.Index(1).Child("name").String()}),
+		Entry("reject a profile without namespaceSelector", []v1.LauncherSecurityProfile{{
+			Name:        "strict",
+			Selector:    metav1.LabelSelector{MatchLabels: map[string]string{"confidential": "true"}},
+			SELinuxType: "container_t",
+		}}, []string{test.Index(0).Child("namespaceSelector").String()}),
+		Entry("reject an invalid namespaceSelector", []v1.LauncherSecurityProfile{{
+			Name: "strict",
+			NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "security-profiles", Operator: "Unknown",
+			}}},
+			SELinuxType: "container_t",
+		}}, []string{test.Index(0).Child("namespaceSelector").String()}),
+		Entry("reject an invalid selector", []v1.LauncherSecurityProfile{{
+			Name:              "strict",
+			NamespaceSelector: profileNamespaces,
+			Selector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "confidential", Operator: "Unknown",
+			}}},
+			SELinuxType: "container_t",
+		}}, []string{test.Index(0).Child("selector").String()}),
+		Entry("reject a profile without seccomp and selinuxType", []v1.LauncherSecurityProfile{{
+			Name:              "empty",
+			NamespaceSelector: profileNamespaces,
+		}}, []string{test.Index(0).String()}),
+		Entry("reject an invalid seccomp profile", []v1.LauncherSecurityProfile{{
+			Name:              "strict",
+			NamespaceSelector: profileNamespaces,
+			Seccomp:           &v1.VirtualMachineInstanceProfile{},
+		}}, []string{test.Index(0).Child("seccomp", "customProfile").String()}),
+		Entry("reject an invalid SELinux type", []v1.LauncherSecurityProfile{{
+			Name:              "strict",
+			NamespaceSelector: profileNamespaces,
+			SELinuxType:       "system_u:system_r:container_t",
+		}}, []string{test.Index(0).Child("selinuxType").String()}),
+	)
+
+	DescribeTable("validateEmulatorBundles", func(bundles []v1.EmulatorBundle, expectedFields []string) {
+		causes := validateEmulatorBundles(test, bundles)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid bundle", []v1.EmulatorBundle{{
+			Name:     "vendor",
+			Image:    emulatorBundleImage,
+			Emulator: "usr/bin/qemu-kvm",
+			Firmware: "usr/share/OVMF",
+		}}, nil),
+		Entry("reject a bundle without name", []v1.EmulatorBundle{{
+			Image: emulatorBundleImage, Emulator: "usr/bin/qemu-kvm",
+		}}, []string{test.Index(0).Child("name").String()}),
+		Entry("reject duplicate names", []v1.EmulatorBundle{
+			{Name: "vendor", Image: emulatorBundleImage, Emulator: "usr/bin/qemu-kvm"},
+			{Name: "vendor", Image: emulatorBundleImage, Emulator: "usr/bin/qemu-system-x86_64"},
+		}, []string{test.Index(1).Child("name").String()}),
+		Entry("reject an image referenced by tag", []v1.EmulatorBundle{{
+			Name: "vendor", Image: "registry.example.com/qemu:latest", Emulator: "usr/bin/qemu-kvm",
+		}}, []string{test.Index(0).Child("image").String()}),
+		Entry("reject a missing emulator", []v1.EmulatorBundle{{
+			Name: "vendor", Image: emulatorBundleImage,
+		}}, []string{test.Index(0).Child("emulator").String()}),
+		Entry("reject an absolute emulator path", []v1.EmulatorBundle{{
+			Name: "vendor", Image: emulatorBundleImage, Emulator: "/usr/bin/qemu-kvm",
+		}}, []string{test.Index(0).Child("emulator").String()}),
+		Entry("reject a firmware path escaping the image", []v1.EmulatorBundle{{
+			Name: "vendor", Image: emulatorBundleImage, Emulator: "usr/bin/qemu-kvm", Firmware: "../OVMF",
+		}}, []string{test.Index(0).Child("firmware").String()}),
+	)
+
+	DescribeTable("validateFirmwareProfiles", func(profiles []v1.FirmwareProfile, expectedFields []string) {
+		causes := validateFirmwareProfiles(test, profiles)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept valid profiles", []v1.FirmwareProfile{
+			{Name: "bios", Type: v1.FirmwareTypeBIOS},
+			{Name: "sev", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{
+				{Architecture: "amd64", Image: emulatorBundleImage, Path: "usr/share/OVMF"},
+				{Architecture: "arm64", Image: emulatorBundleImage},
+			}},
+		}, nil),
+		Entry("reject a profile without name", []v1.FirmwareProfile{{
+			Type: v1.FirmwareTypeUEFI,
+		}}, []string{test.Index(0).Child("name").String()}),
+		Entry("reject duplicate names", []v1.FirmwareProfile{
+			{Name: "uefi", Type: v1.FirmwareTypeUEFI},
+			{Name: "uefi", Type: v1.FirmwareTypeUEFISecureBoot},
+		}, []string{test.Index(1).Child("name").String()}),
+		Entry("reject an unknown type", []v1.FirmwareProfile{{
+			Name: "uefi", Type: "Coreboot",
+		}}, []string{test.Index(0).Child("type").String()}),
+		Entry("reject images for BIOS", []v1.FirmwareProfile{{
+			Name: "bios", Type: v1.FirmwareTypeBIOS, Images: []v1.FirmwareImage{{Architecture: "amd64", Image: emulatorBundleImage}},
+		}}, []string{test.Index(0).Child("images").String()}),
+		Entry("reject an unknown architecture", []v1.FirmwareProfile{{
+			Name: "uefi", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{{Architecture: "riscv64", Image: emulatorBundleImage}},
+		}}, []string{test.Index(0).Child("images").Index(0).Child("architecture").String()}),
+		Entry("reject duplicate architectures", []v1.FirmwareProfile{{
+			Name: "uefi", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{
+				{Architecture: "amd64", Image: emulatorBundleImage},
+				{Architecture: "amd64", Image: emulatorBundleImage},
+			},
+		}}, []string{test.Index(0).Child("images").Index(1).Child("architecture").String()}),
+		Entry("reject an image referenced by tag", []v1.FirmwareProfile{{
+			Name: "uefi", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{{Architecture: "amd64", Image: "registry.example.com/ovmf:latest"}},
+		}}, []string{test.Index(0).Child("images").Index(0).Child("image").String()}),
+		Entry("reject a path escaping the image", []v1.FirmwareProfile{{
+			Name: "uefi", Type: v1.FirmwareTypeUEFI, Images: []v1.FirmwareImage{{Architecture: "amd64", Image: emulatorBundleImage, Path: "/usr/share/OVMF"}},
+		}}, []string{test.Index(0).Child("images").Index(0).Child("path").String()}),
+	)
+
+	DescribeTable("validateVirtualFunctionPools", func(hostDevices *v1.PermittedHostDevices, expectedFields []string) {
+		causes := validateVirtualFunctionPools(test, hostDevices)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid pool", &v1.PermittedHostDevices{
+			VirtualFunctionPools: []v1.VirtualFunctionPool{{PFVendorSelector: "8086:1593", NumVFs: 4, ResourceName: "intel.com/e810_vf"}},
+		}, nil),
+		Entry("reject an invalid physical function selector", &v1.PermittedHostDevices{
+			VirtualFunctionPools: []v1.VirtualFunctionPool{{PFVendorSelector: "e810", NumVFs: 4, ResourceName: "intel.com/e810_vf"}},
+		}, []string{test.Index(0).Child("pfVendorSelector").String()}),
+		Entry("reject zero virtual functions", &v1.PermittedHostDevices{
+			VirtualFunctionPools: []v1.VirtualFunctionPool{{PFVendorSelector: "8086:1593", ResourceName: "intel.com/e810_vf"}},
+		}, []string{test.Index(0).Child("numVFs").String()}),
+		Entry("reject a missing resource name", &v1.PermittedHostDevices{
+			VirtualFunctionPools: []v1.VirtualFunctionPool{{PFVendorSelector: "8086:1593", NumVFs: 4}},
+		}, []string{test.Index(0).Child("resourceName").String()}),
+		Entry("reject duplicate resource names", &v1.PermittedHostDevices{
+			VirtualFunctionPools: []v1.VirtualFunctionPool{
+				{PFVendorSelector: "8086:1593", NumVFs: 4, ResourceName: "intel.com/e810_vf"},
+				{PFVendorSelector: "8086:159b", NumVFs: 4, ResourceName: "intel.com/e810_vf"},
+			},
+		}, []string{test.Index(1).Child("resourceName").String()}),
+		Entry("reject a resource name of a PCI host device", &v1.PermittedHostDevices{
+			PciHostDevices:       []v1.PciHostDevice{{PCIVendorSelector: "8086:1889", ResourceName: "intel.com/e810_vf"}},
+			VirtualFunctionPools: []v1.VirtualFunctionPool{{PFVendorSelector: "8086:1593", NumVFs: 4, ResourceName: "intel.com/e810_vf"}},
+		}, []string{test.Index(0).Child("resourceName").String()}),
+	)
+
+	DescribeTable("validateDefaultBindingPolicies", func(networkConfig *v1.NetworkConfiguration, expectedFields []string) {
+		causes := validateDefaultBindingPolicies(test, networkConfig)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept core bindings", &v1.NetworkConfiguration{
+			DefaultBindingPolicies: []v1.DefaultBindingPolicy{
+				{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"net": "bridge"}}, Binding: "bridge"},
+				{Binding: "masquerade"},
+			},
+		}, nil),
+		Entry("accept a registered binding plugin", &v1.NetworkConfiguration{
+			Binding: map[string]v1.InterfaceBindingPlugin{"passt": {}},
+			DefaultBindingPolicies: []v1.DefaultBindingPolicy{
+				{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"net": "passt"}}, Binding: "passt"},
+			},
+		}, nil),
+		Entry("reject an unknown binding", &v1.NetworkConfiguration{
+			DefaultBindingPolicies: []v1.DefaultBindingPolicy{{Binding: "passt"}},
+		}, []string{test.Index(0).Child("binding").String()}),
+		Entry("reject an invalid namespace selector", &v1.NetworkConfiguration{
+			DefaultBindingPolicies: []v1.DefaultBindingPolicy{{
+				NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "net", Operator: "Between"},
+				}},
+				Binding: "masquerade",
+			}},
+		}, []string{test.Index(0).Child("namespaceSelector").String()}),
+	)
+
+	DescribeTable("validateDNSRegistration", func(dnsRegistration *v1.DNSRegistrationConfiguration, expectedCauses int) {
+		causes := validateDNSRegistration(test, dnsRegistration)
+		Expect(causes).To(HaveLen(expectedCauses))
+		for _, cause := range causes {
+			Expect(cause.Field).To(Equal(test.Child("serviceName").String()))
+		}
+	},
+		Entry("accept a missing configuration", nil, 0),
+		Entry("accept the default service name", &v1.DNSRegistrationConfiguration{}, 0),
+		Entry("accept a valid service name", &v1.DNSRegistrationConfiguration{ServiceName: "vm-records"}, 0),
+		Entry("reject a service name with dots", &v1.DNSRegistrationConfiguration{ServiceName: "vm.records"}, 1),
+		Entry("reject a service name starting with a digit", &v1.DNSRegistrationConfiguration{ServiceName: "1vms"}, 1),
+	)
+
+	DescribeTable("validateHugepagesPool", func(hugepagesPool *v1.HugepagesPoolConfiguration, expectedFields []string) {
+		causes := validateHugepagesPool(test, hugepagesPool)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a missing configuration", nil, nil),
+		Entry("accept valid bounds", &v1.HugepagesPoolConfiguration{MinPages: 512, MaxPages: 4096}, nil),
+		Entry("accept equal bounds", &v1.HugepagesPoolConfiguration{MinPages: 4096, MaxPages: 4096}, nil),
+		Entry("reject a zero maximum", &v1.HugepagesPoolConfiguration{}, []string{test.Child("maxPages").String()}),
+		Entry("reject a minimum above the maximum", &v1.HugepagesPoolConfiguration{MinPages: 4097, MaxPages: 4096},
+			[]string{test.Child("minPages").String()}),
+	)
+
+	DescribeTable("validateHostSensors", func(hostSensors *v1.HostSensorsConfiguration, expectedFields []string) {
+		causes := validateHostSensors(test, hostSensors)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a missing configuration", nil, nil),
+		Entry("accept a namespace selector", &v1.HostSensorsConfiguration{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"appliances": "true"}},
+		}, nil),
+		Entry("reject an empty namespace selector", &v1.HostSensorsConfiguration{},
+			[]string{test.Child("namespaceSelector").String()}),
+		Entry("reject an invalid namespace selector", &v1.HostSensorsConfiguration{
+			NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key: "appliances", Operator: "Unknown",
+			}}},
+		}, []string{test.Child("namespaceSelector").String()}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -3,6 +3,7 @@ package adm
 import (
 	"github.com/spf13/cobra"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/checkpoint"
 	"kubevirt.io/kubevirt/pkg/virtctl/adm/logverbosity"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
@@ -20,6 +21,7 @@ func NewCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(logverbosity.NewCommand())
+	cmd.AddCommand(checkpoint.NewCommand())
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
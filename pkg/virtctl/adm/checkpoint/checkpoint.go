@@ -0,0 +1,372 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	SelectorFlag     = "selector"
+	StorageClassFlag = "storage-class"
+	TimeoutFlag      = "timeout"
+
+	createAction  = "create"
+	discardAction = "discard"
+
+	pollInterval   = 2 * time.Second
+	defaultTimeout = 10 * time.Minute
+)
+
+// command drives the checkpoint of the VMs from the client, no controller
+// takes over when it stops. Its progress is recorded on the VMs and claims,
+// so that it can be rerun.
+type command struct {
+	selector     string
+	storageClass string
+	timeout      time.Duration
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:     "checkpoint create|discard (VM)...",
+		Short:   "Checkpoint the memory state of running VMs to PVCs and stop them, to resume them on their next start.",
+		Example: usage(),
+		Args:    cobra.MinimumNArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVarP(&c.selector, SelectorFlag, "l", "", "Label selector of the VMs to act on, in addition to the named VMs.")
+	cmd.Flags().StringVar(&c.storageClass, StorageClassFlag, "", "The storage class of the PVCs holding the memory state.")
+	cmd.Flags().DurationVar(&c.timeout, TimeoutFlag, defaultTimeout, "How long to wait for the memory state of each VM to be saved.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Save the memory state of the VM 'myvm' to the PVC 'myvm-checkpoint' and stop it:
+  {{ProgramName}} adm checkpoint create myvm
+
+  # Checkpoint all the VMs labeled 'upgrade=checkpoint' to PVCs of the storage class 'object-backed':
+  {{ProgramName}} adm checkpoint create -l upgrade=checkpoint --storage-class=object-backed
+
+  # Resume the VM 'myvm' from its checkpoint:
+  {{ProgramName}} start myvm
+
+  # Boot the VM 'myvm' on its next start instead of resuming it from its checkpoint:
+  {{ProgramName}} adm checkpoint discard myvm`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	action := args[0]
+	if action != createAction && action != discardAction {
+		return fmt.Errorf("invalid action type %s", action)
+	}
+
+	vmNames, err := c.selectVMs(cmd.Context(), virtClient, namespace, args[1:])
+	if err != nil {
+		return err
+	}
+
+	for _, vmName := range vmNames {
+		if action == createAction {
+			err = c.checkpoint(cmd, virtClient, namespace, vmName)
+		} else {
+			err = discard(cmd, virtClient, namespace, vmName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *command) selectVMs(ctx context.Context, virtClient kubecli.KubevirtClient, namespace string, vmNames []string) ([]string, error) {
+	if c.selector != "" {
+		vms, err := virtClient.VirtualMachine(namespace).List(ctx, metav1.ListOptions{LabelSelector: c.selector})
+		if err != nil {
+			return nil, fmt.Errorf("error listing VMs: %v", err)
+		}
+		for _, vm := range vms.Items {
+			vmNames = append(vmNames, vm.Name)
+		}
+	}
+
+	if len(vmNames) == 0 {
+		return nil, fmt.Errorf("no VMs selected")
+	}
+
+	return vmNames, nil
+}
+
+// checkpoint saves the memory state of the VM and stops it. It picks up where
+// an interrupted run stopped, so that it can be rerun until it succeeds.
+func (c *command) checkpoint(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmName string) error {
+	ctx := cmd.Context()
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	claimName := claimNameForVM(vmName)
+	savingMemoryState := false
+	if request := vm.Status.MemoryDumpRequest; request != nil {
+		if request.ClaimName != claimName || request.Format != v1.MemoryDumpFormatState {
+			return fmt.Errorf("VM %s/%s has an associated memory dump, remove it before checkpointing the VM", namespace, vmName)
+		}
+		savingMemoryState = true
+	}
+	checkpointed := !savingMemoryState && hasMemoryStateClaim(vm, claimName)
+
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, vmName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		if checkpointed {
+			cmd.Printf("VM %s/%s is already checkpointed to PVC %s\n", namespace, vmName, claimName)
+			return nil
+		}
+		return fmt.Errorf("VM %s/%s is not running", namespace, vmName)
+	}
+	if err != nil {
+		return err
+	}
+
+	// a VMI which was not started from the checkpoint only has to be stopped,
+	// its memory state was saved before
+	if checkpointed && vmi.Annotations[v1.MemoryStateClaimAnnotation] != claimName {
+		return stop(cmd, virtClient, vm, claimName)
+	}
+
+	if err := c.ensureClaim(ctx, virtClient, vmi, claimName); err != nil {
+		return err
+	}
+
+	paused := isPaused(vmi)
+	if !paused {
+		if err := virtClient.VirtualMachineInstance(namespace).Pause(ctx, vmName, &v1.PauseOptions{}); err != nil {
+			return fmt.Errorf("error pausing VM %s/%s: %v", namespace, vmName, err)
+		}
+	}
+
+	if err := c.saveMemoryState(ctx, virtClient, namespace, vmName, claimName, savingMemoryState); err != nil {
+		if !paused {
+			if unpauseErr := virtClient.VirtualMachineInstance(namespace).Unpause(ctx, vmName, &v1.UnpauseOptions{}); unpauseErr != nil {
+				cmd.Printf("Failed to unpause VM %s/%s: %v\n", namespace, vmName, unpauseErr)
+			}
+		}
+		return err
+	}
+
+	if err := patchMemoryStateClaim(ctx, virtClient, vm, claimName); err != nil {
+		return err
+	}
+
+	return stop(cmd, virtClient, vm, claimName)
+}
+
+func stop(cmd *cobra.Command, virtClient kubecli.KubevirtClient, vm *v1.VirtualMachine, claimName string) error {
+	// the VM is already being stopped if a previous run requested it
+	if runStrategy, err := vm.RunStrategy(); err != nil || runStrategy != v1.RunStrategyHalted {
+		if err := virtClient.VirtualMachine(vm.Namespace).Stop(cmd.Context(), vm.Name, &v1.StopOptions{}); err != nil {
+			return fmt.Errorf("error stopping VM %s/%s: %v", vm.Namespace, vm.Name, err)
+		}
+	}
+
+	cmd.Printf("VM %s/%s was checkpointed to PVC %s and stopped\n", vm.Namespace, vm.Name, claimName)
+	return nil
+}
+
+func (c *command) ensureClaim(ctx context.Context, virtClient kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, claimName string) error {
+	_, err := virtClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(ctx, claimName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	size, err := storagetypes.GetSizeIncludingDefaultFSOverhead(kutil.CalcExpectedMemoryDumpSize(vmi))
+	if err != nil {
+		return err
+	}
+
+	pvc := &k8sv1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: vmi.Namespace,
+		},
+		Spec: k8sv1.PersistentVolumeClaimSpec{
+			AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+			Resources: k8sv1.VolumeResourceRequirements{
+				Requests: k8sv1.ResourceList{
+					k8sv1.ResourceStorage: *size,
+				},
+			},
+		},
+	}
+	if c.storageClass != "" {
+		pvc.Spec.StorageClassName = &c.storageClass
+	}
+
+	_, err = virtClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	return err
+}
+
+// saveMemoryState dumps the memory state of the VM to the claim and
+// dissociates the claim from the VM once the dump completed. If the dump was
+// requested by a previous run, it only waits for it.
+func (c *command) saveMemoryState(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmName, claimName string, requested bool) error {
+	if !requested {
+		request := &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: claimName,
+			Format:    v1.MemoryDumpFormatState,
+		}
+		if err := virtClient.VirtualMachine(namespace).MemoryDump(ctx, vmName, request); err != nil {
+			return fmt.Errorf("error saving the memory state of VM %s/%s: %v", namespace, vmName, err)
+		}
+	}
+
+	var dump *v1.VirtualMachineMemoryDumpRequest
+	err := virtwait.PollImmediately(pollInterval, c.timeout, func(ctx context.Context) (bool, error) {
+		vm, err := virtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		dump = vm.Status.MemoryDumpRequest
+		if dump == nil {
+			return false, nil
+		}
+		switch dump.Phase {
+		case v1.MemoryDumpCompleted, v1.MemoryDumpDissociating:
+			return true, nil
+		case v1.MemoryDumpFailed:
+			return false, fmt.Errorf("saving the memory state of VM %s/%s failed: %s", namespace, vmName, dump.Message)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !dump.Remove {
+		if err := virtClient.VirtualMachine(namespace).RemoveMemoryDump(ctx, vmName); err != nil {
+			return fmt.Errorf("error dissociating PVC %s from VM %s/%s: %v", claimName, namespace, vmName, err)
+		}
+	}
+
+	return virtwait.PollImmediately(pollInterval, c.timeout, func(ctx context.Context) (bool, error) {
+		vm, err := virtClient.VirtualMachine(namespace).Get(ctx, vmName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return vm.Status.MemoryDumpRequest == nil, nil
+	})
+}
+
+func discard(cmd *cobra.Command, virtClient kubecli.KubevirtClient, namespace, vmName string) error {
+	vm, err := virtClient.VirtualMachine(namespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if vm.Spec.Template == nil || vm.Spec.Template.ObjectMeta.Annotations[v1.MemoryStateClaimAnnotation] == "" {
+		cmd.Printf("VM %s/%s has no checkpoint\n", namespace, vmName)
+		return nil
+	}
+
+	claimName := vm.Spec.Template.ObjectMeta.Annotations[v1.MemoryStateClaimAnnotation]
+	patchBytes, err := patch.New(
+		patch.WithTest("/spec/template/metadata/annotations/"+patch.EscapeJSONPointer(v1.MemoryStateClaimAnnotation), claimName),
+		patch.WithRemove("/spec/template/metadata/annotations/"+patch.EscapeJSONPointer(v1.MemoryStateClaimAnnotation)),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := virtClient.VirtualMachine(namespace).Patch(cmd.Context(), vmName, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error discarding the checkpoint of VM %s/%s: %v", namespace, vmName, err)
+	}
+
+	cmd.Printf("Checkpoint of VM %s/%s was discarded, PVC %s can be deleted\n", namespace, vmName, claimName)
+	return nil
+}
+
+func patchMemoryStateClaim(ctx context.Context, virtClient kubecli.KubevirtClient, vm *v1.VirtualMachine, claimName string) error {
+	annotations := map[string]string{}
+	if vm.Spec.Template != nil {
+		for key, value := range vm.Spec.Template.ObjectMeta.Annotations {
+			annotations[key] = value
+		}
+	}
+	annotations[v1.MemoryStateClaimAnnotation] = claimName
+
+	patchBytes, err := patch.New(
+		patch.WithAdd("/spec/template/metadata/annotations", annotations),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := virtClient.VirtualMachine(vm.Namespace).Patch(ctx, vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error setting the memory state claim of VM %s/%s: %v", vm.Namespace, vm.Name, err)
+	}
+	return nil
+}
+
+func hasMemoryStateClaim(vm *v1.VirtualMachine, claimName string) bool {
+	return vm.Spec.Template != nil && vm.Spec.Template.ObjectMeta.Annotations[v1.MemoryStateClaimAnnotation] == claimName
+}
+
+func isPaused(vmi *v1.VirtualMachineInstance) bool {
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == v1.VirtualMachineInstancePaused && condition.Status == k8sv1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func claimNameForVM(vmName string) string {
+	return fmt.Sprintf("%s-checkpoint", vmName)
+}
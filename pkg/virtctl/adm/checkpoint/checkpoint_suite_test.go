@@ -0,0 +1,11 @@
+package checkpoint_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestCheckpoint(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
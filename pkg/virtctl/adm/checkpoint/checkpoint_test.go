@@ -0,0 +1,260 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package checkpoint_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/checkpoint"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Checkpoint", func() {
+	const (
+		vmName    = "test-vm"
+		claimName = "test-vm-checkpoint"
+
+		// 376Mi = (256Mi(vmi memory size) + 100Mi (memory dump overhead)) * 5.5%fsoverhead rounded to MiB
+		claimSize = "376Mi"
+	)
+
+	var (
+		kubeClient *fake.Clientset
+		virtClient *kubevirtfake.Clientset
+
+		vm *v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		kubeClient = fake.NewSimpleClientset()
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		vmi := libvmi.New(
+			libvmi.WithName(vmName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithMemoryRequest("256Mi"),
+		)
+		vm = libvmi.NewVirtualMachine(vmi, libvmi.WithRunStrategy(v1.RunStrategyAlways))
+		vm.Labels = map[string]string{"upgrade": "checkpoint"}
+
+		var err error
+		_, err = virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vm, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	setMemoryDumpRequest := func(request *v1.VirtualMachineMemoryDumpRequest) {
+		obj, err := virtClient.Tracker().Get(v1.SchemeGroupVersion.WithResource("virtualmachines"), metav1.NamespaceDefault, vmName)
+		Expect(err).ToNot(HaveOccurred())
+		current := obj.(*v1.VirtualMachine)
+		current.Status.MemoryDumpRequest = request
+		Expect(virtClient.Tracker().Update(v1.SchemeGroupVersion.WithResource("virtualmachines"), current, metav1.NamespaceDefault)).To(Succeed())
+	}
+
+	expectMemoryDump := func(phase v1.MemoryDumpPhase) {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			putAction, ok := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest])
+			Expect(ok).To(BeTrue())
+			request := putAction.GetOptions()
+			Expect(request.ClaimName).To(Equal(claimName))
+			Expect(request.Format).To(Equal(v1.MemoryDumpFormatState))
+			setMemoryDumpRequest(&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: request.ClaimName,
+				Format:    request.Format,
+				Phase:     phase,
+			})
+			return true, nil, nil
+		})
+		virtClient.PrependReactor("put", "virtualmachines/removememorydump", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+			setMemoryDumpRequest(nil)
+			return true, nil, nil
+		})
+	}
+
+	handleSubresources := func() {
+		for _, subresource := range []string{"virtualmachineinstances/pause", "virtualmachineinstances/unpause", "virtualmachines/stop"} {
+			virtClient.PrependReactor("put", subresource, func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, nil
+			})
+		}
+	}
+
+	memoryStateClaim := func() string {
+		updated, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated.Spec.Template.ObjectMeta.Annotations[v1.MemoryStateClaimAnnotation]
+	}
+
+	DescribeTable("should fail with invalid arguments", func(errorString string, args ...string) {
+		Expect(runCmd(args...)).To(MatchError(ContainSubstring(errorString)))
+	},
+		Entry("without action", "requires at least 1 arg(s), only received 0"),
+		Entry("with invalid action", "invalid action type restore", "restore", vmName),
+		Entry("without VMs", "no VMs selected", "create"),
+		Entry("with selector matching no VMs", "no VMs selected", "create", setFlag(checkpoint.SelectorFlag, "upgrade=none")),
+	)
+
+	It("should save the memory state of the VM and stop it", func() {
+		expectMemoryDump(v1.MemoryDumpCompleted)
+		handleSubresources()
+
+		Expect(runCmd("create", vmName)).To(Succeed())
+
+		pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), claimName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Spec.StorageClassName).To(BeNil())
+		Expect(pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]).To(Equal(resource.MustParse(claimSize)))
+
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "pause")).To(HaveLen(1))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "removememorydump")).To(HaveLen(1))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "stop")).To(HaveLen(1))
+		Expect(memoryStateClaim()).To(Equal(claimName))
+	})
+
+	It("should create the claim with the given storage class for VMs matching the selector", func() {
+		expectMemoryDump(v1.MemoryDumpCompleted)
+		handleSubresources()
+
+		Expect(runCmd("create",
+			setFlag(checkpoint.SelectorFlag, "upgrade=checkpoint"),
+			setFlag(checkpoint.StorageClassFlag, "object-backed"),
+		)).To(Succeed())
+
+		pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), claimName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("object-backed")))
+		Expect(memoryStateClaim()).To(Equal(claimName))
+	})
+
+	It("should unpause the VM and keep it running when saving the memory state fails", func() {
+		expectMemoryDump(v1.MemoryDumpFailed)
+		handleSubresources()
+
+		Expect(runCmd("create", vmName)).To(MatchError(ContainSubstring("saving the memory state of VM default/test-vm failed")))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "unpause")).To(HaveLen(1))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "stop")).To(BeEmpty())
+		Expect(memoryStateClaim()).To(BeEmpty())
+	})
+
+	It("should fail when the VM has an associated memory dump", func() {
+		setMemoryDumpRequest(&v1.VirtualMachineMemoryDumpRequest{ClaimName: "other"})
+
+		Expect(runCmd("create", vmName)).To(MatchError(ContainSubstring("has an associated memory dump")))
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "pause")).To(BeEmpty())
+	})
+
+	Context("after an interrupted run", func() {
+		setMemoryStateClaim := func() {
+			vm.Spec.Template.ObjectMeta.Annotations = map[string]string{v1.MemoryStateClaimAnnotation: claimName}
+			_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Update(context.Background(), vm, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should wait for the memory state it requested before", func() {
+			expectMemoryDump(v1.MemoryDumpCompleted)
+			handleSubresources()
+			setMemoryDumpRequest(&v1.VirtualMachineMemoryDumpRequest{
+				ClaimName: claimName,
+				Format:    v1.MemoryDumpFormatState,
+				Phase:     v1.MemoryDumpCompleted,
+			})
+
+			Expect(runCmd("create", vmName)).To(Succeed())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(BeEmpty())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "removememorydump")).To(HaveLen(1))
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "stop")).To(HaveLen(1))
+			Expect(memoryStateClaim()).To(Equal(claimName))
+		})
+
+		It("should only stop the VM once its memory state was saved", func() {
+			handleSubresources()
+			setMemoryStateClaim()
+
+			Expect(runCmd("create", vmName)).To(Succeed())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachineinstances", "pause")).To(BeEmpty())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(BeEmpty())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "stop")).To(HaveLen(1))
+		})
+
+		It("should succeed without acting on a VM which was checkpointed and stopped", func() {
+			setMemoryStateClaim()
+			Expect(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Delete(context.Background(), vmName, metav1.DeleteOptions{})).To(Succeed())
+
+			Expect(runCmd("create", vmName)).To(Succeed())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "stop")).To(BeEmpty())
+		})
+	})
+
+	It("should fail when the VM is not running", func() {
+		Expect(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Delete(context.Background(), vmName, metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(runCmd("create", vmName)).To(MatchError(ContainSubstring("VM default/test-vm is not running")))
+	})
+
+	It("should discard the checkpoint of the VM", func() {
+		vm.Spec.Template.ObjectMeta.Annotations = map[string]string{
+			v1.MemoryStateClaimAnnotation: claimName,
+			"other":                       "annotation",
+		}
+		_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Update(context.Background(), vm, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(runCmd("discard", vmName)).To(Succeed())
+
+		updated, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.Spec.Template.ObjectMeta.Annotations).To(Equal(map[string]string{"other": "annotation"}))
+	})
+})
+
+func setFlag(flag, parameter string) string {
+	return fmt.Sprintf("--%s=%s", flag, parameter)
+}
+
+func runCmd(args ...string) error {
+	_args := append([]string{"adm", "checkpoint"}, args...)
+	return testing.NewRepeatableVirtctlCommand(_args...)()
+}
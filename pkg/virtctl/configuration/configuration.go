@@ -56,6 +56,10 @@ func run(cmd *cobra.Command, _ []string) error {
 			hostDeviceList = append(hostDeviceList, hd.ResourceName)
 		}
 
+		for _, pool := range kv.Spec.Configuration.PermittedHostDevices.VirtualFunctionPools {
+			hostDeviceList = append(hostDeviceList, pool.ResourceName)
+		}
+
 		for _, hd := range kv.Spec.Configuration.PermittedHostDevices.MediatedDevices {
 			gpuDeviceList = append(gpuDeviceList, hd.ResourceName)
 		}
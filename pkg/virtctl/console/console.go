@@ -20,6 +20,7 @@
 package console
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -40,7 +41,9 @@ import (
 const defaultTimeoutMinutes = 5
 
 type consoleCommand struct {
-	timeout int
+	timeout   int
+	serial    string
+	reconnect bool
 }
 
 func NewCommand() *cobra.Command {
@@ -54,6 +57,10 @@ func NewCommand() *cobra.Command {
 	}
 	cmd.Flags().IntVar(&c.timeout, "timeout", defaultTimeoutMinutes,
 		"The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().StringVar(&c.serial, "serial", "",
+		"The name of the serial port to connect to instead of the serial console.")
+	cmd.Flags().BoolVar(&c.reconnect, "reconnect", false,
+		"Reconnect to the console when the connection breaks, e.g. after the virtual machine instance was migrated.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -62,7 +69,11 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Connect to the serial port 'kernel' on VirtualMachineInstance 'myvmi':
+  {{ProgramName}} console --serial=kernel myvmi
+  # Stay connected to the console on VirtualMachineInstance 'myvmi' across migrations:
+  {{ProgramName}} console --reconnect myvmi`
 
 	return usage
 }
@@ -75,10 +86,10 @@ func (c *consoleCommand) run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
 	}
 
-	return c.handleConsoleConnection(client, namespace, vmi)
+	return c.handleConsoleConnection(cmd.Context(), client, namespace, vmi)
 }
 
-func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient, namespace, vmi string) error {
+func (c *consoleCommand) handleConsoleConnection(ctx context.Context, client kubecli.KubevirtClient, namespace, vmi string) error {
 	// in -> stdinWriter | stdinReader -> console
 	// out <- stdoutReader | stdoutWriter <- console
 	// Wait until the virtual machine is in running phase, user interrupt or timeout
@@ -90,18 +101,32 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 	waitInterrupt := make(chan os.Signal, 1)
 	signal.Notify(waitInterrupt, os.Interrupt)
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	go func() {
-		con, err := client.VirtualMachineInstance(namespace).SerialConsole(vmi,
-			&kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute})
+		options := &kvcorev1.SerialConsoleOptions{ConnectionTimeout: time.Duration(c.timeout) * time.Minute, Serial: c.serial}
+		con, err := client.VirtualMachineInstance(namespace).SerialConsole(vmi, options)
 		runningChan <- err
 
 		if err != nil {
 			return
 		}
 
-		resChan <- con.Stream(kvcorev1.StreamOptions{
+		streamOptions := kvcorev1.StreamOptions{
 			In:  stdinReader,
 			Out: stdoutWriter,
+		}
+		if !c.reconnect {
+			resChan <- con.Stream(streamOptions)
+			return
+		}
+		dial := reuseStream(con, kubecli.SerialConsoleDialer(client.VirtualMachineInstance(namespace), vmi, options))
+		resChan <- kubecli.StreamWithReconnect(ctx, dial, streamOptions, kubecli.ReconnectOptions{
+			OnDisconnect: func(err error) {
+				// The terminal is in raw mode
+				fmt.Fprintf(os.Stderr, "\r\nDisconnected from the console, reconnecting: %v\r\n", err)
+			},
 		})
 	}()
 
@@ -131,6 +156,19 @@ func (c *consoleCommand) handleConsoleConnection(client kubecli.KubevirtClient,
 	return nil
 }
 
+// reuseStream returns a StreamDialer which returns the already opened stream
+// on the first call, and dials a new one on the following calls
+func reuseStream(stream kvcorev1.StreamInterface, dial kubecli.StreamDialer) kubecli.StreamDialer {
+	return func() (kvcorev1.StreamInterface, error) {
+		if stream != nil {
+			opened := stream
+			stream = nil
+			return opened, nil
+		}
+		return dial()
+	}
+}
+
 // Attach attaches stdin and stdout to the console
 // in -> stdinWriter | stdinReader -> console
 // out <- stdoutReader | stdoutWriter <- console
@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -36,7 +37,8 @@ import (
 )
 
 type virtCommand struct {
-	dryRun bool
+	dryRun             bool
+	autoUnpauseTimeout time.Duration
 }
 
 func NewCommand() *cobra.Command {
@@ -53,13 +55,18 @@ Second argument is the name of the resource.`,
 	}
 
 	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+	cmd.Flags().DurationVar(&c.autoUnpauseTimeout, "auto-unpause-timeout", 0, "Duration after which the virtual machine is automatically unpaused, e.g. 30m. If unset, the virtual machine stays paused until it is unpaused.")
 
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func usage() string {
-	return "  # Pause a virtualmachine called 'myvm':\n  {{ProgramName}} pause vm myvm"
+	usage := "  # Pause a virtualmachine called 'myvm':\n"
+	usage += "  {{ProgramName}} pause vm myvm\n\n"
+	usage += "  # Pause a virtualmachine called 'myvm' and unpause it automatically after one hour:\n"
+	usage += "  {{ProgramName}} pause vm myvm --auto-unpause-timeout=1h"
+	return usage
 }
 
 func (vc *virtCommand) Run(cmd *cobra.Command, args []string) error {
@@ -71,16 +78,22 @@ func (vc *virtCommand) Run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
 	}
 
-	var dryRunOption []string
+	pauseOptions := &kubevirtV1.PauseOptions{}
 	if vc.dryRun {
 		cmd.Println("Dry Run execution")
-		dryRunOption = []string{v1.DryRunAll}
+		pauseOptions.DryRun = []string{v1.DryRunAll}
+	}
+	if vc.autoUnpauseTimeout != 0 {
+		if vc.autoUnpauseTimeout < time.Second {
+			return fmt.Errorf("auto-unpause-timeout must be at least one second")
+		}
+		pauseOptions.AutoUnpauseTimeout = &v1.Duration{Duration: vc.autoUnpauseTimeout}
 	}
 
-	return executePauseCMD(virtClient, namespace, resourceType, resourceName, dryRunOption)
+	return executePauseCMD(virtClient, namespace, resourceType, resourceName, pauseOptions)
 }
 
-func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, resourceName string, dryRunOption []string) error {
+func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, resourceName string, pauseOptions *kubevirtV1.PauseOptions) error {
 	switch resourceType {
 	case "virtualmachine", "vm":
 		vm, err := client.VirtualMachine(namespace).Get(context.Background(), resourceName, v1.GetOptions{})
@@ -88,7 +101,7 @@ func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, res
 			return fmt.Errorf("Error getting VirtualMachine %s: %v", resourceName, err)
 		}
 
-		err = client.VirtualMachineInstance(namespace).Pause(context.Background(), vm.Name, &kubevirtV1.PauseOptions{DryRun: dryRunOption})
+		err = client.VirtualMachineInstance(namespace).Pause(context.Background(), vm.Name, pauseOptions)
 		if errors.IsNotFound(err) {
 			return handleNotFoundError(vm)
 		}
@@ -97,7 +110,7 @@ func executePauseCMD(client kubecli.KubevirtClient, namespace, resourceType, res
 		}
 
 	case "virtualmachineinstance", "vmi":
-		err := client.VirtualMachineInstance(namespace).Pause(context.Background(), resourceName, &kubevirtV1.PauseOptions{DryRun: dryRunOption})
+		err := client.VirtualMachineInstance(namespace).Pause(context.Background(), resourceName, pauseOptions)
 		if err != nil {
 			return fmt.Errorf("Error pausing VirtualMachineInstance %s: %v", resourceName, err)
 		}
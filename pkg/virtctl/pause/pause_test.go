@@ -2,6 +2,7 @@ package pause_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -52,12 +53,21 @@ var _ = Describe("Pausing", func() {
 		if len(pauseOptions.DryRun) > 0 {
 			args = append(args, "--dry-run")
 		}
+		if pauseOptions.AutoUnpauseTimeout != nil {
+			args = append(args, "--auto-unpause-timeout", pauseOptions.AutoUnpauseTimeout.Duration.String())
+		}
 		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
 	},
 		Entry("", &v1.PauseOptions{}),
 		Entry("with dry-run option", &v1.PauseOptions{DryRun: []string{k8smetav1.DryRunAll}}),
+		Entry("with auto-unpause timeout", &v1.PauseOptions{AutoUnpauseTimeout: &k8smetav1.Duration{Duration: 30 * time.Minute}}),
 	)
 
+	It("should fail with an auto-unpause timeout below one second", func() {
+		cmd := testing.NewRepeatableVirtctlCommand(COMMAND_PAUSE, "vmi", vmName, "--auto-unpause-timeout", "10ms")
+		Expect(cmd()).To(MatchError(ContainSubstring("auto-unpause-timeout must be at least one second")))
+	})
+
 	DescribeTable("should pause VM", func(pauseOptions *v1.PauseOptions) {
 		vmi := api.NewMinimalVMI(vmName)
 		vm := kubecli.NewMinimalVM(vmName)
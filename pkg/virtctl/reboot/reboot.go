@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package reboot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_REBOOT = "reboot"
+
+	methodFlag = "method"
+)
+
+type command struct {
+	method string
+	dryRun bool
+}
+
+func NewRebootCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "reboot (VMI)",
+		Short: "Gracefully reboot a virtual machine instance",
+		Long: `Gracefully reboots the guest of a virtual machine instance without recreating its virt-launcher pod.
+By default the guest agent is used when connected, falling back to an ACPI power button event otherwise.`,
+		Args:    cobra.ExactArgs(1),
+		Example: usage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.method, methodFlag, "", fmt.Sprintf("Reboot method to use, one of %s or %s. If unset the guest agent is preferred over ACPI.", v1.RebootMethodACPI, v1.RebootMethodGuestAgent))
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # Reboot a virtualmachineinstance called 'myvmi':\n"
+	usage += "  {{ProgramName}} reboot myvmi\n\n"
+	usage += "  # Reboot a virtualmachineinstance called 'myvmi' through the guest agent:\n"
+	usage += "  {{ProgramName}} reboot myvmi --method=GuestAgent"
+	return usage
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+
+	method := v1.RebootMethod(c.method)
+	switch method {
+	case "", v1.RebootMethodACPI, v1.RebootMethodGuestAgent:
+	default:
+		return fmt.Errorf("unsupported reboot method %q, must be one of %s, %s", c.method, v1.RebootMethodACPI, v1.RebootMethodGuestAgent)
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	rebootOptions := &v1.RebootOptions{Method: method}
+	if c.dryRun {
+		cmd.Println("Dry Run execution")
+		rebootOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	if err = virtClient.VirtualMachineInstance(namespace).Reboot(context.Background(), vmi, rebootOptions); err != nil {
+		return fmt.Errorf("Error rebooting VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	cmd.Printf("VMI %s was scheduled to %s\n", vmi, COMMAND_REBOOT)
+	return nil
+}
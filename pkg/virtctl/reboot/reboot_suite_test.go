@@ -0,0 +1,11 @@
+package reboot_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestReboot(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
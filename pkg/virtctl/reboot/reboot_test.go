@@ -0,0 +1,59 @@
+package reboot_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/reboot"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Rebooting", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	Context("With missing input parameters", func() {
+		It("should fail", func() {
+			cmd := testing.NewRepeatableVirtctlCommand(reboot.COMMAND_REBOOT)
+			err := cmd()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should fail with an unknown method", func() {
+		cmd := testing.NewRepeatableVirtctlCommand(reboot.COMMAND_REBOOT, "myvmi", "--method=PowerCycle")
+		Expect(cmd()).To(MatchError(ContainSubstring("unsupported reboot method")))
+	})
+
+	DescribeTable("should reboot VMI", func(extraArgs []string, expectedOptions *v1.RebootOptions) {
+		vmi := libvmi.New()
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().Reboot(context.Background(), vmi.Name, expectedOptions).Return(nil).Times(1)
+
+		args := append([]string{reboot.COMMAND_REBOOT, vmi.Name}, extraArgs...)
+		cmd := testing.NewRepeatableVirtctlCommand(args...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("with the default method", nil, &v1.RebootOptions{}),
+		Entry("with the ACPI method", []string{"--method=ACPI"}, &v1.RebootOptions{Method: v1.RebootMethodACPI}),
+		Entry("with the guest agent method", []string{"--method=GuestAgent"}, &v1.RebootOptions{Method: v1.RebootMethodGuestAgent}),
+		Entry("with dry-run", []string{"--dry-run"}, &v1.RebootOptions{DryRun: []string{metav1.DryRunAll}}),
+	)
+})
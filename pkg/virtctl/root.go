@@ -29,6 +29,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/objectgraph"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/reboot"
 	"kubevirt.io/kubevirt/pkg/virtctl/reset"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
@@ -40,6 +41,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/vm"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc"
+	"kubevirt.io/kubevirt/pkg/virtctl/vsock"
 )
 
 var (
@@ -109,6 +111,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		scp.NewCommand(),
 		ssh.NewCommand(),
 		portforward.NewCommand(),
+		vsock.NewCommand(),
 		vm.NewStartCommand(),
 		vm.NewStopCommand(),
 		vm.NewRestartCommand(),
@@ -125,6 +128,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		pause.NewCommand(),
 		unpause.NewCommand(),
 		softreboot.NewSoftRebootCommand(),
+		reboot.NewRebootCommand(),
 		reset.NewResetCommand(),
 		expose.NewCommand(),
 		version.VersionCommand(),
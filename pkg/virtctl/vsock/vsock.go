@@ -0,0 +1,260 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vsock
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	tlsFlag     = "tls"
+	addressFlag = "address"
+
+	vm  = "vm"
+	vmi = "vmi"
+)
+
+type vsockResource interface {
+	VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error)
+}
+
+type command struct {
+	useTLS  bool
+	address string
+}
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vsock",
+		Short: "Reach services listening on VSOCK inside a virtualmachine or virtualmachineinstance.",
+		Long: `Reach services listening on VSOCK inside a virtualmachine or virtualmachineinstance.
+
+The VSOCK device has to be attached to the guest with spec.domain.devices.autoattachVSOCK.
+Connections get established over the Kubernetes control-plane using websocket streams.
+Usage can be restricted by the cluster administrator through the /vsock subresource.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newConnectCommand(),
+		newPortForwardCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newConnectCommand() *cobra.Command {
+	c := command{useTLS: true}
+	cmd := &cobra.Command{
+		Use:   "connect type/name[/namespace] port",
+		Short: "Connect stdin and stdout to a VSOCK port of a virtualmachine or virtualmachineinstance.",
+		Example: `  # Connect to the VSOCK port 1234 of the vmi testvmi:
+  {{ProgramName}} vsock connect vmi/testvmi 1234
+
+  # Use the VSOCK port 22 of the vm testvm in mynamespace as ssh proxy:
+  ssh -o ProxyCommand="{{ProgramName}} vsock connect vm/testvm/mynamespace 22" user@testvm`,
+		Args: cobra.ExactArgs(2),
+		RunE: c.runConnect,
+	}
+	cmd.Flags().BoolVar(&c.useTLS, tlsFlag, c.useTLS,
+		fmt.Sprintf("--%s=false: Set this to false if the guest service does not speak TLS", tlsFlag))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newPortForwardCommand() *cobra.Command {
+	c := command{useTLS: true, address: "127.0.0.1"}
+	cmd := &cobra.Command{
+		Use:   "port-forward type/name[/namespace] localPort[:targetPort]",
+		Short: "Forward a local port to a VSOCK port of a virtualmachine or virtualmachineinstance.",
+		Example: `  # Forward the local port 8080 to the VSOCK port 8080 of the vmi testvmi:
+  {{ProgramName}} vsock port-forward vmi/testvmi 8080
+
+  # Forward the local port 8080 to the VSOCK port 1234 of the vm testvm in mynamespace:
+  {{ProgramName}} vsock port-forward vm/testvm/mynamespace 8080:1234`,
+		Args: cobra.ExactArgs(2),
+		RunE: c.runPortForward,
+	}
+	cmd.Flags().BoolVar(&c.useTLS, tlsFlag, c.useTLS,
+		fmt.Sprintf("--%s=false: Set this to false if the guest service does not speak TLS", tlsFlag))
+	cmd.Flags().StringVar(&c.address, addressFlag, c.address,
+		fmt.Sprintf("--%s=: Set this to the address the local port should be opened on", addressFlag))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *command) runConnect(cmd *cobra.Command, args []string) error {
+	// stdout carries the traffic
+	cmd.SetOut(os.Stderr)
+	cmd.Root().SetOut(os.Stderr)
+
+	port, err := parsePort(args[1])
+	if err != nil {
+		return err
+	}
+	resource, name, err := resourceFromTarget(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	stream, err := resource.VSOCK(name, &v1.VSOCKOptions{TargetPort: port, UseTLS: &c.useTLS})
+	if err != nil {
+		return fmt.Errorf("can't access VSOCK port %d of %s: %w", port, args[0], err)
+	}
+	return stream.Stream(kvcorev1.StreamOptions{
+		In:  os.Stdin,
+		Out: os.Stdout,
+	})
+}
+
+func (c *command) runPortForward(cmd *cobra.Command, args []string) error {
+	localPort, targetPort, err := parsePorts(args[1])
+	if err != nil {
+		return err
+	}
+	resource, name, err := resourceFromTarget(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(c.address, strconv.FormatUint(uint64(localPort), 10)))
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	log.Log.Infof("forwarding %s to VSOCK port %d", listener.Addr(), targetPort)
+
+	go c.acceptConnections(listener, resource, name, targetPort)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	<-signals
+
+	return nil
+}
+
+func (c *command) acceptConnections(listener net.Listener, resource vsockResource, name string, port uint32) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Log.Errorf("error accepting connection: %v", err)
+			return
+		}
+		stream, err := resource.VSOCK(name, &v1.VSOCKOptions{TargetPort: port, UseTLS: &c.useTLS})
+		if err != nil {
+			log.Log.Errorf("can't access VSOCK port %d of %s: %v", port, name, err)
+			conn.Close()
+			continue
+		}
+		go handleConnection(conn, stream.AsConn())
+	}
+}
+
+// handleConnection copies data between the local connection and the stream to
+// the guest.
+func handleConnection(local, remote net.Conn) {
+	errs := make(chan error)
+	go func() {
+		_, err := io.Copy(remote, local)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(local, remote)
+		errs <- err
+	}()
+
+	handleConnectionError(<-errs)
+	local.Close()
+	remote.Close()
+	handleConnectionError(<-errs)
+}
+
+func handleConnectionError(err error) {
+	if err != nil && !strings.Contains(err.Error(), "use of closed network connection") {
+		log.Log.Errorf("error handling VSOCK connection: %v", err)
+	}
+}
+
+func resourceFromTarget(cmd *cobra.Command, target string) (vsockResource, string, error) {
+	kind, namespace, name, err := portforward.ParseTarget(target)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, fallbackNamespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return nil, "", err
+	}
+	if namespace == "" {
+		namespace = fallbackNamespace
+	}
+
+	switch kind {
+	case vmi:
+		return client.VirtualMachineInstance(namespace), name, nil
+	case vm:
+		return client.VirtualMachine(namespace), name, nil
+	}
+	return nil, "", errors.New("unsupported resource type " + kind)
+}
+
+func parsePorts(arg string) (localPort, targetPort uint32, err error) {
+	local, target, found := strings.Cut(arg, ":")
+	if localPort, err = parsePort(local); err != nil {
+		return 0, 0, err
+	}
+	if !found {
+		return localPort, localPort, nil
+	}
+	if targetPort, err = parsePort(target); err != nil {
+		return 0, 0, err
+	}
+	return localPort, targetPort, nil
+}
+
+func parsePort(arg string) (uint32, error) {
+	port, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid port %q", arg)
+	}
+	return uint32(port), nil
+}
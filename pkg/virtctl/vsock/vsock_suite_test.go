@@ -0,0 +1,11 @@
+package vsock_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestVSOCK(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
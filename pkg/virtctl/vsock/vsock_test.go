@@ -0,0 +1,67 @@
+package vsock_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("VSOCK", func() {
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	DescribeTable("connect should fail with invalid arguments", func(errMsg string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"vsock", "connect"}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("without a port", "accepts 2 arg(s), received 1", "vmi/testvmi"),
+		Entry("with a non-numeric port", `invalid port "ssh"`, "vmi/testvmi", "ssh"),
+		Entry("with port zero", `invalid port "0"`, "vmi/testvmi", "0"),
+		Entry("with an unsupported kind", "unsupported resource type 'pod'", "pod/testpod", "1234"),
+	)
+
+	DescribeTable("port-forward should fail with invalid ports", func(ports, errMsg string) {
+		cmd := testing.NewRepeatableVirtctlCommand("vsock", "port-forward", "vmi/testvmi", ports)
+		Expect(cmd()).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("with an invalid local port", "foo:1234", `invalid port "foo"`),
+		Entry("with an invalid target port", "1234:bar", `invalid port "bar"`),
+	)
+
+	It("should connect to the VSOCK port of a VMI", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface)
+		vmiInterface.EXPECT().VSOCK("testvmi", &v1.VSOCKOptions{TargetPort: 1234, UseTLS: pointer.P(true)}).
+			Return(nil, errors.New("vsock not attached"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("vsock", "connect", "vmi/testvmi", "1234")
+		Expect(cmd()).To(MatchError(ContainSubstring("vsock not attached")))
+	})
+
+	It("should connect to the VSOCK port of a VM in another namespace without TLS", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("mynamespace").Return(vmInterface)
+		vmInterface.EXPECT().VSOCK("testvm", &v1.VSOCKOptions{TargetPort: 22, UseTLS: pointer.P(false)}).
+			Return(nil, errors.New("vsock not attached"))
+
+		cmd := testing.NewRepeatableVirtctlCommand("vsock", "connect", "vm/testvm/mynamespace", "22", "--tls=false")
+		Expect(cmd()).To(MatchError(ContainSubstring("vsock not attached")))
+	})
+})
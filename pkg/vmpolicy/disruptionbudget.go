@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmpolicy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+type budget struct {
+	selector  labels.Selector
+	remaining int32
+}
+
+// DisruptionBudgets tracks the disruptions the VirtualMachineDisruptionBudgets
+// still allow while a controller selects the VirtualMachineInstances it
+// disrupts. It is meant to be used for a single sync.
+type DisruptionBudgets struct {
+	budgetIndexer cache.Indexer
+	vmiStore      cache.Store
+	isDisrupted   func(vmi *v1.VirtualMachineInstance) bool
+
+	budgets map[string][]*budget
+}
+
+// NewDisruptionBudgets returns DisruptionBudgets for the budgets of the
+// indexer. isDisrupted reports whether a VirtualMachineInstance of the store is
+// already being disrupted, those count towards the budgets selecting them.
+func NewDisruptionBudgets(budgetIndexer cache.Indexer, vmiStore cache.Store, isDisrupted func(vmi *v1.VirtualMachineInstance) bool) *DisruptionBudgets {
+	return &DisruptionBudgets{
+		budgetIndexer: budgetIndexer,
+		vmiStore:      vmiStore,
+		isDisrupted:   isDisrupted,
+		budgets:       map[string][]*budget{},
+	}
+}
+
+// NewDisruptionBudgetsFromLists returns DisruptionBudgets for the listed budgets
+// and VirtualMachineInstances, for callers which do not watch them.
+func NewDisruptionBudgetsFromLists(budgets []policyv1alpha1.VirtualMachineDisruptionBudget, vmis []v1.VirtualMachineInstance, isDisrupted func(vmi *v1.VirtualMachineInstance) bool) (*DisruptionBudgets, error) {
+	budgetIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for i := range budgets {
+		if err := budgetIndexer.Add(&budgets[i]); err != nil {
+			return nil, err
+		}
+	}
+	vmiStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for i := range vmis {
+		if err := vmiStore.Add(&vmis[i]); err != nil {
+			return nil, err
+		}
+	}
+	return NewDisruptionBudgets(budgetIndexer, vmiStore, isDisrupted), nil
+}
+
+// Filter returns the VirtualMachineInstances which can be disrupted without
+// exceeding a budget. They are considered in order and each returned
+// VirtualMachineInstance counts towards the budgets selecting it.
+func (d *DisruptionBudgets) Filter(vmis []*v1.VirtualMachineInstance) ([]*v1.VirtualMachineInstance, error) {
+	var allowed []*v1.VirtualMachineInstance
+	for _, vmi := range vmis {
+		budgets, err := d.namespaceBudgets(vmi.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		var selecting []*budget
+		exceeded := false
+		for _, b := range budgets {
+			if !b.selector.Matches(labels.Set(vmi.Labels)) {
+				continue
+			}
+			if b.remaining <= 0 {
+				exceeded = true
+				break
+			}
+			selecting = append(selecting, b)
+		}
+		if exceeded {
+			log.Log.Object(vmi).V(4).Infof("Not disrupting VirtualMachineInstance, its disruption budget is exhausted")
+			continue
+		}
+
+		for _, b := range selecting {
+			b.remaining--
+		}
+		allowed = append(allowed, vmi)
+	}
+	return allowed, nil
+}
+
+func (d *DisruptionBudgets) namespaceBudgets(namespace string) ([]*budget, error) {
+	if budgets, exists := d.budgets[namespace]; exists {
+		return budgets, nil
+	}
+
+	objs, err := d.budgetIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets []*budget
+	for _, obj := range objs {
+		vmdb := obj.(*policyv1alpha1.VirtualMachineDisruptionBudget)
+		selector, err := metav1.LabelSelectorAsSelector(vmdb.Spec.Selector)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Ignoring invalid selector of VirtualMachineDisruptionBudget %s/%s", vmdb.Namespace, vmdb.Name)
+			continue
+		}
+		budgets = append(budgets, &budget{selector: selector, remaining: vmdb.Spec.MaxDisruptions})
+	}
+
+	if len(budgets) > 0 {
+		for _, obj := range d.vmiStore.List() {
+			vmi := obj.(*v1.VirtualMachineInstance)
+			if vmi.Namespace != namespace || !d.isDisrupted(vmi) {
+				continue
+			}
+			for _, b := range budgets {
+				if b.selector.Matches(labels.Set(vmi.Labels)) {
+					b.remaining--
+				}
+			}
+		}
+	}
+
+	d.budgets[namespace] = budgets
+	return budgets, nil
+}
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package vmpolicy_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
+)
+
+var _ = Describe("DisruptionBudgets", func() {
+	var (
+		budgetIndexer cache.Indexer
+		vmiStore      cache.Store
+		disrupted     map[string]bool
+	)
+
+	newBudget := func(name string, matchLabels map[string]string, maxDisruptions int32) *policyv1alpha1.VirtualMachineDisruptionBudget {
+		return &policyv1alpha1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec: policyv1alpha1.VirtualMachineDisruptionBudgetSpec{
+				Selector:       &metav1.LabelSelector{MatchLabels: matchLabels},
+				MaxDisruptions: maxDisruptions,
+			},
+		}
+	}
+
+	newVMI := func(name string, labels map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Labels: labels},
+		}
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+		return vmi
+	}
+
+	filter := func(vmis ...*v1.VirtualMachineInstance) []*v1.VirtualMachineInstance {
+		budgets := vmpolicy.NewDisruptionBudgets(budgetIndexer, vmiStore, func(vmi *v1.VirtualMachineInstance) bool {
+			return disrupted[vmi.Name]
+		})
+		allowed, err := budgets.Filter(vmis)
+		Expect(err).ToNot(HaveOccurred())
+		return allowed
+	}
+
+	BeforeEach(func() {
+		budgetIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		disrupted = map[string]bool{}
+	})
+
+	It("should allow all disruptions without budgets", func() {
+		a, b := newVMI("a", nil), newVMI("b", nil)
+		Expect(filter(a, b)).To(ConsistOf(a, b))
+	})
+
+	It("should allow disruptions up to the budget", func() {
+		Expect(budgetIndexer.Add(newBudget("db", map[string]string{"app": "db"}, 2))).To(Succeed())
+		a := newVMI("a", map[string]string{"app": "db"})
+		b := newVMI("b", map[string]string{"app": "db"})
+		c := newVMI("c", map[string]string{"app": "db"})
+		other := newVMI("other", map[string]string{"app": "web"})
+
+		Expect(filter(a, b, c, other)).To(Equal([]*v1.VirtualMachineInstance{a, b, other}))
+	})
+
+	It("should count the VMIs which are already disrupted", func() {
+		Expect(budgetIndexer.Add(newBudget("db", map[string]string{"app": "db"}, 1))).To(Succeed())
+		newVMI("migrating", map[string]string{"app": "db"})
+		disrupted["migrating"] = true
+		a := newVMI("a", map[string]string{"app": "db"})
+
+		Expect(filter(a)).To(BeEmpty())
+	})
+
+	It("should enforce every budget selecting a VMI", func() {
+		Expect(budgetIndexer.Add(newBudget("db", map[string]string{"app": "db"}, 2))).To(Succeed())
+		Expect(budgetIndexer.Add(newBudget("zone", map[string]string{"zone": "a"}, 1))).To(Succeed())
+		a := newVMI("a", map[string]string{"app": "db", "zone": "a"})
+		b := newVMI("b", map[string]string{"app": "db", "zone": "a"})
+		c := newVMI("c", map[string]string{"app": "db", "zone": "b"})
+
+		Expect(filter(a, b, c)).To(Equal([]*v1.VirtualMachineInstance{a, c}))
+	})
+
+	It("should only count budgets of the namespace of the VMI", func() {
+		budget := newBudget("db", map[string]string{"app": "db"}, 0)
+		budget.Namespace = "other"
+		Expect(budgetIndexer.Add(budget)).To(Succeed())
+		a := newVMI("a", map[string]string{"app": "db"})
+
+		Expect(filter(a)).To(ConsistOf(a))
+	})
+	It("should count the disrupted VMIs of listed budgets and VMIs", func() {
+		budgets, err := vmpolicy.NewDisruptionBudgetsFromLists(
+			[]policyv1alpha1.VirtualMachineDisruptionBudget{*newBudget("db", map[string]string{"app": "db"}, 1)},
+			[]v1.VirtualMachineInstance{*newVMI("migrating", map[string]string{"app": "db"})},
+			func(vmi *v1.VirtualMachineInstance) bool { return vmi.Name == "migrating" },
+		)
+		Expect(err).ToNot(HaveOccurred())
+		allowed, err := budgets.Filter([]*v1.VirtualMachineInstance{newVMI("a", map[string]string{"app": "db"})})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allowed).To(BeEmpty())
+	})
+})
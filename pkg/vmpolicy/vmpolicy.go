@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const day = 24 * time.Hour
+
+// List returns the VirtualMachinePolicies of the namespace sorted by name
+func List(indexer cache.Indexer, namespace string) ([]*policyv1alpha1.VirtualMachinePolicy, error) {
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*policyv1alpha1.VirtualMachinePolicy, 0, len(objs))
+	for _, obj := range objs {
+		policies = append(policies, obj.(*policyv1alpha1.VirtualMachinePolicy))
+	}
+	slices.SortFunc(policies, func(a, b *policyv1alpha1.VirtualMachinePolicy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return policies, nil
+}
+
+// DefaultRunStrategy returns the default run strategy of the first policy,
+// in the order of their names, which sets one
+func DefaultRunStrategy(policies []*policyv1alpha1.VirtualMachinePolicy) *v1.VirtualMachineRunStrategy {
+	for _, policy := range policies {
+		if policy.Spec.DefaultRunStrategy != nil {
+			return policy.Spec.DefaultRunStrategy
+		}
+	}
+	return nil
+}
+
+// PowerOffWindowState returns whether a power off window of the policies is
+// active at the given time, along with the time at which this changes. The
+// returned time is zero if the policies have no power off windows.
+func PowerOffWindowState(policies []*policyv1alpha1.VirtualMachinePolicy, now time.Time) (bool, time.Time) {
+	now = now.UTC()
+	active := false
+	var next time.Time
+	for _, policy := range policies {
+		for _, window := range policy.Spec.PowerOffWindows {
+			start, end, err := windowBounds(&window)
+			if err != nil {
+				log.Log.Reason(err).Errorf("Ignoring invalid power off window of VirtualMachinePolicy %s/%s", policy.Namespace, policy.Name)
+				continue
+			}
+			if start == end {
+				continue
+			}
+
+			// A window starting on the previous day may still be active,
+			// and every window occurs at least once within a week.
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+			for offset := -1; offset <= 7; offset++ {
+				date := today.Add(time.Duration(offset) * day)
+				if !occursOn(&window, date.Weekday()) {
+					continue
+				}
+				windowStart, windowEnd := date.Add(start), date.Add(end)
+				switch {
+				case !now.Before(windowStart) && now.Before(windowEnd):
+					if !active || windowEnd.Before(next) {
+						next = windowEnd
+					}
+					active = true
+				case !active && windowStart.After(now) && (next.IsZero() || windowStart.Before(next)):
+					next = windowStart
+				}
+			}
+		}
+	}
+	return active, next
+}
+
+// windowBounds returns the start and the end of the window as offsets from
+// the midnight of the day it starts on. Windows which end before they start
+// end on the next day.
+func windowBounds(window *policyv1alpha1.PowerOffWindow) (time.Duration, time.Duration, error) {
+	start, err := parseTimeOfDay(window.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseTimeOfDay(window.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		end += day
+	}
+	return start, end, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: %v", value, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func occursOn(window *policyv1alpha1.PowerOffWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	return slices.Contains(window.Days, policyv1alpha1.Weekday(weekday.String()))
+}
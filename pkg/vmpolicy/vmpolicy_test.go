@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmpolicy_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/vmpolicy"
+)
+
+var _ = Describe("VirtualMachinePolicy", func() {
+	newPolicy := func(name string, spec policyv1alpha1.VirtualMachinePolicySpec) *policyv1alpha1.VirtualMachinePolicy {
+		return &policyv1alpha1.VirtualMachinePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       spec,
+		}
+	}
+
+	It("should list the policies of the namespace sorted by name", func() {
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		Expect(indexer.Add(newPolicy("b", policyv1alpha1.VirtualMachinePolicySpec{}))).To(Succeed())
+		Expect(indexer.Add(newPolicy("a", policyv1alpha1.VirtualMachinePolicySpec{}))).To(Succeed())
+		other := newPolicy("c", policyv1alpha1.VirtualMachinePolicySpec{})
+		other.Namespace = "other"
+		Expect(indexer.Add(other)).To(Succeed())
+
+		policies, err := vmpolicy.List(indexer, metav1.NamespaceDefault)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies).To(HaveLen(2))
+		Expect(policies[0].Name).To(Equal("a"))
+		Expect(policies[1].Name).To(Equal("b"))
+	})
+
+	It("should use the default run strategy of the first policy setting one", func() {
+		policies := []*policyv1alpha1.VirtualMachinePolicy{
+			newPolicy("a", policyv1alpha1.VirtualMachinePolicySpec{}),
+			newPolicy("b", policyv1alpha1.VirtualMachinePolicySpec{DefaultRunStrategy: pointer.P(v1.RunStrategyRerunOnFailure)}),
+			newPolicy("c", policyv1alpha1.VirtualMachinePolicySpec{DefaultRunStrategy: pointer.P(v1.RunStrategyManual)}),
+		}
+		Expect(vmpolicy.DefaultRunStrategy(policies)).To(HaveValue(Equal(v1.RunStrategyRerunOnFailure)))
+		Expect(vmpolicy.DefaultRunStrategy(policies[:1])).To(BeNil())
+	})
+
+	// Monday, January 1st 2024
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2024, time.January, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	DescribeTable("should compute the power off window state", func(windows []policyv1alpha1.PowerOffWindow, now time.Time, expectedActive bool, expectedNext time.Time) {
+		policies := []*policyv1alpha1.VirtualMachinePolicy{
+			newPolicy("a", policyv1alpha1.VirtualMachinePolicySpec{PowerOffWindows: windows}),
+		}
+		active, next := vmpolicy.PowerOffWindowState(policies, now)
+		Expect(active).To(Equal(expectedActive))
+		Expect(next).To(Equal(expectedNext))
+	},
+		Entry("without windows", nil, monday(12, 0), false, time.Time{}),
+		Entry("before a daily window",
+			[]policyv1alpha1.PowerOffWindow{{Start: "20:00", End: "22:00"}},
+			monday(12, 0), false, monday(20, 0),
+		),
+		Entry("within a daily window",
+			[]policyv1alpha1.PowerOffWindow{{Start: "20:00", End: "22:00"}},
+			monday(21, 0), true, monday(22, 0),
+		),
+		Entry("after a daily window",
+			[]policyv1alpha1.PowerOffWindow{{Start: "20:00", End: "22:00"}},
+			monday(22, 0), false, monday(20, 0).AddDate(0, 0, 1),
+		),
+		Entry("within a window which started on the previous day",
+			[]policyv1alpha1.PowerOffWindow{{Start: "22:00", End: "06:00"}},
+			monday(3, 0), true, monday(6, 0),
+		),
+		Entry("within a window which started on a listed day",
+			[]policyv1alpha1.PowerOffWindow{{Start: "22:00", End: "06:00", Days: []policyv1alpha1.Weekday{"Sunday"}}},
+			monday(3, 0), true, monday(6, 0),
+		),
+		Entry("on a day which is not listed",
+			[]policyv1alpha1.PowerOffWindow{{Start: "00:00", End: "23:00", Days: []policyv1alpha1.Weekday{"Saturday", "Sunday"}}},
+			monday(12, 0), false, monday(0, 0).AddDate(0, 0, 5),
+		),
+		Entry("within overlapping windows",
+			[]policyv1alpha1.PowerOffWindow{{Start: "08:00", End: "13:00"}, {Start: "11:00", End: "12:00"}},
+			monday(11, 30), true, monday(12, 0),
+		),
+		Entry("with a window starting when it ends",
+			[]policyv1alpha1.PowerOffWindow{{Start: "08:00", End: "08:00"}},
+			monday(8, 0), false, time.Time{},
+		),
+		Entry("with an invalid window",
+			[]policyv1alpha1.PowerOffWindow{{Start: "8am", End: "10:00"}},
+			monday(9, 0), false, time.Time{},
+		),
+	)
+})
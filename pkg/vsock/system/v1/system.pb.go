@@ -12,6 +12,7 @@ It has these top-level messages:
 
 	Bundle
 	EmptyRequest
+	DiskExpansionRequest
 */
 package v1
 
@@ -62,9 +63,34 @@ func (m *EmptyRequest) String() string            { return proto.CompactTextStri
 func (*EmptyRequest) ProtoMessage()               {}
 func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
 
+type DiskExpansionRequest struct {
+	Disk string `protobuf:"bytes,1,opt,name=Disk" json:"Disk,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=Size" json:"Size,omitempty"`
+}
+
+func (m *DiskExpansionRequest) Reset()                    { *m = DiskExpansionRequest{} }
+func (m *DiskExpansionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DiskExpansionRequest) ProtoMessage()               {}
+func (*DiskExpansionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *DiskExpansionRequest) GetDisk() string {
+	if m != nil {
+		return m.Disk
+	}
+	return ""
+}
+
+func (m *DiskExpansionRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Bundle)(nil), "kubevirt.vsock.system.v1.Bundle")
 	proto.RegisterType((*EmptyRequest)(nil), "kubevirt.vsock.system.v1.EmptyRequest")
+	proto.RegisterType((*DiskExpansionRequest)(nil), "kubevirt.vsock.system.v1.DiskExpansionRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -79,6 +105,7 @@ const _ = grpc.SupportPackageIsVersion4
 
 type SystemClient interface {
 	CABundle(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Bundle, error)
+	RequestDiskExpansion(ctx context.Context, in *DiskExpansionRequest, opts ...grpc.CallOption) (*EmptyRequest, error)
 }
 
 type systemClient struct {
@@ -98,10 +125,20 @@ func (c *systemClient) CABundle(ctx context.Context, in *EmptyRequest, opts ...g
 	return out, nil
 }
 
+func (c *systemClient) RequestDiskExpansion(ctx context.Context, in *DiskExpansionRequest, opts ...grpc.CallOption) (*EmptyRequest, error) {
+	out := new(EmptyRequest)
+	err := grpc.Invoke(ctx, "/kubevirt.vsock.system.v1.System/RequestDiskExpansion", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for System service
 
 type SystemServer interface {
 	CABundle(context.Context, *EmptyRequest) (*Bundle, error)
+	RequestDiskExpansion(context.Context, *DiskExpansionRequest) (*EmptyRequest, error)
 }
 
 func RegisterSystemServer(s *grpc.Server, srv SystemServer) {
@@ -126,6 +163,24 @@ func _System_CABundle_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _System_RequestDiskExpansion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskExpansionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).RequestDiskExpansion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.vsock.system.v1.System/RequestDiskExpansion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).RequestDiskExpansion(ctx, req.(*DiskExpansionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _System_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.vsock.system.v1.System",
 	HandlerType: (*SystemServer)(nil),
@@ -134,6 +189,10 @@ var _System_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CABundle",
 			Handler:    _System_CABundle_Handler,
 		},
+		{
+			MethodName: "RequestDiskExpansion",
+			Handler:    _System_RequestDiskExpansion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/vsock/system/v1/system.proto",
@@ -142,15 +201,19 @@ var _System_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/vsock/system/v1/system.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
+	// 219 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xc8, 0x4e, 0xd7,
 	0x2f, 0x2b, 0xce, 0x4f, 0xce, 0xd6, 0x2f, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0xd5, 0x2f, 0x33, 0x84,
 	0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24, 0xb2, 0x4b, 0x93, 0x52, 0xcb, 0x32, 0x8b,
 	0x4a, 0xf4, 0xc0, 0xca, 0xf4, 0xa0, 0x92, 0x65, 0x86, 0x4a, 0x52, 0x5c, 0x6c, 0x4e, 0xa5, 0x79,
 	0x29, 0x39, 0xa9, 0x42, 0x02, 0x5c, 0xcc, 0x41, 0x89, 0xe5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x3c,
 	0x41, 0x20, 0xa6, 0x12, 0x1f, 0x17, 0x8f, 0x6b, 0x6e, 0x41, 0x49, 0x65, 0x50, 0x6a, 0x61, 0x69,
-	0x6a, 0x71, 0x89, 0x51, 0x02, 0x17, 0x5b, 0x30, 0x58, 0xa3, 0x50, 0x18, 0x17, 0x87, 0xb3, 0x23,
-	0x54, 0x9f, 0x9a, 0x1e, 0x2e, 0xc3, 0xf5, 0x90, 0x75, 0x4b, 0x29, 0xe0, 0x56, 0x07, 0x31, 0x49,
-	0x89, 0xc1, 0x89, 0x25, 0x8a, 0xa9, 0xcc, 0x30, 0x89, 0x0d, 0xec, 0x68, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe2, 0x39, 0xce, 0xd8, 0x00, 0x00, 0x00,
+	0x6a, 0x71, 0x89, 0x92, 0x1d, 0x97, 0x88, 0x4b, 0x66, 0x71, 0xb6, 0x6b, 0x45, 0x41, 0x62, 0x5e,
+	0x71, 0x66, 0x7e, 0x1e, 0x54, 0x5c, 0x48, 0x88, 0x8b, 0x05, 0x24, 0x0e, 0xd6, 0xca, 0x19, 0x04,
+	0x66, 0x83, 0xc4, 0x82, 0x33, 0xab, 0x52, 0x25, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0xc0, 0x6c,
+	0xa3, 0x4b, 0x8c, 0x5c, 0x6c, 0xc1, 0x60, 0x9b, 0x85, 0xc2, 0xb8, 0x38, 0x9c, 0x1d, 0xa1, 0x16,
+	0xab, 0xe9, 0xe1, 0x72, 0x9d, 0x1e, 0xb2, 0xf5, 0x52, 0x0a, 0xb8, 0xd5, 0x41, 0x4c, 0x52, 0x62,
+	0x10, 0x2a, 0xe0, 0x12, 0x81, 0x2a, 0x47, 0x71, 0xa9, 0x90, 0x1e, 0x6e, 0xbd, 0xd8, 0xbc, 0x24,
+	0x45, 0xa4, 0x9b, 0x94, 0x18, 0x9c, 0x58, 0xa2, 0x98, 0xca, 0x0c, 0x93, 0xd8, 0xc0, 0xe1, 0x6c,
+	0x0c, 0x18, 0x00, 0xe9, 0x83, 0xc8, 0xe9, 0x8b, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineUsageReport accumulates the resources consumed by the
+// VirtualMachineInstances of a namespace during one period, for showback and
+// chargeback. Reports are created and updated by virt-controller.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineUsageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineUsageReportSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineUsageReportStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineUsageReportList is a list of VirtualMachineUsageReport resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineUsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineUsageReport `json:"items"`
+}
+
+// VirtualMachineUsageReportSpec is the spec for a VirtualMachineUsageReport resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineUsageReportSpec struct {
+	// PeriodStart is the beginning of the reported period
+	PeriodStart metav1.Time `json:"periodStart"`
+	// PeriodEnd is the end of the reported period
+	PeriodEnd metav1.Time `json:"periodEnd"`
+}
+
+// VirtualMachineUsageReportStatus is the status for a VirtualMachineUsageReport resource
+type VirtualMachineUsageReportStatus struct {
+	// LastUpdateTime is the time the usage was last accumulated into the report
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Allocated is the usage of the resources allocated to the guests of the namespace
+	Allocated ResourceUsage `json:"allocated"`
+	// Actual is the usage of the resources the VirtualMachineInstances of the
+	// namespace consumed on their nodes, as measured for their pods
+	Actual ResourceUsage `json:"actual"`
+	// VirtualMachineInstances breaks the usage down by VirtualMachineInstance
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	VirtualMachineInstances []VirtualMachineInstanceUsage `json:"virtualMachineInstances,omitempty"`
+}
+
+// ResourceUsage is the usage of CPU and memory over time
+type ResourceUsage struct {
+	// VCPUHours is the number of vCPUs multiplied with the hours they were used
+	VCPUHours resource.Quantity `json:"vcpuHours"`
+	// MemoryGiBHours is the memory in GiB multiplied with the hours it was used
+	MemoryGiBHours resource.Quantity `json:"memoryGiBHours"`
+}
+
+// VirtualMachineInstanceUsage is the usage of a single VirtualMachineInstance
+type VirtualMachineInstanceUsage struct {
+	// Name of the VirtualMachineInstance
+	Name      string        `json:"name"`
+	Allocated ResourceUsage `json:"allocated"`
+	Actual    ResourceUsage `json:"actual"`
+}
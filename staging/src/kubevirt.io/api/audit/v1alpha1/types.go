@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineAuditEvent records a privileged operation a user requested on a
+// VirtualMachine or VirtualMachineInstance through the subresource API.
+// Events are written by virt-api and can't be changed afterwards.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineAuditEvent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineAuditEventSpec `json:"spec"`
+}
+
+// VirtualMachineAuditEventList is a list of VirtualMachineAuditEvent resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineAuditEventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineAuditEvent `json:"items"`
+}
+
+// AuditOperation is the const type for the operations which are audited
+type AuditOperation string
+
+const (
+	// ConsoleOperation attaches to the serial console of a VMI
+	ConsoleOperation AuditOperation = "Console"
+	// VNCOperation attaches to the graphical console of a VMI
+	VNCOperation AuditOperation = "VNC"
+	// MemoryDumpOperation dumps the memory of a VM to a PVC
+	MemoryDumpOperation AuditOperation = "MemoryDump"
+	// FreezeOperation quiesces the guest filesystems of a VMI
+	FreezeOperation AuditOperation = "Freeze"
+	// UnfreezeOperation thaws the guest filesystems of a VMI
+	UnfreezeOperation AuditOperation = "Unfreeze"
+	// PortForwardOperation forwards a port of a VMI to the client
+	PortForwardOperation AuditOperation = "PortForward"
+	// VSOCKOperation connects the client to a VSOCK port of a VMI
+	VSOCKOperation AuditOperation = "VSOCK"
+	// USBRedirOperation redirects a USB device of the client to a VMI
+	USBRedirOperation AuditOperation = "USBRedir"
+)
+
+// AuditResult is the const type for the results of audited operations
+type AuditResult string
+
+const (
+	// SucceededResult means that virt-api answered the request with a success status code
+	SucceededResult AuditResult = "Succeeded"
+	// FailedResult means that virt-api answered the request with an error status code
+	FailedResult AuditResult = "Failed"
+)
+
+// VirtualMachineAuditEventSpec is the spec for a VirtualMachineAuditEvent resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineAuditEventSpec struct {
+	// Target specifies the VM or VMI the operation was requested on
+	Target corev1.TypedLocalObjectReference `json:"target"`
+	// +kubebuilder:validation:Enum=Console;VNC;MemoryDump;Freeze;Unfreeze;PortForward;VSOCK;USBRedir
+	// Operation is the privileged operation which was requested
+	Operation AuditOperation `json:"operation"`
+	// User is the identity the request was authorized for
+	User AuditUser `json:"user"`
+	// +optional
+	// +listType=atomic
+	// SourceIPs holds the client address the apiserver appended to the X-Forwarded-For header
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+	// RequestTime is the time virt-api received the request
+	RequestTime metav1.Time `json:"requestTime"`
+	// CompletionTime is the time virt-api finished the request, for streams like the console
+	// it is the time the connection was closed
+	CompletionTime metav1.Time `json:"completionTime"`
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	// Result tells whether the operation succeeded
+	Result AuditResult `json:"result"`
+	// ResponseCode is the HTTP status code virt-api answered the request with,
+	// an established stream is reported with 200
+	ResponseCode int32 `json:"responseCode"`
+}
+
+// AuditUser is the identity of the user who requested an operation, as passed
+// on by the apiserver
+type AuditUser struct {
+	Username string `json:"username"`
+	// +optional
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
+	// +optional
+	Extra map[string][]string `json:"extra,omitempty"`
+}
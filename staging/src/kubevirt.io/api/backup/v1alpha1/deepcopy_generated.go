@@ -24,6 +24,7 @@ Copyright The KubeVirt Authors.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -127,6 +128,123 @@ func (in *VirtualMachineBackup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupHook) DeepCopyInto(out *VirtualMachineBackupHook) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(VirtualMachineBackupHookStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupHook.
+func (in *VirtualMachineBackupHook) DeepCopy() *VirtualMachineBackupHook {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupHook) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupHookList) DeepCopyInto(out *VirtualMachineBackupHookList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineBackupHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupHookList.
+func (in *VirtualMachineBackupHookList) DeepCopy() *VirtualMachineBackupHookList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupHookList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineBackupHookList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupHookSpec) DeepCopyInto(out *VirtualMachineBackupHookSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]BackupHookAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreezeTimeout != nil {
+		in, out := &in.FreezeTimeout, &out.FreezeTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupHookSpec.
+func (in *VirtualMachineBackupHookSpec) DeepCopy() *VirtualMachineBackupHookSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupHookSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBackupHookStatus) DeepCopyInto(out *VirtualMachineBackupHookStatus) {
+	*out = *in
+	if in.CompletedActions != nil {
+		in, out := &in.CompletedActions, &out.CompletedActions
+		*out = make([]BackupHookAction, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBackupHookStatus.
+func (in *VirtualMachineBackupHookStatus) DeepCopy() *VirtualMachineBackupHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBackupHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineBackupList) DeepCopyInto(out *VirtualMachineBackupList) {
 	*out = *in
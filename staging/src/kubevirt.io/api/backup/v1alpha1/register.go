@@ -34,6 +34,7 @@ var (
 	// GroupVersionKind
 	VirtualMachineBackupGroupVersionKind        = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackup"}
 	VirtualMachineBackupTrackerGroupVersionKind = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupTracker"}
+	VirtualMachineBackupHookGroupVersionKind    = schema.GroupVersionKind{Group: backup.GroupName, Version: SchemeGroupVersion.Version, Kind: "VirtualMachineBackupHook"}
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -60,6 +61,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineBackupList{},
 		&VirtualMachineBackupTracker{},
 		&VirtualMachineBackupTrackerList{},
+		&VirtualMachineBackupHook{},
+		&VirtualMachineBackupHookList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -211,3 +211,106 @@ type Condition struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 }
+
+// VirtualMachineBackupHook requests virt-controller to prepare a VirtualMachine
+// for a backup or to fix it up after a restore. It allows external backup tools
+// to back up and restore VirtualMachines consistently without custom scripts.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupHook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupHookSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupHookStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupHookList is a list of VirtualMachineBackupHook resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupHookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineBackupHook `json:"items"`
+}
+
+// BackupHookStage is the const type for the stages of a backup or restore a hook is executed at
+type BackupHookStage string
+
+const (
+	// PreBackup hooks are executed before the volumes of the VM are backed up
+	PreBackup BackupHookStage = "PreBackup"
+	// PostBackup hooks are executed once the volumes of the VM are backed up
+	PostBackup BackupHookStage = "PostBackup"
+	// PostRestore hooks are executed once the VM and its volumes are restored
+	PostRestore BackupHookStage = "PostRestore"
+)
+
+// BackupHookAction is the const type for the actions a hook can execute
+type BackupHookAction string
+
+const (
+	// FreezeAction quiesces the guest filesystems through the guest agent
+	FreezeAction BackupHookAction = "Freeze"
+	// UnfreezeAction thaws the guest filesystems through the guest agent
+	UnfreezeAction BackupHookAction = "Unfreeze"
+	// ReattachHotplugVolumesAction attaches the hotpluggable volumes of the VM
+	// which are missing on its running VMI
+	ReattachHotplugVolumesAction BackupHookAction = "ReattachHotplugVolumes"
+	// RegenerateMACAddressesAction clears the MAC addresses of the VM interfaces,
+	// so that new ones are assigned to avoid collisions with the backed up VM
+	RegenerateMACAddressesAction BackupHookAction = "RegenerateMACAddresses"
+)
+
+// VirtualMachineBackupHookSpec is the spec for a VirtualMachineBackupHook resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineBackupHookSpec struct {
+	// Source specifies the VM the hook is executed for
+	// +kubebuilder:validation:XValidation:rule="has(self.apiGroup) && self.apiGroup == 'kubevirt.io'",message="apiGroup must be kubevirt.io"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachine'",message="kind must be VirtualMachine"
+	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
+	Source corev1.TypedLocalObjectReference `json:"source"`
+	// +kubebuilder:validation:Enum=PreBackup;PostBackup;PostRestore
+	// Stage specifies the stage of the backup or restore the hook is executed at
+	Stage BackupHookStage `json:"stage"`
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// Actions are executed in the given order, the hook fails on the first
+	// failing action
+	Actions []BackupHookAction `json:"actions"`
+	// +optional
+	// FreezeTimeout is the time after which a frozen guest is thawed even if
+	// no PostBackup hook unfroze it. Defaults to 5 minutes
+	FreezeTimeout *metav1.Duration `json:"freezeTimeout,omitempty"`
+}
+
+// BackupHookPhase is the const type for the phases of a hook
+type BackupHookPhase string
+
+const (
+	// BackupHookRunning indicates the hook is waiting for the VM or executing its actions
+	BackupHookRunning BackupHookPhase = "Running"
+	// BackupHookSucceeded indicates all the actions of the hook were executed
+	BackupHookSucceeded BackupHookPhase = "Succeeded"
+	// BackupHookFailed indicates one of the actions of the hook failed
+	BackupHookFailed BackupHookPhase = "Failed"
+)
+
+// VirtualMachineBackupHookStatus is the status for a VirtualMachineBackupHook resource
+type VirtualMachineBackupHookStatus struct {
+	// +optional
+	Phase BackupHookPhase `json:"phase,omitempty"`
+	// +optional
+	// +listType=atomic
+	// CompletedActions lists the actions which were already executed
+	CompletedActions []BackupHookAction `json:"completedActions,omitempty"`
+	// +optional
+	// Message explains the current phase of the hook
+	Message string `json:"message,omitempty"`
+	// +optional
+	// CompletionTime is the time the hook succeeded or failed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
@@ -82,3 +82,37 @@ func (Condition) SwaggerDoc() map[string]string {
 		"message":            "+optional",
 	}
 }
+
+func (VirtualMachineBackupHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupHook requests virt-controller to prepare a VirtualMachine\nfor a backup or to fix it up after a restore. It allows external backup tools\nto back up and restore VirtualMachines consistently without custom scripts.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupHookList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineBackupHookList is a list of VirtualMachineBackupHook resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupHookSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineBackupHookSpec is the spec for a VirtualMachineBackupHook resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"",
+		"source":        "Source specifies the VM the hook is executed for\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"stage":         "+kubebuilder:validation:Enum=PreBackup;PostBackup;PostRestore\nStage specifies the stage of the backup or restore the hook is executed at",
+		"actions":       "+listType=atomic\n+kubebuilder:validation:MinItems=1\nActions are executed in the given order, the hook fails on the first\nfailing action",
+		"freezeTimeout": "+optional\nFreezeTimeout is the time after which a frozen guest is thawed even if\nno PostBackup hook unfroze it. Defaults to 5 minutes",
+	}
+}
+
+func (VirtualMachineBackupHookStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineBackupHookStatus is the status for a VirtualMachineBackupHook resource",
+		"phase":            "+optional",
+		"completedActions": "+optional\n+listType=atomic\nCompletedActions lists the actions which were already executed",
+		"message":          "+optional\nMessage explains the current phase of the hook",
+		"completionTime":   "+optional\nCompletionTime is the time the hook succeeded or failed",
+	}
+}
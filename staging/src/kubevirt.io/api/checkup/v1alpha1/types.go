@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineCheckup runs a standardized benchmark, like the network latency
+// between two VirtualMachineInstances, and publishes its results in the status.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineCheckup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineCheckupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineCheckupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineCheckupList is a list of VirtualMachineCheckup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineCheckupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineCheckup `json:"items"`
+}
+
+// VirtualMachineCheckupSpec is the spec for a VirtualMachineCheckup resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+// +kubebuilder:validation:XValidation:rule="[has(self.networkLatency), has(self.storage), has(self.bootTime)].filter(x, x).size() == 1",message="exactly one of networkLatency, storage and bootTime is required"
+type VirtualMachineCheckupSpec struct {
+	// ServiceAccountName is the service account the checkup runs with. It needs
+	// permissions to create, delete and access the consoles of
+	// VirtualMachineInstances in the namespace of the checkup
+	ServiceAccountName string `json:"serviceAccountName"`
+	// Timeout after which the checkup fails, defaults to 10 minutes
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// NetworkLatency measures the latency between two VirtualMachineInstances
+	// +optional
+	NetworkLatency *NetworkLatencyCheckup `json:"networkLatency,omitempty"`
+	// Storage measures the IOPS of a disk of a VirtualMachineInstance
+	// +optional
+	Storage *StorageCheckup `json:"storage,omitempty"`
+	// BootTime measures the time a VirtualMachineInstance takes to boot
+	// +optional
+	BootTime *BootTimeCheckup `json:"bootTime,omitempty"`
+}
+
+// NetworkLatencyCheckup configures the network latency checkup
+type NetworkLatencyCheckup struct {
+	// NetworkAttachmentDefinition is the secondary network the latency is
+	// measured on, as <namespace>/<name> or <name>. The pod network is used if empty
+	// +optional
+	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
+	// SourceNode is the node the source VirtualMachineInstance runs on
+	// +optional
+	SourceNode string `json:"sourceNode,omitempty"`
+	// TargetNode is the node the target VirtualMachineInstance runs on
+	// +optional
+	TargetNode string `json:"targetNode,omitempty"`
+	// SampleDuration is the time the latency is sampled for, defaults to 5 seconds
+	// +optional
+	SampleDuration *metav1.Duration `json:"sampleDuration,omitempty"`
+	// MaxDesiredLatency fails the checkup if the maximum latency is above it
+	// +optional
+	MaxDesiredLatency *metav1.Duration `json:"maxDesiredLatency,omitempty"`
+}
+
+// StorageCheckup configures the storage checkup
+type StorageCheckup struct {
+	// StorageClassName of the benchmarked disk, the default storage class is
+	// used if empty
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// VolumeSize is the size of the benchmarked disk, defaults to 10Gi
+	// +optional
+	VolumeSize *resource.Quantity `json:"volumeSize,omitempty"`
+	// MinDesiredReadIOPS fails the checkup if the read IOPS are below it
+	// +optional
+	MinDesiredReadIOPS *int64 `json:"minDesiredReadIOPS,omitempty"`
+	// MinDesiredWriteIOPS fails the checkup if the write IOPS are below it
+	// +optional
+	MinDesiredWriteIOPS *int64 `json:"minDesiredWriteIOPS,omitempty"`
+}
+
+// BootTimeCheckup configures the boot time checkup
+type BootTimeCheckup struct {
+	// Samples is the number of boots which are measured, defaults to 3
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Samples *int32 `json:"samples,omitempty"`
+	// MaxDesiredBootTime fails the checkup if the maximum boot time is above it
+	// +optional
+	MaxDesiredBootTime *metav1.Duration `json:"maxDesiredBootTime,omitempty"`
+}
+
+// VirtualMachineCheckupPhase is the const type for the phases of a checkup
+type VirtualMachineCheckupPhase string
+
+const (
+	// CheckupRunning indicates the checkup pod was created
+	CheckupRunning VirtualMachineCheckupPhase = "Running"
+	// CheckupSucceeded indicates the checkup completed and met the desired thresholds
+	CheckupSucceeded VirtualMachineCheckupPhase = "Succeeded"
+	// CheckupFailed indicates the checkup failed, timed out or missed a desired threshold
+	CheckupFailed VirtualMachineCheckupPhase = "Failed"
+)
+
+// VirtualMachineCheckupStatus is the status for a VirtualMachineCheckup resource
+type VirtualMachineCheckupStatus struct {
+	// +optional
+	Phase VirtualMachineCheckupPhase `json:"phase,omitempty"`
+	// StartTimestamp is the time the checkup pod was created
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// CompletionTimestamp is the time the checkup succeeded or failed
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+	// FailureReason explains why the checkup failed
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+	// NetworkLatency holds the results of the network latency checkup
+	// +optional
+	NetworkLatency *NetworkLatencyResult `json:"networkLatency,omitempty"`
+	// Storage holds the results of the storage checkup
+	// +optional
+	Storage *StorageResult `json:"storage,omitempty"`
+	// BootTime holds the results of the boot time checkup
+	// +optional
+	BootTime *BootTimeResult `json:"bootTime,omitempty"`
+}
+
+// NetworkLatencyResult are the latencies measured by the network latency checkup
+type NetworkLatencyResult struct {
+	MinLatency     metav1.Duration `json:"minLatency"`
+	AverageLatency metav1.Duration `json:"averageLatency"`
+	MaxLatency     metav1.Duration `json:"maxLatency"`
+}
+
+// StorageResult are the IOPS measured by the storage checkup
+type StorageResult struct {
+	ReadIOPS  int64 `json:"readIOPS"`
+	WriteIOPS int64 `json:"writeIOPS"`
+}
+
+// BootTimeResult are the boot times measured by the boot time checkup, from the
+// creation of the VirtualMachineInstance until its guest agent connected
+type BootTimeResult struct {
+	MinBootTime     metav1.Duration `json:"minBootTime"`
+	AverageBootTime metav1.Duration `json:"averageBootTime"`
+	MaxBootTime     metav1.Duration `json:"maxBootTime"`
+}
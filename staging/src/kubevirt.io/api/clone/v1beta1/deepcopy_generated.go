@@ -143,6 +143,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FirmwareIdentityPolicy != nil {
+		in, out := &in.FirmwareIdentityPolicy, &out.FirmwareIdentityPolicy
+		*out = new(FirmwareIdentityPolicy)
+		**out = **in
+	}
 	if in.Patches != nil {
 		in, out := &in.Patches, &out.Patches
 		*out = make([]string, len(*in))
@@ -88,6 +88,10 @@ type VirtualMachineCloneSpec struct {
 	// be generated automatically.
 	// +optional
 	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+	// FirmwareIdentityPolicy defines whether the target keeps the firmware UUID and SMBIOS serial of the
+	// source, or gets new ones. Defaults to Regenerate. NewSMBiosSerial takes precedence over the policy.
+	// +optional
+	FirmwareIdentityPolicy *FirmwareIdentityPolicy `json:"firmwareIdentityPolicy,omitempty"`
 	// Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
 	// Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
 	// +optional
@@ -95,6 +99,19 @@ type VirtualMachineCloneSpec struct {
 	Patches []string `json:"patches,omitempty"`
 }
 
+// FirmwareIdentityPolicy defines what happens to the firmware UUID and SMBIOS serial of the clone target
+type FirmwareIdentityPolicy string
+
+const (
+	// FirmwareIdentityPolicyPreserve defines a FirmwareIdentityPolicy where the target keeps
+	// the firmware UUID and SMBIOS serial of the source
+	FirmwareIdentityPolicyPreserve FirmwareIdentityPolicy = "Preserve"
+
+	// FirmwareIdentityPolicyRegenerate defines a FirmwareIdentityPolicy where the target gets
+	// a new firmware UUID and SMBIOS serial. This is the default policy.
+	FirmwareIdentityPolicyRegenerate FirmwareIdentityPolicy = "Regenerate"
+)
+
 type VirtualMachineClonePhase string
 
 const (
@@ -304,6 +304,11 @@ func (in *CPU) DeepCopyInto(out *CPU) {
 		*out = new(Realtime)
 		**out = **in
 	}
+	if in.NestedVirtualization != nil {
+		in, out := &in.NestedVirtualization, &out.NestedVirtualization
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -422,6 +427,22 @@ func (in *ChangedBlockTrackingStatus) DeepCopy() *ChangedBlockTrackingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Channel) DeepCopyInto(out *Channel) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Channel.
+func (in *Channel) DeepCopy() *Channel {
+	if in == nil {
+		return nil
+	}
+	out := new(Channel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -467,6 +488,16 @@ func (in *ClaimRequest) DeepCopy() *ClaimRequest {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientPassthroughDevices) DeepCopyInto(out *ClientPassthroughDevices) {
 	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(USBRedirFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +520,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PTP != nil {
+		in, out := &in.PTP, &out.PTP
+		*out = new(PTPClock)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -757,6 +793,32 @@ func (in *ContainerDiskSource) DeepCopy() *ContainerDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiskVerificationConfiguration) DeepCopyInto(out *ContainerDiskVerificationConfiguration) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedTokenHosts != nil {
+		in, out := &in.TrustedTokenHosts, &out.TrustedTokenHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiskVerificationConfiguration.
+func (in *ContainerDiskVerificationConfiguration) DeepCopy() *ContainerDiskVerificationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiskVerificationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerRevisionRef) DeepCopyInto(out *ControllerRevisionRef) {
 	*out = *in
@@ -899,6 +961,22 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRegistrationConfiguration) DeepCopyInto(out *DNSRegistrationConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRegistrationConfiguration.
+func (in *DNSRegistrationConfiguration) DeepCopy() *DNSRegistrationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRegistrationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -955,6 +1033,23 @@ func (in *DataVolumeTemplateSpec) DeepCopy() *DataVolumeTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultBindingPolicy) DeepCopyInto(out *DefaultBindingPolicy) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultBindingPolicy.
+func (in *DefaultBindingPolicy) DeepCopy() *DefaultBindingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultBindingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeprecatedInterfaceMacvtap) DeepCopyInto(out *DeprecatedInterfaceMacvtap) {
 	*out = *in
@@ -1169,6 +1264,13 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SCSIControllers != nil {
+		in, out := &in.SCSIControllers, &out.SCSIControllers
+		*out = make([]SCSIController, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Watchdog != nil {
 		in, out := &in.Watchdog, &out.Watchdog
 		*out = new(Watchdog)
@@ -1196,6 +1298,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Graphics != nil {
+		in, out := &in.Graphics, &out.Graphics
+		*out = new(GraphicsDevice)
+		**out = **in
+	}
 	if in.AutoattachSerialConsole != nil {
 		in, out := &in.AutoattachSerialConsole, &out.AutoattachSerialConsole
 		*out = new(bool)
@@ -1206,11 +1313,21 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Serials != nil {
+		in, out := &in.Serials, &out.Serials
+		*out = make([]SerialPort, len(*in))
+		copy(*out, *in)
+	}
 	if in.AutoattachMemBalloon != nil {
 		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MemBalloonVirtioTransitional != nil {
+		in, out := &in.MemBalloonVirtioTransitional, &out.MemBalloonVirtioTransitional
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AutoattachInputDevice != nil {
 		in, out := &in.AutoattachInputDevice, &out.AutoattachInputDevice
 		*out = new(bool)
@@ -1248,6 +1365,16 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(DownwardMetrics)
 		**out = **in
 	}
+	if in.HostSensors != nil {
+		in, out := &in.HostSensors, &out.HostSensors
+		*out = new(HostSensors)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SGX != nil {
+		in, out := &in.SGX, &out.SGX
+		*out = new(SGX)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PanicDevices != nil {
 		in, out := &in.PanicDevices, &out.PanicDevices
 		*out = make([]PanicDevice, len(*in))
@@ -1272,7 +1399,12 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.ClientPassthrough != nil {
 		in, out := &in.ClientPassthrough, &out.ClientPassthrough
 		*out = new(ClientPassthroughDevices)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Smartcard != nil {
+		in, out := &in.Smartcard, &out.Smartcard
+		*out = new(Smartcard)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Sound != nil {
 		in, out := &in.Sound, &out.Sound
@@ -1287,7 +1419,24 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.Video != nil {
 		in, out := &in.Video, &out.Video
 		*out = new(VideoDevice)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOMMU != nil {
+		in, out := &in.IOMMU, &out.IOMMU
+		*out = new(IOMMUDevice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedMemoryDevices != nil {
+		in, out := &in.SharedMemoryDevices, &out.SharedMemoryDevices
+		*out = make([]SharedMemoryDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]Channel, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
@@ -1364,6 +1513,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.BlockSize != nil {
 		in, out := &in.BlockSize, &out.BlockSize
 		*out = new(BlockSize)
@@ -1379,6 +1533,16 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.RerrorPolicy != nil {
+		in, out := &in.RerrorPolicy, &out.RerrorPolicy
+		*out = new(DiskErrorPolicy)
+		**out = **in
+	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ChangedBlockTracking != nil {
 		in, out := &in.ChangedBlockTracking, &out.ChangedBlockTracking
 		*out = new(bool)
@@ -1403,7 +1567,7 @@ func (in *DiskDevice) DeepCopyInto(out *DiskDevice) {
 	if in.Disk != nil {
 		in, out := &in.Disk, &out.Disk
 		*out = new(DiskTarget)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.LUN != nil {
 		in, out := &in.LUN, &out.LUN
@@ -1428,6 +1592,49 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskExpansionRequest) DeepCopyInto(out *DiskExpansionRequest) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskExpansionRequest.
+func (in *DiskExpansionRequest) DeepCopy() *DiskExpansionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskExpansionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskGarbageCollectionConfiguration) DeepCopyInto(out *DiskGarbageCollectionConfiguration) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskGarbageCollectionConfiguration.
+func (in *DiskGarbageCollectionConfiguration) DeepCopy() *DiskGarbageCollectionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskGarbageCollectionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThreads) DeepCopyInto(out *DiskIOThreads) {
 	*out = *in
@@ -1449,9 +1656,142 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOTuneBurst)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneBurst) DeepCopyInto(out *DiskIOTuneBurst) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.LengthSeconds != nil {
+		in, out := &in.LengthSeconds, &out.LengthSeconds
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneBurst.
+func (in *DiskIOTuneBurst) DeepCopy() *DiskIOTuneBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSpaceLowThreshold) DeepCopyInto(out *DiskSpaceLowThreshold) {
+	*out = *in
+	if in.UsedPercentage != nil {
+		in, out := &in.UsedPercentage, &out.UsedPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HysteresisPercentage != nil {
+		in, out := &in.HysteresisPercentage, &out.HysteresisPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSpaceLowThreshold.
+func (in *DiskSpaceLowThreshold) DeepCopy() *DiskSpaceLowThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSpaceLowThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
+	if in.VirtioTransitional != nil {
+		in, out := &in.VirtioTransitional, &out.VirtioTransitional
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1529,6 +1869,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(Machine)
 		**out = **in
 	}
+	if in.MachineOptions != nil {
+		in, out := &in.MachineOptions, &out.MachineOptions
+		*out = new(MachineOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Firmware != nil {
 		in, out := &in.Firmware, &out.Firmware
 		*out = new(Firmware)
@@ -1565,6 +1910,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(LaunchSecurity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UseEmulation != nil {
+		in, out := &in.UseEmulation, &out.UseEmulation
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1676,6 +2026,22 @@ func (in *EmptyDiskSource) DeepCopy() *EmptyDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmulatorBundle) DeepCopyInto(out *EmulatorBundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmulatorBundle.
+func (in *EmulatorBundle) DeepCopy() *EmulatorBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(EmulatorBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EphemeralVolumeSource) DeepCopyInto(out *EphemeralVolumeSource) {
 	*out = *in
@@ -1829,6 +2195,16 @@ func (in *FeatureHyperv) DeepCopy() *FeatureHyperv {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureKVM) DeepCopyInto(out *FeatureKVM) {
 	*out = *in
+	if in.HintDedicated != nil {
+		in, out := &in.HintDedicated, &out.HintDedicated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PollControl != nil {
+		in, out := &in.PollControl, &out.PollControl
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1937,7 +2313,7 @@ func (in *Features) DeepCopyInto(out *Features) {
 	if in.KVM != nil {
 		in, out := &in.KVM, &out.KVM
 		*out = new(FeatureKVM)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Pvspinlock != nil {
 		in, out := &in.Pvspinlock, &out.Pvspinlock
@@ -1963,7 +2339,7 @@ func (in *Filesystem) DeepCopyInto(out *Filesystem) {
 	if in.Virtiofs != nil {
 		in, out := &in.Virtiofs, &out.Virtiofs
 		*out = new(FilesystemVirtiofs)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1979,30 +2355,87 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
+func (in *FilesystemIDMap) DeepCopyInto(out *FilesystemIDMap) {
 	*out = *in
+	if in.UID != nil {
+		in, out := &in.UID, &out.UID
+		*out = make([]FilesystemIDMapRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GID != nil {
+		in, out := &in.GID, &out.GID
+		*out = make([]FilesystemIDMapRange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemVirtiofs.
-func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemIDMap.
+func (in *FilesystemIDMap) DeepCopy() *FilesystemIDMap {
 	if in == nil {
 		return nil
 	}
-	out := new(FilesystemVirtiofs)
+	out := new(FilesystemIDMap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Firmware) DeepCopyInto(out *Firmware) {
+func (in *FilesystemIDMapRange) DeepCopyInto(out *FilesystemIDMapRange) {
 	*out = *in
-	if in.Bootloader != nil {
-		in, out := &in.Bootloader, &out.Bootloader
-		*out = new(Bootloader)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemIDMapRange.
+func (in *FilesystemIDMapRange) DeepCopy() *FilesystemIDMapRange {
+	if in == nil {
+		return nil
 	}
-	if in.KernelBoot != nil {
+	out := new(FilesystemIDMapRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilesystemVirtiofs) DeepCopyInto(out *FilesystemVirtiofs) {
+	*out = *in
+	if in.QueueSize != nil {
+		in, out := &in.QueueSize, &out.QueueSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ThreadPoolSize != nil {
+		in, out := &in.ThreadPoolSize, &out.ThreadPoolSize
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IDMap != nil {
+		in, out := &in.IDMap, &out.IDMap
+		*out = new(FilesystemIDMap)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilesystemVirtiofs.
+func (in *FilesystemVirtiofs) DeepCopy() *FilesystemVirtiofs {
+	if in == nil {
+		return nil
+	}
+	out := new(FilesystemVirtiofs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firmware) DeepCopyInto(out *Firmware) {
+	*out = *in
+	if in.Bootloader != nil {
+		in, out := &in.Bootloader, &out.Bootloader
+		*out = new(Bootloader)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KernelBoot != nil {
 		in, out := &in.KernelBoot, &out.KernelBoot
 		*out = new(KernelBoot)
 		(*in).DeepCopyInto(*out)
@@ -2025,6 +2458,43 @@ func (in *Firmware) DeepCopy() *Firmware {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareImage) DeepCopyInto(out *FirmwareImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareImage.
+func (in *FirmwareImage) DeepCopy() *FirmwareImage {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareProfile) DeepCopyInto(out *FirmwareProfile) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]FirmwareImage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareProfile.
+func (in *FirmwareProfile) DeepCopy() *FirmwareProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Flags) DeepCopyInto(out *Flags) {
 	*out = *in
@@ -2125,6 +2595,22 @@ func (in *GenerationStatus) DeepCopy() *GenerationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GraphicsDevice) DeepCopyInto(out *GraphicsDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphicsDevice.
+func (in *GraphicsDevice) DeepCopy() *GraphicsDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(GraphicsDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentCommandInfo) DeepCopyInto(out *GuestAgentCommandInfo) {
 	*out = *in
@@ -2141,6 +2627,27 @@ func (in *GuestAgentCommandInfo) DeepCopy() *GuestAgentCommandInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentExecAction) DeepCopyInto(out *GuestAgentExecAction) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentExecAction.
+func (in *GuestAgentExecAction) DeepCopy() *GuestAgentExecAction {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentExecAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentPing) DeepCopyInto(out *GuestAgentPing) {
 	*out = *in
@@ -2157,6 +2664,43 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDiskExpansionConfiguration) DeepCopyInto(out *GuestDiskExpansionConfiguration) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDiskExpansionConfiguration.
+func (in *GuestDiskExpansionConfiguration) DeepCopy() *GuestDiskExpansionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDiskExpansionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestSecret) DeepCopyInto(out *GuestSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestSecret.
+func (in *GuestSecret) DeepCopy() *GuestSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2191,6 +2735,16 @@ func (in *Handler) DeepCopyInto(out *Handler) {
 		*out = new(GuestAgentPing)
 		**out = **in
 	}
+	if in.GuestAgentExec != nil {
+		in, out := &in.GuestAgentExec, &out.GuestAgentExec
+		*out = new(GuestAgentExecAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VSockHTTP != nil {
+		in, out := &in.VSockHTTP, &out.VSockHTTP
+		*out = new(VSockHTTPAction)
+		**out = **in
+	}
 	if in.HTTPGet != nil {
 		in, out := &in.HTTPGet, &out.HTTPGet
 		*out = new(corev1.HTTPGetAction)
@@ -2257,6 +2811,44 @@ func (in *HostDisk) DeepCopy() *HostDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSensors) DeepCopyInto(out *HostSensors) {
+	*out = *in
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]HostSensorType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSensors.
+func (in *HostSensors) DeepCopy() *HostSensors {
+	if in == nil {
+		return nil
+	}
+	out := new(HostSensors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostSensorsConfiguration) DeepCopyInto(out *HostSensorsConfiguration) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostSensorsConfiguration.
+func (in *HostSensorsConfiguration) DeepCopy() *HostSensorsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HostSensorsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HotplugVolumeSource) DeepCopyInto(out *HotplugVolumeSource) {
 	*out = *in
@@ -2270,6 +2862,21 @@ func (in *HotplugVolumeSource) DeepCopyInto(out *HotplugVolumeSource) {
 		*out = new(DataVolumeSource)
 		**out = **in
 	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountVolumeSource)
+		**out = **in
+	}
 	return
 }
 
@@ -2315,6 +2922,22 @@ func (in *Hugepages) DeepCopy() *Hugepages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HugepagesPoolConfiguration) DeepCopyInto(out *HugepagesPoolConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HugepagesPoolConfiguration.
+func (in *HugepagesPoolConfiguration) DeepCopy() *HugepagesPoolConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HugepagesPoolConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperVPassthrough) DeepCopyInto(out *HyperVPassthrough) {
 	*out = *in
@@ -2373,6 +2996,37 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOMMUDevice) DeepCopyInto(out *IOMMUDevice) {
+	*out = *in
+	if in.CachingMode != nil {
+		in, out := &in.CachingMode, &out.CachingMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InterruptRemapping != nil {
+		in, out := &in.InterruptRemapping, &out.InterruptRemapping
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EIM != nil {
+		in, out := &in.EIM, &out.EIM
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOMMUDevice.
+func (in *IOMMUDevice) DeepCopy() *IOMMUDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(IOMMUDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2497,6 +3151,21 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VirtioTransitional != nil {
+		in, out := &in.VirtioTransitional, &out.VirtioTransitional
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Offloads != nil {
+		in, out := &in.Offloads, &out.Offloads
+		*out = new(InterfaceOffloads)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2531,7 +3200,7 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	if in.SRIOV != nil {
 		in, out := &in.SRIOV, &out.SRIOV
 		*out = new(InterfaceSRIOV)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.DeprecatedMacvtap != nil {
 		in, out := &in.DeprecatedMacvtap, &out.DeprecatedMacvtap
@@ -2630,9 +3299,45 @@ func (in *InterfaceMasquerade) DeepCopy() *InterfaceMasquerade {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceOffloads) DeepCopyInto(out *InterfaceOffloads) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TSO != nil {
+		in, out := &in.TSO, &out.TSO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GSO != nil {
+		in, out := &in.GSO, &out.GSO
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceOffloads.
+func (in *InterfaceOffloads) DeepCopy() *InterfaceOffloads {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceOffloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceSRIOV) DeepCopyInto(out *InterfaceSRIOV) {
 	*out = *in
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(InterfaceSRIOVFailover)
+		**out = **in
+	}
 	return
 }
 
@@ -2646,6 +3351,22 @@ func (in *InterfaceSRIOV) DeepCopy() *InterfaceSRIOV {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceSRIOVFailover) DeepCopyInto(out *InterfaceSRIOVFailover) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceSRIOVFailover.
+func (in *InterfaceSRIOVFailover) DeepCopy() *InterfaceSRIOVFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceSRIOVFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 	*out = *in
@@ -2767,6 +3488,22 @@ func (in *KernelInfo) DeepCopy() *KernelInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyBroker) DeepCopyInto(out *KeyBroker) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyBroker.
+func (in *KeyBroker) DeepCopy() *KeyBroker {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyBroker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirt) DeepCopyInto(out *KubeVirt) {
 	*out = *in
@@ -2951,10 +3688,39 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(SeccompConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.VirtualMachineOptions != nil {
-		in, out := &in.VirtualMachineOptions, &out.VirtualMachineOptions
-		*out = new(VirtualMachineOptions)
-		(*in).DeepCopyInto(*out)
+	if in.LauncherSecurityProfiles != nil {
+		in, out := &in.LauncherSecurityProfiles, &out.LauncherSecurityProfiles
+		*out = make([]LauncherSecurityProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VFIOGroupID != nil {
+		in, out := &in.VFIOGroupID, &out.VFIOGroupID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LauncherPodConfiguration != nil {
+		in, out := &in.LauncherPodConfiguration, &out.LauncherPodConfiguration
+		*out = new(LauncherPodConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmulatorBundles != nil {
+		in, out := &in.EmulatorBundles, &out.EmulatorBundles
+		*out = make([]EmulatorBundle, len(*in))
+		copy(*out, *in)
+	}
+	if in.FirmwareProfiles != nil {
+		in, out := &in.FirmwareProfiles, &out.FirmwareProfiles
+		*out = make([]FirmwareProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualMachineOptions != nil {
+		in, out := &in.VirtualMachineOptions, &out.VirtualMachineOptions
+		*out = new(VirtualMachineOptions)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.KSMConfiguration != nil {
 		in, out := &in.KSMConfiguration, &out.KSMConfiguration
@@ -2991,6 +3757,66 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(ChangedBlockTrackingSelectors)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VMIStatusUpdates != nil {
+		in, out := &in.VMIStatusUpdates, &out.VMIStatusUpdates
+		*out = new(VMIStatusUpdateConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiskGarbageCollection != nil {
+		in, out := &in.DiskGarbageCollection, &out.DiskGarbageCollection
+		*out = new(DiskGarbageCollectionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestDiskExpansion != nil {
+		in, out := &in.GuestDiskExpansion, &out.GuestDiskExpansion
+		*out = new(GuestDiskExpansionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerDiskVerification != nil {
+		in, out := &in.ContainerDiskVerification, &out.ContainerDiskVerification
+		*out = new(ContainerDiskVerificationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeScan != nil {
+		in, out := &in.VolumeScan, &out.VolumeScan
+		*out = new(VolumeScanConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HugepagesPool != nil {
+		in, out := &in.HugepagesPool, &out.HugepagesPool
+		*out = new(HugepagesPoolConfiguration)
+		**out = **in
+	}
+	if in.VMStartThrottling != nil {
+		in, out := &in.VMStartThrottling, &out.VMStartThrottling
+		*out = new(VMStartThrottlingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LauncherWarmPools != nil {
+		in, out := &in.LauncherWarmPools, &out.LauncherWarmPools
+		*out = make([]LauncherWarmPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHostPaths != nil {
+		in, out := &in.AllowedHostPaths, &out.AllowedHostPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControllerShards != nil {
+		in, out := &in.ControllerShards, &out.ControllerShards
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.HostSensors != nil {
+		in, out := &in.HostSensors, &out.HostSensors
+		*out = new(HostSensorsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineAuditEvents != nil {
+		in, out := &in.VirtualMachineAuditEvents, &out.VirtualMachineAuditEvents
+		*out = new(VirtualMachineAuditEventsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3203,7 +4029,7 @@ func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 	if in.TDX != nil {
 		in, out := &in.TDX, &out.TDX
 		*out = new(TDX)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -3218,6 +4044,106 @@ func (in *LaunchSecurity) DeepCopy() *LaunchSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherMetadata) DeepCopyInto(out *LauncherMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherMetadata.
+func (in *LauncherMetadata) DeepCopy() *LauncherMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherPodConfiguration) DeepCopyInto(out *LauncherPodConfiguration) {
+	*out = *in
+	if in.AllowedRuntimeClasses != nil {
+		in, out := &in.AllowedRuntimeClasses, &out.AllowedRuntimeClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedLabelPrefixes != nil {
+		in, out := &in.AllowedLabelPrefixes, &out.AllowedLabelPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedAnnotationPrefixes != nil {
+		in, out := &in.AllowedAnnotationPrefixes, &out.AllowedAnnotationPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherPodConfiguration.
+func (in *LauncherPodConfiguration) DeepCopy() *LauncherPodConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherPodConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherSecurityProfile) DeepCopyInto(out *LauncherSecurityProfile) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Seccomp != nil {
+		in, out := &in.Seccomp, &out.Seccomp
+		*out = new(VirtualMachineInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherSecurityProfile.
+func (in *LauncherSecurityProfile) DeepCopy() *LauncherSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LauncherWarmPool) DeepCopyInto(out *LauncherWarmPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LauncherWarmPool.
+func (in *LauncherWarmPool) DeepCopy() *LauncherWarmPool {
+	if in == nil {
+		return nil
+	}
+	out := new(LauncherWarmPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LiveUpdateConfiguration) DeepCopyInto(out *LiveUpdateConfiguration) {
 	*out = *in
@@ -3299,6 +4225,27 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineOptions) DeepCopyInto(out *MachineOptions) {
+	*out = *in
+	if in.PS2 != nil {
+		in, out := &in.PS2, &out.PS2
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineOptions.
+func (in *MachineOptions) DeepCopy() *MachineOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3366,6 +4313,11 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.DIMMSlots != nil {
+		in, out := &in.DIMMSlots, &out.DIMMSlots
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -3632,6 +4584,18 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.DefaultBindingPolicies != nil {
+		in, out := &in.DefaultBindingPolicies, &out.DefaultBindingPolicies
+		*out = make([]DefaultBindingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSRegistration != nil {
+		in, out := &in.DNSRegistration, &out.DNSRegistration
+		*out = new(DNSRegistrationConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -3847,6 +4811,27 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PTPClock) DeepCopyInto(out *PTPClock) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PTPClock.
+func (in *PTPClock) DeepCopy() *PTPClock {
+	if in == nil {
+		return nil
+	}
+	out := new(PTPClock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
 	*out = *in
@@ -3877,6 +4862,11 @@ func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoUnpauseTimeout != nil {
+		in, out := &in.AutoUnpauseTimeout, &out.AutoUnpauseTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -3926,6 +4916,11 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VirtualFunctionPools != nil {
+		in, out := &in.VirtualFunctionPools, &out.VirtualFunctionPools
+		*out = make([]VirtualFunctionPool, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4234,6 +5229,28 @@ func (in *Realtime) DeepCopy() *Realtime {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebootOptions) DeepCopyInto(out *RebootOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootOptions.
+func (in *RebootOptions) DeepCopy() *RebootOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RebootOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReloadableComponentConfiguration) DeepCopyInto(out *ReloadableComponentConfiguration) {
 	*out = *in
@@ -4379,6 +5396,32 @@ func (in *Rng) DeepCopy() *Rng {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCSIController) DeepCopyInto(out *SCSIController) {
+	*out = *in
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.DedicatedIOThread != nil {
+		in, out := &in.DedicatedIOThread, &out.DedicatedIOThread
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCSIController.
+func (in *SCSIController) DeepCopy() *SCSIController {
+	if in == nil {
+		return nil
+	}
+	out := new(SCSIController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEV) DeepCopyInto(out *SEV) {
 	*out = *in
@@ -4390,7 +5433,7 @@ func (in *SEV) DeepCopyInto(out *SEV) {
 	if in.Attestation != nil {
 		in, out := &in.Attestation, &out.Attestation
 		*out = new(SEVAttestation)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -4408,6 +5451,11 @@ func (in *SEV) DeepCopy() *SEV {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVAttestation) DeepCopyInto(out *SEVAttestation) {
 	*out = *in
+	if in.KeyBroker != nil {
+		in, out := &in.KeyBroker, &out.KeyBroker
+		*out = new(KeyBroker)
+		**out = **in
+	}
 	return
 }
 
@@ -4508,6 +5556,47 @@ func (in *SEVSNP) DeepCopy() *SEVSNP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPAttestationReport) DeepCopyInto(out *SEVSNPAttestationReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPAttestationReport.
+func (in *SEVSNPAttestationReport) DeepCopy() *SEVSNPAttestationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPAttestationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SEVSNPAttestationReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPAttestationReportOptions) DeepCopyInto(out *SEVSNPAttestationReportOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPAttestationReportOptions.
+func (in *SEVSNPAttestationReportOptions) DeepCopy() *SEVSNPAttestationReportOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPAttestationReportOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVSecretOptions) DeepCopyInto(out *SEVSecretOptions) {
 	*out = *in
@@ -4540,6 +5629,23 @@ func (in *SEVSessionOptions) DeepCopy() *SEVSessionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SGX) DeepCopyInto(out *SGX) {
+	*out = *in
+	out.EPCSize = in.EPCSize.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SGX.
+func (in *SGX) DeepCopy() *SGX {
+	if in == nil {
+		return nil
+	}
+	out := new(SGX)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SMBiosConfiguration) DeepCopyInto(out *SMBiosConfiguration) {
 	*out = *in
@@ -4642,6 +5748,38 @@ func (in *ScreenshotOptions) DeepCopy() *ScreenshotOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SealedDisk) DeepCopyInto(out *SealedDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SealedDisk.
+func (in *SealedDisk) DeepCopy() *SealedDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(SealedDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SealedInterface) DeepCopyInto(out *SealedInterface) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SealedInterface.
+func (in *SealedInterface) DeepCopy() *SealedInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(SealedInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeccompConfiguration) DeepCopyInto(out *SeccompConfiguration) {
 	*out = *in
@@ -4684,6 +5822,22 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialPort) DeepCopyInto(out *SerialPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialPort.
+func (in *SerialPort) DeepCopy() *SerialPort {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -4700,6 +5854,90 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedMemoryDevice) DeepCopyInto(out *SharedMemoryDevice) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedMemoryDevice.
+func (in *SharedMemoryDevice) DeepCopy() *SharedMemoryDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedMemoryDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Smartcard) DeepCopyInto(out *Smartcard) {
+	*out = *in
+	if in.Passthrough != nil {
+		in, out := &in.Passthrough, &out.Passthrough
+		*out = new(SmartcardPassthrough)
+		**out = **in
+	}
+	if in.HostCertificates != nil {
+		in, out := &in.HostCertificates, &out.HostCertificates
+		*out = new(SmartcardHostCertificates)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Smartcard.
+func (in *Smartcard) DeepCopy() *Smartcard {
+	if in == nil {
+		return nil
+	}
+	out := new(Smartcard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmartcardHostCertificates) DeepCopyInto(out *SmartcardHostCertificates) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmartcardHostCertificates.
+func (in *SmartcardHostCertificates) DeepCopy() *SmartcardHostCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(SmartcardHostCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SmartcardPassthrough) DeepCopyInto(out *SmartcardPassthrough) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SmartcardPassthrough.
+func (in *SmartcardPassthrough) DeepCopy() *SmartcardPassthrough {
+	if in == nil {
+		return nil
+	}
+	out := new(SmartcardPassthrough)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4765,6 +6003,22 @@ func (in *StopOptions) DeepCopy() *StopOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassStartLimit) DeepCopyInto(out *StorageClassStartLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassStartLimit.
+func (in *StorageClassStartLimit) DeepCopy() *StorageClassStartLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassStartLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageMigratedVolumeInfo) DeepCopyInto(out *StorageMigratedVolumeInfo) {
 	*out = *in
@@ -4850,49 +6104,149 @@ func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepSource.
-func (in *SysprepSource) DeepCopy() *SysprepSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysprepSource.
+func (in *SysprepSource) DeepCopy() *SysprepSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SysprepSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDX) DeepCopyInto(out *TDX) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(TDXPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(TDXAttestation)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDX.
+func (in *TDX) DeepCopy() *TDX {
+	if in == nil {
+		return nil
+	}
+	out := new(TDX)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDXAttestation) DeepCopyInto(out *TDXAttestation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDXAttestation.
+func (in *TDXAttestation) DeepCopy() *TDXAttestation {
+	if in == nil {
+		return nil
+	}
+	out := new(TDXAttestation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDXPolicy) DeepCopyInto(out *TDXPolicy) {
+	*out = *in
+	if in.SEPTVEDisable != nil {
+		in, out := &in.SEPTVEDisable, &out.SEPTVEDisable
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDXPolicy.
+func (in *TDXPolicy) DeepCopy() *TDXPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TDXPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSConfiguration) DeepCopyInto(out *TLSConfiguration) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfiguration.
+func (in *TLSConfiguration) DeepCopy() *TLSConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(SysprepSource)
+	out := new(TLSConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TDX) DeepCopyInto(out *TDX) {
+func (in *TPMAttestation) DeepCopyInto(out *TPMAttestation) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.PCRs != nil {
+		in, out := &in.PCRs, &out.PCRs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDX.
-func (in *TDX) DeepCopy() *TDX {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMAttestation.
+func (in *TPMAttestation) DeepCopy() *TPMAttestation {
 	if in == nil {
 		return nil
 	}
-	out := new(TDX)
+	out := new(TPMAttestation)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TPMAttestation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TLSConfiguration) DeepCopyInto(out *TLSConfiguration) {
+func (in *TPMAttestationOptions) DeepCopyInto(out *TPMAttestationOptions) {
 	*out = *in
-	if in.Ciphers != nil {
-		in, out := &in.Ciphers, &out.Ciphers
-		*out = make([]string, len(*in))
+	if in.PCRs != nil {
+		in, out := &in.PCRs, &out.PCRs
+		*out = make([]uint32, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSConfiguration.
-func (in *TLSConfiguration) DeepCopy() *TLSConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMAttestationOptions.
+func (in *TPMAttestationOptions) DeepCopy() *TPMAttestationOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(TLSConfiguration)
+	out := new(TPMAttestationOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4910,6 +6264,11 @@ func (in *TPMDevice) DeepCopyInto(out *TPMDevice) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EncryptionSecretRef != nil {
+		in, out := &in.EncryptionSecretRef, &out.EncryptionSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -5022,6 +6381,32 @@ func (in *USBHostDevice) DeepCopy() *USBHostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBRedirFilter) DeepCopyInto(out *USBRedirFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]USBDeviceClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]USBDeviceClass, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBRedirFilter.
+func (in *USBRedirFilter) DeepCopy() *USBRedirFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(USBRedirFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *USBSelector) DeepCopyInto(out *USBSelector) {
 	*out = *in
@@ -5205,6 +6590,69 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMIStatusUpdateConfiguration) DeepCopyInto(out *VMIStatusUpdateConfiguration) {
+	*out = *in
+	if in.BatchInterval != nil {
+		in, out := &in.BatchInterval, &out.BatchInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxJitter != nil {
+		in, out := &in.MaxJitter, &out.MaxJitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMIStatusUpdateConfiguration.
+func (in *VMIStatusUpdateConfiguration) DeepCopy() *VMIStatusUpdateConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VMIStatusUpdateConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMStartThrottlingConfiguration) DeepCopyInto(out *VMStartThrottlingConfiguration) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClassStartLimit, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMStartThrottlingConfiguration.
+func (in *VMStartThrottlingConfiguration) DeepCopy() *VMStartThrottlingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VMStartThrottlingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCResolutionOptions) DeepCopyInto(out *VNCResolutionOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCResolutionOptions.
+func (in *VNCResolutionOptions) DeepCopy() *VNCResolutionOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VNCResolutionOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
 	*out = *in
@@ -5226,9 +6674,30 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSockHTTPAction) DeepCopyInto(out *VSockHTTPAction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSockHTTPAction.
+func (in *VSockHTTPAction) DeepCopy() *VSockHTTPAction {
+	if in == nil {
+		return nil
+	}
+	out := new(VSockHTTPAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VideoDevice) DeepCopyInto(out *VideoDevice) {
 	*out = *in
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(VideoResolution)
+		**out = **in
+	}
 	return
 }
 
@@ -5242,6 +6711,38 @@ func (in *VideoDevice) DeepCopy() *VideoDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoResolution) DeepCopyInto(out *VideoResolution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoResolution.
+func (in *VideoResolution) DeepCopy() *VideoResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualFunctionPool) DeepCopyInto(out *VirtualFunctionPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualFunctionPool.
+func (in *VirtualFunctionPool) DeepCopy() *VirtualFunctionPool {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualFunctionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -5270,6 +6771,27 @@ func (in *VirtualMachine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineAuditEventsConfiguration) DeepCopyInto(out *VirtualMachineAuditEventsConfiguration) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineAuditEventsConfiguration.
+func (in *VirtualMachineAuditEventsConfiguration) DeepCopy() *VirtualMachineAuditEventsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineAuditEventsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCondition) DeepCopyInto(out *VirtualMachineCondition) {
 	*out = *in
@@ -5409,6 +6931,22 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDeviceStatus) DeepCopyInto(out *VirtualMachineInstanceDeviceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDeviceStatus.
+func (in *VirtualMachineInstanceDeviceStatus) DeepCopy() *VirtualMachineInstanceDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -5539,6 +7077,22 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFilesystem) DeepCopyInto(out *VirtualMachineInstanceGuestFilesystem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFilesystem.
+func (in *VirtualMachineInstanceGuestFilesystem) DeepCopy() *VirtualMachineInstanceGuestFilesystem {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFilesystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5994,6 +7548,30 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopy() *VirtualMachineInst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstancePauseStatus) DeepCopyInto(out *VirtualMachineInstancePauseStatus) {
+	*out = *in
+	if in.PauseTimestamp != nil {
+		in, out := &in.PauseTimestamp, &out.PauseTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.AutoUnpauseTimestamp != nil {
+		in, out := &in.AutoUnpauseTimestamp, &out.AutoUnpauseTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstancePauseStatus.
+func (in *VirtualMachineInstancePauseStatus) DeepCopy() *VirtualMachineInstancePauseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstancePauseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancePhaseTransitionTimestamp) DeepCopyInto(out *VirtualMachineInstancePhaseTransitionTimestamp) {
 	*out = *in
@@ -6250,6 +7828,16 @@ func (in *VirtualMachineInstanceReplicaSetStatus) DeepCopy() *VirtualMachineInst
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSpec) {
 	*out = *in
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LauncherMetadata != nil {
+		in, out := &in.LauncherMetadata, &out.LauncherMetadata
+		*out = new(LauncherMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Domain.DeepCopyInto(&out.Domain)
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
@@ -6342,6 +7930,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DiskSpaceLow != nil {
+		in, out := &in.DiskSpaceLow, &out.DiskSpaceLow
+		*out = new(DiskSpaceLowThreshold)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestSecrets != nil {
+		in, out := &in.GuestSecrets, &out.GuestSecrets
+		*out = make([]GuestSecret, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -6451,6 +8049,28 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(ChangedBlockTrackingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PauseStatus != nil {
+		in, out := &in.PauseStatus, &out.PauseStatus
+		*out = new(VirtualMachineInstancePauseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeviceStatuses != nil {
+		in, out := &in.DeviceStatuses, &out.DeviceStatuses
+		*out = make([]VirtualMachineInstanceDeviceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.GuestFilesystems != nil {
+		in, out := &in.GuestFilesystems, &out.GuestFilesystems
+		*out = make([]VirtualMachineInstanceGuestFilesystem, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskExpansionRequests != nil {
+		in, out := &in.DiskExpansionRequests, &out.DiskExpansionRequests
+		*out = make([]DiskExpansionRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6570,6 +8190,32 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSealedDefaults) DeepCopyInto(out *VirtualMachineSealedDefaults) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]SealedInterface, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]SealedDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSealedDefaults.
+func (in *VirtualMachineSealedDefaults) DeepCopy() *VirtualMachineSealedDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSealedDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -6610,6 +8256,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.Sealed != nil {
+		in, out := &in.Sealed, &out.Sealed
+		*out = new(VirtualMachineSealedDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6819,6 +8470,27 @@ func (in *VolumeMigrationState) DeepCopy() *VolumeMigrationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeScanConfiguration) DeepCopyInto(out *VolumeScanConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeScanConfiguration.
+func (in *VolumeScanConfiguration) DeepCopy() *VolumeScanConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeScanConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
 	*out = *in
@@ -6987,6 +8659,11 @@ func (in *VolumeUpdateState) DeepCopy() *VolumeUpdateState {
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
 	in.WatchdogDevice.DeepCopyInto(&out.WatchdogDevice)
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(WatchdogRemediation)
+		**out = **in
+	}
 	return
 }
 
@@ -7025,3 +8702,19 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchdogRemediation) DeepCopyInto(out *WatchdogRemediation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchdogRemediation.
+func (in *WatchdogRemediation) DeepCopy() *WatchdogRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchdogRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -87,6 +87,9 @@ type ConfigMapVolumeSource struct {
 	// Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
 	// +optional
 	VolumeLabel string `json:"volumeLabel,omitempty"`
+	// Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
 }
 
 // SecretVolumeSource adapts a Secret into a volume.
@@ -102,6 +105,9 @@ type SecretVolumeSource struct {
 	// Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
 	// +optional
 	VolumeLabel string `json:"volumeLabel,omitempty"`
+	// Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
 }
 
 // DownwardAPIVolumeSource represents a volume containing downward API info.
@@ -121,6 +127,9 @@ type ServiceAccountVolumeSource struct {
 	// Name of the service account in the pod's namespace to use.
 	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
 }
 
 // DownwardMetricsVolumeSource adds a very small disk to VMIs which contains a limited view of host and guest
@@ -196,6 +205,10 @@ type DomainSpec struct {
 	// Machine type.
 	// +optional
 	Machine *Machine `json:"machine,omitempty"`
+	// MachineOptions allows to turn off legacy devices of the machine,
+	// reducing the attack surface of hardened guests.
+	// +optional
+	MachineOptions *MachineOptions `json:"machineOptions,omitempty"`
 	// Firmware.
 	// +optional
 	Firmware *Firmware `json:"firmware,omitempty"`
@@ -221,6 +234,16 @@ type DomainSpec struct {
 	// Launch Security setting of the vmi.
 	// +optional
 	LaunchSecurity *LaunchSecurity `json:"launchSecurity,omitempty"`
+	// UseEmulation runs the vmi with software emulation (TCG) instead of KVM.
+	// The vmi does not request /dev/kvm, can run on any node and performs
+	// significantly slower than a hardware accelerated guest.
+	// Intended for CI and cross-architecture testing.
+	// +optional
+	UseEmulation *bool `json:"useEmulation,omitempty"`
+	// EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.
+	// Its qemu binary and firmware are used instead of the ones shipped with virt-launcher.
+	// +optional
+	EmulatorBundle string `json:"emulatorBundle,omitempty"`
 }
 
 // Chassis specifies the chassis info passed to the domain.
@@ -350,8 +373,28 @@ type CPU struct {
 	// Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads
 	// +optional
 	Realtime *Realtime `json:"realtime,omitempty"`
+	// NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest
+	// and schedules the VMI only on nodes whose KVM module allows nested guests.
+	// Only supported on amd64.
+	// +optional
+	NestedVirtualization *bool `json:"nestedVirtualization,omitempty"`
+	// CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type
+	// on hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.
+	// Requires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.
+	// +optional
+	CoreType CPUCoreType `json:"coreType,omitempty"`
 }
 
+// CPUCoreType selects a class of cores on hosts with heterogeneous CPUs.
+type CPUCoreType string
+
+const (
+	// CPUCoreTypePerformance selects the performance cores (cpu_core)
+	CPUCoreTypePerformance CPUCoreType = "performance"
+	// CPUCoreTypeEfficiency selects the efficiency cores (cpu_atom)
+	CPUCoreTypeEfficiency CPUCoreType = "efficiency"
+)
+
 // Realtime holds the tuning knobs specific for realtime workloads.
 type Realtime struct {
 	// Mask defines the vcpu mask expression that defines which vcpus are used for realtime. Format matches libvirt's expressions.
@@ -401,6 +444,11 @@ type Memory struct {
 	// MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
 	// The delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.
 	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
+	// DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest
+	// is hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.
+	// DIMMs can not be hot unplugged.
+	// +optional
+	DIMMSlots *uint32 `json:"dimmSlots,omitempty"`
 }
 
 type MemoryStatus struct {
@@ -427,11 +475,28 @@ type Machine struct {
 	Type string `json:"type"`
 }
 
+// MachineOptions are rendered as properties of the QEMU machine.
+// They are only supported on amd64. The SMBus controller of q35 can not be
+// turned off, as libvirt has no setting for it, and no parallel port is
+// created, so there is nothing to turn off.
+type MachineOptions struct {
+	// PS2 controls the legacy PS/2 (i8042) controller.
+	// Defaults to true.
+	// +optional
+	PS2 *bool `json:"ps2,omitempty"`
+}
+
 type Firmware struct {
 	// UUID reported by the vmi bios.
 	// Defaults to a random generated uid.
 	UUID types.UID `json:"uuid,omitempty"`
+	// Profile is the name of a firmware profile configured in the KubeVirt CR.
+	// The profile selects the bootloader, and may provide a custom firmware build
+	// for the architecture of the VMI.
+	// +optional
+	Profile string `json:"profile,omitempty"`
 	// Settings to control the bootloader that is used.
+	// Defaulted from the firmware profile, if set.
 	// +optional
 	Bootloader *Bootloader `json:"bootloader,omitempty"`
 	// The system-serial-number in SMBIOS
@@ -463,6 +528,16 @@ type Devices struct {
 	// Disks describes disks, cdroms and luns which are connected to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Disks []Disk `json:"disks,omitempty"`
+	// SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the
+	// scsi bus are attached to the controller named in their scsiController field,
+	// or to the first controller if none is named. Spreading busy disks over several
+	// controllers with dedicated IO threads improves storage throughput.
+	// Defaults to a single controller if a disk is on the scsi bus or hotplug is enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems:=32
+	SCSIControllers []SCSIController `json:"scsiControllers,omitempty"`
 	// Watchdog describes a watchdog device which can be added to the vmi.
 	Watchdog *Watchdog `json:"watchdog,omitempty"`
 	// Interfaces describe network interfaces which are added to the vmi.
@@ -475,6 +550,10 @@ type Devices struct {
 	// Whether to attach the default graphics device or not.
 	// VNC will not be available if set to false. Defaults to true.
 	AutoattachGraphicsDevice *bool `json:"autoattachGraphicsDevice,omitempty"`
+	// Graphics describes the configuration of the default graphics device.
+	// Not relevant if autoattachGraphicsDevice is disabled.
+	// +optional
+	Graphics *GraphicsDevice `json:"graphics,omitempty"`
 	// Whether to attach the default virtio-serial console or not.
 	// Serial console access will not be available if set to false. Defaults to true.
 	AutoattachSerialConsole *bool `json:"autoattachSerialConsole,omitempty"`
@@ -483,11 +562,21 @@ type Devices struct {
 	// Not relevant if autoattachSerialConsole is disabled.
 	// Defaults to cluster wide setting on VirtualMachineOptions.
 	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
+	// Serials describe additional serial ports, e.g. for guests which log
+	// their kernel output to ttyS1. Each port can be connected to with
+	// virtctl console --serial.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Serials []SerialPort `json:"serials,omitempty"`
 	// Whether to attach the Memory balloon device with default period.
 	// Period can be adjusted in virt-config.
 	// Defaults to true.
 	// +optional
 	AutoattachMemBalloon *bool `json:"autoattachMemBalloon,omitempty"`
+	// If specified, overrides useVirtioTransitional for the Memory balloon device.
+	// +optional
+	MemBalloonVirtioTransitional *bool `json:"memBalloonVirtioTransitional,omitempty"`
 	// Whether to attach an Input Device.
 	// Defaults to false.
 	// +optional
@@ -512,6 +601,12 @@ type Devices struct {
 	// DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.
 	// +optional
 	DownwardMetrics *DownwardMetrics `json:"downwardMetrics,omitempty"`
+	// HostSensors creates a virtio serial for exposing temperature and power readings of the node to the vmi.
+	// +optional
+	HostSensors *HostSensors `json:"hostSensors,omitempty"`
+	// SGX exposes an Intel SGX enclave page cache (EPC) section to the vmi, allowing it to run SGX enclaves.
+	// +optional
+	SGX *SGX `json:"sgx,omitempty"`
 	// PanicDevices provides additional crash information when a guest crashes.
 	// +optional
 	// +listtype=atomic
@@ -527,6 +622,9 @@ type Devices struct {
 	// To configure and access client devices such as redirecting USB
 	// +optional
 	ClientPassthrough *ClientPassthroughDevices `json:"clientPassthrough,omitempty"`
+	// Smartcard attaches a CCID smartcard reader to the vmi.
+	// +optional
+	Smartcard *Smartcard `json:"smartcard,omitempty"`
 	// Whether to emulate a sound device.
 	// +optional
 	Sound *SoundDevice `json:"sound,omitempty"`
@@ -536,16 +634,37 @@ type Devices struct {
 	// Video describes the video device configuration for the vmi.
 	// +optional
 	Video *VideoDevice `json:"video,omitempty"`
+	// IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run
+	// DPDK or to assign devices to nested guests.
+	// +optional
+	IOMMU *IOMMUDevice `json:"iommu,omitempty"`
+	// SharedMemoryDevices exposes shared memory regions to the guest, allowing
+	// co-located VMs or pods to exchange data through them.
+	// +optional
+	// +listType=atomic
+	SharedMemoryDevices []SharedMemoryDevice `json:"sharedMemoryDevices,omitempty"`
+	// Channels adds virtio-serial channels backed by unix sockets, allowing custom
+	// in-guest agents to talk to sidecars or node agents without networking.
+	// +optional
+	// +listType=atomic
+	Channels []Channel `json:"channels,omitempty"`
 }
 
 // Represent a subset of client devices that can be accessed by VMI. At the
 // moment only, USB devices using Usbredir's library and tooling. Another fit
 // would be a smartcard with libcacard.
 //
-// The struct is currently empty as there is no immediate request for
-// user-facing APIs. This structure simply turns on USB redirection of
-// UsbClientPassthroughMaxNumberOf devices.
+// Setting it turns on USB redirection of up to UsbClientPassthroughMaxNumberOf
+// devices.
 type ClientPassthroughDevices struct {
+	// Count is the number of USB devices which can be redirected at the same time.
+	// Defaults to UsbClientPassthroughMaxNumberOf.
+	// +optional
+	Count *uint32 `json:"count,omitempty"`
+	// Filter restricts the USB devices which can be redirected by their class.
+	// It is enforced by virt-handler when proxying the redirection.
+	// +optional
+	Filter *USBRedirFilter `json:"filter,omitempty"`
 }
 
 // Represents the upper limit allowed by QEMU + KubeVirt.
@@ -553,6 +672,68 @@ const (
 	UsbClientPassthroughMaxNumberOf = 4
 )
 
+// USBRedirFilter allows or denies the redirection of USB devices based on the
+// classes of the device and of its interfaces. The classes are the ones
+// reported by the USB client, the filter does not protect against a client
+// which misreports them.
+type USBRedirFilter struct {
+	// Allow lists the device classes which can be redirected. If empty, all
+	// device classes which are not denied can be redirected.
+	// +optional
+	// +listType=set
+	Allow []USBDeviceClass `json:"allow,omitempty"`
+	// Deny lists the device classes which can not be redirected. Deny takes
+	// precedence over allow.
+	// +optional
+	// +listType=set
+	Deny []USBDeviceClass `json:"deny,omitempty"`
+}
+
+// USBDeviceClass is the class of a USB device or of one of its interfaces.
+type USBDeviceClass string
+
+const (
+	USBDeviceClassAudio          USBDeviceClass = "audio"
+	USBDeviceClassCommunications USBDeviceClass = "communications"
+	USBDeviceClassHID            USBDeviceClass = "hid"
+	USBDeviceClassImage          USBDeviceClass = "image"
+	USBDeviceClassPrinter        USBDeviceClass = "printer"
+	USBDeviceClassMassStorage    USBDeviceClass = "massStorage"
+	USBDeviceClassHub            USBDeviceClass = "hub"
+	USBDeviceClassSmartCard      USBDeviceClass = "smartCard"
+	USBDeviceClassVideo          USBDeviceClass = "video"
+	USBDeviceClassWireless       USBDeviceClass = "wireless"
+	USBDeviceClassVendorSpecific USBDeviceClass = "vendorSpecific"
+)
+
+// Smartcard represents a CCID smartcard reader with a single card.
+// Only one of its members may be specified.
+type Smartcard struct {
+	// Passthrough forwards the smartcard of a client, which connects to the
+	// smartcard subresource of the vmi.
+	// +optional
+	Passthrough *SmartcardPassthrough `json:"passthrough,omitempty"`
+	// HostCertificates emulates a smartcard with certificates of an NSS
+	// database stored in a Secret.
+	// +optional
+	HostCertificates *SmartcardHostCertificates `json:"hostCertificates,omitempty"`
+}
+
+// SmartcardPassthrough forwards the smartcard of a client to the vmi.
+type SmartcardPassthrough struct{}
+
+// SmartcardHostCertificates emulates a smartcard with certificates of an NSS database.
+type SmartcardHostCertificates struct {
+	// SecretName is the name of the Secret holding the files of the NSS database,
+	// for example cert9.db, key4.db and pkcs11.txt.
+	SecretName string `json:"secretName"`
+	// Certificates are the nicknames of the three certificates of the emulated card.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems:=3
+	// +kubebuilder:validation:MaxItems:=3
+	Certificates []string `json:"certificates"`
+}
+
 // Represents the user's configuration to emulate sound cards in the VMI.
 type SoundDevice struct {
 	// User's defined name for this sound device
@@ -571,6 +752,94 @@ type TPMDevice struct {
 	// Persistent indicates the state of the TPM device should be kept accross reboots
 	// Defaults to false
 	Persistent *bool `json:"persistent,omitempty"`
+	// Version is the version of the emulated TPM, 2.0 or 1.2.
+	// Defaults to 2.0
+	// +optional
+	Version TPMVersion `json:"version,omitempty"`
+	// EncryptionSecretRef references a Secret in the namespace of the VMI.
+	// Its "key" entry holds the passphrase the persistent TPM state is encrypted with.
+	// It has to be set before the TPM state is created and requires persistent to be enabled.
+	// +optional
+	EncryptionSecretRef *v1.LocalObjectReference `json:"encryptionSecretRef,omitempty"`
+}
+
+type TPMVersion string
+
+const (
+	TPMVersion12 TPMVersion = "1.2"
+	TPMVersion20 TPMVersion = "2.0"
+)
+
+type IOMMUModel string
+
+const (
+	IOMMUModelIntel  IOMMUModel = "intel"
+	IOMMUModelVirtio IOMMUModel = "virtio"
+)
+
+type IOMMUDevice struct {
+	// Model is the IOMMU model. One of: intel, virtio.
+	// Defaults to intel on amd64 and to virtio on arm64.
+	// +optional
+	Model IOMMUModel `json:"model,omitempty"`
+	// CachingMode makes the guest report every mapping change, which is required
+	// to assign devices to nested guests. Only supported by the intel model.
+	// +optional
+	CachingMode *bool `json:"cachingMode,omitempty"`
+	// InterruptRemapping enables remapping of the interrupts of assigned devices.
+	// Only supported by the intel model.
+	// +optional
+	InterruptRemapping *bool `json:"interruptRemapping,omitempty"`
+	// EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.
+	// Requires interruptRemapping.
+	// +optional
+	EIM *bool `json:"eim,omitempty"`
+}
+
+type SharedMemoryModel string
+
+const (
+	SharedMemoryModelPlain    SharedMemoryModel = "ivshmem-plain"
+	SharedMemoryModelDoorbell SharedMemoryModel = "ivshmem-doorbell"
+)
+
+type SharedMemoryDevice struct {
+	// Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.
+	// Without a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the
+	// virt-launcher pod and can be shared with its sidecar containers.
+	Name string `json:"name"`
+	// Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.
+	// Defaults to ivshmem-plain.
+	// +optional
+	Model SharedMemoryModel `json:"model,omitempty"`
+	// Size of the shared memory region. Must be a power of two.
+	// Required for ivshmem-plain. Not supported by ivshmem-doorbell, where the
+	// server owns the region.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// HostPath is a file on the node backing an ivshmem-plain region, shared with
+	// every VM and pod on the node mapping the same file. It is created if it
+	// does not exist and has to be writable by the qemu user.
+	// +optional
+	HostPath string `json:"hostPath,omitempty"`
+	// ServerSocketPath is the path on the node of the ivshmem-server socket an
+	// ivshmem-doorbell device connects to. Required for ivshmem-doorbell.
+	// +optional
+	ServerSocketPath string `json:"serverSocketPath,omitempty"`
+}
+
+type Channel struct {
+	// Name of the channel. Must be a DNS_LABEL and unique within the vmi.
+	// The channel socket is named <name>.sock.
+	Name string `json:"name"`
+	// Target is the virtio-serial port name the guest sees, for example org.example.agent.0.
+	// In Linux guests the port shows up as /dev/virtio-ports/<target>.
+	Target string `json:"target"`
+	// HostPath is a directory on the node the channel socket is created in.
+	// By default the socket is created in /var/run/kubevirt-channels, a pod-local
+	// directory shared with the hook sidecars of the vmi.
+	// +optional
+	HostPath string `json:"hostPath,omitempty"`
 }
 
 type VideoDevice struct {
@@ -578,6 +847,27 @@ type VideoDevice struct {
 	// If not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).
 	// +optional
 	Type string `json:"type,omitempty"`
+	// Resolution is the preferred resolution advertised to the guest through EDID.
+	// Graphical consoles come up at this resolution if the guest display driver honors it.
+	// Supported by the vga, virtio and bochs video types.
+	// +optional
+	Resolution *VideoResolution `json:"resolution,omitempty"`
+}
+
+// VideoResolution is a display resolution in pixels.
+type VideoResolution struct {
+	// Width in pixels.
+	Width uint32 `json:"width"`
+	// Height in pixels.
+	Height uint32 `json:"height"`
+}
+
+type GraphicsDevice struct {
+	// Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.
+	// It is only needed for VNC clients which send keysyms instead of raw keycodes.
+	// Defaults to en-us.
+	// +optional
+	Keymap string `json:"keymap,omitempty"`
 }
 
 type InputBus string
@@ -590,8 +880,10 @@ const (
 type InputType string
 
 const (
-	InputTypeTablet   InputType = "tablet"
-	InputTypeKeyboard InputType = "keyboard"
+	InputTypeTablet     InputType = "tablet"
+	InputTypeKeyboard   InputType = "keyboard"
+	InputTypeMouse      InputType = "mouse"
+	InputTypeMultitouch InputType = "multitouch"
 )
 
 type Input struct {
@@ -599,12 +891,36 @@ type Input struct {
 	// Supported values: virtio, usb.
 	Bus InputBus `json:"bus,omitempty"`
 	// Type indicated the type of input device.
-	// Supported values: tablet.
+	// A tablet is an absolute pointer, a mouse a relative one.
+	// Multitouch devices are only supported on the virtio bus.
+	// Supported values: tablet, keyboard, mouse, multitouch.
 	Type InputType `json:"type"`
 	// Name is the device name
 	Name string `json:"name"`
 }
 
+type SerialPort struct {
+	// Name of the serial port.
+	Name string `json:"name"`
+	// Port is the index of the serial port in the guest, e.g. 1 for ttyS1.
+	// Port 0 is taken by the default serial console, unless
+	// autoattachSerialConsole is disabled.
+	Port uint32 `json:"port"`
+}
+
+type SCSIController struct {
+	// Name of the controller, referenced by the scsiController field of disks.
+	Name string `json:"name"`
+	// Queues is the number of request queues of the controller.
+	// Defaults to the number of vCPUs if blockMultiQueue is enabled.
+	// +optional
+	Queues *uint32 `json:"queues,omitempty"`
+	// DedicatedIOThread indicates the controller should have an exclusive IO thread,
+	// shared by all disks attached to it. Defaults to false.
+	// +optional
+	DedicatedIOThread *bool `json:"dedicatedIOThread,omitempty"`
+}
+
 type Filesystem struct {
 	// Name is the device name
 	Name string `json:"name"`
@@ -612,10 +928,74 @@ type Filesystem struct {
 	Virtiofs *FilesystemVirtiofs `json:"virtiofs"`
 }
 
-type FilesystemVirtiofs struct{}
+// FilesystemVirtiofs configures the virtiofsd which serves the filesystem.
+// The sandbox of virtiofsd is not configurable: virtiofsd runs without privileges
+// in its own container, which isolates it from the host, and it cannot set up
+// a namespace or chroot sandbox there.
+type FilesystemVirtiofs struct {
+	// QueueSize is the size of the virtqueue of the device.
+	// Must be a power of two not larger than 1024. Defaults to 1024.
+	// +optional
+	QueueSize *uint32 `json:"queueSize,omitempty"`
+	// ThreadPoolSize is the number of threads virtiofsd uses to handle the
+	// requests of the guest. 0 handles them in the thread of the queue.
+	// Defaults to the default of virtiofsd.
+	// +optional
+	ThreadPoolSize *uint32 `json:"threadPoolSize,omitempty"`
+	// IDMap maps the user and group IDs of the guest to the IDs owning the
+	// files of the shared volume.
+	// +optional
+	IDMap *FilesystemIDMap `json:"idMap,omitempty"`
+}
+
+type FilesystemIDMap struct {
+	// UID maps ranges of user IDs.
+	// +optional
+	// +listType=atomic
+	UID []FilesystemIDMapRange `json:"uid,omitempty"`
+	// GID maps ranges of group IDs.
+	// +optional
+	// +listType=atomic
+	GID []FilesystemIDMapRange `json:"gid,omitempty"`
+}
+
+type FilesystemIDMapRange struct {
+	// Start is the first ID of the range in the guest.
+	Start uint32 `json:"start"`
+	// Target is the first ID of the range on the shared volume.
+	Target uint32 `json:"target"`
+	// Count is the number of IDs in the range.
+	Count uint32 `json:"count"`
+}
 
 type DownwardMetrics struct{}
 
+// HostSensors exposes readings of the hardware monitoring sensors of the node.
+type HostSensors struct {
+	// Types are the kinds of sensors exposed to the vmi.
+	// Defaults to all of them.
+	// +optional
+	// +listType=set
+	Types []HostSensorType `json:"types,omitempty"`
+}
+
+// SGX configures the Intel Software Guard Extensions of the vmi.
+type SGX struct {
+	// EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.
+	// It is taken from the EPC of the node, which is not part of its memory.
+	EPCSize resource.Quantity `json:"epcSize"`
+}
+
+// HostSensorType is a kind of hardware monitoring sensor.
+type HostSensorType string
+
+const (
+	// HostSensorTypeTemperature exposes temperatures in millidegrees Celsius.
+	HostSensorTypeTemperature HostSensorType = "temperature"
+	// HostSensorTypePower exposes power consumptions in microwatts.
+	HostSensorTypePower HostSensorType = "power"
+)
+
 type GPU struct {
 	// Name of the GPU device as exposed by a device plugin
 	Name string `json:"name"`
@@ -696,11 +1076,30 @@ type Disk struct {
 	// Serial provides the ability to specify a serial number for the disk device.
 	// +optional
 	Serial string `json:"serial,omitempty"`
+	// SerialPolicy defines how a serial number is chosen for a disk which does not specify one.
+	// Supported values are: None, VolumeNameHash.
+	// VolumeNameHash derives the serial number from the name of the volume, so that it stays the same
+	// when disks are reordered. Defaults to None.
+	// +optional
+	SerialPolicy DiskSerialPolicy `json:"serialPolicy,omitempty"`
+	// WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.
+	// Only supported on the scsi bus.
+	// +optional
+	WWN string `json:"wwn,omitempty"`
 	// dedicatedIOThread indicates this disk should have an exclusive IO Thread.
 	// Enabling this implies useIOThreads = true.
 	// Defaults to false.
 	// +optional
 	DedicatedIOThread *bool `json:"dedicatedIOThread,omitempty"`
+	// SCSIController is the name of the scsi controller the disk is attached to.
+	// Only supported on the scsi bus. Defaults to the first scsi controller.
+	// +optional
+	SCSIController string `json:"scsiController,omitempty"`
+	// Queues is the number of queues of the disk. If specified, it overrides the
+	// number of queues derived from blockMultiQueue for this disk.
+	// Only supported on the virtio bus.
+	// +optional
+	Queues *uint32 `json:"queues,omitempty"`
 	// Cache specifies which kvm disk cache mode should be used.
 	// Supported values are:
 	// none: Guest I/O not cached on the host, but may be kept in a disk cache.
@@ -725,12 +1124,82 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// If specified, it changes the error policy for read errors of the disk.
+	// Valid values are stop, ignore and report. Defaults to the error policy of the disk.
+	// +optional
+	RerrorPolicy *DiskErrorPolicy `json:"rerrorPolicy,omitempty"`
+	// IOTune throttles the IO of the disk. The limits are enforced by QEMU.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
 	// ChangedBlockTracking indicates this disk should have CBT option
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
 }
 
+// DiskIOTune limits the throughput and the IO operations per second of a disk.
+// A total limit can not be combined with a read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesSec limits the total throughput in bytes per second.
+	// +optional
+	TotalBytesSec *uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec limits the read throughput in bytes per second.
+	// +optional
+	ReadBytesSec *uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec limits the write throughput in bytes per second.
+	// +optional
+	WriteBytesSec *uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec limits the total IO operations per second.
+	// +optional
+	TotalIOPSSec *uint64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec limits the read IO operations per second.
+	// +optional
+	ReadIOPSSec *uint64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec limits the write IO operations per second.
+	// +optional
+	WriteIOPSSec *uint64 `json:"writeIOPSSec,omitempty"`
+	// Burst allows the disk to exceed the limits for a short time.
+	// +optional
+	Burst *DiskIOTuneBurst `json:"burst,omitempty"`
+}
+
+// DiskIOTuneBurst defines the limits applied while the disk bursts. Each burst
+// limit requires the corresponding limit of DiskIOTune and must not be lower.
+type DiskIOTuneBurst struct {
+	// TotalBytesSec limits the total throughput in bytes per second during a burst.
+	// +optional
+	TotalBytesSec *uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec limits the read throughput in bytes per second during a burst.
+	// +optional
+	ReadBytesSec *uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec limits the write throughput in bytes per second during a burst.
+	// +optional
+	WriteBytesSec *uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec limits the total IO operations per second during a burst.
+	// +optional
+	TotalIOPSSec *uint64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec limits the read IO operations per second during a burst.
+	// +optional
+	ReadIOPSSec *uint64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec limits the write IO operations per second during a burst.
+	// +optional
+	WriteIOPSSec *uint64 `json:"writeIOPSSec,omitempty"`
+	// LengthSeconds is the maximum duration of a burst in seconds.
+	// Defaults to 1.
+	// +optional
+	LengthSeconds *uint64 `json:"lengthSeconds,omitempty"`
+}
+
+// DiskSerialPolicy defines how a serial number is chosen for a disk
+type DiskSerialPolicy string
+
+const (
+	// DiskSerialPolicyNone does not set a serial number
+	DiskSerialPolicyNone DiskSerialPolicy = "None"
+	// DiskSerialPolicyVolumeNameHash derives the serial number from the name of the volume
+	DiskSerialPolicyVolumeNameHash DiskSerialPolicy = "VolumeNameHash"
+)
+
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
 type CustomBlockSize struct {
 	Logical            uint  `json:"logical,omitempty"`
@@ -763,11 +1232,12 @@ const (
 	DiskBusSATA   DiskBus = "sata"
 	DiskBusVirtio DiskBus = VirtIO
 	DiskBusUSB    DiskBus = "usb"
+	DiskBusNVMe   DiskBus = "nvme"
 )
 
 type DiskTarget struct {
 	// Bus indicates the type of disk device to emulate.
-	// supported values: virtio, sata, scsi, usb.
+	// supported values: virtio, sata, scsi, usb, nvme.
 	Bus DiskBus `json:"bus,omitempty"`
 	// ReadOnly.
 	// Defaults to false.
@@ -775,6 +1245,10 @@ type DiskTarget struct {
 	// If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10
 	// +optional
 	PciAddress string `json:"pciAddress,omitempty"`
+	// If specified, overrides useVirtioTransitional for this disk.
+	// Only applies to the virtio bus.
+	// +optional
+	VirtioTransitional *bool `json:"virtioTransitional,omitempty"`
 }
 
 type LaunchSecurity struct {
@@ -811,9 +1285,61 @@ type SEVSNP struct {
 }
 
 type SEVAttestation struct {
+	// If specified, the launch secret is released by the key broker once it
+	// verified the launch measurement, and the VMI is unpaused afterwards.
+	// Otherwise the launch secret has to be injected through the
+	// sev/injectlaunchsecret subresource.
+	// +optional
+	KeyBroker *KeyBroker `json:"keyBroker,omitempty"`
+}
+
+type KeyBrokerProtocol string
+
+const (
+	// KeyBrokerProtocolKBS is the protocol of the Trustee key broker service.
+	KeyBrokerProtocolKBS KeyBrokerProtocol = "kbs"
+)
+
+// KeyBroker describes an external key broker service, which verifies the
+// attestation evidence of a guest and releases secrets to it.
+type KeyBroker struct {
+	// Protocol spoken with the key broker.
+	// Defaults to kbs.
+	// +optional
+	Protocol KeyBrokerProtocol `json:"protocol,omitempty"`
+	// URL of the key broker service.
+	URL string `json:"url"`
+	// Path of the resource holding the launch secret, e.g. default/sev/launch-secret.
+	ResourcePath string `json:"resourcePath"`
 }
 
 type TDX struct {
+	// Guest policy flags as defined in the Intel TDX module specification.
+	// Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+	// +optional
+	Policy *TDXPolicy `json:"policy,omitempty"`
+	// Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).
+	// +optional
+	MrConfigID string `json:"mrConfigId,omitempty"`
+	// Base64 encoded SHA384 digest identifying the guest owner (MROWNER).
+	// +optional
+	MrOwner string `json:"mrOwner,omitempty"`
+	// Base64 encoded SHA384 digest of the guest owner's owner-defined configuration (MROWNERCONFIG).
+	// +optional
+	MrOwnerConfig string `json:"mrOwnerConfig,omitempty"`
+	// If specified, the guest can request quotes from the quote generation service of the node.
+	// +optional
+	Attestation *TDXAttestation `json:"attestation,omitempty"`
+}
+
+type TDXPolicy struct {
+	// Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).
+	// Defaults to false.
+	// +optional
+	SEPTVEDisable *bool `json:"septVEDisable,omitempty"`
+}
+
+type TDXAttestation struct {
 }
 
 type LunTarget struct {
@@ -937,6 +1463,18 @@ type HotplugVolumeSource struct {
 	// the process of populating that PVC with a disk image.
 	// +optional
 	DataVolume *DataVolumeSource `json:"dataVolume,omitempty"`
+	// ConfigMap represents a reference to a ConfigMap in the same namespace, which is attached
+	// to the vmi as an iso image.
+	// +optional
+	ConfigMap *ConfigMapVolumeSource `json:"configMap,omitempty"`
+	// Secret represents a reference to a Secret in the same namespace, which is attached
+	// to the vmi as an iso image.
+	// +optional
+	Secret *SecretVolumeSource `json:"secret,omitempty"`
+	// ServiceAccount represents a reference to a service account, whose token is attached
+	// to the vmi as an iso image.
+	// +optional
+	ServiceAccount *ServiceAccountVolumeSource `json:"serviceAccount,omitempty"`
 }
 
 type DataVolumeSource struct {
@@ -962,6 +1500,9 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format of the memory dump, Core by default
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1046,6 +1587,18 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,
+	// which guests can discipline their clock to with sub-microsecond accuracy.
+	// +optional
+	PTP *PTPClock `json:"ptp,omitempty"`
+}
+
+// PTPClock is the PTP hardware clock (PHC) of the ptp_kvm driver of the guest.
+type PTPClock struct {
+	// Enabled set to false makes sure that a preference can't add the clock.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // Represents all available timers in a vmi.
@@ -1302,6 +1855,19 @@ type FeatureKVM struct {
 	// Hide the KVM hypervisor from standard MSR based discovery.
 	// Defaults to false
 	Hidden bool `json:"hidden,omitempty"`
+	// HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip
+	// paravirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.
+	// Only supported on amd64.
+	// Defaults to true with dedicated CPU placement on amd64, false otherwise.
+	// +optional
+	HintDedicated *bool `json:"hintDedicated,omitempty"`
+	// PollControl allows the guest to take over halt polling from the host, e.g. with the
+	// cpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.
+	// The halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.
+	// Only supported on amd64.
+	// Defaults to false
+	// +optional
+	PollControl *bool `json:"pollControl,omitempty"`
 }
 
 // WatchdogAction defines the watchdog action, if a watchdog gets triggered.
@@ -1314,6 +1880,10 @@ const (
 	WatchdogActionReset WatchdogAction = "reset"
 	// WatchdogActionShutdown will shutdown the vmi if the watchdog gets triggered.
 	WatchdogActionShutdown WatchdogAction = "shutdown"
+	// WatchdogActionPause will pause the vmi if the watchdog gets triggered.
+	WatchdogActionPause WatchdogAction = "pause"
+	// WatchdogActionNone will leave the vmi running if the watchdog gets triggered.
+	WatchdogActionNone WatchdogAction = "none"
 )
 
 // Named watchdog device.
@@ -1323,6 +1893,35 @@ type Watchdog struct {
 	// WatchdogDevice contains the watchdog type and actions.
 	// Defaults to i6300esb.
 	WatchdogDevice `json:",inline"`
+	// Remediation is carried out by KubeVirt once the watchdog expired,
+	// in addition to the action taken by the watchdog device itself.
+	// +optional
+	Remediation *WatchdogRemediation `json:"remediation,omitempty"`
+}
+
+// WatchdogRemediationType defines what KubeVirt does after the guest watchdog expired.
+type WatchdogRemediationType string
+
+const (
+	// WatchdogRemediationReset resets the guest.
+	WatchdogRemediationReset WatchdogRemediationType = "Reset"
+	// WatchdogRemediationRestartVM restarts the VirtualMachine owning the VMI, like
+	// the restart subresource.
+	WatchdogRemediationRestartVM WatchdogRemediationType = "RestartVM"
+	// WatchdogRemediationMemoryDump dumps the guest memory to a PVC. Requires the
+	// pause or none watchdog action, which keep the guest memory as it was when
+	// the watchdog expired.
+	WatchdogRemediationMemoryDump WatchdogRemediationType = "MemoryDump"
+)
+
+// WatchdogRemediation configures the reaction of KubeVirt to an expired guest watchdog.
+type WatchdogRemediation struct {
+	// Type of the remediation. One of: Reset, RestartVM, MemoryDump.
+	Type WatchdogRemediationType `json:"type"`
+	// MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.
+	// Required by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
 }
 
 // Hardware watchdog device.
@@ -1339,14 +1938,14 @@ type WatchdogDevice struct {
 
 // i6300esb watchdog device.
 type I6300ESBWatchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, pause, none.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
 
 // diag288 watchdog device.
 type Diag288Watchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, pause, none.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
@@ -1391,6 +1990,25 @@ type Interface struct {
 	// This value is required to be unique across all devices and be between 1 and (16*1024-1).
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
+	// If specified, overrides useVirtioTransitional for this interface.
+	// Only applies to the virtio model.
+	// +optional
+	VirtioTransitional *bool `json:"virtioTransitional,omitempty"`
+	// Backend selects the host side backend of the interface.
+	// One of: vhost, userspace.
+	// Defaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.
+	// Only applies to the virtio model.
+	// +optional
+	Backend InterfaceBackend `json:"backend,omitempty"`
+	// Queues is the number of queues of the interface. If specified, it overrides the
+	// number of queues derived from networkInterfaceMultiqueue for this interface.
+	// Only applies to the virtio model.
+	// +optional
+	Queues *uint32 `json:"queues,omitempty"`
+	// Offloads toggles the offloads of the interface on the tap path.
+	// Only applies to the virtio model.
+	// +optional
+	Offloads *InterfaceOffloads `json:"offloads,omitempty"`
 	// State represents the requested operational state of the interface.
 	// The supported values are:
 	// `absent`, expressing a request to remove the interface.
@@ -1401,6 +2019,29 @@ type Interface struct {
 	State InterfaceState `json:"state,omitempty"`
 }
 
+type InterfaceBackend string
+
+const (
+	// InterfaceBackendVhost processes the packets of the interface in the host kernel, using /dev/vhost-net.
+	InterfaceBackendVhost InterfaceBackend = "vhost"
+	// InterfaceBackendUserspace processes the packets of the interface in QEMU.
+	InterfaceBackendUserspace InterfaceBackend = "userspace"
+)
+
+// InterfaceOffloads toggles offloads between the guest and the host.
+// Offloads which are not set are enabled when the guest driver supports them.
+type InterfaceOffloads struct {
+	// Checksum toggles the checksum offload.
+	// +optional
+	Checksum *bool `json:"checksum,omitempty"`
+	// TSO toggles the TCP segmentation offload, for IPv4 and IPv6.
+	// +optional
+	TSO *bool `json:"tso,omitempty"`
+	// GSO toggles the generic segmentation offload of the host.
+	// +optional
+	GSO *bool `json:"gso,omitempty"`
+}
+
 type InterfaceState string
 
 const (
@@ -1486,7 +2127,27 @@ type DeprecatedInterfaceSlirp struct{}
 type InterfaceMasquerade struct{}
 
 // InterfaceSRIOV connects to a given network by passing-through an SR-IOV PCI device via vfio.
-type InterfaceSRIOV struct{}
+type InterfaceSRIOV struct {
+	// Failover pairs the SR-IOV device with a standby virtio interface, which shares the same
+	// MAC address and takes over the traffic while the device is unplugged for live migration.
+	// +optional
+	Failover *InterfaceSRIOVFailover `json:"failover,omitempty"`
+}
+
+// InterfaceSRIOVFailover configures the virtio-net failover pairing of an SR-IOV interface.
+// Either StandbyNetworkName or StandbyInterface must be set.
+type InterfaceSRIOVFailover struct {
+	// StandbyInterface is the name of the bridge-bound virtio interface which backs the
+	// SR-IOV device. It must have the same MAC address as the SR-IOV interface.
+	// It is set to the generated standby interface when StandbyNetworkName is used.
+	// +optional
+	StandbyInterface string `json:"standbyInterface,omitempty"`
+	// StandbyNetworkName is the Multus network of the standby interface, which is generated
+	// when the VMI is created. The standby interface gets the MAC address of the SR-IOV
+	// interface, which is generated if it is not set.
+	// +optional
+	StandbyNetworkName string `json:"standbyNetworkName,omitempty"`
+}
 
 // DeprecatedInterfaceMacvtap is an alias to the deprecated InterfaceMacvtap
 // that connects to a given network by extending the Kubernetes node's L2 networks via a macvtap interface.
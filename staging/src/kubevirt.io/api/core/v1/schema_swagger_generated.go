@@ -14,18 +14,20 @@ func (HostDisk) SwaggerDoc() map[string]string {
 
 func (ConfigMapVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "ConfigMapVolumeSource adapts a ConfigMap into a volume.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes/#configmap",
-		"optional":    "Specify whether the ConfigMap or it's keys must be defined\n+optional",
-		"volumeLabel": "The volume label of the resulting disk inside the VMI.\nDifferent bootstrapping mechanisms require different values.\nTypical values are \"cidata\" (cloud-init), \"config-2\" (cloud-init) or \"OEMDRV\" (kickstart).\n+optional",
+		"":             "ConfigMapVolumeSource adapts a ConfigMap into a volume.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes/#configmap",
+		"optional":     "Specify whether the ConfigMap or it's keys must be defined\n+optional",
+		"volumeLabel":  "The volume label of the resulting disk inside the VMI.\nDifferent bootstrapping mechanisms require different values.\nTypical values are \"cidata\" (cloud-init), \"config-2\" (cloud-init) or \"OEMDRV\" (kickstart).\n+optional",
+		"hotpluggable": "Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.\n+optional",
 	}
 }
 
 func (SecretVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "SecretVolumeSource adapts a Secret into a volume.",
-		"secretName":  "Name of the secret in the pod's namespace to use.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#secret",
-		"optional":    "Specify whether the Secret or it's keys must be defined\n+optional",
-		"volumeLabel": "The volume label of the resulting disk inside the VMI.\nDifferent bootstrapping mechanisms require different values.\nTypical values are \"cidata\" (cloud-init), \"config-2\" (cloud-init) or \"OEMDRV\" (kickstart).\n+optional",
+		"":             "SecretVolumeSource adapts a Secret into a volume.",
+		"secretName":   "Name of the secret in the pod's namespace to use.\nMore info: https://kubernetes.io/docs/concepts/storage/volumes#secret",
+		"optional":     "Specify whether the Secret or it's keys must be defined\n+optional",
+		"volumeLabel":  "The volume label of the resulting disk inside the VMI.\nDifferent bootstrapping mechanisms require different values.\nTypical values are \"cidata\" (cloud-init), \"config-2\" (cloud-init) or \"OEMDRV\" (kickstart).\n+optional",
+		"hotpluggable": "Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.\n+optional",
 	}
 }
 
@@ -41,6 +43,7 @@ func (ServiceAccountVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "ServiceAccountVolumeSource adapts a ServiceAccount into a volume.",
 		"serviceAccountName": "Name of the service account in the pod's namespace to use.\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+		"hotpluggable":       "Hotpluggable indicates whether the volume can be hotplugged and hotunplugged.\n+optional",
 	}
 }
 
@@ -88,6 +91,7 @@ func (DomainSpec) SwaggerDoc() map[string]string {
 		"cpu":             "CPU allow specified the detailed CPU topology inside the vmi.\n+optional",
 		"memory":          "Memory allow specifying the VMI memory features.\n+optional",
 		"machine":         "Machine type.\n+optional",
+		"machineOptions":  "MachineOptions allows to turn off legacy devices of the machine,\nreducing the attack surface of hardened guests.\n+optional",
 		"firmware":        "Firmware.\n+optional",
 		"clock":           "Clock sets the clock and timers of the vmi.\n+optional",
 		"features":        "Features like acpi, apic, hyperv, smm.\n+optional",
@@ -96,6 +100,8 @@ func (DomainSpec) SwaggerDoc() map[string]string {
 		"ioThreads":       "IOThreads specifies the IOThreads options.\n+optional",
 		"chassis":         "Chassis specifies the chassis info passed to the domain.\n+optional",
 		"launchSecurity":  "Launch Security setting of the vmi.\n+optional",
+		"useEmulation":    "UseEmulation runs the vmi with software emulation (TCG) instead of KVM.\nThe vmi does not request /dev/kvm, can run on any node and performs\nsignificantly slower than a hardware accelerated guest.\nIntended for CI and cross-architecture testing.\n+optional",
+		"emulatorBundle":  "EmulatorBundle is the name of an emulator bundle configured in the KubeVirt CR.\nIts qemu binary and firmware are used instead of the ones shipped with virt-launcher.\n+optional",
 	}
 }
 
@@ -168,6 +174,8 @@ func (CPU) SwaggerDoc() map[string]string {
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
 		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
 		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"nestedVirtualization":  "NestedVirtualization exposes the virtualization extensions of the host CPU (vmx or svm) to the guest\nand schedules the VMI only on nodes whose KVM module allows nested guests.\nOnly supported on amd64.\n+optional",
+		"coreType":              "CoreType restricts the vCPU pinning to dedicated pCPUs of a single core type\non hosts with heterogeneous (hybrid) CPUs, as reported by the cpu_core and cpu_atom devices of the kernel.\nRequires DedicatedCPUPlacement. The VMI is scheduled to nodes with cores of this type.\n+optional",
 	}
 }
 
@@ -204,6 +212,7 @@ func (Memory) SwaggerDoc() map[string]string {
 		"hugepages": "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
 		"guest":     "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
 		"maxGuest":  "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hot(un)plugged.",
+		"dimmSlots": "DIMMSlots is the number of DIMM slots of the guest. When set, the memory between Guest and MaxGuest\nis hot plugged as DIMMs instead of with a virtio-mem device, for guests which do not support virtio-mem.\nDIMMs can not be hot unplugged.\n+optional",
 	}
 }
 
@@ -228,10 +237,18 @@ func (Machine) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MachineOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "MachineOptions are rendered as properties of the QEMU machine.\nThey are only supported on amd64. The SMBus controller of q35 can not be\nturned off, as libvirt has no setting for it, and no parallel port is\ncreated, so there is nothing to turn off.",
+		"ps2": "PS2 controls the legacy PS/2 (i8042) controller.\nDefaults to true.\n+optional",
+	}
+}
+
 func (Firmware) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"uuid":       "UUID reported by the vmi bios.\nDefaults to a random generated uid.",
-		"bootloader": "Settings to control the bootloader that is used.\n+optional",
+		"profile":    "Profile is the name of a firmware profile configured in the KubeVirt CR.\nThe profile selects the bootloader, and may provide a custom firmware build\nfor the architecture of the VMI.\n+optional",
+		"bootloader": "Settings to control the bootloader that is used.\nDefaulted from the firmware profile, if set.\n+optional",
 		"serial":     "The system-serial-number in SMBIOS",
 		"kernelBoot": "Settings to set the kernel for booting.\n+optional",
 		"acpi":       "Information that can be set in the ACPI table",
@@ -247,37 +264,79 @@ func (ACPI) SwaggerDoc() map[string]string {
 
 func (Devices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"useVirtioTransitional":      "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
-		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
-		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
-		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
-		"inputs":                     "Inputs describe input devices",
-		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
-		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
-		"autoattachSerialConsole":    "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
-		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
-		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
-		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
-		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
-		"rng":                        "Whether to have random number generator from host\n+optional",
-		"blockMultiQueue":            "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
-		"networkInterfaceMultiqueue": "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
-		"gpus":                       "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
-		"downwardMetrics":            "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
-		"panicDevices":               "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
-		"filesystems":                "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
-		"hostDevices":                "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
-		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
-		"sound":                      "Whether to emulate a sound device.\n+optional",
-		"tpm":                        "Whether to emulate a TPM device.\n+optional",
-		"video":                      "Video describes the video device configuration for the vmi.\n+optional",
+		"useVirtioTransitional":        "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
+		"disableHotplug":               "DisableHotplug disabled the ability to hotplug disks.",
+		"disks":                        "Disks describes disks, cdroms and luns which are connected to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"scsiControllers":              "SCSIControllers describe the virtio-scsi controllers of the vmi. Disks on the\nscsi bus are attached to the controller named in their scsiController field,\nor to the first controller if none is named. Spreading busy disks over several\ncontrollers with dedicated IO threads improves storage throughput.\nDefaults to a single controller if a disk is on the scsi bus or hotplug is enabled.\n+optional\n+listType=map\n+listMapKey=name\n+kubebuilder:validation:MaxItems:=32",
+		"watchdog":                     "Watchdog describes a watchdog device which can be added to the vmi.",
+		"interfaces":                   "Interfaces describe network interfaces which are added to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"inputs":                       "Inputs describe input devices",
+		"autoattachPodInterface":       "Whether to attach a pod network interface. Defaults to true.",
+		"autoattachGraphicsDevice":     "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
+		"graphics":                     "Graphics describes the configuration of the default graphics device.\nNot relevant if autoattachGraphicsDevice is disabled.\n+optional",
+		"autoattachSerialConsole":      "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
+		"logSerialConsole":             "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
+		"serials":                      "Serials describe additional serial ports, e.g. for guests which log\ntheir kernel output to ttyS1. Each port can be connected to with\nvirtctl console --serial.\n+optional\n+listType=map\n+listMapKey=name",
+		"autoattachMemBalloon":         "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
+		"memBalloonVirtioTransitional": "If specified, overrides useVirtioTransitional for the Memory balloon device.\n+optional",
+		"autoattachInputDevice":        "Whether to attach an Input Device.\nDefaults to false.\n+optional",
+		"autoattachVSOCK":              "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
+		"rng":                          "Whether to have random number generator from host\n+optional",
+		"blockMultiQueue":              "Whether or not to enable virtio multi-queue for block devices.\nDefaults to false.\n+optional",
+		"networkInterfaceMultiqueue":   "If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.\n+optional",
+		"gpus":                         "Whether to attach a GPU device to the vmi.\n+optional\n+listType=atomic",
+		"downwardMetrics":              "DownwardMetrics creates a virtio serials for exposing the downward metrics to the vmi.\n+optional",
+		"hostSensors":                  "HostSensors creates a virtio serial for exposing temperature and power readings of the node to the vmi.\n+optional",
+		"sgx":                          "SGX exposes an Intel SGX enclave page cache (EPC) section to the vmi, allowing it to run SGX enclaves.\n+optional",
+		"panicDevices":                 "PanicDevices provides additional crash information when a guest crashes.\n+optional\n+listtype=atomic",
+		"filesystems":                  "Filesystems describes filesystem which is connected to the vmi.\n+optional\n+listType=atomic",
+		"hostDevices":                  "Whether to attach a host device to the vmi.\n+optional\n+listType=atomic",
+		"clientPassthrough":            "To configure and access client devices such as redirecting USB\n+optional",
+		"smartcard":                    "Smartcard attaches a CCID smartcard reader to the vmi.\n+optional",
+		"sound":                        "Whether to emulate a sound device.\n+optional",
+		"tpm":                          "Whether to emulate a TPM device.\n+optional",
+		"video":                        "Video describes the video device configuration for the vmi.\n+optional",
+		"iommu":                        "IOMMU adds a virtual IOMMU to the vmi, allowing the guest to run\nDPDK or to assign devices to nested guests.\n+optional",
+		"sharedMemoryDevices":          "SharedMemoryDevices exposes shared memory regions to the guest, allowing\nco-located VMs or pods to exchange data through them.\n+optional\n+listType=atomic",
+		"channels":                     "Channels adds virtio-serial channels backed by unix sockets, allowing custom\nin-guest agents to talk to sidecars or node agents without networking.\n+optional\n+listType=atomic",
 	}
 }
 
 func (ClientPassthroughDevices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "Represent a subset of client devices that can be accessed by VMI. At the\nmoment only, USB devices using Usbredir's library and tooling. Another fit\nwould be a smartcard with libcacard.\n\nThe struct is currently empty as there is no immediate request for\nuser-facing APIs. This structure simply turns on USB redirection of\nUsbClientPassthroughMaxNumberOf devices.",
+		"":       "Represent a subset of client devices that can be accessed by VMI. At the\nmoment only, USB devices using Usbredir's library and tooling. Another fit\nwould be a smartcard with libcacard.\n\nSetting it turns on USB redirection of up to UsbClientPassthroughMaxNumberOf\ndevices.",
+		"count":  "Count is the number of USB devices which can be redirected at the same time.\nDefaults to UsbClientPassthroughMaxNumberOf.\n+optional",
+		"filter": "Filter restricts the USB devices which can be redirected by their class.\nIt is enforced by virt-handler when proxying the redirection.\n+optional",
+	}
+}
+
+func (USBRedirFilter) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "USBRedirFilter allows or denies the redirection of USB devices based on the\nclasses of the device and of its interfaces. The classes are the ones\nreported by the USB client, the filter does not protect against a client\nwhich misreports them.",
+		"allow": "Allow lists the device classes which can be redirected. If empty, all\ndevice classes which are not denied can be redirected.\n+optional\n+listType=set",
+		"deny":  "Deny lists the device classes which can not be redirected. Deny takes\nprecedence over allow.\n+optional\n+listType=set",
+	}
+}
+
+func (Smartcard) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "Smartcard represents a CCID smartcard reader with a single card.\nOnly one of its members may be specified.",
+		"passthrough":      "Passthrough forwards the smartcard of a client, which connects to the\nsmartcard subresource of the vmi.\n+optional",
+		"hostCertificates": "HostCertificates emulates a smartcard with certificates of an NSS\ndatabase stored in a Secret.\n+optional",
+	}
+}
+
+func (SmartcardPassthrough) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "SmartcardPassthrough forwards the smartcard of a client to the vmi.",
+	}
+}
+
+func (SmartcardHostCertificates) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "SmartcardHostCertificates emulates a smartcard with certificates of an NSS database.",
+		"secretName":   "SecretName is the name of the Secret holding the files of the NSS database,\nfor example cert9.db, key4.db and pkcs11.txt.",
+		"certificates": "Certificates are the nicknames of the three certificates of the emulated card.\n+listType=atomic\n+kubebuilder:validation:MinItems:=3\n+kubebuilder:validation:MaxItems:=3",
 	}
 }
 
@@ -291,25 +350,84 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"enabled":    "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
-		"persistent": "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"enabled":             "Enabled allows a user to explicitly disable the vTPM even when one is enabled by a preference referenced by the VirtualMachine\nDefaults to True",
+		"persistent":          "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"version":             "Version is the version of the emulated TPM, 2.0 or 1.2.\nDefaults to 2.0\n+optional",
+		"encryptionSecretRef": "EncryptionSecretRef references a Secret in the namespace of the VMI.\nIts \"key\" entry holds the passphrase the persistent TPM state is encrypted with.\nIt has to be set before the TPM state is created and requires persistent to be enabled.\n+optional",
+	}
+}
+
+func (IOMMUDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"model":              "Model is the IOMMU model. One of: intel, virtio.\nDefaults to intel on amd64 and to virtio on arm64.\n+optional",
+		"cachingMode":        "CachingMode makes the guest report every mapping change, which is required\nto assign devices to nested guests. Only supported by the intel model.\n+optional",
+		"interruptRemapping": "InterruptRemapping enables remapping of the interrupts of assigned devices.\nOnly supported by the intel model.\n+optional",
+		"eim":                "EIM enables the extended interrupt mode, required by guests with more than 255 vCPUs.\nRequires interruptRemapping.\n+optional",
+	}
+}
+
+func (SharedMemoryDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":             "Name of the shared memory region. Must be a DNS_LABEL and unique within the vmi.\nWithout a hostPath, ivshmem-plain regions are backed by /dev/shm/<name> in the\nvirt-launcher pod and can be shared with its sidecar containers.",
+		"model":            "Model is the shared memory device model. One of: ivshmem-plain, ivshmem-doorbell.\nDefaults to ivshmem-plain.\n+optional",
+		"size":             "Size of the shared memory region. Must be a power of two.\nRequired for ivshmem-plain. Not supported by ivshmem-doorbell, where the\nserver owns the region.\n+optional",
+		"hostPath":         "HostPath is a file on the node backing an ivshmem-plain region, shared with\nevery VM and pod on the node mapping the same file. It is created if it\ndoes not exist and has to be writable by the qemu user.\n+optional",
+		"serverSocketPath": "ServerSocketPath is the path on the node of the ivshmem-server socket an\nivshmem-doorbell device connects to. Required for ivshmem-doorbell.\n+optional",
+	}
+}
+
+func (Channel) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":     "Name of the channel. Must be a DNS_LABEL and unique within the vmi.\nThe channel socket is named <name>.sock.",
+		"target":   "Target is the virtio-serial port name the guest sees, for example org.example.agent.0.\nIn Linux guests the port shows up as /dev/virtio-ports/<target>.",
+		"hostPath": "HostPath is a directory on the node the channel socket is created in.\nBy default the socket is created in /var/run/kubevirt-channels, a pod-local\ndirectory shared with the hook sidecars of the vmi.\n+optional",
 	}
 }
 
 func (VideoDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"type": "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).\nIf not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).\n+optional",
+		"type":       "Type specifies the video device type (e.g., virtio, vga, bochs, ramfb).\nIf not specified, the default is architecture-dependent (VGA for BIOS-based VMs, Bochs for EFI-based VMs on AMD64; virtio for Arm and s390x).\n+optional",
+		"resolution": "Resolution is the preferred resolution advertised to the guest through EDID.\nGraphical consoles come up at this resolution if the guest display driver honors it.\nSupported by the vga, virtio and bochs video types.\n+optional",
+	}
+}
+
+func (VideoResolution) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VideoResolution is a display resolution in pixels.",
+		"width":  "Width in pixels.",
+		"height": "Height in pixels.",
+	}
+}
+
+func (GraphicsDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"keymap": "Keymap is the keyboard layout VNC clients are assumed to use, e.g. de or fr-ch.\nIt is only needed for VNC clients which send keysyms instead of raw keycodes.\nDefaults to en-us.\n+optional",
 	}
 }
 
 func (Input) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"bus":  "Bus indicates the bus of input device to emulate.\nSupported values: virtio, usb.",
-		"type": "Type indicated the type of input device.\nSupported values: tablet.",
+		"type": "Type indicated the type of input device.\nA tablet is an absolute pointer, a mouse a relative one.\nMultitouch devices are only supported on the virtio bus.\nSupported values: tablet, keyboard, mouse, multitouch.",
 		"name": "Name is the device name",
 	}
 }
 
+func (SerialPort) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name": "Name of the serial port.",
+		"port": "Port is the index of the serial port in the guest, e.g. 1 for ttyS1.\nPort 0 is taken by the default serial console, unless\nautoattachSerialConsole is disabled.",
+	}
+}
+
+func (SCSIController) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":              "Name of the controller, referenced by the scsiController field of disks.",
+		"queues":            "Queues is the number of request queues of the controller.\nDefaults to the number of vCPUs if blockMultiQueue is enabled.\n+optional",
+		"dedicatedIOThread": "DedicatedIOThread indicates the controller should have an exclusive IO thread,\nshared by all disks attached to it. Defaults to false.\n+optional",
+	}
+}
+
 func (Filesystem) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":     "Name is the device name",
@@ -318,13 +436,47 @@ func (Filesystem) SwaggerDoc() map[string]string {
 }
 
 func (FilesystemVirtiofs) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"":               "FilesystemVirtiofs configures the virtiofsd which serves the filesystem.\nThe sandbox of virtiofsd is not configurable: virtiofsd runs without privileges\nin its own container, which isolates it from the host, and it cannot set up\na namespace or chroot sandbox there.",
+		"queueSize":      "QueueSize is the size of the virtqueue of the device.\nMust be a power of two not larger than 1024. Defaults to 1024.\n+optional",
+		"threadPoolSize": "ThreadPoolSize is the number of threads virtiofsd uses to handle the\nrequests of the guest. 0 handles them in the thread of the queue.\nDefaults to the default of virtiofsd.\n+optional",
+		"idMap":          "IDMap maps the user and group IDs of the guest to the IDs owning the\nfiles of the shared volume.\n+optional",
+	}
+}
+
+func (FilesystemIDMap) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"uid": "UID maps ranges of user IDs.\n+optional\n+listType=atomic",
+		"gid": "GID maps ranges of group IDs.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FilesystemIDMapRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"start":  "Start is the first ID of the range in the guest.",
+		"target": "Target is the first ID of the range on the shared volume.",
+		"count":  "Count is the number of IDs in the range.",
+	}
 }
 
 func (DownwardMetrics) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (HostSensors) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "HostSensors exposes readings of the hardware monitoring sensors of the node.",
+		"types": "Types are the kinds of sensors exposed to the vmi.\nDefaults to all of them.\n+optional\n+listType=set",
+	}
+}
+
+func (SGX) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "SGX configures the Intel Software Guard Extensions of the vmi.",
+		"epcSize": "EPCSize is the size of the enclave page cache section backing the enclaves of the vmi.\nIt is taken from the EPC of the node, which is not part of its memory.",
+	}
+}
+
 func (GPU) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":       "Name of the GPU device as exposed by a device plugin",
@@ -369,17 +521,49 @@ func (Disk) SwaggerDoc() map[string]string {
 		"name":                 "Name is the device name",
 		"bootOrder":            "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach disk or interface that has a boot order must have a unique value.\nDisks without a boot order are not tried if a disk with a boot order exists.\n+optional",
 		"serial":               "Serial provides the ability to specify a serial number for the disk device.\n+optional",
+		"serialPolicy":         "SerialPolicy defines how a serial number is chosen for a disk which does not specify one.\nSupported values are: None, VolumeNameHash.\nVolumeNameHash derives the serial number from the name of the volume, so that it stays the same\nwhen disks are reordered. Defaults to None.\n+optional",
+		"wwn":                  "WWN provides the World Wide Name of the disk device, 16 hexadecimal digits.\nOnly supported on the scsi bus.\n+optional",
 		"dedicatedIOThread":    "dedicatedIOThread indicates this disk should have an exclusive IO Thread.\nEnabling this implies useIOThreads = true.\nDefaults to false.\n+optional",
+		"scsiController":       "SCSIController is the name of the scsi controller the disk is attached to.\nOnly supported on the scsi bus. Defaults to the first scsi controller.\n+optional",
+		"queues":               "Queues is the number of queues of the disk. If specified, it overrides the\nnumber of queues derived from blockMultiQueue for this disk.\nOnly supported on the virtio bus.\n+optional",
 		"cache":                "Cache specifies which kvm disk cache mode should be used.\nSupported values are:\nnone: Guest I/O not cached on the host, but may be kept in a disk cache.\nwritethrough: Guest I/O cached on the host but written through to the physical medium. Slowest but with most guarantees.\nwriteback: Guest I/O cached on the host.\nDefaults to none if the storage supports O_DIRECT, otherwise writethrough.\n+optional",
 		"io":                   "IO specifies which QEMU disk IO mode should be used.\nSupported values are: native, default, threads.\n+optional",
 		"tag":                  "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
 		"blockSize":            "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"rerrorPolicy":         "If specified, it changes the error policy for read errors of the disk.\nValid values are stop, ignore and report. Defaults to the error policy of the disk.\n+optional",
+		"ioTune":               "IOTune throttles the IO of the disk. The limits are enforced by QEMU.\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
 	}
 }
 
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTune limits the throughput and the IO operations per second of a disk.\nA total limit can not be combined with a read or write limit of the same kind.",
+		"totalBytesSec": "TotalBytesSec limits the total throughput in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec limits the read throughput in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec limits the write throughput in bytes per second.\n+optional",
+		"totalIOPSSec":  "TotalIOPSSec limits the total IO operations per second.\n+optional",
+		"readIOPSSec":   "ReadIOPSSec limits the read IO operations per second.\n+optional",
+		"writeIOPSSec":  "WriteIOPSSec limits the write IO operations per second.\n+optional",
+		"burst":         "Burst allows the disk to exceed the limits for a short time.\n+optional",
+	}
+}
+
+func (DiskIOTuneBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTuneBurst defines the limits applied while the disk bursts. Each burst\nlimit requires the corresponding limit of DiskIOTune and must not be lower.",
+		"totalBytesSec": "TotalBytesSec limits the total throughput in bytes per second during a burst.\n+optional",
+		"readBytesSec":  "ReadBytesSec limits the read throughput in bytes per second during a burst.\n+optional",
+		"writeBytesSec": "WriteBytesSec limits the write throughput in bytes per second during a burst.\n+optional",
+		"totalIOPSSec":  "TotalIOPSSec limits the total IO operations per second during a burst.\n+optional",
+		"readIOPSSec":   "ReadIOPSSec limits the read IO operations per second during a burst.\n+optional",
+		"writeIOPSSec":  "WriteIOPSSec limits the write IO operations per second during a burst.\n+optional",
+		"lengthSeconds": "LengthSeconds is the maximum duration of a burst in seconds.\nDefaults to 1.\n+optional",
+	}
+}
+
 func (CustomBlockSize) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "CustomBlockSize represents the desired logical and physical block size for a VM disk.",
@@ -403,9 +587,10 @@ func (DiskDevice) SwaggerDoc() map[string]string {
 
 func (DiskTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":        "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb.",
-		"readonly":   "ReadOnly.\nDefaults to false.",
-		"pciAddress": "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"bus":                "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi, usb, nvme.",
+		"readonly":           "ReadOnly.\nDefaults to false.",
+		"pciAddress":         "If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"virtioTransitional": "If specified, overrides useVirtioTransitional for this disk.\nOnly applies to the virtio bus.\n+optional",
 	}
 }
 
@@ -437,10 +622,37 @@ func (SEVSNP) SwaggerDoc() map[string]string {
 }
 
 func (SEVAttestation) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"keyBroker": "If specified, the launch secret is released by the key broker once it\nverified the launch measurement, and the VMI is unpaused afterwards.\nOtherwise the launch secret has to be injected through the\nsev/injectlaunchsecret subresource.\n+optional",
+	}
+}
+
+func (KeyBroker) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "KeyBroker describes an external key broker service, which verifies the\nattestation evidence of a guest and releases secrets to it.",
+		"protocol":     "Protocol spoken with the key broker.\nDefaults to kbs.\n+optional",
+		"url":          "URL of the key broker service.",
+		"resourcePath": "Path of the resource holding the launch secret, e.g. default/sev/launch-secret.",
+	}
 }
 
 func (TDX) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"policy":        "Guest policy flags as defined in the Intel TDX module specification.\nNote: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.\n+optional",
+		"mrConfigId":    "Base64 encoded SHA384 digest of the guest owner's configuration (MRCONFIGID).\n+optional",
+		"mrOwner":       "Base64 encoded SHA384 digest identifying the guest owner (MROWNER).\n+optional",
+		"mrOwnerConfig": "Base64 encoded SHA384 digest of the guest owner's owner-defined configuration (MROWNERCONFIG).\n+optional",
+		"attestation":   "If specified, the guest can request quotes from the quote generation service of the node.\n+optional",
+	}
+}
+
+func (TDXPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"septVEDisable": "Disable EPT violation #VE conversion for the guest (SEPT_VE_DISABLE).\nDefaults to false.\n+optional",
+	}
+}
+
+func (TDXAttestation) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
@@ -493,6 +705,9 @@ func (HotplugVolumeSource) SwaggerDoc() map[string]string {
 		"":                      "HotplugVolumeSource Represents the source of a volume to mount which are capable\nof being hotplugged on a live running VMI.\nOnly one of its members may be specified.",
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
 		"dataVolume":            "DataVolume represents the dynamic creation a PVC for this volume as well as\nthe process of populating that PVC with a disk image.\n+optional",
+		"configMap":             "ConfigMap represents a reference to a ConfigMap in the same namespace, which is attached\nto the vmi as an iso image.\n+optional",
+		"secret":                "Secret represents a reference to a Secret in the same namespace, which is attached\nto the vmi as an iso image.\n+optional",
+		"serviceAccount":        "ServiceAccount represents a reference to a service account, whose token is attached\nto the vmi as an iso image.\n+optional",
 	}
 }
 
@@ -511,7 +726,9 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format of the memory dump, Core by default\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -563,6 +780,14 @@ func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
 		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"ptp":   "PTP exposes the host clock to the guest as the PTP hardware clock of the ptp_kvm driver,\nwhich guests can discipline their clock to with sub-microsecond accuracy.\n+optional",
+	}
+}
+
+func (PTPClock) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "PTPClock is the PTP hardware clock (PHC) of the ptp_kvm driver of the guest.",
+		"enabled": "Enabled set to false makes sure that a preference can't add the clock.\nDefaults to true.\n+optional",
 	}
 }
 
@@ -683,14 +908,25 @@ func (FeatureHyperv) SwaggerDoc() map[string]string {
 
 func (FeatureKVM) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"hidden": "Hide the KVM hypervisor from standard MSR based discovery.\nDefaults to false",
+		"hidden":        "Hide the KVM hypervisor from standard MSR based discovery.\nDefaults to false",
+		"hintDedicated": "HintDedicated tells the guest that its vCPUs run on dedicated host CPUs, so it can skip\nparavirtual optimizations meant for overcommitted hosts, like paravirtual spinlocks.\nOnly supported on amd64.\nDefaults to true with dedicated CPU placement on amd64, false otherwise.\n+optional",
+		"pollControl":   "PollControl allows the guest to take over halt polling from the host, e.g. with the\ncpuidle-haltpoll driver, lowering the vCPU wakeup latency at the cost of CPU time.\nThe halt_poll_ns of the host is a kvm module parameter shared by all VMs and is not tuned per VM.\nOnly supported on amd64.\nDefaults to false\n+optional",
 	}
 }
 
 func (Watchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":     "Named watchdog device.",
-		"name": "Name of the watchdog.",
+		"":            "Named watchdog device.",
+		"name":        "Name of the watchdog.",
+		"remediation": "Remediation is carried out by KubeVirt once the watchdog expired,\nin addition to the action taken by the watchdog device itself.\n+optional",
+	}
+}
+
+func (WatchdogRemediation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "WatchdogRemediation configures the reaction of KubeVirt to an expired guest watchdog.",
+		"type":                "Type of the remediation. One of: Reset, RestartVM, MemoryDump.",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to.\nRequired by the MemoryDump remediation, which only applies to VMIs owned by a VirtualMachine.\n+optional",
 	}
 }
 
@@ -705,30 +941,43 @@ func (WatchdogDevice) SwaggerDoc() map[string]string {
 func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "i6300esb watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, pause, none.\nDefaults to reset.",
 	}
 }
 
 func (Diag288Watchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "diag288 watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, pause, none.\nDefaults to reset.",
 	}
 }
 
 func (Interface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
-		"model":       "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
-		"binding":     "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
-		"ports":       "List of ports to be forwarded to the virtual machine.",
-		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
-		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
-		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
-		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
-		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
-		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"name":               "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
+		"model":              "Interface model.\nOne of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
+		"binding":            "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\nversion: 1alphav1",
+		"ports":              "List of ports to be forwarded to the virtual machine.",
+		"macAddress":         "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
+		"bootOrder":          "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
+		"pciAddress":         "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
+		"dhcpOptions":        "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
+		"tag":                "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"acpiIndex":          "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
+		"virtioTransitional": "If specified, overrides useVirtioTransitional for this interface.\nOnly applies to the virtio model.\n+optional",
+		"backend":            "Backend selects the host side backend of the interface.\nOne of: vhost, userspace.\nDefaults to vhost when /dev/vhost-net is available on the node, userspace otherwise.\nOnly applies to the virtio model.\n+optional",
+		"queues":             "Queues is the number of queues of the interface. If specified, it overrides the\nnumber of queues derived from networkInterfaceMultiqueue for this interface.\nOnly applies to the virtio model.\n+optional",
+		"offloads":           "Offloads toggles the offloads of the interface on the tap path.\nOnly applies to the virtio model.\n+optional",
+		"state":              "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+	}
+}
+
+func (InterfaceOffloads) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceOffloads toggles offloads between the guest and the host.\nOffloads which are not set are enabled when the guest driver supports them.",
+		"checksum": "Checksum toggles the checksum offload.\n+optional",
+		"tso":      "TSO toggles the TCP segmentation offload, for IPv4 and IPv6.\n+optional",
+		"gso":      "GSO toggles the generic segmentation offload of the host.\n+optional",
 	}
 }
 
@@ -779,7 +1028,16 @@ func (InterfaceMasquerade) SwaggerDoc() map[string]string {
 
 func (InterfaceSRIOV) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "InterfaceSRIOV connects to a given network by passing-through an SR-IOV PCI device via vfio.",
+		"":         "InterfaceSRIOV connects to a given network by passing-through an SR-IOV PCI device via vfio.",
+		"failover": "Failover pairs the SR-IOV device with a standby virtio interface, which shares the same\nMAC address and takes over the traffic while the device is unplugged for live migration.\n+optional",
+	}
+}
+
+func (InterfaceSRIOVFailover) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "InterfaceSRIOVFailover configures the virtio-net failover pairing of an SR-IOV interface.\nEither StandbyNetworkName or StandbyInterface must be set.",
+		"standbyInterface":   "StandbyInterface is the name of the bridge-bound virtio interface which backs the\nSR-IOV device. It must have the same MAC address as the SR-IOV interface.\nIt is set to the generated standby interface when StandbyNetworkName is used.\n+optional",
+		"standbyNetworkName": "StandbyNetworkName is the Multus network of the standby interface, which is generated\nwhen the VMI is created. The standby interface gets the MAC address of the SR-IOV\ninterface, which is generated if it is not set.\n+optional",
 	}
 }
 
@@ -86,6 +86,22 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
 
+	// If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the
+	// cluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.
+	// The keys have to be allowed in launcherPodConfiguration.
+	// +optional
+	LauncherMetadata *LauncherMetadata `json:"launcherMetadata,omitempty"`
+
+	// LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM
+	// the pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.
+	// Defaults to Container.
+	// +optional
+	LauncherIsolation LauncherIsolation `json:"launcherIsolation,omitempty"`
+
 	// Specification of the desired behavior of the VirtualMachineInstance on the host.
 	Domain DomainSpec `json:"domain"`
 	// NodeSelector is a selector which must be true for the vmi to fit on a node.
@@ -194,8 +210,56 @@ type VirtualMachineInstanceSpec struct {
 	// +listMapKey=name
 	// +optional
 	UtilityVolumes []UtilityVolume `json:"utilityVolumes,omitempty"`
+	// DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem
+	// is filling up. The utilization of the guest filesystems is reported by the guest agent.
+	// +optional
+	DiskSpaceLow *DiskSpaceLowThreshold `json:"diskSpaceLow,omitempty"`
+	// GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,
+	// without writing them to any disk image.
+	// +kubebuilder:validation:MaxItems:=32
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	GuestSecrets []GuestSecret `json:"guestSecrets,omitempty"`
 }
 
+// DiskSpaceLowThreshold configures when a guest filesystem is considered low on space
+type DiskSpaceLowThreshold struct {
+	// UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow
+	// condition is reported. Defaults to 90.
+	// +optional
+	UsedPercentage *int32 `json:"usedPercentage,omitempty"`
+	// HysteresisPercentage is how far the utilization of all the guest filesystems has to drop
+	// below UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.
+	// +optional
+	HysteresisPercentage *int32 `json:"hysteresisPercentage,omitempty"`
+}
+
+// GuestSecret delivers the value of a key of a Secret to the guest.
+type GuestSecret struct {
+	// Name of the guest secret, unique within the VMI. It names the fw_cfg blob.
+	Name string `json:"name"`
+	// SecretName is the name of the Secret in the namespace of the VMI.
+	SecretName string `json:"secretName"`
+	// Key of the Secret whose value is delivered.
+	Key string `json:"key"`
+	// Target selects how the secret is delivered to the guest. FWCfg exposes the secret as
+	// the fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated
+	// secret is delivered on the next start.
+	// Defaults to FWCfg.
+	// +optional
+	Target GuestSecretTarget `json:"target,omitempty"`
+}
+
+// GuestSecretTarget is the way a guest secret is delivered to the guest
+// +kubebuilder:validation:Enum=FWCfg
+type GuestSecretTarget string
+
+const (
+	// GuestSecretTargetFWCfg exposes a guest secret as a fw_cfg blob
+	GuestSecretTargetFWCfg GuestSecretTarget = "FWCfg"
+)
+
 func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
 	type VMISpecAlias VirtualMachineInstanceSpec
 	var vmiSpecAlias VMISpecAlias
@@ -335,6 +399,29 @@ type VirtualMachineInstanceStatus struct {
 	// +nullable
 	// +optional
 	ChangedBlockTracking *ChangedBlockTrackingStatus `json:"changedBlockTracking,omitempty" optional:"true"`
+
+	// PauseStatus reports what paused the VirtualMachineInstance and whether
+	// it is going to be unpaused automatically. It is only set while the
+	// VirtualMachineInstance is paused.
+	// +optional
+	PauseStatus *VirtualMachineInstancePauseStatus `json:"pauseStatus,omitempty"`
+
+	// DeviceStatuses reflects the libvirt state of every disk and interface
+	// of the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	DeviceStatuses []VirtualMachineInstanceDeviceStatus `json:"deviceStatuses,omitempty"`
+
+	// GuestFilesystems reports the utilization of the guest filesystems as reported by the guest agent.
+	// Changes of the utilization are only reflected once they amount to one percent of the capacity.
+	// +optional
+	// +listType=atomic
+	GuestFilesystems []VirtualMachineInstanceGuestFilesystem `json:"guestFilesystems,omitempty"`
+
+	// DiskExpansionRequests lists the disk growths requested by the guest over VSOCK, one per volume.
+	// +optional
+	// +listType=atomic
+	DiskExpansionRequests []DiskExpansionRequest `json:"diskExpansionRequests,omitempty"`
 }
 
 // DeviceStatus has the information of all devices allocated spec.domain.devices
@@ -382,6 +469,89 @@ type DeviceAttribute struct {
 	MDevUUID *string `json:"mDevUUID,omitempty"`
 }
 
+// DeviceType is the kind of a device reported in status.deviceStatuses
+type DeviceType string
+
+const (
+	DeviceTypeDisk      DeviceType = "Disk"
+	DeviceTypeInterface DeviceType = "Interface"
+)
+
+// DeviceLinkState is the link state of an interface
+type DeviceLinkState string
+
+const (
+	DeviceLinkStateUp   DeviceLinkState = "up"
+	DeviceLinkStateDown DeviceLinkState = "down"
+)
+
+// VirtualMachineInstanceDeviceStatus reflects the libvirt state of a device
+type VirtualMachineInstanceDeviceStatus struct {
+	// Name of the device as specified in spec.domain.devices.disks or spec.domain.devices.interfaces
+	Name string `json:"name"`
+	// Type is the kind of the device
+	Type DeviceType `json:"type"`
+	// Attached indicates whether the device is present in the running domain
+	Attached bool `json:"attached"`
+	// HotplugPending indicates that the device was requested but is not attached yet
+	// +optional
+	HotplugPending bool `json:"hotplugPending,omitempty"`
+	// IOErrors is the number of I/O errors reported by the hypervisor for a disk
+	// +optional
+	IOErrors int64 `json:"ioErrors,omitempty"`
+	// LastIOError is the reason of the last I/O error reported for a disk
+	// +optional
+	LastIOError string `json:"lastIOError,omitempty"`
+	// LinkState is the link state of an interface
+	// +optional
+	LinkState DeviceLinkState `json:"linkState,omitempty"`
+	// PCIAddress is the guest PCI address assigned to the device, e.g. 0000:01:00.0
+	// +optional
+	PCIAddress string `json:"pciAddress,omitempty"`
+}
+
+// DiskExpansionRequest is a request of the guest to grow the PVC backing one of its volumes
+type DiskExpansionRequest struct {
+	// Name of the volume to expand
+	Name string `json:"name"`
+	// Size is the requested size of the PVC
+	Size resource.Quantity `json:"size"`
+	// Phase is the progress of the request
+	// +optional
+	Phase DiskExpansionRequestPhase `json:"phase,omitempty"`
+	// Message explains why the request was rejected
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// DiskExpansionRequestPhase is the progress of a DiskExpansionRequest
+type DiskExpansionRequestPhase string
+
+const (
+	// DiskExpansionRequestPending means that the request was not processed by virt-controller yet
+	DiskExpansionRequestPending DiskExpansionRequestPhase = "Pending"
+	// DiskExpansionRequestAccepted means that the PVC was expanded to the requested size
+	DiskExpansionRequestAccepted DiskExpansionRequestPhase = "Accepted"
+	// DiskExpansionRequestRejected means that the request exceeds the quota or the PVC could not be expanded
+	DiskExpansionRequestRejected DiskExpansionRequestPhase = "Rejected"
+)
+
+// VirtualMachineInstanceGuestFilesystem reports the utilization of a guest filesystem
+type VirtualMachineInstanceGuestFilesystem struct {
+	// MountPoint of the filesystem in the guest
+	MountPoint string `json:"mountPoint"`
+	// DiskName is the name of the guest device holding the filesystem
+	// +optional
+	DiskName string `json:"diskName,omitempty"`
+	// FileSystemType is the type of the filesystem, e.g. ext4
+	// +optional
+	FileSystemType string `json:"fileSystemType,omitempty"`
+	// UsedBytes is the used capacity of the filesystem
+	UsedBytes int64 `json:"usedBytes"`
+	// TotalBytes is the capacity of the filesystem
+	TotalBytes int64 `json:"totalBytes"`
+}
+
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
 type StorageMigratedVolumeInfo struct {
 	// VolumeName is the name of the volume that is being migrated
@@ -496,6 +666,9 @@ type HotplugVolumeStatus struct {
 type ContainerDiskInfo struct {
 	// Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk
 	Checksum uint32 `json:"checksum,omitempty"`
+	// ImageID is the image of the containerdisk pinned to the digest pulled by the container runtime
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
 }
 
 // VolumePhase indicates the current phase of the hotplug process.
@@ -600,6 +773,15 @@ func (v *VirtualMachineInstance) IsRealtimeEnabled() bool {
 	return v.Spec.Domain.CPU != nil && v.Spec.Domain.CPU.Realtime != nil
 }
 
+func (v *VirtualMachineInstance) IsNestedVirtualizationEnabled() bool {
+	return v.Spec.Domain.CPU != nil && v.Spec.Domain.CPU.NestedVirtualization != nil && *v.Spec.Domain.CPU.NestedVirtualization
+}
+
+// IsEmulationEnabled returns true if the VMI explicitly requests software emulation
+func (v *VirtualMachineInstance) IsEmulationEnabled() bool {
+	return v.Spec.Domain.UseEmulation != nil && *v.Spec.Domain.UseEmulation
+}
+
 // IsHighPerformanceVMI returns true if the VMI is considered as high performance.
 // A VMI is considered as high performance if one of the following is true:
 // - the vmi requests a dedicated cpu
@@ -706,6 +888,10 @@ const (
 	// If the VMI was paused by the user, this is reported as true.
 	VirtualMachineInstancePaused VirtualMachineInstanceConditionType = "Paused"
 
+	// Reflects the automatic resume of a VMI paused on an IO error. True while resume
+	// attempts are ongoing, false once they were exhausted.
+	VirtualMachineInstanceIOErrorRecovery VirtualMachineInstanceConditionType = "IOErrorRecovery"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceAgentConnected VirtualMachineInstanceConditionType = "AgentConnected"
 
@@ -738,6 +924,42 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceSoftwareEmulation indicates that the VMI runs with software emulation instead of KVM
+	VirtualMachineInstanceSoftwareEmulation VirtualMachineInstanceConditionType = "SoftwareEmulation"
+
+	// VirtualMachineInstanceDomainDrift indicates that the live domain deviates from the domain generated for the VMI
+	VirtualMachineInstanceDomainDrift VirtualMachineInstanceConditionType = "DomainDrift"
+
+	// VirtualMachineInstanceGuestWatchdogExpired indicates that the watchdog device of the guest expired
+	VirtualMachineInstanceGuestWatchdogExpired VirtualMachineInstanceConditionType = "GuestWatchdogExpired"
+
+	// VirtualMachineInstanceDiskSpaceLow indicates that a guest filesystem exceeds the utilization set in spec.diskSpaceLow
+	VirtualMachineInstanceDiskSpaceLow VirtualMachineInstanceConditionType = "DiskSpaceLow"
+
+	// VirtualMachineInstanceDiskExpansionPending indicates that a PVC was expanded but the guest only sees the new size after a restart
+	VirtualMachineInstanceDiskExpansionPending VirtualMachineInstanceConditionType = "DiskExpansionPending"
+
+	// VirtualMachineInstanceContainerDiskVerificationFailed indicates that the signature of a containerdisk image
+	// could not be verified against the keys of the cluster
+	VirtualMachineInstanceContainerDiskVerificationFailed VirtualMachineInstanceConditionType = "ContainerDiskVerificationFailed"
+
+	// VirtualMachineInstanceVolumeScanFailed indicates that the volume scanner of the cluster rejected one of the volumes
+	VirtualMachineInstanceVolumeScanFailed VirtualMachineInstanceConditionType = "VolumeScanFailed"
+
+	// VirtualMachineInstanceStorageDegraded indicates that the storage of the VMI is impaired, the reason tells
+	// whether the VMI is paused on an IO error or a guest filesystem is running out of space
+	VirtualMachineInstanceStorageDegraded VirtualMachineInstanceConditionType = "StorageDegraded"
+
+	// VirtualMachineInstanceNetworkDegraded indicates that a network interface of the VMI is not operational
+	VirtualMachineInstanceNetworkDegraded VirtualMachineInstanceConditionType = "NetworkDegraded"
+
+	// VirtualMachineInstanceGuestAgentOutdated indicates that the connected guest agent is too old to be used
+	VirtualMachineInstanceGuestAgentOutdated VirtualMachineInstanceConditionType = "GuestAgentOutdated"
+
+	// VirtualMachineInstanceMigrationBlocked indicates that the VMI has to be migrated, because it is evicted or
+	// a live update requires it, but it is not live migratable. The reason is the one of the LiveMigratable condition.
+	VirtualMachineInstanceMigrationBlocked VirtualMachineInstanceConditionType = "MigrationBlocked"
 )
 
 // These are valid reasons for VMI conditions.
@@ -760,12 +982,16 @@ const (
 	VirtualMachineInstanceReasonSecureExecutionNotMigratable = "SecureExecutionNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses Intel Trust Domain Extensions (TDX)
 	VirtualMachineInstanceReasonTDXNotMigratable = "TDXNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses Intel Software Guard Extensions (SGX)
+	VirtualMachineInstanceReasonSGXNotMigratable = "SGXNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
 	VirtualMachineInstanceReasonNoTSCFrequencyMigratable = "NoTSCFrequencyNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
 	VirtualMachineInstanceReasonHypervPassthroughNotMigratable = "HypervPassthroughNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it requested SCSI persitent reservation
 	VirtualMachineInstanceReasonPRNotMigratable = "PersistentReservationNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses shared memory devices
+	VirtualMachineInstanceReasonSharedMemoryNotMigratable = "SharedMemoryNotLiveMigratable"
 	// Reason means that not all of the VMI's DVs are ready
 	VirtualMachineInstanceReasonNotAllDVsReady = "NotAllDVsReady"
 	// Reason means that all of the VMI's DVs are bound and ready
@@ -783,6 +1009,39 @@ const (
 
 	// Indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceReasonEvictionRequested = "EvictionRequested"
+
+	// Indicates that the VMI runs with TCG because KVM is not used
+	VirtualMachineInstanceReasonSoftwareEmulation = "SoftwareEmulation"
+
+	// Indicates that the live domain was modified outside of KubeVirt, e.g. by a hook sidecar or through virsh
+	VirtualMachineInstanceReasonDomainDrifted = "DomainDrifted"
+
+	// Indicates that the guest stopped petting its watchdog device
+	VirtualMachineInstanceReasonGuestWatchdogExpired = "GuestWatchdogExpired"
+
+	// Indicates that at least one guest filesystem is filling up
+	VirtualMachineInstanceReasonGuestFilesystemFull = "GuestFilesystemFull"
+
+	// Indicates that a disk could not be expanded while the VMI is running
+	VirtualMachineInstanceReasonDiskExpansionRequiresRestart = "RestartRequired"
+
+	// Indicates that a containerdisk image is not signed by any of the keys of the cluster
+	VirtualMachineInstanceReasonSignatureMismatch = "SignatureMismatch"
+
+	// Indicates that the volume scanner of the cluster rejected a volume before the first boot
+	VirtualMachineInstanceReasonVolumeRejected = "VolumeRejected"
+
+	// Indicates that the VMI is paused because its storage reported an IO error
+	VirtualMachineInstanceReasonIOError = "IOError"
+
+	// Indicates that the link of a network interface is down although it was not requested in the spec
+	VirtualMachineInstanceReasonInterfaceLinkDown = "InterfaceLinkDown"
+
+	// Indicates that the version of the guest agent does not match any of the supported versions
+	VirtualMachineInstanceReasonGuestAgentVersionNotSupported = "GuestAgentVersionNotSupported"
+
+	// Indicates that the guest agent lacks commands required by KubeVirt
+	VirtualMachineInstanceReasonGuestAgentCommandsMissing = "GuestAgentCommandsMissing"
 )
 
 const (
@@ -887,6 +1146,8 @@ type VirtualMachineInstanceNetworkInterface struct {
 	QueueCount int32 `json:"queueCount,omitempty"`
 	// LinkState Reports the current operational link state`. values: up, down.
 	LinkState string `json:"linkState,omitempty"`
+	// Backend reports the host side backend used by the interface. values: vhost, userspace.
+	Backend InterfaceBackend `json:"backend,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -1080,6 +1341,28 @@ const (
 	LiveMigration VirtualMachineInstanceMigrationMethod = "LiveMigration"
 )
 
+// LauncherMetadata holds labels and annotations for the virt-launcher pod
+type LauncherMetadata struct {
+	// Labels to add to the virt-launcher pod
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations to add to the virt-launcher pod
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// LauncherIsolation is the isolation of the virt-launcher pod from the host
+// +kubebuilder:validation:Enum=Container;MicroVM
+type LauncherIsolation string
+
+const (
+	// LauncherIsolationContainer runs the virt-launcher pod as a regular container
+	LauncherIsolationContainer LauncherIsolation = "Container"
+	// LauncherIsolationMicroVM runs the virt-launcher pod in a micro VM, qemu runs
+	// with nested virtualization inside of it
+	LauncherIsolationMicroVM LauncherIsolation = "MicroVM"
+)
+
 // VirtualMachineInstancePhase is a label for the condition of a VirtualMachineInstance at the current time.
 type VirtualMachineInstancePhase string
 
@@ -1147,6 +1430,14 @@ const (
 	// Similar to kubevirt.io/domain. Used on Pod.
 	// Internal use only.
 	CreatedByLabel string = "kubevirt.io/created-by"
+	// This label names the launcher warm pool an idle virt-launcher pod belongs to.
+	// Used on Pod.
+	// Internal use only.
+	LauncherWarmPoolLabel string = "kubevirt.io/launcher-warm-pool"
+	// This annotation hands the VMI which adopted an idle virt-launcher pod of a
+	// launcher warm pool to virt-launcher, as <namespace>/<name>/<uid>. Used on Pod.
+	// Internal use only.
+	LauncherWarmPoolIdentityAnnotation string = "kubevirt.io/launcher-warm-pool-identity"
 	// This label is used to indicate that this pod is the target of a migration job.
 	MigrationJobLabel string = "kubevirt.io/migrationJobUID"
 	// This label indicates the migration name that a PDB is protecting.
@@ -1241,6 +1532,8 @@ const (
 	CPUModelLabel                  = "cpu-model.node.kubevirt.io/"
 	SupportedHostModelMigrationCPU = "cpu-model-migration.node.kubevirt.io/"
 	CPUTimerLabel                  = "cpu-timer.node.kubevirt.io/"
+	// This label represents the core types of a node with hybrid CPUs
+	CPUCoreTypeLabel = "cpu-core-type.node.kubevirt.io/"
 	// This label represents supported HyperV features on the node
 	HypervLabel = "hyperv.node.kubevirt.io/"
 	// This label represents vendor of cpu model on the node
@@ -1292,9 +1585,32 @@ const (
 	// For more info: https://libvirt.org/kbase/debuglogs.html
 	CustomLibvirtLogFiltersAnnotation string = "kubevirt.io/libvirt-log-filters"
 
+	// ReconcileDomainDriftAnnotation allows virt-launcher to restore drifted properties of the live domain
+	// which can be changed without impact on the guest, like the vCPU and emulator thread pinning.
+	ReconcileDomainDriftAnnotation string = "kubevirt.io/reconcile-domain-drift"
+
+	// AllowGuestDiskExpansionAnnotation allows the guest to request the growth of its PVCs over VSOCK
+	// when set to "true". The growth is capped by the guestDiskExpansion quota of the cluster.
+	AllowGuestDiskExpansionAnnotation string = "kubevirt.io/allow-guest-disk-expansion"
+
 	// RealtimeLabel marks the node as capable of running realtime workloads
 	RealtimeLabel string = "kubevirt.io/realtime"
 
+	// RealtimeReadyLabel marks a realtime capable node which meets all requirements to run realtime
+	// workloads with low latency
+	RealtimeReadyLabel string = "kubevirt.io/realtime-ready"
+
+	// RealtimeUnmetRequirementsAnnotation lists why a node is not ready to run realtime workloads
+	// with low latency, e.g. missing isolcpus or hugepages. It is removed once the node is ready.
+	RealtimeUnmetRequirementsAnnotation string = "kubevirt.io/realtime-unmet-requirements"
+
+	// NestedVirtualizationLabel marks the node as capable of running nested guests
+	NestedVirtualizationLabel string = "kubevirt.io/nested-virtualization"
+
+	// KVMUnavailableLabel marks the node as lacking /dev/kvm. VMIs requesting emulation prefer these nodes,
+	// the other VMIs can not be scheduled to them as the node offers no devices.kubevirt.io/kvm resource.
+	KVMUnavailableLabel string = "kubevirt.io/kvm-unavailable"
+
 	// VirtualMachineUnpaused is a custom pod condition set for the virt-launcher pod.
 	// It's used as a readiness gate to prevent paused VMs from being marked as ready.
 	VirtualMachineUnpaused k8sv1.PodConditionType = "kubevirt.io/virtual-machine-unpaused"
@@ -1314,6 +1630,9 @@ const (
 	// TDXLabel marks the node as capable of running workloads with Intel TDX
 	TDXLabel string = "kubevirt.io/tdx"
 
+	// SGXLabel marks the node as capable of running workloads with Intel SGX enclaves
+	SGXLabel string = "kubevirt.io/sgx"
+
 	// KSMEnabledLabel marks the node as KSM-handling enabled
 	KSMEnabledLabel string = "kubevirt.io/ksm-enabled"
 
@@ -1416,6 +1735,22 @@ const (
 	// This could be useful to distinguish evictions originated from the descheduler.
 	EvictionSourceAnnotation = "kubevirt.io/eviction-source"
 
+	// MemoryStateClaimAnnotation names the PersistentVolumeClaim holding the memory state
+	// a VirtualMachineInstance resumes from when it starts.
+	MemoryStateClaimAnnotation string = "kubevirt.io/memory-state-claim"
+
+	// RetainDiskLabel excludes a DataVolume or a PersistentVolumeClaim from the disk garbage collection
+	// when set to "true".
+	RetainDiskLabel string = "kubevirt.io/retain-disk"
+
+	// CollectDiskLabel opts a DataVolume or a PersistentVolumeClaim in to the deletion by the disk garbage
+	// collection when set to "true".
+	CollectDiskLabel string = "kubevirt.io/collect-disk"
+
+	// OrphanedDiskSinceAnnotation records since when a DataVolume or a PersistentVolumeClaim is no longer
+	// referenced by any VirtualMachine, VirtualMachineInstance or snapshot.
+	OrphanedDiskSinceAnnotation string = "kubevirt.io/orphaned-since"
+
 	// AllowAccessClusterServicesNPLabel is a pod label to be set by virt-components to indicate that they require
 	// access to cluster services otherwise blocked by the strict network policy (NP).
 	// This label will be applied to the following virt pods:
@@ -1985,6 +2320,64 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// Sealed holds the defaults which were chosen once for the VirtualMachine and are kept
+	// for its whole lifetime, instead of being written into the template.
+	// Values set in the template take precedence.
+	// Only populated with the SealedVMDefaults feature gate.
+	// +optional
+	Sealed *VirtualMachineSealedDefaults `json:"sealed,omitempty"`
+}
+
+// VirtualMachineSealedDefaults are the defaults which are applied to every
+// VirtualMachineInstance started for a VirtualMachine. Once set, they can not be changed.
+type VirtualMachineSealedDefaults struct {
+	// FirmwareUUID is the UUID exposed to the guest if the template does not set one.
+	// +optional
+	FirmwareUUID types.UID `json:"firmwareUUID,omitempty"`
+
+	// FirmwareSerial is the serial number exposed to the guest if the template does not set one.
+	// +optional
+	FirmwareSerial string `json:"firmwareSerial,omitempty"`
+
+	// MachineType is the machine type used if the template does not set one.
+	// +optional
+	MachineType string `json:"machineType,omitempty"`
+
+	// Interfaces holds the MAC and PCI addresses of the interfaces of the template which do not set them.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Interfaces []SealedInterface `json:"interfaces,omitempty"`
+
+	// Disks holds the PCI addresses of the disks of the template which do not set one.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Disks []SealedDisk `json:"disks,omitempty"`
+}
+
+// SealedInterface is the MAC and PCI address chosen for an interface of the template.
+type SealedInterface struct {
+	// Name of the interface in the template.
+	Name string `json:"name"`
+
+	// MACAddress of the interface.
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// PCIAddress of the interface in the guest, e.g. 0000:01:00.0
+	// +optional
+	PCIAddress string `json:"pciAddress,omitempty"`
+}
+
+// SealedDisk is the PCI address chosen for a disk of the template.
+type SealedDisk struct {
+	// Name of the disk in the template.
+	Name string `json:"name"`
+
+	// PCIAddress of the disk in the guest, e.g. 0000:02:00.0
+	PCIAddress string `json:"pciAddress"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -2338,6 +2731,14 @@ type Handler struct {
 	// GuestAgentPing contacts the qemu-guest-agent for availability checks.
 	// +optional
 	GuestAgentPing *GuestAgentPing `json:"guestAgentPing,omitempty"`
+	// GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.
+	// The probe succeeds if the command exits with status 0.
+	// +optional
+	GuestAgentExec *GuestAgentExecAction `json:"guestAgentExec,omitempty"`
+	// VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.
+	// It does not depend on the pod network and requires autoattachVSOCK to be enabled.
+	// +optional
+	VSockHTTP *VSockHTTPAction `json:"vsockHTTP,omitempty"`
 	// HTTPGet specifies the http request to perform.
 	// +optional
 	HTTPGet *k8sv1.HTTPGetAction `json:"httpGet,omitempty"`
@@ -2737,6 +3138,12 @@ type PauseOptions struct {
 	// +optional
 	// +listType=atomic
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+
+	// AutoUnpauseTimeout is the duration after which the VirtualMachineInstance
+	// is automatically unpaused. If unset, the VirtualMachineInstance stays
+	// paused until it is explicitly unpaused.
+	// +optional
+	AutoUnpauseTimeout *metav1.Duration `json:"autoUnpauseTimeout,omitempty"`
 }
 
 // UnpauseOptions may be provided on unpause request.
@@ -2753,6 +3160,63 @@ type UnpauseOptions struct {
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
 }
 
+// PauseReason describes what paused a VirtualMachineInstance.
+type PauseReason string
+
+const (
+	// PauseReasonUser indicates the VirtualMachineInstance was paused through the pause subresource
+	PauseReasonUser PauseReason = "User"
+	// PauseReasonMigration indicates the VirtualMachineInstance was paused by the migration monitor
+	PauseReasonMigration PauseReason = "Migration"
+	// PauseReasonIOError indicates the VirtualMachineInstance was paused by the hypervisor after an IO error
+	PauseReasonIOError PauseReason = "IOError"
+)
+
+// VirtualMachineInstancePauseStatus reports why a VirtualMachineInstance is paused
+type VirtualMachineInstancePauseStatus struct {
+	// Reason describes what paused the VirtualMachineInstance
+	Reason PauseReason `json:"reason"`
+	// PauseTimestamp is the time the VirtualMachineInstance was paused
+	// +optional
+	// +nullable
+	PauseTimestamp *metav1.Time `json:"pauseTimestamp,omitempty"`
+	// AutoUnpauseTimestamp is the time at which the VirtualMachineInstance is
+	// going to be unpaused automatically
+	// +optional
+	// +nullable
+	AutoUnpauseTimestamp *metav1.Time `json:"autoUnpauseTimestamp,omitempty"`
+}
+
+// RebootMethod selects how the guest is asked to reboot.
+type RebootMethod string
+
+const (
+	// RebootMethodACPI reboots the guest by sending an ACPI power button event.
+	RebootMethodACPI RebootMethod = "ACPI"
+	// RebootMethodGuestAgent reboots the guest through the qemu-guest-agent.
+	RebootMethodGuestAgent RebootMethod = "GuestAgent"
+)
+
+// RebootOptions may be provided on reboot request.
+type RebootOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Method selects how the guest is asked to reboot.
+	// One of: ACPI, GuestAgent.
+	// If unset, the guest agent is used when it is connected, ACPI otherwise.
+	// +optional
+	Method RebootMethod `json:"method,omitempty"`
+
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+}
+
 const (
 	StartRequestDataPausedKey  string = "paused"
 	StartRequestDataPausedTrue string = "true"
@@ -2918,8 +3382,21 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format of the memory dump, Core by default
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
+// MemoryDumpFormat is the format of the memory dump file
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatCore dumps the guest memory as a core file for debugging
+	MemoryDumpFormatCore MemoryDumpFormat = "Core"
+	// MemoryDumpFormatState saves the memory state of the VM, which it can be restored from
+	MemoryDumpFormatState MemoryDumpFormat = "State"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2961,6 +3438,14 @@ type ScreenshotOptions struct {
 	MoveCursor bool `json:"moveCursor"`
 }
 
+// VNCResolutionOptions is provided when requesting a new resolution of the guest display.
+type VNCResolutionOptions struct {
+	// Width in pixels.
+	Width uint32 `json:"width"`
+	// Height in pixels.
+	Height uint32 `json:"height"`
+}
+
 type VSOCKOptions struct {
 	TargetPort uint32 `json:"targetPort"`
 	UseTLS     *bool  `json:"useTLS,omitempty"`
@@ -3060,6 +3545,38 @@ type KubeVirtConfiguration struct {
 	TLSConfiguration               *TLSConfiguration                 `json:"tlsConfiguration,omitempty"`
 	SeccompConfiguration           *SeccompConfiguration             `json:"seccompConfiguration,omitempty"`
 
+	// LauncherSecurityProfiles assign custom seccomp and SELinux settings to virt-launcher pods
+	// based on the labels of the namespace and of the VirtualMachineInstance. The first matching profile is used and
+	// overrides seccompConfiguration and selinuxLauncherType.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	LauncherSecurityProfiles []LauncherSecurityProfile `json:"launcherSecurityProfiles,omitempty"`
+
+	// VFIOGroupID is the ID of the group owning the VFIO device nodes on the nodes. When set, it is
+	// added to the supplemental groups of non-root virt-launcher pods using VFIO devices (host devices,
+	// GPUs and SR-IOV), granting access to them without changing their ownership.
+	// +optional
+	VFIOGroupID *int64 `json:"vfioGroupID,omitempty"`
+
+	// LauncherPodConfiguration defines which virt-launcher pod settings VirtualMachineInstances
+	// are allowed to set directly
+	// +optional
+	LauncherPodConfiguration *LauncherPodConfiguration `json:"launcherPodConfiguration,omitempty"`
+
+	// EmulatorBundles lists the alternative emulator and firmware bundles VirtualMachineInstances
+	// may reference instead of the qemu binary and firmware shipped with virt-launcher
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	EmulatorBundles []EmulatorBundle `json:"emulatorBundles,omitempty"`
+
+	// FirmwareProfiles lists the firmware configurations VirtualMachineInstances may select by name
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	FirmwareProfiles []FirmwareProfile `json:"firmwareProfiles,omitempty"`
+
 	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
 	VMStateStorageClass   string                 `json:"vmStateStorageClass,omitempty"`
 	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
@@ -3093,6 +3610,193 @@ type KubeVirtConfiguration struct {
 	// Enabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.
 	// +nullable
 	ChangedBlockTrackingLabelSelectors *ChangedBlockTrackingSelectors `json:"changedBlockTrackingLabelSelectors,omitempty"`
+
+	// VMIStatusUpdates controls how virt-handler batches frequent updates of the VirtualMachineInstance status
+	// +nullable
+	VMIStatusUpdates *VMIStatusUpdateConfiguration `json:"vmiStatusUpdates,omitempty"`
+
+	// DiskGarbageCollection configures the detection and the cleanup of orphaned disks
+	// +nullable
+	DiskGarbageCollection *DiskGarbageCollectionConfiguration `json:"diskGarbageCollection,omitempty"`
+
+	// GuestDiskExpansion sets the quota for the disk growths requested by guests
+	// +nullable
+	GuestDiskExpansion *GuestDiskExpansionConfiguration `json:"guestDiskExpansion,omitempty"`
+
+	// ContainerDiskVerification holds the keys the signatures of containerdisk images are verified with
+	// +nullable
+	ContainerDiskVerification *ContainerDiskVerificationConfiguration `json:"containerDiskVerification,omitempty"`
+
+	// VolumeScan configures the scanner the volumes of a VMI are passed to before its first boot
+	// +nullable
+	VolumeScan *VolumeScanConfiguration `json:"volumeScan,omitempty"`
+
+	// HugepagesPool lets virt-handler size the 2Mi hugepages pool of the nodes based on the VMI demand
+	// +nullable
+	HugepagesPool *HugepagesPoolConfiguration `json:"hugepagesPool,omitempty"`
+
+	// VMStartThrottling limits the number of VirtualMachines virt-controller starts at the same time per storage class
+	// +nullable
+	VMStartThrottling *VMStartThrottlingConfiguration `json:"vmStartThrottling,omitempty"`
+
+	// LauncherWarmPools keep idle virt-launcher pods new VMIs are started in, to shorten their start
+	// +nullable
+	// +listType=atomic
+	LauncherWarmPools []LauncherWarmPool `json:"launcherWarmPools,omitempty"`
+
+	// AllowedHostPaths lists the host directories VirtualMachineInstances may place the backing files and the
+	// server sockets of shared memory devices, and the sockets of channels in. A host path is allowed when it is one
+	// of these directories or is below one of them. No host path is allowed by default.
+	// +optional
+	// +listType=set
+	AllowedHostPaths []string `json:"allowedHostPaths,omitempty"`
+
+	// ControllerShards splits the VirtualMachineInstance, VirtualMachine and migration controllers of virt-controller
+	// into this many shards by a hash of the namespace. Each shard is run by its own virt-controller deployment, the
+	// first one also runs the controllers which are not sharded. Sharding is disabled by default.
+	// +optional
+	ControllerShards *uint32 `json:"controllerShards,omitempty"`
+
+	// HostSensors allows the VirtualMachineInstances of the selected namespaces to read the sensors of their node.
+	// The hostSensors device is rejected in all namespaces by default.
+	// +nullable
+	HostSensors *HostSensorsConfiguration `json:"hostSensors,omitempty"`
+
+	// VirtualMachineAuditEvents configures the VirtualMachineAuditEvents virt-api records for privileged operations
+	// +nullable
+	VirtualMachineAuditEvents *VirtualMachineAuditEventsConfiguration `json:"virtualMachineAuditEvents,omitempty"`
+}
+
+// LauncherWarmPool keeps a number of idle virt-launcher pods sized by a VirtualMachineClusterInstancetype.
+// A VMI is started in one of the pods if the pod rendered for it only differs in the identity of the VMI.
+type LauncherWarmPool struct {
+	// Name of the pool, unique per namespace.
+	Name string `json:"name"`
+	// Namespace the pods are kept in. Only VMIs of this namespace are started in them.
+	Namespace string `json:"namespace"`
+	// Instancetype is the name of the VirtualMachineClusterInstancetype the pods are sized for.
+	Instancetype string `json:"instancetype"`
+	// Architecture of the nodes the pods run on. Defaults to the default architecture of the cluster.
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+	// Size is the number of idle pods kept in the pool.
+	Size uint32 `json:"size"`
+}
+
+// VMStartThrottlingConfiguration limits the number of VMIs which are starting at the same time on a storage class.
+// VirtualMachines waiting for a start are admitted by the priority of their PriorityClass.
+type VMStartThrottlingConfiguration struct {
+	// MaxStartingPerStorageClass is the number of VMIs with volumes on a storage class which may be starting at the same time.
+	// Zero means no limit.
+	MaxStartingPerStorageClass uint32 `json:"maxStartingPerStorageClass"`
+	// MaxStartingPerNamespace is the number of VMIs of a single namespace which may be starting at the same time on a
+	// storage class with a limit, so that a single namespace can not take all the starts of a storage class.
+	// Zero means no limit.
+	// +optional
+	MaxStartingPerNamespace uint32 `json:"maxStartingPerNamespace,omitempty"`
+	// StorageClasses overrides MaxStartingPerStorageClass for single storage classes.
+	// +optional
+	// +listType=atomic
+	StorageClasses []StorageClassStartLimit `json:"storageClasses,omitempty"`
+}
+
+// StorageClassStartLimit is the number of VMIs which may be starting at the same time on a storage class
+type StorageClassStartLimit struct {
+	// Name is the name of the storage class.
+	Name string `json:"name"`
+	// MaxStarting is the number of VMIs with volumes on the storage class which may be starting at the same time.
+	// Zero means no limit.
+	MaxStarting uint32 `json:"maxStarting"`
+}
+
+// HostSensorsConfiguration selects the namespaces whose VirtualMachineInstances may read the sensors of their node
+type HostSensorsConfiguration struct {
+	// NamespaceSelector is matched against the labels of the namespace of the VirtualMachineInstance,
+	// which are controlled by the cluster admin instead of the VM owner. It must not be empty.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+}
+
+// VirtualMachineAuditEventsConfiguration configures the retention of VirtualMachineAuditEvents
+type VirtualMachineAuditEventsConfiguration struct {
+	// Retention is the duration after its request time an event is deleted by virt-controller.
+	// Defaults to 720h.
+	// +optional
+	Retention *metav1.Duration `json:"retention,omitempty"`
+}
+
+// HugepagesPoolConfiguration bounds the number of 2Mi hugepages virt-handler keeps allocated on a node
+type HugepagesPoolConfiguration struct {
+	// MinPages is the number of 2Mi hugepages kept allocated on a node without any demand.
+	// +optional
+	MinPages uint32 `json:"minPages,omitempty"`
+	// MaxPages is the largest number of 2Mi hugepages virt-handler allocates on a node.
+	MaxPages uint32 `json:"maxPages"`
+}
+
+// VolumeScanConfiguration configures the gRPC service which scans containerdisks and DataVolumes before the first boot
+type VolumeScanConfiguration struct {
+	// Endpoint is the host:port of the gRPC service implementing the kubevirt.storage.scan.v1alpha1.VolumeScanner service.
+	// The connection is secured with TLS.
+	Endpoint string `json:"endpoint"`
+	// CABundle holds the PEM encoded CA certificates the TLS certificate of the scanner is verified with.
+	// Defaults to the CA certificates of the system.
+	// +optional
+	CABundle string `json:"caBundle,omitempty"`
+	// Timeout is the duration a single scan may take before it is retried.
+	// Defaults to 5m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ContainerDiskVerificationConfiguration configures the verification of cosign signatures of containerdisk images
+type ContainerDiskVerificationConfiguration struct {
+	// PublicKeys are PEM encoded cosign public keys.
+	// A containerdisk image is accepted if it carries a valid signature of any of them.
+	// +optional
+	// +listType=atomic
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// TrustedTokenHosts are the hosts, with an optional port, registries may redirect anonymous token requests to.
+	// Token services on the host of the registry itself, and auth.docker.io for Docker Hub, are always trusted.
+	// +optional
+	// +listType=atomic
+	TrustedTokenHosts []string `json:"trustedTokenHosts,omitempty"`
+}
+
+// GuestDiskExpansionConfiguration limits the growth of PVCs which guests can request over VSOCK
+type GuestDiskExpansionConfiguration struct {
+	// MaxSize is the largest size a guest can grow a PVC to.
+	// Requests for larger sizes are rejected. Without it, all requests are rejected.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// DiskGarbageCollectionConfiguration configures the detection of DataVolumes and PersistentVolumeClaims
+// which are no longer referenced by any VirtualMachine, VirtualMachineInstance or snapshot
+type DiskGarbageCollectionConfiguration struct {
+	// GracePeriod is the duration a disk has to stay unreferenced before it is reported as orphaned.
+	// Defaults to 24h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+	// Cleanup enables the deletion of the orphaned disks labeled with kubevirt.io/collect-disk=true
+	// once their grace period elapsed. Disks labeled with kubevirt.io/retain-disk=true are never deleted.
+	// Defaults to false, orphaned disks are only reported.
+	// +optional
+	Cleanup *bool `json:"cleanup,omitempty"`
+}
+
+// VMIStatusUpdateConfiguration controls the batching of VirtualMachineInstance status updates
+type VMIStatusUpdateConfiguration struct {
+	// BatchInterval is the minimum duration between two writes of a VirtualMachineInstance status
+	// which only change informational fields, like the interfaces, the guest OS information and the
+	// device statuses. Such changes are merged into a single patch once the interval elapsed.
+	// Changes of the phase, the conditions or the migration state are always written immediately.
+	// Defaults to 0, which disables batching.
+	// +optional
+	BatchInterval *metav1.Duration `json:"batchInterval,omitempty"`
+	// MaxJitter is the upper bound of a random delay added to the batch interval, spreading the
+	// writes of VirtualMachineInstances whose status changed at the same time. Defaults to 0.
+	// +optional
+	MaxJitter *metav1.Duration `json:"maxJitter,omitempty"`
 }
 
 type ChangedBlockTrackingSelectors struct {
@@ -3217,6 +3921,108 @@ type SeccompConfiguration struct {
 	VirtualMachineInstanceProfile *VirtualMachineInstanceProfile `json:"virtualMachineInstanceProfile,omitempty"`
 }
 
+// LauncherPodConfiguration holds the allowlists for the virt-launcher pod settings of VirtualMachineInstances
+type LauncherPodConfiguration struct {
+	// AllowedRuntimeClasses lists the RuntimeClasses VirtualMachineInstances may request
+	// +optional
+	// +listType=set
+	AllowedRuntimeClasses []string `json:"allowedRuntimeClasses,omitempty"`
+	// AllowedLabelPrefixes lists the key prefixes of the labels VirtualMachineInstances may set on virt-launcher pods
+	// +optional
+	// +listType=set
+	AllowedLabelPrefixes []string `json:"allowedLabelPrefixes,omitempty"`
+	// AllowedAnnotationPrefixes lists the key prefixes of the annotations VirtualMachineInstances may set on virt-launcher pods
+	// +optional
+	// +listType=set
+	AllowedAnnotationPrefixes []string `json:"allowedAnnotationPrefixes,omitempty"`
+	// IsolatedRuntimeClass is the RuntimeClass of the virt-launcher pods of VirtualMachineInstances
+	// with the MicroVM launcher isolation. It has to run pods in micro VMs which provide /dev/kvm
+	// through nested virtualization
+	// +optional
+	IsolatedRuntimeClass string `json:"isolatedRuntimeClass,omitempty"`
+}
+
+// EmulatorBundle is a container image providing a qemu binary and firmware,
+// for instance a vendor patched qemu required by specific hardware
+type EmulatorBundle struct {
+	// Name of the bundle, referenced by VirtualMachineInstances
+	Name string `json:"name"`
+	// Image is the container image holding the bundle. It has to be referenced by digest,
+	// so that only the image trusted by the cluster admin is ever used.
+	Image string `json:"image"`
+	// ImagePullPolicy of the bundle image
+	// +optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Emulator is the path of the qemu binary, relative to the root of the image
+	Emulator string `json:"emulator"`
+	// Firmware is the directory holding the EFI firmware, relative to the root of the image.
+	// The firmware shipped with virt-launcher is used if unset.
+	// +optional
+	Firmware string `json:"firmware,omitempty"`
+}
+
+type FirmwareType string
+
+const (
+	FirmwareTypeBIOS           FirmwareType = "BIOS"
+	FirmwareTypeUEFI           FirmwareType = "UEFI"
+	FirmwareTypeUEFISecureBoot FirmwareType = "UEFISecureBoot"
+)
+
+// FirmwareProfile selects the firmware of the VirtualMachineInstances referencing it,
+// optionally with firmware builds provided by the cluster admin per architecture
+type FirmwareProfile struct {
+	// Name of the profile, referenced by VirtualMachineInstances
+	Name string `json:"name"`
+	// Type of the firmware, one of BIOS, UEFI or UEFISecureBoot.
+	// UEFISecureBoot enables SMM, which SecureBoot requires.
+	// +kubebuilder:validation:Enum=BIOS;UEFI;UEFISecureBoot
+	Type FirmwareType `json:"type"`
+	// Images provide UEFI firmware builds per architecture, for instance an OVMF build for AMD SEV.
+	// The firmware shipped with virt-launcher is used for architectures without an image.
+	// +optional
+	// +listType=map
+	// +listMapKey=architecture
+	Images []FirmwareImage `json:"images,omitempty"`
+}
+
+// FirmwareImage is a container image holding UEFI firmware for one architecture
+type FirmwareImage struct {
+	// Architecture the firmware is built for, one of amd64, arm64 or s390x
+	Architecture string `json:"architecture"`
+	// Image is the container image holding the firmware. It has to be referenced by digest,
+	// so that only the image trusted by the cluster admin is ever used.
+	Image string `json:"image"`
+	// ImagePullPolicy of the firmware image
+	// +optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Path is the directory holding the firmware, relative to the root of the image.
+	// The firmware files are looked up by the names virt-launcher ships them with,
+	// e.g. OVMF_CODE.fd and OVMF_VARS.fd, or OVMF_CODE.cc.fd for SEV.
+	// Defaults to the root of the image.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// LauncherSecurityProfile holds the seccomp and SELinux settings for the virt-launcher pods
+// of the VirtualMachineInstances matching its selectors
+type LauncherSecurityProfile struct {
+	// Name of the profile, must be unique
+	Name string `json:"name"`
+	// NamespaceSelector is matched against the labels of the namespace of the
+	// VirtualMachineInstance, which are controlled by the cluster admin instead
+	// of the VM owner. It must not be empty
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// Selector is matched against the labels of the VirtualMachineInstance
+	Selector metav1.LabelSelector `json:"selector"`
+	// Seccomp defines the seccomp profile for matching virt-launcher pods
+	// +optional
+	Seccomp *VirtualMachineInstanceProfile `json:"seccomp,omitempty"`
+	// SELinuxType defines the SELinux type for matching virt-launcher pods
+	// +optional
+	SELinuxType string `json:"selinuxType,omitempty"`
+}
+
 // VirtualMachineOptions holds the cluster level information regarding the virtual machine.
 type VirtualMachineOptions struct {
 	// DisableFreePageReporting disable the free page reporting of
@@ -3380,6 +4186,11 @@ type PermittedHostDevices struct {
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
 	// +listType=atomic
 	USB []USBHostDevice `json:"usb,omitempty"`
+	// VirtualFunctionPools lists SR-IOV physical functions whose virtual functions
+	// are created by virt-handler and exposed for passthrough.
+	// +optional
+	// +listType=atomic
+	VirtualFunctionPools []VirtualFunctionPool `json:"virtualFunctionPools,omitempty"`
 }
 
 type USBHostDevice struct {
@@ -3411,6 +4222,20 @@ type PciHostDevice struct {
 	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
 }
 
+// VirtualFunctionPool represents the SR-IOV virtual functions virt-handler creates
+// on the matching physical functions of a node and exposes for passthrough
+type VirtualFunctionPool struct {
+	// The vendor_id:product_id tuple of the physical functions in the pool
+	PFVendorSelector string `json:"pfVendorSelector"`
+	// NumVFs is the number of virtual functions to create on each physical function.
+	// It is capped by the number of virtual functions the physical function supports.
+	NumVFs uint32 `json:"numVFs"`
+	// The name of the resource that is representing the virtual functions.
+	// Requested by VMs as host devices. Typically of the form
+	// vendor.com/product_name
+	ResourceName string `json:"resourceName"`
+}
+
 // MediatedHostDevice represents a host mediated device allowed for passthrough
 type MediatedHostDevice struct {
 	MDEVNameSelector         string `json:"mdevNameSelector"`
@@ -3465,6 +4290,37 @@ type NetworkConfiguration struct {
 	DeprecatedPermitSlirpInterface    *bool                             `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool                             `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
 	Binding                           map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// DefaultBindingPolicies select the binding of the pod network interface which is added
+	// to VMIs that specify neither networks nor interfaces, based on the labels of their namespace.
+	// The first matching policy is used. When none matches, defaultNetworkInterface is used.
+	// +optional
+	// +listType=atomic
+	DefaultBindingPolicies []DefaultBindingPolicy `json:"defaultBindingPolicies,omitempty"`
+	// DNSRegistration configures the publishing of DNS records for the names and guest IPs of VMIs.
+	// It is used when the VMDNSRegistration feature gate is enabled.
+	// +optional
+	DNSRegistration *DNSRegistrationConfiguration `json:"dnsRegistration,omitempty"`
+}
+
+// DNSRegistrationConfiguration configures how the names and guest IPs of VMIs are published
+// as endpoints of a headless Service in their namespace.
+type DNSRegistrationConfiguration struct {
+	// ServiceName is the name of the headless Service which holds the records. A VMI can be
+	// resolved as <hostname>.<serviceName>.<namespace>.svc.<cluster domain>, where hostname
+	// is spec.hostname or the name of the VMI. Defaults to "vms".
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// DefaultBindingPolicy selects the binding of the default pod network interface
+// for the VMIs of a set of namespaces.
+type DefaultBindingPolicy struct {
+	// NamespaceSelector selects the namespaces the policy applies to.
+	// An empty selector matches all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// Binding is either one of the core bindings "bridge" and "masquerade",
+	// or the name of a binding plugin registered in binding.
+	Binding string `json:"binding"`
 }
 
 type InterfaceBindingPlugin struct {
@@ -3549,6 +4405,23 @@ const (
 type GuestAgentPing struct {
 }
 
+// GuestAgentExecAction describes a command executed on the guest through the qemu-guest-agent
+type GuestAgentExecAction struct {
+	// Command is the command line to execute inside the guest. The first element is the
+	// executable, the remaining ones are passed as arguments. It is not run in a shell.
+	// +listType=atomic
+	Command []string `json:"command"`
+}
+
+// VSockHTTPAction describes an http GET request sent to the guest over VSOCK
+type VSockHTTPAction struct {
+	// Port is the VSOCK port the guest service listens on.
+	Port uint32 `json:"port"`
+	// Path to access on the HTTP server. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 type ProfilerResult struct {
 	PprofData map[string][]byte `json:"pprofData,omitempty"`
 }
@@ -3753,6 +4626,66 @@ type SEVSecretOptions struct {
 	Secret string `json:"secret,omitempty"`
 }
 
+// SEVSNPAttestationReportOptions is used to request an attestation report of a SEV-SNP guest.
+type SEVSNPAttestationReportOptions struct {
+	// Hex encoded nonce to place into the report data, at most 64 bytes.
+	// A fresh nonce per request prevents replaying an old report.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// SEVSNPAttestationReport contains the attestation report of a SEV-SNP guest.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SEVSNPAttestationReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Base64 encoded attestation report signed by the AMD secure processor.
+	Report string `json:"report,omitempty"`
+	// Hex encoded nonce the report was requested with.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// TPMAttestationOptions is used to select what the TPM quote covers.
+type TPMAttestationOptions struct {
+	// Hex encoded nonce to qualify the quote with, at most 32 bytes.
+	// A fresh nonce per request prevents replaying an old quote.
+	Nonce string `json:"nonce,omitempty"`
+	// Indexes of the SHA-256 PCRs to quote.
+	// Defaults to 0-7, the measurements of the firmware and the boot loader.
+	// +listType=atomic
+	PCRs []uint32 `json:"pcrs,omitempty"`
+	// Base64 encoded credential made with tpm2_makecredential for the
+	// endorsement key and the name of the attestation key. The guest activates
+	// it, which proves that both keys reside in the same vTPM.
+	Credential string `json:"credential,omitempty"`
+}
+
+// TPMAttestation contains the measured boot evidence of the vTPM of a guest.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TPMAttestation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Base64 encoded TCG event log of the measured boot.
+	EventLog string `json:"eventLog,omitempty"`
+	// Base64 encoded TPMS_ATTEST structure quoting the PCRs.
+	Quote string `json:"quote,omitempty"`
+	// Base64 encoded TPMT_SIGNATURE of the quote.
+	Signature string `json:"signature,omitempty"`
+	// Base64 encoded TPM2B_PUBLIC of the attestation key which signed the quote.
+	AttestationKey string `json:"attestationKey,omitempty"`
+	// Hex encoded values of the quoted SHA-256 PCRs, by PCR index.
+	PCRs map[string]string `json:"pcrs,omitempty"`
+	// Hex encoded nonce the quote is qualified with.
+	Nonce string `json:"nonce,omitempty"`
+	// Base64 encoded DER certificate of the RSA endorsement key of the vTPM.
+	EndorsementKeyCertificate string `json:"endorsementKeyCertificate,omitempty"`
+	// PEM encoded certificates of the local CA of swtpm which issued the
+	// endorsement key certificate. They are read by virt-launcher outside of
+	// the guest and are the trust anchor of the endorsement key.
+	EndorsementKeyIssuer string `json:"endorsementKeyIssuer,omitempty"`
+	// Base64 encoded secret of the activated credential.
+	ActivatedCredential string `json:"activatedCredential,omitempty"`
+}
+
 // ObjectGraphNode represents an individual node in the graph.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -20,6 +20,9 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                              "VirtualMachineInstanceSpec is a description of a VirtualMachineInstance.",
 		"priorityClassName":             "If specified, indicates the pod's priority.\nIf not specified, the pod priority will be default or zero if there is no\ndefault.\n+optional",
+		"runtimeClassName":              "If specified, the virt-launcher pod runs with the given RuntimeClass. It overrides the\ncluster wide defaultRuntimeClass and has to be allowed in launcherPodConfiguration.\n+optional",
+		"launcherMetadata":              "LauncherMetadata holds labels and annotations which are only set on the virt-launcher pod.\nThe keys have to be allowed in launcherPodConfiguration.\n+optional",
+		"launcherIsolation":             "LauncherIsolation selects how the virt-launcher pod is isolated from the host. With MicroVM\nthe pod runs in a micro VM with the isolatedRuntimeClass of launcherPodConfiguration.\nDefaults to Container.\n+optional",
 		"domain":                        "Specification of the desired behavior of the VirtualMachineInstance on the host.",
 		"nodeSelector":                  "NodeSelector is a selector which must be true for the vmi to fit on a node.\nSelector which must match a node's labels for the vmi to be scheduled on that node.\nMore info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/\n+optional",
 		"affinity":                      "If affinity is specifies, obey all the affinity rules",
@@ -41,6 +44,26 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
 		"utilityVolumes":                "List of utility volumes that can be mounted to the vmi virt-launcher pod\nwithout having a matching disk in the domain.\nUsed to collect data for various operational workflows.\n+kubebuilder:validation:MaxItems:=256\n+listType=map\n+listMapKey=name\n+optional",
+		"diskSpaceLow":                  "DiskSpaceLow enables the DiskSpaceLow condition, which is reported when a guest filesystem\nis filling up. The utilization of the guest filesystems is reported by the guest agent.\n+optional",
+		"guestSecrets":                  "GuestSecrets are short secrets delivered to the guest through fw_cfg blobs,\nwithout writing them to any disk image.\n+kubebuilder:validation:MaxItems:=32\n+listType=map\n+listMapKey=name\n+optional",
+	}
+}
+
+func (DiskSpaceLowThreshold) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "DiskSpaceLowThreshold configures when a guest filesystem is considered low on space",
+		"usedPercentage":       "UsedPercentage is the utilization of a guest filesystem from which on the DiskSpaceLow\ncondition is reported. Defaults to 90.\n+optional",
+		"hysteresisPercentage": "HysteresisPercentage is how far the utilization of all the guest filesystems has to drop\nbelow UsedPercentage before the DiskSpaceLow condition is cleared. Defaults to 5.\n+optional",
+	}
+}
+
+func (GuestSecret) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "GuestSecret delivers the value of a key of a Secret to the guest.",
+		"name":       "Name of the guest secret, unique within the VMI. It names the fw_cfg blob.",
+		"secretName": "SecretName is the name of the Secret in the namespace of the VMI.",
+		"key":        "Key of the Secret whose value is delivered.",
+		"target":     "Target selects how the secret is delivered to the guest. FWCfg exposes the secret as\nthe fw_cfg blob opt/io.kubevirt/<name>, which is read when the VMI starts, a rotated\nsecret is delivered on the next start.\nDefaults to FWCfg.\n+optional",
 	}
 }
 
@@ -87,6 +110,10 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
 		"deviceStatus":                  "DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available\nonly when DRA feature gate is enabled\nThis field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n+optional",
 		"changedBlockTracking":          "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"pauseStatus":                   "PauseStatus reports what paused the VirtualMachineInstance and whether\nit is going to be unpaused automatically. It is only set while the\nVirtualMachineInstance is paused.\n+optional",
+		"deviceStatuses":                "DeviceStatuses reflects the libvirt state of every disk and interface\nof the VirtualMachineInstance\n+optional\n+listType=atomic",
+		"guestFilesystems":              "GuestFilesystems reports the utilization of the guest filesystems as reported by the guest agent.\nChanges of the utilization are only reflected once they amount to one percent of the capacity.\n+optional\n+listType=atomic",
+		"diskExpansionRequests":         "DiskExpansionRequests lists the disk growths requested by the guest over VSOCK, one per volume.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -122,6 +149,41 @@ func (DeviceAttribute) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceDeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceDeviceStatus reflects the libvirt state of a device",
+		"name":           "Name of the device as specified in spec.domain.devices.disks or spec.domain.devices.interfaces",
+		"type":           "Type is the kind of the device",
+		"attached":       "Attached indicates whether the device is present in the running domain",
+		"hotplugPending": "HotplugPending indicates that the device was requested but is not attached yet\n+optional",
+		"ioErrors":       "IOErrors is the number of I/O errors reported by the hypervisor for a disk\n+optional",
+		"lastIOError":    "LastIOError is the reason of the last I/O error reported for a disk\n+optional",
+		"linkState":      "LinkState is the link state of an interface\n+optional",
+		"pciAddress":     "PCIAddress is the guest PCI address assigned to the device, e.g. 0000:01:00.0\n+optional",
+	}
+}
+
+func (DiskExpansionRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "DiskExpansionRequest is a request of the guest to grow the PVC backing one of its volumes",
+		"name":    "Name of the volume to expand",
+		"size":    "Size is the requested size of the PVC",
+		"phase":   "Phase is the progress of the request\n+optional",
+		"message": "Message explains why the request was rejected\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestFilesystem) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestFilesystem reports the utilization of a guest filesystem",
+		"mountPoint":     "MountPoint of the filesystem in the guest",
+		"diskName":       "DiskName is the name of the guest device holding the filesystem\n+optional",
+		"fileSystemType": "FileSystemType is the type of the filesystem, e.g. ext4\n+optional",
+		"usedBytes":      "UsedBytes is the used capacity of the filesystem",
+		"totalBytes":     "TotalBytes is the capacity of the filesystem",
+	}
+}
+
 func (StorageMigratedVolumeInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration",
@@ -204,6 +266,7 @@ func (ContainerDiskInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "ContainerDiskInfo shows info about the containerdisk",
 		"checksum": "Checksum is the checksum of the rootdisk or kernel artifacts inside the containerdisk",
+		"imageID":  "ImageID is the image of the containerdisk pinned to the digest pulled by the container runtime\n+optional",
 	}
 }
 
@@ -232,6 +295,7 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"infoSource":       "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":       "Specifies how many queues are allocated by MultiQueue",
 		"linkState":        "LinkState Reports the current operational link state`. values: up, down.",
+		"backend":          "Backend reports the host side backend used by the interface. values: vhost, userspace.",
 	}
 }
 
@@ -314,6 +378,14 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 	}
 }
 
+func (LauncherMetadata) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "LauncherMetadata holds labels and annotations for the virt-launcher pod",
+		"labels":      "Labels to add to the virt-launcher pod\n+optional",
+		"annotations": "Annotations to add to the virt-launcher pod\n+optional",
+	}
+}
+
 func (VMISelector) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name": "Name of the VirtualMachineInstance to migrate",
@@ -472,6 +544,35 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"sealed":                "Sealed holds the defaults which were chosen once for the VirtualMachine and are kept\nfor its whole lifetime, instead of being written into the template.\nValues set in the template take precedence.\nOnly populated with the SealedVMDefaults feature gate.\n+optional",
+	}
+}
+
+func (VirtualMachineSealedDefaults) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineSealedDefaults are the defaults which are applied to every\nVirtualMachineInstance started for a VirtualMachine. Once set, they can not be changed.",
+		"firmwareUUID":   "FirmwareUUID is the UUID exposed to the guest if the template does not set one.\n+optional",
+		"firmwareSerial": "FirmwareSerial is the serial number exposed to the guest if the template does not set one.\n+optional",
+		"machineType":    "MachineType is the machine type used if the template does not set one.\n+optional",
+		"interfaces":     "Interfaces holds the MAC and PCI addresses of the interfaces of the template which do not set them.\n+optional\n+listType=map\n+listMapKey=name",
+		"disks":          "Disks holds the PCI addresses of the disks of the template which do not set one.\n+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (SealedInterface) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "SealedInterface is the MAC and PCI address chosen for an interface of the template.",
+		"name":       "Name of the interface in the template.",
+		"macAddress": "MACAddress of the interface.\n+optional",
+		"pciAddress": "PCIAddress of the interface in the guest, e.g. 0000:01:00.0\n+optional",
+	}
+}
+
+func (SealedDisk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "SealedDisk is the PCI address chosen for a disk of the template.",
+		"name":       "Name of the disk in the template.",
+		"pciAddress": "PCIAddress of the disk in the guest, e.g. 0000:02:00.0",
 	}
 }
 
@@ -589,6 +690,8 @@ func (Handler) SwaggerDoc() map[string]string {
 		"":               "Handler defines a specific action that should be taken",
 		"exec":           "One and only one of the following should be specified.\nExec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.\nIf the guest agent is not available, this probe will fail.\n+optional",
 		"guestAgentPing": "GuestAgentPing contacts the qemu-guest-agent for availability checks.\n+optional",
+		"guestAgentExec": "GuestAgentExec specifies a command to execute on the guest through the qemu-guest-agent.\nThe probe succeeds if the command exits with status 0.\n+optional",
+		"vsockHTTP":      "VSockHTTP specifies an http request to perform against a guest service listening on VSOCK.\nIt does not depend on the pod network and requires autoattachVSOCK to be enabled.\n+optional",
 		"httpGet":        "HTTPGet specifies the http request to perform.\n+optional",
 		"tcpSocket":      "TCPSocket specifies an action involving a TCP port.\nTCP hooks not yet supported\n+optional",
 	}
@@ -735,8 +838,9 @@ func (StartOptions) SwaggerDoc() map[string]string {
 
 func (PauseOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "PauseOptions may be provided on pause request.",
-		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"":                   "PauseOptions may be provided on pause request.",
+		"dryRun":             "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"autoUnpauseTimeout": "AutoUnpauseTimeout is the duration after which the VirtualMachineInstance\nis automatically unpaused. If unset, the VirtualMachineInstance stays\npaused until it is explicitly unpaused.\n+optional",
 	}
 }
 
@@ -747,6 +851,23 @@ func (UnpauseOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstancePauseStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "VirtualMachineInstancePauseStatus reports why a VirtualMachineInstance is paused",
+		"reason":               "Reason describes what paused the VirtualMachineInstance",
+		"pauseTimestamp":       "PauseTimestamp is the time the VirtualMachineInstance was paused\n+optional\n+nullable",
+		"autoUnpauseTimestamp": "AutoUnpauseTimestamp is the time at which the VirtualMachineInstance is\ngoing to be unpaused automatically\n+optional\n+nullable",
+	}
+}
+
+func (RebootOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "RebootOptions may be provided on reboot request.",
+		"method": "Method selects how the guest is asked to reboot.\nOne of: ACPI, GuestAgent.\nIf unset, the guest agent is used when it is connected, ACPI otherwise.\n+optional",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (StopOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":            "StopOptions may be provided when deleting an API object.",
@@ -843,6 +964,7 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format of the memory dump, Core by default\n+optional",
 	}
 }
 
@@ -860,6 +982,14 @@ func (ScreenshotOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (VNCResolutionOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VNCResolutionOptions is provided when requesting a new resolution of the guest display.",
+		"width":  "Width in pixels.",
+		"height": "Height in pixels.",
+	}
+}
+
 func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -908,6 +1038,11 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"supportContainerResources":          "+listType=map\n+listMapKey=type\nSupportContainerResources specifies the resource requirements for various types of supporting containers such as container disks/virtiofs/sidecars and hotplug attachment pods. If omitted a sensible default will be supplied.",
 		"supportedGuestAgentVersions":        "deprecated",
 		"minCPUModel":                        "deprecated",
+		"launcherSecurityProfiles":           "LauncherSecurityProfiles assign custom seccomp and SELinux settings to virt-launcher pods\nbased on the labels of the namespace and of the VirtualMachineInstance. The first matching profile is used and\noverrides seccompConfiguration and selinuxLauncherType.\n+listType=map\n+listMapKey=name\n+optional",
+		"vfioGroupID":                        "VFIOGroupID is the ID of the group owning the VFIO device nodes on the nodes. When set, it is\nadded to the supplemental groups of non-root virt-launcher pods using VFIO devices (host devices,\nGPUs and SR-IOV), granting access to them without changing their ownership.\n+optional",
+		"launcherPodConfiguration":           "LauncherPodConfiguration defines which virt-launcher pod settings VirtualMachineInstances\nare allowed to set directly\n+optional",
+		"emulatorBundles":                    "EmulatorBundles lists the alternative emulator and firmware bundles VirtualMachineInstances\nmay reference instead of the qemu binary and firmware shipped with virt-launcher\n+optional\n+listType=map\n+listMapKey=name",
+		"firmwareProfiles":                   "FirmwareProfiles lists the firmware configurations VirtualMachineInstances may select by name\n+optional\n+listType=map\n+listMapKey=name",
 		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
@@ -916,6 +1051,108 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
 		"changedBlockTrackingLabelSelectors": "ChangedBlockTrackingLabelSelectors defines label selectors. VMs matching these selectors will have changed block tracking enabled.\nEnabling changedBlockTracking is mandatory for performing storage-agnostic backups and incremental backups.\n+nullable",
+		"vmiStatusUpdates":                   "VMIStatusUpdates controls how virt-handler batches frequent updates of the VirtualMachineInstance status\n+nullable",
+		"diskGarbageCollection":              "DiskGarbageCollection configures the detection and the cleanup of orphaned disks\n+nullable",
+		"guestDiskExpansion":                 "GuestDiskExpansion sets the quota for the disk growths requested by guests\n+nullable",
+		"containerDiskVerification":          "ContainerDiskVerification holds the keys the signatures of containerdisk images are verified with\n+nullable",
+		"volumeScan":                         "VolumeScan configures the scanner the volumes of a VMI are passed to before its first boot\n+nullable",
+		"hugepagesPool":                      "HugepagesPool lets virt-handler size the 2Mi hugepages pool of the nodes based on the VMI demand\n+nullable",
+		"vmStartThrottling":                  "VMStartThrottling limits the number of VirtualMachines virt-controller starts at the same time per storage class\n+nullable",
+		"launcherWarmPools":                  "LauncherWarmPools keep idle virt-launcher pods new VMIs are started in, to shorten their start\n+nullable\n+listType=atomic",
+		"allowedHostPaths":                   "AllowedHostPaths lists the host directories VirtualMachineInstances may place the backing files and the\nserver sockets of shared memory devices, and the sockets of channels in. A host path is allowed when it is one\nof these directories or is below one of them. No host path is allowed by default.\n+optional\n+listType=set",
+		"controllerShards":                   "ControllerShards splits the VirtualMachineInstance, VirtualMachine and migration controllers of virt-controller\ninto this many shards by a hash of the namespace. Each shard is run by its own virt-controller deployment, the\nfirst one also runs the controllers which are not sharded. Sharding is disabled by default.\n+optional",
+		"hostSensors":                        "HostSensors allows the VirtualMachineInstances of the selected namespaces to read the sensors of their node.\nThe hostSensors device is rejected in all namespaces by default.\n+nullable",
+		"virtualMachineAuditEvents":          "VirtualMachineAuditEvents configures the VirtualMachineAuditEvents virt-api records for privileged operations\n+nullable",
+	}
+}
+
+func (LauncherWarmPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "LauncherWarmPool keeps a number of idle virt-launcher pods sized by a VirtualMachineClusterInstancetype.\nA VMI is started in one of the pods if the pod rendered for it only differs in the identity of the VMI.",
+		"name":         "Name of the pool, unique per namespace.",
+		"namespace":    "Namespace the pods are kept in. Only VMIs of this namespace are started in them.",
+		"instancetype": "Instancetype is the name of the VirtualMachineClusterInstancetype the pods are sized for.",
+		"architecture": "Architecture of the nodes the pods run on. Defaults to the default architecture of the cluster.\n+optional",
+		"size":         "Size is the number of idle pods kept in the pool.",
+	}
+}
+
+func (VMStartThrottlingConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VMStartThrottlingConfiguration limits the number of VMIs which are starting at the same time on a storage class.\nVirtualMachines waiting for a start are admitted by the priority of their PriorityClass.",
+		"maxStartingPerStorageClass": "MaxStartingPerStorageClass is the number of VMIs with volumes on a storage class which may be starting at the same time.\nZero means no limit.",
+		"maxStartingPerNamespace":    "MaxStartingPerNamespace is the number of VMIs of a single namespace which may be starting at the same time on a\nstorage class with a limit, so that a single namespace can not take all the starts of a storage class.\nZero means no limit.\n+optional",
+		"storageClasses":             "StorageClasses overrides MaxStartingPerStorageClass for single storage classes.\n+optional\n+listType=atomic",
+	}
+}
+
+func (StorageClassStartLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "StorageClassStartLimit is the number of VMIs which may be starting at the same time on a storage class",
+		"name":        "Name is the name of the storage class.",
+		"maxStarting": "MaxStarting is the number of VMIs with volumes on the storage class which may be starting at the same time.\nZero means no limit.",
+	}
+}
+
+func (HostSensorsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "HostSensorsConfiguration selects the namespaces whose VirtualMachineInstances may read the sensors of their node",
+		"namespaceSelector": "NamespaceSelector is matched against the labels of the namespace of the VirtualMachineInstance,\nwhich are controlled by the cluster admin instead of the VM owner. It must not be empty.",
+	}
+}
+
+func (VirtualMachineAuditEventsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineAuditEventsConfiguration configures the retention of VirtualMachineAuditEvents",
+		"retention": "Retention is the duration after its request time an event is deleted by virt-controller.\nDefaults to 720h.\n+optional",
+	}
+}
+
+func (HugepagesPoolConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "HugepagesPoolConfiguration bounds the number of 2Mi hugepages virt-handler keeps allocated on a node",
+		"minPages": "MinPages is the number of 2Mi hugepages kept allocated on a node without any demand.\n+optional",
+		"maxPages": "MaxPages is the largest number of 2Mi hugepages virt-handler allocates on a node.",
+	}
+}
+
+func (VolumeScanConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VolumeScanConfiguration configures the gRPC service which scans containerdisks and DataVolumes before the first boot",
+		"endpoint": "Endpoint is the host:port of the gRPC service implementing the kubevirt.storage.scan.v1alpha1.VolumeScanner service.\nThe connection is secured with TLS.",
+		"caBundle": "CABundle holds the PEM encoded CA certificates the TLS certificate of the scanner is verified with.\nDefaults to the CA certificates of the system.\n+optional",
+		"timeout":  "Timeout is the duration a single scan may take before it is retried.\nDefaults to 5m.\n+optional",
+	}
+}
+
+func (ContainerDiskVerificationConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "ContainerDiskVerificationConfiguration configures the verification of cosign signatures of containerdisk images",
+		"publicKeys":        "PublicKeys are PEM encoded cosign public keys.\nA containerdisk image is accepted if it carries a valid signature of any of them.\n+optional\n+listType=atomic",
+		"trustedTokenHosts": "TrustedTokenHosts are the hosts, with an optional port, registries may redirect anonymous token requests to.\nToken services on the host of the registry itself, and auth.docker.io for Docker Hub, are always trusted.\n+optional\n+listType=atomic",
+	}
+}
+
+func (GuestDiskExpansionConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "GuestDiskExpansionConfiguration limits the growth of PVCs which guests can request over VSOCK",
+		"maxSize": "MaxSize is the largest size a guest can grow a PVC to.\nRequests for larger sizes are rejected. Without it, all requests are rejected.\n+optional",
+	}
+}
+
+func (DiskGarbageCollectionConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DiskGarbageCollectionConfiguration configures the detection of DataVolumes and PersistentVolumeClaims\nwhich are no longer referenced by any VirtualMachine, VirtualMachineInstance or snapshot",
+		"gracePeriod": "GracePeriod is the duration a disk has to stay unreferenced before it is reported as orphaned.\nDefaults to 24h.\n+optional",
+		"cleanup":     "Cleanup enables the deletion of the orphaned disks labeled with kubevirt.io/collect-disk=true\nonce their grace period elapsed. Disks labeled with kubevirt.io/retain-disk=true are never deleted.\nDefaults to false, orphaned disks are only reported.\n+optional",
+	}
+}
+
+func (VMIStatusUpdateConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VMIStatusUpdateConfiguration controls the batching of VirtualMachineInstance status updates",
+		"batchInterval": "BatchInterval is the minimum duration between two writes of a VirtualMachineInstance status\nwhich only change informational fields, like the interfaces, the guest OS information and the\ndevice statuses. Such changes are merged into a single patch once the interval elapsed.\nChanges of the phase, the conditions or the migration state are always written immediately.\nDefaults to 0, which disables batching.\n+optional",
+		"maxJitter":     "MaxJitter is the upper bound of a random delay added to the batch interval, spreading the\nwrites of VirtualMachineInstances whose status changed at the same time. Defaults to 0.\n+optional",
 	}
 }
 
@@ -977,6 +1214,57 @@ func (SeccompConfiguration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (LauncherPodConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "LauncherPodConfiguration holds the allowlists for the virt-launcher pod settings of VirtualMachineInstances",
+		"allowedRuntimeClasses":     "AllowedRuntimeClasses lists the RuntimeClasses VirtualMachineInstances may request\n+optional\n+listType=set",
+		"allowedLabelPrefixes":      "AllowedLabelPrefixes lists the key prefixes of the labels VirtualMachineInstances may set on virt-launcher pods\n+optional\n+listType=set",
+		"allowedAnnotationPrefixes": "AllowedAnnotationPrefixes lists the key prefixes of the annotations VirtualMachineInstances may set on virt-launcher pods\n+optional\n+listType=set",
+		"isolatedRuntimeClass":      "IsolatedRuntimeClass is the RuntimeClass of the virt-launcher pods of VirtualMachineInstances\nwith the MicroVM launcher isolation. It has to run pods in micro VMs which provide /dev/kvm\nthrough nested virtualization\n+optional",
+	}
+}
+
+func (EmulatorBundle) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "EmulatorBundle is a container image providing a qemu binary and firmware,\nfor instance a vendor patched qemu required by specific hardware",
+		"name":            "Name of the bundle, referenced by VirtualMachineInstances",
+		"image":           "Image is the container image holding the bundle. It has to be referenced by digest,\nso that only the image trusted by the cluster admin is ever used.",
+		"imagePullPolicy": "ImagePullPolicy of the bundle image\n+optional",
+		"emulator":        "Emulator is the path of the qemu binary, relative to the root of the image",
+		"firmware":        "Firmware is the directory holding the EFI firmware, relative to the root of the image.\nThe firmware shipped with virt-launcher is used if unset.\n+optional",
+	}
+}
+
+func (FirmwareProfile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "FirmwareProfile selects the firmware of the VirtualMachineInstances referencing it,\noptionally with firmware builds provided by the cluster admin per architecture",
+		"name":   "Name of the profile, referenced by VirtualMachineInstances",
+		"type":   "Type of the firmware, one of BIOS, UEFI or UEFISecureBoot.\nUEFISecureBoot enables SMM, which SecureBoot requires.\n+kubebuilder:validation:Enum=BIOS;UEFI;UEFISecureBoot",
+		"images": "Images provide UEFI firmware builds per architecture, for instance an OVMF build for AMD SEV.\nThe firmware shipped with virt-launcher is used for architectures without an image.\n+optional\n+listType=map\n+listMapKey=architecture",
+	}
+}
+
+func (FirmwareImage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "FirmwareImage is a container image holding UEFI firmware for one architecture",
+		"architecture":    "Architecture the firmware is built for, one of amd64, arm64 or s390x",
+		"image":           "Image is the container image holding the firmware. It has to be referenced by digest,\nso that only the image trusted by the cluster admin is ever used.",
+		"imagePullPolicy": "ImagePullPolicy of the firmware image\n+optional",
+		"path":            "Path is the directory holding the firmware, relative to the root of the image.\nThe firmware files are looked up by the names virt-launcher ships them with,\ne.g. OVMF_CODE.fd and OVMF_VARS.fd, or OVMF_CODE.cc.fd for SEV.\nDefaults to the root of the image.\n+optional",
+	}
+}
+
+func (LauncherSecurityProfile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "LauncherSecurityProfile holds the seccomp and SELinux settings for the virt-launcher pods\nof the VirtualMachineInstances matching its selectors",
+		"name":              "Name of the profile, must be unique",
+		"namespaceSelector": "NamespaceSelector is matched against the labels of the namespace of the\nVirtualMachineInstance, which are controlled by the cluster admin instead\nof the VM owner. It must not be empty",
+		"selector":          "Selector is matched against the labels of the VirtualMachineInstance",
+		"seccomp":           "Seccomp defines the seccomp profile for matching virt-launcher pods\n+optional",
+		"selinuxType":       "SELinuxType defines the SELinux type for matching virt-launcher pods\n+optional",
+	}
+}
+
 func (VirtualMachineOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                         "VirtualMachineOptions holds the cluster level information regarding the virtual machine.",
@@ -1051,10 +1339,11 @@ func (LogVerbosity) SwaggerDoc() map[string]string {
 
 func (PermittedHostDevices) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "PermittedHostDevices holds information about devices allowed for passthrough",
-		"pciHostDevices":  "+listType=atomic",
-		"mediatedDevices": "+listType=atomic",
-		"usb":             "+listType=atomic",
+		"":                     "PermittedHostDevices holds information about devices allowed for passthrough",
+		"pciHostDevices":       "+listType=atomic",
+		"mediatedDevices":      "+listType=atomic",
+		"usb":                  "+listType=atomic",
+		"virtualFunctionPools": "VirtualFunctionPools lists SR-IOV physical functions whose virtual functions\nare created by virt-handler and exposed for passthrough.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -1079,6 +1368,15 @@ func (PciHostDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualFunctionPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualFunctionPool represents the SR-IOV virtual functions virt-handler creates\non the matching physical functions of a node and exposes for passthrough",
+		"pfVendorSelector": "The vendor_id:product_id tuple of the physical functions in the pool",
+		"numVFs":           "NumVFs is the number of virtual functions to create on each physical function.\nIt is capped by the number of virtual functions the physical function supports.",
+		"resourceName":     "The name of the resource that is representing the virtual functions.\nRequested by VMs as host devices. Typically of the form\nvendor.com/product_name",
+	}
+}
+
 func (MediatedHostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "MediatedHostDevice represents a host mediated device allowed for passthrough",
@@ -1112,8 +1410,25 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                     "NetworkConfiguration holds network options",
-		"permitSlirpInterface": "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"":                       "NetworkConfiguration holds network options",
+		"permitSlirpInterface":   "DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.\nDeprecated: Removed in v1.3.",
+		"defaultBindingPolicies": "DefaultBindingPolicies select the binding of the pod network interface which is added\nto VMIs that specify neither networks nor interfaces, based on the labels of their namespace.\nThe first matching policy is used. When none matches, defaultNetworkInterface is used.\n+optional\n+listType=atomic",
+		"dnsRegistration":        "DNSRegistration configures the publishing of DNS records for the names and guest IPs of VMIs.\nIt is used when the VMDNSRegistration feature gate is enabled.\n+optional",
+	}
+}
+
+func (DNSRegistrationConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DNSRegistrationConfiguration configures how the names and guest IPs of VMIs are published\nas endpoints of a headless Service in their namespace.",
+		"serviceName": "ServiceName is the name of the headless Service which holds the records. A VMI can be\nresolved as <hostname>.<serviceName>.<namespace>.svc.<cluster domain>, where hostname\nis spec.hostname or the name of the VMI. Defaults to \"vms\".\n+optional",
+	}
+}
+
+func (DefaultBindingPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DefaultBindingPolicy selects the binding of the default pod network interface\nfor the VMIs of a set of namespaces.",
+		"namespaceSelector": "NamespaceSelector selects the namespaces the policy applies to.\nAn empty selector matches all namespaces.",
+		"binding":           "Binding is either one of the core bindings \"bridge\" and \"masquerade\",\nor the name of a binding plugin registered in binding.",
 	}
 }
 
@@ -1148,6 +1463,21 @@ func (GuestAgentPing) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestAgentExecAction) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "GuestAgentExecAction describes a command executed on the guest through the qemu-guest-agent",
+		"command": "Command is the command line to execute inside the guest. The first element is the\nexecutable, the remaining ones are passed as arguments. It is not run in a shell.\n+listType=atomic",
+	}
+}
+
+func (VSockHTTPAction) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VSockHTTPAction describes an http GET request sent to the guest over VSOCK",
+		"port": "Port is the VSOCK port the guest service listens on.",
+		"path": "Path to access on the HTTP server. Defaults to \"/\".\n+optional",
+	}
+}
+
 func (ProfilerResult) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -1226,6 +1556,45 @@ func (SEVSecretOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SEVSNPAttestationReportOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "SEVSNPAttestationReportOptions is used to request an attestation report of a SEV-SNP guest.",
+		"nonce": "Hex encoded nonce to place into the report data, at most 64 bytes.\nA fresh nonce per request prevents replaying an old report.",
+	}
+}
+
+func (SEVSNPAttestationReport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "SEVSNPAttestationReport contains the attestation report of a SEV-SNP guest.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"report": "Base64 encoded attestation report signed by the AMD secure processor.",
+		"nonce":  "Hex encoded nonce the report was requested with.",
+	}
+}
+
+func (TPMAttestationOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "TPMAttestationOptions is used to select what the TPM quote covers.",
+		"nonce":      "Hex encoded nonce to qualify the quote with, at most 32 bytes.\nA fresh nonce per request prevents replaying an old quote.",
+		"pcrs":       "Indexes of the SHA-256 PCRs to quote.\nDefaults to 0-7, the measurements of the firmware and the boot loader.\n+listType=atomic",
+		"credential": "Base64 encoded credential made with tpm2_makecredential for the\nendorsement key and the name of the attestation key. The guest activates\nit, which proves that both keys reside in the same vTPM.",
+	}
+}
+
+func (TPMAttestation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "TPMAttestation contains the measured boot evidence of the vTPM of a guest.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"eventLog":                  "Base64 encoded TCG event log of the measured boot.",
+		"quote":                     "Base64 encoded TPMS_ATTEST structure quoting the PCRs.",
+		"signature":                 "Base64 encoded TPMT_SIGNATURE of the quote.",
+		"attestationKey":            "Base64 encoded TPM2B_PUBLIC of the attestation key which signed the quote.",
+		"pcrs":                      "Hex encoded values of the quoted SHA-256 PCRs, by PCR index.",
+		"nonce":                     "Hex encoded nonce the quote is qualified with.",
+		"endorsementKeyCertificate": "Base64 encoded DER certificate of the RSA endorsement key of the vTPM.",
+		"endorsementKeyIssuer":      "PEM encoded certificates of the local CA of swtpm which issued the\nendorsement key certificate. They are read by virt-launcher outside of\nthe guest and are the trust anchor of the endorsement key.",
+		"activatedCredential":       "Base64 encoded secret of the activated credential.",
+	}
+}
+
 func (ObjectGraphNode) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "ObjectGraphNode represents an individual node in the graph.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -61,6 +61,11 @@ func (in *CPUInstancetype) DeepCopyInto(out *CPUInstancetype) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaxCPUs != nil {
+		in, out := &in.MaxCPUs, &out.MaxCPUs
+		*out = new(uint32)
+		**out = **in
+	}
 	return
 }
 
@@ -134,6 +139,11 @@ func (in *ClockPreferences) DeepCopyInto(out *ClockPreferences) {
 		*out = new(v1.Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreferredPTP != nil {
+		in, out := &in.PreferredPTP, &out.PreferredPTP
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -264,7 +274,7 @@ func (in *FeaturePreferences) DeepCopyInto(out *FeaturePreferences) {
 	if in.PreferredKvm != nil {
 		in, out := &in.PreferredKvm, &out.PreferredKvm
 		*out = new(v1.FeatureKVM)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.PreferredPvspinlock != nil {
 		in, out := &in.PreferredPvspinlock, &out.PreferredPvspinlock
@@ -813,6 +823,11 @@ func (in *VirtualMachinePreferenceSpec) DeepCopyInto(out *VirtualMachinePreferen
 		*out = new(string)
 		**out = **in
 	}
+	if in.PerformanceProfile != nil {
+		in, out := &in.PerformanceProfile, &out.PerformanceProfile
+		*out = new(PerformanceProfile)
+		**out = **in
+	}
 	return
 }
 
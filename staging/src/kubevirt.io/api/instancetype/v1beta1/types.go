@@ -166,6 +166,11 @@ type CPUInstancetype struct {
 	// MaxSockets specifies the maximum amount of sockets that can be hotplugged
 	// +optional
 	MaxSockets *uint32 `json:"maxSockets,omitempty"`
+
+	// MaxCPUs specifies the maximum amount of vCPUs that can be hotplugged.
+	// It is rounded down to whole sockets of the CPU topology and can not be used together with MaxSockets.
+	// +optional
+	MaxCPUs *uint32 `json:"maxCPUs,omitempty"`
 }
 
 // MemoryInstancetype contains the Memory related configuration of a given VirtualMachineInstancetypeSpec.
@@ -307,8 +312,28 @@ type VirtualMachinePreferenceSpec struct {
 	//
 	//+optional
 	PreferredArchitecture *string `json:"preferredArchitecture,omitempty"`
+
+	// PerformanceProfile optionally expands into a curated set of preferences tuning the VirtualMachine for a class of
+	// workloads. Preferences defined explicitly take precedence over the ones of the profile.
+	//
+	//+optional
+	PerformanceProfile *PerformanceProfile `json:"performanceProfile,omitempty"`
 }
 
+// PerformanceProfile defines a curated set of preferences tuning a VirtualMachine for a class of workloads
+type PerformanceProfile string
+
+const (
+	// Tune disk and network IO for throughput, using native IO, dedicated IO threads and multi queue devices
+	PerformanceProfileThroughput PerformanceProfile = "throughput"
+
+	// Tune for low latency, using native IO and avoiding periodic work like memory balloon polling
+	PerformanceProfileLatency PerformanceProfile = "latency"
+
+	// Tune for running many VirtualMachines per node, using threaded IO and shared host resources
+	PerformanceProfileDensity PerformanceProfile = "density"
+)
+
 type VolumePreferences struct {
 
 	// PreffereedStorageClassName optionally defines the preferred storageClass
@@ -625,6 +650,11 @@ type ClockPreferences struct {
 	//
 	// +optional
 	PreferredTimer *v1.Timer `json:"preferredTimer,omitempty"`
+
+	// PreferredPTP optionally enables the PTP hardware clock of the ptp_kvm driver in the guest.
+	//
+	// +optional
+	PreferredPTP *bool `json:"preferredPTP,omitempty"`
 }
 
 type PreferenceRequirements struct {
@@ -54,6 +54,7 @@ func (CPUInstancetype) SwaggerDoc() map[string]string {
 		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
 		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
 		"maxSockets":            "MaxSockets specifies the maximum amount of sockets that can be hotplugged\n+optional",
+		"maxCPUs":               "MaxCPUs specifies the maximum amount of vCPUs that can be hotplugged.\nIt is rounded down to whole sockets of the CPU topology and can not be used together with MaxSockets.\n+optional",
 	}
 }
 
@@ -111,6 +112,7 @@ func (VirtualMachinePreferenceSpec) SwaggerDoc() map[string]string {
 		"annotations":                            "Optionally defines preferred Annotations to be applied to the VirtualMachineInstance\n\n+optional",
 		"preferSpreadSocketToCoreRatio":          "PreferSpreadSocketToCoreRatio defines the ratio to spread vCPUs between cores and sockets, it defaults to 2.\n\n+optional",
 		"preferredArchitecture":                  "PreferredArchitecture defines a prefeerred architecture for the VirtualMachine\n\n+optional",
+		"performanceProfile":                     "PerformanceProfile optionally expands into a curated set of preferences tuning the VirtualMachine for a class of\nworkloads. Preferences defined explicitly take precedence over the ones of the profile.\n\n+optional",
 	}
 }
 
@@ -202,6 +204,7 @@ func (ClockPreferences) SwaggerDoc() map[string]string {
 		"":                     "ClockPreferences contains various optional defaults for Clock.",
 		"preferredClockOffset": "ClockOffset allows specifying the UTC offset or the timezone of the guest clock.\n\n+optional",
 		"preferredTimer":       "Timer specifies whih timers are attached to the vmi.\n\n+optional",
+		"preferredPTP":         "PreferredPTP optionally enables the PTP hardware clock of the ptp_kvm driver in the guest.\n\n+optional",
 	}
 }
 
@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// NodeMaintenanceTaintKey is the key of the NoSchedule taint virt-controller
+	// puts on nodes in maintenance. VirtualMachineInstances are evacuated from
+	// nodes with this taint.
+	NodeMaintenanceTaintKey = "kubevirt.io/maintenance"
+
+	// NodeMaintenanceDomainsAnnotation is set by virt-handler on nodes in
+	// maintenance to the number of domains still running on the node.
+	NodeMaintenanceDomainsAnnotation = "kubevirt.io/maintenance-domains"
+)
+
+// NodeMaintenance puts a node into maintenance. virt-controller keeps new
+// VirtualMachineInstances off the node and live migrates the running ones
+// away, virt-handler confirms that no domains are left on the node. Deleting
+// the NodeMaintenance ends the maintenance.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NodeMaintenance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NodeMaintenanceSpec `json:"spec"`
+
+	// +optional
+	Status *NodeMaintenanceStatus `json:"status,omitempty"`
+}
+
+// NodeMaintenanceList is a list of NodeMaintenance resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NodeMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []NodeMaintenance `json:"items"`
+}
+
+// NodeMaintenanceSpec is the spec for a NodeMaintenance resource
+// +kubebuilder:validation:XValidation:rule="self.nodeName == oldSelf.nodeName",message="nodeName is immutable"
+type NodeMaintenanceSpec struct {
+	// NodeName is the name of the node to put into maintenance
+	// +kubebuilder:validation:MinLength=1
+	NodeName string `json:"nodeName"`
+	// Reason describes why the node is put into maintenance
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// NodeMaintenancePhase is the phase of a NodeMaintenance
+type NodeMaintenancePhase string
+
+const (
+	// NodeMaintenanceDraining means VirtualMachineInstances or domains are
+	// still running on the node
+	NodeMaintenanceDraining NodeMaintenancePhase = "Draining"
+	// NodeMaintenanceReadyForReboot means no VirtualMachineInstances and no
+	// domains are left on the node
+	NodeMaintenanceReadyForReboot NodeMaintenancePhase = "ReadyForReboot"
+)
+
+// NodeMaintenanceStatus is the status for a NodeMaintenance resource
+type NodeMaintenanceStatus struct {
+	// Phase is the phase of the maintenance
+	// +optional
+	Phase NodeMaintenancePhase `json:"phase,omitempty"`
+	// RemainingVirtualMachineInstances is the number of VirtualMachineInstances
+	// still running on the node
+	// +optional
+	RemainingVirtualMachineInstances int32 `json:"remainingVirtualMachineInstances,omitempty"`
+	// RemainingDomains is the number of domains virt-handler reports on the node,
+	// unset until virt-handler reported them
+	// +optional
+	RemainingDomains *int32 `json:"remainingDomains,omitempty"`
+	// BlockingVirtualMachineInstances lists the namespace/name of the
+	// VirtualMachineInstances which will not be live migrated and have to be
+	// stopped before the maintenance can complete
+	// +optional
+	// +listType=atomic
+	BlockingVirtualMachineInstances []string `json:"blockingVirtualMachineInstances,omitempty"`
+	// ReadyTimestamp is the time the node became ready for reboot
+	// +optional
+	ReadyTimestamp *metav1.Time `json:"readyTimestamp,omitempty"`
+}
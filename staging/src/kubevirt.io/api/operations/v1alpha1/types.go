@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineOperationLabel is set to the name of the VirtualMachineOperation
+// on the VirtualMachineInstanceMigrations it creates
+const VirtualMachineOperationLabel = "operations.kubevirt.io/virtualmachineoperation"
+
+// VirtualMachineOperation applies an action to the VirtualMachines selected by
+// its label selector. virt-controller works through the VirtualMachines with a
+// limited concurrency and reports the progress and the failures in the status.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineOperationSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineOperationStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineOperationList is a list of VirtualMachineOperation resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineOperation `json:"items"`
+}
+
+// VirtualMachineOperationAction is the action applied to the VirtualMachines
+// +kubebuilder:validation:Enum=Start;Stop;Restart;Migrate
+type VirtualMachineOperationAction string
+
+const (
+	// ActionStart starts the VirtualMachines and waits until they are ready
+	ActionStart VirtualMachineOperationAction = "Start"
+	// ActionStop stops the VirtualMachines and waits until they are stopped
+	ActionStop VirtualMachineOperationAction = "Stop"
+	// ActionRestart restarts the VirtualMachines and waits until they are ready again
+	ActionRestart VirtualMachineOperationAction = "Restart"
+	// ActionMigrate live migrates the VirtualMachineInstances of the VirtualMachines
+	ActionMigrate VirtualMachineOperationAction = "Migrate"
+)
+
+// VirtualMachineOperationSpec is the spec for a VirtualMachineOperation resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineOperationSpec struct {
+	// Action is applied to all selected VirtualMachines
+	Action VirtualMachineOperationAction `json:"action"`
+	// Selector selects the VirtualMachines in the namespace of the operation.
+	// The VirtualMachines are selected once, when the operation starts
+	Selector metav1.LabelSelector `json:"selector"`
+	// MaxConcurrent is the number of VirtualMachines the action is in progress
+	// for at the same time, defaults to 10
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty"`
+	// MaxFailures stops the operation once more VirtualMachines failed. The
+	// operation continues regardless of failures if unset
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFailures *int32 `json:"maxFailures,omitempty"`
+	// Timeout after which the action fails for a VirtualMachine, defaults to
+	// 10 minutes
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// VirtualMachineOperationPhase is the const type for the phases of an operation
+type VirtualMachineOperationPhase string
+
+const (
+	// OperationRunning indicates the action is applied to the VirtualMachines
+	OperationRunning VirtualMachineOperationPhase = "Running"
+	// OperationSucceeded indicates the action succeeded for all VirtualMachines
+	OperationSucceeded VirtualMachineOperationPhase = "Succeeded"
+	// OperationFailed indicates the action failed for some VirtualMachines or the
+	// operation stopped after too many failures
+	OperationFailed VirtualMachineOperationPhase = "Failed"
+)
+
+// VirtualMachineOperationStatus is the status for a VirtualMachineOperation resource
+type VirtualMachineOperationStatus struct {
+	// +optional
+	Phase VirtualMachineOperationPhase `json:"phase,omitempty"`
+	// StartTimestamp is the time the VirtualMachines were selected
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// CompletionTimestamp is the time the operation succeeded or failed
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+	// Total is the number of selected VirtualMachines
+	// +optional
+	Total int32 `json:"total,omitempty"`
+	// Succeeded is the number of VirtualMachines the action succeeded for
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of VirtualMachines the action failed for
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+	// Pending are the names of the selected VirtualMachines the action was not
+	// applied to yet
+	// +optional
+	// +listType=atomic
+	Pending []string `json:"pending,omitempty"`
+	// InProgress are the VirtualMachines the action was applied to and did not
+	// complete yet
+	// +optional
+	// +listType=atomic
+	InProgress []VirtualMachineOperationTarget `json:"inProgress,omitempty"`
+	// Failures aggregates the failed VirtualMachines by reason
+	// +optional
+	// +listType=atomic
+	Failures []VirtualMachineOperationFailure `json:"failures,omitempty"`
+	// FailureReason explains why the operation stopped
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// VirtualMachineOperationTarget is a VirtualMachine the action is in progress for
+type VirtualMachineOperationTarget struct {
+	// Name of the VirtualMachine
+	Name string `json:"name"`
+	// StartTimestamp is the time the action was applied to the VirtualMachine
+	StartTimestamp metav1.Time `json:"startTimestamp"`
+}
+
+// VirtualMachineOperationFailure counts the VirtualMachines which failed for
+// the same reason
+type VirtualMachineOperationFailure struct {
+	// Reason the action failed
+	Reason string `json:"reason"`
+	// Count is the number of VirtualMachines which failed for the reason
+	Count int32 `json:"count"`
+	// VirtualMachines are the names of the first VirtualMachines which failed
+	// for the reason
+	// +listType=atomic
+	VirtualMachines []string `json:"virtualMachines"`
+}
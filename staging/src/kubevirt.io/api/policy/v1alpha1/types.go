@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// VirtualMachinePolicy restricts the features VirtualMachines and
+// VirtualMachineInstances in its namespace can use. All policies of a namespace
+// are enforced, a VirtualMachineInstance has to comply with each of them.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachinePolicySpec `json:"spec"`
+}
+
+// VirtualMachinePolicyList is a list of VirtualMachinePolicy resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachinePolicy `json:"items"`
+}
+
+// VirtualMachinePolicySpec is the spec for a VirtualMachinePolicy resource
+type VirtualMachinePolicySpec struct {
+	// MaxCPUs is the largest number of vCPUs (sockets * cores * threads) a
+	// VirtualMachineInstance can have
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxCPUs *uint32 `json:"maxCPUs,omitempty"`
+	// MaxMemory is the largest amount of guest memory a VirtualMachineInstance
+	// can have
+	// +optional
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty"`
+	// AllowedDiskBuses lists the buses disks, CD-ROMs and LUNs can be attached
+	// with. All buses are allowed if empty
+	// +optional
+	// +listType=set
+	AllowedDiskBuses []v1.DiskBus `json:"allowedDiskBuses,omitempty"`
+	// AllowedNetworkBindings lists the interface bindings, like bridge,
+	// masquerade or sriov, or the names of network binding plugins interfaces
+	// can use. All bindings are allowed if empty
+	// +optional
+	// +listType=set
+	AllowedNetworkBindings []string `json:"allowedNetworkBindings,omitempty"`
+	// ForbidHostDevices rejects VirtualMachineInstances with host devices or GPUs
+	// +optional
+	ForbidHostDevices bool `json:"forbidHostDevices,omitempty"`
+	// RequireLaunchSecurity rejects VirtualMachineInstances without launch security
+	// +optional
+	RequireLaunchSecurity bool `json:"requireLaunchSecurity,omitempty"`
+	// DefaultRunStrategy is the run strategy of the VirtualMachines created
+	// without one. If several policies of a namespace set it, the one of the
+	// first policy in alphabetical order is used
+	// +optional
+	DefaultRunStrategy *v1.VirtualMachineRunStrategy `json:"defaultRunStrategy,omitempty"`
+	// PowerOffWindows are recurring windows during which the VirtualMachines of
+	// the namespace are stopped. They are started again according to their run
+	// strategy once the window ends
+	// +optional
+	// +listType=atomic
+	PowerOffWindows []PowerOffWindow `json:"powerOffWindows,omitempty"`
+}
+
+// PowerOffWindow is a recurring window of the day during which VirtualMachines
+// are stopped
+type PowerOffWindow struct {
+	// Start is the time of the day the window starts at, as HH:MM in UTC
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+	// End is the time of the day the window ends at, as HH:MM in UTC. Windows
+	// ending before they start end on the next day
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+	// Days are the days of the week the window starts on. The window starts
+	// every day if empty
+	// +optional
+	// +listType=set
+	Days []Weekday `json:"days,omitempty"`
+}
+
+// Weekday is a day of the week
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+// VirtualMachineDisruptionBudget limits the number of VirtualMachineInstances
+// of its namespace which are voluntarily disrupted at the same time, like
+// migrations for node drains or restarts for workload updates.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDisruptionBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineDisruptionBudgetSpec `json:"spec"`
+}
+
+// VirtualMachineDisruptionBudgetList is a list of VirtualMachineDisruptionBudget resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineDisruptionBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineDisruptionBudget `json:"items"`
+}
+
+// VirtualMachineDisruptionBudgetSpec is the spec for a VirtualMachineDisruptionBudget resource
+type VirtualMachineDisruptionBudgetSpec struct {
+	// Selector selects the VirtualMachineInstances the budget applies to by
+	// their labels
+	Selector *metav1.LabelSelector `json:"selector"`
+	// MaxDisruptions is the number of selected VirtualMachineInstances which
+	// can be disrupted at the same time
+	// +kubebuilder:validation:Minimum=0
+	MaxDisruptions int32 `json:"maxDisruptions"`
+}
@@ -72,6 +72,22 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkMapping) DeepCopyInto(out *NetworkMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkMapping.
+func (in *NetworkMapping) DeepCopy() *NetworkMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeClaim) DeepCopyInto(out *PersistentVolumeClaim) {
 	*out = *in
@@ -153,6 +169,22 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassMapping) DeepCopyInto(out *StorageClassMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassMapping.
+func (in *StorageClassMapping) DeepCopy() *StorageClassMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -256,6 +288,11 @@ func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec
 		*out = new(VolumeOwnershipPolicy)
 		**out = **in
 	}
+	if in.FirmwareIdentityPolicy != nil {
+		in, out := &in.FirmwareIdentityPolicy, &out.FirmwareIdentityPolicy
+		*out = new(FirmwareIdentityPolicy)
+		**out = **in
+	}
 	if in.VolumeRestoreOverrides != nil {
 		in, out := &in.VolumeRestoreOverrides, &out.VolumeRestoreOverrides
 		*out = make([]VolumeRestoreOverride, len(*in))
@@ -263,6 +300,16 @@ func (in *VirtualMachineRestoreSpec) DeepCopyInto(out *VirtualMachineRestoreSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.StorageClassMappings != nil {
+		in, out := &in.StorageClassMappings, &out.StorageClassMappings
+		*out = make([]StorageClassMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkMappings != nil {
+		in, out := &in.NetworkMappings, &out.NetworkMappings
+		*out = make([]NetworkMapping, len(*in))
+		copy(*out, *in)
+	}
 	if in.Patches != nil {
 		in, out := &in.Patches, &out.Patches
 		*out = make([]string, len(*in))
@@ -535,6 +582,11 @@ func (in *VirtualMachineSnapshotSpec) DeepCopyInto(out *VirtualMachineSnapshotSp
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.IncludeMemory != nil {
+		in, out := &in.IncludeMemory, &out.IncludeMemory
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -72,6 +72,13 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// IncludeMemory captures the memory state of a running VM along with its
+	// volumes, so that a restore of the snapshot resumes the guest where it was.
+	// The VM is paused until its volumes are snapshotted.
+	// Requires the SnapshotMemory feature gate.
+	// +optional
+	IncludeMemory *bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
@@ -83,6 +90,7 @@ const (
 	VMSnapshotGuestAgentIndication     Indication = "GuestAgent"
 	VMSnapshotQuiesceFailedIndication  Indication = "QuiesceFailed"
 	VMSnapshotPausedIndication         Indication = "Paused"
+	VMSnapshotMemoryIndication         Indication = "Memory"
 )
 
 // SourceIndication provides an indication of the source VM with its description message
@@ -374,6 +382,19 @@ const (
 	VolumeOwnershipPolicyNone VolumeOwnershipPolicy = "None"
 )
 
+// FirmwareIdentityPolicy defines what happens to the firmware UUID and SMBIOS serial of the restored VM
+type FirmwareIdentityPolicy string
+
+const (
+	// FirmwareIdentityPolicyPreserve defines a FirmwareIdentityPolicy where the restored VM keeps
+	// the firmware UUID and SMBIOS serial of the snapshotted VM. This is the default policy.
+	FirmwareIdentityPolicyPreserve FirmwareIdentityPolicy = "Preserve"
+
+	// FirmwareIdentityPolicyRegenerate defines a FirmwareIdentityPolicy where the restored VM gets
+	// a new firmware UUID and SMBIOS serial
+	FirmwareIdentityPolicyRegenerate FirmwareIdentityPolicy = "Regenerate"
+)
+
 // VirtualMachineRestoreSpec is the spec for a VirtualMachineRestore resource
 type VirtualMachineRestoreSpec struct {
 	// initially only VirtualMachine type supported
@@ -390,12 +411,29 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	VolumeOwnershipPolicy *VolumeOwnershipPolicy `json:"volumeOwnershipPolicy,omitempty"`
 
+	// FirmwareIdentityPolicy defines whether the restored VM keeps the firmware UUID and SMBIOS serial
+	// of the snapshotted VM, or gets new ones. Defaults to Preserve.
+	// +optional
+	FirmwareIdentityPolicy *FirmwareIdentityPolicy `json:"firmwareIdentityPolicy,omitempty"`
+
 	// VolumeRestoreOverrides gives the option to change properties of each restored volume
 	// For example, specifying the name of the restored volume, or adding labels/annotations to it
 	// +optional
 	// +listType=atomic
 	VolumeRestoreOverrides []VolumeRestoreOverride `json:"volumeRestoreOverrides,omitempty"`
 
+	// StorageClassMappings replaces the storage classes of the snapshotted volumes
+	// by the storage classes of the restored volumes
+	// +optional
+	// +listType=atomic
+	StorageClassMappings []StorageClassMapping `json:"storageClassMappings,omitempty"`
+
+	// NetworkMappings replaces the Multus networks of the snapshotted VM
+	// by the networks of the restored VM
+	// +optional
+	// +listType=atomic
+	NetworkMappings []NetworkMapping `json:"networkMappings,omitempty"`
+
 	// If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be
 	// applied to the target manifest before it's created. Patches should fit the target's Kind.
 	//
@@ -439,6 +477,24 @@ type VolumeRestore struct {
 	DataVolumeName *string `json:"dataVolumeName,omitempty"`
 }
 
+// StorageClassMapping maps a storage class of the snapshotted volumes to a storage class of the restored volumes
+type StorageClassMapping struct {
+	// Source is the storage class of the snapshotted volumes
+	Source string `json:"source"`
+
+	// Target is the storage class of the restored volumes
+	Target string `json:"target"`
+}
+
+// NetworkMapping maps a Multus network of the snapshotted VM to a network of the restored VM
+type NetworkMapping struct {
+	// Source is the network name of the snapshotted VM, as referenced by its Multus networks
+	Source string `json:"source"`
+
+	// Target is the network name of the restored VM, in the format <namespace>/<name> or <name>
+	Target string `json:"target"`
+}
+
 // VolumeRestoreOverride specifies how a volume should be restored from a VirtualMachineSnapshot
 type VolumeRestoreOverride struct {
 	VolumeName string `json:"volumeName,omitempty"`
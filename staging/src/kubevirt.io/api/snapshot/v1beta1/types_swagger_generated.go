@@ -14,6 +14,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"includeMemory":   "IncludeMemory captures the memory state of a running VM along with its\nvolumes, so that a restore of the snapshot resumes the guest where it was.\nThe VM is paused until its volumes are snapshotted.\nRequires the SnapshotMemory feature gate.\n+optional",
 	}
 }
 
@@ -153,7 +154,10 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"targetReadinessPolicy":  "+optional",
 		"volumeRestorePolicy":    "+optional",
 		"volumeOwnershipPolicy":  "+optional",
+		"firmwareIdentityPolicy": "FirmwareIdentityPolicy defines whether the restored VM keeps the firmware UUID and SMBIOS serial\nof the snapshotted VM, or gets new ones. Defaults to Preserve.\n+optional",
 		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
+		"storageClassMappings":   "StorageClassMappings replaces the storage classes of the snapshotted volumes\nby the storage classes of the restored volumes\n+optional\n+listType=atomic",
+		"networkMappings":        "NetworkMappings replaces the Multus networks of the snapshotted VM\nby the networks of the restored VM\n+optional\n+listType=atomic",
 		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
 	}
 }
@@ -176,6 +180,22 @@ func (VolumeRestore) SwaggerDoc() map[string]string {
 	}
 }
 
+func (StorageClassMapping) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "StorageClassMapping maps a storage class of the snapshotted volumes to a storage class of the restored volumes",
+		"source": "Source is the storage class of the snapshotted volumes",
+		"target": "Target is the storage class of the restored volumes",
+	}
+}
+
+func (NetworkMapping) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "NetworkMapping maps a Multus network of the snapshotted VM to a network of the restored VM",
+		"source": "Source is the network name of the snapshotted VM, as referenced by its Multus networks",
+		"target": "Target is the network name of the restored VM, in the format <namespace>/<name> or <name>",
+	}
+}
+
 func (VolumeRestoreOverride) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":            "VolumeRestoreOverride specifies how a volume should be restored from a VirtualMachineSnapshot",
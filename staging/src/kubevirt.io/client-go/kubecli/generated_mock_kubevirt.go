@@ -85,12 +85,18 @@ import (
 	containerizeddataimporter "kubevirt.io/client-go/containerizeddataimporter"
 	externalsnapshotter "kubevirt.io/client-go/externalsnapshotter"
 	kubevirt "kubevirt.io/client-go/kubevirt"
+	v1alpha114 "kubevirt.io/client-go/kubevirt/typed/accounting/v1alpha1"
+	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/audit/v1alpha1"
 	v1alpha19 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
+	v1alpha113 "kubevirt.io/client-go/kubevirt/typed/checkup/v1alpha1"
 	v1beta117 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	v123 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	v1beta118 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	v1beta119 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	v1alpha115 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
 	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	v1alpha116 "kubevirt.io/client-go/kubevirt/typed/operations/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/kubevirt/typed/policy/v1alpha1"
 	v1beta120 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 	v1beta121 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkattachmentdefinitionclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
@@ -836,6 +842,20 @@ func (mr *MockKubevirtClientMockRecorder) NetworkingV1beta1() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkingV1beta1", reflect.TypeOf((*MockKubevirtClient)(nil).NetworkingV1beta1))
 }
 
+// NodeMaintenance mocks base method.
+func (m *MockKubevirtClient) NodeMaintenance() v1alpha115.NodeMaintenanceInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeMaintenance")
+	ret0, _ := ret[0].(v1alpha115.NodeMaintenanceInterface)
+	return ret0
+}
+
+// NodeMaintenance indicates an expected call of NodeMaintenance.
+func (mr *MockKubevirtClientMockRecorder) NodeMaintenance() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeMaintenance", reflect.TypeOf((*MockKubevirtClient)(nil).NodeMaintenance))
+}
+
 // NodeV1 mocks base method.
 func (m *MockKubevirtClient) NodeV1() v116.NodeV1Interface {
 	m.ctrl.T.Helper()
@@ -1215,6 +1235,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachine(namespace any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachine), namespace)
 }
 
+// VirtualMachineAuditEvent mocks base method.
+func (m *MockKubevirtClient) VirtualMachineAuditEvent(namespace string) v1alpha111.VirtualMachineAuditEventInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineAuditEvent", namespace)
+	ret0, _ := ret[0].(v1alpha111.VirtualMachineAuditEventInterface)
+	return ret0
+}
+
+// VirtualMachineAuditEvent indicates an expected call of VirtualMachineAuditEvent.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineAuditEvent(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineAuditEvent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineAuditEvent), namespace)
+}
+
 // VirtualMachineBackup mocks base method.
 func (m *MockKubevirtClient) VirtualMachineBackup(namespace string) v1alpha19.VirtualMachineBackupInterface {
 	m.ctrl.T.Helper()
@@ -1229,6 +1263,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackup(namespace any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackup), namespace)
 }
 
+// VirtualMachineBackupHook mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackupHook(namespace string) v1alpha19.VirtualMachineBackupHookInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackupHook", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineBackupHookInterface)
+	return ret0
+}
+
+// VirtualMachineBackupHook indicates an expected call of VirtualMachineBackupHook.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupHook(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupHook", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupHook), namespace)
+}
+
 // VirtualMachineBackupTracker mocks base method.
 func (m *MockKubevirtClient) VirtualMachineBackupTracker(namespace string) v1alpha19.VirtualMachineBackupTrackerInterface {
 	m.ctrl.T.Helper()
@@ -1243,6 +1291,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupTracker(namespace
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupTracker", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupTracker), namespace)
 }
 
+// VirtualMachineCheckup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineCheckup(namespace string) v1alpha113.VirtualMachineCheckupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineCheckup", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineCheckupInterface)
+	return ret0
+}
+
+// VirtualMachineCheckup indicates an expected call of VirtualMachineCheckup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineCheckup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineCheckup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineCheckup), namespace)
+}
+
 // VirtualMachineClone mocks base method.
 func (m *MockKubevirtClient) VirtualMachineClone(namespace string) v1beta117.VirtualMachineCloneInterface {
 	m.ctrl.T.Helper()
@@ -1285,6 +1347,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineClusterPreference() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineClusterPreference", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineClusterPreference))
 }
 
+// VirtualMachineDisruptionBudget mocks base method.
+func (m *MockKubevirtClient) VirtualMachineDisruptionBudget(namespace string) v1alpha112.VirtualMachineDisruptionBudgetInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineDisruptionBudget", namespace)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachineDisruptionBudgetInterface)
+	return ret0
+}
+
+// VirtualMachineDisruptionBudget indicates an expected call of VirtualMachineDisruptionBudget.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineDisruptionBudget(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineDisruptionBudget", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineDisruptionBudget), namespace)
+}
+
 // VirtualMachineExport mocks base method.
 func (m *MockKubevirtClient) VirtualMachineExport(namespace string) v1beta118.VirtualMachineExportInterface {
 	m.ctrl.T.Helper()
@@ -1355,6 +1431,34 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineInstancetype(namespace a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineInstancetype", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineInstancetype), namespace)
 }
 
+// VirtualMachineOperation mocks base method.
+func (m *MockKubevirtClient) VirtualMachineOperation(namespace string) v1alpha116.VirtualMachineOperationInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineOperation", namespace)
+	ret0, _ := ret[0].(v1alpha116.VirtualMachineOperationInterface)
+	return ret0
+}
+
+// VirtualMachineOperation indicates an expected call of VirtualMachineOperation.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineOperation(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineOperation", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineOperation), namespace)
+}
+
+// VirtualMachinePolicy mocks base method.
+func (m *MockKubevirtClient) VirtualMachinePolicy(namespace string) v1alpha112.VirtualMachinePolicyInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachinePolicy", namespace)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachinePolicyInterface)
+	return ret0
+}
+
+// VirtualMachinePolicy indicates an expected call of VirtualMachinePolicy.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachinePolicy(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachinePolicy", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachinePolicy), namespace)
+}
+
 // VirtualMachinePool mocks base method.
 func (m *MockKubevirtClient) VirtualMachinePool(namespace string) v1beta120.VirtualMachinePoolInterface {
 	m.ctrl.T.Helper()
@@ -1425,6 +1529,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualMachineUsageReport mocks base method.
+func (m *MockKubevirtClient) VirtualMachineUsageReport(namespace string) v1alpha114.VirtualMachineUsageReportInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineUsageReport", namespace)
+	ret0, _ := ret[0].(v1alpha114.VirtualMachineUsageReportInterface)
+	return ret0
+}
+
+// VirtualMachineUsageReport indicates an expected call of VirtualMachineUsageReport.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineUsageReport(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineUsageReport", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineUsageReport), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1672,6 +1790,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) PortForward(name, por
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).PortForward), name, port, protocol)
 }
 
+// Reboot mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Reboot(ctx context.Context, name string, rebootOptions *v122.RebootOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reboot", ctx, name, rebootOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reboot indicates an expected call of Reboot.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Reboot(ctx, name, rebootOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Reboot), ctx, name, rebootOptions)
+}
+
 // RemoveVolume mocks base method.
 func (m *MockVirtualMachineInstanceInterface) RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v122.RemoveVolumeOptions) error {
 	m.ctrl.T.Helper()
@@ -1744,6 +1876,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVQueryLaunchMeasure
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVQueryLaunchMeasurement", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVQueryLaunchMeasurement), ctx, name)
 }
 
+// SEVSNPAttestationReport mocks base method.
+func (m *MockVirtualMachineInstanceInterface) SEVSNPAttestationReport(ctx context.Context, name string, sevSNPAttestationReportOptions *v122.SEVSNPAttestationReportOptions) (v122.SEVSNPAttestationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SEVSNPAttestationReport", ctx, name, sevSNPAttestationReportOptions)
+	ret0, _ := ret[0].(v122.SEVSNPAttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SEVSNPAttestationReport indicates an expected call of SEVSNPAttestationReport.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SEVSNPAttestationReport(ctx, name, sevSNPAttestationReportOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SEVSNPAttestationReport", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SEVSNPAttestationReport), ctx, name, sevSNPAttestationReportOptions)
+}
+
 // SEVSetupSession mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v122.SEVSessionOptions) error {
 	m.ctrl.T.Helper()
@@ -1788,6 +1935,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SerialConsole(name, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerialConsole", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SerialConsole), name, options)
 }
 
+// Smartcard mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Smartcard(vmiName string) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Smartcard", vmiName)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Smartcard indicates an expected call of Smartcard.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Smartcard(vmiName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Smartcard", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Smartcard), vmiName)
+}
+
 // SoftReboot mocks base method.
 func (m *MockVirtualMachineInstanceInterface) SoftReboot(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -1802,6 +1964,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) SoftReboot(ctx, name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftReboot", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).SoftReboot), ctx, name)
 }
 
+// TPMAttestation mocks base method.
+func (m *MockVirtualMachineInstanceInterface) TPMAttestation(ctx context.Context, name string, tpmAttestationOptions *v122.TPMAttestationOptions) (v122.TPMAttestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TPMAttestation", ctx, name, tpmAttestationOptions)
+	ret0, _ := ret[0].(v122.TPMAttestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TPMAttestation indicates an expected call of TPMAttestation.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) TPMAttestation(ctx, name, tpmAttestationOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TPMAttestation", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).TPMAttestation), ctx, name, tpmAttestationOptions)
+}
+
 // USBRedir mocks base method.
 func (m *MockVirtualMachineInstanceInterface) USBRedir(vmiName string) (v123.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -1890,6 +2067,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) VNC(name, preserveSes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VNC", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).VNC), name, preserveSession)
 }
 
+// VNCResolution mocks base method.
+func (m *MockVirtualMachineInstanceInterface) VNCResolution(ctx context.Context, name string, options *v122.VNCResolutionOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VNCResolution", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VNCResolution indicates an expected call of VNCResolution.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) VNCResolution(ctx, name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VNCResolution", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).VNCResolution), ctx, name, options)
+}
+
 // VSOCK mocks base method.
 func (m *MockVirtualMachineInstanceInterface) VSOCK(name string, options *v122.VSOCKOptions) (v123.StreamInterface, error) {
 	m.ctrl.T.Helper()
@@ -2607,6 +2798,21 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) UpdateStatus(ctx, virtualMach
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockVirtualMachineInterface)(nil).UpdateStatus), ctx, virtualMachine, opts)
 }
 
+// VSOCK mocks base method.
+func (m *MockVirtualMachineInterface) VSOCK(name string, options *v122.VSOCKOptions) (v123.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VSOCK", name, options)
+	ret0, _ := ret[0].(v123.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VSOCK indicates an expected call of VSOCK.
+func (mr *MockVirtualMachineInterfaceMockRecorder) VSOCK(name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VSOCK", reflect.TypeOf((*MockVirtualMachineInterface)(nil).VSOCK), name, options)
+}
+
 // Watch mocks base method.
 func (m *MockVirtualMachineInterface) Watch(ctx context.Context, opts v12.ListOptions) (watch.Interface, error) {
 	m.ctrl.T.Helper()
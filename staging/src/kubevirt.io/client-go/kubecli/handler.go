@@ -22,6 +22,7 @@ import (
 const (
 	consoleTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
 	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
+	smartcardTemplateURI      = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/smartcard"
 	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
 	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
@@ -31,14 +32,19 @@ const (
 	unfreezeTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
 	resetTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
 	softRebootTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
+	rebootTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reboot"
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
 	screenshotTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/screenshot"
+	vncResolutionTemplateURI  = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc/resolution"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
 	sevInjectLaunchSecretTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/injectlaunchsecret"
+	sevSNPAttestationReportTemplateURI   = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/attestationreport"
+
+	tpmAttestationTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/tpm/attestation"
 )
 
 func NewVirtHandlerClient(virtCli KubevirtClient, httpCli *http.Client) VirtHandlerClient {
@@ -60,8 +66,10 @@ type VirtHandlerConn interface {
 	ConnectionDetails() (ip string, port int, err error)
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SmartcardURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error)
 	ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	VNCResolutionURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -69,9 +77,12 @@ type VirtHandlerConn interface {
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	ResetURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	RebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SEVSNPAttestationReportURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	TPMAttestationURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url, contentType string) (string, error)
@@ -189,6 +200,10 @@ func (v *virtHandlerConn) USBRedirURI(vmi *virtv1.VirtualMachineInstance) (strin
 	return v.formatURI(usbredirTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SmartcardURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(smartcardTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance, preserveSession bool) (string, error) {
 	baseURI, err := v.formatURI(vncTemplateURI, vmi)
 	if err != nil {
@@ -208,6 +223,10 @@ func (v *virtHandlerConn) ScreenshotURI(vmi *virtv1.VirtualMachineInstance) (str
 	return v.formatURI(screenshotTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) VNCResolutionURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(vncResolutionTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error) {
 	baseURI, err := v.formatURI(vsockTemplateURI, vmi)
 	if err != nil {
@@ -236,6 +255,10 @@ func (v *virtHandlerConn) SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (str
 	return v.formatURI(softRebootTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) RebootURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(rebootTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(pauseTemplateURI, vmi)
 }
@@ -336,3 +359,11 @@ func (v *virtHandlerConn) SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachin
 func (v *virtHandlerConn) SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevInjectLaunchSecretTemplateURI, vmi)
 }
+
+func (v *virtHandlerConn) SEVSNPAttestationReportURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(sevSNPAttestationReportTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) TPMAttestationURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(tpmAttestationTemplateURI, vmi)
+}
@@ -43,11 +43,17 @@ import (
 	cdiclient "kubevirt.io/client-go/containerizeddataimporter"
 	k8ssnapshotclient "kubevirt.io/client-go/externalsnapshotter"
 	generatedclient "kubevirt.io/client-go/kubevirt"
+	accountingv1 "kubevirt.io/client-go/kubevirt/typed/accounting/v1alpha1"
+	auditv1 "kubevirt.io/client-go/kubevirt/typed/audit/v1alpha1"
 	backupv1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
+	checkupv1 "kubevirt.io/client-go/kubevirt/typed/checkup/v1alpha1"
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	maintenancev1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
 	migrationsv1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	operationsv1 "kubevirt.io/client-go/kubevirt/typed/operations/v1alpha1"
+	policyv1 "kubevirt.io/client-go/kubevirt/typed/policy/v1alpha1"
 	poolv1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 	snapshotv1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
@@ -65,6 +71,13 @@ type KubevirtClient interface {
 	VirtualMachineInstancePreset(namespace string) VirtualMachineInstancePresetInterface
 	VirtualMachineBackup(namespace string) backupv1.VirtualMachineBackupInterface
 	VirtualMachineBackupTracker(namespace string) backupv1.VirtualMachineBackupTrackerInterface
+	VirtualMachineBackupHook(namespace string) backupv1.VirtualMachineBackupHookInterface
+	VirtualMachineAuditEvent(namespace string) auditv1.VirtualMachineAuditEventInterface
+	VirtualMachineUsageReport(namespace string) accountingv1.VirtualMachineUsageReportInterface
+	VirtualMachineCheckup(namespace string) checkupv1.VirtualMachineCheckupInterface
+	VirtualMachineOperation(namespace string) operationsv1.VirtualMachineOperationInterface
+	VirtualMachinePolicy(namespace string) policyv1.VirtualMachinePolicyInterface
+	VirtualMachineDisruptionBudget(namespace string) policyv1.VirtualMachineDisruptionBudgetInterface
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
@@ -74,6 +87,7 @@ type KubevirtClient interface {
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	NodeMaintenance() maintenancev1.NodeMaintenanceInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -182,6 +196,34 @@ func (k kubevirtClient) VirtualMachineBackupTracker(namespace string) backupv1.V
 	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineBackupHook(namespace string) backupv1.VirtualMachineBackupHookInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupHooks(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineAuditEvent(namespace string) auditv1.VirtualMachineAuditEventInterface {
+	return k.generatedKubeVirtClient.AuditV1alpha1().VirtualMachineAuditEvents(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineUsageReport(namespace string) accountingv1.VirtualMachineUsageReportInterface {
+	return k.generatedKubeVirtClient.AccountingV1alpha1().VirtualMachineUsageReports(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineCheckup(namespace string) checkupv1.VirtualMachineCheckupInterface {
+	return k.generatedKubeVirtClient.CheckupV1alpha1().VirtualMachineCheckups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineOperation(namespace string) operationsv1.VirtualMachineOperationInterface {
+	return k.generatedKubeVirtClient.OperationsV1alpha1().VirtualMachineOperations(namespace)
+}
+
+func (k kubevirtClient) VirtualMachinePolicy(namespace string) policyv1.VirtualMachinePolicyInterface {
+	return k.generatedKubeVirtClient.PolicyV1alpha1().VirtualMachinePolicies(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineDisruptionBudget(namespace string) policyv1.VirtualMachineDisruptionBudgetInterface {
+	return k.generatedKubeVirtClient.PolicyV1alpha1().VirtualMachineDisruptionBudgets(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface {
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshots(namespace)
 }
@@ -226,6 +268,10 @@ func (k kubevirtClient) MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationPolicies()
 }
 
+func (k kubevirtClient) NodeMaintenance() maintenancev1.NodeMaintenanceInterface {
+	return k.generatedKubeVirtClient.MaintenanceV1alpha1().NodeMaintenances()
+}
+
 func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1Client {
 	return k.migrationsClient
 }
@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+const (
+	defaultMinReconnectBackoff = time.Second
+	defaultMaxReconnectBackoff = 30 * time.Second
+	streamChunkSize            = 32 * 1024
+)
+
+// StreamDialer opens a new stream to a streaming subresource
+type StreamDialer func() (kvcorev1.StreamInterface, error)
+
+// SerialConsoleDialer returns a StreamDialer for the serial console of a VMI
+func SerialConsoleDialer(vmis kvcorev1.VirtualMachineInstanceExpansion, name string, options *kvcorev1.SerialConsoleOptions) StreamDialer {
+	return func() (kvcorev1.StreamInterface, error) {
+		return vmis.SerialConsole(name, options)
+	}
+}
+
+// ReconnectOptions configure how StreamWithReconnect reopens broken streams
+type ReconnectOptions struct {
+	// MinBackoff is the delay before the first reconnect, it doubles with
+	// every consecutive failure up to MaxBackoff. Default to 1s and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxAttempts limits the consecutive failed attempts to reconnect,
+	// 0 retries until the context is cancelled.
+	MaxAttempts int
+	// OnDisconnect is called with the error which ended a stream, or failed
+	// to open it, before the next attempt.
+	OnDisconnect func(err error)
+}
+
+// StreamWithReconnect streams options.In to and options.Out from the streams
+// opened by dial, and opens a new stream whenever one breaks, e.g. because
+// the VMI was migrated or virt-api restarted.
+//
+// It returns nil once options.In is exhausted, the context error once ctx is
+// cancelled, and the last error once the subresource is not found or not
+// allowed, or MaxAttempts is exceeded. options.In is only read as fast as
+// the streams consume it, input which a stream received right before it
+// broke may be lost.
+//
+// Only streams which carry plain bytes, like the serial console, can be
+// reopened transparently. Protocols with a handshake, like VNC, USB
+// redirection or TLS over VSOCK, can not resume on a new stream.
+func StreamWithReconnect(ctx context.Context, dial StreamDialer, options kvcorev1.StreamOptions, reconnect ReconnectOptions) error {
+	if reconnect.MinBackoff <= 0 {
+		reconnect.MinBackoff = defaultMinReconnectBackoff
+	}
+	if reconnect.MaxBackoff < reconnect.MinBackoff {
+		reconnect.MaxBackoff = max(defaultMaxReconnectBackoff, reconnect.MinBackoff)
+	}
+
+	input := newStreamInput(ctx, options.In)
+	backoff := reconnect.MinBackoff
+	failures := 0
+	for {
+		stream, err := dial()
+		if err == nil {
+			failures = 0
+			backoff = reconnect.MinBackoff
+			err = streamOnce(ctx, stream, input, options.Out)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err == nil {
+				if input.exhausted.Load() {
+					return nil
+				}
+				err = io.EOF
+			}
+		} else if isPermanentStreamError(err) {
+			return err
+		}
+
+		failures++
+		if reconnect.MaxAttempts > 0 && failures > reconnect.MaxAttempts {
+			return err
+		}
+		if reconnect.OnDisconnect != nil {
+			reconnect.OnDisconnect(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, reconnect.MaxBackoff)
+	}
+}
+
+// streamOnce streams until the stream breaks or ctx is cancelled
+func streamOnce(ctx context.Context, stream kvcorev1.StreamInterface, input *streamInput, out io.Writer) error {
+	reader, writer := io.Pipe()
+	stopFeed := make(chan struct{})
+	feedDone := make(chan struct{})
+	go func() {
+		input.feed(writer, stopFeed)
+		close(feedDone)
+	}()
+
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- stream.Stream(kvcorev1.StreamOptions{In: reader, Out: out})
+	}()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		// Closing the connection ends the copy from the stream
+		stream.AsConn().Close()
+		<-streamErr
+		err = ctx.Err()
+	case err = <-streamErr:
+	}
+
+	close(stopFeed)
+	reader.Close()
+	<-feedDone
+	return err
+}
+
+func isPermanentStreamError(err error) bool {
+	var asyncErr *kvcorev1.AsyncSubresourceError
+	if !errors.As(err, &asyncErr) {
+		return false
+	}
+	switch asyncErr.GetStatusCode() {
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+		return true
+	}
+	return false
+}
+
+// streamInput reads the input of a stream once, so that it can be fed into
+// the streams which replace each other
+type streamInput struct {
+	chunks    chan []byte
+	exhausted atomic.Bool
+	// pending is the rest of a chunk which was not written to the last stream
+	pending []byte
+}
+
+func newStreamInput(ctx context.Context, in io.Reader) *streamInput {
+	input := &streamInput{chunks: make(chan []byte)}
+	if in == nil {
+		return input
+	}
+
+	go func() {
+		defer close(input.chunks)
+		for {
+			buf := make([]byte, streamChunkSize)
+			n, err := in.Read(buf)
+			if n > 0 {
+				select {
+				case input.chunks <- buf[:n]:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return input
+}
+
+func (s *streamInput) feed(writer *io.PipeWriter, stop <-chan struct{}) {
+	for {
+		chunk := s.pending
+		if chunk == nil {
+			var ok bool
+			select {
+			case <-stop:
+				return
+			case chunk, ok = <-s.chunks:
+			}
+			if !ok {
+				s.exhausted.Store(true)
+				writer.Close()
+				return
+			}
+		}
+
+		n, err := writer.Write(chunk)
+		if err != nil {
+			s.pending = chunk[n:]
+			return
+		}
+		s.pending = nil
+	}
+}
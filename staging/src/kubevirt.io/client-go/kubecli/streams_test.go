@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package kubecli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+)
+
+// fakeStream receives its input into a shared buffer, and breaks after
+// maxReads reads from it
+type fakeStream struct {
+	received *syncBuffer
+	maxReads int
+	closed   chan struct{}
+	once     sync.Once
+}
+
+func newFakeStream(received *syncBuffer, maxReads int) *fakeStream {
+	return &fakeStream{received: received, maxReads: maxReads, closed: make(chan struct{})}
+}
+
+func (f *fakeStream) Stream(options kvcorev1.StreamOptions) error {
+	type readResult struct {
+		data []byte
+		err  error
+	}
+	for reads := 0; f.maxReads == 0 || reads < f.maxReads; reads++ {
+		result := make(chan readResult, 1)
+		go func() {
+			buf := make([]byte, 1024)
+			n, err := options.In.Read(buf)
+			result <- readResult{buf[:n], err}
+		}()
+
+		select {
+		case <-f.closed:
+			return fmt.Errorf("connection closed")
+		case r := <-result:
+			f.received.Write(r.data)
+			if r.err == io.EOF {
+				return nil
+			} else if r.err != nil {
+				return r.err
+			}
+		}
+	}
+	return fmt.Errorf("stream broke")
+}
+
+func (f *fakeStream) AsConn() net.Conn {
+	return &fakeConn{stream: f}
+}
+
+type fakeConn struct {
+	net.Conn
+	stream *fakeStream
+}
+
+func (c *fakeConn) Close() error {
+	c.stream.once.Do(func() { close(c.stream.closed) })
+	return nil
+}
+
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+var _ = Describe("StreamWithReconnect", func() {
+	var (
+		received  *syncBuffer
+		reconnect ReconnectOptions
+	)
+
+	BeforeEach(func() {
+		received = &syncBuffer{}
+		reconnect = ReconnectOptions{MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	})
+
+	It("should reconnect when a stream breaks and keep feeding the input", func() {
+		streams := []*fakeStream{newFakeStream(received, 1), newFakeStream(received, 0)}
+		dials := 0
+		dial := func() (kvcorev1.StreamInterface, error) {
+			stream := streams[dials]
+			dials++
+			return stream, nil
+		}
+		var disconnects []error
+		reconnect.OnDisconnect = func(err error) { disconnects = append(disconnects, err) }
+
+		in, inWriter := io.Pipe()
+		go func() {
+			defer GinkgoRecover()
+			_, err := inWriter.Write([]byte("first"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = inWriter.Write([]byte("second"))
+			Expect(err).ToNot(HaveOccurred())
+			inWriter.Close()
+		}()
+
+		err := StreamWithReconnect(context.Background(), dial, kvcorev1.StreamOptions{In: in, Out: io.Discard}, reconnect)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dials).To(Equal(2))
+		Expect(disconnects).To(ConsistOf(MatchError("stream broke")))
+		Expect(received.String()).To(Equal("firstsecond"))
+	})
+
+	It("should not retry when the subresource is not found", func() {
+		dials := 0
+		dial := func() (kvcorev1.StreamInterface, error) {
+			dials++
+			return nil, &kvcorev1.AsyncSubresourceError{StatusCode: http.StatusNotFound}
+		}
+
+		err := StreamWithReconnect(context.Background(), dial, kvcorev1.StreamOptions{Out: io.Discard}, reconnect)
+		Expect(err).To(BeAssignableToTypeOf(&kvcorev1.AsyncSubresourceError{}))
+		Expect(dials).To(Equal(1))
+	})
+
+	It("should give up after the maximum number of attempts", func() {
+		dials := 0
+		dial := func() (kvcorev1.StreamInterface, error) {
+			dials++
+			return nil, &kvcorev1.AsyncSubresourceError{StatusCode: http.StatusInternalServerError}
+		}
+		reconnect.MaxAttempts = 2
+
+		err := StreamWithReconnect(context.Background(), dial, kvcorev1.StreamOptions{Out: io.Discard}, reconnect)
+		Expect(err).To(HaveOccurred())
+		Expect(dials).To(Equal(3))
+	})
+
+	It("should close the stream when the context is cancelled", func() {
+		stream := newFakeStream(received, 0)
+		dial := func() (kvcorev1.StreamInterface, error) {
+			return stream, nil
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		in, _ := io.Pipe()
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- StreamWithReconnect(ctx, dial, kvcorev1.StreamOptions{In: in, Out: io.Discard}, reconnect)
+		}()
+		cancel()
+
+		Eventually(errChan).Should(Receive(MatchError(context.Canceled)))
+		Expect(stream.closed).To(BeClosed())
+	})
+})
@@ -101,3 +101,11 @@ func (v *vm) UpdateStatus(ctx context.Context, vmi *v1.VirtualMachine, opts k8sm
 func (v *vm) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
+
+func (v *vm) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
+	queryParams, err := buildVSOCKQueryParams(options)
+	if err != nil {
+		return nil, err
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
+}
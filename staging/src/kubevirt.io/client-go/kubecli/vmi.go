@@ -63,6 +63,10 @@ func (v *vmis) USBRedir(name string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "usbredir", url.Values{})
 }
 
+func (v *vmis) Smartcard(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "smartcard", url.Values{})
+}
+
 func (v *vmis) VNC(name string, preserveSession bool) (kvcorev1.StreamInterface, error) {
 	queryParams := url.Values{}
 	queryParams.Add("preserveSession", strconv.FormatBool(preserveSession))
@@ -92,6 +96,10 @@ type connectionStruct struct {
 }
 
 func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions) (kvcorev1.StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil && options.Serial != "" {
+		queryParams.Add("serial", options.Serial)
+	}
 
 	if options != nil && options.ConnectionTimeout != 0 {
 		timeoutChan := time.Tick(options.ConnectionTimeout)
@@ -110,7 +118,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 				default:
 				}
 
-				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+				con, err := kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 				if err != nil {
 					asyncSubresourceError, ok := err.(*kvcorev1.AsyncSubresourceError)
 					// return if response status code does not equal to 400
@@ -130,7 +138,7 @@ func (v *vmis) SerialConsole(name string, options *kvcorev1.SerialConsoleOptions
 		conStruct := <-connectionChan
 		return conStruct.con, conStruct.err
 	} else {
-		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+		return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 	}
 }
 
@@ -169,6 +177,14 @@ func (v *vmis) Patch(ctx context.Context, name string, pt types.PatchType, data
 }
 
 func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
+	queryParams, err := buildVSOCKQueryParams(options)
+	if err != nil {
+		return nil, err
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
+}
+
+func buildVSOCKQueryParams(options *v1.VSOCKOptions) (url.Values, error) {
 	if options == nil || options.TargetPort == 0 {
 		return nil, fmt.Errorf("target port is required but not provided")
 	}
@@ -179,5 +195,5 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 		useTLS = *options.UseTLS
 	}
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
-	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
+	return queryParams, nil
 }
@@ -380,6 +380,23 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should reboot a VirtualMachineInstance", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "reboot")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).Reboot(context.Background(), "testvm", &v1.RebootOptions{Method: v1.RebootMethodACPI})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch GuestOSInfo from VirtualMachineInstance via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -513,6 +530,24 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should request a VNC resolution via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		options := &v1.VNCResolutionOptions{Width: 1920, Height: 1080}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "vnc/resolution")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).VNCResolution(context.Background(), "testvm", options)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should inject SEV launch secret into a VirtualMachineInstance", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -530,6 +565,58 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch the SEV-SNP attestation report via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		report := v1.SEVSNPAttestationReport{
+			Report: "AAABBB",
+			Nonce:  "c0ffee",
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "sev/attestationreport"), "nonce=c0ffee"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, report),
+		))
+		fetchedReport, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).SEVSNPAttestationReport(context.Background(), "testvm", &v1.SEVSNPAttestationReportOptions{
+			Nonce: "c0ffee",
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedReport).To(Equal(report))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should fetch the TPM attestation via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		tpmAttestation := v1.TPMAttestation{
+			EventLog: "AAABBB",
+			Quote:    "CCCDDD",
+			PCRs:     map[string]string{"0": "00ff", "7": "ff00"},
+			Nonce:    "c0ffee",
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "tpm/attestation"), "credential=Y3JlZA%3D%3D&nonce=c0ffee&pcrs=0%2C7"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, tpmAttestation),
+		))
+		fetchedAttestation, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).TPMAttestation(context.Background(), "testvm", &v1.TPMAttestationOptions{
+			Nonce:      "c0ffee",
+			PCRs:       []uint32{0, 7},
+			Credential: "Y3JlZA==",
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedAttestation).To(Equal(tpmAttestation))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should evacuate cancel a VirtualMachineInstance", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
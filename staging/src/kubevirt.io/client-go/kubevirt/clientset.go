@@ -27,14 +27,20 @@ import (
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
+	accountingv1alpha1 "kubevirt.io/client-go/kubevirt/typed/accounting/v1alpha1"
+	auditv1alpha1 "kubevirt.io/client-go/kubevirt/typed/audit/v1alpha1"
 	backupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
+	checkupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/checkup/v1alpha1"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	exportv1alpha1 "kubevirt.io/client-go/kubevirt/typed/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	operationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/operations/v1alpha1"
+	policyv1alpha1 "kubevirt.io/client-go/kubevirt/typed/policy/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 	snapshotv1alpha1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1alpha1"
@@ -43,14 +49,20 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	AccountingV1alpha1() accountingv1alpha1.AccountingV1alpha1Interface
+	AuditV1alpha1() auditv1alpha1.AuditV1alpha1Interface
 	BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface
+	CheckupV1alpha1() checkupv1alpha1.CheckupV1alpha1Interface
 	CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface
 	CloneV1beta1() clonev1beta1.CloneV1beta1Interface
 	KubevirtV1() kubevirtv1.KubevirtV1Interface
 	ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface
 	ExportV1beta1() exportv1beta1.ExportV1beta1Interface
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
+	MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
+	OperationsV1alpha1() operationsv1alpha1.OperationsV1alpha1Interface
+	PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	PoolV1beta1() poolv1beta1.PoolV1beta1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
@@ -60,25 +72,46 @@ type Interface interface {
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	accountingV1alpha1  *accountingv1alpha1.AccountingV1alpha1Client
+	auditV1alpha1       *auditv1alpha1.AuditV1alpha1Client
 	backupV1alpha1      *backupv1alpha1.BackupV1alpha1Client
+	checkupV1alpha1     *checkupv1alpha1.CheckupV1alpha1Client
 	cloneV1alpha1       *clonev1alpha1.CloneV1alpha1Client
 	cloneV1beta1        *clonev1beta1.CloneV1beta1Client
 	kubevirtV1          *kubevirtv1.KubevirtV1Client
 	exportV1alpha1      *exportv1alpha1.ExportV1alpha1Client
 	exportV1beta1       *exportv1beta1.ExportV1beta1Client
 	instancetypeV1beta1 *instancetypev1beta1.InstancetypeV1beta1Client
+	maintenanceV1alpha1 *maintenancev1alpha1.MaintenanceV1alpha1Client
 	migrationsV1alpha1  *migrationsv1alpha1.MigrationsV1alpha1Client
+	operationsV1alpha1  *operationsv1alpha1.OperationsV1alpha1Client
+	policyV1alpha1      *policyv1alpha1.PolicyV1alpha1Client
 	poolV1alpha1        *poolv1alpha1.PoolV1alpha1Client
 	poolV1beta1         *poolv1beta1.PoolV1beta1Client
 	snapshotV1alpha1    *snapshotv1alpha1.SnapshotV1alpha1Client
 	snapshotV1beta1     *snapshotv1beta1.SnapshotV1beta1Client
 }
 
+// AccountingV1alpha1 retrieves the AccountingV1alpha1Client
+func (c *Clientset) AccountingV1alpha1() accountingv1alpha1.AccountingV1alpha1Interface {
+	return c.accountingV1alpha1
+}
+
+// AuditV1alpha1 retrieves the AuditV1alpha1Client
+func (c *Clientset) AuditV1alpha1() auditv1alpha1.AuditV1alpha1Interface {
+	return c.auditV1alpha1
+}
+
 // BackupV1alpha1 retrieves the BackupV1alpha1Client
 func (c *Clientset) BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface {
 	return c.backupV1alpha1
 }
 
+// CheckupV1alpha1 retrieves the CheckupV1alpha1Client
+func (c *Clientset) CheckupV1alpha1() checkupv1alpha1.CheckupV1alpha1Interface {
+	return c.checkupV1alpha1
+}
+
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
 func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
 	return c.cloneV1alpha1
@@ -109,11 +142,26 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return c.instancetypeV1beta1
 }
 
+// MaintenanceV1alpha1 retrieves the MaintenanceV1alpha1Client
+func (c *Clientset) MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface {
+	return c.maintenanceV1alpha1
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return c.migrationsV1alpha1
 }
 
+// OperationsV1alpha1 retrieves the OperationsV1alpha1Client
+func (c *Clientset) OperationsV1alpha1() operationsv1alpha1.OperationsV1alpha1Interface {
+	return c.operationsV1alpha1
+}
+
+// PolicyV1alpha1 retrieves the PolicyV1alpha1Client
+func (c *Clientset) PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface {
+	return c.policyV1alpha1
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return c.poolV1alpha1
@@ -178,10 +226,22 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.accountingV1alpha1, err = accountingv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.auditV1alpha1, err = auditv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.backupV1alpha1, err = backupv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.checkupV1alpha1, err = checkupv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.cloneV1alpha1, err = clonev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -206,10 +266,22 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.maintenanceV1alpha1, err = maintenancev1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.migrationsV1alpha1, err = migrationsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.operationsV1alpha1, err = operationsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.policyV1alpha1, err = policyv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.poolV1alpha1, err = poolv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
@@ -247,14 +319,20 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.accountingV1alpha1 = accountingv1alpha1.New(c)
+	cs.auditV1alpha1 = auditv1alpha1.New(c)
 	cs.backupV1alpha1 = backupv1alpha1.New(c)
+	cs.checkupV1alpha1 = checkupv1alpha1.New(c)
 	cs.cloneV1alpha1 = clonev1alpha1.New(c)
 	cs.cloneV1beta1 = clonev1beta1.New(c)
 	cs.kubevirtV1 = kubevirtv1.New(c)
 	cs.exportV1alpha1 = exportv1alpha1.New(c)
 	cs.exportV1beta1 = exportv1beta1.New(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
+	cs.maintenanceV1alpha1 = maintenancev1alpha1.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
+	cs.operationsV1alpha1 = operationsv1alpha1.New(c)
+	cs.policyV1alpha1 = policyv1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.poolV1beta1 = poolv1beta1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
@@ -27,8 +27,14 @@ import (
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/testing"
 	clientset "kubevirt.io/client-go/kubevirt"
+	accountingv1alpha1 "kubevirt.io/client-go/kubevirt/typed/accounting/v1alpha1"
+	fakeaccountingv1alpha1 "kubevirt.io/client-go/kubevirt/typed/accounting/v1alpha1/fake"
+	auditv1alpha1 "kubevirt.io/client-go/kubevirt/typed/audit/v1alpha1"
+	fakeauditv1alpha1 "kubevirt.io/client-go/kubevirt/typed/audit/v1alpha1/fake"
 	backupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1"
 	fakebackupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/backup/v1alpha1/fake"
+	checkupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/checkup/v1alpha1"
+	fakecheckupv1alpha1 "kubevirt.io/client-go/kubevirt/typed/checkup/v1alpha1/fake"
 	clonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1"
 	fakeclonev1alpha1 "kubevirt.io/client-go/kubevirt/typed/clone/v1alpha1/fake"
 	clonev1beta1 "kubevirt.io/client-go/kubevirt/typed/clone/v1beta1"
@@ -41,8 +47,14 @@ import (
 	fakeexportv1beta1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1/fake"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	fakeinstancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1/fake"
+	maintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1"
+	fakemaintenancev1alpha1 "kubevirt.io/client-go/kubevirt/typed/maintenance/v1alpha1/fake"
 	migrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1/fake"
+	operationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/operations/v1alpha1"
+	fakeoperationsv1alpha1 "kubevirt.io/client-go/kubevirt/typed/operations/v1alpha1/fake"
+	policyv1alpha1 "kubevirt.io/client-go/kubevirt/typed/policy/v1alpha1"
+	fakepolicyv1alpha1 "kubevirt.io/client-go/kubevirt/typed/policy/v1alpha1/fake"
 	poolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	fakepoolv1alpha1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1/fake"
 	poolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
@@ -107,11 +119,26 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// AccountingV1alpha1 retrieves the AccountingV1alpha1Client
+func (c *Clientset) AccountingV1alpha1() accountingv1alpha1.AccountingV1alpha1Interface {
+	return &fakeaccountingv1alpha1.FakeAccountingV1alpha1{Fake: &c.Fake}
+}
+
+// AuditV1alpha1 retrieves the AuditV1alpha1Client
+func (c *Clientset) AuditV1alpha1() auditv1alpha1.AuditV1alpha1Interface {
+	return &fakeauditv1alpha1.FakeAuditV1alpha1{Fake: &c.Fake}
+}
+
 // BackupV1alpha1 retrieves the BackupV1alpha1Client
 func (c *Clientset) BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface {
 	return &fakebackupv1alpha1.FakeBackupV1alpha1{Fake: &c.Fake}
 }
 
+// CheckupV1alpha1 retrieves the CheckupV1alpha1Client
+func (c *Clientset) CheckupV1alpha1() checkupv1alpha1.CheckupV1alpha1Interface {
+	return &fakecheckupv1alpha1.FakeCheckupV1alpha1{Fake: &c.Fake}
+}
+
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
 func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
 	return &fakeclonev1alpha1.FakeCloneV1alpha1{Fake: &c.Fake}
@@ -142,11 +169,26 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return &fakeinstancetypev1beta1.FakeInstancetypeV1beta1{Fake: &c.Fake}
 }
 
+// MaintenanceV1alpha1 retrieves the MaintenanceV1alpha1Client
+func (c *Clientset) MaintenanceV1alpha1() maintenancev1alpha1.MaintenanceV1alpha1Interface {
+	return &fakemaintenancev1alpha1.FakeMaintenanceV1alpha1{Fake: &c.Fake}
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return &fakemigrationsv1alpha1.FakeMigrationsV1alpha1{Fake: &c.Fake}
 }
 
+// OperationsV1alpha1 retrieves the OperationsV1alpha1Client
+func (c *Clientset) OperationsV1alpha1() operationsv1alpha1.OperationsV1alpha1Interface {
+	return &fakeoperationsv1alpha1.FakeOperationsV1alpha1{Fake: &c.Fake}
+}
+
+// PolicyV1alpha1 retrieves the PolicyV1alpha1Client
+func (c *Clientset) PolicyV1alpha1() policyv1alpha1.PolicyV1alpha1Interface {
+	return &fakepolicyv1alpha1.FakePolicyV1alpha1{Fake: &c.Fake}
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return &fakepoolv1alpha1.FakePoolV1alpha1{Fake: &c.Fake}
@@ -26,14 +26,20 @@ import (
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	serializer "k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	accountingv1alpha1 "kubevirt.io/api/accounting/v1alpha1"
+	auditv1alpha1 "kubevirt.io/api/audit/v1alpha1"
 	backupv1alpha1 "kubevirt.io/api/backup/v1alpha1"
+	checkupv1alpha1 "kubevirt.io/api/checkup/v1alpha1"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	clonev1beta1 "kubevirt.io/api/clone/v1beta1"
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	maintenancev1alpha1 "kubevirt.io/api/maintenance/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	operationsv1alpha1 "kubevirt.io/api/operations/v1alpha1"
+	policyv1alpha1 "kubevirt.io/api/policy/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -44,14 +50,20 @@ var Scheme = runtime.NewScheme()
 var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
+	accountingv1alpha1.AddToScheme,
+	auditv1alpha1.AddToScheme,
 	backupv1alpha1.AddToScheme,
+	checkupv1alpha1.AddToScheme,
 	clonev1alpha1.AddToScheme,
 	clonev1beta1.AddToScheme,
 	kubevirtv1.AddToScheme,
 	exportv1alpha1.AddToScheme,
 	exportv1beta1.AddToScheme,
 	instancetypev1beta1.AddToScheme,
+	maintenancev1alpha1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
+	operationsv1alpha1.AddToScheme,
+	policyv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	poolv1beta1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
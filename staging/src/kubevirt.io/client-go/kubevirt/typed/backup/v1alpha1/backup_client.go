@@ -31,6 +31,7 @@ import (
 type BackupV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineBackupsGetter
+	VirtualMachineBackupHooksGetter
 	VirtualMachineBackupTrackersGetter
 }
 
@@ -43,6 +44,10 @@ func (c *BackupV1alpha1Client) VirtualMachineBackups(namespace string) VirtualMa
 	return newVirtualMachineBackups(c, namespace)
 }
 
+func (c *BackupV1alpha1Client) VirtualMachineBackupHooks(namespace string) VirtualMachineBackupHookInterface {
+	return newVirtualMachineBackupHooks(c, namespace)
+}
+
 func (c *BackupV1alpha1Client) VirtualMachineBackupTrackers(namespace string) VirtualMachineBackupTrackerInterface {
 	return newVirtualMachineBackupTrackers(c, namespace)
 }
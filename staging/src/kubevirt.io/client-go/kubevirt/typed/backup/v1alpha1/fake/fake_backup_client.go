@@ -34,6 +34,10 @@ func (c *FakeBackupV1alpha1) VirtualMachineBackups(namespace string) v1alpha1.Vi
 	return newFakeVirtualMachineBackups(c, namespace)
 }
 
+func (c *FakeBackupV1alpha1) VirtualMachineBackupHooks(namespace string) v1alpha1.VirtualMachineBackupHookInterface {
+	return newFakeVirtualMachineBackupHooks(c, namespace)
+}
+
 func (c *FakeBackupV1alpha1) VirtualMachineBackupTrackers(namespace string) v1alpha1.VirtualMachineBackupTrackerInterface {
 	return newFakeVirtualMachineBackupTrackers(c, namespace)
 }
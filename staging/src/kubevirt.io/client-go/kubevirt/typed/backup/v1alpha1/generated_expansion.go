@@ -22,4 +22,6 @@ package v1alpha1
 
 type VirtualMachineBackupExpansion interface{}
 
+type VirtualMachineBackupHookExpansion interface{}
+
 type VirtualMachineBackupTrackerExpansion interface{}
@@ -105,6 +105,10 @@ func (c *fakeVirtualMachines) PortForward(name string, port int, protocol string
 	return nil, nil
 }
 
+func (c *fakeVirtualMachines) VSOCK(name string, options *v1.VSOCKOptions) (kubevirtv1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *fakeVirtualMachines) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	obj, err := c.Fake.
 		Invokes(fake2.NewGetSubresourceAction(c.Resource(), c.Namespace(), "objectgraph", name, objectGraphOptions), nil)
@@ -39,6 +39,10 @@ func (c *fakeVirtualMachineInstances) USBRedir(vmiName string) (kvcorev1.StreamI
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) Smartcard(vmiName string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *fakeVirtualMachineInstances) VNC(name string, preserveSession bool) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
@@ -47,6 +51,13 @@ func (c *fakeVirtualMachineInstances) Screenshot(ctx context.Context, name strin
 	return nil, nil
 }
 
+func (c *fakeVirtualMachineInstances) VNCResolution(ctx context.Context, name string, options *v1.VNCResolutionOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "vnc/resolution", name, options), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
@@ -93,6 +104,13 @@ func (c *fakeVirtualMachineInstances) SoftReboot(ctx context.Context, name strin
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) Reboot(ctx context.Context, name string, rebootOptions *v1.RebootOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "reboot", name, rebootOptions), nil)
+
+	return err
+}
+
 func (c *fakeVirtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "guestosinfo", name), &v1.VirtualMachineInstanceGuestAgentInfo{})
@@ -161,6 +179,20 @@ func (c *fakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context,
 	return err
 }
 
+func (c *fakeVirtualMachineInstances) SEVSNPAttestationReport(ctx context.Context, name string, sevSNPAttestationReportOptions *v1.SEVSNPAttestationReportOptions) (v1.SEVSNPAttestationReport, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "sev/attestationreport", name), &v1.SEVSNPAttestationReport{})
+
+	return v1.SEVSNPAttestationReport{}, err
+}
+
+func (c *fakeVirtualMachineInstances) TPMAttestation(ctx context.Context, name string, tpmAttestationOptions *v1.TPMAttestationOptions) (v1.TPMAttestation, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(c.Resource(), c.Namespace(), "tpm/attestation", name), &v1.TPMAttestation{})
+
+	return v1.TPMAttestation{}, err
+}
+
 func (c *fakeVirtualMachineInstances) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error) {
 	obj, err := c.Fake.
 		Invokes(fake2.NewGetSubresourceAction(c.Resource(), c.Namespace(), "objectgraph", name, objectGraphOptions), nil)
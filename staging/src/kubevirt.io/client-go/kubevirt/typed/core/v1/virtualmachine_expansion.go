@@ -44,6 +44,7 @@ type VirtualMachineExpansion interface {
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
+	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
@@ -172,6 +173,12 @@ func (c *virtualMachines) PortForward(name string, port int, protocol string) (S
 	return nil, fmt.Errorf("PortForward is not implemented yet in generated client")
 }
 
+func (c *virtualMachines) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
+}
+
 func (c *virtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	body, err := json.Marshal(memoryDumpRequest)
 	if err != nil {
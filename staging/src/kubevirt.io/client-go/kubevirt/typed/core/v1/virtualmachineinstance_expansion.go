@@ -23,6 +23,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,13 +38,18 @@ const vmiSubresourceURL = "/apis/subresources.kubevirt.io/%s"
 
 type SerialConsoleOptions struct {
 	ConnectionTimeout time.Duration
+	// Serial is the name of the serial port to connect to, instead of the
+	// default serial console.
+	Serial string
 }
 
 type VirtualMachineInstanceExpansion interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
+	Smartcard(vmiName string) (StreamInterface, error)
 	VNC(name string, preserveSession bool) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
+	VNCResolution(ctx context.Context, name string, options *v1.VNCResolutionOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Backup(ctx context.Context, name string, backupOptions *backupv1.BackupOptions) error
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
@@ -51,6 +58,7 @@ type VirtualMachineInstanceExpansion interface {
 	Unfreeze(ctx context.Context, name string) error
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
+	Reboot(ctx context.Context, name string, rebootOptions *v1.RebootOptions) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
@@ -62,6 +70,8 @@ type VirtualMachineInstanceExpansion interface {
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
 	SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error
+	SEVSNPAttestationReport(ctx context.Context, name string, sevSNPAttestationReportOptions *v1.SEVSNPAttestationReportOptions) (v1.SEVSNPAttestationReport, error)
+	TPMAttestation(ctx context.Context, name string, tpmAttestationOptions *v1.TPMAttestationOptions) (v1.TPMAttestation, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
 }
 
@@ -77,6 +87,12 @@ func (c *virtualMachineInstances) USBRedir(vmiName string) (StreamInterface, err
 	return nil, fmt.Errorf("USBRedir is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) Smartcard(vmiName string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("Smartcard is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) VNC(name string, preserveSession bool) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
@@ -105,6 +121,22 @@ func (c *virtualMachineInstances) Screenshot(ctx context.Context, name string, o
 	return raw, nil
 }
 
+func (c *virtualMachineInstances) VNCResolution(ctx context.Context, name string, options *v1.VNCResolutionOptions) error {
+	body, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("vnc", "resolution").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
@@ -223,6 +255,23 @@ func (c *virtualMachineInstances) SoftReboot(ctx context.Context, name string) e
 		Error()
 }
 
+func (c *virtualMachineInstances) Reboot(ctx context.Context, name string, rebootOptions *v1.RebootOptions) error {
+	body, err := json.Marshal(rebootOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("reboot").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
 	// WORKAROUND:
@@ -419,6 +468,50 @@ func (c *virtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, nam
 		Error()
 }
 
+func (c *virtualMachineInstances) SEVSNPAttestationReport(ctx context.Context, name string, sevSNPAttestationReportOptions *v1.SEVSNPAttestationReportOptions) (v1.SEVSNPAttestationReport, error) {
+	report := v1.SEVSNPAttestationReport{}
+	request := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("sev", "attestationreport")
+	if sevSNPAttestationReportOptions != nil && sevSNPAttestationReportOptions.Nonce != "" {
+		request = request.Param("nonce", sevSNPAttestationReportOptions.Nonce)
+	}
+	err := request.Do(ctx).Into(&report)
+
+	return report, err
+}
+
+func (c *virtualMachineInstances) TPMAttestation(ctx context.Context, name string, tpmAttestationOptions *v1.TPMAttestationOptions) (v1.TPMAttestation, error) {
+	tpmAttestation := v1.TPMAttestation{}
+	request := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("tpm", "attestation")
+	if tpmAttestationOptions != nil {
+		if tpmAttestationOptions.Nonce != "" {
+			request = request.Param("nonce", tpmAttestationOptions.Nonce)
+		}
+		if len(tpmAttestationOptions.PCRs) > 0 {
+			pcrs := make([]string, 0, len(tpmAttestationOptions.PCRs))
+			for _, pcr := range tpmAttestationOptions.PCRs {
+				pcrs = append(pcrs, strconv.FormatUint(uint64(pcr), 10))
+			}
+			request = request.Param("pcrs", strings.Join(pcrs, ","))
+		}
+		if tpmAttestationOptions.Credential != "" {
+			request = request.Param("credential", tpmAttestationOptions.Credential)
+		}
+	}
+	err := request.Do(ctx).Into(&tpmAttestation)
+
+	return tpmAttestation, err
+}
+
 func (c *virtualMachineInstances) EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error {
 	body, err := json.Marshal(evacuateCancelOptions)
 	if err != nil {
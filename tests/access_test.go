@@ -456,10 +456,22 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "sev/injectlaunchsecret",
 				allowUpdateFor("admin", "edit"),
 				denyAllFor("migrate", "default")),
+			Entry("on vmi sev/attestationreport",
+				"virtualmachineinstances", "sev/attestationreport",
+				allowGetFor("admin", "edit", "view"),
+				denyAllFor("migrate", "default")),
+			Entry("on vmi tpm/attestation",
+				"virtualmachineinstances", "tpm/attestation",
+				allowGetFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vmi usbredir",
 				"virtualmachineinstances", "usbredir",
 				allowGetFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi smartcard",
+				"virtualmachineinstances", "smartcard",
+				allowGetFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 			Entry("on vmi vnc",
 				"virtualmachineinstances", "vnc",
 				allowGetFor("admin", "edit"),
@@ -468,6 +480,10 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				"virtualmachineinstances", "vnc/screenshot",
 				allowGetFor("admin", "edit"),
 				denyAllFor("view", "migrate", "default")),
+			Entry("on vmi vnc/resolution",
+				"virtualmachineinstances", "vnc/resolution",
+				allowUpdateFor("admin", "edit"),
+				denyAllFor("view", "migrate", "default")),
 		)
 	})
 })